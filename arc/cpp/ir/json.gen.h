@@ -182,6 +182,48 @@ inline x::json::json Authorities::to_json() const {
     return j;
 }
 
+inline Interlock Interlock::parse(x::json::Parser parser) {
+    return Interlock{
+        .channel = parser.field<std::uint32_t>("channel"),
+        .comparison = parser.field<std::string>("comparison"),
+        .value = parser.field<double>("value"),
+    };
+}
+
+inline x::json::json Interlock::to_json() const {
+    x::json::json j;
+    j["channel"] = this->channel;
+    j["comparison"] = this->comparison;
+    j["value"] = this->value;
+    return j;
+}
+
+inline Envelope Envelope::parse(x::json::Parser parser) {
+    return Envelope{
+        .channel = parser.field<std::uint32_t>("channel"),
+        .min = parser.field<std::optional<double>>("min"),
+        .max = parser.field<std::optional<double>>("max"),
+        .max_rate = parser.field<std::optional<double>>("max_rate"),
+        .interlocks = parser.field<std::vector<Interlock>>("interlocks"),
+        .safe_value = parser.field<std::optional<double>>("safe_value"),
+        .safe_sequence = parser.field<std::string>("safe_sequence"),
+        .safe_stage = parser.field<std::string>("safe_stage"),
+    };
+}
+
+inline x::json::json Envelope::to_json() const {
+    x::json::json j;
+    j["channel"] = this->channel;
+    j["min"] = this->min;
+    j["max"] = this->max;
+    j["max_rate"] = this->max_rate;
+    j["interlocks"] = x::json::to_array(this->interlocks);
+    j["safe_value"] = this->safe_value;
+    j["safe_sequence"] = this->safe_sequence;
+    j["safe_stage"] = this->safe_stage;
+    return j;
+}
+
 inline IR IR::parse(x::json::Parser parser) {
     return IR{
         .functions = parser.field<Functions>("functions"),
@@ -189,6 +231,7 @@ inline IR IR::parse(x::json::Parser parser) {
         .edges = parser.field<Edges>("edges"),
         .authorities = parser.field<Authorities>("authorities"),
         .root = parser.field<Scope>("root"),
+        .safety = parser.field<Safety>("safety"),
     };
 }
 
@@ -199,6 +242,7 @@ inline x::json::json IR::to_json() const {
     j["edges"] = this->edges.to_json();
     j["authorities"] = this->authorities.to_json();
     j["root"] = this->root.to_json();
+    j["safety"] = this->safety.to_json();
     return j;
 }
 
@@ -247,4 +291,19 @@ inline x::json::json Nodes::to_json() const {
     return j;
 }
 
+inline Safety Safety::parse(x::json::Parser parser) {
+    Safety result;
+    for (auto &item: parser.field<std::vector<Envelope>>())
+        result.push_back(std::move(item));
+    return result;
+}
+
+inline x::json::json Safety::to_json() const {
+    x::json::json j = x::json::json::array();
+    for (const auto &item: *this) {
+        j.push_back(item.to_json());
+    }
+    return j;
+}
+
 }
@@ -326,6 +326,60 @@ Authorities::from_proto(const ::arc::ir::pb::Authorities &pb) {
     return {cpp, x::errors::NIL};
 }
 
+inline std::pair<::arc::ir::pb::Interlock, x::errors::Error>
+Interlock::to_proto() const {
+    ::arc::ir::pb::Interlock pb;
+    pb.set_channel(this->channel);
+    pb.set_comparison(this->comparison);
+    pb.set_value(this->value);
+    return {pb, x::errors::NIL};
+}
+
+inline std::pair<Interlock, x::errors::Error>
+Interlock::from_proto(const ::arc::ir::pb::Interlock &pb) {
+    Interlock cpp;
+    cpp.channel = pb.channel();
+    cpp.comparison = pb.comparison();
+    cpp.value = pb.value();
+    return {cpp, x::errors::NIL};
+}
+
+inline std::pair<::arc::ir::pb::Envelope, x::errors::Error>
+Envelope::to_proto() const {
+    ::arc::ir::pb::Envelope pb;
+    pb.set_channel(this->channel);
+    if (this->min.has_value()) pb.set_min(*this->min);
+    if (this->max.has_value()) pb.set_max(*this->max);
+    if (this->max_rate.has_value()) pb.set_max_rate(*this->max_rate);
+    for (const auto &item: this->interlocks) {
+        auto [v, err] = item.to_proto();
+        if (err) return {{}, err};
+        *pb.add_interlocks() = v;
+    }
+    if (this->safe_value.has_value()) pb.set_safe_value(*this->safe_value);
+    pb.set_safe_sequence(this->safe_sequence);
+    pb.set_safe_stage(this->safe_stage);
+    return {pb, x::errors::NIL};
+}
+
+inline std::pair<Envelope, x::errors::Error>
+Envelope::from_proto(const ::arc::ir::pb::Envelope &pb) {
+    Envelope cpp;
+    cpp.channel = pb.channel();
+    if (pb.has_min()) cpp.min = pb.min();
+    if (pb.has_max()) cpp.max = pb.max();
+    if (pb.has_max_rate()) cpp.max_rate = pb.max_rate();
+    if (auto err = x::pb::from_proto_repeated<Interlock>(
+            cpp.interlocks,
+            pb.interlocks()
+        ))
+        return {{}, err};
+    if (pb.has_safe_value()) cpp.safe_value = pb.safe_value();
+    cpp.safe_sequence = pb.safe_sequence();
+    cpp.safe_stage = pb.safe_stage();
+    return {cpp, x::errors::NIL};
+}
+
 inline std::pair<::arc::ir::pb::IR, x::errors::Error> IR::to_proto() const {
     ::arc::ir::pb::IR pb;
     for (const auto &item: this->functions) {
@@ -353,6 +407,11 @@ inline std::pair<::arc::ir::pb::IR, x::errors::Error> IR::to_proto() const {
         if (err) return {{}, err};
         *pb.mutable_root() = v;
     }
+    for (const auto &item: this->safety) {
+        auto [v, err] = item.to_proto();
+        if (err) return {{}, err};
+        *pb.add_safety() = v;
+    }
     return {pb, x::errors::NIL};
 }
 
@@ -374,6 +433,8 @@ inline std::pair<IR, x::errors::Error> IR::from_proto(const ::arc::ir::pb::IR &p
         if (err) return {{}, err};
         cpp.root = v;
     }
+    if (auto err = x::pb::from_proto_repeated<Envelope>(cpp.safety, pb.safety()))
+        return {{}, err};
     return {cpp, x::errors::NIL};
 }
 
@@ -32,6 +32,8 @@ struct Handle;
 struct Body;
 struct Node;
 struct Authorities;
+struct Interlock;
+struct Envelope;
 struct Transition;
 struct Function;
 struct Edge;
@@ -134,6 +136,28 @@ struct Authorities {
     from_proto(const ::arc::ir::pb::Authorities &pb);
 };
 
+/// @brief Interlock holds a guarded channel at its safe value while another channel's
+/// value satisfies a comparison.
+struct Interlock {
+    /// @brief channel is the key of the channel whose value the interlock conditions
+    /// on.
+    std::uint32_t channel = 0;
+    /// @brief comparison is the operator comparing the channel's value to value: one of
+    /// <, <=, >, >=, == or !=.
+    std::string comparison;
+    /// @brief value is the threshold the channel's value is compared to.
+    double value = 0;
+
+    static Interlock parse(x::json::Parser parser);
+    [[nodiscard]] x::json::json to_json() const;
+
+    using proto_type = ::arc::ir::pb::Interlock;
+    [[nodiscard]] std::pair<::arc::ir::pb::Interlock, x::errors::Error>
+    to_proto() const;
+    static std::pair<Interlock, x::errors::Error>
+    from_proto(const ::arc::ir::pb::Interlock &pb);
+};
+
 /// @brief Transition is a declarative state-transition rule on a sequential Scope.
 struct Transition {
     /// @brief on is the dataflow handle whose truthy value fires this transition.
@@ -233,6 +257,40 @@ struct Nodes : private std::vector<Node> {
     [[nodiscard]] x::json::json to_json() const;
 };
 
+/// @brief Envelope is the safety envelope enforced on writes to an output channel of
+/// an Arc program.
+struct Envelope {
+    /// @brief channel is the key of the guarded channel.
+    std::uint32_t channel = 0;
+    /// @brief min is the smallest value that may be written to the channel.
+    std::optional<double> min;
+    /// @brief max is the largest value that may be written to the channel.
+    std::optional<double> max;
+    /// @brief max_rate is the largest change in the channel's value per second between
+    /// consecutive writes.
+    std::optional<double> max_rate;
+    /// @brief interlocks hold the channel at safe_value while any of their conditions
+    /// are satisfied.
+    std::vector<Interlock> interlocks;
+    /// @brief safe_value is the value the channel is held at while interlocked. Zero
+    /// when unset.
+    std::optional<double> safe_value;
+    /// @brief safe_sequence is the sequence containing safe_stage. Empty when a
+    /// violation does not trigger a stage.
+    std::string safe_sequence;
+    /// @brief safe_stage is the stage entered when a write violates the envelope.
+    std::string safe_stage;
+
+    static Envelope parse(x::json::Parser parser);
+    [[nodiscard]] x::json::json to_json() const;
+
+    using proto_type = ::arc::ir::pb::Envelope;
+    [[nodiscard]] std::pair<::arc::ir::pb::Envelope, x::errors::Error>
+    to_proto() const;
+    static std::pair<Envelope, x::errors::Error>
+    from_proto(const ::arc::ir::pb::Envelope &pb);
+};
+
 /// @brief Edge is a dataflow connection between node parameters in the Arc graph.
 struct Edge {
     /// @brief source is the source node parameter producing data.
@@ -354,6 +412,57 @@ struct Edges : private std::vector<Edge> {
     [[nodiscard]] x::json::json to_json() const;
 };
 
+struct Safety : private std::vector<Envelope> {
+    using Base = std::vector<Envelope>;
+
+    // Inherit constructors - these are instantiated at point of use, not declaration
+    using Base::Base;
+    Safety() = default;
+
+    // Container interface
+    using Base::begin;
+    using Base::capacity;
+    using Base::cbegin;
+    using Base::cend;
+    using Base::const_iterator;
+    using Base::const_reference;
+    using Base::const_reverse_iterator;
+    using Base::crbegin;
+    using Base::crend;
+    using Base::difference_type;
+    using Base::empty;
+    using Base::end;
+    using Base::iterator;
+    using Base::max_size;
+    using Base::rbegin;
+    using Base::reference;
+    using Base::rend;
+    using Base::reserve;
+    using Base::reverse_iterator;
+    using Base::shrink_to_fit;
+    using Base::size;
+    using Base::size_type;
+    using Base::value_type;
+    using Base::operator[];
+    using Base::assign;
+    using Base::at;
+    using Base::back;
+    using Base::clear;
+    using Base::data;
+    using Base::emplace;
+    using Base::emplace_back;
+    using Base::erase;
+    using Base::front;
+    using Base::insert;
+    using Base::pop_back;
+    using Base::push_back;
+    using Base::resize;
+    using Base::swap;
+
+    static Safety parse(x::json::Parser parser);
+    [[nodiscard]] x::json::json to_json() const;
+};
+
 using Members = std::vector<Member>;
 
 /// @brief Member is a tagged union representing a single child of a Scope. Exactly one
@@ -435,6 +544,8 @@ struct IR {
     /// always-live Scope whose strata mix module-scope reactive flow with top-level
     /// gated scopes.
     Scope root;
+    /// @brief safety contains the safety envelopes declared by this program.
+    Safety safety;
 
     static IR parse(x::json::Parser parser);
     [[nodiscard]] x::json::json to_json() const;
@@ -53,6 +53,11 @@ Program::to_proto() const {
         if (err) return {{}, err};
         *pb.mutable_root() = v;
     }
+    for (const auto &item: this->safety) {
+        auto [v, err] = item.to_proto();
+        if (err) return {{}, err};
+        *pb.add_safety() = v;
+    }
     pb.set_wasm(this->wasm.data(), this->wasm.size());
     for (const auto &[k, v]: this->output_memory_bases)
         (*pb.mutable_output_memory_bases())[k] = v;
@@ -81,6 +86,11 @@ Program::from_proto(const ::arc::program::pb::Program &pb) {
         if (err) return {{}, err};
         cpp.root = v;
     }
+    if (auto err = x::pb::from_proto_repeated<::arc::ir::Envelope>(
+            cpp.safety,
+            pb.safety()
+        ))
+        return {{}, err};
     cpp.wasm.assign(pb.wasm().begin(), pb.wasm().end());
     for (const auto &[k, v]: pb.output_memory_bases())
         cpp.output_memory_bases[k] = v;
@@ -9,6 +9,7 @@
 
 #pragma once
 
+#include <algorithm>
 #include <memory>
 #include <vector>
 
@@ -60,9 +61,17 @@ public:
         // Strip module prefix from the node type so factories only match bare
         // names. The compiler emits qualified names (e.g. "time.interval",
         // "control.set_authority") into the IR; normalizing here keeps prefix
-        // awareness out of individual factories.
+        // awareness out of individual factories. Functions linked in from
+        // libraries are keyed by their qualified name (e.g. "conv.c_to_f"), so a
+        // node type that names a compiled function is left intact.
         std::string module_prefix;
-        if (auto dot = cfg.node.type.rfind('.'); dot != std::string::npos) {
+        const bool is_function = std::any_of(
+            cfg.prog.functions.begin(),
+            cfg.prog.functions.end(),
+            [&](const ir::Function &fn) { return fn.key == cfg.node.type; }
+        );
+        if (auto dot = cfg.node.type.rfind('.');
+            !is_function && dot != std::string::npos) {
             module_prefix = cfg.node.type.substr(0, dot);
             cfg.node.type = cfg.node.type.substr(dot + 1);
         }
@@ -46,6 +46,7 @@ inline Type Type::parse(x::json::Parser parser) {
     result.unit = parser.field<std::optional<Unit>>("unit");
     result.constraint = parser.field<x::mem::indirect<Type>>("constraint");
     result.chan_direction = parser.field<ChanDirection>("chan_direction");
+    result.fields = parser.field<Params>("fields");
     return result;
 }
 
@@ -59,6 +60,7 @@ inline x::json::json Type::to_json() const {
     if (this->unit.has_value()) j["unit"] = this->unit->to_json();
     if (this->constraint.has_value()) j["constraint"] = this->constraint->to_json();
     j["chan_direction"] = this->chan_direction;
+    j["fields"] = this->fields.to_json();
     return j;
 }
 
@@ -93,6 +93,11 @@ inline std::pair<::arc::types::pb::Type, x::errors::Error> Type::to_proto() cons
     pb.set_chan_direction(
         static_cast<::arc::types::pb::ChanDirection>(this->chan_direction)
     );
+    for (const auto &item: this->fields) {
+        auto [v, err] = item.to_proto();
+        if (err) return {{}, err};
+        *pb.add_fields() = v;
+    }
     return {pb, x::errors::NIL};
 }
 
@@ -123,6 +128,8 @@ Type::from_proto(const ::arc::types::pb::Type &pb) {
         cpp.constraint = v;
     }
     cpp.chan_direction = static_cast<ChanDirection>(pb.chan_direction());
+    if (auto err = x::pb::from_proto_repeated<Param>(cpp.fields, pb.fields()))
+        return {{}, err};
     return {cpp, x::errors::NIL};
 }
 
@@ -58,6 +58,7 @@ enum class Kind : std::uint8_t {
     Function = 21,
     Sequence = 22,
     Stage = 23,
+    Structure = 24,
 };
 
 enum class ChanDirection : std::uint8_t {
@@ -229,6 +230,8 @@ struct Type : public FunctionProperties {
     /// @brief chan_direction indicates read/write direction for channel-typed config
     /// parameters.
     ChanDirection chan_direction;
+    /// @brief fields contains the named fields of struct types, in declaration order.
+    Params fields;
 
     static Type parse(x::json::Parser parser);
     [[nodiscard]] x::json::json to_json() const;
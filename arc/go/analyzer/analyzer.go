@@ -18,11 +18,13 @@ import (
 	"github.com/synnaxlabs/arc/analyzer/constant"
 	"github.com/synnaxlabs/arc/analyzer/constraints"
 	acontext "github.com/synnaxlabs/arc/analyzer/context"
+	"github.com/synnaxlabs/arc/analyzer/enum"
 	"github.com/synnaxlabs/arc/analyzer/expression"
 	"github.com/synnaxlabs/arc/analyzer/flow"
 	"github.com/synnaxlabs/arc/analyzer/function"
 	"github.com/synnaxlabs/arc/analyzer/sequence"
 	"github.com/synnaxlabs/arc/analyzer/statement"
+	"github.com/synnaxlabs/arc/analyzer/structure"
 	"github.com/synnaxlabs/arc/parser"
 	"github.com/synnaxlabs/arc/symbol"
 	"github.com/synnaxlabs/x/diagnostics"
@@ -55,6 +57,8 @@ func substituteTypeMap(ctx acontext.Context[parser.IProgramContext]) {
 }
 
 func collectDeclarations(ctx acontext.Context[parser.IProgramContext]) {
+	enum.CollectDeclarations(ctx)
+	structure.CollectDeclarations(ctx)
 	constant.CollectDeclarations(ctx)
 	function.CollectDeclarations(ctx)
 	sequence.CollectDeclarations(ctx)
@@ -124,8 +128,9 @@ type callEdgeInfo struct {
 func detectCallCycles(edges *[]acontext.CallEdge, diag *diagnostics.Diagnostics) {
 	callGraph := make(map[string][]callEdgeInfo)
 	for _, edge := range *edges {
-		callGraph[edge.Caller.Name] = append(callGraph[edge.Caller.Name], callEdgeInfo{
-			callee:   edge.Callee.Name,
+		caller := edge.Caller.QualifiedName()
+		callGraph[caller] = append(callGraph[caller], callEdgeInfo{
+			callee:   edge.Callee.QualifiedName(),
 			callSite: edge.CallSite,
 		})
 	}
@@ -164,7 +169,7 @@ func detectCallCycles(edges *[]acontext.CallEdge, diag *diagnostics.Diagnostics)
 		for _, node := range scc {
 			var sites []antlr.ParserRuleContext
 			for _, e := range *edges {
-				if e.Caller.Name == node && sccSet.Contains(e.Callee.Name) {
+				if e.Caller.QualifiedName() == node && sccSet.Contains(e.Callee.QualifiedName()) {
 					sites = append(sites, e.CallSite)
 				}
 			}
@@ -261,6 +266,18 @@ func blockAlwaysCalls(block parser.IBlockContext, sites []antlr.ParserRuleContex
 			}
 			continue
 		}
+		if match := stmt.MatchStatement(); match != nil {
+			if function.MatchStmtAlwaysReturns(match) {
+				return false
+			}
+			if matchAlwaysCalls(match, sites) {
+				return true
+			}
+			if matchSometimesReturns(match) {
+				return false
+			}
+			continue
+		}
 		if stmtContainsSite(stmt, sites) {
 			return true
 		}
@@ -299,6 +316,30 @@ func ifSometimesReturns(ifStmt parser.IIfStatementContext) bool {
 	return false
 }
 
+// matchAlwaysCalls returns true if the match statement has an else arm and every arm
+// always reaches a call site.
+func matchAlwaysCalls(match parser.IMatchStatementContext, sites []antlr.ParserRuleContext) bool {
+	if match.MatchElse() == nil || !blockAlwaysCalls(match.MatchElse().Block(), sites) {
+		return false
+	}
+	for _, arm := range match.AllMatchArm() {
+		if !blockAlwaysCalls(arm.Block(), sites) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchSometimesReturns returns true if any arm of the match statement returns.
+func matchSometimesReturns(match parser.IMatchStatementContext) bool {
+	for _, arm := range match.AllMatchArm() {
+		if function.BlockAlwaysReturns(arm.Block()) {
+			return true
+		}
+	}
+	return match.MatchElse() != nil && function.BlockAlwaysReturns(match.MatchElse().Block())
+}
+
 // stmtContainsSite checks if any call site is a descendant of the given statement.
 func stmtContainsSite(stmt parser.IStatementContext, sites []antlr.ParserRuleContext) bool {
 	for _, site := range sites {
@@ -40,8 +40,8 @@ func Analyze(
 	for _, item := range ctx.AST.AllTopLevelItem() {
 		authBlock := item.AuthorityBlock()
 		if authBlock == nil {
-			// Imports may freely precede the authority block.
-			if item.ImportStatement() != nil {
+			// Imports and safety blocks may freely precede the authority block.
+			if item.ImportStatement() != nil || item.SafetyBlock() != nil {
 				continue
 			}
 			seenDeclaration = true
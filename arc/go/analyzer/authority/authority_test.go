@@ -181,6 +181,18 @@ var _ = Describe("Authority Analyzer", func() {
 			Expect(*config.Default).To(Equal(uint8(200)))
 		})
 
+		It("Should allow a safety block before the authority declaration", func(specCtx SpecContext) {
+			prog := MustSucceed(parser.Parse(`
+				safety valve{max=10}
+				authority 200
+			`))
+			ctx := acontext.NewRoot(specCtx, prog, root)
+			config := authority.Analyze(ctx)
+			Expect(ctx.Diagnostics.Ok()).To(BeTrue(), ctx.Diagnostics.String())
+			Expect(config.Default).ToNot(BeNil())
+			Expect(*config.Default).To(Equal(uint8(200)))
+		})
+
 		It("Should still reject authority appearing after a function even with imports first", func(specCtx SpecContext) {
 			prog := MustSucceed(parser.Parse(`
 				import time
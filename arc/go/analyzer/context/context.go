@@ -98,6 +98,11 @@ type Context[AST antlr.ParserRuleContext] struct {
 	TypeMap map[antlr.ParserRuleContext]types.Type
 	// CallEdges tracks function call relationships for post-analysis channel propagation.
 	CallEdges *[]CallEdge
+	// Libraries tracks the analysis state of each library module imported by the
+	// program. A library maps to false while its source is being analyzed and to true
+	// once it has been, so that each library is analyzed once and import cycles can
+	// be detected.
+	Libraries map[*symbol.Symbol]bool
 	// AST is the current AST node being analyzed.
 	AST AST
 	// TypeHint is the expected type from surrounding context for type inference.
@@ -154,7 +159,8 @@ func (c Context[AST]) Resolve(name string) (*symbol.Symbol, error) {
 // reference is bare. When tail is non-empty, ResolveQualified follows
 // the head and then resolves tail through it; an alias head transparently
 // dispatches to its Target inside Resolve. Deprecation warnings fire on
-// whichever segment resolved to the deprecated symbol.
+// whichever segment resolved to the deprecated symbol. When head is a struct-typed
+// value, tail names one of its fields and the field is returned.
 func (c Context[AST]) ResolveQualified(head, tail string) (*symbol.Symbol, error) {
 	headSym, err := c.Scope.Resolve(c, head)
 	if err != nil {
@@ -164,6 +170,10 @@ func (c Context[AST]) ResolveQualified(head, tail string) (*symbol.Symbol, error
 		c.warnIfDeprecated(head, headSym)
 		return headSym, nil
 	}
+	if headSym.IsStructValue() {
+		c.warnIfDeprecated(head, headSym)
+		return headSym.Field(tail)
+	}
 	tailSym, err := headSym.Resolve(c, tail)
 	if err != nil {
 		return nil, err
@@ -212,6 +222,7 @@ func NewRoot[ASTNode antlr.ParserRuleContext](
 		Constraints: constraints.New(),
 		TypeMap:     make(map[antlr.ParserRuleContext]types.Type),
 		CallEdges:   &[]CallEdge{},
+		Libraries:   make(map[*symbol.Symbol]bool),
 		AST:         ast,
 	}
 }
@@ -233,6 +244,7 @@ func Child[P, N antlr.ParserRuleContext](ctx Context[P], next N) Context[N] {
 		Constraints:         ctx.Constraints,
 		TypeMap:             ctx.TypeMap,
 		CallEdges:           ctx.CallEdges,
+		Libraries:           ctx.Libraries,
 		AST:                 next,
 		TypeHint:            ctx.TypeHint,
 		InTypeInferenceMode: ctx.InTypeInferenceMode,
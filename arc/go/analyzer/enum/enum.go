@@ -0,0 +1,155 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package enum implements semantic analysis for Arc enum declarations.
+//
+// An enum declaration introduces a set of named integer values. Members are numbered
+// from zero unless given an explicit value, and each member without one takes the
+// value after the previous member. Members are referenced as qualified names, behave
+// like integer literals in expressions, and may be matched exhaustively with a match
+// statement. The enum name is usable as a type annotation for its underlying integer
+// type, which defaults to i64.
+//
+// Example:
+//
+//	enum Mode { idle, press, fire, safe }
+//	enum Valve u8 { closed = 0, open = 1 }
+package enum
+
+import (
+	"strconv"
+
+	"github.com/antlr4-go/antlr/v4"
+	acontext "github.com/synnaxlabs/arc/analyzer/context"
+	atypes "github.com/synnaxlabs/arc/analyzer/types"
+	"github.com/synnaxlabs/arc/literal"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/diagnostics"
+)
+
+// CollectDeclarations registers all enum declarations and their members in the
+// symbol table. It runs before the other declaration passes so that enum names are
+// available to struct fields, function signatures, and variable annotations.
+func CollectDeclarations(ctx acontext.Context[parser.IProgramContext]) {
+	for _, item := range ctx.AST.AllTopLevelItem() {
+		if decl := item.EnumDeclaration(); decl != nil {
+			collectEnum(ctx, decl)
+		}
+	}
+}
+
+func collectEnum(
+	ctx acontext.Context[parser.IProgramContext],
+	decl parser.IEnumDeclarationContext,
+) {
+	name := decl.IDENTIFIER().GetText()
+	t := types.I64()
+	if intType := decl.IntegerType(); intType != nil {
+		var err error
+		if t, err = atypes.InferIntegerType(intType); err != nil {
+			ctx.Diagnostics.Add(diagnostics.Error(err, intType))
+			return
+		}
+	}
+	t.Name = name
+	sym, err := ctx.Scope.Add(ctx, symbol.Symbol{
+		Name: name,
+		Kind: symbol.KindEnum,
+		Type: t,
+		AST:  decl,
+	})
+	if err != nil {
+		ctx.Diagnostics.Add(diagnostics.Error(err, decl))
+		return
+	}
+	list := decl.EnumMemberList()
+	if list == nil {
+		ctx.Diagnostics.Add(diagnostics.Errorf(decl, "enum %s must have at least one member", name))
+		return
+	}
+	var (
+		next    int64
+		byValue = make(map[int64]string)
+	)
+	for _, m := range list.AllEnumMember() {
+		memberName := m.IDENTIFIER().GetText()
+		if sym.FindChild(memberName) != nil {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				m, "duplicate member '%s' in enum %s", memberName, name,
+			))
+			continue
+		}
+		value := next
+		if lit := m.INTEGER_LITERAL(); lit != nil {
+			value, err = strconv.ParseInt(lit.GetText(), 10, 64)
+			if err != nil {
+				ctx.Diagnostics.Add(diagnostics.Errorf(
+					m, "invalid value %s for member '%s'", lit.GetText(), memberName,
+				))
+				continue
+			}
+			if m.MINUS() != nil {
+				value = -value
+			}
+		}
+		next = value + 1
+		if _, err = literal.ParseInteger(value, t); err != nil {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				m, "member '%s' of enum %s: %v", memberName, name, err,
+			))
+			continue
+		}
+		if other, ok := byValue[value]; ok {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				m,
+				"member '%s' of enum %s has the same value (%d) as '%s'",
+				memberName, name, value, other,
+			))
+			continue
+		}
+		byValue[value] = memberName
+		if _, err = sym.Add(ctx, symbol.Symbol{
+			Name:         memberName,
+			Kind:         symbol.KindEnumMember,
+			Type:         t,
+			DefaultValue: value,
+			AST:          m,
+		}); err != nil {
+			ctx.Diagnostics.Add(diagnostics.Error(err, m))
+		}
+	}
+}
+
+// Members returns the members of the enum e in declaration order.
+func Members(e *symbol.Symbol) []*symbol.Symbol {
+	return e.FilterChildrenByKind(symbol.KindEnumMember)
+}
+
+// Comparable returns true if values of type t can be compared with enum members: any
+// integer type, or the type of an integer literal.
+func Comparable(t types.Type) bool {
+	if t.Kind == types.KindVariable {
+		return t.Constraint != nil && t.Constraint.Kind == types.KindIntegerConstant
+	}
+	return t.IsInteger()
+}
+
+// OfType returns the enum named by t, or nil if t is not an enum type.
+func OfType[AST antlr.ParserRuleContext](ctx acontext.Context[AST], t types.Type) *symbol.Symbol {
+	if t.Name == "" || !t.IsInteger() {
+		return nil
+	}
+	sym, err := ctx.Scope.Resolve(ctx, t.Name)
+	if err != nil || sym.Kind != symbol.KindEnum {
+		return nil
+	}
+	return sym
+}
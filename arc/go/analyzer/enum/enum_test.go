@@ -0,0 +1,323 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package enum_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/analyzer"
+	acontext "github.com/synnaxlabs/arc/analyzer/context"
+	"github.com/synnaxlabs/arc/analyzer/enum"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	. "github.com/synnaxlabs/arc/symbol/testutil"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/diagnostics"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var channels = []symbol.Symbol{
+	{Name: "mode_ch", Kind: symbol.KindChannel, Type: types.Chan(types.U8()), ID: 1},
+	{Name: "pressure", Kind: symbol.KindChannel, Type: types.Chan(types.F64()), ID: 2},
+	{Name: "valve_cmd", Kind: symbol.KindChannel, Type: types.Chan(types.U8()), ID: 3},
+}
+
+func analyzeProgram(specCtx context.Context, src string) acontext.Context[parser.IProgramContext] {
+	prog := MustSucceed(parser.Parse(src))
+	ctx := acontext.NewRoot(specCtx, prog, NewRoot(nil, channels...))
+	analyzer.AnalyzeProgram(ctx)
+	return ctx
+}
+
+func analyzeExpectSuccess(specCtx context.Context, src string) acontext.Context[parser.IProgramContext] {
+	ctx := analyzeProgram(specCtx, src)
+	ExpectWithOffset(1, *ctx.Diagnostics).To(BeEmpty(), ctx.Diagnostics.String())
+	return ctx
+}
+
+func analyzeExpectDiagnostic(
+	specCtx context.Context,
+	src string,
+	severity diagnostics.Severity,
+	msgMatcher OmegaMatcher,
+) acontext.Context[parser.IProgramContext] {
+	ctx := analyzeProgram(specCtx, src)
+	ExpectWithOffset(1, *ctx.Diagnostics).To(HaveLen(1), ctx.Diagnostics.String())
+	ExpectWithOffset(1, (*ctx.Diagnostics)[0].Message).To(msgMatcher)
+	ExpectWithOffset(1, (*ctx.Diagnostics)[0].Severity).To(Equal(severity))
+	return ctx
+}
+
+func analyzeExpectError(
+	specCtx context.Context,
+	src string,
+	msgMatcher OmegaMatcher,
+) acontext.Context[parser.IProgramContext] {
+	return analyzeExpectDiagnostic(specCtx, src, diagnostics.SeverityError, msgMatcher)
+}
+
+func memberValues(e *symbol.Symbol) map[string]any {
+	values := make(map[string]any)
+	for _, m := range enum.Members(e) {
+		values[m.Name] = m.DefaultValue
+	}
+	return values
+}
+
+var _ = Describe("Enum Analyzer", func() {
+	Describe("CollectDeclarations", func() {
+		It("Should number members from zero by default", func(specCtx SpecContext) {
+			ctx := analyzeExpectSuccess(specCtx, `enum Mode { idle, press, fire }`)
+			sym := MustSucceed(ctx.Scope.Resolve(ctx, "Mode"))
+			Expect(sym.Kind).To(Equal(symbol.KindEnum))
+			Expect(sym.Type.Kind).To(Equal(types.KindI64))
+			Expect(sym.Type.Name).To(Equal("Mode"))
+			Expect(memberValues(sym)).To(Equal(map[string]any{
+				"idle": int64(0), "press": int64(1), "fire": int64(2),
+			}))
+		})
+
+		It("Should continue numbering after explicit values", func(specCtx SpecContext) {
+			ctx := analyzeExpectSuccess(specCtx, `enum Fault { none = -1, low = 10, high, }`)
+			sym := MustSucceed(ctx.Scope.Resolve(ctx, "Fault"))
+			Expect(memberValues(sym)).To(Equal(map[string]any{
+				"none": int64(-1), "low": int64(10), "high": int64(11),
+			}))
+		})
+
+		It("Should use an explicit underlying integer type", func(specCtx SpecContext) {
+			ctx := analyzeExpectSuccess(specCtx, `enum Valve u8 { closed, open }`)
+			sym := MustSucceed(ctx.Scope.Resolve(ctx, "Valve"))
+			Expect(sym.Type.Kind).To(Equal(types.KindU8))
+			member := MustSucceed(sym.Resolve(ctx, "open"))
+			Expect(member.Kind).To(Equal(symbol.KindEnumMember))
+			Expect(member.Type.Kind).To(Equal(types.KindU8))
+		})
+
+		It("Should not expose members as bare names", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			enum Mode { idle, press }
+			func f() i64 {
+				return idle
+			}
+			`, ContainSubstring("undefined symbol: idle"))
+		})
+
+		DescribeTable("Should reject invalid declarations",
+			func(specCtx SpecContext, src string, msg string) {
+				analyzeExpectError(specCtx, src, ContainSubstring(msg))
+			},
+			Entry("empty enum", `enum Mode {}`, "must have at least one member"),
+			Entry("duplicate member", `enum Mode { idle, idle }`, "duplicate member 'idle'"),
+			Entry("duplicate value", `enum Mode { idle = 1, press = 1 }`, "has the same value (1) as 'idle'"),
+			Entry("value out of range", `enum Mode u8 { idle = 256 }`, "out of range for u8"),
+			Entry("conflicting name", `
+			struct Mode { x f64 }
+			enum Mode { idle }`, "Mode"),
+		)
+	})
+
+	Describe("Members", func() {
+		It("Should compare members with channels of any integer type", func(specCtx SpecContext) {
+			analyzeExpectSuccess(specCtx, `
+			enum Mode { idle, press, fire }
+			func check() u8 {
+				return mode_ch == Mode.fire
+			}
+			`)
+		})
+
+		It("Should write members to integer channels", func(specCtx SpecContext) {
+			analyzeExpectSuccess(specCtx, `
+			enum Valve u8 { closed, open }
+			func open_valve() {
+				valve_cmd = Valve.open
+			}
+			`)
+		})
+
+		It("Should accept enum names as type annotations", func(specCtx SpecContext) {
+			ctx := analyzeExpectSuccess(specCtx, `
+			enum Valve u8 { closed, open }
+			func toggle(v Valve) Valve {
+				if v == Valve.open {
+					return Valve.closed
+				}
+				return Valve.open
+			}
+			`)
+			fn := MustSucceed(ctx.Scope.Resolve(ctx, "toggle"))
+			Expect(fn.Type.Inputs[0].Type.Kind).To(Equal(types.KindU8))
+		})
+
+		It("Should reject an unknown member", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			enum Mode { idle, press }
+			func f() i64 {
+				return Mode.fire
+			}
+			`, ContainSubstring("fire"))
+		})
+
+		It("Should reject an enum name used as a value", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			enum Mode { idle, press }
+			func f() i64 {
+				return Mode
+			}
+			`, Equal("Mode is an enum type, not a value"))
+		})
+	})
+
+	Describe("Match", func() {
+		It("Should accept a match covering every member", func(specCtx SpecContext) {
+			analyzeExpectSuccess(specCtx, `
+			enum Mode { idle, press, fire }
+			func f() i64 {
+				count := 0
+				match mode_ch {
+					Mode.idle => { count = 1 }
+					Mode.press, Mode.fire => { count = 2 }
+				}
+				return count
+			}
+			`)
+		})
+
+		It("Should accept a partial match with an else arm", func(specCtx SpecContext) {
+			analyzeExpectSuccess(specCtx, `
+			enum Mode { idle, press, fire }
+			func f() i64 {
+				match mode_ch {
+					Mode.fire => { return 1 }
+					else => { return 0 }
+				}
+			}
+			`)
+		})
+
+		It("Should report the members missing from a match", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			enum Mode { idle, press, fire }
+			func f() {
+				match mode_ch {
+					Mode.press => {}
+				}
+			}
+			`, Equal("match on Mode is not exhaustive: missing idle, fire"))
+		})
+
+		It("Should require an else arm when matching plain integers", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			func f(x i32) {
+				match x {
+					0, 1 => {}
+				}
+			}
+			`, Equal("match on i32 must have an else arm"))
+		})
+
+		It("Should warn about an unreachable else arm on an enum value", func(specCtx SpecContext) {
+			analyzeExpectDiagnostic(specCtx, `
+			enum Valve u8 { closed, open }
+			func f(v Valve) {
+				match v {
+					Valve.closed => {}
+					Valve.open => {}
+					else => {}
+				}
+			}
+			`, diagnostics.SeverityWarning, ContainSubstring("else arm is unreachable"))
+		})
+
+		DescribeTable("Should reject invalid patterns",
+			func(specCtx SpecContext, body string, msg string) {
+				analyzeExpectError(specCtx, `
+				enum Mode { idle, press }
+				enum Valve { closed, open }
+				func f(x i64) {
+					`+body+`
+				}
+				`, ContainSubstring(msg))
+			},
+			Entry("duplicate member", `match x {
+				Mode.idle => {}
+				Mode.idle => {}
+				else => {}
+			}`, "duplicate match pattern Mode.idle"),
+			Entry("duplicate value", `match x {
+				Mode.press => {}
+				1 => {}
+				else => {}
+			}`, "value 1 is already matched by Mode.press"),
+			Entry("members of different enums", `match x {
+				Mode.idle => {}
+				Valve.open => {}
+				else => {}
+			}`, "Valve.open is not a member of enum Mode"),
+			Entry("non-constant pattern", `match x {
+				x + 1 => {}
+				else => {}
+			}`, "must be an enum member or integer literal"),
+			Entry("float pattern", `match x {
+				1.5 => {}
+				else => {}
+			}`, "must be an integer"),
+			Entry("non-integer value", `match pressure {
+				else => {}
+			}`, "cannot match on value of type f64"),
+		)
+	})
+
+	Describe("Routing Tables", func() {
+		It("Should route stage transitions by enum value", func(specCtx SpecContext) {
+			analyzeExpectSuccess(specCtx, `
+			enum Mode u8 { idle, press, fire }
+			sequence main {
+				stage wait {
+					mode_ch => {
+						Mode.press: pressurize,
+						Mode.fire: fire,
+					}
+				}
+				stage pressurize {}
+				stage fire {}
+			}
+			`)
+		})
+
+		DescribeTable("Should reject invalid enum routing tables",
+			func(specCtx SpecContext, table string, msg string) {
+				analyzeExpectError(specCtx, `
+				enum Mode u8 { idle, press }
+				enum Valve u8 { closed, open }
+				sequence main {
+					stage wait {
+						`+table+`
+					}
+					stage hold {}
+				}
+				`, ContainSubstring(msg))
+			},
+			Entry("unknown member", `mode_ch => { Mode.fire: hold }`, "undefined symbol: fire"),
+			Entry("members of different enums", `mode_ch => {
+				Mode.idle: hold,
+				Valve.open: hold,
+			}`, "Valve.open is not a member of enum Mode"),
+			Entry("duplicate entry", `mode_ch => {
+				Mode.idle: hold,
+				Mode.idle: hold,
+			}`, "duplicate routing entry for Mode.idle"),
+			Entry("non-integer source", `pressure => { Mode.idle: hold }`, "cannot route on a value of type f64"),
+		)
+	})
+})
@@ -31,7 +31,7 @@ import (
 func isBool(t basetypes.Type) bool            { return t.IsBool() }
 func isNumeric(t basetypes.Type) bool         { return t.IsNumeric() }
 func isNumericOrString(t basetypes.Type) bool { return t.IsNumeric() || t.Kind == basetypes.KindString }
-func isAny(basetypes.Type) bool               { return true }
+func isComparable(t basetypes.Type) bool      { return !t.IsStruct() }
 
 // getSignedIntegerLiteral extracts a signed integer value from a node.
 // Supports both plain integer literals (2) and negated ones (-2).
@@ -251,7 +251,7 @@ func analyzeEquality(ctx context.Context[parser.IEqualityExpressionContext]) {
 		relExpressions,
 		getEqualityOperator,
 		types.InferRelational,
-		isAny,
+		isComparable,
 	)
 }
 
@@ -362,6 +362,10 @@ func analyzePostfix(ctx context.Context[parser.IPostfixExpressionContext]) {
 		}
 	}
 
+	if members := ctx.AST.AllMemberAccess(); len(members) > 0 {
+		analyzeMemberAccess(ctx, members)
+	}
+
 	funcCalls := ctx.AST.AllFunctionCallSuffix()
 
 	for _, funcCall := range funcCalls {
@@ -399,7 +403,7 @@ func analyzePostfix(ctx context.Context[parser.IPostfixExpressionContext]) {
 				return
 			}
 			if funcName != "len" && funcName != "series.len" {
-				validateFunctionCall(ctx, scope.Type, funcName, funcCalls[0])
+				validateFunctionCall(ctx, types.FreshenCall(scope.Type, ctx.AST), funcName, funcCalls[0])
 			}
 			if scope.AnalyzeCall != nil {
 				scope.AnalyzeCall(ctx.Diagnostics, funcCalls[0])
@@ -422,6 +426,29 @@ func analyzePostfix(ctx context.Context[parser.IPostfixExpressionContext]) {
 	}
 }
 
+// analyzeMemberAccess validates a chain of struct field accesses such as
+// `seg.start.x`, reporting the first access on a value without such a field.
+func analyzeMemberAccess(
+	ctx context.Context[parser.IPostfixExpressionContext],
+	members []parser.IMemberAccessContext,
+) {
+	t := types.InferPostfixOperand(ctx)
+	if !t.IsValid() {
+		return
+	}
+	for _, member := range members {
+		fieldName := member.IDENTIFIER().GetText()
+		field, _, ok := t.Field(fieldName)
+		if !ok {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				member, "%s has no field '%s'", t, fieldName,
+			))
+			return
+		}
+		t = field.Type
+	}
+}
+
 func validateFunctionCall(
 	ctx context.Context[parser.IPostfixExpressionContext],
 	funcType basetypes.Type,
@@ -504,6 +531,12 @@ func analyzePrimary(ctx context.Context[parser.IPrimaryExpressionContext]) {
 			ctx.Diagnostics.Add(diagnostics.Error(err, ctx.AST))
 			return
 		}
+		if resolved.Kind == symbol.KindEnum {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				ctx.AST, "%s is an enum type, not a value", resolved.Name,
+			))
+			return
+		}
 		// Track channel reads for:
 		// 1. Direct channel symbols (KindChannel)
 		// 2. Config params with channel type (they are the source)
@@ -538,6 +571,10 @@ func analyzePrimary(ctx context.Context[parser.IPrimaryExpressionContext]) {
 		Analyze(context.Child(ctx, expr))
 		return
 	}
+	if structLit := ctx.AST.StructLiteral(); structLit != nil {
+		analyzeStructLiteral(context.Child(ctx, structLit))
+		return
+	}
 	if typeCast := ctx.AST.TypeCast(); typeCast != nil {
 		if expr := typeCast.Expression(); expr != nil {
 			Analyze(context.Child(ctx, expr))
@@ -553,6 +590,63 @@ func analyzePrimary(ctx context.Context[parser.IPrimaryExpressionContext]) {
 	}
 }
 
+// analyzeStructLiteral validates a struct literal such as `Point{x: 1.0, y: 2.0}`.
+// Every named field must exist on the struct and be given at most once, and each
+// value must be compatible with its field's type. Omitted fields are zero-valued.
+func analyzeStructLiteral(ctx context.Context[parser.IStructLiteralContext]) {
+	name := ctx.AST.IDENTIFIER().GetText()
+	sym, err := ctx.Resolve(name)
+	if err != nil {
+		ctx.Diagnostics.Add(diagnostics.Error(err, ctx.AST))
+		return
+	}
+	if sym.Kind != symbol.KindStruct {
+		ctx.Diagnostics.Add(diagnostics.Errorf(ctx.AST, "%s is not a struct type", name))
+		return
+	}
+	seen := make(map[string]bool)
+	for _, fv := range ctx.AST.AllStructFieldValue() {
+		fieldName := fv.IDENTIFIER().GetText()
+		expr := fv.Expression()
+		field, _, ok := sym.Type.Field(fieldName)
+		if !ok {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				fv, "struct %s has no field '%s'", name, fieldName,
+			))
+			Analyze(context.Child(ctx, expr))
+			continue
+		}
+		if seen[fieldName] {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				fv, "field '%s' is given more than once in %s literal", fieldName, name,
+			))
+			continue
+		}
+		seen[fieldName] = true
+		exprCtx := context.Child(ctx, expr).WithTypeHint(field.Type)
+		Analyze(exprCtx)
+		valueType := types.InferFromExpression(exprCtx).UnwrapChan()
+		if !valueType.IsValid() || !field.Type.IsValid() {
+			continue
+		}
+		if valueType.Kind == basetypes.KindVariable {
+			if err := ctx.Constraints.AddCompatible(
+				valueType, field.Type, expr,
+				fmt.Sprintf("field '%s' of %s", fieldName, name),
+			); err != nil {
+				ctx.Diagnostics.Add(diagnostics.Error(err, expr))
+			}
+			continue
+		}
+		if !types.Compatible(valueType, field.Type) {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				expr, "cannot use %s as field '%s' of %s (type %s)",
+				valueType, fieldName, name, field.Type,
+			))
+		}
+	}
+}
+
 // buildArgChannels extracts channel argument mappings for chan-typed parameters at a
 // function call site. Maps are keyed by parameter index (position in the callee's
 // input list) so the mapping is valid even when the callee hasn't been fully analyzed
@@ -16,6 +16,7 @@ import (
 
 	"github.com/antlr4-go/antlr/v4"
 	"github.com/synnaxlabs/arc/analyzer/context"
+	"github.com/synnaxlabs/arc/analyzer/enum"
 	"github.com/synnaxlabs/arc/analyzer/expression"
 	atypes "github.com/synnaxlabs/arc/analyzer/types"
 	"github.com/synnaxlabs/arc/ir"
@@ -108,9 +109,10 @@ func parseFunction(ctx context.Context[parser.IFunctionContext], prevNode parser
 		return
 	}
 
-	// Dual-shape ExecBoth (see symbol.ExecBoth): upstream is a trigger, not
-	// a typed input.
-	upstreamIsTrigger := funcType.Exec == symbol.ExecBoth && len(freshType.Config) > 0
+	// Dual-shape ExecBoth (see symbol.ExecBoth): without data inputs, upstream
+	// is a trigger, not a typed input.
+	upstreamIsTrigger := funcType.Exec == symbol.ExecBoth && len(freshType.Config) > 0 &&
+		len(funcType.FlowInputs(freshType)) == 0
 
 	if prevIDNode := prevNode.Identifier(); prevIDNode != nil {
 		idName := prevIDNode.IDENTIFIER().GetText()
@@ -187,7 +189,7 @@ func parseFunction(ctx context.Context[parser.IFunctionContext], prevNode parser
 			}
 		}
 
-		if !upstreamIsTrigger && !hasRoutingTableBetween && len(freshType.Inputs) > 1 {
+		if !upstreamIsTrigger && !hasRoutingTableBetween && len(funcType.FlowInputs(freshType)) > 1 {
 			ctx.Diagnostics.Add(diagnostics.Errorf(ctx.AST, "%s has more than one parameter", name))
 			return
 		}
@@ -422,7 +424,9 @@ func analyzeRoutingTable(ctx context.Context[parser.IRoutingTableContext]) {
 		}
 	}
 
-	if len(nodesBefore) == 0 && len(nodesAfter) > 0 {
+	if parser.IsEnumRoutingTable(ctx.AST) {
+		analyzeEnumRoutingTable(ctx, nodesBefore, nodesAfter)
+	} else if len(nodesBefore) == 0 && len(nodesAfter) > 0 {
 		analyzeInputRoutingTable(ctx, nodesAfter)
 	} else if len(nodesBefore) > 0 {
 		analyzeOutputRoutingTable(ctx, nodesBefore, nodesAfter)
@@ -492,7 +496,7 @@ func analyzeOutputRoutingTable(
 
 	// Analyze each routing entry
 	for _, entry := range ctx.AST.AllRoutingEntry() {
-		outputName := entry.IDENTIFIER(0).GetText()
+		outputName, _ := parser.RoutingKeyParts(entry)
 
 		outputType, exists := fnType.Type.Outputs.Get(outputName)
 		if !exists {
@@ -506,8 +510,8 @@ func analyzeOutputRoutingTable(
 		}
 
 		var targetParamName string
-		if len(entry.AllIDENTIFIER()) > 1 {
-			targetParamName = entry.IDENTIFIER(1).GetText()
+		if param := entry.IDENTIFIER(); param != nil {
+			targetParamName = param.GetText()
 
 			if nextFunc == nil {
 				ctx.Diagnostics.Add(diagnostics.Errorf(
@@ -614,6 +618,101 @@ func analyzeInputRoutingTable(
 	}
 }
 
+// analyzeEnumRoutingTable validates a routing table keyed by enum members, such as
+// `mode -> { Mode.press: pressurize, Mode.fire: ignite }`. The value flowing into
+// the table must be an integer, and an entry is taken whenever the value equals its
+// member. Unlike a match statement, the table need not cover every member.
+func analyzeEnumRoutingTable(
+	ctx context.Context[parser.IRoutingTableContext],
+	nodesBefore []parser.IFlowNodeContext,
+	nodesAfter []parser.IFlowNodeContext,
+) {
+	if len(nodesBefore) == 0 {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			ctx.AST, "enum routing table must follow the value it routes on",
+		))
+		return
+	}
+	if len(nodesAfter) > 0 {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			ctx.AST, "enum routing table must end the flow statement",
+		))
+		return
+	}
+	source := nodesBefore[len(nodesBefore)-1]
+	sourceType := inferFlowNodeOutputType(context.Child(ctx, source)).Unwrap()
+	if !enum.Comparable(sourceType) {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			source,
+			"cannot route on a value of type %s: enum routing tables require an integer",
+			sourceType,
+		))
+		return
+	}
+	var (
+		e    *symbol.Symbol
+		seen = make(set.Set[*symbol.Symbol])
+	)
+	for _, entry := range ctx.AST.AllRoutingEntry() {
+		head, tail := parser.RoutingKeyParts(entry)
+		if tail == "" {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				entry.RoutingKey(),
+				"routing table key '%s' must be an enum member like the other keys",
+				head,
+			))
+			continue
+		}
+		member, err := ctx.ResolveQualified(head, tail)
+		if err != nil {
+			ctx.Diagnostics.Add(diagnostics.Error(err, entry.RoutingKey()))
+			continue
+		}
+		if member.Kind != symbol.KindEnumMember {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				entry.RoutingKey(), "%s.%s is not an enum member", head, tail,
+			))
+			continue
+		}
+		if e == nil {
+			e = member.Parent
+		} else if member.Parent != e {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				entry.RoutingKey(), "%s.%s is not a member of enum %s", head, tail, e.Name,
+			))
+			continue
+		}
+		if seen.Contains(member) {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				entry.RoutingKey(), "duplicate routing entry for %s.%s", head, tail,
+			))
+			continue
+		}
+		seen.Add(member)
+		if param := entry.IDENTIFIER(); param != nil {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				entry,
+				"parameter mapping '%s' requires a func after the routing table",
+				param.GetText(),
+			))
+			continue
+		}
+		nodeSourceType := types.U8()
+		flowNodes := entry.AllFlowNode()
+		for i, flowNode := range flowNodes {
+			analyzeRoutingTargetWithParam(
+				context.Child(ctx, flowNode),
+				nodeSourceType,
+				types.Type{},
+				nil,
+			)
+			if i < len(flowNodes)-1 {
+				nodeSourceType = inferFlowNodeOutputType(context.Child(ctx, flowNode))
+			}
+		}
+	}
+}
+
 func analyzeRoutingTargetWithParam(
 	ctx context.Context[parser.IFlowNodeContext],
 	sourceType types.Type,
@@ -661,7 +760,8 @@ func analyzeRoutingTargetWithParam(
 			}
 		} else {
 			//  A select branch into such an ExecBoth node is a trigger, not a typed input.
-			upstreamIsTrigger := fnType.Exec == symbol.ExecBoth && len(fnType.Type.Config) > 0
+			upstreamIsTrigger := fnType.Exec == symbol.ExecBoth && len(fnType.Type.Config) > 0 &&
+				len(fnType.FlowInputs(fnType.Type)) == 0
 			if !upstreamIsTrigger && len(fnType.Type.Inputs) > 0 {
 				param := fnType.Type.Inputs[0]
 				if err := atypes.Check(ctx.Constraints, sourceType, param.Type, ctx.AST,
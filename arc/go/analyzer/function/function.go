@@ -84,7 +84,7 @@ func collectConfig[T antlr.ParserRuleContext](
 		configName := cfg.IDENTIFIER().GetText()
 		var configType types.Type
 		if typeCtx := cfg.Type_(); typeCtx != nil {
-			configType, _ = atypes.InferFromTypeContext(typeCtx)
+			configType = resolveType(ctx, typeCtx)
 		}
 
 		var defaultValue any
@@ -114,6 +114,19 @@ func collectConfig[T antlr.ParserRuleContext](
 	}
 }
 
+// resolveType resolves a parameter type annotation, reporting struct type names that
+// do not resolve to a declared struct.
+func resolveType[T antlr.ParserRuleContext](
+	ctx acontext.Context[T],
+	typeCtx parser.ITypeContext,
+) types.Type {
+	t, err := atypes.ResolveType(ctx, typeCtx)
+	if err != nil && typeCtx.StructType() != nil {
+		ctx.Diagnostics.Add(diagnostics.Error(err, typeCtx))
+	}
+	return t
+}
+
 // collectInputs extracts input parameter types without adding them to scope.
 func collectInputs(
 	ctx acontext.Context[parser.IInputListContext],
@@ -126,7 +139,7 @@ func collectInputs(
 	for _, input := range ctx.AST.AllInput() {
 		var inputType types.Type
 		if typeCtx := input.Type_(); typeCtx != nil {
-			inputType, _ = atypes.InferFromTypeContext(typeCtx)
+			inputType = resolveType(ctx, typeCtx)
 		}
 		inputName := input.IDENTIFIER().GetText()
 
@@ -170,14 +183,14 @@ func collectOutputs[T antlr.ParserRuleContext](
 	// Case 1: Single named output without parens (e.g., "result f64")
 	if identifier := outputType.IDENTIFIER(); identifier != nil && outputType.Type_() != nil {
 		outputName := identifier.GetText()
-		outputTypeVal, _ := atypes.InferFromTypeContext(outputType.Type_())
+		outputTypeVal := resolveType(ctx, outputType.Type_())
 		*outputs = append(*outputs, types.Param{Name: outputName, Type: outputTypeVal})
 		return
 	}
 
 	// Case 2: Unnamed single output (e.g., "f64")
 	if typeCtx := outputType.Type_(); typeCtx != nil {
-		outputTypeVal, _ := atypes.InferFromTypeContext(typeCtx)
+		outputTypeVal := resolveType(ctx, typeCtx)
 		*outputs = append(*outputs, types.Param{Name: ir.DefaultOutputParam, Type: outputTypeVal})
 		return
 	}
@@ -188,7 +201,7 @@ func collectOutputs[T antlr.ParserRuleContext](
 			outputName := namedOutput.IDENTIFIER().GetText()
 			var outputTypeVal types.Type
 			if typeCtx := namedOutput.Type_(); typeCtx != nil {
-				outputTypeVal, _ = atypes.InferFromTypeContext(typeCtx)
+				outputTypeVal = resolveType(ctx, typeCtx)
 			}
 			*outputs = append(*outputs, types.Param{Name: outputName, Type: outputTypeVal})
 		}
@@ -249,7 +262,7 @@ func addOutputsToScope[T antlr.ParserRuleContext](
 	// Case 1: Single named output without parens (e.g., "result f64")
 	if identifier := outputType.IDENTIFIER(); identifier != nil && outputType.Type_() != nil {
 		outputName := identifier.GetText()
-		outputTypeVal, _ := atypes.InferFromTypeContext(outputType.Type_())
+		outputTypeVal, _ := atypes.ResolveType(ctx, outputType.Type_())
 		if _, err := scope.Add(ctx, symbol.Symbol{
 			Name: outputName,
 			Kind: symbol.KindOutput,
@@ -272,7 +285,7 @@ func addOutputsToScope[T antlr.ParserRuleContext](
 			outputName := namedOutput.IDENTIFIER().GetText()
 			var outputTypeVal types.Type
 			if typeCtx := namedOutput.Type_(); typeCtx != nil {
-				outputTypeVal, _ = atypes.InferFromTypeContext(typeCtx)
+				outputTypeVal, _ = atypes.ResolveType(ctx, typeCtx)
 			}
 			if _, err := scope.Add(ctx, symbol.Symbol{
 				Name: outputName,
@@ -299,6 +312,22 @@ func checkOutputAssignedInBlock(block parser.IBlockContext, outputName string) b
 			checkOutputAssignedInIfStmt(ifStmt, outputName) {
 			return true
 		}
+		if match := stmt.MatchStatement(); match != nil &&
+			checkOutputAssignedInMatchStmt(match, outputName) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkOutputAssignedInMatchStmt(match parser.IMatchStatementContext, outputName string) bool {
+	for _, arm := range match.AllMatchArm() {
+		if checkOutputAssignedInBlock(arm.Block(), outputName) {
+			return true
+		}
+	}
+	if elseArm := match.MatchElse(); elseArm != nil {
+		return checkOutputAssignedInBlock(elseArm.Block(), outputName)
 	}
 	return false
 }
@@ -330,7 +359,7 @@ func addInputsToScope(
 	for _, input := range ctx.AST.AllInput() {
 		var inputType types.Type
 		if typeCtx := input.Type_(); typeCtx != nil {
-			inputType, _ = atypes.InferFromTypeContext(typeCtx)
+			inputType, _ = atypes.ResolveType(ctx, typeCtx)
 		}
 		inputName := input.IDENTIFIER().GetText()
 
@@ -371,6 +400,9 @@ func BlockAlwaysReturns(block parser.IBlockContext) bool {
 		if ifStmt := statements[i].IfStatement(); ifStmt != nil && IfStmtAlwaysReturns(ifStmt) {
 			return true
 		}
+		if match := statements[i].MatchStatement(); match != nil && MatchStmtAlwaysReturns(match) {
+			return true
+		}
 	}
 	return false
 }
@@ -387,6 +419,21 @@ func IfStmtAlwaysReturns(ifStmt parser.IIfStatementContext) bool {
 	return BlockAlwaysReturns(ifStmt.ElseClause().Block())
 }
 
+// MatchStmtAlwaysReturns returns true if the match statement has an else arm and every
+// arm returns. An exhaustive match over an enum without an else arm does not count, as
+// the matched value may hold an integer outside the enum.
+func MatchStmtAlwaysReturns(match parser.IMatchStatementContext) bool {
+	if match.MatchElse() == nil || !BlockAlwaysReturns(match.MatchElse().Block()) {
+		return false
+	}
+	for _, arm := range match.AllMatchArm() {
+		if !BlockAlwaysReturns(arm.Block()) {
+			return false
+		}
+	}
+	return true
+}
+
 // addConfigToScope adds config parameters to the function's scope.
 // The config types are already collected in fn.Type.Config by CollectDeclarations.
 func addConfigToScope[T antlr.ParserRuleContext](
@@ -401,7 +448,7 @@ func addConfigToScope[T antlr.ParserRuleContext](
 		configName := cfg.IDENTIFIER().GetText()
 		var configType types.Type
 		if typeCtx := cfg.Type_(); typeCtx != nil {
-			configType, _ = atypes.InferFromTypeContext(typeCtx)
+			configType, _ = atypes.ResolveType(ctx, typeCtx)
 		}
 
 		var defaultValue any
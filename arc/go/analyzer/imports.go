@@ -10,6 +10,11 @@
 package analyzer
 
 import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/antlr4-go/antlr/v4"
 	"github.com/synnaxlabs/arc/analyzer/codes"
 	acontext "github.com/synnaxlabs/arc/analyzer/context"
 	"github.com/synnaxlabs/arc/parser"
@@ -41,7 +46,9 @@ func checkImportOrder(ctx acontext.Context[parser.IProgramContext]) {
 // each `import` statement. The alias's Target points at the underlying
 // module symbol (looked up in the ambient prelude). Subsequent dotted
 // lookups (`t.now`) resolve through the alias by ordinary scope walk and
-// member descent — no separate import gate is needed.
+// member descent — no separate import gate is needed. Imported libraries
+// are analyzed before their alias is installed so that their members are
+// declared by the time the rest of the program is analyzed.
 //
 // Diagnoses duplicate aliases and unknown module paths.
 func collectImports(ctx acontext.Context[parser.IProgramContext]) {
@@ -57,6 +64,9 @@ func collectImports(ctx acontext.Context[parser.IProgramContext]) {
 			))
 			continue
 		}
+		if module.IsLibrary() {
+			importLibrary(ctx, entry.AST, module)
+		}
 		alias := symbol.Symbol{
 			Name:   entry.Alias,
 			Kind:   symbol.KindModuleAlias,
@@ -73,6 +83,90 @@ func collectImports(ctx acontext.Context[parser.IProgramContext]) {
 	}
 }
 
+// importLibrary analyzes the source of a library module the first time it is
+// imported, declaring its functions and constants as members of the module.
+// The library is analyzed in its own context so that diagnostics positioned in
+// its source are not attributed to the importing document. Instead, any errors
+// are reported as a single diagnostic on the import, with a note for each.
+func importLibrary(
+	ctx acontext.Context[parser.IProgramContext],
+	site antlr.ParserRuleContext,
+	lib *symbol.Symbol,
+) {
+	analyzed, seen := ctx.Libraries[lib]
+	if analyzed {
+		return
+	}
+	if seen {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			site,
+			"import cycle not allowed: library %q imports itself",
+			lib.Name,
+		))
+		return
+	}
+	ast, ok := lib.AST.(parser.IProgramContext)
+	if !ok {
+		ctx.Diagnostics.Add(diagnostics.Errorf(site, "library %q has no source", lib.Name))
+		return
+	}
+	ctx.Libraries[lib] = false
+	libCtx := acontext.NewRoot(ctx.Context, ast, lib).WithConfig(ctx.Config)
+	libCtx.Libraries = ctx.Libraries
+	analyzeLibrary(libCtx)
+	maps.Copy(ctx.TypeMap, libCtx.TypeMap)
+	ctx.Libraries[lib] = true
+	errs := libCtx.Diagnostics.Errors()
+	if len(errs) == 0 {
+		return
+	}
+	d := diagnostics.Errorf(site, "library %q has errors", lib.Name)
+	for _, e := range errs {
+		d = d.WithNote(fmt.Sprintf("%d:%d %s", e.Start.Line, e.Start.Col, e.Message))
+		for _, n := range e.Notes {
+			d = d.WithNote(n.Message)
+		}
+	}
+	ctx.Diagnostics.Add(d)
+}
+
+// AnalyzeLibrary analyzes lib outside of any importing program, populating its
+// functions and constants as members of the module. lib must be attached to a
+// root so that its body can resolve the standard library. Tooling uses this to
+// inspect libraries that no analyzed program has imported yet.
+func AnalyzeLibrary(
+	ctx context.Context,
+	lib *symbol.Symbol,
+	cfg parser.Config,
+) *diagnostics.Diagnostics {
+	ast, ok := lib.AST.(parser.IProgramContext)
+	if !ok {
+		return nil
+	}
+	libCtx := acontext.NewRoot(ctx, ast, lib).WithConfig(cfg)
+	libCtx.Libraries[lib] = false
+	analyzeLibrary(libCtx)
+	libCtx.Libraries[lib] = true
+	return libCtx.Diagnostics
+}
+
+// analyzeLibrary analyzes the source of a library as a program whose root is
+// the library module. Libraries can only import modules and declare functions
+// and global constants, all of which become members of the module.
+func analyzeLibrary(ctx acontext.Context[parser.IProgramContext]) {
+	for _, item := range ctx.AST.AllTopLevelItem() {
+		if item.ImportStatement() == nil &&
+			item.FunctionDeclaration() == nil &&
+			item.GlobalConstant() == nil {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				item,
+				"libraries can only contain imports, functions, and constants",
+			))
+		}
+	}
+	AnalyzeProgram(ctx)
+}
+
 // reportUnusedImports flags every alias child of the program root that was
 // never consulted by resolution.
 func reportUnusedImports(ctx acontext.Context[parser.IProgramContext]) {
@@ -0,0 +1,413 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package safety provides semantic analysis for Arc safety declarations.
+package safety
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/samber/lo"
+	acontext "github.com/synnaxlabs/arc/analyzer/context"
+	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/literal"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/diagnostics"
+	"github.com/synnaxlabs/x/set"
+)
+
+const (
+	configMin       = "min"
+	configMax       = "max"
+	configMaxRate   = "max_rate"
+	configInterlock = "interlock"
+	configSafeValue = "safe_value"
+	configSafeStage = "safe_stage"
+)
+
+// flipped maps each comparison operator to the operator that holds when its operands
+// are swapped, so that interlocks always read as channel <op> value.
+var flipped = map[string]string{
+	"<": ">", "<=": ">=", ">": "<", ">=": "<=", "==": "==", "!=": "!=",
+}
+
+// Analyze validates safety blocks in a program and returns the safety envelopes they
+// declare. It checks:
+//  1. Guarded channel identifiers exist and refer to channels
+//  2. No channel has more than one envelope
+//  3. Config values are named, known, and given at most once
+//  4. min, max, max_rate, and safe_value are numeric constants, with min <= max,
+//     max_rate > 0, and the safe_value of interlocked channels within min and max
+//  5. Interlocks compare channels to numeric constants, joined with or
+//  6. safe_stage refers to a stage of a top-level sequence
+func Analyze(ctx acontext.Context[parser.IProgramContext]) ir.Safety {
+	var (
+		safety       ir.Safety
+		seenChannels = make(set.Set[string])
+	)
+	for _, item := range ctx.AST.AllTopLevelItem() {
+		block := item.SafetyBlock()
+		if block == nil {
+			continue
+		}
+		for _, entry := range block.AllSafetyEntry() {
+			if env, ok := analyzeEntry(ctx, entry, seenChannels); ok {
+				safety = append(safety, env)
+			}
+		}
+	}
+	return safety
+}
+
+func analyzeEntry(
+	ctx acontext.Context[parser.IProgramContext],
+	entry parser.ISafetyEntryContext,
+	seenChannels set.Set[string],
+) (ir.Envelope, bool) {
+	name := entry.IDENTIFIER().GetText()
+	if seenChannels.Contains(name) {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			entry,
+			"duplicate safety envelope for '%s'",
+			name,
+		))
+		return ir.Envelope{}, false
+	}
+	sym, ok := resolveChannel(ctx, entry, name)
+	if !ok {
+		return ir.Envelope{}, false
+	}
+	seenChannels.Add(name)
+	env := ir.Envelope{Channel: uint32(sym.ID)}
+	cfg := entry.ConfigValues()
+	if cfg.AnonymousConfigValues() != nil {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			cfg,
+			"safety config values must be named, e.g. %s{min=0, max=100}",
+			name,
+		))
+		return ir.Envelope{}, false
+	}
+	named := cfg.NamedConfigValues()
+	if named == nil {
+		return env, true
+	}
+	var (
+		valid     = true
+		seenNames = make(set.Set[string])
+	)
+	for _, v := range named.AllNamedConfigValue() {
+		key := v.IDENTIFIER().GetText()
+		if seenNames.Contains(key) {
+			ctx.Diagnostics.Add(diagnostics.Errorf(v, "duplicate safety config '%s'", key))
+			valid = false
+			continue
+		}
+		seenNames.Add(key)
+		expr := v.Expression()
+		switch key {
+		case configMin, configMax, configMaxRate, configSafeValue:
+			value, ok := constant(expr.LogicalOrExpression())
+			if !ok {
+				ctx.Diagnostics.Add(diagnostics.Errorf(
+					v,
+					"safety config '%s' must be a numeric constant",
+					key,
+				))
+				valid = false
+				continue
+			}
+			switch key {
+			case configMin:
+				env.Min = &value
+			case configMax:
+				env.Max = &value
+			case configMaxRate:
+				env.MaxRate = &value
+			case configSafeValue:
+				env.SafeValue = &value
+			}
+		case configInterlock:
+			interlocks, ok := analyzeInterlock(ctx, expr)
+			if !ok {
+				valid = false
+				continue
+			}
+			env.Interlocks = interlocks
+		case configSafeStage:
+			seq, stage, ok := analyzeSafeStage(ctx, expr)
+			if !ok {
+				valid = false
+				continue
+			}
+			env.SafeSequence, env.SafeStage = seq, stage
+		default:
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				v,
+				"unknown safety config '%s'; expected one of min, max, max_rate, "+
+					"interlock, safe_value, or safe_stage",
+				key,
+			))
+			valid = false
+		}
+	}
+	if !valid {
+		return ir.Envelope{}, false
+	}
+	return env, validateBounds(ctx, entry, env)
+}
+
+func validateBounds(
+	ctx acontext.Context[parser.IProgramContext],
+	entry parser.ISafetyEntryContext,
+	env ir.Envelope,
+) bool {
+	if env.Min != nil && env.Max != nil && *env.Min > *env.Max {
+		ctx.Diagnostics.Add(diagnostics.Errorf(entry, "safety min must not exceed max"))
+		return false
+	}
+	if env.MaxRate != nil && *env.MaxRate <= 0 {
+		ctx.Diagnostics.Add(diagnostics.Errorf(entry, "safety max_rate must be positive"))
+		return false
+	}
+	safe := lo.FromPtr(env.SafeValue)
+	if len(env.Interlocks) > 0 &&
+		((env.Min != nil && safe < *env.Min) || (env.Max != nil && safe > *env.Max)) {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			entry,
+			"safety safe_value must be within min and max",
+		))
+		return false
+	}
+	return true
+}
+
+func resolveChannel(
+	ctx acontext.Context[parser.IProgramContext],
+	node antlr.ParserRuleContext,
+	name string,
+) (*symbol.Symbol, bool) {
+	sym, err := ctx.Resolve(name)
+	if err != nil {
+		ctx.Diagnostics.Add(diagnostics.Errorf(node, "channel '%s' not found", name))
+		return nil, false
+	}
+	if sym.Kind != symbol.KindChannel {
+		ctx.Diagnostics.Add(diagnostics.Errorf(node, "'%s' is not a channel", name))
+		return nil, false
+	}
+	return sym, true
+}
+
+// analyzeInterlock parses an interlock expression of the form
+// `a > 1 or b == 0 or ...`, where each condition compares a channel to a numeric
+// constant.
+func analyzeInterlock(
+	ctx acontext.Context[parser.IProgramContext],
+	expr parser.IExpressionContext,
+) ([]ir.Interlock, bool) {
+	var interlocks []ir.Interlock
+	for _, and := range expr.LogicalOrExpression().AllLogicalAndExpression() {
+		eqs := and.AllEqualityExpression()
+		if len(eqs) != 1 {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				and,
+				"interlock conditions must be joined with or",
+			))
+			return nil, false
+		}
+		var (
+			name                string
+			value               float64
+			left, right, op, ok = comparison(eqs[0])
+		)
+		if ok {
+			if name, value, ok = channelAndConstant(left, right); !ok {
+				name, value, ok = channelAndConstant(right, left)
+				op = flipped[op]
+			}
+		}
+		if !ok {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				eqs[0],
+				"interlock condition must compare a channel to a numeric constant, "+
+					"e.g. pressure > 500",
+			))
+			return nil, false
+		}
+		sym, ok := resolveChannel(ctx, eqs[0], name)
+		if !ok {
+			return nil, false
+		}
+		interlocks = append(interlocks, ir.Interlock{
+			Channel:    uint32(sym.ID),
+			Comparison: op,
+			Value:      value,
+		})
+	}
+	return interlocks, true
+}
+
+// comparison splits a single equality or relational comparison into its operands
+// and operator.
+func comparison(
+	eq parser.IEqualityExpressionContext,
+) (left, right antlr.ParserRuleContext, op string, ok bool) {
+	rels := eq.AllRelationalExpression()
+	if len(rels) == 2 {
+		return rels[0], rels[1], eq.GetChild(1).(antlr.TerminalNode).GetText(), true
+	}
+	if len(rels) != 1 {
+		return nil, nil, "", false
+	}
+	adds := rels[0].AllAdditiveExpression()
+	if len(adds) != 2 {
+		return nil, nil, "", false
+	}
+	return adds[0], adds[1], rels[0].GetChild(1).(antlr.TerminalNode).GetText(), true
+}
+
+func channelAndConstant(
+	channel, value antlr.ParserRuleContext,
+) (string, float64, bool) {
+	p := primary(channel)
+	if p == nil || p.IDENTIFIER() == nil {
+		return "", 0, false
+	}
+	v, ok := constant(value)
+	return p.IDENTIFIER().GetText(), v, ok
+}
+
+// analyzeSafeStage parses a safe_stage value of the form `sequence.stage`.
+func analyzeSafeStage(
+	ctx acontext.Context[parser.IProgramContext],
+	expr parser.IExpressionContext,
+) (string, string, bool) {
+	p := primary(expr.LogicalOrExpression())
+	if p == nil || p.QualifiedIdentifier() == nil {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			expr,
+			"safe_stage must name a stage of a sequence, e.g. main.abort",
+		))
+		return "", "", false
+	}
+	seqName, stageName := parser.QualifiedNameParts(p.QualifiedIdentifier())
+	seq, err := ctx.Resolve(seqName)
+	if err != nil || seq.Kind != symbol.KindSequence || !isTopLevel(seq) {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			expr,
+			"'%s' is not a top-level sequence",
+			seqName,
+		))
+		return "", "", false
+	}
+	stage := seq.FindChild(stageName)
+	if stage == nil || stage.Kind != symbol.KindStage {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			expr,
+			"sequence '%s' has no stage '%s'",
+			seqName,
+			stageName,
+		))
+		return "", "", false
+	}
+	return seqName, stageName, true
+}
+
+// isTopLevel reports whether sym is declared directly under the program root, whose
+// parent is either nil or the ambient STL prelude.
+func isTopLevel(sym *symbol.Symbol) bool {
+	parent := sym.Parent
+	return parent != nil && (parent.Parent == nil || parent.Parent.Kind == symbol.KindAmbient)
+}
+
+// unary returns the unary expression of an operand without binary operators, or nil
+// if the operand contains any.
+func unary(node antlr.ParserRuleContext) parser.IUnaryExpressionContext {
+	switch ctx := node.(type) {
+	case parser.ILogicalOrExpressionContext:
+		if ands := ctx.AllLogicalAndExpression(); len(ands) == 1 {
+			return unary(ands[0])
+		}
+	case parser.ILogicalAndExpressionContext:
+		if eqs := ctx.AllEqualityExpression(); len(eqs) == 1 {
+			return unary(eqs[0])
+		}
+	case parser.IEqualityExpressionContext:
+		if rels := ctx.AllRelationalExpression(); len(rels) == 1 {
+			return unary(rels[0])
+		}
+	case parser.IRelationalExpressionContext:
+		if adds := ctx.AllAdditiveExpression(); len(adds) == 1 {
+			return unary(adds[0])
+		}
+	case parser.IAdditiveExpressionContext:
+		if muls := ctx.AllMultiplicativeExpression(); len(muls) == 1 {
+			return unary(muls[0])
+		}
+	case parser.IMultiplicativeExpressionContext:
+		if pows := ctx.AllPowerExpression(); len(pows) == 1 {
+			return unary(pows[0])
+		}
+	case parser.IPowerExpressionContext:
+		if ctx.CARET() == nil {
+			return ctx.UnaryExpression()
+		}
+	}
+	return nil
+}
+
+// primary returns the primary expression of an operand without operators, or nil if
+// the operand contains any.
+func primary(node antlr.ParserRuleContext) parser.IPrimaryExpressionContext {
+	u := unary(node)
+	if u == nil {
+		return nil
+	}
+	return unaryPrimary(u)
+}
+
+func unaryPrimary(u parser.IUnaryExpressionContext) parser.IPrimaryExpressionContext {
+	postfix := u.PostfixExpression()
+	if postfix == nil ||
+		len(postfix.AllIndexOrSlice()) > 0 ||
+		len(postfix.AllFunctionCallSuffix()) > 0 ||
+		len(postfix.AllMemberAccess()) > 0 {
+		return nil
+	}
+	return postfix.PrimaryExpression()
+}
+
+// constant returns the value of an operand that is a numeric literal, optionally
+// negated.
+func constant(node antlr.ParserRuleContext) (float64, bool) {
+	u := unary(node)
+	if u == nil {
+		return 0, false
+	}
+	return unaryConstant(u)
+}
+
+func unaryConstant(u parser.IUnaryExpressionContext) (float64, bool) {
+	if u.MINUS() != nil {
+		v, ok := unaryConstant(u.UnaryExpression())
+		return -v, ok
+	}
+	p := unaryPrimary(u)
+	if p == nil || p.Literal() == nil || p.Literal().NumericLiteral() == nil {
+		return 0, false
+	}
+	parsed, err := literal.ParseNumeric(p.Literal().NumericLiteral(), types.F64())
+	if err != nil {
+		return 0, false
+	}
+	v, ok := parsed.Value.(float64)
+	return v, ok
+}
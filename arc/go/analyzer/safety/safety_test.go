@@ -0,0 +1,222 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package safety_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/analyzer"
+	acontext "github.com/synnaxlabs/arc/analyzer/context"
+	"github.com/synnaxlabs/arc/analyzer/safety"
+	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	. "github.com/synnaxlabs/arc/symbol/testutil"
+	"github.com/synnaxlabs/arc/types"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Safety Analyzer", func() {
+	var (
+		channels = []symbol.Symbol{
+			{Name: "valve", Kind: symbol.KindChannel, Type: types.Chan(types.F64()), ID: 100},
+			{Name: "vent", Kind: symbol.KindChannel, Type: types.Chan(types.F64()), ID: 200},
+			{Name: "pressure", Kind: symbol.KindChannel, Type: types.Chan(types.F64()), ID: 300},
+			{Name: "temp", Kind: symbol.KindChannel, Type: types.Chan(types.F64()), ID: 400},
+			{Name: "limit", Kind: symbol.KindGlobalConstant, Type: types.F64()},
+		}
+		root *symbol.Symbol
+	)
+	BeforeEach(func() {
+		root = NewRoot(nil, channels...)
+	})
+	analyze := func(specCtx SpecContext, source string) (ir.Safety, acontext.Context[parser.IProgramContext]) {
+		prog := MustSucceed(parser.Parse(source))
+		ctx := acontext.NewRoot(specCtx, prog, root)
+		return safety.Analyze(ctx), ctx
+	}
+
+	Describe("Envelopes", func() {
+		It("Should parse bounds and a maximum rate of change", func(specCtx SpecContext) {
+			s, ctx := analyze(specCtx, `safety valve{min=0, max=100, max_rate=20}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeTrue(), ctx.Diagnostics.String())
+			Expect(s).To(HaveLen(1))
+			Expect(s[0].Channel).To(Equal(uint32(100)))
+			Expect(*s[0].Min).To(Equal(0.0))
+			Expect(*s[0].Max).To(Equal(100.0))
+			Expect(*s[0].MaxRate).To(Equal(20.0))
+			Expect(s[0].Interlocks).To(BeEmpty())
+		})
+
+		It("Should accept negative and floating point constants", func(specCtx SpecContext) {
+			s, ctx := analyze(specCtx, `safety valve{min=-2.5, max=-1}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeTrue(), ctx.Diagnostics.String())
+			Expect(*s[0].Min).To(Equal(-2.5))
+			Expect(*s[0].Max).To(Equal(-1.0))
+			Expect(s[0].SafeValue).To(BeNil())
+		})
+
+		It("Should leave omitted bounds unset", func(specCtx SpecContext) {
+			s, ctx := analyze(specCtx, `safety valve{max=10}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeTrue(), ctx.Diagnostics.String())
+			Expect(s[0].Min).To(BeNil())
+			Expect(s[0].MaxRate).To(BeNil())
+		})
+
+		It("Should parse grouped envelopes", func(specCtx SpecContext) {
+			s, ctx := analyze(specCtx, "safety (\n    valve{max=10}\n    vent{min=1}\n)")
+			Expect(ctx.Diagnostics.Ok()).To(BeTrue(), ctx.Diagnostics.String())
+			Expect(s).To(HaveLen(2))
+			Expect(s[0].Channel).To(Equal(uint32(100)))
+			Expect(s[1].Channel).To(Equal(uint32(200)))
+		})
+	})
+
+	Describe("Interlocks", func() {
+		It("Should parse conditions joined with or", func(specCtx SpecContext) {
+			s, ctx := analyze(specCtx, `safety valve{interlock=pressure > 500 or temp >= 80.5, safe_value=0}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeTrue(), ctx.Diagnostics.String())
+			Expect(s[0].Interlocks).To(Equal([]ir.Interlock{
+				{Channel: 300, Comparison: ">", Value: 500},
+				{Channel: 400, Comparison: ">=", Value: 80.5},
+			}))
+			Expect(*s[0].SafeValue).To(Equal(0.0))
+		})
+
+		It("Should parse equality conditions", func(specCtx SpecContext) {
+			s, ctx := analyze(specCtx, `safety valve{interlock=pressure == 1 or temp != 0}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeTrue(), ctx.Diagnostics.String())
+			Expect(s[0].Interlocks).To(Equal([]ir.Interlock{
+				{Channel: 300, Comparison: "==", Value: 1},
+				{Channel: 400, Comparison: "!=", Value: 0},
+			}))
+		})
+
+		It("Should flip conditions with the constant on the left", func(specCtx SpecContext) {
+			s, ctx := analyze(specCtx, `safety valve{interlock=500 < pressure}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeTrue(), ctx.Diagnostics.String())
+			Expect(s[0].Interlocks).To(Equal([]ir.Interlock{
+				{Channel: 300, Comparison: ">", Value: 500},
+			}))
+		})
+
+		It("Should reject conditions joined with and", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{interlock=pressure > 500 and temp > 80}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("joined with or"))
+		})
+
+		It("Should reject conditions comparing two channels", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{interlock=pressure > temp}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("numeric constant"))
+		})
+
+		It("Should reject conditions on identifiers that are not channels", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{interlock=limit > 5}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("not a channel"))
+		})
+	})
+
+	Describe("Safe Stage", func() {
+		It("Should resolve a stage of a top-level sequence", func(specCtx SpecContext) {
+			prog := MustSucceed(parser.Parse(`
+				safety valve{max=10, safe_stage=main.abort}
+				sequence main {
+					stage run {}
+					stage abort {}
+				}
+			`))
+			ctx := acontext.NewRoot(specCtx, prog, root)
+			analyzer.AnalyzeProgram(ctx)
+			s := safety.Analyze(ctx)
+			Expect(ctx.Diagnostics.Ok()).To(BeTrue(), ctx.Diagnostics.String())
+			Expect(s[0].SafeSequence).To(Equal("main"))
+			Expect(s[0].SafeStage).To(Equal("abort"))
+		})
+
+		It("Should reject a missing stage", func(specCtx SpecContext) {
+			prog := MustSucceed(parser.Parse(`
+				safety valve{safe_stage=main.missing}
+				sequence main {
+					stage run {}
+				}
+			`))
+			ctx := acontext.NewRoot(specCtx, prog, root)
+			analyzer.AnalyzeProgram(ctx)
+			safety.Analyze(ctx)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("has no stage 'missing'"))
+		})
+
+		It("Should reject a safe stage that is not in a sequence", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{safe_stage=vent.abort}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("not a top-level sequence"))
+		})
+	})
+
+	Describe("Validation", func() {
+		It("Should reject a non-existent channel", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety nonexistent{max=1}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("not found"))
+		})
+
+		It("Should reject duplicate envelopes for a channel", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, "safety valve{max=1}\nsafety valve{min=0}")
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("duplicate safety envelope"))
+		})
+
+		It("Should reject duplicate config values", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{max=1, max=2}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("duplicate safety config"))
+		})
+
+		It("Should reject unknown config values", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{ceiling=1}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("unknown safety config"))
+		})
+
+		It("Should reject anonymous config values", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{0, 100}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("must be named"))
+		})
+
+		It("Should reject bounds that are not constants", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{max=pressure + 1}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("numeric constant"))
+		})
+
+		It("Should reject a minimum above the maximum", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{min=10, max=1}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("min must not exceed max"))
+		})
+
+		It("Should reject a non-positive maximum rate", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{max_rate=0}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("max_rate must be positive"))
+		})
+
+		It("Should reject a safe value outside the bounds", func(specCtx SpecContext) {
+			_, ctx := analyze(specCtx, `safety valve{min=1, max=10, interlock=pressure > 5}`)
+			Expect(ctx.Diagnostics.Ok()).To(BeFalse())
+			Expect(ctx.Diagnostics.String()).To(ContainSubstring("within min and max"))
+		})
+	})
+})
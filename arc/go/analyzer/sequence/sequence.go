@@ -135,6 +135,12 @@ func AnalyzeSynthInlines(ctx context.Context[parser.IProgramContext]) {
 	var walk func(parent *symbol.Symbol)
 	walk = func(parent *symbol.Symbol) {
 		for _, child := range parent.Children() {
+			// Aliases delegate to the children of the module they import, which
+			// never contain inline sequences and, for libraries, may import
+			// each other.
+			if child.Kind == symbol.KindModuleAlias {
+				continue
+			}
 			if strings.HasPrefix(child.Name, ir.InlinePrefix) {
 				switch decl := child.AST.(type) {
 				case parser.IStageDeclarationContext:
@@ -12,12 +12,16 @@
 package statement
 
 import (
+	"strings"
+
 	"github.com/antlr4-go/antlr/v4"
 	"github.com/synnaxlabs/arc/analyzer/context"
+	"github.com/synnaxlabs/arc/analyzer/enum"
 	"github.com/synnaxlabs/arc/analyzer/expression"
 	atypes "github.com/synnaxlabs/arc/analyzer/types"
 	"github.com/synnaxlabs/arc/analyzer/units"
 	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/literal"
 	"github.com/synnaxlabs/arc/parser"
 	"github.com/synnaxlabs/arc/symbol"
 	"github.com/synnaxlabs/arc/types"
@@ -50,6 +54,8 @@ func Analyze(ctx context.Context[parser.IStatementContext]) {
 		analyzeIfStatement(context.Child(ctx, ctx.AST.IfStatement()))
 	case ctx.AST.ForStatement() != nil:
 		analyzeForStatement(context.Child(ctx, ctx.AST.ForStatement()))
+	case ctx.AST.MatchStatement() != nil:
+		analyzeMatchStatement(context.Child(ctx, ctx.AST.MatchStatement()))
 	case ctx.AST.BreakStatement() != nil:
 		analyzeBreakStatement(context.Child(ctx, ctx.AST.BreakStatement()))
 	case ctx.AST.ContinueStatement() != nil:
@@ -80,7 +86,7 @@ func analyzeVariableDeclarationType[ASTNode antlr.ParserRuleContext](
 	typeCtx parser.ITypeContext,
 ) types.Type {
 	if typeCtx != nil {
-		varType, err := atypes.InferFromTypeContext(typeCtx)
+		varType, err := atypes.ResolveType(ctx, typeCtx)
 		if err != nil {
 			ctx.Diagnostics.Add(diagnostics.Error(err, ctx.AST))
 			return types.Type{}
@@ -246,6 +252,12 @@ func analyzeStatefulVariable(ctx context.Context[parser.IStatefulVariableContext
 		})
 		return
 	}
+	if varType.IsStruct() {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			ctx.AST, "stateful variable '%s' cannot have struct type %s", name, varType,
+		))
+		return
+	}
 	// Stateful variables store VALUES, not channel references.
 	// If initialized from a channel, unwrap to get the value type.
 	if varType.Kind == types.KindChan {
@@ -268,7 +280,7 @@ func analyzeStatefulVariable(ctx context.Context[parser.IStatefulVariableContext
 
 func analyzeIfStatement(ctx context.Context[parser.IIfStatementContext]) {
 	if expr := ctx.AST.Expression(); expr != nil {
-		expression.Analyze(context.Child(ctx, expr))
+		analyzeCondition(context.Child(ctx, expr))
 	}
 
 	if block := ctx.AST.Block(); block != nil {
@@ -277,7 +289,7 @@ func analyzeIfStatement(ctx context.Context[parser.IIfStatementContext]) {
 
 	for _, elseIfClause := range ctx.AST.AllElseIfClause() {
 		if expr := elseIfClause.Expression(); expr != nil {
-			expression.Analyze(context.Child(ctx, expr))
+			analyzeCondition(context.Child(ctx, expr))
 		}
 		if block := elseIfClause.Block(); block != nil {
 			AnalyzeBlock(context.Child(ctx, block))
@@ -291,6 +303,184 @@ func analyzeIfStatement(ctx context.Context[parser.IIfStatementContext]) {
 	}
 }
 
+// analyzeCondition analyzes the condition of an if statement or loop. Struct values
+// have no truth value, so they are rejected.
+func analyzeCondition(ctx context.Context[parser.IExpressionContext]) {
+	expression.Analyze(ctx)
+	if t := atypes.InferFromExpression(ctx); t.IsStruct() {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			ctx.AST, "cannot use struct value of type %s as a condition", t,
+		))
+	}
+}
+
+// analyzeMatchStatement validates a match statement. The scrutinee must be an integer
+// or enum value, and every pattern must be an enum member or integer literal. Matches
+// over an enum must cover every member or provide an else arm; matches over plain
+// integers always require an else arm.
+func analyzeMatchStatement(ctx context.Context[parser.IMatchStatementContext]) {
+	scrutinee := ctx.AST.Expression()
+	if scrutinee == nil {
+		return
+	}
+	expression.Analyze(context.Child(ctx, scrutinee))
+	t := atypes.InferFromExpression(context.Child(ctx, scrutinee)).Unwrap()
+	if !t.IsValid() {
+		return
+	}
+	if !enum.Comparable(t) {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			scrutinee, "cannot match on value of type %s: expected an integer or enum", t,
+		))
+		return
+	}
+	if t.Kind == types.KindVariable {
+		t = types.I64()
+	}
+	matchScope, err := ctx.Scope.Add(ctx, symbol.Symbol{
+		Kind: symbol.KindBlock,
+		AST:  ctx.AST,
+	})
+	if err != nil {
+		ctx.Diagnostics.Add(diagnostics.Error(err, ctx.AST))
+		return
+	}
+	matchCtx := ctx.WithScope(matchScope)
+	if child, _ := matchScope.Add(ctx, symbol.Symbol{
+		Kind: symbol.KindVariable,
+		Type: t,
+		AST:  ctx.AST,
+	}); child != nil {
+		child.Name = "__match"
+	}
+
+	var (
+		scrutineeEnum = enum.OfType(ctx, t)
+		target        = scrutineeEnum
+		matched       = make(map[int64]string)
+	)
+	for _, arm := range ctx.AST.AllMatchArm() {
+		for _, pattern := range arm.AllExpression() {
+			value, member, ok := analyzeMatchPattern(context.Child(matchCtx, pattern))
+			if !ok {
+				continue
+			}
+			if member != nil {
+				if target == nil {
+					target = member.Parent
+				} else if member.Parent != target {
+					ctx.Diagnostics.Add(diagnostics.Errorf(
+						pattern, "%s is not a member of enum %s", pattern.GetText(), target.Name,
+					))
+					continue
+				}
+			}
+			if _, err = literal.ParseInteger(value, t); err != nil {
+				ctx.Diagnostics.Add(diagnostics.Errorf(
+					pattern, "pattern %s does not fit in %s", pattern.GetText(), t,
+				))
+				continue
+			}
+			if prev, dup := matched[value]; dup {
+				ctx.Diagnostics.Add(diagnostics.Errorf(
+					pattern, "duplicate match pattern %s: value %d is already matched by %s",
+					pattern.GetText(), value, prev,
+				))
+				continue
+			}
+			matched[value] = pattern.GetText()
+		}
+		if block := arm.Block(); block != nil {
+			AnalyzeBlock(context.Child(matchCtx, block))
+		}
+	}
+
+	elseArm := ctx.AST.MatchElse()
+	if elseArm != nil {
+		if block := elseArm.Block(); block != nil {
+			AnalyzeBlock(context.Child(matchCtx, block))
+		}
+	}
+	if target == nil {
+		if elseArm == nil {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				ctx.AST, "match on %s must have an else arm", t,
+			))
+		}
+		return
+	}
+	var missing []string
+	for _, m := range enum.Members(target) {
+		if _, ok := matched[m.DefaultValue.(int64)]; !ok {
+			missing = append(missing, m.Name)
+		}
+	}
+	if len(missing) > 0 && elseArm == nil {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			ctx.AST,
+			"match on %s is not exhaustive: missing %s",
+			target.Name, strings.Join(missing, ", "),
+		))
+	}
+	if len(missing) == 0 && elseArm != nil && scrutineeEnum != nil {
+		ctx.Diagnostics.Add(diagnostics.Warningf(
+			elseArm, "else arm is unreachable: all members of %s are matched", target.Name,
+		))
+	}
+}
+
+// analyzeMatchPattern returns the value of a match pattern, along with the enum member
+// it references, if any. Returns false if the pattern is invalid.
+func analyzeMatchPattern(
+	ctx context.Context[parser.IExpressionContext],
+) (value int64, member *symbol.Symbol, ok bool) {
+	if parser.IsLiteral(ctx.AST) || parser.IsNegatedLiteral(ctx.AST) {
+		lit := parser.GetLiteral(ctx.AST)
+		if lit == nil || lit.NumericLiteral() == nil {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				ctx.AST, "match pattern %s must be an integer", ctx.AST.GetText(),
+			))
+			return 0, nil, false
+		}
+		parsed, err := literal.Parse(lit, types.I64())
+		if err != nil {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				ctx.AST, "match pattern %s must be an integer", ctx.AST.GetText(),
+			))
+			return 0, nil, false
+		}
+		value = parsed.Value.(int64)
+		if parser.IsNegatedLiteral(ctx.AST) {
+			value = -value
+		}
+		return value, nil, true
+	}
+	primary := parser.GetPrimaryExpression(ctx.AST)
+	if primary == nil || primary.QualifiedIdentifier() == nil {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			ctx.AST,
+			"match pattern %s must be an enum member or integer literal",
+			ctx.AST.GetText(),
+		))
+		return 0, nil, false
+	}
+	head, tail := parser.QualifiedNameParts(primary.QualifiedIdentifier())
+	sym, err := ctx.ResolveQualified(head, tail)
+	if err != nil {
+		ctx.Diagnostics.Add(diagnostics.Error(err, ctx.AST))
+		return 0, nil, false
+	}
+	if sym.Kind != symbol.KindEnumMember {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			ctx.AST,
+			"match pattern %s must be an enum member or integer literal",
+			ctx.AST.GetText(),
+		))
+		return 0, nil, false
+	}
+	return sym.DefaultValue.(int64), sym, true
+}
+
 func analyzeForStatement(ctx context.Context[parser.IForStatementContext]) {
 	clause := ctx.AST.ForClause()
 	if clause == nil {
@@ -319,7 +509,7 @@ func analyzeForStatement(ctx context.Context[parser.IForStatementContext]) {
 	case hasDeclare && len(idents) == 1:
 		analyzeForSingleIdent(loopCtx, clause, idents[0], expr)
 	case expr != nil:
-		expression.Analyze(context.Child(loopCtx, expr))
+		analyzeCondition(context.Child(loopCtx, expr))
 	}
 
 	if block := ctx.AST.Block(); block != nil {
@@ -1041,6 +1231,14 @@ func analyzeAssignment(ctx context.Context[parser.IAssignmentContext]) {
 		return
 	}
 
+	if members := ctx.AST.AllMemberAccess(); len(members) > 0 {
+		field, ok := resolveFieldTarget(ctx, varScope, members)
+		if !ok {
+			return
+		}
+		varScope, name = field, field.Name
+	}
+
 	if compoundOp := ctx.AST.CompoundOp(); compoundOp != nil {
 		analyzeCompoundAssignment(ctx, varScope, compoundOp)
 		return
@@ -1095,6 +1293,25 @@ func analyzeAssignment(ctx context.Context[parser.IAssignmentContext]) {
 	))
 }
 
+// resolveFieldTarget resolves the struct field targeted by a field assignment such as
+// `p.pos.x = 1`. The field is checked the same way as a variable of its type.
+func resolveFieldTarget(
+	ctx context.Context[parser.IAssignmentContext],
+	varScope *symbol.Symbol,
+	members []parser.IMemberAccessContext,
+) (*symbol.Symbol, bool) {
+	target := varScope
+	for _, member := range members {
+		field, err := target.Field(member.IDENTIFIER().GetText())
+		if err != nil {
+			ctx.Diagnostics.Add(diagnostics.Error(err, member))
+			return nil, false
+		}
+		target = field
+	}
+	return target, true
+}
+
 // AnalyzeFunctionBody analyzes a block and infers its return type by examining
 // all return statements across control flow paths.
 // Returns the inferred return type (invalid if error occurred).
@@ -1162,6 +1379,11 @@ func collectStatementReturnTypes(
 		)
 		return returnTypes
 
+	case ctx.AST.MatchStatement() != nil:
+		return getMatchStatementReturnTypes(
+			context.Child(ctx, ctx.AST.MatchStatement()),
+		)
+
 	default:
 		return []types.Type{}
 	}
@@ -1221,6 +1443,28 @@ func getIfStatementReturnTypes(
 	return allPathsReturn, returnTypes
 }
 
+// getMatchStatementReturnTypes extracts return types from the arms of a match statement.
+func getMatchStatementReturnTypes(
+	ctx context.Context[parser.IMatchStatementContext],
+) []types.Type {
+	var returnTypes []types.Type
+	blocks := make([]parser.IBlockContext, 0, len(ctx.AST.AllMatchArm())+1)
+	for _, arm := range ctx.AST.AllMatchArm() {
+		blocks = append(blocks, arm.Block())
+	}
+	if elseArm := ctx.AST.MatchElse(); elseArm != nil {
+		blocks = append(blocks, elseArm.Block())
+	}
+	for _, block := range blocks {
+		if block == nil {
+			continue
+		}
+		_, blockTypes := getBlockReturnTypes(context.Child(ctx, block))
+		returnTypes = append(returnTypes, blockTypes...)
+	}
+	return returnTypes
+}
+
 // getBlockReturnTypes extracts all return types from a block's statements.
 // Returns (hasReturn bool, returnTypes []types.Type)
 func getBlockReturnTypes(
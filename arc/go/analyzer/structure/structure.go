@@ -0,0 +1,146 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package structure implements semantic analysis for Arc struct declarations.
+//
+// A struct declaration introduces a named aggregate type whose fields are numeric,
+// string, or other struct types. Struct names are usable as type annotations anywhere
+// a type is accepted, and as the head of struct literals.
+//
+// Example:
+//
+//	struct Point { x f64, y f64 }
+//	struct Segment { start Point, end Point }
+//
+// Structs may reference structs declared later in the program, but may not contain
+// themselves, directly or through other structs, since their values have a fixed size.
+package structure
+
+import (
+	acontext "github.com/synnaxlabs/arc/analyzer/context"
+	atypes "github.com/synnaxlabs/arc/analyzer/types"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/diagnostics"
+)
+
+// CollectDeclarations registers all struct declarations in the symbol table and
+// resolves their fields. It runs before the other declaration passes so that struct
+// names are available to function signatures and variable annotations.
+func CollectDeclarations(ctx acontext.Context[parser.IProgramContext]) {
+	c := collector{
+		ctx:      ctx,
+		symbols:  make(map[string]*symbol.Symbol),
+		decls:    make(map[string]parser.IStructDeclarationContext),
+		resolved: make(map[string]bool),
+		visiting: make(map[string]bool),
+	}
+	var order []string
+	for _, item := range ctx.AST.AllTopLevelItem() {
+		decl := item.StructDeclaration()
+		if decl == nil {
+			continue
+		}
+		name := decl.IDENTIFIER().GetText()
+		sym, err := ctx.Scope.Add(ctx, symbol.Symbol{
+			Name: name,
+			Kind: symbol.KindStruct,
+			Type: types.Struct(name, nil),
+			AST:  decl,
+		})
+		if err != nil {
+			ctx.Diagnostics.Add(diagnostics.Error(err, decl))
+			continue
+		}
+		c.symbols[name] = sym
+		c.decls[name] = decl
+		order = append(order, name)
+	}
+	for _, name := range order {
+		c.resolve(name)
+	}
+}
+
+type collector struct {
+	ctx      acontext.Context[parser.IProgramContext]
+	symbols  map[string]*symbol.Symbol
+	decls    map[string]parser.IStructDeclarationContext
+	resolved map[string]bool
+	visiting map[string]bool
+}
+
+// resolve resolves the fields of the named struct, resolving any struct it contains
+// first so that nested field types carry their complete layout. It returns false
+// if the struct could not be resolved because it contains itself.
+func (c *collector) resolve(name string) bool {
+	if c.resolved[name] {
+		return true
+	}
+	if c.visiting[name] {
+		return false
+	}
+	c.visiting[name] = true
+	defer delete(c.visiting, name)
+	decl := c.decls[name]
+	var (
+		fields types.Params
+		seen   = make(map[string]bool)
+	)
+	if list := decl.StructFieldList(); list != nil {
+		for _, f := range list.AllStructField() {
+			fieldName := f.IDENTIFIER().GetText()
+			if seen[fieldName] {
+				c.ctx.Diagnostics.Add(diagnostics.Errorf(
+					f, "duplicate field '%s' in struct %s", fieldName, name,
+				))
+				continue
+			}
+			seen[fieldName] = true
+			fieldType, ok := c.resolveFieldType(name, fieldName, f.Type_())
+			if !ok {
+				continue
+			}
+			fields = append(fields, types.Param{Name: fieldName, Type: fieldType})
+		}
+	}
+	c.symbols[name].Type = types.Struct(name, fields)
+	c.resolved[name] = true
+	return true
+}
+
+func (c *collector) resolveFieldType(
+	structName, fieldName string,
+	typeCtx parser.ITypeContext,
+) (types.Type, bool) {
+	if st := typeCtx.StructType(); st != nil {
+		if ref := st.IDENTIFIER().GetText(); c.decls[ref] != nil && !c.resolve(ref) {
+			c.ctx.Diagnostics.Add(diagnostics.Errorf(
+				typeCtx,
+				"field '%s' makes struct %s recursive: struct %s contains itself",
+				fieldName, structName, ref,
+			))
+			return types.Type{}, false
+		}
+	}
+	t, err := atypes.ResolveType(c.ctx, typeCtx)
+	if err != nil {
+		c.ctx.Diagnostics.Add(diagnostics.Error(err, typeCtx))
+		return types.Type{}, false
+	}
+	if t.Kind == types.KindChan || t.Kind == types.KindSeries {
+		c.ctx.Diagnostics.Add(diagnostics.Errorf(
+			typeCtx,
+			"field '%s' of struct %s has type %s: struct fields must be numeric, string, or struct types",
+			fieldName, structName, t,
+		))
+		return types.Type{}, false
+	}
+	return t, true
+}
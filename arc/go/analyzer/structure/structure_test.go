@@ -0,0 +1,246 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package structure_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/analyzer"
+	acontext "github.com/synnaxlabs/arc/analyzer/context"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	. "github.com/synnaxlabs/arc/symbol/testutil"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/diagnostics"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+func analyzeProgram(specCtx context.Context, src string) acontext.Context[parser.IProgramContext] {
+	prog := MustSucceed(parser.Parse(src))
+	ctx := acontext.NewRoot(specCtx, prog, NewRoot(nil))
+	analyzer.AnalyzeProgram(ctx)
+	return ctx
+}
+
+func analyzeExpectSuccess(specCtx context.Context, src string) acontext.Context[parser.IProgramContext] {
+	ctx := analyzeProgram(specCtx, src)
+	ExpectWithOffset(1, *ctx.Diagnostics).To(BeEmpty(), ctx.Diagnostics.String())
+	return ctx
+}
+
+func analyzeExpectError(
+	specCtx context.Context,
+	src string,
+	msgMatcher OmegaMatcher,
+) acontext.Context[parser.IProgramContext] {
+	ctx := analyzeProgram(specCtx, src)
+	ExpectWithOffset(1, *ctx.Diagnostics).To(HaveLen(1), ctx.Diagnostics.String())
+	ExpectWithOffset(1, (*ctx.Diagnostics)[0].Message).To(msgMatcher)
+	ExpectWithOffset(1, (*ctx.Diagnostics)[0].Severity).To(Equal(diagnostics.SeverityError))
+	return ctx
+}
+
+var point = types.Struct("Point", types.Params{
+	{Name: "x", Type: types.F64()},
+	{Name: "y", Type: types.F64()},
+})
+
+var _ = Describe("Struct Analyzer", func() {
+	Describe("CollectDeclarations", func() {
+		It("Should register a struct symbol with its fields", func(specCtx SpecContext) {
+			ctx := analyzeExpectSuccess(specCtx, `struct Point { x f64, y f64 }`)
+			sym := MustSucceed(ctx.Scope.Resolve(ctx, "Point"))
+			Expect(sym.Kind).To(Equal(symbol.KindStruct))
+			Expect(sym.Type).To(Equal(point))
+		})
+
+		It("Should resolve structs nested in other structs", func(specCtx SpecContext) {
+			ctx := analyzeExpectSuccess(specCtx, `
+			struct Segment { start Point, end Point, label str }
+			struct Point { x f64, y f64 }
+			`)
+			sym := MustSucceed(ctx.Scope.Resolve(ctx, "Segment"))
+			start, offset, ok := sym.Type.Field("start")
+			Expect(ok).To(BeTrue())
+			Expect(offset).To(BeZero())
+			Expect(start.Type).To(Equal(point))
+			_, offset, ok = sym.Type.Field("label")
+			Expect(ok).To(BeTrue())
+			Expect(offset).To(Equal(uint32(32)))
+			Expect(sym.Type.Size()).To(Equal(uint32(36)))
+			Expect(sym.Type.LeafCount()).To(Equal(5))
+		})
+
+		It("Should reject a struct that contains itself", func(specCtx SpecContext) {
+			analyzeExpectError(
+				specCtx,
+				`struct Node { value f64, child Node }`,
+				ContainSubstring("struct Node contains itself"),
+			)
+		})
+
+		It("Should reject mutually recursive structs", func(specCtx SpecContext) {
+			analyzeExpectError(
+				specCtx,
+				`
+				struct A { b B }
+				struct B { a A }
+				`,
+				ContainSubstring("recursive"),
+			)
+		})
+
+		It("Should reject duplicate fields", func(specCtx SpecContext) {
+			analyzeExpectError(
+				specCtx,
+				`struct Point { x f64, x f64 }`,
+				Equal("duplicate field 'x' in struct Point"),
+			)
+		})
+
+		It("Should reject channel-typed fields", func(specCtx SpecContext) {
+			analyzeExpectError(
+				specCtx,
+				`struct Sensor { ch chan f64 }`,
+				ContainSubstring("struct fields must be numeric, string, or struct types"),
+			)
+		})
+
+		It("Should reject fields of an undefined type", func(specCtx SpecContext) {
+			analyzeExpectError(
+				specCtx,
+				`struct Segment { start Point }`,
+				Equal("undefined type: Point"),
+			)
+		})
+	})
+
+	Describe("Usage", func() {
+		It("Should accept struct-typed inputs, outputs and field access", func(specCtx SpecContext) {
+			analyzeExpectSuccess(specCtx, `
+			struct Point { x f64, y f64 }
+			func mid(a Point, b Point) Point {
+				return Point{x: (a.x + b.x) / 2, y: (a.y + b.y) / 2}
+			}
+			func dist() f64 {
+				p := mid(Point{x: 1.0, y: 2.0}, Point{x: 3.0})
+				return p.x + mid(p, p).y
+			}
+			`)
+		})
+
+		It("Should accept nested field access and assignment", func(specCtx SpecContext) {
+			analyzeExpectSuccess(specCtx, `
+			struct Point { x f64, y f64 }
+			struct Segment { start Point, end Point }
+			func f() f64 {
+				s Segment := Segment{start: Point{x: 1.0}}
+				s.end.x = 2.0
+				s.end.y += 1.0
+				s.start = s.end
+				return s.end.x
+			}
+			`)
+		})
+
+		It("Should reject access to an unknown field", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			struct Point { x f64, y f64 }
+			func f() f64 {
+				p := Point{x: 1.0}
+				return p.z
+			}
+			`, Equal("Point has no field 'z'"))
+		})
+
+		It("Should reject an unknown field in a struct literal", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			struct Point { x f64, y f64 }
+			func f() {
+				p := Point{x: 1.0, z: 2.0}
+			}
+			`, Equal("struct Point has no field 'z'"))
+		})
+
+		It("Should reject a field given twice in a struct literal", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			struct Point { x f64, y f64 }
+			func f() {
+				p := Point{x: 1.0, x: 2.0}
+			}
+			`, Equal("field 'x' is given more than once in Point literal"))
+		})
+
+		It("Should reject a field value of the wrong type", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			struct Label { text str }
+			func f() {
+				l := Label{text: 1.0}
+			}
+			`, ContainSubstring("text"))
+		})
+
+		It("Should reject assigning a struct of another type", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			struct Point { x f64, y f64 }
+			struct Size { x f64, y f64 }
+			func f() {
+				p := Point{x: 1.0}
+				p = Size{x: 1.0}
+			}
+			`, Equal("type mismatch: cannot assign Size to 'p' (type Point)"))
+		})
+
+		It("Should reject assignment to an unknown field", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			struct Point { x f64, y f64 }
+			func f() {
+				p := Point{x: 1.0}
+				p.z = 1.0
+			}
+			`, Equal("Point has no field 'z'"))
+		})
+
+		It("Should reject stateful struct variables", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			struct Point { x f64, y f64 }
+			func f() {
+				p $= Point{x: 1.0}
+			}
+			`, Equal("stateful variable 'p' cannot have struct type Point"))
+		})
+
+		It("Should reject comparing struct values", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+				struct Point { x f64, y f64 }
+				func same(a Point, b Point) u8 {
+					return a == b
+				}
+			`, ContainSubstring("cannot use Point in == operation"))
+		})
+
+		It("Should reject a struct value as a condition", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+				struct Point { x f64, y f64 }
+				func check(p Point) {
+					if p {}
+				}
+			`, ContainSubstring("cannot use struct value of type Point as a condition"))
+		})
+
+		It("Should reject an undefined struct type annotation", func(specCtx SpecContext) {
+			analyzeExpectError(specCtx, `
+			func f(p Point) {}
+			`, Equal("undefined type: Point"))
+		})
+	})
+})
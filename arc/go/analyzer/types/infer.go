@@ -10,13 +10,42 @@
 package types
 
 import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/synnaxlabs/arc/analyzer/context"
 	"github.com/synnaxlabs/arc/analyzer/units"
 	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
 	"github.com/synnaxlabs/arc/types"
 	"github.com/synnaxlabs/x/errors"
 )
 
-// InferFromTypeContext extracts the concrete type from an Arc type annotation.
+// ResolveType extracts the concrete type from an Arc type annotation, resolving struct
+// and enum type names against the scope of ctx. Annotations that do not name a
+// declared type are handled by InferFromTypeContext.
+func ResolveType[AST antlr.ParserRuleContext](
+	ctx context.Context[AST],
+	typeCtx parser.ITypeContext,
+) (types.Type, error) {
+	if typeCtx == nil {
+		return types.Type{}, nil
+	}
+	structType := typeCtx.StructType()
+	if structType == nil {
+		return InferFromTypeContext(typeCtx)
+	}
+	name := structType.IDENTIFIER().GetText()
+	sym, err := ctx.Resolve(name)
+	if err != nil {
+		return types.Type{}, errors.Newf("undefined type: %s", name)
+	}
+	if sym.Kind != symbol.KindStruct && sym.Kind != symbol.KindEnum {
+		return types.Type{}, errors.Newf("%s is not a type", name)
+	}
+	return sym.Type, nil
+}
+
+// InferFromTypeContext extracts the concrete type from an Arc type annotation. Struct
+// type names cannot be resolved without a scope, use ResolveType for those.
 func InferFromTypeContext(ctx parser.ITypeContext) (types.Type, error) {
 	if ctx == nil {
 		return types.Type{}, nil
@@ -40,6 +69,12 @@ func InferFromTypeContext(ctx parser.ITypeContext) (types.Type, error) {
 	if series := ctx.SeriesType(); series != nil {
 		return inferSeriesType(series)
 	}
+	if structType := ctx.StructType(); structType != nil {
+		return types.Type{}, errors.Newf(
+			"struct type %s requires a scope to resolve",
+			structType.IDENTIFIER().GetText(),
+		)
+	}
 	return types.Type{}, errors.New("could not determine type")
 }
 
@@ -65,7 +100,7 @@ func inferPrimitiveType(ctx parser.IPrimitiveTypeContext) (types.Type, error) {
 
 func inferNumericType(ctx parser.INumericTypeContext) (types.Type, error) {
 	if integer := ctx.IntegerType(); integer != nil {
-		return inferIntegerType(integer)
+		return InferIntegerType(integer)
 	}
 	if float := ctx.FloatType(); float != nil {
 		return inferFloatType(float)
@@ -73,7 +108,8 @@ func inferNumericType(ctx parser.INumericTypeContext) (types.Type, error) {
 	return types.Type{}, errors.New("unknown type: expected a numeric type (i32, f64, etc.)")
 }
 
-func inferIntegerType(ctx parser.IIntegerTypeContext) (types.Type, error) {
+// InferIntegerType extracts the concrete type from an integer type annotation.
+func InferIntegerType(ctx parser.IIntegerTypeContext) (types.Type, error) {
 	text := ctx.GetText()
 	switch text {
 	case "i8":
@@ -173,6 +209,9 @@ func Compatible(t1, t2 types.Type) bool {
 
 	t1 = t1.Unwrap()
 	t2 = t2.Unwrap()
+	if t1.IsStruct() || t2.IsStruct() {
+		return types.Equal(t1, t2)
+	}
 	// Check base type kind only, not units (units handled by units.ValidateBinaryOp)
 	return t1.Kind == t2.Kind
 }
@@ -192,6 +231,9 @@ func AssignmentCompatible(varType, exprType types.Type) bool {
 	if varType.Kind == types.KindVariable || exprType.Kind == types.KindVariable {
 		return true
 	}
+	if varType.IsStruct() || exprType.IsStruct() {
+		return types.Equal(varType, exprType)
+	}
 	return varType.Kind == exprType.Kind
 }
 
@@ -19,11 +19,21 @@ import (
 	"github.com/synnaxlabs/arc/analyzer/units"
 	"github.com/synnaxlabs/arc/literal"
 	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
 	"github.com/synnaxlabs/arc/types"
 	"github.com/synnaxlabs/x/diagnostics"
 	"go.uber.org/zap"
 )
 
+// FreshenCall renames the type variables of a polymorphic function's type
+// uniquely for the call expression call. Each call then unifies against its
+// own variables, so two calls to math.abs with different argument types do not
+// conflict, and the substitutions never leak into the shared module symbol.
+func FreshenCall(t types.Type, call antlr.ParserRuleContext) types.Type {
+	tok := call.GetStart()
+	return types.Freshen(t, fmt.Sprintf("call_%d_%d", tok.GetLine(), tok.GetColumn()))
+}
+
 // InferFromExpression determines the type of an Arc expression through recursive descent.
 func InferFromExpression(ctx context.Context[parser.IExpressionContext]) types.Type {
 	if logicalOr := ctx.AST.LogicalOrExpression(); logicalOr != nil {
@@ -243,31 +253,44 @@ func InferFromUnaryExpression(ctx context.Context[parser.IUnaryExpressionContext
 }
 
 func inferPostfixType(ctx context.Context[parser.IPostfixExpressionContext]) types.Type {
-	if primary := ctx.AST.PrimaryExpression(); primary != nil {
-		primaryType := inferPrimaryType(context.Child(ctx, primary))
-
-		// Handle function call suffixes - return the function's return type
-		funcCalls := ctx.AST.AllFunctionCallSuffix()
-		if len(funcCalls) > 0 && primaryType.Kind == types.KindFunction {
-			// Get the return type of the function
-			if len(primaryType.Outputs) > 0 {
-				return primaryType.Outputs[0].Type
-			}
-			// Function with no return type
+	t := InferPostfixOperand(ctx)
+	// Handle struct field access - return the type of the accessed field
+	for _, member := range ctx.AST.AllMemberAccess() {
+		field, _, ok := t.Field(member.IDENTIFIER().GetText())
+		if !ok {
 			return types.Type{}
 		}
+		t = field.Type
+	}
+	return t
+}
 
-		// Handle index/slice operations - return the element type for series
-		indexOps := ctx.AST.AllIndexOrSlice()
-		if len(indexOps) > 0 && primaryType.Kind == types.KindSeries {
-			if primaryType.Elem != nil {
-				return *primaryType.Elem
-			}
-		}
+// InferPostfixOperand infers the type of a postfix expression before any struct field
+// access is applied, i.e. the type of the value the first memberAccess suffix reads
+// a field from.
+func InferPostfixOperand(ctx context.Context[parser.IPostfixExpressionContext]) types.Type {
+	primary := ctx.AST.PrimaryExpression()
+	if primary == nil {
+		return types.Type{}
+	}
+	t := inferPrimaryType(context.Child(ctx, primary))
 
-		return primaryType
+	// Handle function call suffixes - return the function's return type
+	funcCalls := ctx.AST.AllFunctionCallSuffix()
+	if len(funcCalls) > 0 && t.Kind == types.KindFunction {
+		t = FreshenCall(t, ctx.AST)
+		// Function with no return type
+		if len(t.Outputs) == 0 {
+			return types.Type{}
+		}
+		// Get the return type of the function
+		t = t.Outputs[0].Type
+	} else if indexOps := ctx.AST.AllIndexOrSlice(); len(indexOps) > 0 &&
+		t.Kind == types.KindSeries && t.Elem != nil {
+		// Handle index/slice operations - return the element type for series
+		t = *t.Elem
 	}
-	return types.Type{}
+	return t
 }
 
 func inferPrimaryType(ctx context.Context[parser.IPrimaryExpressionContext]) types.Type {
@@ -277,10 +300,20 @@ func inferPrimaryType(ctx context.Context[parser.IPrimaryExpressionContext]) typ
 		if err != nil {
 			return types.Type{}
 		}
+		if headSym.IsStructValue() {
+			field, err := headSym.Field(tail)
+			if err != nil {
+				return types.Type{}
+			}
+			return field.Type
+		}
 		resolved, err := headSym.Resolve(ctx, tail)
 		if err != nil {
 			return types.Type{}
 		}
+		if resolved.Kind == symbol.KindEnumMember {
+			return inferEnumMemberType(ctx)
+		}
 		return resolved.Type
 	}
 	if id := ctx.AST.IDENTIFIER(); id != nil {
@@ -311,6 +344,13 @@ func inferPrimaryType(ctx context.Context[parser.IPrimaryExpressionContext]) typ
 			return t
 		}
 	}
+	if structLit := ctx.AST.StructLiteral(); structLit != nil {
+		sym, err := ctx.Scope.Resolve(ctx, structLit.IDENTIFIER().GetText())
+		if err != nil || sym.Kind != symbol.KindStruct {
+			return types.Type{}
+		}
+		return sym.Type
+	}
 	return types.Type{}
 }
 
@@ -533,6 +573,27 @@ func inferNumericLiteralType(
 	return tv
 }
 
+// inferEnumMemberType types an enum member reference like an integer literal, so that
+// members compare against and assign to channels and variables of any integer type.
+func inferEnumMemberType(ctx context.Context[parser.IPrimaryExpressionContext]) types.Type {
+	var (
+		constraint = types.IntegerConstraint()
+		line       = ctx.AST.GetStart().GetLine()
+		col        = ctx.AST.GetStart().GetColumn()
+		tv         = types.Variable(fmt.Sprintf("lit_%d_%d", line, col), &constraint)
+	)
+	if err := ctx.Constraints.AddEquality(
+		tv,
+		tv,
+		ctx.AST,
+		"enum member type variable",
+	); err != nil {
+		zap.S().DPanicf("unexpected error registering type variable with itself: %v", err)
+	}
+	ctx.TypeMap[ctx.AST] = tv
+	return tv
+}
+
 // resolveLiteralConstraint converts a type variable with a literal constraint to a concrete type.
 // This is used when a variable is referenced in an expression to distinguish between:
 // - Direct literals (2 + 3.2) which can be promoted
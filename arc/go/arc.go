@@ -72,6 +72,19 @@ func NewRoot(resolver SymbolResolver, extras ...*symbol.Symbol) *symbol.Symbol {
 	return symbol.NewRoot(resolver, slices.Concat(stl.NewSymbols(), extras))
 }
 
+// NewLibrary parses t as a user-authored library module that programs can
+// import as name. The returned symbol is passed to NewRoot as an extra, after
+// which the functions and constants that the library declares are available to
+// any program that imports it.
+func NewLibrary(name string, t Text, opts ...Option) (*symbol.Symbol, error) {
+	o := newOptions(opts)
+	textWithAST, err := text.Parse(t, o.cfg)
+	if err != nil {
+		return nil, err
+	}
+	return symbol.NewLibrary(name, textWithAST.AST), nil
+}
+
 // CompileGraph parses, analyzes, and compiles a graph-mode program
 // against root. root must have its ambient prelude populated by the
 // caller. Graph mode auto-imports modules; callers do not need to call
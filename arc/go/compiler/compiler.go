@@ -51,6 +51,11 @@ import (
 
 const FmtStrSyntheticPrefix = "fmt$"
 
+// EnumRouteSyntheticPrefix prefixes the keys of the functions synthesized for enum
+// routing tables. Such a function takes one config param per routed member, holding
+// the member's value, and fires the output of the member equal to its input.
+const EnumRouteSyntheticPrefix = "enum_route$"
+
 type compiledFunction struct {
 	scopeName string
 	typeIdx   uint32
@@ -90,8 +95,28 @@ func Compile(ctx context.Context, program ir.IR, opts ...Option) (Output, error)
 		resolver.RegisterLocal(f.Key, uint32(i))
 	}
 
+	// Output memory for every function is laid out before any body is compiled,
+	// since callers read struct results from their callee's output memory.
 	outputMemoryCounter := uint32(0x1000)
 	outputMemoryBases := make(map[string]uint32)
+	for _, i := range program.Functions {
+		if strings.HasPrefix(i.Key, FmtStrSyntheticPrefix) || !hasNamedOutputs(i.Outputs) {
+			continue
+		}
+		outputMemoryBases[i.Key] = outputMemoryCounter
+		var size uint32 = 8
+		for _, oParam := range i.Outputs {
+			if !oParam.Type.IsValid() {
+				return Output{}, errors.Newf(
+					"function %s has output %q with unresolved type",
+					i.Key, oParam.Name,
+				)
+			}
+			size += oParam.Type.Size()
+		}
+		outputMemoryCounter += size
+	}
+	compCtx.Memory = ccontext.NewMemory(outputMemoryCounter, outputMemoryBases)
 
 	var compiled []compiledFunction
 	for _, i := range program.Functions {
@@ -103,36 +128,29 @@ func Compile(ctx context.Context, program ir.IR, opts ...Option) (Output, error)
 			compiled = append(compiled, cf)
 			continue
 		}
+		if strings.HasPrefix(i.Key, EnumRouteSyntheticPrefix) {
+			cf, err := compileEnumRouteSynthetic(compCtx, i, outputMemoryBases[i.Key])
+			if err != nil {
+				return Output{}, err
+			}
+			compiled = append(compiled, cf)
+			continue
+		}
 		params := slices.Concat(i.Config, i.Inputs)
 		var returnType types.Type
-		defaultOutput, hasDefaultOutput := i.Outputs.Get(ir.DefaultOutputParam)
-		hasNamedOutputs := len(i.Outputs) > 1 || (len(i.Outputs) == 1 && !hasDefaultOutput)
-		if !hasNamedOutputs {
+		if !hasNamedOutputs(i.Outputs) {
+			defaultOutput, _ := i.Outputs.Get(ir.DefaultOutputParam)
 			returnType = defaultOutput.Type
 		}
-
-		var outputMemoryBase uint32
-		if hasNamedOutputs {
-			outputMemoryBase = outputMemoryCounter
-			outputMemoryBases[i.Key] = outputMemoryBase
-			var size uint32 = 8
-			for _, oParam := range i.Outputs {
-				if !oParam.Type.IsValid() {
-					return Output{}, errors.Newf(
-						"function %s has output %q with unresolved type",
-						i.Key, oParam.Name,
-					)
-				}
-				if oParam.Type.Kind == types.KindString {
-					size += 4
-				} else {
-					size += uint32(oParam.Type.Density())
-				}
-			}
-			outputMemoryCounter += size
-		}
-
-		cf, err := compileItem(compCtx, i.Key, i.Body.AST, params, returnType, i.Outputs, outputMemoryBase)
+		cf, err := compileItem(
+			compCtx,
+			i.Key,
+			i.Body.AST,
+			params,
+			returnType,
+			i.Outputs,
+			outputMemoryBases[i.Key],
+		)
 		if err != nil {
 			return Output{}, err
 		}
@@ -147,11 +165,20 @@ func Compile(ctx context.Context, program ir.IR, opts ...Option) (Output, error)
 	}
 
 	compCtx.Module.EnableMemory()
+	compCtx.Module.ReserveMemory(compCtx.Memory.End())
 	compCtx.Module.AddExport("memory", wasm.ExportKindMemory, 0)
 
 	return Output{WASM: compCtx.Module.Generate(), OutputMemoryBases: outputMemoryBases}, nil
 }
 
+// hasNamedOutputs returns true if a function's outputs are written to output memory
+// rather than returned: when it has named outputs, or a struct-typed default output,
+// which is flattened into one output per field.
+func hasNamedOutputs(outputs types.Params) bool {
+	_, hasDefaultOutput := outputs.Get(ir.DefaultOutputParam)
+	return len(outputs) > 1 || (len(outputs) == 1 && !hasDefaultOutput)
+}
+
 func compileItem(
 	rootCtx ccontext.Context[antlr.ParserRuleContext],
 	key string,
@@ -161,7 +188,7 @@ func compileItem(
 	outputs types.Params,
 	outputMemoryBase uint32,
 ) (compiledFunction, error) {
-	scope, err := rootCtx.Scope.Resolve(rootCtx, key)
+	scope, err := resolveFunctionScope(rootCtx, rootCtx.Scope, key)
 	if err != nil {
 		return compiledFunction{}, err
 	}
@@ -186,6 +213,10 @@ func compileItem(
 		ctx.Writer.WriteMemoryOp(wasm.OpI64Store, 3, 0)
 	}
 
+	if err = copyStructParams(ctx, scope); err != nil {
+		return compiledFunction{}, err
+	}
+
 	if blockCtx, ok := body.(parser.IBlockContext); ok {
 		_, err = statement.CompileBlock(ccontext.Child(ctx, blockCtx))
 		if err != nil {
@@ -200,13 +231,64 @@ func compileItem(
 	}
 
 	return compiledFunction{
-		scopeName: ctx.Scope.Name,
+		scopeName: key,
 		typeIdx:   typeIdx,
 		locals:    collectLocals(ctx.Scope),
 		writer:    ctx.Writer,
 	}, nil
 }
 
+// copyStructParams copies struct-typed config and input params into their memory
+// slots. A struct param is passed as one WASM param per field, in consecutive locals
+// starting at the param's ID.
+func copyStructParams(
+	ctx ccontext.Context[antlr.ParserRuleContext],
+	scope *symbol.Symbol,
+) error {
+	for _, child := range scope.Children() {
+		if (child.Kind != symbol.KindConfig && child.Kind != symbol.KindInput) ||
+			!child.Type.IsStruct() {
+			continue
+		}
+		addr, err := expression.SymbolAddress(ctx, child)
+		if err != nil {
+			return err
+		}
+		for i, leaf := range expression.Leaves(child.Type) {
+			ctx.Writer.WriteI32Const(int32(addr))
+			ctx.Writer.WriteLocalGet(child.ID + i)
+			if err = ctx.Writer.WriteStore(leaf.Type, leaf.Offset); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveFunctionScope resolves the scope of the function with the given IR key.
+// Functions linked in from a library are keyed by their qualified name
+// (`lib.func`), and are resolved through the library module in the ambient
+// prelude rather than through whatever alias the program imported it as.
+func resolveFunctionScope(
+	ctx context.Context,
+	root *symbol.Symbol,
+	key string,
+) (*symbol.Symbol, error) {
+	libName, name, qualified := strings.Cut(key, ".")
+	if !qualified {
+		return root.Resolve(ctx, key)
+	}
+	modules := root.Root()
+	if modules.Parent != nil {
+		modules = modules.Parent
+	}
+	lib, err := modules.Resolve(ctx, libName, symbol.IncludeInternal)
+	if err != nil {
+		return nil, err
+	}
+	return lib.Resolve(ctx, name, symbol.IncludeInternal)
+}
+
 func compileExpression(ctx ccontext.Context[parser.IExpressionContext]) error {
 	_, err := expression.Compile(ctx)
 	return err
@@ -237,6 +319,50 @@ func compileFmtStrSynthetic(
 	}, nil
 }
 
+// compileEnumRouteSynthetic emits the body of an analyzer-synthesized enum routing
+// function. Member values are distinct, so at most one output fires per call, and its
+// dirty flag is stored directly.
+func compileEnumRouteSynthetic(
+	rootCtx ccontext.Context[antlr.ParserRuleContext],
+	fn ir.Function,
+	outputMemoryBase uint32,
+) (compiledFunction, error) {
+	ctx := rootCtx.WithNewWriter()
+	params := make([]wasm.ValueType, 0, len(fn.Config)+1)
+	for _, c := range fn.Config {
+		params = append(params, wasm.ConvertType(c.Type))
+	}
+	input := fn.Inputs[0]
+	params = append(params, wasm.ConvertType(input.Type))
+	typeIdx := ctx.Module.AddType(wasm.FunctionType{Params: params})
+	ctx.Writer.WriteI32Const(int32(outputMemoryBase))
+	ctx.Writer.WriteI64Const(0)
+	ctx.Writer.WriteMemoryOp(wasm.OpI64Store, 3, 0)
+	inputIdx := len(fn.Config)
+	for i := range fn.Config {
+		ctx.Writer.WriteLocalGet(inputIdx)
+		ctx.Writer.WriteLocalGet(i)
+		if err := ctx.Writer.WriteBinaryOpInferred("==", input.Type); err != nil {
+			return compiledFunction{}, err
+		}
+		ctx.Writer.WriteIf(wasm.BlockTypeEmpty)
+		ctx.Writer.WriteI32Const(int32(outputMemoryBase + 8 + uint32(i)))
+		ctx.Writer.WriteI32Const(1)
+		if err := ctx.Writer.WriteStore(types.U8(), 0); err != nil {
+			return compiledFunction{}, err
+		}
+		ctx.Writer.WriteI32Const(int32(outputMemoryBase))
+		ctx.Writer.WriteI64Const(int64(1) << i)
+		ctx.Writer.WriteMemoryOp(wasm.OpI64Store, 3, 0)
+		ctx.Writer.WriteEnd()
+	}
+	return compiledFunction{
+		scopeName: fn.Key,
+		typeIdx:   typeIdx,
+		writer:    ctx.Writer,
+	}, nil
+}
+
 func collectLocals(scope *symbol.Symbol) []wasm.ValueType {
 	var locals []wasm.ValueType
 	for _, child := range scope.Children() {
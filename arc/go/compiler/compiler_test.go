@@ -3894,4 +3894,216 @@ var _ = Describe("Compiler", func() {
 			Expect(results[0]).To(BeNumerically(">", 0))
 		})
 	})
+
+	Describe("Structs", func() {
+		It("Should read fields of a struct literal", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			struct Point { x f64, y f64 }
+			func sum() f64 {
+				p := Point{x: 1.5, y: 2.5}
+				return p.x + p.y
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			results := MustSucceed(mod.ExportedFunction("sum").Call(ctx))
+			Expect(math.Float64frombits(results[0])).To(Equal(4.0))
+		})
+
+		It("Should zero omitted fields and assign to fields", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			struct Counter { count i32, step i32 }
+			func run() i32 {
+				c := Counter{step: 3}
+				c.count += c.step
+				c.count = c.count * 2
+				return c.count
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			results := MustSucceed(mod.ExportedFunction("run").Call(ctx))
+			Expect(int32(results[0])).To(Equal(int32(6)))
+		})
+
+		It("Should copy struct values on assignment", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			struct Point { x i64, y i64 }
+			func run() i64 {
+				a := Point{x: 1, y: 2}
+				b := a
+				b.x = 10
+				return a.x + b.x
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			results := MustSucceed(mod.ExportedFunction("run").Call(ctx))
+			Expect(int64(results[0])).To(Equal(int64(11)))
+		})
+
+		It("Should access fields of nested structs", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			struct Point { x f32, y f32 }
+			struct Segment { start Point, end Point }
+			func length() f32 {
+				s := Segment{start: Point{x: 1}, end: Point{x: 4, y: 2}}
+				s.start.y = 1
+				return (s.end.x - s.start.x) + (s.end.y - s.start.y)
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			results := MustSucceed(mod.ExportedFunction("length").Call(ctx))
+			Expect(math.Float32frombits(uint32(results[0]))).To(Equal(float32(4)))
+		})
+
+		It("Should pass structs to and return structs from functions", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			struct Point { x f64, y f64 }
+			func mid(a Point, b Point) Point {
+				return Point{x: (a.x + b.x) / 2, y: (a.y + b.y) / 2}
+			}
+			func run() f64 {
+				p := mid(Point{x: 0, y: 2}, Point{x: 4, y: 6})
+				return p.x + mid(p, Point{x: 2, y: 4}).y
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			results := MustSucceed(mod.ExportedFunction("run").Call(ctx))
+			Expect(math.Float64frombits(results[0])).To(Equal(6.0))
+		})
+
+		It("Should write struct results to output memory", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			struct Reading { value f64, ok u8 }
+			func check(v f64) Reading {
+				return Reading{value: v * 2, ok: v > 0}
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			MustSucceed(mod.ExportedFunction("check").Call(ctx, math.Float64bits(1.5)))
+			base := output.OutputMemoryBases["check"]
+			mem := mod.Memory()
+			Expect(MustBeOk(mem.ReadUint64Le(base))).To(Equal(uint64(0b11)))
+			Expect(math.Float64frombits(MustBeOk(mem.ReadUint64Le(base + 8)))).To(Equal(3.0))
+			Expect(MustBeOk(mem.ReadByte(base + 16))).To(Equal(uint8(1)))
+		})
+
+		It("Should set dirty flags for assigned fields of a named struct output", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			struct Point { x f64, y f64 }
+			func split(v f64) (count i32, p Point) {
+				if v > 0 {
+					p.y = v
+				}
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			MustSucceed(mod.ExportedFunction("split").Call(ctx, math.Float64bits(2)))
+			base := output.OutputMemoryBases["split"]
+			mem := mod.Memory()
+			Expect(MustBeOk(mem.ReadUint64Le(base))).To(Equal(uint64(0b100)))
+			Expect(math.Float64frombits(MustBeOk(mem.ReadUint64Le(base + 20)))).To(Equal(2.0))
+		})
+
+		It("Should expose struct config params as individual fields", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			struct Limits { lo f64, hi f64 }
+			func clamp{limits Limits}(v f64) f64 {
+				if v < limits.lo {
+					return limits.lo
+				}
+				if v > limits.hi {
+					return limits.hi
+				}
+				return v
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			clamp := mod.ExportedFunction("clamp")
+			results := MustSucceed(clamp.Call(ctx, math.Float64bits(0), math.Float64bits(10), math.Float64bits(12)))
+			Expect(math.Float64frombits(results[0])).To(Equal(10.0))
+		})
+	})
+
+	Describe("Enums", func() {
+		It("Should compile enum members as constants", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			enum Mode { idle, press, fire = 10, safe }
+			func run() i64 {
+				return Mode.fire + Mode.safe
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			results := MustSucceed(mod.ExportedFunction("run").Call(ctx))
+			Expect(int64(results[0])).To(Equal(int64(21)))
+		})
+
+		It("Should compare enum members against values of other integer types", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			enum Mode { idle, press, fire }
+			func is_fire(v u8) u8 {
+				return v == Mode.fire
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			isFire := mod.ExportedFunction("is_fire")
+			Expect(MustSucceed(isFire.Call(ctx, 2))[0]).To(Equal(uint64(1)))
+			Expect(MustSucceed(isFire.Call(ctx, 1))[0]).To(Equal(uint64(0)))
+		})
+	})
+
+	Describe("Match", func() {
+		It("Should select the arm matching an enum value", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			enum Valve u8 { closed, open = 5, fault = 9 }
+			func classify(v u8) i32 {
+				match v {
+					Valve.closed => { return 1 }
+					Valve.open, Valve.fault => { return 2 }
+				}
+				return 0
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			classify := mod.ExportedFunction("classify")
+			Expect(int32(MustSucceed(classify.Call(ctx, 0))[0])).To(Equal(int32(1)))
+			Expect(int32(MustSucceed(classify.Call(ctx, 5))[0])).To(Equal(int32(2)))
+			Expect(int32(MustSucceed(classify.Call(ctx, 9))[0])).To(Equal(int32(2)))
+			Expect(int32(MustSucceed(classify.Call(ctx, 3))[0])).To(Equal(int32(0)))
+		})
+
+		It("Should match integer literals with an else arm", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			func run(x i32) i32 {
+				match x {
+					1, 2 => { return 10 }
+					-1 => { return 20 }
+					else => { return 30 }
+				}
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			run := mod.ExportedFunction("run")
+			Expect(int32(MustSucceed(run.Call(ctx, 2))[0])).To(Equal(int32(10)))
+			Expect(int32(MustSucceed(run.Call(ctx, uint64(0xFFFFFFFF)))[0])).To(Equal(int32(20)))
+			Expect(int32(MustSucceed(run.Call(ctx, 7))[0])).To(Equal(int32(30)))
+		})
+
+		It("Should match a variable of an enum type", func(ctx SpecContext) {
+			output := MustSucceed(compile(ctx, `
+			enum Mode { idle, press, fire }
+			func run() i64 {
+				m Mode := Mode.press
+				count := 0
+				match m {
+					Mode.idle => { count = 1 }
+					Mode.press => { count = 2 }
+					Mode.fire => { count = 3 }
+				}
+				return count
+			}
+			`, nil))
+			mod := MustSucceed(r.Instantiate(ctx, output.WASM))
+			results := MustSucceed(mod.ExportedFunction("run").Call(ctx))
+			Expect(int64(results[0])).To(Equal(int64(2)))
+		})
+	})
 })
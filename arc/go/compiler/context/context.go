@@ -48,6 +48,8 @@ type Context[ASTNode antlr.ParserRuleContext] struct {
 	loopStack []LoopEntry
 	// Config carries per-parse language settings (e.g. dashed channel names).
 	Config parser.Config
+	// Memory lays out struct values in linear memory.
+	Memory *Memory
 }
 
 func Child[P, ASTNode antlr.ParserRuleContext](ctx Context[P], node ASTNode) Context[ASTNode] {
@@ -66,6 +68,7 @@ func Child[P, ASTNode antlr.ParserRuleContext](ctx Context[P], node ASTNode) Con
 		blockDepth:       ctx.blockDepth,
 		loopStack:        ctx.loopStack,
 		Config:           ctx.Config,
+		Memory:           ctx.Memory,
 	}
 }
 
@@ -0,0 +1,45 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package context
+
+// Memory assigns static linear memory addresses to struct values. Arc does not allow
+// recursion, so a function never has more than one live activation, and every struct
+// value (variables, params, literals, and call results) can live at an address fixed
+// at compile time. Memory is shared by all functions in a module.
+type Memory struct {
+	// OutputBases maps the key of each function with memory-backed outputs to the
+	// base address of its output memory. Struct results of a call are read from the
+	// callee's output memory.
+	OutputBases map[string]uint32
+	slots       map[any]uint32
+	end         uint32
+}
+
+// NewMemory creates a Memory that allocates struct storage upward from base, which
+// must lie past the output memory of every function.
+func NewMemory(base uint32, outputBases map[string]uint32) *Memory {
+	return &Memory{OutputBases: outputBases, slots: make(map[any]uint32), end: base}
+}
+
+// Slot returns the address of the storage identified by key, allocating size bytes
+// the first time key is seen. Keys are the symbols of struct-typed variables and
+// params, and the parser nodes of struct literals.
+func (m *Memory) Slot(key any, size uint32) uint32 {
+	if addr, ok := m.slots[key]; ok {
+		return addr
+	}
+	addr := m.end
+	m.slots[key] = addr
+	m.end += size
+	return addr
+}
+
+// End returns the address just past the highest allocated slot.
+func (m *Memory) End() uint32 { return m.end }
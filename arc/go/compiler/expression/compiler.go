@@ -130,6 +130,9 @@ func compilePower(
 }
 
 func compilePostfix(ctx context.Context[parser.IPostfixExpressionContext]) (types.Type, error) {
+	if _, isStruct := postfixStructType(ctx); isStruct {
+		return compileStructValue(ctx)
+	}
 	primary := ctx.AST.PrimaryExpression()
 	funcCalls := ctx.AST.AllFunctionCallSuffix()
 
@@ -199,6 +202,14 @@ func compileFunctionCallExpr(
 		)
 	}
 
+	target := scope
+	if target.Deprecated != nil {
+		target = target.Deprecated
+	}
+	if target.Stateful {
+		ctx.Writer.WriteI32Const(ctx.Resolver.NextCallSite())
+	}
+
 	concreteInputs := make(types.Params, len(funcType.Inputs))
 	copy(concreteInputs, funcType.Inputs)
 
@@ -210,6 +221,16 @@ func compileFunctionCallExpr(
 
 	for i, arg := range args {
 		paramType := funcType.Inputs[i].Type
+		if paramType.IsStruct() {
+			argType, addr, err := StructAddress(context.Child(ctx, arg))
+			if err != nil {
+				return types.Type{}, errors.Wrapf(err, "argument %d", i)
+			}
+			if err := pushStructLeaves(ctx, argType, addr); err != nil {
+				return types.Type{}, err
+			}
+			continue
+		}
 		hint := paramType
 		if paramType.Kind == types.KindVariable {
 			if resolved, ok := varMap[paramType.Name]; ok {
@@ -252,10 +273,6 @@ func compileFunctionCallExpr(
 		Inputs:  concreteInputs,
 		Outputs: concreteOutputs,
 	})
-	target := scope
-	if target.Deprecated != nil {
-		target = target.Deprecated
-	}
 	ctx.Resolver.EmitCall(ctx.Writer, ctx.WriterID, target, concreteType)
 	defaultOutput, hasDefault := concreteOutputs.Get(ir.DefaultOutputParam)
 	if hasDefault {
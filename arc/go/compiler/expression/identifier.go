@@ -12,6 +12,7 @@ package expression
 import (
 	"github.com/antlr4-go/antlr/v4"
 	"github.com/synnaxlabs/arc/compiler/context"
+	"github.com/synnaxlabs/arc/literal"
 	"github.com/synnaxlabs/arc/symbol"
 	"github.com/synnaxlabs/arc/types"
 	"github.com/synnaxlabs/x/errors"
@@ -60,6 +61,8 @@ func compileIdentifier[ASTNode antlr.ParserRuleContext](
 			return types.Type{}, err
 		}
 		return scope.Type, nil
+	case symbol.KindEnumMember:
+		return compileEnumMember(ctx, scope)
 	case symbol.KindStatefulVariable:
 		emitStatefulLoad(ctx, scope.ID, scope.Type)
 		return scope.Type, nil
@@ -75,6 +78,33 @@ func compileIdentifier[ASTNode antlr.ParserRuleContext](
 	}
 }
 
+// compileEnumMember emits an enum member's value as a constant. Like an integer
+// literal, the member takes the type of its context: the hint when numeric, otherwise
+// the type inferred by the analyzer, falling back to the enum's underlying type.
+func compileEnumMember[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	member *symbol.Symbol,
+) (types.Type, error) {
+	t := ctx.Hint.Unwrap()
+	if !t.IsNumeric() || t.Kind == types.KindVariable {
+		t = member.Type
+		if inferred, ok := ctx.TypeMap[ctx.AST]; ok && inferred.IsNumeric() &&
+			inferred.Kind != types.KindVariable {
+			t = inferred
+		}
+	}
+	t.Name = ""
+	t.Unit = nil
+	parsed, err := literal.ParseInteger(member.DefaultValue.(int64), t)
+	if err != nil {
+		return types.Type{}, err
+	}
+	if err = emitLiteralValue(ctx, parsed.Type, parsed.Value); err != nil {
+		return types.Type{}, err
+	}
+	return parsed.Type, nil
+}
+
 func emitStatefulLoad[ASTNode antlr.ParserRuleContext](
 	ctx context.Context[ASTNode],
 	idx int,
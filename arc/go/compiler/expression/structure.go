@@ -0,0 +1,463 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package expression
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/synnaxlabs/arc/compiler/context"
+	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/errors"
+)
+
+// Struct values live in linear memory at addresses fixed at compile time (see
+// context.Memory). An expression of struct type is compiled to the address of its
+// value, and reading a field loads from that address plus the field's offset.
+
+// Leaf is a primitive value within a struct's memory layout.
+type Leaf struct {
+	Type   types.Type
+	Offset uint32
+}
+
+// Leaves returns the primitive values of a struct type in layout order, which matches
+// the order of types.Params.Flatten.
+func Leaves(t types.Type) []Leaf {
+	var leaves []Leaf
+	var offset uint32
+	for _, f := range t.Fields {
+		if f.Type.IsStruct() {
+			for _, l := range Leaves(f.Type) {
+				leaves = append(leaves, Leaf{Type: l.Type, Offset: offset + l.Offset})
+			}
+		} else {
+			leaves = append(leaves, Leaf{Type: f.Type, Offset: offset})
+		}
+		offset += f.Type.Size()
+	}
+	return leaves
+}
+
+// FieldPath resolves a chain of field names on a struct type, returning the type of the
+// final field, its byte offset, and the index of its first leaf.
+func FieldPath(t types.Type, names []string) (types.Type, uint32, int, error) {
+	var (
+		offset uint32
+		leaf   int
+	)
+	for _, name := range names {
+		if !t.IsStruct() {
+			return types.Type{}, 0, 0, errors.Newf("%s has no field '%s'", t, name)
+		}
+		found := false
+		for _, f := range t.Fields {
+			if f.Name == name {
+				t = f.Type
+				found = true
+				break
+			}
+			offset += f.Type.Size()
+			leaf += f.Type.LeafCount()
+		}
+		if !found {
+			return types.Type{}, 0, 0, errors.Newf("%s has no field '%s'", t, name)
+		}
+	}
+	return t, offset, leaf, nil
+}
+
+// CopyStruct emits a copy of the struct value of type t at src to dst.
+func CopyStruct[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	t types.Type,
+	dst, src uint32,
+) error {
+	if dst == src {
+		return nil
+	}
+	for _, l := range Leaves(t) {
+		ctx.Writer.WriteI32Const(int32(dst))
+		ctx.Writer.WriteI32Const(int32(src))
+		if err := ctx.Writer.WriteLoad(l.Type, l.Offset); err != nil {
+			return err
+		}
+		if err := ctx.Writer.WriteStore(l.Type, l.Offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SymbolAddress returns the address of the storage of a struct-valued symbol.
+// Variables and params are allocated their own slot, while a named output lives in the
+// output memory of the function being compiled.
+func SymbolAddress[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	sym *symbol.Symbol,
+) (uint32, error) {
+	if sym.Kind != symbol.KindOutput {
+		return ctx.Memory.Slot(sym, sym.Type.Size()), nil
+	}
+	return OutputAddress(ctx, sym.Name)
+}
+
+// OutputAddress returns the address of the value of the named struct output of the
+// function being compiled. Its fields are flattened into ctx.Outputs, and the first
+// one marks the start of the struct.
+func OutputAddress[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	name string,
+) (uint32, error) {
+	_, offset, ok := outputLeaf(ctx.Outputs, name+".")
+	if !ok {
+		return 0, errors.Newf("output '%s' not found", name)
+	}
+	return ctx.OutputMemoryBase + 8 + offset, nil
+}
+
+// OutputLeafIndex returns the index in ctx.Outputs of the first field of the named
+// struct output, which is the bit of its first leaf in the output dirty flags.
+func OutputLeafIndex[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	name string,
+) (int, error) {
+	idx, _, ok := outputLeaf(ctx.Outputs, name+".")
+	if !ok {
+		return 0, errors.Newf("output '%s' not found", name)
+	}
+	return idx, nil
+}
+
+func outputLeaf(outputs types.Params, prefix string) (int, uint32, bool) {
+	var offset uint32
+	for i, o := range outputs {
+		if len(o.Name) > len(prefix) && o.Name[:len(prefix)] == prefix {
+			return i, offset, true
+		}
+		offset += o.Type.Size()
+	}
+	return 0, 0, false
+}
+
+// IsStructExpression returns true if the expression evaluates to a struct value.
+func IsStructExpression[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	expr parser.IExpressionContext,
+) bool {
+	postfix := parser.GetPostfixExpression(expr)
+	if postfix == nil {
+		return false
+	}
+	t, ok := postfixStructType(context.Child(ctx, postfix))
+	return ok && t.IsStruct()
+}
+
+// StructAddress compiles an expression of struct type, returning its type and the
+// address of its value. No value is left on the stack.
+func StructAddress(
+	ctx context.Context[parser.IExpressionContext],
+) (types.Type, uint32, error) {
+	postfix := parser.GetPostfixExpression(ctx.AST)
+	if postfix == nil {
+		return types.Type{}, 0, errors.New("expected a struct value")
+	}
+	t, addr, err := compileStructPostfix(context.Child(ctx, postfix))
+	if err != nil {
+		return types.Type{}, 0, err
+	}
+	if !t.IsStruct() {
+		return types.Type{}, 0, errors.Newf("expected a struct value, got %s", t)
+	}
+	return t, addr, nil
+}
+
+// postfixStructType returns the type of a postfix expression that involves a struct
+// value, either as its operand or through member access. It returns false for all
+// other postfix expressions, which are compiled without touching struct memory.
+func postfixStructType(
+	ctx context.Context[parser.IPostfixExpressionContext],
+) (types.Type, bool) {
+	t, ok := operandStructType(ctx)
+	if !ok {
+		return types.Type{}, false
+	}
+	for _, m := range ctx.AST.AllMemberAccess() {
+		if !t.IsStruct() {
+			return types.Type{}, false
+		}
+		f, _, found := t.Field(m.IDENTIFIER().GetText())
+		if !found {
+			return types.Type{}, false
+		}
+		t = f.Type
+	}
+	return t, true
+}
+
+func operandStructType(
+	ctx context.Context[parser.IPostfixExpressionContext],
+) (types.Type, bool) {
+	primary := ctx.AST.PrimaryExpression()
+	if lit := primary.StructLiteral(); lit != nil {
+		sym, err := ctx.Scope.Resolve(ctx, lit.IDENTIFIER().GetText())
+		if err != nil || sym.Kind != symbol.KindStruct {
+			return types.Type{}, false
+		}
+		return sym.Type, true
+	}
+	if primary.LPAREN() != nil && primary.Expression() != nil {
+		inner := parser.GetPostfixExpression(primary.Expression())
+		if inner == nil {
+			return types.Type{}, false
+		}
+		return postfixStructType(context.Child(ctx, inner))
+	}
+	head, tail := parser.PrimaryNameParts(primary)
+	if head == "" {
+		return types.Type{}, false
+	}
+	sym, err := ctx.Scope.Resolve(ctx, head)
+	if err != nil {
+		return types.Type{}, false
+	}
+	if sym.IsStructValue() {
+		if len(ctx.AST.AllFunctionCallSuffix()) > 0 {
+			return types.Type{}, false
+		}
+		if tail == "" {
+			return sym.Type, true
+		}
+		f, _, found := sym.Type.Field(tail)
+		return f.Type, found
+	}
+	if tail != "" {
+		if sym, err = sym.Resolve(ctx, tail); err != nil {
+			return types.Type{}, false
+		}
+	}
+	if sym.Kind != symbol.KindFunction || len(ctx.AST.AllFunctionCallSuffix()) == 0 {
+		return types.Type{}, false
+	}
+	out, ok := sym.Type.Outputs.Get(ir.DefaultOutputParam)
+	if !ok || !out.Type.IsStruct() {
+		return types.Type{}, false
+	}
+	return out.Type, true
+}
+
+// compileStructValue compiles a postfix expression involving a struct value. A struct
+// result leaves its address on the stack, while a primitive field is loaded.
+func compileStructValue(
+	ctx context.Context[parser.IPostfixExpressionContext],
+) (types.Type, error) {
+	t, addr, err := compileStructPostfix(ctx)
+	if err != nil {
+		return types.Type{}, err
+	}
+	ctx.Writer.WriteI32Const(int32(addr))
+	if t.IsStruct() {
+		return t, nil
+	}
+	if err := ctx.Writer.WriteLoad(t, 0); err != nil {
+		return types.Type{}, err
+	}
+	return t, nil
+}
+
+func compileStructPostfix(
+	ctx context.Context[parser.IPostfixExpressionContext],
+) (types.Type, uint32, error) {
+	t, addr, err := compileStructOperand(ctx)
+	if err != nil {
+		return types.Type{}, 0, err
+	}
+	members := ctx.AST.AllMemberAccess()
+	names := make([]string, len(members))
+	for i, m := range members {
+		names[i] = m.IDENTIFIER().GetText()
+	}
+	t, offset, _, err := FieldPath(t, names)
+	if err != nil {
+		return types.Type{}, 0, err
+	}
+	return t, addr + offset, nil
+}
+
+func compileStructOperand(
+	ctx context.Context[parser.IPostfixExpressionContext],
+) (types.Type, uint32, error) {
+	primary := ctx.AST.PrimaryExpression()
+	if lit := primary.StructLiteral(); lit != nil {
+		return compileStructLiteral(context.Child(ctx, lit))
+	}
+	if primary.LPAREN() != nil && primary.Expression() != nil {
+		inner := parser.GetPostfixExpression(primary.Expression())
+		if inner == nil {
+			return types.Type{}, 0, errors.New("expected a struct value")
+		}
+		return compileStructPostfix(context.Child(ctx, inner))
+	}
+	head, tail := parser.PrimaryNameParts(primary)
+	sym, err := ctx.Scope.Resolve(ctx, head)
+	if err != nil {
+		return types.Type{}, 0, err
+	}
+	if sym.IsStructValue() {
+		addr, err := SymbolAddress(ctx, sym)
+		if err != nil {
+			return types.Type{}, 0, err
+		}
+		if tail == "" {
+			return sym.Type, addr, nil
+		}
+		f, offset, ok := sym.Type.Field(tail)
+		if !ok {
+			return types.Type{}, 0, errors.Newf("%s has no field '%s'", sym.Type, tail)
+		}
+		return f.Type, addr + offset, nil
+	}
+	if tail != "" {
+		if sym, err = sym.Resolve(ctx, tail); err != nil {
+			return types.Type{}, 0, err
+		}
+	}
+	funcCalls := ctx.AST.AllFunctionCallSuffix()
+	if sym.Kind != symbol.KindFunction || len(funcCalls) == 0 {
+		return types.Type{}, 0, errors.Newf("'%s' is not a struct value", parser.PrimaryName(primary))
+	}
+	t, err := compileFunctionCallExpr(ctx, parser.PrimaryName(primary), sym, funcCalls[0])
+	if err != nil {
+		return types.Type{}, 0, err
+	}
+	addr, err := structResultAddress(ctx, sym, t)
+	return t, addr, err
+}
+
+// structResultAddress returns the address of the struct result of a call to target.
+// The result is flattened into the callee's outputs, so it is read from the callee's
+// output memory, past its dirty flags.
+func structResultAddress(
+	ctx context.Context[parser.IPostfixExpressionContext],
+	target *symbol.Symbol,
+	t types.Type,
+) (uint32, error) {
+	if target.Deprecated != nil {
+		target = target.Deprecated
+	}
+	key := target.Name
+	if target.Parent != nil && target.Parent.IsLibrary() {
+		key = target.QualifiedName()
+	}
+	base, ok := ctx.Memory.OutputBases[key]
+	if !ok {
+		return 0, errors.Newf("function '%s' does not return %s in memory", key, t)
+	}
+	return base + 8, nil
+}
+
+// compileStructLiteral stores each field of a struct literal into the literal's slot
+// and returns its address. Omitted fields are zeroed, since the slot is reused each
+// time the literal is evaluated.
+func compileStructLiteral(
+	ctx context.Context[parser.IStructLiteralContext],
+) (types.Type, uint32, error) {
+	name := ctx.AST.IDENTIFIER().GetText()
+	sym, err := ctx.Scope.Resolve(ctx, name)
+	if err != nil {
+		return types.Type{}, 0, err
+	}
+	if sym.Kind != symbol.KindStruct {
+		return types.Type{}, 0, errors.Newf("%s is not a struct type", name)
+	}
+	t := sym.Type
+	addr := ctx.Memory.Slot(ctx.AST, t.Size())
+	values := make(map[string]parser.IExpressionContext)
+	for _, fv := range ctx.AST.AllStructFieldValue() {
+		values[fv.IDENTIFIER().GetText()] = fv.Expression()
+	}
+	var offset uint32
+	for _, f := range t.Fields {
+		expr, ok := values[f.Name]
+		if !ok {
+			if err := zeroStruct(ctx, f.Type, addr+offset); err != nil {
+				return types.Type{}, 0, err
+			}
+		} else if err := StoreValue(ctx, expr, f.Type, addr+offset); err != nil {
+			return types.Type{}, 0, errors.Wrapf(err, "field '%s'", f.Name)
+		}
+		offset += f.Type.Size()
+	}
+	return t, addr, nil
+}
+
+// StoreValue compiles expr and stores its value, of type t, at addr. Struct values are
+// copied from the address the expression evaluates to.
+func StoreValue[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	expr parser.IExpressionContext,
+	t types.Type,
+	addr uint32,
+) error {
+	if t.IsStruct() {
+		_, src, err := StructAddress(context.Child(ctx, expr))
+		if err != nil {
+			return err
+		}
+		return CopyStruct(ctx, t, addr, src)
+	}
+	ctx.Writer.WriteI32Const(int32(addr))
+	valueType, err := Compile(context.Child(ctx, expr).WithHint(t))
+	if err != nil {
+		return err
+	}
+	if !types.Equal(valueType, t) {
+		if err := EmitCast(ctx, valueType, t); err != nil {
+			return err
+		}
+	}
+	return ctx.Writer.WriteStore(t, 0)
+}
+
+func zeroStruct[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	t types.Type,
+	addr uint32,
+) error {
+	leaves := []Leaf{{Type: t}}
+	if t.IsStruct() {
+		leaves = Leaves(t)
+	}
+	for _, l := range leaves {
+		ctx.Writer.WriteI32Const(int32(addr))
+		emitZeroValue(ctx, l.Type)
+		if err := ctx.Writer.WriteStore(l.Type, l.Offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pushStructLeaves loads each leaf of the struct value of type t at addr onto the
+// stack, in the order of the flattened params of a function taking it as an argument.
+func pushStructLeaves[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	t types.Type,
+	addr uint32,
+) error {
+	for _, l := range Leaves(t) {
+		ctx.Writer.WriteI32Const(int32(addr))
+		if err := ctx.Writer.WriteLoad(l.Type, l.Offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
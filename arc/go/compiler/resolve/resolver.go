@@ -56,6 +56,7 @@ type Resolver struct {
 	compiled      map[string]compiledFunc
 	writers       []writerPatches
 	handleCounter uint32
+	callSites     int32
 }
 
 // NewResolver creates an empty Resolver. The Resolver does not consult any
@@ -97,8 +98,14 @@ func (r *Resolver) RecordPlaceholder(writerID int, handle uint32, offset int) {
 //     a WASM import under (target.Parent.Name, target.Name). Any type
 //     variables in target.Type are reconciled against concreteType to
 //     produce a per-instantiation type suffix.
+//   - A member of a library module is linked into the program, so it is
+//     treated as a locally-compiled function looked up at link time via
+//     RegisterLocal(target.QualifiedName(), ...).
 //   - Anything else is treated as a locally-compiled function looked up at
 //     link time via RegisterLocal(target.Name, ...).
+//
+// Calls to a Stateful target take a hidden leading i32 call site key, which
+// the caller must push (see NextCallSite) before the call arguments.
 func (r *Resolver) EmitCall(
 	w *wasm.Writer,
 	writerID int,
@@ -106,18 +113,36 @@ func (r *Resolver) EmitCall(
 	concreteType types.Type,
 ) {
 	var module, suffix string
-	if target.Parent != nil && target.Parent.Kind == symbol.KindModule {
+	name := target.Name
+	if target.Parent != nil && target.Parent.IsLibrary() {
+		name = target.QualifiedName()
+	} else if target.Parent != nil && target.Parent.Kind == symbol.KindModule {
 		module = target.Parent.Name
 		suffix = DeriveTypeSuffix(target.Type, concreteType)
 	}
+	if target.Stateful {
+		concreteType.Inputs = append(
+			types.Params{{Name: "call_site", Type: types.I32()}},
+			concreteType.Inputs...,
+		)
+	}
 	r.record(w, writerID, pendingRef{
 		module:       module,
-		name:         target.Name,
+		name:         name,
 		typeSuffix:   suffix,
 		concreteType: concreteType,
 	})
 }
 
+// NextCallSite returns a new call site key for a call to a Stateful host
+// function. Keys are unique across the module so that calls in different
+// functions never share host state.
+func (r *Resolver) NextCallSite() int32 {
+	site := r.callSites
+	r.callSites++
+	return site
+}
+
 // EmitImportCall records a call to a hardcoded host import by explicit
 // (module, name) coordinates. Use this for compiler-emitted calls to
 // well-known host functions (channels.read, series.set_element, ...) where
@@ -29,6 +29,9 @@ func Compile(ctx context.Context[parser.IStatementContext]) (diverged bool, err
 	if forStmt := ctx.AST.ForStatement(); forStmt != nil {
 		return compileForStatement(context.Child(ctx, forStmt))
 	}
+	if matchStmt := ctx.AST.MatchStatement(); matchStmt != nil {
+		return compileMatchStatement(context.Child(ctx, matchStmt))
+	}
 	if ctx.AST.BreakStatement() != nil {
 		return true, compileBreakStatement(context.Child(ctx, ctx.AST.BreakStatement()))
 	}
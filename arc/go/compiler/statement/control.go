@@ -99,16 +99,99 @@ func compileIfStatement(ctx context.Context[parser.IIfStatementContext]) (diverg
 	return false, nil
 }
 
+// compileMatchStatement stores the scrutinee in a hidden local and lowers the arms to
+// a chain of if/else blocks, where each arm tests the local against its patterns.
+func compileMatchStatement(
+	ctx context.Context[parser.IMatchStatementContext],
+) (diverged bool, err error) {
+	matchScope, err := ctx.Scope.GetChildByParserRule(ctx.AST)
+	if err != nil {
+		return false, err
+	}
+	matchCtx := ctx.WithScope(matchScope)
+	local, err := matchScope.Resolve(ctx, "__match")
+	if err != nil {
+		return false, err
+	}
+	t := local.Type
+	if err = compileMatchOperand(matchCtx, ctx.AST.Expression(), t); err != nil {
+		return false, errors.Wrap(err, "failed to compile match value")
+	}
+	ctx.Writer.WriteLocalSet(local.ID)
+
+	var (
+		arms               = ctx.AST.AllMatchArm()
+		elseArm            = ctx.AST.MatchElse()
+		armCtx             = matchCtx
+		allBranchesDiverge = true
+	)
+	for i, arm := range arms {
+		if i > 0 {
+			ctx.Writer.WriteElse()
+		}
+		for j, pattern := range arm.AllExpression() {
+			ctx.Writer.WriteLocalGet(local.ID)
+			if err = compileMatchOperand(armCtx, pattern, t); err != nil {
+				return false, errors.Wrapf(err, "failed to compile match arm[%d] pattern", i)
+			}
+			if err = ctx.Writer.WriteBinaryOpInferred("==", t); err != nil {
+				return false, err
+			}
+			if j > 0 {
+				ctx.Writer.WriteOpcode(wasm.OpI32Or)
+			}
+		}
+		ctx.Writer.WriteIf(wasm.BlockTypeEmpty)
+		armCtx = armCtx.EnterBlock()
+		armDiverged, err := CompileBlock(context.Child(armCtx, arm.Block()))
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to compile match arm[%d]", i)
+		}
+		allBranchesDiverge = allBranchesDiverge && armDiverged
+	}
+	if elseArm != nil {
+		if len(arms) > 0 {
+			ctx.Writer.WriteElse()
+		}
+		elseDiverged, err := CompileBlock(context.Child(armCtx, elseArm.Block()))
+		if err != nil {
+			return false, errors.Wrap(err, "failed to compile match else arm")
+		}
+		allBranchesDiverge = allBranchesDiverge && elseDiverged
+	}
+	for range arms {
+		ctx.Writer.WriteEnd()
+	}
+	// Values outside the matched set skip every arm unless there is an else arm.
+	if elseArm != nil && allBranchesDiverge {
+		ctx.Writer.WriteUnreachable()
+		return true, nil
+	}
+	return false, nil
+}
+
+func compileMatchOperand(
+	ctx context.Context[parser.IMatchStatementContext],
+	expr parser.IExpressionContext,
+	target types.Type,
+) error {
+	compiledType, err := expression.Compile(context.Child(ctx, expr).WithHint(target))
+	if err != nil {
+		return err
+	}
+	if !types.Equal(compiledType, target) &&
+		wasm.ConvertType(compiledType) != wasm.ConvertType(target) {
+		return expression.EmitCast(ctx, compiledType, target)
+	}
+	return nil
+}
+
 func compileReturnStatement(ctx context.Context[parser.IReturnStatementContext]) error {
 	expr := ctx.AST.Expression()
 	defer ctx.Writer.WriteReturn()
 	if expr == nil {
 		return nil
 	}
-	exprType, err := expression.Compile(context.Child(ctx, expr))
-	if err != nil {
-		return errors.Wrap(err, "failed to compile return expression")
-	}
 	enclosingScope, err := ctx.Scope.ClosestAncestorOfKind(symbol.KindFunction)
 	if err != nil {
 		return errors.New("return statement not in function")
@@ -118,6 +201,13 @@ func compileReturnStatement(ctx context.Context[parser.IReturnStatementContext])
 		returnParam, _ := enclosingScope.Type.Outputs.Get(ir.DefaultOutputParam)
 		returnType = returnParam.Type
 	}
+	if returnType.IsStruct() {
+		return compileStructReturn(ctx, returnType)
+	}
+	exprType, err := expression.Compile(context.Child(ctx, expr))
+	if err != nil {
+		return errors.Wrap(err, "failed to compile return expression")
+	}
 	if !types.Equal(returnType, exprType) {
 		return expression.EmitCast(ctx, exprType, returnType)
 	}
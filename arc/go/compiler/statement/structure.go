@@ -0,0 +1,121 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package statement
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/synnaxlabs/arc/compiler/context"
+	"github.com/synnaxlabs/arc/compiler/expression"
+	"github.com/synnaxlabs/arc/compiler/wasm"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/errors"
+)
+
+// compileStructAssignment compiles an assignment to a struct-valued symbol, either of
+// the whole value (p = q) or of one of its fields (p.x = 1, s.start.x += 1).
+func compileStructAssignment(
+	ctx context.Context[parser.IAssignmentContext],
+	scope *symbol.Symbol,
+) error {
+	members := ctx.AST.AllMemberAccess()
+	names := make([]string, len(members))
+	for i, m := range members {
+		names[i] = m.IDENTIFIER().GetText()
+	}
+	base, err := expression.SymbolAddress(ctx, scope)
+	if err != nil {
+		return err
+	}
+	t, offset, leaf, err := expression.FieldPath(scope.Type, names)
+	if err != nil {
+		return err
+	}
+	addr := base + offset
+	if compoundOp := ctx.AST.CompoundOp(); compoundOp != nil {
+		if err = compileFieldCompoundAssignment(ctx, compoundOp, t, addr); err != nil {
+			return err
+		}
+	} else if err = expression.StoreValue(ctx, ctx.AST.Expression(), t, addr); err != nil {
+		return errors.Wrapf(err, "failed to compile assignment expression for '%s'", scope.Name)
+	}
+	if scope.Kind != symbol.KindOutput {
+		return nil
+	}
+	first, err := expression.OutputLeafIndex(ctx, scope.Name)
+	if err != nil {
+		return err
+	}
+	markOutputsDirty(ctx, first+leaf, t.LeafCount())
+	return nil
+}
+
+func compileFieldCompoundAssignment(
+	ctx context.Context[parser.IAssignmentContext],
+	compoundOp parser.ICompoundOpContext,
+	t types.Type,
+	addr uint32,
+) error {
+	ctx.Writer.WriteI32Const(int32(addr))
+	ctx.Writer.WriteI32Const(int32(addr))
+	if err := ctx.Writer.WriteLoad(t, 0); err != nil {
+		return err
+	}
+	exprType, err := expression.Compile(context.Child(ctx, ctx.AST.Expression()).WithHint(t))
+	if err != nil {
+		return err
+	}
+	if !types.Equal(t, exprType) {
+		if err = expression.EmitCast(ctx, exprType, t); err != nil {
+			return err
+		}
+	}
+	op := compoundOpToString(compoundOp)
+	if t.Kind == types.KindString && op == "+" {
+		ctx.Resolver.EmitStringConcat(ctx.Writer, ctx.WriterID)
+	} else if err = ctx.Writer.WriteBinaryOpInferred(op, t); err != nil {
+		return err
+	}
+	return ctx.Writer.WriteStore(t, 0)
+}
+
+// compileStructReturn copies a returned struct value into the output memory of the
+// enclosing function, where its flattened default output lives.
+func compileStructReturn(
+	ctx context.Context[parser.IReturnStatementContext],
+	t types.Type,
+) error {
+	if err := expression.StoreValue(
+		ctx,
+		ctx.AST.Expression(),
+		t,
+		ctx.OutputMemoryBase+8,
+	); err != nil {
+		return errors.Wrap(err, "failed to compile return expression")
+	}
+	markOutputsDirty(ctx, 0, t.LeafCount())
+	return nil
+}
+
+// markOutputsDirty sets count consecutive bits of the output dirty flags, starting at
+// the bit of output first.
+func markOutputsDirty[ASTNode antlr.ParserRuleContext](
+	ctx context.Context[ASTNode],
+	first, count int,
+) {
+	mask := int64(uint64(1)<<count-1) << first
+	ctx.Writer.WriteI32Const(int32(ctx.OutputMemoryBase))
+	ctx.Writer.WriteI32Const(int32(ctx.OutputMemoryBase))
+	ctx.Writer.WriteMemoryOp(wasm.OpI64Load, 3, 0)
+	ctx.Writer.WriteI64Const(mask)
+	ctx.Writer.WriteOpcode(wasm.OpI64Or)
+	ctx.Writer.WriteMemoryOp(wasm.OpI64Store, 3, 0)
+}
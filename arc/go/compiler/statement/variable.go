@@ -76,6 +76,17 @@ func compileLocalVariable(ctx context.Context[parser.ILocalVariableContext]) err
 		}
 	}
 
+	if varType.IsStruct() {
+		addr, err := expression.SymbolAddress(ctx, varScope)
+		if err != nil {
+			return err
+		}
+		if err = expression.StoreValue(ctx, ctx.AST.Expression(), varType, addr); err != nil {
+			return errors.Wrapf(err, "failed to compile initialization expression for '%s'", name)
+		}
+		return nil
+	}
+
 	exprCtx := context.Child(ctx, ctx.AST.Expression()).WithHint(varType)
 	exprType, err := expression.Compile(exprCtx)
 	if err != nil {
@@ -368,6 +379,10 @@ func compileAssignment(
 		return err
 	}
 
+	if len(ctx.AST.AllMemberAccess()) > 0 || scope.IsStructValue() {
+		return compileStructAssignment(ctx, scope)
+	}
+
 	if compoundOp := ctx.AST.CompoundOp(); compoundOp != nil {
 		return compileCompoundAssignment(ctx, scope, compoundOp)
 	}
@@ -497,37 +512,11 @@ func compileOutputAssignment(
 	// Stack: [address, value]
 
 	// Write the appropriate store instruction based on type
-	switch scope.Type.Kind {
-	case types.KindI8, types.KindU8:
-		ctx.Writer.WriteMemoryOp(wasm.OpI32Store8, 0, 0)
-	case types.KindI16, types.KindU16:
-		ctx.Writer.WriteMemoryOp(wasm.OpI32Store16, 1, 0)
-	case types.KindI32, types.KindU32:
-		ctx.Writer.WriteMemoryOp(wasm.OpI32Store, 2, 0)
-	case types.KindI64, types.KindU64:
-		ctx.Writer.WriteMemoryOp(wasm.OpI64Store, 3, 0)
-	case types.KindF32:
-		ctx.Writer.WriteMemoryOp(wasm.OpF32Store, 2, 0)
-	case types.KindF64:
-		ctx.Writer.WriteMemoryOp(wasm.OpF64Store, 3, 0)
-	case types.KindString:
-		ctx.Writer.WriteMemoryOp(wasm.OpI32Store, 2, 0)
-	default:
-		return errors.Newf("unsupported output type %v", scope.Type)
+	if err := ctx.Writer.WriteStore(scope.Type, 0); err != nil {
+		return errors.Wrapf(err, "unsupported output type %v", scope.Type)
 	}
 
 	// Step 5: Set the dirty flag bit
-	// dirty_flags |= (1 << outputIndex)
-	// Store instruction needs: [address, value]
-	ctx.Writer.WriteI32Const(int32(ctx.OutputMemoryBase))
-	// Load current flags, compute new value, store
-	ctx.Writer.WriteI32Const(int32(ctx.OutputMemoryBase))
-	ctx.Writer.WriteMemoryOp(wasm.OpI64Load, 3, 0)
-	ctx.Writer.WriteI64Const(1)
-	ctx.Writer.WriteI64Const(int64(outputIndex))
-	ctx.Writer.WriteOpcode(wasm.OpI64Shl)
-	ctx.Writer.WriteOpcode(wasm.OpI64Or)
-	// Stack: [address, new_value]
-	ctx.Writer.WriteMemoryOp(wasm.OpI64Store, 3, 0)
+	markOutputsDirty(ctx, outputIndex, 1)
 	return nil
 }
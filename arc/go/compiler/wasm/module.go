@@ -13,6 +13,9 @@ import (
 	"bytes"
 )
 
+// PageSize is the size in bytes of a WASM linear memory page.
+const PageSize = 64 * 1024
+
 // FunctionType represents a function signature
 type FunctionType struct {
 	Params  []ValueType
@@ -56,6 +59,9 @@ type Module struct {
 	buf        bytes.Buffer
 	dataOffset uint32
 	memory     bool
+	// memoryEnd is the end of the highest linear memory region reserved by the
+	// compiler, used to size the memory's initial page count.
+	memoryEnd uint32
 }
 
 // NewModule creates a new WASM module
@@ -138,6 +144,12 @@ func (m *Module) EnableMemory() {
 	m.memory = true
 }
 
+// ReserveMemory ensures the module's memory is initially large enough to hold
+// addresses up to (but excluding) end.
+func (m *Module) ReserveMemory(end uint32) {
+	m.memoryEnd = max(m.memoryEnd, end)
+}
+
 // AddData adds a data segment to the module and returns its offset in linear memory.
 // The data will be placed at the current dataOffset, which is then incremented.
 func (m *Module) AddData(bytes []byte) uint32 {
@@ -227,9 +239,9 @@ func (m *Module) writeMemorySection() {
 	var section bytes.Buffer
 	// Number of memories (1)
 	section.WriteByte(1)
-	// Memory limits (min 1 page, no max)
-	section.WriteByte(0)             // no max
-	writeUnsignedLEB128(&section, 1) // min 1 page
+	// Memory limits (min pages covering reserved memory, no max)
+	section.WriteByte(0) // no max
+	writeUnsignedLEB128(&section, uint64(max(1, (m.memoryEnd+PageSize-1)/PageSize)))
 	m.writeSection(SectionMemory, section.Bytes())
 }
 
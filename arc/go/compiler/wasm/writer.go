@@ -16,6 +16,7 @@ import (
 
 	"github.com/samber/lo"
 	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/errors"
 )
 
 // Writer handles low-level WASM instruction encoding
@@ -175,6 +176,55 @@ func (e *Writer) WriteMemoryOp(op Opcode, align, offset uint32) {
 	e.WriteLEB128Unsigned(uint64(offset))
 }
 
+// WriteLoad writes the load instruction that reads a value of the primitive type t
+// from the address on top of the stack plus offset. Strings load as their i32 handle.
+func (e *Writer) WriteLoad(t types.Type, offset uint32) error {
+	switch t.Kind {
+	case types.KindI8:
+		e.WriteMemoryOp(OpI32Load8S, 0, offset)
+	case types.KindU8:
+		e.WriteMemoryOp(OpI32Load8U, 0, offset)
+	case types.KindI16:
+		e.WriteMemoryOp(OpI32Load16S, 1, offset)
+	case types.KindU16:
+		e.WriteMemoryOp(OpI32Load16U, 1, offset)
+	case types.KindI32, types.KindU32, types.KindString:
+		e.WriteMemoryOp(OpI32Load, 2, offset)
+	case types.KindI64, types.KindU64:
+		e.WriteMemoryOp(OpI64Load, 3, offset)
+	case types.KindF32:
+		e.WriteMemoryOp(OpF32Load, 2, offset)
+	case types.KindF64:
+		e.WriteMemoryOp(OpF64Load, 3, offset)
+	default:
+		return errors.Newf("cannot load value of type %v from memory", t)
+	}
+	return nil
+}
+
+// WriteStore writes the store instruction that stores a value of the primitive type
+// t. The stack must hold [address, value]; the value is written to address plus
+// offset. Strings store as their i32 handle.
+func (e *Writer) WriteStore(t types.Type, offset uint32) error {
+	switch t.Kind {
+	case types.KindI8, types.KindU8:
+		e.WriteMemoryOp(OpI32Store8, 0, offset)
+	case types.KindI16, types.KindU16:
+		e.WriteMemoryOp(OpI32Store16, 1, offset)
+	case types.KindI32, types.KindU32, types.KindString:
+		e.WriteMemoryOp(OpI32Store, 2, offset)
+	case types.KindI64, types.KindU64:
+		e.WriteMemoryOp(OpI64Store, 3, offset)
+	case types.KindF32:
+		e.WriteMemoryOp(OpF32Store, 2, offset)
+	case types.KindF64:
+		e.WriteMemoryOp(OpF64Store, 3, offset)
+	default:
+		return errors.Newf("cannot store value of type %v to memory", t)
+	}
+	return nil
+}
+
 // writeBlockType writes a block type (for if/block/loop)
 func (e *Writer) writeBlockType(bt BlockType) {
 	if bt.empty {
@@ -0,0 +1,98 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package arc_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/stl/channels"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/telem"
+)
+
+var _ = Describe("DSP", func() {
+	lastF64 := func(h *runtimeHarness, key uint32) float64 {
+		out, _ := h.Flush()
+		series := out.Get(key).Series
+		Expect(series).ToNot(BeEmpty(), "channel %d not written", key)
+		vals := telem.UnmarshalSeries[float64](series[len(series)-1])
+		Expect(vals).ToNot(BeEmpty())
+		return vals[len(vals)-1]
+	}
+
+	step := func(ctx SpecContext, h *runtimeHarness, v float64) {
+		h.Ingest(100, telem.NewSeriesV(v))
+		h.Tick(ctx, telem.Millisecond)
+		h.channelState.ClearReads()
+	}
+
+	It("Should run a block as a flow node", func(ctx SpecContext) {
+		h := newRuntimeHarness(ctx, `import dsp
+sensor -> dsp.moving_avg{window=2} -> filtered`, channelSymbols(map[string]channelDef{
+			"sensor":   {types.F64(), 100},
+			"filtered": {types.F64(), 200},
+		}),
+			channels.Digest{Key: 100, DataType: telem.Float64T},
+			channels.Digest{Key: 200, DataType: telem.Float64T},
+		)
+		defer h.Close(ctx)
+
+		step(ctx, h, 10)
+		Expect(lastF64(h, 200)).To(BeNumerically("~", 10, 1e-9))
+		step(ctx, h, 20)
+		Expect(lastF64(h, 200)).To(BeNumerically("~", 15, 1e-9))
+		step(ctx, h, 40)
+		Expect(lastF64(h, 200)).To(BeNumerically("~", 30, 1e-9))
+	})
+
+	It("Should keep separate state for each call inside a function body", func(ctx SpecContext) {
+		h := newRuntimeHarness(ctx, `import dsp
+func smooth(x f64) f64 {
+    fast := dsp.moving_avg(x, 1)
+    slow := dsp.moving_avg(x, 3)
+    return fast - slow
+}
+
+sensor -> smooth{} -> spread`, channelSymbols(map[string]channelDef{
+			"sensor": {types.F64(), 100},
+			"spread": {types.F64(), 200},
+		}),
+			channels.Digest{Key: 100, DataType: telem.Float64T},
+			channels.Digest{Key: 200, DataType: telem.Float64T},
+		)
+		defer h.Close(ctx)
+
+		step(ctx, h, 3)
+		Expect(lastF64(h, 200)).To(BeNumerically("~", 0, 1e-9))
+		step(ctx, h, 6)
+		Expect(lastF64(h, 200)).To(BeNumerically("~", 6-4.5, 1e-9))
+		step(ctx, h, 9)
+		Expect(lastF64(h, 200)).To(BeNumerically("~", 9-6, 1e-9))
+	})
+
+	It("Should call the numeric math functions from a function body", func(ctx SpecContext) {
+		h := newRuntimeHarness(ctx, `import math
+func magnitude(x f64) f64 {
+    return math.sqrt(math.abs(x)) + math.hypot(3.0, 4.0) + f64(math.abs(i32(-2)))
+}
+
+sensor -> magnitude{} -> result`, channelSymbols(map[string]channelDef{
+			"sensor": {types.F64(), 100},
+			"result": {types.F64(), 200},
+		}),
+			channels.Digest{Key: 100, DataType: telem.Float64T},
+			channels.Digest{Key: 200, DataType: telem.Float64T},
+		)
+		defer h.Close(ctx)
+
+		step(ctx, h, -16)
+		Expect(lastF64(h, 200)).To(BeNumerically("~", 11, 1e-9))
+	})
+})
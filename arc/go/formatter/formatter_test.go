@@ -305,6 +305,21 @@ var _ = Describe("Formatter", func() {
 		Entry("globals before function", "A := 1\nB := 2\nfunc foo() {}", "A := 1\nB := 2\nfunc foo() {}\n"),
 	)
 
+	DescribeTable("Enums and Match",
+		func(input, expected string) {
+			Expect(formatter.Format(input)).To(Equal(expected))
+		},
+		Entry("enum declaration", "enum Mode{idle,press=3,fire}",
+			"enum Mode {\n    idle,\n    press = 3,\n    fire\n}\n"),
+		Entry("enum with underlying type", "enum Valve u8{closed,open}",
+			"enum Valve u8 {\n    closed,\n    open\n}\n"),
+		Entry("match with multiple patterns per arm",
+			"func f(x u8) {\nmatch x {\nMode.idle,Mode.press=>{}\nelse=>{}\n}\n}",
+			"func f(x u8) {\n    match x {\n        Mode.idle, Mode.press => {}\n        else => {}\n    }\n}\n"),
+		Entry("enum routing table", "mode=>{Mode.press:pressurize,Mode.fire:ignite}",
+			"mode => {\n    Mode.press: pressurize,\n    Mode.fire: ignite\n}\n"),
+	)
+
 	DescribeTable("Nested Structures",
 		func(input, expected string) {
 			Expect(formatter.Format(input)).To(Equal(expected))
@@ -423,6 +438,18 @@ var _ = Describe("Formatter", func() {
 		Entry("default at end moves to top", "authority (valve 100 vent 150 200)", "authority (\n    200\n    valve 100\n    vent 150\n)\n"),
 	)
 
+	DescribeTable("Safety Blocks",
+		func(input, expected string) {
+			Expect(formatter.Format(input)).To(Equal(expected))
+		},
+		Entry("simple safety", "safety valve {min=0,max=100}", "safety valve{min=0, max=100}\n"),
+		Entry("safety with interlock", "safety valve{interlock=pressure>500, safe_value=0}", "safety valve{interlock=pressure > 500, safe_value=0}\n"),
+		Entry("safety with grouped entries", "safety (valve{min=0} vent{max=1})", "safety (\n    valve{min=0}\n    vent{max=1}\n)\n"),
+		Entry("grouped safety over multiple lines", "safety (\nvalve{min=0}\nvent{max=-1.5}\n)", "safety (\n    valve{min=0}\n    vent{max=-1.5}\n)\n"),
+		Entry("safety after authority", "authority 200\nsafety valve{max=10}", "authority 200\nsafety valve{max=10}\n"),
+		Entry("grouped safety before function", "safety (valve{min=0})\nfunc foo(){}", "safety (\n    valve{min=0}\n)\nfunc foo() {}\n"),
+	)
+
 	DescribeTable("Malformed Input",
 		func(input, shouldContain string) {
 			result := formatter.Format(input)
@@ -25,6 +25,7 @@ const (
 	braceContextConfigValues
 	braceContextStageBody
 	braceContextSequenceBody
+	braceContextMatchBody
 )
 
 type parenContext int
@@ -34,6 +35,7 @@ const (
 	parenContextInputList
 	parenContextMultiOutput
 	parenContextAuthority
+	parenContextSafety
 	parenContextImport
 )
 
@@ -260,9 +262,9 @@ func (p *printer) emitToken(tok antlr.Token, idx int, tokens []antlr.Token, ca *
 	hasLeadingComments := len(leadingComments) > 0
 
 	if p.prevLine > 0 && tokLine > p.prevLine && !p.atLineStart {
-		if p.inAuthorityParenContext() {
-			// Skip general newline handling inside authority blocks;
-			// entry formatting handles newlines independently.
+		if p.inAuthorityParenContext() || p.inSafetyParenContext() {
+			// Skip general newline handling inside authority and safety
+			// blocks; entry formatting handles newlines independently.
 		} else if p.inCollapsedImportParen() {
 			// A collapsed single-item import renders on one logical line
 			// regardless of how the user wrote it; suppress source-line
@@ -320,12 +322,32 @@ func (p *printer) detectBraceContext(idx int, tokens []antlr.Token) braceContext
 	if p.isSequenceBody(idx, tokens) {
 		return braceContextSequenceBody
 	}
+	if p.isMatchBody(idx, tokens) {
+		return braceContextMatchBody
+	}
 	if p.isConfigValuesBlock(idx, tokens) {
 		return braceContextConfigValues
 	}
 	return braceContextBlock
 }
 
+// isMatchBody reports whether the brace at idx opens the arms of a match statement.
+// Arm patterns are comma-separated on a single line, so commas in a match body must
+// not break lines.
+func (p *printer) isMatchBody(idx int, tokens []antlr.Token) bool {
+	for i := idx - 1; i >= 0; i-- {
+		switch tokens[i].GetTokenType() {
+		case parser.ArcLexerMATCH:
+			return true
+		case parser.ArcLexerLBRACE, parser.ArcLexerRBRACE,
+			parser.ArcLexerTRANSITION, parser.ArcLexerARROW,
+			parser.ArcLexerDECLARE, parser.ArcLexerASSIGN, parser.ArcLexerRETURN:
+			return false
+		}
+	}
+	return false
+}
+
 func (p *printer) isStageBody(idx int, tokens []antlr.Token) bool {
 	if idx < 1 {
 		return false
@@ -407,7 +429,8 @@ func (p *printer) isConfigValuesBlock(idx int, tokens []antlr.Token) bool {
 		}
 		switch t {
 		case parser.ArcLexerIF, parser.ArcLexerFOR, parser.ArcLexerELSE,
-			parser.ArcLexerFUNC, parser.ArcLexerSTAGE, parser.ArcLexerSEQUENCE:
+			parser.ArcLexerFUNC, parser.ArcLexerSTAGE, parser.ArcLexerSEQUENCE,
+			parser.ArcLexerSTRUCT, parser.ArcLexerENUM:
 			return false
 		case parser.ArcLexerLBRACE, parser.ArcLexerRBRACE,
 			parser.ArcLexerCOLON, parser.ArcLexerARROW,
@@ -698,8 +721,8 @@ func (p *printer) handleOpenParen(idx int, tokens []antlr.Token) {
 		p.writeSpace()
 	}
 
-	// Authority paren blocks are always multiline
-	if ctx == parenContextAuthority {
+	// Authority and safety paren blocks are always multiline
+	if ctx == parenContextAuthority || ctx == parenContextSafety {
 		depth := len(p.parenContextStack)
 		p.multilineParens.Add(depth)
 		p.emitChar("(")
@@ -780,6 +803,9 @@ func (p *printer) detectParenContext(idx int, tokens []antlr.Token) parenContext
 	if idx > 0 && tokens[idx-1].GetTokenType() == parser.ArcLexerAUTHORITY {
 		return parenContextAuthority
 	}
+	if idx > 0 && tokens[idx-1].GetTokenType() == parser.ArcLexerSAFETY {
+		return parenContextSafety
+	}
 	if idx > 0 && tokens[idx-1].GetTokenType() == parser.ArcLexerIMPORT {
 		return parenContextImport
 	}
@@ -862,6 +888,13 @@ func (p *printer) inAuthorityParenContext() bool {
 	return p.parenContextStack[len(p.parenContextStack)-1] == parenContextAuthority
 }
 
+func (p *printer) inSafetyParenContext() bool {
+	if len(p.parenContextStack) == 0 {
+		return false
+	}
+	return p.parenContextStack[len(p.parenContextStack)-1] == parenContextSafety
+}
+
 func (p *printer) popParenContext() parenContext {
 	if len(p.parenContextStack) == 0 {
 		return parenContextDefault
@@ -1043,6 +1076,14 @@ func (p *printer) handleDefault(tok antlr.Token, idx int, tokens []antlr.Token)
 		p.writeNewline()
 	}
 
+	// In safety paren context, each entry ends with the RBRACE of its config
+	// values, so an IDENT after one starts the next entry.
+	if p.inSafetyParenContext() && !p.atLineStart &&
+		tokType == parser.ArcLexerIDENTIFIER &&
+		p.lastTokenType == parser.ArcLexerRBRACE {
+		p.writeNewline()
+	}
+
 	// In a multi-line import paren context, break lines between items.
 	// An item ends with an IDENT; the next IDENT or AUTHORITY starts a
 	// new item (a `.` or `as` continuation keeps the current item).
@@ -1072,7 +1113,8 @@ func (p *printer) handleDefault(tok antlr.Token, idx int, tokens []antlr.Token)
 
 func (p *printer) needsNewlineBefore(tokType int) bool {
 	switch tokType {
-	case parser.ArcLexerFUNC, parser.ArcLexerSEQUENCE, parser.ArcLexerAUTHORITY:
+	case parser.ArcLexerFUNC, parser.ArcLexerSEQUENCE, parser.ArcLexerAUTHORITY,
+		parser.ArcLexerSAFETY:
 		return p.prevToken != nil && p.lastTokenType != parser.ArcLexerRBRACE
 	case parser.ArcLexerSTAGE:
 		return p.prevToken != nil
@@ -1105,7 +1147,7 @@ func (p *printer) needsNewlineAfter(tokType int, idx int, tokens []antlr.Token)
 	case parser.ArcLexerRBRACE:
 		if nextType == parser.ArcLexerFUNC || nextType == parser.ArcLexerSEQUENCE ||
 			nextType == parser.ArcLexerSTAGE || nextType == parser.ArcLexerAUTHORITY ||
-			nextType == antlr.TokenEOF {
+			nextType == parser.ArcLexerSAFETY || nextType == antlr.TokenEOF {
 			return true
 		}
 	}
@@ -1228,7 +1270,8 @@ func (p *printer) isKeyword(tokType int) bool {
 		parser.ArcLexerFOR, parser.ArcLexerRETURN,
 		parser.ArcLexerSEQUENCE, parser.ArcLexerSTAGE,
 		parser.ArcLexerNEXT, parser.ArcLexerNOT, parser.ArcLexerAUTHORITY,
-		parser.ArcLexerIMPORT, parser.ArcLexerAS:
+		parser.ArcLexerSAFETY, parser.ArcLexerIMPORT, parser.ArcLexerAS,
+		parser.ArcLexerSTRUCT, parser.ArcLexerENUM, parser.ArcLexerMATCH:
 		return true
 	}
 	return false
@@ -1266,7 +1309,8 @@ func (p *printer) needsSpaceBeforeParen() bool {
 	switch p.lastTokenType {
 	case parser.ArcLexerIDENTIFIER:
 		return false
-	case parser.ArcLexerIF, parser.ArcLexerAUTHORITY, parser.ArcLexerIMPORT:
+	case parser.ArcLexerIF, parser.ArcLexerAUTHORITY, parser.ArcLexerSAFETY,
+		parser.ArcLexerIMPORT:
 		return true
 	case parser.ArcLexerRBRACE:
 		return true
@@ -1296,6 +1340,10 @@ func (p *printer) shouldBreakAfterComma() bool {
 	if p.inForHeader {
 		return false
 	}
+	if len(p.braceContextStack) > 0 &&
+		p.braceContextStack[len(p.braceContextStack)-1] == braceContextMatchBody {
+		return false
+	}
 	// Break after comma in multiline paren lists
 	if p.multilineParens.Contains(len(p.parenContextStack)) {
 		return true
@@ -132,7 +132,7 @@ func Analyze(
 			Type:     n.Type,
 			Channels: fnSym.Channels.Copy(),
 			Config:   freshType.Config,
-			Inputs:   freshType.Inputs,
+			Inputs:   fnSym.FlowInputs(freshType),
 			Outputs:  freshType.Outputs,
 		}
 		// Process provided config values
@@ -59,13 +59,14 @@ var _ = Describe("Codec", func() {
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_22": "value_22"},
+								Value: map[string]interface{}{"key_23": "value_23"},
 							},
 						},
 						Inputs: []types.Param{
 							{
-								Name: "test_24",
+								Name: "test_25",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -73,18 +74,19 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_30",
+									Name:          "test_31",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_35": "value_35"},
+								Value: map[string]interface{}{"key_37": "value_37"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name: "test_37",
+								Name: "test_39",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -92,34 +94,35 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_43",
+									Name:          "test_45",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_48": "value_48"},
+								Value: map[string]interface{}{"key_51": "value_51"},
 							},
 						},
 						Channels: types.Channels{
-							Read:  map[uint32]string{51: "test_50"},
-							Write: map[uint32]string{52: "test_51"},
+							Read:  map[uint32]string{54: "test_53"},
+							Write: map[uint32]string{55: "test_54"},
 						},
 					},
 				},
 				Edges: []ir.Edge{
 					{
-						Source: ir.Handle{Node: "test_54", Param: "test_55"},
-						Target: ir.Handle{Node: "test_57", Param: "test_58"},
+						Source: ir.Handle{Node: "test_57", Param: "test_58"},
+						Target: ir.Handle{Node: "test_60", Param: "test_61"},
 						Kind:   ir.EdgeKind(0),
 					},
 				},
 				Nodes: []graph.Node{
 					{
-						Key:      "test_61",
-						Type:     "test_62",
-						Config:   msgpack.EncodedJSON{"key_63": "value_63"},
-						Position: spatial.XY{X: 65.5, Y: 66.5},
+						Key:      "test_64",
+						Type:     "test_65",
+						Config:   msgpack.EncodedJSON{"key_66": "value_66"},
+						Position: spatial.XY{X: 68.5, Y: 69.5},
 					},
 				},
 			}),
@@ -201,13 +204,14 @@ func BenchmarkEncodeDecodeGraph(b *testing.B) {
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_22": "value_22"},
+						Value: map[string]interface{}{"key_23": "value_23"},
 					},
 				},
 				Inputs: []types.Param{
 					{
-						Name: "test_24",
+						Name: "test_25",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs:  []types.Param{{}},
@@ -215,18 +219,19 @@ func BenchmarkEncodeDecodeGraph(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_30",
+							Name:          "test_31",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_35": "value_35"},
+						Value: map[string]interface{}{"key_37": "value_37"},
 					},
 				},
 				Outputs: []types.Param{
 					{
-						Name: "test_37",
+						Name: "test_39",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs:  []types.Param{{}},
@@ -234,34 +239,35 @@ func BenchmarkEncodeDecodeGraph(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_43",
+							Name:          "test_45",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_48": "value_48"},
+						Value: map[string]interface{}{"key_51": "value_51"},
 					},
 				},
 				Channels: types.Channels{
-					Read:  map[uint32]string{51: "test_50"},
-					Write: map[uint32]string{52: "test_51"},
+					Read:  map[uint32]string{54: "test_53"},
+					Write: map[uint32]string{55: "test_54"},
 				},
 			},
 		},
 		Edges: []ir.Edge{
 			{
-				Source: ir.Handle{Node: "test_54", Param: "test_55"},
-				Target: ir.Handle{Node: "test_57", Param: "test_58"},
+				Source: ir.Handle{Node: "test_57", Param: "test_58"},
+				Target: ir.Handle{Node: "test_60", Param: "test_61"},
 				Kind:   ir.EdgeKind(0),
 			},
 		},
 		Nodes: []graph.Node{
 			{
-				Key:      "test_61",
-				Type:     "test_62",
-				Config:   msgpack.EncodedJSON{"key_63": "value_63"},
-				Position: spatial.XY{X: 65.5, Y: 66.5},
+				Key:      "test_64",
+				Type:     "test_65",
+				Config:   msgpack.EncodedJSON{"key_66": "value_66"},
+				Position: spatial.XY{X: 68.5, Y: 69.5},
 			},
 		},
 	}
@@ -342,13 +348,14 @@ func FuzzDecodeGraph(f *testing.F) {
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_22": "value_22"},
+							Value: map[string]interface{}{"key_23": "value_23"},
 						},
 					},
 					Inputs: []types.Param{
 						{
-							Name: "test_24",
+							Name: "test_25",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -356,18 +363,19 @@ func FuzzDecodeGraph(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_30",
+								Name:          "test_31",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_35": "value_35"},
+							Value: map[string]interface{}{"key_37": "value_37"},
 						},
 					},
 					Outputs: []types.Param{
 						{
-							Name: "test_37",
+							Name: "test_39",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -375,34 +383,35 @@ func FuzzDecodeGraph(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_43",
+								Name:          "test_45",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_48": "value_48"},
+							Value: map[string]interface{}{"key_51": "value_51"},
 						},
 					},
 					Channels: types.Channels{
-						Read:  map[uint32]string{51: "test_50"},
-						Write: map[uint32]string{52: "test_51"},
+						Read:  map[uint32]string{54: "test_53"},
+						Write: map[uint32]string{55: "test_54"},
 					},
 				},
 			},
 			Edges: []ir.Edge{
 				{
-					Source: ir.Handle{Node: "test_54", Param: "test_55"},
-					Target: ir.Handle{Node: "test_57", Param: "test_58"},
+					Source: ir.Handle{Node: "test_57", Param: "test_58"},
+					Target: ir.Handle{Node: "test_60", Param: "test_61"},
 					Kind:   ir.EdgeKind(0),
 				},
 			},
 			Nodes: []graph.Node{
 				{
-					Key:      "test_61",
-					Type:     "test_62",
-					Config:   msgpack.EncodedJSON{"key_63": "value_63"},
-					Position: spatial.XY{X: 65.5, Y: 66.5},
+					Key:      "test_64",
+					Type:     "test_65",
+					Config:   msgpack.EncodedJSON{"key_66": "value_66"},
+					Position: spatial.XY{X: 68.5, Y: 69.5},
 				},
 			},
 		}
@@ -117,6 +117,130 @@ func (e *Edge) DecodeOrc(r *orc.Reader) error {
 	return nil
 }
 
+func (e Envelope) EncodeOrc(w *orc.Writer) error {
+	w.Uint32(uint32(e.Channel))
+	if e.Min != nil {
+		w.Bool(true)
+		w.Float64(float64((*e.Min)))
+	} else {
+		w.Bool(false)
+	}
+	if e.Max != nil {
+		w.Bool(true)
+		w.Float64(float64((*e.Max)))
+	} else {
+		w.Bool(false)
+	}
+	if e.MaxRate != nil {
+		w.Bool(true)
+		w.Float64(float64((*e.MaxRate)))
+	} else {
+		w.Bool(false)
+	}
+	w.Bool(e.Interlocks != nil)
+	if e.Interlocks != nil {
+		w.Uint32(uint32(len(e.Interlocks)))
+		for i := range e.Interlocks {
+			if err := e.Interlocks[i].EncodeOrc(w); err != nil {
+				return err
+			}
+		}
+	}
+	if e.SafeValue != nil {
+		w.Bool(true)
+		w.Float64(float64((*e.SafeValue)))
+	} else {
+		w.Bool(false)
+	}
+	w.String(e.SafeSequence)
+	w.String(e.SafeStage)
+	return nil
+}
+
+func (e *Envelope) DecodeOrc(r *orc.Reader) error {
+	var err error
+	if e.Channel, err = r.Uint32(); err != nil {
+		return err
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			var hv float64
+			if hv, err = r.Float64(); err != nil {
+				return err
+			}
+			e.Min = &hv
+		}
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			var hv float64
+			if hv, err = r.Float64(); err != nil {
+				return err
+			}
+			e.Max = &hv
+		}
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			var hv float64
+			if hv, err = r.Float64(); err != nil {
+				return err
+			}
+			e.MaxRate = &hv
+		}
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			n, err := r.CollectionLen()
+			if err != nil {
+				return err
+			}
+			e.Interlocks = make([]Interlock, n)
+			for i := range e.Interlocks {
+				if err = e.Interlocks[i].DecodeOrc(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			var hv float64
+			if hv, err = r.Float64(); err != nil {
+				return err
+			}
+			e.SafeValue = &hv
+		}
+	}
+	if e.SafeSequence, err = r.String(); err != nil {
+		return err
+	}
+	if e.SafeStage, err = r.String(); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (f Function) EncodeOrc(w *orc.Writer) error {
 	w.String(f.Key)
 	if err := f.Body.EncodeOrc(w); err != nil {
@@ -274,6 +398,15 @@ func (ir IR) EncodeOrc(w *orc.Writer) error {
 	if err := ir.Root.EncodeOrc(w); err != nil {
 		return err
 	}
+	w.Bool(ir.Safety != nil)
+	if ir.Safety != nil {
+		w.Uint32(uint32(len(ir.Safety)))
+		for i := range ir.Safety {
+			if err := ir.Safety[i].EncodeOrc(w); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -339,6 +472,45 @@ func (ir *IR) DecodeOrc(r *orc.Reader) error {
 	if err = ir.Root.DecodeOrc(r); err != nil {
 		return err
 	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			n, err := r.CollectionLen()
+			if err != nil {
+				return err
+			}
+			ir.Safety = make([]Envelope, n)
+			for i := range ir.Safety {
+				if err = ir.Safety[i].DecodeOrc(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (iv Interlock) EncodeOrc(w *orc.Writer) error {
+	w.Uint32(uint32(iv.Channel))
+	w.String(iv.Comparison)
+	w.Float64(float64(iv.Value))
+	return nil
+}
+
+func (iv *Interlock) DecodeOrc(r *orc.Reader) error {
+	var err error
+	if iv.Channel, err = r.Uint32(); err != nil {
+		return err
+	}
+	if iv.Comparison, err = r.String(); err != nil {
+		return err
+	}
+	if iv.Value, err = r.Float64(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -84,6 +84,53 @@ var _ = Describe("Codec", func() {
 			}),
 		)
 	})
+	Describe("Envelope", func() {
+		DescribeTable("should round-trip encode and decode",
+			func(original ir.Envelope) {
+				w := orc.NewWriter(0)
+				Expect(original.EncodeOrc(w)).To(Succeed())
+				var decoded ir.Envelope
+				r := orc.NewReader(nil)
+				r.ResetBytes(w.Bytes())
+				Expect(decoded.DecodeOrc(r)).To(Succeed())
+				Expect(decoded).To(Equal(original))
+			},
+			Entry("fully populated", ir.Envelope{
+				Channel: 2,
+				Min:     func() *float64 { v := float64(2.5); return &v }(),
+				Max:     func() *float64 { v := float64(3.5); return &v }(),
+				MaxRate: func() *float64 { v := float64(4.5); return &v }(),
+				Interlocks: []ir.Interlock{{
+					Channel:    7,
+					Comparison: "test_7",
+					Value:      8.5,
+				}},
+				SafeValue:    func() *float64 { v := float64(9.5); return &v }(),
+				SafeSequence: "test_10",
+				SafeStage:    "test_11",
+			}),
+			Entry("zero values", ir.Envelope{
+				Channel:      0,
+				Min:          nil,
+				Max:          nil,
+				MaxRate:      nil,
+				Interlocks:   nil,
+				SafeValue:    nil,
+				SafeSequence: "",
+				SafeStage:    "",
+			}),
+			Entry("empty collections", ir.Envelope{
+				Channel:      2,
+				Min:          func() *float64 { v := float64(2.5); return &v }(),
+				Max:          func() *float64 { v := float64(3.5); return &v }(),
+				MaxRate:      func() *float64 { v := float64(4.5); return &v }(),
+				Interlocks:   []ir.Interlock{},
+				SafeValue:    func() *float64 { v := float64(6.5); return &v }(),
+				SafeSequence: "test_7",
+				SafeStage:    "test_8",
+			}),
+		)
+	})
 	Describe("Function", func() {
 		DescribeTable("should round-trip encode and decode",
 			func(original ir.Function) {
@@ -140,14 +187,15 @@ var _ = Describe("Codec", func() {
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      33.5,
-									Name:       "test_34",
+									Scale:      34.5,
+									Name:       "test_35",
 								}
 								return &v
 							}(),
@@ -159,48 +207,56 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_40",
+									Name:          "test_41",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_49",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_51": "value_51"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_46": "value_46"},
+						Value: map[string]interface{}{"key_52": "value_52"},
 					},
 				},
 				Inputs: []types.Param{
 					{
-						Name: "test_48",
+						Name: "test_54",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_51",
+										Name:  "test_57",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_53": "value_53"},
+										Value: map[string]interface{}{"key_59": "value_59"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_55",
+										Name:  "test_61",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_57": "value_57"},
+										Value: map[string]interface{}{"key_63": "value_63"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_59",
+										Name:  "test_65",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_61": "value_61"},
+										Value: map[string]interface{}{"key_67": "value_67"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_63",
+							Name: "test_69",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -209,19 +265,20 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_69",
+									Name:          "test_75",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      76.5,
-									Name:       "test_77",
+									Scale:      83.5,
+									Name:       "test_84",
 								}
 								return &v
 							}(),
@@ -233,48 +290,56 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_83",
+									Name:          "test_90",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_98",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_100": "value_100"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_89": "value_89"},
+						Value: map[string]interface{}{"key_101": "value_101"},
 					},
 				},
 				Outputs: []types.Param{
 					{
-						Name: "test_91",
+						Name: "test_103",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_94",
+										Name:  "test_106",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_96": "value_96"},
+										Value: map[string]interface{}{"key_108": "value_108"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_98",
+										Name:  "test_110",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_100": "value_100"},
+										Value: map[string]interface{}{"key_112": "value_112"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_102",
+										Name:  "test_114",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_104": "value_104"},
+										Value: map[string]interface{}{"key_116": "value_116"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_106",
+							Name: "test_118",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -283,19 +348,20 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_112",
+									Name:          "test_124",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      119.5,
-									Name:       "test_120",
+									Scale:      132.5,
+									Name:       "test_133",
 								}
 								return &v
 							}(),
@@ -307,22 +373,30 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_126",
+									Name:          "test_139",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_147",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_149": "value_149"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_132": "value_132"},
+						Value: map[string]interface{}{"key_150": "value_150"},
 					},
 				},
 				Channels: types.Channels{
-					Read:  map[uint32]string{135: "test_134"},
-					Write: map[uint32]string{136: "test_135"},
+					Read:  map[uint32]string{153: "test_152"},
+					Write: map[uint32]string{154: "test_153"},
 				},
 			}),
 			Entry("zero values", ir.Function{
@@ -389,13 +463,14 @@ var _ = Describe("Codec", func() {
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_17": "value_17"},
+								Value: map[string]interface{}{"key_18": "value_18"},
 							},
 						},
 						Inputs: []types.Param{
 							{
-								Name: "test_19",
+								Name: "test_20",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -403,18 +478,19 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_25",
+									Name:          "test_26",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_30": "value_30"},
+								Value: map[string]interface{}{"key_32": "value_32"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name: "test_32",
+								Name: "test_34",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -422,28 +498,29 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_38",
+									Name:          "test_40",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_43": "value_43"},
+								Value: map[string]interface{}{"key_46": "value_46"},
 							},
 						},
 						Channels: types.Channels{
-							Read:  map[uint32]string{46: "test_45"},
-							Write: map[uint32]string{47: "test_46"},
+							Read:  map[uint32]string{49: "test_48"},
+							Write: map[uint32]string{50: "test_49"},
 						},
 					},
 				},
 				Nodes: []ir.Node{
 					{
-						Key:  "test_48",
-						Type: "test_49",
+						Key:  "test_51",
+						Type: "test_52",
 						Config: []types.Param{
 							{
-								Name: "test_51",
+								Name: "test_54",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -451,18 +528,19 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_57",
+									Name:          "test_60",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_62": "value_62"},
+								Value: map[string]interface{}{"key_66": "value_66"},
 							},
 						},
 						Inputs: []types.Param{
 							{
-								Name: "test_64",
+								Name: "test_68",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -470,18 +548,19 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_70",
+									Name:          "test_74",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_75": "value_75"},
+								Value: map[string]interface{}{"key_80": "value_80"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name: "test_77",
+								Name: "test_82",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -489,44 +568,45 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_83",
+									Name:          "test_88",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_88": "value_88"},
+								Value: map[string]interface{}{"key_94": "value_94"},
 							},
 						},
 						Channels: types.Channels{
-							Read:  map[uint32]string{91: "test_90"},
-							Write: map[uint32]string{92: "test_91"},
+							Read:  map[uint32]string{97: "test_96"},
+							Write: map[uint32]string{98: "test_97"},
 						},
 					},
 				},
 				Edges: []ir.Edge{
 					{
-						Source: ir.Handle{Node: "test_94", Param: "test_95"},
-						Target: ir.Handle{Node: "test_97", Param: "test_98"},
+						Source: ir.Handle{Node: "test_100", Param: "test_101"},
+						Target: ir.Handle{Node: "test_103", Param: "test_104"},
 						Kind:   ir.EdgeKind(0),
 					},
 				},
 				Authorities: ir.Authorities{
-					Default:  func() *uint8 { v := uint8(102); return &v }(),
-					Channels: map[uint32]uint8{103: 103},
+					Default:  func() *uint8 { v := uint8(108); return &v }(),
+					Channels: map[uint32]uint8{109: 109},
 				},
 				Root: ir.Scope{
-					Key:        "test_104",
+					Key:        "test_110",
 					Mode:       ir.ScopeMode(0),
 					Liveness:   ir.Liveness(0),
-					Activation: func() *ir.Handle { v := ir.Handle{Node: "test_108", Param: "test_109"}; return &v }(),
+					Activation: func() *ir.Handle { v := ir.Handle{Node: "test_114", Param: "test_115"}; return &v }(),
 					Strata: [][]ir.Member{
 						{
 							{
-								NodeKey: func() *string { v := string("test_111"); return &v }(),
+								NodeKey: func() *string { v := string("test_117"); return &v }(),
 								Scope: func() *ir.Scope {
 									v := ir.Scope{
-										Key:         "test_113",
+										Key:         "test_119",
 										Mode:        ir.ScopeMode(0),
 										Liveness:    ir.Liveness(0),
 										Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -541,10 +621,10 @@ var _ = Describe("Codec", func() {
 					},
 					Steps: []ir.Member{
 						{
-							NodeKey: func() *string { v := string("test_121"); return &v }(),
+							NodeKey: func() *string { v := string("test_127"); return &v }(),
 							Scope: func() *ir.Scope {
 								v := ir.Scope{
-									Key:         "test_123",
+									Key:         "test_129",
 									Mode:        ir.ScopeMode(0),
 									Liveness:    ir.Liveness(0),
 									Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -558,11 +638,29 @@ var _ = Describe("Codec", func() {
 					},
 					Transitions: []ir.Transition{
 						{
-							On:        ir.Handle{Node: "test_132", Param: "test_133"},
-							TargetKey: func() *string { v := string("test_134"); return &v }(),
+							On:        ir.Handle{Node: "test_138", Param: "test_139"},
+							TargetKey: func() *string { v := string("test_140"); return &v }(),
 						},
 					},
 				},
+				Safety: []ir.Envelope{
+					{
+						Channel: 143,
+						Min:     func() *float64 { v := float64(143.5); return &v }(),
+						Max:     func() *float64 { v := float64(144.5); return &v }(),
+						MaxRate: func() *float64 { v := float64(145.5); return &v }(),
+						Interlocks: []ir.Interlock{
+							{
+								Channel:    148,
+								Comparison: "test_148",
+								Value:      149.5,
+							},
+						},
+						SafeValue:    func() *float64 { v := float64(150.5); return &v }(),
+						SafeSequence: "test_151",
+						SafeStage:    "test_152",
+					},
+				},
 			}),
 			Entry("zero values", ir.IR{
 				Functions:   nil,
@@ -578,6 +676,7 @@ var _ = Describe("Codec", func() {
 					Steps:       nil,
 					Transitions: nil,
 				},
+				Safety: nil,
 			}),
 			Entry("empty collections", ir.IR{
 				Functions: []ir.Function{},
@@ -596,6 +695,30 @@ var _ = Describe("Codec", func() {
 					Steps:       []ir.Member{},
 					Transitions: []ir.Transition{},
 				},
+				Safety: []ir.Envelope{},
+			}),
+		)
+	})
+	Describe("Interlock", func() {
+		DescribeTable("should round-trip encode and decode",
+			func(original ir.Interlock) {
+				w := orc.NewWriter(0)
+				Expect(original.EncodeOrc(w)).To(Succeed())
+				var decoded ir.Interlock
+				r := orc.NewReader(nil)
+				r.ResetBytes(w.Bytes())
+				Expect(decoded.DecodeOrc(r)).To(Succeed())
+				Expect(decoded).To(Equal(original))
+			},
+			Entry("fully populated", ir.Interlock{
+				Channel:    2,
+				Comparison: "test_2",
+				Value:      3.5,
+			}),
+			Entry("zero values", ir.Interlock{
+				Channel:    0,
+				Comparison: "",
+				Value:      0,
 			}),
 		)
 	})
@@ -723,14 +846,15 @@ var _ = Describe("Codec", func() {
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      32.5,
-									Name:       "test_33",
+									Scale:      33.5,
+									Name:       "test_34",
 								}
 								return &v
 							}(),
@@ -742,48 +866,56 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_39",
+									Name:          "test_40",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_48",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_50": "value_50"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_45": "value_45"},
+						Value: map[string]interface{}{"key_51": "value_51"},
 					},
 				},
 				Inputs: []types.Param{
 					{
-						Name: "test_47",
+						Name: "test_53",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_50",
+										Name:  "test_56",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_52": "value_52"},
+										Value: map[string]interface{}{"key_58": "value_58"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_54",
+										Name:  "test_60",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_56": "value_56"},
+										Value: map[string]interface{}{"key_62": "value_62"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_58",
+										Name:  "test_64",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_60": "value_60"},
+										Value: map[string]interface{}{"key_66": "value_66"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_62",
+							Name: "test_68",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -792,19 +924,20 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_68",
+									Name:          "test_74",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      75.5,
-									Name:       "test_76",
+									Scale:      82.5,
+									Name:       "test_83",
 								}
 								return &v
 							}(),
@@ -816,48 +949,56 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_82",
+									Name:          "test_89",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_97",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_99": "value_99"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_88": "value_88"},
+						Value: map[string]interface{}{"key_100": "value_100"},
 					},
 				},
 				Outputs: []types.Param{
 					{
-						Name: "test_90",
+						Name: "test_102",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_93",
+										Name:  "test_105",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_95": "value_95"},
+										Value: map[string]interface{}{"key_107": "value_107"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_97",
+										Name:  "test_109",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_99": "value_99"},
+										Value: map[string]interface{}{"key_111": "value_111"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_101",
+										Name:  "test_113",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_103": "value_103"},
+										Value: map[string]interface{}{"key_115": "value_115"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_105",
+							Name: "test_117",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -866,19 +1007,20 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_111",
+									Name:          "test_123",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      118.5,
-									Name:       "test_119",
+									Scale:      131.5,
+									Name:       "test_132",
 								}
 								return &v
 							}(),
@@ -890,22 +1032,30 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_125",
+									Name:          "test_138",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_146",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_148": "value_148"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_131": "value_131"},
+						Value: map[string]interface{}{"key_149": "value_149"},
 					},
 				},
 				Channels: types.Channels{
-					Read:  map[uint32]string{134: "test_133"},
-					Write: map[uint32]string{135: "test_134"},
+					Read:  map[uint32]string{152: "test_151"},
+					Write: map[uint32]string{153: "test_152"},
 				},
 			}),
 			Entry("zero values", ir.Node{
@@ -1117,6 +1267,36 @@ func BenchmarkEncodeDecodeEdge(b *testing.B) {
 	}
 }
 
+func BenchmarkEncodeDecodeEnvelope(b *testing.B) {
+	e := ir.Envelope{
+		Channel: 2,
+		Min:     func() *float64 { v := float64(2.5); return &v }(),
+		Max:     func() *float64 { v := float64(3.5); return &v }(),
+		MaxRate: func() *float64 { v := float64(4.5); return &v }(),
+		Interlocks: []ir.Interlock{{
+			Channel:    7,
+			Comparison: "test_7",
+			Value:      8.5,
+		}},
+		SafeValue:    func() *float64 { v := float64(9.5); return &v }(),
+		SafeSequence: "test_10",
+		SafeStage:    "test_11",
+	}
+	w := orc.NewWriter(0)
+	r := orc.NewReader(nil)
+	for i := 0; i < b.N; i++ {
+		w.Reset()
+		if err := e.EncodeOrc(w); err != nil {
+			b.Fatal(err)
+		}
+		var decoded ir.Envelope
+		r.ResetBytes(w.Bytes())
+		if err := decoded.DecodeOrc(r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkEncodeDecodeFunction(b *testing.B) {
 	f := ir.Function{
 		Key:  "test_1",
@@ -1163,14 +1343,15 @@ func BenchmarkEncodeDecodeFunction(b *testing.B) {
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      33.5,
-							Name:       "test_34",
+							Scale:      34.5,
+							Name:       "test_35",
 						}
 						return &v
 					}(),
@@ -1182,48 +1363,56 @@ func BenchmarkEncodeDecodeFunction(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_40",
+							Name:          "test_41",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_49",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_51": "value_51"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_46": "value_46"},
+				Value: map[string]interface{}{"key_52": "value_52"},
 			},
 		},
 		Inputs: []types.Param{
 			{
-				Name: "test_48",
+				Name: "test_54",
 				Type: types.Type{
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name:  "test_51",
+								Name:  "test_57",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_53": "value_53"},
+								Value: map[string]interface{}{"key_59": "value_59"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name:  "test_55",
+								Name:  "test_61",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_57": "value_57"},
+								Value: map[string]interface{}{"key_63": "value_63"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name:  "test_59",
+								Name:  "test_65",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_61": "value_61"},
+								Value: map[string]interface{}{"key_67": "value_67"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_63",
+					Name: "test_69",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
@@ -1232,19 +1421,20 @@ func BenchmarkEncodeDecodeFunction(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_69",
+							Name:          "test_75",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      76.5,
-							Name:       "test_77",
+							Scale:      83.5,
+							Name:       "test_84",
 						}
 						return &v
 					}(),
@@ -1256,48 +1446,56 @@ func BenchmarkEncodeDecodeFunction(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_83",
+							Name:          "test_90",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_98",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_100": "value_100"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_89": "value_89"},
+				Value: map[string]interface{}{"key_101": "value_101"},
 			},
 		},
 		Outputs: []types.Param{
 			{
-				Name: "test_91",
+				Name: "test_103",
 				Type: types.Type{
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name:  "test_94",
+								Name:  "test_106",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_96": "value_96"},
+								Value: map[string]interface{}{"key_108": "value_108"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name:  "test_98",
+								Name:  "test_110",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_100": "value_100"},
+								Value: map[string]interface{}{"key_112": "value_112"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name:  "test_102",
+								Name:  "test_114",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_104": "value_104"},
+								Value: map[string]interface{}{"key_116": "value_116"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_106",
+					Name: "test_118",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
@@ -1306,19 +1504,20 @@ func BenchmarkEncodeDecodeFunction(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_112",
+							Name:          "test_124",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      119.5,
-							Name:       "test_120",
+							Scale:      132.5,
+							Name:       "test_133",
 						}
 						return &v
 					}(),
@@ -1330,22 +1529,30 @@ func BenchmarkEncodeDecodeFunction(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_126",
+							Name:          "test_139",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_147",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_149": "value_149"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_132": "value_132"},
+				Value: map[string]interface{}{"key_150": "value_150"},
 			},
 		},
 		Channels: types.Channels{
-			Read:  map[uint32]string{135: "test_134"},
-			Write: map[uint32]string{136: "test_135"},
+			Read:  map[uint32]string{153: "test_152"},
+			Write: map[uint32]string{154: "test_153"},
 		},
 	}
 	w := orc.NewWriter(0)
@@ -1401,13 +1608,14 @@ func BenchmarkEncodeDecodeIR(b *testing.B) {
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_17": "value_17"},
+						Value: map[string]interface{}{"key_18": "value_18"},
 					},
 				},
 				Inputs: []types.Param{
 					{
-						Name: "test_19",
+						Name: "test_20",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs:  []types.Param{{}},
@@ -1415,18 +1623,19 @@ func BenchmarkEncodeDecodeIR(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_25",
+							Name:          "test_26",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_30": "value_30"},
+						Value: map[string]interface{}{"key_32": "value_32"},
 					},
 				},
 				Outputs: []types.Param{
 					{
-						Name: "test_32",
+						Name: "test_34",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs:  []types.Param{{}},
@@ -1434,28 +1643,29 @@ func BenchmarkEncodeDecodeIR(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_38",
+							Name:          "test_40",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_43": "value_43"},
+						Value: map[string]interface{}{"key_46": "value_46"},
 					},
 				},
 				Channels: types.Channels{
-					Read:  map[uint32]string{46: "test_45"},
-					Write: map[uint32]string{47: "test_46"},
+					Read:  map[uint32]string{49: "test_48"},
+					Write: map[uint32]string{50: "test_49"},
 				},
 			},
 		},
 		Nodes: []ir.Node{
 			{
-				Key:  "test_48",
-				Type: "test_49",
+				Key:  "test_51",
+				Type: "test_52",
 				Config: []types.Param{
 					{
-						Name: "test_51",
+						Name: "test_54",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs:  []types.Param{{}},
@@ -1463,18 +1673,19 @@ func BenchmarkEncodeDecodeIR(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_57",
+							Name:          "test_60",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_62": "value_62"},
+						Value: map[string]interface{}{"key_66": "value_66"},
 					},
 				},
 				Inputs: []types.Param{
 					{
-						Name: "test_64",
+						Name: "test_68",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs:  []types.Param{{}},
@@ -1482,18 +1693,19 @@ func BenchmarkEncodeDecodeIR(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_70",
+							Name:          "test_74",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_75": "value_75"},
+						Value: map[string]interface{}{"key_80": "value_80"},
 					},
 				},
 				Outputs: []types.Param{
 					{
-						Name: "test_77",
+						Name: "test_82",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs:  []types.Param{{}},
@@ -1501,44 +1713,45 @@ func BenchmarkEncodeDecodeIR(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_83",
+							Name:          "test_88",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_88": "value_88"},
+						Value: map[string]interface{}{"key_94": "value_94"},
 					},
 				},
 				Channels: types.Channels{
-					Read:  map[uint32]string{91: "test_90"},
-					Write: map[uint32]string{92: "test_91"},
+					Read:  map[uint32]string{97: "test_96"},
+					Write: map[uint32]string{98: "test_97"},
 				},
 			},
 		},
 		Edges: []ir.Edge{
 			{
-				Source: ir.Handle{Node: "test_94", Param: "test_95"},
-				Target: ir.Handle{Node: "test_97", Param: "test_98"},
+				Source: ir.Handle{Node: "test_100", Param: "test_101"},
+				Target: ir.Handle{Node: "test_103", Param: "test_104"},
 				Kind:   ir.EdgeKind(0),
 			},
 		},
 		Authorities: ir.Authorities{
-			Default:  func() *uint8 { v := uint8(102); return &v }(),
-			Channels: map[uint32]uint8{103: 103},
+			Default:  func() *uint8 { v := uint8(108); return &v }(),
+			Channels: map[uint32]uint8{109: 109},
 		},
 		Root: ir.Scope{
-			Key:        "test_104",
+			Key:        "test_110",
 			Mode:       ir.ScopeMode(0),
 			Liveness:   ir.Liveness(0),
-			Activation: func() *ir.Handle { v := ir.Handle{Node: "test_108", Param: "test_109"}; return &v }(),
+			Activation: func() *ir.Handle { v := ir.Handle{Node: "test_114", Param: "test_115"}; return &v }(),
 			Strata: [][]ir.Member{
 				{
 					{
-						NodeKey: func() *string { v := string("test_111"); return &v }(),
+						NodeKey: func() *string { v := string("test_117"); return &v }(),
 						Scope: func() *ir.Scope {
 							v := ir.Scope{
-								Key:         "test_113",
+								Key:         "test_119",
 								Mode:        ir.ScopeMode(0),
 								Liveness:    ir.Liveness(0),
 								Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -1553,10 +1766,10 @@ func BenchmarkEncodeDecodeIR(b *testing.B) {
 			},
 			Steps: []ir.Member{
 				{
-					NodeKey: func() *string { v := string("test_121"); return &v }(),
+					NodeKey: func() *string { v := string("test_127"); return &v }(),
 					Scope: func() *ir.Scope {
 						v := ir.Scope{
-							Key:         "test_123",
+							Key:         "test_129",
 							Mode:        ir.ScopeMode(0),
 							Liveness:    ir.Liveness(0),
 							Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -1570,11 +1783,29 @@ func BenchmarkEncodeDecodeIR(b *testing.B) {
 			},
 			Transitions: []ir.Transition{
 				{
-					On:        ir.Handle{Node: "test_132", Param: "test_133"},
-					TargetKey: func() *string { v := string("test_134"); return &v }(),
+					On:        ir.Handle{Node: "test_138", Param: "test_139"},
+					TargetKey: func() *string { v := string("test_140"); return &v }(),
 				},
 			},
 		},
+		Safety: []ir.Envelope{
+			{
+				Channel: 143,
+				Min:     func() *float64 { v := float64(143.5); return &v }(),
+				Max:     func() *float64 { v := float64(144.5); return &v }(),
+				MaxRate: func() *float64 { v := float64(145.5); return &v }(),
+				Interlocks: []ir.Interlock{
+					{
+						Channel:    148,
+						Comparison: "test_148",
+						Value:      149.5,
+					},
+				},
+				SafeValue:    func() *float64 { v := float64(150.5); return &v }(),
+				SafeSequence: "test_151",
+				SafeStage:    "test_152",
+			},
+		},
 	}
 	w := orc.NewWriter(0)
 	r := orc.NewReader(nil)
@@ -1591,6 +1822,27 @@ func BenchmarkEncodeDecodeIR(b *testing.B) {
 	}
 }
 
+func BenchmarkEncodeDecodeInterlock(b *testing.B) {
+	iv := ir.Interlock{
+		Channel:    2,
+		Comparison: "test_2",
+		Value:      3.5,
+	}
+	w := orc.NewWriter(0)
+	r := orc.NewReader(nil)
+	for i := 0; i < b.N; i++ {
+		w.Reset()
+		if err := iv.EncodeOrc(w); err != nil {
+			b.Fatal(err)
+		}
+		var decoded ir.Interlock
+		r.ResetBytes(w.Bytes())
+		if err := decoded.DecodeOrc(r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkEncodeDecodeMember(b *testing.B) {
 	mv := ir.Member{
 		NodeKey: func() *string { v := string("test_1"); return &v }(),
@@ -1707,14 +1959,15 @@ func BenchmarkEncodeDecodeNode(b *testing.B) {
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      32.5,
-							Name:       "test_33",
+							Scale:      33.5,
+							Name:       "test_34",
 						}
 						return &v
 					}(),
@@ -1726,48 +1979,56 @@ func BenchmarkEncodeDecodeNode(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_39",
+							Name:          "test_40",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_48",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_50": "value_50"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_45": "value_45"},
+				Value: map[string]interface{}{"key_51": "value_51"},
 			},
 		},
 		Inputs: []types.Param{
 			{
-				Name: "test_47",
+				Name: "test_53",
 				Type: types.Type{
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name:  "test_50",
+								Name:  "test_56",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_52": "value_52"},
+								Value: map[string]interface{}{"key_58": "value_58"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name:  "test_54",
+								Name:  "test_60",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_56": "value_56"},
+								Value: map[string]interface{}{"key_62": "value_62"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name:  "test_58",
+								Name:  "test_64",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_60": "value_60"},
+								Value: map[string]interface{}{"key_66": "value_66"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_62",
+					Name: "test_68",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
@@ -1776,19 +2037,20 @@ func BenchmarkEncodeDecodeNode(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_68",
+							Name:          "test_74",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      75.5,
-							Name:       "test_76",
+							Scale:      82.5,
+							Name:       "test_83",
 						}
 						return &v
 					}(),
@@ -1800,48 +2062,56 @@ func BenchmarkEncodeDecodeNode(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_82",
+							Name:          "test_89",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_97",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_99": "value_99"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_88": "value_88"},
+				Value: map[string]interface{}{"key_100": "value_100"},
 			},
 		},
 		Outputs: []types.Param{
 			{
-				Name: "test_90",
+				Name: "test_102",
 				Type: types.Type{
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name:  "test_93",
+								Name:  "test_105",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_95": "value_95"},
+								Value: map[string]interface{}{"key_107": "value_107"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name:  "test_97",
+								Name:  "test_109",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_99": "value_99"},
+								Value: map[string]interface{}{"key_111": "value_111"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name:  "test_101",
+								Name:  "test_113",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_103": "value_103"},
+								Value: map[string]interface{}{"key_115": "value_115"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_105",
+					Name: "test_117",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
@@ -1850,19 +2120,20 @@ func BenchmarkEncodeDecodeNode(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_111",
+							Name:          "test_123",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      118.5,
-							Name:       "test_119",
+							Scale:      131.5,
+							Name:       "test_132",
 						}
 						return &v
 					}(),
@@ -1874,22 +2145,30 @@ func BenchmarkEncodeDecodeNode(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_125",
+							Name:          "test_138",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_146",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_148": "value_148"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_131": "value_131"},
+				Value: map[string]interface{}{"key_149": "value_149"},
 			},
 		},
 		Channels: types.Channels{
-			Read:  map[uint32]string{134: "test_133"},
-			Write: map[uint32]string{135: "test_134"},
+			Read:  map[uint32]string{152: "test_151"},
+			Write: map[uint32]string{153: "test_152"},
 		},
 	}
 	w := orc.NewWriter(0)
@@ -2185,6 +2464,91 @@ func FuzzDecodeEdge(f *testing.F) {
 	})
 }
 
+func FuzzDecodeEnvelope(f *testing.F) {
+	{
+		seed := ir.Envelope{
+			Channel: 2,
+			Min:     func() *float64 { v := float64(2.5); return &v }(),
+			Max:     func() *float64 { v := float64(3.5); return &v }(),
+			MaxRate: func() *float64 { v := float64(4.5); return &v }(),
+			Interlocks: []ir.Interlock{{
+				Channel:    7,
+				Comparison: "test_7",
+				Value:      8.5,
+			}},
+			SafeValue:    func() *float64 { v := float64(9.5); return &v }(),
+			SafeSequence: "test_10",
+			SafeStage:    "test_11",
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	{
+		seed := ir.Envelope{
+			Channel:      0,
+			Min:          nil,
+			Max:          nil,
+			MaxRate:      nil,
+			Interlocks:   nil,
+			SafeValue:    nil,
+			SafeSequence: "",
+			SafeStage:    "",
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	{
+		seed := ir.Envelope{
+			Channel:      2,
+			Min:          func() *float64 { v := float64(2.5); return &v }(),
+			Max:          func() *float64 { v := float64(3.5); return &v }(),
+			MaxRate:      func() *float64 { v := float64(4.5); return &v }(),
+			Interlocks:   []ir.Interlock{},
+			SafeValue:    func() *float64 { v := float64(6.5); return &v }(),
+			SafeSequence: "test_7",
+			SafeStage:    "test_8",
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded ir.Envelope
+		r := orc.NewReader(nil)
+		r.ResetBytes(data)
+		if err := decoded.DecodeOrc(r); err != nil {
+			return
+		}
+		w1 := orc.NewWriter(len(data))
+		if err := decoded.EncodeOrc(w1); err != nil {
+			t.Fatalf("encode after successful decode failed: %v", err)
+		}
+		var redecoded ir.Envelope
+		r.ResetBytes(w1.Bytes())
+		if err := redecoded.DecodeOrc(r); err != nil {
+			t.Fatalf("re-decode failed: %v", err)
+		}
+		w2 := orc.NewWriter(w1.Len())
+		if err := redecoded.EncodeOrc(w2); err != nil {
+			t.Fatalf("re-encode failed: %v", err)
+		}
+		if w1.Len() != w2.Len() {
+			t.Fatalf("encoded length differs between cycles: w1=%d w2=%d", w1.Len(), w2.Len())
+		}
+		if !reflect.DeepEqual(decoded, redecoded) {
+			t.Fatal("round-trip mismatch: decoded values differ after re-encode/re-decode cycle")
+		}
+	})
+}
+
 func FuzzDecodeFunction(f *testing.F) {
 	{
 		seed := ir.Function{
@@ -2232,14 +2596,15 @@ func FuzzDecodeFunction(f *testing.F) {
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      33.5,
-								Name:       "test_34",
+								Scale:      34.5,
+								Name:       "test_35",
 							}
 							return &v
 						}(),
@@ -2251,48 +2616,56 @@ func FuzzDecodeFunction(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_40",
+								Name:          "test_41",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_49",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_51": "value_51"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_46": "value_46"},
+					Value: map[string]interface{}{"key_52": "value_52"},
 				},
 			},
 			Inputs: []types.Param{
 				{
-					Name: "test_48",
+					Name: "test_54",
 					Type: types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_51",
+									Name:  "test_57",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_53": "value_53"},
+									Value: map[string]interface{}{"key_59": "value_59"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_55",
+									Name:  "test_61",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_57": "value_57"},
+									Value: map[string]interface{}{"key_63": "value_63"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_59",
+									Name:  "test_65",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_61": "value_61"},
+									Value: map[string]interface{}{"key_67": "value_67"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_63",
+						Name: "test_69",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -2301,19 +2674,20 @@ func FuzzDecodeFunction(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_69",
+								Name:          "test_75",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      76.5,
-								Name:       "test_77",
+								Scale:      83.5,
+								Name:       "test_84",
 							}
 							return &v
 						}(),
@@ -2325,48 +2699,56 @@ func FuzzDecodeFunction(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_83",
+								Name:          "test_90",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_98",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_100": "value_100"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_89": "value_89"},
+					Value: map[string]interface{}{"key_101": "value_101"},
 				},
 			},
 			Outputs: []types.Param{
 				{
-					Name: "test_91",
+					Name: "test_103",
 					Type: types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_94",
+									Name:  "test_106",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_96": "value_96"},
+									Value: map[string]interface{}{"key_108": "value_108"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_98",
+									Name:  "test_110",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_100": "value_100"},
+									Value: map[string]interface{}{"key_112": "value_112"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_102",
+									Name:  "test_114",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_104": "value_104"},
+									Value: map[string]interface{}{"key_116": "value_116"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_106",
+						Name: "test_118",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -2375,19 +2757,20 @@ func FuzzDecodeFunction(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_112",
+								Name:          "test_124",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      119.5,
-								Name:       "test_120",
+								Scale:      132.5,
+								Name:       "test_133",
 							}
 							return &v
 						}(),
@@ -2399,22 +2782,30 @@ func FuzzDecodeFunction(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_126",
+								Name:          "test_139",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_147",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_149": "value_149"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_132": "value_132"},
+					Value: map[string]interface{}{"key_150": "value_150"},
 				},
 			},
 			Channels: types.Channels{
-				Read:  map[uint32]string{135: "test_134"},
-				Write: map[uint32]string{136: "test_135"},
+				Read:  map[uint32]string{153: "test_152"},
+				Write: map[uint32]string{154: "test_153"},
 			},
 		}
 		w := orc.NewWriter(0)
@@ -2550,13 +2941,14 @@ func FuzzDecodeIR(f *testing.F) {
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_17": "value_17"},
+							Value: map[string]interface{}{"key_18": "value_18"},
 						},
 					},
 					Inputs: []types.Param{
 						{
-							Name: "test_19",
+							Name: "test_20",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2564,18 +2956,19 @@ func FuzzDecodeIR(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_25",
+								Name:          "test_26",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_30": "value_30"},
+							Value: map[string]interface{}{"key_32": "value_32"},
 						},
 					},
 					Outputs: []types.Param{
 						{
-							Name: "test_32",
+							Name: "test_34",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2583,28 +2976,29 @@ func FuzzDecodeIR(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_38",
+								Name:          "test_40",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_43": "value_43"},
+							Value: map[string]interface{}{"key_46": "value_46"},
 						},
 					},
 					Channels: types.Channels{
-						Read:  map[uint32]string{46: "test_45"},
-						Write: map[uint32]string{47: "test_46"},
+						Read:  map[uint32]string{49: "test_48"},
+						Write: map[uint32]string{50: "test_49"},
 					},
 				},
 			},
 			Nodes: []ir.Node{
 				{
-					Key:  "test_48",
-					Type: "test_49",
+					Key:  "test_51",
+					Type: "test_52",
 					Config: []types.Param{
 						{
-							Name: "test_51",
+							Name: "test_54",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2612,18 +3006,19 @@ func FuzzDecodeIR(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_57",
+								Name:          "test_60",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_62": "value_62"},
+							Value: map[string]interface{}{"key_66": "value_66"},
 						},
 					},
 					Inputs: []types.Param{
 						{
-							Name: "test_64",
+							Name: "test_68",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2631,18 +3026,19 @@ func FuzzDecodeIR(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_70",
+								Name:          "test_74",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_75": "value_75"},
+							Value: map[string]interface{}{"key_80": "value_80"},
 						},
 					},
 					Outputs: []types.Param{
 						{
-							Name: "test_77",
+							Name: "test_82",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2650,44 +3046,45 @@ func FuzzDecodeIR(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_83",
+								Name:          "test_88",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_88": "value_88"},
+							Value: map[string]interface{}{"key_94": "value_94"},
 						},
 					},
 					Channels: types.Channels{
-						Read:  map[uint32]string{91: "test_90"},
-						Write: map[uint32]string{92: "test_91"},
+						Read:  map[uint32]string{97: "test_96"},
+						Write: map[uint32]string{98: "test_97"},
 					},
 				},
 			},
 			Edges: []ir.Edge{
 				{
-					Source: ir.Handle{Node: "test_94", Param: "test_95"},
-					Target: ir.Handle{Node: "test_97", Param: "test_98"},
+					Source: ir.Handle{Node: "test_100", Param: "test_101"},
+					Target: ir.Handle{Node: "test_103", Param: "test_104"},
 					Kind:   ir.EdgeKind(0),
 				},
 			},
 			Authorities: ir.Authorities{
-				Default:  func() *uint8 { v := uint8(102); return &v }(),
-				Channels: map[uint32]uint8{103: 103},
+				Default:  func() *uint8 { v := uint8(108); return &v }(),
+				Channels: map[uint32]uint8{109: 109},
 			},
 			Root: ir.Scope{
-				Key:        "test_104",
+				Key:        "test_110",
 				Mode:       ir.ScopeMode(0),
 				Liveness:   ir.Liveness(0),
-				Activation: func() *ir.Handle { v := ir.Handle{Node: "test_108", Param: "test_109"}; return &v }(),
+				Activation: func() *ir.Handle { v := ir.Handle{Node: "test_114", Param: "test_115"}; return &v }(),
 				Strata: [][]ir.Member{
 					{
 						{
-							NodeKey: func() *string { v := string("test_111"); return &v }(),
+							NodeKey: func() *string { v := string("test_117"); return &v }(),
 							Scope: func() *ir.Scope {
 								v := ir.Scope{
-									Key:         "test_113",
+									Key:         "test_119",
 									Mode:        ir.ScopeMode(0),
 									Liveness:    ir.Liveness(0),
 									Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -2702,10 +3099,10 @@ func FuzzDecodeIR(f *testing.F) {
 				},
 				Steps: []ir.Member{
 					{
-						NodeKey: func() *string { v := string("test_121"); return &v }(),
+						NodeKey: func() *string { v := string("test_127"); return &v }(),
 						Scope: func() *ir.Scope {
 							v := ir.Scope{
-								Key:         "test_123",
+								Key:         "test_129",
 								Mode:        ir.ScopeMode(0),
 								Liveness:    ir.Liveness(0),
 								Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -2719,9 +3116,27 @@ func FuzzDecodeIR(f *testing.F) {
 				},
 				Transitions: []ir.Transition{
 					{
-						On:        ir.Handle{Node: "test_132", Param: "test_133"},
-						TargetKey: func() *string { v := string("test_134"); return &v }(),
+						On:        ir.Handle{Node: "test_138", Param: "test_139"},
+						TargetKey: func() *string { v := string("test_140"); return &v }(),
+					},
+				},
+			},
+			Safety: []ir.Envelope{
+				{
+					Channel: 143,
+					Min:     func() *float64 { v := float64(143.5); return &v }(),
+					Max:     func() *float64 { v := float64(144.5); return &v }(),
+					MaxRate: func() *float64 { v := float64(145.5); return &v }(),
+					Interlocks: []ir.Interlock{
+						{
+							Channel:    148,
+							Comparison: "test_148",
+							Value:      149.5,
+						},
 					},
+					SafeValue:    func() *float64 { v := float64(150.5); return &v }(),
+					SafeSequence: "test_151",
+					SafeStage:    "test_152",
 				},
 			},
 		}
@@ -2746,6 +3161,7 @@ func FuzzDecodeIR(f *testing.F) {
 				Steps:       nil,
 				Transitions: nil,
 			},
+			Safety: nil,
 		}
 		w := orc.NewWriter(0)
 		if err := seed.EncodeOrc(w); err != nil {
@@ -2771,6 +3187,7 @@ func FuzzDecodeIR(f *testing.F) {
 				Steps:       []ir.Member{},
 				Transitions: []ir.Transition{},
 			},
+			Safety: []ir.Envelope{},
 		}
 		w := orc.NewWriter(0)
 		if err := seed.EncodeOrc(w); err != nil {
@@ -2807,6 +3224,60 @@ func FuzzDecodeIR(f *testing.F) {
 	})
 }
 
+func FuzzDecodeInterlock(f *testing.F) {
+	{
+		seed := ir.Interlock{
+			Channel:    2,
+			Comparison: "test_2",
+			Value:      3.5,
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	{
+		seed := ir.Interlock{
+			Channel:    0,
+			Comparison: "",
+			Value:      0,
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded ir.Interlock
+		r := orc.NewReader(nil)
+		r.ResetBytes(data)
+		if err := decoded.DecodeOrc(r); err != nil {
+			return
+		}
+		w1 := orc.NewWriter(len(data))
+		if err := decoded.EncodeOrc(w1); err != nil {
+			t.Fatalf("encode after successful decode failed: %v", err)
+		}
+		var redecoded ir.Interlock
+		r.ResetBytes(w1.Bytes())
+		if err := redecoded.DecodeOrc(r); err != nil {
+			t.Fatalf("re-decode failed: %v", err)
+		}
+		w2 := orc.NewWriter(w1.Len())
+		if err := redecoded.EncodeOrc(w2); err != nil {
+			t.Fatalf("re-encode failed: %v", err)
+		}
+		if w1.Len() != w2.Len() {
+			t.Fatalf("encoded length differs between cycles: w1=%d w2=%d", w1.Len(), w2.Len())
+		}
+		if !reflect.DeepEqual(decoded, redecoded) {
+			t.Fatal("round-trip mismatch: decoded values differ after re-encode/re-decode cycle")
+		}
+	})
+}
+
 func FuzzDecodeMember(f *testing.F) {
 	{
 		seed := ir.Member{
@@ -2953,14 +3424,15 @@ func FuzzDecodeNode(f *testing.F) {
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      32.5,
-								Name:       "test_33",
+								Scale:      33.5,
+								Name:       "test_34",
 							}
 							return &v
 						}(),
@@ -2972,48 +3444,56 @@ func FuzzDecodeNode(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_39",
+								Name:          "test_40",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_48",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_50": "value_50"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_45": "value_45"},
+					Value: map[string]interface{}{"key_51": "value_51"},
 				},
 			},
 			Inputs: []types.Param{
 				{
-					Name: "test_47",
+					Name: "test_53",
 					Type: types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_50",
+									Name:  "test_56",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_52": "value_52"},
+									Value: map[string]interface{}{"key_58": "value_58"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_54",
+									Name:  "test_60",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_56": "value_56"},
+									Value: map[string]interface{}{"key_62": "value_62"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_58",
+									Name:  "test_64",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_60": "value_60"},
+									Value: map[string]interface{}{"key_66": "value_66"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_62",
+						Name: "test_68",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -3022,19 +3502,20 @@ func FuzzDecodeNode(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_68",
+								Name:          "test_74",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      75.5,
-								Name:       "test_76",
+								Scale:      82.5,
+								Name:       "test_83",
 							}
 							return &v
 						}(),
@@ -3046,48 +3527,56 @@ func FuzzDecodeNode(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_82",
+								Name:          "test_89",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_97",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_99": "value_99"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_88": "value_88"},
+					Value: map[string]interface{}{"key_100": "value_100"},
 				},
 			},
 			Outputs: []types.Param{
 				{
-					Name: "test_90",
+					Name: "test_102",
 					Type: types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_93",
+									Name:  "test_105",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_95": "value_95"},
+									Value: map[string]interface{}{"key_107": "value_107"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_97",
+									Name:  "test_109",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_99": "value_99"},
+									Value: map[string]interface{}{"key_111": "value_111"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_101",
+									Name:  "test_113",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_103": "value_103"},
+									Value: map[string]interface{}{"key_115": "value_115"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_105",
+						Name: "test_117",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -3096,19 +3585,20 @@ func FuzzDecodeNode(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_111",
+								Name:          "test_123",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      118.5,
-								Name:       "test_119",
+								Scale:      131.5,
+								Name:       "test_132",
 							}
 							return &v
 						}(),
@@ -3120,22 +3610,30 @@ func FuzzDecodeNode(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_125",
+								Name:          "test_138",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_146",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_148": "value_148"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_131": "value_131"},
+					Value: map[string]interface{}{"key_149": "value_149"},
 				},
 			},
 			Channels: types.Channels{
-				Read:  map[uint32]string{134: "test_133"},
-				Write: map[uint32]string{135: "test_134"},
+				Read:  map[uint32]string{152: "test_151"},
+				Write: map[uint32]string{153: "test_152"},
 			},
 		}
 		w := orc.NewWriter(0)
@@ -851,6 +851,185 @@ func (x *Authorities) GetChannels() map[uint32]uint32 {
 	return nil
 }
 
+// Interlock holds a guarded channel at its safe value while another channel's value
+// satisfies a comparison.
+type Interlock struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// channel is the key of the channel whose value the interlock conditions on.
+	Channel uint32 `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// comparison is the operator comparing the channel's value to value: one of <, <=, >,
+	// >=, == or !=.
+	Comparison string `protobuf:"bytes,2,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// value is the threshold the channel's value is compared to.
+	Value         float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interlock) Reset() {
+	*x = Interlock{}
+	mi := &file_arc_go_ir_pb_ir_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interlock) ProtoMessage() {}
+
+func (x *Interlock) ProtoReflect() protoreflect.Message {
+	mi := &file_arc_go_ir_pb_ir_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interlock.ProtoReflect.Descriptor instead.
+func (*Interlock) Descriptor() ([]byte, []int) {
+	return file_arc_go_ir_pb_ir_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Interlock) GetChannel() uint32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *Interlock) GetComparison() string {
+	if x != nil {
+		return x.Comparison
+	}
+	return ""
+}
+
+func (x *Interlock) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Envelope is the safety envelope enforced on writes to an output channel of an Arc
+// program.
+type Envelope struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// channel is the key of the guarded channel.
+	Channel uint32 `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// min is the smallest value that may be written to the channel.
+	Min *float64 `protobuf:"fixed64,2,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	// max is the largest value that may be written to the channel.
+	Max *float64 `protobuf:"fixed64,3,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	// max_rate is the largest change in the channel's value per second between consecutive
+	// writes.
+	MaxRate *float64 `protobuf:"fixed64,4,opt,name=max_rate,json=maxRate,proto3,oneof" json:"max_rate,omitempty"`
+	// interlocks hold the channel at safe_value while any of their conditions are
+	// satisfied.
+	Interlocks []*Interlock `protobuf:"bytes,5,rep,name=interlocks,proto3" json:"interlocks,omitempty"`
+	// safe_value is the value the channel is held at while interlocked. Zero when unset.
+	SafeValue *float64 `protobuf:"fixed64,6,opt,name=safe_value,json=safeValue,proto3,oneof" json:"safe_value,omitempty"`
+	// safe_sequence is the sequence containing safe_stage. Empty when a violation does not
+	// trigger a stage.
+	SafeSequence string `protobuf:"bytes,7,opt,name=safe_sequence,json=safeSequence,proto3" json:"safe_sequence,omitempty"`
+	// safe_stage is the stage entered when a write violates the envelope.
+	SafeStage     string `protobuf:"bytes,8,opt,name=safe_stage,json=safeStage,proto3" json:"safe_stage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	mi := &file_arc_go_ir_pb_ir_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_arc_go_ir_pb_ir_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_arc_go_ir_pb_ir_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Envelope) GetChannel() uint32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *Envelope) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *Envelope) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *Envelope) GetMaxRate() float64 {
+	if x != nil && x.MaxRate != nil {
+		return *x.MaxRate
+	}
+	return 0
+}
+
+func (x *Envelope) GetInterlocks() []*Interlock {
+	if x != nil {
+		return x.Interlocks
+	}
+	return nil
+}
+
+func (x *Envelope) GetSafeValue() float64 {
+	if x != nil && x.SafeValue != nil {
+		return *x.SafeValue
+	}
+	return 0
+}
+
+func (x *Envelope) GetSafeSequence() string {
+	if x != nil {
+		return x.SafeSequence
+	}
+	return ""
+}
+
+func (x *Envelope) GetSafeStage() string {
+	if x != nil {
+		return x.SafeStage
+	}
+	return ""
+}
+
 // IR is the intermediate representation of an Arc program as a dataflow graph with
 // stratified execution, bridging semantic analysis and WebAssembly compilation.
 type IR struct {
@@ -865,14 +1044,16 @@ type IR struct {
 	Authorities *Authorities `protobuf:"bytes,4,opt,name=authorities,proto3" json:"authorities,omitempty"`
 	// root is the top-level execution context. The root is always a parallel, always-live
 	// Scope whose strata mix module-scope reactive flow with top-level gated scopes.
-	Root          *Scope `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	Root *Scope `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	// safety contains the safety envelopes declared by this program.
+	Safety        []*Envelope `protobuf:"bytes,6,rep,name=safety,proto3" json:"safety,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IR) Reset() {
 	*x = IR{}
-	mi := &file_arc_go_ir_pb_ir_proto_msgTypes[10]
+	mi := &file_arc_go_ir_pb_ir_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -884,7 +1065,7 @@ func (x *IR) String() string {
 func (*IR) ProtoMessage() {}
 
 func (x *IR) ProtoReflect() protoreflect.Message {
-	mi := &file_arc_go_ir_pb_ir_proto_msgTypes[10]
+	mi := &file_arc_go_ir_pb_ir_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1078,7 @@ func (x *IR) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IR.ProtoReflect.Descriptor instead.
 func (*IR) Descriptor() ([]byte, []int) {
-	return file_arc_go_ir_pb_ir_proto_rawDescGZIP(), []int{10}
+	return file_arc_go_ir_pb_ir_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *IR) GetFunctions() []*Function {
@@ -935,6 +1116,13 @@ func (x *IR) GetRoot() *Scope {
 	return nil
 }
 
+func (x *IR) GetSafety() []*Envelope {
+	if x != nil {
+		return x.Safety
+	}
+	return nil
+}
+
 var File_arc_go_ir_pb_ir_proto protoreflect.FileDescriptor
 
 const file_arc_go_ir_pb_ir_proto_rawDesc = "" +
@@ -994,13 +1182,37 @@ const file_arc_go_ir_pb_ir_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01B\n" +
 	"\n" +
-	"\b_default\"\xe5\x01\n" +
+	"\b_default\"[\n" +
+	"\tInterlock\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\rR\achannel\x12\x1e\n" +
+	"\n" +
+	"comparison\x18\x02 \x01(\tR\n" +
+	"comparison\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\"\xbc\x02\n" +
+	"\bEnvelope\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\rR\achannel\x12\x15\n" +
+	"\x03min\x18\x02 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x03 \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x1e\n" +
+	"\bmax_rate\x18\x04 \x01(\x01H\x02R\amaxRate\x88\x01\x01\x124\n" +
+	"\n" +
+	"interlocks\x18\x05 \x03(\v2\x14.arc.ir.pb.InterlockR\n" +
+	"interlocks\x12\"\n" +
+	"\n" +
+	"safe_value\x18\x06 \x01(\x01H\x03R\tsafeValue\x88\x01\x01\x12#\n" +
+	"\rsafe_sequence\x18\a \x01(\tR\fsafeSequence\x12\x1d\n" +
+	"\n" +
+	"safe_stage\x18\b \x01(\tR\tsafeStageB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_maxB\v\n" +
+	"\t_max_rateB\r\n" +
+	"\v_safe_value\"\x92\x02\n" +
 	"\x02IR\x121\n" +
 	"\tfunctions\x18\x01 \x03(\v2\x13.arc.ir.pb.FunctionR\tfunctions\x12%\n" +
 	"\x05nodes\x18\x02 \x03(\v2\x0f.arc.ir.pb.NodeR\x05nodes\x12%\n" +
 	"\x05edges\x18\x03 \x03(\v2\x0f.arc.ir.pb.EdgeR\x05edges\x128\n" +
 	"\vauthorities\x18\x04 \x01(\v2\x16.arc.ir.pb.AuthoritiesR\vauthorities\x12$\n" +
-	"\x04root\x18\x05 \x01(\v2\x10.arc.ir.pb.ScopeR\x04root*Z\n" +
+	"\x04root\x18\x05 \x01(\v2\x10.arc.ir.pb.ScopeR\x04root\x12+\n" +
+	"\x06safety\x18\x06 \x03(\v2\x13.arc.ir.pb.EnvelopeR\x06safety*Z\n" +
 	"\bEdgeKind\x12\x19\n" +
 	"\x15EDGE_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14EDGE_KIND_CONTINUOUS\x10\x01\x12\x19\n" +
@@ -1028,7 +1240,7 @@ func file_arc_go_ir_pb_ir_proto_rawDescGZIP() []byte {
 }
 
 var file_arc_go_ir_pb_ir_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_arc_go_ir_pb_ir_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_arc_go_ir_pb_ir_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_arc_go_ir_pb_ir_proto_goTypes = []any{
 	(EdgeKind)(0),          // 0: arc.ir.pb.EdgeKind
 	(ScopeMode)(0),         // 1: arc.ir.pb.ScopeMode
@@ -1043,10 +1255,12 @@ var file_arc_go_ir_pb_ir_proto_goTypes = []any{
 	(*Function)(nil),       // 10: arc.ir.pb.Function
 	(*Node)(nil),           // 11: arc.ir.pb.Node
 	(*Authorities)(nil),    // 12: arc.ir.pb.Authorities
-	(*IR)(nil),             // 13: arc.ir.pb.IR
-	nil,                    // 14: arc.ir.pb.Authorities.ChannelsEntry
-	(*pb.Param)(nil),       // 15: arc.types.pb.Param
-	(*pb.Channels)(nil),    // 16: arc.types.pb.Channels
+	(*Interlock)(nil),      // 13: arc.ir.pb.Interlock
+	(*Envelope)(nil),       // 14: arc.ir.pb.Envelope
+	(*IR)(nil),             // 15: arc.ir.pb.IR
+	nil,                    // 16: arc.ir.pb.Authorities.ChannelsEntry
+	(*pb.Param)(nil),       // 17: arc.types.pb.Param
+	(*pb.Channels)(nil),    // 18: arc.types.pb.Channels
 }
 var file_arc_go_ir_pb_ir_proto_depIdxs = []int32{
 	3,  // 0: arc.ir.pb.Edge.source:type_name -> arc.ir.pb.Handle
@@ -1062,25 +1276,27 @@ var file_arc_go_ir_pb_ir_proto_depIdxs = []int32{
 	6,  // 10: arc.ir.pb.Scope.steps:type_name -> arc.ir.pb.Member
 	5,  // 11: arc.ir.pb.Scope.transitions:type_name -> arc.ir.pb.Transition
 	9,  // 12: arc.ir.pb.Function.body:type_name -> arc.ir.pb.Body
-	15, // 13: arc.ir.pb.Function.config:type_name -> arc.types.pb.Param
-	15, // 14: arc.ir.pb.Function.inputs:type_name -> arc.types.pb.Param
-	15, // 15: arc.ir.pb.Function.outputs:type_name -> arc.types.pb.Param
-	16, // 16: arc.ir.pb.Function.channels:type_name -> arc.types.pb.Channels
-	15, // 17: arc.ir.pb.Node.config:type_name -> arc.types.pb.Param
-	15, // 18: arc.ir.pb.Node.inputs:type_name -> arc.types.pb.Param
-	15, // 19: arc.ir.pb.Node.outputs:type_name -> arc.types.pb.Param
-	16, // 20: arc.ir.pb.Node.channels:type_name -> arc.types.pb.Channels
-	14, // 21: arc.ir.pb.Authorities.channels:type_name -> arc.ir.pb.Authorities.ChannelsEntry
-	10, // 22: arc.ir.pb.IR.functions:type_name -> arc.ir.pb.Function
-	11, // 23: arc.ir.pb.IR.nodes:type_name -> arc.ir.pb.Node
-	4,  // 24: arc.ir.pb.IR.edges:type_name -> arc.ir.pb.Edge
-	12, // 25: arc.ir.pb.IR.authorities:type_name -> arc.ir.pb.Authorities
-	8,  // 26: arc.ir.pb.IR.root:type_name -> arc.ir.pb.Scope
-	27, // [27:27] is the sub-list for method output_type
-	27, // [27:27] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	17, // 13: arc.ir.pb.Function.config:type_name -> arc.types.pb.Param
+	17, // 14: arc.ir.pb.Function.inputs:type_name -> arc.types.pb.Param
+	17, // 15: arc.ir.pb.Function.outputs:type_name -> arc.types.pb.Param
+	18, // 16: arc.ir.pb.Function.channels:type_name -> arc.types.pb.Channels
+	17, // 17: arc.ir.pb.Node.config:type_name -> arc.types.pb.Param
+	17, // 18: arc.ir.pb.Node.inputs:type_name -> arc.types.pb.Param
+	17, // 19: arc.ir.pb.Node.outputs:type_name -> arc.types.pb.Param
+	18, // 20: arc.ir.pb.Node.channels:type_name -> arc.types.pb.Channels
+	16, // 21: arc.ir.pb.Authorities.channels:type_name -> arc.ir.pb.Authorities.ChannelsEntry
+	13, // 22: arc.ir.pb.Envelope.interlocks:type_name -> arc.ir.pb.Interlock
+	10, // 23: arc.ir.pb.IR.functions:type_name -> arc.ir.pb.Function
+	11, // 24: arc.ir.pb.IR.nodes:type_name -> arc.ir.pb.Node
+	4,  // 25: arc.ir.pb.IR.edges:type_name -> arc.ir.pb.Edge
+	12, // 26: arc.ir.pb.IR.authorities:type_name -> arc.ir.pb.Authorities
+	8,  // 27: arc.ir.pb.IR.root:type_name -> arc.ir.pb.Scope
+	14, // 28: arc.ir.pb.IR.safety:type_name -> arc.ir.pb.Envelope
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_arc_go_ir_pb_ir_proto_init() }
@@ -1092,13 +1308,14 @@ func file_arc_go_ir_pb_ir_proto_init() {
 	file_arc_go_ir_pb_ir_proto_msgTypes[3].OneofWrappers = []any{}
 	file_arc_go_ir_pb_ir_proto_msgTypes[5].OneofWrappers = []any{}
 	file_arc_go_ir_pb_ir_proto_msgTypes[9].OneofWrappers = []any{}
+	file_arc_go_ir_pb_ir_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arc_go_ir_pb_ir_proto_rawDesc), len(file_arc_go_ir_pb_ir_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   12,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
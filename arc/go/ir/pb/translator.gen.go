@@ -654,6 +654,136 @@ func AuthoritiesListFromPB(pbs []*Authorities) ([]ir.Authorities, error) {
 	return result, nil
 }
 
+// InterlockToPB converts Interlock to Interlock.
+func InterlockToPB(r ir.Interlock) (*Interlock, error) {
+	pb := &Interlock{
+		Channel:    r.Channel,
+		Comparison: r.Comparison,
+		Value:      r.Value,
+	}
+	return pb, nil
+}
+
+// InterlockFromPB converts Interlock to Interlock.
+func InterlockFromPB(pb *Interlock) (ir.Interlock, error) {
+	var r ir.Interlock
+	if pb == nil {
+		return r, nil
+	}
+	r.Channel = pb.Channel
+	r.Comparison = pb.Comparison
+	r.Value = pb.Value
+	return r, nil
+}
+
+// InterlocksToPB converts a slice of Interlock to Interlock.
+func InterlocksToPB(rs []ir.Interlock) ([]*Interlock, error) {
+	result := make([]*Interlock, len(rs))
+	for i := range rs {
+		var err error
+		result[i], err = InterlockToPB(rs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// InterlocksFromPB converts a slice of Interlock to Interlock.
+func InterlocksFromPB(pbs []*Interlock) ([]ir.Interlock, error) {
+	result := make([]ir.Interlock, len(pbs))
+	for i, pb := range pbs {
+		var err error
+		result[i], err = InterlockFromPB(pb)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// EnvelopeToPB converts Envelope to Envelope.
+func EnvelopeToPB(r ir.Envelope) (*Envelope, error) {
+	interlocksVal, err := InterlocksToPB(r.Interlocks)
+	if err != nil {
+		return nil, err
+	}
+	pb := &Envelope{
+		Channel:      r.Channel,
+		SafeSequence: r.SafeSequence,
+		SafeStage:    r.SafeStage,
+		Interlocks:   interlocksVal,
+	}
+	if r.Min != nil {
+		pb.Min = r.Min
+	}
+	if r.Max != nil {
+		pb.Max = r.Max
+	}
+	if r.MaxRate != nil {
+		pb.MaxRate = r.MaxRate
+	}
+	if r.SafeValue != nil {
+		pb.SafeValue = r.SafeValue
+	}
+	return pb, nil
+}
+
+// EnvelopeFromPB converts Envelope to Envelope.
+func EnvelopeFromPB(pb *Envelope) (ir.Envelope, error) {
+	var r ir.Envelope
+	if pb == nil {
+		return r, nil
+	}
+	var err error
+	r.Interlocks, err = InterlocksFromPB(pb.Interlocks)
+	if err != nil {
+		return ir.Envelope{}, err
+	}
+	r.Channel = pb.Channel
+	r.SafeSequence = pb.SafeSequence
+	r.SafeStage = pb.SafeStage
+	if pb.Min != nil {
+		r.Min = pb.Min
+	}
+	if pb.Max != nil {
+		r.Max = pb.Max
+	}
+	if pb.MaxRate != nil {
+		r.MaxRate = pb.MaxRate
+	}
+	if pb.SafeValue != nil {
+		r.SafeValue = pb.SafeValue
+	}
+	return r, nil
+}
+
+// EnvelopesToPB converts a slice of Envelope to Envelope.
+func EnvelopesToPB(rs []ir.Envelope) ([]*Envelope, error) {
+	result := make([]*Envelope, len(rs))
+	for i := range rs {
+		var err error
+		result[i], err = EnvelopeToPB(rs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// EnvelopesFromPB converts a slice of Envelope to Envelope.
+func EnvelopesFromPB(pbs []*Envelope) ([]ir.Envelope, error) {
+	result := make([]ir.Envelope, len(pbs))
+	for i, pb := range pbs {
+		var err error
+		result[i], err = EnvelopeFromPB(pb)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 // IRToPB converts IR to IR.
 func IRToPB(r ir.IR) (*IR, error) {
 	functionsVal, err := FunctionsToPB(r.Functions)
@@ -676,12 +806,17 @@ func IRToPB(r ir.IR) (*IR, error) {
 	if err != nil {
 		return nil, err
 	}
+	safetyVal, err := EnvelopesToPB(r.Safety)
+	if err != nil {
+		return nil, err
+	}
 	pb := &IR{
 		Functions:   functionsVal,
 		Nodes:       nodesVal,
 		Edges:       edgesVal,
 		Authorities: authoritiesVal,
 		Root:        rootVal,
+		Safety:      safetyVal,
 	}
 	return pb, nil
 }
@@ -713,6 +848,10 @@ func IRFromPB(pb *IR) (ir.IR, error) {
 	if err != nil {
 		return ir.IR{}, err
 	}
+	r.Safety, err = EnvelopesFromPB(pb.Safety)
+	if err != nil {
+		return ir.IR{}, err
+	}
 	return r, nil
 }
 
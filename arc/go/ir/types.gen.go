@@ -26,6 +26,9 @@ type Functions []Function
 // Nodes is a collection of node instantiations in an Arc module.
 type Nodes []Node
 
+// Safety is the collection of safety envelopes declared by an Arc program.
+type Safety []Envelope
+
 // Members is an ordered collection of Scope members, one per position.
 type Members = []Member
 
@@ -173,6 +176,42 @@ type Authorities struct {
 	Channels map[uint32]uint8 `json:"channels" msgpack:"channels"`
 }
 
+// Interlock holds a guarded channel at its safe value while another channel's value
+// satisfies a comparison.
+type Interlock struct {
+	// Channel is the key of the channel whose value the interlock conditions on.
+	Channel uint32 `json:"channel" msgpack:"channel"`
+	// Comparison is the operator comparing the channel's value to value: one of <, <=, >,
+	// >=, == or !=.
+	Comparison string `json:"comparison" msgpack:"comparison"`
+	// Value is the threshold the channel's value is compared to.
+	Value float64 `json:"value" msgpack:"value"`
+}
+
+// Envelope is the safety envelope enforced on writes to an output channel of an Arc
+// program.
+type Envelope struct {
+	// Channel is the key of the guarded channel.
+	Channel uint32 `json:"channel" msgpack:"channel"`
+	// Min is the smallest value that may be written to the channel.
+	Min *float64 `json:"min,omitempty" msgpack:"min,omitempty"`
+	// Max is the largest value that may be written to the channel.
+	Max *float64 `json:"max,omitempty" msgpack:"max,omitempty"`
+	// MaxRate is the largest change in the channel's value per second between consecutive
+	// writes.
+	MaxRate *float64 `json:"max_rate,omitempty" msgpack:"max_rate,omitempty"`
+	// Interlocks hold the channel at safe_value while any of their conditions are
+	// satisfied.
+	Interlocks []Interlock `json:"interlocks" msgpack:"interlocks"`
+	// SafeValue is the value the channel is held at while interlocked. Zero when unset.
+	SafeValue *float64 `json:"safe_value,omitempty" msgpack:"safe_value,omitempty"`
+	// SafeSequence is the sequence containing safe_stage. Empty when a violation does not
+	// trigger a stage.
+	SafeSequence string `json:"safe_sequence" msgpack:"safe_sequence"`
+	// SafeStage is the stage entered when a write violates the envelope.
+	SafeStage string `json:"safe_stage" msgpack:"safe_stage"`
+}
+
 // IR is the intermediate representation of an Arc program as a dataflow graph with
 // stratified execution, bridging semantic analysis and WebAssembly compilation.
 type IR struct {
@@ -186,7 +225,9 @@ type IR struct {
 	Authorities Authorities `json:"authorities" msgpack:"authorities"`
 	// Root is the top-level execution context. The root is always a parallel, always-live
 	// Scope whose strata mix module-scope reactive flow with top-level gated scopes.
-	Root    Scope                                  `json:"root" msgpack:"root"`
+	Root Scope `json:"root" msgpack:"root"`
+	// Safety contains the safety envelopes declared by this program.
+	Safety  Safety                                 `json:"safety" msgpack:"safety"`
 	Symbols *symbol.Symbol                         `json:"-"`
 	TypeMap map[antlr.ParserRuleContext]types.Type `json:"-"`
 }
@@ -0,0 +1,277 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package arc_test
+
+import (
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc"
+	"github.com/synnaxlabs/arc/stl/channels"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+func newLibrary(name, source string) symbol.Symbol {
+	return *MustSucceed(arc.NewLibrary(name, arc.Text{Raw: source}))
+}
+
+var _ = Describe("Libraries", func() {
+	conv := newLibrary("conv", `
+import time
+
+OFFSET f64 := 32.0
+
+func scale(c f64) f64 {
+    return c * 1.8
+}
+
+func c_to_f(c f64) f64 {
+    return scale(c) + OFFSET
+}
+
+func clamp(v f64, lo f64, hi f64) f64 {
+    if v < lo {
+        return lo
+    }
+    if v > hi {
+        return hi
+    }
+    return v
+}
+
+func started(start i64) u8 {
+    if time.now() > start {
+        return 1
+    }
+    return 0
+}
+`)
+	chans := channelSymbols(map[string]channelDef{
+		"sensor": {types.F64(), 100},
+		"output": {types.F64(), 101},
+	})
+	digests := []channels.Digest{
+		{Key: 100, DataType: telem.Float64T},
+		{Key: 101, DataType: telem.Float64T},
+	}
+	run := func(ctx SpecContext, source string, input float64) float64 {
+		h := newRuntimeHarness(
+			ctx,
+			source,
+			slices.Concat(chans, []symbol.Symbol{conv}),
+			digests...,
+		)
+		defer h.Close(ctx)
+		h.Ingest(100, telem.NewSeriesV(input))
+		h.Tick(ctx, telem.Millisecond)
+		h.channelState.ClearReads()
+		out, _ := h.Flush()
+		ch := out.Get(101)
+		Expect(ch.Series).ToNot(BeEmpty(), "output not written")
+		vals := telem.UnmarshalSeries[float64](ch.Series[len(ch.Series)-1])
+		Expect(vals).ToNot(BeEmpty())
+		return vals[len(vals)-1]
+	}
+
+	Describe("Linking", func() {
+		It("Should call a library function from a program function", func(ctx SpecContext) {
+			Expect(run(ctx, `
+import conv
+func to_f(c f64) f64 {
+    return conv.c_to_f(c)
+}
+sensor -> to_f{} -> output
+`, 100)).To(Equal(212.0))
+		})
+
+		It("Should use a library function as a flow node", func(ctx SpecContext) {
+			Expect(run(ctx, `
+import conv
+sensor -> conv.c_to_f{} -> output
+`, 0)).To(Equal(32.0))
+		})
+
+		It("Should call library functions through an alias", func(ctx SpecContext) {
+			Expect(run(ctx, `
+import conv as c
+func bounded(v f64) f64 {
+    return c.clamp(v, 0.0, 10.0)
+}
+sensor -> bounded{} -> output
+`, 25)).To(Equal(10.0))
+		})
+
+		It("Should call modules imported by the library", func(ctx SpecContext) {
+			Expect(run(ctx, `
+import conv
+func check(v f64) f64 {
+    if conv.started(0) == 1 {
+        return v
+    }
+    return 0.0
+}
+sensor -> check{} -> output
+`, 5)).To(Equal(5.0))
+		})
+
+		It("Should inline library constants", func(ctx SpecContext) {
+			Expect(run(ctx, `
+import conv
+func offset(v f64) f64 {
+    return v + conv.OFFSET
+}
+sensor -> offset{} -> output
+`, 1)).To(Equal(33.0))
+		})
+
+		It("Should not conflict with program functions of the same name", func(ctx SpecContext) {
+			Expect(run(ctx, `
+import conv
+func scale(v f64) f64 {
+    return conv.scale(v) * 2.0
+}
+sensor -> scale{} -> output
+`, 10)).To(Equal(36.0))
+		})
+
+		It("Should link libraries that are imported by other libraries", func(ctx SpecContext) {
+			thermo := newLibrary("thermo", `
+import conv
+func boiling() f64 {
+    return conv.c_to_f(100.0)
+}
+`)
+			h := newRuntimeHarness(
+				ctx,
+				`
+import thermo
+func check(v f64) f64 {
+    return thermo.boiling() - v
+}
+sensor -> check{} -> output
+`,
+				slices.Concat(chans, []symbol.Symbol{conv, thermo}),
+				digests...,
+			)
+			defer h.Close(ctx)
+			h.Ingest(100, telem.NewSeriesV(12.0))
+			h.Tick(ctx, telem.Millisecond)
+			out, _ := h.Flush()
+			vals := telem.UnmarshalSeries[float64](out.Get(101).Series[0])
+			Expect(vals).To(Equal([]float64{200}))
+		})
+	})
+
+	Describe("Analysis", func() {
+		It("Should require the library to be imported", func() {
+			d := analyze(`
+func to_f(c f64) f64 {
+    return conv.c_to_f(c)
+}
+`, []symbol.Symbol{conv})
+			Expect(d.Ok()).To(BeFalse())
+		})
+
+		It("Should report undefined library members", func() {
+			d := analyze(`
+import conv
+func to_f(c f64) f64 {
+    return conv.to_kelvin(c)
+}
+`, []symbol.Symbol{conv})
+			Expect(d.Ok()).To(BeFalse())
+			Expect(messages(d)).To(ContainSubstring("to_kelvin"))
+		})
+
+		It("Should check the types of library function arguments", func() {
+			d := analyze(`
+import conv
+func to_f(c str) f64 {
+    return conv.c_to_f(c)
+}
+`, []symbol.Symbol{conv})
+			Expect(d.Ok()).To(BeFalse())
+		})
+
+		It("Should report errors in a library on the import", func() {
+			broken := newLibrary("broken", `
+func bad() f64 {
+    return undefined_thing
+}
+`)
+			d := analyze(`
+import broken
+func f() f64 {
+    return broken.bad()
+}
+`, []symbol.Symbol{broken})
+			Expect(d.Ok()).To(BeFalse())
+			Expect((*d)[0].Start.Line).To(Equal(2))
+			Expect(messages(d)).To(ContainSubstring(`library "broken" has errors`))
+			Expect(messages(d)).To(ContainSubstring("undefined_thing"))
+		})
+
+		It("Should reject flow statements in a library", func() {
+			flows := newLibrary("flows", `
+func double(v f64) f64 {
+    return v * 2.0
+}
+sensor -> double{} -> output
+`)
+			d := analyze(`
+import flows
+func f(v f64) f64 {
+    return flows.double(v)
+}
+`, chans, []symbol.Symbol{flows})
+			Expect(d.Ok()).To(BeFalse())
+			Expect(messages(d)).To(ContainSubstring(
+				"libraries can only contain imports, functions, and constants",
+			))
+		})
+
+		It("Should reject import cycles between libraries", func() {
+			a := newLibrary("a", `
+import b
+func fa() i64 {
+    return b.fb()
+}
+`)
+			b := newLibrary("b", `
+import a
+func fb() i64 {
+    return a.fa()
+}
+`)
+			d := analyze(`
+import a
+func f() i64 {
+    return a.fa()
+}
+`, []symbol.Symbol{a, b})
+			Expect(d.Ok()).To(BeFalse())
+			Expect(messages(d)).To(ContainSubstring("import cycle not allowed"))
+		})
+
+		It("Should not expose the imports of a library to the program", func() {
+			d := analyze(`
+import conv
+func f() i64 {
+    return time.now()
+}
+`, []symbol.Symbol{conv})
+			Expect(d.Ok()).To(BeFalse())
+		})
+	})
+})
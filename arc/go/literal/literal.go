@@ -173,6 +173,12 @@ func parseNumericWithUnit(
 	return ParsedValue{Value: siValue, Type: resultType}, nil
 }
 
+// ParseInteger converts an integer value to the target type, checking that it fits in
+// the type's range. An invalid target type yields an i64.
+func ParseInteger(value int64, targetType types.Type) (ParsedValue, error) {
+	return parseIntegerLiteral(value, targetType)
+}
+
 // parseIntegerLiteral converts an integer value to the target type.
 // It takes int64 directly to preserve full precision for large integer literals.
 func parseIntegerLiteral(intValue int64, targetType types.Type) (ParsedValue, error) {
@@ -462,3 +468,13 @@ func Negate(v any) any {
 		return v
 	}
 }
+
+// Zero returns the zero value of the given primitive type, in the same Go
+// representation Parse produces for a literal of that type.
+func Zero(t types.Type) any {
+	if t.Kind == types.KindString {
+		return ""
+	}
+	v, _ := convertToTargetKind(0, t, t.Unit)
+	return v.Value
+}
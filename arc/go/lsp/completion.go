@@ -11,10 +11,12 @@ package lsp
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/antlr4-go/antlr/v4"
+	"github.com/synnaxlabs/arc/analyzer"
 	"github.com/synnaxlabs/arc/parser"
 	"github.com/synnaxlabs/arc/symbol"
 	"github.com/synnaxlabs/arc/types"
@@ -284,6 +286,15 @@ var completions = []completionInfo{
 		InsertFormat: protocol.InsertTextFormatSnippet,
 		Category:     categoryTopLevelKeyword,
 	},
+	{
+		Label:        parser.LiteralSAFETY,
+		Detail:       "safety declaration",
+		Doc:          "Sets a safety envelope enforced on writes to an output channel",
+		Insert:       "safety ${1:channel}{${2:min}=$3}",
+		Kind:         protocol.CompletionItemKindKeyword,
+		InsertFormat: protocol.InsertTextFormatSnippet,
+		Category:     categoryTopLevelKeyword,
+	},
 	{
 		Label:        parser.LiteralFUNC,
 		Detail:       "func declaration",
@@ -302,6 +313,15 @@ var completions = []completionInfo{
 		InsertFormat: protocol.InsertTextFormatSnippet,
 		Category:     categoryTopLevelKeyword,
 	},
+	{
+		Label:        parser.LiteralENUM,
+		Detail:       "enum declaration",
+		Doc:          "Declares a set of named integer values",
+		Insert:       "enum ${1:Name} {\n\t${2:member},\n}",
+		Kind:         protocol.CompletionItemKindKeyword,
+		InsertFormat: protocol.InsertTextFormatSnippet,
+		Category:     categoryTopLevelKeyword,
+	},
 	{
 		Label:        parser.LiteralIF,
 		Detail:       "if statement",
@@ -347,6 +367,15 @@ var completions = []completionInfo{
 		InsertFormat: protocol.InsertTextFormatSnippet,
 		Category:     categoryFunctionKeyword,
 	},
+	{
+		Label:        parser.LiteralMATCH,
+		Detail:       "match statement",
+		Doc:          "Selects a branch by enum member or integer value",
+		Insert:       "match ${1:value} {\n\t${2:pattern} => {\n\t\t$0\n\t}\n}",
+		Kind:         protocol.CompletionItemKindKeyword,
+		InsertFormat: protocol.InsertTextFormatSnippet,
+		Category:     categoryFunctionKeyword,
+	},
 	{
 		Label:        parser.LiteralBREAK,
 		Detail:       "break statement",
@@ -491,7 +520,9 @@ func (s *Server) getCompletionItems(
 		items = append(items, item)
 	}
 
-	if completionCtx != ContextTypeAnnotation {
+	if completionCtx == ContextTypeAnnotation {
+		items = appendUserTypeCompletions(ctx, items, doc.findScopeAtPosition(pos), prefix)
+	} else {
 		var execFilter symbol.ExecContext
 		switch nesting {
 		case NestingFunction:
@@ -528,16 +559,30 @@ func (s *Server) getCompletionItems(
 				moduleImported = true
 				mod = mod.Target
 			}
-			if mod != nil && mod.Kind == symbol.KindModule {
+			// A library reached through the fallback has not been analyzed, so
+			// it has no members until we analyze it here.
+			if mod != nil && mod.IsLibrary() && len(mod.Children()) == 0 {
+				analyzer.AnalyzeLibrary(ctx, mod, parser.Config{AllowDashedNames: s.cfg.AllowDashedNames})
+			}
+			memberScope := s.memberAccessScope(ctx, doc, pos, memberPrefix)
+			if fieldType, ok := resolveStructPath(ctx, memberScope, moduleName); ok {
+				items = appendStructFieldCompletions(items, fieldType, modulePrefix, memberPrefix, pos, startChar)
+			} else if e := resolveEnum(ctx, memberScope, moduleName); e != nil {
+				items = appendEnumMemberCompletions(items, e, modulePrefix, memberPrefix, pos, startChar)
+			} else if mod != nil && mod.Kind == symbol.KindModule {
 				var importEdit []protocol.TextEdit
 				if !moduleImported {
 					importEdit = buildAutoImportEdit(doc, mod.Name)
 				}
 				for _, sym := range mod.Children() {
-					if sym.Kind != symbol.KindFunction || sym.Internal {
+					if sym.Kind != symbol.KindFunction && sym.Kind != symbol.KindGlobalConstant {
+						continue
+					}
+					if sym.Internal {
 						continue
 					}
-					if !sym.Exec.Compatible(execFilter) {
+					// Constants are values in every execution context.
+					if sym.Kind == symbol.KindFunction && !sym.Exec.Compatible(execFilter) {
 						continue
 					}
 					if memberPrefix != "" && !strings.HasPrefix(sym.Name, memberPrefix) {
@@ -587,6 +632,125 @@ func (s *Server) getCompletionItems(
 	return items
 }
 
+// memberAccessScope returns the scope at pos for completing a member access. While
+// "p." is being typed the document does not parse, so its last analysis has no scopes;
+// the content is then analyzed again with the trailing dot removed.
+func (s *Server) memberAccessScope(
+	ctx context.Context,
+	doc *Document,
+	pos protocol.Position,
+	memberPrefix string,
+) *symbol.Symbol {
+	if scope := doc.findScopeAtPosition(pos); scope != nil || memberPrefix != "" {
+		return scope
+	}
+	content := doc.displayContent()
+	offset := lsp.PositionToOffset(content, pos)
+	if offset <= 0 || offset > len(content) || content[offset-1] != '.' {
+		return nil
+	}
+	repaired := content[:offset-1] + content[offset:]
+	_, repairedIR, _ := s.analyze(ctx, repaired, doc.isBlock())
+	return findScopeAt(repairedIR.Symbols, doc.isBlock(), pos)
+}
+
+// appendUserTypeCompletions appends the struct and enum types visible from scope,
+// which are valid wherever a type annotation is expected.
+func appendUserTypeCompletions(
+	ctx context.Context,
+	items []protocol.CompletionItem,
+	scope *symbol.Symbol,
+	prefix string,
+) []protocol.CompletionItem {
+	if scope == nil {
+		return items
+	}
+	symbols, err := scope.Search(ctx, prefix)
+	if err != nil {
+		return items
+	}
+	for _, sym := range symbols {
+		if sym.Kind == symbol.KindStruct || sym.Kind == symbol.KindEnum {
+			items = append(items, symbolCompletionItem(sym))
+		}
+	}
+	return items
+}
+
+// appendStructFieldCompletions appends the fields of a struct value for a member
+// access prefix such as "p." or "s.start.x".
+func appendStructFieldCompletions(
+	items []protocol.CompletionItem,
+	t types.Type,
+	structPrefix, fieldPrefix string,
+	pos protocol.Position,
+	startChar uint32,
+) []protocol.CompletionItem {
+	for _, f := range t.Fields {
+		if !strings.HasPrefix(f.Name, fieldPrefix) {
+			continue
+		}
+		qualifiedName := structPrefix + f.Name
+		items = append(items, protocol.CompletionItem{
+			Label:      f.Name,
+			Kind:       protocol.CompletionItemKindField,
+			Detail:     f.Type.String(),
+			FilterText: qualifiedName,
+			TextEdit: &protocol.TextEdit{
+				Range: protocol.Range{
+					Start: protocol.Position{Line: pos.Line, Character: startChar},
+					End:   pos,
+				},
+				NewText: qualifiedName,
+			},
+		})
+	}
+	return items
+}
+
+// resolveEnum returns the enum named name visible from scope, or nil if there is none.
+func resolveEnum(ctx context.Context, scope *symbol.Symbol, name string) *symbol.Symbol {
+	if scope == nil {
+		return nil
+	}
+	sym, err := scope.Resolve(ctx, name, symbol.WithoutUsageTracking)
+	if err != nil || sym.Kind != symbol.KindEnum {
+		return nil
+	}
+	return sym
+}
+
+// appendEnumMemberCompletions appends the members of an enum for a member access
+// prefix such as "Mode." or "Mode.pr".
+func appendEnumMemberCompletions(
+	items []protocol.CompletionItem,
+	e *symbol.Symbol,
+	enumPrefix, memberPrefix string,
+	pos protocol.Position,
+	startChar uint32,
+) []protocol.CompletionItem {
+	for _, m := range e.FilterChildrenByKind(symbol.KindEnumMember) {
+		if !strings.HasPrefix(m.Name, memberPrefix) {
+			continue
+		}
+		qualifiedName := enumPrefix + m.Name
+		items = append(items, protocol.CompletionItem{
+			Label:      m.Name,
+			Kind:       protocol.CompletionItemKindEnumMember,
+			Detail:     fmt.Sprintf("%s = %d", qualifiedName, m.DefaultValue),
+			FilterText: qualifiedName,
+			TextEdit: &protocol.TextEdit{
+				Range: protocol.Range{
+					Start: protocol.Position{Line: pos.Line, Character: startChar},
+					End:   pos,
+				},
+				NewText: qualifiedName,
+			},
+		})
+	}
+	return items
+}
+
 func getAllowedCategories(ctx CompletionContext) completionCategory {
 	switch ctx {
 	case ContextTypeAnnotation:
@@ -615,6 +779,20 @@ func symbolCompletionItem(sym *symbol.Symbol) protocol.CompletionItem {
 			Detail: "module",
 		}
 	}
+	if sym.Kind == symbol.KindStruct {
+		return protocol.CompletionItem{
+			Label:  sym.Name,
+			Kind:   protocol.CompletionItemKindStruct,
+			Detail: "struct",
+		}
+	}
+	if sym.Kind == symbol.KindEnum {
+		return protocol.CompletionItem{
+			Label:  sym.Name,
+			Kind:   protocol.CompletionItemKindEnum,
+			Detail: "enum",
+		}
+	}
 	var (
 		kind   protocol.CompletionItemKind
 		detail string
@@ -929,7 +1107,7 @@ func appendModuleMemberCompletions(
 				continue
 			}
 			for _, member := range mod.Children() {
-				if member.Internal || member.Name == "" {
+				if member.Internal || member.Name == "" || member.Kind == symbol.KindModuleAlias {
 					continue
 				}
 				if member.Kind == symbol.KindFunction && !member.Exec.Compatible(execFilter) {
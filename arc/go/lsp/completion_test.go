@@ -558,6 +558,18 @@ var _ = Describe("Completion", func() {
 			Expect(HasCompletion(completions.Items, "authority")).To(BeTrue())
 		})
 
+		It("should suggest safety keyword at top level", func(ctx SpecContext) {
+			server = MustSucceed(lsp.New(lsp.Config{NewRoot: func() *symbol.Symbol { return NewRoot(nil, globalResolver...) }}))
+			server.SetClient(&MockClient{})
+
+			content := "saf"
+			OpenArcDocument(server, ctx, uri, content)
+
+			completions := Completion(server, ctx, uri, 0, 3)
+			Expect(completions).ToNot(BeNil())
+			Expect(HasCompletion(completions.Items, "safety")).To(BeTrue())
+		})
+
 		It("should suggest channels inside authority block", func(ctx SpecContext) {
 			server = MustSucceed(lsp.New(lsp.Config{NewRoot: func() *symbol.Symbol { return NewRoot(nil, globalResolver...) }}))
 			server.SetClient(&MockClient{})
@@ -1353,4 +1365,52 @@ var _ = Describe("Completion", func() {
 				"sequence main {\n    stage cat {\n        \n    }\n}", uint32(2), uint32(8), "next"),
 		)
 	})
+
+	Describe("Struct Completion", func() {
+		It("should complete the fields of a struct value", func(ctx SpecContext) {
+			content := "struct Point { x f64, y f64 }\nfunc test(p Point) f64 {\n    return p.\n}"
+			OpenArcDocument(server, ctx, uri, content)
+			completions := Completion(server, ctx, uri, 2, 13)
+			Expect(completions).ToNot(BeNil())
+			Expect(HasCompletion(completions.Items, "x")).To(BeTrue())
+			Expect(HasCompletion(completions.Items, "y")).To(BeTrue())
+		})
+
+		It("should complete the fields of a nested struct by prefix", func(ctx SpecContext) {
+			content := "struct Point { x f64, y f64 }\nstruct Box { min Point, max Point }\nfunc test(b Box) f64 {\n    return b.min.y\n}"
+			OpenArcDocument(server, ctx, uri, content)
+			completions := Completion(server, ctx, uri, 3, 18)
+			Expect(completions).ToNot(BeNil())
+			Expect(HasCompletion(completions.Items, "y")).To(BeTrue())
+			Expect(HasCompletion(completions.Items, "x")).To(BeFalse())
+		})
+
+		It("should offer struct types in type annotation position", func(ctx SpecContext) {
+			content := "struct Point { x f64, y f64 }\nfunc test(p Po) {\n}"
+			OpenArcDocument(server, ctx, uri, content)
+			completions := Completion(server, ctx, uri, 1, 14)
+			Expect(completions).ToNot(BeNil())
+			Expect(HasCompletion(completions.Items, "Point")).To(BeTrue())
+		})
+	})
+
+	Describe("Enum Completion", func() {
+		It("should complete the members of an enum", func(ctx SpecContext) {
+			content := "enum Mode { idle, press, fire }\nfunc test() i64 {\n    return Mode.\n}"
+			OpenArcDocument(server, ctx, uri, content)
+			completions := Completion(server, ctx, uri, 2, 16)
+			Expect(completions).ToNot(BeNil())
+			Expect(HasCompletion(completions.Items, "idle")).To(BeTrue())
+			Expect(HasCompletion(completions.Items, "press")).To(BeTrue())
+			Expect(HasCompletion(completions.Items, "fire")).To(BeTrue())
+		})
+
+		It("should offer enum types in type annotation position", func(ctx SpecContext) {
+			content := "enum Mode { idle, press }\nfunc test(m Mo) {\n}"
+			OpenArcDocument(server, ctx, uri, content)
+			completions := Completion(server, ctx, uri, 1, 14)
+			Expect(completions).ToNot(BeNil())
+			Expect(HasCompletion(completions.Items, "Mode")).To(BeTrue())
+		})
+	})
 })
@@ -282,9 +282,19 @@ var keywordDocs = map[string]string{
 		doc.Divider(),
 		doc.Paragraph("Must appear before all function, flow, and sequence declarations."),
 	).Render(),
+	parser.LiteralSAFETY: doc.New(
+		doc.TitleWithKind(parser.LiteralSAFETY, "Keyword"),
+		doc.Paragraph("Declares a safety envelope for a write channel. The runtime clamps every value written to the channel to min and max, limits its change per second to max_rate, and holds it at safe_value while any interlock condition holds. Violations are reported as warnings, and can enter a safe stage."),
+		doc.Divider(),
+		doc.Code("arc", "safety valve_cmd{min=0, max=100, max_rate=20}"),
+		doc.Divider(),
+		doc.Paragraph("Interlocks compare other channels to constants, joined with or:"),
+		doc.Divider(),
+		doc.Code("arc", "safety valve_cmd{\n    interlock=pressure > 500 or temp > 80,\n    safe_value=0,\n    safe_stage=main.abort\n}"),
+	).Render(),
 	parser.LiteralIMPORT: doc.New(
 		doc.TitleWithKind(parser.LiteralIMPORT, "Keyword"),
-		doc.Paragraph("Imports modules so their qualified members can be used. A module must be imported before its dotted members (e.g. time.now, control.set_authority) can be referenced."),
+		doc.Paragraph("Imports modules so their qualified members can be used. A module must be imported before its dotted members (e.g. time.now, control.set_authority) can be referenced. Library modules always resolve to their latest stored version."),
 		doc.Divider(),
 		doc.Code("arc", "import ( time control )"),
 		doc.Divider(),
@@ -330,6 +340,9 @@ func (s *Server) extractDocComment(content string, sym *symbol.Symbol) string {
 	if sym.AST == nil {
 		return ""
 	}
+	if lib := sym.Library(); lib != nil {
+		content = librarySource(lib)
+	}
 	start := sym.AST.GetStart()
 	if start == nil {
 		return ""
@@ -369,6 +382,17 @@ func (s *Server) extractDocComment(content string, sym *symbol.Symbol) string {
 	return cleanDocComment(commentTokens)
 }
 
+// librarySource returns the source text of lib, which is declared outside of
+// the document being served.
+func librarySource(lib *symbol.Symbol) string {
+	start := lib.AST.GetStart()
+	if start == nil || start.GetInputStream() == nil {
+		return ""
+	}
+	stream := start.GetInputStream()
+	return stream.GetText(0, stream.Size()-1)
+}
+
 func hasCodeBetween(tokens []antlr.Token, fromIndex int, targetLine int) bool {
 	startLine := tokens[fromIndex].GetLine()
 	commentText := tokens[fromIndex].GetText()
@@ -449,12 +473,96 @@ func resolveDotted(
 	return resolveDotted(ctx, sym, tail)
 }
 
+// resolveStructPath resolves a dotted path into a struct value, such as "p" or
+// "s.start", returning the type of the value the path refers to.
+func resolveStructPath(
+	ctx context.Context,
+	scope *symbol.Symbol,
+	path string,
+) (types.Type, bool) {
+	if scope == nil {
+		return types.Type{}, false
+	}
+	head, tail, hasDot := strings.Cut(path, ".")
+	sym, err := scope.Resolve(ctx, head, symbol.WithoutUsageTracking)
+	if err != nil || !sym.IsStructValue() {
+		return types.Type{}, false
+	}
+	t := sym.Type
+	for hasDot {
+		var name string
+		name, tail, hasDot = strings.Cut(tail, ".")
+		f, _, ok := t.Field(name)
+		if !ok {
+			return types.Type{}, false
+		}
+		t = f.Type
+	}
+	return t, true
+}
+
+func structFieldHover(
+	ctx context.Context,
+	scope *symbol.Symbol,
+	name string,
+) string {
+	dot := strings.LastIndex(name, ".")
+	if dot < 0 {
+		return ""
+	}
+	owner, ok := resolveStructPath(ctx, scope, name[:dot])
+	if !ok {
+		return ""
+	}
+	f, _, ok := owner.Field(name[dot+1:])
+	if !ok {
+		return ""
+	}
+	d := doc.New(doc.TitleWithKind(name, "Field"))
+	d.Add(doc.Detail("Type", f.Type.String(), true))
+	d.Add(doc.Paragraph(fmt.Sprintf("Field of struct `%s`", owner.Name)))
+	return d.Render()
+}
+
+// formatStructDeclaration returns the declaration of a struct type without code
+// fences.
+func formatStructDeclaration(t types.Type) string {
+	var decl strings.Builder
+	decl.WriteString("struct ")
+	decl.WriteString(t.Name)
+	decl.WriteString(" {")
+	for _, f := range t.Fields {
+		_, _ = fmt.Fprintf(&decl, "\n    %s %s", f.Name, f.Type)
+	}
+	decl.WriteString("\n}")
+	return decl.String()
+}
+
+func formatEnumDeclaration(e *symbol.Symbol) string {
+	var decl strings.Builder
+	decl.WriteString("enum ")
+	decl.WriteString(e.Name)
+	if !types.Equal(e.Type, types.I64()) {
+		decl.WriteString(" ")
+		decl.WriteString(e.Type.String())
+	}
+	decl.WriteString(" {")
+	for _, m := range e.FilterChildrenByKind(symbol.KindEnumMember) {
+		_, _ = fmt.Fprintf(&decl, "\n    %s = %v", m.Name, m.DefaultValue)
+	}
+	decl.WriteString("\n}")
+	return decl.String()
+}
+
 func (s *Server) getUserSymbolHover(
 	ctx context.Context,
 	scope *symbol.Symbol,
 	name string,
 	content string,
 ) string {
+	if fieldHover := structFieldHover(ctx, scope, name); fieldHover != "" {
+		return fieldHover
+	}
 	sym, err := resolveDotted(ctx, scope, name)
 	if err != nil {
 		return ""
@@ -508,6 +616,18 @@ func (s *Server) getUserSymbolHover(
 		}
 	case symbol.KindStage:
 		d = doc.New(doc.TitleWithKind(displayName, "Stage"))
+	case symbol.KindStruct:
+		d = doc.New(doc.TitleWithKind(displayName, "Struct"))
+		d.Add(doc.Divider())
+		d.Add(doc.Code("arc", formatStructDeclaration(sym.Type)))
+	case symbol.KindEnum:
+		d = doc.New(doc.TitleWithKind(displayName, "Enum"))
+		d.Add(doc.Divider())
+		d.Add(doc.Code("arc", formatEnumDeclaration(sym)))
+	case symbol.KindEnumMember:
+		d = doc.New(doc.TitleWithKind(displayName, "Enum Member"))
+		d.Add(doc.Detail("Value", fmt.Sprint(sym.DefaultValue), true))
+		d.Add(doc.Detail("Type", sym.Type.String(), true))
 	default:
 		d = doc.New(doc.Title(displayName))
 		d.Add(doc.Detail("Type", sym.Type.String(), true))
@@ -609,7 +729,7 @@ func formatSequenceStagesList(sym *symbol.Symbol) []string {
 func formatModuleMembersList(sym *symbol.Symbol) []string {
 	var members []string
 	for _, child := range sym.Children() {
-		if child.Internal || child.Name == "" {
+		if child.Internal || child.Name == "" || child.Kind == symbol.KindModuleAlias {
 			continue
 		}
 		members = append(members, "`"+child.Name+"`")
@@ -617,12 +737,13 @@ func formatModuleMembersList(sym *symbol.Symbol) []string {
 	return members
 }
 
-// symbolToLocation converts a symbol to an LSP Location pointing to its definition
+// symbolToLocation converts a symbol to an LSP Location pointing to its definition.
+// Symbols declared in a library have no location in the document.
 func (s *Server) symbolToLocation(
 	uri protocol.DocumentURI,
 	sym *symbol.Symbol,
 ) *protocol.Location {
-	if sym.AST == nil {
+	if sym.AST == nil || sym.Library() != nil {
 		return nil
 	}
 	start := sym.AST.GetStart()
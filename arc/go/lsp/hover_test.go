@@ -48,6 +48,7 @@ var _ = Describe("Hover", func() {
 		Entry("return", "return 42", uint32(3), "return", ""),
 		Entry("sequence", "sequence main { stage first {} }", uint32(4), "sequence", "state machine"),
 		Entry("authority", "authority 200", uint32(4), "authority", "control authority"),
+		Entry("safety", "safety valve{max=10}", uint32(3), "safety", "safety envelope"),
 	)
 
 	DescribeTable("type hover with range",
@@ -931,4 +932,65 @@ func add(a i32, b i32) i32 {
 			Expect(tokens.Data[3]).To(Equal(uint32(lsp.SemanticTokenTypeKeyword)))
 		})
 	})
+
+	Describe("Structs", func() {
+		const content = `struct Point { x f64, y f64 }
+struct Segment { start Point, end Point }
+
+func length(s Segment) f64 {
+    return s.end.x - s.start.x
+}`
+
+		It("should show the declaration of a struct type", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, content)
+			hover := Hover(server, ctx, uri, 3, 15)
+			Expect(hover).ToNot(BeNil())
+			Expect(hover.Contents.Value).To(ContainSubstring("#### Segment"))
+			Expect(hover.Contents.Value).To(ContainSubstring("Struct"))
+			Expect(hover.Contents.Value).To(ContainSubstring("start Point"))
+		})
+
+		It("should show the type of a struct field", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, content)
+			hover := Hover(server, ctx, uri, 4, 18)
+			Expect(hover).ToNot(BeNil())
+			Expect(hover.Contents.Value).To(ContainSubstring("#### s.end.x"))
+			Expect(hover.Contents.Value).To(ContainSubstring("f64"))
+			Expect(hover.Contents.Value).To(ContainSubstring("Field of struct `Point`"))
+		})
+
+		It("should show a nested struct field", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, content)
+			hover := Hover(server, ctx, uri, 4, 14)
+			Expect(hover).ToNot(BeNil())
+			Expect(hover.Contents.Value).To(ContainSubstring("#### s.end"))
+			Expect(hover.Contents.Value).To(ContainSubstring("Field of struct `Segment`"))
+		})
+	})
+
+	Describe("Enums", func() {
+		const content = `enum Valve u8 { closed, open = 4 }
+
+func toggle(v Valve) u8 {
+    return v == Valve.open
+}`
+
+		It("should show the declaration of an enum type", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, content)
+			hover := Hover(server, ctx, uri, 2, 16)
+			Expect(hover).ToNot(BeNil())
+			Expect(hover.Contents.Value).To(ContainSubstring("#### Valve"))
+			Expect(hover.Contents.Value).To(ContainSubstring("Enum"))
+			Expect(hover.Contents.Value).To(ContainSubstring("enum Valve u8 {"))
+			Expect(hover.Contents.Value).To(ContainSubstring("open = 4"))
+		})
+
+		It("should show the value of an enum member", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, content)
+			hover := Hover(server, ctx, uri, 3, 24)
+			Expect(hover).ToNot(BeNil())
+			Expect(hover.Contents.Value).To(ContainSubstring("Enum Member"))
+			Expect(hover.Contents.Value).To(ContainSubstring("4"))
+		})
+	})
 })
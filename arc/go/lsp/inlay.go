@@ -0,0 +1,112 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package lsp
+
+import (
+	"context"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/lsp/protocol"
+)
+
+// InlayHint returns the inferred type of every declaration in the requested range
+// that omits an explicit type (x := 1.5, count $= 0). Types include their unit,
+// so a value derived from a pressure channel reads "f64 psi".
+func (s *Server) InlayHint(
+	_ context.Context,
+	params *protocol.InlayHintParams,
+) ([]protocol.InlayHint, error) {
+	doc, ok := s.getDocument(params.TextDocument.URI)
+	if !ok || doc.IR.Symbols == nil {
+		return []protocol.InlayHint{}, nil
+	}
+	hints := []protocol.InlayHint{}
+	collectInlayHints(doc, doc.IR.Symbols, params.Range, &hints)
+	return hints, nil
+}
+
+func collectInlayHints(
+	doc *Document,
+	scope *symbol.Symbol,
+	rng protocol.Range,
+	hints *[]protocol.InlayHint,
+) {
+	for _, child := range scope.Children() {
+		if child.Library() != nil {
+			continue
+		}
+		if name := untypedDeclarationName(child); name != nil && isHintableType(child.Type) {
+			pos := doc.toDocPosition(tokenRange(name).End)
+			if isPositionInProtocolRange(pos, rng) {
+				*hints = append(*hints, protocol.InlayHint{
+					Position:    pos,
+					Label:       child.Type.String(),
+					Kind:        protocol.InlayHintKindType,
+					PaddingLeft: true,
+				})
+			}
+		}
+		collectInlayHints(doc, child, rng, hints)
+	}
+}
+
+// untypedDeclarationName returns the name token of the declaration of sym when
+// the declaration leaves the type to inference, and nil otherwise.
+func untypedDeclarationName(sym *symbol.Symbol) antlr.Token {
+	switch sym.Kind {
+	case symbol.KindVariable, symbol.KindStatefulVariable, symbol.KindGlobalConstant:
+	default:
+		return nil
+	}
+	switch decl := sym.AST.(type) {
+	case parser.ILocalVariableContext:
+		if decl.Type_() == nil && decl.IDENTIFIER() != nil {
+			return decl.IDENTIFIER().GetSymbol()
+		}
+	case parser.IStatefulVariableContext:
+		if decl.Type_() == nil && decl.IDENTIFIER() != nil {
+			return decl.IDENTIFIER().GetSymbol()
+		}
+	case parser.IGlobalConstantContext:
+		if decl.Type_() == nil && decl.IDENTIFIER() != nil {
+			return decl.IDENTIFIER().GetSymbol()
+		}
+	}
+	return nil
+}
+
+// isHintableType reports whether t is a concrete type worth showing. Unresolved
+// type variables and untyped constants would only display their constraint.
+func isHintableType(t types.Type) bool {
+	if !t.IsValid() {
+		return false
+	}
+	switch t.Kind {
+	case types.KindVariable,
+		types.KindNumericConstant,
+		types.KindIntegerConstant,
+		types.KindFloatConstant,
+		types.KindExactIntegerFloatConstant:
+		return false
+	default:
+		return true
+	}
+}
+
+func isPositionInProtocolRange(pos protocol.Position, rng protocol.Range) bool {
+	afterStart := pos.Line > rng.Start.Line ||
+		(pos.Line == rng.Start.Line && pos.Character >= rng.Start.Character)
+	beforeEnd := pos.Line < rng.End.Line ||
+		(pos.Line == rng.End.Line && pos.Character <= rng.End.Character)
+	return afterStart && beforeEnd
+}
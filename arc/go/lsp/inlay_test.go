@@ -0,0 +1,90 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package lsp_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/lsp"
+	. "github.com/synnaxlabs/arc/lsp/testutil"
+	"github.com/synnaxlabs/x/lsp/protocol"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("InlayHint", func() {
+	var (
+		server *lsp.Server
+		uri    protocol.DocumentURI
+	)
+
+	BeforeEach(func() {
+		server, uri = SetupTestServer()
+	})
+
+	wholeDocument := protocol.Range{
+		Start: protocol.Position{Line: 0, Character: 0},
+		End:   protocol.Position{Line: 100, Character: 0},
+	}
+
+	inlayHints := func(ctx SpecContext, rng protocol.Range) []protocol.InlayHint {
+		return MustSucceed(server.InlayHint(ctx, &protocol.InlayHintParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+			Range:        rng,
+		}))
+	}
+
+	It("Should show the inferred type of untyped declarations", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `func test(a f32) {
+    x := a * 2
+    total $= 0.0
+}`)
+		hints := inlayHints(ctx, wholeDocument)
+		Expect(hints).To(HaveLen(2))
+		Expect(hints[0].Label).To(Equal("f32"))
+		Expect(hints[0].Position).To(Equal(protocol.Position{Line: 1, Character: 5}))
+		Expect(hints[0].Kind).To(Equal(protocol.InlayHintKindType))
+		Expect(hints[0].PaddingLeft).To(BeTrue())
+		Expect(hints[1].Label).To(Equal("f64"))
+		Expect(hints[1].Position).To(Equal(protocol.Position{Line: 2, Character: 9}))
+	})
+
+	It("Should include unit dimensions in the hinted type", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `func test() {
+    p := 5.0psi
+}`)
+		hints := inlayHints(ctx, wholeDocument)
+		Expect(hints).To(HaveLen(1))
+		Expect(hints[0].Label).To(Equal("f64 psi"))
+	})
+
+	It("Should not hint declarations with an explicit type", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `func test() {
+    x f32 := 1.0
+}`)
+		Expect(inlayHints(ctx, wholeDocument)).To(BeEmpty())
+	})
+
+	It("Should only hint declarations within the requested range", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `func test() {
+    x := 1.0
+    y := 2.0
+}`)
+		hints := inlayHints(ctx, protocol.Range{
+			Start: protocol.Position{Line: 2, Character: 0},
+			End:   protocol.Position{Line: 3, Character: 0},
+		})
+		Expect(hints).To(HaveLen(1))
+		Expect(hints[0].Position.Line).To(Equal(uint32(2)))
+	})
+
+	It("Should return no hints for an unknown document", func(ctx SpecContext) {
+		Expect(inlayHints(ctx, wholeDocument)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,69 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package lsp_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc"
+	"github.com/synnaxlabs/arc/lsp"
+	. "github.com/synnaxlabs/arc/lsp/testutil"
+	"github.com/synnaxlabs/arc/symbol"
+	. "github.com/synnaxlabs/arc/symbol/testutil"
+	"github.com/synnaxlabs/x/lsp/protocol"
+	. "github.com/synnaxlabs/x/lsp/testutil"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Libraries", func() {
+	var (
+		server *lsp.Server
+		uri    protocol.DocumentURI
+	)
+
+	BeforeEach(func() {
+		conv := *MustSucceed(arc.NewLibrary("conv", arc.Text{Raw: `
+OFFSET f64 := 32.0
+
+// c_to_f converts a temperature from celsius to fahrenheit.
+func c_to_f(c f64) f64 {
+    return c * 1.8 + OFFSET
+}
+`}))
+		server = MustSucceed(lsp.New(lsp.Config{
+			NewRoot: func() *symbol.Symbol { return NewRoot(nil, conv) },
+		}))
+		server.SetClient(&MockClient{})
+		uri = "file:///test.arc"
+	})
+
+	content := "import conv\n\nfunc to_f(c f64) f64 {\n    return conv.c_to_f(c)\n}"
+
+	It("Should complete the members of an imported library", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, "import conv\n\nfunc f() f64 {\n    return conv.\n}")
+		completions := Completion(server, ctx, uri, 3, 16)
+		Expect(completions).ToNot(BeNil())
+		Expect(HasCompletion(completions.Items, "c_to_f")).To(BeTrue())
+		Expect(HasCompletion(completions.Items, "OFFSET")).To(BeTrue())
+	})
+
+	It("Should show the doc comment of a library function on hover", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, content)
+		hover := Hover(server, ctx, uri, 3, 17)
+		Expect(hover).ToNot(BeNil())
+		Expect(hover.Contents.Value).To(ContainSubstring("c_to_f"))
+		Expect(hover.Contents.Value).To(ContainSubstring("converts a temperature"))
+	})
+
+	It("Should not return a definition inside the document for a library function", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, content)
+		Expect(Definition(server, ctx, uri, 3, 17)).To(BeEmpty())
+	})
+})
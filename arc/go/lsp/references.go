@@ -0,0 +1,62 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package lsp
+
+import (
+	"context"
+	"slices"
+
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/x/lsp/protocol"
+)
+
+func isReferenceable(sym *symbol.Symbol, err error) bool {
+	return err == nil && sym != nil
+}
+
+// References returns every location in the document that refers to the symbol
+// under the cursor. Channels and other globals match by kind and name, so all
+// reads and writes of a channel are found even though it has no declaration in
+// the document.
+func (s *Server) References(
+	ctx context.Context,
+	params *protocol.ReferenceParams,
+) ([]protocol.Location, error) {
+	doc, ok := s.getDocument(params.TextDocument.URI)
+	if !ok || doc.IR.Symbols == nil {
+		return nil, nil
+	}
+	sym, err := doc.resolveSymbolAtPosition(ctx, params.Position)
+	if !isReferenceable(sym, err) {
+		s.logUnexpectedSymbolError(sym, err)
+		return nil, nil
+	}
+	occurrences := s.symbolOccurrences(ctx, doc, sym, isReferenceable)
+	if !params.Context.IncludeDeclaration {
+		occurrences = withoutDeclaration(sym, occurrences)
+	}
+	locations := make([]protocol.Location, len(occurrences))
+	for i, r := range occurrences {
+		locations[i] = protocol.Location{URI: params.TextDocument.URI, Range: r}
+	}
+	return doc.toDocLocations(locations), nil
+}
+
+// withoutDeclaration drops the occurrence of the identifier that declares sym.
+func withoutDeclaration(sym *symbol.Symbol, occurrences []protocol.Range) []protocol.Range {
+	name := declarationNameToken(sym)
+	if name == nil {
+		return occurrences
+	}
+	declStart := tokenRange(name).Start
+	return slices.DeleteFunc(occurrences, func(r protocol.Range) bool {
+		return r.Start == declStart
+	})
+}
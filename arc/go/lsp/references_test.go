@@ -0,0 +1,135 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package lsp_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/lsp"
+	. "github.com/synnaxlabs/arc/lsp/testutil"
+	"github.com/synnaxlabs/arc/symbol"
+	. "github.com/synnaxlabs/arc/symbol/testutil"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/lsp/protocol"
+	. "github.com/synnaxlabs/x/lsp/testutil"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("References", func() {
+	var (
+		server *lsp.Server
+		uri    protocol.DocumentURI
+	)
+
+	BeforeEach(func() {
+		server = MustSucceed(lsp.New(lsp.Config{NewRoot: func() *symbol.Symbol {
+			return NewRoot(nil, symbol.Symbol{
+				Name: "sensor",
+				Type: types.Chan(types.F32()),
+				Kind: symbol.KindChannel,
+				ID:   1,
+			})
+		}}))
+		server.SetClient(&MockClient{})
+		uri = "file:///test.arc"
+	})
+
+	references := func(
+		ctx SpecContext,
+		line, char uint32,
+		includeDeclaration bool,
+	) []protocol.Location {
+		return MustSucceed(server.References(ctx, &protocol.ReferenceParams{
+			TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+				Position:     protocol.Position{Line: line, Character: char},
+			},
+			Context: protocol.ReferenceContext{IncludeDeclaration: includeDeclaration},
+		}))
+	}
+
+	lines := func(locations []protocol.Location) []uint32 {
+		result := make([]uint32, len(locations))
+		for i, loc := range locations {
+			result[i] = loc.Range.Start.Line
+		}
+		return result
+	}
+
+	It("Should find every use of a local variable including its declaration", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `func test() {
+    x i32 := 42
+    y := x + 10
+    z := x * 2
+}`)
+		locations := references(ctx, 2, 9, true) // y := x| + 10
+		Expect(lines(locations)).To(Equal([]uint32{1, 2, 3}))
+		Expect(locations[0].URI).To(Equal(uri))
+		Expect(locations[0].Range.Start.Character).To(Equal(uint32(4)))
+		Expect(locations[0].Range.End.Character).To(Equal(uint32(5)))
+	})
+
+	It("Should omit the declaration when not requested", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `func test() {
+    x i32 := 42
+    y := x + 10
+    z := x * 2
+}`)
+		Expect(lines(references(ctx, 1, 4, false))).To(Equal([]uint32{2, 3}))
+	})
+
+	It("Should not match a shadowing variable of the same name in another function", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `func a() {
+    x := 1
+    y := x
+}
+
+func b() {
+    x := 2
+    y := x
+}`)
+		Expect(lines(references(ctx, 2, 9, true))).To(Equal([]uint32{1, 2}))
+	})
+
+	It("Should find every use of a channel across functions and flows", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `func double() f32 {
+    return sensor * 2
+}
+
+func check() {
+    v := sensor
+}`)
+		Expect(lines(references(ctx, 1, 12, true))).To(Equal([]uint32{1, 5}))
+	})
+
+	It("Should find transitions to a stage", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `sequence main {
+    stage idle {
+        sensor > 10 => firing
+    }
+    stage firing {
+        sensor < 5 => idle
+    }
+}`)
+		Expect(lines(references(ctx, 4, 11, true))).To(Equal([]uint32{2, 4}))
+		Expect(lines(references(ctx, 4, 11, false))).To(Equal([]uint32{2}))
+	})
+
+	It("Should return nil for an unknown symbol", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, `func test() {
+    return
+}`)
+		Expect(references(ctx, 1, 6, true)).To(BeNil())
+	})
+
+	It("Should return nil when the document is not open", func(ctx SpecContext) {
+		Expect(references(ctx, 0, 0, true)).To(BeNil())
+	})
+})
@@ -40,6 +40,11 @@ func isRenameable(sym *symbol.Symbol, err error) bool {
 		sym.Name == sym.Target.Name {
 		return false
 	}
+	// Library members are declared outside of the document, so renaming them
+	// would only break the references to them.
+	if sym.Library() != nil {
+		return false
+	}
 	// Source-defined symbols rename via text edits alone. Resolver-supplied
 	// symbols opt in via Symbol.Renameable; the host's OnRename callback is
 	// responsible for propagating the rename to the underlying resource.
@@ -95,17 +100,36 @@ func (s *Server) Rename(
 	}, nil
 }
 
-// renameTextEdits walks every identifier token in doc, keeps the ones that
-// resolve to the same symbol as targetSym, and emits a TextEdit per
-// occurrence that replaces the token with newName. The block-mode column
-// shift is applied inline via toDocRange so the walk produces protocol-
-// ready edits in a single pass instead of a Location → TextEdit hop.
+// renameTextEdits emits a TextEdit replacing each occurrence of targetSym in
+// doc with newName. The block-mode column shift is applied inline via
+// toDocRange so the edits are protocol-ready.
 func (s *Server) renameTextEdits(
 	ctx context.Context,
 	doc *Document,
 	targetSym *symbol.Symbol,
 	newName string,
 ) []protocol.TextEdit {
+	occurrences := s.symbolOccurrences(ctx, doc, targetSym, isRenameable)
+	if len(occurrences) == 0 {
+		return nil
+	}
+	edits := make([]protocol.TextEdit, len(occurrences))
+	for i, r := range occurrences {
+		edits[i] = protocol.TextEdit{Range: doc.toDocRange(r), NewText: newName}
+	}
+	return edits
+}
+
+// symbolOccurrences walks every identifier token in doc and returns the range of
+// each one that resolves to the same symbol as targetSym, in document order.
+// Ranges are in analyzed-content coordinates; callers apply toDocRange. keep
+// filters each resolved symbol before it is compared against targetSym.
+func (s *Server) symbolOccurrences(
+	ctx context.Context,
+	doc *Document,
+	targetSym *symbol.Symbol,
+	keep func(*symbol.Symbol, error) bool,
+) []protocol.Range {
 	if doc.IR.Symbols == nil || targetSym == nil {
 		return nil
 	}
@@ -113,7 +137,7 @@ func (s *Server) renameTextEdits(
 	// channels) have no AST and instead match by kind + name, since every
 	// in-scope reference to the same name resolves to the same global symbol.
 	matchByAST := targetSym.AST != nil
-	var edits []protocol.TextEdit
+	var ranges []protocol.Range
 	for _, t := range tokenizeContent(doc.Content) {
 		if t.GetTokenType() != parser.ArcLexerIDENTIFIER {
 			continue
@@ -125,7 +149,7 @@ func (s *Server) renameTextEdits(
 		pos := position{Line: t.GetLine(), Col: t.GetColumn()}
 		scope := findScopeAtInternalPosition(doc.IR.Symbols, pos)
 		sym, err := scope.Resolve(ctx, tokenText, symbol.WithoutUsageTracking)
-		if !isRenameable(sym, err) {
+		if !keep(sym, err) {
 			continue
 		}
 		s.logUnexpectedSymbolError(sym, err)
@@ -136,19 +160,16 @@ func (s *Server) renameTextEdits(
 		} else if sym.Kind != targetSym.Kind || sym.AST != nil {
 			continue
 		}
-		edits = append(edits, protocol.TextEdit{
-			Range: doc.toDocRange(protocol.Range{
-				Start: protocol.Position{
-					Line:      uint32(pos.Line - 1),
-					Character: uint32(pos.Col),
-				},
-				End: protocol.Position{
-					Line:      uint32(pos.Line - 1),
-					Character: uint32(pos.Col + len(tokenText)),
-				},
-			}),
-			NewText: newName,
+		ranges = append(ranges, protocol.Range{
+			Start: protocol.Position{
+				Line:      uint32(pos.Line - 1),
+				Character: uint32(pos.Col),
+			},
+			End: protocol.Position{
+				Line:      uint32(pos.Line - 1),
+				Character: uint32(pos.Col + len(tokenText)),
+			},
 		})
 	}
-	return edits
+	return ranges
 }
@@ -368,8 +368,10 @@ func mapSymbolKind(kind symbol.Kind) *uint32 {
 		tokenType = SemanticTokenTypeFunction
 	case symbol.KindVariable:
 		tokenType = SemanticTokenTypeVariable
-	case symbol.KindConstant, symbol.KindGlobalConstant:
+	case symbol.KindConstant, symbol.KindGlobalConstant, symbol.KindEnumMember:
 		tokenType = SemanticTokenTypeConstant
+	case symbol.KindEnum:
+		tokenType = SemanticTokenTypeType
 	case symbol.KindStatefulVariable:
 		tokenType = SemanticTokenTypeStatefulVariable
 	case symbol.KindConfig:
@@ -403,8 +405,9 @@ func mapLexerTokenType(antlrType int) *uint32 {
 		parser.ArcLexerFOR, parser.ArcLexerBREAK, parser.ArcLexerCONTINUE,
 		parser.ArcLexerSEQUENCE, parser.ArcLexerSTAGE,
 		parser.ArcLexerNEXT, parser.ArcLexerAND, parser.ArcLexerOR,
-		parser.ArcLexerNOT, parser.ArcLexerAUTHORITY,
-		parser.ArcLexerIMPORT, parser.ArcLexerAS:
+		parser.ArcLexerNOT, parser.ArcLexerAUTHORITY, parser.ArcLexerSAFETY,
+		parser.ArcLexerIMPORT, parser.ArcLexerAS,
+		parser.ArcLexerSTRUCT, parser.ArcLexerENUM, parser.ArcLexerMATCH:
 		tokenType = SemanticTokenTypeKeyword
 	case parser.ArcLexerI8, parser.ArcLexerI16, parser.ArcLexerI32, parser.ArcLexerI64,
 		parser.ArcLexerU8, parser.ArcLexerU16, parser.ArcLexerU32, parser.ArcLexerU64,
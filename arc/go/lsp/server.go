@@ -148,8 +148,18 @@ func New(cfgs ...Config) (*Server, error) {
 					parser.LiteralDOT,
 				},
 			},
+			SignatureHelpProvider: &protocol.SignatureHelpOptions{
+				TriggerCharacters: []string{
+					parser.LiteralLPAREN,
+					parser.LiteralLBRACE,
+					parser.LiteralCOMMA,
+				},
+			},
 			DefinitionProvider:              true,
+			ReferencesProvider:              true,
 			DocumentSymbolProvider:          true,
+			WorkspaceSymbolProvider:         true,
+			InlayHintProvider:               true,
 			DocumentFormattingProvider:      true,
 			DocumentRangeFormattingProvider: true,
 			FoldingRangeProvider:            true,
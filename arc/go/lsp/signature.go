@@ -0,0 +1,244 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/lsp/protocol"
+	"github.com/synnaxlabs/x/set"
+)
+
+// callSite describes the innermost unclosed call or config block that encloses
+// the cursor.
+type callSite struct {
+	// name is the (possibly qualified) name of the function being called.
+	name string
+	// config is true when the cursor sits in a config block ({...}) rather than an
+	// argument list ((...)).
+	config bool
+	// argIndex is the zero-based index of the positional argument under the
+	// cursor. Only meaningful for argument lists.
+	argIndex int
+	// paramName is the config parameter being written, if one has been named.
+	paramName string
+	// assigned holds the config parameters already written before the cursor.
+	assigned []string
+}
+
+// SignatureHelp returns the signature of the function whose argument list or
+// config block encloses the cursor, with the parameter under the cursor active.
+func (s *Server) SignatureHelp(
+	ctx context.Context,
+	params *protocol.SignatureHelpParams,
+) (*protocol.SignatureHelp, error) {
+	doc, ok := s.getDocument(params.TextDocument.URI)
+	if !ok {
+		return nil, nil
+	}
+	tokens := getTokensBeforeCursor(tokenizeContent(doc.displayContent()), params.Position)
+	call := findCallSite(tokens)
+	if call == nil {
+		return nil, nil
+	}
+	var scope *symbol.Symbol
+	if doc.IR.Symbols != nil {
+		scope = doc.findScopeAtPosition(params.Position)
+	} else {
+		scope = s.cfg.NewRoot()
+	}
+	sym, err := resolveDotted(ctx, scope, call.name)
+	if err != nil || sym.Type.Kind != types.KindFunction {
+		return nil, nil
+	}
+	fnParams := sym.Type.Inputs
+	if call.config {
+		fnParams = sym.Type.Config
+	}
+	if len(fnParams) == 0 {
+		return nil, nil
+	}
+	info := protocol.SignatureInformation{
+		Label:      formatSignatureLabel(sym),
+		Parameters: make([]protocol.ParameterInformation, len(fnParams)),
+	}
+	if blocks := sym.Doc.Blocks(); len(blocks) > 0 {
+		info.Documentation = protocol.MarkupContent{
+			Kind:  protocol.Markdown,
+			Value: sym.Doc.Render(),
+		}
+	}
+	for i, p := range fnParams {
+		info.Parameters[i] = protocol.ParameterInformation{Label: formatParam(p)}
+		if p.Value != nil {
+			info.Parameters[i].Documentation = fmt.Sprintf("default: %v", p.Value)
+		}
+	}
+	return &protocol.SignatureHelp{
+		Signatures:      []protocol.SignatureInformation{info},
+		ActiveParameter: uint32(activeParameter(call, fnParams)),
+	}, nil
+}
+
+func activeParameter(call *callSite, fnParams types.Params) int {
+	if !call.config {
+		return min(call.argIndex, len(fnParams)-1)
+	}
+	if call.paramName != "" {
+		for i, p := range fnParams {
+			if p.Name == call.paramName {
+				return i
+			}
+		}
+	}
+	// No parameter named yet: point at the first one still left to write.
+	assigned := set.New(call.assigned...)
+	for i, p := range fnParams {
+		if !assigned.Contains(p.Name) {
+			return i
+		}
+	}
+	return 0
+}
+
+// findCallSite walks backward from the cursor to the innermost unclosed
+// parenthesis or brace and reports the call it belongs to. It returns nil when
+// that delimiter does not follow a function name, e.g. a function body, a stage
+// body or a parenthesized expression.
+func findCallSite(tokens []antlr.Token) *callSite {
+	depth := 0
+	commas := 0
+	for i := len(tokens) - 1; i >= 0; i-- {
+		switch tokens[i].GetTokenType() {
+		case parser.ArcLexerRPAREN, parser.ArcLexerRBRACE, parser.ArcLexerRBRACKET:
+			depth++
+		case parser.ArcLexerLBRACKET:
+			if depth == 0 {
+				return nil
+			}
+			depth--
+		case parser.ArcLexerCOMMA:
+			if depth == 0 {
+				commas++
+			}
+		case parser.ArcLexerLPAREN, parser.ArcLexerLBRACE:
+			if depth > 0 {
+				depth--
+				continue
+			}
+			name, ok := calleeName(tokens[:i])
+			if !ok {
+				return nil
+			}
+			call := &callSite{name: name, argIndex: commas}
+			if tokens[i].GetTokenType() == parser.ArcLexerLBRACE {
+				call.config = true
+				call.paramName, call.assigned = configParamsBeforeCursor(tokens[i+1:])
+			}
+			return call
+		}
+	}
+	return nil
+}
+
+// calleeName returns the (possibly qualified) function name that ends the given
+// tokens, provided it is not the name of a declaration.
+func calleeName(tokens []antlr.Token) (string, bool) {
+	n := len(tokens)
+	if n == 0 {
+		return "", false
+	}
+	start := n - 1
+	last := tokens[start].GetTokenType()
+	// Qualified members may be named by a keyword (stable.for).
+	isQualifiedKeyword := last == parser.ArcLexerFOR &&
+		start > 0 && tokens[start-1].GetTokenType() == parser.ArcLexerDOT
+	if last != parser.ArcLexerIDENTIFIER && !isQualifiedKeyword {
+		return "", false
+	}
+	name := tokens[start].GetText()
+	for start >= 2 &&
+		tokens[start-1].GetTokenType() == parser.ArcLexerDOT &&
+		tokens[start-2].GetTokenType() == parser.ArcLexerIDENTIFIER {
+		name = tokens[start-2].GetText() + "." + name
+		start -= 2
+	}
+	if isQualifiedKeyword && !strings.Contains(name, ".") {
+		return "", false
+	}
+	if start > 0 && isDeclarationKeyword(tokens[start-1].GetTokenType()) {
+		return "", false
+	}
+	return name, true
+}
+
+// configParamsBeforeCursor returns the config parameter the cursor is writing
+// and the parameters already written, given the tokens inside a config block.
+func configParamsBeforeCursor(tokens []antlr.Token) (current string, assigned []string) {
+	depth := 0
+	expectName := true
+	for i, t := range tokens {
+		switch t.GetTokenType() {
+		case parser.ArcLexerLPAREN, parser.ArcLexerLBRACE, parser.ArcLexerLBRACKET:
+			depth++
+		case parser.ArcLexerRPAREN, parser.ArcLexerRBRACE, parser.ArcLexerRBRACKET:
+			depth--
+		case parser.ArcLexerCOMMA:
+			if depth == 0 {
+				current, expectName = "", true
+			}
+		case parser.ArcLexerIDENTIFIER:
+			if depth == 0 && expectName {
+				current, expectName = t.GetText(), false
+				if i+1 < len(tokens) && tokens[i+1].GetTokenType() == parser.ArcLexerASSIGN {
+					assigned = append(assigned, current)
+				}
+			}
+		}
+	}
+	return current, assigned
+}
+
+func formatParam(p types.Param) string {
+	return p.Name + " " + p.Type.String()
+}
+
+func formatParams(params types.Params) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		parts[i] = formatParam(p)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatSignatureLabel renders a function's signature on a single line, e.g.
+// "pid{kp f64, ki f64}(setpoint f64, value f64) f64".
+func formatSignatureLabel(sym *symbol.Symbol) string {
+	var sig strings.Builder
+	sig.WriteString(sym.Name)
+	if len(sym.Type.Config) > 0 {
+		sig.WriteString("{" + formatParams(sym.Type.Config) + "}")
+	}
+	sig.WriteString("(" + formatParams(sym.Type.Inputs) + ")")
+	switch len(sym.Type.Outputs) {
+	case 0:
+	case 1:
+		sig.WriteString(" " + sym.Type.Outputs[0].Type.String())
+	default:
+		sig.WriteString(" {" + formatParams(sym.Type.Outputs) + "}")
+	}
+	return sig.String()
+}
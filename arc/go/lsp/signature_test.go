@@ -0,0 +1,123 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package lsp_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/lsp"
+	. "github.com/synnaxlabs/arc/lsp/testutil"
+	"github.com/synnaxlabs/arc/symbol"
+	. "github.com/synnaxlabs/arc/symbol/testutil"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/lsp/protocol"
+	. "github.com/synnaxlabs/x/lsp/testutil"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("SignatureHelp", func() {
+	var (
+		server *lsp.Server
+		uri    protocol.DocumentURI
+	)
+
+	BeforeEach(func() {
+		server = MustSucceed(lsp.New(lsp.Config{NewRoot: func() *symbol.Symbol {
+			return NewRoot(nil, symbol.Symbol{
+				Name: "sensor",
+				Type: types.Chan(types.F64()),
+				Kind: symbol.KindChannel,
+				ID:   1,
+			})
+		}}))
+		server.SetClient(&MockClient{})
+		uri = "file:///test.arc"
+	})
+
+	signatureHelp := func(ctx SpecContext, line, char uint32) *protocol.SignatureHelp {
+		return MustSucceed(server.SignatureHelp(ctx, &protocol.SignatureHelpParams{
+			TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+				Position:     protocol.Position{Line: line, Character: char},
+			},
+		}))
+	}
+
+	const program = `func scale{factor f64, offset f64} (value f64) f64 {
+    return value * factor + offset
+}
+
+func add(a f64, b f64) f64 {
+    return a + b
+}
+
+func main() {
+    x := add(1.0, 2.0)
+}
+
+sensor -> scale{factor=2.0, offset=1.0}`
+
+	Describe("Config Blocks", func() {
+		It("Should show the config parameters of a function", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			help := signatureHelp(ctx, 12, 16) // scale{|factor
+			Expect(help).ToNot(BeNil())
+			Expect(help.Signatures).To(HaveLen(1))
+			sig := help.Signatures[0]
+			Expect(sig.Label).To(Equal("scale{factor f64, offset f64}(value f64) f64"))
+			Expect(sig.Parameters).To(HaveLen(2))
+			Expect(sig.Parameters[0].Label).To(Equal("factor f64"))
+			Expect(sig.Parameters[1].Label).To(Equal("offset f64"))
+			Expect(help.ActiveParameter).To(Equal(uint32(0)))
+		})
+
+		It("Should activate the parameter being written", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			help := signatureHelp(ctx, 12, 31) // offset|=1.0
+			Expect(help).ToNot(BeNil())
+			Expect(help.ActiveParameter).To(Equal(uint32(1)))
+		})
+
+		It("Should activate the first unwritten parameter after a comma", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			help := signatureHelp(ctx, 12, 27) // factor=2.0,| offset
+			Expect(help).ToNot(BeNil())
+			Expect(help.ActiveParameter).To(Equal(uint32(1)))
+		})
+
+		It("Should show qualified standard library members", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, "import ( stable )\n\nsensor -> stable.for{duration=5s}")
+			help := signatureHelp(ctx, 2, 21) // stable.for{|duration
+			Expect(help).ToNot(BeNil())
+			Expect(help.Signatures[0].Parameters).To(HaveLen(1))
+			Expect(help.Signatures[0].Parameters[0].Label).To(Equal("duration i64 ns"))
+		})
+	})
+
+	Describe("Argument Lists", func() {
+		It("Should activate the argument under the cursor", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			help := signatureHelp(ctx, 9, 13) // add(|1.0
+			Expect(help).ToNot(BeNil())
+			Expect(help.Signatures[0].Label).To(Equal("add(a f64, b f64) f64"))
+			Expect(help.ActiveParameter).To(Equal(uint32(0)))
+
+			help = signatureHelp(ctx, 9, 19) // 2.|0
+			Expect(help).ToNot(BeNil())
+			Expect(help.ActiveParameter).To(Equal(uint32(1)))
+		})
+	})
+
+	It("Should return nil outside of a call", func(ctx SpecContext) {
+		OpenArcDocument(server, ctx, uri, program)
+		Expect(signatureHelp(ctx, 1, 10)).To(BeNil()) // inside the scale body
+		Expect(signatureHelp(ctx, 0, 12)).To(BeNil()) // func scale{|factor
+	})
+})
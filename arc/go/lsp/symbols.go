@@ -0,0 +1,207 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package lsp
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/x/lsp/protocol"
+)
+
+// DocumentSymbol returns the outline of a document: its functions, sequences and
+// their stages, structs, enums and global constants.
+func (s *Server) DocumentSymbol(
+	_ context.Context,
+	params *protocol.DocumentSymbolParams,
+) ([]any, error) {
+	doc, ok := s.getDocument(params.TextDocument.URI)
+	if !ok || doc.IR.Symbols == nil {
+		return []any{}, nil
+	}
+	outline := collectDocumentSymbols(doc, doc.IR.Symbols)
+	result := make([]any, len(outline))
+	for i, sym := range outline {
+		result[i] = sym
+	}
+	return result, nil
+}
+
+// Symbols returns the outline symbols of every open document whose name contains
+// the query, ignoring case. An empty query matches every symbol.
+func (s *Server) Symbols(
+	_ context.Context,
+	params *protocol.WorkspaceSymbolParams,
+) ([]protocol.SymbolInformation, error) {
+	s.mu.RLock()
+	uris := make([]protocol.DocumentURI, 0, len(s.documents))
+	for uri := range s.documents {
+		uris = append(uris, uri)
+	}
+	s.mu.RUnlock()
+	slices.Sort(uris)
+	query := strings.ToLower(params.Query)
+	var result []protocol.SymbolInformation
+	for _, uri := range uris {
+		doc, ok := s.getDocument(uri)
+		if !ok || doc.IR.Symbols == nil {
+			continue
+		}
+		flattenDocumentSymbols(
+			uri,
+			"",
+			collectDocumentSymbols(doc, doc.IR.Symbols),
+			func(info protocol.SymbolInformation) {
+				if strings.Contains(strings.ToLower(info.Name), query) {
+					result = append(result, info)
+				}
+			},
+		)
+	}
+	return result, nil
+}
+
+func flattenDocumentSymbols(
+	uri protocol.DocumentURI,
+	container string,
+	syms []protocol.DocumentSymbol,
+	visit func(protocol.SymbolInformation),
+) {
+	for _, sym := range syms {
+		visit(protocol.SymbolInformation{
+			Name:          sym.Name,
+			Kind:          sym.Kind,
+			Location:      protocol.Location{URI: uri, Range: sym.Range},
+			ContainerName: container,
+		})
+		flattenDocumentSymbols(uri, sym.Name, sym.Children, visit)
+	}
+}
+
+func collectDocumentSymbols(doc *Document, scope *symbol.Symbol) []protocol.DocumentSymbol {
+	var result []protocol.DocumentSymbol
+	for _, child := range scope.Children() {
+		kind, ok := outlineSymbolKind(child.Kind)
+		if !ok || child.Name == "" || child.AST == nil || child.Library() != nil {
+			continue
+		}
+		name := declarationNameToken(child)
+		if name == nil {
+			continue
+		}
+		result = append(result, protocol.DocumentSymbol{
+			Name:           child.Name,
+			Detail:         outlineDetail(child),
+			Kind:           kind,
+			Deprecated:     child.Deprecated != nil,
+			Range:          doc.toDocRange(ruleRange(child.AST)),
+			SelectionRange: doc.toDocRange(tokenRange(name)),
+			Children:       collectDocumentSymbols(doc, child),
+		})
+	}
+	// Analysis declares some kinds (enums, structs) ahead of others, so restore
+	// document order.
+	slices.SortFunc(result, func(a, b protocol.DocumentSymbol) int {
+		if a.Range.Start.Line != b.Range.Start.Line {
+			return cmp.Compare(a.Range.Start.Line, b.Range.Start.Line)
+		}
+		return cmp.Compare(a.Range.Start.Character, b.Range.Start.Character)
+	})
+	return result
+}
+
+func outlineSymbolKind(kind symbol.Kind) (protocol.SymbolKind, bool) {
+	switch kind {
+	case symbol.KindFunction:
+		return protocol.SymbolKindFunction, true
+	case symbol.KindSequence:
+		return protocol.SymbolKindNamespace, true
+	case symbol.KindStage:
+		return protocol.SymbolKindEvent, true
+	case symbol.KindStruct:
+		return protocol.SymbolKindStruct, true
+	case symbol.KindEnum:
+		return protocol.SymbolKindEnum, true
+	case symbol.KindEnumMember:
+		return protocol.SymbolKindEnumMember, true
+	case symbol.KindGlobalConstant:
+		return protocol.SymbolKindConstant, true
+	default:
+		return 0, false
+	}
+}
+
+func outlineDetail(sym *symbol.Symbol) string {
+	switch sym.Kind {
+	case symbol.KindFunction:
+		return formatSignatureLabel(sym)
+	case symbol.KindSequence, symbol.KindStage:
+		return ""
+	case symbol.KindStruct:
+		return "struct"
+	case symbol.KindEnumMember:
+		return fmt.Sprintf("= %v", sym.DefaultValue)
+	default:
+		return sym.Type.String()
+	}
+}
+
+// declarationNameToken returns the identifier token that names sym in its
+// declaration: the first IDENTIFIER in the symbol's AST whose text is the
+// symbol's name.
+func declarationNameToken(sym *symbol.Symbol) antlr.Token {
+	if sym.AST == nil {
+		return nil
+	}
+	var find func(tree antlr.Tree) antlr.Token
+	find = func(tree antlr.Tree) antlr.Token {
+		if term, ok := tree.(antlr.TerminalNode); ok {
+			tok := term.GetSymbol()
+			if tok.GetTokenType() == parser.ArcLexerIDENTIFIER && tok.GetText() == sym.Name {
+				return tok
+			}
+			return nil
+		}
+		for _, child := range tree.GetChildren() {
+			if tok := find(child); tok != nil {
+				return tok
+			}
+		}
+		return nil
+	}
+	return find(sym.AST)
+}
+
+func tokenRange(tok antlr.Token) protocol.Range {
+	line := uint32(tok.GetLine() - 1)
+	col := uint32(tok.GetColumn())
+	return protocol.Range{
+		Start: protocol.Position{Line: line, Character: col},
+		End:   protocol.Position{Line: line, Character: col + uint32(len(tok.GetText()))},
+	}
+}
+
+func ruleRange(rule antlr.ParserRuleContext) protocol.Range {
+	start, stop := rule.GetStart(), rule.GetStop()
+	if stop == nil {
+		stop = start
+	}
+	end := tokenRange(stop).End
+	return protocol.Range{
+		Start: protocol.Position{Line: uint32(start.GetLine() - 1), Character: uint32(start.GetColumn())},
+		End:   end,
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package lsp_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/lsp"
+	. "github.com/synnaxlabs/arc/lsp/testutil"
+	"github.com/synnaxlabs/x/lsp/protocol"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Symbols", func() {
+	var (
+		server *lsp.Server
+		uri    protocol.DocumentURI
+	)
+
+	BeforeEach(func() {
+		server, uri = SetupTestServer()
+	})
+
+	documentSymbols := func(ctx SpecContext) []protocol.DocumentSymbol {
+		result := MustSucceed(server.DocumentSymbol(ctx, &protocol.DocumentSymbolParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+		}))
+		syms := make([]protocol.DocumentSymbol, len(result))
+		for i, r := range result {
+			syms[i] = r.(protocol.DocumentSymbol)
+		}
+		return syms
+	}
+
+	names := func(syms []protocol.DocumentSymbol) []string {
+		result := make([]string, len(syms))
+		for i, s := range syms {
+			result[i] = s.Name
+		}
+		return result
+	}
+
+	const program = `LIMIT := 100
+
+enum Mode u8 {
+    idle,
+    firing,
+}
+
+func add(a f64, b f64) f64 {
+    x := a + b
+    return x
+}
+
+sequence main {
+    stage precheck {
+    }
+    stage pressurize {
+    }
+}`
+
+	Describe("DocumentSymbol", func() {
+		It("Should outline top-level declarations", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			syms := documentSymbols(ctx)
+			Expect(names(syms)).To(Equal([]string{"LIMIT", "Mode", "add", "main"}))
+			Expect(syms[0].Kind).To(Equal(protocol.SymbolKindConstant))
+			Expect(syms[1].Kind).To(Equal(protocol.SymbolKindEnum))
+			Expect(syms[2].Kind).To(Equal(protocol.SymbolKindFunction))
+			Expect(syms[2].Detail).To(Equal("add(a f64, b f64) f64"))
+			Expect(syms[3].Kind).To(Equal(protocol.SymbolKindNamespace))
+		})
+
+		It("Should nest stages under their sequence and members under their enum", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			syms := documentSymbols(ctx)
+			Expect(names(syms[1].Children)).To(Equal([]string{"idle", "firing"}))
+			Expect(syms[1].Children[1].Kind).To(Equal(protocol.SymbolKindEnumMember))
+			Expect(syms[1].Children[1].Detail).To(Equal("= 1"))
+			Expect(names(syms[3].Children)).To(Equal([]string{"precheck", "pressurize"}))
+			Expect(syms[3].Children[0].Kind).To(Equal(protocol.SymbolKindEvent))
+		})
+
+		It("Should not outline local variables", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			Expect(documentSymbols(ctx)[2].Children).To(BeEmpty())
+		})
+
+		It("Should select the declaration name within the full range", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			fn := documentSymbols(ctx)[2]
+			Expect(fn.Range.Start).To(Equal(protocol.Position{Line: 7, Character: 0}))
+			Expect(fn.Range.End.Line).To(Equal(uint32(10)))
+			Expect(fn.SelectionRange).To(Equal(protocol.Range{
+				Start: protocol.Position{Line: 7, Character: 5},
+				End:   protocol.Position{Line: 7, Character: 8},
+			}))
+		})
+
+		It("Should return an empty outline for an unknown document", func(ctx SpecContext) {
+			Expect(documentSymbols(ctx)).To(BeEmpty())
+		})
+	})
+
+	Describe("Workspace Symbols", func() {
+		It("Should search the outlines of every open document", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			OpenArcDocument(server, ctx, "file:///other.arc", `func press() {
+}`)
+			result := MustSucceed(server.Symbols(ctx, &protocol.WorkspaceSymbolParams{
+				Query: "PRES",
+			}))
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].Name).To(Equal("press"))
+			Expect(result[0].Location.URI).To(Equal(protocol.DocumentURI("file:///other.arc")))
+			Expect(result[1].Name).To(Equal("pressurize"))
+			Expect(result[1].ContainerName).To(Equal("main"))
+			Expect(result[1].Location.URI).To(Equal(uri))
+		})
+
+		It("Should return every symbol for an empty query", func(ctx SpecContext) {
+			OpenArcDocument(server, ctx, uri, program)
+			result := MustSucceed(server.Symbols(ctx, &protocol.WorkspaceSymbolParams{}))
+			Expect(result).To(HaveLen(8))
+		})
+	})
+})
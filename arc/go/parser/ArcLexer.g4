@@ -11,6 +11,7 @@ RETURN      : 'return';
 FOR         : 'for';
 BREAK       : 'break';
 CONTINUE    : 'continue';
+MATCH       : 'match';
 IMPORT      : 'import';
 AS          : 'as';
 
@@ -25,6 +26,13 @@ CHAN        : 'chan';
 // Authority keywords
 AUTHORITY   : 'authority';
 
+// Safety keywords
+SAFETY      : 'safety';
+
+// Type declaration keywords
+STRUCT      : 'struct';
+ENUM        : 'enum';
+
 // Primitive types
 I8          : 'i8';
 I16         : 'i16';
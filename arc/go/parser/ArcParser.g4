@@ -15,10 +15,13 @@ program
 topLevelItem
     : importStatement
     | authorityBlock
+    | safetyBlock
     | functionDeclaration
     | flowStatement
     | sequenceDeclaration
     | stageDeclaration
+    | structDeclaration
+    | enumDeclaration
     | globalConstant
     ;
 
@@ -60,6 +63,19 @@ authorityEntry
     | IDENTIFIER INTEGER_LITERAL
     ;
 
+// =============================================================================
+// Safety Declarations
+// =============================================================================
+
+safetyBlock
+    : SAFETY safetyEntry
+    | SAFETY LPAREN safetyEntry* RPAREN
+    ;
+
+safetyEntry
+    : IDENTIFIER configValues
+    ;
+
 // =============================================================================
 // Function Declarations
 // =============================================================================
@@ -147,6 +163,41 @@ singleInvocation
     | expression
     ;
 
+// =============================================================================
+// Struct Declarations
+// =============================================================================
+
+// struct Point { x f64, y f64 }
+structDeclaration
+    : STRUCT IDENTIFIER LBRACE structFieldList? RBRACE
+    ;
+
+structFieldList
+    : structField (COMMA structField)* COMMA?
+    ;
+
+structField
+    : IDENTIFIER type
+    ;
+
+// =============================================================================
+// Enum Declarations
+// =============================================================================
+
+// enum Mode { idle, press, fire, safe }
+// enum Valve u8 { closed = 0, open = 1 }
+enumDeclaration
+    : ENUM IDENTIFIER integerType? LBRACE enumMemberList? RBRACE
+    ;
+
+enumMemberList
+    : enumMember (COMMA enumMember)* COMMA?
+    ;
+
+enumMember
+    : IDENTIFIER (ASSIGN MINUS? INTEGER_LITERAL)?
+    ;
+
 // =============================================================================
 // Global Constants
 // =============================================================================
@@ -176,7 +227,14 @@ routingTable
     ;
 
 routingEntry
-    : IDENTIFIER COLON flowNode (ARROW flowNode)* (COLON IDENTIFIER)?
+    : routingKey COLON flowNode (ARROW flowNode)* (COLON IDENTIFIER)?
+    ;
+
+// An output name of the preceding func, or an enum member (Mode.fire) selecting
+// the entry when the preceding value equals it.
+routingKey
+    : IDENTIFIER
+    | qualifiedIdentifier
     ;
 
 flowNode
@@ -246,6 +304,7 @@ statement
     | assignment
     | ifStatement
     | forStatement
+    | matchStatement
     | breakStatement
     | continueStatement
     | returnStatement
@@ -272,6 +331,8 @@ assignment
     | IDENTIFIER indexOrSlice ASSIGN expression
     | IDENTIFIER compoundOp expression
     | IDENTIFIER indexOrSlice compoundOp expression
+    | IDENTIFIER memberAccess+ ASSIGN expression
+    | IDENTIFIER memberAccess+ compoundOp expression
     ;
 
 compoundOp
@@ -294,6 +355,23 @@ elseClause
     : ELSE block
     ;
 
+// match mode {
+//     Mode.idle => { ... }
+//     Mode.press, Mode.fire => { ... }
+//     else => { ... }
+// }
+matchStatement
+    : MATCH expression LBRACE matchArm* matchElse? RBRACE
+    ;
+
+matchArm
+    : expression (COMMA expression)* TRANSITION block
+    ;
+
+matchElse
+    : ELSE TRANSITION block
+    ;
+
 forStatement
     : FOR forClause block
     ;
@@ -325,6 +403,7 @@ type
     : primitiveType unitSuffix?
     | channelType
     | seriesType
+    | structType
     ;
 
 unitSuffix
@@ -359,6 +438,12 @@ seriesType
     : SERIES primitiveType unitSuffix?
     ;
 
+// A user-declared struct, referenced by name. A struct name followed by '('
+// always parses as a function call, since structs cannot be cast to.
+structType
+    : IDENTIFIER
+    ;
+
 // =============================================================================
 // Expressions
 // =============================================================================
@@ -403,7 +488,7 @@ unaryExpression
     ;
 
 postfixExpression
-    : primaryExpression (indexOrSlice | functionCallSuffix)*
+    : primaryExpression (indexOrSlice | functionCallSuffix | memberAccess)*
     ;
 
 indexOrSlice
@@ -415,18 +500,35 @@ functionCallSuffix
     : LPAREN argumentList? RPAREN
     ;
 
+// Struct field access. A single `a.b` parses as a qualifiedIdentifier and is
+// resolved to a field during analysis; deeper paths chain memberAccess suffixes.
+memberAccess
+    : DOT IDENTIFIER
+    ;
+
 primaryExpression
     : literal
     | qualifiedIdentifier
     | IDENTIFIER
     | LPAREN expression RPAREN
     | typeCast
+    | structLiteral
     ;
 
 typeCast
     : type LPAREN expression RPAREN
     ;
 
+// Point{x: 1.0, y: 2.0}. Omitted fields are zero-valued. At least one field is
+// required so that `if x {}` keeps parsing as a condition and an empty block.
+structLiteral
+    : IDENTIFIER LBRACE structFieldValue (COMMA structFieldValue)* COMMA? RBRACE
+    ;
+
+structFieldValue
+    : IDENTIFIER COLON expression
+    ;
+
 // =============================================================================
 // Literals
 // =============================================================================
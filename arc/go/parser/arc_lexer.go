@@ -53,42 +53,43 @@ func arclexerLexerInit() {
 	}
 	staticData.LiteralNames = []string{
 		"", "'func'", "'if'", "'else'", "'return'", "'for'", "'break'", "'continue'",
-		"'import'", "'as'", "'sequence'", "'stage'", "'next'", "'chan'", "'authority'",
-		"'i8'", "'i16'", "'i32'", "'i64'", "'u8'", "'u16'", "'u32'", "'u64'",
-		"'f32'", "'f64'", "'str'", "'series'", "'->'", "':='", "'$='", "'=>'",
-		"'='", "'+='", "'-='", "'*='", "'/='", "'%='", "'+'", "'-'", "'*'",
-		"'/'", "'%'", "'^'", "'=='", "'!='", "'<'", "'>'", "'<='", "'>='", "'and'",
-		"'or'", "'not'", "'('", "')'", "'{'", "'}'", "'['", "']'", "','", "':'",
-		"'.'",
+		"'match'", "'import'", "'as'", "'sequence'", "'stage'", "'next'", "'chan'",
+		"'authority'", "'safety'", "'struct'", "'enum'", "'i8'", "'i16'", "'i32'",
+		"'i64'", "'u8'", "'u16'", "'u32'", "'u64'", "'f32'", "'f64'", "'str'",
+		"'series'", "'->'", "':='", "'$='", "'=>'", "'='", "'+='", "'-='", "'*='",
+		"'/='", "'%='", "'+'", "'-'", "'*'", "'/'", "'%'", "'^'", "'=='", "'!='",
+		"'<'", "'>'", "'<='", "'>='", "'and'", "'or'", "'not'", "'('", "')'",
+		"'{'", "'}'", "'['", "']'", "','", "':'", "'.'",
 	}
 	staticData.SymbolicNames = []string{
-		"", "FUNC", "IF", "ELSE", "RETURN", "FOR", "BREAK", "CONTINUE", "IMPORT",
-		"AS", "SEQUENCE", "STAGE", "NEXT", "CHAN", "AUTHORITY", "I8", "I16",
-		"I32", "I64", "U8", "U16", "U32", "U64", "F32", "F64", "STR", "SERIES",
-		"ARROW", "DECLARE", "STATE_DECLARE", "TRANSITION", "ASSIGN", "PLUS_ASSIGN",
-		"MINUS_ASSIGN", "STAR_ASSIGN", "SLASH_ASSIGN", "PERCENT_ASSIGN", "PLUS",
-		"MINUS", "STAR", "SLASH", "PERCENT", "CARET", "EQ", "NEQ", "LT", "GT",
-		"LEQ", "GEQ", "AND", "OR", "NOT", "LPAREN", "RPAREN", "LBRACE", "RBRACE",
-		"LBRACKET", "RBRACKET", "COMMA", "COLON", "DOT", "INTEGER_LITERAL",
-		"FLOAT_LITERAL", "STR_LITERAL_MULTI", "STR_LITERAL", "IDENTIFIER", "SINGLE_LINE_COMMENT",
-		"MULTI_LINE_COMMENT", "WS",
+		"", "FUNC", "IF", "ELSE", "RETURN", "FOR", "BREAK", "CONTINUE", "MATCH",
+		"IMPORT", "AS", "SEQUENCE", "STAGE", "NEXT", "CHAN", "AUTHORITY", "SAFETY",
+		"STRUCT", "ENUM", "I8", "I16", "I32", "I64", "U8", "U16", "U32", "U64",
+		"F32", "F64", "STR", "SERIES", "ARROW", "DECLARE", "STATE_DECLARE",
+		"TRANSITION", "ASSIGN", "PLUS_ASSIGN", "MINUS_ASSIGN", "STAR_ASSIGN",
+		"SLASH_ASSIGN", "PERCENT_ASSIGN", "PLUS", "MINUS", "STAR", "SLASH",
+		"PERCENT", "CARET", "EQ", "NEQ", "LT", "GT", "LEQ", "GEQ", "AND", "OR",
+		"NOT", "LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACKET", "RBRACKET",
+		"COMMA", "COLON", "DOT", "INTEGER_LITERAL", "FLOAT_LITERAL", "STR_LITERAL_MULTI",
+		"STR_LITERAL", "IDENTIFIER", "SINGLE_LINE_COMMENT", "MULTI_LINE_COMMENT",
+		"WS",
 	}
 	staticData.RuleNames = []string{
-		"FUNC", "IF", "ELSE", "RETURN", "FOR", "BREAK", "CONTINUE", "IMPORT",
-		"AS", "SEQUENCE", "STAGE", "NEXT", "CHAN", "AUTHORITY", "I8", "I16",
-		"I32", "I64", "U8", "U16", "U32", "U64", "F32", "F64", "STR", "SERIES",
-		"ARROW", "DECLARE", "STATE_DECLARE", "TRANSITION", "ASSIGN", "PLUS_ASSIGN",
-		"MINUS_ASSIGN", "STAR_ASSIGN", "SLASH_ASSIGN", "PERCENT_ASSIGN", "PLUS",
-		"MINUS", "STAR", "SLASH", "PERCENT", "CARET", "EQ", "NEQ", "LT", "GT",
-		"LEQ", "GEQ", "AND", "OR", "NOT", "LPAREN", "RPAREN", "LBRACE", "RBRACE",
-		"LBRACKET", "RBRACKET", "COMMA", "COLON", "DOT", "DIGITS", "DIGIT",
-		"INTEGER_LITERAL", "FLOAT_LITERAL", "STR_LITERAL_MULTI", "STR_LITERAL",
-		"STR_PREFIX", "IDENTIFIER", "SINGLE_LINE_COMMENT", "MULTI_LINE_COMMENT",
-		"WS",
+		"FUNC", "IF", "ELSE", "RETURN", "FOR", "BREAK", "CONTINUE", "MATCH",
+		"IMPORT", "AS", "SEQUENCE", "STAGE", "NEXT", "CHAN", "AUTHORITY", "SAFETY",
+		"STRUCT", "ENUM", "I8", "I16", "I32", "I64", "U8", "U16", "U32", "U64",
+		"F32", "F64", "STR", "SERIES", "ARROW", "DECLARE", "STATE_DECLARE",
+		"TRANSITION", "ASSIGN", "PLUS_ASSIGN", "MINUS_ASSIGN", "STAR_ASSIGN",
+		"SLASH_ASSIGN", "PERCENT_ASSIGN", "PLUS", "MINUS", "STAR", "SLASH",
+		"PERCENT", "CARET", "EQ", "NEQ", "LT", "GT", "LEQ", "GEQ", "AND", "OR",
+		"NOT", "LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACKET", "RBRACKET",
+		"COMMA", "COLON", "DOT", "DIGITS", "DIGIT", "INTEGER_LITERAL", "FLOAT_LITERAL",
+		"STR_LITERAL_MULTI", "STR_LITERAL", "STR_PREFIX", "IDENTIFIER", "SINGLE_LINE_COMMENT",
+		"MULTI_LINE_COMMENT", "WS",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 68, 456, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
+		4, 0, 72, 489, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
 		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
 		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
 		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
@@ -101,192 +102,207 @@ func arclexerLexerInit() {
 		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
 		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7,
 		62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67,
-		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
-		1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3,
-		1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5,
-		1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7,
-		1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9,
-		1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10,
-		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1,
-		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14,
-		1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1,
-		17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1,
-		22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25,
-		1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 27, 1,
-		27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 31,
-		1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1,
-		34, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 39,
-		1, 39, 1, 40, 1, 40, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1,
-		43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47,
-		1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1,
-		50, 1, 51, 1, 51, 1, 52, 1, 52, 1, 53, 1, 53, 1, 54, 1, 54, 1, 55, 1, 55,
-		1, 56, 1, 56, 1, 57, 1, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 4, 60, 364,
-		8, 60, 11, 60, 12, 60, 365, 1, 61, 1, 61, 1, 62, 1, 62, 1, 63, 1, 63, 1,
-		63, 3, 63, 375, 8, 63, 1, 63, 1, 63, 3, 63, 379, 8, 63, 1, 64, 3, 64, 382,
-		8, 64, 1, 64, 1, 64, 1, 64, 1, 64, 5, 64, 388, 8, 64, 10, 64, 12, 64, 391,
-		9, 64, 1, 64, 1, 64, 1, 65, 3, 65, 396, 8, 65, 1, 65, 1, 65, 1, 65, 1,
-		65, 5, 65, 402, 8, 65, 10, 65, 12, 65, 405, 9, 65, 1, 65, 1, 65, 1, 66,
-		1, 66, 1, 66, 1, 66, 1, 66, 3, 66, 414, 8, 66, 1, 67, 1, 67, 1, 67, 1,
-		67, 5, 67, 420, 8, 67, 10, 67, 12, 67, 423, 9, 67, 1, 68, 1, 68, 1, 68,
-		1, 68, 5, 68, 429, 8, 68, 10, 68, 12, 68, 432, 9, 68, 1, 68, 1, 68, 1,
-		69, 1, 69, 1, 69, 1, 69, 5, 69, 440, 8, 69, 10, 69, 12, 69, 443, 9, 69,
-		1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 4, 70, 451, 8, 70, 11, 70, 12,
-		70, 452, 1, 70, 1, 70, 1, 441, 0, 71, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11,
-		6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15,
-		31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43, 22, 45, 23, 47, 24,
-		49, 25, 51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61, 31, 63, 32, 65, 33,
-		67, 34, 69, 35, 71, 36, 73, 37, 75, 38, 77, 39, 79, 40, 81, 41, 83, 42,
-		85, 43, 87, 44, 89, 45, 91, 46, 93, 47, 95, 48, 97, 49, 99, 50, 101, 51,
-		103, 52, 105, 53, 107, 54, 109, 55, 111, 56, 113, 57, 115, 58, 117, 59,
-		119, 60, 121, 0, 123, 0, 125, 61, 127, 62, 129, 63, 131, 64, 133, 0, 135,
-		65, 137, 66, 139, 67, 141, 68, 1, 0, 8, 1, 0, 48, 57, 2, 0, 92, 92, 96,
-		96, 4, 0, 10, 10, 13, 13, 34, 34, 92, 92, 2, 0, 102, 102, 114, 114, 3,
-		0, 65, 90, 95, 95, 97, 122, 4, 0, 48, 57, 65, 90, 95, 95, 97, 122, 2, 0,
-		10, 10, 13, 13, 3, 0, 9, 10, 13, 13, 32, 32, 468, 0, 1, 1, 0, 0, 0, 0,
-		3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0,
-		11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0,
-		0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0,
-		0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0,
-		0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1,
-		0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49,
-		1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0,
-		57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0,
-		0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0,
-		0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0,
-		0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1,
-		0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95,
-		1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0,
-		103, 1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0,
-		0, 0, 0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117,
-		1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0,
-		0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1,
-		0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0, 0, 0, 1, 143, 1, 0, 0, 0, 3,
-		148, 1, 0, 0, 0, 5, 151, 1, 0, 0, 0, 7, 156, 1, 0, 0, 0, 9, 163, 1, 0,
-		0, 0, 11, 167, 1, 0, 0, 0, 13, 173, 1, 0, 0, 0, 15, 182, 1, 0, 0, 0, 17,
-		189, 1, 0, 0, 0, 19, 192, 1, 0, 0, 0, 21, 201, 1, 0, 0, 0, 23, 207, 1,
-		0, 0, 0, 25, 212, 1, 0, 0, 0, 27, 217, 1, 0, 0, 0, 29, 227, 1, 0, 0, 0,
-		31, 230, 1, 0, 0, 0, 33, 234, 1, 0, 0, 0, 35, 238, 1, 0, 0, 0, 37, 242,
-		1, 0, 0, 0, 39, 245, 1, 0, 0, 0, 41, 249, 1, 0, 0, 0, 43, 253, 1, 0, 0,
-		0, 45, 257, 1, 0, 0, 0, 47, 261, 1, 0, 0, 0, 49, 265, 1, 0, 0, 0, 51, 269,
-		1, 0, 0, 0, 53, 276, 1, 0, 0, 0, 55, 279, 1, 0, 0, 0, 57, 282, 1, 0, 0,
-		0, 59, 285, 1, 0, 0, 0, 61, 288, 1, 0, 0, 0, 63, 290, 1, 0, 0, 0, 65, 293,
-		1, 0, 0, 0, 67, 296, 1, 0, 0, 0, 69, 299, 1, 0, 0, 0, 71, 302, 1, 0, 0,
-		0, 73, 305, 1, 0, 0, 0, 75, 307, 1, 0, 0, 0, 77, 309, 1, 0, 0, 0, 79, 311,
-		1, 0, 0, 0, 81, 313, 1, 0, 0, 0, 83, 315, 1, 0, 0, 0, 85, 317, 1, 0, 0,
-		0, 87, 320, 1, 0, 0, 0, 89, 323, 1, 0, 0, 0, 91, 325, 1, 0, 0, 0, 93, 327,
-		1, 0, 0, 0, 95, 330, 1, 0, 0, 0, 97, 333, 1, 0, 0, 0, 99, 337, 1, 0, 0,
-		0, 101, 340, 1, 0, 0, 0, 103, 344, 1, 0, 0, 0, 105, 346, 1, 0, 0, 0, 107,
-		348, 1, 0, 0, 0, 109, 350, 1, 0, 0, 0, 111, 352, 1, 0, 0, 0, 113, 354,
-		1, 0, 0, 0, 115, 356, 1, 0, 0, 0, 117, 358, 1, 0, 0, 0, 119, 360, 1, 0,
-		0, 0, 121, 363, 1, 0, 0, 0, 123, 367, 1, 0, 0, 0, 125, 369, 1, 0, 0, 0,
-		127, 378, 1, 0, 0, 0, 129, 381, 1, 0, 0, 0, 131, 395, 1, 0, 0, 0, 133,
-		413, 1, 0, 0, 0, 135, 415, 1, 0, 0, 0, 137, 424, 1, 0, 0, 0, 139, 435,
-		1, 0, 0, 0, 141, 450, 1, 0, 0, 0, 143, 144, 5, 102, 0, 0, 144, 145, 5,
-		117, 0, 0, 145, 146, 5, 110, 0, 0, 146, 147, 5, 99, 0, 0, 147, 2, 1, 0,
-		0, 0, 148, 149, 5, 105, 0, 0, 149, 150, 5, 102, 0, 0, 150, 4, 1, 0, 0,
-		0, 151, 152, 5, 101, 0, 0, 152, 153, 5, 108, 0, 0, 153, 154, 5, 115, 0,
-		0, 154, 155, 5, 101, 0, 0, 155, 6, 1, 0, 0, 0, 156, 157, 5, 114, 0, 0,
-		157, 158, 5, 101, 0, 0, 158, 159, 5, 116, 0, 0, 159, 160, 5, 117, 0, 0,
-		160, 161, 5, 114, 0, 0, 161, 162, 5, 110, 0, 0, 162, 8, 1, 0, 0, 0, 163,
-		164, 5, 102, 0, 0, 164, 165, 5, 111, 0, 0, 165, 166, 5, 114, 0, 0, 166,
-		10, 1, 0, 0, 0, 167, 168, 5, 98, 0, 0, 168, 169, 5, 114, 0, 0, 169, 170,
-		5, 101, 0, 0, 170, 171, 5, 97, 0, 0, 171, 172, 5, 107, 0, 0, 172, 12, 1,
-		0, 0, 0, 173, 174, 5, 99, 0, 0, 174, 175, 5, 111, 0, 0, 175, 176, 5, 110,
-		0, 0, 176, 177, 5, 116, 0, 0, 177, 178, 5, 105, 0, 0, 178, 179, 5, 110,
-		0, 0, 179, 180, 5, 117, 0, 0, 180, 181, 5, 101, 0, 0, 181, 14, 1, 0, 0,
-		0, 182, 183, 5, 105, 0, 0, 183, 184, 5, 109, 0, 0, 184, 185, 5, 112, 0,
-		0, 185, 186, 5, 111, 0, 0, 186, 187, 5, 114, 0, 0, 187, 188, 5, 116, 0,
-		0, 188, 16, 1, 0, 0, 0, 189, 190, 5, 97, 0, 0, 190, 191, 5, 115, 0, 0,
-		191, 18, 1, 0, 0, 0, 192, 193, 5, 115, 0, 0, 193, 194, 5, 101, 0, 0, 194,
-		195, 5, 113, 0, 0, 195, 196, 5, 117, 0, 0, 196, 197, 5, 101, 0, 0, 197,
-		198, 5, 110, 0, 0, 198, 199, 5, 99, 0, 0, 199, 200, 5, 101, 0, 0, 200,
-		20, 1, 0, 0, 0, 201, 202, 5, 115, 0, 0, 202, 203, 5, 116, 0, 0, 203, 204,
-		5, 97, 0, 0, 204, 205, 5, 103, 0, 0, 205, 206, 5, 101, 0, 0, 206, 22, 1,
-		0, 0, 0, 207, 208, 5, 110, 0, 0, 208, 209, 5, 101, 0, 0, 209, 210, 5, 120,
-		0, 0, 210, 211, 5, 116, 0, 0, 211, 24, 1, 0, 0, 0, 212, 213, 5, 99, 0,
-		0, 213, 214, 5, 104, 0, 0, 214, 215, 5, 97, 0, 0, 215, 216, 5, 110, 0,
-		0, 216, 26, 1, 0, 0, 0, 217, 218, 5, 97, 0, 0, 218, 219, 5, 117, 0, 0,
-		219, 220, 5, 116, 0, 0, 220, 221, 5, 104, 0, 0, 221, 222, 5, 111, 0, 0,
-		222, 223, 5, 114, 0, 0, 223, 224, 5, 105, 0, 0, 224, 225, 5, 116, 0, 0,
-		225, 226, 5, 121, 0, 0, 226, 28, 1, 0, 0, 0, 227, 228, 5, 105, 0, 0, 228,
-		229, 5, 56, 0, 0, 229, 30, 1, 0, 0, 0, 230, 231, 5, 105, 0, 0, 231, 232,
-		5, 49, 0, 0, 232, 233, 5, 54, 0, 0, 233, 32, 1, 0, 0, 0, 234, 235, 5, 105,
-		0, 0, 235, 236, 5, 51, 0, 0, 236, 237, 5, 50, 0, 0, 237, 34, 1, 0, 0, 0,
-		238, 239, 5, 105, 0, 0, 239, 240, 5, 54, 0, 0, 240, 241, 5, 52, 0, 0, 241,
-		36, 1, 0, 0, 0, 242, 243, 5, 117, 0, 0, 243, 244, 5, 56, 0, 0, 244, 38,
-		1, 0, 0, 0, 245, 246, 5, 117, 0, 0, 246, 247, 5, 49, 0, 0, 247, 248, 5,
-		54, 0, 0, 248, 40, 1, 0, 0, 0, 249, 250, 5, 117, 0, 0, 250, 251, 5, 51,
-		0, 0, 251, 252, 5, 50, 0, 0, 252, 42, 1, 0, 0, 0, 253, 254, 5, 117, 0,
-		0, 254, 255, 5, 54, 0, 0, 255, 256, 5, 52, 0, 0, 256, 44, 1, 0, 0, 0, 257,
-		258, 5, 102, 0, 0, 258, 259, 5, 51, 0, 0, 259, 260, 5, 50, 0, 0, 260, 46,
-		1, 0, 0, 0, 261, 262, 5, 102, 0, 0, 262, 263, 5, 54, 0, 0, 263, 264, 5,
-		52, 0, 0, 264, 48, 1, 0, 0, 0, 265, 266, 5, 115, 0, 0, 266, 267, 5, 116,
-		0, 0, 267, 268, 5, 114, 0, 0, 268, 50, 1, 0, 0, 0, 269, 270, 5, 115, 0,
-		0, 270, 271, 5, 101, 0, 0, 271, 272, 5, 114, 0, 0, 272, 273, 5, 105, 0,
-		0, 273, 274, 5, 101, 0, 0, 274, 275, 5, 115, 0, 0, 275, 52, 1, 0, 0, 0,
-		276, 277, 5, 45, 0, 0, 277, 278, 5, 62, 0, 0, 278, 54, 1, 0, 0, 0, 279,
-		280, 5, 58, 0, 0, 280, 281, 5, 61, 0, 0, 281, 56, 1, 0, 0, 0, 282, 283,
-		5, 36, 0, 0, 283, 284, 5, 61, 0, 0, 284, 58, 1, 0, 0, 0, 285, 286, 5, 61,
-		0, 0, 286, 287, 5, 62, 0, 0, 287, 60, 1, 0, 0, 0, 288, 289, 5, 61, 0, 0,
-		289, 62, 1, 0, 0, 0, 290, 291, 5, 43, 0, 0, 291, 292, 5, 61, 0, 0, 292,
-		64, 1, 0, 0, 0, 293, 294, 5, 45, 0, 0, 294, 295, 5, 61, 0, 0, 295, 66,
-		1, 0, 0, 0, 296, 297, 5, 42, 0, 0, 297, 298, 5, 61, 0, 0, 298, 68, 1, 0,
-		0, 0, 299, 300, 5, 47, 0, 0, 300, 301, 5, 61, 0, 0, 301, 70, 1, 0, 0, 0,
-		302, 303, 5, 37, 0, 0, 303, 304, 5, 61, 0, 0, 304, 72, 1, 0, 0, 0, 305,
-		306, 5, 43, 0, 0, 306, 74, 1, 0, 0, 0, 307, 308, 5, 45, 0, 0, 308, 76,
-		1, 0, 0, 0, 309, 310, 5, 42, 0, 0, 310, 78, 1, 0, 0, 0, 311, 312, 5, 47,
-		0, 0, 312, 80, 1, 0, 0, 0, 313, 314, 5, 37, 0, 0, 314, 82, 1, 0, 0, 0,
-		315, 316, 5, 94, 0, 0, 316, 84, 1, 0, 0, 0, 317, 318, 5, 61, 0, 0, 318,
-		319, 5, 61, 0, 0, 319, 86, 1, 0, 0, 0, 320, 321, 5, 33, 0, 0, 321, 322,
-		5, 61, 0, 0, 322, 88, 1, 0, 0, 0, 323, 324, 5, 60, 0, 0, 324, 90, 1, 0,
-		0, 0, 325, 326, 5, 62, 0, 0, 326, 92, 1, 0, 0, 0, 327, 328, 5, 60, 0, 0,
-		328, 329, 5, 61, 0, 0, 329, 94, 1, 0, 0, 0, 330, 331, 5, 62, 0, 0, 331,
-		332, 5, 61, 0, 0, 332, 96, 1, 0, 0, 0, 333, 334, 5, 97, 0, 0, 334, 335,
-		5, 110, 0, 0, 335, 336, 5, 100, 0, 0, 336, 98, 1, 0, 0, 0, 337, 338, 5,
-		111, 0, 0, 338, 339, 5, 114, 0, 0, 339, 100, 1, 0, 0, 0, 340, 341, 5, 110,
-		0, 0, 341, 342, 5, 111, 0, 0, 342, 343, 5, 116, 0, 0, 343, 102, 1, 0, 0,
-		0, 344, 345, 5, 40, 0, 0, 345, 104, 1, 0, 0, 0, 346, 347, 5, 41, 0, 0,
-		347, 106, 1, 0, 0, 0, 348, 349, 5, 123, 0, 0, 349, 108, 1, 0, 0, 0, 350,
-		351, 5, 125, 0, 0, 351, 110, 1, 0, 0, 0, 352, 353, 5, 91, 0, 0, 353, 112,
-		1, 0, 0, 0, 354, 355, 5, 93, 0, 0, 355, 114, 1, 0, 0, 0, 356, 357, 5, 44,
-		0, 0, 357, 116, 1, 0, 0, 0, 358, 359, 5, 58, 0, 0, 359, 118, 1, 0, 0, 0,
-		360, 361, 5, 46, 0, 0, 361, 120, 1, 0, 0, 0, 362, 364, 3, 123, 61, 0, 363,
-		362, 1, 0, 0, 0, 364, 365, 1, 0, 0, 0, 365, 363, 1, 0, 0, 0, 365, 366,
-		1, 0, 0, 0, 366, 122, 1, 0, 0, 0, 367, 368, 7, 0, 0, 0, 368, 124, 1, 0,
-		0, 0, 369, 370, 3, 121, 60, 0, 370, 126, 1, 0, 0, 0, 371, 372, 3, 121,
-		60, 0, 372, 374, 5, 46, 0, 0, 373, 375, 3, 121, 60, 0, 374, 373, 1, 0,
-		0, 0, 374, 375, 1, 0, 0, 0, 375, 379, 1, 0, 0, 0, 376, 377, 5, 46, 0, 0,
-		377, 379, 3, 121, 60, 0, 378, 371, 1, 0, 0, 0, 378, 376, 1, 0, 0, 0, 379,
-		128, 1, 0, 0, 0, 380, 382, 3, 133, 66, 0, 381, 380, 1, 0, 0, 0, 381, 382,
-		1, 0, 0, 0, 382, 383, 1, 0, 0, 0, 383, 389, 5, 96, 0, 0, 384, 388, 8, 1,
-		0, 0, 385, 386, 5, 92, 0, 0, 386, 388, 9, 0, 0, 0, 387, 384, 1, 0, 0, 0,
-		387, 385, 1, 0, 0, 0, 388, 391, 1, 0, 0, 0, 389, 387, 1, 0, 0, 0, 389,
-		390, 1, 0, 0, 0, 390, 392, 1, 0, 0, 0, 391, 389, 1, 0, 0, 0, 392, 393,
-		5, 96, 0, 0, 393, 130, 1, 0, 0, 0, 394, 396, 3, 133, 66, 0, 395, 394, 1,
-		0, 0, 0, 395, 396, 1, 0, 0, 0, 396, 397, 1, 0, 0, 0, 397, 403, 5, 34, 0,
-		0, 398, 402, 8, 2, 0, 0, 399, 400, 5, 92, 0, 0, 400, 402, 9, 0, 0, 0, 401,
-		398, 1, 0, 0, 0, 401, 399, 1, 0, 0, 0, 402, 405, 1, 0, 0, 0, 403, 401,
-		1, 0, 0, 0, 403, 404, 1, 0, 0, 0, 404, 406, 1, 0, 0, 0, 405, 403, 1, 0,
-		0, 0, 406, 407, 5, 34, 0, 0, 407, 132, 1, 0, 0, 0, 408, 414, 7, 3, 0, 0,
-		409, 410, 5, 114, 0, 0, 410, 414, 5, 102, 0, 0, 411, 412, 5, 102, 0, 0,
-		412, 414, 5, 114, 0, 0, 413, 408, 1, 0, 0, 0, 413, 409, 1, 0, 0, 0, 413,
-		411, 1, 0, 0, 0, 414, 134, 1, 0, 0, 0, 415, 421, 7, 4, 0, 0, 416, 420,
-		7, 5, 0, 0, 417, 418, 4, 67, 0, 0, 418, 420, 5, 45, 0, 0, 419, 416, 1,
-		0, 0, 0, 419, 417, 1, 0, 0, 0, 420, 423, 1, 0, 0, 0, 421, 419, 1, 0, 0,
-		0, 421, 422, 1, 0, 0, 0, 422, 136, 1, 0, 0, 0, 423, 421, 1, 0, 0, 0, 424,
-		425, 5, 47, 0, 0, 425, 426, 5, 47, 0, 0, 426, 430, 1, 0, 0, 0, 427, 429,
-		8, 6, 0, 0, 428, 427, 1, 0, 0, 0, 429, 432, 1, 0, 0, 0, 430, 428, 1, 0,
-		0, 0, 430, 431, 1, 0, 0, 0, 431, 433, 1, 0, 0, 0, 432, 430, 1, 0, 0, 0,
-		433, 434, 6, 68, 0, 0, 434, 138, 1, 0, 0, 0, 435, 436, 5, 47, 0, 0, 436,
-		437, 5, 42, 0, 0, 437, 441, 1, 0, 0, 0, 438, 440, 9, 0, 0, 0, 439, 438,
-		1, 0, 0, 0, 440, 443, 1, 0, 0, 0, 441, 442, 1, 0, 0, 0, 441, 439, 1, 0,
-		0, 0, 442, 444, 1, 0, 0, 0, 443, 441, 1, 0, 0, 0, 444, 445, 5, 42, 0, 0,
-		445, 446, 5, 47, 0, 0, 446, 447, 1, 0, 0, 0, 447, 448, 6, 69, 0, 0, 448,
-		140, 1, 0, 0, 0, 449, 451, 7, 7, 0, 0, 450, 449, 1, 0, 0, 0, 451, 452,
-		1, 0, 0, 0, 452, 450, 1, 0, 0, 0, 452, 453, 1, 0, 0, 0, 453, 454, 1, 0,
-		0, 0, 454, 455, 6, 70, 0, 0, 455, 142, 1, 0, 0, 0, 16, 0, 365, 374, 378,
-		381, 387, 389, 395, 401, 403, 413, 419, 421, 430, 441, 452, 1, 0, 1, 0,
+		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
+		73, 7, 73, 2, 74, 7, 74, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1,
+		1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
+		1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6,
+		1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7,
+		1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 10,
+		1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1,
+		11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13,
+		1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1,
+		14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16,
+		1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1,
+		17, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20,
+		1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1,
+		23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26,
+		1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1,
+		28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30,
+		1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 34, 1,
+		34, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 38,
+		1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 41, 1, 41, 1, 42, 1,
+		42, 1, 43, 1, 43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 47,
+		1, 47, 1, 47, 1, 48, 1, 48, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 51, 1,
+		51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54,
+		1, 54, 1, 54, 1, 55, 1, 55, 1, 56, 1, 56, 1, 57, 1, 57, 1, 58, 1, 58, 1,
+		59, 1, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1, 62, 1, 62, 1, 63, 1, 63, 1, 64,
+		4, 64, 397, 8, 64, 11, 64, 12, 64, 398, 1, 65, 1, 65, 1, 66, 1, 66, 1,
+		67, 1, 67, 1, 67, 3, 67, 408, 8, 67, 1, 67, 1, 67, 3, 67, 412, 8, 67, 1,
+		68, 3, 68, 415, 8, 68, 1, 68, 1, 68, 1, 68, 1, 68, 5, 68, 421, 8, 68, 10,
+		68, 12, 68, 424, 9, 68, 1, 68, 1, 68, 1, 69, 3, 69, 429, 8, 69, 1, 69,
+		1, 69, 1, 69, 1, 69, 5, 69, 435, 8, 69, 10, 69, 12, 69, 438, 9, 69, 1,
+		69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 3, 70, 447, 8, 70, 1, 71,
+		1, 71, 1, 71, 1, 71, 5, 71, 453, 8, 71, 10, 71, 12, 71, 456, 9, 71, 1,
+		72, 1, 72, 1, 72, 1, 72, 5, 72, 462, 8, 72, 10, 72, 12, 72, 465, 9, 72,
+		1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 5, 73, 473, 8, 73, 10, 73, 12,
+		73, 476, 9, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 74, 4, 74, 484, 8,
+		74, 11, 74, 12, 74, 485, 1, 74, 1, 74, 1, 474, 0, 75, 1, 1, 3, 2, 5, 3,
+		7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13,
+		27, 14, 29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43, 22,
+		45, 23, 47, 24, 49, 25, 51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61, 31,
+		63, 32, 65, 33, 67, 34, 69, 35, 71, 36, 73, 37, 75, 38, 77, 39, 79, 40,
+		81, 41, 83, 42, 85, 43, 87, 44, 89, 45, 91, 46, 93, 47, 95, 48, 97, 49,
+		99, 50, 101, 51, 103, 52, 105, 53, 107, 54, 109, 55, 111, 56, 113, 57,
+		115, 58, 117, 59, 119, 60, 121, 61, 123, 62, 125, 63, 127, 64, 129, 0,
+		131, 0, 133, 65, 135, 66, 137, 67, 139, 68, 141, 0, 143, 69, 145, 70, 147,
+		71, 149, 72, 1, 0, 8, 1, 0, 48, 57, 2, 0, 92, 92, 96, 96, 4, 0, 10, 10,
+		13, 13, 34, 34, 92, 92, 2, 0, 102, 102, 114, 114, 3, 0, 65, 90, 95, 95,
+		97, 122, 4, 0, 48, 57, 65, 90, 95, 95, 97, 122, 2, 0, 10, 10, 13, 13, 3,
+		0, 9, 10, 13, 13, 32, 32, 501, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5,
+		1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13,
+		1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0,
+		21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0,
+		0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0,
+		0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0,
+		0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1,
+		0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59,
+		1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0,
+		67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0,
+		0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0,
+		0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0,
+		0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1,
+		0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0,
+		105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0,
+		0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119,
+		1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0,
+		0, 127, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1,
+		0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0,
+		147, 1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 1, 151, 1, 0, 0, 0, 3, 156, 1, 0,
+		0, 0, 5, 159, 1, 0, 0, 0, 7, 164, 1, 0, 0, 0, 9, 171, 1, 0, 0, 0, 11, 175,
+		1, 0, 0, 0, 13, 181, 1, 0, 0, 0, 15, 190, 1, 0, 0, 0, 17, 196, 1, 0, 0,
+		0, 19, 203, 1, 0, 0, 0, 21, 206, 1, 0, 0, 0, 23, 215, 1, 0, 0, 0, 25, 221,
+		1, 0, 0, 0, 27, 226, 1, 0, 0, 0, 29, 231, 1, 0, 0, 0, 31, 241, 1, 0, 0,
+		0, 33, 248, 1, 0, 0, 0, 35, 255, 1, 0, 0, 0, 37, 260, 1, 0, 0, 0, 39, 263,
+		1, 0, 0, 0, 41, 267, 1, 0, 0, 0, 43, 271, 1, 0, 0, 0, 45, 275, 1, 0, 0,
+		0, 47, 278, 1, 0, 0, 0, 49, 282, 1, 0, 0, 0, 51, 286, 1, 0, 0, 0, 53, 290,
+		1, 0, 0, 0, 55, 294, 1, 0, 0, 0, 57, 298, 1, 0, 0, 0, 59, 302, 1, 0, 0,
+		0, 61, 309, 1, 0, 0, 0, 63, 312, 1, 0, 0, 0, 65, 315, 1, 0, 0, 0, 67, 318,
+		1, 0, 0, 0, 69, 321, 1, 0, 0, 0, 71, 323, 1, 0, 0, 0, 73, 326, 1, 0, 0,
+		0, 75, 329, 1, 0, 0, 0, 77, 332, 1, 0, 0, 0, 79, 335, 1, 0, 0, 0, 81, 338,
+		1, 0, 0, 0, 83, 340, 1, 0, 0, 0, 85, 342, 1, 0, 0, 0, 87, 344, 1, 0, 0,
+		0, 89, 346, 1, 0, 0, 0, 91, 348, 1, 0, 0, 0, 93, 350, 1, 0, 0, 0, 95, 353,
+		1, 0, 0, 0, 97, 356, 1, 0, 0, 0, 99, 358, 1, 0, 0, 0, 101, 360, 1, 0, 0,
+		0, 103, 363, 1, 0, 0, 0, 105, 366, 1, 0, 0, 0, 107, 370, 1, 0, 0, 0, 109,
+		373, 1, 0, 0, 0, 111, 377, 1, 0, 0, 0, 113, 379, 1, 0, 0, 0, 115, 381,
+		1, 0, 0, 0, 117, 383, 1, 0, 0, 0, 119, 385, 1, 0, 0, 0, 121, 387, 1, 0,
+		0, 0, 123, 389, 1, 0, 0, 0, 125, 391, 1, 0, 0, 0, 127, 393, 1, 0, 0, 0,
+		129, 396, 1, 0, 0, 0, 131, 400, 1, 0, 0, 0, 133, 402, 1, 0, 0, 0, 135,
+		411, 1, 0, 0, 0, 137, 414, 1, 0, 0, 0, 139, 428, 1, 0, 0, 0, 141, 446,
+		1, 0, 0, 0, 143, 448, 1, 0, 0, 0, 145, 457, 1, 0, 0, 0, 147, 468, 1, 0,
+		0, 0, 149, 483, 1, 0, 0, 0, 151, 152, 5, 102, 0, 0, 152, 153, 5, 117, 0,
+		0, 153, 154, 5, 110, 0, 0, 154, 155, 5, 99, 0, 0, 155, 2, 1, 0, 0, 0, 156,
+		157, 5, 105, 0, 0, 157, 158, 5, 102, 0, 0, 158, 4, 1, 0, 0, 0, 159, 160,
+		5, 101, 0, 0, 160, 161, 5, 108, 0, 0, 161, 162, 5, 115, 0, 0, 162, 163,
+		5, 101, 0, 0, 163, 6, 1, 0, 0, 0, 164, 165, 5, 114, 0, 0, 165, 166, 5,
+		101, 0, 0, 166, 167, 5, 116, 0, 0, 167, 168, 5, 117, 0, 0, 168, 169, 5,
+		114, 0, 0, 169, 170, 5, 110, 0, 0, 170, 8, 1, 0, 0, 0, 171, 172, 5, 102,
+		0, 0, 172, 173, 5, 111, 0, 0, 173, 174, 5, 114, 0, 0, 174, 10, 1, 0, 0,
+		0, 175, 176, 5, 98, 0, 0, 176, 177, 5, 114, 0, 0, 177, 178, 5, 101, 0,
+		0, 178, 179, 5, 97, 0, 0, 179, 180, 5, 107, 0, 0, 180, 12, 1, 0, 0, 0,
+		181, 182, 5, 99, 0, 0, 182, 183, 5, 111, 0, 0, 183, 184, 5, 110, 0, 0,
+		184, 185, 5, 116, 0, 0, 185, 186, 5, 105, 0, 0, 186, 187, 5, 110, 0, 0,
+		187, 188, 5, 117, 0, 0, 188, 189, 5, 101, 0, 0, 189, 14, 1, 0, 0, 0, 190,
+		191, 5, 109, 0, 0, 191, 192, 5, 97, 0, 0, 192, 193, 5, 116, 0, 0, 193,
+		194, 5, 99, 0, 0, 194, 195, 5, 104, 0, 0, 195, 16, 1, 0, 0, 0, 196, 197,
+		5, 105, 0, 0, 197, 198, 5, 109, 0, 0, 198, 199, 5, 112, 0, 0, 199, 200,
+		5, 111, 0, 0, 200, 201, 5, 114, 0, 0, 201, 202, 5, 116, 0, 0, 202, 18,
+		1, 0, 0, 0, 203, 204, 5, 97, 0, 0, 204, 205, 5, 115, 0, 0, 205, 20, 1,
+		0, 0, 0, 206, 207, 5, 115, 0, 0, 207, 208, 5, 101, 0, 0, 208, 209, 5, 113,
+		0, 0, 209, 210, 5, 117, 0, 0, 210, 211, 5, 101, 0, 0, 211, 212, 5, 110,
+		0, 0, 212, 213, 5, 99, 0, 0, 213, 214, 5, 101, 0, 0, 214, 22, 1, 0, 0,
+		0, 215, 216, 5, 115, 0, 0, 216, 217, 5, 116, 0, 0, 217, 218, 5, 97, 0,
+		0, 218, 219, 5, 103, 0, 0, 219, 220, 5, 101, 0, 0, 220, 24, 1, 0, 0, 0,
+		221, 222, 5, 110, 0, 0, 222, 223, 5, 101, 0, 0, 223, 224, 5, 120, 0, 0,
+		224, 225, 5, 116, 0, 0, 225, 26, 1, 0, 0, 0, 226, 227, 5, 99, 0, 0, 227,
+		228, 5, 104, 0, 0, 228, 229, 5, 97, 0, 0, 229, 230, 5, 110, 0, 0, 230,
+		28, 1, 0, 0, 0, 231, 232, 5, 97, 0, 0, 232, 233, 5, 117, 0, 0, 233, 234,
+		5, 116, 0, 0, 234, 235, 5, 104, 0, 0, 235, 236, 5, 111, 0, 0, 236, 237,
+		5, 114, 0, 0, 237, 238, 5, 105, 0, 0, 238, 239, 5, 116, 0, 0, 239, 240,
+		5, 121, 0, 0, 240, 30, 1, 0, 0, 0, 241, 242, 5, 115, 0, 0, 242, 243, 5,
+		97, 0, 0, 243, 244, 5, 102, 0, 0, 244, 245, 5, 101, 0, 0, 245, 246, 5,
+		116, 0, 0, 246, 247, 5, 121, 0, 0, 247, 32, 1, 0, 0, 0, 248, 249, 5, 115,
+		0, 0, 249, 250, 5, 116, 0, 0, 250, 251, 5, 114, 0, 0, 251, 252, 5, 117,
+		0, 0, 252, 253, 5, 99, 0, 0, 253, 254, 5, 116, 0, 0, 254, 34, 1, 0, 0,
+		0, 255, 256, 5, 101, 0, 0, 256, 257, 5, 110, 0, 0, 257, 258, 5, 117, 0,
+		0, 258, 259, 5, 109, 0, 0, 259, 36, 1, 0, 0, 0, 260, 261, 5, 105, 0, 0,
+		261, 262, 5, 56, 0, 0, 262, 38, 1, 0, 0, 0, 263, 264, 5, 105, 0, 0, 264,
+		265, 5, 49, 0, 0, 265, 266, 5, 54, 0, 0, 266, 40, 1, 0, 0, 0, 267, 268,
+		5, 105, 0, 0, 268, 269, 5, 51, 0, 0, 269, 270, 5, 50, 0, 0, 270, 42, 1,
+		0, 0, 0, 271, 272, 5, 105, 0, 0, 272, 273, 5, 54, 0, 0, 273, 274, 5, 52,
+		0, 0, 274, 44, 1, 0, 0, 0, 275, 276, 5, 117, 0, 0, 276, 277, 5, 56, 0,
+		0, 277, 46, 1, 0, 0, 0, 278, 279, 5, 117, 0, 0, 279, 280, 5, 49, 0, 0,
+		280, 281, 5, 54, 0, 0, 281, 48, 1, 0, 0, 0, 282, 283, 5, 117, 0, 0, 283,
+		284, 5, 51, 0, 0, 284, 285, 5, 50, 0, 0, 285, 50, 1, 0, 0, 0, 286, 287,
+		5, 117, 0, 0, 287, 288, 5, 54, 0, 0, 288, 289, 5, 52, 0, 0, 289, 52, 1,
+		0, 0, 0, 290, 291, 5, 102, 0, 0, 291, 292, 5, 51, 0, 0, 292, 293, 5, 50,
+		0, 0, 293, 54, 1, 0, 0, 0, 294, 295, 5, 102, 0, 0, 295, 296, 5, 54, 0,
+		0, 296, 297, 5, 52, 0, 0, 297, 56, 1, 0, 0, 0, 298, 299, 5, 115, 0, 0,
+		299, 300, 5, 116, 0, 0, 300, 301, 5, 114, 0, 0, 301, 58, 1, 0, 0, 0, 302,
+		303, 5, 115, 0, 0, 303, 304, 5, 101, 0, 0, 304, 305, 5, 114, 0, 0, 305,
+		306, 5, 105, 0, 0, 306, 307, 5, 101, 0, 0, 307, 308, 5, 115, 0, 0, 308,
+		60, 1, 0, 0, 0, 309, 310, 5, 45, 0, 0, 310, 311, 5, 62, 0, 0, 311, 62,
+		1, 0, 0, 0, 312, 313, 5, 58, 0, 0, 313, 314, 5, 61, 0, 0, 314, 64, 1, 0,
+		0, 0, 315, 316, 5, 36, 0, 0, 316, 317, 5, 61, 0, 0, 317, 66, 1, 0, 0, 0,
+		318, 319, 5, 61, 0, 0, 319, 320, 5, 62, 0, 0, 320, 68, 1, 0, 0, 0, 321,
+		322, 5, 61, 0, 0, 322, 70, 1, 0, 0, 0, 323, 324, 5, 43, 0, 0, 324, 325,
+		5, 61, 0, 0, 325, 72, 1, 0, 0, 0, 326, 327, 5, 45, 0, 0, 327, 328, 5, 61,
+		0, 0, 328, 74, 1, 0, 0, 0, 329, 330, 5, 42, 0, 0, 330, 331, 5, 61, 0, 0,
+		331, 76, 1, 0, 0, 0, 332, 333, 5, 47, 0, 0, 333, 334, 5, 61, 0, 0, 334,
+		78, 1, 0, 0, 0, 335, 336, 5, 37, 0, 0, 336, 337, 5, 61, 0, 0, 337, 80,
+		1, 0, 0, 0, 338, 339, 5, 43, 0, 0, 339, 82, 1, 0, 0, 0, 340, 341, 5, 45,
+		0, 0, 341, 84, 1, 0, 0, 0, 342, 343, 5, 42, 0, 0, 343, 86, 1, 0, 0, 0,
+		344, 345, 5, 47, 0, 0, 345, 88, 1, 0, 0, 0, 346, 347, 5, 37, 0, 0, 347,
+		90, 1, 0, 0, 0, 348, 349, 5, 94, 0, 0, 349, 92, 1, 0, 0, 0, 350, 351, 5,
+		61, 0, 0, 351, 352, 5, 61, 0, 0, 352, 94, 1, 0, 0, 0, 353, 354, 5, 33,
+		0, 0, 354, 355, 5, 61, 0, 0, 355, 96, 1, 0, 0, 0, 356, 357, 5, 60, 0, 0,
+		357, 98, 1, 0, 0, 0, 358, 359, 5, 62, 0, 0, 359, 100, 1, 0, 0, 0, 360,
+		361, 5, 60, 0, 0, 361, 362, 5, 61, 0, 0, 362, 102, 1, 0, 0, 0, 363, 364,
+		5, 62, 0, 0, 364, 365, 5, 61, 0, 0, 365, 104, 1, 0, 0, 0, 366, 367, 5,
+		97, 0, 0, 367, 368, 5, 110, 0, 0, 368, 369, 5, 100, 0, 0, 369, 106, 1,
+		0, 0, 0, 370, 371, 5, 111, 0, 0, 371, 372, 5, 114, 0, 0, 372, 108, 1, 0,
+		0, 0, 373, 374, 5, 110, 0, 0, 374, 375, 5, 111, 0, 0, 375, 376, 5, 116,
+		0, 0, 376, 110, 1, 0, 0, 0, 377, 378, 5, 40, 0, 0, 378, 112, 1, 0, 0, 0,
+		379, 380, 5, 41, 0, 0, 380, 114, 1, 0, 0, 0, 381, 382, 5, 123, 0, 0, 382,
+		116, 1, 0, 0, 0, 383, 384, 5, 125, 0, 0, 384, 118, 1, 0, 0, 0, 385, 386,
+		5, 91, 0, 0, 386, 120, 1, 0, 0, 0, 387, 388, 5, 93, 0, 0, 388, 122, 1,
+		0, 0, 0, 389, 390, 5, 44, 0, 0, 390, 124, 1, 0, 0, 0, 391, 392, 5, 58,
+		0, 0, 392, 126, 1, 0, 0, 0, 393, 394, 5, 46, 0, 0, 394, 128, 1, 0, 0, 0,
+		395, 397, 3, 131, 65, 0, 396, 395, 1, 0, 0, 0, 397, 398, 1, 0, 0, 0, 398,
+		396, 1, 0, 0, 0, 398, 399, 1, 0, 0, 0, 399, 130, 1, 0, 0, 0, 400, 401,
+		7, 0, 0, 0, 401, 132, 1, 0, 0, 0, 402, 403, 3, 129, 64, 0, 403, 134, 1,
+		0, 0, 0, 404, 405, 3, 129, 64, 0, 405, 407, 5, 46, 0, 0, 406, 408, 3, 129,
+		64, 0, 407, 406, 1, 0, 0, 0, 407, 408, 1, 0, 0, 0, 408, 412, 1, 0, 0, 0,
+		409, 410, 5, 46, 0, 0, 410, 412, 3, 129, 64, 0, 411, 404, 1, 0, 0, 0, 411,
+		409, 1, 0, 0, 0, 412, 136, 1, 0, 0, 0, 413, 415, 3, 141, 70, 0, 414, 413,
+		1, 0, 0, 0, 414, 415, 1, 0, 0, 0, 415, 416, 1, 0, 0, 0, 416, 422, 5, 96,
+		0, 0, 417, 421, 8, 1, 0, 0, 418, 419, 5, 92, 0, 0, 419, 421, 9, 0, 0, 0,
+		420, 417, 1, 0, 0, 0, 420, 418, 1, 0, 0, 0, 421, 424, 1, 0, 0, 0, 422,
+		420, 1, 0, 0, 0, 422, 423, 1, 0, 0, 0, 423, 425, 1, 0, 0, 0, 424, 422,
+		1, 0, 0, 0, 425, 426, 5, 96, 0, 0, 426, 138, 1, 0, 0, 0, 427, 429, 3, 141,
+		70, 0, 428, 427, 1, 0, 0, 0, 428, 429, 1, 0, 0, 0, 429, 430, 1, 0, 0, 0,
+		430, 436, 5, 34, 0, 0, 431, 435, 8, 2, 0, 0, 432, 433, 5, 92, 0, 0, 433,
+		435, 9, 0, 0, 0, 434, 431, 1, 0, 0, 0, 434, 432, 1, 0, 0, 0, 435, 438,
+		1, 0, 0, 0, 436, 434, 1, 0, 0, 0, 436, 437, 1, 0, 0, 0, 437, 439, 1, 0,
+		0, 0, 438, 436, 1, 0, 0, 0, 439, 440, 5, 34, 0, 0, 440, 140, 1, 0, 0, 0,
+		441, 447, 7, 3, 0, 0, 442, 443, 5, 114, 0, 0, 443, 447, 5, 102, 0, 0, 444,
+		445, 5, 102, 0, 0, 445, 447, 5, 114, 0, 0, 446, 441, 1, 0, 0, 0, 446, 442,
+		1, 0, 0, 0, 446, 444, 1, 0, 0, 0, 447, 142, 1, 0, 0, 0, 448, 454, 7, 4,
+		0, 0, 449, 453, 7, 5, 0, 0, 450, 451, 4, 71, 0, 0, 451, 453, 5, 45, 0,
+		0, 452, 449, 1, 0, 0, 0, 452, 450, 1, 0, 0, 0, 453, 456, 1, 0, 0, 0, 454,
+		452, 1, 0, 0, 0, 454, 455, 1, 0, 0, 0, 455, 144, 1, 0, 0, 0, 456, 454,
+		1, 0, 0, 0, 457, 458, 5, 47, 0, 0, 458, 459, 5, 47, 0, 0, 459, 463, 1,
+		0, 0, 0, 460, 462, 8, 6, 0, 0, 461, 460, 1, 0, 0, 0, 462, 465, 1, 0, 0,
+		0, 463, 461, 1, 0, 0, 0, 463, 464, 1, 0, 0, 0, 464, 466, 1, 0, 0, 0, 465,
+		463, 1, 0, 0, 0, 466, 467, 6, 72, 0, 0, 467, 146, 1, 0, 0, 0, 468, 469,
+		5, 47, 0, 0, 469, 470, 5, 42, 0, 0, 470, 474, 1, 0, 0, 0, 471, 473, 9,
+		0, 0, 0, 472, 471, 1, 0, 0, 0, 473, 476, 1, 0, 0, 0, 474, 475, 1, 0, 0,
+		0, 474, 472, 1, 0, 0, 0, 475, 477, 1, 0, 0, 0, 476, 474, 1, 0, 0, 0, 477,
+		478, 5, 42, 0, 0, 478, 479, 5, 47, 0, 0, 479, 480, 1, 0, 0, 0, 480, 481,
+		6, 73, 0, 0, 481, 148, 1, 0, 0, 0, 482, 484, 7, 7, 0, 0, 483, 482, 1, 0,
+		0, 0, 484, 485, 1, 0, 0, 0, 485, 483, 1, 0, 0, 0, 485, 486, 1, 0, 0, 0,
+		486, 487, 1, 0, 0, 0, 487, 488, 6, 74, 0, 0, 488, 150, 1, 0, 0, 0, 16,
+		0, 398, 407, 411, 414, 420, 422, 428, 434, 436, 446, 452, 454, 463, 474,
+		485, 1, 0, 1, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -334,72 +350,76 @@ const (
 	ArcLexerFOR                 = 5
 	ArcLexerBREAK               = 6
 	ArcLexerCONTINUE            = 7
-	ArcLexerIMPORT              = 8
-	ArcLexerAS                  = 9
-	ArcLexerSEQUENCE            = 10
-	ArcLexerSTAGE               = 11
-	ArcLexerNEXT                = 12
-	ArcLexerCHAN                = 13
-	ArcLexerAUTHORITY           = 14
-	ArcLexerI8                  = 15
-	ArcLexerI16                 = 16
-	ArcLexerI32                 = 17
-	ArcLexerI64                 = 18
-	ArcLexerU8                  = 19
-	ArcLexerU16                 = 20
-	ArcLexerU32                 = 21
-	ArcLexerU64                 = 22
-	ArcLexerF32                 = 23
-	ArcLexerF64                 = 24
-	ArcLexerSTR                 = 25
-	ArcLexerSERIES              = 26
-	ArcLexerARROW               = 27
-	ArcLexerDECLARE             = 28
-	ArcLexerSTATE_DECLARE       = 29
-	ArcLexerTRANSITION          = 30
-	ArcLexerASSIGN              = 31
-	ArcLexerPLUS_ASSIGN         = 32
-	ArcLexerMINUS_ASSIGN        = 33
-	ArcLexerSTAR_ASSIGN         = 34
-	ArcLexerSLASH_ASSIGN        = 35
-	ArcLexerPERCENT_ASSIGN      = 36
-	ArcLexerPLUS                = 37
-	ArcLexerMINUS               = 38
-	ArcLexerSTAR                = 39
-	ArcLexerSLASH               = 40
-	ArcLexerPERCENT             = 41
-	ArcLexerCARET               = 42
-	ArcLexerEQ                  = 43
-	ArcLexerNEQ                 = 44
-	ArcLexerLT                  = 45
-	ArcLexerGT                  = 46
-	ArcLexerLEQ                 = 47
-	ArcLexerGEQ                 = 48
-	ArcLexerAND                 = 49
-	ArcLexerOR                  = 50
-	ArcLexerNOT                 = 51
-	ArcLexerLPAREN              = 52
-	ArcLexerRPAREN              = 53
-	ArcLexerLBRACE              = 54
-	ArcLexerRBRACE              = 55
-	ArcLexerLBRACKET            = 56
-	ArcLexerRBRACKET            = 57
-	ArcLexerCOMMA               = 58
-	ArcLexerCOLON               = 59
-	ArcLexerDOT                 = 60
-	ArcLexerINTEGER_LITERAL     = 61
-	ArcLexerFLOAT_LITERAL       = 62
-	ArcLexerSTR_LITERAL_MULTI   = 63
-	ArcLexerSTR_LITERAL         = 64
-	ArcLexerIDENTIFIER          = 65
-	ArcLexerSINGLE_LINE_COMMENT = 66
-	ArcLexerMULTI_LINE_COMMENT  = 67
-	ArcLexerWS                  = 68
+	ArcLexerMATCH               = 8
+	ArcLexerIMPORT              = 9
+	ArcLexerAS                  = 10
+	ArcLexerSEQUENCE            = 11
+	ArcLexerSTAGE               = 12
+	ArcLexerNEXT                = 13
+	ArcLexerCHAN                = 14
+	ArcLexerAUTHORITY           = 15
+	ArcLexerSAFETY              = 16
+	ArcLexerSTRUCT              = 17
+	ArcLexerENUM                = 18
+	ArcLexerI8                  = 19
+	ArcLexerI16                 = 20
+	ArcLexerI32                 = 21
+	ArcLexerI64                 = 22
+	ArcLexerU8                  = 23
+	ArcLexerU16                 = 24
+	ArcLexerU32                 = 25
+	ArcLexerU64                 = 26
+	ArcLexerF32                 = 27
+	ArcLexerF64                 = 28
+	ArcLexerSTR                 = 29
+	ArcLexerSERIES              = 30
+	ArcLexerARROW               = 31
+	ArcLexerDECLARE             = 32
+	ArcLexerSTATE_DECLARE       = 33
+	ArcLexerTRANSITION          = 34
+	ArcLexerASSIGN              = 35
+	ArcLexerPLUS_ASSIGN         = 36
+	ArcLexerMINUS_ASSIGN        = 37
+	ArcLexerSTAR_ASSIGN         = 38
+	ArcLexerSLASH_ASSIGN        = 39
+	ArcLexerPERCENT_ASSIGN      = 40
+	ArcLexerPLUS                = 41
+	ArcLexerMINUS               = 42
+	ArcLexerSTAR                = 43
+	ArcLexerSLASH               = 44
+	ArcLexerPERCENT             = 45
+	ArcLexerCARET               = 46
+	ArcLexerEQ                  = 47
+	ArcLexerNEQ                 = 48
+	ArcLexerLT                  = 49
+	ArcLexerGT                  = 50
+	ArcLexerLEQ                 = 51
+	ArcLexerGEQ                 = 52
+	ArcLexerAND                 = 53
+	ArcLexerOR                  = 54
+	ArcLexerNOT                 = 55
+	ArcLexerLPAREN              = 56
+	ArcLexerRPAREN              = 57
+	ArcLexerLBRACE              = 58
+	ArcLexerRBRACE              = 59
+	ArcLexerLBRACKET            = 60
+	ArcLexerRBRACKET            = 61
+	ArcLexerCOMMA               = 62
+	ArcLexerCOLON               = 63
+	ArcLexerDOT                 = 64
+	ArcLexerINTEGER_LITERAL     = 65
+	ArcLexerFLOAT_LITERAL       = 66
+	ArcLexerSTR_LITERAL_MULTI   = 67
+	ArcLexerSTR_LITERAL         = 68
+	ArcLexerIDENTIFIER          = 69
+	ArcLexerSINGLE_LINE_COMMENT = 70
+	ArcLexerMULTI_LINE_COMMENT  = 71
+	ArcLexerWS                  = 72
 )
 
 func (l *ArcLexer) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 67:
+	case 71:
 		return l.IDENTIFIER_Sempred(localctx, predIndex)
 
 	default:
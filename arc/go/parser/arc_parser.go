@@ -42,49 +42,54 @@ func arcparserParserInit() {
 	staticData := &ArcParserParserStaticData
 	staticData.LiteralNames = []string{
 		"", "'func'", "'if'", "'else'", "'return'", "'for'", "'break'", "'continue'",
-		"'import'", "'as'", "'sequence'", "'stage'", "'next'", "'chan'", "'authority'",
-		"'i8'", "'i16'", "'i32'", "'i64'", "'u8'", "'u16'", "'u32'", "'u64'",
-		"'f32'", "'f64'", "'str'", "'series'", "'->'", "':='", "'$='", "'=>'",
-		"'='", "'+='", "'-='", "'*='", "'/='", "'%='", "'+'", "'-'", "'*'",
-		"'/'", "'%'", "'^'", "'=='", "'!='", "'<'", "'>'", "'<='", "'>='", "'and'",
-		"'or'", "'not'", "'('", "')'", "'{'", "'}'", "'['", "']'", "','", "':'",
-		"'.'",
+		"'match'", "'import'", "'as'", "'sequence'", "'stage'", "'next'", "'chan'",
+		"'authority'", "'safety'", "'struct'", "'enum'", "'i8'", "'i16'", "'i32'",
+		"'i64'", "'u8'", "'u16'", "'u32'", "'u64'", "'f32'", "'f64'", "'str'",
+		"'series'", "'->'", "':='", "'$='", "'=>'", "'='", "'+='", "'-='", "'*='",
+		"'/='", "'%='", "'+'", "'-'", "'*'", "'/'", "'%'", "'^'", "'=='", "'!='",
+		"'<'", "'>'", "'<='", "'>='", "'and'", "'or'", "'not'", "'('", "')'",
+		"'{'", "'}'", "'['", "']'", "','", "':'", "'.'",
 	}
 	staticData.SymbolicNames = []string{
-		"", "FUNC", "IF", "ELSE", "RETURN", "FOR", "BREAK", "CONTINUE", "IMPORT",
-		"AS", "SEQUENCE", "STAGE", "NEXT", "CHAN", "AUTHORITY", "I8", "I16",
-		"I32", "I64", "U8", "U16", "U32", "U64", "F32", "F64", "STR", "SERIES",
-		"ARROW", "DECLARE", "STATE_DECLARE", "TRANSITION", "ASSIGN", "PLUS_ASSIGN",
-		"MINUS_ASSIGN", "STAR_ASSIGN", "SLASH_ASSIGN", "PERCENT_ASSIGN", "PLUS",
-		"MINUS", "STAR", "SLASH", "PERCENT", "CARET", "EQ", "NEQ", "LT", "GT",
-		"LEQ", "GEQ", "AND", "OR", "NOT", "LPAREN", "RPAREN", "LBRACE", "RBRACE",
-		"LBRACKET", "RBRACKET", "COMMA", "COLON", "DOT", "INTEGER_LITERAL",
-		"FLOAT_LITERAL", "STR_LITERAL_MULTI", "STR_LITERAL", "IDENTIFIER", "SINGLE_LINE_COMMENT",
-		"MULTI_LINE_COMMENT", "WS",
+		"", "FUNC", "IF", "ELSE", "RETURN", "FOR", "BREAK", "CONTINUE", "MATCH",
+		"IMPORT", "AS", "SEQUENCE", "STAGE", "NEXT", "CHAN", "AUTHORITY", "SAFETY",
+		"STRUCT", "ENUM", "I8", "I16", "I32", "I64", "U8", "U16", "U32", "U64",
+		"F32", "F64", "STR", "SERIES", "ARROW", "DECLARE", "STATE_DECLARE",
+		"TRANSITION", "ASSIGN", "PLUS_ASSIGN", "MINUS_ASSIGN", "STAR_ASSIGN",
+		"SLASH_ASSIGN", "PERCENT_ASSIGN", "PLUS", "MINUS", "STAR", "SLASH",
+		"PERCENT", "CARET", "EQ", "NEQ", "LT", "GT", "LEQ", "GEQ", "AND", "OR",
+		"NOT", "LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACKET", "RBRACKET",
+		"COMMA", "COLON", "DOT", "INTEGER_LITERAL", "FLOAT_LITERAL", "STR_LITERAL_MULTI",
+		"STR_LITERAL", "IDENTIFIER", "SINGLE_LINE_COMMENT", "MULTI_LINE_COMMENT",
+		"WS",
 	}
 	staticData.RuleNames = []string{
 		"program", "topLevelItem", "importStatement", "importItem", "importPath",
-		"importPathHead", "authorityBlock", "authorityEntry", "functionDeclaration",
-		"inputList", "input", "outputType", "multiOutputBlock", "namedOutput",
-		"configBlock", "configList", "config", "sequenceDeclaration", "sequenceItem",
-		"stageDeclaration", "stageBody", "stageItem", "singleInvocation", "globalConstant",
-		"flowStatement", "flowOperator", "routingTable", "routingEntry", "flowNode",
-		"identifier", "function", "qualifiedIdentifier", "configValues", "namedConfigValues",
-		"namedConfigValue", "anonymousConfigValues", "arguments", "argumentList",
-		"block", "statement", "variableDeclaration", "localVariable", "statefulVariable",
-		"assignment", "compoundOp", "ifStatement", "elseIfClause", "elseClause",
-		"forStatement", "forClause", "breakStatement", "continueStatement",
-		"returnStatement", "type", "unitSuffix", "primitiveType", "numericType",
-		"integerType", "floatType", "channelType", "seriesType", "expression",
+		"importPathHead", "authorityBlock", "authorityEntry", "safetyBlock",
+		"safetyEntry", "functionDeclaration", "inputList", "input", "outputType",
+		"multiOutputBlock", "namedOutput", "configBlock", "configList", "config",
+		"sequenceDeclaration", "sequenceItem", "stageDeclaration", "stageBody",
+		"stageItem", "singleInvocation", "structDeclaration", "structFieldList",
+		"structField", "enumDeclaration", "enumMemberList", "enumMember", "globalConstant",
+		"flowStatement", "flowOperator", "routingTable", "routingEntry", "routingKey",
+		"flowNode", "identifier", "function", "qualifiedIdentifier", "configValues",
+		"namedConfigValues", "namedConfigValue", "anonymousConfigValues", "arguments",
+		"argumentList", "block", "statement", "variableDeclaration", "localVariable",
+		"statefulVariable", "assignment", "compoundOp", "ifStatement", "elseIfClause",
+		"elseClause", "matchStatement", "matchArm", "matchElse", "forStatement",
+		"forClause", "breakStatement", "continueStatement", "returnStatement",
+		"type", "unitSuffix", "primitiveType", "numericType", "integerType",
+		"floatType", "channelType", "seriesType", "structType", "expression",
 		"logicalOrExpression", "logicalAndExpression", "equalityExpression",
 		"relationalExpression", "additiveExpression", "multiplicativeExpression",
 		"powerExpression", "unaryExpression", "postfixExpression", "indexOrSlice",
-		"functionCallSuffix", "primaryExpression", "typeCast", "literal", "numericLiteral",
-		"seriesLiteral", "expressionList",
+		"functionCallSuffix", "memberAccess", "primaryExpression", "typeCast",
+		"structLiteral", "structFieldValue", "literal", "numericLiteral", "seriesLiteral",
+		"expressionList",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 68, 778, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 72, 960, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
@@ -99,343 +104,425 @@ func arcparserParserInit() {
 		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68,
 		7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7,
 		73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78,
-		1, 0, 5, 0, 160, 8, 0, 10, 0, 12, 0, 163, 9, 0, 1, 0, 1, 0, 1, 1, 1, 1,
-		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 174, 8, 1, 1, 2, 1, 2, 1, 2, 1, 2,
-		1, 2, 5, 2, 181, 8, 2, 10, 2, 12, 2, 184, 9, 2, 1, 2, 3, 2, 187, 8, 2,
-		1, 3, 1, 3, 1, 3, 3, 3, 192, 8, 3, 1, 4, 1, 4, 1, 4, 5, 4, 197, 8, 4, 10,
-		4, 12, 4, 200, 9, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 5, 6, 209,
-		8, 6, 10, 6, 12, 6, 212, 9, 6, 1, 6, 3, 6, 215, 8, 6, 1, 7, 1, 7, 1, 7,
-		3, 7, 220, 8, 7, 1, 8, 1, 8, 1, 8, 3, 8, 225, 8, 8, 1, 8, 1, 8, 3, 8, 229,
-		8, 8, 1, 8, 1, 8, 3, 8, 233, 8, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 5, 9,
-		240, 8, 9, 10, 9, 12, 9, 243, 9, 9, 1, 9, 3, 9, 246, 8, 9, 1, 10, 1, 10,
-		1, 10, 1, 10, 3, 10, 252, 8, 10, 1, 11, 1, 11, 1, 11, 1, 11, 3, 11, 258,
-		8, 11, 1, 12, 1, 12, 1, 12, 1, 12, 5, 12, 264, 8, 12, 10, 12, 12, 12, 267,
-		9, 12, 1, 12, 3, 12, 270, 8, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1,
-		14, 1, 14, 3, 14, 279, 8, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 5, 15,
-		286, 8, 15, 10, 15, 12, 15, 289, 9, 15, 1, 15, 3, 15, 292, 8, 15, 1, 16,
-		1, 16, 1, 16, 1, 16, 3, 16, 298, 8, 16, 1, 17, 1, 17, 3, 17, 302, 8, 17,
-		1, 17, 1, 17, 1, 17, 3, 17, 307, 8, 17, 1, 17, 5, 17, 310, 8, 17, 10, 17,
-		12, 17, 313, 9, 17, 1, 17, 3, 17, 316, 8, 17, 3, 17, 318, 8, 17, 1, 17,
-		1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 3, 18, 326, 8, 18, 1, 19, 1, 19, 3,
-		19, 330, 8, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 3, 20, 337, 8, 20, 1,
-		20, 5, 20, 340, 8, 20, 10, 20, 12, 20, 343, 9, 20, 1, 20, 3, 20, 346, 8,
-		20, 3, 20, 348, 8, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 3, 21, 355, 8,
-		21, 1, 22, 1, 22, 3, 22, 359, 8, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23,
-		1, 23, 1, 23, 1, 23, 3, 23, 369, 8, 23, 1, 24, 1, 24, 3, 24, 373, 8, 24,
-		1, 24, 1, 24, 1, 24, 3, 24, 378, 8, 24, 4, 24, 380, 8, 24, 11, 24, 12,
-		24, 381, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 5, 26, 390, 8, 26, 10,
-		26, 12, 26, 393, 9, 26, 1, 26, 3, 26, 396, 8, 26, 1, 26, 1, 26, 1, 27,
-		1, 27, 1, 27, 1, 27, 1, 27, 5, 27, 405, 8, 27, 10, 27, 12, 27, 408, 9,
-		27, 1, 27, 1, 27, 3, 27, 412, 8, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28,
-		1, 28, 3, 28, 420, 8, 28, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1,
-		30, 3, 30, 429, 8, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31,
-		1, 31, 1, 31, 3, 31, 440, 8, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1,
-		32, 1, 32, 1, 32, 1, 32, 1, 32, 3, 32, 452, 8, 32, 1, 33, 1, 33, 1, 33,
-		5, 33, 457, 8, 33, 10, 33, 12, 33, 460, 9, 33, 1, 33, 3, 33, 463, 8, 33,
-		1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 5, 35, 472, 8, 35, 10,
-		35, 12, 35, 475, 9, 35, 1, 35, 3, 35, 478, 8, 35, 1, 36, 1, 36, 3, 36,
-		482, 8, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 5, 37, 489, 8, 37, 10, 37,
-		12, 37, 492, 9, 37, 1, 37, 3, 37, 495, 8, 37, 1, 38, 1, 38, 5, 38, 499,
-		8, 38, 10, 38, 12, 38, 502, 9, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1,
-		39, 1, 39, 1, 39, 1, 39, 1, 39, 3, 39, 514, 8, 39, 1, 40, 1, 40, 3, 40,
-		518, 8, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 3,
-		41, 528, 8, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42,
-		3, 42, 538, 8, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1,
-		43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 3, 43,
-		557, 8, 43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 5, 45, 565, 8, 45,
-		10, 45, 12, 45, 568, 9, 45, 1, 45, 3, 45, 571, 8, 45, 1, 46, 1, 46, 1,
-		46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 49,
-		1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 3, 49, 595,
-		8, 49, 1, 50, 1, 50, 1, 51, 1, 51, 1, 52, 1, 52, 3, 52, 603, 8, 52, 1,
-		53, 1, 53, 3, 53, 607, 8, 53, 1, 53, 1, 53, 3, 53, 611, 8, 53, 1, 54, 1,
-		54, 1, 55, 1, 55, 3, 55, 617, 8, 55, 1, 56, 1, 56, 3, 56, 621, 8, 56, 1,
-		57, 1, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 3, 59, 630, 8, 59, 1, 59,
-		1, 59, 3, 59, 634, 8, 59, 1, 60, 1, 60, 1, 60, 3, 60, 639, 8, 60, 1, 61,
-		1, 61, 1, 62, 1, 62, 1, 62, 5, 62, 646, 8, 62, 10, 62, 12, 62, 649, 9,
-		62, 1, 63, 1, 63, 1, 63, 5, 63, 654, 8, 63, 10, 63, 12, 63, 657, 9, 63,
-		1, 64, 1, 64, 1, 64, 5, 64, 662, 8, 64, 10, 64, 12, 64, 665, 9, 64, 1,
-		65, 1, 65, 1, 65, 5, 65, 670, 8, 65, 10, 65, 12, 65, 673, 9, 65, 1, 66,
-		1, 66, 1, 66, 5, 66, 678, 8, 66, 10, 66, 12, 66, 681, 9, 66, 1, 67, 1,
-		67, 1, 67, 5, 67, 686, 8, 67, 10, 67, 12, 67, 689, 9, 67, 1, 68, 1, 68,
-		1, 68, 3, 68, 694, 8, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 3, 69, 701,
-		8, 69, 1, 70, 1, 70, 1, 70, 5, 70, 706, 8, 70, 10, 70, 12, 70, 709, 9,
-		70, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 3, 71, 717, 8, 71, 1, 71,
-		1, 71, 3, 71, 721, 8, 71, 1, 71, 3, 71, 724, 8, 71, 1, 72, 1, 72, 3, 72,
-		728, 8, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1,
-		73, 1, 73, 3, 73, 740, 8, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75,
-		1, 75, 1, 75, 1, 75, 3, 75, 751, 8, 75, 1, 76, 3, 76, 754, 8, 76, 1, 76,
-		1, 76, 1, 76, 3, 76, 759, 8, 76, 1, 77, 1, 77, 3, 77, 763, 8, 77, 1, 77,
-		1, 77, 1, 78, 1, 78, 1, 78, 5, 78, 770, 8, 78, 10, 78, 12, 78, 773, 9,
-		78, 1, 78, 3, 78, 776, 8, 78, 1, 78, 0, 0, 79, 0, 2, 4, 6, 8, 10, 12, 14,
-		16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50,
-		52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86,
-		88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118,
-		120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148,
-		150, 152, 154, 156, 0, 10, 2, 0, 14, 14, 65, 65, 2, 0, 27, 27, 30, 30,
-		1, 0, 32, 36, 1, 0, 15, 22, 1, 0, 23, 24, 1, 0, 43, 44, 1, 0, 45, 48, 1,
-		0, 37, 38, 1, 0, 39, 41, 1, 0, 61, 62, 822, 0, 161, 1, 0, 0, 0, 2, 173,
-		1, 0, 0, 0, 4, 186, 1, 0, 0, 0, 6, 188, 1, 0, 0, 0, 8, 193, 1, 0, 0, 0,
-		10, 201, 1, 0, 0, 0, 12, 214, 1, 0, 0, 0, 14, 219, 1, 0, 0, 0, 16, 221,
-		1, 0, 0, 0, 18, 236, 1, 0, 0, 0, 20, 247, 1, 0, 0, 0, 22, 257, 1, 0, 0,
-		0, 24, 259, 1, 0, 0, 0, 26, 273, 1, 0, 0, 0, 28, 276, 1, 0, 0, 0, 30, 282,
-		1, 0, 0, 0, 32, 293, 1, 0, 0, 0, 34, 299, 1, 0, 0, 0, 36, 325, 1, 0, 0,
-		0, 38, 327, 1, 0, 0, 0, 40, 333, 1, 0, 0, 0, 42, 354, 1, 0, 0, 0, 44, 358,
-		1, 0, 0, 0, 46, 368, 1, 0, 0, 0, 48, 372, 1, 0, 0, 0, 50, 383, 1, 0, 0,
-		0, 52, 385, 1, 0, 0, 0, 54, 399, 1, 0, 0, 0, 56, 419, 1, 0, 0, 0, 58, 421,
-		1, 0, 0, 0, 60, 428, 1, 0, 0, 0, 62, 439, 1, 0, 0, 0, 64, 451, 1, 0, 0,
-		0, 66, 453, 1, 0, 0, 0, 68, 464, 1, 0, 0, 0, 70, 468, 1, 0, 0, 0, 72, 479,
-		1, 0, 0, 0, 74, 485, 1, 0, 0, 0, 76, 496, 1, 0, 0, 0, 78, 513, 1, 0, 0,
-		0, 80, 517, 1, 0, 0, 0, 82, 527, 1, 0, 0, 0, 84, 537, 1, 0, 0, 0, 86, 556,
-		1, 0, 0, 0, 88, 558, 1, 0, 0, 0, 90, 560, 1, 0, 0, 0, 92, 572, 1, 0, 0,
-		0, 94, 577, 1, 0, 0, 0, 96, 580, 1, 0, 0, 0, 98, 594, 1, 0, 0, 0, 100,
-		596, 1, 0, 0, 0, 102, 598, 1, 0, 0, 0, 104, 600, 1, 0, 0, 0, 106, 610,
-		1, 0, 0, 0, 108, 612, 1, 0, 0, 0, 110, 616, 1, 0, 0, 0, 112, 620, 1, 0,
-		0, 0, 114, 622, 1, 0, 0, 0, 116, 624, 1, 0, 0, 0, 118, 633, 1, 0, 0, 0,
-		120, 635, 1, 0, 0, 0, 122, 640, 1, 0, 0, 0, 124, 642, 1, 0, 0, 0, 126,
-		650, 1, 0, 0, 0, 128, 658, 1, 0, 0, 0, 130, 666, 1, 0, 0, 0, 132, 674,
-		1, 0, 0, 0, 134, 682, 1, 0, 0, 0, 136, 690, 1, 0, 0, 0, 138, 700, 1, 0,
-		0, 0, 140, 702, 1, 0, 0, 0, 142, 723, 1, 0, 0, 0, 144, 725, 1, 0, 0, 0,
-		146, 739, 1, 0, 0, 0, 148, 741, 1, 0, 0, 0, 150, 750, 1, 0, 0, 0, 152,
-		753, 1, 0, 0, 0, 154, 760, 1, 0, 0, 0, 156, 766, 1, 0, 0, 0, 158, 160,
-		3, 2, 1, 0, 159, 158, 1, 0, 0, 0, 160, 163, 1, 0, 0, 0, 161, 159, 1, 0,
-		0, 0, 161, 162, 1, 0, 0, 0, 162, 164, 1, 0, 0, 0, 163, 161, 1, 0, 0, 0,
-		164, 165, 5, 0, 0, 1, 165, 1, 1, 0, 0, 0, 166, 174, 3, 4, 2, 0, 167, 174,
-		3, 12, 6, 0, 168, 174, 3, 16, 8, 0, 169, 174, 3, 48, 24, 0, 170, 174, 3,
-		34, 17, 0, 171, 174, 3, 38, 19, 0, 172, 174, 3, 46, 23, 0, 173, 166, 1,
-		0, 0, 0, 173, 167, 1, 0, 0, 0, 173, 168, 1, 0, 0, 0, 173, 169, 1, 0, 0,
-		0, 173, 170, 1, 0, 0, 0, 173, 171, 1, 0, 0, 0, 173, 172, 1, 0, 0, 0, 174,
-		3, 1, 0, 0, 0, 175, 176, 5, 8, 0, 0, 176, 187, 3, 6, 3, 0, 177, 178, 5,
-		8, 0, 0, 178, 182, 5, 52, 0, 0, 179, 181, 3, 6, 3, 0, 180, 179, 1, 0, 0,
-		0, 181, 184, 1, 0, 0, 0, 182, 180, 1, 0, 0, 0, 182, 183, 1, 0, 0, 0, 183,
-		185, 1, 0, 0, 0, 184, 182, 1, 0, 0, 0, 185, 187, 5, 53, 0, 0, 186, 175,
-		1, 0, 0, 0, 186, 177, 1, 0, 0, 0, 187, 5, 1, 0, 0, 0, 188, 191, 3, 8, 4,
-		0, 189, 190, 5, 9, 0, 0, 190, 192, 5, 65, 0, 0, 191, 189, 1, 0, 0, 0, 191,
-		192, 1, 0, 0, 0, 192, 7, 1, 0, 0, 0, 193, 198, 3, 10, 5, 0, 194, 195, 5,
-		60, 0, 0, 195, 197, 5, 65, 0, 0, 196, 194, 1, 0, 0, 0, 197, 200, 1, 0,
-		0, 0, 198, 196, 1, 0, 0, 0, 198, 199, 1, 0, 0, 0, 199, 9, 1, 0, 0, 0, 200,
-		198, 1, 0, 0, 0, 201, 202, 7, 0, 0, 0, 202, 11, 1, 0, 0, 0, 203, 204, 5,
-		14, 0, 0, 204, 215, 5, 61, 0, 0, 205, 206, 5, 14, 0, 0, 206, 210, 5, 52,
-		0, 0, 207, 209, 3, 14, 7, 0, 208, 207, 1, 0, 0, 0, 209, 212, 1, 0, 0, 0,
-		210, 208, 1, 0, 0, 0, 210, 211, 1, 0, 0, 0, 211, 213, 1, 0, 0, 0, 212,
-		210, 1, 0, 0, 0, 213, 215, 5, 53, 0, 0, 214, 203, 1, 0, 0, 0, 214, 205,
-		1, 0, 0, 0, 215, 13, 1, 0, 0, 0, 216, 220, 5, 61, 0, 0, 217, 218, 5, 65,
-		0, 0, 218, 220, 5, 61, 0, 0, 219, 216, 1, 0, 0, 0, 219, 217, 1, 0, 0, 0,
-		220, 15, 1, 0, 0, 0, 221, 222, 5, 1, 0, 0, 222, 224, 5, 65, 0, 0, 223,
-		225, 3, 28, 14, 0, 224, 223, 1, 0, 0, 0, 224, 225, 1, 0, 0, 0, 225, 226,
-		1, 0, 0, 0, 226, 228, 5, 52, 0, 0, 227, 229, 3, 18, 9, 0, 228, 227, 1,
-		0, 0, 0, 228, 229, 1, 0, 0, 0, 229, 230, 1, 0, 0, 0, 230, 232, 5, 53, 0,
-		0, 231, 233, 3, 22, 11, 0, 232, 231, 1, 0, 0, 0, 232, 233, 1, 0, 0, 0,
-		233, 234, 1, 0, 0, 0, 234, 235, 3, 76, 38, 0, 235, 17, 1, 0, 0, 0, 236,
-		241, 3, 20, 10, 0, 237, 238, 5, 58, 0, 0, 238, 240, 3, 20, 10, 0, 239,
-		237, 1, 0, 0, 0, 240, 243, 1, 0, 0, 0, 241, 239, 1, 0, 0, 0, 241, 242,
-		1, 0, 0, 0, 242, 245, 1, 0, 0, 0, 243, 241, 1, 0, 0, 0, 244, 246, 5, 58,
-		0, 0, 245, 244, 1, 0, 0, 0, 245, 246, 1, 0, 0, 0, 246, 19, 1, 0, 0, 0,
-		247, 248, 5, 65, 0, 0, 248, 251, 3, 106, 53, 0, 249, 250, 5, 31, 0, 0,
-		250, 252, 3, 150, 75, 0, 251, 249, 1, 0, 0, 0, 251, 252, 1, 0, 0, 0, 252,
-		21, 1, 0, 0, 0, 253, 258, 3, 106, 53, 0, 254, 255, 5, 65, 0, 0, 255, 258,
-		3, 106, 53, 0, 256, 258, 3, 24, 12, 0, 257, 253, 1, 0, 0, 0, 257, 254,
-		1, 0, 0, 0, 257, 256, 1, 0, 0, 0, 258, 23, 1, 0, 0, 0, 259, 260, 5, 52,
-		0, 0, 260, 265, 3, 26, 13, 0, 261, 262, 5, 58, 0, 0, 262, 264, 3, 26, 13,
-		0, 263, 261, 1, 0, 0, 0, 264, 267, 1, 0, 0, 0, 265, 263, 1, 0, 0, 0, 265,
-		266, 1, 0, 0, 0, 266, 269, 1, 0, 0, 0, 267, 265, 1, 0, 0, 0, 268, 270,
-		5, 58, 0, 0, 269, 268, 1, 0, 0, 0, 269, 270, 1, 0, 0, 0, 270, 271, 1, 0,
-		0, 0, 271, 272, 5, 53, 0, 0, 272, 25, 1, 0, 0, 0, 273, 274, 5, 65, 0, 0,
-		274, 275, 3, 106, 53, 0, 275, 27, 1, 0, 0, 0, 276, 278, 5, 54, 0, 0, 277,
-		279, 3, 30, 15, 0, 278, 277, 1, 0, 0, 0, 278, 279, 1, 0, 0, 0, 279, 280,
-		1, 0, 0, 0, 280, 281, 5, 55, 0, 0, 281, 29, 1, 0, 0, 0, 282, 287, 3, 32,
-		16, 0, 283, 284, 5, 58, 0, 0, 284, 286, 3, 32, 16, 0, 285, 283, 1, 0, 0,
-		0, 286, 289, 1, 0, 0, 0, 287, 285, 1, 0, 0, 0, 287, 288, 1, 0, 0, 0, 288,
-		291, 1, 0, 0, 0, 289, 287, 1, 0, 0, 0, 290, 292, 5, 58, 0, 0, 291, 290,
-		1, 0, 0, 0, 291, 292, 1, 0, 0, 0, 292, 31, 1, 0, 0, 0, 293, 294, 5, 65,
-		0, 0, 294, 297, 3, 106, 53, 0, 295, 296, 5, 31, 0, 0, 296, 298, 3, 150,
-		75, 0, 297, 295, 1, 0, 0, 0, 297, 298, 1, 0, 0, 0, 298, 33, 1, 0, 0, 0,
-		299, 301, 5, 10, 0, 0, 300, 302, 5, 65, 0, 0, 301, 300, 1, 0, 0, 0, 301,
-		302, 1, 0, 0, 0, 302, 303, 1, 0, 0, 0, 303, 317, 5, 54, 0, 0, 304, 311,
-		3, 36, 18, 0, 305, 307, 5, 58, 0, 0, 306, 305, 1, 0, 0, 0, 306, 307, 1,
-		0, 0, 0, 307, 308, 1, 0, 0, 0, 308, 310, 3, 36, 18, 0, 309, 306, 1, 0,
-		0, 0, 310, 313, 1, 0, 0, 0, 311, 309, 1, 0, 0, 0, 311, 312, 1, 0, 0, 0,
-		312, 315, 1, 0, 0, 0, 313, 311, 1, 0, 0, 0, 314, 316, 5, 58, 0, 0, 315,
-		314, 1, 0, 0, 0, 315, 316, 1, 0, 0, 0, 316, 318, 1, 0, 0, 0, 317, 304,
-		1, 0, 0, 0, 317, 318, 1, 0, 0, 0, 318, 319, 1, 0, 0, 0, 319, 320, 5, 55,
-		0, 0, 320, 35, 1, 0, 0, 0, 321, 326, 3, 38, 19, 0, 322, 326, 3, 34, 17,
-		0, 323, 326, 3, 48, 24, 0, 324, 326, 3, 44, 22, 0, 325, 321, 1, 0, 0, 0,
-		325, 322, 1, 0, 0, 0, 325, 323, 1, 0, 0, 0, 325, 324, 1, 0, 0, 0, 326,
-		37, 1, 0, 0, 0, 327, 329, 5, 11, 0, 0, 328, 330, 5, 65, 0, 0, 329, 328,
-		1, 0, 0, 0, 329, 330, 1, 0, 0, 0, 330, 331, 1, 0, 0, 0, 331, 332, 3, 40,
-		20, 0, 332, 39, 1, 0, 0, 0, 333, 347, 5, 54, 0, 0, 334, 341, 3, 42, 21,
-		0, 335, 337, 5, 58, 0, 0, 336, 335, 1, 0, 0, 0, 336, 337, 1, 0, 0, 0, 337,
-		338, 1, 0, 0, 0, 338, 340, 3, 42, 21, 0, 339, 336, 1, 0, 0, 0, 340, 343,
-		1, 0, 0, 0, 341, 339, 1, 0, 0, 0, 341, 342, 1, 0, 0, 0, 342, 345, 1, 0,
-		0, 0, 343, 341, 1, 0, 0, 0, 344, 346, 5, 58, 0, 0, 345, 344, 1, 0, 0, 0,
-		345, 346, 1, 0, 0, 0, 346, 348, 1, 0, 0, 0, 347, 334, 1, 0, 0, 0, 347,
-		348, 1, 0, 0, 0, 348, 349, 1, 0, 0, 0, 349, 350, 5, 55, 0, 0, 350, 41,
-		1, 0, 0, 0, 351, 355, 3, 48, 24, 0, 352, 355, 3, 44, 22, 0, 353, 355, 3,
-		34, 17, 0, 354, 351, 1, 0, 0, 0, 354, 352, 1, 0, 0, 0, 354, 353, 1, 0,
-		0, 0, 355, 43, 1, 0, 0, 0, 356, 359, 3, 60, 30, 0, 357, 359, 3, 122, 61,
-		0, 358, 356, 1, 0, 0, 0, 358, 357, 1, 0, 0, 0, 359, 45, 1, 0, 0, 0, 360,
-		361, 5, 65, 0, 0, 361, 362, 5, 28, 0, 0, 362, 369, 3, 150, 75, 0, 363,
-		364, 5, 65, 0, 0, 364, 365, 3, 106, 53, 0, 365, 366, 5, 28, 0, 0, 366,
-		367, 3, 150, 75, 0, 367, 369, 1, 0, 0, 0, 368, 360, 1, 0, 0, 0, 368, 363,
-		1, 0, 0, 0, 369, 47, 1, 0, 0, 0, 370, 373, 3, 52, 26, 0, 371, 373, 3, 56,
-		28, 0, 372, 370, 1, 0, 0, 0, 372, 371, 1, 0, 0, 0, 373, 379, 1, 0, 0, 0,
-		374, 377, 3, 50, 25, 0, 375, 378, 3, 52, 26, 0, 376, 378, 3, 56, 28, 0,
-		377, 375, 1, 0, 0, 0, 377, 376, 1, 0, 0, 0, 378, 380, 1, 0, 0, 0, 379,
-		374, 1, 0, 0, 0, 380, 381, 1, 0, 0, 0, 381, 379, 1, 0, 0, 0, 381, 382,
-		1, 0, 0, 0, 382, 49, 1, 0, 0, 0, 383, 384, 7, 1, 0, 0, 384, 51, 1, 0, 0,
-		0, 385, 386, 5, 54, 0, 0, 386, 391, 3, 54, 27, 0, 387, 388, 5, 58, 0, 0,
-		388, 390, 3, 54, 27, 0, 389, 387, 1, 0, 0, 0, 390, 393, 1, 0, 0, 0, 391,
-		389, 1, 0, 0, 0, 391, 392, 1, 0, 0, 0, 392, 395, 1, 0, 0, 0, 393, 391,
-		1, 0, 0, 0, 394, 396, 5, 58, 0, 0, 395, 394, 1, 0, 0, 0, 395, 396, 1, 0,
-		0, 0, 396, 397, 1, 0, 0, 0, 397, 398, 5, 55, 0, 0, 398, 53, 1, 0, 0, 0,
-		399, 400, 5, 65, 0, 0, 400, 401, 5, 59, 0, 0, 401, 406, 3, 56, 28, 0, 402,
-		403, 5, 27, 0, 0, 403, 405, 3, 56, 28, 0, 404, 402, 1, 0, 0, 0, 405, 408,
-		1, 0, 0, 0, 406, 404, 1, 0, 0, 0, 406, 407, 1, 0, 0, 0, 407, 411, 1, 0,
-		0, 0, 408, 406, 1, 0, 0, 0, 409, 410, 5, 59, 0, 0, 410, 412, 5, 65, 0,
-		0, 411, 409, 1, 0, 0, 0, 411, 412, 1, 0, 0, 0, 412, 55, 1, 0, 0, 0, 413,
-		420, 3, 58, 29, 0, 414, 420, 3, 60, 30, 0, 415, 420, 3, 122, 61, 0, 416,
-		420, 3, 38, 19, 0, 417, 420, 3, 34, 17, 0, 418, 420, 5, 12, 0, 0, 419,
-		413, 1, 0, 0, 0, 419, 414, 1, 0, 0, 0, 419, 415, 1, 0, 0, 0, 419, 416,
-		1, 0, 0, 0, 419, 417, 1, 0, 0, 0, 419, 418, 1, 0, 0, 0, 420, 57, 1, 0,
-		0, 0, 421, 422, 5, 65, 0, 0, 422, 59, 1, 0, 0, 0, 423, 424, 3, 62, 31,
-		0, 424, 425, 3, 64, 32, 0, 425, 429, 1, 0, 0, 0, 426, 427, 5, 65, 0, 0,
-		427, 429, 3, 64, 32, 0, 428, 423, 1, 0, 0, 0, 428, 426, 1, 0, 0, 0, 429,
-		61, 1, 0, 0, 0, 430, 431, 5, 65, 0, 0, 431, 432, 5, 60, 0, 0, 432, 440,
-		5, 65, 0, 0, 433, 434, 5, 65, 0, 0, 434, 435, 5, 60, 0, 0, 435, 440, 5,
-		5, 0, 0, 436, 437, 5, 14, 0, 0, 437, 438, 5, 60, 0, 0, 438, 440, 5, 65,
-		0, 0, 439, 430, 1, 0, 0, 0, 439, 433, 1, 0, 0, 0, 439, 436, 1, 0, 0, 0,
-		440, 63, 1, 0, 0, 0, 441, 442, 5, 54, 0, 0, 442, 452, 5, 55, 0, 0, 443,
-		444, 5, 54, 0, 0, 444, 445, 3, 66, 33, 0, 445, 446, 5, 55, 0, 0, 446, 452,
-		1, 0, 0, 0, 447, 448, 5, 54, 0, 0, 448, 449, 3, 70, 35, 0, 449, 450, 5,
-		55, 0, 0, 450, 452, 1, 0, 0, 0, 451, 441, 1, 0, 0, 0, 451, 443, 1, 0, 0,
-		0, 451, 447, 1, 0, 0, 0, 452, 65, 1, 0, 0, 0, 453, 458, 3, 68, 34, 0, 454,
-		455, 5, 58, 0, 0, 455, 457, 3, 68, 34, 0, 456, 454, 1, 0, 0, 0, 457, 460,
-		1, 0, 0, 0, 458, 456, 1, 0, 0, 0, 458, 459, 1, 0, 0, 0, 459, 462, 1, 0,
-		0, 0, 460, 458, 1, 0, 0, 0, 461, 463, 5, 58, 0, 0, 462, 461, 1, 0, 0, 0,
-		462, 463, 1, 0, 0, 0, 463, 67, 1, 0, 0, 0, 464, 465, 5, 65, 0, 0, 465,
-		466, 5, 31, 0, 0, 466, 467, 3, 122, 61, 0, 467, 69, 1, 0, 0, 0, 468, 473,
-		3, 122, 61, 0, 469, 470, 5, 58, 0, 0, 470, 472, 3, 122, 61, 0, 471, 469,
-		1, 0, 0, 0, 472, 475, 1, 0, 0, 0, 473, 471, 1, 0, 0, 0, 473, 474, 1, 0,
-		0, 0, 474, 477, 1, 0, 0, 0, 475, 473, 1, 0, 0, 0, 476, 478, 5, 58, 0, 0,
-		477, 476, 1, 0, 0, 0, 477, 478, 1, 0, 0, 0, 478, 71, 1, 0, 0, 0, 479, 481,
-		5, 52, 0, 0, 480, 482, 3, 74, 37, 0, 481, 480, 1, 0, 0, 0, 481, 482, 1,
-		0, 0, 0, 482, 483, 1, 0, 0, 0, 483, 484, 5, 53, 0, 0, 484, 73, 1, 0, 0,
-		0, 485, 490, 3, 122, 61, 0, 486, 487, 5, 58, 0, 0, 487, 489, 3, 122, 61,
-		0, 488, 486, 1, 0, 0, 0, 489, 492, 1, 0, 0, 0, 490, 488, 1, 0, 0, 0, 490,
-		491, 1, 0, 0, 0, 491, 494, 1, 0, 0, 0, 492, 490, 1, 0, 0, 0, 493, 495,
-		5, 58, 0, 0, 494, 493, 1, 0, 0, 0, 494, 495, 1, 0, 0, 0, 495, 75, 1, 0,
-		0, 0, 496, 500, 5, 54, 0, 0, 497, 499, 3, 78, 39, 0, 498, 497, 1, 0, 0,
-		0, 499, 502, 1, 0, 0, 0, 500, 498, 1, 0, 0, 0, 500, 501, 1, 0, 0, 0, 501,
-		503, 1, 0, 0, 0, 502, 500, 1, 0, 0, 0, 503, 504, 5, 55, 0, 0, 504, 77,
-		1, 0, 0, 0, 505, 514, 3, 80, 40, 0, 506, 514, 3, 86, 43, 0, 507, 514, 3,
-		90, 45, 0, 508, 514, 3, 96, 48, 0, 509, 514, 3, 100, 50, 0, 510, 514, 3,
-		102, 51, 0, 511, 514, 3, 104, 52, 0, 512, 514, 3, 122, 61, 0, 513, 505,
-		1, 0, 0, 0, 513, 506, 1, 0, 0, 0, 513, 507, 1, 0, 0, 0, 513, 508, 1, 0,
-		0, 0, 513, 509, 1, 0, 0, 0, 513, 510, 1, 0, 0, 0, 513, 511, 1, 0, 0, 0,
-		513, 512, 1, 0, 0, 0, 514, 79, 1, 0, 0, 0, 515, 518, 3, 82, 41, 0, 516,
-		518, 3, 84, 42, 0, 517, 515, 1, 0, 0, 0, 517, 516, 1, 0, 0, 0, 518, 81,
-		1, 0, 0, 0, 519, 520, 5, 65, 0, 0, 520, 521, 5, 28, 0, 0, 521, 528, 3,
-		122, 61, 0, 522, 523, 5, 65, 0, 0, 523, 524, 3, 106, 53, 0, 524, 525, 5,
-		28, 0, 0, 525, 526, 3, 122, 61, 0, 526, 528, 1, 0, 0, 0, 527, 519, 1, 0,
-		0, 0, 527, 522, 1, 0, 0, 0, 528, 83, 1, 0, 0, 0, 529, 530, 5, 65, 0, 0,
-		530, 531, 5, 29, 0, 0, 531, 538, 3, 122, 61, 0, 532, 533, 5, 65, 0, 0,
-		533, 534, 3, 106, 53, 0, 534, 535, 5, 29, 0, 0, 535, 536, 3, 122, 61, 0,
-		536, 538, 1, 0, 0, 0, 537, 529, 1, 0, 0, 0, 537, 532, 1, 0, 0, 0, 538,
-		85, 1, 0, 0, 0, 539, 540, 5, 65, 0, 0, 540, 541, 5, 31, 0, 0, 541, 557,
-		3, 122, 61, 0, 542, 543, 5, 65, 0, 0, 543, 544, 3, 142, 71, 0, 544, 545,
-		5, 31, 0, 0, 545, 546, 3, 122, 61, 0, 546, 557, 1, 0, 0, 0, 547, 548, 5,
-		65, 0, 0, 548, 549, 3, 88, 44, 0, 549, 550, 3, 122, 61, 0, 550, 557, 1,
-		0, 0, 0, 551, 552, 5, 65, 0, 0, 552, 553, 3, 142, 71, 0, 553, 554, 3, 88,
-		44, 0, 554, 555, 3, 122, 61, 0, 555, 557, 1, 0, 0, 0, 556, 539, 1, 0, 0,
-		0, 556, 542, 1, 0, 0, 0, 556, 547, 1, 0, 0, 0, 556, 551, 1, 0, 0, 0, 557,
-		87, 1, 0, 0, 0, 558, 559, 7, 2, 0, 0, 559, 89, 1, 0, 0, 0, 560, 561, 5,
-		2, 0, 0, 561, 562, 3, 122, 61, 0, 562, 566, 3, 76, 38, 0, 563, 565, 3,
-		92, 46, 0, 564, 563, 1, 0, 0, 0, 565, 568, 1, 0, 0, 0, 566, 564, 1, 0,
-		0, 0, 566, 567, 1, 0, 0, 0, 567, 570, 1, 0, 0, 0, 568, 566, 1, 0, 0, 0,
-		569, 571, 3, 94, 47, 0, 570, 569, 1, 0, 0, 0, 570, 571, 1, 0, 0, 0, 571,
-		91, 1, 0, 0, 0, 572, 573, 5, 3, 0, 0, 573, 574, 5, 2, 0, 0, 574, 575, 3,
-		122, 61, 0, 575, 576, 3, 76, 38, 0, 576, 93, 1, 0, 0, 0, 577, 578, 5, 3,
-		0, 0, 578, 579, 3, 76, 38, 0, 579, 95, 1, 0, 0, 0, 580, 581, 5, 5, 0, 0,
-		581, 582, 3, 98, 49, 0, 582, 583, 3, 76, 38, 0, 583, 97, 1, 0, 0, 0, 584,
-		585, 5, 65, 0, 0, 585, 586, 5, 58, 0, 0, 586, 587, 5, 65, 0, 0, 587, 588,
-		5, 28, 0, 0, 588, 595, 3, 122, 61, 0, 589, 590, 5, 65, 0, 0, 590, 591,
-		5, 28, 0, 0, 591, 595, 3, 122, 61, 0, 592, 595, 3, 122, 61, 0, 593, 595,
-		1, 0, 0, 0, 594, 584, 1, 0, 0, 0, 594, 589, 1, 0, 0, 0, 594, 592, 1, 0,
-		0, 0, 594, 593, 1, 0, 0, 0, 595, 99, 1, 0, 0, 0, 596, 597, 5, 6, 0, 0,
-		597, 101, 1, 0, 0, 0, 598, 599, 5, 7, 0, 0, 599, 103, 1, 0, 0, 0, 600,
-		602, 5, 4, 0, 0, 601, 603, 3, 122, 61, 0, 602, 601, 1, 0, 0, 0, 602, 603,
-		1, 0, 0, 0, 603, 105, 1, 0, 0, 0, 604, 606, 3, 110, 55, 0, 605, 607, 3,
-		108, 54, 0, 606, 605, 1, 0, 0, 0, 606, 607, 1, 0, 0, 0, 607, 611, 1, 0,
-		0, 0, 608, 611, 3, 118, 59, 0, 609, 611, 3, 120, 60, 0, 610, 604, 1, 0,
-		0, 0, 610, 608, 1, 0, 0, 0, 610, 609, 1, 0, 0, 0, 611, 107, 1, 0, 0, 0,
-		612, 613, 5, 65, 0, 0, 613, 109, 1, 0, 0, 0, 614, 617, 3, 112, 56, 0, 615,
-		617, 5, 25, 0, 0, 616, 614, 1, 0, 0, 0, 616, 615, 1, 0, 0, 0, 617, 111,
-		1, 0, 0, 0, 618, 621, 3, 114, 57, 0, 619, 621, 3, 116, 58, 0, 620, 618,
-		1, 0, 0, 0, 620, 619, 1, 0, 0, 0, 621, 113, 1, 0, 0, 0, 622, 623, 7, 3,
-		0, 0, 623, 115, 1, 0, 0, 0, 624, 625, 7, 4, 0, 0, 625, 117, 1, 0, 0, 0,
-		626, 627, 5, 13, 0, 0, 627, 629, 3, 110, 55, 0, 628, 630, 3, 108, 54, 0,
-		629, 628, 1, 0, 0, 0, 629, 630, 1, 0, 0, 0, 630, 634, 1, 0, 0, 0, 631,
-		632, 5, 13, 0, 0, 632, 634, 3, 120, 60, 0, 633, 626, 1, 0, 0, 0, 633, 631,
-		1, 0, 0, 0, 634, 119, 1, 0, 0, 0, 635, 636, 5, 26, 0, 0, 636, 638, 3, 110,
-		55, 0, 637, 639, 3, 108, 54, 0, 638, 637, 1, 0, 0, 0, 638, 639, 1, 0, 0,
-		0, 639, 121, 1, 0, 0, 0, 640, 641, 3, 124, 62, 0, 641, 123, 1, 0, 0, 0,
-		642, 647, 3, 126, 63, 0, 643, 644, 5, 50, 0, 0, 644, 646, 3, 126, 63, 0,
-		645, 643, 1, 0, 0, 0, 646, 649, 1, 0, 0, 0, 647, 645, 1, 0, 0, 0, 647,
-		648, 1, 0, 0, 0, 648, 125, 1, 0, 0, 0, 649, 647, 1, 0, 0, 0, 650, 655,
-		3, 128, 64, 0, 651, 652, 5, 49, 0, 0, 652, 654, 3, 128, 64, 0, 653, 651,
-		1, 0, 0, 0, 654, 657, 1, 0, 0, 0, 655, 653, 1, 0, 0, 0, 655, 656, 1, 0,
-		0, 0, 656, 127, 1, 0, 0, 0, 657, 655, 1, 0, 0, 0, 658, 663, 3, 130, 65,
-		0, 659, 660, 7, 5, 0, 0, 660, 662, 3, 130, 65, 0, 661, 659, 1, 0, 0, 0,
-		662, 665, 1, 0, 0, 0, 663, 661, 1, 0, 0, 0, 663, 664, 1, 0, 0, 0, 664,
-		129, 1, 0, 0, 0, 665, 663, 1, 0, 0, 0, 666, 671, 3, 132, 66, 0, 667, 668,
-		7, 6, 0, 0, 668, 670, 3, 132, 66, 0, 669, 667, 1, 0, 0, 0, 670, 673, 1,
-		0, 0, 0, 671, 669, 1, 0, 0, 0, 671, 672, 1, 0, 0, 0, 672, 131, 1, 0, 0,
-		0, 673, 671, 1, 0, 0, 0, 674, 679, 3, 134, 67, 0, 675, 676, 7, 7, 0, 0,
-		676, 678, 3, 134, 67, 0, 677, 675, 1, 0, 0, 0, 678, 681, 1, 0, 0, 0, 679,
-		677, 1, 0, 0, 0, 679, 680, 1, 0, 0, 0, 680, 133, 1, 0, 0, 0, 681, 679,
-		1, 0, 0, 0, 682, 687, 3, 136, 68, 0, 683, 684, 7, 8, 0, 0, 684, 686, 3,
-		136, 68, 0, 685, 683, 1, 0, 0, 0, 686, 689, 1, 0, 0, 0, 687, 685, 1, 0,
-		0, 0, 687, 688, 1, 0, 0, 0, 688, 135, 1, 0, 0, 0, 689, 687, 1, 0, 0, 0,
-		690, 693, 3, 138, 69, 0, 691, 692, 5, 42, 0, 0, 692, 694, 3, 136, 68, 0,
-		693, 691, 1, 0, 0, 0, 693, 694, 1, 0, 0, 0, 694, 137, 1, 0, 0, 0, 695,
-		696, 5, 38, 0, 0, 696, 701, 3, 138, 69, 0, 697, 698, 5, 51, 0, 0, 698,
-		701, 3, 138, 69, 0, 699, 701, 3, 140, 70, 0, 700, 695, 1, 0, 0, 0, 700,
-		697, 1, 0, 0, 0, 700, 699, 1, 0, 0, 0, 701, 139, 1, 0, 0, 0, 702, 707,
-		3, 146, 73, 0, 703, 706, 3, 142, 71, 0, 704, 706, 3, 144, 72, 0, 705, 703,
-		1, 0, 0, 0, 705, 704, 1, 0, 0, 0, 706, 709, 1, 0, 0, 0, 707, 705, 1, 0,
-		0, 0, 707, 708, 1, 0, 0, 0, 708, 141, 1, 0, 0, 0, 709, 707, 1, 0, 0, 0,
-		710, 711, 5, 56, 0, 0, 711, 712, 3, 122, 61, 0, 712, 713, 5, 57, 0, 0,
-		713, 724, 1, 0, 0, 0, 714, 716, 5, 56, 0, 0, 715, 717, 3, 122, 61, 0, 716,
-		715, 1, 0, 0, 0, 716, 717, 1, 0, 0, 0, 717, 718, 1, 0, 0, 0, 718, 720,
-		5, 59, 0, 0, 719, 721, 3, 122, 61, 0, 720, 719, 1, 0, 0, 0, 720, 721, 1,
-		0, 0, 0, 721, 722, 1, 0, 0, 0, 722, 724, 5, 57, 0, 0, 723, 710, 1, 0, 0,
-		0, 723, 714, 1, 0, 0, 0, 724, 143, 1, 0, 0, 0, 725, 727, 5, 52, 0, 0, 726,
-		728, 3, 74, 37, 0, 727, 726, 1, 0, 0, 0, 727, 728, 1, 0, 0, 0, 728, 729,
-		1, 0, 0, 0, 729, 730, 5, 53, 0, 0, 730, 145, 1, 0, 0, 0, 731, 740, 3, 150,
-		75, 0, 732, 740, 3, 62, 31, 0, 733, 740, 5, 65, 0, 0, 734, 735, 5, 52,
-		0, 0, 735, 736, 3, 122, 61, 0, 736, 737, 5, 53, 0, 0, 737, 740, 1, 0, 0,
-		0, 738, 740, 3, 148, 74, 0, 739, 731, 1, 0, 0, 0, 739, 732, 1, 0, 0, 0,
-		739, 733, 1, 0, 0, 0, 739, 734, 1, 0, 0, 0, 739, 738, 1, 0, 0, 0, 740,
-		147, 1, 0, 0, 0, 741, 742, 3, 106, 53, 0, 742, 743, 5, 52, 0, 0, 743, 744,
-		3, 122, 61, 0, 744, 745, 5, 53, 0, 0, 745, 149, 1, 0, 0, 0, 746, 751, 3,
-		152, 76, 0, 747, 751, 5, 64, 0, 0, 748, 751, 5, 63, 0, 0, 749, 751, 3,
-		154, 77, 0, 750, 746, 1, 0, 0, 0, 750, 747, 1, 0, 0, 0, 750, 748, 1, 0,
-		0, 0, 750, 749, 1, 0, 0, 0, 751, 151, 1, 0, 0, 0, 752, 754, 5, 38, 0, 0,
-		753, 752, 1, 0, 0, 0, 753, 754, 1, 0, 0, 0, 754, 755, 1, 0, 0, 0, 755,
-		758, 7, 9, 0, 0, 756, 757, 4, 76, 0, 0, 757, 759, 5, 65, 0, 0, 758, 756,
-		1, 0, 0, 0, 758, 759, 1, 0, 0, 0, 759, 153, 1, 0, 0, 0, 760, 762, 5, 56,
-		0, 0, 761, 763, 3, 156, 78, 0, 762, 761, 1, 0, 0, 0, 762, 763, 1, 0, 0,
-		0, 763, 764, 1, 0, 0, 0, 764, 765, 5, 57, 0, 0, 765, 155, 1, 0, 0, 0, 766,
-		771, 3, 122, 61, 0, 767, 768, 5, 58, 0, 0, 768, 770, 3, 122, 61, 0, 769,
-		767, 1, 0, 0, 0, 770, 773, 1, 0, 0, 0, 771, 769, 1, 0, 0, 0, 771, 772,
-		1, 0, 0, 0, 772, 775, 1, 0, 0, 0, 773, 771, 1, 0, 0, 0, 774, 776, 5, 58,
-		0, 0, 775, 774, 1, 0, 0, 0, 775, 776, 1, 0, 0, 0, 776, 157, 1, 0, 0, 0,
-		92, 161, 173, 182, 186, 191, 198, 210, 214, 219, 224, 228, 232, 241, 245,
-		251, 257, 265, 269, 278, 287, 291, 297, 301, 306, 311, 315, 317, 325, 329,
-		336, 341, 345, 347, 354, 358, 368, 372, 377, 381, 391, 395, 406, 411, 419,
-		428, 439, 451, 458, 462, 473, 477, 481, 490, 494, 500, 513, 517, 527, 537,
-		556, 566, 570, 594, 602, 606, 610, 616, 620, 629, 633, 638, 647, 655, 663,
-		671, 679, 687, 693, 700, 705, 707, 716, 720, 723, 727, 739, 750, 753, 758,
-		762, 771, 775,
+		2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2,
+		84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89,
+		7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94, 7,
+		94, 1, 0, 5, 0, 192, 8, 0, 10, 0, 12, 0, 195, 9, 0, 1, 0, 1, 0, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 209, 8, 1, 1,
+		2, 1, 2, 1, 2, 1, 2, 1, 2, 5, 2, 216, 8, 2, 10, 2, 12, 2, 219, 9, 2, 1,
+		2, 3, 2, 222, 8, 2, 1, 3, 1, 3, 1, 3, 3, 3, 227, 8, 3, 1, 4, 1, 4, 1, 4,
+		5, 4, 232, 8, 4, 10, 4, 12, 4, 235, 9, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6,
+		1, 6, 1, 6, 5, 6, 244, 8, 6, 10, 6, 12, 6, 247, 9, 6, 1, 6, 3, 6, 250,
+		8, 6, 1, 7, 1, 7, 1, 7, 3, 7, 255, 8, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8,
+		5, 8, 262, 8, 8, 10, 8, 12, 8, 265, 9, 8, 1, 8, 3, 8, 268, 8, 8, 1, 9,
+		1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 3, 10, 276, 8, 10, 1, 10, 1, 10, 3, 10,
+		280, 8, 10, 1, 10, 1, 10, 3, 10, 284, 8, 10, 1, 10, 1, 10, 1, 11, 1, 11,
+		1, 11, 5, 11, 291, 8, 11, 10, 11, 12, 11, 294, 9, 11, 1, 11, 3, 11, 297,
+		8, 11, 1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 303, 8, 12, 1, 13, 1, 13, 1,
+		13, 1, 13, 3, 13, 309, 8, 13, 1, 14, 1, 14, 1, 14, 1, 14, 5, 14, 315, 8,
+		14, 10, 14, 12, 14, 318, 9, 14, 1, 14, 3, 14, 321, 8, 14, 1, 14, 1, 14,
+		1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 3, 16, 330, 8, 16, 1, 16, 1, 16, 1,
+		17, 1, 17, 1, 17, 5, 17, 337, 8, 17, 10, 17, 12, 17, 340, 9, 17, 1, 17,
+		3, 17, 343, 8, 17, 1, 18, 1, 18, 1, 18, 1, 18, 3, 18, 349, 8, 18, 1, 19,
+		1, 19, 3, 19, 353, 8, 19, 1, 19, 1, 19, 1, 19, 3, 19, 358, 8, 19, 1, 19,
+		5, 19, 361, 8, 19, 10, 19, 12, 19, 364, 9, 19, 1, 19, 3, 19, 367, 8, 19,
+		3, 19, 369, 8, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 3, 20, 377,
+		8, 20, 1, 21, 1, 21, 3, 21, 381, 8, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1,
+		22, 3, 22, 388, 8, 22, 1, 22, 5, 22, 391, 8, 22, 10, 22, 12, 22, 394, 9,
+		22, 1, 22, 3, 22, 397, 8, 22, 3, 22, 399, 8, 22, 1, 22, 1, 22, 1, 23, 1,
+		23, 1, 23, 3, 23, 406, 8, 23, 1, 24, 1, 24, 3, 24, 410, 8, 24, 1, 25, 1,
+		25, 1, 25, 1, 25, 3, 25, 416, 8, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26,
+		5, 26, 423, 8, 26, 10, 26, 12, 26, 426, 9, 26, 1, 26, 3, 26, 429, 8, 26,
+		1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 3, 28, 437, 8, 28, 1, 28, 1,
+		28, 3, 28, 441, 8, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 5, 29, 448, 8,
+		29, 10, 29, 12, 29, 451, 9, 29, 1, 29, 3, 29, 454, 8, 29, 1, 30, 1, 30,
+		1, 30, 3, 30, 459, 8, 30, 1, 30, 3, 30, 462, 8, 30, 1, 31, 1, 31, 1, 31,
+		1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 3, 31, 472, 8, 31, 1, 32, 1, 32, 3,
+		32, 476, 8, 32, 1, 32, 1, 32, 1, 32, 3, 32, 481, 8, 32, 4, 32, 483, 8,
+		32, 11, 32, 12, 32, 484, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 5, 34,
+		493, 8, 34, 10, 34, 12, 34, 496, 9, 34, 1, 34, 3, 34, 499, 8, 34, 1, 34,
+		1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 5, 35, 508, 8, 35, 10, 35, 12,
+		35, 511, 9, 35, 1, 35, 1, 35, 3, 35, 515, 8, 35, 1, 36, 1, 36, 3, 36, 519,
+		8, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 3, 37, 527, 8, 37, 1,
+		38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 3, 39, 536, 8, 39, 1, 40,
+		1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 3, 40, 547, 8,
+		40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41,
+		3, 41, 559, 8, 41, 1, 42, 1, 42, 1, 42, 5, 42, 564, 8, 42, 10, 42, 12,
+		42, 567, 9, 42, 1, 42, 3, 42, 570, 8, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1,
+		44, 1, 44, 1, 44, 5, 44, 579, 8, 44, 10, 44, 12, 44, 582, 9, 44, 1, 44,
+		3, 44, 585, 8, 44, 1, 45, 1, 45, 3, 45, 589, 8, 45, 1, 45, 1, 45, 1, 46,
+		1, 46, 1, 46, 5, 46, 596, 8, 46, 10, 46, 12, 46, 599, 9, 46, 1, 46, 3,
+		46, 602, 8, 46, 1, 47, 1, 47, 5, 47, 606, 8, 47, 10, 47, 12, 47, 609, 9,
+		47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48,
+		1, 48, 3, 48, 622, 8, 48, 1, 49, 1, 49, 3, 49, 626, 8, 49, 1, 50, 1, 50,
+		1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 3, 50, 636, 8, 50, 1, 51, 1,
+		51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 3, 51, 646, 8, 51, 1, 52,
+		1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
+		52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 4, 52, 667, 8, 52,
+		11, 52, 12, 52, 668, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 4, 52, 676, 8,
+		52, 11, 52, 12, 52, 677, 1, 52, 1, 52, 1, 52, 3, 52, 683, 8, 52, 1, 53,
+		1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 5, 54, 691, 8, 54, 10, 54, 12, 54, 694,
+		9, 54, 1, 54, 3, 54, 697, 8, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1,
+		56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 5, 57, 711, 8, 57, 10, 57,
+		12, 57, 714, 9, 57, 1, 57, 3, 57, 717, 8, 57, 1, 57, 1, 57, 1, 58, 1, 58,
+		1, 58, 5, 58, 724, 8, 58, 10, 58, 12, 58, 727, 9, 58, 1, 58, 1, 58, 1,
+		58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61,
+		1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 3, 61, 750, 8,
+		61, 1, 62, 1, 62, 1, 63, 1, 63, 1, 64, 1, 64, 3, 64, 758, 8, 64, 1, 65,
+		1, 65, 3, 65, 762, 8, 65, 1, 65, 1, 65, 1, 65, 3, 65, 767, 8, 65, 1, 66,
+		1, 66, 1, 67, 1, 67, 3, 67, 773, 8, 67, 1, 68, 1, 68, 3, 68, 777, 8, 68,
+		1, 69, 1, 69, 1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 3, 71, 786, 8, 71, 1,
+		71, 1, 71, 3, 71, 790, 8, 71, 1, 72, 1, 72, 1, 72, 3, 72, 795, 8, 72, 1,
+		73, 1, 73, 1, 74, 1, 74, 1, 75, 1, 75, 1, 75, 5, 75, 804, 8, 75, 10, 75,
+		12, 75, 807, 9, 75, 1, 76, 1, 76, 1, 76, 5, 76, 812, 8, 76, 10, 76, 12,
+		76, 815, 9, 76, 1, 77, 1, 77, 1, 77, 5, 77, 820, 8, 77, 10, 77, 12, 77,
+		823, 9, 77, 1, 78, 1, 78, 1, 78, 5, 78, 828, 8, 78, 10, 78, 12, 78, 831,
+		9, 78, 1, 79, 1, 79, 1, 79, 5, 79, 836, 8, 79, 10, 79, 12, 79, 839, 9,
+		79, 1, 80, 1, 80, 1, 80, 5, 80, 844, 8, 80, 10, 80, 12, 80, 847, 9, 80,
+		1, 81, 1, 81, 1, 81, 3, 81, 852, 8, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1,
+		82, 3, 82, 859, 8, 82, 1, 83, 1, 83, 1, 83, 1, 83, 5, 83, 865, 8, 83, 10,
+		83, 12, 83, 868, 9, 83, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 3, 84,
+		876, 8, 84, 1, 84, 1, 84, 3, 84, 880, 8, 84, 1, 84, 3, 84, 883, 8, 84,
+		1, 85, 1, 85, 3, 85, 887, 8, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1,
+		87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 3, 87, 903,
+		8, 87, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 89, 1, 89, 1, 89, 1, 89, 1,
+		89, 5, 89, 915, 8, 89, 10, 89, 12, 89, 918, 9, 89, 1, 89, 3, 89, 921, 8,
+		89, 1, 89, 1, 89, 1, 90, 1, 90, 1, 90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 91,
+		3, 91, 933, 8, 91, 1, 92, 3, 92, 936, 8, 92, 1, 92, 1, 92, 1, 92, 3, 92,
+		941, 8, 92, 1, 93, 1, 93, 3, 93, 945, 8, 93, 1, 93, 1, 93, 1, 94, 1, 94,
+		1, 94, 5, 94, 952, 8, 94, 10, 94, 12, 94, 955, 9, 94, 1, 94, 3, 94, 958,
+		8, 94, 1, 94, 0, 0, 95, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24,
+		26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60,
+		62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96,
+		98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126,
+		128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156,
+		158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 186,
+		188, 0, 10, 2, 0, 15, 15, 69, 69, 2, 0, 31, 31, 34, 34, 1, 0, 36, 40, 1,
+		0, 19, 26, 1, 0, 27, 28, 1, 0, 47, 48, 1, 0, 49, 52, 1, 0, 41, 42, 1, 0,
+		43, 45, 1, 0, 65, 66, 1016, 0, 193, 1, 0, 0, 0, 2, 208, 1, 0, 0, 0, 4,
+		221, 1, 0, 0, 0, 6, 223, 1, 0, 0, 0, 8, 228, 1, 0, 0, 0, 10, 236, 1, 0,
+		0, 0, 12, 249, 1, 0, 0, 0, 14, 254, 1, 0, 0, 0, 16, 267, 1, 0, 0, 0, 18,
+		269, 1, 0, 0, 0, 20, 272, 1, 0, 0, 0, 22, 287, 1, 0, 0, 0, 24, 298, 1,
+		0, 0, 0, 26, 308, 1, 0, 0, 0, 28, 310, 1, 0, 0, 0, 30, 324, 1, 0, 0, 0,
+		32, 327, 1, 0, 0, 0, 34, 333, 1, 0, 0, 0, 36, 344, 1, 0, 0, 0, 38, 350,
+		1, 0, 0, 0, 40, 376, 1, 0, 0, 0, 42, 378, 1, 0, 0, 0, 44, 384, 1, 0, 0,
+		0, 46, 405, 1, 0, 0, 0, 48, 409, 1, 0, 0, 0, 50, 411, 1, 0, 0, 0, 52, 419,
+		1, 0, 0, 0, 54, 430, 1, 0, 0, 0, 56, 433, 1, 0, 0, 0, 58, 444, 1, 0, 0,
+		0, 60, 455, 1, 0, 0, 0, 62, 471, 1, 0, 0, 0, 64, 475, 1, 0, 0, 0, 66, 486,
+		1, 0, 0, 0, 68, 488, 1, 0, 0, 0, 70, 502, 1, 0, 0, 0, 72, 518, 1, 0, 0,
+		0, 74, 526, 1, 0, 0, 0, 76, 528, 1, 0, 0, 0, 78, 535, 1, 0, 0, 0, 80, 546,
+		1, 0, 0, 0, 82, 558, 1, 0, 0, 0, 84, 560, 1, 0, 0, 0, 86, 571, 1, 0, 0,
+		0, 88, 575, 1, 0, 0, 0, 90, 586, 1, 0, 0, 0, 92, 592, 1, 0, 0, 0, 94, 603,
+		1, 0, 0, 0, 96, 621, 1, 0, 0, 0, 98, 625, 1, 0, 0, 0, 100, 635, 1, 0, 0,
+		0, 102, 645, 1, 0, 0, 0, 104, 682, 1, 0, 0, 0, 106, 684, 1, 0, 0, 0, 108,
+		686, 1, 0, 0, 0, 110, 698, 1, 0, 0, 0, 112, 703, 1, 0, 0, 0, 114, 706,
+		1, 0, 0, 0, 116, 720, 1, 0, 0, 0, 118, 731, 1, 0, 0, 0, 120, 735, 1, 0,
+		0, 0, 122, 749, 1, 0, 0, 0, 124, 751, 1, 0, 0, 0, 126, 753, 1, 0, 0, 0,
+		128, 755, 1, 0, 0, 0, 130, 766, 1, 0, 0, 0, 132, 768, 1, 0, 0, 0, 134,
+		772, 1, 0, 0, 0, 136, 776, 1, 0, 0, 0, 138, 778, 1, 0, 0, 0, 140, 780,
+		1, 0, 0, 0, 142, 789, 1, 0, 0, 0, 144, 791, 1, 0, 0, 0, 146, 796, 1, 0,
+		0, 0, 148, 798, 1, 0, 0, 0, 150, 800, 1, 0, 0, 0, 152, 808, 1, 0, 0, 0,
+		154, 816, 1, 0, 0, 0, 156, 824, 1, 0, 0, 0, 158, 832, 1, 0, 0, 0, 160,
+		840, 1, 0, 0, 0, 162, 848, 1, 0, 0, 0, 164, 858, 1, 0, 0, 0, 166, 860,
+		1, 0, 0, 0, 168, 882, 1, 0, 0, 0, 170, 884, 1, 0, 0, 0, 172, 890, 1, 0,
+		0, 0, 174, 902, 1, 0, 0, 0, 176, 904, 1, 0, 0, 0, 178, 909, 1, 0, 0, 0,
+		180, 924, 1, 0, 0, 0, 182, 932, 1, 0, 0, 0, 184, 935, 1, 0, 0, 0, 186,
+		942, 1, 0, 0, 0, 188, 948, 1, 0, 0, 0, 190, 192, 3, 2, 1, 0, 191, 190,
+		1, 0, 0, 0, 192, 195, 1, 0, 0, 0, 193, 191, 1, 0, 0, 0, 193, 194, 1, 0,
+		0, 0, 194, 196, 1, 0, 0, 0, 195, 193, 1, 0, 0, 0, 196, 197, 5, 0, 0, 1,
+		197, 1, 1, 0, 0, 0, 198, 209, 3, 4, 2, 0, 199, 209, 3, 12, 6, 0, 200, 209,
+		3, 16, 8, 0, 201, 209, 3, 20, 10, 0, 202, 209, 3, 64, 32, 0, 203, 209,
+		3, 38, 19, 0, 204, 209, 3, 42, 21, 0, 205, 209, 3, 50, 25, 0, 206, 209,
+		3, 56, 28, 0, 207, 209, 3, 62, 31, 0, 208, 198, 1, 0, 0, 0, 208, 199, 1,
+		0, 0, 0, 208, 200, 1, 0, 0, 0, 208, 201, 1, 0, 0, 0, 208, 202, 1, 0, 0,
+		0, 208, 203, 1, 0, 0, 0, 208, 204, 1, 0, 0, 0, 208, 205, 1, 0, 0, 0, 208,
+		206, 1, 0, 0, 0, 208, 207, 1, 0, 0, 0, 209, 3, 1, 0, 0, 0, 210, 211, 5,
+		9, 0, 0, 211, 222, 3, 6, 3, 0, 212, 213, 5, 9, 0, 0, 213, 217, 5, 56, 0,
+		0, 214, 216, 3, 6, 3, 0, 215, 214, 1, 0, 0, 0, 216, 219, 1, 0, 0, 0, 217,
+		215, 1, 0, 0, 0, 217, 218, 1, 0, 0, 0, 218, 220, 1, 0, 0, 0, 219, 217,
+		1, 0, 0, 0, 220, 222, 5, 57, 0, 0, 221, 210, 1, 0, 0, 0, 221, 212, 1, 0,
+		0, 0, 222, 5, 1, 0, 0, 0, 223, 226, 3, 8, 4, 0, 224, 225, 5, 10, 0, 0,
+		225, 227, 5, 69, 0, 0, 226, 224, 1, 0, 0, 0, 226, 227, 1, 0, 0, 0, 227,
+		7, 1, 0, 0, 0, 228, 233, 3, 10, 5, 0, 229, 230, 5, 64, 0, 0, 230, 232,
+		5, 69, 0, 0, 231, 229, 1, 0, 0, 0, 232, 235, 1, 0, 0, 0, 233, 231, 1, 0,
+		0, 0, 233, 234, 1, 0, 0, 0, 234, 9, 1, 0, 0, 0, 235, 233, 1, 0, 0, 0, 236,
+		237, 7, 0, 0, 0, 237, 11, 1, 0, 0, 0, 238, 239, 5, 15, 0, 0, 239, 250,
+		5, 65, 0, 0, 240, 241, 5, 15, 0, 0, 241, 245, 5, 56, 0, 0, 242, 244, 3,
+		14, 7, 0, 243, 242, 1, 0, 0, 0, 244, 247, 1, 0, 0, 0, 245, 243, 1, 0, 0,
+		0, 245, 246, 1, 0, 0, 0, 246, 248, 1, 0, 0, 0, 247, 245, 1, 0, 0, 0, 248,
+		250, 5, 57, 0, 0, 249, 238, 1, 0, 0, 0, 249, 240, 1, 0, 0, 0, 250, 13,
+		1, 0, 0, 0, 251, 255, 5, 65, 0, 0, 252, 253, 5, 69, 0, 0, 253, 255, 5,
+		65, 0, 0, 254, 251, 1, 0, 0, 0, 254, 252, 1, 0, 0, 0, 255, 15, 1, 0, 0,
+		0, 256, 257, 5, 16, 0, 0, 257, 268, 3, 18, 9, 0, 258, 259, 5, 16, 0, 0,
+		259, 263, 5, 56, 0, 0, 260, 262, 3, 18, 9, 0, 261, 260, 1, 0, 0, 0, 262,
+		265, 1, 0, 0, 0, 263, 261, 1, 0, 0, 0, 263, 264, 1, 0, 0, 0, 264, 266,
+		1, 0, 0, 0, 265, 263, 1, 0, 0, 0, 266, 268, 5, 57, 0, 0, 267, 256, 1, 0,
+		0, 0, 267, 258, 1, 0, 0, 0, 268, 17, 1, 0, 0, 0, 269, 270, 5, 69, 0, 0,
+		270, 271, 3, 82, 41, 0, 271, 19, 1, 0, 0, 0, 272, 273, 5, 1, 0, 0, 273,
+		275, 5, 69, 0, 0, 274, 276, 3, 32, 16, 0, 275, 274, 1, 0, 0, 0, 275, 276,
+		1, 0, 0, 0, 276, 277, 1, 0, 0, 0, 277, 279, 5, 56, 0, 0, 278, 280, 3, 22,
+		11, 0, 279, 278, 1, 0, 0, 0, 279, 280, 1, 0, 0, 0, 280, 281, 1, 0, 0, 0,
+		281, 283, 5, 57, 0, 0, 282, 284, 3, 26, 13, 0, 283, 282, 1, 0, 0, 0, 283,
+		284, 1, 0, 0, 0, 284, 285, 1, 0, 0, 0, 285, 286, 3, 94, 47, 0, 286, 21,
+		1, 0, 0, 0, 287, 292, 3, 24, 12, 0, 288, 289, 5, 62, 0, 0, 289, 291, 3,
+		24, 12, 0, 290, 288, 1, 0, 0, 0, 291, 294, 1, 0, 0, 0, 292, 290, 1, 0,
+		0, 0, 292, 293, 1, 0, 0, 0, 293, 296, 1, 0, 0, 0, 294, 292, 1, 0, 0, 0,
+		295, 297, 5, 62, 0, 0, 296, 295, 1, 0, 0, 0, 296, 297, 1, 0, 0, 0, 297,
+		23, 1, 0, 0, 0, 298, 299, 5, 69, 0, 0, 299, 302, 3, 130, 65, 0, 300, 301,
+		5, 35, 0, 0, 301, 303, 3, 182, 91, 0, 302, 300, 1, 0, 0, 0, 302, 303, 1,
+		0, 0, 0, 303, 25, 1, 0, 0, 0, 304, 309, 3, 130, 65, 0, 305, 306, 5, 69,
+		0, 0, 306, 309, 3, 130, 65, 0, 307, 309, 3, 28, 14, 0, 308, 304, 1, 0,
+		0, 0, 308, 305, 1, 0, 0, 0, 308, 307, 1, 0, 0, 0, 309, 27, 1, 0, 0, 0,
+		310, 311, 5, 56, 0, 0, 311, 316, 3, 30, 15, 0, 312, 313, 5, 62, 0, 0, 313,
+		315, 3, 30, 15, 0, 314, 312, 1, 0, 0, 0, 315, 318, 1, 0, 0, 0, 316, 314,
+		1, 0, 0, 0, 316, 317, 1, 0, 0, 0, 317, 320, 1, 0, 0, 0, 318, 316, 1, 0,
+		0, 0, 319, 321, 5, 62, 0, 0, 320, 319, 1, 0, 0, 0, 320, 321, 1, 0, 0, 0,
+		321, 322, 1, 0, 0, 0, 322, 323, 5, 57, 0, 0, 323, 29, 1, 0, 0, 0, 324,
+		325, 5, 69, 0, 0, 325, 326, 3, 130, 65, 0, 326, 31, 1, 0, 0, 0, 327, 329,
+		5, 58, 0, 0, 328, 330, 3, 34, 17, 0, 329, 328, 1, 0, 0, 0, 329, 330, 1,
+		0, 0, 0, 330, 331, 1, 0, 0, 0, 331, 332, 5, 59, 0, 0, 332, 33, 1, 0, 0,
+		0, 333, 338, 3, 36, 18, 0, 334, 335, 5, 62, 0, 0, 335, 337, 3, 36, 18,
+		0, 336, 334, 1, 0, 0, 0, 337, 340, 1, 0, 0, 0, 338, 336, 1, 0, 0, 0, 338,
+		339, 1, 0, 0, 0, 339, 342, 1, 0, 0, 0, 340, 338, 1, 0, 0, 0, 341, 343,
+		5, 62, 0, 0, 342, 341, 1, 0, 0, 0, 342, 343, 1, 0, 0, 0, 343, 35, 1, 0,
+		0, 0, 344, 345, 5, 69, 0, 0, 345, 348, 3, 130, 65, 0, 346, 347, 5, 35,
+		0, 0, 347, 349, 3, 182, 91, 0, 348, 346, 1, 0, 0, 0, 348, 349, 1, 0, 0,
+		0, 349, 37, 1, 0, 0, 0, 350, 352, 5, 11, 0, 0, 351, 353, 5, 69, 0, 0, 352,
+		351, 1, 0, 0, 0, 352, 353, 1, 0, 0, 0, 353, 354, 1, 0, 0, 0, 354, 368,
+		5, 58, 0, 0, 355, 362, 3, 40, 20, 0, 356, 358, 5, 62, 0, 0, 357, 356, 1,
+		0, 0, 0, 357, 358, 1, 0, 0, 0, 358, 359, 1, 0, 0, 0, 359, 361, 3, 40, 20,
+		0, 360, 357, 1, 0, 0, 0, 361, 364, 1, 0, 0, 0, 362, 360, 1, 0, 0, 0, 362,
+		363, 1, 0, 0, 0, 363, 366, 1, 0, 0, 0, 364, 362, 1, 0, 0, 0, 365, 367,
+		5, 62, 0, 0, 366, 365, 1, 0, 0, 0, 366, 367, 1, 0, 0, 0, 367, 369, 1, 0,
+		0, 0, 368, 355, 1, 0, 0, 0, 368, 369, 1, 0, 0, 0, 369, 370, 1, 0, 0, 0,
+		370, 371, 5, 59, 0, 0, 371, 39, 1, 0, 0, 0, 372, 377, 3, 42, 21, 0, 373,
+		377, 3, 38, 19, 0, 374, 377, 3, 64, 32, 0, 375, 377, 3, 48, 24, 0, 376,
+		372, 1, 0, 0, 0, 376, 373, 1, 0, 0, 0, 376, 374, 1, 0, 0, 0, 376, 375,
+		1, 0, 0, 0, 377, 41, 1, 0, 0, 0, 378, 380, 5, 12, 0, 0, 379, 381, 5, 69,
+		0, 0, 380, 379, 1, 0, 0, 0, 380, 381, 1, 0, 0, 0, 381, 382, 1, 0, 0, 0,
+		382, 383, 3, 44, 22, 0, 383, 43, 1, 0, 0, 0, 384, 398, 5, 58, 0, 0, 385,
+		392, 3, 46, 23, 0, 386, 388, 5, 62, 0, 0, 387, 386, 1, 0, 0, 0, 387, 388,
+		1, 0, 0, 0, 388, 389, 1, 0, 0, 0, 389, 391, 3, 46, 23, 0, 390, 387, 1,
+		0, 0, 0, 391, 394, 1, 0, 0, 0, 392, 390, 1, 0, 0, 0, 392, 393, 1, 0, 0,
+		0, 393, 396, 1, 0, 0, 0, 394, 392, 1, 0, 0, 0, 395, 397, 5, 62, 0, 0, 396,
+		395, 1, 0, 0, 0, 396, 397, 1, 0, 0, 0, 397, 399, 1, 0, 0, 0, 398, 385,
+		1, 0, 0, 0, 398, 399, 1, 0, 0, 0, 399, 400, 1, 0, 0, 0, 400, 401, 5, 59,
+		0, 0, 401, 45, 1, 0, 0, 0, 402, 406, 3, 64, 32, 0, 403, 406, 3, 48, 24,
+		0, 404, 406, 3, 38, 19, 0, 405, 402, 1, 0, 0, 0, 405, 403, 1, 0, 0, 0,
+		405, 404, 1, 0, 0, 0, 406, 47, 1, 0, 0, 0, 407, 410, 3, 78, 39, 0, 408,
+		410, 3, 148, 74, 0, 409, 407, 1, 0, 0, 0, 409, 408, 1, 0, 0, 0, 410, 49,
+		1, 0, 0, 0, 411, 412, 5, 17, 0, 0, 412, 413, 5, 69, 0, 0, 413, 415, 5,
+		58, 0, 0, 414, 416, 3, 52, 26, 0, 415, 414, 1, 0, 0, 0, 415, 416, 1, 0,
+		0, 0, 416, 417, 1, 0, 0, 0, 417, 418, 5, 59, 0, 0, 418, 51, 1, 0, 0, 0,
+		419, 424, 3, 54, 27, 0, 420, 421, 5, 62, 0, 0, 421, 423, 3, 54, 27, 0,
+		422, 420, 1, 0, 0, 0, 423, 426, 1, 0, 0, 0, 424, 422, 1, 0, 0, 0, 424,
+		425, 1, 0, 0, 0, 425, 428, 1, 0, 0, 0, 426, 424, 1, 0, 0, 0, 427, 429,
+		5, 62, 0, 0, 428, 427, 1, 0, 0, 0, 428, 429, 1, 0, 0, 0, 429, 53, 1, 0,
+		0, 0, 430, 431, 5, 69, 0, 0, 431, 432, 3, 130, 65, 0, 432, 55, 1, 0, 0,
+		0, 433, 434, 5, 18, 0, 0, 434, 436, 5, 69, 0, 0, 435, 437, 3, 138, 69,
+		0, 436, 435, 1, 0, 0, 0, 436, 437, 1, 0, 0, 0, 437, 438, 1, 0, 0, 0, 438,
+		440, 5, 58, 0, 0, 439, 441, 3, 58, 29, 0, 440, 439, 1, 0, 0, 0, 440, 441,
+		1, 0, 0, 0, 441, 442, 1, 0, 0, 0, 442, 443, 5, 59, 0, 0, 443, 57, 1, 0,
+		0, 0, 444, 449, 3, 60, 30, 0, 445, 446, 5, 62, 0, 0, 446, 448, 3, 60, 30,
+		0, 447, 445, 1, 0, 0, 0, 448, 451, 1, 0, 0, 0, 449, 447, 1, 0, 0, 0, 449,
+		450, 1, 0, 0, 0, 450, 453, 1, 0, 0, 0, 451, 449, 1, 0, 0, 0, 452, 454,
+		5, 62, 0, 0, 453, 452, 1, 0, 0, 0, 453, 454, 1, 0, 0, 0, 454, 59, 1, 0,
+		0, 0, 455, 461, 5, 69, 0, 0, 456, 458, 5, 35, 0, 0, 457, 459, 5, 42, 0,
+		0, 458, 457, 1, 0, 0, 0, 458, 459, 1, 0, 0, 0, 459, 460, 1, 0, 0, 0, 460,
+		462, 5, 65, 0, 0, 461, 456, 1, 0, 0, 0, 461, 462, 1, 0, 0, 0, 462, 61,
+		1, 0, 0, 0, 463, 464, 5, 69, 0, 0, 464, 465, 5, 32, 0, 0, 465, 472, 3,
+		182, 91, 0, 466, 467, 5, 69, 0, 0, 467, 468, 3, 130, 65, 0, 468, 469, 5,
+		32, 0, 0, 469, 470, 3, 182, 91, 0, 470, 472, 1, 0, 0, 0, 471, 463, 1, 0,
+		0, 0, 471, 466, 1, 0, 0, 0, 472, 63, 1, 0, 0, 0, 473, 476, 3, 68, 34, 0,
+		474, 476, 3, 74, 37, 0, 475, 473, 1, 0, 0, 0, 475, 474, 1, 0, 0, 0, 476,
+		482, 1, 0, 0, 0, 477, 480, 3, 66, 33, 0, 478, 481, 3, 68, 34, 0, 479, 481,
+		3, 74, 37, 0, 480, 478, 1, 0, 0, 0, 480, 479, 1, 0, 0, 0, 481, 483, 1,
+		0, 0, 0, 482, 477, 1, 0, 0, 0, 483, 484, 1, 0, 0, 0, 484, 482, 1, 0, 0,
+		0, 484, 485, 1, 0, 0, 0, 485, 65, 1, 0, 0, 0, 486, 487, 7, 1, 0, 0, 487,
+		67, 1, 0, 0, 0, 488, 489, 5, 58, 0, 0, 489, 494, 3, 70, 35, 0, 490, 491,
+		5, 62, 0, 0, 491, 493, 3, 70, 35, 0, 492, 490, 1, 0, 0, 0, 493, 496, 1,
+		0, 0, 0, 494, 492, 1, 0, 0, 0, 494, 495, 1, 0, 0, 0, 495, 498, 1, 0, 0,
+		0, 496, 494, 1, 0, 0, 0, 497, 499, 5, 62, 0, 0, 498, 497, 1, 0, 0, 0, 498,
+		499, 1, 0, 0, 0, 499, 500, 1, 0, 0, 0, 500, 501, 5, 59, 0, 0, 501, 69,
+		1, 0, 0, 0, 502, 503, 3, 72, 36, 0, 503, 504, 5, 63, 0, 0, 504, 509, 3,
+		74, 37, 0, 505, 506, 5, 31, 0, 0, 506, 508, 3, 74, 37, 0, 507, 505, 1,
+		0, 0, 0, 508, 511, 1, 0, 0, 0, 509, 507, 1, 0, 0, 0, 509, 510, 1, 0, 0,
+		0, 510, 514, 1, 0, 0, 0, 511, 509, 1, 0, 0, 0, 512, 513, 5, 63, 0, 0, 513,
+		515, 5, 69, 0, 0, 514, 512, 1, 0, 0, 0, 514, 515, 1, 0, 0, 0, 515, 71,
+		1, 0, 0, 0, 516, 519, 5, 69, 0, 0, 517, 519, 3, 80, 40, 0, 518, 516, 1,
+		0, 0, 0, 518, 517, 1, 0, 0, 0, 519, 73, 1, 0, 0, 0, 520, 527, 3, 76, 38,
+		0, 521, 527, 3, 78, 39, 0, 522, 527, 3, 148, 74, 0, 523, 527, 3, 42, 21,
+		0, 524, 527, 3, 38, 19, 0, 525, 527, 5, 13, 0, 0, 526, 520, 1, 0, 0, 0,
+		526, 521, 1, 0, 0, 0, 526, 522, 1, 0, 0, 0, 526, 523, 1, 0, 0, 0, 526,
+		524, 1, 0, 0, 0, 526, 525, 1, 0, 0, 0, 527, 75, 1, 0, 0, 0, 528, 529, 5,
+		69, 0, 0, 529, 77, 1, 0, 0, 0, 530, 531, 3, 80, 40, 0, 531, 532, 3, 82,
+		41, 0, 532, 536, 1, 0, 0, 0, 533, 534, 5, 69, 0, 0, 534, 536, 3, 82, 41,
+		0, 535, 530, 1, 0, 0, 0, 535, 533, 1, 0, 0, 0, 536, 79, 1, 0, 0, 0, 537,
+		538, 5, 69, 0, 0, 538, 539, 5, 64, 0, 0, 539, 547, 5, 69, 0, 0, 540, 541,
+		5, 69, 0, 0, 541, 542, 5, 64, 0, 0, 542, 547, 5, 5, 0, 0, 543, 544, 5,
+		15, 0, 0, 544, 545, 5, 64, 0, 0, 545, 547, 5, 69, 0, 0, 546, 537, 1, 0,
+		0, 0, 546, 540, 1, 0, 0, 0, 546, 543, 1, 0, 0, 0, 547, 81, 1, 0, 0, 0,
+		548, 549, 5, 58, 0, 0, 549, 559, 5, 59, 0, 0, 550, 551, 5, 58, 0, 0, 551,
+		552, 3, 84, 42, 0, 552, 553, 5, 59, 0, 0, 553, 559, 1, 0, 0, 0, 554, 555,
+		5, 58, 0, 0, 555, 556, 3, 88, 44, 0, 556, 557, 5, 59, 0, 0, 557, 559, 1,
+		0, 0, 0, 558, 548, 1, 0, 0, 0, 558, 550, 1, 0, 0, 0, 558, 554, 1, 0, 0,
+		0, 559, 83, 1, 0, 0, 0, 560, 565, 3, 86, 43, 0, 561, 562, 5, 62, 0, 0,
+		562, 564, 3, 86, 43, 0, 563, 561, 1, 0, 0, 0, 564, 567, 1, 0, 0, 0, 565,
+		563, 1, 0, 0, 0, 565, 566, 1, 0, 0, 0, 566, 569, 1, 0, 0, 0, 567, 565,
+		1, 0, 0, 0, 568, 570, 5, 62, 0, 0, 569, 568, 1, 0, 0, 0, 569, 570, 1, 0,
+		0, 0, 570, 85, 1, 0, 0, 0, 571, 572, 5, 69, 0, 0, 572, 573, 5, 35, 0, 0,
+		573, 574, 3, 148, 74, 0, 574, 87, 1, 0, 0, 0, 575, 580, 3, 148, 74, 0,
+		576, 577, 5, 62, 0, 0, 577, 579, 3, 148, 74, 0, 578, 576, 1, 0, 0, 0, 579,
+		582, 1, 0, 0, 0, 580, 578, 1, 0, 0, 0, 580, 581, 1, 0, 0, 0, 581, 584,
+		1, 0, 0, 0, 582, 580, 1, 0, 0, 0, 583, 585, 5, 62, 0, 0, 584, 583, 1, 0,
+		0, 0, 584, 585, 1, 0, 0, 0, 585, 89, 1, 0, 0, 0, 586, 588, 5, 56, 0, 0,
+		587, 589, 3, 92, 46, 0, 588, 587, 1, 0, 0, 0, 588, 589, 1, 0, 0, 0, 589,
+		590, 1, 0, 0, 0, 590, 591, 5, 57, 0, 0, 591, 91, 1, 0, 0, 0, 592, 597,
+		3, 148, 74, 0, 593, 594, 5, 62, 0, 0, 594, 596, 3, 148, 74, 0, 595, 593,
+		1, 0, 0, 0, 596, 599, 1, 0, 0, 0, 597, 595, 1, 0, 0, 0, 597, 598, 1, 0,
+		0, 0, 598, 601, 1, 0, 0, 0, 599, 597, 1, 0, 0, 0, 600, 602, 5, 62, 0, 0,
+		601, 600, 1, 0, 0, 0, 601, 602, 1, 0, 0, 0, 602, 93, 1, 0, 0, 0, 603, 607,
+		5, 58, 0, 0, 604, 606, 3, 96, 48, 0, 605, 604, 1, 0, 0, 0, 606, 609, 1,
+		0, 0, 0, 607, 605, 1, 0, 0, 0, 607, 608, 1, 0, 0, 0, 608, 610, 1, 0, 0,
+		0, 609, 607, 1, 0, 0, 0, 610, 611, 5, 59, 0, 0, 611, 95, 1, 0, 0, 0, 612,
+		622, 3, 98, 49, 0, 613, 622, 3, 104, 52, 0, 614, 622, 3, 108, 54, 0, 615,
+		622, 3, 120, 60, 0, 616, 622, 3, 114, 57, 0, 617, 622, 3, 124, 62, 0, 618,
+		622, 3, 126, 63, 0, 619, 622, 3, 128, 64, 0, 620, 622, 3, 148, 74, 0, 621,
+		612, 1, 0, 0, 0, 621, 613, 1, 0, 0, 0, 621, 614, 1, 0, 0, 0, 621, 615,
+		1, 0, 0, 0, 621, 616, 1, 0, 0, 0, 621, 617, 1, 0, 0, 0, 621, 618, 1, 0,
+		0, 0, 621, 619, 1, 0, 0, 0, 621, 620, 1, 0, 0, 0, 622, 97, 1, 0, 0, 0,
+		623, 626, 3, 100, 50, 0, 624, 626, 3, 102, 51, 0, 625, 623, 1, 0, 0, 0,
+		625, 624, 1, 0, 0, 0, 626, 99, 1, 0, 0, 0, 627, 628, 5, 69, 0, 0, 628,
+		629, 5, 32, 0, 0, 629, 636, 3, 148, 74, 0, 630, 631, 5, 69, 0, 0, 631,
+		632, 3, 130, 65, 0, 632, 633, 5, 32, 0, 0, 633, 634, 3, 148, 74, 0, 634,
+		636, 1, 0, 0, 0, 635, 627, 1, 0, 0, 0, 635, 630, 1, 0, 0, 0, 636, 101,
+		1, 0, 0, 0, 637, 638, 5, 69, 0, 0, 638, 639, 5, 33, 0, 0, 639, 646, 3,
+		148, 74, 0, 640, 641, 5, 69, 0, 0, 641, 642, 3, 130, 65, 0, 642, 643, 5,
+		33, 0, 0, 643, 644, 3, 148, 74, 0, 644, 646, 1, 0, 0, 0, 645, 637, 1, 0,
+		0, 0, 645, 640, 1, 0, 0, 0, 646, 103, 1, 0, 0, 0, 647, 648, 5, 69, 0, 0,
+		648, 649, 5, 35, 0, 0, 649, 683, 3, 148, 74, 0, 650, 651, 5, 69, 0, 0,
+		651, 652, 3, 168, 84, 0, 652, 653, 5, 35, 0, 0, 653, 654, 3, 148, 74, 0,
+		654, 683, 1, 0, 0, 0, 655, 656, 5, 69, 0, 0, 656, 657, 3, 106, 53, 0, 657,
+		658, 3, 148, 74, 0, 658, 683, 1, 0, 0, 0, 659, 660, 5, 69, 0, 0, 660, 661,
+		3, 168, 84, 0, 661, 662, 3, 106, 53, 0, 662, 663, 3, 148, 74, 0, 663, 683,
+		1, 0, 0, 0, 664, 666, 5, 69, 0, 0, 665, 667, 3, 172, 86, 0, 666, 665, 1,
+		0, 0, 0, 667, 668, 1, 0, 0, 0, 668, 666, 1, 0, 0, 0, 668, 669, 1, 0, 0,
+		0, 669, 670, 1, 0, 0, 0, 670, 671, 5, 35, 0, 0, 671, 672, 3, 148, 74, 0,
+		672, 683, 1, 0, 0, 0, 673, 675, 5, 69, 0, 0, 674, 676, 3, 172, 86, 0, 675,
+		674, 1, 0, 0, 0, 676, 677, 1, 0, 0, 0, 677, 675, 1, 0, 0, 0, 677, 678,
+		1, 0, 0, 0, 678, 679, 1, 0, 0, 0, 679, 680, 3, 106, 53, 0, 680, 681, 3,
+		148, 74, 0, 681, 683, 1, 0, 0, 0, 682, 647, 1, 0, 0, 0, 682, 650, 1, 0,
+		0, 0, 682, 655, 1, 0, 0, 0, 682, 659, 1, 0, 0, 0, 682, 664, 1, 0, 0, 0,
+		682, 673, 1, 0, 0, 0, 683, 105, 1, 0, 0, 0, 684, 685, 7, 2, 0, 0, 685,
+		107, 1, 0, 0, 0, 686, 687, 5, 2, 0, 0, 687, 688, 3, 148, 74, 0, 688, 692,
+		3, 94, 47, 0, 689, 691, 3, 110, 55, 0, 690, 689, 1, 0, 0, 0, 691, 694,
+		1, 0, 0, 0, 692, 690, 1, 0, 0, 0, 692, 693, 1, 0, 0, 0, 693, 696, 1, 0,
+		0, 0, 694, 692, 1, 0, 0, 0, 695, 697, 3, 112, 56, 0, 696, 695, 1, 0, 0,
+		0, 696, 697, 1, 0, 0, 0, 697, 109, 1, 0, 0, 0, 698, 699, 5, 3, 0, 0, 699,
+		700, 5, 2, 0, 0, 700, 701, 3, 148, 74, 0, 701, 702, 3, 94, 47, 0, 702,
+		111, 1, 0, 0, 0, 703, 704, 5, 3, 0, 0, 704, 705, 3, 94, 47, 0, 705, 113,
+		1, 0, 0, 0, 706, 707, 5, 8, 0, 0, 707, 708, 3, 148, 74, 0, 708, 712, 5,
+		58, 0, 0, 709, 711, 3, 116, 58, 0, 710, 709, 1, 0, 0, 0, 711, 714, 1, 0,
+		0, 0, 712, 710, 1, 0, 0, 0, 712, 713, 1, 0, 0, 0, 713, 716, 1, 0, 0, 0,
+		714, 712, 1, 0, 0, 0, 715, 717, 3, 118, 59, 0, 716, 715, 1, 0, 0, 0, 716,
+		717, 1, 0, 0, 0, 717, 718, 1, 0, 0, 0, 718, 719, 5, 59, 0, 0, 719, 115,
+		1, 0, 0, 0, 720, 725, 3, 148, 74, 0, 721, 722, 5, 62, 0, 0, 722, 724, 3,
+		148, 74, 0, 723, 721, 1, 0, 0, 0, 724, 727, 1, 0, 0, 0, 725, 723, 1, 0,
+		0, 0, 725, 726, 1, 0, 0, 0, 726, 728, 1, 0, 0, 0, 727, 725, 1, 0, 0, 0,
+		728, 729, 5, 34, 0, 0, 729, 730, 3, 94, 47, 0, 730, 117, 1, 0, 0, 0, 731,
+		732, 5, 3, 0, 0, 732, 733, 5, 34, 0, 0, 733, 734, 3, 94, 47, 0, 734, 119,
+		1, 0, 0, 0, 735, 736, 5, 5, 0, 0, 736, 737, 3, 122, 61, 0, 737, 738, 3,
+		94, 47, 0, 738, 121, 1, 0, 0, 0, 739, 740, 5, 69, 0, 0, 740, 741, 5, 62,
+		0, 0, 741, 742, 5, 69, 0, 0, 742, 743, 5, 32, 0, 0, 743, 750, 3, 148, 74,
+		0, 744, 745, 5, 69, 0, 0, 745, 746, 5, 32, 0, 0, 746, 750, 3, 148, 74,
+		0, 747, 750, 3, 148, 74, 0, 748, 750, 1, 0, 0, 0, 749, 739, 1, 0, 0, 0,
+		749, 744, 1, 0, 0, 0, 749, 747, 1, 0, 0, 0, 749, 748, 1, 0, 0, 0, 750,
+		123, 1, 0, 0, 0, 751, 752, 5, 6, 0, 0, 752, 125, 1, 0, 0, 0, 753, 754,
+		5, 7, 0, 0, 754, 127, 1, 0, 0, 0, 755, 757, 5, 4, 0, 0, 756, 758, 3, 148,
+		74, 0, 757, 756, 1, 0, 0, 0, 757, 758, 1, 0, 0, 0, 758, 129, 1, 0, 0, 0,
+		759, 761, 3, 134, 67, 0, 760, 762, 3, 132, 66, 0, 761, 760, 1, 0, 0, 0,
+		761, 762, 1, 0, 0, 0, 762, 767, 1, 0, 0, 0, 763, 767, 3, 142, 71, 0, 764,
+		767, 3, 144, 72, 0, 765, 767, 3, 146, 73, 0, 766, 759, 1, 0, 0, 0, 766,
+		763, 1, 0, 0, 0, 766, 764, 1, 0, 0, 0, 766, 765, 1, 0, 0, 0, 767, 131,
+		1, 0, 0, 0, 768, 769, 5, 69, 0, 0, 769, 133, 1, 0, 0, 0, 770, 773, 3, 136,
+		68, 0, 771, 773, 5, 29, 0, 0, 772, 770, 1, 0, 0, 0, 772, 771, 1, 0, 0,
+		0, 773, 135, 1, 0, 0, 0, 774, 777, 3, 138, 69, 0, 775, 777, 3, 140, 70,
+		0, 776, 774, 1, 0, 0, 0, 776, 775, 1, 0, 0, 0, 777, 137, 1, 0, 0, 0, 778,
+		779, 7, 3, 0, 0, 779, 139, 1, 0, 0, 0, 780, 781, 7, 4, 0, 0, 781, 141,
+		1, 0, 0, 0, 782, 783, 5, 14, 0, 0, 783, 785, 3, 134, 67, 0, 784, 786, 3,
+		132, 66, 0, 785, 784, 1, 0, 0, 0, 785, 786, 1, 0, 0, 0, 786, 790, 1, 0,
+		0, 0, 787, 788, 5, 14, 0, 0, 788, 790, 3, 144, 72, 0, 789, 782, 1, 0, 0,
+		0, 789, 787, 1, 0, 0, 0, 790, 143, 1, 0, 0, 0, 791, 792, 5, 30, 0, 0, 792,
+		794, 3, 134, 67, 0, 793, 795, 3, 132, 66, 0, 794, 793, 1, 0, 0, 0, 794,
+		795, 1, 0, 0, 0, 795, 145, 1, 0, 0, 0, 796, 797, 5, 69, 0, 0, 797, 147,
+		1, 0, 0, 0, 798, 799, 3, 150, 75, 0, 799, 149, 1, 0, 0, 0, 800, 805, 3,
+		152, 76, 0, 801, 802, 5, 54, 0, 0, 802, 804, 3, 152, 76, 0, 803, 801, 1,
+		0, 0, 0, 804, 807, 1, 0, 0, 0, 805, 803, 1, 0, 0, 0, 805, 806, 1, 0, 0,
+		0, 806, 151, 1, 0, 0, 0, 807, 805, 1, 0, 0, 0, 808, 813, 3, 154, 77, 0,
+		809, 810, 5, 53, 0, 0, 810, 812, 3, 154, 77, 0, 811, 809, 1, 0, 0, 0, 812,
+		815, 1, 0, 0, 0, 813, 811, 1, 0, 0, 0, 813, 814, 1, 0, 0, 0, 814, 153,
+		1, 0, 0, 0, 815, 813, 1, 0, 0, 0, 816, 821, 3, 156, 78, 0, 817, 818, 7,
+		5, 0, 0, 818, 820, 3, 156, 78, 0, 819, 817, 1, 0, 0, 0, 820, 823, 1, 0,
+		0, 0, 821, 819, 1, 0, 0, 0, 821, 822, 1, 0, 0, 0, 822, 155, 1, 0, 0, 0,
+		823, 821, 1, 0, 0, 0, 824, 829, 3, 158, 79, 0, 825, 826, 7, 6, 0, 0, 826,
+		828, 3, 158, 79, 0, 827, 825, 1, 0, 0, 0, 828, 831, 1, 0, 0, 0, 829, 827,
+		1, 0, 0, 0, 829, 830, 1, 0, 0, 0, 830, 157, 1, 0, 0, 0, 831, 829, 1, 0,
+		0, 0, 832, 837, 3, 160, 80, 0, 833, 834, 7, 7, 0, 0, 834, 836, 3, 160,
+		80, 0, 835, 833, 1, 0, 0, 0, 836, 839, 1, 0, 0, 0, 837, 835, 1, 0, 0, 0,
+		837, 838, 1, 0, 0, 0, 838, 159, 1, 0, 0, 0, 839, 837, 1, 0, 0, 0, 840,
+		845, 3, 162, 81, 0, 841, 842, 7, 8, 0, 0, 842, 844, 3, 162, 81, 0, 843,
+		841, 1, 0, 0, 0, 844, 847, 1, 0, 0, 0, 845, 843, 1, 0, 0, 0, 845, 846,
+		1, 0, 0, 0, 846, 161, 1, 0, 0, 0, 847, 845, 1, 0, 0, 0, 848, 851, 3, 164,
+		82, 0, 849, 850, 5, 46, 0, 0, 850, 852, 3, 162, 81, 0, 851, 849, 1, 0,
+		0, 0, 851, 852, 1, 0, 0, 0, 852, 163, 1, 0, 0, 0, 853, 854, 5, 42, 0, 0,
+		854, 859, 3, 164, 82, 0, 855, 856, 5, 55, 0, 0, 856, 859, 3, 164, 82, 0,
+		857, 859, 3, 166, 83, 0, 858, 853, 1, 0, 0, 0, 858, 855, 1, 0, 0, 0, 858,
+		857, 1, 0, 0, 0, 859, 165, 1, 0, 0, 0, 860, 866, 3, 174, 87, 0, 861, 865,
+		3, 168, 84, 0, 862, 865, 3, 170, 85, 0, 863, 865, 3, 172, 86, 0, 864, 861,
+		1, 0, 0, 0, 864, 862, 1, 0, 0, 0, 864, 863, 1, 0, 0, 0, 865, 868, 1, 0,
+		0, 0, 866, 864, 1, 0, 0, 0, 866, 867, 1, 0, 0, 0, 867, 167, 1, 0, 0, 0,
+		868, 866, 1, 0, 0, 0, 869, 870, 5, 60, 0, 0, 870, 871, 3, 148, 74, 0, 871,
+		872, 5, 61, 0, 0, 872, 883, 1, 0, 0, 0, 873, 875, 5, 60, 0, 0, 874, 876,
+		3, 148, 74, 0, 875, 874, 1, 0, 0, 0, 875, 876, 1, 0, 0, 0, 876, 877, 1,
+		0, 0, 0, 877, 879, 5, 63, 0, 0, 878, 880, 3, 148, 74, 0, 879, 878, 1, 0,
+		0, 0, 879, 880, 1, 0, 0, 0, 880, 881, 1, 0, 0, 0, 881, 883, 5, 61, 0, 0,
+		882, 869, 1, 0, 0, 0, 882, 873, 1, 0, 0, 0, 883, 169, 1, 0, 0, 0, 884,
+		886, 5, 56, 0, 0, 885, 887, 3, 92, 46, 0, 886, 885, 1, 0, 0, 0, 886, 887,
+		1, 0, 0, 0, 887, 888, 1, 0, 0, 0, 888, 889, 5, 57, 0, 0, 889, 171, 1, 0,
+		0, 0, 890, 891, 5, 64, 0, 0, 891, 892, 5, 69, 0, 0, 892, 173, 1, 0, 0,
+		0, 893, 903, 3, 182, 91, 0, 894, 903, 3, 80, 40, 0, 895, 903, 5, 69, 0,
+		0, 896, 897, 5, 56, 0, 0, 897, 898, 3, 148, 74, 0, 898, 899, 5, 57, 0,
+		0, 899, 903, 1, 0, 0, 0, 900, 903, 3, 176, 88, 0, 901, 903, 3, 178, 89,
+		0, 902, 893, 1, 0, 0, 0, 902, 894, 1, 0, 0, 0, 902, 895, 1, 0, 0, 0, 902,
+		896, 1, 0, 0, 0, 902, 900, 1, 0, 0, 0, 902, 901, 1, 0, 0, 0, 903, 175,
+		1, 0, 0, 0, 904, 905, 3, 130, 65, 0, 905, 906, 5, 56, 0, 0, 906, 907, 3,
+		148, 74, 0, 907, 908, 5, 57, 0, 0, 908, 177, 1, 0, 0, 0, 909, 910, 5, 69,
+		0, 0, 910, 911, 5, 58, 0, 0, 911, 916, 3, 180, 90, 0, 912, 913, 5, 62,
+		0, 0, 913, 915, 3, 180, 90, 0, 914, 912, 1, 0, 0, 0, 915, 918, 1, 0, 0,
+		0, 916, 914, 1, 0, 0, 0, 916, 917, 1, 0, 0, 0, 917, 920, 1, 0, 0, 0, 918,
+		916, 1, 0, 0, 0, 919, 921, 5, 62, 0, 0, 920, 919, 1, 0, 0, 0, 920, 921,
+		1, 0, 0, 0, 921, 922, 1, 0, 0, 0, 922, 923, 5, 59, 0, 0, 923, 179, 1, 0,
+		0, 0, 924, 925, 5, 69, 0, 0, 925, 926, 5, 63, 0, 0, 926, 927, 3, 148, 74,
+		0, 927, 181, 1, 0, 0, 0, 928, 933, 3, 184, 92, 0, 929, 933, 5, 68, 0, 0,
+		930, 933, 5, 67, 0, 0, 931, 933, 3, 186, 93, 0, 932, 928, 1, 0, 0, 0, 932,
+		929, 1, 0, 0, 0, 932, 930, 1, 0, 0, 0, 932, 931, 1, 0, 0, 0, 933, 183,
+		1, 0, 0, 0, 934, 936, 5, 42, 0, 0, 935, 934, 1, 0, 0, 0, 935, 936, 1, 0,
+		0, 0, 936, 937, 1, 0, 0, 0, 937, 940, 7, 9, 0, 0, 938, 939, 4, 92, 0, 0,
+		939, 941, 5, 69, 0, 0, 940, 938, 1, 0, 0, 0, 940, 941, 1, 0, 0, 0, 941,
+		185, 1, 0, 0, 0, 942, 944, 5, 60, 0, 0, 943, 945, 3, 188, 94, 0, 944, 943,
+		1, 0, 0, 0, 944, 945, 1, 0, 0, 0, 945, 946, 1, 0, 0, 0, 946, 947, 5, 61,
+		0, 0, 947, 187, 1, 0, 0, 0, 948, 953, 3, 148, 74, 0, 949, 950, 5, 62, 0,
+		0, 950, 952, 3, 148, 74, 0, 951, 949, 1, 0, 0, 0, 952, 955, 1, 0, 0, 0,
+		953, 951, 1, 0, 0, 0, 953, 954, 1, 0, 0, 0, 954, 957, 1, 0, 0, 0, 955,
+		953, 1, 0, 0, 0, 956, 958, 5, 62, 0, 0, 957, 956, 1, 0, 0, 0, 957, 958,
+		1, 0, 0, 0, 958, 189, 1, 0, 0, 0, 111, 193, 208, 217, 221, 226, 233, 245,
+		249, 254, 263, 267, 275, 279, 283, 292, 296, 302, 308, 316, 320, 329, 338,
+		342, 348, 352, 357, 362, 366, 368, 376, 380, 387, 392, 396, 398, 405, 409,
+		415, 424, 428, 436, 440, 449, 453, 458, 461, 471, 475, 480, 484, 494, 498,
+		509, 514, 518, 526, 535, 546, 558, 565, 569, 580, 584, 588, 597, 601, 607,
+		621, 625, 635, 645, 668, 677, 682, 692, 696, 712, 716, 725, 749, 757, 761,
+		766, 772, 776, 785, 789, 794, 805, 813, 821, 829, 837, 845, 851, 858, 864,
+		866, 875, 879, 882, 886, 902, 916, 920, 932, 935, 940, 944, 953, 957,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -481,67 +568,71 @@ const (
 	ArcParserFOR                 = 5
 	ArcParserBREAK               = 6
 	ArcParserCONTINUE            = 7
-	ArcParserIMPORT              = 8
-	ArcParserAS                  = 9
-	ArcParserSEQUENCE            = 10
-	ArcParserSTAGE               = 11
-	ArcParserNEXT                = 12
-	ArcParserCHAN                = 13
-	ArcParserAUTHORITY           = 14
-	ArcParserI8                  = 15
-	ArcParserI16                 = 16
-	ArcParserI32                 = 17
-	ArcParserI64                 = 18
-	ArcParserU8                  = 19
-	ArcParserU16                 = 20
-	ArcParserU32                 = 21
-	ArcParserU64                 = 22
-	ArcParserF32                 = 23
-	ArcParserF64                 = 24
-	ArcParserSTR                 = 25
-	ArcParserSERIES              = 26
-	ArcParserARROW               = 27
-	ArcParserDECLARE             = 28
-	ArcParserSTATE_DECLARE       = 29
-	ArcParserTRANSITION          = 30
-	ArcParserASSIGN              = 31
-	ArcParserPLUS_ASSIGN         = 32
-	ArcParserMINUS_ASSIGN        = 33
-	ArcParserSTAR_ASSIGN         = 34
-	ArcParserSLASH_ASSIGN        = 35
-	ArcParserPERCENT_ASSIGN      = 36
-	ArcParserPLUS                = 37
-	ArcParserMINUS               = 38
-	ArcParserSTAR                = 39
-	ArcParserSLASH               = 40
-	ArcParserPERCENT             = 41
-	ArcParserCARET               = 42
-	ArcParserEQ                  = 43
-	ArcParserNEQ                 = 44
-	ArcParserLT                  = 45
-	ArcParserGT                  = 46
-	ArcParserLEQ                 = 47
-	ArcParserGEQ                 = 48
-	ArcParserAND                 = 49
-	ArcParserOR                  = 50
-	ArcParserNOT                 = 51
-	ArcParserLPAREN              = 52
-	ArcParserRPAREN              = 53
-	ArcParserLBRACE              = 54
-	ArcParserRBRACE              = 55
-	ArcParserLBRACKET            = 56
-	ArcParserRBRACKET            = 57
-	ArcParserCOMMA               = 58
-	ArcParserCOLON               = 59
-	ArcParserDOT                 = 60
-	ArcParserINTEGER_LITERAL     = 61
-	ArcParserFLOAT_LITERAL       = 62
-	ArcParserSTR_LITERAL_MULTI   = 63
-	ArcParserSTR_LITERAL         = 64
-	ArcParserIDENTIFIER          = 65
-	ArcParserSINGLE_LINE_COMMENT = 66
-	ArcParserMULTI_LINE_COMMENT  = 67
-	ArcParserWS                  = 68
+	ArcParserMATCH               = 8
+	ArcParserIMPORT              = 9
+	ArcParserAS                  = 10
+	ArcParserSEQUENCE            = 11
+	ArcParserSTAGE               = 12
+	ArcParserNEXT                = 13
+	ArcParserCHAN                = 14
+	ArcParserAUTHORITY           = 15
+	ArcParserSAFETY              = 16
+	ArcParserSTRUCT              = 17
+	ArcParserENUM                = 18
+	ArcParserI8                  = 19
+	ArcParserI16                 = 20
+	ArcParserI32                 = 21
+	ArcParserI64                 = 22
+	ArcParserU8                  = 23
+	ArcParserU16                 = 24
+	ArcParserU32                 = 25
+	ArcParserU64                 = 26
+	ArcParserF32                 = 27
+	ArcParserF64                 = 28
+	ArcParserSTR                 = 29
+	ArcParserSERIES              = 30
+	ArcParserARROW               = 31
+	ArcParserDECLARE             = 32
+	ArcParserSTATE_DECLARE       = 33
+	ArcParserTRANSITION          = 34
+	ArcParserASSIGN              = 35
+	ArcParserPLUS_ASSIGN         = 36
+	ArcParserMINUS_ASSIGN        = 37
+	ArcParserSTAR_ASSIGN         = 38
+	ArcParserSLASH_ASSIGN        = 39
+	ArcParserPERCENT_ASSIGN      = 40
+	ArcParserPLUS                = 41
+	ArcParserMINUS               = 42
+	ArcParserSTAR                = 43
+	ArcParserSLASH               = 44
+	ArcParserPERCENT             = 45
+	ArcParserCARET               = 46
+	ArcParserEQ                  = 47
+	ArcParserNEQ                 = 48
+	ArcParserLT                  = 49
+	ArcParserGT                  = 50
+	ArcParserLEQ                 = 51
+	ArcParserGEQ                 = 52
+	ArcParserAND                 = 53
+	ArcParserOR                  = 54
+	ArcParserNOT                 = 55
+	ArcParserLPAREN              = 56
+	ArcParserRPAREN              = 57
+	ArcParserLBRACE              = 58
+	ArcParserRBRACE              = 59
+	ArcParserLBRACKET            = 60
+	ArcParserRBRACKET            = 61
+	ArcParserCOMMA               = 62
+	ArcParserCOLON               = 63
+	ArcParserDOT                 = 64
+	ArcParserINTEGER_LITERAL     = 65
+	ArcParserFLOAT_LITERAL       = 66
+	ArcParserSTR_LITERAL_MULTI   = 67
+	ArcParserSTR_LITERAL         = 68
+	ArcParserIDENTIFIER          = 69
+	ArcParserSINGLE_LINE_COMMENT = 70
+	ArcParserMULTI_LINE_COMMENT  = 71
+	ArcParserWS                  = 72
 )
 
 // ArcParser rules.
@@ -554,77 +645,93 @@ const (
 	ArcParserRULE_importPathHead           = 5
 	ArcParserRULE_authorityBlock           = 6
 	ArcParserRULE_authorityEntry           = 7
-	ArcParserRULE_functionDeclaration      = 8
-	ArcParserRULE_inputList                = 9
-	ArcParserRULE_input                    = 10
-	ArcParserRULE_outputType               = 11
-	ArcParserRULE_multiOutputBlock         = 12
-	ArcParserRULE_namedOutput              = 13
-	ArcParserRULE_configBlock              = 14
-	ArcParserRULE_configList               = 15
-	ArcParserRULE_config                   = 16
-	ArcParserRULE_sequenceDeclaration      = 17
-	ArcParserRULE_sequenceItem             = 18
-	ArcParserRULE_stageDeclaration         = 19
-	ArcParserRULE_stageBody                = 20
-	ArcParserRULE_stageItem                = 21
-	ArcParserRULE_singleInvocation         = 22
-	ArcParserRULE_globalConstant           = 23
-	ArcParserRULE_flowStatement            = 24
-	ArcParserRULE_flowOperator             = 25
-	ArcParserRULE_routingTable             = 26
-	ArcParserRULE_routingEntry             = 27
-	ArcParserRULE_flowNode                 = 28
-	ArcParserRULE_identifier               = 29
-	ArcParserRULE_function                 = 30
-	ArcParserRULE_qualifiedIdentifier      = 31
-	ArcParserRULE_configValues             = 32
-	ArcParserRULE_namedConfigValues        = 33
-	ArcParserRULE_namedConfigValue         = 34
-	ArcParserRULE_anonymousConfigValues    = 35
-	ArcParserRULE_arguments                = 36
-	ArcParserRULE_argumentList             = 37
-	ArcParserRULE_block                    = 38
-	ArcParserRULE_statement                = 39
-	ArcParserRULE_variableDeclaration      = 40
-	ArcParserRULE_localVariable            = 41
-	ArcParserRULE_statefulVariable         = 42
-	ArcParserRULE_assignment               = 43
-	ArcParserRULE_compoundOp               = 44
-	ArcParserRULE_ifStatement              = 45
-	ArcParserRULE_elseIfClause             = 46
-	ArcParserRULE_elseClause               = 47
-	ArcParserRULE_forStatement             = 48
-	ArcParserRULE_forClause                = 49
-	ArcParserRULE_breakStatement           = 50
-	ArcParserRULE_continueStatement        = 51
-	ArcParserRULE_returnStatement          = 52
-	ArcParserRULE_type                     = 53
-	ArcParserRULE_unitSuffix               = 54
-	ArcParserRULE_primitiveType            = 55
-	ArcParserRULE_numericType              = 56
-	ArcParserRULE_integerType              = 57
-	ArcParserRULE_floatType                = 58
-	ArcParserRULE_channelType              = 59
-	ArcParserRULE_seriesType               = 60
-	ArcParserRULE_expression               = 61
-	ArcParserRULE_logicalOrExpression      = 62
-	ArcParserRULE_logicalAndExpression     = 63
-	ArcParserRULE_equalityExpression       = 64
-	ArcParserRULE_relationalExpression     = 65
-	ArcParserRULE_additiveExpression       = 66
-	ArcParserRULE_multiplicativeExpression = 67
-	ArcParserRULE_powerExpression          = 68
-	ArcParserRULE_unaryExpression          = 69
-	ArcParserRULE_postfixExpression        = 70
-	ArcParserRULE_indexOrSlice             = 71
-	ArcParserRULE_functionCallSuffix       = 72
-	ArcParserRULE_primaryExpression        = 73
-	ArcParserRULE_typeCast                 = 74
-	ArcParserRULE_literal                  = 75
-	ArcParserRULE_numericLiteral           = 76
-	ArcParserRULE_seriesLiteral            = 77
-	ArcParserRULE_expressionList           = 78
+	ArcParserRULE_safetyBlock              = 8
+	ArcParserRULE_safetyEntry              = 9
+	ArcParserRULE_functionDeclaration      = 10
+	ArcParserRULE_inputList                = 11
+	ArcParserRULE_input                    = 12
+	ArcParserRULE_outputType               = 13
+	ArcParserRULE_multiOutputBlock         = 14
+	ArcParserRULE_namedOutput              = 15
+	ArcParserRULE_configBlock              = 16
+	ArcParserRULE_configList               = 17
+	ArcParserRULE_config                   = 18
+	ArcParserRULE_sequenceDeclaration      = 19
+	ArcParserRULE_sequenceItem             = 20
+	ArcParserRULE_stageDeclaration         = 21
+	ArcParserRULE_stageBody                = 22
+	ArcParserRULE_stageItem                = 23
+	ArcParserRULE_singleInvocation         = 24
+	ArcParserRULE_structDeclaration        = 25
+	ArcParserRULE_structFieldList          = 26
+	ArcParserRULE_structField              = 27
+	ArcParserRULE_enumDeclaration          = 28
+	ArcParserRULE_enumMemberList           = 29
+	ArcParserRULE_enumMember               = 30
+	ArcParserRULE_globalConstant           = 31
+	ArcParserRULE_flowStatement            = 32
+	ArcParserRULE_flowOperator             = 33
+	ArcParserRULE_routingTable             = 34
+	ArcParserRULE_routingEntry             = 35
+	ArcParserRULE_routingKey               = 36
+	ArcParserRULE_flowNode                 = 37
+	ArcParserRULE_identifier               = 38
+	ArcParserRULE_function                 = 39
+	ArcParserRULE_qualifiedIdentifier      = 40
+	ArcParserRULE_configValues             = 41
+	ArcParserRULE_namedConfigValues        = 42
+	ArcParserRULE_namedConfigValue         = 43
+	ArcParserRULE_anonymousConfigValues    = 44
+	ArcParserRULE_arguments                = 45
+	ArcParserRULE_argumentList             = 46
+	ArcParserRULE_block                    = 47
+	ArcParserRULE_statement                = 48
+	ArcParserRULE_variableDeclaration      = 49
+	ArcParserRULE_localVariable            = 50
+	ArcParserRULE_statefulVariable         = 51
+	ArcParserRULE_assignment               = 52
+	ArcParserRULE_compoundOp               = 53
+	ArcParserRULE_ifStatement              = 54
+	ArcParserRULE_elseIfClause             = 55
+	ArcParserRULE_elseClause               = 56
+	ArcParserRULE_matchStatement           = 57
+	ArcParserRULE_matchArm                 = 58
+	ArcParserRULE_matchElse                = 59
+	ArcParserRULE_forStatement             = 60
+	ArcParserRULE_forClause                = 61
+	ArcParserRULE_breakStatement           = 62
+	ArcParserRULE_continueStatement        = 63
+	ArcParserRULE_returnStatement          = 64
+	ArcParserRULE_type                     = 65
+	ArcParserRULE_unitSuffix               = 66
+	ArcParserRULE_primitiveType            = 67
+	ArcParserRULE_numericType              = 68
+	ArcParserRULE_integerType              = 69
+	ArcParserRULE_floatType                = 70
+	ArcParserRULE_channelType              = 71
+	ArcParserRULE_seriesType               = 72
+	ArcParserRULE_structType               = 73
+	ArcParserRULE_expression               = 74
+	ArcParserRULE_logicalOrExpression      = 75
+	ArcParserRULE_logicalAndExpression     = 76
+	ArcParserRULE_equalityExpression       = 77
+	ArcParserRULE_relationalExpression     = 78
+	ArcParserRULE_additiveExpression       = 79
+	ArcParserRULE_multiplicativeExpression = 80
+	ArcParserRULE_powerExpression          = 81
+	ArcParserRULE_unaryExpression          = 82
+	ArcParserRULE_postfixExpression        = 83
+	ArcParserRULE_indexOrSlice             = 84
+	ArcParserRULE_functionCallSuffix       = 85
+	ArcParserRULE_memberAccess             = 86
+	ArcParserRULE_primaryExpression        = 87
+	ArcParserRULE_typeCast                 = 88
+	ArcParserRULE_structLiteral            = 89
+	ArcParserRULE_structFieldValue         = 90
+	ArcParserRULE_literal                  = 91
+	ArcParserRULE_numericLiteral           = 92
+	ArcParserRULE_seriesLiteral            = 93
+	ArcParserRULE_expressionList           = 94
 )
 
 // IProgramContext is an interface to support dynamic dispatch.
@@ -756,20 +863,20 @@ func (p *ArcParser) Program() (localctx IProgramContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(161)
+	p.SetState(193)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2209015342213104382) != 0) || _la == ArcParserSTR_LITERAL || _la == ArcParserIDENTIFIER {
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1549242672009443842) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&31) != 0) {
 		{
-			p.SetState(158)
+			p.SetState(190)
 			p.TopLevelItem()
 		}
 
-		p.SetState(163)
+		p.SetState(195)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -777,7 +884,7 @@ func (p *ArcParser) Program() (localctx IProgramContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(164)
+		p.SetState(196)
 		p.Match(ArcParserEOF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -808,10 +915,13 @@ type ITopLevelItemContext interface {
 	// Getter signatures
 	ImportStatement() IImportStatementContext
 	AuthorityBlock() IAuthorityBlockContext
+	SafetyBlock() ISafetyBlockContext
 	FunctionDeclaration() IFunctionDeclarationContext
 	FlowStatement() IFlowStatementContext
 	SequenceDeclaration() ISequenceDeclarationContext
 	StageDeclaration() IStageDeclarationContext
+	StructDeclaration() IStructDeclarationContext
+	EnumDeclaration() IEnumDeclarationContext
 	GlobalConstant() IGlobalConstantContext
 
 	// IsTopLevelItemContext differentiates from other interfaces.
@@ -882,6 +992,22 @@ func (s *TopLevelItemContext) AuthorityBlock() IAuthorityBlockContext {
 	return t.(IAuthorityBlockContext)
 }
 
+func (s *TopLevelItemContext) SafetyBlock() ISafetyBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISafetyBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISafetyBlockContext)
+}
+
 func (s *TopLevelItemContext) FunctionDeclaration() IFunctionDeclarationContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -946,6 +1072,38 @@ func (s *TopLevelItemContext) StageDeclaration() IStageDeclarationContext {
 	return t.(IStageDeclarationContext)
 }
 
+func (s *TopLevelItemContext) StructDeclaration() IStructDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructDeclarationContext)
+}
+
+func (s *TopLevelItemContext) EnumDeclaration() IEnumDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEnumDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumDeclarationContext)
+}
+
 func (s *TopLevelItemContext) GlobalConstant() IGlobalConstantContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -995,7 +1153,7 @@ func (s *TopLevelItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 func (p *ArcParser) TopLevelItem() (localctx ITopLevelItemContext) {
 	localctx = NewTopLevelItemContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 2, ArcParserRULE_topLevelItem)
-	p.SetState(173)
+	p.SetState(208)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1005,49 +1163,70 @@ func (p *ArcParser) TopLevelItem() (localctx ITopLevelItemContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(166)
+			p.SetState(198)
 			p.ImportStatement()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(167)
+			p.SetState(199)
 			p.AuthorityBlock()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(168)
-			p.FunctionDeclaration()
+			p.SetState(200)
+			p.SafetyBlock()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(169)
-			p.FlowStatement()
+			p.SetState(201)
+			p.FunctionDeclaration()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(170)
-			p.SequenceDeclaration()
+			p.SetState(202)
+			p.FlowStatement()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(171)
-			p.StageDeclaration()
+			p.SetState(203)
+			p.SequenceDeclaration()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(172)
+			p.SetState(204)
+			p.StageDeclaration()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(205)
+			p.StructDeclaration()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(206)
+			p.EnumDeclaration()
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(207)
 			p.GlobalConstant()
 		}
 
@@ -1206,7 +1385,7 @@ func (p *ArcParser) ImportStatement() (localctx IImportStatementContext) {
 	p.EnterRule(localctx, 4, ArcParserRULE_importStatement)
 	var _la int
 
-	p.SetState(186)
+	p.SetState(221)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1216,7 +1395,7 @@ func (p *ArcParser) ImportStatement() (localctx IImportStatementContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(175)
+			p.SetState(210)
 			p.Match(ArcParserIMPORT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1224,14 +1403,14 @@ func (p *ArcParser) ImportStatement() (localctx IImportStatementContext) {
 			}
 		}
 		{
-			p.SetState(176)
+			p.SetState(211)
 			p.ImportItem()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(177)
+			p.SetState(212)
 			p.Match(ArcParserIMPORT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1239,14 +1418,14 @@ func (p *ArcParser) ImportStatement() (localctx IImportStatementContext) {
 			}
 		}
 		{
-			p.SetState(178)
+			p.SetState(213)
 			p.Match(ArcParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(182)
+		p.SetState(217)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1255,11 +1434,11 @@ func (p *ArcParser) ImportStatement() (localctx IImportStatementContext) {
 
 		for _la == ArcParserAUTHORITY || _la == ArcParserIDENTIFIER {
 			{
-				p.SetState(179)
+				p.SetState(214)
 				p.ImportItem()
 			}
 
-			p.SetState(184)
+			p.SetState(219)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -1267,7 +1446,7 @@ func (p *ArcParser) ImportStatement() (localctx IImportStatementContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(185)
+			p.SetState(220)
 			p.Match(ArcParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1401,10 +1580,10 @@ func (p *ArcParser) ImportItem() (localctx IImportItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(188)
+		p.SetState(223)
 		p.ImportPath()
 	}
-	p.SetState(191)
+	p.SetState(226)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1413,7 +1592,7 @@ func (p *ArcParser) ImportItem() (localctx IImportItemContext) {
 
 	if _la == ArcParserAS {
 		{
-			p.SetState(189)
+			p.SetState(224)
 			p.Match(ArcParserAS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1421,7 +1600,7 @@ func (p *ArcParser) ImportItem() (localctx IImportItemContext) {
 			}
 		}
 		{
-			p.SetState(190)
+			p.SetState(225)
 			p.Match(ArcParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1563,10 +1742,10 @@ func (p *ArcParser) ImportPath() (localctx IImportPathContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(193)
+		p.SetState(228)
 		p.ImportPathHead()
 	}
-	p.SetState(198)
+	p.SetState(233)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1575,7 +1754,7 @@ func (p *ArcParser) ImportPath() (localctx IImportPathContext) {
 
 	for _la == ArcParserDOT {
 		{
-			p.SetState(194)
+			p.SetState(229)
 			p.Match(ArcParserDOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1583,7 +1762,7 @@ func (p *ArcParser) ImportPath() (localctx IImportPathContext) {
 			}
 		}
 		{
-			p.SetState(195)
+			p.SetState(230)
 			p.Match(ArcParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1591,7 +1770,7 @@ func (p *ArcParser) ImportPath() (localctx IImportPathContext) {
 			}
 		}
 
-		p.SetState(200)
+		p.SetState(235)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1704,7 +1883,7 @@ func (p *ArcParser) ImportPathHead() (localctx IImportPathHeadContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(201)
+		p.SetState(236)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == ArcParserAUTHORITY || _la == ArcParserIDENTIFIER) {
@@ -1871,7 +2050,7 @@ func (p *ArcParser) AuthorityBlock() (localctx IAuthorityBlockContext) {
 	p.EnterRule(localctx, 12, ArcParserRULE_authorityBlock)
 	var _la int
 
-	p.SetState(214)
+	p.SetState(249)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1881,7 +2060,7 @@ func (p *ArcParser) AuthorityBlock() (localctx IAuthorityBlockContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(203)
+			p.SetState(238)
 			p.Match(ArcParserAUTHORITY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1889,7 +2068,7 @@ func (p *ArcParser) AuthorityBlock() (localctx IAuthorityBlockContext) {
 			}
 		}
 		{
-			p.SetState(204)
+			p.SetState(239)
 			p.Match(ArcParserINTEGER_LITERAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1900,7 +2079,7 @@ func (p *ArcParser) AuthorityBlock() (localctx IAuthorityBlockContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(205)
+			p.SetState(240)
 			p.Match(ArcParserAUTHORITY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1908,14 +2087,14 @@ func (p *ArcParser) AuthorityBlock() (localctx IAuthorityBlockContext) {
 			}
 		}
 		{
-			p.SetState(206)
+			p.SetState(241)
 			p.Match(ArcParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(210)
+		p.SetState(245)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1924,11 +2103,11 @@ func (p *ArcParser) AuthorityBlock() (localctx IAuthorityBlockContext) {
 
 		for _la == ArcParserINTEGER_LITERAL || _la == ArcParserIDENTIFIER {
 			{
-				p.SetState(207)
+				p.SetState(242)
 				p.AuthorityEntry()
 			}
 
-			p.SetState(212)
+			p.SetState(247)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -1936,7 +2115,7 @@ func (p *ArcParser) AuthorityBlock() (localctx IAuthorityBlockContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(213)
+			p.SetState(248)
 			p.Match(ArcParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2049,7 +2228,7 @@ func (s *AuthorityEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface
 func (p *ArcParser) AuthorityEntry() (localctx IAuthorityEntryContext) {
 	localctx = NewAuthorityEntryContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 14, ArcParserRULE_authorityEntry)
-	p.SetState(219)
+	p.SetState(254)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2059,7 +2238,7 @@ func (p *ArcParser) AuthorityEntry() (localctx IAuthorityEntryContext) {
 	case ArcParserINTEGER_LITERAL:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(216)
+			p.SetState(251)
 			p.Match(ArcParserINTEGER_LITERAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2070,7 +2249,7 @@ func (p *ArcParser) AuthorityEntry() (localctx IAuthorityEntryContext) {
 	case ArcParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(217)
+			p.SetState(252)
 			p.Match(ArcParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2078,7 +2257,7 @@ func (p *ArcParser) AuthorityEntry() (localctx IAuthorityEntryContext) {
 			}
 		}
 		{
-			p.SetState(218)
+			p.SetState(253)
 			p.Match(ArcParserINTEGER_LITERAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2104,113 +2283,91 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFunctionDeclarationContext is an interface to support dynamic dispatch.
-type IFunctionDeclarationContext interface {
+// ISafetyBlockContext is an interface to support dynamic dispatch.
+type ISafetyBlockContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	FUNC() antlr.TerminalNode
-	IDENTIFIER() antlr.TerminalNode
+	SAFETY() antlr.TerminalNode
+	AllSafetyEntry() []ISafetyEntryContext
+	SafetyEntry(i int) ISafetyEntryContext
 	LPAREN() antlr.TerminalNode
 	RPAREN() antlr.TerminalNode
-	Block() IBlockContext
-	ConfigBlock() IConfigBlockContext
-	InputList() IInputListContext
-	OutputType() IOutputTypeContext
 
-	// IsFunctionDeclarationContext differentiates from other interfaces.
-	IsFunctionDeclarationContext()
+	// IsSafetyBlockContext differentiates from other interfaces.
+	IsSafetyBlockContext()
 }
 
-type FunctionDeclarationContext struct {
+type SafetyBlockContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionDeclarationContext() *FunctionDeclarationContext {
-	var p = new(FunctionDeclarationContext)
+func NewEmptySafetyBlockContext() *SafetyBlockContext {
+	var p = new(SafetyBlockContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_functionDeclaration
+	p.RuleIndex = ArcParserRULE_safetyBlock
 	return p
 }
 
-func InitEmptyFunctionDeclarationContext(p *FunctionDeclarationContext) {
+func InitEmptySafetyBlockContext(p *SafetyBlockContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_functionDeclaration
+	p.RuleIndex = ArcParserRULE_safetyBlock
 }
 
-func (*FunctionDeclarationContext) IsFunctionDeclarationContext() {}
+func (*SafetyBlockContext) IsSafetyBlockContext() {}
 
-func NewFunctionDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionDeclarationContext {
-	var p = new(FunctionDeclarationContext)
+func NewSafetyBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SafetyBlockContext {
+	var p = new(SafetyBlockContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_functionDeclaration
+	p.RuleIndex = ArcParserRULE_safetyBlock
 
 	return p
 }
 
-func (s *FunctionDeclarationContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *FunctionDeclarationContext) FUNC() antlr.TerminalNode {
-	return s.GetToken(ArcParserFUNC, 0)
-}
-
-func (s *FunctionDeclarationContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
-}
-
-func (s *FunctionDeclarationContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserLPAREN, 0)
-}
+func (s *SafetyBlockContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionDeclarationContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserRPAREN, 0)
+func (s *SafetyBlockContext) SAFETY() antlr.TerminalNode {
+	return s.GetToken(ArcParserSAFETY, 0)
 }
 
-func (s *FunctionDeclarationContext) Block() IBlockContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBlockContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *SafetyBlockContext) AllSafetyEntry() []ISafetyEntryContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISafetyEntryContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IBlockContext)
-}
-
-func (s *FunctionDeclarationContext) ConfigBlock() IConfigBlockContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConfigBlockContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	tst := make([]ISafetyEntryContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISafetyEntryContext); ok {
+			tst[i] = t.(ISafetyEntryContext)
+			i++
 		}
 	}
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IConfigBlockContext)
+	return tst
 }
 
-func (s *FunctionDeclarationContext) InputList() IInputListContext {
+func (s *SafetyBlockContext) SafetyEntry(i int) ISafetyEntryContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IInputListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ISafetyEntryContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -2218,141 +2375,126 @@ func (s *FunctionDeclarationContext) InputList() IInputListContext {
 		return nil
 	}
 
-	return t.(IInputListContext)
+	return t.(ISafetyEntryContext)
 }
 
-func (s *FunctionDeclarationContext) OutputType() IOutputTypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOutputTypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *SafetyBlockContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserLPAREN, 0)
+}
 
-	return t.(IOutputTypeContext)
+func (s *SafetyBlockContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserRPAREN, 0)
 }
 
-func (s *FunctionDeclarationContext) GetRuleContext() antlr.RuleContext {
+func (s *SafetyBlockContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SafetyBlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SafetyBlockContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterFunctionDeclaration(s)
+		listenerT.EnterSafetyBlock(s)
 	}
 }
 
-func (s *FunctionDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SafetyBlockContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitFunctionDeclaration(s)
+		listenerT.ExitSafetyBlock(s)
 	}
 }
 
-func (s *FunctionDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SafetyBlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitFunctionDeclaration(s)
+		return t.VisitSafetyBlock(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) FunctionDeclaration() (localctx IFunctionDeclarationContext) {
-	localctx = NewFunctionDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, ArcParserRULE_functionDeclaration)
+func (p *ArcParser) SafetyBlock() (localctx ISafetyBlockContext) {
+	localctx = NewSafetyBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, ArcParserRULE_safetyBlock)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(221)
-		p.Match(ArcParserFUNC)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(222)
-		p.Match(ArcParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(224)
+	p.SetState(267)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserLBRACE {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 10, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(223)
-			p.ConfigBlock()
+			p.SetState(256)
+			p.Match(ArcParserSAFETY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-
-	}
-	{
-		p.SetState(226)
-		p.Match(ArcParserLPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		{
+			p.SetState(257)
+			p.SafetyEntry()
 		}
-	}
-	p.SetState(228)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserIDENTIFIER {
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(227)
-			p.InputList()
+			p.SetState(258)
+			p.Match(ArcParserSAFETY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-
-	}
-	{
-		p.SetState(230)
-		p.Match(ArcParserRPAREN)
+		{
+			p.SetState(259)
+			p.Match(ArcParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(263)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
-	}
-	p.SetState(232)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if (int64((_la-13)) & ^0x3f) == 0 && ((int64(1)<<(_la-13))&4504149383200765) != 0 {
-		{
-			p.SetState(231)
-			p.OutputType()
-		}
+		_la = p.GetTokenStream().LA(1)
 
-	}
-	{
-		p.SetState(234)
-		p.Block()
-	}
+		for _la == ArcParserIDENTIFIER {
+			{
+				p.SetState(260)
+				p.SafetyEntry()
+			}
 
-errorExit:
+			p.SetState(265)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(266)
+			p.Match(ArcParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
 	if p.HasError() {
 		v := p.GetError()
 		localctx.SetException(v)
@@ -2365,86 +2507,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IInputListContext is an interface to support dynamic dispatch.
-type IInputListContext interface {
+// ISafetyEntryContext is an interface to support dynamic dispatch.
+type ISafetyEntryContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllInput() []IInputContext
-	Input(i int) IInputContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	ConfigValues() IConfigValuesContext
 
-	// IsInputListContext differentiates from other interfaces.
-	IsInputListContext()
+	// IsSafetyEntryContext differentiates from other interfaces.
+	IsSafetyEntryContext()
 }
 
-type InputListContext struct {
+type SafetyEntryContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyInputListContext() *InputListContext {
-	var p = new(InputListContext)
+func NewEmptySafetyEntryContext() *SafetyEntryContext {
+	var p = new(SafetyEntryContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_inputList
+	p.RuleIndex = ArcParserRULE_safetyEntry
 	return p
 }
 
-func InitEmptyInputListContext(p *InputListContext) {
+func InitEmptySafetyEntryContext(p *SafetyEntryContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_inputList
+	p.RuleIndex = ArcParserRULE_safetyEntry
 }
 
-func (*InputListContext) IsInputListContext() {}
+func (*SafetyEntryContext) IsSafetyEntryContext() {}
 
-func NewInputListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InputListContext {
-	var p = new(InputListContext)
+func NewSafetyEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SafetyEntryContext {
+	var p = new(SafetyEntryContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_inputList
+	p.RuleIndex = ArcParserRULE_safetyEntry
 
 	return p
 }
 
-func (s *InputListContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *InputListContext) AllInput() []IInputContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IInputContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IInputContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IInputContext); ok {
-			tst[i] = t.(IInputContext)
-			i++
-		}
-	}
+func (s *SafetyEntryContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *SafetyEntryContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *InputListContext) Input(i int) IInputContext {
+func (s *SafetyEntryContext) ConfigValues() IConfigValuesContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IInputContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IConfigValuesContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -2452,111 +2571,54 @@ func (s *InputListContext) Input(i int) IInputContext {
 		return nil
 	}
 
-	return t.(IInputContext)
-}
-
-func (s *InputListContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserCOMMA)
-}
-
-func (s *InputListContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserCOMMA, i)
+	return t.(IConfigValuesContext)
 }
 
-func (s *InputListContext) GetRuleContext() antlr.RuleContext {
+func (s *SafetyEntryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InputListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SafetyEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InputListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SafetyEntryContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterInputList(s)
+		listenerT.EnterSafetyEntry(s)
 	}
 }
 
-func (s *InputListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SafetyEntryContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitInputList(s)
+		listenerT.ExitSafetyEntry(s)
 	}
 }
 
-func (s *InputListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SafetyEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitInputList(s)
+		return t.VisitSafetyEntry(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) InputList() (localctx IInputListContext) {
-	localctx = NewInputListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, ArcParserRULE_inputList)
-	var _la int
-
-	var _alt int
-
+func (p *ArcParser) SafetyEntry() (localctx ISafetyEntryContext) {
+	localctx = NewSafetyEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, ArcParserRULE_safetyEntry)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(236)
-		p.Input()
-	}
-	p.SetState(241)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(237)
-				p.Match(ArcParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(238)
-				p.Input()
-			}
-
-		}
-		p.SetState(243)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext())
+		p.SetState(269)
+		p.Match(ArcParserIDENTIFIER)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(245)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == ArcParserCOMMA {
-		{
-			p.SetState(244)
-			p.Match(ArcParserCOMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
+	{
+		p.SetState(270)
+		p.ConfigValues()
 	}
 
 errorExit:
@@ -2572,63 +2634,79 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IInputContext is an interface to support dynamic dispatch.
-type IInputContext interface {
+// IFunctionDeclarationContext is an interface to support dynamic dispatch.
+type IFunctionDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
+	FUNC() antlr.TerminalNode
 	IDENTIFIER() antlr.TerminalNode
-	Type_() ITypeContext
-	ASSIGN() antlr.TerminalNode
-	Literal() ILiteralContext
+	LPAREN() antlr.TerminalNode
+	RPAREN() antlr.TerminalNode
+	Block() IBlockContext
+	ConfigBlock() IConfigBlockContext
+	InputList() IInputListContext
+	OutputType() IOutputTypeContext
 
-	// IsInputContext differentiates from other interfaces.
-	IsInputContext()
+	// IsFunctionDeclarationContext differentiates from other interfaces.
+	IsFunctionDeclarationContext()
 }
 
-type InputContext struct {
+type FunctionDeclarationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyInputContext() *InputContext {
-	var p = new(InputContext)
+func NewEmptyFunctionDeclarationContext() *FunctionDeclarationContext {
+	var p = new(FunctionDeclarationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_input
+	p.RuleIndex = ArcParserRULE_functionDeclaration
 	return p
 }
 
-func InitEmptyInputContext(p *InputContext) {
+func InitEmptyFunctionDeclarationContext(p *FunctionDeclarationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_input
+	p.RuleIndex = ArcParserRULE_functionDeclaration
 }
 
-func (*InputContext) IsInputContext() {}
+func (*FunctionDeclarationContext) IsFunctionDeclarationContext() {}
 
-func NewInputContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InputContext {
-	var p = new(InputContext)
+func NewFunctionDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionDeclarationContext {
+	var p = new(FunctionDeclarationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_input
+	p.RuleIndex = ArcParserRULE_functionDeclaration
 
 	return p
 }
 
-func (s *InputContext) GetParser() antlr.Parser { return s.parser }
+func (s *FunctionDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *InputContext) IDENTIFIER() antlr.TerminalNode {
+func (s *FunctionDeclarationContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(ArcParserFUNC, 0)
+}
+
+func (s *FunctionDeclarationContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *InputContext) Type_() ITypeContext {
+func (s *FunctionDeclarationContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserLPAREN, 0)
+}
+
+func (s *FunctionDeclarationContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserRPAREN, 0)
+}
+
+func (s *FunctionDeclarationContext) Block() IBlockContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeContext); ok {
+		if _, ok := ctx.(IBlockContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2638,17 +2716,29 @@ func (s *InputContext) Type_() ITypeContext {
 		return nil
 	}
 
-	return t.(ITypeContext)
+	return t.(IBlockContext)
 }
 
-func (s *InputContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(ArcParserASSIGN, 0)
+func (s *FunctionDeclarationContext) ConfigBlock() IConfigBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConfigBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConfigBlockContext)
 }
 
-func (s *InputContext) Literal() ILiteralContext {
+func (s *FunctionDeclarationContext) InputList() IInputListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILiteralContext); ok {
+		if _, ok := ctx.(IInputListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2658,79 +2748,139 @@ func (s *InputContext) Literal() ILiteralContext {
 		return nil
 	}
 
-	return t.(ILiteralContext)
+	return t.(IInputListContext)
 }
 
-func (s *InputContext) GetRuleContext() antlr.RuleContext {
+func (s *FunctionDeclarationContext) OutputType() IOutputTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOutputTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOutputTypeContext)
+}
+
+func (s *FunctionDeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InputContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FunctionDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InputContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FunctionDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterInput(s)
+		listenerT.EnterFunctionDeclaration(s)
 	}
 }
 
-func (s *InputContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FunctionDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitInput(s)
+		listenerT.ExitFunctionDeclaration(s)
 	}
 }
 
-func (s *InputContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FunctionDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitInput(s)
+		return t.VisitFunctionDeclaration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Input() (localctx IInputContext) {
-	localctx = NewInputContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, ArcParserRULE_input)
+func (p *ArcParser) FunctionDeclaration() (localctx IFunctionDeclarationContext) {
+	localctx = NewFunctionDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, ArcParserRULE_functionDeclaration)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(247)
+		p.SetState(272)
+		p.Match(ArcParserFUNC)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(273)
 		p.Match(ArcParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(275)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == ArcParserLBRACE {
+		{
+			p.SetState(274)
+			p.ConfigBlock()
+		}
+
+	}
 	{
-		p.SetState(248)
-		p.Type_()
+		p.SetState(277)
+		p.Match(ArcParserLPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-	p.SetState(251)
+	p.SetState(279)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserASSIGN {
+	if _la == ArcParserIDENTIFIER {
 		{
-			p.SetState(249)
-			p.Match(ArcParserASSIGN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(278)
+			p.InputList()
+		}
+
+	}
+	{
+		p.SetState(281)
+		p.Match(ArcParserRPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
+	}
+	p.SetState(283)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64((_la-14)) & ^0x3f) == 0 && ((int64(1)<<(_la-14))&36033195065606113) != 0 {
 		{
-			p.SetState(250)
-			p.Literal()
+			p.SetState(282)
+			p.OutputType()
 		}
 
 	}
+	{
+		p.SetState(285)
+		p.Block()
+	}
 
 errorExit:
 	if p.HasError() {
@@ -2745,80 +2895,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOutputTypeContext is an interface to support dynamic dispatch.
-type IOutputTypeContext interface {
+// IInputListContext is an interface to support dynamic dispatch.
+type IInputListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Type_() ITypeContext
-	IDENTIFIER() antlr.TerminalNode
-	MultiOutputBlock() IMultiOutputBlockContext
+	AllInput() []IInputContext
+	Input(i int) IInputContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsOutputTypeContext differentiates from other interfaces.
-	IsOutputTypeContext()
+	// IsInputListContext differentiates from other interfaces.
+	IsInputListContext()
 }
 
-type OutputTypeContext struct {
+type InputListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOutputTypeContext() *OutputTypeContext {
-	var p = new(OutputTypeContext)
+func NewEmptyInputListContext() *InputListContext {
+	var p = new(InputListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_outputType
+	p.RuleIndex = ArcParserRULE_inputList
 	return p
 }
 
-func InitEmptyOutputTypeContext(p *OutputTypeContext) {
+func InitEmptyInputListContext(p *InputListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_outputType
+	p.RuleIndex = ArcParserRULE_inputList
 }
 
-func (*OutputTypeContext) IsOutputTypeContext() {}
+func (*InputListContext) IsInputListContext() {}
 
-func NewOutputTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OutputTypeContext {
-	var p = new(OutputTypeContext)
+func NewInputListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InputListContext {
+	var p = new(InputListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_outputType
+	p.RuleIndex = ArcParserRULE_inputList
 
 	return p
 }
 
-func (s *OutputTypeContext) GetParser() antlr.Parser { return s.parser }
+func (s *InputListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OutputTypeContext) Type_() ITypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *InputListContext) AllInput() []IInputContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IInputContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IInputContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IInputContext); ok {
+			tst[i] = t.(IInputContext)
+			i++
+		}
 	}
 
-	return t.(ITypeContext)
-}
-
-func (s *OutputTypeContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
+	return tst
 }
 
-func (s *OutputTypeContext) MultiOutputBlock() IMultiOutputBlockContext {
+func (s *InputListContext) Input(i int) IInputContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMultiOutputBlockContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IInputContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -2826,81 +2982,111 @@ func (s *OutputTypeContext) MultiOutputBlock() IMultiOutputBlockContext {
 		return nil
 	}
 
-	return t.(IMultiOutputBlockContext)
+	return t.(IInputContext)
 }
 
-func (s *OutputTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *InputListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
+}
+
+func (s *InputListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
+}
+
+func (s *InputListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OutputTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *InputListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OutputTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InputListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterOutputType(s)
+		listenerT.EnterInputList(s)
 	}
 }
 
-func (s *OutputTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InputListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitOutputType(s)
+		listenerT.ExitInputList(s)
 	}
 }
 
-func (s *OutputTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *InputListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitOutputType(s)
+		return t.VisitInputList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) OutputType() (localctx IOutputTypeContext) {
-	localctx = NewOutputTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, ArcParserRULE_outputType)
-	p.SetState(257)
+func (p *ArcParser) InputList() (localctx IInputListContext) {
+	localctx = NewInputListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, ArcParserRULE_inputList)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(287)
+		p.Input()
+	}
+	p.SetState(292)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(288)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(289)
+				p.Input()
+			}
 
-	switch p.GetTokenStream().LA(1) {
-	case ArcParserCHAN, ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64, ArcParserF32, ArcParserF64, ArcParserSTR, ArcParserSERIES:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(253)
-			p.Type_()
 		}
+		p.SetState(294)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(296)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-	case ArcParserIDENTIFIER:
-		p.EnterOuterAlt(localctx, 2)
+	if _la == ArcParserCOMMA {
 		{
-			p.SetState(254)
-			p.Match(ArcParserIDENTIFIER)
+			p.SetState(295)
+			p.Match(ArcParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		{
-			p.SetState(255)
-			p.Type_()
-		}
-
-	case ArcParserLPAREN:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(256)
-			p.MultiOutputBlock()
-		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -2916,92 +3102,85 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMultiOutputBlockContext is an interface to support dynamic dispatch.
-type IMultiOutputBlockContext interface {
+// IInputContext is an interface to support dynamic dispatch.
+type IInputContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LPAREN() antlr.TerminalNode
-	AllNamedOutput() []INamedOutputContext
-	NamedOutput(i int) INamedOutputContext
-	RPAREN() antlr.TerminalNode
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	Type_() ITypeContext
+	ASSIGN() antlr.TerminalNode
+	Literal() ILiteralContext
 
-	// IsMultiOutputBlockContext differentiates from other interfaces.
-	IsMultiOutputBlockContext()
+	// IsInputContext differentiates from other interfaces.
+	IsInputContext()
 }
 
-type MultiOutputBlockContext struct {
+type InputContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMultiOutputBlockContext() *MultiOutputBlockContext {
-	var p = new(MultiOutputBlockContext)
+func NewEmptyInputContext() *InputContext {
+	var p = new(InputContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_multiOutputBlock
+	p.RuleIndex = ArcParserRULE_input
 	return p
 }
 
-func InitEmptyMultiOutputBlockContext(p *MultiOutputBlockContext) {
+func InitEmptyInputContext(p *InputContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_multiOutputBlock
+	p.RuleIndex = ArcParserRULE_input
 }
 
-func (*MultiOutputBlockContext) IsMultiOutputBlockContext() {}
+func (*InputContext) IsInputContext() {}
 
-func NewMultiOutputBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultiOutputBlockContext {
-	var p = new(MultiOutputBlockContext)
+func NewInputContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InputContext {
+	var p = new(InputContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_multiOutputBlock
+	p.RuleIndex = ArcParserRULE_input
 
 	return p
 }
 
-func (s *MultiOutputBlockContext) GetParser() antlr.Parser { return s.parser }
+func (s *InputContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MultiOutputBlockContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserLPAREN, 0)
+func (s *InputContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *MultiOutputBlockContext) AllNamedOutput() []INamedOutputContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(INamedOutputContext); ok {
-			len++
+func (s *InputContext) Type_() ITypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]INamedOutputContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(INamedOutputContext); ok {
-			tst[i] = t.(INamedOutputContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(ITypeContext)
 }
 
-func (s *MultiOutputBlockContext) NamedOutput(i int) INamedOutputContext {
+func (s *InputContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserASSIGN, 0)
+}
+
+func (s *InputContext) Literal() ILiteralContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamedOutputContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ILiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -3009,131 +3188,78 @@ func (s *MultiOutputBlockContext) NamedOutput(i int) INamedOutputContext {
 		return nil
 	}
 
-	return t.(INamedOutputContext)
-}
-
-func (s *MultiOutputBlockContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserRPAREN, 0)
-}
-
-func (s *MultiOutputBlockContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserCOMMA)
-}
-
-func (s *MultiOutputBlockContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserCOMMA, i)
+	return t.(ILiteralContext)
 }
 
-func (s *MultiOutputBlockContext) GetRuleContext() antlr.RuleContext {
+func (s *InputContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MultiOutputBlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *InputContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MultiOutputBlockContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InputContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterMultiOutputBlock(s)
+		listenerT.EnterInput(s)
 	}
 }
 
-func (s *MultiOutputBlockContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InputContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitMultiOutputBlock(s)
+		listenerT.ExitInput(s)
 	}
 }
 
-func (s *MultiOutputBlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *InputContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitMultiOutputBlock(s)
+		return t.VisitInput(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) MultiOutputBlock() (localctx IMultiOutputBlockContext) {
-	localctx = NewMultiOutputBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, ArcParserRULE_multiOutputBlock)
+func (p *ArcParser) Input() (localctx IInputContext) {
+	localctx = NewInputContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, ArcParserRULE_input)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(259)
-		p.Match(ArcParserLPAREN)
+		p.SetState(298)
+		p.Match(ArcParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(260)
-		p.NamedOutput()
-	}
-	p.SetState(265)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(261)
-				p.Match(ArcParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(262)
-				p.NamedOutput()
-			}
-
-		}
-		p.SetState(267)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
+		p.SetState(299)
+		p.Type_()
 	}
-	p.SetState(269)
+	p.SetState(302)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserCOMMA {
+	if _la == ArcParserASSIGN {
 		{
-			p.SetState(268)
-			p.Match(ArcParserCOMMA)
+			p.SetState(300)
+			p.Match(ArcParserASSIGN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	}
-	{
-		p.SetState(271)
-		p.Match(ArcParserRPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		{
+			p.SetState(301)
+			p.Literal()
 		}
+
 	}
 
 errorExit:
@@ -3149,61 +3275,78 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INamedOutputContext is an interface to support dynamic dispatch.
-type INamedOutputContext interface {
+// IOutputTypeContext is an interface to support dynamic dispatch.
+type IOutputTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
 	Type_() ITypeContext
+	IDENTIFIER() antlr.TerminalNode
+	MultiOutputBlock() IMultiOutputBlockContext
 
-	// IsNamedOutputContext differentiates from other interfaces.
-	IsNamedOutputContext()
+	// IsOutputTypeContext differentiates from other interfaces.
+	IsOutputTypeContext()
 }
 
-type NamedOutputContext struct {
+type OutputTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNamedOutputContext() *NamedOutputContext {
-	var p = new(NamedOutputContext)
+func NewEmptyOutputTypeContext() *OutputTypeContext {
+	var p = new(OutputTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_namedOutput
+	p.RuleIndex = ArcParserRULE_outputType
 	return p
 }
 
-func InitEmptyNamedOutputContext(p *NamedOutputContext) {
+func InitEmptyOutputTypeContext(p *OutputTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_namedOutput
+	p.RuleIndex = ArcParserRULE_outputType
 }
 
-func (*NamedOutputContext) IsNamedOutputContext() {}
+func (*OutputTypeContext) IsOutputTypeContext() {}
 
-func NewNamedOutputContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamedOutputContext {
-	var p = new(NamedOutputContext)
+func NewOutputTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OutputTypeContext {
+	var p = new(OutputTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_namedOutput
+	p.RuleIndex = ArcParserRULE_outputType
 
 	return p
 }
 
-func (s *NamedOutputContext) GetParser() antlr.Parser { return s.parser }
+func (s *OutputTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NamedOutputContext) IDENTIFIER() antlr.TerminalNode {
+func (s *OutputTypeContext) Type_() ITypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeContext)
+}
+
+func (s *OutputTypeContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *NamedOutputContext) Type_() ITypeContext {
+func (s *OutputTypeContext) MultiOutputBlock() IMultiOutputBlockContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeContext); ok {
+		if _, ok := ctx.(IMultiOutputBlockContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3213,54 +3356,80 @@ func (s *NamedOutputContext) Type_() ITypeContext {
 		return nil
 	}
 
-	return t.(ITypeContext)
+	return t.(IMultiOutputBlockContext)
 }
 
-func (s *NamedOutputContext) GetRuleContext() antlr.RuleContext {
+func (s *OutputTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NamedOutputContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OutputTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NamedOutputContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OutputTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterNamedOutput(s)
+		listenerT.EnterOutputType(s)
 	}
 }
 
-func (s *NamedOutputContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OutputTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitNamedOutput(s)
+		listenerT.ExitOutputType(s)
 	}
 }
 
-func (s *NamedOutputContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OutputTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitNamedOutput(s)
+		return t.VisitOutputType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) NamedOutput() (localctx INamedOutputContext) {
-	localctx = NewNamedOutputContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, ArcParserRULE_namedOutput)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(273)
-		p.Match(ArcParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+func (p *ArcParser) OutputType() (localctx IOutputTypeContext) {
+	localctx = NewOutputTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, ArcParserRULE_outputType)
+	p.SetState(308)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	{
-		p.SetState(274)
-		p.Type_()
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(304)
+			p.Type_()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(305)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(306)
+			p.Type_()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(307)
+			p.MultiOutputBlock()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -3276,68 +3445,92 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConfigBlockContext is an interface to support dynamic dispatch.
-type IConfigBlockContext interface {
+// IMultiOutputBlockContext is an interface to support dynamic dispatch.
+type IMultiOutputBlockContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LBRACE() antlr.TerminalNode
-	RBRACE() antlr.TerminalNode
-	ConfigList() IConfigListContext
+	LPAREN() antlr.TerminalNode
+	AllNamedOutput() []INamedOutputContext
+	NamedOutput(i int) INamedOutputContext
+	RPAREN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsConfigBlockContext differentiates from other interfaces.
-	IsConfigBlockContext()
+	// IsMultiOutputBlockContext differentiates from other interfaces.
+	IsMultiOutputBlockContext()
 }
 
-type ConfigBlockContext struct {
+type MultiOutputBlockContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConfigBlockContext() *ConfigBlockContext {
-	var p = new(ConfigBlockContext)
+func NewEmptyMultiOutputBlockContext() *MultiOutputBlockContext {
+	var p = new(MultiOutputBlockContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_configBlock
+	p.RuleIndex = ArcParserRULE_multiOutputBlock
 	return p
 }
 
-func InitEmptyConfigBlockContext(p *ConfigBlockContext) {
+func InitEmptyMultiOutputBlockContext(p *MultiOutputBlockContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_configBlock
+	p.RuleIndex = ArcParserRULE_multiOutputBlock
 }
 
-func (*ConfigBlockContext) IsConfigBlockContext() {}
+func (*MultiOutputBlockContext) IsMultiOutputBlockContext() {}
 
-func NewConfigBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConfigBlockContext {
-	var p = new(ConfigBlockContext)
+func NewMultiOutputBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultiOutputBlockContext {
+	var p = new(MultiOutputBlockContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_configBlock
+	p.RuleIndex = ArcParserRULE_multiOutputBlock
 
 	return p
 }
 
-func (s *ConfigBlockContext) GetParser() antlr.Parser { return s.parser }
+func (s *MultiOutputBlockContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ConfigBlockContext) LBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserLBRACE, 0)
+func (s *MultiOutputBlockContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserLPAREN, 0)
 }
 
-func (s *ConfigBlockContext) RBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserRBRACE, 0)
+func (s *MultiOutputBlockContext) AllNamedOutput() []INamedOutputContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INamedOutputContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INamedOutputContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INamedOutputContext); ok {
+			tst[i] = t.(INamedOutputContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *ConfigBlockContext) ConfigList() IConfigListContext {
+func (s *MultiOutputBlockContext) NamedOutput(i int) INamedOutputContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConfigListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(INamedOutputContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -3345,70 +3538,127 @@ func (s *ConfigBlockContext) ConfigList() IConfigListContext {
 		return nil
 	}
 
-	return t.(IConfigListContext)
+	return t.(INamedOutputContext)
 }
 
-func (s *ConfigBlockContext) GetRuleContext() antlr.RuleContext {
+func (s *MultiOutputBlockContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserRPAREN, 0)
+}
+
+func (s *MultiOutputBlockContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
+}
+
+func (s *MultiOutputBlockContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
+}
+
+func (s *MultiOutputBlockContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConfigBlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MultiOutputBlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConfigBlockContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MultiOutputBlockContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterConfigBlock(s)
+		listenerT.EnterMultiOutputBlock(s)
 	}
 }
 
-func (s *ConfigBlockContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MultiOutputBlockContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitConfigBlock(s)
+		listenerT.ExitMultiOutputBlock(s)
 	}
 }
 
-func (s *ConfigBlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MultiOutputBlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitConfigBlock(s)
+		return t.VisitMultiOutputBlock(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ConfigBlock() (localctx IConfigBlockContext) {
-	localctx = NewConfigBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, ArcParserRULE_configBlock)
+func (p *ArcParser) MultiOutputBlock() (localctx IMultiOutputBlockContext) {
+	localctx = NewMultiOutputBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, ArcParserRULE_multiOutputBlock)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(276)
-		p.Match(ArcParserLBRACE)
+		p.SetState(310)
+		p.Match(ArcParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(278)
+	{
+		p.SetState(311)
+		p.NamedOutput()
+	}
+	p.SetState(316)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 18, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(312)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(313)
+				p.NamedOutput()
+			}
+
+		}
+		p.SetState(318)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 18, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(320)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserIDENTIFIER {
+	if _la == ArcParserCOMMA {
 		{
-			p.SetState(277)
-			p.ConfigList()
+			p.SetState(319)
+			p.Match(ArcParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
 	}
 	{
-		p.SetState(280)
-		p.Match(ArcParserRBRACE)
+		p.SetState(322)
+		p.Match(ArcParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -3428,86 +3678,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConfigListContext is an interface to support dynamic dispatch.
-type IConfigListContext interface {
+// INamedOutputContext is an interface to support dynamic dispatch.
+type INamedOutputContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllConfig() []IConfigContext
-	Config(i int) IConfigContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	Type_() ITypeContext
 
-	// IsConfigListContext differentiates from other interfaces.
-	IsConfigListContext()
+	// IsNamedOutputContext differentiates from other interfaces.
+	IsNamedOutputContext()
 }
 
-type ConfigListContext struct {
+type NamedOutputContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConfigListContext() *ConfigListContext {
-	var p = new(ConfigListContext)
+func NewEmptyNamedOutputContext() *NamedOutputContext {
+	var p = new(NamedOutputContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_configList
+	p.RuleIndex = ArcParserRULE_namedOutput
 	return p
 }
 
-func InitEmptyConfigListContext(p *ConfigListContext) {
+func InitEmptyNamedOutputContext(p *NamedOutputContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_configList
+	p.RuleIndex = ArcParserRULE_namedOutput
 }
 
-func (*ConfigListContext) IsConfigListContext() {}
+func (*NamedOutputContext) IsNamedOutputContext() {}
 
-func NewConfigListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConfigListContext {
-	var p = new(ConfigListContext)
+func NewNamedOutputContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamedOutputContext {
+	var p = new(NamedOutputContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_configList
+	p.RuleIndex = ArcParserRULE_namedOutput
 
 	return p
 }
 
-func (s *ConfigListContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ConfigListContext) AllConfig() []IConfigContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IConfigContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IConfigContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IConfigContext); ok {
-			tst[i] = t.(IConfigContext)
-			i++
-		}
-	}
+func (s *NamedOutputContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *NamedOutputContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *ConfigListContext) Config(i int) IConfigContext {
+func (s *NamedOutputContext) Type_() ITypeContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConfigContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -3515,111 +3742,54 @@ func (s *ConfigListContext) Config(i int) IConfigContext {
 		return nil
 	}
 
-	return t.(IConfigContext)
-}
-
-func (s *ConfigListContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserCOMMA)
-}
-
-func (s *ConfigListContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserCOMMA, i)
+	return t.(ITypeContext)
 }
 
-func (s *ConfigListContext) GetRuleContext() antlr.RuleContext {
+func (s *NamedOutputContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConfigListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NamedOutputContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConfigListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NamedOutputContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterConfigList(s)
+		listenerT.EnterNamedOutput(s)
 	}
 }
 
-func (s *ConfigListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NamedOutputContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitConfigList(s)
+		listenerT.ExitNamedOutput(s)
 	}
 }
 
-func (s *ConfigListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NamedOutputContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitConfigList(s)
+		return t.VisitNamedOutput(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ConfigList() (localctx IConfigListContext) {
-	localctx = NewConfigListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, ArcParserRULE_configList)
-	var _la int
-
-	var _alt int
-
+func (p *ArcParser) NamedOutput() (localctx INamedOutputContext) {
+	localctx = NewNamedOutputContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, ArcParserRULE_namedOutput)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(282)
-		p.Config()
-	}
-	p.SetState(287)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 19, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(283)
-				p.Match(ArcParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(284)
-				p.Config()
-			}
-
-		}
-		p.SetState(289)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 19, p.GetParserRuleContext())
+		p.SetState(324)
+		p.Match(ArcParserIDENTIFIER)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(291)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == ArcParserCOMMA {
-		{
-			p.SetState(290)
-			p.Match(ArcParserCOMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
+	{
+		p.SetState(325)
+		p.Type_()
 	}
 
 errorExit:
@@ -3635,83 +3805,66 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConfigContext is an interface to support dynamic dispatch.
-type IConfigContext interface {
+// IConfigBlockContext is an interface to support dynamic dispatch.
+type IConfigBlockContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	Type_() ITypeContext
-	ASSIGN() antlr.TerminalNode
-	Literal() ILiteralContext
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	ConfigList() IConfigListContext
 
-	// IsConfigContext differentiates from other interfaces.
-	IsConfigContext()
+	// IsConfigBlockContext differentiates from other interfaces.
+	IsConfigBlockContext()
 }
 
-type ConfigContext struct {
+type ConfigBlockContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConfigContext() *ConfigContext {
-	var p = new(ConfigContext)
+func NewEmptyConfigBlockContext() *ConfigBlockContext {
+	var p = new(ConfigBlockContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_config
+	p.RuleIndex = ArcParserRULE_configBlock
 	return p
 }
 
-func InitEmptyConfigContext(p *ConfigContext) {
+func InitEmptyConfigBlockContext(p *ConfigBlockContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_config
+	p.RuleIndex = ArcParserRULE_configBlock
 }
 
-func (*ConfigContext) IsConfigContext() {}
+func (*ConfigBlockContext) IsConfigBlockContext() {}
 
-func NewConfigContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConfigContext {
-	var p = new(ConfigContext)
+func NewConfigBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConfigBlockContext {
+	var p = new(ConfigBlockContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_config
+	p.RuleIndex = ArcParserRULE_configBlock
 
 	return p
 }
 
-func (s *ConfigContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ConfigContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
-}
-
-func (s *ConfigContext) Type_() ITypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ConfigBlockContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(ITypeContext)
+func (s *ConfigBlockContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
 }
 
-func (s *ConfigContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(ArcParserASSIGN, 0)
+func (s *ConfigBlockContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
 }
 
-func (s *ConfigContext) Literal() ILiteralContext {
+func (s *ConfigBlockContext) ConfigList() IConfigListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILiteralContext); ok {
+		if _, ok := ctx.(IConfigListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3721,79 +3874,75 @@ func (s *ConfigContext) Literal() ILiteralContext {
 		return nil
 	}
 
-	return t.(ILiteralContext)
+	return t.(IConfigListContext)
 }
 
-func (s *ConfigContext) GetRuleContext() antlr.RuleContext {
+func (s *ConfigBlockContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConfigContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConfigBlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConfigContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConfigBlockContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterConfig(s)
+		listenerT.EnterConfigBlock(s)
 	}
 }
 
-func (s *ConfigContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConfigBlockContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitConfig(s)
+		listenerT.ExitConfigBlock(s)
 	}
 }
 
-func (s *ConfigContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ConfigBlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitConfig(s)
+		return t.VisitConfigBlock(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Config() (localctx IConfigContext) {
-	localctx = NewConfigContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, ArcParserRULE_config)
+func (p *ArcParser) ConfigBlock() (localctx IConfigBlockContext) {
+	localctx = NewConfigBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, ArcParserRULE_configBlock)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(293)
-		p.Match(ArcParserIDENTIFIER)
+		p.SetState(327)
+		p.Match(ArcParserLBRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(294)
-		p.Type_()
-	}
-	p.SetState(297)
+	p.SetState(329)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserASSIGN {
-		{
-			p.SetState(295)
-			p.Match(ArcParserASSIGN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	if _la == ArcParserIDENTIFIER {
 		{
-			p.SetState(296)
-			p.Literal()
+			p.SetState(328)
+			p.ConfigList()
 		}
 
 	}
+	{
+		p.SetState(331)
+		p.Match(ArcParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
 
 errorExit:
 	if p.HasError() {
@@ -3808,89 +3957,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISequenceDeclarationContext is an interface to support dynamic dispatch.
-type ISequenceDeclarationContext interface {
+// IConfigListContext is an interface to support dynamic dispatch.
+type IConfigListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SEQUENCE() antlr.TerminalNode
-	LBRACE() antlr.TerminalNode
-	RBRACE() antlr.TerminalNode
-	IDENTIFIER() antlr.TerminalNode
-	AllSequenceItem() []ISequenceItemContext
-	SequenceItem(i int) ISequenceItemContext
+	AllConfig() []IConfigContext
+	Config(i int) IConfigContext
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
-	// IsSequenceDeclarationContext differentiates from other interfaces.
-	IsSequenceDeclarationContext()
+	// IsConfigListContext differentiates from other interfaces.
+	IsConfigListContext()
 }
 
-type SequenceDeclarationContext struct {
+type ConfigListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySequenceDeclarationContext() *SequenceDeclarationContext {
-	var p = new(SequenceDeclarationContext)
+func NewEmptyConfigListContext() *ConfigListContext {
+	var p = new(ConfigListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_sequenceDeclaration
+	p.RuleIndex = ArcParserRULE_configList
 	return p
 }
 
-func InitEmptySequenceDeclarationContext(p *SequenceDeclarationContext) {
+func InitEmptyConfigListContext(p *ConfigListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_sequenceDeclaration
+	p.RuleIndex = ArcParserRULE_configList
 }
 
-func (*SequenceDeclarationContext) IsSequenceDeclarationContext() {}
+func (*ConfigListContext) IsConfigListContext() {}
 
-func NewSequenceDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SequenceDeclarationContext {
-	var p = new(SequenceDeclarationContext)
+func NewConfigListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConfigListContext {
+	var p = new(ConfigListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_sequenceDeclaration
+	p.RuleIndex = ArcParserRULE_configList
 
 	return p
 }
 
-func (s *SequenceDeclarationContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SequenceDeclarationContext) SEQUENCE() antlr.TerminalNode {
-	return s.GetToken(ArcParserSEQUENCE, 0)
-}
-
-func (s *SequenceDeclarationContext) LBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserLBRACE, 0)
-}
-
-func (s *SequenceDeclarationContext) RBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserRBRACE, 0)
-}
-
-func (s *SequenceDeclarationContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
-}
+func (s *ConfigListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SequenceDeclarationContext) AllSequenceItem() []ISequenceItemContext {
+func (s *ConfigListContext) AllConfig() []IConfigContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ISequenceItemContext); ok {
+		if _, ok := ctx.(IConfigContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ISequenceItemContext, len)
+	tst := make([]IConfigContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ISequenceItemContext); ok {
-			tst[i] = t.(ISequenceItemContext)
+		if t, ok := ctx.(IConfigContext); ok {
+			tst[i] = t.(IConfigContext)
 			i++
 		}
 	}
@@ -3898,11 +4027,11 @@ func (s *SequenceDeclarationContext) AllSequenceItem() []ISequenceItemContext {
 	return tst
 }
 
-func (s *SequenceDeclarationContext) SequenceItem(i int) ISequenceItemContext {
+func (s *ConfigListContext) Config(i int) IConfigContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISequenceItemContext); ok {
+		if _, ok := ctx.(IConfigContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -3915,174 +4044,112 @@ func (s *SequenceDeclarationContext) SequenceItem(i int) ISequenceItemContext {
 		return nil
 	}
 
-	return t.(ISequenceItemContext)
+	return t.(IConfigContext)
 }
 
-func (s *SequenceDeclarationContext) AllCOMMA() []antlr.TerminalNode {
+func (s *ConfigListContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(ArcParserCOMMA)
 }
 
-func (s *SequenceDeclarationContext) COMMA(i int) antlr.TerminalNode {
+func (s *ConfigListContext) COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(ArcParserCOMMA, i)
 }
 
-func (s *SequenceDeclarationContext) GetRuleContext() antlr.RuleContext {
+func (s *ConfigListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SequenceDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConfigListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SequenceDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConfigListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterSequenceDeclaration(s)
+		listenerT.EnterConfigList(s)
 	}
 }
 
-func (s *SequenceDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConfigListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitSequenceDeclaration(s)
+		listenerT.ExitConfigList(s)
 	}
 }
 
-func (s *SequenceDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ConfigListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitSequenceDeclaration(s)
+		return t.VisitConfigList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) SequenceDeclaration() (localctx ISequenceDeclarationContext) {
-	localctx = NewSequenceDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, ArcParserRULE_sequenceDeclaration)
+func (p *ArcParser) ConfigList() (localctx IConfigListContext) {
+	localctx = NewConfigListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, ArcParserRULE_configList)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(299)
-		p.Match(ArcParserSEQUENCE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(333)
+		p.Config()
 	}
-	p.SetState(301)
+	p.SetState(338)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == ArcParserIDENTIFIER {
-		{
-			p.SetState(300)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	}
-	{
-		p.SetState(303)
-		p.Match(ArcParserLBRACE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(317)
-	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(334)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(335)
+				p.Config()
+			}
 
-	if (int64((_la-10)) & ^0x3f) == 0 && ((int64(1)<<(_la-10))&69900352492797951) != 0 {
-		{
-			p.SetState(304)
-			p.SequenceItem()
 		}
-		p.SetState(311)
+		p.SetState(340)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				p.SetState(306)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
-
-				if _la == ArcParserCOMMA {
-					{
-						p.SetState(305)
-						p.Match(ArcParserCOMMA)
-						if p.HasError() {
-							// Recognition error - abort rule
-							goto errorExit
-						}
-					}
-
-				}
-				{
-					p.SetState(308)
-					p.SequenceItem()
-				}
+	}
+	p.SetState(342)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-			}
-			p.SetState(313)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext())
+	if _la == ArcParserCOMMA {
+		{
+			p.SetState(341)
+			p.Match(ArcParserCOMMA)
 			if p.HasError() {
+				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(315)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == ArcParserCOMMA {
-			{
-				p.SetState(314)
-				p.Match(ArcParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
 
 	}
-	{
-		p.SetState(319)
-		p.Match(ArcParserRBRACE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
 
 errorExit:
 	if p.HasError() {
@@ -4097,75 +4164,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISequenceItemContext is an interface to support dynamic dispatch.
-type ISequenceItemContext interface {
+// IConfigContext is an interface to support dynamic dispatch.
+type IConfigContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	StageDeclaration() IStageDeclarationContext
-	SequenceDeclaration() ISequenceDeclarationContext
-	FlowStatement() IFlowStatementContext
-	SingleInvocation() ISingleInvocationContext
+	IDENTIFIER() antlr.TerminalNode
+	Type_() ITypeContext
+	ASSIGN() antlr.TerminalNode
+	Literal() ILiteralContext
 
-	// IsSequenceItemContext differentiates from other interfaces.
-	IsSequenceItemContext()
+	// IsConfigContext differentiates from other interfaces.
+	IsConfigContext()
 }
 
-type SequenceItemContext struct {
+type ConfigContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySequenceItemContext() *SequenceItemContext {
-	var p = new(SequenceItemContext)
+func NewEmptyConfigContext() *ConfigContext {
+	var p = new(ConfigContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_sequenceItem
+	p.RuleIndex = ArcParserRULE_config
 	return p
 }
 
-func InitEmptySequenceItemContext(p *SequenceItemContext) {
+func InitEmptyConfigContext(p *ConfigContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_sequenceItem
+	p.RuleIndex = ArcParserRULE_config
 }
 
-func (*SequenceItemContext) IsSequenceItemContext() {}
+func (*ConfigContext) IsConfigContext() {}
 
-func NewSequenceItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SequenceItemContext {
-	var p = new(SequenceItemContext)
+func NewConfigContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConfigContext {
+	var p = new(ConfigContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_sequenceItem
+	p.RuleIndex = ArcParserRULE_config
 
 	return p
 }
 
-func (s *SequenceItemContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SequenceItemContext) StageDeclaration() IStageDeclarationContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStageDeclarationContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ConfigContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IStageDeclarationContext)
+func (s *ConfigContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *SequenceItemContext) SequenceDeclaration() ISequenceDeclarationContext {
+func (s *ConfigContext) Type_() ITypeContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISequenceDeclarationContext); ok {
+		if _, ok := ctx.(ITypeContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4175,29 +4230,17 @@ func (s *SequenceItemContext) SequenceDeclaration() ISequenceDeclarationContext
 		return nil
 	}
 
-	return t.(ISequenceDeclarationContext)
+	return t.(ITypeContext)
 }
 
-func (s *SequenceItemContext) FlowStatement() IFlowStatementContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFlowStatementContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFlowStatementContext)
+func (s *ConfigContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserASSIGN, 0)
 }
 
-func (s *SequenceItemContext) SingleInvocation() ISingleInvocationContext {
+func (s *ConfigContext) Literal() ILiteralContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISingleInvocationContext); ok {
+		if _, ok := ctx.(ILiteralContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4207,79 +4250,78 @@ func (s *SequenceItemContext) SingleInvocation() ISingleInvocationContext {
 		return nil
 	}
 
-	return t.(ISingleInvocationContext)
+	return t.(ILiteralContext)
 }
 
-func (s *SequenceItemContext) GetRuleContext() antlr.RuleContext {
+func (s *ConfigContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SequenceItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConfigContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SequenceItemContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConfigContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterSequenceItem(s)
+		listenerT.EnterConfig(s)
 	}
 }
 
-func (s *SequenceItemContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConfigContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitSequenceItem(s)
+		listenerT.ExitConfig(s)
 	}
 }
 
-func (s *SequenceItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ConfigContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitSequenceItem(s)
+		return t.VisitConfig(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) SequenceItem() (localctx ISequenceItemContext) {
-	localctx = NewSequenceItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, ArcParserRULE_sequenceItem)
-	p.SetState(325)
+func (p *ArcParser) Config() (localctx IConfigContext) {
+	localctx = NewConfigContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, ArcParserRULE_config)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(344)
+		p.Match(ArcParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(345)
+		p.Type_()
+	}
+	p.SetState(348)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 27, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(321)
-			p.StageDeclaration()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(322)
-			p.SequenceDeclaration()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	if _la == ArcParserASSIGN {
 		{
-			p.SetState(323)
-			p.FlowStatement()
+			p.SetState(346)
+			p.Match(ArcParserASSIGN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(324)
-			p.SingleInvocation()
+			p.SetState(347)
+			p.Literal()
 		}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -4295,64 +4337,106 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStageDeclarationContext is an interface to support dynamic dispatch.
-type IStageDeclarationContext interface {
+// ISequenceDeclarationContext is an interface to support dynamic dispatch.
+type ISequenceDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	STAGE() antlr.TerminalNode
-	StageBody() IStageBodyContext
+	SEQUENCE() antlr.TerminalNode
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
 	IDENTIFIER() antlr.TerminalNode
+	AllSequenceItem() []ISequenceItemContext
+	SequenceItem(i int) ISequenceItemContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsStageDeclarationContext differentiates from other interfaces.
-	IsStageDeclarationContext()
+	// IsSequenceDeclarationContext differentiates from other interfaces.
+	IsSequenceDeclarationContext()
 }
 
-type StageDeclarationContext struct {
+type SequenceDeclarationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStageDeclarationContext() *StageDeclarationContext {
-	var p = new(StageDeclarationContext)
+func NewEmptySequenceDeclarationContext() *SequenceDeclarationContext {
+	var p = new(SequenceDeclarationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_stageDeclaration
+	p.RuleIndex = ArcParserRULE_sequenceDeclaration
 	return p
 }
 
-func InitEmptyStageDeclarationContext(p *StageDeclarationContext) {
+func InitEmptySequenceDeclarationContext(p *SequenceDeclarationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_stageDeclaration
+	p.RuleIndex = ArcParserRULE_sequenceDeclaration
 }
 
-func (*StageDeclarationContext) IsStageDeclarationContext() {}
+func (*SequenceDeclarationContext) IsSequenceDeclarationContext() {}
 
-func NewStageDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StageDeclarationContext {
-	var p = new(StageDeclarationContext)
+func NewSequenceDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SequenceDeclarationContext {
+	var p = new(SequenceDeclarationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_stageDeclaration
+	p.RuleIndex = ArcParserRULE_sequenceDeclaration
 
 	return p
 }
 
-func (s *StageDeclarationContext) GetParser() antlr.Parser { return s.parser }
+func (s *SequenceDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StageDeclarationContext) STAGE() antlr.TerminalNode {
-	return s.GetToken(ArcParserSTAGE, 0)
+func (s *SequenceDeclarationContext) SEQUENCE() antlr.TerminalNode {
+	return s.GetToken(ArcParserSEQUENCE, 0)
 }
 
-func (s *StageDeclarationContext) StageBody() IStageBodyContext {
+func (s *SequenceDeclarationContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
+}
+
+func (s *SequenceDeclarationContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
+}
+
+func (s *SequenceDeclarationContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
+
+func (s *SequenceDeclarationContext) AllSequenceItem() []ISequenceItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISequenceItemContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISequenceItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISequenceItemContext); ok {
+			tst[i] = t.(ISequenceItemContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SequenceDeclarationContext) SequenceItem(i int) ISequenceItemContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStageBodyContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ISequenceItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -4360,58 +4444,64 @@ func (s *StageDeclarationContext) StageBody() IStageBodyContext {
 		return nil
 	}
 
-	return t.(IStageBodyContext)
+	return t.(ISequenceItemContext)
 }
 
-func (s *StageDeclarationContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
+func (s *SequenceDeclarationContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
 }
 
-func (s *StageDeclarationContext) GetRuleContext() antlr.RuleContext {
+func (s *SequenceDeclarationContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
+}
+
+func (s *SequenceDeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StageDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SequenceDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StageDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SequenceDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterStageDeclaration(s)
+		listenerT.EnterSequenceDeclaration(s)
 	}
 }
 
-func (s *StageDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SequenceDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitStageDeclaration(s)
+		listenerT.ExitSequenceDeclaration(s)
 	}
 }
 
-func (s *StageDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SequenceDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitStageDeclaration(s)
+		return t.VisitSequenceDeclaration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) StageDeclaration() (localctx IStageDeclarationContext) {
-	localctx = NewStageDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, ArcParserRULE_stageDeclaration)
+func (p *ArcParser) SequenceDeclaration() (localctx ISequenceDeclarationContext) {
+	localctx = NewSequenceDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, ArcParserRULE_sequenceDeclaration)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(327)
-		p.Match(ArcParserSTAGE)
+		p.SetState(350)
+		p.Match(ArcParserSEQUENCE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(329)
+	p.SetState(352)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4420,7 +4510,7 @@ func (p *ArcParser) StageDeclaration() (localctx IStageDeclarationContext) {
 
 	if _la == ArcParserIDENTIFIER {
 		{
-			p.SetState(328)
+			p.SetState(351)
 			p.Match(ArcParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4430,8 +4520,97 @@ func (p *ArcParser) StageDeclaration() (localctx IStageDeclarationContext) {
 
 	}
 	{
-		p.SetState(331)
-		p.StageBody()
+		p.SetState(354)
+		p.Match(ArcParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(368)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64((_la-11)) & ^0x3f) == 0 && ((int64(1)<<(_la-11))&559202819942383391) != 0 {
+		{
+			p.SetState(355)
+			p.SequenceItem()
+		}
+		p.SetState(362)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 26, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				p.SetState(357)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == ArcParserCOMMA {
+					{
+						p.SetState(356)
+						p.Match(ArcParserCOMMA)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				}
+				{
+					p.SetState(359)
+					p.SequenceItem()
+				}
+
+			}
+			p.SetState(364)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 26, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(366)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == ArcParserCOMMA {
+			{
+				p.SetState(365)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	}
+	{
+		p.SetState(370)
+		p.Match(ArcParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -4447,96 +4626,93 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStageBodyContext is an interface to support dynamic dispatch.
-type IStageBodyContext interface {
+// ISequenceItemContext is an interface to support dynamic dispatch.
+type ISequenceItemContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LBRACE() antlr.TerminalNode
-	RBRACE() antlr.TerminalNode
-	AllStageItem() []IStageItemContext
-	StageItem(i int) IStageItemContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	StageDeclaration() IStageDeclarationContext
+	SequenceDeclaration() ISequenceDeclarationContext
+	FlowStatement() IFlowStatementContext
+	SingleInvocation() ISingleInvocationContext
 
-	// IsStageBodyContext differentiates from other interfaces.
-	IsStageBodyContext()
+	// IsSequenceItemContext differentiates from other interfaces.
+	IsSequenceItemContext()
 }
 
-type StageBodyContext struct {
+type SequenceItemContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStageBodyContext() *StageBodyContext {
-	var p = new(StageBodyContext)
+func NewEmptySequenceItemContext() *SequenceItemContext {
+	var p = new(SequenceItemContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_stageBody
+	p.RuleIndex = ArcParserRULE_sequenceItem
 	return p
 }
 
-func InitEmptyStageBodyContext(p *StageBodyContext) {
+func InitEmptySequenceItemContext(p *SequenceItemContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_stageBody
+	p.RuleIndex = ArcParserRULE_sequenceItem
 }
 
-func (*StageBodyContext) IsStageBodyContext() {}
+func (*SequenceItemContext) IsSequenceItemContext() {}
 
-func NewStageBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StageBodyContext {
-	var p = new(StageBodyContext)
+func NewSequenceItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SequenceItemContext {
+	var p = new(SequenceItemContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_stageBody
+	p.RuleIndex = ArcParserRULE_sequenceItem
 
 	return p
 }
 
-func (s *StageBodyContext) GetParser() antlr.Parser { return s.parser }
+func (s *SequenceItemContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StageBodyContext) LBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserLBRACE, 0)
-}
+func (s *SequenceItemContext) StageDeclaration() IStageDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStageDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *StageBodyContext) RBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserRBRACE, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStageDeclarationContext)
 }
 
-func (s *StageBodyContext) AllStageItem() []IStageItemContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStageItemContext); ok {
-			len++
+func (s *SequenceItemContext) SequenceDeclaration() ISequenceDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISequenceDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IStageItemContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStageItemContext); ok {
-			tst[i] = t.(IStageItemContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(ISequenceDeclarationContext)
 }
 
-func (s *StageBodyContext) StageItem(i int) IStageItemContext {
+func (s *SequenceItemContext) FlowStatement() IFlowStatementContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStageItemContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IFlowStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -4544,147 +4720,95 @@ func (s *StageBodyContext) StageItem(i int) IStageItemContext {
 		return nil
 	}
 
-	return t.(IStageItemContext)
+	return t.(IFlowStatementContext)
 }
 
-func (s *StageBodyContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserCOMMA)
-}
+func (s *SequenceItemContext) SingleInvocation() ISingleInvocationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISingleInvocationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *StageBodyContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserCOMMA, i)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISingleInvocationContext)
 }
 
-func (s *StageBodyContext) GetRuleContext() antlr.RuleContext {
+func (s *SequenceItemContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StageBodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SequenceItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StageBodyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SequenceItemContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterStageBody(s)
+		listenerT.EnterSequenceItem(s)
 	}
 }
 
-func (s *StageBodyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SequenceItemContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitStageBody(s)
+		listenerT.ExitSequenceItem(s)
 	}
 }
 
-func (s *StageBodyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SequenceItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitStageBody(s)
+		return t.VisitSequenceItem(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) StageBody() (localctx IStageBodyContext) {
-	localctx = NewStageBodyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, ArcParserRULE_stageBody)
-	var _la int
-
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(333)
-		p.Match(ArcParserLBRACE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(347)
+func (p *ArcParser) SequenceItem() (localctx ISequenceItemContext) {
+	localctx = NewSequenceItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, ArcParserRULE_sequenceItem)
+	p.SetState(376)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-10)) & ^0x3f) == 0 && ((int64(1)<<(_la-10))&69900352492797951) != 0 {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(334)
-			p.StageItem()
-		}
-		p.SetState(341)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 30, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+			p.SetState(372)
+			p.StageDeclaration()
 		}
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				p.SetState(336)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
 
-				if _la == ArcParserCOMMA {
-					{
-						p.SetState(335)
-						p.Match(ArcParserCOMMA)
-						if p.HasError() {
-							// Recognition error - abort rule
-							goto errorExit
-						}
-					}
-
-				}
-				{
-					p.SetState(338)
-					p.StageItem()
-				}
-
-			}
-			p.SetState(343)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 30, p.GetParserRuleContext())
-			if p.HasError() {
-				goto errorExit
-			}
-		}
-		p.SetState(345)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(373)
+			p.SequenceDeclaration()
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == ArcParserCOMMA {
-			{
-				p.SetState(344)
-				p.Match(ArcParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
 
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(374)
+			p.FlowStatement()
 		}
 
-	}
-	{
-		p.SetState(349)
-		p.Match(ArcParserRBRACE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(375)
+			p.SingleInvocation()
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -4700,74 +4824,62 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStageItemContext is an interface to support dynamic dispatch.
-type IStageItemContext interface {
+// IStageDeclarationContext is an interface to support dynamic dispatch.
+type IStageDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	FlowStatement() IFlowStatementContext
-	SingleInvocation() ISingleInvocationContext
-	SequenceDeclaration() ISequenceDeclarationContext
+	STAGE() antlr.TerminalNode
+	StageBody() IStageBodyContext
+	IDENTIFIER() antlr.TerminalNode
 
-	// IsStageItemContext differentiates from other interfaces.
-	IsStageItemContext()
+	// IsStageDeclarationContext differentiates from other interfaces.
+	IsStageDeclarationContext()
 }
 
-type StageItemContext struct {
+type StageDeclarationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStageItemContext() *StageItemContext {
-	var p = new(StageItemContext)
+func NewEmptyStageDeclarationContext() *StageDeclarationContext {
+	var p = new(StageDeclarationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_stageItem
+	p.RuleIndex = ArcParserRULE_stageDeclaration
 	return p
 }
 
-func InitEmptyStageItemContext(p *StageItemContext) {
+func InitEmptyStageDeclarationContext(p *StageDeclarationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_stageItem
+	p.RuleIndex = ArcParserRULE_stageDeclaration
 }
 
-func (*StageItemContext) IsStageItemContext() {}
+func (*StageDeclarationContext) IsStageDeclarationContext() {}
 
-func NewStageItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StageItemContext {
-	var p = new(StageItemContext)
+func NewStageDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StageDeclarationContext {
+	var p = new(StageDeclarationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_stageItem
+	p.RuleIndex = ArcParserRULE_stageDeclaration
 
 	return p
 }
 
-func (s *StageItemContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *StageItemContext) FlowStatement() IFlowStatementContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFlowStatementContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *StageDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IFlowStatementContext)
+func (s *StageDeclarationContext) STAGE() antlr.TerminalNode {
+	return s.GetToken(ArcParserSTAGE, 0)
 }
 
-func (s *StageItemContext) SingleInvocation() ISingleInvocationContext {
+func (s *StageDeclarationContext) StageBody() IStageBodyContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISingleInvocationContext); ok {
+		if _, ok := ctx.(IStageBodyContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4777,88 +4889,78 @@ func (s *StageItemContext) SingleInvocation() ISingleInvocationContext {
 		return nil
 	}
 
-	return t.(ISingleInvocationContext)
+	return t.(IStageBodyContext)
 }
 
-func (s *StageItemContext) SequenceDeclaration() ISequenceDeclarationContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISequenceDeclarationContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISequenceDeclarationContext)
+func (s *StageDeclarationContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *StageItemContext) GetRuleContext() antlr.RuleContext {
+func (s *StageDeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StageItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StageDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StageItemContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StageDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterStageItem(s)
+		listenerT.EnterStageDeclaration(s)
 	}
 }
 
-func (s *StageItemContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StageDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitStageItem(s)
+		listenerT.ExitStageDeclaration(s)
 	}
 }
 
-func (s *StageItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StageDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitStageItem(s)
+		return t.VisitStageDeclaration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) StageItem() (localctx IStageItemContext) {
-	localctx = NewStageItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, ArcParserRULE_stageItem)
-	p.SetState(354)
+func (p *ArcParser) StageDeclaration() (localctx IStageDeclarationContext) {
+	localctx = NewStageDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, ArcParserRULE_stageDeclaration)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(378)
+		p.Match(ArcParserSTAGE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(380)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(351)
-			p.FlowStatement()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(352)
-			p.SingleInvocation()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	if _la == ArcParserIDENTIFIER {
 		{
-			p.SetState(353)
-			p.SequenceDeclaration()
+			p.SetState(379)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+	}
+	{
+		p.SetState(382)
+		p.StageBody()
 	}
 
 errorExit:
@@ -4874,75 +4976,96 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISingleInvocationContext is an interface to support dynamic dispatch.
-type ISingleInvocationContext interface {
+// IStageBodyContext is an interface to support dynamic dispatch.
+type IStageBodyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Function() IFunctionContext
-	Expression() IExpressionContext
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	AllStageItem() []IStageItemContext
+	StageItem(i int) IStageItemContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsSingleInvocationContext differentiates from other interfaces.
-	IsSingleInvocationContext()
+	// IsStageBodyContext differentiates from other interfaces.
+	IsStageBodyContext()
 }
 
-type SingleInvocationContext struct {
+type StageBodyContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySingleInvocationContext() *SingleInvocationContext {
-	var p = new(SingleInvocationContext)
+func NewEmptyStageBodyContext() *StageBodyContext {
+	var p = new(StageBodyContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_singleInvocation
+	p.RuleIndex = ArcParserRULE_stageBody
 	return p
 }
 
-func InitEmptySingleInvocationContext(p *SingleInvocationContext) {
+func InitEmptyStageBodyContext(p *StageBodyContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_singleInvocation
+	p.RuleIndex = ArcParserRULE_stageBody
 }
 
-func (*SingleInvocationContext) IsSingleInvocationContext() {}
+func (*StageBodyContext) IsStageBodyContext() {}
 
-func NewSingleInvocationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SingleInvocationContext {
-	var p = new(SingleInvocationContext)
+func NewStageBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StageBodyContext {
+	var p = new(StageBodyContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_singleInvocation
+	p.RuleIndex = ArcParserRULE_stageBody
 
 	return p
 }
 
-func (s *SingleInvocationContext) GetParser() antlr.Parser { return s.parser }
+func (s *StageBodyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SingleInvocationContext) Function() IFunctionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFunctionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *StageBodyContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
+}
+
+func (s *StageBodyContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
+}
+
+func (s *StageBodyContext) AllStageItem() []IStageItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStageItemContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IStageItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStageItemContext); ok {
+			tst[i] = t.(IStageItemContext)
+			i++
+		}
 	}
 
-	return t.(IFunctionContext)
+	return tst
 }
 
-func (s *SingleInvocationContext) Expression() IExpressionContext {
+func (s *StageBodyContext) StageItem(i int) IStageItemContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IStageItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -4950,65 +5073,147 @@ func (s *SingleInvocationContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IStageItemContext)
 }
 
-func (s *SingleInvocationContext) GetRuleContext() antlr.RuleContext {
+func (s *StageBodyContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
+}
+
+func (s *StageBodyContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
+}
+
+func (s *StageBodyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SingleInvocationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StageBodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SingleInvocationContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StageBodyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterSingleInvocation(s)
+		listenerT.EnterStageBody(s)
 	}
 }
 
-func (s *SingleInvocationContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StageBodyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitSingleInvocation(s)
+		listenerT.ExitStageBody(s)
 	}
 }
 
-func (s *SingleInvocationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StageBodyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitSingleInvocation(s)
+		return t.VisitStageBody(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) SingleInvocation() (localctx ISingleInvocationContext) {
-	localctx = NewSingleInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, ArcParserRULE_singleInvocation)
-	p.SetState(358)
+func (p *ArcParser) StageBody() (localctx IStageBodyContext) {
+	localctx = NewStageBodyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, ArcParserRULE_stageBody)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(384)
+		p.Match(ArcParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(398)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	if (int64((_la-11)) & ^0x3f) == 0 && ((int64(1)<<(_la-11))&559202819942383391) != 0 {
 		{
-			p.SetState(356)
-			p.Function()
+			p.SetState(385)
+			p.StageItem()
+		}
+		p.SetState(392)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 32, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
 		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				p.SetState(387)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(357)
-			p.Expression()
+				if _la == ArcParserCOMMA {
+					{
+						p.SetState(386)
+						p.Match(ArcParserCOMMA)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				}
+				{
+					p.SetState(389)
+					p.StageItem()
+				}
+
+			}
+			p.SetState(394)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 32, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		p.SetState(396)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+		if _la == ArcParserCOMMA {
+			{
+				p.SetState(395)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	}
+	{
+		p.SetState(400)
+		p.Match(ArcParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -5024,67 +5229,74 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IGlobalConstantContext is an interface to support dynamic dispatch.
-type IGlobalConstantContext interface {
+// IStageItemContext is an interface to support dynamic dispatch.
+type IStageItemContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	DECLARE() antlr.TerminalNode
-	Literal() ILiteralContext
-	Type_() ITypeContext
+	FlowStatement() IFlowStatementContext
+	SingleInvocation() ISingleInvocationContext
+	SequenceDeclaration() ISequenceDeclarationContext
 
-	// IsGlobalConstantContext differentiates from other interfaces.
-	IsGlobalConstantContext()
+	// IsStageItemContext differentiates from other interfaces.
+	IsStageItemContext()
 }
 
-type GlobalConstantContext struct {
+type StageItemContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGlobalConstantContext() *GlobalConstantContext {
-	var p = new(GlobalConstantContext)
+func NewEmptyStageItemContext() *StageItemContext {
+	var p = new(StageItemContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_globalConstant
+	p.RuleIndex = ArcParserRULE_stageItem
 	return p
 }
 
-func InitEmptyGlobalConstantContext(p *GlobalConstantContext) {
+func InitEmptyStageItemContext(p *StageItemContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_globalConstant
+	p.RuleIndex = ArcParserRULE_stageItem
 }
 
-func (*GlobalConstantContext) IsGlobalConstantContext() {}
+func (*StageItemContext) IsStageItemContext() {}
 
-func NewGlobalConstantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GlobalConstantContext {
-	var p = new(GlobalConstantContext)
+func NewStageItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StageItemContext {
+	var p = new(StageItemContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_globalConstant
+	p.RuleIndex = ArcParserRULE_stageItem
 
 	return p
 }
 
-func (s *GlobalConstantContext) GetParser() antlr.Parser { return s.parser }
+func (s *StageItemContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GlobalConstantContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
-}
+func (s *StageItemContext) FlowStatement() IFlowStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFlowStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *GlobalConstantContext) DECLARE() antlr.TerminalNode {
-	return s.GetToken(ArcParserDECLARE, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFlowStatementContext)
 }
 
-func (s *GlobalConstantContext) Literal() ILiteralContext {
+func (s *StageItemContext) SingleInvocation() ISingleInvocationContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILiteralContext); ok {
+		if _, ok := ctx.(ISingleInvocationContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5094,13 +5306,13 @@ func (s *GlobalConstantContext) Literal() ILiteralContext {
 		return nil
 	}
 
-	return t.(ILiteralContext)
+	return t.(ISingleInvocationContext)
 }
 
-func (s *GlobalConstantContext) Type_() ITypeContext {
+func (s *StageItemContext) SequenceDeclaration() ISequenceDeclarationContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeContext); ok {
+		if _, ok := ctx.(ISequenceDeclarationContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5110,43 +5322,43 @@ func (s *GlobalConstantContext) Type_() ITypeContext {
 		return nil
 	}
 
-	return t.(ITypeContext)
+	return t.(ISequenceDeclarationContext)
 }
 
-func (s *GlobalConstantContext) GetRuleContext() antlr.RuleContext {
+func (s *StageItemContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GlobalConstantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StageItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GlobalConstantContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StageItemContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterGlobalConstant(s)
+		listenerT.EnterStageItem(s)
 	}
 }
 
-func (s *GlobalConstantContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StageItemContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitGlobalConstant(s)
+		listenerT.ExitStageItem(s)
 	}
 }
 
-func (s *GlobalConstantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StageItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitGlobalConstant(s)
+		return t.VisitStageItem(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) GlobalConstant() (localctx IGlobalConstantContext) {
-	localctx = NewGlobalConstantContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, ArcParserRULE_globalConstant)
-	p.SetState(368)
+func (p *ArcParser) StageItem() (localctx IStageItemContext) {
+	localctx = NewStageItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, ArcParserRULE_stageItem)
+	p.SetState(405)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5156,51 +5368,22 @@ func (p *ArcParser) GlobalConstant() (localctx IGlobalConstantContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(360)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(361)
-			p.Match(ArcParserDECLARE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(362)
-			p.Literal()
+			p.SetState(402)
+			p.FlowStatement()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(363)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(364)
-			p.Type_()
-		}
-		{
-			p.SetState(365)
-			p.Match(ArcParserDECLARE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(403)
+			p.SingleInvocation()
 		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(366)
-			p.Literal()
+			p.SetState(404)
+			p.SequenceDeclaration()
 		}
 
 	case antlr.ATNInvalidAltNumber:
@@ -5220,88 +5403,75 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFlowStatementContext is an interface to support dynamic dispatch.
-type IFlowStatementContext interface {
+// ISingleInvocationContext is an interface to support dynamic dispatch.
+type ISingleInvocationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllRoutingTable() []IRoutingTableContext
-	RoutingTable(i int) IRoutingTableContext
-	AllFlowNode() []IFlowNodeContext
-	FlowNode(i int) IFlowNodeContext
-	AllFlowOperator() []IFlowOperatorContext
-	FlowOperator(i int) IFlowOperatorContext
+	Function() IFunctionContext
+	Expression() IExpressionContext
 
-	// IsFlowStatementContext differentiates from other interfaces.
-	IsFlowStatementContext()
+	// IsSingleInvocationContext differentiates from other interfaces.
+	IsSingleInvocationContext()
 }
 
-type FlowStatementContext struct {
+type SingleInvocationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFlowStatementContext() *FlowStatementContext {
-	var p = new(FlowStatementContext)
+func NewEmptySingleInvocationContext() *SingleInvocationContext {
+	var p = new(SingleInvocationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_flowStatement
+	p.RuleIndex = ArcParserRULE_singleInvocation
 	return p
 }
 
-func InitEmptyFlowStatementContext(p *FlowStatementContext) {
+func InitEmptySingleInvocationContext(p *SingleInvocationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_flowStatement
+	p.RuleIndex = ArcParserRULE_singleInvocation
 }
 
-func (*FlowStatementContext) IsFlowStatementContext() {}
+func (*SingleInvocationContext) IsSingleInvocationContext() {}
 
-func NewFlowStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FlowStatementContext {
-	var p = new(FlowStatementContext)
+func NewSingleInvocationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SingleInvocationContext {
+	var p = new(SingleInvocationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_flowStatement
+	p.RuleIndex = ArcParserRULE_singleInvocation
 
 	return p
 }
 
-func (s *FlowStatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *SingleInvocationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FlowStatementContext) AllRoutingTable() []IRoutingTableContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IRoutingTableContext); ok {
-			len++
+func (s *SingleInvocationContext) Function() IFunctionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IRoutingTableContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IRoutingTableContext); ok {
-			tst[i] = t.(IRoutingTableContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IFunctionContext)
 }
 
-func (s *FlowStatementContext) RoutingTable(i int) IRoutingTableContext {
+func (s *SingleInvocationContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IRoutingTableContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -5309,193 +5479,66 @@ func (s *FlowStatementContext) RoutingTable(i int) IRoutingTableContext {
 		return nil
 	}
 
-	return t.(IRoutingTableContext)
+	return t.(IExpressionContext)
 }
 
-func (s *FlowStatementContext) AllFlowNode() []IFlowNodeContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IFlowNodeContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IFlowNodeContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IFlowNodeContext); ok {
-			tst[i] = t.(IFlowNodeContext)
-			i++
-		}
-	}
+func (s *SingleInvocationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return tst
+func (s *SingleInvocationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FlowStatementContext) FlowNode(i int) IFlowNodeContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFlowNodeContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
+func (s *SingleInvocationContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterSingleInvocation(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *SingleInvocationContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitSingleInvocation(s)
 	}
+}
 
-	return t.(IFlowNodeContext)
+func (s *SingleInvocationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitSingleInvocation(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
 }
 
-func (s *FlowStatementContext) AllFlowOperator() []IFlowOperatorContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IFlowOperatorContext); ok {
-			len++
-		}
+func (p *ArcParser) SingleInvocation() (localctx ISingleInvocationContext) {
+	localctx = NewSingleInvocationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, ArcParserRULE_singleInvocation)
+	p.SetState(409)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
 
-	tst := make([]IFlowOperatorContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IFlowOperatorContext); ok {
-			tst[i] = t.(IFlowOperatorContext)
-			i++
-		}
-	}
-
-	return tst
-}
-
-func (s *FlowStatementContext) FlowOperator(i int) IFlowOperatorContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFlowOperatorContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFlowOperatorContext)
-}
-
-func (s *FlowStatementContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *FlowStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *FlowStatementContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterFlowStatement(s)
-	}
-}
-
-func (s *FlowStatementContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitFlowStatement(s)
-	}
-}
-
-func (s *FlowStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case ArcParserVisitor:
-		return t.VisitFlowStatement(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-func (p *ArcParser) FlowStatement() (localctx IFlowStatementContext) {
-	localctx = NewFlowStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, ArcParserRULE_flowStatement)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(372)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetTokenStream().LA(1) {
-	case ArcParserLBRACE:
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 36, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(370)
-			p.RoutingTable()
+			p.SetState(407)
+			p.Function()
 		}
 
-	case ArcParserSEQUENCE, ArcParserSTAGE, ArcParserNEXT, ArcParserCHAN, ArcParserAUTHORITY, ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64, ArcParserF32, ArcParserF64, ArcParserSTR, ArcParserSERIES, ArcParserMINUS, ArcParserNOT, ArcParserLPAREN, ArcParserLBRACKET, ArcParserINTEGER_LITERAL, ArcParserFLOAT_LITERAL, ArcParserSTR_LITERAL_MULTI, ArcParserSTR_LITERAL, ArcParserIDENTIFIER:
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(371)
-			p.FlowNode()
+			p.SetState(408)
+			p.Expression()
 		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
-	}
-	p.SetState(379)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
+	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
-
-	for ok := true; ok; ok = _la == ArcParserARROW || _la == ArcParserTRANSITION {
-		{
-			p.SetState(374)
-			p.FlowOperator()
-		}
-		p.SetState(377)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-
-		switch p.GetTokenStream().LA(1) {
-		case ArcParserLBRACE:
-			{
-				p.SetState(375)
-				p.RoutingTable()
-			}
-
-		case ArcParserSEQUENCE, ArcParserSTAGE, ArcParserNEXT, ArcParserCHAN, ArcParserAUTHORITY, ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64, ArcParserF32, ArcParserF64, ArcParserSTR, ArcParserSERIES, ArcParserMINUS, ArcParserNOT, ArcParserLPAREN, ArcParserLBRACKET, ArcParserINTEGER_LITERAL, ArcParserFLOAT_LITERAL, ArcParserSTR_LITERAL_MULTI, ArcParserSTR_LITERAL, ArcParserIDENTIFIER:
-			{
-				p.SetState(376)
-				p.FlowNode()
-			}
-
-		default:
-			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			goto errorExit
-		}
-
-		p.SetState(381)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
 
 errorExit:
 	if p.HasError() {
@@ -5510,106 +5553,168 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFlowOperatorContext is an interface to support dynamic dispatch.
-type IFlowOperatorContext interface {
+// IStructDeclarationContext is an interface to support dynamic dispatch.
+type IStructDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ARROW() antlr.TerminalNode
-	TRANSITION() antlr.TerminalNode
+	STRUCT() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	StructFieldList() IStructFieldListContext
 
-	// IsFlowOperatorContext differentiates from other interfaces.
-	IsFlowOperatorContext()
+	// IsStructDeclarationContext differentiates from other interfaces.
+	IsStructDeclarationContext()
 }
 
-type FlowOperatorContext struct {
+type StructDeclarationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFlowOperatorContext() *FlowOperatorContext {
-	var p = new(FlowOperatorContext)
+func NewEmptyStructDeclarationContext() *StructDeclarationContext {
+	var p = new(StructDeclarationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_flowOperator
+	p.RuleIndex = ArcParserRULE_structDeclaration
 	return p
 }
 
-func InitEmptyFlowOperatorContext(p *FlowOperatorContext) {
+func InitEmptyStructDeclarationContext(p *StructDeclarationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_flowOperator
+	p.RuleIndex = ArcParserRULE_structDeclaration
 }
 
-func (*FlowOperatorContext) IsFlowOperatorContext() {}
+func (*StructDeclarationContext) IsStructDeclarationContext() {}
 
-func NewFlowOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FlowOperatorContext {
-	var p = new(FlowOperatorContext)
+func NewStructDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructDeclarationContext {
+	var p = new(StructDeclarationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_flowOperator
+	p.RuleIndex = ArcParserRULE_structDeclaration
 
 	return p
 }
 
-func (s *FlowOperatorContext) GetParser() antlr.Parser { return s.parser }
+func (s *StructDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FlowOperatorContext) ARROW() antlr.TerminalNode {
-	return s.GetToken(ArcParserARROW, 0)
+func (s *StructDeclarationContext) STRUCT() antlr.TerminalNode {
+	return s.GetToken(ArcParserSTRUCT, 0)
 }
 
-func (s *FlowOperatorContext) TRANSITION() antlr.TerminalNode {
-	return s.GetToken(ArcParserTRANSITION, 0)
+func (s *StructDeclarationContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *FlowOperatorContext) GetRuleContext() antlr.RuleContext {
+func (s *StructDeclarationContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
+}
+
+func (s *StructDeclarationContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
+}
+
+func (s *StructDeclarationContext) StructFieldList() IStructFieldListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructFieldListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructFieldListContext)
+}
+
+func (s *StructDeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FlowOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FlowOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterFlowOperator(s)
+		listenerT.EnterStructDeclaration(s)
 	}
 }
 
-func (s *FlowOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitFlowOperator(s)
+		listenerT.ExitStructDeclaration(s)
 	}
 }
 
-func (s *FlowOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StructDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitFlowOperator(s)
+		return t.VisitStructDeclaration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) FlowOperator() (localctx IFlowOperatorContext) {
-	localctx = NewFlowOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, ArcParserRULE_flowOperator)
+func (p *ArcParser) StructDeclaration() (localctx IStructDeclarationContext) {
+	localctx = NewStructDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, ArcParserRULE_structDeclaration)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(383)
-		_la = p.GetTokenStream().LA(1)
+		p.SetState(411)
+		p.Match(ArcParserSTRUCT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(412)
+		p.Match(ArcParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(413)
+		p.Match(ArcParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(415)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-		if !(_la == ArcParserARROW || _la == ArcParserTRANSITION) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	if _la == ArcParserIDENTIFIER {
+		{
+			p.SetState(414)
+			p.StructFieldList()
+		}
+
+	}
+	{
+		p.SetState(417)
+		p.Match(ArcParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
 
@@ -5626,75 +5731,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IRoutingTableContext is an interface to support dynamic dispatch.
-type IRoutingTableContext interface {
+// IStructFieldListContext is an interface to support dynamic dispatch.
+type IStructFieldListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LBRACE() antlr.TerminalNode
-	AllRoutingEntry() []IRoutingEntryContext
-	RoutingEntry(i int) IRoutingEntryContext
-	RBRACE() antlr.TerminalNode
+	AllStructField() []IStructFieldContext
+	StructField(i int) IStructFieldContext
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
-	// IsRoutingTableContext differentiates from other interfaces.
-	IsRoutingTableContext()
+	// IsStructFieldListContext differentiates from other interfaces.
+	IsStructFieldListContext()
 }
 
-type RoutingTableContext struct {
+type StructFieldListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRoutingTableContext() *RoutingTableContext {
-	var p = new(RoutingTableContext)
+func NewEmptyStructFieldListContext() *StructFieldListContext {
+	var p = new(StructFieldListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_routingTable
+	p.RuleIndex = ArcParserRULE_structFieldList
 	return p
 }
 
-func InitEmptyRoutingTableContext(p *RoutingTableContext) {
+func InitEmptyStructFieldListContext(p *StructFieldListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_routingTable
+	p.RuleIndex = ArcParserRULE_structFieldList
 }
 
-func (*RoutingTableContext) IsRoutingTableContext() {}
+func (*StructFieldListContext) IsStructFieldListContext() {}
 
-func NewRoutingTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RoutingTableContext {
-	var p = new(RoutingTableContext)
+func NewStructFieldListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructFieldListContext {
+	var p = new(StructFieldListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_routingTable
+	p.RuleIndex = ArcParserRULE_structFieldList
 
 	return p
 }
 
-func (s *RoutingTableContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *RoutingTableContext) LBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserLBRACE, 0)
-}
+func (s *StructFieldListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RoutingTableContext) AllRoutingEntry() []IRoutingEntryContext {
+func (s *StructFieldListContext) AllStructField() []IStructFieldContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IRoutingEntryContext); ok {
+		if _, ok := ctx.(IStructFieldContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IRoutingEntryContext, len)
+	tst := make([]IStructFieldContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IRoutingEntryContext); ok {
-			tst[i] = t.(IRoutingEntryContext)
+		if t, ok := ctx.(IStructFieldContext); ok {
+			tst[i] = t.(IStructFieldContext)
 			i++
 		}
 	}
@@ -5702,11 +5801,11 @@ func (s *RoutingTableContext) AllRoutingEntry() []IRoutingEntryContext {
 	return tst
 }
 
-func (s *RoutingTableContext) RoutingEntry(i int) IRoutingEntryContext {
+func (s *StructFieldListContext) StructField(i int) IStructFieldContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IRoutingEntryContext); ok {
+		if _, ok := ctx.(IStructFieldContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -5719,84 +5818,72 @@ func (s *RoutingTableContext) RoutingEntry(i int) IRoutingEntryContext {
 		return nil
 	}
 
-	return t.(IRoutingEntryContext)
-}
-
-func (s *RoutingTableContext) RBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserRBRACE, 0)
+	return t.(IStructFieldContext)
 }
 
-func (s *RoutingTableContext) AllCOMMA() []antlr.TerminalNode {
+func (s *StructFieldListContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(ArcParserCOMMA)
 }
 
-func (s *RoutingTableContext) COMMA(i int) antlr.TerminalNode {
+func (s *StructFieldListContext) COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(ArcParserCOMMA, i)
 }
 
-func (s *RoutingTableContext) GetRuleContext() antlr.RuleContext {
+func (s *StructFieldListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RoutingTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructFieldListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RoutingTableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterRoutingTable(s)
+		listenerT.EnterStructFieldList(s)
 	}
 }
 
-func (s *RoutingTableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitRoutingTable(s)
+		listenerT.ExitStructFieldList(s)
 	}
 }
 
-func (s *RoutingTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StructFieldListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitRoutingTable(s)
+		return t.VisitStructFieldList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) RoutingTable() (localctx IRoutingTableContext) {
-	localctx = NewRoutingTableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, ArcParserRULE_routingTable)
+func (p *ArcParser) StructFieldList() (localctx IStructFieldListContext) {
+	localctx = NewStructFieldListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, ArcParserRULE_structFieldList)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(385)
-		p.Match(ArcParserLBRACE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(386)
-		p.RoutingEntry()
+		p.SetState(419)
+		p.StructField()
 	}
-	p.SetState(391)
+	p.SetState(424)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 39, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 38, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(387)
+				p.SetState(420)
 				p.Match(ArcParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -5804,22 +5891,22 @@ func (p *ArcParser) RoutingTable() (localctx IRoutingTableContext) {
 				}
 			}
 			{
-				p.SetState(388)
-				p.RoutingEntry()
+				p.SetState(421)
+				p.StructField()
 			}
 
 		}
-		p.SetState(393)
+		p.SetState(426)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 39, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 38, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(395)
+	p.SetState(428)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5828,7 +5915,7 @@ func (p *ArcParser) RoutingTable() (localctx IRoutingTableContext) {
 
 	if _la == ArcParserCOMMA {
 		{
-			p.SetState(394)
+			p.SetState(427)
 			p.Match(ArcParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5837,14 +5924,6 @@ func (p *ArcParser) RoutingTable() (localctx IRoutingTableContext) {
 		}
 
 	}
-	{
-		p.SetState(397)
-		p.Match(ArcParserRBRACE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
 
 errorExit:
 	if p.HasError() {
@@ -5859,106 +5938,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IRoutingEntryContext is an interface to support dynamic dispatch.
-type IRoutingEntryContext interface {
+// IStructFieldContext is an interface to support dynamic dispatch.
+type IStructFieldContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllIDENTIFIER() []antlr.TerminalNode
-	IDENTIFIER(i int) antlr.TerminalNode
-	AllCOLON() []antlr.TerminalNode
-	COLON(i int) antlr.TerminalNode
-	AllFlowNode() []IFlowNodeContext
-	FlowNode(i int) IFlowNodeContext
-	AllARROW() []antlr.TerminalNode
-	ARROW(i int) antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	Type_() ITypeContext
 
-	// IsRoutingEntryContext differentiates from other interfaces.
-	IsRoutingEntryContext()
+	// IsStructFieldContext differentiates from other interfaces.
+	IsStructFieldContext()
 }
 
-type RoutingEntryContext struct {
+type StructFieldContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRoutingEntryContext() *RoutingEntryContext {
-	var p = new(RoutingEntryContext)
+func NewEmptyStructFieldContext() *StructFieldContext {
+	var p = new(StructFieldContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_routingEntry
+	p.RuleIndex = ArcParserRULE_structField
 	return p
 }
 
-func InitEmptyRoutingEntryContext(p *RoutingEntryContext) {
+func InitEmptyStructFieldContext(p *StructFieldContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_routingEntry
+	p.RuleIndex = ArcParserRULE_structField
 }
 
-func (*RoutingEntryContext) IsRoutingEntryContext() {}
+func (*StructFieldContext) IsStructFieldContext() {}
 
-func NewRoutingEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RoutingEntryContext {
-	var p = new(RoutingEntryContext)
+func NewStructFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructFieldContext {
+	var p = new(StructFieldContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_routingEntry
+	p.RuleIndex = ArcParserRULE_structField
 
 	return p
 }
 
-func (s *RoutingEntryContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *RoutingEntryContext) AllIDENTIFIER() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserIDENTIFIER)
-}
-
-func (s *RoutingEntryContext) IDENTIFIER(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, i)
-}
-
-func (s *RoutingEntryContext) AllCOLON() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserCOLON)
-}
-
-func (s *RoutingEntryContext) COLON(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserCOLON, i)
-}
-
-func (s *RoutingEntryContext) AllFlowNode() []IFlowNodeContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IFlowNodeContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IFlowNodeContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IFlowNodeContext); ok {
-			tst[i] = t.(IFlowNodeContext)
-			i++
-		}
-	}
+func (s *StructFieldContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *StructFieldContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *RoutingEntryContext) FlowNode(i int) IFlowNodeContext {
+func (s *StructFieldContext) Type_() ITypeContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFlowNodeContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -5966,55 +6002,45 @@ func (s *RoutingEntryContext) FlowNode(i int) IFlowNodeContext {
 		return nil
 	}
 
-	return t.(IFlowNodeContext)
-}
-
-func (s *RoutingEntryContext) AllARROW() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserARROW)
-}
-
-func (s *RoutingEntryContext) ARROW(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserARROW, i)
+	return t.(ITypeContext)
 }
 
-func (s *RoutingEntryContext) GetRuleContext() antlr.RuleContext {
+func (s *StructFieldContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RoutingEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RoutingEntryContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterRoutingEntry(s)
+		listenerT.EnterStructField(s)
 	}
 }
 
-func (s *RoutingEntryContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitRoutingEntry(s)
+		listenerT.ExitStructField(s)
 	}
 }
 
-func (s *RoutingEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StructFieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitRoutingEntry(s)
+		return t.VisitStructField(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) RoutingEntry() (localctx IRoutingEntryContext) {
-	localctx = NewRoutingEntryContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, ArcParserRULE_routingEntry)
-	var _la int
-
+func (p *ArcParser) StructField() (localctx IStructFieldContext) {
+	localctx = NewStructFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, ArcParserRULE_structField)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(399)
+		p.SetState(430)
 		p.Match(ArcParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6022,70 +6048,8 @@ func (p *ArcParser) RoutingEntry() (localctx IRoutingEntryContext) {
 		}
 	}
 	{
-		p.SetState(400)
-		p.Match(ArcParserCOLON)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(401)
-		p.FlowNode()
-	}
-	p.SetState(406)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == ArcParserARROW {
-		{
-			p.SetState(402)
-			p.Match(ArcParserARROW)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(403)
-			p.FlowNode()
-		}
-
-		p.SetState(408)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	p.SetState(411)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == ArcParserCOLON {
-		{
-			p.SetState(409)
-			p.Match(ArcParserCOLON)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(410)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
+		p.SetState(431)
+		p.Type_()
 	}
 
 errorExit:
@@ -6101,77 +6065,77 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFlowNodeContext is an interface to support dynamic dispatch.
-type IFlowNodeContext interface {
+// IEnumDeclarationContext is an interface to support dynamic dispatch.
+type IEnumDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Identifier() IIdentifierContext
-	Function() IFunctionContext
-	Expression() IExpressionContext
-	StageDeclaration() IStageDeclarationContext
-	SequenceDeclaration() ISequenceDeclarationContext
-	NEXT() antlr.TerminalNode
+	ENUM() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	IntegerType() IIntegerTypeContext
+	EnumMemberList() IEnumMemberListContext
 
-	// IsFlowNodeContext differentiates from other interfaces.
-	IsFlowNodeContext()
+	// IsEnumDeclarationContext differentiates from other interfaces.
+	IsEnumDeclarationContext()
 }
 
-type FlowNodeContext struct {
+type EnumDeclarationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFlowNodeContext() *FlowNodeContext {
-	var p = new(FlowNodeContext)
+func NewEmptyEnumDeclarationContext() *EnumDeclarationContext {
+	var p = new(EnumDeclarationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_flowNode
+	p.RuleIndex = ArcParserRULE_enumDeclaration
 	return p
 }
 
-func InitEmptyFlowNodeContext(p *FlowNodeContext) {
+func InitEmptyEnumDeclarationContext(p *EnumDeclarationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_flowNode
+	p.RuleIndex = ArcParserRULE_enumDeclaration
 }
 
-func (*FlowNodeContext) IsFlowNodeContext() {}
+func (*EnumDeclarationContext) IsEnumDeclarationContext() {}
 
-func NewFlowNodeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FlowNodeContext {
-	var p = new(FlowNodeContext)
+func NewEnumDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumDeclarationContext {
+	var p = new(EnumDeclarationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_flowNode
+	p.RuleIndex = ArcParserRULE_enumDeclaration
 
 	return p
 }
 
-func (s *FlowNodeContext) GetParser() antlr.Parser { return s.parser }
+func (s *EnumDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FlowNodeContext) Identifier() IIdentifierContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentifierContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *EnumDeclarationContext) ENUM() antlr.TerminalNode {
+	return s.GetToken(ArcParserENUM, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *EnumDeclarationContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
 
-	return t.(IIdentifierContext)
+func (s *EnumDeclarationContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
 }
 
-func (s *FlowNodeContext) Function() IFunctionContext {
+func (s *EnumDeclarationContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
+}
+
+func (s *EnumDeclarationContext) IntegerType() IIntegerTypeContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFunctionContext); ok {
+		if _, ok := ctx.(IIntegerTypeContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6181,13 +6145,13 @@ func (s *FlowNodeContext) Function() IFunctionContext {
 		return nil
 	}
 
-	return t.(IFunctionContext)
+	return t.(IIntegerTypeContext)
 }
 
-func (s *FlowNodeContext) Expression() IExpressionContext {
+func (s *EnumDeclarationContext) EnumMemberList() IEnumMemberListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IEnumMemberListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6197,133 +6161,104 @@ func (s *FlowNodeContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IEnumMemberListContext)
 }
 
-func (s *FlowNodeContext) StageDeclaration() IStageDeclarationContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStageDeclarationContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *EnumDeclarationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	if t == nil {
-		return nil
+func (s *EnumDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EnumDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterEnumDeclaration(s)
 	}
+}
 
-	return t.(IStageDeclarationContext)
+func (s *EnumDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitEnumDeclaration(s)
+	}
 }
 
-func (s *FlowNodeContext) SequenceDeclaration() ISequenceDeclarationContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISequenceDeclarationContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISequenceDeclarationContext)
-}
-
-func (s *FlowNodeContext) NEXT() antlr.TerminalNode {
-	return s.GetToken(ArcParserNEXT, 0)
-}
-
-func (s *FlowNodeContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *FlowNodeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *FlowNodeContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterFlowNode(s)
-	}
-}
-
-func (s *FlowNodeContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitFlowNode(s)
-	}
-}
-
-func (s *FlowNodeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *EnumDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitFlowNode(s)
+		return t.VisitEnumDeclaration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) FlowNode() (localctx IFlowNodeContext) {
-	localctx = NewFlowNodeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, ArcParserRULE_flowNode)
-	p.SetState(419)
+func (p *ArcParser) EnumDeclaration() (localctx IEnumDeclarationContext) {
+	localctx = NewEnumDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, ArcParserRULE_enumDeclaration)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(433)
+		p.Match(ArcParserENUM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(434)
+		p.Match(ArcParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(436)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 43, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(413)
-			p.Identifier()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(414)
-			p.Function()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&133693440) != 0 {
 		{
-			p.SetState(415)
-			p.Expression()
+			p.SetState(435)
+			p.IntegerType()
 		}
 
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(416)
-			p.StageDeclaration()
+	}
+	{
+		p.SetState(438)
+		p.Match(ArcParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
+	}
+	p.SetState(440)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
+	if _la == ArcParserIDENTIFIER {
 		{
-			p.SetState(417)
-			p.SequenceDeclaration()
+			p.SetState(439)
+			p.EnumMemberList()
 		}
 
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(418)
-			p.Match(ArcParserNEXT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+	}
+	{
+		p.SetState(442)
+		p.Match(ArcParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -6339,98 +6274,199 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IIdentifierContext is an interface to support dynamic dispatch.
-type IIdentifierContext interface {
+// IEnumMemberListContext is an interface to support dynamic dispatch.
+type IEnumMemberListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
+	AllEnumMember() []IEnumMemberContext
+	EnumMember(i int) IEnumMemberContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsIdentifierContext differentiates from other interfaces.
-	IsIdentifierContext()
+	// IsEnumMemberListContext differentiates from other interfaces.
+	IsEnumMemberListContext()
 }
 
-type IdentifierContext struct {
+type EnumMemberListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyIdentifierContext() *IdentifierContext {
-	var p = new(IdentifierContext)
+func NewEmptyEnumMemberListContext() *EnumMemberListContext {
+	var p = new(EnumMemberListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_identifier
+	p.RuleIndex = ArcParserRULE_enumMemberList
 	return p
 }
 
-func InitEmptyIdentifierContext(p *IdentifierContext) {
+func InitEmptyEnumMemberListContext(p *EnumMemberListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_identifier
+	p.RuleIndex = ArcParserRULE_enumMemberList
 }
 
-func (*IdentifierContext) IsIdentifierContext() {}
+func (*EnumMemberListContext) IsEnumMemberListContext() {}
 
-func NewIdentifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierContext {
-	var p = new(IdentifierContext)
+func NewEnumMemberListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumMemberListContext {
+	var p = new(EnumMemberListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_identifier
+	p.RuleIndex = ArcParserRULE_enumMemberList
 
 	return p
 }
 
-func (s *IdentifierContext) GetParser() antlr.Parser { return s.parser }
+func (s *EnumMemberListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *IdentifierContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
+func (s *EnumMemberListContext) AllEnumMember() []IEnumMemberContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IEnumMemberContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IEnumMemberContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IEnumMemberContext); ok {
+			tst[i] = t.(IEnumMemberContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *IdentifierContext) GetRuleContext() antlr.RuleContext {
+func (s *EnumMemberListContext) EnumMember(i int) IEnumMemberContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEnumMemberContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumMemberContext)
+}
+
+func (s *EnumMemberListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
+}
+
+func (s *EnumMemberListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
+}
+
+func (s *EnumMemberListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IdentifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *EnumMemberListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IdentifierContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EnumMemberListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterIdentifier(s)
+		listenerT.EnterEnumMemberList(s)
 	}
 }
 
-func (s *IdentifierContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EnumMemberListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitIdentifier(s)
+		listenerT.ExitEnumMemberList(s)
 	}
 }
 
-func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *EnumMemberListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitIdentifier(s)
+		return t.VisitEnumMemberList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Identifier() (localctx IIdentifierContext) {
-	localctx = NewIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, ArcParserRULE_identifier)
+func (p *ArcParser) EnumMemberList() (localctx IEnumMemberListContext) {
+	localctx = NewEnumMemberListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, ArcParserRULE_enumMemberList)
+	var _la int
+
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(421)
-		p.Match(ArcParserIDENTIFIER)
+		p.SetState(444)
+		p.EnumMember()
+	}
+	p.SetState(449)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(445)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(446)
+				p.EnumMember()
+			}
+
+		}
+		p.SetState(451)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext())
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(453)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == ArcParserCOMMA {
+		{
+			p.SetState(452)
+			p.Match(ArcParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
 
 errorExit:
 	if p.HasError() {
@@ -6445,158 +6481,158 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFunctionContext is an interface to support dynamic dispatch.
-type IFunctionContext interface {
+// IEnumMemberContext is an interface to support dynamic dispatch.
+type IEnumMemberContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	QualifiedIdentifier() IQualifiedIdentifierContext
-	ConfigValues() IConfigValuesContext
 	IDENTIFIER() antlr.TerminalNode
+	ASSIGN() antlr.TerminalNode
+	INTEGER_LITERAL() antlr.TerminalNode
+	MINUS() antlr.TerminalNode
 
-	// IsFunctionContext differentiates from other interfaces.
-	IsFunctionContext()
+	// IsEnumMemberContext differentiates from other interfaces.
+	IsEnumMemberContext()
 }
 
-type FunctionContext struct {
+type EnumMemberContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionContext() *FunctionContext {
-	var p = new(FunctionContext)
+func NewEmptyEnumMemberContext() *EnumMemberContext {
+	var p = new(EnumMemberContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_function
+	p.RuleIndex = ArcParserRULE_enumMember
 	return p
 }
 
-func InitEmptyFunctionContext(p *FunctionContext) {
+func InitEmptyEnumMemberContext(p *EnumMemberContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_function
+	p.RuleIndex = ArcParserRULE_enumMember
 }
 
-func (*FunctionContext) IsFunctionContext() {}
+func (*EnumMemberContext) IsEnumMemberContext() {}
 
-func NewFunctionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionContext {
-	var p = new(FunctionContext)
+func NewEnumMemberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumMemberContext {
+	var p = new(EnumMemberContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_function
+	p.RuleIndex = ArcParserRULE_enumMember
 
 	return p
 }
 
-func (s *FunctionContext) GetParser() antlr.Parser { return s.parser }
+func (s *EnumMemberContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionContext) QualifiedIdentifier() IQualifiedIdentifierContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IQualifiedIdentifierContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *EnumMemberContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IQualifiedIdentifierContext)
+func (s *EnumMemberContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserASSIGN, 0)
 }
 
-func (s *FunctionContext) ConfigValues() IConfigValuesContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConfigValuesContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IConfigValuesContext)
+func (s *EnumMemberContext) INTEGER_LITERAL() antlr.TerminalNode {
+	return s.GetToken(ArcParserINTEGER_LITERAL, 0)
 }
 
-func (s *FunctionContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
+func (s *EnumMemberContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(ArcParserMINUS, 0)
 }
 
-func (s *FunctionContext) GetRuleContext() antlr.RuleContext {
+func (s *EnumMemberContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *EnumMemberContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EnumMemberContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterFunction(s)
+		listenerT.EnterEnumMember(s)
 	}
 }
 
-func (s *FunctionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EnumMemberContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitFunction(s)
+		listenerT.ExitEnumMember(s)
 	}
 }
 
-func (s *FunctionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *EnumMemberContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitFunction(s)
+		return t.VisitEnumMember(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Function() (localctx IFunctionContext) {
-	localctx = NewFunctionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, ArcParserRULE_function)
-	p.SetState(428)
+func (p *ArcParser) EnumMember() (localctx IEnumMemberContext) {
+	localctx = NewEnumMemberContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, ArcParserRULE_enumMember)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(455)
+		p.Match(ArcParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(461)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 44, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	if _la == ArcParserASSIGN {
 		{
-			p.SetState(423)
-			p.QualifiedIdentifier()
+			p.SetState(456)
+			p.Match(ArcParserASSIGN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		{
-			p.SetState(424)
-			p.ConfigValues()
+		p.SetState(458)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+		if _la == ArcParserMINUS {
+			{
+				p.SetState(457)
+				p.Match(ArcParserMINUS)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
 		{
-			p.SetState(426)
-			p.Match(ArcParserIDENTIFIER)
+			p.SetState(460)
+			p.Match(ArcParserINTEGER_LITERAL)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		{
-			p.SetState(427)
-			p.ConfigValues()
-		}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -6612,120 +6648,139 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IQualifiedIdentifierContext is an interface to support dynamic dispatch.
-type IQualifiedIdentifierContext interface {
+// IGlobalConstantContext is an interface to support dynamic dispatch.
+type IGlobalConstantContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllIDENTIFIER() []antlr.TerminalNode
-	IDENTIFIER(i int) antlr.TerminalNode
-	DOT() antlr.TerminalNode
-	FOR() antlr.TerminalNode
-	AUTHORITY() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	DECLARE() antlr.TerminalNode
+	Literal() ILiteralContext
+	Type_() ITypeContext
 
-	// IsQualifiedIdentifierContext differentiates from other interfaces.
-	IsQualifiedIdentifierContext()
+	// IsGlobalConstantContext differentiates from other interfaces.
+	IsGlobalConstantContext()
 }
 
-type QualifiedIdentifierContext struct {
+type GlobalConstantContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyQualifiedIdentifierContext() *QualifiedIdentifierContext {
-	var p = new(QualifiedIdentifierContext)
+func NewEmptyGlobalConstantContext() *GlobalConstantContext {
+	var p = new(GlobalConstantContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_qualifiedIdentifier
+	p.RuleIndex = ArcParserRULE_globalConstant
 	return p
 }
 
-func InitEmptyQualifiedIdentifierContext(p *QualifiedIdentifierContext) {
+func InitEmptyGlobalConstantContext(p *GlobalConstantContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_qualifiedIdentifier
+	p.RuleIndex = ArcParserRULE_globalConstant
 }
 
-func (*QualifiedIdentifierContext) IsQualifiedIdentifierContext() {}
+func (*GlobalConstantContext) IsGlobalConstantContext() {}
 
-func NewQualifiedIdentifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QualifiedIdentifierContext {
-	var p = new(QualifiedIdentifierContext)
+func NewGlobalConstantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GlobalConstantContext {
+	var p = new(GlobalConstantContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_qualifiedIdentifier
+	p.RuleIndex = ArcParserRULE_globalConstant
 
 	return p
 }
 
-func (s *QualifiedIdentifierContext) GetParser() antlr.Parser { return s.parser }
+func (s *GlobalConstantContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *QualifiedIdentifierContext) AllIDENTIFIER() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserIDENTIFIER)
+func (s *GlobalConstantContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *QualifiedIdentifierContext) IDENTIFIER(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, i)
+func (s *GlobalConstantContext) DECLARE() antlr.TerminalNode {
+	return s.GetToken(ArcParserDECLARE, 0)
 }
 
-func (s *QualifiedIdentifierContext) DOT() antlr.TerminalNode {
-	return s.GetToken(ArcParserDOT, 0)
-}
+func (s *GlobalConstantContext) Literal() ILiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *QualifiedIdentifierContext) FOR() antlr.TerminalNode {
-	return s.GetToken(ArcParserFOR, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralContext)
 }
 
-func (s *QualifiedIdentifierContext) AUTHORITY() antlr.TerminalNode {
-	return s.GetToken(ArcParserAUTHORITY, 0)
+func (s *GlobalConstantContext) Type_() ITypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeContext)
 }
 
-func (s *QualifiedIdentifierContext) GetRuleContext() antlr.RuleContext {
+func (s *GlobalConstantContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *QualifiedIdentifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GlobalConstantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *QualifiedIdentifierContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GlobalConstantContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterQualifiedIdentifier(s)
+		listenerT.EnterGlobalConstant(s)
 	}
 }
 
-func (s *QualifiedIdentifierContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GlobalConstantContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitQualifiedIdentifier(s)
+		listenerT.ExitGlobalConstant(s)
 	}
 }
 
-func (s *QualifiedIdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GlobalConstantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitQualifiedIdentifier(s)
+		return t.VisitGlobalConstant(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) QualifiedIdentifier() (localctx IQualifiedIdentifierContext) {
-	localctx = NewQualifiedIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, ArcParserRULE_qualifiedIdentifier)
-	p.SetState(439)
+func (p *ArcParser) GlobalConstant() (localctx IGlobalConstantContext) {
+	localctx = NewGlobalConstantContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, ArcParserRULE_globalConstant)
+	p.SetState(471)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 45, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 46, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(430)
+			p.SetState(463)
 			p.Match(ArcParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6733,26 +6788,22 @@ func (p *ArcParser) QualifiedIdentifier() (localctx IQualifiedIdentifierContext)
 			}
 		}
 		{
-			p.SetState(431)
-			p.Match(ArcParserDOT)
+			p.SetState(464)
+			p.Match(ArcParserDECLARE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(432)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(465)
+			p.Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(433)
+			p.SetState(466)
 			p.Match(ArcParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6760,47 +6811,20 @@ func (p *ArcParser) QualifiedIdentifier() (localctx IQualifiedIdentifierContext)
 			}
 		}
 		{
-			p.SetState(434)
-			p.Match(ArcParserDOT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(435)
-			p.Match(ArcParserFOR)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(436)
-			p.Match(ArcParserAUTHORITY)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(467)
+			p.Type_()
 		}
 		{
-			p.SetState(437)
-			p.Match(ArcParserDOT)
+			p.SetState(468)
+			p.Match(ArcParserDECLARE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(438)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(469)
+			p.Literal()
 		}
 
 	case antlr.ATNInvalidAltNumber:
@@ -6820,69 +6844,129 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConfigValuesContext is an interface to support dynamic dispatch.
-type IConfigValuesContext interface {
+// IFlowStatementContext is an interface to support dynamic dispatch.
+type IFlowStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LBRACE() antlr.TerminalNode
-	RBRACE() antlr.TerminalNode
-	NamedConfigValues() INamedConfigValuesContext
-	AnonymousConfigValues() IAnonymousConfigValuesContext
+	AllRoutingTable() []IRoutingTableContext
+	RoutingTable(i int) IRoutingTableContext
+	AllFlowNode() []IFlowNodeContext
+	FlowNode(i int) IFlowNodeContext
+	AllFlowOperator() []IFlowOperatorContext
+	FlowOperator(i int) IFlowOperatorContext
 
-	// IsConfigValuesContext differentiates from other interfaces.
-	IsConfigValuesContext()
+	// IsFlowStatementContext differentiates from other interfaces.
+	IsFlowStatementContext()
 }
 
-type ConfigValuesContext struct {
+type FlowStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConfigValuesContext() *ConfigValuesContext {
-	var p = new(ConfigValuesContext)
+func NewEmptyFlowStatementContext() *FlowStatementContext {
+	var p = new(FlowStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_configValues
+	p.RuleIndex = ArcParserRULE_flowStatement
 	return p
 }
 
-func InitEmptyConfigValuesContext(p *ConfigValuesContext) {
+func InitEmptyFlowStatementContext(p *FlowStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_configValues
+	p.RuleIndex = ArcParserRULE_flowStatement
 }
 
-func (*ConfigValuesContext) IsConfigValuesContext() {}
-
-func NewConfigValuesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConfigValuesContext {
-	var p = new(ConfigValuesContext)
+func (*FlowStatementContext) IsFlowStatementContext() {}
+
+func NewFlowStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FlowStatementContext {
+	var p = new(FlowStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_configValues
+	p.RuleIndex = ArcParserRULE_flowStatement
 
 	return p
 }
 
-func (s *ConfigValuesContext) GetParser() antlr.Parser { return s.parser }
+func (s *FlowStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ConfigValuesContext) LBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserLBRACE, 0)
+func (s *FlowStatementContext) AllRoutingTable() []IRoutingTableContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IRoutingTableContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IRoutingTableContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IRoutingTableContext); ok {
+			tst[i] = t.(IRoutingTableContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *ConfigValuesContext) RBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserRBRACE, 0)
+func (s *FlowStatementContext) RoutingTable(i int) IRoutingTableContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRoutingTableContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoutingTableContext)
 }
 
-func (s *ConfigValuesContext) NamedConfigValues() INamedConfigValuesContext {
+func (s *FlowStatementContext) AllFlowNode() []IFlowNodeContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFlowNodeContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFlowNodeContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFlowNodeContext); ok {
+			tst[i] = t.(IFlowNodeContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FlowStatementContext) FlowNode(i int) IFlowNodeContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamedConfigValuesContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IFlowNodeContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -6890,15 +6974,40 @@ func (s *ConfigValuesContext) NamedConfigValues() INamedConfigValuesContext {
 		return nil
 	}
 
-	return t.(INamedConfigValuesContext)
+	return t.(IFlowNodeContext)
 }
 
-func (s *ConfigValuesContext) AnonymousConfigValues() IAnonymousConfigValuesContext {
+func (s *FlowStatementContext) AllFlowOperator() []IFlowOperatorContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFlowOperatorContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFlowOperatorContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFlowOperatorContext); ok {
+			tst[i] = t.(IFlowOperatorContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FlowStatementContext) FlowOperator(i int) IFlowOperatorContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAnonymousConfigValuesContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IFlowOperatorContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -6906,116 +7015,110 @@ func (s *ConfigValuesContext) AnonymousConfigValues() IAnonymousConfigValuesCont
 		return nil
 	}
 
-	return t.(IAnonymousConfigValuesContext)
+	return t.(IFlowOperatorContext)
 }
 
-func (s *ConfigValuesContext) GetRuleContext() antlr.RuleContext {
+func (s *FlowStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConfigValuesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FlowStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConfigValuesContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FlowStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterConfigValues(s)
+		listenerT.EnterFlowStatement(s)
 	}
 }
 
-func (s *ConfigValuesContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FlowStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitConfigValues(s)
+		listenerT.ExitFlowStatement(s)
 	}
 }
 
-func (s *ConfigValuesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FlowStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitConfigValues(s)
+		return t.VisitFlowStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ConfigValues() (localctx IConfigValuesContext) {
-	localctx = NewConfigValuesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, ArcParserRULE_configValues)
-	p.SetState(451)
+func (p *ArcParser) FlowStatement() (localctx IFlowStatementContext) {
+	localctx = NewFlowStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, ArcParserRULE_flowStatement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(475)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 46, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(441)
-			p.Match(ArcParserLBRACE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	switch p.GetTokenStream().LA(1) {
+	case ArcParserLBRACE:
 		{
-			p.SetState(442)
-			p.Match(ArcParserRBRACE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(473)
+			p.RoutingTable()
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+	case ArcParserSEQUENCE, ArcParserSTAGE, ArcParserNEXT, ArcParserCHAN, ArcParserAUTHORITY, ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64, ArcParserF32, ArcParserF64, ArcParserSTR, ArcParserSERIES, ArcParserMINUS, ArcParserNOT, ArcParserLPAREN, ArcParserLBRACKET, ArcParserINTEGER_LITERAL, ArcParserFLOAT_LITERAL, ArcParserSTR_LITERAL_MULTI, ArcParserSTR_LITERAL, ArcParserIDENTIFIER:
 		{
-			p.SetState(443)
-			p.Match(ArcParserLBRACE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(474)
+			p.FlowNode()
 		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+	p.SetState(482)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == ArcParserARROW || _la == ArcParserTRANSITION {
 		{
-			p.SetState(444)
-			p.NamedConfigValues()
+			p.SetState(477)
+			p.FlowOperator()
 		}
-		{
-			p.SetState(445)
-			p.Match(ArcParserRBRACE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+		p.SetState(480)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(447)
-			p.Match(ArcParserLBRACE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+		switch p.GetTokenStream().LA(1) {
+		case ArcParserLBRACE:
+			{
+				p.SetState(478)
+				p.RoutingTable()
 			}
-		}
-		{
-			p.SetState(448)
-			p.AnonymousConfigValues()
-		}
-		{
-			p.SetState(449)
-			p.Match(ArcParserRBRACE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+
+		case ArcParserSEQUENCE, ArcParserSTAGE, ArcParserNEXT, ArcParserCHAN, ArcParserAUTHORITY, ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64, ArcParserF32, ArcParserF64, ArcParserSTR, ArcParserSERIES, ArcParserMINUS, ArcParserNOT, ArcParserLPAREN, ArcParserLBRACKET, ArcParserINTEGER_LITERAL, ArcParserFLOAT_LITERAL, ArcParserSTR_LITERAL_MULTI, ArcParserSTR_LITERAL, ArcParserIDENTIFIER:
+			{
+				p.SetState(479)
+				p.FlowNode()
 			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+		p.SetState(484)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -7031,69 +7134,191 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INamedConfigValuesContext is an interface to support dynamic dispatch.
-type INamedConfigValuesContext interface {
+// IFlowOperatorContext is an interface to support dynamic dispatch.
+type IFlowOperatorContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllNamedConfigValue() []INamedConfigValueContext
-	NamedConfigValue(i int) INamedConfigValueContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	ARROW() antlr.TerminalNode
+	TRANSITION() antlr.TerminalNode
 
-	// IsNamedConfigValuesContext differentiates from other interfaces.
-	IsNamedConfigValuesContext()
+	// IsFlowOperatorContext differentiates from other interfaces.
+	IsFlowOperatorContext()
 }
 
-type NamedConfigValuesContext struct {
+type FlowOperatorContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNamedConfigValuesContext() *NamedConfigValuesContext {
-	var p = new(NamedConfigValuesContext)
+func NewEmptyFlowOperatorContext() *FlowOperatorContext {
+	var p = new(FlowOperatorContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_namedConfigValues
+	p.RuleIndex = ArcParserRULE_flowOperator
 	return p
 }
 
-func InitEmptyNamedConfigValuesContext(p *NamedConfigValuesContext) {
+func InitEmptyFlowOperatorContext(p *FlowOperatorContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_namedConfigValues
+	p.RuleIndex = ArcParserRULE_flowOperator
 }
 
-func (*NamedConfigValuesContext) IsNamedConfigValuesContext() {}
+func (*FlowOperatorContext) IsFlowOperatorContext() {}
 
-func NewNamedConfigValuesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamedConfigValuesContext {
-	var p = new(NamedConfigValuesContext)
+func NewFlowOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FlowOperatorContext {
+	var p = new(FlowOperatorContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_namedConfigValues
+	p.RuleIndex = ArcParserRULE_flowOperator
 
 	return p
 }
 
-func (s *NamedConfigValuesContext) GetParser() antlr.Parser { return s.parser }
+func (s *FlowOperatorContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NamedConfigValuesContext) AllNamedConfigValue() []INamedConfigValueContext {
+func (s *FlowOperatorContext) ARROW() antlr.TerminalNode {
+	return s.GetToken(ArcParserARROW, 0)
+}
+
+func (s *FlowOperatorContext) TRANSITION() antlr.TerminalNode {
+	return s.GetToken(ArcParserTRANSITION, 0)
+}
+
+func (s *FlowOperatorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FlowOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FlowOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterFlowOperator(s)
+	}
+}
+
+func (s *FlowOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitFlowOperator(s)
+	}
+}
+
+func (s *FlowOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitFlowOperator(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) FlowOperator() (localctx IFlowOperatorContext) {
+	localctx = NewFlowOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, ArcParserRULE_flowOperator)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(486)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == ArcParserARROW || _la == ArcParserTRANSITION) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRoutingTableContext is an interface to support dynamic dispatch.
+type IRoutingTableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LBRACE() antlr.TerminalNode
+	AllRoutingEntry() []IRoutingEntryContext
+	RoutingEntry(i int) IRoutingEntryContext
+	RBRACE() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsRoutingTableContext differentiates from other interfaces.
+	IsRoutingTableContext()
+}
+
+type RoutingTableContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRoutingTableContext() *RoutingTableContext {
+	var p = new(RoutingTableContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_routingTable
+	return p
+}
+
+func InitEmptyRoutingTableContext(p *RoutingTableContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_routingTable
+}
+
+func (*RoutingTableContext) IsRoutingTableContext() {}
+
+func NewRoutingTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RoutingTableContext {
+	var p = new(RoutingTableContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_routingTable
+
+	return p
+}
+
+func (s *RoutingTableContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RoutingTableContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
+}
+
+func (s *RoutingTableContext) AllRoutingEntry() []IRoutingEntryContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(INamedConfigValueContext); ok {
+		if _, ok := ctx.(IRoutingEntryContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]INamedConfigValueContext, len)
+	tst := make([]IRoutingEntryContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(INamedConfigValueContext); ok {
-			tst[i] = t.(INamedConfigValueContext)
+		if t, ok := ctx.(IRoutingEntryContext); ok {
+			tst[i] = t.(IRoutingEntryContext)
 			i++
 		}
 	}
@@ -7101,11 +7326,11 @@ func (s *NamedConfigValuesContext) AllNamedConfigValue() []INamedConfigValueCont
 	return tst
 }
 
-func (s *NamedConfigValuesContext) NamedConfigValue(i int) INamedConfigValueContext {
+func (s *RoutingTableContext) RoutingEntry(i int) IRoutingEntryContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamedConfigValueContext); ok {
+		if _, ok := ctx.(IRoutingEntryContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -7118,72 +7343,84 @@ func (s *NamedConfigValuesContext) NamedConfigValue(i int) INamedConfigValueCont
 		return nil
 	}
 
-	return t.(INamedConfigValueContext)
+	return t.(IRoutingEntryContext)
 }
 
-func (s *NamedConfigValuesContext) AllCOMMA() []antlr.TerminalNode {
+func (s *RoutingTableContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
+}
+
+func (s *RoutingTableContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(ArcParserCOMMA)
 }
 
-func (s *NamedConfigValuesContext) COMMA(i int) antlr.TerminalNode {
+func (s *RoutingTableContext) COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(ArcParserCOMMA, i)
 }
 
-func (s *NamedConfigValuesContext) GetRuleContext() antlr.RuleContext {
+func (s *RoutingTableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NamedConfigValuesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RoutingTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NamedConfigValuesContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RoutingTableContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterNamedConfigValues(s)
+		listenerT.EnterRoutingTable(s)
 	}
 }
 
-func (s *NamedConfigValuesContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RoutingTableContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitNamedConfigValues(s)
+		listenerT.ExitRoutingTable(s)
 	}
 }
 
-func (s *NamedConfigValuesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RoutingTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitNamedConfigValues(s)
+		return t.VisitRoutingTable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) NamedConfigValues() (localctx INamedConfigValuesContext) {
-	localctx = NewNamedConfigValuesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, ArcParserRULE_namedConfigValues)
+func (p *ArcParser) RoutingTable() (localctx IRoutingTableContext) {
+	localctx = NewRoutingTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, ArcParserRULE_routingTable)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(453)
-		p.NamedConfigValue()
+		p.SetState(488)
+		p.Match(ArcParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(489)
+		p.RoutingEntry()
 	}
-	p.SetState(458)
+	p.SetState(494)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 50, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(454)
+				p.SetState(490)
 				p.Match(ArcParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7191,22 +7428,22 @@ func (p *ArcParser) NamedConfigValues() (localctx INamedConfigValuesContext) {
 				}
 			}
 			{
-				p.SetState(455)
-				p.NamedConfigValue()
+				p.SetState(491)
+				p.RoutingEntry()
 			}
 
 		}
-		p.SetState(460)
+		p.SetState(496)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 50, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(462)
+	p.SetState(498)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -7215,7 +7452,7 @@ func (p *ArcParser) NamedConfigValues() (localctx INamedConfigValuesContext) {
 
 	if _la == ArcParserCOMMA {
 		{
-			p.SetState(461)
+			p.SetState(497)
 			p.Match(ArcParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7224,6 +7461,14 @@ func (p *ArcParser) NamedConfigValues() (localctx INamedConfigValuesContext) {
 		}
 
 	}
+	{
+		p.SetState(500)
+		p.Match(ArcParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
 
 errorExit:
 	if p.HasError() {
@@ -7238,66 +7483,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INamedConfigValueContext is an interface to support dynamic dispatch.
-type INamedConfigValueContext interface {
+// IRoutingEntryContext is an interface to support dynamic dispatch.
+type IRoutingEntryContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
+	RoutingKey() IRoutingKeyContext
+	AllCOLON() []antlr.TerminalNode
+	COLON(i int) antlr.TerminalNode
+	AllFlowNode() []IFlowNodeContext
+	FlowNode(i int) IFlowNodeContext
+	AllARROW() []antlr.TerminalNode
+	ARROW(i int) antlr.TerminalNode
 	IDENTIFIER() antlr.TerminalNode
-	ASSIGN() antlr.TerminalNode
-	Expression() IExpressionContext
 
-	// IsNamedConfigValueContext differentiates from other interfaces.
-	IsNamedConfigValueContext()
+	// IsRoutingEntryContext differentiates from other interfaces.
+	IsRoutingEntryContext()
 }
 
-type NamedConfigValueContext struct {
+type RoutingEntryContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNamedConfigValueContext() *NamedConfigValueContext {
-	var p = new(NamedConfigValueContext)
+func NewEmptyRoutingEntryContext() *RoutingEntryContext {
+	var p = new(RoutingEntryContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_namedConfigValue
+	p.RuleIndex = ArcParserRULE_routingEntry
 	return p
 }
 
-func InitEmptyNamedConfigValueContext(p *NamedConfigValueContext) {
+func InitEmptyRoutingEntryContext(p *RoutingEntryContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_namedConfigValue
+	p.RuleIndex = ArcParserRULE_routingEntry
 }
 
-func (*NamedConfigValueContext) IsNamedConfigValueContext() {}
+func (*RoutingEntryContext) IsRoutingEntryContext() {}
 
-func NewNamedConfigValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamedConfigValueContext {
-	var p = new(NamedConfigValueContext)
+func NewRoutingEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RoutingEntryContext {
+	var p = new(RoutingEntryContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_namedConfigValue
+	p.RuleIndex = ArcParserRULE_routingEntry
 
 	return p
 }
 
-func (s *NamedConfigValueContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *NamedConfigValueContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
-}
-
-func (s *NamedConfigValueContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(ArcParserASSIGN, 0)
-}
+func (s *RoutingEntryContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NamedConfigValueContext) Expression() IExpressionContext {
+func (s *RoutingEntryContext) RoutingKey() IRoutingKeyContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IRoutingKeyContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7307,140 +7549,31 @@ func (s *NamedConfigValueContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
-}
-
-func (s *NamedConfigValueContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IRoutingKeyContext)
 }
 
-func (s *NamedConfigValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *RoutingEntryContext) AllCOLON() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOLON)
 }
 
-func (s *NamedConfigValueContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterNamedConfigValue(s)
-	}
+func (s *RoutingEntryContext) COLON(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOLON, i)
 }
 
-func (s *NamedConfigValueContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitNamedConfigValue(s)
-	}
-}
-
-func (s *NamedConfigValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case ArcParserVisitor:
-		return t.VisitNamedConfigValue(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-func (p *ArcParser) NamedConfigValue() (localctx INamedConfigValueContext) {
-	localctx = NewNamedConfigValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, ArcParserRULE_namedConfigValue)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(464)
-		p.Match(ArcParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(465)
-		p.Match(ArcParserASSIGN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(466)
-		p.Expression()
-	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IAnonymousConfigValuesContext is an interface to support dynamic dispatch.
-type IAnonymousConfigValuesContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	AllExpression() []IExpressionContext
-	Expression(i int) IExpressionContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
-
-	// IsAnonymousConfigValuesContext differentiates from other interfaces.
-	IsAnonymousConfigValuesContext()
-}
-
-type AnonymousConfigValuesContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyAnonymousConfigValuesContext() *AnonymousConfigValuesContext {
-	var p = new(AnonymousConfigValuesContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_anonymousConfigValues
-	return p
-}
-
-func InitEmptyAnonymousConfigValuesContext(p *AnonymousConfigValuesContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_anonymousConfigValues
-}
-
-func (*AnonymousConfigValuesContext) IsAnonymousConfigValuesContext() {}
-
-func NewAnonymousConfigValuesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AnonymousConfigValuesContext {
-	var p = new(AnonymousConfigValuesContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = ArcParserRULE_anonymousConfigValues
-
-	return p
-}
-
-func (s *AnonymousConfigValuesContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *AnonymousConfigValuesContext) AllExpression() []IExpressionContext {
+func (s *RoutingEntryContext) AllFlowNode() []IFlowNodeContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IFlowNodeContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IExpressionContext, len)
+	tst := make([]IFlowNodeContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
+		if t, ok := ctx.(IFlowNodeContext); ok {
+			tst[i] = t.(IFlowNodeContext)
 			i++
 		}
 	}
@@ -7448,11 +7581,11 @@ func (s *AnonymousConfigValuesContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *AnonymousConfigValuesContext) Expression(i int) IExpressionContext {
+func (s *RoutingEntryContext) FlowNode(i int) IFlowNodeContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IFlowNodeContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -7465,105 +7598,120 @@ func (s *AnonymousConfigValuesContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IFlowNodeContext)
 }
 
-func (s *AnonymousConfigValuesContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserCOMMA)
+func (s *RoutingEntryContext) AllARROW() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserARROW)
 }
 
-func (s *AnonymousConfigValuesContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserCOMMA, i)
+func (s *RoutingEntryContext) ARROW(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserARROW, i)
 }
 
-func (s *AnonymousConfigValuesContext) GetRuleContext() antlr.RuleContext {
+func (s *RoutingEntryContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
+
+func (s *RoutingEntryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AnonymousConfigValuesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RoutingEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AnonymousConfigValuesContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RoutingEntryContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterAnonymousConfigValues(s)
+		listenerT.EnterRoutingEntry(s)
 	}
 }
 
-func (s *AnonymousConfigValuesContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RoutingEntryContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitAnonymousConfigValues(s)
+		listenerT.ExitRoutingEntry(s)
 	}
 }
 
-func (s *AnonymousConfigValuesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RoutingEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitAnonymousConfigValues(s)
+		return t.VisitRoutingEntry(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) AnonymousConfigValues() (localctx IAnonymousConfigValuesContext) {
-	localctx = NewAnonymousConfigValuesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, ArcParserRULE_anonymousConfigValues)
+func (p *ArcParser) RoutingEntry() (localctx IRoutingEntryContext) {
+	localctx = NewRoutingEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, ArcParserRULE_routingEntry)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(468)
-		p.Expression()
+		p.SetState(502)
+		p.RoutingKey()
 	}
-	p.SetState(473)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+	{
+		p.SetState(503)
+		p.Match(ArcParserCOLON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 49, p.GetParserRuleContext())
+	{
+		p.SetState(504)
+		p.FlowNode()
+	}
+	p.SetState(509)
+	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(469)
-				p.Match(ArcParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(470)
-				p.Expression()
-			}
+	_la = p.GetTokenStream().LA(1)
 
+	for _la == ArcParserARROW {
+		{
+			p.SetState(505)
+			p.Match(ArcParserARROW)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		p.SetState(475)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(506)
+			p.FlowNode()
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 49, p.GetParserRuleContext())
+
+		p.SetState(511)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(477)
+	p.SetState(514)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserCOMMA {
+	if _la == ArcParserCOLON {
 		{
-			p.SetState(476)
-			p.Match(ArcParserCOMMA)
+			p.SetState(512)
+			p.Match(ArcParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(513)
+			p.Match(ArcParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
@@ -7585,66 +7733,61 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IArgumentsContext is an interface to support dynamic dispatch.
-type IArgumentsContext interface {
+// IRoutingKeyContext is an interface to support dynamic dispatch.
+type IRoutingKeyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LPAREN() antlr.TerminalNode
-	RPAREN() antlr.TerminalNode
-	ArgumentList() IArgumentListContext
+	IDENTIFIER() antlr.TerminalNode
+	QualifiedIdentifier() IQualifiedIdentifierContext
 
-	// IsArgumentsContext differentiates from other interfaces.
-	IsArgumentsContext()
+	// IsRoutingKeyContext differentiates from other interfaces.
+	IsRoutingKeyContext()
 }
 
-type ArgumentsContext struct {
+type RoutingKeyContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArgumentsContext() *ArgumentsContext {
-	var p = new(ArgumentsContext)
+func NewEmptyRoutingKeyContext() *RoutingKeyContext {
+	var p = new(RoutingKeyContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_arguments
+	p.RuleIndex = ArcParserRULE_routingKey
 	return p
 }
 
-func InitEmptyArgumentsContext(p *ArgumentsContext) {
+func InitEmptyRoutingKeyContext(p *RoutingKeyContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_arguments
+	p.RuleIndex = ArcParserRULE_routingKey
 }
 
-func (*ArgumentsContext) IsArgumentsContext() {}
+func (*RoutingKeyContext) IsRoutingKeyContext() {}
 
-func NewArgumentsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentsContext {
-	var p = new(ArgumentsContext)
+func NewRoutingKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RoutingKeyContext {
+	var p = new(RoutingKeyContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_arguments
+	p.RuleIndex = ArcParserRULE_routingKey
 
 	return p
 }
 
-func (s *ArgumentsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ArgumentsContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserLPAREN, 0)
-}
+func (s *RoutingKeyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArgumentsContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserRPAREN, 0)
+func (s *RoutingKeyContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *ArgumentsContext) ArgumentList() IArgumentListContext {
+func (s *RoutingKeyContext) QualifiedIdentifier() IQualifiedIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IArgumentListContext); ok {
+		if _, ok := ctx.(IQualifiedIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7654,74 +7797,69 @@ func (s *ArgumentsContext) ArgumentList() IArgumentListContext {
 		return nil
 	}
 
-	return t.(IArgumentListContext)
+	return t.(IQualifiedIdentifierContext)
 }
 
-func (s *ArgumentsContext) GetRuleContext() antlr.RuleContext {
+func (s *RoutingKeyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArgumentsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RoutingKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArgumentsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RoutingKeyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterArguments(s)
+		listenerT.EnterRoutingKey(s)
 	}
 }
 
-func (s *ArgumentsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RoutingKeyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitArguments(s)
+		listenerT.ExitRoutingKey(s)
 	}
 }
 
-func (s *ArgumentsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RoutingKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitArguments(s)
+		return t.VisitRoutingKey(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Arguments() (localctx IArgumentsContext) {
-	localctx = NewArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, ArcParserRULE_arguments)
-	var _la int
+func (p *ArcParser) RoutingKey() (localctx IRoutingKeyContext) {
+	localctx = NewRoutingKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, ArcParserRULE_routingKey)
+	p.SetState(518)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(479)
-		p.Match(ArcParserLPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 54, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(516)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-	p.SetState(481)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-13)) & ^0x3f) == 0 && ((int64(1)<<(_la-13))&8735345038344191) != 0 {
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(480)
-			p.ArgumentList()
+			p.SetState(517)
+			p.QualifiedIdentifier()
 		}
 
-	}
-	{
-		p.SetState(483)
-		p.Match(ArcParserRPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -7737,86 +7875,95 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IArgumentListContext is an interface to support dynamic dispatch.
-type IArgumentListContext interface {
+// IFlowNodeContext is an interface to support dynamic dispatch.
+type IFlowNodeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllExpression() []IExpressionContext
-	Expression(i int) IExpressionContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	Identifier() IIdentifierContext
+	Function() IFunctionContext
+	Expression() IExpressionContext
+	StageDeclaration() IStageDeclarationContext
+	SequenceDeclaration() ISequenceDeclarationContext
+	NEXT() antlr.TerminalNode
 
-	// IsArgumentListContext differentiates from other interfaces.
-	IsArgumentListContext()
+	// IsFlowNodeContext differentiates from other interfaces.
+	IsFlowNodeContext()
 }
 
-type ArgumentListContext struct {
+type FlowNodeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArgumentListContext() *ArgumentListContext {
-	var p = new(ArgumentListContext)
+func NewEmptyFlowNodeContext() *FlowNodeContext {
+	var p = new(FlowNodeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_argumentList
+	p.RuleIndex = ArcParserRULE_flowNode
 	return p
 }
 
-func InitEmptyArgumentListContext(p *ArgumentListContext) {
+func InitEmptyFlowNodeContext(p *FlowNodeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_argumentList
+	p.RuleIndex = ArcParserRULE_flowNode
 }
 
-func (*ArgumentListContext) IsArgumentListContext() {}
+func (*FlowNodeContext) IsFlowNodeContext() {}
 
-func NewArgumentListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentListContext {
-	var p = new(ArgumentListContext)
+func NewFlowNodeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FlowNodeContext {
+	var p = new(FlowNodeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_argumentList
+	p.RuleIndex = ArcParserRULE_flowNode
 
 	return p
 }
 
-func (s *ArgumentListContext) GetParser() antlr.Parser { return s.parser }
+func (s *FlowNodeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArgumentListContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
+func (s *FlowNodeContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *FlowNodeContext) Function() IFunctionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	return tst
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionContext)
 }
 
-func (s *ArgumentListContext) Expression(i int) IExpressionContext {
+func (s *FlowNodeContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -7827,108 +7974,130 @@ func (s *ArgumentListContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *ArgumentListContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserCOMMA)
+func (s *FlowNodeContext) StageDeclaration() IStageDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStageDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStageDeclarationContext)
 }
 
-func (s *ArgumentListContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserCOMMA, i)
+func (s *FlowNodeContext) SequenceDeclaration() ISequenceDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISequenceDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISequenceDeclarationContext)
 }
 
-func (s *ArgumentListContext) GetRuleContext() antlr.RuleContext {
+func (s *FlowNodeContext) NEXT() antlr.TerminalNode {
+	return s.GetToken(ArcParserNEXT, 0)
+}
+
+func (s *FlowNodeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArgumentListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FlowNodeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArgumentListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FlowNodeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterArgumentList(s)
+		listenerT.EnterFlowNode(s)
 	}
 }
 
-func (s *ArgumentListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FlowNodeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitArgumentList(s)
+		listenerT.ExitFlowNode(s)
 	}
 }
 
-func (s *ArgumentListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FlowNodeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitArgumentList(s)
+		return t.VisitFlowNode(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ArgumentList() (localctx IArgumentListContext) {
-	localctx = NewArgumentListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, ArcParserRULE_argumentList)
-	var _la int
-
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(485)
-		p.Expression()
-	}
-	p.SetState(490)
+func (p *ArcParser) FlowNode() (localctx IFlowNodeContext) {
+	localctx = NewFlowNodeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, ArcParserRULE_flowNode)
+	p.SetState(526)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 52, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(486)
-				p.Match(ArcParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(487)
-				p.Expression()
-			}
 
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 55, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(520)
+			p.Identifier()
 		}
-		p.SetState(492)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(521)
+			p.Function()
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 52, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(522)
+			p.Expression()
 		}
-	}
-	p.SetState(494)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserCOMMA {
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(493)
-			p.Match(ArcParserCOMMA)
+			p.SetState(523)
+			p.StageDeclaration()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(524)
+			p.SequenceDeclaration()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(525)
+			p.Match(ArcParserNEXT)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -7944,171 +8113,93 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IBlockContext is an interface to support dynamic dispatch.
-type IBlockContext interface {
+// IIdentifierContext is an interface to support dynamic dispatch.
+type IIdentifierContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LBRACE() antlr.TerminalNode
-	RBRACE() antlr.TerminalNode
-	AllStatement() []IStatementContext
-	Statement(i int) IStatementContext
+	IDENTIFIER() antlr.TerminalNode
 
-	// IsBlockContext differentiates from other interfaces.
-	IsBlockContext()
+	// IsIdentifierContext differentiates from other interfaces.
+	IsIdentifierContext()
 }
 
-type BlockContext struct {
+type IdentifierContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBlockContext() *BlockContext {
-	var p = new(BlockContext)
+func NewEmptyIdentifierContext() *IdentifierContext {
+	var p = new(IdentifierContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_block
+	p.RuleIndex = ArcParserRULE_identifier
 	return p
 }
 
-func InitEmptyBlockContext(p *BlockContext) {
+func InitEmptyIdentifierContext(p *IdentifierContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_block
+	p.RuleIndex = ArcParserRULE_identifier
 }
 
-func (*BlockContext) IsBlockContext() {}
+func (*IdentifierContext) IsIdentifierContext() {}
 
-func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
-	var p = new(BlockContext)
+func NewIdentifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierContext {
+	var p = new(IdentifierContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_block
+	p.RuleIndex = ArcParserRULE_identifier
 
 	return p
 }
 
-func (s *BlockContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *BlockContext) LBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserLBRACE, 0)
-}
-
-func (s *BlockContext) RBRACE() antlr.TerminalNode {
-	return s.GetToken(ArcParserRBRACE, 0)
-}
-
-func (s *BlockContext) AllStatement() []IStatementContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStatementContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IStatementContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStatementContext); ok {
-			tst[i] = t.(IStatementContext)
-			i++
-		}
-	}
-
-	return tst
-}
-
-func (s *BlockContext) Statement(i int) IStatementContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStatementContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *IdentifierContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IStatementContext)
+func (s *IdentifierContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *BlockContext) GetRuleContext() antlr.RuleContext {
+func (s *IdentifierContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IdentifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *BlockContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IdentifierContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterBlock(s)
+		listenerT.EnterIdentifier(s)
 	}
 }
 
-func (s *BlockContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IdentifierContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitBlock(s)
+		listenerT.ExitIdentifier(s)
 	}
 }
 
-func (s *BlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitBlock(s)
+		return t.VisitIdentifier(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Block() (localctx IBlockContext) {
-	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, ArcParserRULE_block)
-	var _la int
-
+func (p *ArcParser) Identifier() (localctx IIdentifierContext) {
+	localctx = NewIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, ArcParserRULE_identifier)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(496)
-		p.Match(ArcParserLBRACE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(500)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for (int64((_la-2)) & ^0x3f) == 0 && ((int64(1)<<(_la-2))&-556757435180648387) != 0 {
-		{
-			p.SetState(497)
-			p.Statement()
-		}
-
-		p.SetState(502)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(503)
-		p.Match(ArcParserRBRACE)
+		p.SetState(528)
+		p.Match(ArcParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -8128,63 +8219,58 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStatementContext is an interface to support dynamic dispatch.
-type IStatementContext interface {
+// IFunctionContext is an interface to support dynamic dispatch.
+type IFunctionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	VariableDeclaration() IVariableDeclarationContext
-	Assignment() IAssignmentContext
-	IfStatement() IIfStatementContext
-	ForStatement() IForStatementContext
-	BreakStatement() IBreakStatementContext
-	ContinueStatement() IContinueStatementContext
-	ReturnStatement() IReturnStatementContext
-	Expression() IExpressionContext
+	QualifiedIdentifier() IQualifiedIdentifierContext
+	ConfigValues() IConfigValuesContext
+	IDENTIFIER() antlr.TerminalNode
 
-	// IsStatementContext differentiates from other interfaces.
-	IsStatementContext()
+	// IsFunctionContext differentiates from other interfaces.
+	IsFunctionContext()
 }
 
-type StatementContext struct {
+type FunctionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStatementContext() *StatementContext {
-	var p = new(StatementContext)
+func NewEmptyFunctionContext() *FunctionContext {
+	var p = new(FunctionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_statement
+	p.RuleIndex = ArcParserRULE_function
 	return p
 }
 
-func InitEmptyStatementContext(p *StatementContext) {
+func InitEmptyFunctionContext(p *FunctionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_statement
+	p.RuleIndex = ArcParserRULE_function
 }
 
-func (*StatementContext) IsStatementContext() {}
+func (*FunctionContext) IsFunctionContext() {}
 
-func NewStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatementContext {
-	var p = new(StatementContext)
+func NewFunctionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionContext {
+	var p = new(FunctionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_statement
+	p.RuleIndex = ArcParserRULE_function
 
 	return p
 }
 
-func (s *StatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *FunctionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StatementContext) VariableDeclaration() IVariableDeclarationContext {
+func (s *FunctionContext) QualifiedIdentifier() IQualifiedIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IVariableDeclarationContext); ok {
+		if _, ok := ctx.(IQualifiedIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8194,13 +8280,13 @@ func (s *StatementContext) VariableDeclaration() IVariableDeclarationContext {
 		return nil
 	}
 
-	return t.(IVariableDeclarationContext)
+	return t.(IQualifiedIdentifierContext)
 }
 
-func (s *StatementContext) Assignment() IAssignmentContext {
+func (s *FunctionContext) ConfigValues() IConfigValuesContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAssignmentContext); ok {
+		if _, ok := ctx.(IConfigValuesContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8210,79 +8296,3103 @@ func (s *StatementContext) Assignment() IAssignmentContext {
 		return nil
 	}
 
-	return t.(IAssignmentContext)
+	return t.(IConfigValuesContext)
 }
 
-func (s *StatementContext) IfStatement() IIfStatementContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIfStatementContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *FunctionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *FunctionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return t.(IIfStatementContext)
+func (s *FunctionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StatementContext) ForStatement() IForStatementContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IForStatementContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (s *FunctionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterFunction(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *FunctionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitFunction(s)
 	}
-
-	return t.(IForStatementContext)
 }
 
-func (s *StatementContext) BreakStatement() IBreakStatementContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBreakStatementContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *FunctionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitFunction(s)
 
-	if t == nil {
-		return nil
+	default:
+		return t.VisitChildren(s)
 	}
-
-	return t.(IBreakStatementContext)
 }
 
-func (s *StatementContext) ContinueStatement() IContinueStatementContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IContinueStatementContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
+func (p *ArcParser) Function() (localctx IFunctionContext) {
+	localctx = NewFunctionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, ArcParserRULE_function)
+	p.SetState(535)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
 
-	return t.(IContinueStatementContext)
-}
-
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 56, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(530)
+			p.QualifiedIdentifier()
+		}
+		{
+			p.SetState(531)
+			p.ConfigValues()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(533)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(534)
+			p.ConfigValues()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IQualifiedIdentifierContext is an interface to support dynamic dispatch.
+type IQualifiedIdentifierContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllIDENTIFIER() []antlr.TerminalNode
+	IDENTIFIER(i int) antlr.TerminalNode
+	DOT() antlr.TerminalNode
+	FOR() antlr.TerminalNode
+	AUTHORITY() antlr.TerminalNode
+
+	// IsQualifiedIdentifierContext differentiates from other interfaces.
+	IsQualifiedIdentifierContext()
+}
+
+type QualifiedIdentifierContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyQualifiedIdentifierContext() *QualifiedIdentifierContext {
+	var p = new(QualifiedIdentifierContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_qualifiedIdentifier
+	return p
+}
+
+func InitEmptyQualifiedIdentifierContext(p *QualifiedIdentifierContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_qualifiedIdentifier
+}
+
+func (*QualifiedIdentifierContext) IsQualifiedIdentifierContext() {}
+
+func NewQualifiedIdentifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QualifiedIdentifierContext {
+	var p = new(QualifiedIdentifierContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_qualifiedIdentifier
+
+	return p
+}
+
+func (s *QualifiedIdentifierContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QualifiedIdentifierContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserIDENTIFIER)
+}
+
+func (s *QualifiedIdentifierContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, i)
+}
+
+func (s *QualifiedIdentifierContext) DOT() antlr.TerminalNode {
+	return s.GetToken(ArcParserDOT, 0)
+}
+
+func (s *QualifiedIdentifierContext) FOR() antlr.TerminalNode {
+	return s.GetToken(ArcParserFOR, 0)
+}
+
+func (s *QualifiedIdentifierContext) AUTHORITY() antlr.TerminalNode {
+	return s.GetToken(ArcParserAUTHORITY, 0)
+}
+
+func (s *QualifiedIdentifierContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QualifiedIdentifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *QualifiedIdentifierContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterQualifiedIdentifier(s)
+	}
+}
+
+func (s *QualifiedIdentifierContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitQualifiedIdentifier(s)
+	}
+}
+
+func (s *QualifiedIdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitQualifiedIdentifier(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) QualifiedIdentifier() (localctx IQualifiedIdentifierContext) {
+	localctx = NewQualifiedIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, ArcParserRULE_qualifiedIdentifier)
+	p.SetState(546)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(537)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(538)
+			p.Match(ArcParserDOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(539)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(540)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(541)
+			p.Match(ArcParserDOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(542)
+			p.Match(ArcParserFOR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(543)
+			p.Match(ArcParserAUTHORITY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(544)
+			p.Match(ArcParserDOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(545)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IConfigValuesContext is an interface to support dynamic dispatch.
+type IConfigValuesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	NamedConfigValues() INamedConfigValuesContext
+	AnonymousConfigValues() IAnonymousConfigValuesContext
+
+	// IsConfigValuesContext differentiates from other interfaces.
+	IsConfigValuesContext()
+}
+
+type ConfigValuesContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyConfigValuesContext() *ConfigValuesContext {
+	var p = new(ConfigValuesContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_configValues
+	return p
+}
+
+func InitEmptyConfigValuesContext(p *ConfigValuesContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_configValues
+}
+
+func (*ConfigValuesContext) IsConfigValuesContext() {}
+
+func NewConfigValuesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConfigValuesContext {
+	var p = new(ConfigValuesContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_configValues
+
+	return p
+}
+
+func (s *ConfigValuesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ConfigValuesContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
+}
+
+func (s *ConfigValuesContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
+}
+
+func (s *ConfigValuesContext) NamedConfigValues() INamedConfigValuesContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedConfigValuesContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedConfigValuesContext)
+}
+
+func (s *ConfigValuesContext) AnonymousConfigValues() IAnonymousConfigValuesContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAnonymousConfigValuesContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAnonymousConfigValuesContext)
+}
+
+func (s *ConfigValuesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ConfigValuesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ConfigValuesContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterConfigValues(s)
+	}
+}
+
+func (s *ConfigValuesContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitConfigValues(s)
+	}
+}
+
+func (s *ConfigValuesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitConfigValues(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) ConfigValues() (localctx IConfigValuesContext) {
+	localctx = NewConfigValuesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, ArcParserRULE_configValues)
+	p.SetState(558)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 58, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(548)
+			p.Match(ArcParserLBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(549)
+			p.Match(ArcParserRBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(550)
+			p.Match(ArcParserLBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(551)
+			p.NamedConfigValues()
+		}
+		{
+			p.SetState(552)
+			p.Match(ArcParserRBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(554)
+			p.Match(ArcParserLBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(555)
+			p.AnonymousConfigValues()
+		}
+		{
+			p.SetState(556)
+			p.Match(ArcParserRBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// INamedConfigValuesContext is an interface to support dynamic dispatch.
+type INamedConfigValuesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllNamedConfigValue() []INamedConfigValueContext
+	NamedConfigValue(i int) INamedConfigValueContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsNamedConfigValuesContext differentiates from other interfaces.
+	IsNamedConfigValuesContext()
+}
+
+type NamedConfigValuesContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNamedConfigValuesContext() *NamedConfigValuesContext {
+	var p = new(NamedConfigValuesContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_namedConfigValues
+	return p
+}
+
+func InitEmptyNamedConfigValuesContext(p *NamedConfigValuesContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_namedConfigValues
+}
+
+func (*NamedConfigValuesContext) IsNamedConfigValuesContext() {}
+
+func NewNamedConfigValuesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamedConfigValuesContext {
+	var p = new(NamedConfigValuesContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_namedConfigValues
+
+	return p
+}
+
+func (s *NamedConfigValuesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NamedConfigValuesContext) AllNamedConfigValue() []INamedConfigValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INamedConfigValueContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INamedConfigValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INamedConfigValueContext); ok {
+			tst[i] = t.(INamedConfigValueContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *NamedConfigValuesContext) NamedConfigValue(i int) INamedConfigValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedConfigValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedConfigValueContext)
+}
+
+func (s *NamedConfigValuesContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
+}
+
+func (s *NamedConfigValuesContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
+}
+
+func (s *NamedConfigValuesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NamedConfigValuesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NamedConfigValuesContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterNamedConfigValues(s)
+	}
+}
+
+func (s *NamedConfigValuesContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitNamedConfigValues(s)
+	}
+}
+
+func (s *NamedConfigValuesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitNamedConfigValues(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) NamedConfigValues() (localctx INamedConfigValuesContext) {
+	localctx = NewNamedConfigValuesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, ArcParserRULE_namedConfigValues)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(560)
+		p.NamedConfigValue()
+	}
+	p.SetState(565)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 59, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(561)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(562)
+				p.NamedConfigValue()
+			}
+
+		}
+		p.SetState(567)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 59, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(569)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == ArcParserCOMMA {
+		{
+			p.SetState(568)
+			p.Match(ArcParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// INamedConfigValueContext is an interface to support dynamic dispatch.
+type INamedConfigValueContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	ASSIGN() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsNamedConfigValueContext differentiates from other interfaces.
+	IsNamedConfigValueContext()
+}
+
+type NamedConfigValueContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNamedConfigValueContext() *NamedConfigValueContext {
+	var p = new(NamedConfigValueContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_namedConfigValue
+	return p
+}
+
+func InitEmptyNamedConfigValueContext(p *NamedConfigValueContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_namedConfigValue
+}
+
+func (*NamedConfigValueContext) IsNamedConfigValueContext() {}
+
+func NewNamedConfigValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamedConfigValueContext {
+	var p = new(NamedConfigValueContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_namedConfigValue
+
+	return p
+}
+
+func (s *NamedConfigValueContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NamedConfigValueContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
+
+func (s *NamedConfigValueContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserASSIGN, 0)
+}
+
+func (s *NamedConfigValueContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *NamedConfigValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NamedConfigValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NamedConfigValueContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterNamedConfigValue(s)
+	}
+}
+
+func (s *NamedConfigValueContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitNamedConfigValue(s)
+	}
+}
+
+func (s *NamedConfigValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitNamedConfigValue(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) NamedConfigValue() (localctx INamedConfigValueContext) {
+	localctx = NewNamedConfigValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, ArcParserRULE_namedConfigValue)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(571)
+		p.Match(ArcParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(572)
+		p.Match(ArcParserASSIGN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(573)
+		p.Expression()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAnonymousConfigValuesContext is an interface to support dynamic dispatch.
+type IAnonymousConfigValuesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsAnonymousConfigValuesContext differentiates from other interfaces.
+	IsAnonymousConfigValuesContext()
+}
+
+type AnonymousConfigValuesContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAnonymousConfigValuesContext() *AnonymousConfigValuesContext {
+	var p = new(AnonymousConfigValuesContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_anonymousConfigValues
+	return p
+}
+
+func InitEmptyAnonymousConfigValuesContext(p *AnonymousConfigValuesContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_anonymousConfigValues
+}
+
+func (*AnonymousConfigValuesContext) IsAnonymousConfigValuesContext() {}
+
+func NewAnonymousConfigValuesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AnonymousConfigValuesContext {
+	var p = new(AnonymousConfigValuesContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_anonymousConfigValues
+
+	return p
+}
+
+func (s *AnonymousConfigValuesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AnonymousConfigValuesContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AnonymousConfigValuesContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AnonymousConfigValuesContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
+}
+
+func (s *AnonymousConfigValuesContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
+}
+
+func (s *AnonymousConfigValuesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AnonymousConfigValuesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AnonymousConfigValuesContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterAnonymousConfigValues(s)
+	}
+}
+
+func (s *AnonymousConfigValuesContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitAnonymousConfigValues(s)
+	}
+}
+
+func (s *AnonymousConfigValuesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitAnonymousConfigValues(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) AnonymousConfigValues() (localctx IAnonymousConfigValuesContext) {
+	localctx = NewAnonymousConfigValuesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, ArcParserRULE_anonymousConfigValues)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(575)
+		p.Expression()
+	}
+	p.SetState(580)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 61, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(576)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(577)
+				p.Expression()
+			}
+
+		}
+		p.SetState(582)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 61, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(584)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == ArcParserCOMMA {
+		{
+			p.SetState(583)
+			p.Match(ArcParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IArgumentsContext is an interface to support dynamic dispatch.
+type IArgumentsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAREN() antlr.TerminalNode
+	RPAREN() antlr.TerminalNode
+	ArgumentList() IArgumentListContext
+
+	// IsArgumentsContext differentiates from other interfaces.
+	IsArgumentsContext()
+}
+
+type ArgumentsContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArgumentsContext() *ArgumentsContext {
+	var p = new(ArgumentsContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_arguments
+	return p
+}
+
+func InitEmptyArgumentsContext(p *ArgumentsContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_arguments
+}
+
+func (*ArgumentsContext) IsArgumentsContext() {}
+
+func NewArgumentsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentsContext {
+	var p = new(ArgumentsContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_arguments
+
+	return p
+}
+
+func (s *ArgumentsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgumentsContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserLPAREN, 0)
+}
+
+func (s *ArgumentsContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserRPAREN, 0)
+}
+
+func (s *ArgumentsContext) ArgumentList() IArgumentListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArgumentListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgumentListContext)
+}
+
+func (s *ArgumentsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArgumentsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgumentsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterArguments(s)
+	}
+}
+
+func (s *ArgumentsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitArguments(s)
+	}
+}
+
+func (s *ArgumentsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitArguments(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) Arguments() (localctx IArgumentsContext) {
+	localctx = NewArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, ArcParserRULE_arguments)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(586)
+		p.Match(ArcParserLPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(588)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64((_la-14)) & ^0x3f) == 0 && ((int64(1)<<(_la-14))&69882760306753507) != 0 {
+		{
+			p.SetState(587)
+			p.ArgumentList()
+		}
+
+	}
+	{
+		p.SetState(590)
+		p.Match(ArcParserRPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IArgumentListContext is an interface to support dynamic dispatch.
+type IArgumentListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsArgumentListContext differentiates from other interfaces.
+	IsArgumentListContext()
+}
+
+type ArgumentListContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArgumentListContext() *ArgumentListContext {
+	var p = new(ArgumentListContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_argumentList
+	return p
+}
+
+func InitEmptyArgumentListContext(p *ArgumentListContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_argumentList
+}
+
+func (*ArgumentListContext) IsArgumentListContext() {}
+
+func NewArgumentListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentListContext {
+	var p = new(ArgumentListContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_argumentList
+
+	return p
+}
+
+func (s *ArgumentListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgumentListContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ArgumentListContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ArgumentListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
+}
+
+func (s *ArgumentListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
+}
+
+func (s *ArgumentListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArgumentListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgumentListContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterArgumentList(s)
+	}
+}
+
+func (s *ArgumentListContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitArgumentList(s)
+	}
+}
+
+func (s *ArgumentListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitArgumentList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) ArgumentList() (localctx IArgumentListContext) {
+	localctx = NewArgumentListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, ArcParserRULE_argumentList)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(592)
+		p.Expression()
+	}
+	p.SetState(597)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 64, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(593)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(594)
+				p.Expression()
+			}
+
+		}
+		p.SetState(599)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 64, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(601)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == ArcParserCOMMA {
+		{
+			p.SetState(600)
+			p.Match(ArcParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IBlockContext is an interface to support dynamic dispatch.
+type IBlockContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	AllStatement() []IStatementContext
+	Statement(i int) IStatementContext
+
+	// IsBlockContext differentiates from other interfaces.
+	IsBlockContext()
+}
+
+type BlockContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBlockContext() *BlockContext {
+	var p = new(BlockContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_block
+	return p
+}
+
+func InitEmptyBlockContext(p *BlockContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_block
+}
+
+func (*BlockContext) IsBlockContext() {}
+
+func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
+	var p = new(BlockContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_block
+
+	return p
+}
+
+func (s *BlockContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BlockContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
+}
+
+func (s *BlockContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
+}
+
+func (s *BlockContext) AllStatement() []IStatementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatementContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatementContext); ok {
+			tst[i] = t.(IStatementContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BlockContext) Statement(i int) IStatementContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *BlockContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BlockContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterBlock(s)
+	}
+}
+
+func (s *BlockContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitBlock(s)
+	}
+}
+
+func (s *BlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitBlock(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) Block() (localctx IBlockContext) {
+	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, ArcParserRULE_block)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(603)
+		p.Match(ArcParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(607)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1261012295857258996) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&31) != 0) {
+		{
+			p.SetState(604)
+			p.Statement()
+		}
+
+		p.SetState(609)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(610)
+		p.Match(ArcParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IStatementContext is an interface to support dynamic dispatch.
+type IStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	VariableDeclaration() IVariableDeclarationContext
+	Assignment() IAssignmentContext
+	IfStatement() IIfStatementContext
+	ForStatement() IForStatementContext
+	MatchStatement() IMatchStatementContext
+	BreakStatement() IBreakStatementContext
+	ContinueStatement() IContinueStatementContext
+	ReturnStatement() IReturnStatementContext
+	Expression() IExpressionContext
+
+	// IsStatementContext differentiates from other interfaces.
+	IsStatementContext()
+}
+
+type StatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStatementContext() *StatementContext {
+	var p = new(StatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_statement
+	return p
+}
+
+func InitEmptyStatementContext(p *StatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_statement
+}
+
+func (*StatementContext) IsStatementContext() {}
+
+func NewStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatementContext {
+	var p = new(StatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_statement
+
+	return p
+}
+
+func (s *StatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StatementContext) VariableDeclaration() IVariableDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVariableDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableDeclarationContext)
+}
+
+func (s *StatementContext) Assignment() IAssignmentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAssignmentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAssignmentContext)
+}
+
+func (s *StatementContext) IfStatement() IIfStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIfStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIfStatementContext)
+}
+
+func (s *StatementContext) ForStatement() IForStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IForStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IForStatementContext)
+}
+
+func (s *StatementContext) MatchStatement() IMatchStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMatchStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchStatementContext)
+}
+
+func (s *StatementContext) BreakStatement() IBreakStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBreakStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBreakStatementContext)
+}
+
+func (s *StatementContext) ContinueStatement() IContinueStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IContinueStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IContinueStatementContext)
+}
+
 func (s *StatementContext) ReturnStatement() IReturnStatementContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IReturnStatementContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IReturnStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IReturnStatementContext)
+}
+
+func (s *StatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *StatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterStatement(s)
+	}
+}
+
+func (s *StatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitStatement(s)
+	}
+}
+
+func (s *StatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) Statement() (localctx IStatementContext) {
+	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, ArcParserRULE_statement)
+	p.SetState(621)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 67, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(612)
+			p.VariableDeclaration()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(613)
+			p.Assignment()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(614)
+			p.IfStatement()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(615)
+			p.ForStatement()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(616)
+			p.MatchStatement()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(617)
+			p.BreakStatement()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(618)
+			p.ContinueStatement()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(619)
+			p.ReturnStatement()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(620)
+			p.Expression()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IVariableDeclarationContext is an interface to support dynamic dispatch.
+type IVariableDeclarationContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LocalVariable() ILocalVariableContext
+	StatefulVariable() IStatefulVariableContext
+
+	// IsVariableDeclarationContext differentiates from other interfaces.
+	IsVariableDeclarationContext()
+}
+
+type VariableDeclarationContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVariableDeclarationContext() *VariableDeclarationContext {
+	var p = new(VariableDeclarationContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_variableDeclaration
+	return p
+}
+
+func InitEmptyVariableDeclarationContext(p *VariableDeclarationContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_variableDeclaration
+}
+
+func (*VariableDeclarationContext) IsVariableDeclarationContext() {}
+
+func NewVariableDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableDeclarationContext {
+	var p = new(VariableDeclarationContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_variableDeclaration
+
+	return p
+}
+
+func (s *VariableDeclarationContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *VariableDeclarationContext) LocalVariable() ILocalVariableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILocalVariableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILocalVariableContext)
+}
+
+func (s *VariableDeclarationContext) StatefulVariable() IStatefulVariableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatefulVariableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatefulVariableContext)
+}
+
+func (s *VariableDeclarationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VariableDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *VariableDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterVariableDeclaration(s)
+	}
+}
+
+func (s *VariableDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitVariableDeclaration(s)
+	}
+}
+
+func (s *VariableDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitVariableDeclaration(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) VariableDeclaration() (localctx IVariableDeclarationContext) {
+	localctx = NewVariableDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, ArcParserRULE_variableDeclaration)
+	p.SetState(625)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 68, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(623)
+			p.LocalVariable()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(624)
+			p.StatefulVariable()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ILocalVariableContext is an interface to support dynamic dispatch.
+type ILocalVariableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	DECLARE() antlr.TerminalNode
+	Expression() IExpressionContext
+	Type_() ITypeContext
+
+	// IsLocalVariableContext differentiates from other interfaces.
+	IsLocalVariableContext()
+}
+
+type LocalVariableContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLocalVariableContext() *LocalVariableContext {
+	var p = new(LocalVariableContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_localVariable
+	return p
+}
+
+func InitEmptyLocalVariableContext(p *LocalVariableContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_localVariable
+}
+
+func (*LocalVariableContext) IsLocalVariableContext() {}
+
+func NewLocalVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LocalVariableContext {
+	var p = new(LocalVariableContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_localVariable
+
+	return p
+}
+
+func (s *LocalVariableContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LocalVariableContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
+
+func (s *LocalVariableContext) DECLARE() antlr.TerminalNode {
+	return s.GetToken(ArcParserDECLARE, 0)
+}
+
+func (s *LocalVariableContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *LocalVariableContext) Type_() ITypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeContext)
+}
+
+func (s *LocalVariableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LocalVariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LocalVariableContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterLocalVariable(s)
+	}
+}
+
+func (s *LocalVariableContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitLocalVariable(s)
+	}
+}
+
+func (s *LocalVariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitLocalVariable(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) LocalVariable() (localctx ILocalVariableContext) {
+	localctx = NewLocalVariableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, ArcParserRULE_localVariable)
+	p.SetState(635)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 69, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(627)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(628)
+			p.Match(ArcParserDECLARE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(629)
+			p.Expression()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(630)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(631)
+			p.Type_()
+		}
+		{
+			p.SetState(632)
+			p.Match(ArcParserDECLARE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(633)
+			p.Expression()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IStatefulVariableContext is an interface to support dynamic dispatch.
+type IStatefulVariableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	STATE_DECLARE() antlr.TerminalNode
+	Expression() IExpressionContext
+	Type_() ITypeContext
+
+	// IsStatefulVariableContext differentiates from other interfaces.
+	IsStatefulVariableContext()
+}
+
+type StatefulVariableContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStatefulVariableContext() *StatefulVariableContext {
+	var p = new(StatefulVariableContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_statefulVariable
+	return p
+}
+
+func InitEmptyStatefulVariableContext(p *StatefulVariableContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_statefulVariable
+}
+
+func (*StatefulVariableContext) IsStatefulVariableContext() {}
+
+func NewStatefulVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatefulVariableContext {
+	var p = new(StatefulVariableContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_statefulVariable
+
+	return p
+}
+
+func (s *StatefulVariableContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StatefulVariableContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
+
+func (s *StatefulVariableContext) STATE_DECLARE() antlr.TerminalNode {
+	return s.GetToken(ArcParserSTATE_DECLARE, 0)
+}
+
+func (s *StatefulVariableContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *StatefulVariableContext) Type_() ITypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeContext)
+}
+
+func (s *StatefulVariableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StatefulVariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StatefulVariableContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterStatefulVariable(s)
+	}
+}
+
+func (s *StatefulVariableContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitStatefulVariable(s)
+	}
+}
+
+func (s *StatefulVariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitStatefulVariable(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) StatefulVariable() (localctx IStatefulVariableContext) {
+	localctx = NewStatefulVariableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, ArcParserRULE_statefulVariable)
+	p.SetState(645)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 70, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(637)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(638)
+			p.Match(ArcParserSTATE_DECLARE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(639)
+			p.Expression()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(640)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(641)
+			p.Type_()
+		}
+		{
+			p.SetState(642)
+			p.Match(ArcParserSTATE_DECLARE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(643)
+			p.Expression()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAssignmentContext is an interface to support dynamic dispatch.
+type IAssignmentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	ASSIGN() antlr.TerminalNode
+	Expression() IExpressionContext
+	IndexOrSlice() IIndexOrSliceContext
+	CompoundOp() ICompoundOpContext
+	AllMemberAccess() []IMemberAccessContext
+	MemberAccess(i int) IMemberAccessContext
+
+	// IsAssignmentContext differentiates from other interfaces.
+	IsAssignmentContext()
+}
+
+type AssignmentContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAssignmentContext() *AssignmentContext {
+	var p = new(AssignmentContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_assignment
+	return p
+}
+
+func InitEmptyAssignmentContext(p *AssignmentContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_assignment
+}
+
+func (*AssignmentContext) IsAssignmentContext() {}
+
+func NewAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignmentContext {
+	var p = new(AssignmentContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_assignment
+
+	return p
+}
+
+func (s *AssignmentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AssignmentContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
+
+func (s *AssignmentContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserASSIGN, 0)
+}
+
+func (s *AssignmentContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AssignmentContext) IndexOrSlice() IIndexOrSliceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIndexOrSliceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIndexOrSliceContext)
+}
+
+func (s *AssignmentContext) CompoundOp() ICompoundOpContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompoundOpContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompoundOpContext)
+}
+
+func (s *AssignmentContext) AllMemberAccess() []IMemberAccessContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMemberAccessContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMemberAccessContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMemberAccessContext); ok {
+			tst[i] = t.(IMemberAccessContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AssignmentContext) MemberAccess(i int) IMemberAccessContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMemberAccessContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMemberAccessContext)
+}
+
+func (s *AssignmentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterAssignment(s)
+	}
+}
+
+func (s *AssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitAssignment(s)
+	}
+}
+
+func (s *AssignmentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitAssignment(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) Assignment() (localctx IAssignmentContext) {
+	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, ArcParserRULE_assignment)
+	var _la int
+
+	p.SetState(682)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 73, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(647)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(648)
+			p.Match(ArcParserASSIGN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(649)
+			p.Expression()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(650)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(651)
+			p.IndexOrSlice()
+		}
+		{
+			p.SetState(652)
+			p.Match(ArcParserASSIGN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(653)
+			p.Expression()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(655)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(656)
+			p.CompoundOp()
+		}
+		{
+			p.SetState(657)
+			p.Expression()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(659)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(660)
+			p.IndexOrSlice()
+		}
+		{
+			p.SetState(661)
+			p.CompoundOp()
+		}
+		{
+			p.SetState(662)
+			p.Expression()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(664)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(666)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == ArcParserDOT {
+			{
+				p.SetState(665)
+				p.MemberAccess()
+			}
+
+			p.SetState(668)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(670)
+			p.Match(ArcParserASSIGN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(671)
+			p.Expression()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(673)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(675)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == ArcParserDOT {
+			{
+				p.SetState(674)
+				p.MemberAccess()
+			}
+
+			p.SetState(677)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(679)
+			p.CompoundOp()
+		}
+		{
+			p.SetState(680)
+			p.Expression()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICompoundOpContext is an interface to support dynamic dispatch.
+type ICompoundOpContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	PLUS_ASSIGN() antlr.TerminalNode
+	MINUS_ASSIGN() antlr.TerminalNode
+	STAR_ASSIGN() antlr.TerminalNode
+	SLASH_ASSIGN() antlr.TerminalNode
+	PERCENT_ASSIGN() antlr.TerminalNode
+
+	// IsCompoundOpContext differentiates from other interfaces.
+	IsCompoundOpContext()
+}
+
+type CompoundOpContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompoundOpContext() *CompoundOpContext {
+	var p = new(CompoundOpContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_compoundOp
+	return p
+}
+
+func InitEmptyCompoundOpContext(p *CompoundOpContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_compoundOp
+}
+
+func (*CompoundOpContext) IsCompoundOpContext() {}
+
+func NewCompoundOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompoundOpContext {
+	var p = new(CompoundOpContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_compoundOp
+
+	return p
+}
+
+func (s *CompoundOpContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompoundOpContext) PLUS_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserPLUS_ASSIGN, 0)
+}
+
+func (s *CompoundOpContext) MINUS_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserMINUS_ASSIGN, 0)
+}
+
+func (s *CompoundOpContext) STAR_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserSTAR_ASSIGN, 0)
+}
+
+func (s *CompoundOpContext) SLASH_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserSLASH_ASSIGN, 0)
+}
+
+func (s *CompoundOpContext) PERCENT_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(ArcParserPERCENT_ASSIGN, 0)
+}
+
+func (s *CompoundOpContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompoundOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompoundOpContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterCompoundOp(s)
+	}
+}
+
+func (s *CompoundOpContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitCompoundOp(s)
+	}
+}
+
+func (s *CompoundOpContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitCompoundOp(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ArcParser) CompoundOp() (localctx ICompoundOpContext) {
+	localctx = NewCompoundOpContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, ArcParserRULE_compoundOp)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(684)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2130303778816) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IIfStatementContext is an interface to support dynamic dispatch.
+type IIfStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IF() antlr.TerminalNode
+	Expression() IExpressionContext
+	Block() IBlockContext
+	AllElseIfClause() []IElseIfClauseContext
+	ElseIfClause(i int) IElseIfClauseContext
+	ElseClause() IElseClauseContext
+
+	// IsIfStatementContext differentiates from other interfaces.
+	IsIfStatementContext()
+}
+
+type IfStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyIfStatementContext() *IfStatementContext {
+	var p = new(IfStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_ifStatement
+	return p
+}
+
+func InitEmptyIfStatementContext(p *IfStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_ifStatement
+}
+
+func (*IfStatementContext) IsIfStatementContext() {}
+
+func NewIfStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfStatementContext {
+	var p = new(IfStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_ifStatement
+
+	return p
+}
+
+func (s *IfStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *IfStatementContext) IF() antlr.TerminalNode {
+	return s.GetToken(ArcParserIF, 0)
+}
+
+func (s *IfStatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *IfStatementContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *IfStatementContext) AllElseIfClause() []IElseIfClauseContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IElseIfClauseContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IElseIfClauseContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IElseIfClauseContext); ok {
+			tst[i] = t.(IElseIfClauseContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *IfStatementContext) ElseIfClause(i int) IElseIfClauseContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IElseIfClauseContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -8290,13 +11400,13 @@ func (s *StatementContext) ReturnStatement() IReturnStatementContext {
 		return nil
 	}
 
-	return t.(IReturnStatementContext)
+	return t.(IElseIfClauseContext)
 }
 
-func (s *StatementContext) Expression() IExpressionContext {
+func (s *IfStatementContext) ElseClause() IElseClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IElseClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8306,107 +11416,264 @@ func (s *StatementContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IElseClauseContext)
 }
 
-func (s *StatementContext) GetRuleContext() antlr.RuleContext {
+func (s *IfStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IfStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IfStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterStatement(s)
+		listenerT.EnterIfStatement(s)
 	}
 }
 
-func (s *StatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IfStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitStatement(s)
+		listenerT.ExitIfStatement(s)
 	}
 }
 
-func (s *StatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IfStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitStatement(s)
+		return t.VisitIfStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Statement() (localctx IStatementContext) {
-	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, ArcParserRULE_statement)
-	p.SetState(513)
+func (p *ArcParser) IfStatement() (localctx IIfStatementContext) {
+	localctx = NewIfStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, ArcParserRULE_ifStatement)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(686)
+		p.Match(ArcParserIF)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(687)
+		p.Expression()
+	}
+	{
+		p.SetState(688)
+		p.Block()
+	}
+	p.SetState(692)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 74, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(689)
+				p.ElseIfClause()
+			}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 55, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(505)
-			p.VariableDeclaration()
 		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(506)
-			p.Assignment()
+		p.SetState(694)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(507)
-			p.IfStatement()
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 74, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
 		}
+	}
+	p.SetState(696)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
+	if _la == ArcParserELSE {
 		{
-			p.SetState(508)
-			p.ForStatement()
+			p.SetState(695)
+			p.ElseClause()
 		}
 
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(509)
-			p.BreakStatement()
-		}
+	}
 
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(510)
-			p.ContinueStatement()
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IElseIfClauseContext is an interface to support dynamic dispatch.
+type IElseIfClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ELSE() antlr.TerminalNode
+	IF() antlr.TerminalNode
+	Expression() IExpressionContext
+	Block() IBlockContext
+
+	// IsElseIfClauseContext differentiates from other interfaces.
+	IsElseIfClauseContext()
+}
+
+type ElseIfClauseContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyElseIfClauseContext() *ElseIfClauseContext {
+	var p = new(ElseIfClauseContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_elseIfClause
+	return p
+}
+
+func InitEmptyElseIfClauseContext(p *ElseIfClauseContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_elseIfClause
+}
+
+func (*ElseIfClauseContext) IsElseIfClauseContext() {}
+
+func NewElseIfClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElseIfClauseContext {
+	var p = new(ElseIfClauseContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_elseIfClause
+
+	return p
+}
+
+func (s *ElseIfClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ElseIfClauseContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(ArcParserELSE, 0)
+}
+
+func (s *ElseIfClauseContext) IF() antlr.TerminalNode {
+	return s.GetToken(ArcParserIF, 0)
+}
+
+func (s *ElseIfClauseContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
+	}
 
-	case 7:
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(511)
-			p.ReturnStatement()
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ElseIfClauseContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ElseIfClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ElseIfClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ElseIfClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.EnterElseIfClause(s)
+	}
+}
+
+func (s *ElseIfClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(ArcParserListener); ok {
+		listenerT.ExitElseIfClause(s)
+	}
+}
+
+func (s *ElseIfClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ArcParserVisitor:
+		return t.VisitElseIfClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
 
-	case 8:
-		p.EnterOuterAlt(localctx, 8)
-		{
-			p.SetState(512)
-			p.Expression()
+func (p *ArcParser) ElseIfClause() (localctx IElseIfClauseContext) {
+	localctx = NewElseIfClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, ArcParserRULE_elseIfClause)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(698)
+		p.Match(ArcParserELSE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(699)
+		p.Match(ArcParserIF)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+	}
+	{
+		p.SetState(700)
+		p.Expression()
+	}
+	{
+		p.SetState(701)
+		p.Block()
 	}
 
 errorExit:
@@ -8422,73 +11689,61 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IVariableDeclarationContext is an interface to support dynamic dispatch.
-type IVariableDeclarationContext interface {
+// IElseClauseContext is an interface to support dynamic dispatch.
+type IElseClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LocalVariable() ILocalVariableContext
-	StatefulVariable() IStatefulVariableContext
+	ELSE() antlr.TerminalNode
+	Block() IBlockContext
 
-	// IsVariableDeclarationContext differentiates from other interfaces.
-	IsVariableDeclarationContext()
+	// IsElseClauseContext differentiates from other interfaces.
+	IsElseClauseContext()
 }
 
-type VariableDeclarationContext struct {
+type ElseClauseContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyVariableDeclarationContext() *VariableDeclarationContext {
-	var p = new(VariableDeclarationContext)
+func NewEmptyElseClauseContext() *ElseClauseContext {
+	var p = new(ElseClauseContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_variableDeclaration
+	p.RuleIndex = ArcParserRULE_elseClause
 	return p
 }
 
-func InitEmptyVariableDeclarationContext(p *VariableDeclarationContext) {
+func InitEmptyElseClauseContext(p *ElseClauseContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_variableDeclaration
+	p.RuleIndex = ArcParserRULE_elseClause
 }
 
-func (*VariableDeclarationContext) IsVariableDeclarationContext() {}
+func (*ElseClauseContext) IsElseClauseContext() {}
 
-func NewVariableDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableDeclarationContext {
-	var p = new(VariableDeclarationContext)
+func NewElseClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElseClauseContext {
+	var p = new(ElseClauseContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_variableDeclaration
+	p.RuleIndex = ArcParserRULE_elseClause
 
 	return p
 }
 
-func (s *VariableDeclarationContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *VariableDeclarationContext) LocalVariable() ILocalVariableContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILocalVariableContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ElseClauseContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(ILocalVariableContext)
+func (s *ElseClauseContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(ArcParserELSE, 0)
 }
 
-func (s *VariableDeclarationContext) StatefulVariable() IStatefulVariableContext {
+func (s *ElseClauseContext) Block() IBlockContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStatefulVariableContext); ok {
+		if _, ok := ctx.(IBlockContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8498,65 +11753,54 @@ func (s *VariableDeclarationContext) StatefulVariable() IStatefulVariableContext
 		return nil
 	}
 
-	return t.(IStatefulVariableContext)
+	return t.(IBlockContext)
 }
 
-func (s *VariableDeclarationContext) GetRuleContext() antlr.RuleContext {
+func (s *ElseClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VariableDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ElseClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VariableDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ElseClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterVariableDeclaration(s)
+		listenerT.EnterElseClause(s)
 	}
 }
 
-func (s *VariableDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ElseClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitVariableDeclaration(s)
+		listenerT.ExitElseClause(s)
 	}
 }
 
-func (s *VariableDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ElseClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitVariableDeclaration(s)
+		return t.VisitElseClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) VariableDeclaration() (localctx IVariableDeclarationContext) {
-	localctx = NewVariableDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, ArcParserRULE_variableDeclaration)
-	p.SetState(517)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 56, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(515)
-			p.LocalVariable()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(516)
-			p.StatefulVariable()
+func (p *ArcParser) ElseClause() (localctx IElseClauseContext) {
+	localctx = NewElseClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, ArcParserRULE_elseClause)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(703)
+		p.Match(ArcParserELSE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+	}
+	{
+		p.SetState(704)
+		p.Block()
 	}
 
 errorExit:
@@ -8572,64 +11816,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ILocalVariableContext is an interface to support dynamic dispatch.
-type ILocalVariableContext interface {
+// IMatchStatementContext is an interface to support dynamic dispatch.
+type IMatchStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	DECLARE() antlr.TerminalNode
+	MATCH() antlr.TerminalNode
 	Expression() IExpressionContext
-	Type_() ITypeContext
+	LBRACE() antlr.TerminalNode
+	RBRACE() antlr.TerminalNode
+	AllMatchArm() []IMatchArmContext
+	MatchArm(i int) IMatchArmContext
+	MatchElse() IMatchElseContext
 
-	// IsLocalVariableContext differentiates from other interfaces.
-	IsLocalVariableContext()
+	// IsMatchStatementContext differentiates from other interfaces.
+	IsMatchStatementContext()
 }
 
-type LocalVariableContext struct {
+type MatchStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLocalVariableContext() *LocalVariableContext {
-	var p = new(LocalVariableContext)
+func NewEmptyMatchStatementContext() *MatchStatementContext {
+	var p = new(MatchStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_localVariable
+	p.RuleIndex = ArcParserRULE_matchStatement
 	return p
 }
 
-func InitEmptyLocalVariableContext(p *LocalVariableContext) {
+func InitEmptyMatchStatementContext(p *MatchStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_localVariable
+	p.RuleIndex = ArcParserRULE_matchStatement
 }
 
-func (*LocalVariableContext) IsLocalVariableContext() {}
+func (*MatchStatementContext) IsMatchStatementContext() {}
 
-func NewLocalVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LocalVariableContext {
-	var p = new(LocalVariableContext)
+func NewMatchStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchStatementContext {
+	var p = new(MatchStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_localVariable
+	p.RuleIndex = ArcParserRULE_matchStatement
 
 	return p
 }
 
-func (s *LocalVariableContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *LocalVariableContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
-}
+func (s *MatchStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LocalVariableContext) DECLARE() antlr.TerminalNode {
-	return s.GetToken(ArcParserDECLARE, 0)
+func (s *MatchStatementContext) MATCH() antlr.TerminalNode {
+	return s.GetToken(ArcParserMATCH, 0)
 }
 
-func (s *LocalVariableContext) Expression() IExpressionContext {
+func (s *MatchStatementContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
@@ -8645,10 +11888,59 @@ func (s *LocalVariableContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *LocalVariableContext) Type_() ITypeContext {
+func (s *MatchStatementContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
+}
+
+func (s *MatchStatementContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
+}
+
+func (s *MatchStatementContext) AllMatchArm() []IMatchArmContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMatchArmContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMatchArmContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMatchArmContext); ok {
+			tst[i] = t.(IMatchArmContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MatchStatementContext) MatchArm(i int) IMatchArmContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeContext); ok {
+		if _, ok := ctx.(IMatchArmContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchArmContext)
+}
+
+func (s *MatchStatementContext) MatchElse() IMatchElseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMatchElseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8658,101 +11950,106 @@ func (s *LocalVariableContext) Type_() ITypeContext {
 		return nil
 	}
 
-	return t.(ITypeContext)
+	return t.(IMatchElseContext)
 }
 
-func (s *LocalVariableContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LocalVariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LocalVariableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterLocalVariable(s)
+		listenerT.EnterMatchStatement(s)
 	}
 }
 
-func (s *LocalVariableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitLocalVariable(s)
+		listenerT.ExitMatchStatement(s)
 	}
 }
 
-func (s *LocalVariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MatchStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitLocalVariable(s)
+		return t.VisitMatchStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) LocalVariable() (localctx ILocalVariableContext) {
-	localctx = NewLocalVariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, ArcParserRULE_localVariable)
-	p.SetState(527)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
+func (p *ArcParser) MatchStatement() (localctx IMatchStatementContext) {
+	localctx = NewMatchStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, ArcParserRULE_matchStatement)
+	var _la int
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(519)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(520)
-			p.Match(ArcParserDECLARE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(521)
-			p.Expression()
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(706)
+		p.Match(ArcParserMATCH)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(522)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+	}
+	{
+		p.SetState(707)
+		p.Expression()
+	}
+	{
+		p.SetState(708)
+		p.Match(ArcParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
+	}
+	p.SetState(712)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for (int64((_la-14)) & ^0x3f) == 0 && ((int64(1)<<(_la-14))&69882760306753507) != 0 {
 		{
-			p.SetState(523)
-			p.Type_()
+			p.SetState(709)
+			p.MatchArm()
 		}
-		{
-			p.SetState(524)
-			p.Match(ArcParserDECLARE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+
+		p.SetState(714)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(716)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == ArcParserELSE {
 		{
-			p.SetState(525)
-			p.Expression()
+			p.SetState(715)
+			p.MatchElse()
 		}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+	}
+	{
+		p.SetState(718)
+		p.Match(ArcParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -8768,69 +12065,88 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStatefulVariableContext is an interface to support dynamic dispatch.
-type IStatefulVariableContext interface {
+// IMatchArmContext is an interface to support dynamic dispatch.
+type IMatchArmContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	STATE_DECLARE() antlr.TerminalNode
-	Expression() IExpressionContext
-	Type_() ITypeContext
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	TRANSITION() antlr.TerminalNode
+	Block() IBlockContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsStatefulVariableContext differentiates from other interfaces.
-	IsStatefulVariableContext()
+	// IsMatchArmContext differentiates from other interfaces.
+	IsMatchArmContext()
 }
 
-type StatefulVariableContext struct {
+type MatchArmContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStatefulVariableContext() *StatefulVariableContext {
-	var p = new(StatefulVariableContext)
+func NewEmptyMatchArmContext() *MatchArmContext {
+	var p = new(MatchArmContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_statefulVariable
+	p.RuleIndex = ArcParserRULE_matchArm
 	return p
 }
 
-func InitEmptyStatefulVariableContext(p *StatefulVariableContext) {
+func InitEmptyMatchArmContext(p *MatchArmContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_statefulVariable
+	p.RuleIndex = ArcParserRULE_matchArm
 }
 
-func (*StatefulVariableContext) IsStatefulVariableContext() {}
+func (*MatchArmContext) IsMatchArmContext() {}
 
-func NewStatefulVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatefulVariableContext {
-	var p = new(StatefulVariableContext)
+func NewMatchArmContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchArmContext {
+	var p = new(MatchArmContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_statefulVariable
+	p.RuleIndex = ArcParserRULE_matchArm
 
 	return p
 }
 
-func (s *StatefulVariableContext) GetParser() antlr.Parser { return s.parser }
+func (s *MatchArmContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StatefulVariableContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
-}
+func (s *MatchArmContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
 
-func (s *StatefulVariableContext) STATE_DECLARE() antlr.TerminalNode {
-	return s.GetToken(ArcParserSTATE_DECLARE, 0)
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *StatefulVariableContext) Expression() IExpressionContext {
+func (s *MatchArmContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -8841,10 +12157,14 @@ func (s *StatefulVariableContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *StatefulVariableContext) Type_() ITypeContext {
+func (s *MatchArmContext) TRANSITION() antlr.TerminalNode {
+	return s.GetToken(ArcParserTRANSITION, 0)
+}
+
+func (s *MatchArmContext) Block() IBlockContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeContext); ok {
+		if _, ok := ctx.(IBlockContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8854,101 +12174,96 @@ func (s *StatefulVariableContext) Type_() ITypeContext {
 		return nil
 	}
 
-	return t.(ITypeContext)
+	return t.(IBlockContext)
 }
 
-func (s *StatefulVariableContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchArmContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
+}
+
+func (s *MatchArmContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
+}
+
+func (s *MatchArmContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StatefulVariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchArmContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StatefulVariableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchArmContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterStatefulVariable(s)
+		listenerT.EnterMatchArm(s)
 	}
 }
 
-func (s *StatefulVariableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchArmContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitStatefulVariable(s)
+		listenerT.ExitMatchArm(s)
 	}
 }
 
-func (s *StatefulVariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MatchArmContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitStatefulVariable(s)
+		return t.VisitMatchArm(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) StatefulVariable() (localctx IStatefulVariableContext) {
-	localctx = NewStatefulVariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, ArcParserRULE_statefulVariable)
-	p.SetState(537)
+func (p *ArcParser) MatchArm() (localctx IMatchArmContext) {
+	localctx = NewMatchArmContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, ArcParserRULE_matchArm)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(720)
+		p.Expression()
+	}
+	p.SetState(725)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 58, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(529)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	for _la == ArcParserCOMMA {
 		{
-			p.SetState(530)
-			p.Match(ArcParserSTATE_DECLARE)
+			p.SetState(721)
+			p.Match(ArcParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(531)
+			p.SetState(722)
 			p.Expression()
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(532)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(533)
-			p.Type_()
-		}
-		{
-			p.SetState(534)
-			p.Match(ArcParserSTATE_DECLARE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+		p.SetState(727)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-		{
-			p.SetState(535)
-			p.Expression()
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(728)
+		p.Match(ArcParserTRANSITION)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+	}
+	{
+		p.SetState(729)
+		p.Block()
 	}
 
 errorExit:
@@ -8964,100 +12279,66 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IAssignmentContext is an interface to support dynamic dispatch.
-type IAssignmentContext interface {
+// IMatchElseContext is an interface to support dynamic dispatch.
+type IMatchElseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	ASSIGN() antlr.TerminalNode
-	Expression() IExpressionContext
-	IndexOrSlice() IIndexOrSliceContext
-	CompoundOp() ICompoundOpContext
+	ELSE() antlr.TerminalNode
+	TRANSITION() antlr.TerminalNode
+	Block() IBlockContext
 
-	// IsAssignmentContext differentiates from other interfaces.
-	IsAssignmentContext()
+	// IsMatchElseContext differentiates from other interfaces.
+	IsMatchElseContext()
 }
 
-type AssignmentContext struct {
+type MatchElseContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAssignmentContext() *AssignmentContext {
-	var p = new(AssignmentContext)
+func NewEmptyMatchElseContext() *MatchElseContext {
+	var p = new(MatchElseContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_assignment
+	p.RuleIndex = ArcParserRULE_matchElse
 	return p
 }
 
-func InitEmptyAssignmentContext(p *AssignmentContext) {
+func InitEmptyMatchElseContext(p *MatchElseContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_assignment
+	p.RuleIndex = ArcParserRULE_matchElse
 }
 
-func (*AssignmentContext) IsAssignmentContext() {}
+func (*MatchElseContext) IsMatchElseContext() {}
 
-func NewAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignmentContext {
-	var p = new(AssignmentContext)
+func NewMatchElseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchElseContext {
+	var p = new(MatchElseContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_assignment
+	p.RuleIndex = ArcParserRULE_matchElse
 
 	return p
 }
 
-func (s *AssignmentContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *AssignmentContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
-}
-
-func (s *AssignmentContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(ArcParserASSIGN, 0)
-}
-
-func (s *AssignmentContext) Expression() IExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *MatchElseContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IExpressionContext)
+func (s *MatchElseContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(ArcParserELSE, 0)
 }
 
-func (s *AssignmentContext) IndexOrSlice() IIndexOrSliceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIndexOrSliceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIndexOrSliceContext)
+func (s *MatchElseContext) TRANSITION() antlr.TerminalNode {
+	return s.GetToken(ArcParserTRANSITION, 0)
 }
 
-func (s *AssignmentContext) CompoundOp() ICompoundOpContext {
+func (s *MatchElseContext) Block() IBlockContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICompoundOpContext); ok {
+		if _, ok := ctx.(IBlockContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9067,143 +12348,62 @@ func (s *AssignmentContext) CompoundOp() ICompoundOpContext {
 		return nil
 	}
 
-	return t.(ICompoundOpContext)
+	return t.(IBlockContext)
 }
 
-func (s *AssignmentContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchElseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchElseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchElseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterAssignment(s)
+		listenerT.EnterMatchElse(s)
 	}
 }
 
-func (s *AssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchElseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitAssignment(s)
+		listenerT.ExitMatchElse(s)
 	}
 }
 
-func (s *AssignmentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MatchElseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitAssignment(s)
+		return t.VisitMatchElse(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Assignment() (localctx IAssignmentContext) {
-	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, ArcParserRULE_assignment)
-	p.SetState(556)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 59, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(539)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(540)
-			p.Match(ArcParserASSIGN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(541)
-			p.Expression()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(542)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(543)
-			p.IndexOrSlice()
-		}
-		{
-			p.SetState(544)
-			p.Match(ArcParserASSIGN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(545)
-			p.Expression()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(547)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(548)
-			p.CompoundOp()
-		}
-		{
-			p.SetState(549)
-			p.Expression()
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(551)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(552)
-			p.IndexOrSlice()
-		}
-		{
-			p.SetState(553)
-			p.CompoundOp()
+func (p *ArcParser) MatchElse() (localctx IMatchElseContext) {
+	localctx = NewMatchElseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, ArcParserRULE_matchElse)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(731)
+		p.Match(ArcParserELSE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-		{
-			p.SetState(554)
-			p.Expression()
+	}
+	{
+		p.SetState(732)
+		p.Match(ArcParserTRANSITION)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+	}
+	{
+		p.SetState(733)
+		p.Block()
 	}
 
 errorExit:
@@ -9219,123 +12419,140 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICompoundOpContext is an interface to support dynamic dispatch.
-type ICompoundOpContext interface {
+// IForStatementContext is an interface to support dynamic dispatch.
+type IForStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PLUS_ASSIGN() antlr.TerminalNode
-	MINUS_ASSIGN() antlr.TerminalNode
-	STAR_ASSIGN() antlr.TerminalNode
-	SLASH_ASSIGN() antlr.TerminalNode
-	PERCENT_ASSIGN() antlr.TerminalNode
+	FOR() antlr.TerminalNode
+	ForClause() IForClauseContext
+	Block() IBlockContext
 
-	// IsCompoundOpContext differentiates from other interfaces.
-	IsCompoundOpContext()
+	// IsForStatementContext differentiates from other interfaces.
+	IsForStatementContext()
 }
 
-type CompoundOpContext struct {
+type ForStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCompoundOpContext() *CompoundOpContext {
-	var p = new(CompoundOpContext)
+func NewEmptyForStatementContext() *ForStatementContext {
+	var p = new(ForStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_compoundOp
+	p.RuleIndex = ArcParserRULE_forStatement
 	return p
 }
 
-func InitEmptyCompoundOpContext(p *CompoundOpContext) {
+func InitEmptyForStatementContext(p *ForStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_compoundOp
+	p.RuleIndex = ArcParserRULE_forStatement
 }
 
-func (*CompoundOpContext) IsCompoundOpContext() {}
+func (*ForStatementContext) IsForStatementContext() {}
 
-func NewCompoundOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompoundOpContext {
-	var p = new(CompoundOpContext)
+func NewForStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForStatementContext {
+	var p = new(ForStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_compoundOp
+	p.RuleIndex = ArcParserRULE_forStatement
 
 	return p
 }
 
-func (s *CompoundOpContext) GetParser() antlr.Parser { return s.parser }
+func (s *ForStatementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CompoundOpContext) PLUS_ASSIGN() antlr.TerminalNode {
-	return s.GetToken(ArcParserPLUS_ASSIGN, 0)
+func (s *ForStatementContext) FOR() antlr.TerminalNode {
+	return s.GetToken(ArcParserFOR, 0)
 }
 
-func (s *CompoundOpContext) MINUS_ASSIGN() antlr.TerminalNode {
-	return s.GetToken(ArcParserMINUS_ASSIGN, 0)
-}
+func (s *ForStatementContext) ForClause() IForClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IForClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *CompoundOpContext) STAR_ASSIGN() antlr.TerminalNode {
-	return s.GetToken(ArcParserSTAR_ASSIGN, 0)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *CompoundOpContext) SLASH_ASSIGN() antlr.TerminalNode {
-	return s.GetToken(ArcParserSLASH_ASSIGN, 0)
+	return t.(IForClauseContext)
 }
 
-func (s *CompoundOpContext) PERCENT_ASSIGN() antlr.TerminalNode {
-	return s.GetToken(ArcParserPERCENT_ASSIGN, 0)
+func (s *ForStatementContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
 }
 
-func (s *CompoundOpContext) GetRuleContext() antlr.RuleContext {
+func (s *ForStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CompoundOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ForStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CompoundOpContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ForStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterCompoundOp(s)
+		listenerT.EnterForStatement(s)
 	}
 }
 
-func (s *CompoundOpContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ForStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitCompoundOp(s)
+		listenerT.ExitForStatement(s)
 	}
 }
 
-func (s *CompoundOpContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ForStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitCompoundOp(s)
+		return t.VisitForStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) CompoundOp() (localctx ICompoundOpContext) {
-	localctx = NewCompoundOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, ArcParserRULE_compoundOp)
-	var _la int
-
+func (p *ArcParser) ForStatement() (localctx IForStatementContext) {
+	localctx = NewForStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, ArcParserRULE_forStatement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(558)
-		_la = p.GetTokenStream().LA(1)
-
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&133143986176) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		p.SetState(735)
+		p.Match(ArcParserFOR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
+	{
+		p.SetState(736)
+		p.ForClause()
+	}
+	{
+		p.SetState(737)
+		p.Block()
+	}
 
 errorExit:
 	if p.HasError() {
@@ -9350,138 +12567,76 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IIfStatementContext is an interface to support dynamic dispatch.
-type IIfStatementContext interface {
+// IForClauseContext is an interface to support dynamic dispatch.
+type IForClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IF() antlr.TerminalNode
+	AllIDENTIFIER() []antlr.TerminalNode
+	IDENTIFIER(i int) antlr.TerminalNode
+	COMMA() antlr.TerminalNode
+	DECLARE() antlr.TerminalNode
 	Expression() IExpressionContext
-	Block() IBlockContext
-	AllElseIfClause() []IElseIfClauseContext
-	ElseIfClause(i int) IElseIfClauseContext
-	ElseClause() IElseClauseContext
 
-	// IsIfStatementContext differentiates from other interfaces.
-	IsIfStatementContext()
+	// IsForClauseContext differentiates from other interfaces.
+	IsForClauseContext()
 }
 
-type IfStatementContext struct {
+type ForClauseContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyIfStatementContext() *IfStatementContext {
-	var p = new(IfStatementContext)
+func NewEmptyForClauseContext() *ForClauseContext {
+	var p = new(ForClauseContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_ifStatement
+	p.RuleIndex = ArcParserRULE_forClause
 	return p
 }
 
-func InitEmptyIfStatementContext(p *IfStatementContext) {
+func InitEmptyForClauseContext(p *ForClauseContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_ifStatement
+	p.RuleIndex = ArcParserRULE_forClause
 }
 
-func (*IfStatementContext) IsIfStatementContext() {}
+func (*ForClauseContext) IsForClauseContext() {}
 
-func NewIfStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfStatementContext {
-	var p = new(IfStatementContext)
+func NewForClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForClauseContext {
+	var p = new(ForClauseContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_ifStatement
+	p.RuleIndex = ArcParserRULE_forClause
 
 	return p
 }
 
-func (s *IfStatementContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *IfStatementContext) IF() antlr.TerminalNode {
-	return s.GetToken(ArcParserIF, 0)
-}
-
-func (s *IfStatementContext) Expression() IExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ForClauseContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IExpressionContext)
+func (s *ForClauseContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserIDENTIFIER)
 }
 
-func (s *IfStatementContext) Block() IBlockContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBlockContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IBlockContext)
+func (s *ForClauseContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, i)
 }
 
-func (s *IfStatementContext) AllElseIfClause() []IElseIfClauseContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IElseIfClauseContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IElseIfClauseContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IElseIfClauseContext); ok {
-			tst[i] = t.(IElseIfClauseContext)
-			i++
-		}
-	}
-
-	return tst
+func (s *ForClauseContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, 0)
 }
 
-func (s *IfStatementContext) ElseIfClause(i int) IElseIfClauseContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IElseIfClauseContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IElseIfClauseContext)
+func (s *ForClauseContext) DECLARE() antlr.TerminalNode {
+	return s.GetToken(ArcParserDECLARE, 0)
 }
 
-func (s *IfStatementContext) ElseClause() IElseClauseContext {
+func (s *ForClauseContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IElseClauseContext); ok {
+		if _, ok := ctx.(IExpressionContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9491,103 +12646,123 @@ func (s *IfStatementContext) ElseClause() IElseClauseContext {
 		return nil
 	}
 
-	return t.(IElseClauseContext)
+	return t.(IExpressionContext)
 }
 
-func (s *IfStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *ForClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IfStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ForClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IfStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ForClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterIfStatement(s)
+		listenerT.EnterForClause(s)
 	}
 }
 
-func (s *IfStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ForClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitIfStatement(s)
+		listenerT.ExitForClause(s)
 	}
 }
 
-func (s *IfStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ForClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitIfStatement(s)
+		return t.VisitForClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) IfStatement() (localctx IIfStatementContext) {
-	localctx = NewIfStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, ArcParserRULE_ifStatement)
-	var _la int
-
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(560)
-		p.Match(ArcParserIF)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(561)
-		p.Expression()
-	}
-	{
-		p.SetState(562)
-		p.Block()
-	}
-	p.SetState(566)
+func (p *ArcParser) ForClause() (localctx IForClauseContext) {
+	localctx = NewForClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, ArcParserRULE_forClause)
+	p.SetState(749)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 60, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(563)
-				p.ElseIfClause()
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 79, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(739)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(740)
+			p.Match(ArcParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(741)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
+		}
+		{
+			p.SetState(742)
+			p.Match(ArcParserDECLARE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(743)
+			p.Expression()
+		}
 
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(744)
+			p.Match(ArcParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		p.SetState(568)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(745)
+			p.Match(ArcParserDECLARE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 60, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(746)
+			p.Expression()
 		}
-	}
-	p.SetState(570)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserELSE {
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(569)
-			p.ElseClause()
+			p.SetState(747)
+			p.Expression()
 		}
 
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -9603,153 +12778,98 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IElseIfClauseContext is an interface to support dynamic dispatch.
-type IElseIfClauseContext interface {
+// IBreakStatementContext is an interface to support dynamic dispatch.
+type IBreakStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ELSE() antlr.TerminalNode
-	IF() antlr.TerminalNode
-	Expression() IExpressionContext
-	Block() IBlockContext
+	BREAK() antlr.TerminalNode
 
-	// IsElseIfClauseContext differentiates from other interfaces.
-	IsElseIfClauseContext()
+	// IsBreakStatementContext differentiates from other interfaces.
+	IsBreakStatementContext()
 }
 
-type ElseIfClauseContext struct {
+type BreakStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyElseIfClauseContext() *ElseIfClauseContext {
-	var p = new(ElseIfClauseContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_elseIfClause
-	return p
-}
-
-func InitEmptyElseIfClauseContext(p *ElseIfClauseContext) {
+func NewEmptyBreakStatementContext() *BreakStatementContext {
+	var p = new(BreakStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_elseIfClause
-}
-
-func (*ElseIfClauseContext) IsElseIfClauseContext() {}
-
-func NewElseIfClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElseIfClauseContext {
-	var p = new(ElseIfClauseContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = ArcParserRULE_elseIfClause
-
+	p.RuleIndex = ArcParserRULE_breakStatement
 	return p
 }
 
-func (s *ElseIfClauseContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ElseIfClauseContext) ELSE() antlr.TerminalNode {
-	return s.GetToken(ArcParserELSE, 0)
+func InitEmptyBreakStatementContext(p *BreakStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_breakStatement
 }
 
-func (s *ElseIfClauseContext) IF() antlr.TerminalNode {
-	return s.GetToken(ArcParserIF, 0)
-}
+func (*BreakStatementContext) IsBreakStatementContext() {}
 
-func (s *ElseIfClauseContext) Expression() IExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func NewBreakStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BreakStatementContext {
+	var p = new(BreakStatementContext)
 
-	if t == nil {
-		return nil
-	}
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	return t.(IExpressionContext)
-}
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_breakStatement
 
-func (s *ElseIfClauseContext) Block() IBlockContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBlockContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+	return p
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *BreakStatementContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IBlockContext)
+func (s *BreakStatementContext) BREAK() antlr.TerminalNode {
+	return s.GetToken(ArcParserBREAK, 0)
 }
 
-func (s *ElseIfClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *BreakStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ElseIfClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BreakStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ElseIfClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BreakStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterElseIfClause(s)
+		listenerT.EnterBreakStatement(s)
 	}
 }
 
-func (s *ElseIfClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BreakStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitElseIfClause(s)
+		listenerT.ExitBreakStatement(s)
 	}
 }
 
-func (s *ElseIfClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BreakStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitElseIfClause(s)
+		return t.VisitBreakStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ElseIfClause() (localctx IElseIfClauseContext) {
-	localctx = NewElseIfClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, ArcParserRULE_elseIfClause)
+func (p *ArcParser) BreakStatement() (localctx IBreakStatementContext) {
+	localctx = NewBreakStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 124, ArcParserRULE_breakStatement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(572)
-		p.Match(ArcParserELSE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(573)
-		p.Match(ArcParserIF)
+		p.SetState(751)
+		p.Match(ArcParserBREAK)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(574)
-		p.Expression()
-	}
-	{
-		p.SetState(575)
-		p.Block()
-	}
 
 errorExit:
 	if p.HasError() {
@@ -9764,119 +12884,98 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IElseClauseContext is an interface to support dynamic dispatch.
-type IElseClauseContext interface {
+// IContinueStatementContext is an interface to support dynamic dispatch.
+type IContinueStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ELSE() antlr.TerminalNode
-	Block() IBlockContext
+	CONTINUE() antlr.TerminalNode
 
-	// IsElseClauseContext differentiates from other interfaces.
-	IsElseClauseContext()
+	// IsContinueStatementContext differentiates from other interfaces.
+	IsContinueStatementContext()
 }
 
-type ElseClauseContext struct {
+type ContinueStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyElseClauseContext() *ElseClauseContext {
-	var p = new(ElseClauseContext)
+func NewEmptyContinueStatementContext() *ContinueStatementContext {
+	var p = new(ContinueStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_elseClause
+	p.RuleIndex = ArcParserRULE_continueStatement
 	return p
 }
 
-func InitEmptyElseClauseContext(p *ElseClauseContext) {
+func InitEmptyContinueStatementContext(p *ContinueStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_elseClause
+	p.RuleIndex = ArcParserRULE_continueStatement
 }
 
-func (*ElseClauseContext) IsElseClauseContext() {}
+func (*ContinueStatementContext) IsContinueStatementContext() {}
 
-func NewElseClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElseClauseContext {
-	var p = new(ElseClauseContext)
+func NewContinueStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ContinueStatementContext {
+	var p = new(ContinueStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_elseClause
+	p.RuleIndex = ArcParserRULE_continueStatement
 
 	return p
 }
 
-func (s *ElseClauseContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ElseClauseContext) ELSE() antlr.TerminalNode {
-	return s.GetToken(ArcParserELSE, 0)
-}
-
-func (s *ElseClauseContext) Block() IBlockContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBlockContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ContinueStatementContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IBlockContext)
+func (s *ContinueStatementContext) CONTINUE() antlr.TerminalNode {
+	return s.GetToken(ArcParserCONTINUE, 0)
 }
 
-func (s *ElseClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *ContinueStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ElseClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ContinueStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ElseClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ContinueStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterElseClause(s)
+		listenerT.EnterContinueStatement(s)
 	}
 }
 
-func (s *ElseClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ContinueStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitElseClause(s)
+		listenerT.ExitContinueStatement(s)
 	}
 }
 
-func (s *ElseClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ContinueStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitElseClause(s)
+		return t.VisitContinueStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ElseClause() (localctx IElseClauseContext) {
-	localctx = NewElseClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, ArcParserRULE_elseClause)
+func (p *ArcParser) ContinueStatement() (localctx IContinueStatementContext) {
+	localctx = NewContinueStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 126, ArcParserRULE_continueStatement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(577)
-		p.Match(ArcParserELSE)
+		p.SetState(753)
+		p.Match(ArcParserCONTINUE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(578)
-		p.Block()
-	}
 
 errorExit:
 	if p.HasError() {
@@ -9891,78 +12990,61 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IForStatementContext is an interface to support dynamic dispatch.
-type IForStatementContext interface {
+// IReturnStatementContext is an interface to support dynamic dispatch.
+type IReturnStatementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	FOR() antlr.TerminalNode
-	ForClause() IForClauseContext
-	Block() IBlockContext
+	RETURN() antlr.TerminalNode
+	Expression() IExpressionContext
 
-	// IsForStatementContext differentiates from other interfaces.
-	IsForStatementContext()
+	// IsReturnStatementContext differentiates from other interfaces.
+	IsReturnStatementContext()
 }
 
-type ForStatementContext struct {
+type ReturnStatementContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyForStatementContext() *ForStatementContext {
-	var p = new(ForStatementContext)
+func NewEmptyReturnStatementContext() *ReturnStatementContext {
+	var p = new(ReturnStatementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_forStatement
+	p.RuleIndex = ArcParserRULE_returnStatement
 	return p
 }
 
-func InitEmptyForStatementContext(p *ForStatementContext) {
+func InitEmptyReturnStatementContext(p *ReturnStatementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_forStatement
+	p.RuleIndex = ArcParserRULE_returnStatement
 }
 
-func (*ForStatementContext) IsForStatementContext() {}
+func (*ReturnStatementContext) IsReturnStatementContext() {}
 
-func NewForStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForStatementContext {
-	var p = new(ForStatementContext)
+func NewReturnStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnStatementContext {
+	var p = new(ReturnStatementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_forStatement
+	p.RuleIndex = ArcParserRULE_returnStatement
 
 	return p
 }
 
-func (s *ForStatementContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ForStatementContext) FOR() antlr.TerminalNode {
-	return s.GetToken(ArcParserFOR, 0)
-}
-
-func (s *ForStatementContext) ForClause() IForClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IForClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ReturnStatementContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IForClauseContext)
+func (s *ReturnStatementContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(ArcParserRETURN, 0)
 }
 
-func (s *ForStatementContext) Block() IBlockContext {
+func (s *ReturnStatementContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBlockContext); ok {
+		if _, ok := ctx.(IExpressionContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9972,58 +13054,62 @@ func (s *ForStatementContext) Block() IBlockContext {
 		return nil
 	}
 
-	return t.(IBlockContext)
+	return t.(IExpressionContext)
 }
 
-func (s *ForStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *ReturnStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ForStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ReturnStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ForStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ReturnStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterForStatement(s)
+		listenerT.EnterReturnStatement(s)
 	}
 }
 
-func (s *ForStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ReturnStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitForStatement(s)
+		listenerT.ExitReturnStatement(s)
 	}
 }
 
-func (s *ForStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ReturnStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitForStatement(s)
+		return t.VisitReturnStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ForStatement() (localctx IForStatementContext) {
-	localctx = NewForStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, ArcParserRULE_forStatement)
+func (p *ArcParser) ReturnStatement() (localctx IReturnStatementContext) {
+	localctx = NewReturnStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, ArcParserRULE_returnStatement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(580)
-		p.Match(ArcParserFOR)
+		p.SetState(755)
+		p.Match(ArcParserRETURN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(581)
-		p.ForClause()
-	}
-	{
-		p.SetState(582)
-		p.Block()
+	p.SetState(757)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 80, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(756)
+			p.Expression()
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
 	}
 
 errorExit:
@@ -10039,76 +13125,124 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IForClauseContext is an interface to support dynamic dispatch.
-type IForClauseContext interface {
+// ITypeContext is an interface to support dynamic dispatch.
+type ITypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllIDENTIFIER() []antlr.TerminalNode
-	IDENTIFIER(i int) antlr.TerminalNode
-	COMMA() antlr.TerminalNode
-	DECLARE() antlr.TerminalNode
-	Expression() IExpressionContext
+	PrimitiveType() IPrimitiveTypeContext
+	UnitSuffix() IUnitSuffixContext
+	ChannelType() IChannelTypeContext
+	SeriesType() ISeriesTypeContext
+	StructType() IStructTypeContext
 
-	// IsForClauseContext differentiates from other interfaces.
-	IsForClauseContext()
+	// IsTypeContext differentiates from other interfaces.
+	IsTypeContext()
 }
 
-type ForClauseContext struct {
+type TypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyForClauseContext() *ForClauseContext {
-	var p = new(ForClauseContext)
+func NewEmptyTypeContext() *TypeContext {
+	var p = new(TypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_forClause
+	p.RuleIndex = ArcParserRULE_type
 	return p
 }
 
-func InitEmptyForClauseContext(p *ForClauseContext) {
+func InitEmptyTypeContext(p *TypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_forClause
+	p.RuleIndex = ArcParserRULE_type
 }
 
-func (*ForClauseContext) IsForClauseContext() {}
+func (*TypeContext) IsTypeContext() {}
 
-func NewForClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForClauseContext {
-	var p = new(ForClauseContext)
+func NewTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeContext {
+	var p = new(TypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_forClause
+	p.RuleIndex = ArcParserRULE_type
 
 	return p
 }
 
-func (s *ForClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ForClauseContext) AllIDENTIFIER() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserIDENTIFIER)
+func (s *TypeContext) PrimitiveType() IPrimitiveTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimitiveTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimitiveTypeContext)
 }
 
-func (s *ForClauseContext) IDENTIFIER(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, i)
+func (s *TypeContext) UnitSuffix() IUnitSuffixContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnitSuffixContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnitSuffixContext)
 }
 
-func (s *ForClauseContext) COMMA() antlr.TerminalNode {
-	return s.GetToken(ArcParserCOMMA, 0)
+func (s *TypeContext) ChannelType() IChannelTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IChannelTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IChannelTypeContext)
 }
 
-func (s *ForClauseContext) DECLARE() antlr.TerminalNode {
-	return s.GetToken(ArcParserDECLARE, 0)
+func (s *TypeContext) SeriesType() ISeriesTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISeriesTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISeriesTypeContext)
 }
 
-func (s *ForClauseContext) Expression() IExpressionContext {
+func (s *TypeContext) StructType() IStructTypeContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IStructTypeContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10118,122 +13252,95 @@ func (s *ForClauseContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IStructTypeContext)
 }
 
-func (s *ForClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ForClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ForClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterForClause(s)
+		listenerT.EnterType(s)
 	}
 }
 
-func (s *ForClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitForClause(s)
+		listenerT.ExitType(s)
 	}
 }
 
-func (s *ForClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitForClause(s)
+		return t.VisitType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ForClause() (localctx IForClauseContext) {
-	localctx = NewForClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, ArcParserRULE_forClause)
-	p.SetState(594)
+func (p *ArcParser) Type_() (localctx ITypeContext) {
+	localctx = NewTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, ArcParserRULE_type)
+	var _la int
+
+	p.SetState(766)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 62, p.GetParserRuleContext()) {
-	case 1:
+	switch p.GetTokenStream().LA(1) {
+	case ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64, ArcParserF32, ArcParserF64, ArcParserSTR:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(584)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(585)
-			p.Match(ArcParserCOMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(759)
+			p.PrimitiveType()
 		}
-		{
-			p.SetState(586)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+		p.SetState(761)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-		{
-			p.SetState(587)
-			p.Match(ArcParserDECLARE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == ArcParserIDENTIFIER {
+			{
+				p.SetState(760)
+				p.UnitSuffix()
 			}
-		}
-		{
-			p.SetState(588)
-			p.Expression()
+
 		}
 
-	case 2:
+	case ArcParserCHAN:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(589)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(590)
-			p.Match(ArcParserDECLARE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(591)
-			p.Expression()
+			p.SetState(763)
+			p.ChannelType()
 		}
 
-	case 3:
+	case ArcParserSERIES:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(592)
-			p.Expression()
+			p.SetState(764)
+			p.SeriesType()
 		}
 
-	case 4:
+	case ArcParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(765)
+			p.StructType()
+		}
 
-	case antlr.ATNInvalidAltNumber:
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
@@ -10250,93 +13357,93 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IBreakStatementContext is an interface to support dynamic dispatch.
-type IBreakStatementContext interface {
+// IUnitSuffixContext is an interface to support dynamic dispatch.
+type IUnitSuffixContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	BREAK() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
 
-	// IsBreakStatementContext differentiates from other interfaces.
-	IsBreakStatementContext()
+	// IsUnitSuffixContext differentiates from other interfaces.
+	IsUnitSuffixContext()
 }
 
-type BreakStatementContext struct {
+type UnitSuffixContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBreakStatementContext() *BreakStatementContext {
-	var p = new(BreakStatementContext)
+func NewEmptyUnitSuffixContext() *UnitSuffixContext {
+	var p = new(UnitSuffixContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_breakStatement
+	p.RuleIndex = ArcParserRULE_unitSuffix
 	return p
 }
 
-func InitEmptyBreakStatementContext(p *BreakStatementContext) {
+func InitEmptyUnitSuffixContext(p *UnitSuffixContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_breakStatement
+	p.RuleIndex = ArcParserRULE_unitSuffix
 }
 
-func (*BreakStatementContext) IsBreakStatementContext() {}
+func (*UnitSuffixContext) IsUnitSuffixContext() {}
 
-func NewBreakStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BreakStatementContext {
-	var p = new(BreakStatementContext)
+func NewUnitSuffixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnitSuffixContext {
+	var p = new(UnitSuffixContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_breakStatement
+	p.RuleIndex = ArcParserRULE_unitSuffix
 
 	return p
 }
 
-func (s *BreakStatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *UnitSuffixContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BreakStatementContext) BREAK() antlr.TerminalNode {
-	return s.GetToken(ArcParserBREAK, 0)
+func (s *UnitSuffixContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *BreakStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *UnitSuffixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BreakStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UnitSuffixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *BreakStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnitSuffixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterBreakStatement(s)
+		listenerT.EnterUnitSuffix(s)
 	}
 }
 
-func (s *BreakStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnitSuffixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitBreakStatement(s)
+		listenerT.ExitUnitSuffix(s)
 	}
 }
 
-func (s *BreakStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UnitSuffixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitBreakStatement(s)
+		return t.VisitUnitSuffix(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) BreakStatement() (localctx IBreakStatementContext) {
-	localctx = NewBreakStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, ArcParserRULE_breakStatement)
+func (p *ArcParser) UnitSuffix() (localctx IUnitSuffixContext) {
+	localctx = NewUnitSuffixContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, ArcParserRULE_unitSuffix)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(596)
-		p.Match(ArcParserBREAK)
+		p.SetState(768)
+		p.Match(ArcParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -10356,97 +13463,134 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IContinueStatementContext is an interface to support dynamic dispatch.
-type IContinueStatementContext interface {
+// IPrimitiveTypeContext is an interface to support dynamic dispatch.
+type IPrimitiveTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	CONTINUE() antlr.TerminalNode
+	NumericType() INumericTypeContext
+	STR() antlr.TerminalNode
 
-	// IsContinueStatementContext differentiates from other interfaces.
-	IsContinueStatementContext()
+	// IsPrimitiveTypeContext differentiates from other interfaces.
+	IsPrimitiveTypeContext()
 }
 
-type ContinueStatementContext struct {
+type PrimitiveTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyContinueStatementContext() *ContinueStatementContext {
-	var p = new(ContinueStatementContext)
+func NewEmptyPrimitiveTypeContext() *PrimitiveTypeContext {
+	var p = new(PrimitiveTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_continueStatement
+	p.RuleIndex = ArcParserRULE_primitiveType
 	return p
 }
 
-func InitEmptyContinueStatementContext(p *ContinueStatementContext) {
+func InitEmptyPrimitiveTypeContext(p *PrimitiveTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_continueStatement
+	p.RuleIndex = ArcParserRULE_primitiveType
 }
 
-func (*ContinueStatementContext) IsContinueStatementContext() {}
+func (*PrimitiveTypeContext) IsPrimitiveTypeContext() {}
 
-func NewContinueStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ContinueStatementContext {
-	var p = new(ContinueStatementContext)
+func NewPrimitiveTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimitiveTypeContext {
+	var p = new(PrimitiveTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_continueStatement
+	p.RuleIndex = ArcParserRULE_primitiveType
 
 	return p
 }
 
-func (s *ContinueStatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrimitiveTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ContinueStatementContext) CONTINUE() antlr.TerminalNode {
-	return s.GetToken(ArcParserCONTINUE, 0)
+func (s *PrimitiveTypeContext) NumericType() INumericTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INumericTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INumericTypeContext)
 }
 
-func (s *ContinueStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *PrimitiveTypeContext) STR() antlr.TerminalNode {
+	return s.GetToken(ArcParserSTR, 0)
+}
+
+func (s *PrimitiveTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ContinueStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrimitiveTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ContinueStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrimitiveTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterContinueStatement(s)
+		listenerT.EnterPrimitiveType(s)
 	}
 }
 
-func (s *ContinueStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrimitiveTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitContinueStatement(s)
+		listenerT.ExitPrimitiveType(s)
 	}
 }
 
-func (s *ContinueStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrimitiveTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitContinueStatement(s)
+		return t.VisitPrimitiveType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ContinueStatement() (localctx IContinueStatementContext) {
-	localctx = NewContinueStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, ArcParserRULE_continueStatement)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(598)
-		p.Match(ArcParserCONTINUE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+func (p *ArcParser) PrimitiveType() (localctx IPrimitiveTypeContext) {
+	localctx = NewPrimitiveTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, ArcParserRULE_primitiveType)
+	p.SetState(772)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64, ArcParserF32, ArcParserF64:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(770)
+			p.NumericType()
+		}
+
+	case ArcParserSTR:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(771)
+			p.Match(ArcParserSTR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -10462,61 +13606,73 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IReturnStatementContext is an interface to support dynamic dispatch.
-type IReturnStatementContext interface {
+// INumericTypeContext is an interface to support dynamic dispatch.
+type INumericTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	RETURN() antlr.TerminalNode
-	Expression() IExpressionContext
+	IntegerType() IIntegerTypeContext
+	FloatType() IFloatTypeContext
 
-	// IsReturnStatementContext differentiates from other interfaces.
-	IsReturnStatementContext()
+	// IsNumericTypeContext differentiates from other interfaces.
+	IsNumericTypeContext()
 }
 
-type ReturnStatementContext struct {
+type NumericTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyReturnStatementContext() *ReturnStatementContext {
-	var p = new(ReturnStatementContext)
+func NewEmptyNumericTypeContext() *NumericTypeContext {
+	var p = new(NumericTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_returnStatement
+	p.RuleIndex = ArcParserRULE_numericType
 	return p
 }
 
-func InitEmptyReturnStatementContext(p *ReturnStatementContext) {
+func InitEmptyNumericTypeContext(p *NumericTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_returnStatement
+	p.RuleIndex = ArcParserRULE_numericType
 }
 
-func (*ReturnStatementContext) IsReturnStatementContext() {}
+func (*NumericTypeContext) IsNumericTypeContext() {}
 
-func NewReturnStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnStatementContext {
-	var p = new(ReturnStatementContext)
+func NewNumericTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NumericTypeContext {
+	var p = new(NumericTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_returnStatement
+	p.RuleIndex = ArcParserRULE_numericType
 
 	return p
 }
 
-func (s *ReturnStatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *NumericTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ReturnStatementContext) RETURN() antlr.TerminalNode {
-	return s.GetToken(ArcParserRETURN, 0)
+func (s *NumericTypeContext) IntegerType() IIntegerTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIntegerTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIntegerTypeContext)
 }
 
-func (s *ReturnStatementContext) Expression() IExpressionContext {
+func (s *NumericTypeContext) FloatType() IFloatTypeContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IFloatTypeContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10526,61 +13682,65 @@ func (s *ReturnStatementContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IFloatTypeContext)
 }
 
-func (s *ReturnStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *NumericTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ReturnStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NumericTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ReturnStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NumericTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterReturnStatement(s)
+		listenerT.EnterNumericType(s)
 	}
 }
 
-func (s *ReturnStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NumericTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitReturnStatement(s)
+		listenerT.ExitNumericType(s)
 	}
 }
 
-func (s *ReturnStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NumericTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitReturnStatement(s)
+		return t.VisitNumericType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ReturnStatement() (localctx IReturnStatementContext) {
-	localctx = NewReturnStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, ArcParserRULE_returnStatement)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(600)
-		p.Match(ArcParserRETURN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(602)
+func (p *ArcParser) NumericType() (localctx INumericTypeContext) {
+	localctx = NewNumericTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 136, ArcParserRULE_numericType)
+	p.SetState(776)
 	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 63, p.GetParserRuleContext()) == 1 {
+	switch p.GetTokenStream().LA(1) {
+	case ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(601)
-			p.Expression()
+			p.SetState(774)
+			p.IntegerType()
 		}
 
-	} else if p.HasError() { // JIM
+	case ArcParserF32, ArcParserF64:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(775)
+			p.FloatType()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
@@ -10597,199 +13757,137 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITypeContext is an interface to support dynamic dispatch.
-type ITypeContext interface {
+// IIntegerTypeContext is an interface to support dynamic dispatch.
+type IIntegerTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PrimitiveType() IPrimitiveTypeContext
-	UnitSuffix() IUnitSuffixContext
-	ChannelType() IChannelTypeContext
-	SeriesType() ISeriesTypeContext
+	I8() antlr.TerminalNode
+	I16() antlr.TerminalNode
+	I32() antlr.TerminalNode
+	I64() antlr.TerminalNode
+	U8() antlr.TerminalNode
+	U16() antlr.TerminalNode
+	U32() antlr.TerminalNode
+	U64() antlr.TerminalNode
 
-	// IsTypeContext differentiates from other interfaces.
-	IsTypeContext()
+	// IsIntegerTypeContext differentiates from other interfaces.
+	IsIntegerTypeContext()
 }
 
-type TypeContext struct {
+type IntegerTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeContext() *TypeContext {
-	var p = new(TypeContext)
+func NewEmptyIntegerTypeContext() *IntegerTypeContext {
+	var p = new(IntegerTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_type
+	p.RuleIndex = ArcParserRULE_integerType
 	return p
 }
 
-func InitEmptyTypeContext(p *TypeContext) {
+func InitEmptyIntegerTypeContext(p *IntegerTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_type
+	p.RuleIndex = ArcParserRULE_integerType
 }
 
-func (*TypeContext) IsTypeContext() {}
-
-func NewTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeContext {
-	var p = new(TypeContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = ArcParserRULE_type
-
-	return p
-}
+func (*IntegerTypeContext) IsIntegerTypeContext() {}
 
-func (s *TypeContext) GetParser() antlr.Parser { return s.parser }
+func NewIntegerTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IntegerTypeContext {
+	var p = new(IntegerTypeContext)
 
-func (s *TypeContext) PrimitiveType() IPrimitiveTypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrimitiveTypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	if t == nil {
-		return nil
-	}
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_integerType
 
-	return t.(IPrimitiveTypeContext)
+	return p
 }
 
-func (s *TypeContext) UnitSuffix() IUnitSuffixContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnitSuffixContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *IntegerTypeContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *IntegerTypeContext) I8() antlr.TerminalNode {
+	return s.GetToken(ArcParserI8, 0)
+}
 
-	return t.(IUnitSuffixContext)
+func (s *IntegerTypeContext) I16() antlr.TerminalNode {
+	return s.GetToken(ArcParserI16, 0)
 }
 
-func (s *TypeContext) ChannelType() IChannelTypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IChannelTypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *IntegerTypeContext) I32() antlr.TerminalNode {
+	return s.GetToken(ArcParserI32, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *IntegerTypeContext) I64() antlr.TerminalNode {
+	return s.GetToken(ArcParserI64, 0)
+}
 
-	return t.(IChannelTypeContext)
+func (s *IntegerTypeContext) U8() antlr.TerminalNode {
+	return s.GetToken(ArcParserU8, 0)
 }
 
-func (s *TypeContext) SeriesType() ISeriesTypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISeriesTypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *IntegerTypeContext) U16() antlr.TerminalNode {
+	return s.GetToken(ArcParserU16, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *IntegerTypeContext) U32() antlr.TerminalNode {
+	return s.GetToken(ArcParserU32, 0)
+}
 
-	return t.(ISeriesTypeContext)
+func (s *IntegerTypeContext) U64() antlr.TerminalNode {
+	return s.GetToken(ArcParserU64, 0)
 }
 
-func (s *TypeContext) GetRuleContext() antlr.RuleContext {
+func (s *IntegerTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IntegerTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IntegerTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterType(s)
+		listenerT.EnterIntegerType(s)
 	}
 }
 
-func (s *TypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IntegerTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitType(s)
+		listenerT.ExitIntegerType(s)
 	}
 }
 
-func (s *TypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IntegerTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitType(s)
+		return t.VisitIntegerType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Type_() (localctx ITypeContext) {
-	localctx = NewTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, ArcParserRULE_type)
+func (p *ArcParser) IntegerType() (localctx IIntegerTypeContext) {
+	localctx = NewIntegerTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 138, ArcParserRULE_integerType)
 	var _la int
 
-	p.SetState(610)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetTokenStream().LA(1) {
-	case ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64, ArcParserF32, ArcParserF64, ArcParserSTR:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(604)
-			p.PrimitiveType()
-		}
-		p.SetState(606)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(778)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == ArcParserIDENTIFIER {
-			{
-				p.SetState(605)
-				p.UnitSuffix()
-			}
-
-		}
-
-	case ArcParserCHAN:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(608)
-			p.ChannelType()
-		}
-
-	case ArcParserSERIES:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(609)
-			p.SeriesType()
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&133693440) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -10805,96 +13903,106 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IUnitSuffixContext is an interface to support dynamic dispatch.
-type IUnitSuffixContext interface {
+// IFloatTypeContext is an interface to support dynamic dispatch.
+type IFloatTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
+	F32() antlr.TerminalNode
+	F64() antlr.TerminalNode
 
-	// IsUnitSuffixContext differentiates from other interfaces.
-	IsUnitSuffixContext()
+	// IsFloatTypeContext differentiates from other interfaces.
+	IsFloatTypeContext()
 }
 
-type UnitSuffixContext struct {
+type FloatTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUnitSuffixContext() *UnitSuffixContext {
-	var p = new(UnitSuffixContext)
+func NewEmptyFloatTypeContext() *FloatTypeContext {
+	var p = new(FloatTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_unitSuffix
+	p.RuleIndex = ArcParserRULE_floatType
 	return p
 }
 
-func InitEmptyUnitSuffixContext(p *UnitSuffixContext) {
+func InitEmptyFloatTypeContext(p *FloatTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_unitSuffix
+	p.RuleIndex = ArcParserRULE_floatType
 }
 
-func (*UnitSuffixContext) IsUnitSuffixContext() {}
+func (*FloatTypeContext) IsFloatTypeContext() {}
 
-func NewUnitSuffixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnitSuffixContext {
-	var p = new(UnitSuffixContext)
+func NewFloatTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FloatTypeContext {
+	var p = new(FloatTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_unitSuffix
+	p.RuleIndex = ArcParserRULE_floatType
 
 	return p
 }
 
-func (s *UnitSuffixContext) GetParser() antlr.Parser { return s.parser }
+func (s *FloatTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *UnitSuffixContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
+func (s *FloatTypeContext) F32() antlr.TerminalNode {
+	return s.GetToken(ArcParserF32, 0)
 }
 
-func (s *UnitSuffixContext) GetRuleContext() antlr.RuleContext {
+func (s *FloatTypeContext) F64() antlr.TerminalNode {
+	return s.GetToken(ArcParserF64, 0)
+}
+
+func (s *FloatTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UnitSuffixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FloatTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *UnitSuffixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FloatTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterUnitSuffix(s)
+		listenerT.EnterFloatType(s)
 	}
 }
 
-func (s *UnitSuffixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FloatTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitUnitSuffix(s)
+		listenerT.ExitFloatType(s)
 	}
 }
 
-func (s *UnitSuffixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FloatTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitUnitSuffix(s)
+		return t.VisitFloatType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) UnitSuffix() (localctx IUnitSuffixContext) {
-	localctx = NewUnitSuffixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, ArcParserRULE_unitSuffix)
+func (p *ArcParser) FloatType() (localctx IFloatTypeContext) {
+	localctx = NewFloatTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 140, ArcParserRULE_floatType)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(612)
-		p.Match(ArcParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		p.SetState(780)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == ArcParserF32 || _la == ArcParserF64) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 	}
 
@@ -10911,57 +14019,79 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPrimitiveTypeContext is an interface to support dynamic dispatch.
-type IPrimitiveTypeContext interface {
+// IChannelTypeContext is an interface to support dynamic dispatch.
+type IChannelTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	NumericType() INumericTypeContext
-	STR() antlr.TerminalNode
+	CHAN() antlr.TerminalNode
+	PrimitiveType() IPrimitiveTypeContext
+	UnitSuffix() IUnitSuffixContext
+	SeriesType() ISeriesTypeContext
 
-	// IsPrimitiveTypeContext differentiates from other interfaces.
-	IsPrimitiveTypeContext()
+	// IsChannelTypeContext differentiates from other interfaces.
+	IsChannelTypeContext()
 }
 
-type PrimitiveTypeContext struct {
+type ChannelTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrimitiveTypeContext() *PrimitiveTypeContext {
-	var p = new(PrimitiveTypeContext)
+func NewEmptyChannelTypeContext() *ChannelTypeContext {
+	var p = new(ChannelTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_primitiveType
+	p.RuleIndex = ArcParserRULE_channelType
 	return p
 }
 
-func InitEmptyPrimitiveTypeContext(p *PrimitiveTypeContext) {
+func InitEmptyChannelTypeContext(p *ChannelTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_primitiveType
+	p.RuleIndex = ArcParserRULE_channelType
 }
 
-func (*PrimitiveTypeContext) IsPrimitiveTypeContext() {}
+func (*ChannelTypeContext) IsChannelTypeContext() {}
 
-func NewPrimitiveTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimitiveTypeContext {
-	var p = new(PrimitiveTypeContext)
+func NewChannelTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChannelTypeContext {
+	var p = new(ChannelTypeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_channelType
+
+	return p
+}
+
+func (s *ChannelTypeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ChannelTypeContext) CHAN() antlr.TerminalNode {
+	return s.GetToken(ArcParserCHAN, 0)
+}
 
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+func (s *ChannelTypeContext) PrimitiveType() IPrimitiveTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimitiveTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	p.parser = parser
-	p.RuleIndex = ArcParserRULE_primitiveType
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IPrimitiveTypeContext)
 }
 
-func (s *PrimitiveTypeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *PrimitiveTypeContext) NumericType() INumericTypeContext {
+func (s *ChannelTypeContext) UnitSuffix() IUnitSuffixContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INumericTypeContext); ok {
+		if _, ok := ctx.(IUnitSuffixContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10971,73 +14101,112 @@ func (s *PrimitiveTypeContext) NumericType() INumericTypeContext {
 		return nil
 	}
 
-	return t.(INumericTypeContext)
+	return t.(IUnitSuffixContext)
 }
 
-func (s *PrimitiveTypeContext) STR() antlr.TerminalNode {
-	return s.GetToken(ArcParserSTR, 0)
+func (s *ChannelTypeContext) SeriesType() ISeriesTypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISeriesTypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISeriesTypeContext)
 }
 
-func (s *PrimitiveTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *ChannelTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PrimitiveTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ChannelTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PrimitiveTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ChannelTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterPrimitiveType(s)
+		listenerT.EnterChannelType(s)
 	}
 }
 
-func (s *PrimitiveTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ChannelTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitPrimitiveType(s)
+		listenerT.ExitChannelType(s)
 	}
 }
 
-func (s *PrimitiveTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ChannelTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitPrimitiveType(s)
+		return t.VisitChannelType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) PrimitiveType() (localctx IPrimitiveTypeContext) {
-	localctx = NewPrimitiveTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, ArcParserRULE_primitiveType)
-	p.SetState(616)
+func (p *ArcParser) ChannelType() (localctx IChannelTypeContext) {
+	localctx = NewChannelTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 142, ArcParserRULE_channelType)
+	var _la int
+
+	p.SetState(789)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetTokenStream().LA(1) {
-	case ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64, ArcParserF32, ArcParserF64:
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 86, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(614)
-			p.NumericType()
+			p.SetState(782)
+			p.Match(ArcParserCHAN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(783)
+			p.PrimitiveType()
+		}
+		p.SetState(785)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 
-	case ArcParserSTR:
+		if _la == ArcParserIDENTIFIER {
+			{
+				p.SetState(784)
+				p.UnitSuffix()
+			}
+
+		}
+
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(615)
-			p.Match(ArcParserSTR)
+			p.SetState(787)
+			p.Match(ArcParserCHAN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
+		{
+			p.SetState(788)
+			p.SeriesType()
+		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
 
@@ -11054,57 +14223,62 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INumericTypeContext is an interface to support dynamic dispatch.
-type INumericTypeContext interface {
+// ISeriesTypeContext is an interface to support dynamic dispatch.
+type ISeriesTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IntegerType() IIntegerTypeContext
-	FloatType() IFloatTypeContext
+	SERIES() antlr.TerminalNode
+	PrimitiveType() IPrimitiveTypeContext
+	UnitSuffix() IUnitSuffixContext
 
-	// IsNumericTypeContext differentiates from other interfaces.
-	IsNumericTypeContext()
+	// IsSeriesTypeContext differentiates from other interfaces.
+	IsSeriesTypeContext()
 }
 
-type NumericTypeContext struct {
+type SeriesTypeContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNumericTypeContext() *NumericTypeContext {
-	var p = new(NumericTypeContext)
+func NewEmptySeriesTypeContext() *SeriesTypeContext {
+	var p = new(SeriesTypeContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_numericType
+	p.RuleIndex = ArcParserRULE_seriesType
 	return p
 }
 
-func InitEmptyNumericTypeContext(p *NumericTypeContext) {
+func InitEmptySeriesTypeContext(p *SeriesTypeContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_numericType
+	p.RuleIndex = ArcParserRULE_seriesType
 }
 
-func (*NumericTypeContext) IsNumericTypeContext() {}
+func (*SeriesTypeContext) IsSeriesTypeContext() {}
 
-func NewNumericTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NumericTypeContext {
-	var p = new(NumericTypeContext)
+func NewSeriesTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SeriesTypeContext {
+	var p = new(SeriesTypeContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_numericType
+	p.RuleIndex = ArcParserRULE_seriesType
 
 	return p
 }
 
-func (s *NumericTypeContext) GetParser() antlr.Parser { return s.parser }
+func (s *SeriesTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NumericTypeContext) IntegerType() IIntegerTypeContext {
+func (s *SeriesTypeContext) SERIES() antlr.TerminalNode {
+	return s.GetToken(ArcParserSERIES, 0)
+}
+
+func (s *SeriesTypeContext) PrimitiveType() IPrimitiveTypeContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIntegerTypeContext); ok {
+		if _, ok := ctx.(IPrimitiveTypeContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11114,13 +14288,13 @@ func (s *NumericTypeContext) IntegerType() IIntegerTypeContext {
 		return nil
 	}
 
-	return t.(IIntegerTypeContext)
+	return t.(IPrimitiveTypeContext)
 }
 
-func (s *NumericTypeContext) FloatType() IFloatTypeContext {
+func (s *SeriesTypeContext) UnitSuffix() IUnitSuffixContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFloatTypeContext); ok {
+		if _, ok := ctx.(IUnitSuffixContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11130,66 +14304,70 @@ func (s *NumericTypeContext) FloatType() IFloatTypeContext {
 		return nil
 	}
 
-	return t.(IFloatTypeContext)
+	return t.(IUnitSuffixContext)
 }
 
-func (s *NumericTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *SeriesTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NumericTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SeriesTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NumericTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SeriesTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterNumericType(s)
+		listenerT.EnterSeriesType(s)
 	}
 }
 
-func (s *NumericTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SeriesTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitNumericType(s)
+		listenerT.ExitSeriesType(s)
 	}
 }
 
-func (s *NumericTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SeriesTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitNumericType(s)
+		return t.VisitSeriesType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) NumericType() (localctx INumericTypeContext) {
-	localctx = NewNumericTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, ArcParserRULE_numericType)
-	p.SetState(620)
+func (p *ArcParser) SeriesType() (localctx ISeriesTypeContext) {
+	localctx = NewSeriesTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 144, ArcParserRULE_seriesType)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(791)
+		p.Match(ArcParserSERIES)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(792)
+		p.PrimitiveType()
+	}
+	p.SetState(794)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case ArcParserI8, ArcParserI16, ArcParserI32, ArcParserI64, ArcParserU8, ArcParserU16, ArcParserU32, ArcParserU64:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(618)
-			p.IntegerType()
-		}
-
-	case ArcParserF32, ArcParserF64:
-		p.EnterOuterAlt(localctx, 2)
+	if _la == ArcParserIDENTIFIER {
 		{
-			p.SetState(619)
-			p.FloatType()
+			p.SetState(793)
+			p.UnitSuffix()
 		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -11205,136 +14383,96 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IIntegerTypeContext is an interface to support dynamic dispatch.
-type IIntegerTypeContext interface {
+// IStructTypeContext is an interface to support dynamic dispatch.
+type IStructTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	I8() antlr.TerminalNode
-	I16() antlr.TerminalNode
-	I32() antlr.TerminalNode
-	I64() antlr.TerminalNode
-	U8() antlr.TerminalNode
-	U16() antlr.TerminalNode
-	U32() antlr.TerminalNode
-	U64() antlr.TerminalNode
-
-	// IsIntegerTypeContext differentiates from other interfaces.
-	IsIntegerTypeContext()
-}
-
-type IntegerTypeContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyIntegerTypeContext() *IntegerTypeContext {
-	var p = new(IntegerTypeContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_integerType
-	return p
-}
-
-func InitEmptyIntegerTypeContext(p *IntegerTypeContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_integerType
-}
-
-func (*IntegerTypeContext) IsIntegerTypeContext() {}
-
-func NewIntegerTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IntegerTypeContext {
-	var p = new(IntegerTypeContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = ArcParserRULE_integerType
-
-	return p
-}
-
-func (s *IntegerTypeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *IntegerTypeContext) I8() antlr.TerminalNode {
-	return s.GetToken(ArcParserI8, 0)
-}
-
-func (s *IntegerTypeContext) I16() antlr.TerminalNode {
-	return s.GetToken(ArcParserI16, 0)
-}
+	IDENTIFIER() antlr.TerminalNode
 
-func (s *IntegerTypeContext) I32() antlr.TerminalNode {
-	return s.GetToken(ArcParserI32, 0)
+	// IsStructTypeContext differentiates from other interfaces.
+	IsStructTypeContext()
 }
 
-func (s *IntegerTypeContext) I64() antlr.TerminalNode {
-	return s.GetToken(ArcParserI64, 0)
+type StructTypeContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *IntegerTypeContext) U8() antlr.TerminalNode {
-	return s.GetToken(ArcParserU8, 0)
+func NewEmptyStructTypeContext() *StructTypeContext {
+	var p = new(StructTypeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_structType
+	return p
 }
 
-func (s *IntegerTypeContext) U16() antlr.TerminalNode {
-	return s.GetToken(ArcParserU16, 0)
+func InitEmptyStructTypeContext(p *StructTypeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = ArcParserRULE_structType
 }
 
-func (s *IntegerTypeContext) U32() antlr.TerminalNode {
-	return s.GetToken(ArcParserU32, 0)
+func (*StructTypeContext) IsStructTypeContext() {}
+
+func NewStructTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructTypeContext {
+	var p = new(StructTypeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ArcParserRULE_structType
+
+	return p
 }
 
-func (s *IntegerTypeContext) U64() antlr.TerminalNode {
-	return s.GetToken(ArcParserU64, 0)
+func (s *StructTypeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StructTypeContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *IntegerTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *StructTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IntegerTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IntegerTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterIntegerType(s)
+		listenerT.EnterStructType(s)
 	}
 }
 
-func (s *IntegerTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitIntegerType(s)
+		listenerT.ExitStructType(s)
 	}
 }
 
-func (s *IntegerTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StructTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitIntegerType(s)
+		return t.VisitStructType(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) IntegerType() (localctx IIntegerTypeContext) {
-	localctx = NewIntegerTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, ArcParserRULE_integerType)
-	var _la int
-
+func (p *ArcParser) StructType() (localctx IStructTypeContext) {
+	localctx = NewStructTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 146, ArcParserRULE_structType)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(622)
-		_la = p.GetTokenStream().LA(1)
-
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&8355840) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		p.SetState(796)
+		p.Match(ArcParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
 
@@ -11351,107 +14489,105 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFloatTypeContext is an interface to support dynamic dispatch.
-type IFloatTypeContext interface {
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	F32() antlr.TerminalNode
-	F64() antlr.TerminalNode
+	LogicalOrExpression() ILogicalOrExpressionContext
 
-	// IsFloatTypeContext differentiates from other interfaces.
-	IsFloatTypeContext()
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
 }
 
-type FloatTypeContext struct {
+type ExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFloatTypeContext() *FloatTypeContext {
-	var p = new(FloatTypeContext)
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_floatType
+	p.RuleIndex = ArcParserRULE_expression
 	return p
 }
 
-func InitEmptyFloatTypeContext(p *FloatTypeContext) {
+func InitEmptyExpressionContext(p *ExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_floatType
+	p.RuleIndex = ArcParserRULE_expression
 }
 
-func (*FloatTypeContext) IsFloatTypeContext() {}
+func (*ExpressionContext) IsExpressionContext() {}
 
-func NewFloatTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FloatTypeContext {
-	var p = new(FloatTypeContext)
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_floatType
+	p.RuleIndex = ArcParserRULE_expression
 
 	return p
 }
 
-func (s *FloatTypeContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FloatTypeContext) F32() antlr.TerminalNode {
-	return s.GetToken(ArcParserF32, 0)
-}
+func (s *ExpressionContext) LogicalOrExpression() ILogicalOrExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILogicalOrExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *FloatTypeContext) F64() antlr.TerminalNode {
-	return s.GetToken(ArcParserF64, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILogicalOrExpressionContext)
 }
 
-func (s *FloatTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FloatTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FloatTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterFloatType(s)
+		listenerT.EnterExpression(s)
 	}
 }
 
-func (s *FloatTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitFloatType(s)
+		listenerT.ExitExpression(s)
 	}
 }
 
-func (s *FloatTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitFloatType(s)
+		return t.VisitExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) FloatType() (localctx IFloatTypeContext) {
-	localctx = NewFloatTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, ArcParserRULE_floatType)
-	var _la int
-
+func (p *ArcParser) Expression() (localctx IExpressionContext) {
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 148, ArcParserRULE_expression)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(624)
-		_la = p.GetTokenStream().LA(1)
-
-		if !(_la == ArcParserF32 || _la == ArcParserF64) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
-		}
+		p.SetState(798)
+		p.LogicalOrExpression()
 	}
 
 errorExit:
@@ -11467,81 +14603,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IChannelTypeContext is an interface to support dynamic dispatch.
-type IChannelTypeContext interface {
+// ILogicalOrExpressionContext is an interface to support dynamic dispatch.
+type ILogicalOrExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	CHAN() antlr.TerminalNode
-	PrimitiveType() IPrimitiveTypeContext
-	UnitSuffix() IUnitSuffixContext
-	SeriesType() ISeriesTypeContext
+	AllLogicalAndExpression() []ILogicalAndExpressionContext
+	LogicalAndExpression(i int) ILogicalAndExpressionContext
+	AllOR() []antlr.TerminalNode
+	OR(i int) antlr.TerminalNode
 
-	// IsChannelTypeContext differentiates from other interfaces.
-	IsChannelTypeContext()
+	// IsLogicalOrExpressionContext differentiates from other interfaces.
+	IsLogicalOrExpressionContext()
 }
 
-type ChannelTypeContext struct {
+type LogicalOrExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyChannelTypeContext() *ChannelTypeContext {
-	var p = new(ChannelTypeContext)
+func NewEmptyLogicalOrExpressionContext() *LogicalOrExpressionContext {
+	var p = new(LogicalOrExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_channelType
+	p.RuleIndex = ArcParserRULE_logicalOrExpression
 	return p
 }
 
-func InitEmptyChannelTypeContext(p *ChannelTypeContext) {
+func InitEmptyLogicalOrExpressionContext(p *LogicalOrExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_channelType
+	p.RuleIndex = ArcParserRULE_logicalOrExpression
 }
 
-func (*ChannelTypeContext) IsChannelTypeContext() {}
+func (*LogicalOrExpressionContext) IsLogicalOrExpressionContext() {}
 
-func NewChannelTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChannelTypeContext {
-	var p = new(ChannelTypeContext)
+func NewLogicalOrExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LogicalOrExpressionContext {
+	var p = new(LogicalOrExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_channelType
+	p.RuleIndex = ArcParserRULE_logicalOrExpression
 
 	return p
 }
 
-func (s *ChannelTypeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ChannelTypeContext) CHAN() antlr.TerminalNode {
-	return s.GetToken(ArcParserCHAN, 0)
-}
+func (s *LogicalOrExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ChannelTypeContext) PrimitiveType() IPrimitiveTypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrimitiveTypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *LogicalOrExpressionContext) AllLogicalAndExpression() []ILogicalAndExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ILogicalAndExpressionContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]ILogicalAndExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ILogicalAndExpressionContext); ok {
+			tst[i] = t.(ILogicalAndExpressionContext)
+			i++
+		}
 	}
 
-	return t.(IPrimitiveTypeContext)
+	return tst
 }
 
-func (s *ChannelTypeContext) UnitSuffix() IUnitSuffixContext {
+func (s *LogicalOrExpressionContext) LogicalAndExpression(i int) ILogicalAndExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnitSuffixContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ILogicalAndExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -11549,113 +14690,84 @@ func (s *ChannelTypeContext) UnitSuffix() IUnitSuffixContext {
 		return nil
 	}
 
-	return t.(IUnitSuffixContext)
+	return t.(ILogicalAndExpressionContext)
 }
 
-func (s *ChannelTypeContext) SeriesType() ISeriesTypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISeriesTypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *LogicalOrExpressionContext) AllOR() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserOR)
+}
 
-	return t.(ISeriesTypeContext)
+func (s *LogicalOrExpressionContext) OR(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserOR, i)
 }
 
-func (s *ChannelTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *LogicalOrExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ChannelTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *LogicalOrExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ChannelTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LogicalOrExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterChannelType(s)
+		listenerT.EnterLogicalOrExpression(s)
 	}
 }
 
-func (s *ChannelTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LogicalOrExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitChannelType(s)
+		listenerT.ExitLogicalOrExpression(s)
 	}
 }
 
-func (s *ChannelTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LogicalOrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitChannelType(s)
+		return t.VisitLogicalOrExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) ChannelType() (localctx IChannelTypeContext) {
-	localctx = NewChannelTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, ArcParserRULE_channelType)
+func (p *ArcParser) LogicalOrExpression() (localctx ILogicalOrExpressionContext) {
+	localctx = NewLogicalOrExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 150, ArcParserRULE_logicalOrExpression)
 	var _la int
 
-	p.SetState(633)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(800)
+		p.LogicalAndExpression()
+	}
+	p.SetState(805)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 69, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	for _la == ArcParserOR {
 		{
-			p.SetState(626)
-			p.Match(ArcParserCHAN)
+			p.SetState(801)
+			p.Match(ArcParserOR)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(627)
-			p.PrimitiveType()
+			p.SetState(802)
+			p.LogicalAndExpression()
 		}
-		p.SetState(629)
+
+		p.SetState(807)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-
-		if _la == ArcParserIDENTIFIER {
-			{
-				p.SetState(628)
-				p.UnitSuffix()
-			}
-
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(631)
-			p.Match(ArcParserCHAN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(632)
-			p.SeriesType()
-		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -11671,80 +14783,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISeriesTypeContext is an interface to support dynamic dispatch.
-type ISeriesTypeContext interface {
+// ILogicalAndExpressionContext is an interface to support dynamic dispatch.
+type ILogicalAndExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SERIES() antlr.TerminalNode
-	PrimitiveType() IPrimitiveTypeContext
-	UnitSuffix() IUnitSuffixContext
+	AllEqualityExpression() []IEqualityExpressionContext
+	EqualityExpression(i int) IEqualityExpressionContext
+	AllAND() []antlr.TerminalNode
+	AND(i int) antlr.TerminalNode
 
-	// IsSeriesTypeContext differentiates from other interfaces.
-	IsSeriesTypeContext()
+	// IsLogicalAndExpressionContext differentiates from other interfaces.
+	IsLogicalAndExpressionContext()
 }
 
-type SeriesTypeContext struct {
+type LogicalAndExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySeriesTypeContext() *SeriesTypeContext {
-	var p = new(SeriesTypeContext)
+func NewEmptyLogicalAndExpressionContext() *LogicalAndExpressionContext {
+	var p = new(LogicalAndExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_seriesType
+	p.RuleIndex = ArcParserRULE_logicalAndExpression
 	return p
 }
 
-func InitEmptySeriesTypeContext(p *SeriesTypeContext) {
+func InitEmptyLogicalAndExpressionContext(p *LogicalAndExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_seriesType
+	p.RuleIndex = ArcParserRULE_logicalAndExpression
 }
 
-func (*SeriesTypeContext) IsSeriesTypeContext() {}
+func (*LogicalAndExpressionContext) IsLogicalAndExpressionContext() {}
 
-func NewSeriesTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SeriesTypeContext {
-	var p = new(SeriesTypeContext)
+func NewLogicalAndExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LogicalAndExpressionContext {
+	var p = new(LogicalAndExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_seriesType
+	p.RuleIndex = ArcParserRULE_logicalAndExpression
 
 	return p
 }
 
-func (s *SeriesTypeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SeriesTypeContext) SERIES() antlr.TerminalNode {
-	return s.GetToken(ArcParserSERIES, 0)
-}
+func (s *LogicalAndExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SeriesTypeContext) PrimitiveType() IPrimitiveTypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrimitiveTypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *LogicalAndExpressionContext) AllEqualityExpression() []IEqualityExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IEqualityExpressionContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IEqualityExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IEqualityExpressionContext); ok {
+			tst[i] = t.(IEqualityExpressionContext)
+			i++
+		}
 	}
 
-	return t.(IPrimitiveTypeContext)
+	return tst
 }
 
-func (s *SeriesTypeContext) UnitSuffix() IUnitSuffixContext {
+func (s *LogicalAndExpressionContext) EqualityExpression(i int) IEqualityExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnitSuffixContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IEqualityExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -11752,70 +14870,84 @@ func (s *SeriesTypeContext) UnitSuffix() IUnitSuffixContext {
 		return nil
 	}
 
-	return t.(IUnitSuffixContext)
+	return t.(IEqualityExpressionContext)
 }
 
-func (s *SeriesTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *LogicalAndExpressionContext) AllAND() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserAND)
+}
+
+func (s *LogicalAndExpressionContext) AND(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserAND, i)
+}
+
+func (s *LogicalAndExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SeriesTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *LogicalAndExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SeriesTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LogicalAndExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterSeriesType(s)
+		listenerT.EnterLogicalAndExpression(s)
 	}
 }
 
-func (s *SeriesTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LogicalAndExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitSeriesType(s)
+		listenerT.ExitLogicalAndExpression(s)
 	}
 }
 
-func (s *SeriesTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LogicalAndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitSeriesType(s)
+		return t.VisitLogicalAndExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) SeriesType() (localctx ISeriesTypeContext) {
-	localctx = NewSeriesTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, ArcParserRULE_seriesType)
+func (p *ArcParser) LogicalAndExpression() (localctx ILogicalAndExpressionContext) {
+	localctx = NewLogicalAndExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 152, ArcParserRULE_logicalAndExpression)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(635)
-		p.Match(ArcParserSERIES)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(636)
-		p.PrimitiveType()
+		p.SetState(808)
+		p.EqualityExpression()
 	}
-	p.SetState(638)
+	p.SetState(813)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserIDENTIFIER {
+	for _la == ArcParserAND {
 		{
-			p.SetState(637)
-			p.UnitSuffix()
+			p.SetState(809)
+			p.Match(ArcParserAND)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(810)
+			p.EqualityExpression()
 		}
 
+		p.SetState(815)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -11831,58 +14963,88 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// IEqualityExpressionContext is an interface to support dynamic dispatch.
+type IEqualityExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LogicalOrExpression() ILogicalOrExpressionContext
+	AllRelationalExpression() []IRelationalExpressionContext
+	RelationalExpression(i int) IRelationalExpressionContext
+	AllEQ() []antlr.TerminalNode
+	EQ(i int) antlr.TerminalNode
+	AllNEQ() []antlr.TerminalNode
+	NEQ(i int) antlr.TerminalNode
 
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+	// IsEqualityExpressionContext differentiates from other interfaces.
+	IsEqualityExpressionContext()
 }
 
-type ExpressionContext struct {
+type EqualityExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptyEqualityExpressionContext() *EqualityExpressionContext {
+	var p = new(EqualityExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_expression
+	p.RuleIndex = ArcParserRULE_equalityExpression
 	return p
 }
 
-func InitEmptyExpressionContext(p *ExpressionContext) {
+func InitEmptyEqualityExpressionContext(p *EqualityExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_expression
+	p.RuleIndex = ArcParserRULE_equalityExpression
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*EqualityExpressionContext) IsEqualityExpressionContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEqualityExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EqualityExpressionContext {
+	var p = new(EqualityExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_expression
+	p.RuleIndex = ArcParserRULE_equalityExpression
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *EqualityExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionContext) LogicalOrExpression() ILogicalOrExpressionContext {
+func (s *EqualityExpressionContext) AllRelationalExpression() []IRelationalExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IRelationalExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IRelationalExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IRelationalExpressionContext); ok {
+			tst[i] = t.(IRelationalExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EqualityExpressionContext) RelationalExpression(i int) IRelationalExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILogicalOrExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IRelationalExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -11890,46 +15052,95 @@ func (s *ExpressionContext) LogicalOrExpression() ILogicalOrExpressionContext {
 		return nil
 	}
 
-	return t.(ILogicalOrExpressionContext)
+	return t.(IRelationalExpressionContext)
 }
 
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *EqualityExpressionContext) AllEQ() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserEQ)
+}
+
+func (s *EqualityExpressionContext) EQ(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserEQ, i)
+}
+
+func (s *EqualityExpressionContext) AllNEQ() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserNEQ)
+}
+
+func (s *EqualityExpressionContext) NEQ(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserNEQ, i)
+}
+
+func (s *EqualityExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *EqualityExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EqualityExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterExpression(s)
+		listenerT.EnterEqualityExpression(s)
 	}
 }
 
-func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EqualityExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitExpression(s)
+		listenerT.ExitEqualityExpression(s)
 	}
 }
 
-func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *EqualityExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitExpression(s)
+		return t.VisitEqualityExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) Expression() (localctx IExpressionContext) {
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, ArcParserRULE_expression)
+func (p *ArcParser) EqualityExpression() (localctx IEqualityExpressionContext) {
+	localctx = NewEqualityExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 154, ArcParserRULE_equalityExpression)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(640)
-		p.LogicalOrExpression()
+		p.SetState(816)
+		p.RelationalExpression()
+	}
+	p.SetState(821)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == ArcParserEQ || _la == ArcParserNEQ {
+		{
+			p.SetState(817)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == ArcParserEQ || _la == ArcParserNEQ) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(818)
+			p.RelationalExpression()
+		}
+
+		p.SetState(823)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -11945,69 +15156,75 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ILogicalOrExpressionContext is an interface to support dynamic dispatch.
-type ILogicalOrExpressionContext interface {
+// IRelationalExpressionContext is an interface to support dynamic dispatch.
+type IRelationalExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllLogicalAndExpression() []ILogicalAndExpressionContext
-	LogicalAndExpression(i int) ILogicalAndExpressionContext
-	AllOR() []antlr.TerminalNode
-	OR(i int) antlr.TerminalNode
+	AllAdditiveExpression() []IAdditiveExpressionContext
+	AdditiveExpression(i int) IAdditiveExpressionContext
+	AllLT() []antlr.TerminalNode
+	LT(i int) antlr.TerminalNode
+	AllGT() []antlr.TerminalNode
+	GT(i int) antlr.TerminalNode
+	AllLEQ() []antlr.TerminalNode
+	LEQ(i int) antlr.TerminalNode
+	AllGEQ() []antlr.TerminalNode
+	GEQ(i int) antlr.TerminalNode
 
-	// IsLogicalOrExpressionContext differentiates from other interfaces.
-	IsLogicalOrExpressionContext()
+	// IsRelationalExpressionContext differentiates from other interfaces.
+	IsRelationalExpressionContext()
 }
 
-type LogicalOrExpressionContext struct {
+type RelationalExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLogicalOrExpressionContext() *LogicalOrExpressionContext {
-	var p = new(LogicalOrExpressionContext)
+func NewEmptyRelationalExpressionContext() *RelationalExpressionContext {
+	var p = new(RelationalExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_logicalOrExpression
+	p.RuleIndex = ArcParserRULE_relationalExpression
 	return p
 }
 
-func InitEmptyLogicalOrExpressionContext(p *LogicalOrExpressionContext) {
+func InitEmptyRelationalExpressionContext(p *RelationalExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_logicalOrExpression
+	p.RuleIndex = ArcParserRULE_relationalExpression
 }
 
-func (*LogicalOrExpressionContext) IsLogicalOrExpressionContext() {}
+func (*RelationalExpressionContext) IsRelationalExpressionContext() {}
 
-func NewLogicalOrExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LogicalOrExpressionContext {
-	var p = new(LogicalOrExpressionContext)
+func NewRelationalExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RelationalExpressionContext {
+	var p = new(RelationalExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_logicalOrExpression
+	p.RuleIndex = ArcParserRULE_relationalExpression
 
 	return p
 }
 
-func (s *LogicalOrExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *RelationalExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LogicalOrExpressionContext) AllLogicalAndExpression() []ILogicalAndExpressionContext {
+func (s *RelationalExpressionContext) AllAdditiveExpression() []IAdditiveExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ILogicalAndExpressionContext); ok {
+		if _, ok := ctx.(IAdditiveExpressionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ILogicalAndExpressionContext, len)
+	tst := make([]IAdditiveExpressionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ILogicalAndExpressionContext); ok {
-			tst[i] = t.(ILogicalAndExpressionContext)
+		if t, ok := ctx.(IAdditiveExpressionContext); ok {
+			tst[i] = t.(IAdditiveExpressionContext)
 			i++
 		}
 	}
@@ -12015,11 +15232,11 @@ func (s *LogicalOrExpressionContext) AllLogicalAndExpression() []ILogicalAndExpr
 	return tst
 }
 
-func (s *LogicalOrExpressionContext) LogicalAndExpression(i int) ILogicalAndExpressionContext {
+func (s *RelationalExpressionContext) AdditiveExpression(i int) IAdditiveExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILogicalAndExpressionContext); ok {
+		if _, ok := ctx.(IAdditiveExpressionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -12032,79 +15249,106 @@ func (s *LogicalOrExpressionContext) LogicalAndExpression(i int) ILogicalAndExpr
 		return nil
 	}
 
-	return t.(ILogicalAndExpressionContext)
+	return t.(IAdditiveExpressionContext)
 }
 
-func (s *LogicalOrExpressionContext) AllOR() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserOR)
+func (s *RelationalExpressionContext) AllLT() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserLT)
 }
 
-func (s *LogicalOrExpressionContext) OR(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserOR, i)
+func (s *RelationalExpressionContext) LT(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserLT, i)
 }
 
-func (s *LogicalOrExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *RelationalExpressionContext) AllGT() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserGT)
+}
+
+func (s *RelationalExpressionContext) GT(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserGT, i)
+}
+
+func (s *RelationalExpressionContext) AllLEQ() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserLEQ)
+}
+
+func (s *RelationalExpressionContext) LEQ(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserLEQ, i)
+}
+
+func (s *RelationalExpressionContext) AllGEQ() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserGEQ)
+}
+
+func (s *RelationalExpressionContext) GEQ(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserGEQ, i)
+}
+
+func (s *RelationalExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LogicalOrExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RelationalExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LogicalOrExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RelationalExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterLogicalOrExpression(s)
+		listenerT.EnterRelationalExpression(s)
 	}
 }
 
-func (s *LogicalOrExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RelationalExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitLogicalOrExpression(s)
+		listenerT.ExitRelationalExpression(s)
 	}
 }
 
-func (s *LogicalOrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RelationalExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitLogicalOrExpression(s)
+		return t.VisitRelationalExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) LogicalOrExpression() (localctx ILogicalOrExpressionContext) {
-	localctx = NewLogicalOrExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, ArcParserRULE_logicalOrExpression)
+func (p *ArcParser) RelationalExpression() (localctx IRelationalExpressionContext) {
+	localctx = NewRelationalExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 156, ArcParserRULE_relationalExpression)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(642)
-		p.LogicalAndExpression()
+		p.SetState(824)
+		p.AdditiveExpression()
 	}
-	p.SetState(647)
+	p.SetState(829)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == ArcParserOR {
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&8444249301319680) != 0 {
 		{
-			p.SetState(643)
-			p.Match(ArcParserOR)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+			p.SetState(825)
+			_la = p.GetTokenStream().LA(1)
+
+			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&8444249301319680) != 0) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
 			}
 		}
 		{
-			p.SetState(644)
-			p.LogicalAndExpression()
+			p.SetState(826)
+			p.AdditiveExpression()
 		}
 
-		p.SetState(649)
+		p.SetState(831)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -12125,69 +15369,71 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ILogicalAndExpressionContext is an interface to support dynamic dispatch.
-type ILogicalAndExpressionContext interface {
+// IAdditiveExpressionContext is an interface to support dynamic dispatch.
+type IAdditiveExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllEqualityExpression() []IEqualityExpressionContext
-	EqualityExpression(i int) IEqualityExpressionContext
-	AllAND() []antlr.TerminalNode
-	AND(i int) antlr.TerminalNode
+	AllMultiplicativeExpression() []IMultiplicativeExpressionContext
+	MultiplicativeExpression(i int) IMultiplicativeExpressionContext
+	AllPLUS() []antlr.TerminalNode
+	PLUS(i int) antlr.TerminalNode
+	AllMINUS() []antlr.TerminalNode
+	MINUS(i int) antlr.TerminalNode
 
-	// IsLogicalAndExpressionContext differentiates from other interfaces.
-	IsLogicalAndExpressionContext()
+	// IsAdditiveExpressionContext differentiates from other interfaces.
+	IsAdditiveExpressionContext()
 }
 
-type LogicalAndExpressionContext struct {
+type AdditiveExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLogicalAndExpressionContext() *LogicalAndExpressionContext {
-	var p = new(LogicalAndExpressionContext)
+func NewEmptyAdditiveExpressionContext() *AdditiveExpressionContext {
+	var p = new(AdditiveExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_logicalAndExpression
+	p.RuleIndex = ArcParserRULE_additiveExpression
 	return p
 }
 
-func InitEmptyLogicalAndExpressionContext(p *LogicalAndExpressionContext) {
+func InitEmptyAdditiveExpressionContext(p *AdditiveExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_logicalAndExpression
+	p.RuleIndex = ArcParserRULE_additiveExpression
 }
 
-func (*LogicalAndExpressionContext) IsLogicalAndExpressionContext() {}
+func (*AdditiveExpressionContext) IsAdditiveExpressionContext() {}
 
-func NewLogicalAndExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LogicalAndExpressionContext {
-	var p = new(LogicalAndExpressionContext)
+func NewAdditiveExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AdditiveExpressionContext {
+	var p = new(AdditiveExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_logicalAndExpression
+	p.RuleIndex = ArcParserRULE_additiveExpression
 
 	return p
 }
 
-func (s *LogicalAndExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *AdditiveExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LogicalAndExpressionContext) AllEqualityExpression() []IEqualityExpressionContext {
+func (s *AdditiveExpressionContext) AllMultiplicativeExpression() []IMultiplicativeExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IEqualityExpressionContext); ok {
+		if _, ok := ctx.(IMultiplicativeExpressionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IEqualityExpressionContext, len)
+	tst := make([]IMultiplicativeExpressionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IEqualityExpressionContext); ok {
-			tst[i] = t.(IEqualityExpressionContext)
+		if t, ok := ctx.(IMultiplicativeExpressionContext); ok {
+			tst[i] = t.(IMultiplicativeExpressionContext)
 			i++
 		}
 	}
@@ -12195,11 +15441,11 @@ func (s *LogicalAndExpressionContext) AllEqualityExpression() []IEqualityExpress
 	return tst
 }
 
-func (s *LogicalAndExpressionContext) EqualityExpression(i int) IEqualityExpressionContext {
+func (s *AdditiveExpressionContext) MultiplicativeExpression(i int) IMultiplicativeExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEqualityExpressionContext); ok {
+		if _, ok := ctx.(IMultiplicativeExpressionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -12212,84 +15458,104 @@ func (s *LogicalAndExpressionContext) EqualityExpression(i int) IEqualityExpress
 		return nil
 	}
 
-	return t.(IEqualityExpressionContext)
+	return t.(IMultiplicativeExpressionContext)
 }
 
-func (s *LogicalAndExpressionContext) AllAND() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserAND)
+func (s *AdditiveExpressionContext) AllPLUS() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserPLUS)
 }
 
-func (s *LogicalAndExpressionContext) AND(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserAND, i)
+func (s *AdditiveExpressionContext) PLUS(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserPLUS, i)
 }
 
-func (s *LogicalAndExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *AdditiveExpressionContext) AllMINUS() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserMINUS)
+}
+
+func (s *AdditiveExpressionContext) MINUS(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserMINUS, i)
+}
+
+func (s *AdditiveExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LogicalAndExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AdditiveExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LogicalAndExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AdditiveExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterLogicalAndExpression(s)
+		listenerT.EnterAdditiveExpression(s)
 	}
 }
 
-func (s *LogicalAndExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AdditiveExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitLogicalAndExpression(s)
+		listenerT.ExitAdditiveExpression(s)
 	}
 }
 
-func (s *LogicalAndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AdditiveExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitLogicalAndExpression(s)
+		return t.VisitAdditiveExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) LogicalAndExpression() (localctx ILogicalAndExpressionContext) {
-	localctx = NewLogicalAndExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, ArcParserRULE_logicalAndExpression)
+func (p *ArcParser) AdditiveExpression() (localctx IAdditiveExpressionContext) {
+	localctx = NewAdditiveExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 158, ArcParserRULE_additiveExpression)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(650)
-		p.EqualityExpression()
+		p.SetState(832)
+		p.MultiplicativeExpression()
 	}
-	p.SetState(655)
+	p.SetState(837)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 92, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(833)
+				_la = p.GetTokenStream().LA(1)
 
-	for _la == ArcParserAND {
-		{
-			p.SetState(651)
-			p.Match(ArcParserAND)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+				if !(_la == ArcParserPLUS || _la == ArcParserMINUS) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+			{
+				p.SetState(834)
+				p.MultiplicativeExpression()
 			}
-		}
-		{
-			p.SetState(652)
-			p.EqualityExpression()
-		}
 
-		p.SetState(657)
+		}
+		p.SetState(839)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 92, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -12305,71 +15571,73 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IEqualityExpressionContext is an interface to support dynamic dispatch.
-type IEqualityExpressionContext interface {
+// IMultiplicativeExpressionContext is an interface to support dynamic dispatch.
+type IMultiplicativeExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllRelationalExpression() []IRelationalExpressionContext
-	RelationalExpression(i int) IRelationalExpressionContext
-	AllEQ() []antlr.TerminalNode
-	EQ(i int) antlr.TerminalNode
-	AllNEQ() []antlr.TerminalNode
-	NEQ(i int) antlr.TerminalNode
+	AllPowerExpression() []IPowerExpressionContext
+	PowerExpression(i int) IPowerExpressionContext
+	AllSTAR() []antlr.TerminalNode
+	STAR(i int) antlr.TerminalNode
+	AllSLASH() []antlr.TerminalNode
+	SLASH(i int) antlr.TerminalNode
+	AllPERCENT() []antlr.TerminalNode
+	PERCENT(i int) antlr.TerminalNode
 
-	// IsEqualityExpressionContext differentiates from other interfaces.
-	IsEqualityExpressionContext()
+	// IsMultiplicativeExpressionContext differentiates from other interfaces.
+	IsMultiplicativeExpressionContext()
 }
 
-type EqualityExpressionContext struct {
+type MultiplicativeExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyEqualityExpressionContext() *EqualityExpressionContext {
-	var p = new(EqualityExpressionContext)
+func NewEmptyMultiplicativeExpressionContext() *MultiplicativeExpressionContext {
+	var p = new(MultiplicativeExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_equalityExpression
+	p.RuleIndex = ArcParserRULE_multiplicativeExpression
 	return p
 }
 
-func InitEmptyEqualityExpressionContext(p *EqualityExpressionContext) {
+func InitEmptyMultiplicativeExpressionContext(p *MultiplicativeExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_equalityExpression
+	p.RuleIndex = ArcParserRULE_multiplicativeExpression
 }
 
-func (*EqualityExpressionContext) IsEqualityExpressionContext() {}
+func (*MultiplicativeExpressionContext) IsMultiplicativeExpressionContext() {}
 
-func NewEqualityExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EqualityExpressionContext {
-	var p = new(EqualityExpressionContext)
+func NewMultiplicativeExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultiplicativeExpressionContext {
+	var p = new(MultiplicativeExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_equalityExpression
+	p.RuleIndex = ArcParserRULE_multiplicativeExpression
 
 	return p
 }
 
-func (s *EqualityExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *MultiplicativeExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *EqualityExpressionContext) AllRelationalExpression() []IRelationalExpressionContext {
+func (s *MultiplicativeExpressionContext) AllPowerExpression() []IPowerExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IRelationalExpressionContext); ok {
+		if _, ok := ctx.(IPowerExpressionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IRelationalExpressionContext, len)
+	tst := make([]IPowerExpressionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IRelationalExpressionContext); ok {
-			tst[i] = t.(IRelationalExpressionContext)
+		if t, ok := ctx.(IPowerExpressionContext); ok {
+			tst[i] = t.(IPowerExpressionContext)
 			i++
 		}
 	}
@@ -12377,11 +15645,11 @@ func (s *EqualityExpressionContext) AllRelationalExpression() []IRelationalExpre
 	return tst
 }
 
-func (s *EqualityExpressionContext) RelationalExpression(i int) IRelationalExpressionContext {
+func (s *MultiplicativeExpressionContext) PowerExpression(i int) IPowerExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IRelationalExpressionContext); ok {
+		if _, ok := ctx.(IPowerExpressionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -12394,78 +15662,86 @@ func (s *EqualityExpressionContext) RelationalExpression(i int) IRelationalExpre
 		return nil
 	}
 
-	return t.(IRelationalExpressionContext)
+	return t.(IPowerExpressionContext)
 }
 
-func (s *EqualityExpressionContext) AllEQ() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserEQ)
+func (s *MultiplicativeExpressionContext) AllSTAR() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserSTAR)
 }
 
-func (s *EqualityExpressionContext) EQ(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserEQ, i)
+func (s *MultiplicativeExpressionContext) STAR(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserSTAR, i)
 }
 
-func (s *EqualityExpressionContext) AllNEQ() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserNEQ)
+func (s *MultiplicativeExpressionContext) AllSLASH() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserSLASH)
 }
 
-func (s *EqualityExpressionContext) NEQ(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserNEQ, i)
+func (s *MultiplicativeExpressionContext) SLASH(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserSLASH, i)
 }
 
-func (s *EqualityExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *MultiplicativeExpressionContext) AllPERCENT() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserPERCENT)
+}
+
+func (s *MultiplicativeExpressionContext) PERCENT(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserPERCENT, i)
+}
+
+func (s *MultiplicativeExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EqualityExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MultiplicativeExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *EqualityExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MultiplicativeExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterEqualityExpression(s)
+		listenerT.EnterMultiplicativeExpression(s)
 	}
 }
 
-func (s *EqualityExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MultiplicativeExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitEqualityExpression(s)
+		listenerT.ExitMultiplicativeExpression(s)
 	}
 }
 
-func (s *EqualityExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MultiplicativeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitEqualityExpression(s)
+		return t.VisitMultiplicativeExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) EqualityExpression() (localctx IEqualityExpressionContext) {
-	localctx = NewEqualityExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, ArcParserRULE_equalityExpression)
+func (p *ArcParser) MultiplicativeExpression() (localctx IMultiplicativeExpressionContext) {
+	localctx = NewMultiplicativeExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 160, ArcParserRULE_multiplicativeExpression)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(658)
-		p.RelationalExpression()
+		p.SetState(840)
+		p.PowerExpression()
 	}
-	p.SetState(663)
+	p.SetState(845)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == ArcParserEQ || _la == ArcParserNEQ {
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&61572651155456) != 0 {
 		{
-			p.SetState(659)
+			p.SetState(841)
 			_la = p.GetTokenStream().LA(1)
 
-			if !(_la == ArcParserEQ || _la == ArcParserNEQ) {
+			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&61572651155456) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -12473,11 +15749,11 @@ func (p *ArcParser) EqualityExpression() (localctx IEqualityExpressionContext) {
 			}
 		}
 		{
-			p.SetState(660)
-			p.RelationalExpression()
+			p.SetState(842)
+			p.PowerExpression()
 		}
 
-		p.SetState(665)
+		p.SetState(847)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -12498,92 +15774,60 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IRelationalExpressionContext is an interface to support dynamic dispatch.
-type IRelationalExpressionContext interface {
+// IPowerExpressionContext is an interface to support dynamic dispatch.
+type IPowerExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllAdditiveExpression() []IAdditiveExpressionContext
-	AdditiveExpression(i int) IAdditiveExpressionContext
-	AllLT() []antlr.TerminalNode
-	LT(i int) antlr.TerminalNode
-	AllGT() []antlr.TerminalNode
-	GT(i int) antlr.TerminalNode
-	AllLEQ() []antlr.TerminalNode
-	LEQ(i int) antlr.TerminalNode
-	AllGEQ() []antlr.TerminalNode
-	GEQ(i int) antlr.TerminalNode
+	UnaryExpression() IUnaryExpressionContext
+	CARET() antlr.TerminalNode
+	PowerExpression() IPowerExpressionContext
 
-	// IsRelationalExpressionContext differentiates from other interfaces.
-	IsRelationalExpressionContext()
+	// IsPowerExpressionContext differentiates from other interfaces.
+	IsPowerExpressionContext()
 }
 
-type RelationalExpressionContext struct {
+type PowerExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRelationalExpressionContext() *RelationalExpressionContext {
-	var p = new(RelationalExpressionContext)
+func NewEmptyPowerExpressionContext() *PowerExpressionContext {
+	var p = new(PowerExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_relationalExpression
+	p.RuleIndex = ArcParserRULE_powerExpression
 	return p
 }
 
-func InitEmptyRelationalExpressionContext(p *RelationalExpressionContext) {
+func InitEmptyPowerExpressionContext(p *PowerExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_relationalExpression
+	p.RuleIndex = ArcParserRULE_powerExpression
 }
 
-func (*RelationalExpressionContext) IsRelationalExpressionContext() {}
+func (*PowerExpressionContext) IsPowerExpressionContext() {}
 
-func NewRelationalExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RelationalExpressionContext {
-	var p = new(RelationalExpressionContext)
+func NewPowerExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PowerExpressionContext {
+	var p = new(PowerExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_relationalExpression
+	p.RuleIndex = ArcParserRULE_powerExpression
 
 	return p
 }
 
-func (s *RelationalExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *RelationalExpressionContext) AllAdditiveExpression() []IAdditiveExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IAdditiveExpressionContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IAdditiveExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IAdditiveExpressionContext); ok {
-			tst[i] = t.(IAdditiveExpressionContext)
-			i++
-		}
-	}
-
-	return tst
-}
+func (s *PowerExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RelationalExpressionContext) AdditiveExpression(i int) IAdditiveExpressionContext {
+func (s *PowerExpressionContext) UnaryExpression() IUnaryExpressionContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAdditiveExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IUnaryExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -12591,111 +15835,90 @@ func (s *RelationalExpressionContext) AdditiveExpression(i int) IAdditiveExpress
 		return nil
 	}
 
-	return t.(IAdditiveExpressionContext)
-}
-
-func (s *RelationalExpressionContext) AllLT() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserLT)
-}
-
-func (s *RelationalExpressionContext) LT(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserLT, i)
-}
-
-func (s *RelationalExpressionContext) AllGT() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserGT)
-}
-
-func (s *RelationalExpressionContext) GT(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserGT, i)
-}
-
-func (s *RelationalExpressionContext) AllLEQ() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserLEQ)
+	return t.(IUnaryExpressionContext)
 }
 
-func (s *RelationalExpressionContext) LEQ(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserLEQ, i)
+func (s *PowerExpressionContext) CARET() antlr.TerminalNode {
+	return s.GetToken(ArcParserCARET, 0)
 }
 
-func (s *RelationalExpressionContext) AllGEQ() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserGEQ)
-}
+func (s *PowerExpressionContext) PowerExpression() IPowerExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPowerExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *RelationalExpressionContext) GEQ(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserGEQ, i)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPowerExpressionContext)
 }
 
-func (s *RelationalExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *PowerExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RelationalExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PowerExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RelationalExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PowerExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterRelationalExpression(s)
+		listenerT.EnterPowerExpression(s)
 	}
 }
 
-func (s *RelationalExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PowerExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitRelationalExpression(s)
+		listenerT.ExitPowerExpression(s)
 	}
 }
 
-func (s *RelationalExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PowerExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitRelationalExpression(s)
+		return t.VisitPowerExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) RelationalExpression() (localctx IRelationalExpressionContext) {
-	localctx = NewRelationalExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, ArcParserRULE_relationalExpression)
+func (p *ArcParser) PowerExpression() (localctx IPowerExpressionContext) {
+	localctx = NewPowerExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 162, ArcParserRULE_powerExpression)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(666)
-		p.AdditiveExpression()
+		p.SetState(848)
+		p.UnaryExpression()
 	}
-	p.SetState(671)
+	p.SetState(851)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&527765581332480) != 0 {
+	if _la == ArcParserCARET {
 		{
-			p.SetState(667)
-			_la = p.GetTokenStream().LA(1)
-
-			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&527765581332480) != 0) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
+			p.SetState(849)
+			p.Match(ArcParserCARET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
 		}
 		{
-			p.SetState(668)
-			p.AdditiveExpression()
+			p.SetState(850)
+			p.PowerExpression()
 		}
 
-		p.SetState(673)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -12711,88 +15934,65 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IAdditiveExpressionContext is an interface to support dynamic dispatch.
-type IAdditiveExpressionContext interface {
+// IUnaryExpressionContext is an interface to support dynamic dispatch.
+type IUnaryExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllMultiplicativeExpression() []IMultiplicativeExpressionContext
-	MultiplicativeExpression(i int) IMultiplicativeExpressionContext
-	AllPLUS() []antlr.TerminalNode
-	PLUS(i int) antlr.TerminalNode
-	AllMINUS() []antlr.TerminalNode
-	MINUS(i int) antlr.TerminalNode
+	MINUS() antlr.TerminalNode
+	UnaryExpression() IUnaryExpressionContext
+	NOT() antlr.TerminalNode
+	PostfixExpression() IPostfixExpressionContext
 
-	// IsAdditiveExpressionContext differentiates from other interfaces.
-	IsAdditiveExpressionContext()
+	// IsUnaryExpressionContext differentiates from other interfaces.
+	IsUnaryExpressionContext()
 }
 
-type AdditiveExpressionContext struct {
+type UnaryExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAdditiveExpressionContext() *AdditiveExpressionContext {
-	var p = new(AdditiveExpressionContext)
+func NewEmptyUnaryExpressionContext() *UnaryExpressionContext {
+	var p = new(UnaryExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_additiveExpression
+	p.RuleIndex = ArcParserRULE_unaryExpression
 	return p
 }
 
-func InitEmptyAdditiveExpressionContext(p *AdditiveExpressionContext) {
+func InitEmptyUnaryExpressionContext(p *UnaryExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_additiveExpression
+	p.RuleIndex = ArcParserRULE_unaryExpression
 }
 
-func (*AdditiveExpressionContext) IsAdditiveExpressionContext() {}
+func (*UnaryExpressionContext) IsUnaryExpressionContext() {}
 
-func NewAdditiveExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AdditiveExpressionContext {
-	var p = new(AdditiveExpressionContext)
+func NewUnaryExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnaryExpressionContext {
+	var p = new(UnaryExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_additiveExpression
+	p.RuleIndex = ArcParserRULE_unaryExpression
 
 	return p
 }
 
-func (s *AdditiveExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *AdditiveExpressionContext) AllMultiplicativeExpression() []IMultiplicativeExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IMultiplicativeExpressionContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IMultiplicativeExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IMultiplicativeExpressionContext); ok {
-			tst[i] = t.(IMultiplicativeExpressionContext)
-			i++
-		}
-	}
+func (s *UnaryExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *UnaryExpressionContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(ArcParserMINUS, 0)
 }
 
-func (s *AdditiveExpressionContext) MultiplicativeExpression(i int) IMultiplicativeExpressionContext {
+func (s *UnaryExpressionContext) UnaryExpression() IUnaryExpressionContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMultiplicativeExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IUnaryExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -12800,104 +16000,108 @@ func (s *AdditiveExpressionContext) MultiplicativeExpression(i int) IMultiplicat
 		return nil
 	}
 
-	return t.(IMultiplicativeExpressionContext)
+	return t.(IUnaryExpressionContext)
 }
 
-func (s *AdditiveExpressionContext) AllPLUS() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserPLUS)
+func (s *UnaryExpressionContext) NOT() antlr.TerminalNode {
+	return s.GetToken(ArcParserNOT, 0)
 }
 
-func (s *AdditiveExpressionContext) PLUS(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserPLUS, i)
-}
+func (s *UnaryExpressionContext) PostfixExpression() IPostfixExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPostfixExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *AdditiveExpressionContext) AllMINUS() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserMINUS)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *AdditiveExpressionContext) MINUS(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserMINUS, i)
+	return t.(IPostfixExpressionContext)
 }
 
-func (s *AdditiveExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *UnaryExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AdditiveExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UnaryExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AdditiveExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterAdditiveExpression(s)
+		listenerT.EnterUnaryExpression(s)
 	}
 }
 
-func (s *AdditiveExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitAdditiveExpression(s)
+		listenerT.ExitUnaryExpression(s)
 	}
 }
 
-func (s *AdditiveExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UnaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitAdditiveExpression(s)
+		return t.VisitUnaryExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) AdditiveExpression() (localctx IAdditiveExpressionContext) {
-	localctx = NewAdditiveExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, ArcParserRULE_additiveExpression)
-	var _la int
-
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(674)
-		p.MultiplicativeExpression()
-	}
-	p.SetState(679)
+func (p *ArcParser) UnaryExpression() (localctx IUnaryExpressionContext) {
+	localctx = NewUnaryExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 164, ArcParserRULE_unaryExpression)
+	p.SetState(858)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 75, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(675)
-				_la = p.GetTokenStream().LA(1)
 
-				if !(_la == ArcParserPLUS || _la == ArcParserMINUS) {
-					p.GetErrorHandler().RecoverInline(p)
-				} else {
-					p.GetErrorHandler().ReportMatch(p)
-					p.Consume()
-				}
-			}
-			{
-				p.SetState(676)
-				p.MultiplicativeExpression()
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 95, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(853)
+			p.Match(ArcParserMINUS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
+		}
+		{
+			p.SetState(854)
+			p.UnaryExpression()
+		}
 
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(855)
+			p.Match(ArcParserNOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		p.SetState(681)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(856)
+			p.UnaryExpression()
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 75, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(857)
+			p.PostfixExpression()
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -12913,73 +16117,88 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMultiplicativeExpressionContext is an interface to support dynamic dispatch.
-type IMultiplicativeExpressionContext interface {
+// IPostfixExpressionContext is an interface to support dynamic dispatch.
+type IPostfixExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllPowerExpression() []IPowerExpressionContext
-	PowerExpression(i int) IPowerExpressionContext
-	AllSTAR() []antlr.TerminalNode
-	STAR(i int) antlr.TerminalNode
-	AllSLASH() []antlr.TerminalNode
-	SLASH(i int) antlr.TerminalNode
-	AllPERCENT() []antlr.TerminalNode
-	PERCENT(i int) antlr.TerminalNode
+	PrimaryExpression() IPrimaryExpressionContext
+	AllIndexOrSlice() []IIndexOrSliceContext
+	IndexOrSlice(i int) IIndexOrSliceContext
+	AllFunctionCallSuffix() []IFunctionCallSuffixContext
+	FunctionCallSuffix(i int) IFunctionCallSuffixContext
+	AllMemberAccess() []IMemberAccessContext
+	MemberAccess(i int) IMemberAccessContext
 
-	// IsMultiplicativeExpressionContext differentiates from other interfaces.
-	IsMultiplicativeExpressionContext()
+	// IsPostfixExpressionContext differentiates from other interfaces.
+	IsPostfixExpressionContext()
 }
 
-type MultiplicativeExpressionContext struct {
+type PostfixExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMultiplicativeExpressionContext() *MultiplicativeExpressionContext {
-	var p = new(MultiplicativeExpressionContext)
+func NewEmptyPostfixExpressionContext() *PostfixExpressionContext {
+	var p = new(PostfixExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_multiplicativeExpression
+	p.RuleIndex = ArcParserRULE_postfixExpression
 	return p
 }
 
-func InitEmptyMultiplicativeExpressionContext(p *MultiplicativeExpressionContext) {
+func InitEmptyPostfixExpressionContext(p *PostfixExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_multiplicativeExpression
+	p.RuleIndex = ArcParserRULE_postfixExpression
 }
 
-func (*MultiplicativeExpressionContext) IsMultiplicativeExpressionContext() {}
+func (*PostfixExpressionContext) IsPostfixExpressionContext() {}
 
-func NewMultiplicativeExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultiplicativeExpressionContext {
-	var p = new(MultiplicativeExpressionContext)
+func NewPostfixExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PostfixExpressionContext {
+	var p = new(PostfixExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_multiplicativeExpression
+	p.RuleIndex = ArcParserRULE_postfixExpression
 
 	return p
 }
 
-func (s *MultiplicativeExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *PostfixExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MultiplicativeExpressionContext) AllPowerExpression() []IPowerExpressionContext {
+func (s *PostfixExpressionContext) PrimaryExpression() IPrimaryExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimaryExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimaryExpressionContext)
+}
+
+func (s *PostfixExpressionContext) AllIndexOrSlice() []IIndexOrSliceContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IPowerExpressionContext); ok {
+		if _, ok := ctx.(IIndexOrSliceContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IPowerExpressionContext, len)
+	tst := make([]IIndexOrSliceContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IPowerExpressionContext); ok {
-			tst[i] = t.(IPowerExpressionContext)
+		if t, ok := ctx.(IIndexOrSliceContext); ok {
+			tst[i] = t.(IIndexOrSliceContext)
 			i++
 		}
 	}
@@ -12987,11 +16206,11 @@ func (s *MultiplicativeExpressionContext) AllPowerExpression() []IPowerExpressio
 	return tst
 }
 
-func (s *MultiplicativeExpressionContext) PowerExpression(i int) IPowerExpressionContext {
+func (s *PostfixExpressionContext) IndexOrSlice(i int) IIndexOrSliceContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPowerExpressionContext); ok {
+		if _, ok := ctx.(IIndexOrSliceContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -13004,103 +16223,182 @@ func (s *MultiplicativeExpressionContext) PowerExpression(i int) IPowerExpressio
 		return nil
 	}
 
-	return t.(IPowerExpressionContext)
+	return t.(IIndexOrSliceContext)
 }
 
-func (s *MultiplicativeExpressionContext) AllSTAR() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserSTAR)
-}
+func (s *PostfixExpressionContext) AllFunctionCallSuffix() []IFunctionCallSuffixContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFunctionCallSuffixContext); ok {
+			len++
+		}
+	}
 
-func (s *MultiplicativeExpressionContext) STAR(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserSTAR, i)
-}
+	tst := make([]IFunctionCallSuffixContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFunctionCallSuffixContext); ok {
+			tst[i] = t.(IFunctionCallSuffixContext)
+			i++
+		}
+	}
 
-func (s *MultiplicativeExpressionContext) AllSLASH() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserSLASH)
+	return tst
 }
 
-func (s *MultiplicativeExpressionContext) SLASH(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserSLASH, i)
+func (s *PostfixExpressionContext) FunctionCallSuffix(i int) IFunctionCallSuffixContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionCallSuffixContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionCallSuffixContext)
 }
 
-func (s *MultiplicativeExpressionContext) AllPERCENT() []antlr.TerminalNode {
-	return s.GetTokens(ArcParserPERCENT)
+func (s *PostfixExpressionContext) AllMemberAccess() []IMemberAccessContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMemberAccessContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMemberAccessContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMemberAccessContext); ok {
+			tst[i] = t.(IMemberAccessContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *MultiplicativeExpressionContext) PERCENT(i int) antlr.TerminalNode {
-	return s.GetToken(ArcParserPERCENT, i)
+func (s *PostfixExpressionContext) MemberAccess(i int) IMemberAccessContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMemberAccessContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMemberAccessContext)
 }
 
-func (s *MultiplicativeExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *PostfixExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MultiplicativeExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PostfixExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MultiplicativeExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PostfixExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterMultiplicativeExpression(s)
+		listenerT.EnterPostfixExpression(s)
 	}
 }
 
-func (s *MultiplicativeExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PostfixExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitMultiplicativeExpression(s)
+		listenerT.ExitPostfixExpression(s)
 	}
 }
 
-func (s *MultiplicativeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PostfixExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitMultiplicativeExpression(s)
+		return t.VisitPostfixExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) MultiplicativeExpression() (localctx IMultiplicativeExpressionContext) {
-	localctx = NewMultiplicativeExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, ArcParserRULE_multiplicativeExpression)
-	var _la int
+func (p *ArcParser) PostfixExpression() (localctx IPostfixExpressionContext) {
+	localctx = NewPostfixExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 166, ArcParserRULE_postfixExpression)
+	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(682)
-		p.PowerExpression()
+		p.SetState(860)
+		p.PrimaryExpression()
 	}
-	p.SetState(687)
+	p.SetState(866)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 97, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			p.SetState(864)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3848290697216) != 0 {
-		{
-			p.SetState(683)
-			_la = p.GetTokenStream().LA(1)
+			switch p.GetTokenStream().LA(1) {
+			case ArcParserLBRACKET:
+				{
+					p.SetState(861)
+					p.IndexOrSlice()
+				}
 
-			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3848290697216) != 0) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
+			case ArcParserLPAREN:
+				{
+					p.SetState(862)
+					p.FunctionCallSuffix()
+				}
+
+			case ArcParserDOT:
+				{
+					p.SetState(863)
+					p.MemberAccess()
+				}
+
+			default:
+				p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				goto errorExit
 			}
-		}
-		{
-			p.SetState(684)
-			p.PowerExpression()
-		}
 
-		p.SetState(689)
+		}
+		p.SetState(868)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 97, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -13116,80 +16414,91 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPowerExpressionContext is an interface to support dynamic dispatch.
-type IPowerExpressionContext interface {
+// IIndexOrSliceContext is an interface to support dynamic dispatch.
+type IIndexOrSliceContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	UnaryExpression() IUnaryExpressionContext
-	CARET() antlr.TerminalNode
-	PowerExpression() IPowerExpressionContext
+	LBRACKET() antlr.TerminalNode
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	RBRACKET() antlr.TerminalNode
+	COLON() antlr.TerminalNode
 
-	// IsPowerExpressionContext differentiates from other interfaces.
-	IsPowerExpressionContext()
+	// IsIndexOrSliceContext differentiates from other interfaces.
+	IsIndexOrSliceContext()
 }
 
-type PowerExpressionContext struct {
+type IndexOrSliceContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPowerExpressionContext() *PowerExpressionContext {
-	var p = new(PowerExpressionContext)
+func NewEmptyIndexOrSliceContext() *IndexOrSliceContext {
+	var p = new(IndexOrSliceContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_powerExpression
+	p.RuleIndex = ArcParserRULE_indexOrSlice
 	return p
 }
 
-func InitEmptyPowerExpressionContext(p *PowerExpressionContext) {
+func InitEmptyIndexOrSliceContext(p *IndexOrSliceContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_powerExpression
+	p.RuleIndex = ArcParserRULE_indexOrSlice
 }
 
-func (*PowerExpressionContext) IsPowerExpressionContext() {}
+func (*IndexOrSliceContext) IsIndexOrSliceContext() {}
 
-func NewPowerExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PowerExpressionContext {
-	var p = new(PowerExpressionContext)
+func NewIndexOrSliceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IndexOrSliceContext {
+	var p = new(IndexOrSliceContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_powerExpression
+	p.RuleIndex = ArcParserRULE_indexOrSlice
 
 	return p
 }
 
-func (s *PowerExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *IndexOrSliceContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PowerExpressionContext) UnaryExpression() IUnaryExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnaryExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *IndexOrSliceContext) LBRACKET() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACKET, 0)
+}
+
+func (s *IndexOrSliceContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
 	}
 
-	return t.(IUnaryExpressionContext)
-}
-
-func (s *PowerExpressionContext) CARET() antlr.TerminalNode {
-	return s.GetToken(ArcParserCARET, 0)
+	return tst
 }
 
-func (s *PowerExpressionContext) PowerExpression() IPowerExpressionContext {
+func (s *IndexOrSliceContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPowerExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -13197,70 +16506,139 @@ func (s *PowerExpressionContext) PowerExpression() IPowerExpressionContext {
 		return nil
 	}
 
-	return t.(IPowerExpressionContext)
+	return t.(IExpressionContext)
 }
 
-func (s *PowerExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *IndexOrSliceContext) RBRACKET() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACKET, 0)
+}
+
+func (s *IndexOrSliceContext) COLON() antlr.TerminalNode {
+	return s.GetToken(ArcParserCOLON, 0)
+}
+
+func (s *IndexOrSliceContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PowerExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IndexOrSliceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PowerExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IndexOrSliceContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterPowerExpression(s)
+		listenerT.EnterIndexOrSlice(s)
 	}
 }
 
-func (s *PowerExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IndexOrSliceContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitPowerExpression(s)
+		listenerT.ExitIndexOrSlice(s)
 	}
 }
 
-func (s *PowerExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IndexOrSliceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitPowerExpression(s)
+		return t.VisitIndexOrSlice(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) PowerExpression() (localctx IPowerExpressionContext) {
-	localctx = NewPowerExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, ArcParserRULE_powerExpression)
+func (p *ArcParser) IndexOrSlice() (localctx IIndexOrSliceContext) {
+	localctx = NewIndexOrSliceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 168, ArcParserRULE_indexOrSlice)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(690)
-		p.UnaryExpression()
-	}
-	p.SetState(693)
+	p.SetState(882)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == ArcParserCARET {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 100, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(691)
-			p.Match(ArcParserCARET)
+			p.SetState(869)
+			p.Match(ArcParserLBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(692)
-			p.PowerExpression()
+			p.SetState(870)
+			p.Expression()
+		}
+		{
+			p.SetState(871)
+			p.Match(ArcParserRBRACKET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(873)
+			p.Match(ArcParserLBRACKET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(875)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64((_la-14)) & ^0x3f) == 0 && ((int64(1)<<(_la-14))&69882760306753507) != 0 {
+			{
+				p.SetState(874)
+				p.Expression()
+			}
+
+		}
+		{
+			p.SetState(877)
+			p.Match(ArcParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(879)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64((_la-14)) & ^0x3f) == 0 && ((int64(1)<<(_la-14))&69882760306753507) != 0 {
+			{
+				p.SetState(878)
+				p.Expression()
+			}
+
+		}
+		{
+			p.SetState(881)
+			p.Match(ArcParserRBRACKET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -13276,83 +16654,66 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IUnaryExpressionContext is an interface to support dynamic dispatch.
-type IUnaryExpressionContext interface {
+// IFunctionCallSuffixContext is an interface to support dynamic dispatch.
+type IFunctionCallSuffixContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	MINUS() antlr.TerminalNode
-	UnaryExpression() IUnaryExpressionContext
-	NOT() antlr.TerminalNode
-	PostfixExpression() IPostfixExpressionContext
+	LPAREN() antlr.TerminalNode
+	RPAREN() antlr.TerminalNode
+	ArgumentList() IArgumentListContext
 
-	// IsUnaryExpressionContext differentiates from other interfaces.
-	IsUnaryExpressionContext()
+	// IsFunctionCallSuffixContext differentiates from other interfaces.
+	IsFunctionCallSuffixContext()
 }
 
-type UnaryExpressionContext struct {
+type FunctionCallSuffixContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUnaryExpressionContext() *UnaryExpressionContext {
-	var p = new(UnaryExpressionContext)
+func NewEmptyFunctionCallSuffixContext() *FunctionCallSuffixContext {
+	var p = new(FunctionCallSuffixContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_unaryExpression
+	p.RuleIndex = ArcParserRULE_functionCallSuffix
 	return p
 }
 
-func InitEmptyUnaryExpressionContext(p *UnaryExpressionContext) {
+func InitEmptyFunctionCallSuffixContext(p *FunctionCallSuffixContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_unaryExpression
+	p.RuleIndex = ArcParserRULE_functionCallSuffix
 }
 
-func (*UnaryExpressionContext) IsUnaryExpressionContext() {}
+func (*FunctionCallSuffixContext) IsFunctionCallSuffixContext() {}
 
-func NewUnaryExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnaryExpressionContext {
-	var p = new(UnaryExpressionContext)
+func NewFunctionCallSuffixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallSuffixContext {
+	var p = new(FunctionCallSuffixContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_unaryExpression
+	p.RuleIndex = ArcParserRULE_functionCallSuffix
 
 	return p
 }
 
-func (s *UnaryExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *UnaryExpressionContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(ArcParserMINUS, 0)
-}
-
-func (s *UnaryExpressionContext) UnaryExpression() IUnaryExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnaryExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *FunctionCallSuffixContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IUnaryExpressionContext)
+func (s *FunctionCallSuffixContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserLPAREN, 0)
 }
 
-func (s *UnaryExpressionContext) NOT() antlr.TerminalNode {
-	return s.GetToken(ArcParserNOT, 0)
+func (s *FunctionCallSuffixContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserRPAREN, 0)
 }
 
-func (s *UnaryExpressionContext) PostfixExpression() IPostfixExpressionContext {
+func (s *FunctionCallSuffixContext) ArgumentList() IArgumentListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPostfixExpressionContext); ok {
+		if _, ok := ctx.(IArgumentListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -13362,88 +16723,74 @@ func (s *UnaryExpressionContext) PostfixExpression() IPostfixExpressionContext {
 		return nil
 	}
 
-	return t.(IPostfixExpressionContext)
+	return t.(IArgumentListContext)
 }
 
-func (s *UnaryExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *FunctionCallSuffixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UnaryExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FunctionCallSuffixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *UnaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FunctionCallSuffixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterUnaryExpression(s)
+		listenerT.EnterFunctionCallSuffix(s)
 	}
 }
 
-func (s *UnaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FunctionCallSuffixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitUnaryExpression(s)
+		listenerT.ExitFunctionCallSuffix(s)
 	}
 }
 
-func (s *UnaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FunctionCallSuffixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitUnaryExpression(s)
+		return t.VisitFunctionCallSuffix(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) UnaryExpression() (localctx IUnaryExpressionContext) {
-	localctx = NewUnaryExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, ArcParserRULE_unaryExpression)
-	p.SetState(700)
+func (p *ArcParser) FunctionCallSuffix() (localctx IFunctionCallSuffixContext) {
+	localctx = NewFunctionCallSuffixContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 170, ArcParserRULE_functionCallSuffix)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(884)
+		p.Match(ArcParserLPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(886)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 78, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(695)
-			p.Match(ArcParserMINUS)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(696)
-			p.UnaryExpression()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(697)
-			p.Match(ArcParserNOT)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(698)
-			p.UnaryExpression()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	if (int64((_la-14)) & ^0x3f) == 0 && ((int64(1)<<(_la-14))&69882760306753507) != 0 {
 		{
-			p.SetState(699)
-			p.PostfixExpression()
+			p.SetState(885)
+			p.ArgumentList()
 		}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+	}
+	{
+		p.SetState(888)
+		p.Match(ArcParserRPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -13459,237 +16806,108 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPostfixExpressionContext is an interface to support dynamic dispatch.
-type IPostfixExpressionContext interface {
+// IMemberAccessContext is an interface to support dynamic dispatch.
+type IMemberAccessContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PrimaryExpression() IPrimaryExpressionContext
-	AllIndexOrSlice() []IIndexOrSliceContext
-	IndexOrSlice(i int) IIndexOrSliceContext
-	AllFunctionCallSuffix() []IFunctionCallSuffixContext
-	FunctionCallSuffix(i int) IFunctionCallSuffixContext
+	DOT() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
 
-	// IsPostfixExpressionContext differentiates from other interfaces.
-	IsPostfixExpressionContext()
+	// IsMemberAccessContext differentiates from other interfaces.
+	IsMemberAccessContext()
 }
 
-type PostfixExpressionContext struct {
+type MemberAccessContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPostfixExpressionContext() *PostfixExpressionContext {
-	var p = new(PostfixExpressionContext)
+func NewEmptyMemberAccessContext() *MemberAccessContext {
+	var p = new(MemberAccessContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_postfixExpression
+	p.RuleIndex = ArcParserRULE_memberAccess
 	return p
 }
 
-func InitEmptyPostfixExpressionContext(p *PostfixExpressionContext) {
+func InitEmptyMemberAccessContext(p *MemberAccessContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_postfixExpression
+	p.RuleIndex = ArcParserRULE_memberAccess
 }
 
-func (*PostfixExpressionContext) IsPostfixExpressionContext() {}
+func (*MemberAccessContext) IsMemberAccessContext() {}
 
-func NewPostfixExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PostfixExpressionContext {
-	var p = new(PostfixExpressionContext)
+func NewMemberAccessContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MemberAccessContext {
+	var p = new(MemberAccessContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_postfixExpression
+	p.RuleIndex = ArcParserRULE_memberAccess
 
 	return p
 }
 
-func (s *PostfixExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *PostfixExpressionContext) PrimaryExpression() IPrimaryExpressionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrimaryExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IPrimaryExpressionContext)
-}
-
-func (s *PostfixExpressionContext) AllIndexOrSlice() []IIndexOrSliceContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IIndexOrSliceContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IIndexOrSliceContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IIndexOrSliceContext); ok {
-			tst[i] = t.(IIndexOrSliceContext)
-			i++
-		}
-	}
-
-	return tst
-}
-
-func (s *PostfixExpressionContext) IndexOrSlice(i int) IIndexOrSliceContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIndexOrSliceContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIndexOrSliceContext)
-}
-
-func (s *PostfixExpressionContext) AllFunctionCallSuffix() []IFunctionCallSuffixContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IFunctionCallSuffixContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IFunctionCallSuffixContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IFunctionCallSuffixContext); ok {
-			tst[i] = t.(IFunctionCallSuffixContext)
-			i++
-		}
-	}
+func (s *MemberAccessContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *MemberAccessContext) DOT() antlr.TerminalNode {
+	return s.GetToken(ArcParserDOT, 0)
 }
 
-func (s *PostfixExpressionContext) FunctionCallSuffix(i int) IFunctionCallSuffixContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFunctionCallSuffixContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFunctionCallSuffixContext)
+func (s *MemberAccessContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *PostfixExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *MemberAccessContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PostfixExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MemberAccessContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PostfixExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MemberAccessContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterPostfixExpression(s)
+		listenerT.EnterMemberAccess(s)
 	}
 }
 
-func (s *PostfixExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MemberAccessContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitPostfixExpression(s)
+		listenerT.ExitMemberAccess(s)
 	}
 }
 
-func (s *PostfixExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MemberAccessContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitPostfixExpression(s)
+		return t.VisitMemberAccess(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) PostfixExpression() (localctx IPostfixExpressionContext) {
-	localctx = NewPostfixExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, ArcParserRULE_postfixExpression)
-	var _alt int
-
+func (p *ArcParser) MemberAccess() (localctx IMemberAccessContext) {
+	localctx = NewMemberAccessContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 172, ArcParserRULE_memberAccess)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(702)
-		p.PrimaryExpression()
-	}
-	p.SetState(707)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 80, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			p.SetState(705)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-
-			switch p.GetTokenStream().LA(1) {
-			case ArcParserLBRACKET:
-				{
-					p.SetState(703)
-					p.IndexOrSlice()
-				}
-
-			case ArcParserLPAREN:
-				{
-					p.SetState(704)
-					p.FunctionCallSuffix()
-				}
-
-			default:
-				p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-				goto errorExit
-			}
-
-		}
-		p.SetState(709)
-		p.GetErrorHandler().Sync(p)
+		p.SetState(890)
+		p.Match(ArcParserDOT)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 80, p.GetParserRuleContext())
+	}
+	{
+		p.SetState(891)
+		p.Match(ArcParserIDENTIFIER)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
@@ -13707,91 +16925,125 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IIndexOrSliceContext is an interface to support dynamic dispatch.
-type IIndexOrSliceContext interface {
+// IPrimaryExpressionContext is an interface to support dynamic dispatch.
+type IPrimaryExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LBRACKET() antlr.TerminalNode
-	AllExpression() []IExpressionContext
-	Expression(i int) IExpressionContext
-	RBRACKET() antlr.TerminalNode
-	COLON() antlr.TerminalNode
+	Literal() ILiteralContext
+	QualifiedIdentifier() IQualifiedIdentifierContext
+	IDENTIFIER() antlr.TerminalNode
+	LPAREN() antlr.TerminalNode
+	Expression() IExpressionContext
+	RPAREN() antlr.TerminalNode
+	TypeCast() ITypeCastContext
+	StructLiteral() IStructLiteralContext
 
-	// IsIndexOrSliceContext differentiates from other interfaces.
-	IsIndexOrSliceContext()
+	// IsPrimaryExpressionContext differentiates from other interfaces.
+	IsPrimaryExpressionContext()
 }
 
-type IndexOrSliceContext struct {
+type PrimaryExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyIndexOrSliceContext() *IndexOrSliceContext {
-	var p = new(IndexOrSliceContext)
+func NewEmptyPrimaryExpressionContext() *PrimaryExpressionContext {
+	var p = new(PrimaryExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_indexOrSlice
+	p.RuleIndex = ArcParserRULE_primaryExpression
 	return p
 }
 
-func InitEmptyIndexOrSliceContext(p *IndexOrSliceContext) {
+func InitEmptyPrimaryExpressionContext(p *PrimaryExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_indexOrSlice
+	p.RuleIndex = ArcParserRULE_primaryExpression
 }
 
-func (*IndexOrSliceContext) IsIndexOrSliceContext() {}
+func (*PrimaryExpressionContext) IsPrimaryExpressionContext() {}
 
-func NewIndexOrSliceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IndexOrSliceContext {
-	var p = new(IndexOrSliceContext)
+func NewPrimaryExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimaryExpressionContext {
+	var p = new(PrimaryExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_indexOrSlice
+	p.RuleIndex = ArcParserRULE_primaryExpression
 
 	return p
 }
 
-func (s *IndexOrSliceContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrimaryExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrimaryExpressionContext) Literal() ILiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralContext)
+}
+
+func (s *PrimaryExpressionContext) QualifiedIdentifier() IQualifiedIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQualifiedIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQualifiedIdentifierContext)
+}
+
+func (s *PrimaryExpressionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
 
-func (s *IndexOrSliceContext) LBRACKET() antlr.TerminalNode {
-	return s.GetToken(ArcParserLBRACKET, 0)
+func (s *PrimaryExpressionContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserLPAREN, 0)
 }
 
-func (s *IndexOrSliceContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
+func (s *PrimaryExpressionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
-			len++
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IExpressionContext)
 }
 
-func (s *IndexOrSliceContext) Expression(i int) IExpressionContext {
+func (s *PrimaryExpressionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserRPAREN, 0)
+}
+
+func (s *PrimaryExpressionContext) TypeCast() ITypeCastContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITypeCastContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -13799,135 +17051,125 @@ func (s *IndexOrSliceContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ITypeCastContext)
 }
 
-func (s *IndexOrSliceContext) RBRACKET() antlr.TerminalNode {
-	return s.GetToken(ArcParserRBRACKET, 0)
-}
+func (s *PrimaryExpressionContext) StructLiteral() IStructLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStructLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *IndexOrSliceContext) COLON() antlr.TerminalNode {
-	return s.GetToken(ArcParserCOLON, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructLiteralContext)
 }
 
-func (s *IndexOrSliceContext) GetRuleContext() antlr.RuleContext {
+func (s *PrimaryExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IndexOrSliceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrimaryExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IndexOrSliceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrimaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterIndexOrSlice(s)
+		listenerT.EnterPrimaryExpression(s)
 	}
 }
 
-func (s *IndexOrSliceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrimaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitIndexOrSlice(s)
+		listenerT.ExitPrimaryExpression(s)
 	}
 }
 
-func (s *IndexOrSliceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrimaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitIndexOrSlice(s)
+		return t.VisitPrimaryExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) IndexOrSlice() (localctx IIndexOrSliceContext) {
-	localctx = NewIndexOrSliceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, ArcParserRULE_indexOrSlice)
-	var _la int
-
-	p.SetState(723)
+func (p *ArcParser) PrimaryExpression() (localctx IPrimaryExpressionContext) {
+	localctx = NewPrimaryExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 174, ArcParserRULE_primaryExpression)
+	p.SetState(902)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 83, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 102, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(710)
-			p.Match(ArcParserLBRACKET)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(893)
+			p.Literal()
 		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(711)
-			p.Expression()
+			p.SetState(894)
+			p.QualifiedIdentifier()
 		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(712)
-			p.Match(ArcParserRBRACKET)
+			p.SetState(895)
+			p.Match(ArcParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(714)
-			p.Match(ArcParserLBRACKET)
+			p.SetState(896)
+			p.Match(ArcParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(716)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		if (int64((_la-13)) & ^0x3f) == 0 && ((int64(1)<<(_la-13))&8735345038344191) != 0 {
-			{
-				p.SetState(715)
-				p.Expression()
-			}
-
+		{
+			p.SetState(897)
+			p.Expression()
 		}
 		{
-			p.SetState(718)
-			p.Match(ArcParserCOLON)
+			p.SetState(898)
+			p.Match(ArcParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(720)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		if (int64((_la-13)) & ^0x3f) == 0 && ((int64(1)<<(_la-13))&8735345038344191) != 0 {
-			{
-				p.SetState(719)
-				p.Expression()
-			}
 
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(900)
+			p.TypeCast()
 		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(722)
-			p.Match(ArcParserRBRACKET)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(901)
+			p.StructLiteral()
 		}
 
 	case antlr.ATNInvalidAltNumber:
@@ -13947,66 +17189,79 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFunctionCallSuffixContext is an interface to support dynamic dispatch.
-type IFunctionCallSuffixContext interface {
+// ITypeCastContext is an interface to support dynamic dispatch.
+type ITypeCastContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
+	Type_() ITypeContext
 	LPAREN() antlr.TerminalNode
+	Expression() IExpressionContext
 	RPAREN() antlr.TerminalNode
-	ArgumentList() IArgumentListContext
 
-	// IsFunctionCallSuffixContext differentiates from other interfaces.
-	IsFunctionCallSuffixContext()
+	// IsTypeCastContext differentiates from other interfaces.
+	IsTypeCastContext()
 }
 
-type FunctionCallSuffixContext struct {
+type TypeCastContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionCallSuffixContext() *FunctionCallSuffixContext {
-	var p = new(FunctionCallSuffixContext)
+func NewEmptyTypeCastContext() *TypeCastContext {
+	var p = new(TypeCastContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_functionCallSuffix
+	p.RuleIndex = ArcParserRULE_typeCast
 	return p
 }
 
-func InitEmptyFunctionCallSuffixContext(p *FunctionCallSuffixContext) {
+func InitEmptyTypeCastContext(p *TypeCastContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_functionCallSuffix
+	p.RuleIndex = ArcParserRULE_typeCast
 }
 
-func (*FunctionCallSuffixContext) IsFunctionCallSuffixContext() {}
+func (*TypeCastContext) IsTypeCastContext() {}
 
-func NewFunctionCallSuffixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallSuffixContext {
-	var p = new(FunctionCallSuffixContext)
+func NewTypeCastContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeCastContext {
+	var p = new(TypeCastContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_functionCallSuffix
+	p.RuleIndex = ArcParserRULE_typeCast
 
 	return p
 }
 
-func (s *FunctionCallSuffixContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeCastContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionCallSuffixContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserLPAREN, 0)
+func (s *TypeCastContext) Type_() ITypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeContext)
 }
 
-func (s *FunctionCallSuffixContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserRPAREN, 0)
+func (s *TypeCastContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserLPAREN, 0)
 }
 
-func (s *FunctionCallSuffixContext) ArgumentList() IArgumentListContext {
+func (s *TypeCastContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IArgumentListContext); ok {
+		if _, ok := ctx.(IExpressionContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -14016,69 +17271,65 @@ func (s *FunctionCallSuffixContext) ArgumentList() IArgumentListContext {
 		return nil
 	}
 
-	return t.(IArgumentListContext)
+	return t.(IExpressionContext)
 }
 
-func (s *FunctionCallSuffixContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeCastContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(ArcParserRPAREN, 0)
+}
+
+func (s *TypeCastContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionCallSuffixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeCastContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionCallSuffixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeCastContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterFunctionCallSuffix(s)
+		listenerT.EnterTypeCast(s)
 	}
 }
 
-func (s *FunctionCallSuffixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeCastContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitFunctionCallSuffix(s)
+		listenerT.ExitTypeCast(s)
 	}
 }
 
-func (s *FunctionCallSuffixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TypeCastContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitFunctionCallSuffix(s)
+		return t.VisitTypeCast(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) FunctionCallSuffix() (localctx IFunctionCallSuffixContext) {
-	localctx = NewFunctionCallSuffixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, ArcParserRULE_functionCallSuffix)
-	var _la int
-
+func (p *ArcParser) TypeCast() (localctx ITypeCastContext) {
+	localctx = NewTypeCastContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 176, ArcParserRULE_typeCast)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(725)
+		p.SetState(904)
+		p.Type_()
+	}
+	{
+		p.SetState(905)
 		p.Match(ArcParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(727)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if (int64((_la-13)) & ^0x3f) == 0 && ((int64(1)<<(_la-13))&8735345038344191) != 0 {
-		{
-			p.SetState(726)
-			p.ArgumentList()
-		}
-
+	{
+		p.SetState(906)
+		p.Expression()
 	}
 	{
-		p.SetState(729)
+		p.SetState(907)
 		p.Match(ArcParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -14099,104 +17350,97 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPrimaryExpressionContext is an interface to support dynamic dispatch.
-type IPrimaryExpressionContext interface {
+// IStructLiteralContext is an interface to support dynamic dispatch.
+type IStructLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Literal() ILiteralContext
-	QualifiedIdentifier() IQualifiedIdentifierContext
 	IDENTIFIER() antlr.TerminalNode
-	LPAREN() antlr.TerminalNode
-	Expression() IExpressionContext
-	RPAREN() antlr.TerminalNode
-	TypeCast() ITypeCastContext
-
-	// IsPrimaryExpressionContext differentiates from other interfaces.
-	IsPrimaryExpressionContext()
+	LBRACE() antlr.TerminalNode
+	AllStructFieldValue() []IStructFieldValueContext
+	StructFieldValue(i int) IStructFieldValueContext
+	RBRACE() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsStructLiteralContext differentiates from other interfaces.
+	IsStructLiteralContext()
 }
 
-type PrimaryExpressionContext struct {
+type StructLiteralContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrimaryExpressionContext() *PrimaryExpressionContext {
-	var p = new(PrimaryExpressionContext)
+func NewEmptyStructLiteralContext() *StructLiteralContext {
+	var p = new(StructLiteralContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_primaryExpression
+	p.RuleIndex = ArcParserRULE_structLiteral
 	return p
 }
 
-func InitEmptyPrimaryExpressionContext(p *PrimaryExpressionContext) {
+func InitEmptyStructLiteralContext(p *StructLiteralContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_primaryExpression
+	p.RuleIndex = ArcParserRULE_structLiteral
 }
 
-func (*PrimaryExpressionContext) IsPrimaryExpressionContext() {}
+func (*StructLiteralContext) IsStructLiteralContext() {}
 
-func NewPrimaryExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimaryExpressionContext {
-	var p = new(PrimaryExpressionContext)
+func NewStructLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructLiteralContext {
+	var p = new(StructLiteralContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_primaryExpression
+	p.RuleIndex = ArcParserRULE_structLiteral
 
 	return p
 }
 
-func (s *PrimaryExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *PrimaryExpressionContext) Literal() ILiteralContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILiteralContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *StructLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *StructLiteralContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
+}
 
-	return t.(ILiteralContext)
+func (s *StructLiteralContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserLBRACE, 0)
 }
 
-func (s *PrimaryExpressionContext) QualifiedIdentifier() IQualifiedIdentifierContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IQualifiedIdentifierContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *StructLiteralContext) AllStructFieldValue() []IStructFieldValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStructFieldValueContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IStructFieldValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStructFieldValueContext); ok {
+			tst[i] = t.(IStructFieldValueContext)
+			i++
+		}
 	}
 
-	return t.(IQualifiedIdentifierContext)
-}
-
-func (s *PrimaryExpressionContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(ArcParserIDENTIFIER, 0)
-}
-
-func (s *PrimaryExpressionContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserLPAREN, 0)
+	return tst
 }
 
-func (s *PrimaryExpressionContext) Expression() IExpressionContext {
+func (s *StructLiteralContext) StructFieldValue(i int) IStructFieldValueContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IStructFieldValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -14204,126 +17448,139 @@ func (s *PrimaryExpressionContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IStructFieldValueContext)
 }
 
-func (s *PrimaryExpressionContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserRPAREN, 0)
+func (s *StructLiteralContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(ArcParserRBRACE, 0)
 }
 
-func (s *PrimaryExpressionContext) TypeCast() ITypeCastContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeCastContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *StructLiteralContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(ArcParserCOMMA)
+}
 
-	return t.(ITypeCastContext)
+func (s *StructLiteralContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(ArcParserCOMMA, i)
 }
 
-func (s *PrimaryExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *StructLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PrimaryExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PrimaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterPrimaryExpression(s)
+		listenerT.EnterStructLiteral(s)
 	}
 }
 
-func (s *PrimaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitPrimaryExpression(s)
+		listenerT.ExitStructLiteral(s)
 	}
 }
 
-func (s *PrimaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StructLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitPrimaryExpression(s)
+		return t.VisitStructLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) PrimaryExpression() (localctx IPrimaryExpressionContext) {
-	localctx = NewPrimaryExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, ArcParserRULE_primaryExpression)
-	p.SetState(739)
+func (p *ArcParser) StructLiteral() (localctx IStructLiteralContext) {
+	localctx = NewStructLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 178, ArcParserRULE_structLiteral)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(909)
+		p.Match(ArcParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(910)
+		p.Match(ArcParserLBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(911)
+		p.StructFieldValue()
+	}
+	p.SetState(916)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 103, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(912)
+				p.Match(ArcParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(913)
+				p.StructFieldValue()
+			}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 85, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(731)
-			p.Literal()
 		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(732)
-			p.QualifiedIdentifier()
+		p.SetState(918)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(733)
-			p.Match(ArcParserIDENTIFIER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 103, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
 		}
+	}
+	p.SetState(920)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(734)
-			p.Match(ArcParserLPAREN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(735)
-			p.Expression()
-		}
+	if _la == ArcParserCOMMA {
 		{
-			p.SetState(736)
-			p.Match(ArcParserRPAREN)
+			p.SetState(919)
+			p.Match(ArcParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(738)
-			p.TypeCast()
+	}
+	{
+		p.SetState(922)
+		p.Match(ArcParserRBRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -14339,76 +17596,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITypeCastContext is an interface to support dynamic dispatch.
-type ITypeCastContext interface {
+// IStructFieldValueContext is an interface to support dynamic dispatch.
+type IStructFieldValueContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Type_() ITypeContext
-	LPAREN() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	COLON() antlr.TerminalNode
 	Expression() IExpressionContext
-	RPAREN() antlr.TerminalNode
 
-	// IsTypeCastContext differentiates from other interfaces.
-	IsTypeCastContext()
+	// IsStructFieldValueContext differentiates from other interfaces.
+	IsStructFieldValueContext()
 }
 
-type TypeCastContext struct {
+type StructFieldValueContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeCastContext() *TypeCastContext {
-	var p = new(TypeCastContext)
+func NewEmptyStructFieldValueContext() *StructFieldValueContext {
+	var p = new(StructFieldValueContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_typeCast
+	p.RuleIndex = ArcParserRULE_structFieldValue
 	return p
 }
 
-func InitEmptyTypeCastContext(p *TypeCastContext) {
+func InitEmptyStructFieldValueContext(p *StructFieldValueContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = ArcParserRULE_typeCast
+	p.RuleIndex = ArcParserRULE_structFieldValue
 }
 
-func (*TypeCastContext) IsTypeCastContext() {}
+func (*StructFieldValueContext) IsStructFieldValueContext() {}
 
-func NewTypeCastContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeCastContext {
-	var p = new(TypeCastContext)
+func NewStructFieldValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructFieldValueContext {
+	var p = new(StructFieldValueContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = ArcParserRULE_typeCast
+	p.RuleIndex = ArcParserRULE_structFieldValue
 
 	return p
 }
 
-func (s *TypeCastContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TypeCastContext) Type_() ITypeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *StructFieldValueContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(ITypeContext)
+func (s *StructFieldValueContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(ArcParserIDENTIFIER, 0)
 }
 
-func (s *TypeCastContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserLPAREN, 0)
+func (s *StructFieldValueContext) COLON() antlr.TerminalNode {
+	return s.GetToken(ArcParserCOLON, 0)
 }
 
-func (s *TypeCastContext) Expression() IExpressionContext {
+func (s *StructFieldValueContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
@@ -14424,68 +17668,60 @@ func (s *TypeCastContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *TypeCastContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(ArcParserRPAREN, 0)
-}
-
-func (s *TypeCastContext) GetRuleContext() antlr.RuleContext {
+func (s *StructFieldValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeCastContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StructFieldValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeCastContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldValueContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.EnterTypeCast(s)
+		listenerT.EnterStructFieldValue(s)
 	}
 }
 
-func (s *TypeCastContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldValueContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(ArcParserListener); ok {
-		listenerT.ExitTypeCast(s)
+		listenerT.ExitStructFieldValue(s)
 	}
 }
 
-func (s *TypeCastContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StructFieldValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case ArcParserVisitor:
-		return t.VisitTypeCast(s)
+		return t.VisitStructFieldValue(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *ArcParser) TypeCast() (localctx ITypeCastContext) {
-	localctx = NewTypeCastContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, ArcParserRULE_typeCast)
+func (p *ArcParser) StructFieldValue() (localctx IStructFieldValueContext) {
+	localctx = NewStructFieldValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 180, ArcParserRULE_structFieldValue)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(741)
-		p.Type_()
-	}
-	{
-		p.SetState(742)
-		p.Match(ArcParserLPAREN)
+		p.SetState(924)
+		p.Match(ArcParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(743)
-		p.Expression()
-	}
-	{
-		p.SetState(744)
-		p.Match(ArcParserRPAREN)
+		p.SetState(925)
+		p.Match(ArcParserCOLON)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	{
+		p.SetState(926)
+		p.Expression()
+	}
 
 errorExit:
 	if p.HasError() {
@@ -14621,8 +17857,8 @@ func (s *LiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ArcParser) Literal() (localctx ILiteralContext) {
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, ArcParserRULE_literal)
-	p.SetState(750)
+	p.EnterRule(localctx, 182, ArcParserRULE_literal)
+	p.SetState(932)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -14632,14 +17868,14 @@ func (p *ArcParser) Literal() (localctx ILiteralContext) {
 	case ArcParserMINUS, ArcParserINTEGER_LITERAL, ArcParserFLOAT_LITERAL:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(746)
+			p.SetState(928)
 			p.NumericLiteral()
 		}
 
 	case ArcParserSTR_LITERAL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(747)
+			p.SetState(929)
 			p.Match(ArcParserSTR_LITERAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14650,7 +17886,7 @@ func (p *ArcParser) Literal() (localctx ILiteralContext) {
 	case ArcParserSTR_LITERAL_MULTI:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(748)
+			p.SetState(930)
 			p.Match(ArcParserSTR_LITERAL_MULTI)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14661,7 +17897,7 @@ func (p *ArcParser) Literal() (localctx ILiteralContext) {
 	case ArcParserLBRACKET:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(749)
+			p.SetState(931)
 			p.SeriesLiteral()
 		}
 
@@ -14780,11 +18016,11 @@ func (s *NumericLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *ArcParser) NumericLiteral() (localctx INumericLiteralContext) {
 	localctx = NewNumericLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, ArcParserRULE_numericLiteral)
+	p.EnterRule(localctx, 184, ArcParserRULE_numericLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(753)
+	p.SetState(935)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -14793,7 +18029,7 @@ func (p *ArcParser) NumericLiteral() (localctx INumericLiteralContext) {
 
 	if _la == ArcParserMINUS {
 		{
-			p.SetState(752)
+			p.SetState(934)
 			p.Match(ArcParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14803,7 +18039,7 @@ func (p *ArcParser) NumericLiteral() (localctx INumericLiteralContext) {
 
 	}
 	{
-		p.SetState(755)
+		p.SetState(937)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == ArcParserINTEGER_LITERAL || _la == ArcParserFLOAT_LITERAL) {
@@ -14813,18 +18049,18 @@ func (p *ArcParser) NumericLiteral() (localctx INumericLiteralContext) {
 			p.Consume()
 		}
 	}
-	p.SetState(758)
+	p.SetState(940)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 88, p.GetParserRuleContext()) == 1 {
-		p.SetState(756)
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 107, p.GetParserRuleContext()) == 1 {
+		p.SetState(938)
 
 		if !(p.TokensAdjacent(p.GetTokenStream().LT(-1), p.GetTokenStream().LT(1))) {
 			p.SetError(antlr.NewFailedPredicateException(p, "p.TokensAdjacent(p.GetTokenStream().LT(-1), p.GetTokenStream().LT(1))", ""))
 			goto errorExit
 		}
 		{
-			p.SetState(757)
+			p.SetState(939)
 			p.Match(ArcParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14953,34 +18189,34 @@ func (s *SeriesLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *ArcParser) SeriesLiteral() (localctx ISeriesLiteralContext) {
 	localctx = NewSeriesLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, ArcParserRULE_seriesLiteral)
+	p.EnterRule(localctx, 186, ArcParserRULE_seriesLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(760)
+		p.SetState(942)
 		p.Match(ArcParserLBRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(762)
+	p.SetState(944)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-13)) & ^0x3f) == 0 && ((int64(1)<<(_la-13))&8735345038344191) != 0 {
+	if (int64((_la-14)) & ^0x3f) == 0 && ((int64(1)<<(_la-14))&69882760306753507) != 0 {
 		{
-			p.SetState(761)
+			p.SetState(943)
 			p.ExpressionList()
 		}
 
 	}
 	{
-		p.SetState(764)
+		p.SetState(946)
 		p.Match(ArcParserRBRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -15131,29 +18367,29 @@ func (s *ExpressionListContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *ArcParser) ExpressionList() (localctx IExpressionListContext) {
 	localctx = NewExpressionListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, ArcParserRULE_expressionList)
+	p.EnterRule(localctx, 188, ArcParserRULE_expressionList)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(766)
+		p.SetState(948)
 		p.Expression()
 	}
-	p.SetState(771)
+	p.SetState(953)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 90, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 109, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(767)
+				p.SetState(949)
 				p.Match(ArcParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15161,22 +18397,22 @@ func (p *ArcParser) ExpressionList() (localctx IExpressionListContext) {
 				}
 			}
 			{
-				p.SetState(768)
+				p.SetState(950)
 				p.Expression()
 			}
 
 		}
-		p.SetState(773)
+		p.SetState(955)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 90, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 109, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(775)
+	p.SetState(957)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15185,7 +18421,7 @@ func (p *ArcParser) ExpressionList() (localctx IExpressionListContext) {
 
 	if _la == ArcParserCOMMA {
 		{
-			p.SetState(774)
+			p.SetState(956)
 			p.Match(ArcParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15210,7 +18446,7 @@ errorExit:
 
 func (p *ArcParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 76:
+	case 92:
 		var t *NumericLiteralContext = nil
 		if localctx != nil {
 			t = localctx.(*NumericLiteralContext)
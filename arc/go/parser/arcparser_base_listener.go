@@ -77,6 +77,18 @@ func (s *BaseArcParserListener) EnterAuthorityEntry(ctx *AuthorityEntryContext)
 // ExitAuthorityEntry is called when production authorityEntry is exited.
 func (s *BaseArcParserListener) ExitAuthorityEntry(ctx *AuthorityEntryContext) {}
 
+// EnterSafetyBlock is called when production safetyBlock is entered.
+func (s *BaseArcParserListener) EnterSafetyBlock(ctx *SafetyBlockContext) {}
+
+// ExitSafetyBlock is called when production safetyBlock is exited.
+func (s *BaseArcParserListener) ExitSafetyBlock(ctx *SafetyBlockContext) {}
+
+// EnterSafetyEntry is called when production safetyEntry is entered.
+func (s *BaseArcParserListener) EnterSafetyEntry(ctx *SafetyEntryContext) {}
+
+// ExitSafetyEntry is called when production safetyEntry is exited.
+func (s *BaseArcParserListener) ExitSafetyEntry(ctx *SafetyEntryContext) {}
+
 // EnterFunctionDeclaration is called when production functionDeclaration is entered.
 func (s *BaseArcParserListener) EnterFunctionDeclaration(ctx *FunctionDeclarationContext) {}
 
@@ -167,6 +179,42 @@ func (s *BaseArcParserListener) EnterSingleInvocation(ctx *SingleInvocationConte
 // ExitSingleInvocation is called when production singleInvocation is exited.
 func (s *BaseArcParserListener) ExitSingleInvocation(ctx *SingleInvocationContext) {}
 
+// EnterStructDeclaration is called when production structDeclaration is entered.
+func (s *BaseArcParserListener) EnterStructDeclaration(ctx *StructDeclarationContext) {}
+
+// ExitStructDeclaration is called when production structDeclaration is exited.
+func (s *BaseArcParserListener) ExitStructDeclaration(ctx *StructDeclarationContext) {}
+
+// EnterStructFieldList is called when production structFieldList is entered.
+func (s *BaseArcParserListener) EnterStructFieldList(ctx *StructFieldListContext) {}
+
+// ExitStructFieldList is called when production structFieldList is exited.
+func (s *BaseArcParserListener) ExitStructFieldList(ctx *StructFieldListContext) {}
+
+// EnterStructField is called when production structField is entered.
+func (s *BaseArcParserListener) EnterStructField(ctx *StructFieldContext) {}
+
+// ExitStructField is called when production structField is exited.
+func (s *BaseArcParserListener) ExitStructField(ctx *StructFieldContext) {}
+
+// EnterEnumDeclaration is called when production enumDeclaration is entered.
+func (s *BaseArcParserListener) EnterEnumDeclaration(ctx *EnumDeclarationContext) {}
+
+// ExitEnumDeclaration is called when production enumDeclaration is exited.
+func (s *BaseArcParserListener) ExitEnumDeclaration(ctx *EnumDeclarationContext) {}
+
+// EnterEnumMemberList is called when production enumMemberList is entered.
+func (s *BaseArcParserListener) EnterEnumMemberList(ctx *EnumMemberListContext) {}
+
+// ExitEnumMemberList is called when production enumMemberList is exited.
+func (s *BaseArcParserListener) ExitEnumMemberList(ctx *EnumMemberListContext) {}
+
+// EnterEnumMember is called when production enumMember is entered.
+func (s *BaseArcParserListener) EnterEnumMember(ctx *EnumMemberContext) {}
+
+// ExitEnumMember is called when production enumMember is exited.
+func (s *BaseArcParserListener) ExitEnumMember(ctx *EnumMemberContext) {}
+
 // EnterGlobalConstant is called when production globalConstant is entered.
 func (s *BaseArcParserListener) EnterGlobalConstant(ctx *GlobalConstantContext) {}
 
@@ -197,6 +245,12 @@ func (s *BaseArcParserListener) EnterRoutingEntry(ctx *RoutingEntryContext) {}
 // ExitRoutingEntry is called when production routingEntry is exited.
 func (s *BaseArcParserListener) ExitRoutingEntry(ctx *RoutingEntryContext) {}
 
+// EnterRoutingKey is called when production routingKey is entered.
+func (s *BaseArcParserListener) EnterRoutingKey(ctx *RoutingKeyContext) {}
+
+// ExitRoutingKey is called when production routingKey is exited.
+func (s *BaseArcParserListener) ExitRoutingKey(ctx *RoutingKeyContext) {}
+
 // EnterFlowNode is called when production flowNode is entered.
 func (s *BaseArcParserListener) EnterFlowNode(ctx *FlowNodeContext) {}
 
@@ -317,6 +371,24 @@ func (s *BaseArcParserListener) EnterElseClause(ctx *ElseClauseContext) {}
 // ExitElseClause is called when production elseClause is exited.
 func (s *BaseArcParserListener) ExitElseClause(ctx *ElseClauseContext) {}
 
+// EnterMatchStatement is called when production matchStatement is entered.
+func (s *BaseArcParserListener) EnterMatchStatement(ctx *MatchStatementContext) {}
+
+// ExitMatchStatement is called when production matchStatement is exited.
+func (s *BaseArcParserListener) ExitMatchStatement(ctx *MatchStatementContext) {}
+
+// EnterMatchArm is called when production matchArm is entered.
+func (s *BaseArcParserListener) EnterMatchArm(ctx *MatchArmContext) {}
+
+// ExitMatchArm is called when production matchArm is exited.
+func (s *BaseArcParserListener) ExitMatchArm(ctx *MatchArmContext) {}
+
+// EnterMatchElse is called when production matchElse is entered.
+func (s *BaseArcParserListener) EnterMatchElse(ctx *MatchElseContext) {}
+
+// ExitMatchElse is called when production matchElse is exited.
+func (s *BaseArcParserListener) ExitMatchElse(ctx *MatchElseContext) {}
+
 // EnterForStatement is called when production forStatement is entered.
 func (s *BaseArcParserListener) EnterForStatement(ctx *ForStatementContext) {}
 
@@ -395,6 +467,12 @@ func (s *BaseArcParserListener) EnterSeriesType(ctx *SeriesTypeContext) {}
 // ExitSeriesType is called when production seriesType is exited.
 func (s *BaseArcParserListener) ExitSeriesType(ctx *SeriesTypeContext) {}
 
+// EnterStructType is called when production structType is entered.
+func (s *BaseArcParserListener) EnterStructType(ctx *StructTypeContext) {}
+
+// ExitStructType is called when production structType is exited.
+func (s *BaseArcParserListener) ExitStructType(ctx *StructTypeContext) {}
+
 // EnterExpression is called when production expression is entered.
 func (s *BaseArcParserListener) EnterExpression(ctx *ExpressionContext) {}
 
@@ -467,6 +545,12 @@ func (s *BaseArcParserListener) EnterFunctionCallSuffix(ctx *FunctionCallSuffixC
 // ExitFunctionCallSuffix is called when production functionCallSuffix is exited.
 func (s *BaseArcParserListener) ExitFunctionCallSuffix(ctx *FunctionCallSuffixContext) {}
 
+// EnterMemberAccess is called when production memberAccess is entered.
+func (s *BaseArcParserListener) EnterMemberAccess(ctx *MemberAccessContext) {}
+
+// ExitMemberAccess is called when production memberAccess is exited.
+func (s *BaseArcParserListener) ExitMemberAccess(ctx *MemberAccessContext) {}
+
 // EnterPrimaryExpression is called when production primaryExpression is entered.
 func (s *BaseArcParserListener) EnterPrimaryExpression(ctx *PrimaryExpressionContext) {}
 
@@ -479,6 +563,18 @@ func (s *BaseArcParserListener) EnterTypeCast(ctx *TypeCastContext) {}
 // ExitTypeCast is called when production typeCast is exited.
 func (s *BaseArcParserListener) ExitTypeCast(ctx *TypeCastContext) {}
 
+// EnterStructLiteral is called when production structLiteral is entered.
+func (s *BaseArcParserListener) EnterStructLiteral(ctx *StructLiteralContext) {}
+
+// ExitStructLiteral is called when production structLiteral is exited.
+func (s *BaseArcParserListener) ExitStructLiteral(ctx *StructLiteralContext) {}
+
+// EnterStructFieldValue is called when production structFieldValue is entered.
+func (s *BaseArcParserListener) EnterStructFieldValue(ctx *StructFieldValueContext) {}
+
+// ExitStructFieldValue is called when production structFieldValue is exited.
+func (s *BaseArcParserListener) ExitStructFieldValue(ctx *StructFieldValueContext) {}
+
 // EnterLiteral is called when production literal is entered.
 func (s *BaseArcParserListener) EnterLiteral(ctx *LiteralContext) {}
 
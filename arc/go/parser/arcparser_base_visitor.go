@@ -48,6 +48,14 @@ func (v *BaseArcParserVisitor) VisitAuthorityEntry(ctx *AuthorityEntryContext) i
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseArcParserVisitor) VisitSafetyBlock(ctx *SafetyBlockContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseArcParserVisitor) VisitSafetyEntry(ctx *SafetyEntryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseArcParserVisitor) VisitFunctionDeclaration(ctx *FunctionDeclarationContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -108,6 +116,30 @@ func (v *BaseArcParserVisitor) VisitSingleInvocation(ctx *SingleInvocationContex
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseArcParserVisitor) VisitStructDeclaration(ctx *StructDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseArcParserVisitor) VisitStructFieldList(ctx *StructFieldListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseArcParserVisitor) VisitStructField(ctx *StructFieldContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseArcParserVisitor) VisitEnumDeclaration(ctx *EnumDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseArcParserVisitor) VisitEnumMemberList(ctx *EnumMemberListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseArcParserVisitor) VisitEnumMember(ctx *EnumMemberContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseArcParserVisitor) VisitGlobalConstant(ctx *GlobalConstantContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -128,6 +160,10 @@ func (v *BaseArcParserVisitor) VisitRoutingEntry(ctx *RoutingEntryContext) inter
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseArcParserVisitor) VisitRoutingKey(ctx *RoutingKeyContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseArcParserVisitor) VisitFlowNode(ctx *FlowNodeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -208,6 +244,18 @@ func (v *BaseArcParserVisitor) VisitElseClause(ctx *ElseClauseContext) interface
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseArcParserVisitor) VisitMatchStatement(ctx *MatchStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseArcParserVisitor) VisitMatchArm(ctx *MatchArmContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseArcParserVisitor) VisitMatchElse(ctx *MatchElseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseArcParserVisitor) VisitForStatement(ctx *ForStatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -260,6 +308,10 @@ func (v *BaseArcParserVisitor) VisitSeriesType(ctx *SeriesTypeContext) interface
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseArcParserVisitor) VisitStructType(ctx *StructTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseArcParserVisitor) VisitExpression(ctx *ExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -308,6 +360,10 @@ func (v *BaseArcParserVisitor) VisitFunctionCallSuffix(ctx *FunctionCallSuffixCo
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseArcParserVisitor) VisitMemberAccess(ctx *MemberAccessContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseArcParserVisitor) VisitPrimaryExpression(ctx *PrimaryExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -316,6 +372,14 @@ func (v *BaseArcParserVisitor) VisitTypeCast(ctx *TypeCastContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseArcParserVisitor) VisitStructLiteral(ctx *StructLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseArcParserVisitor) VisitStructFieldValue(ctx *StructFieldValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseArcParserVisitor) VisitLiteral(ctx *LiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -40,6 +40,12 @@ type ArcParserListener interface {
 	// EnterAuthorityEntry is called when entering the authorityEntry production.
 	EnterAuthorityEntry(c *AuthorityEntryContext)
 
+	// EnterSafetyBlock is called when entering the safetyBlock production.
+	EnterSafetyBlock(c *SafetyBlockContext)
+
+	// EnterSafetyEntry is called when entering the safetyEntry production.
+	EnterSafetyEntry(c *SafetyEntryContext)
+
 	// EnterFunctionDeclaration is called when entering the functionDeclaration production.
 	EnterFunctionDeclaration(c *FunctionDeclarationContext)
 
@@ -85,6 +91,24 @@ type ArcParserListener interface {
 	// EnterSingleInvocation is called when entering the singleInvocation production.
 	EnterSingleInvocation(c *SingleInvocationContext)
 
+	// EnterStructDeclaration is called when entering the structDeclaration production.
+	EnterStructDeclaration(c *StructDeclarationContext)
+
+	// EnterStructFieldList is called when entering the structFieldList production.
+	EnterStructFieldList(c *StructFieldListContext)
+
+	// EnterStructField is called when entering the structField production.
+	EnterStructField(c *StructFieldContext)
+
+	// EnterEnumDeclaration is called when entering the enumDeclaration production.
+	EnterEnumDeclaration(c *EnumDeclarationContext)
+
+	// EnterEnumMemberList is called when entering the enumMemberList production.
+	EnterEnumMemberList(c *EnumMemberListContext)
+
+	// EnterEnumMember is called when entering the enumMember production.
+	EnterEnumMember(c *EnumMemberContext)
+
 	// EnterGlobalConstant is called when entering the globalConstant production.
 	EnterGlobalConstant(c *GlobalConstantContext)
 
@@ -100,6 +124,9 @@ type ArcParserListener interface {
 	// EnterRoutingEntry is called when entering the routingEntry production.
 	EnterRoutingEntry(c *RoutingEntryContext)
 
+	// EnterRoutingKey is called when entering the routingKey production.
+	EnterRoutingKey(c *RoutingKeyContext)
+
 	// EnterFlowNode is called when entering the flowNode production.
 	EnterFlowNode(c *FlowNodeContext)
 
@@ -160,6 +187,15 @@ type ArcParserListener interface {
 	// EnterElseClause is called when entering the elseClause production.
 	EnterElseClause(c *ElseClauseContext)
 
+	// EnterMatchStatement is called when entering the matchStatement production.
+	EnterMatchStatement(c *MatchStatementContext)
+
+	// EnterMatchArm is called when entering the matchArm production.
+	EnterMatchArm(c *MatchArmContext)
+
+	// EnterMatchElse is called when entering the matchElse production.
+	EnterMatchElse(c *MatchElseContext)
+
 	// EnterForStatement is called when entering the forStatement production.
 	EnterForStatement(c *ForStatementContext)
 
@@ -199,6 +235,9 @@ type ArcParserListener interface {
 	// EnterSeriesType is called when entering the seriesType production.
 	EnterSeriesType(c *SeriesTypeContext)
 
+	// EnterStructType is called when entering the structType production.
+	EnterStructType(c *StructTypeContext)
+
 	// EnterExpression is called when entering the expression production.
 	EnterExpression(c *ExpressionContext)
 
@@ -235,12 +274,21 @@ type ArcParserListener interface {
 	// EnterFunctionCallSuffix is called when entering the functionCallSuffix production.
 	EnterFunctionCallSuffix(c *FunctionCallSuffixContext)
 
+	// EnterMemberAccess is called when entering the memberAccess production.
+	EnterMemberAccess(c *MemberAccessContext)
+
 	// EnterPrimaryExpression is called when entering the primaryExpression production.
 	EnterPrimaryExpression(c *PrimaryExpressionContext)
 
 	// EnterTypeCast is called when entering the typeCast production.
 	EnterTypeCast(c *TypeCastContext)
 
+	// EnterStructLiteral is called when entering the structLiteral production.
+	EnterStructLiteral(c *StructLiteralContext)
+
+	// EnterStructFieldValue is called when entering the structFieldValue production.
+	EnterStructFieldValue(c *StructFieldValueContext)
+
 	// EnterLiteral is called when entering the literal production.
 	EnterLiteral(c *LiteralContext)
 
@@ -277,6 +325,12 @@ type ArcParserListener interface {
 	// ExitAuthorityEntry is called when exiting the authorityEntry production.
 	ExitAuthorityEntry(c *AuthorityEntryContext)
 
+	// ExitSafetyBlock is called when exiting the safetyBlock production.
+	ExitSafetyBlock(c *SafetyBlockContext)
+
+	// ExitSafetyEntry is called when exiting the safetyEntry production.
+	ExitSafetyEntry(c *SafetyEntryContext)
+
 	// ExitFunctionDeclaration is called when exiting the functionDeclaration production.
 	ExitFunctionDeclaration(c *FunctionDeclarationContext)
 
@@ -322,6 +376,24 @@ type ArcParserListener interface {
 	// ExitSingleInvocation is called when exiting the singleInvocation production.
 	ExitSingleInvocation(c *SingleInvocationContext)
 
+	// ExitStructDeclaration is called when exiting the structDeclaration production.
+	ExitStructDeclaration(c *StructDeclarationContext)
+
+	// ExitStructFieldList is called when exiting the structFieldList production.
+	ExitStructFieldList(c *StructFieldListContext)
+
+	// ExitStructField is called when exiting the structField production.
+	ExitStructField(c *StructFieldContext)
+
+	// ExitEnumDeclaration is called when exiting the enumDeclaration production.
+	ExitEnumDeclaration(c *EnumDeclarationContext)
+
+	// ExitEnumMemberList is called when exiting the enumMemberList production.
+	ExitEnumMemberList(c *EnumMemberListContext)
+
+	// ExitEnumMember is called when exiting the enumMember production.
+	ExitEnumMember(c *EnumMemberContext)
+
 	// ExitGlobalConstant is called when exiting the globalConstant production.
 	ExitGlobalConstant(c *GlobalConstantContext)
 
@@ -337,6 +409,9 @@ type ArcParserListener interface {
 	// ExitRoutingEntry is called when exiting the routingEntry production.
 	ExitRoutingEntry(c *RoutingEntryContext)
 
+	// ExitRoutingKey is called when exiting the routingKey production.
+	ExitRoutingKey(c *RoutingKeyContext)
+
 	// ExitFlowNode is called when exiting the flowNode production.
 	ExitFlowNode(c *FlowNodeContext)
 
@@ -397,6 +472,15 @@ type ArcParserListener interface {
 	// ExitElseClause is called when exiting the elseClause production.
 	ExitElseClause(c *ElseClauseContext)
 
+	// ExitMatchStatement is called when exiting the matchStatement production.
+	ExitMatchStatement(c *MatchStatementContext)
+
+	// ExitMatchArm is called when exiting the matchArm production.
+	ExitMatchArm(c *MatchArmContext)
+
+	// ExitMatchElse is called when exiting the matchElse production.
+	ExitMatchElse(c *MatchElseContext)
+
 	// ExitForStatement is called when exiting the forStatement production.
 	ExitForStatement(c *ForStatementContext)
 
@@ -436,6 +520,9 @@ type ArcParserListener interface {
 	// ExitSeriesType is called when exiting the seriesType production.
 	ExitSeriesType(c *SeriesTypeContext)
 
+	// ExitStructType is called when exiting the structType production.
+	ExitStructType(c *StructTypeContext)
+
 	// ExitExpression is called when exiting the expression production.
 	ExitExpression(c *ExpressionContext)
 
@@ -472,12 +559,21 @@ type ArcParserListener interface {
 	// ExitFunctionCallSuffix is called when exiting the functionCallSuffix production.
 	ExitFunctionCallSuffix(c *FunctionCallSuffixContext)
 
+	// ExitMemberAccess is called when exiting the memberAccess production.
+	ExitMemberAccess(c *MemberAccessContext)
+
 	// ExitPrimaryExpression is called when exiting the primaryExpression production.
 	ExitPrimaryExpression(c *PrimaryExpressionContext)
 
 	// ExitTypeCast is called when exiting the typeCast production.
 	ExitTypeCast(c *TypeCastContext)
 
+	// ExitStructLiteral is called when exiting the structLiteral production.
+	ExitStructLiteral(c *StructLiteralContext)
+
+	// ExitStructFieldValue is called when exiting the structFieldValue production.
+	ExitStructFieldValue(c *StructFieldValueContext)
+
 	// ExitLiteral is called when exiting the literal production.
 	ExitLiteral(c *LiteralContext)
 
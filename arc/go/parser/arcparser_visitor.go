@@ -40,6 +40,12 @@ type ArcParserVisitor interface {
 	// Visit a parse tree produced by ArcParser#authorityEntry.
 	VisitAuthorityEntry(ctx *AuthorityEntryContext) interface{}
 
+	// Visit a parse tree produced by ArcParser#safetyBlock.
+	VisitSafetyBlock(ctx *SafetyBlockContext) interface{}
+
+	// Visit a parse tree produced by ArcParser#safetyEntry.
+	VisitSafetyEntry(ctx *SafetyEntryContext) interface{}
+
 	// Visit a parse tree produced by ArcParser#functionDeclaration.
 	VisitFunctionDeclaration(ctx *FunctionDeclarationContext) interface{}
 
@@ -85,6 +91,24 @@ type ArcParserVisitor interface {
 	// Visit a parse tree produced by ArcParser#singleInvocation.
 	VisitSingleInvocation(ctx *SingleInvocationContext) interface{}
 
+	// Visit a parse tree produced by ArcParser#structDeclaration.
+	VisitStructDeclaration(ctx *StructDeclarationContext) interface{}
+
+	// Visit a parse tree produced by ArcParser#structFieldList.
+	VisitStructFieldList(ctx *StructFieldListContext) interface{}
+
+	// Visit a parse tree produced by ArcParser#structField.
+	VisitStructField(ctx *StructFieldContext) interface{}
+
+	// Visit a parse tree produced by ArcParser#enumDeclaration.
+	VisitEnumDeclaration(ctx *EnumDeclarationContext) interface{}
+
+	// Visit a parse tree produced by ArcParser#enumMemberList.
+	VisitEnumMemberList(ctx *EnumMemberListContext) interface{}
+
+	// Visit a parse tree produced by ArcParser#enumMember.
+	VisitEnumMember(ctx *EnumMemberContext) interface{}
+
 	// Visit a parse tree produced by ArcParser#globalConstant.
 	VisitGlobalConstant(ctx *GlobalConstantContext) interface{}
 
@@ -100,6 +124,9 @@ type ArcParserVisitor interface {
 	// Visit a parse tree produced by ArcParser#routingEntry.
 	VisitRoutingEntry(ctx *RoutingEntryContext) interface{}
 
+	// Visit a parse tree produced by ArcParser#routingKey.
+	VisitRoutingKey(ctx *RoutingKeyContext) interface{}
+
 	// Visit a parse tree produced by ArcParser#flowNode.
 	VisitFlowNode(ctx *FlowNodeContext) interface{}
 
@@ -160,6 +187,15 @@ type ArcParserVisitor interface {
 	// Visit a parse tree produced by ArcParser#elseClause.
 	VisitElseClause(ctx *ElseClauseContext) interface{}
 
+	// Visit a parse tree produced by ArcParser#matchStatement.
+	VisitMatchStatement(ctx *MatchStatementContext) interface{}
+
+	// Visit a parse tree produced by ArcParser#matchArm.
+	VisitMatchArm(ctx *MatchArmContext) interface{}
+
+	// Visit a parse tree produced by ArcParser#matchElse.
+	VisitMatchElse(ctx *MatchElseContext) interface{}
+
 	// Visit a parse tree produced by ArcParser#forStatement.
 	VisitForStatement(ctx *ForStatementContext) interface{}
 
@@ -199,6 +235,9 @@ type ArcParserVisitor interface {
 	// Visit a parse tree produced by ArcParser#seriesType.
 	VisitSeriesType(ctx *SeriesTypeContext) interface{}
 
+	// Visit a parse tree produced by ArcParser#structType.
+	VisitStructType(ctx *StructTypeContext) interface{}
+
 	// Visit a parse tree produced by ArcParser#expression.
 	VisitExpression(ctx *ExpressionContext) interface{}
 
@@ -235,12 +274,21 @@ type ArcParserVisitor interface {
 	// Visit a parse tree produced by ArcParser#functionCallSuffix.
 	VisitFunctionCallSuffix(ctx *FunctionCallSuffixContext) interface{}
 
+	// Visit a parse tree produced by ArcParser#memberAccess.
+	VisitMemberAccess(ctx *MemberAccessContext) interface{}
+
 	// Visit a parse tree produced by ArcParser#primaryExpression.
 	VisitPrimaryExpression(ctx *PrimaryExpressionContext) interface{}
 
 	// Visit a parse tree produced by ArcParser#typeCast.
 	VisitTypeCast(ctx *TypeCastContext) interface{}
 
+	// Visit a parse tree produced by ArcParser#structLiteral.
+	VisitStructLiteral(ctx *StructLiteralContext) interface{}
+
+	// Visit a parse tree produced by ArcParser#structFieldValue.
+	VisitStructFieldValue(ctx *StructFieldValueContext) interface{}
+
 	// Visit a parse tree produced by ArcParser#literal.
 	VisitLiteral(ctx *LiteralContext) interface{}
 
@@ -226,6 +226,16 @@ func isNumericLiteral(node antlr.ParserRuleContext) bool {
 // GetPrimaryExpression extracts the primary expression from an expression that has no
 // operators. Returns nil if the expression contains any binary or unary operators.
 func GetPrimaryExpression(expr IExpressionContext) IPrimaryExpressionContext {
+	postfix := GetPostfixExpression(expr)
+	if postfix == nil {
+		return nil
+	}
+	return postfix.PrimaryExpression()
+}
+
+// GetPostfixExpression extracts the postfix expression from an expression that has no
+// operators. Returns nil if the expression contains any binary or unary operators.
+func GetPostfixExpression(expr IExpressionContext) IPostfixExpressionContext {
 	if expr == nil {
 		return nil
 	}
@@ -261,11 +271,7 @@ func GetPrimaryExpression(expr IExpressionContext) IPrimaryExpressionContext {
 	if unary == nil {
 		return nil
 	}
-	postfix := unary.PostfixExpression()
-	if postfix == nil {
-		return nil
-	}
-	return postfix.PrimaryExpression()
+	return unary.PostfixExpression()
 }
 
 // QualifiedNameParts returns the head and tail of a qualified identifier
@@ -334,6 +340,27 @@ func PrimaryName(primary IPrimaryExpressionContext) string {
 	return head + "." + tail
 }
 
+// RoutingKeyParts returns the head and tail of a routing entry's key. An output
+// name (high) returns (name, ""); an enum member (Mode.fire) returns (head, tail).
+func RoutingKeyParts(entry IRoutingEntryContext) (head, tail string) {
+	key := entry.RoutingKey()
+	if qid := key.QualifiedIdentifier(); qid != nil {
+		return QualifiedNameParts(qid)
+	}
+	return key.IDENTIFIER().GetText(), ""
+}
+
+// IsEnumRoutingTable returns true if any entry of the routing table is keyed by an
+// enum member rather than an output name.
+func IsEnumRoutingTable(rt IRoutingTableContext) bool {
+	for _, entry := range rt.AllRoutingEntry() {
+		if entry.RoutingKey().QualifiedIdentifier() != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // ImportEntry is one collected import. Path is the dotted source path
 // ("math", "math.trig"); Alias is the user-visible qualifier.
 type ImportEntry struct {
@@ -292,6 +292,37 @@ func test{} () {}`)
 		})
 	})
 
+	Describe("Safety", func() {
+		It("Should parse a simple safety declaration", func() {
+			prog := mustParseProgram(`safety valve{min=0, max=100}`)
+			block := prog.TopLevelItem(0).SafetyBlock()
+			Expect(block).NotTo(BeNil())
+			entries := block.AllSafetyEntry()
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].IDENTIFIER().GetText()).To(Equal("valve"))
+			Expect(entries[0].ConfigValues().NamedConfigValues().AllNamedConfigValue()).To(HaveLen(2))
+		})
+
+		It("Should parse grouped safety declarations", func() {
+			prog := mustParseProgram("safety (\n    valve{max=10}\n    vent{interlock=pressure > 5}\n)")
+			block := prog.TopLevelItem(0).SafetyBlock()
+			Expect(block).NotTo(BeNil())
+			entries := block.AllSafetyEntry()
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[1].IDENTIFIER().GetText()).To(Equal("vent"))
+		})
+
+		It("Should parse safety alongside other declarations", func() {
+			prog := mustParseProgram(`
+authority 200
+safety valve{max=10}
+func test() {}
+`)
+			Expect(prog.TopLevelItem(1).SafetyBlock()).NotTo(BeNil())
+			Expect(prog.TopLevelItem(2).FunctionDeclaration()).NotTo(BeNil())
+		})
+	})
+
 	Describe("Functions", func() {
 		It("Should parse basic function declaration", func() {
 			prog := mustParseProgram(`
@@ -1289,7 +1320,7 @@ sensor -> demux{threshold=100} -> {
 				Expect(entries).To(HaveLen(2))
 
 				// First entry: high -> alarm{}
-				Expect(entries[0].IDENTIFIER(0).GetText()).To(Equal("high"))
+				Expect(entries[0].RoutingKey().GetText()).To(Equal("high"))
 				Expect(entries[0].AllARROW()).To(HaveLen(0))
 				highTargets := entries[0].AllFlowNode()
 				Expect(highTargets).To(HaveLen(1))
@@ -1297,7 +1328,7 @@ sensor -> demux{threshold=100} -> {
 				Expect(highTargets[0].Function().IDENTIFIER().GetText()).To(Equal("alarm"))
 
 				// Second entry: low -> logger{}
-				Expect(entries[1].IDENTIFIER(0).GetText()).To(Equal("low"))
+				Expect(entries[1].RoutingKey().GetText()).To(Equal("low"))
 				lowTargets := entries[1].AllFlowNode()
 				Expect(lowTargets).To(HaveLen(1))
 				Expect(lowTargets[0].Function()).NotTo(BeNil())
@@ -1320,9 +1351,9 @@ sensor -> range_classifier{} -> {
 				entries := routingTable.AllRoutingEntry()
 
 				Expect(entries).To(HaveLen(3))
-				Expect(entries[0].IDENTIFIER(0).GetText()).To(Equal("below_range"))
-				Expect(entries[1].IDENTIFIER(0).GetText()).To(Equal("in_range"))
-				Expect(entries[2].IDENTIFIER(0).GetText()).To(Equal("above_range"))
+				Expect(entries[0].RoutingKey().GetText()).To(Equal("below_range"))
+				Expect(entries[1].RoutingKey().GetText()).To(Equal("in_range"))
+				Expect(entries[2].RoutingKey().GetText()).To(Equal("above_range"))
 			})
 
 			It("Should parse routing table to channels", func() {
@@ -1375,7 +1406,7 @@ sensor -> state_router{} -> {
 				Expect(entries).To(HaveLen(2))
 
 				// First entry: idle_out: processor{} -> idle_display{}
-				Expect(entries[0].IDENTIFIER(0).GetText()).To(Equal("idle_out"))
+				Expect(entries[0].RoutingKey().GetText()).To(Equal("idle_out"))
 				entry0Nodes := entries[0].AllFlowNode()
 				Expect(entry0Nodes).To(HaveLen(2))
 				Expect(entries[0].AllARROW()).To(HaveLen(1))
@@ -1383,7 +1414,7 @@ sensor -> state_router{} -> {
 				Expect(entry0Nodes[1].Function().IDENTIFIER().GetText()).To(Equal("idle_display"))
 
 				// Second entry: active_out: controller{} -> actuator
-				Expect(entries[1].IDENTIFIER(0).GetText()).To(Equal("active_out"))
+				Expect(entries[1].RoutingKey().GetText()).To(Equal("active_out"))
 				entry1Nodes := entries[1].AllFlowNode()
 				Expect(entry1Nodes).To(HaveLen(2))
 				Expect(entries[1].AllARROW()).To(HaveLen(1))
@@ -1408,22 +1439,22 @@ first{} -> {
 
 				// First entry: outputA: processor{}: paramC
 				entry0 := entries[0]
-				Expect(entry0.IDENTIFIER(0).GetText()).To(Equal("outputA"))
+				Expect(entry0.RoutingKey().GetText()).To(Equal("outputA"))
 				entry0Nodes := entry0.AllFlowNode()
 				Expect(entry0Nodes).To(HaveLen(1))
 				Expect(entry0Nodes[0].Function().IDENTIFIER().GetText()).To(Equal("processor"))
 				// Check trailing parameter name
-				Expect(entry0.AllIDENTIFIER()).To(HaveLen(2))
-				Expect(entry0.IDENTIFIER(1).GetText()).To(Equal("paramC"))
+				Expect(entry0.IDENTIFIER()).ToNot(BeNil())
+				Expect(entry0.IDENTIFIER().GetText()).To(Equal("paramC"))
 
 				// Second entry: outputB: paramD (no trailing parameter)
 				entry1 := entries[1]
-				Expect(entry1.IDENTIFIER(0).GetText()).To(Equal("outputB"))
+				Expect(entry1.RoutingKey().GetText()).To(Equal("outputB"))
 				entry1Nodes := entry1.AllFlowNode()
 				Expect(entry1Nodes).To(HaveLen(1))
 				Expect(entry1Nodes[0].Identifier().IDENTIFIER().GetText()).To(Equal("paramD"))
 				// No trailing parameter
-				Expect(entry1.AllIDENTIFIER()).To(HaveLen(1))
+				Expect(entry1.IDENTIFIER()).To(BeNil())
 			})
 
 			It("Should parse routing table with chained processing and parameter mapping", func() {
@@ -1440,19 +1471,19 @@ stage1{} -> {
 
 				// First entry: out1: filter{} -> amplifier{}: input
 				entry0 := entries[0]
-				Expect(entry0.IDENTIFIER(0).GetText()).To(Equal("out1"))
+				Expect(entry0.RoutingKey().GetText()).To(Equal("out1"))
 				Expect(entry0.AllFlowNode()).To(HaveLen(2))
 				Expect(entry0.AllARROW()).To(HaveLen(1))
-				Expect(entry0.AllIDENTIFIER()).To(HaveLen(2))
-				Expect(entry0.IDENTIFIER(1).GetText()).To(Equal("input"))
+				Expect(entry0.IDENTIFIER()).ToNot(BeNil())
+				Expect(entry0.IDENTIFIER().GetText()).To(Equal("input"))
 
 				// Second entry: out2: processor{} -> converter{}: value
 				entry1 := entries[1]
-				Expect(entry1.IDENTIFIER(0).GetText()).To(Equal("out2"))
+				Expect(entry1.RoutingKey().GetText()).To(Equal("out2"))
 				Expect(entry1.AllFlowNode()).To(HaveLen(2))
 				Expect(entry1.AllARROW()).To(HaveLen(1))
-				Expect(entry1.AllIDENTIFIER()).To(HaveLen(2))
-				Expect(entry1.IDENTIFIER(1).GetText()).To(Equal("value"))
+				Expect(entry1.IDENTIFIER()).ToNot(BeNil())
+				Expect(entry1.IDENTIFIER().GetText()).To(Equal("value"))
 			})
 		})
 
@@ -1511,14 +1542,14 @@ sensor -> demux{threshold=100.0} -> {
 				Expect(entries).To(HaveLen(2))
 
 				// First entry: sensor1 -> a
-				Expect(entries[0].IDENTIFIER(0).GetText()).To(Equal("sensor1"))
+				Expect(entries[0].RoutingKey().GetText()).To(Equal("sensor1"))
 				entry0Targets := entries[0].AllFlowNode()
 				Expect(entry0Targets).To(HaveLen(1))
 				Expect(entry0Targets[0].Identifier()).NotTo(BeNil())
 				Expect(entry0Targets[0].Identifier().IDENTIFIER().GetText()).To(Equal("a"))
 
 				// Second entry: sensor2 -> b
-				Expect(entries[1].IDENTIFIER(0).GetText()).To(Equal("sensor2"))
+				Expect(entries[1].RoutingKey().GetText()).To(Equal("sensor2"))
 				entry1Targets := entries[1].AllFlowNode()
 				Expect(entry1Targets).To(HaveLen(1))
 				Expect(entry1Targets[0].Identifier()).NotTo(BeNil())
@@ -1542,7 +1573,7 @@ sensor -> demux{threshold=100.0} -> {
 
 				// First entry: sensor1 -> lowpass{cutoff=0.5} -> a
 				entry0 := entries[0]
-				Expect(entry0.IDENTIFIER(0).GetText()).To(Equal("sensor1"))
+				Expect(entry0.RoutingKey().GetText()).To(Equal("sensor1"))
 				Expect(entry0.AllARROW()).To(HaveLen(1)) // sensor1 -> lowpass{} -> a
 				entry0Nodes := entry0.AllFlowNode()
 				Expect(entry0Nodes).To(HaveLen(2)) // lowpass{}, a
@@ -1551,7 +1582,7 @@ sensor -> demux{threshold=100.0} -> {
 
 				// Second entry: sensor2 -> scale{factor=2.0} -> b
 				entry1 := entries[1]
-				Expect(entry1.IDENTIFIER(0).GetText()).To(Equal("sensor2"))
+				Expect(entry1.RoutingKey().GetText()).To(Equal("sensor2"))
 				Expect(entry1.AllARROW()).To(HaveLen(1))
 				entry1Nodes := entry1.AllFlowNode()
 				Expect(entry1Nodes).To(HaveLen(2))
@@ -1767,6 +1798,90 @@ sequence main {
 		})
 	})
 
+	Describe("Enums and Match", func() {
+		Context("Enum Declarations", func() {
+			It("Should parse an enum with implicit and explicit values", func() {
+				prog := mustParseProgram(`
+enum Mode u8 {
+    idle,
+    firing = 4,
+    abort = -1,
+}`)
+				decl := prog.TopLevelItem(0).EnumDeclaration()
+				Expect(decl).NotTo(BeNil())
+				Expect(decl.IDENTIFIER().GetText()).To(Equal("Mode"))
+				Expect(decl.IntegerType().GetText()).To(Equal("u8"))
+
+				members := decl.EnumMemberList().AllEnumMember()
+				Expect(members).To(HaveLen(3))
+				Expect(members[0].IDENTIFIER().GetText()).To(Equal("idle"))
+				Expect(members[0].INTEGER_LITERAL()).To(BeNil())
+				Expect(members[1].INTEGER_LITERAL().GetText()).To(Equal("4"))
+				Expect(members[2].MINUS()).NotTo(BeNil())
+			})
+
+			It("Should parse an empty enum without a type", func() {
+				prog := mustParseProgram(`enum Empty {}`)
+				decl := prog.TopLevelItem(0).EnumDeclaration()
+				Expect(decl).NotTo(BeNil())
+				Expect(decl.IntegerType()).To(BeNil())
+				Expect(decl.EnumMemberList()).To(BeNil())
+			})
+		})
+
+		Context("Match Statements", func() {
+			It("Should parse arms with multiple patterns and an else arm", func() {
+				stmt := mustParseStatement(`match mode {
+    Mode.idle, Mode.abort => { return 0 }
+    Mode.firing => { return 1 }
+    else => { return 2 }
+}`)
+				match := stmt.MatchStatement()
+				Expect(match).NotTo(BeNil())
+				Expect(match.Expression().GetText()).To(Equal("mode"))
+
+				arms := match.AllMatchArm()
+				Expect(arms).To(HaveLen(2))
+				Expect(arms[0].AllExpression()).To(HaveLen(2))
+				Expect(arms[0].Expression(1).GetText()).To(Equal("Mode.abort"))
+				Expect(arms[1].AllExpression()).To(HaveLen(1))
+				Expect(match.MatchElse()).NotTo(BeNil())
+			})
+
+			It("Should parse a match without an else arm", func() {
+				stmt := mustParseStatement(`match x { 1 => {} }`)
+				match := stmt.MatchStatement()
+				Expect(match).NotTo(BeNil())
+				Expect(match.AllMatchArm()).To(HaveLen(1))
+				Expect(match.MatchElse()).To(BeNil())
+			})
+		})
+
+		Context("Enum Routing Tables", func() {
+			It("Should parse enum members as routing keys", func() {
+				prog := mustParseProgram(`mode -> { Mode.idle: hold, Mode.firing: fire }`)
+				flow := prog.TopLevelItem(0).FlowStatement()
+				rt := flow.AllRoutingTable()[0]
+				Expect(parser.IsEnumRoutingTable(rt)).To(BeTrue())
+
+				entries := rt.AllRoutingEntry()
+				Expect(entries).To(HaveLen(2))
+				head, tail := parser.RoutingKeyParts(entries[0])
+				Expect(head).To(Equal("Mode"))
+				Expect(tail).To(Equal("idle"))
+			})
+
+			It("Should not treat output name routing keys as enum members", func() {
+				prog := mustParseProgram(`sensor -> { high: alarm }`)
+				rt := prog.TopLevelItem(0).FlowStatement().AllRoutingTable()[0]
+				Expect(parser.IsEnumRoutingTable(rt)).To(BeFalse())
+				head, tail := parser.RoutingKeyParts(rt.RoutingEntry(0))
+				Expect(head).To(Equal("high"))
+				Expect(tail).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Qualified Identifiers", func() {
 		It("Should parse a qualified identifier in an expression", func() {
 			expr := mustParseExpression("math.avg")
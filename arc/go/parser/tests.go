@@ -0,0 +1,121 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package parser
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/synnaxlabs/x/diagnostics"
+)
+
+// testKeyword introduces a test block. It is a contextual keyword rather than a lexer
+// token so that existing programs using "test" as an identifier keep working.
+const testKeyword = "test"
+
+// TestBlock is a top-level `test "name" { ... }` block in Arc source. Test blocks are
+// not part of the program: SplitTests removes them before parsing and the arc/test
+// package runs them.
+type TestBlock struct {
+	// Name is the raw string literal naming the test, including its quotes.
+	Name string
+	// Body is the source between the block's braces.
+	Body string
+	// Start is the position of the test keyword.
+	Start diagnostics.Position
+	// BodyStart is the position of the first character after the opening brace.
+	BodyStart diagnostics.Position
+}
+
+// SplitTests extracts the top-level test blocks from source. It returns source with
+// each block replaced by spaces (newlines are kept, so line and column positions in
+// the remaining program are unchanged) along with the extracted blocks in declaration
+// order. A test block without a closing brace is left in place so that the parser
+// reports it.
+func SplitTests(source string, cfgs ...Config) (string, []TestBlock) {
+	lexer := NewLexer(source, ConfigOf(cfgs...))
+	lexer.RemoveErrorListeners()
+	var toks []antlr.Token
+	for _, tok := range lexer.GetAllTokens() {
+		if tok.GetChannel() == antlr.TokenDefaultChannel {
+			toks = append(toks, tok)
+		}
+	}
+	var (
+		runes  []rune
+		blocks []TestBlock
+		depth  int
+	)
+	for i := 0; i < len(toks); i++ {
+		switch toks[i].GetTokenType() {
+		case ArcLexerLBRACE:
+			depth++
+			continue
+		case ArcLexerRBRACE:
+			depth--
+			continue
+		}
+		if depth != 0 || !isTestHeader(toks, i) {
+			continue
+		}
+		end := matchingBrace(toks, i+2)
+		if end < 0 {
+			break
+		}
+		if runes == nil {
+			runes = []rune(source)
+		}
+		open, closing := toks[i+2], toks[end]
+		blocks = append(blocks, TestBlock{
+			Name:  toks[i+1].GetText(),
+			Body:  string(runes[open.GetStop()+1 : closing.GetStart()]),
+			Start: diagnostics.Position{Line: toks[i].GetLine(), Col: toks[i].GetColumn()},
+			BodyStart: diagnostics.Position{
+				Line: open.GetLine(),
+				Col:  open.GetColumn() + 1,
+			},
+		})
+		for j := toks[i].GetStart(); j <= closing.GetStop(); j++ {
+			if runes[j] != '\n' && runes[j] != '\r' {
+				runes[j] = ' '
+			}
+		}
+		i = end
+	}
+	if runes == nil {
+		return source, nil
+	}
+	return string(runes), blocks
+}
+
+// isTestHeader reports whether toks[i:] begins with `test "name" {`.
+func isTestHeader(toks []antlr.Token, i int) bool {
+	return i+2 < len(toks) &&
+		toks[i].GetTokenType() == ArcLexerIDENTIFIER &&
+		toks[i].GetText() == testKeyword &&
+		toks[i+1].GetTokenType() == ArcLexerSTR_LITERAL &&
+		toks[i+2].GetTokenType() == ArcLexerLBRACE
+}
+
+// matchingBrace returns the index of the brace closing the one at toks[open], or -1
+// if it is never closed.
+func matchingBrace(toks []antlr.Token, open int) int {
+	depth := 0
+	for i := open; i < len(toks); i++ {
+		switch toks[i].GetTokenType() {
+		case ArcLexerLBRACE:
+			depth++
+		case ArcLexerRBRACE:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
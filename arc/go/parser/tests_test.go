@@ -0,0 +1,63 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package parser_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/x/diagnostics"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("SplitTests", func() {
+	It("Should extract top-level test blocks and blank them out of the program", func() {
+		src := "x := 1\ntest \"opens\" {\n  feed a 1\n}\ny := 2"
+		program, blocks := parser.SplitTests(src)
+		Expect(blocks).To(HaveLen(1))
+		Expect(blocks[0].Name).To(Equal(`"opens"`))
+		Expect(blocks[0].Body).To(Equal("\n  feed a 1\n"))
+		Expect(blocks[0].Start).To(Equal(diagnostics.Position{Line: 2, Col: 0}))
+		Expect(blocks[0].BodyStart).To(Equal(diagnostics.Position{Line: 2, Col: 14}))
+		Expect(program).To(HaveLen(len(src)))
+		Expect(strings.Split(program, "\n")).To(Equal([]string{
+			"x := 1", strings.Repeat(" ", 14), strings.Repeat(" ", 10), " ", "y := 2",
+		}))
+	})
+
+	It("Should keep nested braces inside a test block", func() {
+		_, blocks := parser.SplitTests("test \"a\" { feed x [1, 2] { } }\ntest \"b\" {}")
+		Expect(blocks).To(HaveLen(2))
+		Expect(blocks[0].Body).To(Equal(" feed x [1, 2] { } "))
+		Expect(blocks[1].Name).To(Equal(`"b"`))
+		Expect(blocks[1].Body).To(BeEmpty())
+	})
+
+	It("Should ignore test blocks that are not at the top level", func() {
+		src := "func f() {\n  test \"x\" { }\n}"
+		program, blocks := parser.SplitTests(src)
+		Expect(blocks).To(BeEmpty())
+		Expect(program).To(Equal(src))
+	})
+
+	It("Should leave an unterminated test block for the parser to report", func() {
+		src := "test \"x\" {\n  feed a 1\n"
+		program, blocks := parser.SplitTests(src)
+		Expect(blocks).To(BeEmpty())
+		Expect(program).To(Equal(src))
+	})
+
+	It("Should produce a program that parses without the test blocks", func() {
+		program, _ := parser.SplitTests("func f() {\n}\ntest \"x\" {\n  expect stage a.b\n}")
+		MustSucceed(parser.Parse(program))
+	})
+})
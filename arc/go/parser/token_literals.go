@@ -17,6 +17,7 @@ const (
 	LiteralFOR           = "for"
 	LiteralBREAK         = "break"
 	LiteralCONTINUE      = "continue"
+	LiteralMATCH         = "match"
 	LiteralIMPORT        = "import"
 	LiteralAS            = "as"
 	LiteralSEQUENCE      = "sequence"
@@ -24,6 +25,9 @@ const (
 	LiteralNEXT          = "next"
 	LiteralCHAN          = "chan"
 	LiteralAUTHORITY     = "authority"
+	LiteralSAFETY        = "safety"
+	LiteralSTRUCT        = "struct"
+	LiteralENUM          = "enum"
 	LiteralI8            = "i8"
 	LiteralI16           = "i16"
 	LiteralI32           = "i32"
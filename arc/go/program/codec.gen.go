@@ -50,6 +50,15 @@ func (p Program) EncodeOrc(w *orc.Writer) error {
 	if err := p.Root.EncodeOrc(w); err != nil {
 		return err
 	}
+	w.Bool(p.Safety != nil)
+	if p.Safety != nil {
+		w.Uint32(uint32(len(p.Safety)))
+		for i := range p.Safety {
+			if err := p.Safety[i].EncodeOrc(w); err != nil {
+				return err
+			}
+		}
+	}
 	w.Bool(p.WASM != nil)
 	if p.WASM != nil {
 		w.WriteWithLen(p.WASM)
@@ -127,6 +136,24 @@ func (p *Program) DecodeOrc(r *orc.Reader) error {
 	if err = p.Root.DecodeOrc(r); err != nil {
 		return err
 	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			n, err := r.CollectionLen()
+			if err != nil {
+				return err
+			}
+			p.Safety = make([]ir.Envelope, n)
+			for i := range p.Safety {
+				if err = p.Safety[i].DecodeOrc(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
 	{
 		present, err := r.Bool()
 		if err != nil {
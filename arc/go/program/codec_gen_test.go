@@ -58,13 +58,14 @@ var _ = Describe("Codec", func() {
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_17": "value_17"},
+									Value: map[string]interface{}{"key_18": "value_18"},
 								},
 							},
 							Inputs: []types.Param{
 								{
-									Name: "test_19",
+									Name: "test_20",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -72,18 +73,19 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_25",
+										Name:          "test_26",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_30": "value_30"},
+									Value: map[string]interface{}{"key_32": "value_32"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name: "test_32",
+									Name: "test_34",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -91,28 +93,29 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_38",
+										Name:          "test_40",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_43": "value_43"},
+									Value: map[string]interface{}{"key_46": "value_46"},
 								},
 							},
 							Channels: types.Channels{
-								Read:  map[uint32]string{46: "test_45"},
-								Write: map[uint32]string{47: "test_46"},
+								Read:  map[uint32]string{49: "test_48"},
+								Write: map[uint32]string{50: "test_49"},
 							},
 						},
 					},
 					Nodes: []ir.Node{
 						{
-							Key:  "test_48",
-							Type: "test_49",
+							Key:  "test_51",
+							Type: "test_52",
 							Config: []types.Param{
 								{
-									Name: "test_51",
+									Name: "test_54",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -120,18 +123,19 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_57",
+										Name:          "test_60",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_62": "value_62"},
+									Value: map[string]interface{}{"key_66": "value_66"},
 								},
 							},
 							Inputs: []types.Param{
 								{
-									Name: "test_64",
+									Name: "test_68",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -139,18 +143,19 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_70",
+										Name:          "test_74",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_75": "value_75"},
+									Value: map[string]interface{}{"key_80": "value_80"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name: "test_77",
+									Name: "test_82",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -158,44 +163,45 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_83",
+										Name:          "test_88",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_88": "value_88"},
+									Value: map[string]interface{}{"key_94": "value_94"},
 								},
 							},
 							Channels: types.Channels{
-								Read:  map[uint32]string{91: "test_90"},
-								Write: map[uint32]string{92: "test_91"},
+								Read:  map[uint32]string{97: "test_96"},
+								Write: map[uint32]string{98: "test_97"},
 							},
 						},
 					},
 					Edges: []ir.Edge{
 						{
-							Source: ir.Handle{Node: "test_94", Param: "test_95"},
-							Target: ir.Handle{Node: "test_97", Param: "test_98"},
+							Source: ir.Handle{Node: "test_100", Param: "test_101"},
+							Target: ir.Handle{Node: "test_103", Param: "test_104"},
 							Kind:   ir.EdgeKind(0),
 						},
 					},
 					Authorities: ir.Authorities{
-						Default:  func() *uint8 { v := uint8(102); return &v }(),
-						Channels: map[uint32]uint8{103: 103},
+						Default:  func() *uint8 { v := uint8(108); return &v }(),
+						Channels: map[uint32]uint8{109: 109},
 					},
 					Root: ir.Scope{
-						Key:        "test_104",
+						Key:        "test_110",
 						Mode:       ir.ScopeMode(0),
 						Liveness:   ir.Liveness(0),
-						Activation: func() *ir.Handle { v := ir.Handle{Node: "test_108", Param: "test_109"}; return &v }(),
+						Activation: func() *ir.Handle { v := ir.Handle{Node: "test_114", Param: "test_115"}; return &v }(),
 						Strata: [][]ir.Member{
 							{
 								{
-									NodeKey: func() *string { v := string("test_111"); return &v }(),
+									NodeKey: func() *string { v := string("test_117"); return &v }(),
 									Scope: func() *ir.Scope {
 										v := ir.Scope{
-											Key:         "test_113",
+											Key:         "test_119",
 											Mode:        ir.ScopeMode(0),
 											Liveness:    ir.Liveness(0),
 											Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -210,10 +216,10 @@ var _ = Describe("Codec", func() {
 						},
 						Steps: []ir.Member{
 							{
-								NodeKey: func() *string { v := string("test_121"); return &v }(),
+								NodeKey: func() *string { v := string("test_127"); return &v }(),
 								Scope: func() *ir.Scope {
 									v := ir.Scope{
-										Key:         "test_123",
+										Key:         "test_129",
 										Mode:        ir.ScopeMode(0),
 										Liveness:    ir.Liveness(0),
 										Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -227,15 +233,33 @@ var _ = Describe("Codec", func() {
 						},
 						Transitions: []ir.Transition{
 							{
-								On:        ir.Handle{Node: "test_132", Param: "test_133"},
-								TargetKey: func() *string { v := string("test_134"); return &v }(),
+								On:        ir.Handle{Node: "test_138", Param: "test_139"},
+								TargetKey: func() *string { v := string("test_140"); return &v }(),
 							},
 						},
 					},
+					Safety: []ir.Envelope{
+						{
+							Channel: 143,
+							Min:     func() *float64 { v := float64(143.5); return &v }(),
+							Max:     func() *float64 { v := float64(144.5); return &v }(),
+							MaxRate: func() *float64 { v := float64(145.5); return &v }(),
+							Interlocks: []ir.Interlock{
+								{
+									Channel:    148,
+									Comparison: "test_148",
+									Value:      149.5,
+								},
+							},
+							SafeValue:    func() *float64 { v := float64(150.5); return &v }(),
+							SafeSequence: "test_151",
+							SafeStage:    "test_152",
+						},
+					},
 				},
 				Output: compiler.Output{
-					WASM:              []byte{135, 136, 137},
-					OutputMemoryBases: map[string]uint32{"test_136": 137},
+					WASM:              []byte{153, 154, 155},
+					OutputMemoryBases: map[string]uint32{"test_154": 155},
 				},
 			}),
 			Entry("zero values", program.Program{
@@ -253,6 +277,7 @@ var _ = Describe("Codec", func() {
 						Steps:       nil,
 						Transitions: nil,
 					},
+					Safety: nil,
 				},
 				Output: compiler.Output{WASM: nil, OutputMemoryBases: nil},
 			}),
@@ -274,9 +299,10 @@ var _ = Describe("Codec", func() {
 						Steps:       []ir.Member{},
 						Transitions: []ir.Transition{},
 					},
+					Safety: []ir.Envelope{},
 				},
 				Output: compiler.Output{
-					WASM:              []byte{17, 18, 19},
+					WASM:              []byte{18, 19, 20},
 					OutputMemoryBases: map[string]uint32{},
 				},
 			}),
@@ -306,13 +332,14 @@ func BenchmarkEncodeDecodeProgram(b *testing.B) {
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_17": "value_17"},
+							Value: map[string]interface{}{"key_18": "value_18"},
 						},
 					},
 					Inputs: []types.Param{
 						{
-							Name: "test_19",
+							Name: "test_20",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -320,18 +347,19 @@ func BenchmarkEncodeDecodeProgram(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_25",
+								Name:          "test_26",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_30": "value_30"},
+							Value: map[string]interface{}{"key_32": "value_32"},
 						},
 					},
 					Outputs: []types.Param{
 						{
-							Name: "test_32",
+							Name: "test_34",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -339,28 +367,29 @@ func BenchmarkEncodeDecodeProgram(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_38",
+								Name:          "test_40",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_43": "value_43"},
+							Value: map[string]interface{}{"key_46": "value_46"},
 						},
 					},
 					Channels: types.Channels{
-						Read:  map[uint32]string{46: "test_45"},
-						Write: map[uint32]string{47: "test_46"},
+						Read:  map[uint32]string{49: "test_48"},
+						Write: map[uint32]string{50: "test_49"},
 					},
 				},
 			},
 			Nodes: []ir.Node{
 				{
-					Key:  "test_48",
-					Type: "test_49",
+					Key:  "test_51",
+					Type: "test_52",
 					Config: []types.Param{
 						{
-							Name: "test_51",
+							Name: "test_54",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -368,18 +397,19 @@ func BenchmarkEncodeDecodeProgram(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_57",
+								Name:          "test_60",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_62": "value_62"},
+							Value: map[string]interface{}{"key_66": "value_66"},
 						},
 					},
 					Inputs: []types.Param{
 						{
-							Name: "test_64",
+							Name: "test_68",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -387,18 +417,19 @@ func BenchmarkEncodeDecodeProgram(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_70",
+								Name:          "test_74",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_75": "value_75"},
+							Value: map[string]interface{}{"key_80": "value_80"},
 						},
 					},
 					Outputs: []types.Param{
 						{
-							Name: "test_77",
+							Name: "test_82",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -406,44 +437,45 @@ func BenchmarkEncodeDecodeProgram(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_83",
+								Name:          "test_88",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_88": "value_88"},
+							Value: map[string]interface{}{"key_94": "value_94"},
 						},
 					},
 					Channels: types.Channels{
-						Read:  map[uint32]string{91: "test_90"},
-						Write: map[uint32]string{92: "test_91"},
+						Read:  map[uint32]string{97: "test_96"},
+						Write: map[uint32]string{98: "test_97"},
 					},
 				},
 			},
 			Edges: []ir.Edge{
 				{
-					Source: ir.Handle{Node: "test_94", Param: "test_95"},
-					Target: ir.Handle{Node: "test_97", Param: "test_98"},
+					Source: ir.Handle{Node: "test_100", Param: "test_101"},
+					Target: ir.Handle{Node: "test_103", Param: "test_104"},
 					Kind:   ir.EdgeKind(0),
 				},
 			},
 			Authorities: ir.Authorities{
-				Default:  func() *uint8 { v := uint8(102); return &v }(),
-				Channels: map[uint32]uint8{103: 103},
+				Default:  func() *uint8 { v := uint8(108); return &v }(),
+				Channels: map[uint32]uint8{109: 109},
 			},
 			Root: ir.Scope{
-				Key:        "test_104",
+				Key:        "test_110",
 				Mode:       ir.ScopeMode(0),
 				Liveness:   ir.Liveness(0),
-				Activation: func() *ir.Handle { v := ir.Handle{Node: "test_108", Param: "test_109"}; return &v }(),
+				Activation: func() *ir.Handle { v := ir.Handle{Node: "test_114", Param: "test_115"}; return &v }(),
 				Strata: [][]ir.Member{
 					{
 						{
-							NodeKey: func() *string { v := string("test_111"); return &v }(),
+							NodeKey: func() *string { v := string("test_117"); return &v }(),
 							Scope: func() *ir.Scope {
 								v := ir.Scope{
-									Key:         "test_113",
+									Key:         "test_119",
 									Mode:        ir.ScopeMode(0),
 									Liveness:    ir.Liveness(0),
 									Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -458,10 +490,10 @@ func BenchmarkEncodeDecodeProgram(b *testing.B) {
 				},
 				Steps: []ir.Member{
 					{
-						NodeKey: func() *string { v := string("test_121"); return &v }(),
+						NodeKey: func() *string { v := string("test_127"); return &v }(),
 						Scope: func() *ir.Scope {
 							v := ir.Scope{
-								Key:         "test_123",
+								Key:         "test_129",
 								Mode:        ir.ScopeMode(0),
 								Liveness:    ir.Liveness(0),
 								Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -475,15 +507,33 @@ func BenchmarkEncodeDecodeProgram(b *testing.B) {
 				},
 				Transitions: []ir.Transition{
 					{
-						On:        ir.Handle{Node: "test_132", Param: "test_133"},
-						TargetKey: func() *string { v := string("test_134"); return &v }(),
+						On:        ir.Handle{Node: "test_138", Param: "test_139"},
+						TargetKey: func() *string { v := string("test_140"); return &v }(),
+					},
+				},
+			},
+			Safety: []ir.Envelope{
+				{
+					Channel: 143,
+					Min:     func() *float64 { v := float64(143.5); return &v }(),
+					Max:     func() *float64 { v := float64(144.5); return &v }(),
+					MaxRate: func() *float64 { v := float64(145.5); return &v }(),
+					Interlocks: []ir.Interlock{
+						{
+							Channel:    148,
+							Comparison: "test_148",
+							Value:      149.5,
+						},
 					},
+					SafeValue:    func() *float64 { v := float64(150.5); return &v }(),
+					SafeSequence: "test_151",
+					SafeStage:    "test_152",
 				},
 			},
 		},
 		Output: compiler.Output{
-			WASM:              []byte{135, 136, 137},
-			OutputMemoryBases: map[string]uint32{"test_136": 137},
+			WASM:              []byte{153, 154, 155},
+			OutputMemoryBases: map[string]uint32{"test_154": 155},
 		},
 	}
 	w := orc.NewWriter(0)
@@ -524,13 +574,14 @@ func FuzzDecodeProgram(f *testing.F) {
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_17": "value_17"},
+								Value: map[string]interface{}{"key_18": "value_18"},
 							},
 						},
 						Inputs: []types.Param{
 							{
-								Name: "test_19",
+								Name: "test_20",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -538,18 +589,19 @@ func FuzzDecodeProgram(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_25",
+									Name:          "test_26",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_30": "value_30"},
+								Value: map[string]interface{}{"key_32": "value_32"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name: "test_32",
+								Name: "test_34",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -557,28 +609,29 @@ func FuzzDecodeProgram(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_38",
+									Name:          "test_40",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_43": "value_43"},
+								Value: map[string]interface{}{"key_46": "value_46"},
 							},
 						},
 						Channels: types.Channels{
-							Read:  map[uint32]string{46: "test_45"},
-							Write: map[uint32]string{47: "test_46"},
+							Read:  map[uint32]string{49: "test_48"},
+							Write: map[uint32]string{50: "test_49"},
 						},
 					},
 				},
 				Nodes: []ir.Node{
 					{
-						Key:  "test_48",
-						Type: "test_49",
+						Key:  "test_51",
+						Type: "test_52",
 						Config: []types.Param{
 							{
-								Name: "test_51",
+								Name: "test_54",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -586,18 +639,19 @@ func FuzzDecodeProgram(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_57",
+									Name:          "test_60",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_62": "value_62"},
+								Value: map[string]interface{}{"key_66": "value_66"},
 							},
 						},
 						Inputs: []types.Param{
 							{
-								Name: "test_64",
+								Name: "test_68",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -605,18 +659,19 @@ func FuzzDecodeProgram(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_70",
+									Name:          "test_74",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_75": "value_75"},
+								Value: map[string]interface{}{"key_80": "value_80"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name: "test_77",
+								Name: "test_82",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -624,44 +679,45 @@ func FuzzDecodeProgram(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_83",
+									Name:          "test_88",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_88": "value_88"},
+								Value: map[string]interface{}{"key_94": "value_94"},
 							},
 						},
 						Channels: types.Channels{
-							Read:  map[uint32]string{91: "test_90"},
-							Write: map[uint32]string{92: "test_91"},
+							Read:  map[uint32]string{97: "test_96"},
+							Write: map[uint32]string{98: "test_97"},
 						},
 					},
 				},
 				Edges: []ir.Edge{
 					{
-						Source: ir.Handle{Node: "test_94", Param: "test_95"},
-						Target: ir.Handle{Node: "test_97", Param: "test_98"},
+						Source: ir.Handle{Node: "test_100", Param: "test_101"},
+						Target: ir.Handle{Node: "test_103", Param: "test_104"},
 						Kind:   ir.EdgeKind(0),
 					},
 				},
 				Authorities: ir.Authorities{
-					Default:  func() *uint8 { v := uint8(102); return &v }(),
-					Channels: map[uint32]uint8{103: 103},
+					Default:  func() *uint8 { v := uint8(108); return &v }(),
+					Channels: map[uint32]uint8{109: 109},
 				},
 				Root: ir.Scope{
-					Key:        "test_104",
+					Key:        "test_110",
 					Mode:       ir.ScopeMode(0),
 					Liveness:   ir.Liveness(0),
-					Activation: func() *ir.Handle { v := ir.Handle{Node: "test_108", Param: "test_109"}; return &v }(),
+					Activation: func() *ir.Handle { v := ir.Handle{Node: "test_114", Param: "test_115"}; return &v }(),
 					Strata: [][]ir.Member{
 						{
 							{
-								NodeKey: func() *string { v := string("test_111"); return &v }(),
+								NodeKey: func() *string { v := string("test_117"); return &v }(),
 								Scope: func() *ir.Scope {
 									v := ir.Scope{
-										Key:         "test_113",
+										Key:         "test_119",
 										Mode:        ir.ScopeMode(0),
 										Liveness:    ir.Liveness(0),
 										Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -676,10 +732,10 @@ func FuzzDecodeProgram(f *testing.F) {
 					},
 					Steps: []ir.Member{
 						{
-							NodeKey: func() *string { v := string("test_121"); return &v }(),
+							NodeKey: func() *string { v := string("test_127"); return &v }(),
 							Scope: func() *ir.Scope {
 								v := ir.Scope{
-									Key:         "test_123",
+									Key:         "test_129",
 									Mode:        ir.ScopeMode(0),
 									Liveness:    ir.Liveness(0),
 									Activation:  func() *ir.Handle { v := ir.Handle{}; return &v }(),
@@ -693,15 +749,33 @@ func FuzzDecodeProgram(f *testing.F) {
 					},
 					Transitions: []ir.Transition{
 						{
-							On:        ir.Handle{Node: "test_132", Param: "test_133"},
-							TargetKey: func() *string { v := string("test_134"); return &v }(),
+							On:        ir.Handle{Node: "test_138", Param: "test_139"},
+							TargetKey: func() *string { v := string("test_140"); return &v }(),
+						},
+					},
+				},
+				Safety: []ir.Envelope{
+					{
+						Channel: 143,
+						Min:     func() *float64 { v := float64(143.5); return &v }(),
+						Max:     func() *float64 { v := float64(144.5); return &v }(),
+						MaxRate: func() *float64 { v := float64(145.5); return &v }(),
+						Interlocks: []ir.Interlock{
+							{
+								Channel:    148,
+								Comparison: "test_148",
+								Value:      149.5,
+							},
 						},
+						SafeValue:    func() *float64 { v := float64(150.5); return &v }(),
+						SafeSequence: "test_151",
+						SafeStage:    "test_152",
 					},
 				},
 			},
 			Output: compiler.Output{
-				WASM:              []byte{135, 136, 137},
-				OutputMemoryBases: map[string]uint32{"test_136": 137},
+				WASM:              []byte{153, 154, 155},
+				OutputMemoryBases: map[string]uint32{"test_154": 155},
 			},
 		}
 		w := orc.NewWriter(0)
@@ -726,6 +800,7 @@ func FuzzDecodeProgram(f *testing.F) {
 					Steps:       nil,
 					Transitions: nil,
 				},
+				Safety: nil,
 			},
 			Output: compiler.Output{WASM: nil, OutputMemoryBases: nil},
 		}
@@ -754,9 +829,10 @@ func FuzzDecodeProgram(f *testing.F) {
 					Steps:       []ir.Member{},
 					Transitions: []ir.Transition{},
 				},
+				Safety: []ir.Envelope{},
 			},
 			Output: compiler.Output{
-				WASM:              []byte{17, 18, 19},
+				WASM:              []byte{18, 19, 20},
 				OutputMemoryBases: map[string]uint32{},
 			},
 		}
@@ -48,11 +48,13 @@ type Program struct {
 	// root is the top-level execution context. The root is always a parallel, always-live
 	// Scope whose strata mix module-scope reactive flow with top-level gated scopes.
 	Root *pb.Scope `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	// safety contains the safety envelopes declared by this program.
+	Safety []*pb.Envelope `protobuf:"bytes,6,rep,name=safety,proto3" json:"safety,omitempty"`
 	// wasm is compiled WebAssembly bytecode for sandboxed execution.
-	Wasm []byte `protobuf:"bytes,6,opt,name=wasm,proto3" json:"wasm,omitempty"`
+	Wasm []byte `protobuf:"bytes,7,opt,name=wasm,proto3" json:"wasm,omitempty"`
 	// output_memory_bases contains memory base addresses for multi-output functions,
 	// mapping function keys to their base addresses.
-	OutputMemoryBases map[string]uint32 `protobuf:"bytes,7,rep,name=output_memory_bases,json=outputMemoryBases,proto3" json:"output_memory_bases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	OutputMemoryBases map[string]uint32 `protobuf:"bytes,8,rep,name=output_memory_bases,json=outputMemoryBases,proto3" json:"output_memory_bases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -122,6 +124,13 @@ func (x *Program) GetRoot() *pb.Scope {
 	return nil
 }
 
+func (x *Program) GetSafety() []*pb.Envelope {
+	if x != nil {
+		return x.Safety
+	}
+	return nil
+}
+
 func (x *Program) GetWasm() []byte {
 	if x != nil {
 		return x.Wasm
@@ -140,15 +149,16 @@ var File_arc_go_program_pb_program_proto protoreflect.FileDescriptor
 
 const file_arc_go_program_pb_program_proto_rawDesc = "" +
 	"\n" +
-	"\x1farc/go/program/pb/program.proto\x12\x0earc.program.pb\x1a\x15arc/go/ir/pb/ir.proto\"\xa4\x03\n" +
+	"\x1farc/go/program/pb/program.proto\x12\x0earc.program.pb\x1a\x15arc/go/ir/pb/ir.proto\"\xd1\x03\n" +
 	"\aProgram\x121\n" +
 	"\tfunctions\x18\x01 \x03(\v2\x13.arc.ir.pb.FunctionR\tfunctions\x12%\n" +
 	"\x05nodes\x18\x02 \x03(\v2\x0f.arc.ir.pb.NodeR\x05nodes\x12%\n" +
 	"\x05edges\x18\x03 \x03(\v2\x0f.arc.ir.pb.EdgeR\x05edges\x128\n" +
 	"\vauthorities\x18\x04 \x01(\v2\x16.arc.ir.pb.AuthoritiesR\vauthorities\x12$\n" +
-	"\x04root\x18\x05 \x01(\v2\x10.arc.ir.pb.ScopeR\x04root\x12\x12\n" +
-	"\x04wasm\x18\x06 \x01(\fR\x04wasm\x12^\n" +
-	"\x13output_memory_bases\x18\a \x03(\v2..arc.program.pb.Program.OutputMemoryBasesEntryR\x11outputMemoryBases\x1aD\n" +
+	"\x04root\x18\x05 \x01(\v2\x10.arc.ir.pb.ScopeR\x04root\x12+\n" +
+	"\x06safety\x18\x06 \x03(\v2\x13.arc.ir.pb.EnvelopeR\x06safety\x12\x12\n" +
+	"\x04wasm\x18\a \x01(\fR\x04wasm\x12^\n" +
+	"\x13output_memory_bases\x18\b \x03(\v2..arc.program.pb.Program.OutputMemoryBasesEntryR\x11outputMemoryBases\x1aD\n" +
 	"\x16OutputMemoryBasesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01B\xa2\x01\n" +
@@ -175,6 +185,7 @@ var file_arc_go_program_pb_program_proto_goTypes = []any{
 	(*pb.Edge)(nil),        // 4: arc.ir.pb.Edge
 	(*pb.Authorities)(nil), // 5: arc.ir.pb.Authorities
 	(*pb.Scope)(nil),       // 6: arc.ir.pb.Scope
+	(*pb.Envelope)(nil),    // 7: arc.ir.pb.Envelope
 }
 var file_arc_go_program_pb_program_proto_depIdxs = []int32{
 	2, // 0: arc.program.pb.Program.functions:type_name -> arc.ir.pb.Function
@@ -182,12 +193,13 @@ var file_arc_go_program_pb_program_proto_depIdxs = []int32{
 	4, // 2: arc.program.pb.Program.edges:type_name -> arc.ir.pb.Edge
 	5, // 3: arc.program.pb.Program.authorities:type_name -> arc.ir.pb.Authorities
 	6, // 4: arc.program.pb.Program.root:type_name -> arc.ir.pb.Scope
-	1, // 5: arc.program.pb.Program.output_memory_bases:type_name -> arc.program.pb.Program.OutputMemoryBasesEntry
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7, // 5: arc.program.pb.Program.safety:type_name -> arc.ir.pb.Envelope
+	1, // 6: arc.program.pb.Program.output_memory_bases:type_name -> arc.program.pb.Program.OutputMemoryBasesEntry
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_arc_go_program_pb_program_proto_init() }
@@ -38,6 +38,10 @@ func ProgramToPB(r program.Program) (*Program, error) {
 	if err != nil {
 		return nil, err
 	}
+	safetyVal, err := irpb.EnvelopesToPB(r.Safety)
+	if err != nil {
+		return nil, err
+	}
 	pb := &Program{
 		Wasm:              r.WASM,
 		OutputMemoryBases: r.OutputMemoryBases,
@@ -46,6 +50,7 @@ func ProgramToPB(r program.Program) (*Program, error) {
 		Edges:             edgesVal,
 		Authorities:       authoritiesVal,
 		Root:              rootVal,
+		Safety:            safetyVal,
 	}
 	return pb, nil
 }
@@ -77,6 +82,10 @@ func ProgramFromPB(pb *Program) (program.Program, error) {
 	if err != nil {
 		return program.Program{}, err
 	}
+	r.Safety, err = irpb.EnvelopesFromPB(pb.Safety)
+	if err != nil {
+		return program.Program{}, err
+	}
 	r.WASM = pb.Wasm
 	r.OutputMemoryBases = pb.OutputMemoryBases
 	return r, nil
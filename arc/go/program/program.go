@@ -113,3 +113,13 @@ func (m Program) wasmSummary() string {
 	shortHash := hex.EncodeToString(hash[:])[:8]
 	return fmt.Sprintf("WASM: %d bytes (sha256: %s...)", len(m.WASM), shortHash)
 }
+
+// Hash returns a hex-encoded SHA256 digest of the program's WASM bytecode and IR.
+// Two programs with the same hash execute identically, so the hash can be used to
+// check that state captured from one program is compatible with another.
+func (m Program) Hash() string {
+	h := sha256.New()
+	h.Write(m.WASM)
+	h.Write([]byte(m.IR.String()))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -70,4 +70,28 @@ var _ = Describe("Program", func() {
 			Expect(s).To(ContainSubstring("node1"))
 		})
 	})
+
+	Describe("Hash", func() {
+		nodeProgram := func(nodeType string) program.Program {
+			return program.Program{
+				Output: compiler.Output{WASM: []byte{0x00, 0x61, 0x73, 0x6d}},
+				IR:     ir.IR{Nodes: ir.Nodes{{Key: "node1", Type: nodeType}}},
+			}
+		}
+
+		It("Should return the same hash for identical programs", func() {
+			Expect(nodeProgram("add").Hash()).To(Equal(nodeProgram("add").Hash()))
+			Expect(nodeProgram("add").Hash()).To(HaveLen(64))
+		})
+
+		It("Should return a different hash when the IR changes", func() {
+			Expect(nodeProgram("add").Hash()).ToNot(Equal(nodeProgram("sub").Hash()))
+		})
+
+		It("Should return a different hash when the WASM changes", func() {
+			changed := nodeProgram("add")
+			changed.WASM = append(changed.WASM, 0x01)
+			Expect(changed.Hash()).ToNot(Equal(nodeProgram("add").Hash()))
+		})
+	})
 })
@@ -694,4 +694,62 @@ var _ = Describe("Routing Table Runtime", func() {
 			Expect(telem.UnmarshalSeries[uint8](out.Get(200).Series[0])).To(Equal([]uint8{1}))
 		})
 	})
+
+	Describe("Routing by Enum Value", func() {
+		It("Should transition to the stage selected by an enum value", func(ctx SpecContext) {
+			resolver := channelSymbols(map[string]channelDef{
+				"trigger": {types.U8(), 50},
+				"mode":    {types.U8(), 100},
+				"vlv_cmd": {types.U8(), 200},
+				"ign_cmd": {types.U8(), 300},
+			})
+			h := newRuntimeHarness(ctx, `
+				enum Mode u8 { idle, press, fire = 5 }
+
+				trigger => main
+
+				sequence main {
+				    stage wait {
+				        mode => {
+				            Mode.press: pressurize,
+				            Mode.fire: ignite,
+				        }
+				    }
+				    stage pressurize {
+				        1 -> vlv_cmd
+				    }
+				    stage ignite {
+				        1 -> ign_cmd
+				    }
+				}`, resolver,
+				channels.Digest{Key: 50, DataType: telem.Uint8T},
+				channels.Digest{Key: 100, DataType: telem.Uint8T},
+				channels.Digest{Key: 200, DataType: telem.Uint8T},
+				channels.Digest{Key: 300, DataType: telem.Uint8T},
+			)
+			defer h.Close(ctx)
+
+			h.Ingest(50, telem.NewSeriesV[uint8](1))
+			h.Tick(ctx, telem.Millisecond)
+			h.channelState.ClearReads()
+			_, changed := h.Flush()
+			Expect(changed).To(BeFalse())
+
+			// A value outside the table selects no transition.
+			h.Ingest(100, telem.NewSeriesV[uint8](0))
+			h.Tick(ctx, 2*telem.Millisecond)
+			h.channelState.ClearReads()
+			_, changed = h.Flush()
+			Expect(changed).To(BeFalse())
+
+			h.Ingest(100, telem.NewSeriesV[uint8](5))
+			h.Tick(ctx, 3*telem.Millisecond)
+			h.channelState.ClearReads()
+			out, changed := h.Flush()
+			Expect(changed).To(BeTrue())
+			Expect(out.Get(200).Series).To(HaveLen(0))
+			Expect(out.Get(300).Series).To(HaveLen(1))
+			Expect(telem.UnmarshalSeries[uint8](out.Get(300).Series[0])).To(Equal([]uint8{1}))
+		})
+	})
 })
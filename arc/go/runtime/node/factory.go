@@ -57,9 +57,12 @@ func (f CompoundFactory) Create(ctx context.Context, cfg Config) (Node, error) {
 	// Strip module prefix from the node type so factories only match bare names.
 	// The compiler emits qualified names (e.g. "time.interval", "control.set_authority")
 	// into the IR; normalizing here keeps prefix awareness out of individual
-	// factories.
+	// factories. Functions linked in from libraries are keyed by their qualified
+	// name (e.g. "conv.c_to_f"), so a node type that names a compiled function is
+	// left intact.
 	var modulePrefix string
-	if i := strings.LastIndex(cfg.Node.Type, "."); i >= 0 {
+	_, isFunction := cfg.Program.Functions.Find(cfg.Node.Type)
+	if i := strings.LastIndex(cfg.Node.Type, "."); i >= 0 && !isFunction {
 		modulePrefix = cfg.Node.Type[:i]
 		cfg.Node.Type = cfg.Node.Type[i+1:]
 	}
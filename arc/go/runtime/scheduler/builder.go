@@ -69,7 +69,7 @@ func newBuilder(prog ir.IR, runtimeNodes map[string]rnode.Node) *builder {
 		params := make(map[string]int, len(n.Outputs))
 		for j, p := range n.Outputs {
 			params[p.Name] = j
-			rn.outputs = append(rn.outputs, outputResolved{markHandleIdx: -1})
+			rn.outputs = append(rn.outputs, outputResolved{markHandleIdx: -1, param: p.Name})
 		}
 		b.outputByParam[rn] = params
 		b.nodes[n.Key] = rn
@@ -236,7 +236,7 @@ func (b *builder) getOrCreateOutput(n *node, param string) int {
 		return idx
 	}
 	idx := len(n.outputs)
-	n.outputs = append(n.outputs, outputResolved{markHandleIdx: -1})
+	n.outputs = append(n.outputs, outputResolved{markHandleIdx: -1, param: param})
 	params[param] = idx
 	return idx
 }
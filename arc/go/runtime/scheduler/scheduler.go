@@ -40,6 +40,8 @@ type outputResolved struct {
 	markHandleIdx int
 	// activates are the gated scopes whose activation handle is this output.
 	activates []*scope
+	// param is the output's parameter name, retained for tracing.
+	param string
 }
 
 // node pairs a runtime node with its pre-resolved per-output propagation
@@ -121,6 +123,12 @@ type Scheduler struct {
 	// errorHandler receives errors raised by nodes via ctx.ReportError;
 	// nil drops them.
 	errorHandler ErrorHandler
+	// stageHandler is notified whenever a sequential scope enters a step;
+	// nil drops the notifications.
+	stageHandler StageHandler
+	// tracer receives scheduler events when tracing is enabled; nil disables
+	// tracing.
+	tracer Tracer
 	// changedFlags[i] is set when node i has a pending upstream change
 	// for the current cycle. Cleared at end of cycle.
 	changedFlags []uint8
@@ -163,6 +171,103 @@ func (s *Scheduler) SetErrorHandler(handler ErrorHandler) {
 	s.errorHandler = handler
 }
 
+// Stage identifies a step of a sequential scope.
+type Stage struct {
+	// Scope is the key of the sequential scope.
+	Scope string
+	// Step is the key of the step within the scope.
+	Step string
+}
+
+// StageHandler is notified whenever a sequential scope enters a step.
+type StageHandler interface {
+	HandleStage(ctx context.Context, stage Stage)
+}
+
+// StageHandlerFunc adapts an ordinary function to the StageHandler interface.
+type StageHandlerFunc func(ctx context.Context, stage Stage)
+
+// HandleStage implements StageHandler.
+func (f StageHandlerFunc) HandleStage(ctx context.Context, stage Stage) {
+	f(ctx, stage)
+}
+
+// SetStageHandler configures the handler notified of sequential step
+// entries. Steps entered while New activates the root scope happen before
+// any handler can be set; use ActiveStages to observe them.
+func (s *Scheduler) SetStageHandler(handler StageHandler) {
+	s.stageHandler = handler
+}
+
+// ActiveStages returns the active step of every active sequential scope, in
+// scope-tree order.
+func (s *Scheduler) ActiveStages() []Stage {
+	return s.appendActiveStages(nil, s.root)
+}
+
+func (s *Scheduler) appendActiveStages(stages []Stage, ss *scope) []Stage {
+	if !ss.active {
+		return stages
+	}
+	if ss.ir.Mode == ir.ScopeModeSequential {
+		if ss.activeStep < 0 {
+			return stages
+		}
+		m := &ss.members[ss.activeStep]
+		stages = append(stages, Stage{Scope: ss.ir.Key, Step: m.key})
+		if m.scope != nil {
+			stages = s.appendActiveStages(stages, m.scope)
+		}
+		return stages
+	}
+	for i := range ss.members {
+		if sc := ss.members[i].scope; sc != nil {
+			stages = s.appendActiveStages(stages, sc)
+		}
+	}
+	return stages
+}
+
+// EnterStage moves the sequential scope with the given key to the given step,
+// activating the scope if it isn't active. The step runs on the next call to Next.
+// Entering the step a scope is already on leaves it untouched. Returns false if the
+// program has no such scope or step.
+func (s *Scheduler) EnterStage(ctx context.Context, sequence, stage string) bool {
+	ss := findSequentialScope(s.root, sequence)
+	if ss == nil {
+		return false
+	}
+	idx, ok := ss.memberByKey[stage]
+	if !ok {
+		return false
+	}
+	if ss.active && ss.activeStep == idx {
+		return true
+	}
+	s.nodeCtx.Context = ctx
+	if ss.active && ss.activeStep >= 0 {
+		s.traceStepExit(ss)
+		s.deactivateStep(&ss.members[ss.activeStep])
+	}
+	ss.active = true
+	s.activateSequentialStep(ss, idx)
+	return true
+}
+
+func findSequentialScope(ss *scope, key string) *scope {
+	if ss.ir.Mode == ir.ScopeModeSequential && ss.ir.Key == key {
+		return ss
+	}
+	for i := range ss.members {
+		if sc := ss.members[i].scope; sc != nil {
+			if found := findSequentialScope(sc, key); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
 // NextDeadline returns the earliest deadline reported by any node during
 // the previous Next call. Callers use this to sleep until the scheduler
 // has work to do.
@@ -242,6 +347,9 @@ func (s *Scheduler) executeMember(stratumIdx int, m *member) {
 	}
 	if stratumIdx == 0 || s.changedFlags[idx] != 0 || wasSelfChanged {
 		s.currNode = m.node
+		if s.tracer != nil {
+			s.trace(Event{Kind: EventNodeExecuted, Node: m.node.key})
+		}
 		s.currNode.Next(s.nodeCtx)
 	}
 }
@@ -264,13 +372,19 @@ func (s *Scheduler) evaluateTransitions(ss *scope) bool {
 			continue
 		}
 		if !ss.transitionOnNode[i].IsOutputTruthy(ss.transitionOnOutputIdx[i]) {
+			s.traceTransition(ss, i, false)
 			continue
 		}
+		s.traceTransition(ss, i, true)
 		s.markedFlags[handleIdx] = 0
+		t := ss.ir.Transitions[i]
+		if t.TargetKey != nil {
+			// Exiting the sequence traces the step's exit in deactivateScope.
+			s.traceStepExit(ss)
+		}
 		if ss.activeStep >= 0 {
 			s.deactivateStep(&ss.members[ss.activeStep])
 		}
-		t := ss.ir.Transitions[i]
 		if t.TargetKey == nil {
 			s.deactivateScope(ss)
 		} else {
@@ -325,11 +439,17 @@ func (s *Scheduler) activateScope(ss *scope) {
 	}
 }
 
-// activateSequentialStep points the active pointer at idx and resets
-// (or cascade-activates) that step.
+// activateSequentialStep points the active pointer at idx, notifies the
+// stage handler, and resets (or cascade-activates) that step.
 func (s *Scheduler) activateSequentialStep(ss *scope, idx int) {
 	ss.activeStep = idx
 	m := &ss.members[idx]
+	if s.stageHandler != nil {
+		s.stageHandler.HandleStage(s.nodeCtx.Context, Stage{Scope: ss.ir.Key, Step: m.key})
+	}
+	if s.tracer != nil {
+		s.trace(Event{Kind: EventStageEntered, Scope: ss.ir.Key, Step: m.key})
+	}
 	if m.isNode() {
 		s.resetLeafNode(m)
 		return
@@ -355,6 +475,7 @@ func (s *Scheduler) deactivateStep(m *member) {
 // until the next activation overwrites it.
 func (s *Scheduler) deactivateScope(ss *scope) {
 	if ss.ir.Mode == ir.ScopeModeSequential {
+		s.traceStepExit(ss)
 		ss.activeStep = -1
 	}
 	for i := range ss.members {
@@ -372,6 +493,9 @@ func (s *Scheduler) markChanged(outputIdx int) {
 		return
 	}
 	out := &s.currNode.outputs[outputIdx]
+	if s.tracer != nil {
+		s.trace(Event{Kind: EventOutputChanged, Node: s.currNode.key, Output: out.param})
+	}
 	truthy := s.currNode.IsOutputTruthy(outputIdx)
 	if truthy && out.markHandleIdx >= 0 {
 		s.markedFlags[out.markHandleIdx] = 1
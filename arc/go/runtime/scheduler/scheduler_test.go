@@ -1131,6 +1131,333 @@ var _ = Describe("Scheduler", func() {
 		})
 	})
 
+	Describe("Stage notifications", func() {
+		// sequence main { stage first; stage second; } advancing to second
+		// when first_node fires. The sequence has the given liveness and
+		// activation handle.
+		buildSeq := func(liveness ir.Liveness, activation *ir.Handle) ir.IR {
+			first := parallelScope("first", stratum(ir.NodeMember("first_node")))
+			second := parallelScope("second", stratum(ir.NodeMember("second_node")))
+			main := sequentialScope("main", []ir.Member{
+				{Scope: &first},
+				{Scope: &second},
+			}, ir.Transition{
+				On:        ir.Handle{Node: "first_node", Param: "output"},
+				TargetKey: stepKeyTarget("second"),
+			})
+			main.Liveness = liveness
+			main.Activation = activation
+			return programOf(
+				[]ir.Node{
+					irNode("trigger", "output"),
+					irNode("first_node", "output"),
+					irNode("second_node"),
+				},
+				nil,
+				rootScope(ir.NodeMember("trigger"), ir.ScopeMember(main)),
+			)
+		}
+
+		It("Should notify the handler of every step entry in order", func(ctx SpecContext) {
+			mock("trigger", true)
+			firstNode := mock("first_node")
+			mock("second_node")
+			s := build(buildSeq(ir.LivenessGated, &ir.Handle{Node: "trigger", Param: "output"}))
+			var stages []scheduler.Stage
+			s.SetStageHandler(scheduler.StageHandlerFunc(func(_ context.Context, st scheduler.Stage) {
+				stages = append(stages, st)
+			}))
+			s.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			Expect(stages).To(Equal([]scheduler.Stage{{Scope: "main", Step: "first"}}))
+			firstNode.SetTruthy(0)
+			s.Next(ctx, 2*telem.Microsecond, node.ReasonTimerTick)
+			Expect(stages).To(Equal([]scheduler.Stage{
+				{Scope: "main", Step: "first"},
+				{Scope: "main", Step: "second"},
+			}))
+			Expect(s.ActiveStages()).To(Equal([]scheduler.Stage{{Scope: "main", Step: "second"}}))
+		})
+
+		It("Should report steps entered during construction through ActiveStages", func() {
+			mock("trigger")
+			mock("first_node")
+			mock("second_node")
+			s := build(buildSeq(ir.LivenessAlways, nil))
+			Expect(s.ActiveStages()).To(Equal([]scheduler.Stage{{Scope: "main", Step: "first"}}))
+		})
+
+		It("Should report no stages when no sequence is active", func() {
+			mock("trigger")
+			mock("first_node")
+			mock("second_node")
+			s := build(buildSeq(ir.LivenessGated, nil))
+			Expect(s.ActiveStages()).To(BeEmpty())
+		})
+
+		It("Should enter a step of an inactive sequence", func(ctx SpecContext) {
+			mock("trigger")
+			mock("first_node")
+			secondNode := mock("second_node")
+			s := build(buildSeq(ir.LivenessGated, nil))
+			var stages []scheduler.Stage
+			s.SetStageHandler(scheduler.StageHandlerFunc(func(_ context.Context, st scheduler.Stage) {
+				stages = append(stages, st)
+			}))
+			Expect(s.EnterStage(ctx, "main", "second")).To(BeTrue())
+			Expect(stages).To(Equal([]scheduler.Stage{{Scope: "main", Step: "second"}}))
+			s.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			Expect(secondNode.NextCalled).To(Equal(1))
+		})
+
+		It("Should leave a sequence already on the step untouched", func(ctx SpecContext) {
+			mock("trigger")
+			mock("first_node")
+			mock("second_node")
+			s := build(buildSeq(ir.LivenessAlways, nil))
+			var stages []scheduler.Stage
+			s.SetStageHandler(scheduler.StageHandlerFunc(func(_ context.Context, st scheduler.Stage) {
+				stages = append(stages, st)
+			}))
+			Expect(s.EnterStage(ctx, "main", "first")).To(BeTrue())
+			Expect(stages).To(BeEmpty())
+		})
+
+		It("Should return false for a missing sequence or step", func(ctx SpecContext) {
+			mock("trigger")
+			mock("first_node")
+			mock("second_node")
+			s := build(buildSeq(ir.LivenessAlways, nil))
+			Expect(s.EnterStage(ctx, "other", "first")).To(BeFalse())
+			Expect(s.EnterStage(ctx, "main", "missing")).To(BeFalse())
+			Expect(s.ActiveStages()).To(Equal([]scheduler.Stage{{Scope: "main", Step: "first"}}))
+		})
+	})
+
+	Describe("Snapshots", func() {
+		// sequence main { stage first; stage second; } gated on trigger,
+		// advancing to second when first_node fires.
+		buildSeq := func() ir.IR {
+			first := parallelScope("first", stratum(ir.NodeMember("first_node")))
+			second := parallelScope("second", stratum(ir.NodeMember("second_node")))
+			main := sequentialScope("main", []ir.Member{
+				{Scope: &first},
+				{Scope: &second},
+			}, ir.Transition{
+				On:        ir.Handle{Node: "first_node", Param: "output"},
+				TargetKey: stepKeyTarget("second"),
+			})
+			main.Activation = &ir.Handle{Node: "trigger", Param: "output"}
+			return programOf(
+				[]ir.Node{
+					irNode("trigger", "output"),
+					irNode("first_node", "output"),
+					irNode("second_node"),
+				},
+				nil,
+				rootScope(ir.NodeMember("trigger"), ir.ScopeMember(main)),
+			)
+		}
+
+		It("Should capture the activation state of every scope", func(ctx SpecContext) {
+			mock("trigger", true)
+			mock("first_node", true)
+			mock("second_node")
+			s := build(buildSeq())
+			s.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			Expect(s.Snapshot()).To(Equal(scheduler.Snapshot{Scopes: []scheduler.ScopeSnapshot{
+				{Path: "", Active: true},
+				{Path: "main", Active: true, Step: "second"},
+				{Path: "main.first", Active: false},
+				{Path: "main.second", Active: true},
+			}}))
+		})
+
+		It("Should restore the active step of a sequence in a fresh scheduler", func(ctx SpecContext) {
+			mock("trigger", true)
+			mock("first_node", true)
+			mock("second_node")
+			s := build(buildSeq())
+			s.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			snap := s.Snapshot()
+
+			nodes = make(map[string]node.Node)
+			mocks = make(map[string]*MockNode)
+			mock("trigger")
+			firstNode := mock("first_node")
+			secondNode := mock("second_node")
+			restored := build(buildSeq())
+			var stages []scheduler.Stage
+			restored.SetStageHandler(scheduler.StageHandlerFunc(func(_ context.Context, st scheduler.Stage) {
+				stages = append(stages, st)
+			}))
+			restored.Restore(snap)
+			Expect(stages).To(BeEmpty())
+			Expect(restored.ActiveStages()).To(Equal([]scheduler.Stage{{Scope: "main", Step: "second"}}))
+			restored.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			Expect(firstNode.NextCalled).To(Equal(0))
+			Expect(secondNode.NextCalled).To(Equal(1))
+			Expect(secondNode.ResetCalled).To(Equal(1))
+		})
+
+		It("Should deactivate scopes that were inactive when captured", func(ctx SpecContext) {
+			mock("trigger", true)
+			mock("first_node")
+			mock("second_node")
+			s := build(buildSeq())
+			s.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			Expect(s.ActiveStages()).To(HaveLen(1))
+			s.Restore(scheduler.Snapshot{Scopes: []scheduler.ScopeSnapshot{
+				{Path: "main", Active: false},
+			}})
+			Expect(s.ActiveStages()).To(BeEmpty())
+		})
+
+		It("Should ignore scopes and steps that are not in the program", func() {
+			mock("trigger")
+			mock("first_node")
+			mock("second_node")
+			s := build(buildSeq())
+			s.Restore(scheduler.Snapshot{Scopes: []scheduler.ScopeSnapshot{
+				{Path: "other", Active: true, Step: "first"},
+				{Path: "main", Active: true, Step: "missing"},
+			}})
+			Expect(s.ActiveStages()).To(Equal([]scheduler.Stage{{Scope: "main", Step: "first"}}))
+		})
+	})
+
+	Describe("Tracing", func() {
+		// sequence main { stage first; stage second; } gated on trigger,
+		// advancing to second when first_node fires and exiting when
+		// second_node fires.
+		buildSeq := func() ir.IR {
+			first := parallelScope("first", stratum(ir.NodeMember("first_node")))
+			second := parallelScope("second", stratum(ir.NodeMember("second_node")))
+			main := sequentialScope("main", []ir.Member{
+				{Scope: &first},
+				{Scope: &second},
+			}, ir.Transition{
+				On:        ir.Handle{Node: "first_node", Param: "output"},
+				TargetKey: stepKeyTarget("second"),
+			}, ir.Transition{
+				On:        ir.Handle{Node: "second_node", Param: "output"},
+				TargetKey: exitTarget(),
+			})
+			main.Activation = &ir.Handle{Node: "trigger", Param: "output"}
+			return programOf(
+				[]ir.Node{
+					irNode("trigger", "output"),
+					irNode("first_node", "output"),
+					irNode("second_node", "output"),
+				},
+				nil,
+				rootScope(ir.NodeMember("trigger"), ir.ScopeMember(main)),
+			)
+		}
+		trace := func(s *scheduler.Scheduler) *[]scheduler.Event {
+			var events []scheduler.Event
+			s.SetTracer(scheduler.TracerFunc(func(_ context.Context, e scheduler.Event) {
+				events = append(events, e)
+			}))
+			return &events
+		}
+
+		It("Should trace node executions, output changes, and stage entries", func(ctx SpecContext) {
+			mock("trigger", true)
+			mock("first_node")
+			mock("second_node")
+			s := build(buildSeq())
+			events := trace(s)
+			s.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			Expect(*events).To(Equal([]scheduler.Event{
+				{Kind: scheduler.EventNodeExecuted, Elapsed: telem.Microsecond, Node: "trigger"},
+				{Kind: scheduler.EventOutputChanged, Elapsed: telem.Microsecond, Node: "trigger", Output: "output"},
+				{Kind: scheduler.EventStageEntered, Elapsed: telem.Microsecond, Scope: "main", Step: "first"},
+				{Kind: scheduler.EventNodeExecuted, Elapsed: telem.Microsecond, Node: "first_node"},
+			}))
+		})
+
+		It("Should trace a fired transition, the step exit, and the next step entry in order", func(ctx SpecContext) {
+			mock("trigger", true)
+			firstNode := mock("first_node")
+			mock("second_node")
+			s := build(buildSeq())
+			s.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			events := trace(s)
+			firstNode.SetTruthy(0)
+			s.Next(ctx, 2*telem.Microsecond, node.ReasonTimerTick)
+			var stages []scheduler.Event
+			for _, e := range *events {
+				if e.Kind != scheduler.EventNodeExecuted && e.Kind != scheduler.EventOutputChanged {
+					stages = append(stages, e)
+				}
+			}
+			Expect(stages).To(Equal([]scheduler.Event{
+				{
+					Kind:    scheduler.EventTransitionEvaluated,
+					Elapsed: 2 * telem.Microsecond,
+					Node:    "first_node",
+					Output:  "output",
+					Scope:   "main",
+					Step:    "first",
+					Target:  "second",
+					Fired:   true,
+				},
+				{Kind: scheduler.EventStageExited, Elapsed: 2 * telem.Microsecond, Scope: "main", Step: "first"},
+				{Kind: scheduler.EventStageEntered, Elapsed: 2 * telem.Microsecond, Scope: "main", Step: "second"},
+			}))
+		})
+
+		It("Should trace the exit of the active step when a sequence exits", func(ctx SpecContext) {
+			trigger := mock("trigger", true)
+			firstNode := mock("first_node", true)
+			secondNode := mock("second_node")
+			s := build(buildSeq())
+			s.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			trigger.OutputTruthy[0] = false
+			firstNode.OutputTruthy[0] = false
+			events := trace(s)
+			secondNode.SetTruthy(0)
+			s.Next(ctx, 2*telem.Microsecond, node.ReasonTimerTick)
+			Expect(*events).To(ContainElements(
+				scheduler.Event{
+					Kind:    scheduler.EventTransitionEvaluated,
+					Elapsed: 2 * telem.Microsecond,
+					Node:    "second_node",
+					Output:  "output",
+					Scope:   "main",
+					Step:    "second",
+					Fired:   true,
+				},
+				scheduler.Event{Kind: scheduler.EventStageExited, Elapsed: 2 * telem.Microsecond, Scope: "main", Step: "second"},
+			))
+			Expect(s.ActiveStages()).To(BeEmpty())
+		})
+
+		It("Should not trace steps entered by Restore", func() {
+			mock("trigger")
+			mock("first_node")
+			mock("second_node")
+			s := build(buildSeq())
+			events := trace(s)
+			s.Restore(scheduler.Snapshot{Scopes: []scheduler.ScopeSnapshot{
+				{Path: "main", Active: true, Step: "second"},
+			}})
+			Expect(*events).To(BeEmpty())
+			Expect(s.ActiveStages()).To(Equal([]scheduler.Stage{{Scope: "main", Step: "second"}}))
+		})
+
+		It("Should stop tracing when the tracer is cleared", func(ctx SpecContext) {
+			mock("trigger", true)
+			mock("first_node")
+			mock("second_node")
+			s := build(buildSeq())
+			events := trace(s)
+			s.SetTracer(nil)
+			s.Next(ctx, telem.Microsecond, node.ReasonTimerTick)
+			Expect(*events).To(BeEmpty())
+		})
+	})
+
 	Describe("Edge cases", func() {
 		It("Should accept zero elapsed time", func(ctx SpecContext) {
 			nodeA := mock("A")
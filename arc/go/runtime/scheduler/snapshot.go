@@ -0,0 +1,121 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package scheduler
+
+import "github.com/synnaxlabs/arc/ir"
+
+// ScopeSnapshot is the activation state of a single scope.
+type ScopeSnapshot struct {
+	// Path identifies the scope by the keys of the scopes on the way to it from
+	// the root, joined by periods.
+	Path string `json:"path" msgpack:"path"`
+	// Active is whether the scope was active.
+	Active bool `json:"active" msgpack:"active"`
+	// Step is the key of the active step of a sequential scope, and empty for
+	// parallel scopes or sequences without an active step.
+	Step string `json:"step,omitempty" msgpack:"step,omitempty"`
+}
+
+// Snapshot is the activation state of every scope in a Scheduler's scope tree,
+// captured with Snapshot and re-applied to a fresh Scheduler for the same program
+// with Restore.
+type Snapshot struct {
+	// Scopes holds the state of each scope in scope-tree order.
+	Scopes []ScopeSnapshot `json:"scopes" msgpack:"scopes"`
+}
+
+// Snapshot captures the activation state of the scheduler's scope tree.
+func (s *Scheduler) Snapshot() Snapshot {
+	var snap Snapshot
+	snap.Scopes = appendScopeSnapshots(snap.Scopes, s.root, s.root.ir.Key)
+	return snap
+}
+
+func appendScopeSnapshots(snaps []ScopeSnapshot, ss *scope, path string) []ScopeSnapshot {
+	st := ScopeSnapshot{Path: path, Active: ss.active}
+	if ss.ir.Mode == ir.ScopeModeSequential && ss.active && ss.activeStep >= 0 {
+		st.Step = ss.members[ss.activeStep].key
+	}
+	snaps = append(snaps, st)
+	for i := range ss.members {
+		if sc := ss.members[i].scope; sc != nil {
+			snaps = appendScopeSnapshots(snaps, sc, childPath(path, sc.ir.Key))
+		}
+	}
+	return snaps
+}
+
+// Restore re-applies a snapshot captured by Snapshot, activating and deactivating
+// scopes and moving sequential scopes to their captured steps. Scopes are restored
+// from the root down, so nested scopes end up in their captured state even when
+// restoring a parent activates them. Scopes and steps that don't exist in the
+// scheduler's program are ignored. Steps entered by Restore are not reported to
+// the stage handler or the tracer; use ActiveStages to observe them.
+func (s *Scheduler) Restore(snap Snapshot) {
+	states := make(map[string]ScopeSnapshot, len(snap.Scopes))
+	for _, st := range snap.Scopes {
+		states[st.Path] = st
+	}
+	handler, tracer := s.stageHandler, s.tracer
+	s.stageHandler, s.tracer = nil, nil
+	s.restoreScope(s.root, s.root.ir.Key, states)
+	s.stageHandler, s.tracer = handler, tracer
+}
+
+func (s *Scheduler) restoreScope(ss *scope, path string, states map[string]ScopeSnapshot) {
+	if st, ok := states[path]; ok {
+		s.restoreActivation(ss, st)
+	}
+	for i := range ss.members {
+		if sc := ss.members[i].scope; sc != nil {
+			s.restoreScope(sc, childPath(path, sc.ir.Key), states)
+		}
+	}
+}
+
+func (s *Scheduler) restoreActivation(ss *scope, st ScopeSnapshot) {
+	if !st.Active {
+		if ss.active {
+			if ss.ir.Mode == ir.ScopeModeSequential && ss.activeStep >= 0 {
+				s.deactivateStep(&ss.members[ss.activeStep])
+			}
+			s.deactivateScope(ss)
+		}
+		return
+	}
+	if ss.ir.Mode != ir.ScopeModeSequential {
+		if !ss.active {
+			s.activateScope(ss)
+		}
+		return
+	}
+	idx, ok := ss.memberByKey[st.Step]
+	if !ok {
+		if !ss.active {
+			s.activateScope(ss)
+		}
+		return
+	}
+	if ss.active && ss.activeStep == idx {
+		return
+	}
+	if ss.active && ss.activeStep >= 0 {
+		s.deactivateStep(&ss.members[ss.activeStep])
+	}
+	ss.active = true
+	s.activateSequentialStep(ss, idx)
+}
+
+func childPath(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
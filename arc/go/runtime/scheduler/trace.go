@@ -0,0 +1,121 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/synnaxlabs/x/telem"
+)
+
+// EventKind is the kind of a traced scheduler event.
+type EventKind string
+
+const (
+	// EventNodeExecuted is emitted each time a node runs.
+	EventNodeExecuted EventKind = "node_executed"
+	// EventOutputChanged is emitted when a node reports a change on one of its
+	// outputs.
+	EventOutputChanged EventKind = "output_changed"
+	// EventStageEntered is emitted when a sequential scope enters a step.
+	EventStageEntered EventKind = "stage_entered"
+	// EventStageExited is emitted when a sequential scope leaves a step, either
+	// by transitioning to another step or by exiting the sequence.
+	EventStageExited EventKind = "stage_exited"
+	// EventTransitionEvaluated is emitted when a transition's condition was
+	// marked during a cycle, whether or not the transition fired.
+	EventTransitionEvaluated EventKind = "transition_evaluated"
+)
+
+// Event is a single traced scheduler event. Fields that don't apply to the
+// event's kind are left empty.
+type Event struct {
+	// Kind is the kind of event.
+	Kind EventKind `json:"kind" msgpack:"kind"`
+	// Elapsed is the elapsed time of the cycle the event occurred in.
+	Elapsed telem.TimeSpan `json:"elapsed" msgpack:"elapsed"`
+	// Node is the key of the node that executed, changed an output, or sources
+	// the evaluated transition's condition.
+	Node string `json:"node,omitempty" msgpack:"node,omitempty"`
+	// Output is the name of the changed output, or of the output the evaluated
+	// transition's condition reads.
+	Output string `json:"output,omitempty" msgpack:"output,omitempty"`
+	// Scope is the key of the sequential scope a stage or transition event
+	// belongs to.
+	Scope string `json:"scope,omitempty" msgpack:"scope,omitempty"`
+	// Step is the key of the step entered or exited, or of the step that was
+	// active when a transition was evaluated.
+	Step string `json:"step,omitempty" msgpack:"step,omitempty"`
+	// Target is the key of the step an evaluated transition leads to, or empty if
+	// it exits the sequence.
+	Target string `json:"target,omitempty" msgpack:"target,omitempty"`
+	// Fired is whether an evaluated transition's condition was truthy, causing it
+	// to fire.
+	Fired bool `json:"fired,omitempty" msgpack:"fired,omitempty"`
+}
+
+// Tracer receives the events of a traced scheduler. Trace is called synchronously
+// from within Next, so implementations should return quickly.
+type Tracer interface {
+	Trace(ctx context.Context, event Event)
+}
+
+// TracerFunc adapts an ordinary function to the Tracer interface.
+type TracerFunc func(ctx context.Context, event Event)
+
+// Trace implements Tracer.
+func (f TracerFunc) Trace(ctx context.Context, event Event) { f(ctx, event) }
+
+// SetTracer configures the tracer that receives the scheduler's events. A nil
+// tracer disables tracing, which is the default and adds no per-event overhead.
+func (s *Scheduler) SetTracer(tracer Tracer) { s.tracer = tracer }
+
+// trace stamps event with the current cycle's elapsed time and forwards it to the
+// tracer. Callers must check that the tracer is non-nil before building events.
+func (s *Scheduler) trace(event Event) {
+	event.Elapsed = s.nodeCtx.Elapsed
+	s.tracer.Trace(s.nodeCtx.Context, event)
+}
+
+// traceStepExit traces the exit of a sequential scope's active step.
+func (s *Scheduler) traceStepExit(ss *scope) {
+	if s.tracer == nil || ss.activeStep < 0 {
+		return
+	}
+	s.trace(Event{
+		Kind:  EventStageExited,
+		Scope: ss.ir.Key,
+		Step:  ss.members[ss.activeStep].key,
+	})
+}
+
+// traceTransition traces the evaluation of transition i of a sequential scope.
+func (s *Scheduler) traceTransition(ss *scope, i int, fired bool) {
+	if s.tracer == nil {
+		return
+	}
+	t := ss.ir.Transitions[i]
+	event := Event{
+		Kind:  EventTransitionEvaluated,
+		Scope: ss.ir.Key,
+		Node:  t.On.Node,
+		Fired: fired,
+	}
+	if n := ss.transitionOnNode[i]; n != nil {
+		event.Output = n.outputs[ss.transitionOnOutputIdx[i]].param
+	}
+	if ss.activeStep >= 0 {
+		event.Step = ss.members[ss.activeStep].key
+	}
+	if t.TargetKey != nil {
+		event.Target = *t.TargetKey
+	}
+	s.trace(event)
+}
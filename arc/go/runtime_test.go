@@ -20,6 +20,7 @@ import (
 	"github.com/synnaxlabs/arc/stl/channels"
 	"github.com/synnaxlabs/arc/stl/constant"
 	"github.com/synnaxlabs/arc/stl/control"
+	"github.com/synnaxlabs/arc/stl/dsp"
 	stlerrors "github.com/synnaxlabs/arc/stl/errors"
 	stlmath "github.com/synnaxlabs/arc/stl/math"
 	stlop "github.com/synnaxlabs/arc/stl/op"
@@ -80,6 +81,7 @@ func newRuntimeHarness(
 	stringsMod := MustSucceed(stlstrings.NewHost(ctx, wasmRT, stringsState, nil))
 	mathMod := MustSucceed(stlmath.NewHost(ctx, wasmRT))
 	errorsMod := MustSucceed(stlerrors.NewHost(ctx, wasmRT, nil))
+	dspMod := MustSucceed(dsp.NewHost(ctx, wasmRT))
 
 	factory := node.CompoundFactory{
 		channelMod,
@@ -91,6 +93,7 @@ func newRuntimeHarness(
 		stable.NewHost(),
 		control.NewHost(authorityState),
 		mathMod,
+		dspMod,
 	}
 
 	h := &runtimeHarness{
@@ -111,7 +114,7 @@ func newRuntimeHarness(
 			Module:        guest,
 			Memory:        guest.Memory(),
 			Strings:       stringsState,
-			NodeKeySetter: statefulMod,
+			NodeKeySetter: wasm.NodeKeySetters{statefulMod, dspMod},
 		})
 	}
 
@@ -126,6 +126,7 @@ func (h *Host) Create(_ context.Context, cfg node.Config) (node.Node, error) {
 			State: cfg.State,
 			key:   nodeCfg.Channel,
 			state: h.state,
+			clock: telem.MonoClock{Source: h.state.nowSource()},
 		}, nil
 	}
 	return &sink{State: cfg.State, state: h.state, key: nodeCfg.Channel}, nil
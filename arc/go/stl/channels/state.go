@@ -36,18 +36,35 @@ type ProgramState struct {
 }
 
 // NewProgramState creates a new ProgramState from channel digests.
-func NewProgramState(digests []Digest) *ProgramState {
+func NewProgramState(digests []Digest, opts ...func(*ProgramState)) *ProgramState {
 	cs := &ProgramState{
 		reads:   make(map[uint32]telem.MultiSeries),
 		writes:  make(map[uint32]telem.Series),
 		indexes: make(map[uint32]uint32),
 	}
+	for _, opt := range opts {
+		opt(cs)
+	}
 	for _, d := range digests {
 		cs.indexes[d.Key] = d.Index
 	}
 	return cs
 }
 
+// WithNow overrides the clock used to timestamp indexed channel writes and
+// reads from channels without an index. Nil uses telem.Now.
+func WithNow(fn func() telem.TimeStamp) func(*ProgramState) {
+	return func(cs *ProgramState) { cs.clock.Source = fn }
+}
+
+// nowSource returns the clock override passed with WithNow, if any.
+func (cs *ProgramState) nowSource() func() telem.TimeStamp {
+	if cs == nil {
+		return nil
+	}
+	return cs.clock.Source
+}
+
 // Ingest adds external channel data to the read buffer.
 func (cs *ProgramState) Ingest(fr telem.Frame[uint32]) {
 	for rawI, key := range fr.RawKeys() {
@@ -45,16 +45,34 @@ func NewSymbols() []*symbol.Symbol {
 
 // Host is the runtime host-side support for the constant builtin: a node
 // factory only. No WASM bindings, no per-program state.
-type Host struct{}
+type Host struct {
+	now func() telem.TimeStamp
+}
 
-// NewHost constructs a constant Host.
-func NewHost() *Host { return &Host{} }
+// NewHost constructs a constant Host. By default constants are timestamped
+// with telem.Now; pass WithNow(fn) to override.
+func NewHost(opts ...func(*Host)) *Host {
+	h := &Host{}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// WithNow overrides the clock used to timestamp constant outputs.
+func WithNow(fn func() telem.TimeStamp) func(*Host) {
+	return func(h *Host) { h.now = fn }
+}
 
 func (h *Host) Create(_ context.Context, cfg node.Config) (node.Node, error) {
 	if cfg.Node.Type != symbolName {
 		return nil, query.ErrNotFound
 	}
-	return &constant{State: cfg.State, value: cfg.Node.Config[0].Value}, nil
+	return &constant{
+		State: cfg.State,
+		clock: telem.MonoClock{Source: h.now},
+		value: cfg.Node.Config[0].Value,
+	}, nil
 }
 
 type constant struct {
@@ -0,0 +1,275 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package dsp
+
+import (
+	"math"
+	"slices"
+
+	"github.com/synnaxlabs/x/telem"
+)
+
+// block is the sample-by-sample state machine behind a dsp function. The same
+// implementation backs both the flow node and the WASM host function.
+type block interface {
+	// next consumes one sample of the input signal. args holds the signal
+	// followed by the values of the block's config and auxiliary inputs, in
+	// declaration order; time spans are expressed in seconds. ts is the
+	// timestamp of the sample.
+	next(args []float64, ts telem.TimeStamp) float64
+}
+
+// sampleClock tracks the time elapsed between consecutive samples.
+type sampleClock struct {
+	last    telem.TimeStamp
+	started bool
+}
+
+// step records ts and returns the number of seconds elapsed since the
+// previous sample, along with whether ts is the first sample seen. Samples
+// that do not move time forward report zero elapsed time.
+func (c *sampleClock) step(ts telem.TimeStamp) (dt float64, first bool) {
+	first = !c.started
+	if !first && ts > c.last {
+		dt = telem.TimeSpan(ts - c.last).Seconds()
+	}
+	if first || ts > c.last {
+		c.last = ts
+	}
+	c.started = true
+	return dt, first
+}
+
+func clamp(v, lo, hi float64) float64 { return math.Max(lo, math.Min(hi, v)) }
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// pid is a PID controller with derivative-on-measurement, integral clamping
+// anti-windup, and bumpless manual-to-auto transfer. The integral
+// term is stored with the integral gain already applied so that retuning ki
+// does not bump the output.
+type pid struct {
+	clock     sampleClock
+	integral  float64
+	prevInput float64
+}
+
+func (p *pid) next(args []float64, ts telem.TimeStamp) float64 {
+	var (
+		x, setpoint    = args[0], args[1]
+		kp, ki, kd     = args[2], args[3], args[4]
+		outMin, outMax = args[5], args[6]
+		manual         = args[7] != 0
+		manualOutput   = args[8]
+		e              = setpoint - x
+		dt, first      = p.clock.step(ts)
+		derivative     float64
+	)
+	if !first && dt > 0 {
+		derivative = -(x - p.prevInput) / dt
+	}
+	p.prevInput = x
+	if manual {
+		// Back-calculate the integral so the first automatic output matches
+		// the manual output.
+		p.integral = manualOutput - kp*e - kd*derivative
+		return manualOutput
+	}
+	var (
+		pd       = kp*e + kd*derivative
+		integral = p.integral + ki*e*dt
+	)
+	if outMin < outMax {
+		// Let the integral grow only until the output reaches a limit.
+		if pd+integral > outMax {
+			integral = min(integral, max(p.integral, outMax-pd))
+		} else if pd+integral < outMin {
+			integral = max(integral, min(p.integral, outMin-pd))
+		}
+	}
+	p.integral = integral
+	if outMin < outMax {
+		return clamp(pd+integral, outMin, outMax)
+	}
+	return pd + integral
+}
+
+// lowpass is a first-order low-pass filter with time constant tau.
+type lowpass struct {
+	clock  sampleClock
+	output float64
+}
+
+func (l *lowpass) next(args []float64, ts telem.TimeStamp) float64 {
+	x, tau := args[0], args[1]
+	dt, first := l.clock.step(ts)
+	if first || tau <= 0 {
+		l.output = x
+		return l.output
+	}
+	l.output += dt / (tau + dt) * (x - l.output)
+	return l.output
+}
+
+// highpass is a first-order high-pass filter with time constant tau.
+type highpass struct {
+	clock     sampleClock
+	output    float64
+	prevInput float64
+}
+
+func (h *highpass) next(args []float64, ts telem.TimeStamp) float64 {
+	x, tau := args[0], args[1]
+	dt, first := h.clock.step(ts)
+	if first || tau <= 0 {
+		h.output = 0
+	} else {
+		h.output = tau / (tau + dt) * (h.output + x - h.prevInput)
+	}
+	h.prevInput = x
+	return h.output
+}
+
+// window holds the most recent samples of a signal, up to the size given by
+// args[1].
+type window struct {
+	samples []float64
+}
+
+func (w *window) push(args []float64) {
+	size := max(int(args[1]), 1)
+	w.samples = append(w.samples, args[0])
+	if excess := len(w.samples) - size; excess > 0 {
+		w.samples = slices.Delete(w.samples, 0, excess)
+	}
+}
+
+// movingAvg is the mean of the last N samples.
+type movingAvg struct{ window }
+
+func (m *movingAvg) next(args []float64, _ telem.TimeStamp) float64 {
+	m.push(args)
+	var sum float64
+	for _, v := range m.samples {
+		sum += v
+	}
+	return sum / float64(len(m.samples))
+}
+
+// movingMedian is the median of the last N samples.
+type movingMedian struct {
+	window
+	sorted []float64
+}
+
+func (m *movingMedian) next(args []float64, _ telem.TimeStamp) float64 {
+	m.push(args)
+	m.sorted = append(m.sorted[:0], m.samples...)
+	slices.Sort(m.sorted)
+	mid := len(m.sorted) / 2
+	if len(m.sorted)%2 == 0 {
+		return (m.sorted[mid-1] + m.sorted[mid]) / 2
+	}
+	return m.sorted[mid]
+}
+
+// integrator integrates a signal over time using the trapezoidal rule. The
+// output holds at the initial value while reset is nonzero.
+type integrator struct {
+	clock     sampleClock
+	output    float64
+	prevInput float64
+}
+
+func (i *integrator) next(args []float64, ts telem.TimeStamp) float64 {
+	x, initial, reset := args[0], args[1], args[2] != 0
+	dt, first := i.clock.step(ts)
+	if first || reset {
+		i.output = initial
+	} else {
+		i.output += (x + i.prevInput) / 2 * dt
+	}
+	i.prevInput = x
+	return i.output
+}
+
+// rateLimit bounds how fast the output can follow the input, in units per
+// second. A non-positive fall rate reuses the rise rate, and a non-positive
+// rise rate disables limiting.
+type rateLimit struct {
+	clock  sampleClock
+	output float64
+}
+
+func (r *rateLimit) next(args []float64, ts telem.TimeStamp) float64 {
+	x, rise, fall := args[0], args[1], args[2]
+	dt, first := r.clock.step(ts)
+	if first || rise <= 0 {
+		r.output = x
+		return r.output
+	}
+	if fall <= 0 {
+		fall = rise
+	}
+	r.output += clamp(x-r.output, -fall*dt, rise*dt)
+	return r.output
+}
+
+// hysteresis is a two-threshold comparator. The output switches on when the
+// input reaches high and off when it falls to low.
+type hysteresis struct{ on bool }
+
+func (h *hysteresis) next(args []float64, _ telem.TimeStamp) float64 {
+	x, low, high := args[0], args[1], args[2]
+	if x >= high {
+		h.on = true
+	} else if x <= low {
+		h.on = false
+	}
+	return boolToFloat(h.on)
+}
+
+// deadband holds its output until the input moves more than width away
+// from it.
+type deadband struct {
+	output  float64
+	started bool
+}
+
+func (d *deadband) next(args []float64, _ telem.TimeStamp) float64 {
+	x, width := args[0], args[1]
+	if !d.started || math.Abs(x-d.output) > width {
+		d.output = x
+		d.started = true
+	}
+	return d.output
+}
+
+// edge detects transitions of a signal between zero and nonzero. The signal
+// is considered zero before the first sample.
+type edge struct {
+	rising bool
+	prev   bool
+}
+
+func (e *edge) next(args []float64, _ telem.TimeStamp) float64 {
+	cur := args[0] != 0
+	fired := cur && !e.prev
+	if !e.rising {
+		fired = !cur && e.prev
+	}
+	e.prev = cur
+	return boolToFloat(fired)
+}
@@ -0,0 +1,399 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package dsp implements the signal processing and control blocks of the Arc
+// standard library: PID control, filters, moving windows, integration, rate
+// limiting, hysteresis, deadbands, and edge detection. Every block is usable
+// as a flow node, where the upstream edge feeds the signal and the config
+// block sets its parameters, and as a function call inside a func body, where
+// the signal and parameters are passed as arguments.
+package dsp
+
+import (
+	"context"
+	"math"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/runtime/node"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/lsp/doc"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/telem"
+)
+
+const name = "dsp"
+
+var numConstraint = types.NumericConstraint()
+
+// spec describes a dsp block. The block's inputs are the signal, followed by
+// a mirror of its config params, followed by its auxiliary inputs. In flow
+// form the config params are set through the config block and the auxiliary
+// inputs are optional edges; in func form all of them are call arguments.
+type spec struct {
+	name     string
+	doc      doc.Doc
+	config   types.Params
+	aux      types.Params
+	output   types.Type
+	newBlock func() block
+}
+
+// inputs returns the full input list of the block, with the signal typed as
+// signal.
+func (s spec) inputs(signal types.Type) types.Params {
+	inputs := types.Params{{Name: ir.DefaultInputParam, Type: signal}}
+	inputs = append(inputs, s.config...)
+	return append(inputs, s.aux...)
+}
+
+func (s spec) symbol() *symbol.Symbol {
+	return &symbol.Symbol{
+		Name:     s.name,
+		Kind:     symbol.KindFunction,
+		Exec:     symbol.ExecBoth,
+		Stateful: true,
+		Type: types.Function(types.FunctionProperties{
+			Config:  s.config,
+			Inputs:  s.inputs(types.Variable("T", &numConstraint)),
+			Outputs: types.Params{{Name: ir.DefaultOutputParam, Type: s.output}},
+		}),
+		Doc: s.doc,
+	}
+}
+
+func blockDoc(desc, flow, fn string) doc.Doc {
+	return doc.New(
+		doc.Paragraph(desc),
+		doc.Divider(),
+		doc.Code("arc", flow),
+		doc.Divider(),
+		doc.Paragraph("Inside a func block, pass the parameters as arguments:"),
+		doc.Divider(),
+		doc.Code("arc", fn),
+	)
+}
+
+var specs = []spec{
+	{
+		name: "pid",
+		doc: blockDoc(
+			"PID controller with anti-windup and bumpless transfer. The output is "+
+				"clamped to [out_min, out_max] and the integral only accumulates "+
+				"until the output reaches a limit (limits are disabled when "+
+				"out_min >= out_max). While manual is nonzero the output follows "+
+				"manual_output, and the controller resumes from that value when "+
+				"manual returns to zero.",
+			"pressure -> dsp.pid{setpoint=50, kp=2, ki=0.5, out_min=0, out_max=100} -> valve_cmd",
+			"valve_cmd = dsp.pid(pressure, 50.0, 2.0, 0.5)",
+		),
+		config: types.Params{
+			{Name: "setpoint", Type: types.F64()},
+			{Name: "kp", Type: types.F64(), Value: 1.0},
+			{Name: "ki", Type: types.F64(), Value: 0.0},
+			{Name: "kd", Type: types.F64(), Value: 0.0},
+			{Name: "out_min", Type: types.F64(), Value: 0.0},
+			{Name: "out_max", Type: types.F64(), Value: 0.0},
+		},
+		aux: types.Params{
+			{Name: "manual", Type: types.U8(), Value: uint8(0)},
+			{Name: "manual_output", Type: types.F64(), Value: 0.0},
+		},
+		output:   types.F64(),
+		newBlock: func() block { return &pid{} },
+	},
+	{
+		name: "lowpass",
+		doc: blockDoc(
+			"First-order low-pass filter with time constant tau.",
+			"sensor -> dsp.lowpass{tau=500ms} -> filtered",
+			"filtered = dsp.lowpass(sensor, 500ms)",
+		),
+		config:   types.Params{{Name: "tau", Type: types.TimeSpan()}},
+		output:   types.F64(),
+		newBlock: func() block { return &lowpass{} },
+	},
+	{
+		name: "highpass",
+		doc: blockDoc(
+			"First-order high-pass filter with time constant tau.",
+			"sensor -> dsp.highpass{tau=2s} -> ac_component",
+			"ac_component = dsp.highpass(sensor, 2s)",
+		),
+		config:   types.Params{{Name: "tau", Type: types.TimeSpan()}},
+		output:   types.F64(),
+		newBlock: func() block { return &highpass{} },
+	},
+	{
+		name: "moving_avg",
+		doc: blockDoc(
+			"Mean of the last window samples.",
+			"sensor -> dsp.moving_avg{window=10} -> smoothed",
+			"smoothed = dsp.moving_avg(sensor, 10)",
+		),
+		config:   types.Params{{Name: "window", Type: types.I64()}},
+		output:   types.F64(),
+		newBlock: func() block { return &movingAvg{} },
+	},
+	{
+		name: "moving_median",
+		doc: blockDoc(
+			"Median of the last window samples. Useful for rejecting spikes.",
+			"sensor -> dsp.moving_median{window=5} -> despiked",
+			"despiked = dsp.moving_median(sensor, 5)",
+		),
+		config:   types.Params{{Name: "window", Type: types.I64()}},
+		output:   types.F64(),
+		newBlock: func() block { return &movingMedian{} },
+	},
+	{
+		name: "integrator",
+		doc: blockDoc(
+			"Integrates the input over time (per second) using the trapezoidal "+
+				"rule, starting from initial. The output holds at initial while "+
+				"reset is nonzero.",
+			"flow_rate -> dsp.integrator{} -> total_volume",
+			"total_volume = dsp.integrator(flow_rate)",
+		),
+		config: types.Params{{Name: "initial", Type: types.F64(), Value: 0.0}},
+		aux: types.Params{
+			{Name: "reset", Type: types.U8(), Value: uint8(0)},
+		},
+		output:   types.F64(),
+		newBlock: func() block { return &integrator{} },
+	},
+	{
+		name: "rate_limit",
+		doc: blockDoc(
+			"Limits how fast the output follows the input, in units per second. "+
+				"fall_rate defaults to rate.",
+			"setpoint -> dsp.rate_limit{rate=5, fall_rate=10} -> ramped",
+			"ramped = dsp.rate_limit(setpoint, 5.0, 10.0)",
+		),
+		config: types.Params{
+			{Name: "rate", Type: types.F64()},
+			{Name: "fall_rate", Type: types.F64(), Value: 0.0},
+		},
+		output:   types.F64(),
+		newBlock: func() block { return &rateLimit{} },
+	},
+	{
+		name: "hysteresis",
+		doc: blockDoc(
+			"Outputs 1 once the input reaches high, and 0 once it falls to low.",
+			"temperature -> dsp.hysteresis{low=68, high=72} -> heater_off",
+			"heater_off = dsp.hysteresis(temperature, 68.0, 72.0)",
+		),
+		config: types.Params{
+			{Name: "low", Type: types.F64()},
+			{Name: "high", Type: types.F64()},
+		},
+		output:   types.U8(),
+		newBlock: func() block { return &hysteresis{} },
+	},
+	{
+		name: "deadband",
+		doc: blockDoc(
+			"Holds the output until the input moves more than width away from it.",
+			"sensor -> dsp.deadband{width=0.5} -> stable",
+			"stable = dsp.deadband(sensor, 0.5)",
+		),
+		config:   types.Params{{Name: "width", Type: types.F64()}},
+		output:   types.F64(),
+		newBlock: func() block { return &deadband{} },
+	},
+	{
+		name: "rising_edge",
+		doc: blockDoc(
+			"Outputs 1 for each sample where the input changes from zero to nonzero, and 0 otherwise.",
+			"start_button -> dsp.rising_edge{} -> start_pulse",
+			"pulse = dsp.rising_edge(start_button)",
+		),
+		output:   types.U8(),
+		newBlock: func() block { return &edge{rising: true} },
+	},
+	{
+		name: "falling_edge",
+		doc: blockDoc(
+			"Outputs 1 for each sample where the input changes from nonzero to zero, and 0 otherwise.",
+			"pump_running -> dsp.falling_edge{} -> pump_stopped",
+			"stopped = dsp.falling_edge(pump_running)",
+		),
+		output:   types.U8(),
+		newBlock: func() block { return &edge{rising: false} },
+	},
+}
+
+var moduleDoc = doc.New(
+	doc.Paragraph("Signal processing and control blocks: PID control, filters, moving windows, integration, rate limiting, hysteresis, deadbands, and edge detection."),
+)
+
+// NewSymbols returns a fresh slice of ambient prelude symbols this package
+// contributes: the dsp module.
+func NewSymbols() []*symbol.Symbol {
+	mod := &symbol.Symbol{Name: name, Kind: symbol.KindModule, Doc: moduleDoc}
+	for _, s := range specs {
+		mod.AddChild(s.symbol())
+	}
+	return []*symbol.Symbol{mod}
+}
+
+// Host is the runtime host-side support for the dsp module: it registers the
+// WASM host bindings used by func-form calls, holds the state of every call
+// site, and acts as the node factory for flow-form blocks. Host implements
+// the wasm package's NodeKeySetter and SampleTimeSetter interfaces so that
+// call-site state is scoped to the executing node.
+type Host struct {
+	nodeKey    string
+	sampleTime telem.TimeStamp
+	blocks     map[string]map[uint32]block
+}
+
+// SetNodeKey sets the active node key so subsequent calls scope their state
+// to the right node. Called by the runtime when entering a node.
+func (h *Host) SetNodeKey(key string) { h.nodeKey = key }
+
+// ClearNode discards the state of every call site in the node with the given
+// key. Called by the runtime when the stage containing the node is activated.
+func (h *Host) ClearNode(key string) { delete(h.blocks, key) }
+
+// SetSampleTime sets the timestamp of the sample being processed, which
+// time-dependent blocks use to compute elapsed time.
+func (h *Host) SetSampleTime(ts telem.TimeStamp) { h.sampleTime = ts }
+
+func (h *Host) block(s *spec, site uint32) block {
+	sites, ok := h.blocks[h.nodeKey]
+	if !ok {
+		sites = make(map[uint32]block)
+		h.blocks[h.nodeKey] = sites
+	}
+	b, ok := sites[site]
+	if !ok {
+		b = s.newBlock()
+		sites[site] = b
+	}
+	return b
+}
+
+var signalTypes = []types.Type{
+	types.U8(), types.U16(), types.U32(), types.U64(),
+	types.I8(), types.I16(), types.I32(), types.I64(),
+	types.F32(), types.F64(),
+}
+
+// NewHost registers the dsp module's WASM host bindings with rt and returns a
+// Host that acts as the node factory for flow-form blocks. Each block is
+// exported once per signal type as <block>_<type>, taking the call site key
+// followed by the block's inputs.
+func NewHost(ctx context.Context, rt wazero.Runtime) (*Host, error) {
+	h := &Host{blocks: make(map[string]map[uint32]block)}
+	if rt == nil {
+		return h, nil
+	}
+	builder := rt.NewHostModuleBuilder(name)
+	for i := range specs {
+		for _, t := range signalTypes {
+			builder = bindBlock(builder, h, &specs[i], t)
+		}
+	}
+	if _, err := builder.Instantiate(ctx); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func bindBlock(
+	builder wazero.HostModuleBuilder,
+	h *Host,
+	s *spec,
+	signal types.Type,
+) wazero.HostModuleBuilder {
+	inputs := s.inputs(signal)
+	params := make([]api.ValueType, 0, len(inputs)+1)
+	params = append(params, api.ValueTypeI32)
+	for _, p := range inputs {
+		params = append(params, valueType(p.Type))
+	}
+	args := make([]float64, len(inputs))
+	fn := api.GoFunc(func(_ context.Context, stack []uint64) {
+		b := h.block(s, uint32(stack[0]))
+		for i, p := range inputs {
+			args[i] = decode(p.Type, stack[i+1])
+		}
+		stack[0] = encode(s.output, b.next(args, h.sampleTime))
+	})
+	return builder.NewFunctionBuilder().
+		WithGoFunction(fn, params, []api.ValueType{valueType(s.output)}).
+		Export(s.name + "_" + signal.String())
+}
+
+func valueType(t types.Type) api.ValueType {
+	switch t.Kind {
+	case types.KindI64, types.KindU64:
+		return api.ValueTypeI64
+	case types.KindF32:
+		return api.ValueTypeF32
+	case types.KindF64:
+		return api.ValueTypeF64
+	default:
+		return api.ValueTypeI32
+	}
+}
+
+// decode converts a WASM value of type t to a float64, expressing time spans
+// in seconds.
+func decode(t types.Type, v uint64) float64 {
+	switch t.Kind {
+	case types.KindU8:
+		return float64(uint8(v))
+	case types.KindU16:
+		return float64(uint16(v))
+	case types.KindU32:
+		return float64(uint32(v))
+	case types.KindU64:
+		return float64(v)
+	case types.KindI8:
+		return float64(int8(v))
+	case types.KindI16:
+		return float64(int16(v))
+	case types.KindI32:
+		return float64(int32(v))
+	case types.KindI64:
+		if isSpan(t) {
+			return telem.TimeSpan(int64(v)).Seconds()
+		}
+		return float64(int64(v))
+	case types.KindF32:
+		return float64(math.Float32frombits(uint32(v)))
+	default:
+		return math.Float64frombits(v)
+	}
+}
+
+func encode(t types.Type, v float64) uint64 {
+	if t.Kind == types.KindU8 {
+		return uint64(uint8(v))
+	}
+	return math.Float64bits(v)
+}
+
+func isSpan(t types.Type) bool { return types.Equal(t, types.TimeSpan()) }
+
+// Create implements node.Factory, instantiating flow-form blocks.
+func (h *Host) Create(_ context.Context, cfg node.Config) (node.Node, error) {
+	for i := range specs {
+		if specs[i].name == cfg.Node.Type {
+			return newBlockNode(&specs[i], cfg)
+		}
+	}
+	return nil, query.ErrNotFound
+}
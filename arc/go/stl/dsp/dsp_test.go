@@ -0,0 +1,347 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package dsp_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/graph"
+	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/program"
+	"github.com/synnaxlabs/arc/runtime/node"
+	"github.com/synnaxlabs/arc/stl/dsp"
+	"github.com/synnaxlabs/arc/stl/testutil"
+	"github.com/synnaxlabs/arc/symbol"
+	. "github.com/synnaxlabs/arc/symbol/testutil"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+type blockSetup struct {
+	state *node.ProgramState
+	n     node.Node
+}
+
+// openBlock instantiates the dsp block nodeType as a flow node fed by an f64
+// "input" node. Each entry in aux connects a source node of the same name to
+// the block's auxiliary input with that name.
+func openBlock(
+	ctx SpecContext,
+	nodeType string,
+	config map[string]any,
+	aux ...string,
+) blockSetup {
+	g := graph.Graph{
+		Nodes: []graph.Node{
+			{Key: "input", Type: "input"},
+			{Key: "dsp", Type: "dsp." + nodeType, Config: config},
+		},
+		Edges: []graph.Edge{{
+			Source: ir.Handle{Node: "input", Param: ir.DefaultOutputParam},
+			Target: ir.Handle{Node: "dsp", Param: ir.DefaultInputParam},
+		}},
+		Functions: []graph.Function{{
+			Key:     "input",
+			Outputs: types.Params{{Name: ir.DefaultOutputParam, Type: types.F64()}},
+		}},
+	}
+	for _, name := range aux {
+		g.Nodes = append(g.Nodes, graph.Node{Key: name, Type: name})
+		g.Edges = append(g.Edges, graph.Edge{
+			Source: ir.Handle{Node: name, Param: ir.DefaultOutputParam},
+			Target: ir.Handle{Node: "dsp", Param: name},
+		})
+		g.Functions = append(g.Functions, graph.Function{
+			Key:     name,
+			Outputs: types.Params{{Name: ir.DefaultOutputParam, Type: types.U8()}},
+		})
+	}
+	analyzed, diagnostics := graph.Analyze(ctx, g, NewGraphRoot(nil))
+	Expect(diagnostics.Ok()).To(BeTrue(), diagnostics.String())
+	s := node.New(analyzed)
+	irNode := analyzed.Nodes.Get("dsp")
+	irNode.Type = nodeType
+	h := MustSucceed(dsp.NewHost(ctx, nil))
+	n := MustSucceed(h.Create(ctx, node.Config{
+		Node:    irNode,
+		State:   s.Node("dsp"),
+		Program: program.Program{IR: analyzed},
+	}))
+	return blockSetup{state: s, n: n}
+}
+
+func (b blockSetup) feed(ctx SpecContext, values []float64, seconds ...telem.TimeStamp) {
+	input := b.state.Node("input")
+	*input.Output(0) = telem.NewSeriesV(values...)
+	*input.OutputTime(0) = telem.NewSeriesSecondsTSV(seconds...)
+	b.n.Next(node.Context{Context: ctx, MarkChanged: func(int) {}})
+}
+
+func (b blockSetup) set(source string, value uint8, seconds telem.TimeStamp) {
+	n := b.state.Node(source)
+	*n.Output(0) = telem.NewSeriesV(value)
+	*n.OutputTime(0) = telem.NewSeriesSecondsTSV(seconds)
+}
+
+func (b blockSetup) output() []float64 {
+	return telem.UnmarshalSeries[float64](*b.state.Node("dsp").Output(0))
+}
+
+func (b blockSetup) flags() []uint8 {
+	return telem.UnmarshalSeries[uint8](*b.state.Node("dsp").Output(0))
+}
+
+func expectClose(actual []float64, expected ...float64) {
+	ExpectWithOffset(1, actual).To(HaveLen(len(expected)))
+	for i, v := range expected {
+		ExpectWithOffset(1, actual[i]).To(BeNumerically("~", v, 1e-6), "sample %d", i)
+	}
+}
+
+var _ = Describe("DSP", func() {
+	Describe("Symbols", func() {
+		var root *symbol.Symbol
+		BeforeEach(func() { root = symbol.NewRoot(nil, dsp.NewSymbols()) })
+
+		It("Should expose every block as a stateful ExecBoth function", func(ctx SpecContext) {
+			mod := MustSucceed(root.Resolve(ctx, "dsp", symbol.IncludeInternal))
+			for _, name := range []string{
+				"pid", "lowpass", "highpass", "moving_avg", "moving_median",
+				"integrator", "rate_limit", "hysteresis", "deadband",
+				"rising_edge", "falling_edge",
+			} {
+				sym := MustSucceed(mod.Resolve(ctx, name))
+				Expect(sym.Exec).To(Equal(symbol.ExecBoth), name)
+				Expect(sym.Stateful).To(BeTrue(), name)
+			}
+		})
+
+		It("Should expose only the signal and auxiliary inputs in flow form", func(ctx SpecContext) {
+			mod := MustSucceed(root.Resolve(ctx, "dsp", symbol.IncludeInternal))
+			pid := MustSucceed(mod.Resolve(ctx, "pid"))
+			names := make([]string, 0)
+			for _, p := range pid.FlowInputs(pid.Type) {
+				names = append(names, p.Name)
+			}
+			Expect(names).To(Equal([]string{ir.DefaultInputParam, "manual", "manual_output"}))
+			Expect(pid.Type.Inputs).To(HaveLen(9))
+		})
+	})
+
+	Describe("Factory", func() {
+		It("Should return ErrNotFound for an unknown node type", func(ctx SpecContext) {
+			h := MustSucceed(dsp.NewHost(ctx, nil))
+			_, err := h.Create(ctx, node.Config{Node: ir.Node{Type: "unknown"}})
+			Expect(err).To(MatchError(query.ErrNotFound))
+		})
+	})
+
+	Describe("pid", func() {
+		It("Should compute proportional and integral action", func(ctx SpecContext) {
+			b := openBlock(ctx, "pid", map[string]any{"setpoint": 10.0, "kp": 2.0, "ki": 1.0})
+			b.feed(ctx, []float64{8, 8, 9}, 1, 2, 3)
+			expectClose(b.output(), 4, 6, 5)
+		})
+
+		It("Should not kick the output when the setpoint is constant and the measurement is steady", func(ctx SpecContext) {
+			b := openBlock(ctx, "pid", map[string]any{"setpoint": 0.0, "kp": 0.0, "kd": 1.0})
+			b.feed(ctx, []float64{5, 5, 7}, 1, 2, 3)
+			expectClose(b.output(), 0, 0, -2)
+		})
+
+		It("Should clamp the output and stop integrating while saturated", func(ctx SpecContext) {
+			b := openBlock(ctx, "pid", map[string]any{
+				"setpoint": 10.0, "kp": 1.0, "ki": 1.0, "out_min": 0.0, "out_max": 12.0,
+			})
+			b.feed(ctx, []float64{0, 0, 0, 0}, 1, 2, 3, 4)
+			expectClose(b.output(), 10, 12, 12, 12)
+			b.feed(ctx, []float64{11}, 5)
+			// Without anti-windup the integral would be 30 and the output would
+			// stay saturated.
+			expectClose(b.output(), 0)
+		})
+
+		It("Should track the manual output and transfer back without a bump", func(ctx SpecContext) {
+			b := openBlock(ctx, "pid", map[string]any{"setpoint": 10.0, "kp": 1.0, "ki": 1.0}, "manual")
+			b.set("manual", 1, 1)
+			b.feed(ctx, []float64{5}, 1)
+			expectClose(b.output(), 0)
+			b.set("manual", 0, 2)
+			b.feed(ctx, []float64{5}, 2)
+			expectClose(b.output(), 5)
+		})
+	})
+
+	Describe("lowpass", func() {
+		It("Should smooth a step with the configured time constant", func(ctx SpecContext) {
+			b := openBlock(ctx, "lowpass", map[string]any{"tau": telem.Second})
+			b.feed(ctx, []float64{0, 10, 10}, 1, 2, 3)
+			expectClose(b.output(), 0, 5, 7.5)
+		})
+
+		It("Should restart from the first sample after a reset", func(ctx SpecContext) {
+			b := openBlock(ctx, "lowpass", map[string]any{"tau": telem.Second})
+			b.feed(ctx, []float64{0, 10}, 1, 2)
+			b.n.Reset()
+			b.feed(ctx, []float64{4}, 3)
+			expectClose(b.output(), 4)
+		})
+	})
+
+	Describe("highpass", func() {
+		It("Should pass changes and decay towards zero", func(ctx SpecContext) {
+			b := openBlock(ctx, "highpass", map[string]any{"tau": telem.Second})
+			b.feed(ctx, []float64{0, 10, 10}, 1, 2, 3)
+			expectClose(b.output(), 0, 5, 2.5)
+		})
+	})
+
+	Describe("moving windows", func() {
+		It("Should average the last N samples", func(ctx SpecContext) {
+			b := openBlock(ctx, "moving_avg", map[string]any{"window": int64(3)})
+			b.feed(ctx, []float64{3, 6, 9, 12}, 1, 2, 3, 4)
+			expectClose(b.output(), 3, 4.5, 6, 9)
+		})
+
+		It("Should take the median of the last N samples", func(ctx SpecContext) {
+			b := openBlock(ctx, "moving_median", map[string]any{"window": int64(3)})
+			b.feed(ctx, []float64{1, 100, 2, 3}, 1, 2, 3, 4)
+			expectClose(b.output(), 1, 50.5, 2, 3)
+		})
+	})
+
+	Describe("integrator", func() {
+		It("Should integrate using the trapezoidal rule", func(ctx SpecContext) {
+			b := openBlock(ctx, "integrator", map[string]any{"initial": 1.0})
+			b.feed(ctx, []float64{2, 2, 4}, 1, 2, 3)
+			expectClose(b.output(), 1, 3, 6)
+		})
+
+		It("Should hold at the initial value while reset is set", func(ctx SpecContext) {
+			b := openBlock(ctx, "integrator", nil, "reset")
+			b.set("reset", 0, 1)
+			b.feed(ctx, []float64{2, 2}, 1, 2)
+			expectClose(b.output(), 0, 2)
+			b.set("reset", 1, 3)
+			b.feed(ctx, []float64{2}, 3)
+			expectClose(b.output(), 0)
+		})
+	})
+
+	Describe("rate_limit", func() {
+		It("Should limit rising and falling rates separately", func(ctx SpecContext) {
+			b := openBlock(ctx, "rate_limit", map[string]any{"rate": 2.0, "fall_rate": 5.0})
+			b.feed(ctx, []float64{0, 10, 10, 0}, 1, 2, 3, 4)
+			expectClose(b.output(), 0, 2, 4, 0)
+		})
+	})
+
+	Describe("hysteresis", func() {
+		It("Should switch on at high and off at low", func(ctx SpecContext) {
+			b := openBlock(ctx, "hysteresis", map[string]any{"low": 1.0, "high": 3.0})
+			b.feed(ctx, []float64{2, 3, 2, 1, 2}, 1, 2, 3, 4, 5)
+			Expect(b.flags()).To(Equal([]uint8{0, 1, 1, 0, 0}))
+		})
+	})
+
+	Describe("deadband", func() {
+		It("Should ignore changes within the band", func(ctx SpecContext) {
+			b := openBlock(ctx, "deadband", map[string]any{"width": 1.0})
+			b.feed(ctx, []float64{5, 5.5, 6.5, 6}, 1, 2, 3, 4)
+			expectClose(b.output(), 5, 5, 6.5, 6.5)
+		})
+	})
+
+	Describe("edge detection", func() {
+		It("Should detect rising edges", func(ctx SpecContext) {
+			b := openBlock(ctx, "rising_edge", nil)
+			b.feed(ctx, []float64{0, 1, 1, 0, 1}, 1, 2, 3, 4, 5)
+			Expect(b.flags()).To(Equal([]uint8{0, 1, 0, 0, 1}))
+		})
+
+		It("Should detect falling edges", func(ctx SpecContext) {
+			b := openBlock(ctx, "falling_edge", nil)
+			b.feed(ctx, []float64{1, 1, 0, 0, 1}, 1, 2, 3, 4, 5)
+			Expect(b.flags()).To(Equal([]uint8{0, 0, 1, 0, 0}))
+		})
+	})
+
+	Describe("WASM", func() {
+		var (
+			rt *testutil.Runtime
+			h  *dsp.Host
+		)
+
+		BeforeEach(func(ctx SpecContext) {
+			rt = testutil.NewRuntime(ctx)
+			h = MustSucceed(dsp.NewHost(ctx, rt.Underlying()))
+			rt.Passthrough(ctx, "dsp")
+			h.SetNodeKey("node")
+		})
+
+		AfterEach(func(ctx SpecContext) {
+			Expect(rt.Close(ctx)).To(Succeed())
+		})
+
+		movingAvg := func(ctx context.Context, site uint32, v float64) float64 {
+			res := rt.Call(ctx, "dsp", "moving_avg_f64", testutil.U32(site), testutil.F64(v), testutil.I64(2))
+			return testutil.AsF64(res[0])
+		}
+
+		It("Should export a binding for every block and signal type", func() {
+			defs := rt.Underlying().Module("dsp").ExportedFunctionDefinitions()
+			Expect(defs).To(HaveLen(11 * 10))
+			Expect(defs).To(HaveKey("pid_f64"))
+			Expect(defs).To(HaveKey("lowpass_i32"))
+			Expect(defs).To(HaveKey("rising_edge_u8"))
+		})
+
+		It("Should keep separate state for every call site", func(ctx SpecContext) {
+			Expect(movingAvg(ctx, 0, 2)).To(Equal(2.0))
+			Expect(movingAvg(ctx, 1, 10)).To(Equal(10.0))
+			Expect(movingAvg(ctx, 0, 4)).To(Equal(3.0))
+			Expect(movingAvg(ctx, 1, 20)).To(Equal(15.0))
+		})
+
+		It("Should keep separate state for every node", func(ctx SpecContext) {
+			Expect(movingAvg(ctx, 0, 2)).To(Equal(2.0))
+			h.SetNodeKey("other")
+			Expect(movingAvg(ctx, 0, 10)).To(Equal(10.0))
+			h.SetNodeKey("node")
+			Expect(movingAvg(ctx, 0, 4)).To(Equal(3.0))
+		})
+
+		It("Should discard a node's state when it is cleared", func(ctx SpecContext) {
+			Expect(movingAvg(ctx, 0, 2)).To(Equal(2.0))
+			h.ClearNode("node")
+			Expect(movingAvg(ctx, 0, 4)).To(Equal(4.0))
+		})
+
+		It("Should use the sample time for time-dependent blocks", func(ctx SpecContext) {
+			lowpass := func(v float64) float64 {
+				res := rt.Call(ctx, "dsp", "lowpass_f64", testutil.U32(0), testutil.F64(v), testutil.I64(int64(telem.Second)))
+				return testutil.AsF64(res[0])
+			}
+			h.SetSampleTime(0)
+			Expect(lowpass(0)).To(Equal(0.0))
+			h.SetSampleTime(telem.SecondTS)
+			Expect(lowpass(10)).To(BeNumerically("~", 5, 1e-9))
+		})
+
+		It("Should return u8 outputs for boolean blocks", func(ctx SpecContext) {
+			res := rt.Call(ctx, "dsp", "hysteresis_i32", testutil.U32(0), testutil.I32(5), testutil.F64(1), testutil.F64(3))
+			Expect(testutil.AsU32(res[0])).To(Equal(uint32(1)))
+		})
+	})
+})
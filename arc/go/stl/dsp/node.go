@@ -0,0 +1,154 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package dsp
+
+import (
+	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/runtime/node"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/zyn"
+)
+
+// blockNode runs a block as a flow node. Input 0 is the signal and inputs
+// 1..n are the block's auxiliary inputs. Auxiliary inputs hold their most
+// recent value, so a connected reset or manual input acts on its level
+// rather than on its edges.
+type blockNode struct {
+	*node.State
+	spec  *spec
+	block block
+	// args holds the signal, the parsed config values, and the auxiliary
+	// input values, in the order the block expects them.
+	args []float64
+}
+
+var _ node.Node = (*blockNode)(nil)
+
+func newBlockNode(s *spec, cfg node.Config) (node.Node, error) {
+	args := make([]float64, 1+len(s.config)+len(s.aux))
+	for i, p := range s.config {
+		value := p.Value
+		if provided, ok := cfg.Node.Config.Get(p.Name); ok && provided.Value != nil {
+			value = provided.Value
+		}
+		if value == nil {
+			return nil, errors.Newf("%s.%s: missing required config parameter %q", name, s.name, p.Name)
+		}
+		v, err := parseConfigValue(p.Name, value, isSpan(p.Type))
+		if err != nil {
+			return nil, err
+		}
+		args[1+i] = v
+	}
+	for i, p := range s.aux {
+		if _, found := cfg.Program.Edges.FindByTarget(ir.Handle{
+			Node:  cfg.Node.Key,
+			Param: p.Name,
+		}); found {
+			cfg.State.InitInput(
+				1+i,
+				telem.NewSeriesFromAny(p.Value, types.ToTelem(p.Type)),
+				telem.NewSeriesV[telem.TimeStamp](1),
+			)
+		}
+	}
+	return &blockNode{State: cfg.State, spec: s, block: s.newBlock(), args: args}, nil
+}
+
+func parseConfigValue(param string, value any, span bool) (float64, error) {
+	if span {
+		var ns int64
+		if err := zyn.Int64().Coerce().Parse(value, &ns); err != nil {
+			return 0, errors.Wrapf(err, "invalid %s", param)
+		}
+		return telem.TimeSpan(ns).Seconds(), nil
+	}
+	var v float64
+	if err := zyn.Number().Float64().Coerce().Parse(value, &v); err != nil {
+		return 0, errors.Wrapf(err, "invalid %s", param)
+	}
+	return v, nil
+}
+
+func (n *blockNode) Reset() {
+	n.State.Reset()
+	n.block = n.spec.newBlock()
+}
+
+func (n *blockNode) Next(ctx node.Context) {
+	if !n.RefreshInputs() {
+		return
+	}
+	input := n.Input(0)
+	inputTime := n.InputTime(0)
+	length := int(input.Len())
+	if length == 0 || inputTime.Len() == 0 {
+		return
+	}
+	var (
+		auxStart   = 1 + len(n.spec.config)
+		values     = make([]float64, length)
+		timestamps = make([]telem.TimeStamp, length)
+	)
+	for i := range length {
+		n.args[0] = sampleAt(input, i)
+		for j := range n.spec.aux {
+			aux := n.Input(1 + j)
+			n.args[auxStart+j] = sampleAt(aux, min(i, int(aux.Len())-1))
+		}
+		timestamps[i] = telem.ValueAt[telem.TimeStamp](inputTime, min(i, int(inputTime.Len())-1))
+		values[i] = n.block.next(n.args, timestamps[i])
+	}
+	if n.spec.output.Kind == types.KindU8 {
+		flags := make([]uint8, length)
+		for i, v := range values {
+			flags[i] = uint8(v)
+		}
+		*n.Output(0) = telem.NewSeriesV(flags...)
+	} else {
+		*n.Output(0) = telem.NewSeriesV(values...)
+	}
+	*n.OutputTime(0) = telem.NewSeriesV(timestamps...)
+	n.Output(0).Alignment = input.Alignment
+	n.Output(0).TimeRange = input.TimeRange
+	n.OutputTime(0).Alignment = input.Alignment
+	n.OutputTime(0).TimeRange = input.TimeRange
+	ctx.MarkChanged(0)
+}
+
+// sampleAt returns the i-th sample of a numeric series as a float64.
+func sampleAt(s telem.Series, i int) float64 {
+	switch s.DataType {
+	case telem.Float64T:
+		return telem.ValueAt[float64](s, i)
+	case telem.Float32T:
+		return float64(telem.ValueAt[float32](s, i))
+	case telem.Int64T:
+		return float64(telem.ValueAt[int64](s, i))
+	case telem.Int32T:
+		return float64(telem.ValueAt[int32](s, i))
+	case telem.Int16T:
+		return float64(telem.ValueAt[int16](s, i))
+	case telem.Int8T:
+		return float64(telem.ValueAt[int8](s, i))
+	case telem.Uint64T:
+		return float64(telem.ValueAt[uint64](s, i))
+	case telem.Uint32T:
+		return float64(telem.ValueAt[uint32](s, i))
+	case telem.Uint16T:
+		return float64(telem.ValueAt[uint16](s, i))
+	case telem.Uint8T:
+		return float64(telem.ValueAt[uint8](s, i))
+	default:
+		return 0
+	}
+}
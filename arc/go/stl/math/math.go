@@ -28,6 +28,7 @@ import (
 )
 
 const (
+	absSymbolName        = "abs"
 	avgSymbolName        = "avg"
 	countConfigParam     = "count"
 	derivativeSymbolName = "derivative"
@@ -112,11 +113,117 @@ var (
 		doc.Divider(),
 		doc.Code("arc", "sensor -> math.derivative{} -> rate_output"),
 	)
+	absDoc = doc.New(
+		doc.Paragraph("Returns the absolute value of x."),
+		doc.Divider(),
+		doc.Code("arc", "error := math.abs(setpoint - pressure)"),
+	)
 	moduleDoc = doc.New(
-		doc.Paragraph("Numerical primitives: running averages, running min/max, derivatives, and arithmetic helpers."),
+		doc.Paragraph("Numerical primitives: running averages, running min/max, derivatives, and arithmetic, trigonometric, exponential, and logarithmic functions."),
 	)
 )
 
+var floatConstraint = types.FloatConstraint()
+
+type floatFunc struct {
+	name string
+	desc string
+	fn   func(float64) float64
+}
+
+// floatUnary lists the single-argument float functions, in the order they
+// appear in the math module.
+var floatUnary = []floatFunc{
+	{name: "sqrt", desc: "Returns the square root of x.", fn: math.Sqrt},
+	{name: "cbrt", desc: "Returns the cube root of x.", fn: math.Cbrt},
+	{name: "exp", desc: "Returns e raised to the power of x.", fn: math.Exp},
+	{name: "ln", desc: "Returns the natural logarithm of x.", fn: math.Log},
+	{name: "log2", desc: "Returns the base-2 logarithm of x.", fn: math.Log2},
+	{name: "log10", desc: "Returns the base-10 logarithm of x.", fn: math.Log10},
+	{name: "sin", desc: "Returns the sine of x, in radians.", fn: math.Sin},
+	{name: "cos", desc: "Returns the cosine of x, in radians.", fn: math.Cos},
+	{name: "tan", desc: "Returns the tangent of x, in radians.", fn: math.Tan},
+	{name: "asin", desc: "Returns the arcsine of x, in radians.", fn: math.Asin},
+	{name: "acos", desc: "Returns the arccosine of x, in radians.", fn: math.Acos},
+	{name: "atan", desc: "Returns the arctangent of x, in radians.", fn: math.Atan},
+	{name: "floor", desc: "Returns the greatest integer value less than or equal to x.", fn: math.Floor},
+	{name: "ceil", desc: "Returns the least integer value greater than or equal to x.", fn: math.Ceil},
+	{name: "round", desc: "Returns x rounded to the nearest integer, rounding half away from zero.", fn: math.Round},
+	{name: "trunc", desc: "Returns the integer part of x.", fn: math.Trunc},
+}
+
+type floatBinaryFunc struct {
+	name   string
+	desc   string
+	params [2]string
+	fn     func(float64, float64) float64
+}
+
+var floatBinary = []floatBinaryFunc{
+	{
+		name:   "atan2",
+		desc:   "Returns the arctangent of y/x, in radians, using the signs of both arguments to determine the quadrant.",
+		params: [2]string{"y", "x"},
+		fn:     math.Atan2,
+	},
+	{
+		name:   "hypot",
+		desc:   "Returns sqrt(x*x + y*y), avoiding overflow and underflow.",
+		params: [2]string{"x", "y"},
+		fn:     math.Hypot,
+	},
+}
+
+func newAbsSymbol() *symbol.Symbol {
+	return &symbol.Symbol{
+		Name: absSymbolName,
+		Kind: symbol.KindFunction,
+		Exec: symbol.ExecWASM,
+		Type: types.Function(types.FunctionProperties{
+			Inputs:  types.Params{{Name: "x", Type: types.Variable("T", &numConstraint)}},
+			Outputs: types.Params{{Name: ir.DefaultOutputParam, Type: types.Variable("T", &numConstraint)}},
+		}),
+		Doc: absDoc,
+	}
+}
+
+func newFloatUnarySymbol(f floatFunc) *symbol.Symbol {
+	return &symbol.Symbol{
+		Name: f.name,
+		Kind: symbol.KindFunction,
+		Exec: symbol.ExecWASM,
+		Type: types.Function(types.FunctionProperties{
+			Inputs:  types.Params{{Name: "x", Type: types.Variable("T", &floatConstraint)}},
+			Outputs: types.Params{{Name: ir.DefaultOutputParam, Type: types.Variable("T", &floatConstraint)}},
+		}),
+		Doc: doc.New(
+			doc.Paragraph(f.desc),
+			doc.Divider(),
+			doc.Code("arc", "y := math."+f.name+"(x)"),
+		),
+	}
+}
+
+func newFloatBinarySymbol(f floatBinaryFunc) *symbol.Symbol {
+	return &symbol.Symbol{
+		Name: f.name,
+		Kind: symbol.KindFunction,
+		Exec: symbol.ExecWASM,
+		Type: types.Function(types.FunctionProperties{
+			Inputs: types.Params{
+				{Name: f.params[0], Type: types.Variable("T", &floatConstraint)},
+				{Name: f.params[1], Type: types.Variable("T", &floatConstraint)},
+			},
+			Outputs: types.Params{{Name: ir.DefaultOutputParam, Type: types.Variable("T", &floatConstraint)}},
+		}),
+		Doc: doc.New(
+			doc.Paragraph(f.desc),
+			doc.Divider(),
+			doc.Code("arc", "r := math."+f.name+"("+f.params[0]+", "+f.params[1]+")"),
+		),
+	}
+}
+
 func newPowSymbol() *symbol.Symbol {
 	return &symbol.Symbol{
 		Name:     powSymbolName,
@@ -160,7 +267,13 @@ func NewSymbols() []*symbol.Symbol {
 	max := createBaseSymbol(maxSymbolName, maxDoc)
 	derivative := newDerivativeSymbol()
 	mod := &symbol.Symbol{Name: name, Kind: symbol.KindModule, Doc: moduleDoc}
-	mod.AddChild(newPowSymbol(), avg, min, max, derivative)
+	mod.AddChild(newPowSymbol(), avg, min, max, derivative, newAbsSymbol())
+	for _, f := range floatUnary {
+		mod.AddChild(newFloatUnarySymbol(f))
+	}
+	for _, f := range floatBinary {
+		mod.AddChild(newFloatBinarySymbol(f))
+	}
 	avgBare := *avg
 	avgBare.Deprecated = avg
 	minBare := *min
@@ -173,7 +286,8 @@ func NewSymbols() []*symbol.Symbol {
 }
 
 // Host is the runtime host-side support for math: it registers the WASM
-// host-function bindings (pow_*, neg_*) and acts as the node factory for
+// host-function bindings (pow_*, neg_*, abs_*, and the float functions) and
+// acts as the node factory for
 // avg / min / max / derivative.
 type Host struct{}
 
@@ -211,6 +325,36 @@ func NewHost(ctx context.Context, rt wazero.Runtime) (*Host, error) {
 	builder = bindF32Unary(builder, "neg", func(a float32) float32 { return -a })
 	builder = bindF64Unary(builder, "neg", func(a float64) float64 { return -a })
 
+	builder = bindI32Unary[uint8](builder, absSymbolName, "u8", func(a uint8) uint8 { return a })
+	builder = bindI32Unary[uint16](builder, absSymbolName, "u16", func(a uint16) uint16 { return a })
+	builder = bindI32Unary[uint32](builder, absSymbolName, "u32", func(a uint32) uint32 { return a })
+	builder = bindI32Unary[int8](builder, absSymbolName, "i8", absInt[int8])
+	builder = bindI32Unary[int16](builder, absSymbolName, "i16", absInt[int16])
+	builder = bindI32Unary[int32](builder, absSymbolName, "i32", absInt[int32])
+	builder = bindI64Unary[uint64](builder, absSymbolName, "u64", func(a uint64) uint64 { return a })
+	builder = bindI64Unary[int64](builder, absSymbolName, "i64", absInt[int64])
+	builder = bindF32Unary(builder, absSymbolName, func(a float32) float32 {
+		return float32(math.Abs(float64(a)))
+	})
+	builder = bindF64Unary(builder, absSymbolName, math.Abs)
+
+	for _, f := range floatUnary {
+		builder = bindF32Unary(builder, f.name, func(a float32) float32 {
+			return float32(f.fn(float64(a)))
+		})
+		builder = bindF64Unary(builder, f.name, f.fn)
+	}
+	for _, f := range floatBinary {
+		builder = builder.NewFunctionBuilder().
+			WithFunc(func(_ context.Context, a float32, b float32) float32 {
+				return float32(f.fn(float64(a), float64(b)))
+			}).Export(f.name + "_f32")
+		builder = builder.NewFunctionBuilder().
+			WithFunc(func(_ context.Context, a float64, b float64) float64 {
+				return f.fn(a, b)
+			}).Export(f.name + "_f64")
+	}
+
 	if _, err := builder.Instantiate(ctx); err != nil {
 		return nil, err
 	}
@@ -455,6 +599,15 @@ func (d *derivativeNode) Next(ctx node.Context) {
 	ctx.MarkChanged(0)
 }
 
+// absInt returns the absolute value of a. Like Go's two's complement
+// negation, the minimum value of T is returned unchanged.
+func absInt[T int8 | int16 | int32 | int64](a T) T {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
 type i32Powable interface {
 	uint8 | uint16 | uint32 | int8 | int16 | int32
 }
@@ -71,6 +71,143 @@ func (h *Host) ClearNode(key string) {
 	delete(h.stateSeries, key)
 }
 
+// Snapshot is a copy of the stateful variables of a program, keyed by node key and
+// then by variable ID. String and series variables hold their values rather than
+// handles, so a snapshot stays valid after the strings and series ProgramStates
+// are cleared.
+type Snapshot struct {
+	U8     map[string]map[uint32]uint8        `json:"u8,omitempty" msgpack:"u8,omitempty"`
+	U16    map[string]map[uint32]uint16       `json:"u16,omitempty" msgpack:"u16,omitempty"`
+	U32    map[string]map[uint32]uint32       `json:"u32,omitempty" msgpack:"u32,omitempty"`
+	U64    map[string]map[uint32]uint64       `json:"u64,omitempty" msgpack:"u64,omitempty"`
+	I8     map[string]map[uint32]int8         `json:"i8,omitempty" msgpack:"i8,omitempty"`
+	I16    map[string]map[uint32]int16        `json:"i16,omitempty" msgpack:"i16,omitempty"`
+	I32    map[string]map[uint32]int32        `json:"i32,omitempty" msgpack:"i32,omitempty"`
+	I64    map[string]map[uint32]int64        `json:"i64,omitempty" msgpack:"i64,omitempty"`
+	F32    map[string]map[uint32]float32      `json:"f32,omitempty" msgpack:"f32,omitempty"`
+	F64    map[string]map[uint32]float64      `json:"f64,omitempty" msgpack:"f64,omitempty"`
+	String map[string]map[uint32]string       `json:"string,omitempty" msgpack:"string,omitempty"`
+	Series map[string]map[uint32]telem.Series `json:"series,omitempty" msgpack:"series,omitempty"`
+}
+
+// Snapshot returns a copy of the current value of every stateful variable.
+func (h *Host) Snapshot() Snapshot {
+	return Snapshot{
+		U8:     copyState(h.stateU8, identity),
+		U16:    copyState(h.stateU16, identity),
+		U32:    copyState(h.stateU32, identity),
+		U64:    copyState(h.stateU64, identity),
+		I8:     copyState(h.stateI8, identity),
+		I16:    copyState(h.stateI16, identity),
+		I32:    copyState(h.stateI32, identity),
+		I64:    copyState(h.stateI64, identity),
+		F32:    copyState(h.stateF32, identity),
+		F64:    copyState(h.stateF64, identity),
+		String: copyState(h.stateString, identity),
+		Series: copyState(h.stateSeries, telem.Series.DeepCopy),
+	}
+}
+
+// Restore replaces the value of every stateful variable with the values in snap.
+// Variables missing from snap re-initialize on their next load. Restore should be
+// called after the program's nodes are reset, as resetting a node clears its
+// variables.
+func (h *Host) Restore(snap Snapshot) {
+	restoreState(h.stateU8, snap.U8, identity)
+	restoreState(h.stateU16, snap.U16, identity)
+	restoreState(h.stateU32, snap.U32, identity)
+	restoreState(h.stateU64, snap.U64, identity)
+	restoreState(h.stateI8, snap.I8, identity)
+	restoreState(h.stateI16, snap.I16, identity)
+	restoreState(h.stateI32, snap.I32, identity)
+	restoreState(h.stateI64, snap.I64, identity)
+	restoreState(h.stateF32, snap.F32, identity)
+	restoreState(h.stateF64, snap.F64, identity)
+	restoreState(h.stateString, snap.String, identity)
+	restoreState(h.stateSeries, snap.Series, telem.Series.DeepCopy)
+}
+
+// Remap returns a copy of the snapshot with each variable moved to the ID that
+// remap returns for its node key and current ID. Variables for which remap returns
+// false are dropped. Remap is used to carry state over to a recompiled program,
+// whose variable IDs may differ from those of the program the snapshot was taken
+// from.
+func (s Snapshot) Remap(remap func(nodeKey string, id uint32) (uint32, bool)) Snapshot {
+	return Snapshot{
+		U8:     remapState(s.U8, remap),
+		U16:    remapState(s.U16, remap),
+		U32:    remapState(s.U32, remap),
+		U64:    remapState(s.U64, remap),
+		I8:     remapState(s.I8, remap),
+		I16:    remapState(s.I16, remap),
+		I32:    remapState(s.I32, remap),
+		I64:    remapState(s.I64, remap),
+		F32:    remapState(s.F32, remap),
+		F64:    remapState(s.F64, remap),
+		String: remapState(s.String, remap),
+		Series: remapState(s.Series, remap),
+	}
+}
+
+func identity[T any](v T) T { return v }
+
+func remapState[T any](
+	src map[string]map[uint32]T,
+	remap func(nodeKey string, id uint32) (uint32, bool),
+) map[string]map[uint32]T {
+	var dst map[string]map[uint32]T
+	for key, vars := range src {
+		for id, v := range vars {
+			newID, ok := remap(key, id)
+			if !ok {
+				continue
+			}
+			if dst == nil {
+				dst = make(map[string]map[uint32]T)
+			}
+			if dst[key] == nil {
+				dst[key] = make(map[uint32]T)
+			}
+			dst[key][newID] = v
+		}
+	}
+	return dst
+}
+
+func copyState[T any](
+	src map[string]map[uint32]T,
+	copyValue func(T) T,
+) map[string]map[uint32]T {
+	if len(src) == 0 {
+		return nil
+	}
+	dst := make(map[string]map[uint32]T, len(src))
+	for key, vars := range src {
+		if len(vars) == 0 {
+			continue
+		}
+		inner := make(map[uint32]T, len(vars))
+		for id, v := range vars {
+			inner[id] = copyValue(v)
+		}
+		dst[key] = inner
+	}
+	return dst
+}
+
+// restoreState replaces the contents of dst in place, as the host bindings hold
+// references to the state maps.
+func restoreState[T any](
+	dst map[string]map[uint32]T,
+	src map[string]map[uint32]T,
+	copyValue func(T) T,
+) {
+	clear(dst)
+	for key, vars := range copyState(src, copyValue) {
+		dst[key] = vars
+	}
+}
+
 // NewHost registers the state module's WASM host bindings with rt. The
 // stateful module's host functions allocate values through the series and
 // strings ProgramStates, so both must be supplied.
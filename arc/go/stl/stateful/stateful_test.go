@@ -319,4 +319,62 @@ var _ = Describe("Vars", func() {
 			Expect(callU32(ctx, "load_i32", testutil.U32(0), testutil.U32(0))).To(Equal(uint32(100)))
 		})
 	})
+
+	Describe("Snapshot", func() {
+		It("Should restore the values held when the snapshot was taken", func(ctx SpecContext) {
+			mod.SetNodeKey("node1")
+			rt.CallVoid(ctx, "stateful", "store_i32", testutil.U32(0), testutil.U32(100))
+			rt.CallVoid(ctx, "stateful", "store_f64", testutil.U32(1), testutil.F64(2.5))
+			rt.CallVoid(ctx, "stateful", "store_str", testutil.U32(2), testutil.U32(strS.Create("armed")))
+			rt.CallVoid(ctx, "stateful", "store_series_f64", testutil.U32(3), testutil.U32(seriesS.Store(telem.NewSeriesV(1.0, 2.0))))
+			snap := mod.Snapshot()
+			rt.CallVoid(ctx, "stateful", "store_i32", testutil.U32(0), testutil.U32(200))
+			rt.CallVoid(ctx, "stateful", "store_i32", testutil.U32(4), testutil.U32(7))
+			mod.Restore(snap)
+			Expect(callU32(ctx, "load_i32", testutil.U32(0), testutil.U32(0))).To(Equal(uint32(100)))
+			Expect(callU32(ctx, "load_i32", testutil.U32(4), testutil.U32(42))).To(Equal(uint32(42)))
+			Expect(callF64(ctx, "load_f64", testutil.U32(1), testutil.F64(0))).To(Equal(2.5))
+			Expect(MustBeOk(strS.Get(callU32(ctx, "load_str", testutil.U32(2), testutil.U32(0))))).To(Equal("armed"))
+			ser := MustBeOk(seriesS.Get(callU32(ctx, "load_series_f64", testutil.U32(3), testutil.U32(0))))
+			Expect(telem.UnmarshalSeries[float64](ser)).To(Equal([]float64{1.0, 2.0}))
+		})
+
+		It("Should not share values with the host after the snapshot is taken", func(ctx SpecContext) {
+			mod.SetNodeKey("node1")
+			rt.CallVoid(ctx, "stateful", "store_series_i32", testutil.U32(0), testutil.U32(seriesS.Store(telem.NewSeriesV[int32](1))))
+			snap := mod.Snapshot()
+			snap.Series["node1"][0].Data[0] = 0xFF
+			stored := MustBeOk(seriesS.Get(callU32(ctx, "load_series_i32", testutil.U32(0), testutil.U32(0))))
+			Expect(telem.ValueAt[int32](stored, 0)).To(Equal(int32(1)))
+		})
+
+		It("Should restore a snapshot into a different host", func(ctx SpecContext) {
+			mod.SetNodeKey("node1")
+			rt.CallVoid(ctx, "stateful", "store_u8", testutil.U32(0), testutil.U32(3))
+			mod.SetNodeKey("node2")
+			rt.CallVoid(ctx, "stateful", "store_str", testutil.U32(0), testutil.U32(strS.Create("vent")))
+			other := MustSucceed(stateful.NewHost(ctx, nil, seriesS, strS))
+			other.Restore(mod.Snapshot())
+			Expect(other.Snapshot()).To(Equal(stateful.Snapshot{
+				U8:     map[string]map[uint32]uint8{"node1": {0: 3}},
+				String: map[string]map[uint32]string{"node2": {0: "vent"}},
+			}))
+		})
+
+		It("Should move variables to their remapped IDs and drop the rest", func() {
+			snap := stateful.Snapshot{
+				I64: map[string]map[uint32]int64{"node1": {0: 10, 1: 20}},
+				F64: map[string]map[uint32]float64{"node2": {3: 1.5}},
+			}
+			remapped := snap.Remap(func(nodeKey string, id uint32) (uint32, bool) {
+				if nodeKey == "node1" && id == 1 {
+					return 4, true
+				}
+				return 0, false
+			})
+			Expect(remapped).To(Equal(stateful.Snapshot{
+				I64: map[string]map[uint32]int64{"node1": {4: 20}},
+			}))
+		})
+	})
 })
@@ -19,6 +19,7 @@ import (
 	"github.com/synnaxlabs/arc/stl/channels"
 	"github.com/synnaxlabs/arc/stl/constant"
 	"github.com/synnaxlabs/arc/stl/control"
+	"github.com/synnaxlabs/arc/stl/dsp"
 	"github.com/synnaxlabs/arc/stl/errors"
 	"github.com/synnaxlabs/arc/stl/math"
 	"github.com/synnaxlabs/arc/stl/op"
@@ -40,6 +41,7 @@ func NewSymbols() []*symbol.Symbol {
 		channels.NewSymbols(),
 		constant.NewSymbols(),
 		control.NewSymbols(),
+		dsp.NewSymbols(),
 		errors.NewSymbols(),
 		math.NewSymbols(),
 		op.NewSymbols(),
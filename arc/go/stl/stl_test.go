@@ -79,25 +79,28 @@ var _ = Describe("STL Symbols", func() {
 					continue
 				}
 				inputs := sym.Type.Inputs
-				config := sym.Type.Config
-				if len(inputs) != len(config) {
-					violations = append(violations, fmt.Sprintf(
-						"%s.%s (Inputs has %d params, Config has %d; ExecBoth requires "+
-							"one-to-one mirroring)",
-						mod.Name, sym.Name, len(inputs), len(config),
-					))
-					continue
-				}
-				for i := range inputs {
-					if inputs[i].Name != config[i].Name || !types.Equal(inputs[i].Type, config[i].Type) {
+				for _, cfg := range sym.Type.Config {
+					in, ok := inputs.Get(cfg.Name)
+					if !ok {
+						violations = append(violations, fmt.Sprintf(
+							"%s.%s (Config param %s has no mirroring input)",
+							mod.Name, sym.Name, cfg.Name,
+						))
+						continue
+					}
+					if !types.Equal(in.Type, cfg.Type) {
 						violations = append(violations, fmt.Sprintf(
-							"%s.%s (Inputs[%d]={%s,%s} does not match Config[%d]={%s,%s})",
-							mod.Name, sym.Name,
-							i, inputs[i].Name, inputs[i].Type,
-							i, config[i].Name, config[i].Type,
+							"%s.%s (input {%s,%s} does not match Config param {%s,%s})",
+							mod.Name, sym.Name, in.Name, in.Type, cfg.Name, cfg.Type,
 						))
 					}
 				}
+				if data := sym.FlowInputs(sym.Type); len(data) > 0 && data[0].Name != ir.DefaultInputParam {
+					violations = append(violations, fmt.Sprintf(
+						"%s.%s (first data input is named %q, expected %q)",
+						mod.Name, sym.Name, data[0].Name, ir.DefaultInputParam,
+					))
+				}
 			}
 		}
 		Expect(violations).To(BeEmpty(),
@@ -121,8 +121,16 @@ type Host struct {
 
 // NewHost registers the time module's `now` WASM host binding with rt and
 // returns a Host handle that acts as the node factory for interval / wait.
-func NewHost(ctx context.Context, rt wazero.Runtime) (*Host, error) {
+// Pass WithNow(fn) to replace the wall clock behind `now`.
+func NewHost(
+	ctx context.Context,
+	rt wazero.Runtime,
+	opts ...func(*Host),
+) (*Host, error) {
 	h := &Host{BaseInterval: unsetBaseInterval}
+	for _, opt := range opts {
+		opt(h)
+	}
 	if rt == nil {
 		return h, nil
 	}
@@ -137,6 +145,12 @@ func NewHost(ctx context.Context, rt wazero.Runtime) (*Host, error) {
 	return h, nil
 }
 
+// WithNow overrides the clock read by `now`. Used to run a program against
+// simulated time.
+func WithNow(fn func() telem.TimeStamp) func(*Host) {
+	return func(h *Host) { h.clock.Source = fn }
+}
+
 func (h *Host) Create(_ context.Context, cfg node.Config) (node.Node, error) {
 	switch cfg.Node.Type {
 	case intervalSymbolName:
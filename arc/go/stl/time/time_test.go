@@ -1526,6 +1526,25 @@ var _ = Describe("Time", func() {
 			Expect(ts).To(BeNumerically(">=", before))
 			Expect(ts).To(BeNumerically("<=", after))
 		})
+		It("Should read the clock passed with WithNow", func(ctx SpecContext) {
+			simulated := MustSucceed(time.NewHost(ctx, nil, time.WithNow(func() telem.TimeStamp {
+				return 42 * telem.SecondTS
+			})))
+			n := MustSucceed(simulated.Create(ctx, node.Config{
+				Node:  ir.Node{Type: "now"},
+				State: s.Node("now_1"),
+			}))
+			nowNode := s.Node("now_1")
+			n.Next(node.Context{
+				Context:         ctx,
+				Reason:          node.ReasonTimerTick,
+				MarkChanged:     func(int) {},
+				MarkSelfChanged: func() {},
+				SetDeadline:     func(telem.TimeSpan) {},
+			})
+			Expect(telem.ValueAt[telem.TimeStamp](*nowNode.Output(0), 0)).To(Equal(42 * telem.SecondTS))
+			Expect(telem.ValueAt[telem.TimeStamp](*nowNode.OutputTime(0), 0)).To(Equal(42 * telem.SecondTS))
+		})
 		It("Should fire on channel input reason", func(ctx SpecContext) {
 			cfg := node.Config{
 				Node:  ir.Node{Type: "now"},
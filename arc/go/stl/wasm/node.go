@@ -34,6 +34,47 @@ type NodeKeySetter interface {
 	ClearNode(key string)
 }
 
+// SampleTimeSetter is an optional extension of NodeKeySetter for modules whose
+// host functions depend on the timestamp of the sample being processed (e.g.,
+// filters and controllers). The runtime calls SetSampleTime before each WASM
+// invocation.
+type SampleTimeSetter interface {
+	SetSampleTime(ts telem.TimeStamp)
+}
+
+// NodeKeySetters fans node key and sample time updates out to several
+// modules.
+type NodeKeySetters []NodeKeySetter
+
+var (
+	_ NodeKeySetter    = NodeKeySetters(nil)
+	_ SampleTimeSetter = NodeKeySetters(nil)
+)
+
+// SetNodeKey implements NodeKeySetter.
+func (s NodeKeySetters) SetNodeKey(key string) {
+	for _, setter := range s {
+		setter.SetNodeKey(key)
+	}
+}
+
+// ClearNode implements NodeKeySetter.
+func (s NodeKeySetters) ClearNode(key string) {
+	for _, setter := range s {
+		setter.ClearNode(key)
+	}
+}
+
+// SetSampleTime implements SampleTimeSetter, forwarding to the modules that
+// implement it.
+func (s NodeKeySetters) SetSampleTime(ts telem.TimeStamp) {
+	for _, setter := range s {
+		if t, ok := setter.(SampleTimeSetter); ok {
+			t.SetSampleTime(ts)
+		}
+	}
+}
+
 type result struct {
 	Value   uint64
 	Changed bool
@@ -55,6 +96,7 @@ type nodeImpl struct {
 	isEntryNode   bool
 	clock         telem.MonoClock
 	nodeKeySetter NodeKeySetter
+	sampleTimer   SampleTimeSetter
 	stringInputs  []bool
 	stringOutputs []bool
 	strings       *stlstrings.ProgramState
@@ -182,6 +224,15 @@ func (n *nodeImpl) Next(ctx node.Context) {
 				n.params[n.configCount+j] = uint64(n.strings.Create(string(data)))
 			}
 		}
+		var ts uint64
+		if len(n.ir.Inputs) > 0 {
+			ts = valueAt(longestInputTime, int(i))
+		} else {
+			ts = uint64(n.clock.Now())
+		}
+		if n.sampleTimer != nil {
+			n.sampleTimer.SetSampleTime(telem.TimeStamp(ts))
+		}
 		res, err := n.call(ctx.Context, n.params...)
 		if err != nil {
 			ctx.ReportError(errors.Wrapf(
@@ -193,12 +244,6 @@ func (n *nodeImpl) Next(ctx node.Context) {
 			))
 			continue
 		}
-		var ts uint64
-		if len(n.ir.Inputs) > 0 {
-			ts = valueAt(longestInputTime, int(i))
-		} else {
-			ts = uint64(n.clock.Now())
-		}
 		for j, value := range res {
 			if value.Changed {
 				if n.stringOutputs[j] {
@@ -29,6 +29,9 @@ type Module struct {
 	Memory        api.Memory
 	Strings       *stlstrings.ProgramState
 	NodeKeySetter NodeKeySetter
+	// Now overrides the clock used to timestamp the outputs of nodes without
+	// inputs. Nil uses telem.Now.
+	Now func() telem.TimeStamp
 }
 
 func (w *Module) Create(_ context.Context, cfg node.Config) (node.Node, error) {
@@ -89,6 +92,7 @@ func (w *Module) Create(_ context.Context, cfg node.Config) (node.Node, error) {
 		stringOutputs[i] = out.Type.Kind == types.KindString
 	}
 
+	sampleTimer, _ := w.NodeKeySetter.(SampleTimeSetter)
 	n := &nodeImpl{
 		State:         cfg.State,
 		ir:            cfg.Node,
@@ -103,6 +107,8 @@ func (w *Module) Create(_ context.Context, cfg node.Config) (node.Node, error) {
 		offsets:       make([]int, len(irFn.Outputs)),
 		isEntryNode:   isEntryNode,
 		nodeKeySetter: w.NodeKeySetter,
+		sampleTimer:   sampleTimer,
+		clock:         telem.MonoClock{Source: w.Now},
 		stringInputs:  stringInputs,
 		stringOutputs: stringOutputs,
 		strings:       w.Strings,
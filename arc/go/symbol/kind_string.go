@@ -34,11 +34,14 @@ func _() {
 	_ = x[KindModule-14]
 	_ = x[KindModuleAlias-15]
 	_ = x[KindAmbient-16]
+	_ = x[KindStruct-17]
+	_ = x[KindEnum-18]
+	_ = x[KindEnumMember-19]
 }
 
-const _Kind_name = "KindVariableKindStatefulVariableKindChannelKindFunctionKindBlockKindConfigKindInputKindOutputKindSequenceKindStageKindConstantKindGlobalConstantKindLoopKindLoopVariableKindModuleKindModuleAliasKindAmbient"
+const _Kind_name = "KindVariableKindStatefulVariableKindChannelKindFunctionKindBlockKindConfigKindInputKindOutputKindSequenceKindStageKindConstantKindGlobalConstantKindLoopKindLoopVariableKindModuleKindModuleAliasKindAmbientKindStructKindEnumKindEnumMember"
 
-var _Kind_index = [...]uint8{0, 12, 32, 43, 55, 64, 74, 83, 93, 105, 114, 126, 144, 152, 168, 178, 193, 204}
+var _Kind_index = [...]uint8{0, 12, 32, 43, 55, 64, 74, 83, 93, 105, 114, 126, 144, 152, 168, 178, 193, 204, 214, 222, 236}
 
 func (i Kind) String() string {
 	idx := int(i) - 0
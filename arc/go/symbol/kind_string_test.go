@@ -37,6 +37,9 @@ var _ = Describe("Kind.String", func() {
 		Entry("KindModule", symbol.KindModule, "KindModule"),
 		Entry("KindModuleAlias", symbol.KindModuleAlias, "KindModuleAlias"),
 		Entry("KindAmbient", symbol.KindAmbient, "KindAmbient"),
+		Entry("KindStruct", symbol.KindStruct, "KindStruct"),
+		Entry("KindEnum", symbol.KindEnum, "KindEnum"),
+		Entry("KindEnumMember", symbol.KindEnumMember, "KindEnumMember"),
 	)
 
 	It("Should fall back to a numeric representation for unknown Kinds", func() {
@@ -58,9 +58,12 @@ const (
 	ExecWASM ExecContext = 1 << iota
 	// ExecFlow marks a symbol as only usable in flow statements (graph nodes).
 	ExecFlow
-	// ExecBoth marks a symbol as usable in both contexts. Inputs must mirror
-	// Config one-for-one (N=0 allowed); upstream edges in flow form are
-	// triggers, not typed inputs. Invariant enforced in stl_test.go.
+	// ExecBoth marks a symbol as usable in both contexts. Every Config param
+	// must be mirrored by an Input of the same name and type (N=0 allowed),
+	// so the func form passes config as call arguments. The remaining
+	// inputs are data inputs fed by edges in flow form; when there are none,
+	// upstream edges are triggers, not typed inputs. Invariant enforced in
+	// stl_test.go.
 	ExecBoth = ExecWASM | ExecFlow
 )
 
@@ -116,7 +119,9 @@ const (
 	// to that module's children — the Resolve walk does not continue out
 	// through Parent. This is what gives module access its member-only
 	// semantics: `math.foo` cannot accidentally find an unrelated `foo` in
-	// an enclosing scope.
+	// an enclosing scope. Lookups from inside a library module's functions
+	// are lexical rather than member accesses, so they continue out to the
+	// ambient prelude.
 	KindModule
 	// KindModuleAlias represents an import alias bound in a file's root. The
 	// Target field points at the underlying module symbol; member lookups on
@@ -128,6 +133,17 @@ const (
 	// recognizes KindAmbient as the boundary above the user program — it
 	// walks to the topmost user-program scope and stops there.
 	KindAmbient
+	// KindStruct represents a user-declared struct type. Its Type is the struct
+	// type itself, and struct type annotations resolve to it by name.
+	KindStruct
+	// KindEnum represents a user-declared enum. Its Type is the enum's underlying
+	// integer type, named after the enum, and its children are its members. Like a
+	// module, an enum is sealed: member lookups never escape to enclosing scopes.
+	KindEnum
+	// KindEnumMember represents a named value of an enum. DefaultValue holds the
+	// member's value as an int64. Like an integer literal, a reference to a member
+	// takes the integer type its context requires.
+	KindEnumMember
 )
 
 // Symbol is a named entity in an Arc program and, when it has Children, a
@@ -179,6 +195,11 @@ type Symbol struct {
 	// Flow, or Both). A zero value is invalid and will cause resolution to
 	// fail, forcing every symbol to be explicitly tagged.
 	Exec ExecContext
+	// Stateful marks a host function that keeps state between calls, such as
+	// a filter or a controller. The compiler passes a module-unique i32 call
+	// site key as a hidden leading argument so the host can keep separate
+	// state for every call in a function body.
+	Stateful bool
 	// AnalyzeCall runs after generic func-form validation. Optional.
 	AnalyzeCall CallHook
 	// AnalyzeFlowConfig runs after generic flow-form config validation. Optional.
@@ -258,6 +279,52 @@ func NewRoot(dynamicResolver Resolver, ambientGlobals []*Symbol) *Symbol {
 	return root
 }
 
+// NewLibrary creates a module symbol for a user-authored library whose members
+// are declared by program. The module has no children until a program imports
+// it: the analyzer then declares the library's functions and constants on the
+// per-root copy made by NewRoot, so every program analyzes its own view of the
+// library.
+func NewLibrary(name string, program parser.IProgramContext) *Symbol {
+	return &Symbol{Name: name, Kind: KindModule, AST: program}
+}
+
+// IsLibrary reports whether s is a module declared by a user-authored library
+// rather than by the standard library.
+func (s *Symbol) IsLibrary() bool { return s.Kind == KindModule && s.AST != nil }
+
+// Library returns the library module that s is declared in (including s
+// itself), or nil if s is not part of a library.
+func (s *Symbol) Library() *Symbol {
+	for ; s != nil; s = s.Parent {
+		if s.IsLibrary() {
+			return s
+		}
+	}
+	return nil
+}
+
+// IsStructValue reports whether s holds a value of a struct type, as opposed to
+// declaring one.
+func (s *Symbol) IsStructValue() bool { return s.Kind != KindStruct && s.Type.IsStruct() }
+
+// Field returns a symbol standing in for the field with the given name on a
+// struct-typed value. The returned symbol is not part of the symbol table: it
+// carries the dotted access path as its Name, the field's type as its Type, and
+// the Kind, ID, and AST of the value the field was accessed on.
+func (s *Symbol) Field(name string) (*Symbol, error) {
+	field, _, ok := s.Type.Field(name)
+	if !ok {
+		return nil, errors.Newf("%s has no field '%s'", s.Type, name)
+	}
+	return &Symbol{
+		Name: s.Name + "." + name,
+		Kind: s.Kind,
+		Type: field.Type,
+		ID:   s.ID,
+		AST:  s.AST,
+	}, nil
+}
+
 // GetChildByParserRule finds a direct child with the given AST parser rule.
 // Returns an error if no matching child is found.
 func (s *Symbol) GetChildByParserRule(rule antlr.ParserRuleContext) (*Symbol, error) {
@@ -345,6 +412,13 @@ func (s *Symbol) Add(ctx context.Context, sym Symbol) (*Symbol, error) {
 		sym.Kind == KindOutput ||
 		sym.Kind == KindLoopVariable {
 		child.ID = s.addIndex()
+		// Struct-typed params are passed one WASM param per field, so they
+		// reserve an ID for each field past the first.
+		if (sym.Kind == KindInput || sym.Kind == KindConfig) && sym.Type.IsStruct() {
+			for range sym.Type.LeafCount() - 1 {
+				s.addIndex()
+			}
+		}
 	}
 	s.children = append(s.children, child)
 	return child, nil
@@ -369,15 +443,16 @@ func (s *Symbol) AddChild(children ...*Symbol) *Symbol {
 // other entry points that reference module-qualified names
 // (`control.set_authority`, `time.interval`) without producing `import`
 // statements. Internal modules are skipped — they are compiler-only and
-// must not become reachable by name from user code. Text-mode callers
-// do not call this — the analyzer installs aliases from source-level
-// import declarations.
+// must not become reachable by name from user code. Libraries are also
+// skipped, since their members are only declared when the analyzer
+// processes an `import` of them. Text-mode callers do not call this — the
+// analyzer installs aliases from source-level import declarations.
 func AutoImportModules(root *Symbol) {
 	if root.Parent == nil {
 		return
 	}
 	for _, child := range root.Parent.children {
-		if child.Kind != KindModule || child.Internal {
+		if child.Kind != KindModule || child.Internal || child.IsLibrary() {
 			continue
 		}
 		alias := &Symbol{
@@ -429,6 +504,24 @@ func (s *Symbol) Root() *Symbol {
 	}
 }
 
+// FlowInputs returns the inputs of t, a (possibly freshened) instance of s's
+// type, that a flow node instantiated from s exposes. Standard library
+// ExecBoth functions take their config through the flow node's config
+// block, so the inputs mirroring config are omitted. All other functions
+// expose every input.
+func (s *Symbol) FlowInputs(t types.Type) types.Params {
+	if s.Exec != ExecBoth || s.AST != nil || len(t.Config) == 0 {
+		return t.Inputs
+	}
+	inputs := make(types.Params, 0, len(t.Inputs))
+	for _, p := range t.Inputs {
+		if _, mirrored := t.Config.Get(p.Name); !mirrored {
+			inputs = append(inputs, p)
+		}
+	}
+	return inputs
+}
+
 // QualifiedName returns s's canonical dotted name. For a module member
 // (s.Parent.Kind == KindModule), returns "<module>.<name>". For a top-level
 // symbol, returns s.Name. This is the canonical string identifier used at
@@ -457,6 +550,10 @@ type resolveOpts struct {
 	// consulted. Read-only callers operating on an already-analyzed tree
 	// set this so the lookup leaves no trace; see WithoutUsageTracking.
 	suppressUsage bool
+	// lexical is set once the walk has moved outward through Parent. A
+	// module reached this way is an enclosing scope rather than the target
+	// of a member access, so its seal does not apply.
+	lexical bool
 }
 
 // IncludeInternal causes Resolve to walk past the user-visibility filters:
@@ -475,9 +572,11 @@ func IncludeInternal(o *resolveOpts) { o.includeInternal = true }
 func WithoutUsageTracking(o *resolveOpts) { o.suppressUsage = true }
 
 // Resolve looks up a single name using lexical scoping rules: children →
-// global resolver → parent. The walk stops at a KindModule scope — inside
-// a module, lookup cannot escape outward. That seal is what gives module
-// members their member-only semantics. By default the walk also skips
+// global resolver → parent. The walk stops at a KindModule or KindEnum scope
+// that was the target of the lookup — member access on a module or enum cannot
+// escape outward. That seal is what gives module members their member-only
+// semantics, while lookups from inside a library's functions still walk out
+// through the library to the ambient prelude. By default the walk also skips
 // KindModule entries encountered along the way: bare module names are
 // not accessible from user code; modules are reached only through
 // KindModuleAlias children installed by `import`. Pass IncludeInternal
@@ -547,10 +646,11 @@ func (s *Symbol) resolve(
 			}
 		}
 	}
-	if s.Kind == KindModule {
+	if (s.Kind == KindModule || s.Kind == KindEnum) && !opts.lexical {
 		return nil, &UndefinedSymbolError{ctx: ctx, Name: name, scope: s}
 	}
 	if s.Parent != nil {
+		opts.lexical = true
 		return s.Parent.resolve(ctx, name, opts, origin)
 	}
 	return nil, &UndefinedSymbolError{ctx: ctx, Name: name, scope: origin}
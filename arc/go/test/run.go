@@ -0,0 +1,416 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/runtime/node"
+	"github.com/synnaxlabs/arc/runtime/scheduler"
+	"github.com/synnaxlabs/arc/stl/channels"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/diagnostics"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// StatusSet records a status.set call made by a program under test.
+type StatusSet struct {
+	KeyOrName string
+	Message   string
+	Variant   string
+	Time      telem.TimeStamp
+}
+
+// Env connects an Instance to the simulated clock and recorders of a test.
+type Env struct {
+	// Now returns the current simulated time. Every clock the program reads must
+	// use it.
+	Now func() telem.TimeStamp
+	// SetStatus records a status.set call in place of updating a real status.
+	SetStatus func(StatusSet)
+	// ReportError records an error reported by a host module of the program.
+	ReportError func(error)
+}
+
+// Instance is a freshly instantiated copy of the program under test.
+type Instance interface {
+	// Cycle ingests fr, runs the program's scheduler at the given elapsed time, and
+	// returns the channel writes the program made. The returned bool is false if
+	// the program made no writes.
+	Cycle(
+		ctx context.Context,
+		fr telem.Frame[uint32],
+		elapsed telem.TimeSpan,
+		reason node.RunReason,
+	) (telem.Frame[uint32], bool)
+	// Scheduler returns the scheduler running the program's nodes.
+	Scheduler() *scheduler.Scheduler
+	// BaseInterval returns the base period of the program's timers, or
+	// telem.TimeSpanMax if the program has none.
+	BaseInterval() telem.TimeSpan
+	// Close releases the instance.
+	Close() error
+}
+
+// Config is the configuration for running the tests of a program.
+type Config struct {
+	// Channels resolves the channel names used by test steps.
+	Channels symbol.Resolver
+	// Digests describe the channels used by the program. Values fed to a channel
+	// with an index are written alongside a timestamp on that index.
+	Digests []channels.Digest
+	// Open instantiates a fresh copy of the program. Every test runs against its
+	// own instance.
+	Open func(ctx context.Context, env Env) (Instance, error)
+	// Start is the simulated time at which every test begins.
+	Start telem.TimeStamp
+	// Parser is the language configuration the program was compiled with.
+	Parser parser.Config
+}
+
+// Failure is a failed assertion or a runtime error raised during a test.
+type Failure struct {
+	// Position is the position of the step that failed.
+	Position diagnostics.Position
+	// Message describes the failure.
+	Message string
+}
+
+// Result is the outcome of running a single test.
+type Result struct {
+	// Name is the name of the test.
+	Name string
+	// Position is where the test block starts in the source file.
+	Position diagnostics.Position
+	// Failures holds every failure in the test, in order.
+	Failures []Failure
+}
+
+// Passed returns true if the test had no failures.
+func (r Result) Passed() bool { return len(r.Failures) == 0 }
+
+// Run runs each test against a fresh instance of the program, in order. Tests run
+// on a simulated clock: time only moves forward when a test feeds values with
+// every or advances it explicitly, and timers fire at their deadlines as it does.
+// An error is returned only if an instance could not be opened or closed; failed
+// assertions are reported in the results.
+func Run(ctx context.Context, cfg Config, tests []Test) ([]Result, error) {
+	if cfg.Open == nil {
+		return nil, errors.New("arc.test: open must be non-nil")
+	}
+	if cfg.Channels == nil {
+		return nil, errors.New("arc.test: channels must be non-nil")
+	}
+	results := make([]Result, 0, len(tests))
+	for _, t := range tests {
+		res, err := run(ctx, cfg, t)
+		if err != nil {
+			return nil, errors.Wrapf(err, "test %q", t.Name)
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// runner drives a single test against an instance.
+type runner struct {
+	cfg      Config
+	inst     Instance
+	result   Result
+	step     Step
+	elapsed  telem.TimeSpan
+	lastTick telem.TimeSpan
+	// alignment is the alignment of the next fed sample.
+	alignment telem.Alignment
+	indexes   map[uint32]uint32
+	writes    map[uint32]telem.Series
+	statuses  []StatusSet
+}
+
+func run(ctx context.Context, cfg Config, t Test) (res Result, err error) {
+	r := &runner{
+		cfg:      cfg,
+		result:   Result{Name: t.Name, Position: t.Position},
+		lastTick: -1,
+		indexes:  make(map[uint32]uint32, len(cfg.Digests)),
+		writes:   make(map[uint32]telem.Series),
+		step:     Step{Position: t.Position},
+	}
+	for _, d := range cfg.Digests {
+		r.indexes[d.Key] = d.Index
+	}
+	if r.inst, err = cfg.Open(ctx, Env{
+		Now:         r.now,
+		SetStatus:   func(s StatusSet) { r.statuses = append(r.statuses, s) },
+		ReportError: func(err error) { r.fail("%s", err.Error()) },
+	}); err != nil {
+		return res, err
+	}
+	defer func() {
+		err = errors.Join(err, r.inst.Close())
+	}()
+	sched := r.inst.Scheduler()
+	sched.SetErrorHandler(scheduler.ErrorHandlerFunc(
+		func(_ context.Context, nodeKey string, err error) {
+			r.fail("node %s: %s", nodeKey, err.Error())
+		},
+	))
+	if r.hasTimers() {
+		// Mirror a live task, which ticks once on start so that timer nodes seed
+		// their first deadline.
+		r.lastTick = 0
+		r.cycle(ctx, telem.Frame[uint32]{}, node.ReasonTimerTick)
+	}
+	for _, step := range t.Steps {
+		r.step = step
+		switch step.Kind {
+		case StepFeed:
+			r.feed(ctx, step)
+		case StepAdvance:
+			r.advance(ctx, r.elapsed+step.Span)
+		case StepExpectChannel:
+			r.expectChannel(ctx, step)
+		case StepExpectStage:
+			r.expectStage(step)
+		case StepExpectStatus:
+			r.expectStatus(step)
+		}
+	}
+	return r.result, nil
+}
+
+func (r *runner) now() telem.TimeStamp { return r.cfg.Start.Add(r.elapsed) }
+
+func (r *runner) hasTimers() bool { return r.inst.BaseInterval() != telem.TimeSpanMax }
+
+func (r *runner) fail(format string, args ...any) {
+	r.result.Failures = append(r.result.Failures, Failure{
+		Position: r.step.Position,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// cycle runs the program once at the current simulated time and records its
+// writes.
+func (r *runner) cycle(ctx context.Context, fr telem.Frame[uint32], reason node.RunReason) {
+	out, changed := r.inst.Cycle(ctx, fr, r.elapsed, reason)
+	if !changed {
+		return
+	}
+	for key, s := range out.Entries() {
+		if s.Len() > 0 {
+			r.writes[key] = s
+		}
+	}
+}
+
+// advance moves the simulated clock to target, ticking the program at every timer
+// deadline on the way.
+func (r *runner) advance(ctx context.Context, target telem.TimeSpan) {
+	if r.hasTimers() {
+		for {
+			deadline := max(r.inst.Scheduler().NextDeadline(), r.elapsed)
+			if deadline > target || deadline == r.lastTick {
+				break
+			}
+			r.elapsed, r.lastTick = deadline, deadline
+			r.cycle(ctx, telem.Frame[uint32]{}, node.ReasonTimerTick)
+		}
+	}
+	r.elapsed = max(r.elapsed, target)
+}
+
+// channel resolves the channel named by the current step.
+func (r *runner) channel(ctx context.Context, name string) (uint32, types.Type, bool) {
+	sym, err := r.cfg.Channels.Resolve(ctx, name)
+	if err != nil || sym.Kind != symbol.KindChannel || sym.Type.Elem == nil {
+		r.fail("unknown channel %s", name)
+		return 0, types.Type{}, false
+	}
+	return uint32(sym.ID), *sym.Type.Elem, true
+}
+
+func (r *runner) feed(ctx context.Context, step Step) {
+	key, t, ok := r.channel(ctx, step.Channel)
+	if !ok {
+		return
+	}
+	dataType := types.ToTelem(t)
+	for i, src := range step.Values {
+		v, err := parseValue(src, t, r.cfg.Parser)
+		if err != nil {
+			r.fail("cannot feed %s to %s: %s", src, step.Channel, err.Error())
+			return
+		}
+		if i > 0 {
+			r.advance(ctx, r.elapsed+step.Every)
+		}
+		data := telem.NewSeriesFromAny(v, dataType)
+		data.Alignment = r.alignment
+		fr := telem.Frame[uint32]{}.Append(key, data)
+		if index := r.indexes[key]; index != 0 && index != key {
+			stamps := telem.NewSeriesV(r.now())
+			stamps.Alignment = r.alignment
+			fr = fr.Append(index, stamps)
+		}
+		r.alignment++
+		r.cycle(ctx, fr, node.ReasonChannelInput)
+	}
+}
+
+func (r *runner) expectChannel(ctx context.Context, step Step) {
+	key, t, ok := r.channel(ctx, step.Channel)
+	if !ok {
+		return
+	}
+	written, ok := r.writes[key]
+	if !ok {
+		r.fail("expected %s %s %s, but %s was never written", step.Channel, step.Operator, step.Values[0], step.Channel)
+		return
+	}
+	v, err := parseValue(step.Values[0], t, r.cfg.Parser)
+	if err != nil {
+		r.fail("cannot compare %s to %s: %s", step.Channel, step.Values[0], err.Error())
+		return
+	}
+	var (
+		dt       = written.DataType
+		expected = telem.Series{DataType: dt, Data: telem.NewSeriesFromAny(v, dt).At(0)}
+		actual   = telem.Series{DataType: dt, Data: written.At(-1)}
+		matched  bool
+	)
+	if cmp, ok := compare(actual, expected); ok {
+		switch step.Operator {
+		case "==":
+			matched = cmp == 0
+		case "!=":
+			matched = cmp != 0
+		case "<":
+			matched = cmp < 0
+		case ">":
+			matched = cmp > 0
+		case "<=":
+			matched = cmp <= 0
+		case ">=":
+			matched = cmp >= 0
+		}
+	} else {
+		r.fail("cannot compare %s values with %s", written.DataType, step.Operator)
+		return
+	}
+	if !matched {
+		r.fail("expected %s %s %s, got %s", step.Channel, step.Operator, step.Values[0], sampleString(actual))
+	}
+}
+
+func (r *runner) expectStage(step Step) {
+	want := scheduler.Stage{Scope: step.Sequence, Step: step.Stage}
+	active := r.inst.Scheduler().ActiveStages()
+	if slices.Contains(active, want) {
+		return
+	}
+	var current string
+	for _, s := range active {
+		if s.Scope == step.Sequence {
+			current = s.Step
+		}
+	}
+	if current == "" {
+		r.fail("expected stage %s.%s to be active, but %s is not running", step.Sequence, step.Stage, step.Sequence)
+		return
+	}
+	r.fail("expected stage %s.%s to be active, got %s.%s", step.Sequence, step.Stage, step.Sequence, current)
+}
+
+func (r *runner) expectStatus(step Step) {
+	i := len(r.statuses) - 1
+	for ; i >= 0 && r.statuses[i].KeyOrName != step.Status; i-- {
+	}
+	if i == -1 {
+		r.fail("expected status %q to be set, but it never was", step.Status)
+		return
+	}
+	got := r.statuses[i]
+	if got.Variant != step.Variant {
+		r.fail("expected status %q to have variant %s, got %s", step.Status, step.Variant, got.Variant)
+	}
+	if step.Message != "" && got.Message != step.Message {
+		r.fail("expected status %q to have message %q, got %q", step.Status, step.Message, got.Message)
+	}
+}
+
+// compare compares two series holding the raw bytes of a single sample of the same
+// data type, returning -1,
+// 0, or 1. Variable-density data types can only be compared for equality, and
+// report 1 when they differ.
+func compare(a, b telem.Series) (int, bool) {
+	if a.DataType.IsVariable() {
+		if bytes.Equal(a.Data, b.Data) {
+			return 0, true
+		}
+		return 1, true
+	}
+	x, ok := sampleFloat(a)
+	if !ok {
+		return 0, false
+	}
+	y, _ := sampleFloat(b)
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	}
+	return 0, true
+}
+
+func sampleFloat(s telem.Series) (float64, bool) {
+	switch s.DataType {
+	case telem.Float64T:
+		return telem.ValueAt[float64](s, 0), true
+	case telem.Float32T:
+		return float64(telem.ValueAt[float32](s, 0)), true
+	case telem.Int64T:
+		return float64(telem.ValueAt[int64](s, 0)), true
+	case telem.Int32T:
+		return float64(telem.ValueAt[int32](s, 0)), true
+	case telem.Int16T:
+		return float64(telem.ValueAt[int16](s, 0)), true
+	case telem.Int8T:
+		return float64(telem.ValueAt[int8](s, 0)), true
+	case telem.Uint64T:
+		return float64(telem.ValueAt[uint64](s, 0)), true
+	case telem.Uint32T:
+		return float64(telem.ValueAt[uint32](s, 0)), true
+	case telem.Uint16T:
+		return float64(telem.ValueAt[uint16](s, 0)), true
+	case telem.Uint8T:
+		return float64(telem.ValueAt[uint8](s, 0)), true
+	case telem.TimeStampT:
+		return float64(telem.ValueAt[telem.TimeStamp](s, 0)), true
+	}
+	return 0, false
+}
+
+func sampleString(s telem.Series) string {
+	if s.DataType.IsVariable() {
+		return fmt.Sprintf("%q", string(s.Data))
+	}
+	if f, ok := sampleFloat(s); ok {
+		return fmt.Sprint(f)
+	}
+	return s.String()
+}
@@ -0,0 +1,292 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package test_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc"
+	"github.com/synnaxlabs/arc/runtime/node"
+	"github.com/synnaxlabs/arc/runtime/scheduler"
+	"github.com/synnaxlabs/arc/stl"
+	"github.com/synnaxlabs/arc/stl/channels"
+	"github.com/synnaxlabs/arc/stl/constant"
+	stlerrors "github.com/synnaxlabs/arc/stl/errors"
+	stlmath "github.com/synnaxlabs/arc/stl/math"
+	stlop "github.com/synnaxlabs/arc/stl/op"
+	"github.com/synnaxlabs/arc/stl/selector"
+	"github.com/synnaxlabs/arc/stl/series"
+	"github.com/synnaxlabs/arc/stl/stable"
+	"github.com/synnaxlabs/arc/stl/stateful"
+	stlstrings "github.com/synnaxlabs/arc/stl/strings"
+	"github.com/synnaxlabs/arc/stl/time"
+	"github.com/synnaxlabs/arc/stl/wasm"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/test"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/diagnostics"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+	"github.com/tetratelabs/wazero"
+)
+
+// channelResolver resolves the channels available to programs under test.
+type channelResolver map[string]*symbol.Symbol
+
+func (r channelResolver) Resolve(_ context.Context, name string) (*symbol.Symbol, error) {
+	if s, ok := r[name]; ok {
+		return s, nil
+	}
+	return nil, query.ErrNotFound
+}
+
+func (r channelResolver) Search(context.Context, string) ([]*symbol.Symbol, error) {
+	return nil, nil
+}
+
+// instance runs a compiled program with the standard library modules that don't
+// need a cluster.
+type instance struct {
+	scheduler    *scheduler.Scheduler
+	channels     *channels.ProgramState
+	series       *series.ProgramState
+	strings      *stlstrings.ProgramState
+	wasmRT       wazero.Runtime
+	baseInterval telem.TimeSpan
+}
+
+var _ test.Instance = (*instance)(nil)
+
+func (i *instance) Cycle(
+	ctx context.Context,
+	fr telem.Frame[uint32],
+	elapsed telem.TimeSpan,
+	reason node.RunReason,
+) (telem.Frame[uint32], bool) {
+	i.channels.Ingest(fr)
+	i.scheduler.Next(ctx, elapsed, reason)
+	i.channels.ClearReads()
+	i.series.Clear()
+	i.strings.Clear()
+	return i.channels.Flush(telem.Frame[uint32]{})
+}
+
+func (i *instance) Scheduler() *scheduler.Scheduler { return i.scheduler }
+
+func (i *instance) BaseInterval() telem.TimeSpan { return i.baseInterval }
+
+func (i *instance) Close() error { return i.wasmRT.Close(context.Background()) }
+
+// open instantiates prog for a test, in the same way as the runtime harness of the
+// arc package.
+func open(ctx context.Context, prog arc.Program, digests []channels.Digest, env test.Env) *instance {
+	inst := &instance{
+		channels: channels.NewProgramState(digests, channels.WithNow(env.Now)),
+		series:   series.NewProgramState(),
+		strings:  stlstrings.NewProgramState(),
+		wasmRT:   wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfigCompiler()),
+	}
+	timeMod := MustSucceed(time.NewHost(ctx, inst.wasmRT, time.WithNow(env.Now)))
+	channelMod := MustSucceed(channels.NewHost(ctx, inst.wasmRT, inst.channels, inst.strings))
+	statefulMod := MustSucceed(stateful.NewHost(ctx, inst.wasmRT, inst.series, inst.strings))
+	MustSucceed(series.NewHost(ctx, inst.wasmRT, inst.series))
+	stringsMod := MustSucceed(stlstrings.NewHost(ctx, inst.wasmRT, inst.strings, nil))
+	MustSucceed(stlmath.NewHost(ctx, inst.wasmRT))
+	errorsMod := MustSucceed(stlerrors.NewHost(ctx, inst.wasmRT, nil))
+	factory := node.CompoundFactory{
+		channelMod,
+		statefulMod,
+		timeMod,
+		selector.NewHost(),
+		constant.NewHost(constant.WithNow(env.Now)),
+		stlop.NewHost(),
+		stable.NewHost(stable.WithNow(env.Now)),
+	}
+	if len(prog.WASM) > 0 {
+		guest := MustSucceed(inst.wasmRT.Instantiate(ctx, prog.WASM))
+		stringsMod.SetMemory(guest.Memory())
+		errorsMod.SetMemory(guest.Memory())
+		factory = append(factory, &wasm.Module{
+			Module:        guest,
+			Memory:        guest.Memory(),
+			Strings:       inst.strings,
+			NodeKeySetter: statefulMod,
+			Now:           env.Now,
+		})
+	}
+	nodeState := node.New(prog.IR)
+	nodes := make(map[string]node.Node, len(prog.Nodes))
+	for _, n := range prog.Nodes {
+		nodes[n.Key] = MustSucceed(factory.Create(ctx, node.Config{
+			Node:    n,
+			Program: prog,
+			State:   nodeState.Node(n.Key),
+		}))
+	}
+	inst.baseInterval = timeMod.BaseInterval
+	inst.scheduler = scheduler.New(prog.IR, nodes, time.CalculateTolerance(timeMod.BaseInterval))
+	return inst
+}
+
+var _ = Describe("Run", func() {
+	var (
+		resolver = channelResolver{
+			"pressure_idx": {Name: "pressure_idx", Kind: symbol.KindChannel, Type: types.Chan(types.TimeStamp()), ID: 1},
+			"pressure":     {Name: "pressure", Kind: symbol.KindChannel, Type: types.Chan(types.F32()), ID: 2},
+			"vent_idx":     {Name: "vent_idx", Kind: symbol.KindChannel, Type: types.Chan(types.TimeStamp()), ID: 3},
+			"vent_cmd":     {Name: "vent_cmd", Kind: symbol.KindChannel, Type: types.Chan(types.U8()), ID: 4},
+		}
+		digests = []channels.Digest{
+			{Key: 1, Index: 1, DataType: telem.TimeStampT},
+			{Key: 2, Index: 1, DataType: telem.Float32T},
+			{Key: 3, Index: 3, DataType: telem.TimeStampT},
+			{Key: 4, Index: 3, DataType: telem.Uint8T},
+		}
+	)
+
+	runTests := func(ctx context.Context, source string) []test.Result {
+		prog := MustSucceed(arc.CompileText(
+			ctx,
+			arc.Text{Raw: source},
+			symbol.NewRoot(resolver, stl.NewSymbols()),
+		))
+		tests := MustSucceed(test.Parse(source))
+		return MustSucceed(test.Run(ctx, test.Config{
+			Channels: resolver,
+			Digests:  digests,
+			Start:    100 * telem.SecondTS,
+			Open: func(ctx context.Context, env test.Env) (test.Instance, error) {
+				return open(ctx, prog, digests, env), nil
+			},
+		}, tests))
+	}
+
+	It("Should pass a test whose assertions hold", func(ctx SpecContext) {
+		results := runTests(ctx, `
+pressure > 100 -> vent_cmd
+
+test "vents above 100" {
+    feed pressure 30
+    expect vent_cmd == 0
+    feed pressure 120
+    expect vent_cmd == 1
+    expect vent_cmd >= 1
+    expect vent_cmd != 0
+}
+`)
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Name).To(Equal("vents above 100"))
+		Expect(results[0].Failures).To(BeEmpty())
+		Expect(results[0].Passed()).To(BeTrue())
+	})
+
+	It("Should report failed assertions at the position of their step", func(ctx SpecContext) {
+		results := runTests(ctx, `
+pressure > 100 -> vent_cmd
+
+test "wrong threshold" {
+    feed pressure 90
+    expect vent_cmd == 1
+    expect vent_cmd < 0
+}
+`)
+		Expect(results[0].Passed()).To(BeFalse())
+		Expect(results[0].Failures).To(Equal([]test.Failure{
+			{
+				Position: diagnostics.Position{Line: 6, Col: 4},
+				Message:  "expected vent_cmd == 1, got 0",
+			},
+			{
+				Position: diagnostics.Position{Line: 7, Col: 4},
+				Message:  "expected vent_cmd < 0, got 0",
+			},
+		}))
+	})
+
+	It("Should run each test against a fresh instance of the program", func(ctx SpecContext) {
+		results := runTests(ctx, `
+pressure > 100 -> vent_cmd
+
+test "first" {
+    feed pressure 120
+    expect vent_cmd == 1
+}
+
+test "second" {
+    expect vent_cmd == 1
+}
+`)
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Passed()).To(BeTrue())
+		Expect(results[1].Failures).To(HaveLen(1))
+		Expect(results[1].Failures[0].Message).To(ContainSubstring("vent_cmd was never written"))
+	})
+
+	It("Should advance the simulated clock through stage transitions and waits", func(ctx SpecContext) {
+		results := runTests(ctx, `
+sequence abort {
+    stage armed {
+        pressure > 100 => vent
+    }
+    stage vent {
+        1 -> vent_cmd,
+        wait{duration=2s} => safe
+    }
+    stage safe {
+        0 -> vent_cmd
+    }
+}
+pressure => abort
+
+test "vents then safes" {
+    feed pressure [30, 120] every 1s
+    expect stage abort.vent
+    expect vent_cmd == 1
+    advance 1s
+    expect stage abort.vent
+    advance 1s
+    expect stage abort.safe
+    expect vent_cmd == 0
+}
+
+test "stays armed" {
+    feed pressure [30, 60, 90] every 1s
+    expect stage abort.vent
+}
+`)
+		Expect(results[0].Failures).To(BeEmpty())
+		Expect(results[1].Failures).To(Equal([]test.Failure{{
+			Position: diagnostics.Position{Line: 29, Col: 4},
+			Message:  "expected stage abort.vent to be active, got abort.armed",
+		}}))
+	})
+
+	It("Should report channels that cannot be resolved", func(ctx SpecContext) {
+		results := runTests(ctx, `
+pressure > 100 -> vent_cmd
+
+test "typo" {
+    feed presure 1
+}
+`)
+		Expect(results[0].Failures).To(Equal([]test.Failure{{
+			Position: diagnostics.Position{Line: 5, Col: 4},
+			Message:  "unknown channel presure",
+		}}))
+	})
+
+	It("Should require an open function", func(ctx SpecContext) {
+		Expect(test.Run(ctx, test.Config{Channels: resolver}, nil)).
+			Error().To(MatchError(ContainSubstring("open must be non-nil")))
+	})
+})
@@ -0,0 +1,423 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package test runs the test blocks declared in Arc source files. A test block feeds
+// scripted values into a program's channels on a simulated clock and asserts on the
+// program's channel writes, active stages, and status.set calls:
+//
+//	test "vents on overpressure" {
+//	    feed pressure [30, 120] every 1s
+//	    advance 2s
+//	    expect vent_cmd == 1
+//	    expect stage abort.vent
+//	    expect status "ox_alarm" error "Overpressure"
+//	}
+//
+// Each line of a test block is one step. Test blocks are skipped when a program is
+// compiled, so they can live alongside the program they test.
+package test
+
+import (
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/synnaxlabs/arc/literal"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/types"
+	"github.com/synnaxlabs/x/diagnostics"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// StepKind identifies what a test step does.
+type StepKind uint8
+
+const (
+	// StepFeed writes values to a channel read by the program.
+	StepFeed StepKind = iota + 1
+	// StepAdvance moves the simulated clock forward, firing any timers that come
+	// due.
+	StepAdvance
+	// StepExpectChannel asserts on the last value the program wrote to a channel.
+	StepExpectChannel
+	// StepExpectStage asserts that a stage of a sequence is active.
+	StepExpectStage
+	// StepExpectStatus asserts on the last status.set call for a status.
+	StepExpectStatus
+)
+
+// Step is a single line of a test block.
+type Step struct {
+	// Kind is what the step does.
+	Kind StepKind
+	// Position is where the step starts in the source file.
+	Position diagnostics.Position
+	// Channel is the name of the channel fed or asserted on.
+	Channel string
+	// Values holds the literal sources of the values to feed, or the single value
+	// to compare against. Values are parsed once the channel's type is known.
+	Values []string
+	// Every is the simulated time between consecutive fed values.
+	Every telem.TimeSpan
+	// Span is how far an advance step moves the simulated clock.
+	Span telem.TimeSpan
+	// Operator is the comparison operator of a channel assertion.
+	Operator string
+	// Sequence and Stage name the stage a stage assertion expects to be active.
+	Sequence string
+	Stage    string
+	// Status is the key or name of the status a status assertion checks.
+	Status string
+	// Variant is the variant the status is expected to have.
+	Variant string
+	// Message is the message the status is expected to have. An empty message is
+	// not checked.
+	Message string
+}
+
+// Test is a parsed test block.
+type Test struct {
+	// Name is the name of the test.
+	Name string
+	// Position is where the test block starts in the source file.
+	Position diagnostics.Position
+	// Steps are the steps of the test, in order.
+	Steps []Step
+}
+
+const (
+	keywordFeed    = "feed"
+	keywordAdvance = "advance"
+	keywordExpect  = "expect"
+	keywordEvery   = "every"
+	keywordStatus  = "status"
+)
+
+var operators = map[int]string{
+	parser.ArcLexerEQ:  "==",
+	parser.ArcLexerNEQ: "!=",
+	parser.ArcLexerLT:  "<",
+	parser.ArcLexerGT:  ">",
+	parser.ArcLexerLEQ: "<=",
+	parser.ArcLexerGEQ: ">=",
+}
+
+// Parse extracts and parses the test blocks in an Arc source file. The returned
+// diagnostics are nil if every test block is well formed.
+func Parse(source string, cfgs ...parser.Config) ([]Test, *diagnostics.Diagnostics) {
+	_, blocks := parser.SplitTests(source, cfgs...)
+	var (
+		tests = make([]Test, 0, len(blocks))
+		diag  = &diagnostics.Diagnostics{}
+	)
+	for _, b := range blocks {
+		name, err := literal.ParseString(b.Name, types.String())
+		if err != nil {
+			diag.Add(errorAt(b.Start, "%s", err.Error()))
+			continue
+		}
+		p := &blockParser{
+			body:  []rune(b.Body),
+			start: b.BodyStart,
+			diag:  diag,
+			cfg:   parser.ConfigOf(cfgs...),
+		}
+		tests = append(tests, Test{
+			Name:     name.Value.(string),
+			Position: b.Start,
+			Steps:    p.parse(),
+		})
+	}
+	if !diag.Ok() {
+		return tests, diag
+	}
+	return tests, nil
+}
+
+// blockParser parses the steps in the body of a single test block.
+type blockParser struct {
+	body  []rune
+	start diagnostics.Position
+	diag  *diagnostics.Diagnostics
+	cfg   parser.Config
+}
+
+func (p *blockParser) parse() []Step {
+	lexer := parser.NewLexer(string(p.body), p.cfg)
+	lexer.RemoveErrorListeners()
+	var (
+		steps []Step
+		line  []antlr.Token
+	)
+	flush := func() {
+		if len(line) == 0 {
+			return
+		}
+		if step, ok := p.parseStep(line); ok {
+			steps = append(steps, step)
+		}
+		line = nil
+	}
+	for _, tok := range lexer.GetAllTokens() {
+		if tok.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		if len(line) > 0 && line[0].GetLine() != tok.GetLine() {
+			flush()
+		}
+		line = append(line, tok)
+	}
+	flush()
+	return steps
+}
+
+// position converts the position of a token in the block body to its position in
+// the source file.
+func (p *blockParser) position(tok antlr.Token) diagnostics.Position {
+	if tok.GetLine() == 1 {
+		return diagnostics.Position{Line: p.start.Line, Col: p.start.Col + tok.GetColumn()}
+	}
+	return diagnostics.Position{Line: p.start.Line + tok.GetLine() - 1, Col: tok.GetColumn()}
+}
+
+// text returns the source spanned by toks.
+func (p *blockParser) text(toks []antlr.Token) string {
+	if len(toks) == 0 {
+		return ""
+	}
+	return string(p.body[toks[0].GetStart() : toks[len(toks)-1].GetStop()+1])
+}
+
+func (p *blockParser) errorf(tok antlr.Token, format string, args ...any) {
+	p.diag.Add(errorAt(p.position(tok), format, args...))
+}
+
+func (p *blockParser) parseStep(toks []antlr.Token) (Step, bool) {
+	step := Step{Position: p.position(toks[0])}
+	switch {
+	case isIdentifier(toks[0], keywordFeed):
+		return step, p.parseFeed(&step, toks)
+	case isIdentifier(toks[0], keywordAdvance):
+		step.Kind = StepAdvance
+		if len(toks) < 2 {
+			p.errorf(toks[0], "expected a time span after %s", keywordAdvance)
+			return step, false
+		}
+		span, ok := p.parseSpan(toks[1:])
+		step.Span = span
+		return step, ok
+	case isIdentifier(toks[0], keywordExpect):
+		return step, p.parseExpect(&step, toks)
+	}
+	p.errorf(
+		toks[0],
+		"unexpected %q: test steps start with %s, %s, or %s",
+		toks[0].GetText(),
+		keywordFeed,
+		keywordAdvance,
+		keywordExpect,
+	)
+	return step, false
+}
+
+// parseFeed parses `feed <channel> <value> [every <span>]`, where value is a literal
+// or a series literal of values fed one after another.
+func (p *blockParser) parseFeed(step *Step, toks []antlr.Token) bool {
+	step.Kind = StepFeed
+	if len(toks) < 3 || toks[1].GetTokenType() != parser.ArcLexerIDENTIFIER {
+		p.errorf(toks[0], "expected %s <channel> <value>", keywordFeed)
+		return false
+	}
+	step.Channel = toks[1].GetText()
+	rest := toks[2:]
+	for i, tok := range rest {
+		if !isIdentifier(tok, keywordEvery) {
+			continue
+		}
+		if i+1 == len(rest) {
+			p.errorf(tok, "expected a time span after %s", keywordEvery)
+			return false
+		}
+		every, ok := p.parseSpan(rest[i+1:])
+		if !ok {
+			return false
+		}
+		if every <= 0 {
+			p.errorf(tok, "%s must be a positive time span", keywordEvery)
+			return false
+		}
+		step.Every = every
+		rest = rest[:i]
+		break
+	}
+	if len(rest) == 0 {
+		p.errorf(toks[1], "expected a value to feed to %s", step.Channel)
+		return false
+	}
+	expr, diag := parser.ParseExpression(p.text(rest), p.cfg)
+	if diag != nil {
+		p.errorf(rest[0], "invalid value %q", p.text(rest))
+		return false
+	}
+	lit := parser.GetLiteral(expr)
+	if lit == nil {
+		p.errorf(rest[0], "expected a literal value, got %q", p.text(rest))
+		return false
+	}
+	if series := lit.SeriesLiteral(); series != nil {
+		if list := series.ExpressionList(); list != nil {
+			for _, e := range list.AllExpression() {
+				step.Values = append(step.Values, e.GetText())
+			}
+		}
+		if len(step.Values) == 0 {
+			p.errorf(rest[0], "expected at least one value to feed")
+			return false
+		}
+	} else {
+		step.Values = []string{p.text(rest)}
+	}
+	if len(step.Values) > 1 && step.Every == 0 {
+		p.errorf(rest[0], "feeding more than one value requires %s <span>", keywordEvery)
+		return false
+	}
+	return true
+}
+
+// parseExpect parses the three assertion forms:
+//
+//	expect <channel> <operator> <value>
+//	expect stage <sequence>.<stage>
+//	expect status "<key or name>" <variant> ["<message>"]
+func (p *blockParser) parseExpect(step *Step, toks []antlr.Token) bool {
+	if len(toks) < 2 {
+		p.errorf(toks[0], "expected a channel, stage, or status after %s", keywordExpect)
+		return false
+	}
+	switch {
+	case toks[1].GetTokenType() == parser.ArcLexerSTAGE:
+		step.Kind = StepExpectStage
+		if len(toks) != 5 ||
+			toks[2].GetTokenType() != parser.ArcLexerIDENTIFIER ||
+			toks[3].GetTokenType() != parser.ArcLexerDOT ||
+			toks[4].GetTokenType() != parser.ArcLexerIDENTIFIER {
+			p.errorf(toks[1], "expected %s stage <sequence>.<stage>", keywordExpect)
+			return false
+		}
+		step.Sequence, step.Stage = toks[2].GetText(), toks[4].GetText()
+		return true
+	case isIdentifier(toks[1], keywordStatus) &&
+		len(toks) > 2 &&
+		toks[2].GetTokenType() == parser.ArcLexerSTR_LITERAL:
+		step.Kind = StepExpectStatus
+		if len(toks) < 4 || len(toks) > 5 || toks[3].GetTokenType() != parser.ArcLexerIDENTIFIER {
+			p.errorf(toks[1], "expected %s status \"<key or name>\" <variant> [\"<message>\"]", keywordExpect)
+			return false
+		}
+		status, ok := p.parseString(toks[2])
+		if !ok {
+			return false
+		}
+		step.Status, step.Variant = status, toks[3].GetText()
+		if len(toks) == 5 {
+			if step.Message, ok = p.parseString(toks[4]); !ok {
+				return false
+			}
+		}
+		return true
+	}
+	step.Kind = StepExpectChannel
+	if len(toks) < 4 || toks[1].GetTokenType() != parser.ArcLexerIDENTIFIER {
+		p.errorf(toks[1], "expected %s <channel> <operator> <value>", keywordExpect)
+		return false
+	}
+	op, ok := operators[toks[2].GetTokenType()]
+	if !ok {
+		p.errorf(toks[2], "expected a comparison operator, got %q", toks[2].GetText())
+		return false
+	}
+	step.Channel, step.Operator = toks[1].GetText(), op
+	value := p.text(toks[3:])
+	expr, diag := parser.ParseExpression(value, p.cfg)
+	if diag != nil || parser.GetLiteral(expr) == nil {
+		p.errorf(toks[3], "expected a literal value, got %q", value)
+		return false
+	}
+	step.Values = []string{value}
+	return true
+}
+
+func (p *blockParser) parseString(tok antlr.Token) (string, bool) {
+	if tok.GetTokenType() != parser.ArcLexerSTR_LITERAL {
+		p.errorf(tok, "expected a string, got %q", tok.GetText())
+		return "", false
+	}
+	v, err := literal.ParseString(tok.GetText(), types.String())
+	if err != nil {
+		p.errorf(tok, "%s", err.Error())
+		return "", false
+	}
+	return v.Value.(string), true
+}
+
+func (p *blockParser) parseSpan(toks []antlr.Token) (telem.TimeSpan, bool) {
+	span, err := parseSpan(p.text(toks), p.cfg)
+	if err != nil {
+		p.errorf(toks[0], "%s", err.Error())
+		return 0, false
+	}
+	return span, true
+}
+
+// parseSpan parses a time span literal such as 500ms.
+func parseSpan(src string, cfg parser.Config) (telem.TimeSpan, error) {
+	v, err := parseValue(src, types.TimeSpan(), cfg)
+	if err != nil {
+		return 0, err
+	}
+	switch span := v.(type) {
+	case telem.TimeSpan:
+		return span, nil
+	case int64:
+		return telem.TimeSpan(span), nil
+	}
+	return 0, errors.Newf("expected a time span, got %q", src)
+}
+
+// parseValue parses the literal in src as a value of type t.
+func parseValue(src string, t types.Type, cfg parser.Config) (any, error) {
+	expr, diag := parser.ParseExpression(src, cfg)
+	if diag != nil {
+		return nil, errors.Newf("invalid value %q", src)
+	}
+	lit := parser.GetLiteral(expr)
+	if lit == nil {
+		return nil, errors.Newf("expected a literal value, got %q", src)
+	}
+	v, err := literal.Parse(lit, t)
+	if err != nil {
+		return nil, err
+	}
+	if parser.IsNegatedLiteral(expr) {
+		v.Value = literal.Negate(v.Value)
+	}
+	return v.Value, nil
+}
+
+func isIdentifier(tok antlr.Token, name string) bool {
+	return tok.GetTokenType() == parser.ArcLexerIDENTIFIER && tok.GetText() == name
+}
+
+func errorAt(pos diagnostics.Position, format string, args ...any) diagnostics.Diagnostic {
+	return diagnostics.Diagnostic{
+		Severity: diagnostics.SeverityError,
+		Start:    pos,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
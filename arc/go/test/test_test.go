@@ -0,0 +1,115 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package test_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/test"
+	"github.com/synnaxlabs/x/diagnostics"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Parse", func() {
+	It("Should parse every kind of step", func() {
+		tests := MustSucceed(test.Parse(`
+x := 1
+test "vents on overpressure" {
+    feed pressure [30, -120.5] every 500ms
+    feed mode "auto"
+    advance 2s
+    expect vent_cmd == 1
+    expect stage abort.vent
+    expect status "ox_alarm" error "Overpressure"
+    expect status "ox_alarm" warning
+}
+`))
+		Expect(tests).To(HaveLen(1))
+		Expect(tests[0].Name).To(Equal("vents on overpressure"))
+		Expect(tests[0].Position).To(Equal(diagnostics.Position{Line: 3, Col: 0}))
+		Expect(tests[0].Steps).To(Equal([]test.Step{
+			{
+				Kind:     test.StepFeed,
+				Position: diagnostics.Position{Line: 4, Col: 4},
+				Channel:  "pressure",
+				Values:   []string{"30", "-120.5"},
+				Every:    500 * telem.Millisecond,
+			},
+			{
+				Kind:     test.StepFeed,
+				Position: diagnostics.Position{Line: 5, Col: 4},
+				Channel:  "mode",
+				Values:   []string{`"auto"`},
+			},
+			{
+				Kind:     test.StepAdvance,
+				Position: diagnostics.Position{Line: 6, Col: 4},
+				Span:     2 * telem.Second,
+			},
+			{
+				Kind:     test.StepExpectChannel,
+				Position: diagnostics.Position{Line: 7, Col: 4},
+				Channel:  "vent_cmd",
+				Operator: "==",
+				Values:   []string{"1"},
+			},
+			{
+				Kind:     test.StepExpectStage,
+				Position: diagnostics.Position{Line: 8, Col: 4},
+				Sequence: "abort",
+				Stage:    "vent",
+			},
+			{
+				Kind:     test.StepExpectStatus,
+				Position: diagnostics.Position{Line: 9, Col: 4},
+				Status:   "ox_alarm",
+				Variant:  "error",
+				Message:  "Overpressure",
+			},
+			{
+				Kind:     test.StepExpectStatus,
+				Position: diagnostics.Position{Line: 10, Col: 4},
+				Status:   "ox_alarm",
+				Variant:  "warning",
+			},
+		}))
+	})
+
+	It("Should ignore comments and blank lines", func() {
+		tests := MustSucceed(test.Parse("test \"a\" {\n  // setup\n\n  advance 1s // wait\n}"))
+		Expect(tests[0].Steps).To(HaveLen(1))
+		Expect(tests[0].Steps[0].Span).To(Equal(telem.Second))
+	})
+
+	It("Should return no tests for a program without test blocks", func() {
+		Expect(test.Parse("x := 1")).To(BeEmpty())
+	})
+
+	DescribeTable("Should report malformed steps",
+		func(step string, msg string) {
+			_, diag := test.Parse("test \"a\" {\n  " + step + "\n}")
+			Expect(diag).ToNot(BeNil())
+			Expect(diag.Errors()).To(HaveLen(1))
+			Expect(diag.Errors()[0].Message).To(ContainSubstring(msg))
+			Expect(diag.Errors()[0].Start.Line).To(Equal(2))
+		},
+		Entry("unknown step", "wait 1s", `unexpected "wait"`),
+		Entry("feed without a value", "feed pressure", "expected feed <channel> <value>"),
+		Entry("series without every", "feed pressure [1, 2]", "requires every"),
+		Entry("non-literal value", "feed pressure a + b", "expected a literal value"),
+		Entry("non-positive every", "feed pressure [1, 2] every 0s", "must be a positive"),
+		Entry("advance without a span", "advance", "expected a time span"),
+		Entry("assignment instead of comparison", "expect vent_cmd = 1", "expected a comparison operator"),
+		Entry("missing operator", "expect vent_cmd 1", "expected expect <channel> <operator> <value>"),
+		Entry("malformed stage", "expect stage abort", "expected expect stage <sequence>.<stage>"),
+		Entry("status without variant", `expect status "ox"`, "expected expect status"),
+	)
+})
@@ -18,6 +18,7 @@ import (
 	"github.com/antlr4-go/antlr/v4"
 	"github.com/synnaxlabs/arc/analyzer"
 	"github.com/synnaxlabs/arc/analyzer/authority"
+	"github.com/synnaxlabs/arc/analyzer/safety"
 	acontext "github.com/synnaxlabs/arc/analyzer/context"
 	"github.com/synnaxlabs/arc/compiler"
 	"github.com/synnaxlabs/arc/ir"
@@ -557,6 +558,15 @@ func analyzeFunctionNode(
 		))
 		return nodeResult{}, false
 	}
+	if port, ok := findStructPort(sym.Type); ok {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			ctx.AST,
+			"function '%s' cannot be used as a flow statement: %s '%s' has struct type %s, "+
+				"and struct values can only be passed between func blocks",
+			name, port.kind, port.param.Name, port.param.Type,
+		))
+		return nodeResult{}, false
+	}
 	// Node.Type is the canonical module path so factories find it. After
 	// alias indirection in Resolve, sym's tree position already points at
 	// the canonical module member; QualifiedName joins the parts.
@@ -567,19 +577,15 @@ func analyzeFunctionNode(
 		Type:     nodeType,
 		Channels: sym.Channels.Copy(),
 		Config:   slices.Clone(freshType.Config),
+		Inputs:   slices.Clone(sym.FlowInputs(freshType)),
 		Outputs:  slices.Clone(freshType.Outputs),
 	}
-	// STL ExecBoth inputs mirror config; in flow form the upstream is a
-	// trigger, so omit Inputs. User-defined funcs (AST != nil) keep them.
-	upstreamIsTrigger := sym.Exec == symbol.ExecBoth && sym.AST == nil
-	if !upstreamIsTrigger {
-		n.Inputs = slices.Clone(freshType.Inputs)
-	}
 	var ok bool
 	n.Config, ok = extractConfigValues(acontext.Child(ctx, ctx.AST.ConfigValues()), n.Config, n, sym)
 	if !ok {
 		return nodeResult{}, false
 	}
+	n.Config = n.Config.Flatten()
 	return newNodeResult(n, firstInputParam(n.Inputs), firstOutputParam(n.Outputs)), true
 }
 
@@ -698,6 +704,79 @@ func analyzeExpression(
 	return newNodeResult(n, ir.DefaultInputParam, ir.DefaultOutputParam), true
 }
 
+type structPort struct {
+	kind  string
+	param types.Param
+}
+
+// findStructPort returns the first struct-typed input or output of the function type
+// t. Edges carry primitive values only, so such functions cannot be flow nodes.
+func findStructPort(t types.Type) (structPort, bool) {
+	for _, p := range t.Inputs {
+		if p.Type.IsStruct() {
+			return structPort{kind: "input", param: p}, true
+		}
+	}
+	for _, p := range t.Outputs {
+		if p.Type.IsStruct() {
+			return structPort{kind: "output", param: p}, true
+		}
+	}
+	return structPort{}, false
+}
+
+// newFunction builds the IR function for the user-defined function symbol c.
+// Struct-typed params are flattened to one param per field, which is how the
+// compiled function receives and produces them.
+func newFunction(key string, c *symbol.Symbol) ir.Function {
+	fnDecl, ok := c.AST.(parser.IFunctionDeclarationContext)
+	var bodyAst antlr.ParserRuleContext = fnDecl
+	if ok {
+		bodyAst = fnDecl.Block()
+	}
+	exprDecl, ok := c.AST.(parser.IExpressionContext)
+	if ok {
+		bodyAst = exprDecl
+	}
+	return ir.Function{
+		Key:      key,
+		Body:     ir.Body{Raw: bodyAst.GetText(), AST: bodyAst},
+		Config:   c.Type.Config.Flatten(),
+		Inputs:   c.Type.Inputs.Flatten(),
+		Outputs:  c.Type.Outputs.Flatten(),
+		Channels: c.Channels,
+	}
+}
+
+// appendLibraryFunctions appends the functions of every library imported by
+// scope, and of the libraries those libraries import, to fns. Library
+// functions are keyed by their qualified name so that they cannot collide with
+// the program's own functions. visited holds the libraries that have already
+// been linked.
+func appendLibraryFunctions(
+	fns ir.Functions,
+	scope *symbol.Symbol,
+	visited set.Set[*symbol.Symbol],
+) ir.Functions {
+	for _, c := range scope.Children() {
+		if c.Kind != symbol.KindModuleAlias || c.Target == nil || !c.Target.IsLibrary() {
+			continue
+		}
+		lib := c.Target
+		if visited.Contains(lib) {
+			continue
+		}
+		visited.Add(lib)
+		for _, member := range lib.Children() {
+			if member.Kind == symbol.KindFunction && member.AST != nil {
+				fns = append(fns, newFunction(member.QualifiedName(), member))
+			}
+		}
+		fns = appendLibraryFunctions(fns, lib, visited)
+	}
+	return fns
+}
+
 // Analyze performs semantic analysis on parsed Arc code and builds the IR.
 // Returns a partially complete IR even on errors for LSP support.
 //
@@ -717,6 +796,7 @@ func Analyze(
 
 	analyzer.AnalyzeProgram(aCtx)
 	i.Authorities = authority.Analyze(aCtx)
+	i.Safety = safety.Analyze(aCtx)
 	if !aCtx.Diagnostics.Ok() {
 		return i, aCtx.Diagnostics
 	}
@@ -725,24 +805,9 @@ func Analyze(
 		if c.Kind != symbol.KindFunction || c.AST == nil {
 			continue
 		}
-		fnDecl, ok := c.AST.(parser.IFunctionDeclarationContext)
-		var bodyAst antlr.ParserRuleContext = fnDecl
-		if ok {
-			bodyAst = fnDecl.Block()
-		}
-		exprDecl, ok := c.AST.(parser.IExpressionContext)
-		if ok {
-			bodyAst = exprDecl
-		}
-		i.Functions = append(i.Functions, ir.Function{
-			Key:      c.Name,
-			Body:     ir.Body{Raw: bodyAst.GetText(), AST: bodyAst},
-			Config:   c.Type.Config,
-			Inputs:   c.Type.Inputs,
-			Outputs:  c.Type.Outputs,
-			Channels: c.Channels,
-		})
+		i.Functions = append(i.Functions, newFunction(c.Name, c))
 	}
+	i.Functions = appendLibraryFunctions(i.Functions, i.Symbols, make(set.Set[*symbol.Symbol]))
 	kg := newKeyGenerator(&i.Functions)
 	shell := newShellBuilder(collectSynthByAST(aCtx.Scope.Root()))
 
@@ -942,7 +1007,8 @@ func (p *flowChainProcessor) injectImplicitTriggers(expr parser.IExpressionConte
 func (p *flowChainProcessor) processFlowNode(flowNode parser.IFlowNodeContext) bool {
 	p.currentIndex++
 	isLast := p.currentIndex == p.totalFlowNodes
-	isSink := isLast && flowNode.Identifier() != nil
+	// A lone channel before a routing table (mode => {...}) is the table's source.
+	isSink := isLast && p.currentIndex > 1 && flowNode.Identifier() != nil
 
 	// Inject implicit triggers for expression as first node
 	if p.currentIndex == 1 && p.prevNode == nil {
@@ -1044,9 +1110,25 @@ func (p *flowChainProcessor) processRoutingTable(rt parser.IRoutingTableContext)
 		))
 		return false
 	}
+	source := *p.prevNode
+	if parser.IsEnumRoutingTable(rt) {
+		route, ok := buildEnumRouteNode(acontext.Child(p.ctx, rt), source, p.kg)
+		if !ok {
+			return false
+		}
+		// The route node must see every value, including the zero value of the
+		// enum, so it is always fed by a continuous edge.
+		p.edges = append(p.edges, ir.Edge{
+			Source: p.prevOutput,
+			Target: ir.Handle{Node: route.Key, Param: ir.DefaultInputParam},
+			Kind:   ir.EdgeKindContinuous,
+		})
+		p.nodes = append(p.nodes, route)
+		source = route
+	}
 	newNodes, newEdges, inlineMembers, ok := analyzeOutputRoutingTable(
 		acontext.Child(p.ctx, rt),
-		*p.prevNode,
+		source,
 		p.kg,
 		p.shell,
 	)
@@ -1110,7 +1192,53 @@ func extractConfigValues(
 		return config, true
 	}
 
-	parseConfigExpr := func(
+	var parseConfigExpr func(
+		expr parser.IExpressionContext,
+		paramType types.Type,
+		paramName string,
+	) (any, bool)
+
+	// parseStructConfig parses a struct literal config value into a map from field
+	// name to field value, the form types.Params.Flatten expects.
+	parseStructConfig := func(
+		expr parser.IExpressionContext,
+		paramType types.Type,
+		paramName string,
+	) (any, bool) {
+		primary := parser.GetPrimaryExpression(expr)
+		if primary == nil || primary.StructLiteral() == nil {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				expr,
+				"config value for '%s' must be a %s literal",
+				paramName, paramType,
+			))
+			return nil, false
+		}
+		values := make(map[string]any, len(paramType.Fields))
+		for _, fv := range primary.StructLiteral().AllStructFieldValue() {
+			fieldName := fv.IDENTIFIER().GetText()
+			field, _, ok := paramType.Field(fieldName)
+			if !ok {
+				ctx.Diagnostics.Add(diagnostics.Errorf(
+					fv, "struct %s has no field '%s'", paramType, fieldName,
+				))
+				return nil, false
+			}
+			value, ok := parseConfigExpr(fv.Expression(), field.Type, paramName+"."+fieldName)
+			if !ok {
+				return nil, false
+			}
+			values[fieldName] = value
+		}
+		for _, field := range paramType.Fields {
+			if _, ok := values[field.Name]; !ok {
+				values[field.Name] = zeroConfigValue(field.Type)
+			}
+		}
+		return values, true
+	}
+
+	parseConfigExpr = func(
 		expr parser.IExpressionContext,
 		paramType types.Type,
 		paramName string,
@@ -1131,6 +1259,10 @@ func extractConfigValues(
 			return channelKey, true
 		}
 
+		if paramType.IsStruct() {
+			return parseStructConfig(expr, paramType, paramName)
+		}
+
 		if primary := parser.GetPrimaryExpression(expr); primary != nil {
 			if id := primary.IDENTIFIER(); id != nil {
 				sym, err := ctx.Scope.Resolve(ctx, id.GetText())
@@ -1191,6 +1323,18 @@ func extractConfigValues(
 	return config, true
 }
 
+// zeroConfigValue returns the value an omitted struct field takes in a config value.
+func zeroConfigValue(t types.Type) any {
+	if !t.IsStruct() {
+		return literal.Zero(t)
+	}
+	values := make(map[string]any, len(t.Fields))
+	for _, f := range t.Fields {
+		values[f.Name] = zeroConfigValue(f.Type)
+	}
+	return values
+}
+
 // collectSynthByAST returns a map from each inline-body declaration in the tree
 // rooted at root to its synth scope, keyed by the declaration's parser node.
 func collectSynthByAST(root *symbol.Symbol) map[antlr.ParserRuleContext]*symbol.Symbol {
@@ -1257,7 +1401,11 @@ func analyzeOutputRoutingTable(
 	)
 
 	for _, entry := range ctx.AST.AllRoutingEntry() {
-		outputName := entry.IDENTIFIER(0).GetText()
+		// Enum routing nodes name their outputs after the member (Mode.fire -> fire).
+		outputName, member := parser.RoutingKeyParts(entry)
+		if member != "" {
+			outputName = member
+		}
 		if !sourceNode.Outputs.Has(outputName) {
 			ctx.Diagnostics.Add(diagnostics.Errorf(
 				entry,
@@ -1274,8 +1422,8 @@ func analyzeOutputRoutingTable(
 		}
 
 		var targetParamName string
-		if len(entry.AllIDENTIFIER()) > 1 {
-			targetParamName = entry.IDENTIFIER(1).GetText()
+		if param := entry.IDENTIFIER(); param != nil {
+			targetParamName = param.GetText()
 		}
 
 		sourceOutput := ir.Handle{Node: sourceNode.Key, Param: outputName}
@@ -1330,6 +1478,67 @@ func analyzeOutputRoutingTable(
 	return nodes, edges, inlineMembers, true
 }
 
+// buildEnumRouteNode lowers the keys of an enum routing table into a synthetic
+// function node with one output per entry, named after its member. The node holds
+// the member values in its config, and fires the output of the member equal to its
+// input, so the entries can then be wired like those of any output routing table.
+func buildEnumRouteNode(
+	ctx acontext.Context[parser.IRoutingTableContext],
+	source ir.Node,
+	kg *keyGenerator,
+) (ir.Node, bool) {
+	if len(source.Outputs) == 0 {
+		ctx.Diagnostics.Add(diagnostics.Errorf(
+			ctx.AST, "enum routing table requires a value to route on",
+		))
+		return ir.Node{}, false
+	}
+	t := ctx.Constraints.ApplySubstitutions(source.Outputs[0].Type)
+	t.Name = ""
+	t.Unit = nil
+	var (
+		params  types.Params
+		config  types.Params
+		outputs types.Params
+	)
+	for _, entry := range ctx.AST.AllRoutingEntry() {
+		head, tail := parser.RoutingKeyParts(entry)
+		member, err := ctx.ResolveQualified(head, tail)
+		if err != nil {
+			ctx.Diagnostics.Add(diagnostics.Error(err, entry))
+			return ir.Node{}, false
+		}
+		parsed, err := literal.ParseInteger(member.DefaultValue.(int64), t)
+		if err != nil {
+			ctx.Diagnostics.Add(diagnostics.Errorf(
+				entry, "cannot route on %s.%s: %v", head, tail, err,
+			))
+			return ir.Node{}, false
+		}
+		params = append(params, types.Param{Name: tail, Type: t})
+		config = append(config, types.Param{Name: tail, Type: t, Value: parsed.Value})
+		outputs = append(outputs, types.Param{Name: tail, Type: types.U8()})
+	}
+	key := kg.generate("enum_route", "")
+	synthKey := compiler.EnumRouteSyntheticPrefix + key
+	inputs := types.Params{{Name: ir.DefaultInputParam, Type: t}}
+	*kg.synthFuncs = append(*kg.synthFuncs, ir.Function{
+		Key:      synthKey,
+		Inputs:   inputs,
+		Config:   params,
+		Outputs:  outputs,
+		Channels: types.NewChannels(),
+	})
+	return ir.Node{
+		Key:      key,
+		Type:     synthKey,
+		Channels: types.NewChannels(),
+		Config:   config,
+		Inputs:   inputs,
+		Outputs:  outputs,
+	}, true
+}
+
 // stepInfo collects metadata about a step for computing member keys.
 type stepInfo struct {
 	key  string
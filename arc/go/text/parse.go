@@ -17,9 +17,11 @@ import (
 // Parse parses Arc source code into an AST.
 //
 // Returns the Text with both Raw source and parsed AST. Returns a diagnostic object
-// that will be nil if no errors occurred during the parsing process.
+// that will be nil if no errors occurred during the parsing process. Top-level test
+// blocks are not part of the program and are skipped; see parser.SplitTests.
 func Parse(t Text, cfgs ...parser.Config) (Text, *diagnostics.Diagnostics) {
-	ast, diag := parser.Parse(t.Raw, cfgs...)
+	program, _ := parser.SplitTests(t.Raw, cfgs...)
+	ast, diag := parser.Parse(program, cfgs...)
 	if diag != nil {
 		return Text{}, diag
 	}
@@ -358,6 +358,50 @@ var _ = Describe("Text", func() {
 				Expect(node.Config[1].Value).To(Equal(2.5))
 			})
 
+			It("Should flatten a struct config value into one config param per field", func(ctx SpecContext) {
+				source := `
+				struct Limits { lo f64, hi f64 }
+
+				func clamp{limits Limits} () f64 {
+				    return limits.hi
+				}
+
+				func print{} () {}
+
+				clamp{limits=Limits{hi: 10.0}} -> print{}`
+				parsedText := MustSucceed(text.Parse(text.Text{Raw: source}))
+				inter, diagnostics := text.Analyze(ctx, parsedText, NewRoot(nil))
+				Expect(diagnostics.Ok()).To(BeTrue(), diagnostics.String())
+
+				node := findNodeByKey(inter.Nodes, "clamp_0")
+				Expect(node.Config).To(HaveLen(2))
+				Expect(node.Config[0].Name).To(Equal("limits.lo"))
+				Expect(node.Config[0].Value).To(Equal(0.0))
+				Expect(node.Config[1].Name).To(Equal("limits.hi"))
+				Expect(node.Config[1].Value).To(Equal(10.0))
+				fn := MustBeOk(inter.Functions.Find("clamp"))
+				Expect(fn.Config).To(HaveLen(2))
+			})
+
+			It("Should reject a function with a struct input as a flow statement", func(ctx SpecContext) {
+				source := `
+				struct Point { x f64, y f64 }
+
+				func norm{} (p Point) f64 {
+				    return p.x + p.y
+				}
+
+				func print{} () {}
+
+				norm{} -> print{}`
+				parsedText := MustSucceed(text.Parse(text.Text{Raw: source}))
+				_, diagnostics := text.Analyze(ctx, parsedText, NewRoot(nil))
+				Expect(diagnostics.Ok()).To(BeFalse())
+				Expect(diagnostics.Errors()[0].Message).To(ContainSubstring(
+					"input 'p' has struct type Point",
+				))
+			})
+
 			It("Should handle simple config with multiple values", func(ctx SpecContext) {
 				source := `
 				func calculator{a i64, b i64, c i64} () i64 {
@@ -3493,6 +3537,38 @@ time.wait{duration=500ms} -> output`
 		})
 	})
 
+	Describe("Safety Analysis", func() {
+		It("Should include safety envelopes in IR", func(ctx SpecContext) {
+			resolver := []symbol.Symbol{
+				{Name: "valve", Kind: symbol.KindChannel, Type: types.Chan(types.F64()), ID: 100},
+				{Name: "pressure", Kind: symbol.KindChannel, Type: types.Chan(types.F64()), ID: 200},
+			}
+			source := `
+			safety valve{min=0, max=100, interlock=pressure > 500, safe_stage=main.abort}
+
+			func a{} () f64 {
+			    return 0.0
+			}
+			a{} -> valve
+
+			sequence main {
+			    stage run {}
+			    stage abort {}
+			}`
+			parsedText := MustSucceed(text.Parse(text.Text{Raw: source}))
+			inter, diagnostics := text.Analyze(ctx, parsedText, NewRoot(nil, resolver...))
+			Expect(diagnostics.Ok()).To(BeTrue(), diagnostics.String())
+			Expect(inter.Safety).To(HaveLen(1))
+			Expect(inter.Safety[0].Channel).To(Equal(uint32(100)))
+			Expect(*inter.Safety[0].Max).To(Equal(100.0))
+			Expect(inter.Safety[0].Interlocks).To(Equal([]ir.Interlock{
+				{Channel: 200, Comparison: ">", Value: 500},
+			}))
+			Expect(inter.Safety[0].SafeSequence).To(Equal("main"))
+			Expect(inter.Safety[0].SafeStage).To(Equal("abort"))
+		})
+	})
+
 	Describe("Compile", func() {
 		It("Should compile a simple arc program to WebAssembly", func(ctx SpecContext) {
 			resolver := []symbol.Symbol{
@@ -321,6 +321,17 @@ func (t Type) EncodeOrc(w *orc.Writer) error {
 		w.Bool(false)
 	}
 	w.Int64(int64(t.ChanDirection))
+	if t.Fields != nil {
+		w.Bool(true)
+		w.Uint32(uint32(len(t.Fields)))
+		for j := range t.Fields {
+			if err := t.Fields[j].EncodeOrc(w); err != nil {
+				return err
+			}
+		}
+	} else {
+		w.Bool(false)
+	}
 	return nil
 }
 
@@ -436,6 +447,24 @@ func (t *Type) DecodeOrc(r *orc.Reader) error {
 		}
 		t.ChanDirection = ChanDirection(v)
 	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			n, err := r.CollectionLen()
+			if err != nil {
+				return err
+			}
+			t.Fields = make([]Param, n)
+			for j := range t.Fields {
+				if err = t.Fields[j].DecodeOrc(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
 	return nil
 }
 
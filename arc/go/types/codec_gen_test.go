@@ -129,14 +129,15 @@ var _ = Describe("Codec", func() {
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      30.5,
-									Name:       "test_31",
+									Scale:      31.5,
+									Name:       "test_32",
 								}
 								return &v
 							}(),
@@ -148,48 +149,56 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_37",
+									Name:          "test_38",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_46",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_48": "value_48"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_43": "value_43"},
+						Value: map[string]interface{}{"key_49": "value_49"},
 					},
 				},
 				Outputs: []types.Param{
 					{
-						Name: "test_45",
+						Name: "test_51",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_48",
+										Name:  "test_54",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_50": "value_50"},
+										Value: map[string]interface{}{"key_56": "value_56"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_52",
+										Name:  "test_58",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_54": "value_54"},
+										Value: map[string]interface{}{"key_60": "value_60"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_56",
+										Name:  "test_62",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_58": "value_58"},
+										Value: map[string]interface{}{"key_64": "value_64"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_60",
+							Name: "test_66",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -198,19 +207,20 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_66",
+									Name:          "test_72",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      73.5,
-									Name:       "test_74",
+									Scale:      80.5,
+									Name:       "test_81",
 								}
 								return &v
 							}(),
@@ -222,48 +232,56 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_80",
+									Name:          "test_87",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_95",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_97": "value_97"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_86": "value_86"},
+						Value: map[string]interface{}{"key_98": "value_98"},
 					},
 				},
 				Config: []types.Param{
 					{
-						Name: "test_88",
+						Name: "test_100",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_91",
+										Name:  "test_103",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_93": "value_93"},
+										Value: map[string]interface{}{"key_105": "value_105"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_95",
+										Name:  "test_107",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_97": "value_97"},
+										Value: map[string]interface{}{"key_109": "value_109"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_99",
+										Name:  "test_111",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_101": "value_101"},
+										Value: map[string]interface{}{"key_113": "value_113"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_103",
+							Name: "test_115",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -272,19 +290,20 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_109",
+									Name:          "test_121",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      116.5,
-									Name:       "test_117",
+									Scale:      129.5,
+									Name:       "test_130",
 								}
 								return &v
 							}(),
@@ -296,17 +315,25 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_123",
+									Name:          "test_136",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_144",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_146": "value_146"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_129": "value_129"},
+						Value: map[string]interface{}{"key_147": "value_147"},
 					},
 				},
 			}),
@@ -352,13 +379,14 @@ var _ = Describe("Codec", func() {
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_15": "value_15"},
+								Value: map[string]interface{}{"key_16": "value_16"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name: "test_17",
+								Name: "test_18",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -366,18 +394,19 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_23",
+									Name:          "test_24",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_28": "value_28"},
+								Value: map[string]interface{}{"key_30": "value_30"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name: "test_30",
+								Name: "test_32",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -385,45 +414,46 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_36",
+									Name:          "test_38",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_41": "value_41"},
+								Value: map[string]interface{}{"key_44": "value_44"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_43",
+					Name: "test_46",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_46",
+										Name:  "test_49",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_48": "value_48"},
+										Value: map[string]interface{}{"key_51": "value_51"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_50",
+										Name:  "test_53",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_52": "value_52"},
+										Value: map[string]interface{}{"key_55": "value_55"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_54",
+										Name:  "test_57",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_56": "value_56"},
+										Value: map[string]interface{}{"key_59": "value_59"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_58",
+							Name: "test_61",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -432,19 +462,20 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_64",
+									Name:          "test_67",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      71.5,
-									Name:       "test_72",
+									Scale:      75.5,
+									Name:       "test_76",
 								}
 								return &v
 							}(),
@@ -456,32 +487,40 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_78",
+									Name:          "test_82",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_90",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_92": "value_92"},
+								},
+							},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{
-								Length:      87,
-								Mass:        88,
-								Time:        89,
-								Current:     90,
-								Temperature: 91,
-								Angle:       92,
-								Count:       93,
-								Data:        94,
-							},
-							Scale: 94.5,
-							Name:  "test_95",
+								Length:      96,
+								Mass:        97,
+								Time:        98,
+								Current:     99,
+								Temperature: 100,
+								Angle:       101,
+								Count:       102,
+								Data:        103,
+							},
+							Scale: 103.5,
+							Name:  "test_104",
 						}
 						return &v
 					}(),
@@ -490,28 +529,28 @@ var _ = Describe("Codec", func() {
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_98",
+										Name:  "test_107",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_100": "value_100"},
+										Value: map[string]interface{}{"key_109": "value_109"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_102",
+										Name:  "test_111",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_104": "value_104"},
+										Value: map[string]interface{}{"key_113": "value_113"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_106",
+										Name:  "test_115",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_108": "value_108"},
+										Value: map[string]interface{}{"key_117": "value_117"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_110",
+							Name: "test_119",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -520,19 +559,20 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_116",
+									Name:          "test_125",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      123.5,
-									Name:       "test_124",
+									Scale:      133.5,
+									Name:       "test_134",
 								}
 								return &v
 							}(),
@@ -544,21 +584,49 @@ var _ = Describe("Codec", func() {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_130",
+									Name:          "test_140",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_148",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_150": "value_150"},
+								},
+							},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name: "test_153",
+							Type: types.Type{
+								FunctionProperties: types.FunctionProperties{
+									Inputs:  []types.Param{{}},
+									Outputs: []types.Param{{}},
+									Config:  []types.Param{{}},
+								},
+								Kind:          types.Kind(0),
+								Name:          "test_159",
+								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
+							},
+							Value: map[string]interface{}{"key_165": "value_165"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_137": "value_137"},
+				Value: map[string]interface{}{"key_166": "value_166"},
 			}),
 			Entry("zero values", types.Param{
 				Name: "",
@@ -574,6 +642,7 @@ var _ = Describe("Codec", func() {
 					Unit:          nil,
 					Constraint:    nil,
 					ChanDirection: types.ChanDirection(0),
+					Fields:        nil,
 				},
 				Value: nil,
 			}),
@@ -634,14 +703,15 @@ var _ = Describe("Codec", func() {
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      30.5,
-										Name:       "test_31",
+										Scale:      31.5,
+										Name:       "test_32",
 									}
 									return &v
 								}(),
@@ -653,48 +723,56 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_37",
+										Name:          "test_38",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields: []types.Param{
+									{
+										Name:  "test_46",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_48": "value_48"},
+									},
+								},
 							},
-							Value: map[string]interface{}{"key_43": "value_43"},
+							Value: map[string]interface{}{"key_49": "value_49"},
 						},
 					},
 					Outputs: []types.Param{
 						{
-							Name: "test_45",
+							Name: "test_51",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs: []types.Param{
 										{
-											Name:  "test_48",
+											Name:  "test_54",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_50": "value_50"},
+											Value: map[string]interface{}{"key_56": "value_56"},
 										},
 									},
 									Outputs: []types.Param{
 										{
-											Name:  "test_52",
+											Name:  "test_58",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_54": "value_54"},
+											Value: map[string]interface{}{"key_60": "value_60"},
 										},
 									},
 									Config: []types.Param{
 										{
-											Name:  "test_56",
+											Name:  "test_62",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_58": "value_58"},
+											Value: map[string]interface{}{"key_64": "value_64"},
 										},
 									},
 								},
 								Kind: types.Kind(0),
-								Name: "test_60",
+								Name: "test_66",
 								Elem: func() *types.Type {
 									v := types.Type{
 										FunctionProperties: types.FunctionProperties{
@@ -703,19 +781,20 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_66",
+										Name:          "test_72",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      73.5,
-										Name:       "test_74",
+										Scale:      80.5,
+										Name:       "test_81",
 									}
 									return &v
 								}(),
@@ -727,48 +806,56 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_80",
+										Name:          "test_87",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields: []types.Param{
+									{
+										Name:  "test_95",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_97": "value_97"},
+									},
+								},
 							},
-							Value: map[string]interface{}{"key_86": "value_86"},
+							Value: map[string]interface{}{"key_98": "value_98"},
 						},
 					},
 					Config: []types.Param{
 						{
-							Name: "test_88",
+							Name: "test_100",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs: []types.Param{
 										{
-											Name:  "test_91",
+											Name:  "test_103",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_93": "value_93"},
+											Value: map[string]interface{}{"key_105": "value_105"},
 										},
 									},
 									Outputs: []types.Param{
 										{
-											Name:  "test_95",
+											Name:  "test_107",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_97": "value_97"},
+											Value: map[string]interface{}{"key_109": "value_109"},
 										},
 									},
 									Config: []types.Param{
 										{
-											Name:  "test_99",
+											Name:  "test_111",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_101": "value_101"},
+											Value: map[string]interface{}{"key_113": "value_113"},
 										},
 									},
 								},
 								Kind: types.Kind(0),
-								Name: "test_103",
+								Name: "test_115",
 								Elem: func() *types.Type {
 									v := types.Type{
 										FunctionProperties: types.FunctionProperties{
@@ -777,19 +864,20 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_109",
+										Name:          "test_121",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      116.5,
-										Name:       "test_117",
+										Scale:      129.5,
+										Name:       "test_130",
 									}
 									return &v
 								}(),
@@ -801,28 +889,36 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_123",
+										Name:          "test_136",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields: []types.Param{
+									{
+										Name:  "test_144",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_146": "value_146"},
+									},
+								},
 							},
-							Value: map[string]interface{}{"key_129": "value_129"},
+							Value: map[string]interface{}{"key_147": "value_147"},
 						},
 					},
 				},
 				Kind: types.Kind(0),
-				Name: "test_131",
+				Name: "test_149",
 				Elem: func() *types.Type {
 					v := types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name: "test_134",
+									Name: "test_152",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -830,18 +926,19 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_140",
+										Name:          "test_158",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_145": "value_145"},
+									Value: map[string]interface{}{"key_164": "value_164"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name: "test_147",
+									Name: "test_166",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -849,18 +946,19 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_153",
+										Name:          "test_172",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_158": "value_158"},
+									Value: map[string]interface{}{"key_178": "value_178"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name: "test_160",
+									Name: "test_180",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -868,45 +966,46 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_166",
+										Name:          "test_186",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_171": "value_171"},
+									Value: map[string]interface{}{"key_192": "value_192"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_173",
+						Name: "test_194",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs: []types.Param{
 										{
-											Name:  "test_176",
+											Name:  "test_197",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_178": "value_178"},
+											Value: map[string]interface{}{"key_199": "value_199"},
 										},
 									},
 									Outputs: []types.Param{
 										{
-											Name:  "test_180",
+											Name:  "test_201",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_182": "value_182"},
+											Value: map[string]interface{}{"key_203": "value_203"},
 										},
 									},
 									Config: []types.Param{
 										{
-											Name:  "test_184",
+											Name:  "test_205",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_186": "value_186"},
+											Value: map[string]interface{}{"key_207": "value_207"},
 										},
 									},
 								},
 								Kind: types.Kind(0),
-								Name: "test_188",
+								Name: "test_209",
 								Elem: func() *types.Type {
 									v := types.Type{
 										FunctionProperties: types.FunctionProperties{
@@ -915,19 +1014,20 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_194",
+										Name:          "test_215",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      201.5,
-										Name:       "test_202",
+										Scale:      223.5,
+										Name:       "test_224",
 									}
 									return &v
 								}(),
@@ -939,32 +1039,40 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_208",
+										Name:          "test_230",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields: []types.Param{
+									{
+										Name:  "test_238",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_240": "value_240"},
+									},
+								},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{
-									Length:      91,
-									Mass:        92,
-									Time:        93,
-									Current:     94,
-									Temperature: 95,
-									Angle:       96,
-									Count:       97,
-									Data:        98,
-								},
-								Scale: 224.5,
-								Name:  "test_225",
+									Length:      118,
+									Mass:        119,
+									Time:        120,
+									Current:     121,
+									Temperature: 122,
+									Angle:       123,
+									Count:       124,
+									Data:        125,
+								},
+								Scale: 251.5,
+								Name:  "test_252",
 							}
 							return &v
 						}(),
@@ -973,28 +1081,28 @@ var _ = Describe("Codec", func() {
 								FunctionProperties: types.FunctionProperties{
 									Inputs: []types.Param{
 										{
-											Name:  "test_228",
+											Name:  "test_255",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_230": "value_230"},
+											Value: map[string]interface{}{"key_257": "value_257"},
 										},
 									},
 									Outputs: []types.Param{
 										{
-											Name:  "test_232",
+											Name:  "test_259",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_234": "value_234"},
+											Value: map[string]interface{}{"key_261": "value_261"},
 										},
 									},
 									Config: []types.Param{
 										{
-											Name:  "test_236",
+											Name:  "test_263",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_238": "value_238"},
+											Value: map[string]interface{}{"key_265": "value_265"},
 										},
 									},
 								},
 								Kind: types.Kind(0),
-								Name: "test_240",
+								Name: "test_267",
 								Elem: func() *types.Type {
 									v := types.Type{
 										FunctionProperties: types.FunctionProperties{
@@ -1003,19 +1111,20 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_246",
+										Name:          "test_273",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      253.5,
-										Name:       "test_254",
+										Scale:      281.5,
+										Name:       "test_282",
 									}
 									return &v
 								}(),
@@ -1027,36 +1136,64 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_260",
+										Name:          "test_288",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields: []types.Param{
+									{
+										Name:  "test_296",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_298": "value_298"},
+									},
+								},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name: "test_301",
+								Type: types.Type{
+									FunctionProperties: types.FunctionProperties{
+										Inputs:  []types.Param{{}},
+										Outputs: []types.Param{{}},
+										Config:  []types.Param{{}},
+									},
+									Kind:          types.Kind(0),
+									Name:          "test_307",
+									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
+								},
+								Value: map[string]interface{}{"key_313": "value_313"},
+							},
+						},
 					}
 					return &v
 				}(),
 				Unit: func() *types.Unit {
 					v := types.Unit{
 						Dimensions: types.Dimensions{
-							Length:      18,
-							Mass:        19,
-							Time:        20,
-							Current:     21,
-							Temperature: 22,
-							Angle:       23,
-							Count:       24,
-							Data:        25,
+							Length:      65,
+							Mass:        66,
+							Time:        67,
+							Current:     68,
+							Temperature: 69,
+							Angle:       70,
+							Count:       71,
+							Data:        72,
 						},
-						Scale: 277.5,
-						Name:  "test_278",
+						Scale: 324.5,
+						Name:  "test_325",
 					}
 					return &v
 				}(),
@@ -1065,7 +1202,7 @@ var _ = Describe("Codec", func() {
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name: "test_281",
+									Name: "test_328",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -1073,18 +1210,19 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_287",
+										Name:          "test_334",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_292": "value_292"},
+									Value: map[string]interface{}{"key_340": "value_340"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name: "test_294",
+									Name: "test_342",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -1092,18 +1230,19 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_300",
+										Name:          "test_348",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_305": "value_305"},
+									Value: map[string]interface{}{"key_354": "value_354"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name: "test_307",
+									Name: "test_356",
 									Type: types.Type{
 										FunctionProperties: types.FunctionProperties{
 											Inputs:  []types.Param{{}},
@@ -1111,45 +1250,46 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_313",
+										Name:          "test_362",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									},
-									Value: map[string]interface{}{"key_318": "value_318"},
+									Value: map[string]interface{}{"key_368": "value_368"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_320",
+						Name: "test_370",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs: []types.Param{
 										{
-											Name:  "test_323",
+											Name:  "test_373",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_325": "value_325"},
+											Value: map[string]interface{}{"key_375": "value_375"},
 										},
 									},
 									Outputs: []types.Param{
 										{
-											Name:  "test_327",
+											Name:  "test_377",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_329": "value_329"},
+											Value: map[string]interface{}{"key_379": "value_379"},
 										},
 									},
 									Config: []types.Param{
 										{
-											Name:  "test_331",
+											Name:  "test_381",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_333": "value_333"},
+											Value: map[string]interface{}{"key_383": "value_383"},
 										},
 									},
 								},
 								Kind: types.Kind(0),
-								Name: "test_335",
+								Name: "test_385",
 								Elem: func() *types.Type {
 									v := types.Type{
 										FunctionProperties: types.FunctionProperties{
@@ -1158,19 +1298,20 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_341",
+										Name:          "test_391",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      348.5,
-										Name:       "test_349",
+										Scale:      399.5,
+										Name:       "test_400",
 									}
 									return &v
 								}(),
@@ -1182,32 +1323,40 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_355",
+										Name:          "test_406",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields: []types.Param{
+									{
+										Name:  "test_414",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_416": "value_416"},
+									},
+								},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{
-									Length:      112,
-									Mass:        113,
-									Time:        114,
-									Current:     115,
-									Temperature: 116,
-									Angle:       117,
-									Count:       118,
-									Data:        119,
-								},
-								Scale: 371.5,
-								Name:  "test_372",
+									Length:      42,
+									Mass:        43,
+									Time:        44,
+									Current:     45,
+									Temperature: 46,
+									Angle:       47,
+									Count:       48,
+									Data:        49,
+								},
+								Scale: 427.5,
+								Name:  "test_428",
 							}
 							return &v
 						}(),
@@ -1216,28 +1365,28 @@ var _ = Describe("Codec", func() {
 								FunctionProperties: types.FunctionProperties{
 									Inputs: []types.Param{
 										{
-											Name:  "test_375",
+											Name:  "test_431",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_377": "value_377"},
+											Value: map[string]interface{}{"key_433": "value_433"},
 										},
 									},
 									Outputs: []types.Param{
 										{
-											Name:  "test_379",
+											Name:  "test_435",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_381": "value_381"},
+											Value: map[string]interface{}{"key_437": "value_437"},
 										},
 									},
 									Config: []types.Param{
 										{
-											Name:  "test_383",
+											Name:  "test_439",
 											Type:  types.Type{},
-											Value: map[string]interface{}{"key_385": "value_385"},
+											Value: map[string]interface{}{"key_441": "value_441"},
 										},
 									},
 								},
 								Kind: types.Kind(0),
-								Name: "test_387",
+								Name: "test_443",
 								Elem: func() *types.Type {
 									v := types.Type{
 										FunctionProperties: types.FunctionProperties{
@@ -1246,19 +1395,20 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_393",
+										Name:          "test_449",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      400.5,
-										Name:       "test_401",
+										Scale:      457.5,
+										Name:       "test_458",
 									}
 									return &v
 								}(),
@@ -1270,23 +1420,134 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{{}},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_407",
+										Name:          "test_464",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{{}},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields: []types.Param{
+									{
+										Name:  "test_472",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_474": "value_474"},
+									},
+								},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name: "test_477",
+								Type: types.Type{
+									FunctionProperties: types.FunctionProperties{
+										Inputs:  []types.Param{{}},
+										Outputs: []types.Param{{}},
+										Config:  []types.Param{{}},
+									},
+									Kind:          types.Kind(0),
+									Name:          "test_483",
+									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
+								},
+								Value: map[string]interface{}{"key_489": "value_489"},
+							},
+						},
 					}
 					return &v
 				}(),
 				ChanDirection: types.ChanDirection(0),
+				Fields: []types.Param{
+					{
+						Name: "test_492",
+						Type: types.Type{
+							FunctionProperties: types.FunctionProperties{
+								Inputs: []types.Param{
+									{
+										Name:  "test_495",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_497": "value_497"},
+									},
+								},
+								Outputs: []types.Param{
+									{
+										Name:  "test_499",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_501": "value_501"},
+									},
+								},
+								Config: []types.Param{
+									{
+										Name:  "test_503",
+										Type:  types.Type{},
+										Value: map[string]interface{}{"key_505": "value_505"},
+									},
+								},
+							},
+							Kind: types.Kind(0),
+							Name: "test_507",
+							Elem: func() *types.Type {
+								v := types.Type{
+									FunctionProperties: types.FunctionProperties{
+										Inputs:  []types.Param{{}},
+										Outputs: []types.Param{{}},
+										Config:  []types.Param{{}},
+									},
+									Kind:          types.Kind(0),
+									Name:          "test_513",
+									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
+								}
+								return &v
+							}(),
+							Unit: func() *types.Unit {
+								v := types.Unit{
+									Dimensions: types.Dimensions{},
+									Scale:      521.5,
+									Name:       "test_522",
+								}
+								return &v
+							}(),
+							Constraint: func() *types.Type {
+								v := types.Type{
+									FunctionProperties: types.FunctionProperties{
+										Inputs:  []types.Param{{}},
+										Outputs: []types.Param{{}},
+										Config:  []types.Param{{}},
+									},
+									Kind:          types.Kind(0),
+									Name:          "test_528",
+									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
+								}
+								return &v
+							}(),
+							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_536",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_538": "value_538"},
+								},
+							},
+						},
+						Value: map[string]interface{}{"key_539": "value_539"},
+					},
+				},
 			}),
 			Entry("zero values", types.Type{
 				FunctionProperties: types.FunctionProperties{
@@ -1300,6 +1561,7 @@ var _ = Describe("Codec", func() {
 				Unit:          nil,
 				Constraint:    nil,
 				ChanDirection: types.ChanDirection(0),
+				Fields:        nil,
 			}),
 			Entry("empty collections", types.Type{
 				FunctionProperties: types.FunctionProperties{
@@ -1340,14 +1602,15 @@ var _ = Describe("Codec", func() {
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      30.5,
-										Name:       "test_31",
+										Scale:      31.5,
+										Name:       "test_32",
 									}
 									return &v
 								}(),
@@ -1359,32 +1622,34 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_37",
+										Name:          "test_38",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{
-									Length:      46,
-									Mass:        47,
-									Time:        48,
-									Current:     49,
-									Temperature: 50,
-									Angle:       51,
-									Count:       52,
-									Data:        53,
-								},
-								Scale: 53.5,
-								Name:  "test_54",
+									Length:      49,
+									Mass:        50,
+									Time:        51,
+									Current:     52,
+									Temperature: 53,
+									Angle:       54,
+									Count:       55,
+									Data:        56,
+								},
+								Scale: 56.5,
+								Name:  "test_57",
 							}
 							return &v
 						}(),
@@ -1396,7 +1661,7 @@ var _ = Describe("Codec", func() {
 									Config:  []types.Param{},
 								},
 								Kind: types.Kind(0),
-								Name: "test_60",
+								Name: "test_63",
 								Elem: func() *types.Type {
 									v := types.Type{
 										FunctionProperties: types.FunctionProperties{
@@ -1405,19 +1670,20 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_66",
+										Name:          "test_69",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      73.5,
-										Name:       "test_74",
+										Scale:      77.5,
+										Name:       "test_78",
 									}
 									return &v
 								}(),
@@ -1429,36 +1695,39 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_80",
+										Name:          "test_84",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields:        []types.Param{},
 					}
 					return &v
 				}(),
 				Unit: func() *types.Unit {
 					v := types.Unit{
 						Dimensions: types.Dimensions{
-							Length:      90,
-							Mass:        91,
-							Time:        92,
-							Current:     93,
-							Temperature: 94,
-							Angle:       95,
-							Count:       96,
-							Data:        97,
+							Length:      97,
+							Mass:        98,
+							Time:        99,
+							Current:     100,
+							Temperature: 101,
+							Angle:       102,
+							Count:       103,
+							Data:        104,
 						},
-						Scale: 97.5,
-						Name:  "test_98",
+						Scale: 104.5,
+						Name:  "test_105",
 					}
 					return &v
 				}(),
@@ -1470,7 +1739,7 @@ var _ = Describe("Codec", func() {
 							Config:  []types.Param{},
 						},
 						Kind: types.Kind(0),
-						Name: "test_104",
+						Name: "test_111",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -1479,7 +1748,7 @@ var _ = Describe("Codec", func() {
 									Config:  []types.Param{},
 								},
 								Kind: types.Kind(0),
-								Name: "test_110",
+								Name: "test_117",
 								Elem: func() *types.Type {
 									v := types.Type{
 										FunctionProperties: types.FunctionProperties{
@@ -1488,19 +1757,20 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_116",
+										Name:          "test_123",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      123.5,
-										Name:       "test_124",
+										Scale:      131.5,
+										Name:       "test_132",
 									}
 									return &v
 								}(),
@@ -1512,32 +1782,34 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_130",
+										Name:          "test_138",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{
-									Length:      13,
-									Mass:        14,
-									Time:        15,
-									Current:     16,
-									Temperature: 17,
-									Angle:       18,
-									Count:       19,
-									Data:        20,
-								},
-								Scale: 146.5,
-								Name:  "test_147",
+									Length:      23,
+									Mass:        24,
+									Time:        25,
+									Current:     26,
+									Temperature: 27,
+									Angle:       28,
+									Count:       29,
+									Data:        30,
+								},
+								Scale: 156.5,
+								Name:  "test_157",
 							}
 							return &v
 						}(),
@@ -1549,7 +1821,7 @@ var _ = Describe("Codec", func() {
 									Config:  []types.Param{},
 								},
 								Kind: types.Kind(0),
-								Name: "test_153",
+								Name: "test_163",
 								Elem: func() *types.Type {
 									v := types.Type{
 										FunctionProperties: types.FunctionProperties{
@@ -1558,19 +1830,20 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_159",
+										Name:          "test_169",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{},
 									}
 									return &v
 								}(),
 								Unit: func() *types.Unit {
 									v := types.Unit{
 										Dimensions: types.Dimensions{},
-										Scale:      166.5,
-										Name:       "test_167",
+										Scale:      177.5,
+										Name:       "test_178",
 									}
 									return &v
 								}(),
@@ -1582,23 +1855,27 @@ var _ = Describe("Codec", func() {
 											Config:  []types.Param{},
 										},
 										Kind:          types.Kind(0),
-										Name:          "test_173",
+										Name:          "test_184",
 										Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 										Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 										Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 										ChanDirection: types.ChanDirection(0),
+										Fields:        []types.Param{},
 									}
 									return &v
 								}(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields:        []types.Param{},
 					}
 					return &v
 				}(),
 				ChanDirection: types.ChanDirection(0),
+				Fields:        []types.Param{},
 			}),
 		)
 	})
@@ -1735,14 +2012,15 @@ func BenchmarkEncodeDecodeFunctionProperties(b *testing.B) {
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      30.5,
-							Name:       "test_31",
+							Scale:      31.5,
+							Name:       "test_32",
 						}
 						return &v
 					}(),
@@ -1754,48 +2032,56 @@ func BenchmarkEncodeDecodeFunctionProperties(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_37",
+							Name:          "test_38",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_46",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_48": "value_48"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_43": "value_43"},
+				Value: map[string]interface{}{"key_49": "value_49"},
 			},
 		},
 		Outputs: []types.Param{
 			{
-				Name: "test_45",
+				Name: "test_51",
 				Type: types.Type{
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name:  "test_48",
+								Name:  "test_54",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_50": "value_50"},
+								Value: map[string]interface{}{"key_56": "value_56"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name:  "test_52",
+								Name:  "test_58",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_54": "value_54"},
+								Value: map[string]interface{}{"key_60": "value_60"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name:  "test_56",
+								Name:  "test_62",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_58": "value_58"},
+								Value: map[string]interface{}{"key_64": "value_64"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_60",
+					Name: "test_66",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
@@ -1804,19 +2090,20 @@ func BenchmarkEncodeDecodeFunctionProperties(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_66",
+							Name:          "test_72",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      73.5,
-							Name:       "test_74",
+							Scale:      80.5,
+							Name:       "test_81",
 						}
 						return &v
 					}(),
@@ -1828,48 +2115,56 @@ func BenchmarkEncodeDecodeFunctionProperties(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_80",
+							Name:          "test_87",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_95",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_97": "value_97"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_86": "value_86"},
+				Value: map[string]interface{}{"key_98": "value_98"},
 			},
 		},
 		Config: []types.Param{
 			{
-				Name: "test_88",
+				Name: "test_100",
 				Type: types.Type{
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name:  "test_91",
+								Name:  "test_103",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_93": "value_93"},
+								Value: map[string]interface{}{"key_105": "value_105"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name:  "test_95",
+								Name:  "test_107",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_97": "value_97"},
+								Value: map[string]interface{}{"key_109": "value_109"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name:  "test_99",
+								Name:  "test_111",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_101": "value_101"},
+								Value: map[string]interface{}{"key_113": "value_113"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_103",
+					Name: "test_115",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
@@ -1878,19 +2173,20 @@ func BenchmarkEncodeDecodeFunctionProperties(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_109",
+							Name:          "test_121",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      116.5,
-							Name:       "test_117",
+							Scale:      129.5,
+							Name:       "test_130",
 						}
 						return &v
 					}(),
@@ -1902,17 +2198,25 @@ func BenchmarkEncodeDecodeFunctionProperties(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_123",
+							Name:          "test_136",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_144",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_146": "value_146"},
+						},
+					},
 				},
-				Value: map[string]interface{}{"key_129": "value_129"},
+				Value: map[string]interface{}{"key_147": "value_147"},
 			},
 		},
 	}
@@ -1951,13 +2255,14 @@ func BenchmarkEncodeDecodeParam(b *testing.B) {
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_15": "value_15"},
+						Value: map[string]interface{}{"key_16": "value_16"},
 					},
 				},
 				Outputs: []types.Param{
 					{
-						Name: "test_17",
+						Name: "test_18",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs:  []types.Param{{}},
@@ -1965,18 +2270,19 @@ func BenchmarkEncodeDecodeParam(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_23",
+							Name:          "test_24",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_28": "value_28"},
+						Value: map[string]interface{}{"key_30": "value_30"},
 					},
 				},
 				Config: []types.Param{
 					{
-						Name: "test_30",
+						Name: "test_32",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs:  []types.Param{{}},
@@ -1984,45 +2290,46 @@ func BenchmarkEncodeDecodeParam(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_36",
+							Name:          "test_38",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						},
-						Value: map[string]interface{}{"key_41": "value_41"},
+						Value: map[string]interface{}{"key_44": "value_44"},
 					},
 				},
 			},
 			Kind: types.Kind(0),
-			Name: "test_43",
+			Name: "test_46",
 			Elem: func() *types.Type {
 				v := types.Type{
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name:  "test_46",
+								Name:  "test_49",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_48": "value_48"},
+								Value: map[string]interface{}{"key_51": "value_51"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name:  "test_50",
+								Name:  "test_53",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_52": "value_52"},
+								Value: map[string]interface{}{"key_55": "value_55"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name:  "test_54",
+								Name:  "test_57",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_56": "value_56"},
+								Value: map[string]interface{}{"key_59": "value_59"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_58",
+					Name: "test_61",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
@@ -2031,19 +2338,20 @@ func BenchmarkEncodeDecodeParam(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_64",
+							Name:          "test_67",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      71.5,
-							Name:       "test_72",
+							Scale:      75.5,
+							Name:       "test_76",
 						}
 						return &v
 					}(),
@@ -2055,32 +2363,40 @@ func BenchmarkEncodeDecodeParam(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_78",
+							Name:          "test_82",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_90",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_92": "value_92"},
+						},
+					},
 				}
 				return &v
 			}(),
 			Unit: func() *types.Unit {
 				v := types.Unit{
 					Dimensions: types.Dimensions{
-						Length:      87,
-						Mass:        88,
-						Time:        89,
-						Current:     90,
-						Temperature: 91,
-						Angle:       92,
-						Count:       93,
-						Data:        94,
+						Length:      96,
+						Mass:        97,
+						Time:        98,
+						Current:     99,
+						Temperature: 100,
+						Angle:       101,
+						Count:       102,
+						Data:        103,
 					},
-					Scale: 94.5,
-					Name:  "test_95",
+					Scale: 103.5,
+					Name:  "test_104",
 				}
 				return &v
 			}(),
@@ -2089,28 +2405,28 @@ func BenchmarkEncodeDecodeParam(b *testing.B) {
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name:  "test_98",
+								Name:  "test_107",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_100": "value_100"},
+								Value: map[string]interface{}{"key_109": "value_109"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name:  "test_102",
+								Name:  "test_111",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_104": "value_104"},
+								Value: map[string]interface{}{"key_113": "value_113"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name:  "test_106",
+								Name:  "test_115",
 								Type:  types.Type{},
-								Value: map[string]interface{}{"key_108": "value_108"},
+								Value: map[string]interface{}{"key_117": "value_117"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_110",
+					Name: "test_119",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
@@ -2119,19 +2435,20 @@ func BenchmarkEncodeDecodeParam(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_116",
+							Name:          "test_125",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{},
-							Scale:      123.5,
-							Name:       "test_124",
+							Scale:      133.5,
+							Name:       "test_134",
 						}
 						return &v
 					}(),
@@ -2143,21 +2460,49 @@ func BenchmarkEncodeDecodeParam(b *testing.B) {
 								Config:  []types.Param{{}},
 							},
 							Kind:          types.Kind(0),
-							Name:          "test_130",
+							Name:          "test_140",
 							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_148",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_150": "value_150"},
+						},
+					},
 				}
 				return &v
 			}(),
 			ChanDirection: types.ChanDirection(0),
+			Fields: []types.Param{
+				{
+					Name: "test_153",
+					Type: types.Type{
+						FunctionProperties: types.FunctionProperties{
+							Inputs:  []types.Param{{}},
+							Outputs: []types.Param{{}},
+							Config:  []types.Param{{}},
+						},
+						Kind:          types.Kind(0),
+						Name:          "test_159",
+						Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+						Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+						Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+						ChanDirection: types.ChanDirection(0),
+						Fields:        []types.Param{{}},
+					},
+					Value: map[string]interface{}{"key_165": "value_165"},
+				},
+			},
 		},
-		Value: map[string]interface{}{"key_137": "value_137"},
+		Value: map[string]interface{}{"key_166": "value_166"},
 	}
 	w := orc.NewWriter(0)
 	r := orc.NewReader(nil)
@@ -2219,14 +2564,15 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      30.5,
-								Name:       "test_31",
+								Scale:      31.5,
+								Name:       "test_32",
 							}
 							return &v
 						}(),
@@ -2238,48 +2584,56 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_37",
+								Name:          "test_38",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_46",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_48": "value_48"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_43": "value_43"},
+					Value: map[string]interface{}{"key_49": "value_49"},
 				},
 			},
 			Outputs: []types.Param{
 				{
-					Name: "test_45",
+					Name: "test_51",
 					Type: types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_48",
+									Name:  "test_54",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_50": "value_50"},
+									Value: map[string]interface{}{"key_56": "value_56"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_52",
+									Name:  "test_58",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_54": "value_54"},
+									Value: map[string]interface{}{"key_60": "value_60"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_56",
+									Name:  "test_62",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_58": "value_58"},
+									Value: map[string]interface{}{"key_64": "value_64"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_60",
+						Name: "test_66",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -2288,19 +2642,20 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_66",
+								Name:          "test_72",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      73.5,
-								Name:       "test_74",
+								Scale:      80.5,
+								Name:       "test_81",
 							}
 							return &v
 						}(),
@@ -2312,48 +2667,56 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_80",
+								Name:          "test_87",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_95",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_97": "value_97"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_86": "value_86"},
+					Value: map[string]interface{}{"key_98": "value_98"},
 				},
 			},
 			Config: []types.Param{
 				{
-					Name: "test_88",
+					Name: "test_100",
 					Type: types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_91",
+									Name:  "test_103",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_93": "value_93"},
+									Value: map[string]interface{}{"key_105": "value_105"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_95",
+									Name:  "test_107",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_97": "value_97"},
+									Value: map[string]interface{}{"key_109": "value_109"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_99",
+									Name:  "test_111",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_101": "value_101"},
+									Value: map[string]interface{}{"key_113": "value_113"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_103",
+						Name: "test_115",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -2362,19 +2725,20 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_109",
+								Name:          "test_121",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      116.5,
-								Name:       "test_117",
+								Scale:      129.5,
+								Name:       "test_130",
 							}
 							return &v
 						}(),
@@ -2386,28 +2750,36 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_123",
+								Name:          "test_136",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_144",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_146": "value_146"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_129": "value_129"},
+					Value: map[string]interface{}{"key_147": "value_147"},
 				},
 			},
 		},
 		Kind: types.Kind(0),
-		Name: "test_131",
+		Name: "test_149",
 		Elem: func() *types.Type {
 			v := types.Type{
 				FunctionProperties: types.FunctionProperties{
 					Inputs: []types.Param{
 						{
-							Name: "test_134",
+							Name: "test_152",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2415,18 +2787,19 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_140",
+								Name:          "test_158",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_145": "value_145"},
+							Value: map[string]interface{}{"key_164": "value_164"},
 						},
 					},
 					Outputs: []types.Param{
 						{
-							Name: "test_147",
+							Name: "test_166",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2434,18 +2807,19 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_153",
+								Name:          "test_172",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_158": "value_158"},
+							Value: map[string]interface{}{"key_178": "value_178"},
 						},
 					},
 					Config: []types.Param{
 						{
-							Name: "test_160",
+							Name: "test_180",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2453,45 +2827,46 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_166",
+								Name:          "test_186",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_171": "value_171"},
+							Value: map[string]interface{}{"key_192": "value_192"},
 						},
 					},
 				},
 				Kind: types.Kind(0),
-				Name: "test_173",
+				Name: "test_194",
 				Elem: func() *types.Type {
 					v := types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_176",
+									Name:  "test_197",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_178": "value_178"},
+									Value: map[string]interface{}{"key_199": "value_199"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_180",
+									Name:  "test_201",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_182": "value_182"},
+									Value: map[string]interface{}{"key_203": "value_203"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_184",
+									Name:  "test_205",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_186": "value_186"},
+									Value: map[string]interface{}{"key_207": "value_207"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_188",
+						Name: "test_209",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -2500,19 +2875,20 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_194",
+								Name:          "test_215",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      201.5,
-								Name:       "test_202",
+								Scale:      223.5,
+								Name:       "test_224",
 							}
 							return &v
 						}(),
@@ -2524,32 +2900,40 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_208",
+								Name:          "test_230",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_238",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_240": "value_240"},
+							},
+						},
 					}
 					return &v
 				}(),
 				Unit: func() *types.Unit {
 					v := types.Unit{
 						Dimensions: types.Dimensions{
-							Length:      91,
-							Mass:        92,
-							Time:        93,
-							Current:     94,
-							Temperature: 95,
-							Angle:       96,
-							Count:       97,
-							Data:        98,
+							Length:      118,
+							Mass:        119,
+							Time:        120,
+							Current:     121,
+							Temperature: 122,
+							Angle:       123,
+							Count:       124,
+							Data:        125,
 						},
-						Scale: 224.5,
-						Name:  "test_225",
+						Scale: 251.5,
+						Name:  "test_252",
 					}
 					return &v
 				}(),
@@ -2558,28 +2942,28 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_228",
+									Name:  "test_255",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_230": "value_230"},
+									Value: map[string]interface{}{"key_257": "value_257"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_232",
+									Name:  "test_259",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_234": "value_234"},
+									Value: map[string]interface{}{"key_261": "value_261"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_236",
+									Name:  "test_263",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_238": "value_238"},
+									Value: map[string]interface{}{"key_265": "value_265"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_240",
+						Name: "test_267",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -2588,19 +2972,20 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_246",
+								Name:          "test_273",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      253.5,
-								Name:       "test_254",
+								Scale:      281.5,
+								Name:       "test_282",
 							}
 							return &v
 						}(),
@@ -2612,45 +2997,73 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_260",
+								Name:          "test_288",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_296",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_298": "value_298"},
+							},
+						},
 					}
 					return &v
 				}(),
 				ChanDirection: types.ChanDirection(0),
+				Fields: []types.Param{
+					{
+						Name: "test_301",
+						Type: types.Type{
+							FunctionProperties: types.FunctionProperties{
+								Inputs:  []types.Param{{}},
+								Outputs: []types.Param{{}},
+								Config:  []types.Param{{}},
+							},
+							Kind:          types.Kind(0),
+							Name:          "test_307",
+							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
+						},
+						Value: map[string]interface{}{"key_313": "value_313"},
+					},
+				},
+			}
+			return &v
+		}(),
+		Unit: func() *types.Unit {
+			v := types.Unit{
+				Dimensions: types.Dimensions{
+					Length:      65,
+					Mass:        66,
+					Time:        67,
+					Current:     68,
+					Temperature: 69,
+					Angle:       70,
+					Count:       71,
+					Data:        72,
+				},
+				Scale: 324.5,
+				Name:  "test_325",
 			}
 			return &v
 		}(),
-		Unit: func() *types.Unit {
-			v := types.Unit{
-				Dimensions: types.Dimensions{
-					Length:      18,
-					Mass:        19,
-					Time:        20,
-					Current:     21,
-					Temperature: 22,
-					Angle:       23,
-					Count:       24,
-					Data:        25,
-				},
-				Scale: 277.5,
-				Name:  "test_278",
-			}
-			return &v
-		}(),
 		Constraint: func() *types.Type {
 			v := types.Type{
 				FunctionProperties: types.FunctionProperties{
 					Inputs: []types.Param{
 						{
-							Name: "test_281",
+							Name: "test_328",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2658,18 +3071,19 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_287",
+								Name:          "test_334",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_292": "value_292"},
+							Value: map[string]interface{}{"key_340": "value_340"},
 						},
 					},
 					Outputs: []types.Param{
 						{
-							Name: "test_294",
+							Name: "test_342",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2677,18 +3091,19 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_300",
+								Name:          "test_348",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_305": "value_305"},
+							Value: map[string]interface{}{"key_354": "value_354"},
 						},
 					},
 					Config: []types.Param{
 						{
-							Name: "test_307",
+							Name: "test_356",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -2696,45 +3111,46 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_313",
+								Name:          "test_362",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_318": "value_318"},
+							Value: map[string]interface{}{"key_368": "value_368"},
 						},
 					},
 				},
 				Kind: types.Kind(0),
-				Name: "test_320",
+				Name: "test_370",
 				Elem: func() *types.Type {
 					v := types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_323",
+									Name:  "test_373",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_325": "value_325"},
+									Value: map[string]interface{}{"key_375": "value_375"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_327",
+									Name:  "test_377",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_329": "value_329"},
+									Value: map[string]interface{}{"key_379": "value_379"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_331",
+									Name:  "test_381",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_333": "value_333"},
+									Value: map[string]interface{}{"key_383": "value_383"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_335",
+						Name: "test_385",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -2743,19 +3159,20 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_341",
+								Name:          "test_391",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      348.5,
-								Name:       "test_349",
+								Scale:      399.5,
+								Name:       "test_400",
 							}
 							return &v
 						}(),
@@ -2767,32 +3184,40 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_355",
+								Name:          "test_406",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_414",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_416": "value_416"},
+							},
+						},
 					}
 					return &v
 				}(),
 				Unit: func() *types.Unit {
 					v := types.Unit{
 						Dimensions: types.Dimensions{
-							Length:      112,
-							Mass:        113,
-							Time:        114,
-							Current:     115,
-							Temperature: 116,
-							Angle:       117,
-							Count:       118,
-							Data:        119,
+							Length:      42,
+							Mass:        43,
+							Time:        44,
+							Current:     45,
+							Temperature: 46,
+							Angle:       47,
+							Count:       48,
+							Data:        49,
 						},
-						Scale: 371.5,
-						Name:  "test_372",
+						Scale: 427.5,
+						Name:  "test_428",
 					}
 					return &v
 				}(),
@@ -2801,28 +3226,28 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_375",
+									Name:  "test_431",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_377": "value_377"},
+									Value: map[string]interface{}{"key_433": "value_433"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_379",
+									Name:  "test_435",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_381": "value_381"},
+									Value: map[string]interface{}{"key_437": "value_437"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_383",
+									Name:  "test_439",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_385": "value_385"},
+									Value: map[string]interface{}{"key_441": "value_441"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_387",
+						Name: "test_443",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -2831,19 +3256,20 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_393",
+								Name:          "test_449",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      400.5,
-								Name:       "test_401",
+								Scale:      457.5,
+								Name:       "test_458",
 							}
 							return &v
 						}(),
@@ -2855,23 +3281,134 @@ func BenchmarkEncodeDecodeType(b *testing.B) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_407",
+								Name:          "test_464",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_472",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_474": "value_474"},
+							},
+						},
 					}
 					return &v
 				}(),
 				ChanDirection: types.ChanDirection(0),
+				Fields: []types.Param{
+					{
+						Name: "test_477",
+						Type: types.Type{
+							FunctionProperties: types.FunctionProperties{
+								Inputs:  []types.Param{{}},
+								Outputs: []types.Param{{}},
+								Config:  []types.Param{{}},
+							},
+							Kind:          types.Kind(0),
+							Name:          "test_483",
+							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
+						},
+						Value: map[string]interface{}{"key_489": "value_489"},
+					},
+				},
 			}
 			return &v
 		}(),
 		ChanDirection: types.ChanDirection(0),
+		Fields: []types.Param{
+			{
+				Name: "test_492",
+				Type: types.Type{
+					FunctionProperties: types.FunctionProperties{
+						Inputs: []types.Param{
+							{
+								Name:  "test_495",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_497": "value_497"},
+							},
+						},
+						Outputs: []types.Param{
+							{
+								Name:  "test_499",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_501": "value_501"},
+							},
+						},
+						Config: []types.Param{
+							{
+								Name:  "test_503",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_505": "value_505"},
+							},
+						},
+					},
+					Kind: types.Kind(0),
+					Name: "test_507",
+					Elem: func() *types.Type {
+						v := types.Type{
+							FunctionProperties: types.FunctionProperties{
+								Inputs:  []types.Param{{}},
+								Outputs: []types.Param{{}},
+								Config:  []types.Param{{}},
+							},
+							Kind:          types.Kind(0),
+							Name:          "test_513",
+							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
+						}
+						return &v
+					}(),
+					Unit: func() *types.Unit {
+						v := types.Unit{
+							Dimensions: types.Dimensions{},
+							Scale:      521.5,
+							Name:       "test_522",
+						}
+						return &v
+					}(),
+					Constraint: func() *types.Type {
+						v := types.Type{
+							FunctionProperties: types.FunctionProperties{
+								Inputs:  []types.Param{{}},
+								Outputs: []types.Param{{}},
+								Config:  []types.Param{{}},
+							},
+							Kind:          types.Kind(0),
+							Name:          "test_528",
+							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
+						}
+						return &v
+					}(),
+					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name:  "test_536",
+							Type:  types.Type{},
+							Value: map[string]interface{}{"key_538": "value_538"},
+						},
+					},
+				},
+				Value: map[string]interface{}{"key_539": "value_539"},
+			},
+		},
 	}
 	w := orc.NewWriter(0)
 	r := orc.NewReader(nil)
@@ -3084,14 +3621,15 @@ func FuzzDecodeFunctionProperties(f *testing.F) {
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      30.5,
-								Name:       "test_31",
+								Scale:      31.5,
+								Name:       "test_32",
 							}
 							return &v
 						}(),
@@ -3103,48 +3641,56 @@ func FuzzDecodeFunctionProperties(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_37",
+								Name:          "test_38",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_46",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_48": "value_48"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_43": "value_43"},
+					Value: map[string]interface{}{"key_49": "value_49"},
 				},
 			},
 			Outputs: []types.Param{
 				{
-					Name: "test_45",
+					Name: "test_51",
 					Type: types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_48",
+									Name:  "test_54",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_50": "value_50"},
+									Value: map[string]interface{}{"key_56": "value_56"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_52",
+									Name:  "test_58",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_54": "value_54"},
+									Value: map[string]interface{}{"key_60": "value_60"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_56",
+									Name:  "test_62",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_58": "value_58"},
+									Value: map[string]interface{}{"key_64": "value_64"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_60",
+						Name: "test_66",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -3153,19 +3699,20 @@ func FuzzDecodeFunctionProperties(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_66",
+								Name:          "test_72",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      73.5,
-								Name:       "test_74",
+								Scale:      80.5,
+								Name:       "test_81",
 							}
 							return &v
 						}(),
@@ -3177,48 +3724,56 @@ func FuzzDecodeFunctionProperties(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_80",
+								Name:          "test_87",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_95",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_97": "value_97"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_86": "value_86"},
+					Value: map[string]interface{}{"key_98": "value_98"},
 				},
 			},
 			Config: []types.Param{
 				{
-					Name: "test_88",
+					Name: "test_100",
 					Type: types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_91",
+									Name:  "test_103",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_93": "value_93"},
+									Value: map[string]interface{}{"key_105": "value_105"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_95",
+									Name:  "test_107",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_97": "value_97"},
+									Value: map[string]interface{}{"key_109": "value_109"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_99",
+									Name:  "test_111",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_101": "value_101"},
+									Value: map[string]interface{}{"key_113": "value_113"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_103",
+						Name: "test_115",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -3227,19 +3782,20 @@ func FuzzDecodeFunctionProperties(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_109",
+								Name:          "test_121",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      116.5,
-								Name:       "test_117",
+								Scale:      129.5,
+								Name:       "test_130",
 							}
 							return &v
 						}(),
@@ -3251,17 +3807,25 @@ func FuzzDecodeFunctionProperties(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_123",
+								Name:          "test_136",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_144",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_146": "value_146"},
+							},
+						},
 					},
-					Value: map[string]interface{}{"key_129": "value_129"},
+					Value: map[string]interface{}{"key_147": "value_147"},
 				},
 			},
 		}
@@ -3345,13 +3909,14 @@ func FuzzDecodeParam(f *testing.F) {
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_15": "value_15"},
+							Value: map[string]interface{}{"key_16": "value_16"},
 						},
 					},
 					Outputs: []types.Param{
 						{
-							Name: "test_17",
+							Name: "test_18",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -3359,18 +3924,19 @@ func FuzzDecodeParam(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_23",
+								Name:          "test_24",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_28": "value_28"},
+							Value: map[string]interface{}{"key_30": "value_30"},
 						},
 					},
 					Config: []types.Param{
 						{
-							Name: "test_30",
+							Name: "test_32",
 							Type: types.Type{
 								FunctionProperties: types.FunctionProperties{
 									Inputs:  []types.Param{{}},
@@ -3378,45 +3944,46 @@ func FuzzDecodeParam(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_36",
+								Name:          "test_38",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							},
-							Value: map[string]interface{}{"key_41": "value_41"},
+							Value: map[string]interface{}{"key_44": "value_44"},
 						},
 					},
 				},
 				Kind: types.Kind(0),
-				Name: "test_43",
+				Name: "test_46",
 				Elem: func() *types.Type {
 					v := types.Type{
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_46",
+									Name:  "test_49",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_48": "value_48"},
+									Value: map[string]interface{}{"key_51": "value_51"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_50",
+									Name:  "test_53",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_52": "value_52"},
+									Value: map[string]interface{}{"key_55": "value_55"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_54",
+									Name:  "test_57",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_56": "value_56"},
+									Value: map[string]interface{}{"key_59": "value_59"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_58",
+						Name: "test_61",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -3425,19 +3992,20 @@ func FuzzDecodeParam(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_64",
+								Name:          "test_67",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      71.5,
-								Name:       "test_72",
+								Scale:      75.5,
+								Name:       "test_76",
 							}
 							return &v
 						}(),
@@ -3449,32 +4017,40 @@ func FuzzDecodeParam(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_78",
+								Name:          "test_82",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_90",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_92": "value_92"},
+							},
+						},
 					}
 					return &v
 				}(),
 				Unit: func() *types.Unit {
 					v := types.Unit{
 						Dimensions: types.Dimensions{
-							Length:      87,
-							Mass:        88,
-							Time:        89,
-							Current:     90,
-							Temperature: 91,
-							Angle:       92,
-							Count:       93,
-							Data:        94,
+							Length:      96,
+							Mass:        97,
+							Time:        98,
+							Current:     99,
+							Temperature: 100,
+							Angle:       101,
+							Count:       102,
+							Data:        103,
 						},
-						Scale: 94.5,
-						Name:  "test_95",
+						Scale: 103.5,
+						Name:  "test_104",
 					}
 					return &v
 				}(),
@@ -3483,28 +4059,28 @@ func FuzzDecodeParam(f *testing.F) {
 						FunctionProperties: types.FunctionProperties{
 							Inputs: []types.Param{
 								{
-									Name:  "test_98",
+									Name:  "test_107",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_100": "value_100"},
+									Value: map[string]interface{}{"key_109": "value_109"},
 								},
 							},
 							Outputs: []types.Param{
 								{
-									Name:  "test_102",
+									Name:  "test_111",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_104": "value_104"},
+									Value: map[string]interface{}{"key_113": "value_113"},
 								},
 							},
 							Config: []types.Param{
 								{
-									Name:  "test_106",
+									Name:  "test_115",
 									Type:  types.Type{},
-									Value: map[string]interface{}{"key_108": "value_108"},
+									Value: map[string]interface{}{"key_117": "value_117"},
 								},
 							},
 						},
 						Kind: types.Kind(0),
-						Name: "test_110",
+						Name: "test_119",
 						Elem: func() *types.Type {
 							v := types.Type{
 								FunctionProperties: types.FunctionProperties{
@@ -3513,19 +4089,20 @@ func FuzzDecodeParam(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_116",
+								Name:          "test_125",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						Unit: func() *types.Unit {
 							v := types.Unit{
 								Dimensions: types.Dimensions{},
-								Scale:      123.5,
-								Name:       "test_124",
+								Scale:      133.5,
+								Name:       "test_134",
 							}
 							return &v
 						}(),
@@ -3537,21 +4114,49 @@ func FuzzDecodeParam(f *testing.F) {
 									Config:  []types.Param{{}},
 								},
 								Kind:          types.Kind(0),
-								Name:          "test_130",
+								Name:          "test_140",
 								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
 							}
 							return &v
 						}(),
 						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_148",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_150": "value_150"},
+							},
+						},
 					}
 					return &v
 				}(),
 				ChanDirection: types.ChanDirection(0),
+				Fields: []types.Param{
+					{
+						Name: "test_153",
+						Type: types.Type{
+							FunctionProperties: types.FunctionProperties{
+								Inputs:  []types.Param{{}},
+								Outputs: []types.Param{{}},
+								Config:  []types.Param{{}},
+							},
+							Kind:          types.Kind(0),
+							Name:          "test_159",
+							Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+							Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+							Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{{}},
+						},
+						Value: map[string]interface{}{"key_165": "value_165"},
+					},
+				},
 			},
-			Value: map[string]interface{}{"key_137": "value_137"},
+			Value: map[string]interface{}{"key_166": "value_166"},
 		}
 		w := orc.NewWriter(0)
 		if err := seed.EncodeOrc(w); err != nil {
@@ -3574,6 +4179,7 @@ func FuzzDecodeParam(f *testing.F) {
 				Unit:          nil,
 				Constraint:    nil,
 				ChanDirection: types.ChanDirection(0),
+				Fields:        nil,
 			},
 			Value: nil,
 		}
@@ -3658,14 +4264,15 @@ func FuzzDecodeType(f *testing.F) {
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      30.5,
-									Name:       "test_31",
+									Scale:      31.5,
+									Name:       "test_32",
 								}
 								return &v
 							}(),
@@ -3677,48 +4284,56 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_37",
+									Name:          "test_38",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_46",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_48": "value_48"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_43": "value_43"},
+						Value: map[string]interface{}{"key_49": "value_49"},
 					},
 				},
 				Outputs: []types.Param{
 					{
-						Name: "test_45",
+						Name: "test_51",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_48",
+										Name:  "test_54",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_50": "value_50"},
+										Value: map[string]interface{}{"key_56": "value_56"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_52",
+										Name:  "test_58",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_54": "value_54"},
+										Value: map[string]interface{}{"key_60": "value_60"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_56",
+										Name:  "test_62",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_58": "value_58"},
+										Value: map[string]interface{}{"key_64": "value_64"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_60",
+							Name: "test_66",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -3727,19 +4342,20 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_66",
+									Name:          "test_72",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      73.5,
-									Name:       "test_74",
+									Scale:      80.5,
+									Name:       "test_81",
 								}
 								return &v
 							}(),
@@ -3751,48 +4367,56 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_80",
+									Name:          "test_87",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_95",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_97": "value_97"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_86": "value_86"},
+						Value: map[string]interface{}{"key_98": "value_98"},
 					},
 				},
 				Config: []types.Param{
 					{
-						Name: "test_88",
+						Name: "test_100",
 						Type: types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_91",
+										Name:  "test_103",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_93": "value_93"},
+										Value: map[string]interface{}{"key_105": "value_105"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_95",
+										Name:  "test_107",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_97": "value_97"},
+										Value: map[string]interface{}{"key_109": "value_109"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_99",
+										Name:  "test_111",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_101": "value_101"},
+										Value: map[string]interface{}{"key_113": "value_113"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_103",
+							Name: "test_115",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -3801,19 +4425,20 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_109",
+									Name:          "test_121",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      116.5,
-									Name:       "test_117",
+									Scale:      129.5,
+									Name:       "test_130",
 								}
 								return &v
 							}(),
@@ -3825,28 +4450,36 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_123",
+									Name:          "test_136",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_144",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_146": "value_146"},
+								},
+							},
 						},
-						Value: map[string]interface{}{"key_129": "value_129"},
+						Value: map[string]interface{}{"key_147": "value_147"},
 					},
 				},
 			},
 			Kind: types.Kind(0),
-			Name: "test_131",
+			Name: "test_149",
 			Elem: func() *types.Type {
 				v := types.Type{
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name: "test_134",
+								Name: "test_152",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -3854,18 +4487,19 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_140",
+									Name:          "test_158",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_145": "value_145"},
+								Value: map[string]interface{}{"key_164": "value_164"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name: "test_147",
+								Name: "test_166",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -3873,18 +4507,19 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_153",
+									Name:          "test_172",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_158": "value_158"},
+								Value: map[string]interface{}{"key_178": "value_178"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name: "test_160",
+								Name: "test_180",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -3892,45 +4527,46 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_166",
+									Name:          "test_186",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_171": "value_171"},
+								Value: map[string]interface{}{"key_192": "value_192"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_173",
+					Name: "test_194",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_176",
+										Name:  "test_197",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_178": "value_178"},
+										Value: map[string]interface{}{"key_199": "value_199"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_180",
+										Name:  "test_201",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_182": "value_182"},
+										Value: map[string]interface{}{"key_203": "value_203"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_184",
+										Name:  "test_205",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_186": "value_186"},
+										Value: map[string]interface{}{"key_207": "value_207"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_188",
+							Name: "test_209",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -3939,19 +4575,20 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_194",
+									Name:          "test_215",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      201.5,
-									Name:       "test_202",
+									Scale:      223.5,
+									Name:       "test_224",
 								}
 								return &v
 							}(),
@@ -3963,32 +4600,40 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_208",
+									Name:          "test_230",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_238",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_240": "value_240"},
+								},
+							},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{
-								Length:      91,
-								Mass:        92,
-								Time:        93,
-								Current:     94,
-								Temperature: 95,
-								Angle:       96,
-								Count:       97,
-								Data:        98,
-							},
-							Scale: 224.5,
-							Name:  "test_225",
+								Length:      118,
+								Mass:        119,
+								Time:        120,
+								Current:     121,
+								Temperature: 122,
+								Angle:       123,
+								Count:       124,
+								Data:        125,
+							},
+							Scale: 251.5,
+							Name:  "test_252",
 						}
 						return &v
 					}(),
@@ -3997,28 +4642,28 @@ func FuzzDecodeType(f *testing.F) {
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_228",
+										Name:  "test_255",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_230": "value_230"},
+										Value: map[string]interface{}{"key_257": "value_257"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_232",
+										Name:  "test_259",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_234": "value_234"},
+										Value: map[string]interface{}{"key_261": "value_261"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_236",
+										Name:  "test_263",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_238": "value_238"},
+										Value: map[string]interface{}{"key_265": "value_265"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_240",
+							Name: "test_267",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -4027,19 +4672,20 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_246",
+									Name:          "test_273",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      253.5,
-									Name:       "test_254",
+									Scale:      281.5,
+									Name:       "test_282",
 								}
 								return &v
 							}(),
@@ -4051,36 +4697,64 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_260",
+									Name:          "test_288",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_296",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_298": "value_298"},
+								},
+							},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name: "test_301",
+							Type: types.Type{
+								FunctionProperties: types.FunctionProperties{
+									Inputs:  []types.Param{{}},
+									Outputs: []types.Param{{}},
+									Config:  []types.Param{{}},
+								},
+								Kind:          types.Kind(0),
+								Name:          "test_307",
+								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
+							},
+							Value: map[string]interface{}{"key_313": "value_313"},
+						},
+					},
 				}
 				return &v
 			}(),
 			Unit: func() *types.Unit {
 				v := types.Unit{
 					Dimensions: types.Dimensions{
-						Length:      18,
-						Mass:        19,
-						Time:        20,
-						Current:     21,
-						Temperature: 22,
-						Angle:       23,
-						Count:       24,
-						Data:        25,
+						Length:      65,
+						Mass:        66,
+						Time:        67,
+						Current:     68,
+						Temperature: 69,
+						Angle:       70,
+						Count:       71,
+						Data:        72,
 					},
-					Scale: 277.5,
-					Name:  "test_278",
+					Scale: 324.5,
+					Name:  "test_325",
 				}
 				return &v
 			}(),
@@ -4089,7 +4763,7 @@ func FuzzDecodeType(f *testing.F) {
 					FunctionProperties: types.FunctionProperties{
 						Inputs: []types.Param{
 							{
-								Name: "test_281",
+								Name: "test_328",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -4097,18 +4771,19 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_287",
+									Name:          "test_334",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_292": "value_292"},
+								Value: map[string]interface{}{"key_340": "value_340"},
 							},
 						},
 						Outputs: []types.Param{
 							{
-								Name: "test_294",
+								Name: "test_342",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -4116,18 +4791,19 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_300",
+									Name:          "test_348",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_305": "value_305"},
+								Value: map[string]interface{}{"key_354": "value_354"},
 							},
 						},
 						Config: []types.Param{
 							{
-								Name: "test_307",
+								Name: "test_356",
 								Type: types.Type{
 									FunctionProperties: types.FunctionProperties{
 										Inputs:  []types.Param{{}},
@@ -4135,45 +4811,46 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_313",
+									Name:          "test_362",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								},
-								Value: map[string]interface{}{"key_318": "value_318"},
+								Value: map[string]interface{}{"key_368": "value_368"},
 							},
 						},
 					},
 					Kind: types.Kind(0),
-					Name: "test_320",
+					Name: "test_370",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_323",
+										Name:  "test_373",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_325": "value_325"},
+										Value: map[string]interface{}{"key_375": "value_375"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_327",
+										Name:  "test_377",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_329": "value_329"},
+										Value: map[string]interface{}{"key_379": "value_379"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_331",
+										Name:  "test_381",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_333": "value_333"},
+										Value: map[string]interface{}{"key_383": "value_383"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_335",
+							Name: "test_385",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -4182,19 +4859,20 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_341",
+									Name:          "test_391",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      348.5,
-									Name:       "test_349",
+									Scale:      399.5,
+									Name:       "test_400",
 								}
 								return &v
 							}(),
@@ -4206,32 +4884,40 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_355",
+									Name:          "test_406",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_414",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_416": "value_416"},
+								},
+							},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{
-								Length:      112,
-								Mass:        113,
-								Time:        114,
-								Current:     115,
-								Temperature: 116,
-								Angle:       117,
-								Count:       118,
-								Data:        119,
-							},
-							Scale: 371.5,
-							Name:  "test_372",
+								Length:      42,
+								Mass:        43,
+								Time:        44,
+								Current:     45,
+								Temperature: 46,
+								Angle:       47,
+								Count:       48,
+								Data:        49,
+							},
+							Scale: 427.5,
+							Name:  "test_428",
 						}
 						return &v
 					}(),
@@ -4240,28 +4926,28 @@ func FuzzDecodeType(f *testing.F) {
 							FunctionProperties: types.FunctionProperties{
 								Inputs: []types.Param{
 									{
-										Name:  "test_375",
+										Name:  "test_431",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_377": "value_377"},
+										Value: map[string]interface{}{"key_433": "value_433"},
 									},
 								},
 								Outputs: []types.Param{
 									{
-										Name:  "test_379",
+										Name:  "test_435",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_381": "value_381"},
+										Value: map[string]interface{}{"key_437": "value_437"},
 									},
 								},
 								Config: []types.Param{
 									{
-										Name:  "test_383",
+										Name:  "test_439",
 										Type:  types.Type{},
-										Value: map[string]interface{}{"key_385": "value_385"},
+										Value: map[string]interface{}{"key_441": "value_441"},
 									},
 								},
 							},
 							Kind: types.Kind(0),
-							Name: "test_387",
+							Name: "test_443",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -4270,19 +4956,20 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_393",
+									Name:          "test_449",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      400.5,
-									Name:       "test_401",
+									Scale:      457.5,
+									Name:       "test_458",
 								}
 								return &v
 							}(),
@@ -4294,23 +4981,134 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{{}},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_407",
+									Name:          "test_464",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{{}},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields: []types.Param{
+								{
+									Name:  "test_472",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_474": "value_474"},
+								},
+							},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields: []types.Param{
+						{
+							Name: "test_477",
+							Type: types.Type{
+								FunctionProperties: types.FunctionProperties{
+									Inputs:  []types.Param{{}},
+									Outputs: []types.Param{{}},
+									Config:  []types.Param{{}},
+								},
+								Kind:          types.Kind(0),
+								Name:          "test_483",
+								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
+							},
+							Value: map[string]interface{}{"key_489": "value_489"},
+						},
+					},
 				}
 				return &v
 			}(),
 			ChanDirection: types.ChanDirection(0),
+			Fields: []types.Param{
+				{
+					Name: "test_492",
+					Type: types.Type{
+						FunctionProperties: types.FunctionProperties{
+							Inputs: []types.Param{
+								{
+									Name:  "test_495",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_497": "value_497"},
+								},
+							},
+							Outputs: []types.Param{
+								{
+									Name:  "test_499",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_501": "value_501"},
+								},
+							},
+							Config: []types.Param{
+								{
+									Name:  "test_503",
+									Type:  types.Type{},
+									Value: map[string]interface{}{"key_505": "value_505"},
+								},
+							},
+						},
+						Kind: types.Kind(0),
+						Name: "test_507",
+						Elem: func() *types.Type {
+							v := types.Type{
+								FunctionProperties: types.FunctionProperties{
+									Inputs:  []types.Param{{}},
+									Outputs: []types.Param{{}},
+									Config:  []types.Param{{}},
+								},
+								Kind:          types.Kind(0),
+								Name:          "test_513",
+								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
+							}
+							return &v
+						}(),
+						Unit: func() *types.Unit {
+							v := types.Unit{
+								Dimensions: types.Dimensions{},
+								Scale:      521.5,
+								Name:       "test_522",
+							}
+							return &v
+						}(),
+						Constraint: func() *types.Type {
+							v := types.Type{
+								FunctionProperties: types.FunctionProperties{
+									Inputs:  []types.Param{{}},
+									Outputs: []types.Param{{}},
+									Config:  []types.Param{{}},
+								},
+								Kind:          types.Kind(0),
+								Name:          "test_528",
+								Elem:          func() *types.Type { v := types.Type{}; return &v }(),
+								Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
+								Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
+								ChanDirection: types.ChanDirection(0),
+								Fields:        []types.Param{{}},
+							}
+							return &v
+						}(),
+						ChanDirection: types.ChanDirection(0),
+						Fields: []types.Param{
+							{
+								Name:  "test_536",
+								Type:  types.Type{},
+								Value: map[string]interface{}{"key_538": "value_538"},
+							},
+						},
+					},
+					Value: map[string]interface{}{"key_539": "value_539"},
+				},
+			},
 		}
 		w := orc.NewWriter(0)
 		if err := seed.EncodeOrc(w); err != nil {
@@ -4331,6 +5129,7 @@ func FuzzDecodeType(f *testing.F) {
 			Unit:          nil,
 			Constraint:    nil,
 			ChanDirection: types.ChanDirection(0),
+			Fields:        nil,
 		}
 		w := orc.NewWriter(0)
 		if err := seed.EncodeOrc(w); err != nil {
@@ -4378,14 +5177,15 @@ func FuzzDecodeType(f *testing.F) {
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      30.5,
-									Name:       "test_31",
+									Scale:      31.5,
+									Name:       "test_32",
 								}
 								return &v
 							}(),
@@ -4397,32 +5197,34 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_37",
+									Name:          "test_38",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{
-								Length:      46,
-								Mass:        47,
-								Time:        48,
-								Current:     49,
-								Temperature: 50,
-								Angle:       51,
-								Count:       52,
-								Data:        53,
-							},
-							Scale: 53.5,
-							Name:  "test_54",
+								Length:      49,
+								Mass:        50,
+								Time:        51,
+								Current:     52,
+								Temperature: 53,
+								Angle:       54,
+								Count:       55,
+								Data:        56,
+							},
+							Scale: 56.5,
+							Name:  "test_57",
 						}
 						return &v
 					}(),
@@ -4434,7 +5236,7 @@ func FuzzDecodeType(f *testing.F) {
 								Config:  []types.Param{},
 							},
 							Kind: types.Kind(0),
-							Name: "test_60",
+							Name: "test_63",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -4443,19 +5245,20 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_66",
+									Name:          "test_69",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      73.5,
-									Name:       "test_74",
+									Scale:      77.5,
+									Name:       "test_78",
 								}
 								return &v
 							}(),
@@ -4467,36 +5270,39 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_80",
+									Name:          "test_84",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields:        []types.Param{},
 				}
 				return &v
 			}(),
 			Unit: func() *types.Unit {
 				v := types.Unit{
 					Dimensions: types.Dimensions{
-						Length:      90,
-						Mass:        91,
-						Time:        92,
-						Current:     93,
-						Temperature: 94,
-						Angle:       95,
-						Count:       96,
-						Data:        97,
+						Length:      97,
+						Mass:        98,
+						Time:        99,
+						Current:     100,
+						Temperature: 101,
+						Angle:       102,
+						Count:       103,
+						Data:        104,
 					},
-					Scale: 97.5,
-					Name:  "test_98",
+					Scale: 104.5,
+					Name:  "test_105",
 				}
 				return &v
 			}(),
@@ -4508,7 +5314,7 @@ func FuzzDecodeType(f *testing.F) {
 						Config:  []types.Param{},
 					},
 					Kind: types.Kind(0),
-					Name: "test_104",
+					Name: "test_111",
 					Elem: func() *types.Type {
 						v := types.Type{
 							FunctionProperties: types.FunctionProperties{
@@ -4517,7 +5323,7 @@ func FuzzDecodeType(f *testing.F) {
 								Config:  []types.Param{},
 							},
 							Kind: types.Kind(0),
-							Name: "test_110",
+							Name: "test_117",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -4526,19 +5332,20 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_116",
+									Name:          "test_123",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      123.5,
-									Name:       "test_124",
+									Scale:      131.5,
+									Name:       "test_132",
 								}
 								return &v
 							}(),
@@ -4550,32 +5357,34 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_130",
+									Name:          "test_138",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{},
 						}
 						return &v
 					}(),
 					Unit: func() *types.Unit {
 						v := types.Unit{
 							Dimensions: types.Dimensions{
-								Length:      13,
-								Mass:        14,
-								Time:        15,
-								Current:     16,
-								Temperature: 17,
-								Angle:       18,
-								Count:       19,
-								Data:        20,
-							},
-							Scale: 146.5,
-							Name:  "test_147",
+								Length:      23,
+								Mass:        24,
+								Time:        25,
+								Current:     26,
+								Temperature: 27,
+								Angle:       28,
+								Count:       29,
+								Data:        30,
+							},
+							Scale: 156.5,
+							Name:  "test_157",
 						}
 						return &v
 					}(),
@@ -4587,7 +5396,7 @@ func FuzzDecodeType(f *testing.F) {
 								Config:  []types.Param{},
 							},
 							Kind: types.Kind(0),
-							Name: "test_153",
+							Name: "test_163",
 							Elem: func() *types.Type {
 								v := types.Type{
 									FunctionProperties: types.FunctionProperties{
@@ -4596,19 +5405,20 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_159",
+									Name:          "test_169",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{},
 								}
 								return &v
 							}(),
 							Unit: func() *types.Unit {
 								v := types.Unit{
 									Dimensions: types.Dimensions{},
-									Scale:      166.5,
-									Name:       "test_167",
+									Scale:      177.5,
+									Name:       "test_178",
 								}
 								return &v
 							}(),
@@ -4620,23 +5430,27 @@ func FuzzDecodeType(f *testing.F) {
 										Config:  []types.Param{},
 									},
 									Kind:          types.Kind(0),
-									Name:          "test_173",
+									Name:          "test_184",
 									Elem:          func() *types.Type { v := types.Type{}; return &v }(),
 									Unit:          func() *types.Unit { v := types.Unit{}; return &v }(),
 									Constraint:    func() *types.Type { v := types.Type{}; return &v }(),
 									ChanDirection: types.ChanDirection(0),
+									Fields:        []types.Param{},
 								}
 								return &v
 							}(),
 							ChanDirection: types.ChanDirection(0),
+							Fields:        []types.Param{},
 						}
 						return &v
 					}(),
 					ChanDirection: types.ChanDirection(0),
+					Fields:        []types.Param{},
 				}
 				return &v
 			}(),
 			ChanDirection: types.ChanDirection(0),
+			Fields:        []types.Param{},
 		}
 		w := orc.NewWriter(0)
 		if err := seed.EncodeOrc(w); err != nil {
@@ -109,6 +109,10 @@ func TypeToPB(r types.Type) (*Type, error) {
 	if err != nil {
 		return nil, err
 	}
+	fieldsVal, err := ParamsToPB(r.Fields)
+	if err != nil {
+		return nil, err
+	}
 	pb := &Type{
 		Name:          r.Name,
 		Inputs:        inputsVal,
@@ -116,6 +120,7 @@ func TypeToPB(r types.Type) (*Type, error) {
 		Config:        configVal,
 		Kind:          kindVal,
 		ChanDirection: chanDirectionVal,
+		Fields:        fieldsVal,
 	}
 	if r.Elem != nil {
 		var err error
@@ -168,6 +173,10 @@ func TypeFromPB(pb *Type) (types.Type, error) {
 	if err != nil {
 		return types.Type{}, err
 	}
+	r.Fields, err = ParamsFromPB(pb.Fields)
+	if err != nil {
+		return types.Type{}, err
+	}
 	r.Name = pb.Name
 	if pb.Elem != nil {
 		val, err := TypeFromPB(pb.Elem)
@@ -514,6 +523,8 @@ func KindToPB(v types.Kind) (Kind, error) {
 		return Kind_KIND_SEQUENCE, nil
 	case types.KindStage:
 		return Kind_KIND_STAGE, nil
+	case types.KindStructure:
+		return Kind_KIND_STRUCTURE, nil
 	default:
 		return 0, errors.Newf("unrecognized types.Kind value: %v", v)
 	}
@@ -566,6 +577,8 @@ func KindFromPB(v Kind) (types.Kind, error) {
 		return types.KindSequence, nil
 	case Kind_KIND_STAGE:
 		return types.KindStage, nil
+	case Kind_KIND_STRUCTURE:
+		return types.KindStructure, nil
 	default:
 		return 0, errors.Newf("unrecognized Kind value: %v", v)
 	}
@@ -59,6 +59,7 @@ const (
 	Kind_KIND_FUNCTION                     Kind = 21
 	Kind_KIND_SEQUENCE                     Kind = 22
 	Kind_KIND_STAGE                        Kind = 23
+	Kind_KIND_STRUCTURE                    Kind = 24
 )
 
 // Enum value maps for Kind.
@@ -86,6 +87,7 @@ var (
 		21: "KIND_FUNCTION",
 		22: "KIND_SEQUENCE",
 		23: "KIND_STAGE",
+		24: "KIND_STRUCTURE",
 	}
 	Kind_value = map[string]int32{
 		"KIND_INVALID":                      0,
@@ -110,6 +112,7 @@ var (
 		"KIND_FUNCTION":                     21,
 		"KIND_SEQUENCE":                     22,
 		"KIND_STAGE":                        23,
+		"KIND_STRUCTURE":                    24,
 	}
 )
 
@@ -276,6 +279,8 @@ type Type struct {
 	Constraint *Type `protobuf:"bytes,8,opt,name=constraint,proto3,oneof" json:"constraint,omitempty"`
 	// chan_direction indicates read/write direction for channel-typed config parameters.
 	ChanDirection ChanDirection `protobuf:"varint,9,opt,name=chan_direction,json=chanDirection,proto3,enum=arc.types.pb.ChanDirection" json:"chan_direction,omitempty"`
+	// fields contains the named fields of struct types, in declaration order.
+	Fields        []*Param `protobuf:"bytes,10,rep,name=fields,proto3" json:"fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -373,6 +378,13 @@ func (x *Type) GetChanDirection() ChanDirection {
 	return ChanDirection_CHAN_DIRECTION_NONE
 }
 
+func (x *Type) GetFields() []*Param {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 // Param is a named, typed parameter with optional default value.
 type Param struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -675,7 +687,7 @@ const file_arc_go_types_pb_types_proto_rawDesc = "" +
 	"\x12FunctionProperties\x12+\n" +
 	"\x06inputs\x18\x01 \x03(\v2\x13.arc.types.pb.ParamR\x06inputs\x12-\n" +
 	"\aoutputs\x18\x02 \x03(\v2\x13.arc.types.pb.ParamR\aoutputs\x12+\n" +
-	"\x06config\x18\x03 \x03(\v2\x13.arc.types.pb.ParamR\x06config\"\xc3\x03\n" +
+	"\x06config\x18\x03 \x03(\v2\x13.arc.types.pb.ParamR\x06config\"\xf0\x03\n" +
 	"\x04Type\x12+\n" +
 	"\x06inputs\x18\x01 \x03(\v2\x13.arc.types.pb.ParamR\x06inputs\x12-\n" +
 	"\aoutputs\x18\x02 \x03(\v2\x13.arc.types.pb.ParamR\aoutputs\x12+\n" +
@@ -687,7 +699,9 @@ const file_arc_go_types_pb_types_proto_rawDesc = "" +
 	"\n" +
 	"constraint\x18\b \x01(\v2\x12.arc.types.pb.TypeH\x02R\n" +
 	"constraint\x88\x01\x01\x12B\n" +
-	"\x0echan_direction\x18\t \x01(\x0e2\x1b.arc.types.pb.ChanDirectionR\rchanDirectionB\a\n" +
+	"\x0echan_direction\x18\t \x01(\x0e2\x1b.arc.types.pb.ChanDirectionR\rchanDirection\x12+\n" +
+	"\x06fields\x18\n" +
+	" \x03(\v2\x13.arc.types.pb.ParamR\x06fieldsB\a\n" +
 	"\x05_elemB\a\n" +
 	"\x05_unitB\r\n" +
 	"\v_constraint\"Y\n" +
@@ -720,7 +734,7 @@ const file_arc_go_types_pb_types_proto_rawDesc = "" +
 	"dimensions\x18\x01 \x01(\v2\x18.arc.types.pb.DimensionsR\n" +
 	"dimensions\x12\x14\n" +
 	"\x05scale\x18\x02 \x01(\x01R\x05scale\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name*\x9c\x03\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name*\xb0\x03\n" +
 	"\x04Kind\x12\x10\n" +
 	"\fKIND_INVALID\x10\x00\x12\f\n" +
 	"\bKIND_U_8\x10\x01\x12\r\n" +
@@ -745,7 +759,8 @@ const file_arc_go_types_pb_types_proto_rawDesc = "" +
 	"\rKIND_FUNCTION\x10\x15\x12\x11\n" +
 	"\rKIND_SEQUENCE\x10\x16\x12\x0e\n" +
 	"\n" +
-	"KIND_STAGE\x10\x17*[\n" +
+	"KIND_STAGE\x10\x17\x12\x12\n" +
+	"\x0eKIND_STRUCTURE\x10\x18*[\n" +
 	"\rChanDirection\x12\x17\n" +
 	"\x13CHAN_DIRECTION_NONE\x10\x00\x12\x17\n" +
 	"\x13CHAN_DIRECTION_READ\x10\x01\x12\x18\n" +
@@ -791,15 +806,16 @@ var file_arc_go_types_pb_types_proto_depIdxs = []int32{
 	7,  // 8: arc.types.pb.Type.unit:type_name -> arc.types.pb.Unit
 	3,  // 9: arc.types.pb.Type.constraint:type_name -> arc.types.pb.Type
 	1,  // 10: arc.types.pb.Type.chan_direction:type_name -> arc.types.pb.ChanDirection
-	3,  // 11: arc.types.pb.Param.type:type_name -> arc.types.pb.Type
-	8,  // 12: arc.types.pb.Channels.read:type_name -> arc.types.pb.Channels.ReadEntry
-	9,  // 13: arc.types.pb.Channels.write:type_name -> arc.types.pb.Channels.WriteEntry
-	6,  // 14: arc.types.pb.Unit.dimensions:type_name -> arc.types.pb.Dimensions
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	4,  // 11: arc.types.pb.Type.fields:type_name -> arc.types.pb.Param
+	3,  // 12: arc.types.pb.Param.type:type_name -> arc.types.pb.Type
+	8,  // 13: arc.types.pb.Channels.read:type_name -> arc.types.pb.Channels.ReadEntry
+	9,  // 14: arc.types.pb.Channels.write:type_name -> arc.types.pb.Channels.WriteEntry
+	6,  // 15: arc.types.pb.Unit.dimensions:type_name -> arc.types.pb.Dimensions
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_arc_go_types_pb_types_proto_init() }
@@ -32,6 +32,10 @@
 // Function Types:
 //   - Functions with named inputs, outputs, and configuration parameters
 //
+// Struct Types:
+//   - User-declared aggregates of named fields (e.g., struct Point { x f64, y f64 })
+//   - Fields are laid out contiguously in declaration order with no padding
+//
 // # Usage
 //
 // Creating types:
@@ -157,6 +161,29 @@ func (p Params) RequiredCount() int {
 	return count
 }
 
+// Flatten expands struct-typed parameters into one parameter per primitive field,
+// named by the dotted path from the parameter (e.g., "p.x"), in layout order.
+// Non-struct parameters are kept as is. When a struct parameter carries a value, it
+// must be a map from field name to field value, and each field parameter takes its
+// entry. Flatten is how struct parameters cross the runtime boundary, which only
+// handles primitive values.
+func (p Params) Flatten() Params {
+	var flat Params
+	for _, param := range p {
+		if param.Type.Kind != KindStructure {
+			flat = append(flat, param)
+			continue
+		}
+		values, _ := param.Value.(map[string]any)
+		fields := make(Params, len(param.Type.Fields))
+		for i, f := range param.Type.Fields {
+			fields[i] = Param{Name: param.Name + "." + f.Name, Type: f.Type, Value: values[f.Name]}
+		}
+		flat = append(flat, fields.Flatten()...)
+	}
+	return flat
+}
+
 // IntegerMaxValue returns the maximum value representable by this integer type.
 // Panics if the type is not an integer type.
 // Note: For U64, returns math.MaxInt64 for comparison safety since MaxUint64
@@ -265,6 +292,8 @@ func (t Type) String() string {
 		return "sequence"
 	case KindStage:
 		return "stage"
+	case KindStructure:
+		return t.Name
 	default:
 		return "invalid"
 	}
@@ -403,6 +432,61 @@ func Function(props FunctionProperties) Type {
 	return Type{Kind: KindFunction, FunctionProperties: props}
 }
 
+// Struct returns a user-declared struct type with the given name and fields.
+func Struct(name string, fields Params) Type {
+	return Type{Kind: KindStructure, Name: name, Fields: fields}
+}
+
+// IsStruct returns true if the type is a user-declared struct type.
+func (t Type) IsStruct() bool { return t.Kind == KindStructure }
+
+// Field returns the field with the given name and its byte offset from the start of
+// the struct. Returns false if t is not a struct or has no such field.
+func (t Type) Field(name string) (Param, uint32, bool) {
+	if t.Kind != KindStructure {
+		return Param{}, 0, false
+	}
+	var offset uint32
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f, offset, true
+		}
+		offset += f.Type.Size()
+	}
+	return Param{}, 0, false
+}
+
+// LeafCount returns the number of primitive values a value of the type holds: the
+// number of flattened fields for a struct, and 1 otherwise.
+func (t Type) LeafCount() int {
+	if t.Kind != KindStructure {
+		return 1
+	}
+	count := 0
+	for _, f := range t.Fields {
+		count += f.Type.LeafCount()
+	}
+	return count
+}
+
+// Size returns the number of bytes a value of the type occupies in WASM linear
+// memory: the density of a numeric primitive, 4 for a string handle, and the sum of
+// its field sizes for a struct.
+func (t Type) Size() uint32 {
+	switch t.Kind {
+	case KindString:
+		return 4
+	case KindStructure:
+		var size uint32
+		for _, f := range t.Fields {
+			size += f.Type.Size()
+		}
+		return size
+	default:
+		return uint32(t.Density())
+	}
+}
+
 // IsNumeric returns true if the type is a numeric type (integer or float).
 func (t Type) IsNumeric() bool {
 	unwrapped := t.Unwrap()
@@ -532,6 +616,9 @@ func Equal(t Type, v Type) bool {
 		}
 		return Equal(*t.Constraint, *v.Constraint)
 	}
+	if t.Kind == KindStructure {
+		return t.Name == v.Name && paramsEqual(t.Fields, v.Fields)
+	}
 	if t.Kind == KindFunction {
 		if !paramsEqual(t.Inputs, v.Inputs) {
 			return false
@@ -44,6 +44,7 @@ const (
 	KindFunction
 	KindSequence
 	KindStage
+	KindStructure
 )
 
 // ChanDirection indicates read/write direction for channel-typed config parameters.
@@ -83,6 +84,8 @@ type Type struct {
 	Constraint *Type `json:"constraint,omitempty" msgpack:"constraint,omitempty"`
 	// ChanDirection indicates read/write direction for channel-typed config parameters.
 	ChanDirection ChanDirection `json:"chan_direction" msgpack:"chan_direction"`
+	// Fields contains the named fields of struct types, in declaration order.
+	Fields Params `json:"fields" msgpack:"fields"`
 }
 
 // Param is a named, typed parameter with optional default value.
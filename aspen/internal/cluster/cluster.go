@@ -96,6 +96,8 @@ func Open(ctx context.Context, configs ...Config) (c *Cluster, err error) {
 		c.L.Info("existing cluster found in storage. restarting activities")
 		host := c.GetHost()
 		host.Heartbeat = host.Heartbeat.Restart()
+		// The host may have left the cluster when it was last shut down.
+		host.State = node.StateHealthy
 		c.SetNode(ctx, host)
 		c.Pledge.ClusterKey = c.Key()
 		if err := pledge_.Arbitrate(c.Pledge); err != nil {
@@ -187,6 +189,15 @@ func (c *Cluster) Resolve(key node.Key) (address.Address, error) {
 	return n.Address, err
 }
 
+// Leave gracefully removes the host from the cluster by marking it as left and
+// spreading the change to its peers, so that they don't need to wait for the host to
+// be detected as dead. Leave should be called right before the Cluster is closed. If
+// the host is restarted, it will rejoin the cluster as healthy.
+func (c *Cluster) Leave(ctx context.Context) error {
+	c.L.Info("leaving cluster")
+	return c.gossip.Leave(ctx)
+}
+
 func (c *Cluster) Close() error { return c.shutdown.Close() }
 
 func (c *Cluster) gossipInitialState(ctx context.Context) error {
@@ -83,4 +83,46 @@ var _ = Describe("Cluster", func() {
 
 	})
 
+	Describe("Failure Detection", func() {
+
+		It("Should mark a node that stops gossiping as dead", func() {
+			cfg := cluster.Config{Gossip: gossip.Config{
+				SuspectTimeout: 25 * time.Millisecond,
+				DeadTimeout:    50 * time.Millisecond,
+			}}
+			c1 := MustSucceed(builder.New(clusterCtx, cfg))
+			c2 := MustSucceed(builder.New(clusterCtx, cfg))
+			Eventually(func(g Gomega) {
+				g.Expect(c1.Node(c2.HostKey())).Error().ToNot(HaveOccurred())
+			}).Should(Succeed())
+			Expect(c2.Close()).To(Succeed())
+			delete(builder.ClusterAPIs, c2.HostKey())
+			Eventually(func(g Gomega) {
+				g.Expect(MustSucceed(c1.Node(c2.HostKey())).State).To(Equal(node.StateDead))
+			}).Should(Succeed())
+		})
+
+	})
+
+	Describe("Leave", func() {
+
+		It("Should mark the host as left on its peers", func(ctx SpecContext) {
+			c1 := MustSucceed(builder.New(clusterCtx, cluster.Config{}))
+			c2 := MustSucceed(builder.New(clusterCtx, cluster.Config{}))
+			Eventually(func(g Gomega) {
+				g.Expect(c1.Node(c2.HostKey())).Error().ToNot(HaveOccurred())
+			}).Should(Succeed())
+			Expect(c2.Leave(ctx)).To(Succeed())
+			Expect(c2.Host().State).To(Equal(node.StateLeft))
+			Expect(MustSucceed(c1.Node(c2.HostKey())).State).To(Equal(node.StateLeft))
+		})
+
+		It("Should succeed when the host has no peers", func(ctx SpecContext) {
+			c1 := MustSucceed(builder.New(clusterCtx, cluster.Config{}))
+			Expect(c1.Leave(ctx)).To(Succeed())
+			Expect(c1.Host().State).To(Equal(node.StateLeft))
+		})
+
+	})
+
 })
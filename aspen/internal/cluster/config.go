@@ -53,6 +53,9 @@ type Config struct {
 	// to the backend. If this is set to FlushOnEvery, the Cluster state is flushed on
 	// every change.
 	StorageFlushInterval time.Duration
+	// LeaveTimeout is the maximum amount of time to spend notifying peers that the
+	// host is leaving the cluster.
+	LeaveTimeout time.Duration
 }
 
 var _ config.Config[Config] = Config{}
@@ -63,6 +66,7 @@ func (cfg Config) Override(other Config) Config {
 	cfg.Codec = override.Nil(cfg.Codec, other.Codec)
 	cfg.StorageFlushInterval = override.Numeric(cfg.StorageFlushInterval, other.StorageFlushInterval)
 	cfg.StorageKey = override.Slice(cfg.StorageKey, other.StorageKey)
+	cfg.LeaveTimeout = override.Numeric(cfg.LeaveTimeout, other.LeaveTimeout)
 	cfg.Storage = override.Nil(cfg.Storage, other.Storage)
 	cfg.Instrumentation = override.Zero(cfg.Instrumentation, other.Instrumentation)
 	cfg.Gossip = cfg.Gossip.Override(other.Gossip)
@@ -77,6 +81,7 @@ func (cfg Config) Validate() error {
 	validate.NotNil(v, "codec", cfg.Codec)
 	validate.NonZero(v, "storage_flush_interval", cfg.StorageFlushInterval)
 	validate.NotEmptySlice(v, "local_key", cfg.StorageKey)
+	validate.Positive(v, "leave_timeout", cfg.LeaveTimeout)
 	return v.Error()
 }
 
@@ -90,6 +95,7 @@ func (cfg Config) Report() alamos.Report {
 	}
 	report["storage_key"] = string(cfg.StorageKey)
 	report["storage_flush_interval"] = cfg.StorageFlushInterval
+	report["leave_timeout"] = cfg.LeaveTimeout
 	return report
 }
 
@@ -99,6 +105,7 @@ var (
 		StorageKey:           []byte("aspen.cluster"),
 		Gossip:               gossip.DefaultConfig,
 		StorageFlushInterval: 1 * time.Second,
+		LeaveTimeout:         2 * time.Second,
 		// Encodes as JSON, decodes with fallback: JSON -> MsgPack -> Gob.
 		// MsgPack was the primary codec from v0.39 to v0.53, Gob before that.
 		Codec: encoding.NewDecodeFallbackCodec(json.Codec, msgpack.Codec, gob.Codec),
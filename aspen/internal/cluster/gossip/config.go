@@ -33,11 +33,20 @@ type Config struct {
 	alamos.Instrumentation
 	// Interval is the interval at which a node will gossip its state.
 	Interval time.Duration
+	// SuspectTimeout is the amount of time a peer's heartbeat can go without
+	// advancing before the peer is marked as suspect.
+	SuspectTimeout time.Duration
+	// DeadTimeout is the amount of time a peer's heartbeat can go without advancing
+	// before the peer is marked as dead. Must be greater than or equal to
+	// SuspectTimeout.
+	DeadTimeout time.Duration
 }
 
 // Override implements the config.ServiceConfig interface.
 func (cfg Config) Override(other Config) Config {
 	cfg.Interval = override.Numeric(cfg.Interval, other.Interval)
+	cfg.SuspectTimeout = override.Numeric(cfg.SuspectTimeout, other.SuspectTimeout)
+	cfg.DeadTimeout = override.Numeric(cfg.DeadTimeout, other.DeadTimeout)
 	cfg.TransportClient = override.Nil(cfg.TransportClient, other.TransportClient)
 	cfg.TransportServer = override.Nil(cfg.TransportServer, other.TransportServer)
 	cfg.Store = override.Nil(cfg.Store, other.Store)
@@ -52,6 +61,8 @@ func (cfg Config) Validate() error {
 	validate.NotNil(v, "transport_server", cfg.TransportServer)
 	validate.NotNil(v, "store", cfg.Store)
 	validate.Positive(v, "interval", cfg.Interval)
+	validate.Positive(v, "suspect_timeout", cfg.SuspectTimeout)
+	validate.GreaterThanEq(v, "dead_timeout", cfg.DeadTimeout, cfg.SuspectTimeout)
 	return v.Error()
 }
 
@@ -59,6 +70,8 @@ func (cfg Config) Validate() error {
 func (cfg Config) Report() alamos.Report {
 	return alamos.Report{
 		"interval":         cfg.Interval,
+		"suspect_timeout":  cfg.SuspectTimeout,
+		"dead_timeout":     cfg.DeadTimeout,
 		"transport_client": cfg.TransportClient.Report(),
 		"transport_server": cfg.TransportServer.Report(),
 	}
@@ -66,9 +79,13 @@ func (cfg Config) Report() alamos.Report {
 
 var (
 	DefaultConfig = Config{
-		Interval: 1 * time.Second,
+		Interval:       1 * time.Second,
+		SuspectTimeout: 10 * time.Second,
+		DeadTimeout:    30 * time.Second,
 	}
 	FastConfig = DefaultConfig.Override(Config{
-		Interval: 50 * time.Millisecond,
+		Interval:       50 * time.Millisecond,
+		SuspectTimeout: 1 * time.Second,
+		DeadTimeout:    3 * time.Second,
 	})
 )
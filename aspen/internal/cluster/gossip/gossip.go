@@ -11,17 +11,40 @@ package gossip
 
 import (
 	"context"
+	"sync"
+	"time"
+
 	"github.com/synnaxlabs/aspen/internal/node"
 	"github.com/synnaxlabs/x/address"
 	"github.com/synnaxlabs/x/config"
 	"github.com/synnaxlabs/x/errors"
 	"github.com/synnaxlabs/x/rand"
 	"github.com/synnaxlabs/x/signal"
+	"github.com/synnaxlabs/x/version"
 	"go.uber.org/zap"
-	"time"
 )
 
-type Gossip struct{ Config }
+// Gossip spreads cluster state between nodes and detects failed peers. Failure
+// detection follows the SWIM model: a peer that can't be reached directly is marked
+// as suspect, and a peer whose heartbeat hasn't advanced (either directly or through
+// another peer) within Config.SuspectTimeout or Config.DeadTimeout is marked as suspect
+// or dead respectively. A peer recovers as soon as a newer heartbeat is observed.
+type Gossip struct {
+	Config
+	mu struct {
+		sync.Mutex
+		// observed holds the most recent heartbeat observed for each peer, along with
+		// the local time at which it was observed.
+		observed map[node.Key]observation
+	}
+	// hostMu serializes modifications to the host node.
+	hostMu sync.Mutex
+}
+
+type observation struct {
+	heartbeat version.Heartbeat
+	at        time.Time
+}
 
 // New opens a new Gossip that will spread cluster state to and from the given store.
 func New(cfgs ...Config) (*Gossip, error) {
@@ -30,6 +53,7 @@ func New(cfgs ...Config) (*Gossip, error) {
 		return nil, err
 	}
 	g := &Gossip{Config: cfg}
+	g.mu.observed = make(map[node.Key]observation)
 	g.TransportServer.BindHandler(g.process)
 	return g, nil
 }
@@ -52,14 +76,24 @@ func (g *Gossip) GoGossip(ctx signal.Context) {
 	)
 }
 
+// GossipOnce exchanges state with a random peer and then checks for failed peers.
+// Peers that are suspect or dead are still selected so that they can be detected as
+// healthy again, but a failure to reach them is not reported as an error.
 func (g *Gossip) GossipOnce(ctx context.Context) (err error) {
 	snap := g.Store.CopyState()
-	peer := RandomPeer(snap.Nodes, snap.HostKey)
+	peer := rand.MapValue(snap.Nodes.WhereActive().WhereNot(snap.HostKey))
 	g.incrementHostHeartbeat(ctx)
 	if peer.Address != "" {
-		err = g.GossipOnceWith(ctx, peer.Address)
+		if err = g.GossipOnceWith(ctx, peer.Address); err != nil {
+			if peer.State != node.StateHealthy {
+				err = nil
+			} else {
+				g.setState(ctx, peer, node.StateSuspect)
+			}
+		}
 	}
-	return
+	g.detectFailures(ctx)
+	return err
 }
 
 func (g *Gossip) GossipOnceWith(ctx context.Context, addr address.Address) error {
@@ -76,12 +110,90 @@ func (g *Gossip) GossipOnceWith(ctx context.Context, addr address.Address) error
 	return err
 }
 
+// Leave marks the host as having left the cluster and spreads the change directly to
+// every active peer. Leave returns an error only if none of the peers could be reached.
+func (g *Gossip) Leave(ctx context.Context) error {
+	g.hostMu.Lock()
+	host := g.Store.GetHost()
+	host.State = node.StateLeft
+	host.Heartbeat = host.Heartbeat.Increment()
+	g.Store.SetNode(ctx, host)
+	g.hostMu.Unlock()
+	snap := g.Store.CopyState()
+	var (
+		errs    error
+		reached = false
+		peers   = snap.Nodes.WhereActive().WhereNot(snap.HostKey)
+	)
+	for _, peer := range peers {
+		if err := g.GossipOnceWith(ctx, peer.Address); err != nil {
+			errs = errors.Combine(errs, err)
+			continue
+		}
+		reached = true
+	}
+	if reached || len(peers) == 0 {
+		return nil
+	}
+	return errs
+}
+
 func (g *Gossip) incrementHostHeartbeat(ctx context.Context) {
+	g.hostMu.Lock()
+	defer g.hostMu.Unlock()
 	host := g.Store.GetHost()
 	host.Heartbeat = host.Heartbeat.Increment()
 	g.Store.SetNode(ctx, host)
 }
 
+// detectFailures marks peers whose heartbeats have not advanced within the configured
+// timeouts as suspect or dead.
+func (g *Gossip) detectFailures(ctx context.Context) {
+	snap := g.Store.CopyState()
+	now := time.Now()
+	g.mu.Lock()
+	var transitions []node.Node
+	for key, n := range snap.Nodes {
+		if key == snap.HostKey || n.State == node.StateLeft {
+			delete(g.mu.observed, key)
+			continue
+		}
+		obs, ok := g.mu.observed[key]
+		if !ok || n.Heartbeat.OlderThan(obs.heartbeat) {
+			g.mu.observed[key] = observation{heartbeat: n.Heartbeat, at: now}
+			continue
+		}
+		elapsed := now.Sub(obs.at)
+		next := n.State
+		if elapsed >= g.DeadTimeout {
+			next = node.StateDead
+		} else if elapsed >= g.SuspectTimeout && n.State == node.StateHealthy {
+			next = node.StateSuspect
+		}
+		if next != n.State {
+			n.State = next
+			transitions = append(transitions, n)
+		}
+	}
+	g.mu.Unlock()
+	for _, n := range transitions {
+		g.setState(ctx, n, n.State)
+	}
+}
+
+// setState sets the state of the given peer, as long as the store hasn't received a
+// newer heartbeat for it in the meantime.
+func (g *Gossip) setState(ctx context.Context, n node.Node, state node.State) {
+	if g.Store.SetNodeState(ctx, n.Key, n.Heartbeat, state) {
+		g.L.Info(
+			"peer state changed",
+			zap.Stringer("key", n.Key),
+			zap.Stringer("address", n.Address),
+			zap.Stringer("state", state),
+		)
+	}
+}
+
 func (g *Gossip) process(ctx context.Context, msg Message) (Message, error) {
 	ctx, span := g.T.Debug(ctx, "gossip-server")
 	defer span.End()
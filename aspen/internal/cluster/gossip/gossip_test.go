@@ -93,4 +93,61 @@ var _ = Describe("OperationSender", func() {
 			}).To(Panic())
 		})
 	})
+	Describe("Failure Detection", func() {
+		var (
+			t1 *mock.UnaryServer[gossip.Message, gossip.Message]
+			s  store.Store
+			g  *gossip.Gossip
+		)
+		BeforeEach(func(ctx SpecContext) {
+			t1 = net.UnaryServer("")
+			s = store.New(ctx)
+			s.SetState(ctx, store.State{
+				Nodes: node.Group{
+					1: {Key: 1, Address: t1.Address},
+					2: {Key: 2, Address: "localhost:9999"},
+				},
+				HostKey: 1,
+			})
+			g = MustSucceed(gossip.New(gossip.Config{
+				Instrumentation: PanicLogger(),
+				Store:           s,
+				TransportClient: net.UnaryClient(),
+				TransportServer: t1,
+				Interval:        5 * time.Millisecond,
+				SuspectTimeout:  20 * time.Millisecond,
+				DeadTimeout:     40 * time.Millisecond,
+			}))
+		})
+		It("Should mark a peer that cannot be reached as suspect", func(ctx SpecContext) {
+			Expect(g.GossipOnce(ctx)).ToNot(Succeed())
+			Expect(MustBeOk(s.GetNode(2)).State).To(Equal(node.StateSuspect))
+			By("Not returning an error when the peer is already suspect")
+			Expect(g.GossipOnce(ctx)).To(Succeed())
+		})
+		It("Should mark a peer whose heartbeat does not advance as dead", func(ctx SpecContext) {
+			Expect(g.GossipOnce(ctx)).ToNot(Succeed())
+			Eventually(func(g2 Gomega) {
+				g2.Expect(g.GossipOnce(ctx)).To(Succeed())
+				g2.Expect(MustBeOk(s.GetNode(2)).State).To(Equal(node.StateDead))
+			}).Should(Succeed())
+		})
+		It("Should mark a peer as healthy when its heartbeat advances", func(ctx SpecContext) {
+			Expect(g.GossipOnce(ctx)).ToNot(Succeed())
+			s.Merge(ctx, node.Group{2: {
+				Key:       2,
+				Address:   "localhost:9999",
+				Heartbeat: MustBeOk(s.GetNode(2)).Heartbeat.Increment(),
+			}})
+			Expect(MustBeOk(s.GetNode(2)).State).To(Equal(node.StateHealthy))
+		})
+		It("Should not detect failures of peers that have left", func(ctx SpecContext) {
+			s.SetNode(ctx, node.Node{Key: 2, Address: "localhost:9999", State: node.StateLeft})
+			Expect(g.GossipOnce(ctx)).To(Succeed())
+			Consistently(func(g2 Gomega) {
+				g2.Expect(g.GossipOnce(ctx)).To(Succeed())
+				g2.Expect(MustBeOk(s.GetNode(2)).State).To(Equal(node.StateLeft))
+			}, 80*time.Millisecond).Should(Succeed())
+		})
+	})
 })
@@ -19,6 +19,7 @@ import (
 	"github.com/synnaxlabs/aspen/internal/node"
 	"github.com/synnaxlabs/x/change"
 	"github.com/synnaxlabs/x/store"
+	"github.com/synnaxlabs/x/version"
 )
 
 type Change struct {
@@ -38,6 +39,9 @@ type Store interface {
 	SetClusterKey(ctx context.Context, key uuid.UUID)
 	// SetNode sets a node in state.
 	SetNode(context.Context, node.Node)
+	// SetNodeState sets the state of the node with the given key if the node's
+	// heartbeat matches the given heartbeat. Returns true if the state was changed.
+	SetNodeState(ctx context.Context, key node.Key, heartbeat version.Heartbeat, state node.State) bool
 	// GetNode returns a node from state. Returns false if the node is not found.
 	GetNode(key node.Key) (node.Node, bool)
 	// Merge merges a node.Group into State.Nodes by selecting nodes from group with heartbeats
@@ -150,6 +154,24 @@ func (c *core) SetNode(ctx context.Context, n node.Node) {
 	c.SetState(ctx, snap)
 }
 
+// SetNodeState implements Store.
+func (c *core) SetNodeState(
+	ctx context.Context,
+	key node.Key,
+	heartbeat version.Heartbeat,
+	state node.State,
+) bool {
+	snap := c.CopyState()
+	n, ok := snap.Nodes[key]
+	if !ok || n.Heartbeat != heartbeat || n.State == state {
+		return false
+	}
+	n.State = state
+	snap.Nodes[key] = n
+	c.SetState(ctx, snap)
+	return true
+}
+
 // Merge implements Store.
 func (c *core) Merge(ctx context.Context, other node.Group) {
 	snap := c.CopyState()
@@ -44,6 +44,30 @@ var _ = Describe("Store", func() {
 
 	})
 
+	Describe("SetNodeState", func() {
+
+		It("Should set the state of a node with a matching heartbeat", func(ctx SpecContext) {
+			hb := version.Heartbeat{Version: 2}
+			s.SetNode(ctx, node.Node{Key: 1, Heartbeat: hb})
+			Expect(s.SetNodeState(ctx, 1, hb, node.StateSuspect)).To(BeTrue())
+			n, _ := s.GetNode(1)
+			Expect(n.State).To(Equal(node.StateSuspect))
+		})
+
+		It("Should not set the state of a node with a newer heartbeat", func(ctx SpecContext) {
+			s.SetNode(ctx, node.Node{Key: 1, Heartbeat: version.Heartbeat{Version: 3}})
+			Expect(s.SetNodeState(ctx, 1, version.Heartbeat{Version: 2}, node.StateDead)).
+				To(BeFalse())
+			n, _ := s.GetNode(1)
+			Expect(n.State).To(Equal(node.StateHealthy))
+		})
+
+		It("Should return false if the node does not exist", func(ctx SpecContext) {
+			Expect(s.SetNodeState(ctx, 1, version.Heartbeat{}, node.StateDead)).To(BeFalse())
+		})
+
+	})
+
 	Describe("Apply", func() {
 
 		It("Should add nonexistent nodes", func(ctx SpecContext) {
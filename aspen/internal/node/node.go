@@ -49,6 +49,7 @@ func (n Node) Digest() Digest { return Digest{Key: n.Key, Heartbeat: n.Heartbeat
 
 type Change = change.Change[Key, Node]
 
+// State is the state of a node as observed by the host.
 type State uint32
 
 func BasicallyEqual(prev, next Node) bool {
@@ -57,12 +58,34 @@ func BasicallyEqual(prev, next Node) bool {
 }
 
 const (
+	// StateHealthy means the node's heartbeat is advancing.
 	StateHealthy State = iota
+	// StateSuspect means the node could not be reached, or its heartbeat has not
+	// advanced recently.
 	StateSuspect
+	// StateDead means the node's heartbeat has not advanced for long enough that the
+	// node is considered to have failed.
 	StateDead
+	// StateLeft means the node gracefully left the cluster.
 	StateLeft
 )
 
+// String implements fmt.Stringer.
+func (s State) String() string {
+	switch s {
+	case StateHealthy:
+		return "healthy"
+	case StateSuspect:
+		return "suspect"
+	case StateDead:
+		return "dead"
+	case StateLeft:
+		return "left"
+	default:
+		return "unknown"
+	}
+}
+
 type Digest struct {
 	Key       Key
 	Heartbeat version.Heartbeat
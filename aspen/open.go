@@ -20,9 +20,11 @@ import (
 	"github.com/synnaxlabs/aspen/internal/cluster"
 	"github.com/synnaxlabs/aspen/internal/kv"
 	"github.com/synnaxlabs/x/address"
+	xio "github.com/synnaxlabs/x/io"
 	xkv "github.com/synnaxlabs/x/kv"
 	"github.com/synnaxlabs/x/kv/pebblekv"
 	"github.com/synnaxlabs/x/service"
+	"go.uber.org/zap"
 )
 
 func Open(
@@ -67,10 +69,26 @@ func Open(
 	if err = o.transport.Serve(); !ok(err, o.transport) {
 		return nil, err
 	}
+	// Closers run in reverse order, so the host leaves the cluster before the
+	// transport stops serving requests.
+	if !ok(nil, xio.CloserFunc(func() error { return leave(db, o) })) {
+		return nil, ctx.Err()
+	}
 
 	return db, err
 }
 
+// leave gracefully removes the host from the cluster. Failing to reach any peers is
+// not an error, as they will eventually detect that the host is dead.
+func leave(db *DB, o *options) error {
+	ctx, cancel := context.WithTimeout(context.Background(), o.cluster.LeaveTimeout)
+	defer cancel()
+	if err := db.Cluster.Leave(ctx); err != nil {
+		o.L.Warn("failed to notify peers of leaving cluster", zap.Error(err))
+	}
+	return nil
+}
+
 func configureTransport(o *options) error {
 	if err := o.transport.Configure(
 		o.addr, o.Instrumentation, o.transport.external,
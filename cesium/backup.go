@@ -0,0 +1,104 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package cesium
+
+import (
+	"context"
+
+	"github.com/synnaxlabs/cesium/internal/domain"
+	"github.com/synnaxlabs/cesium/internal/meta"
+	"github.com/synnaxlabs/x/errors"
+	xfs "github.com/synnaxlabs/x/io/fs"
+)
+
+// channelSnapshot is the state of a single channel captured for a backup. snapshot is
+// nil for virtual channels, which have no data.
+type channelSnapshot struct {
+	ch       Channel
+	snapshot *domain.Snapshot
+}
+
+// Backup writes a point-in-time copy of the database to the given file system, which
+// should be empty. Writers may continue to commit data while Backup runs, and the copy
+// contains the data that was committed when Backup was called. The copy can be opened
+// with Open like any other database.
+//
+// Garbage collection is suspended for the duration of the backup.
+func (db *DB) Backup(ctx context.Context, dst xfs.FS) (err error) {
+	if db.closed.Load() {
+		return ErrDBClosed
+	}
+	ctx, span := db.T.Bench(ctx, "backup")
+	defer func() { err = span.EndWith(err) }()
+	snapshots, err := db.snapshot()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, s := range snapshots {
+			if s.snapshot != nil {
+				err = errors.Combine(err, s.snapshot.Close())
+			}
+		}
+	}()
+	for _, s := range snapshots {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		sub, err := dst.Sub(keyToDirName(s.ch.Key))
+		if err != nil {
+			return err
+		}
+		if err = meta.Create(ctx, sub, db.metaCodec, s.ch); err != nil {
+			return err
+		}
+		if s.snapshot == nil {
+			continue
+		}
+		if err = s.snapshot.WriteTo(ctx, sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshot captures the state of every channel in the database. Data channels are
+// captured before index channels, so that any data committed before its channel was
+// captured has timestamps in the backup even if its index was written to concurrently.
+func (db *DB) snapshot() (snapshots []channelSnapshot, err error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, s := range snapshots {
+			if s.snapshot != nil {
+				err = errors.Combine(err, s.snapshot.Close())
+			}
+		}
+	}()
+	for _, isIndex := range []bool{false, true} {
+		for _, u := range db.mu.dbs.unary {
+			if u.Channel().IsIndex != isIndex {
+				continue
+			}
+			s, err := u.Snapshot()
+			if err != nil {
+				return snapshots, err
+			}
+			snapshots = append(snapshots, channelSnapshot{ch: u.Channel(), snapshot: s})
+		}
+	}
+	for _, v := range db.mu.dbs.virtual {
+		snapshots = append(snapshots, channelSnapshot{ch: v.Channel()})
+	}
+	return snapshots, nil
+}
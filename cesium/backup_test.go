@@ -0,0 +1,109 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package cesium_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/cesium"
+	. "github.com/synnaxlabs/cesium/internal/testutil"
+	xfs "github.com/synnaxlabs/x/io/fs"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Backup", func() {
+	for fsName, openFS := range FileSystems {
+		Context("FS: "+fsName, func() {
+			var (
+				db      *cesium.DB
+				fs      xfs.FS
+				dstFS   xfs.FS
+				index   cesium.ChannelKey
+				data    cesium.ChannelKey
+				virtual cesium.ChannelKey
+			)
+			BeforeEach(func(ctx SpecContext) {
+				fs = openFS()
+				dstFS = openFS()
+				index = GenerateChannelKey()
+				data = GenerateChannelKey()
+				virtual = GenerateChannelKey()
+				db = openDBOnFS(ctx, fs)
+				Expect(db.CreateChannel(
+					ctx,
+					cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+					cesium.Channel{Key: data, Name: "data", DataType: telem.Int64T, Index: index},
+					cesium.Channel{Key: virtual, Name: "virtual", DataType: telem.Int64T, Virtual: true},
+				)).To(Succeed())
+			})
+			AfterEach(func() { Expect(db.Close()).To(Succeed()) })
+
+			It("Should back up the data committed while a writer is open", func(ctx SpecContext) {
+				w := MustSucceed(db.OpenWriter(ctx, cesium.WriterConfig{
+					Channels: []cesium.ChannelKey{index, data},
+					Start:    10 * telem.SecondTS,
+				}))
+				MustSucceed(w.Write(telem.MultiFrame(
+					[]cesium.ChannelKey{index, data},
+					[]telem.Series{
+						telem.NewSeriesSecondsTSV(10, 11, 12),
+						telem.NewSeriesV[int64](1, 2, 3),
+					},
+				)))
+				MustSucceed(w.Commit())
+				By("Writing uncommitted data before the backup")
+				MustSucceed(w.Write(telem.MultiFrame(
+					[]cesium.ChannelKey{index, data},
+					[]telem.Series{
+						telem.NewSeriesSecondsTSV(13, 14),
+						telem.NewSeriesV[int64](4, 5),
+					},
+				)))
+
+				Expect(db.Backup(ctx, dstFS)).To(Succeed())
+				MustSucceed(w.Commit())
+				Expect(w.Close()).To(Succeed())
+
+				restored := openDBOnFS(ctx, dstFS)
+				channels := MustSucceed(restored.RetrieveChannels(ctx, index, data, virtual))
+				Expect(channels).To(HaveLen(3))
+				frame := MustSucceed(restored.Read(ctx, telem.TimeRangeMax, index, data))
+				Expect(frame.Get(index).Series).To(HaveLen(1))
+				Expect(frame.Get(index).Series[0]).To(telem.MatchSeriesDataV(
+					10*telem.SecondTS,
+					11*telem.SecondTS,
+					12*telem.SecondTS,
+				))
+				Expect(frame.Get(data).Series[0]).To(telem.MatchSeriesDataV[int64](1, 2, 3))
+
+				By("Writing to the restored database")
+				Expect(restored.Write(ctx, 20*telem.SecondTS, telem.MultiFrame(
+					[]cesium.ChannelKey{index, data},
+					[]telem.Series{
+						telem.NewSeriesSecondsTSV(20, 21),
+						telem.NewSeriesV[int64](6, 7),
+					},
+				))).To(Succeed())
+				frame = MustSucceed(restored.Read(ctx, telem.TimeRangeMax, data))
+				Expect(frame.Get(data).Series).To(HaveLen(2))
+				Expect(restored.Close()).To(Succeed())
+				Expect(MustSucceed(cesium.Check("", false, cesium.WithFS(dstFS))).Healthy()).
+					To(BeTrue())
+			})
+
+			It("Should return an error if the database is closed", func(ctx SpecContext) {
+				closed := openDBOnFS(ctx, openFS())
+				Expect(closed.Close()).To(Succeed())
+				Expect(closed.Backup(ctx, dstFS)).To(MatchError(cesium.ErrDBClosed))
+			})
+		})
+	}
+})
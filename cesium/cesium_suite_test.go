@@ -30,6 +30,10 @@ func openDBOnFS(ctx context.Context, fs xfs.FS) *cesium.DB {
 	))
 }
 
+// indexHeaderSize is the size of the version header at the start of every domain
+// index file.
+const indexHeaderSize = 8
+
 func channelKeyToPath(key cesium.ChannelKey) string {
 	return strconv.Itoa(int(key))
 }
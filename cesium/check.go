@@ -0,0 +1,90 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package cesium
+
+import (
+	"strconv"
+
+	"github.com/synnaxlabs/cesium/internal/domain"
+)
+
+type (
+	// CorruptDomain is a range of a channel's data that failed verification.
+	CorruptDomain = domain.CorruptDomain
+	// DomainCheckReport summarizes the results of checking the data of a channel.
+	DomainCheckReport = domain.CheckReport
+)
+
+// ChannelCheckReport summarizes the results of checking the data of a single channel.
+type ChannelCheckReport struct {
+	DomainCheckReport
+	// Key is the key of the channel.
+	Key ChannelKey
+}
+
+// CheckReport summarizes the results of a Check.
+type CheckReport struct {
+	// Channels contains a report for each channel that stores data on disk.
+	Channels []ChannelCheckReport
+}
+
+// Healthy returns true if no corruption was found in any channel.
+func (r CheckReport) Healthy() bool {
+	for _, ch := range r.Channels {
+		if !ch.Healthy() {
+			return false
+		}
+	}
+	return true
+}
+
+// Check verifies the data of every channel in the database stored in dirname against
+// the checksums recorded when the data was written. If repair is true, corrupt ranges
+// of data are removed from each channel's index so that the database can be opened and
+// read again. Check must not be called while the database is open.
+func Check(dirname string, repair bool, opts ...Option) (CheckReport, error) {
+	var report CheckReport
+	o, err := newOptions(dirname, opts...)
+	if err != nil {
+		return report, err
+	}
+	if err = openFS(o); err != nil {
+		return report, err
+	}
+	info, err := o.fs.List("")
+	if err != nil {
+		return report, err
+	}
+	for _, i := range info {
+		if !i.IsDir() {
+			continue
+		}
+		key, err := strconv.Atoi(i.Name())
+		if err != nil {
+			continue
+		}
+		fs, err := o.fs.Sub(i.Name())
+		if err != nil {
+			return report, err
+		}
+		chReport, err := domain.Check(fs, repair)
+		if err != nil {
+			return report, err
+		}
+		if chReport.Domains == 0 && chReport.Healthy() {
+			continue
+		}
+		report.Channels = append(report.Channels, ChannelCheckReport{
+			Key:               ChannelKey(key),
+			DomainCheckReport: chReport,
+		})
+	}
+	return report, nil
+}
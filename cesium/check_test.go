@@ -0,0 +1,100 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package cesium_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/cesium"
+	. "github.com/synnaxlabs/cesium/internal/testutil"
+	xfs "github.com/synnaxlabs/x/io/fs"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Check", func() {
+	for fsName, openFS := range FileSystems {
+		Context("FS: "+fsName, func() {
+			var (
+				fs    xfs.FS
+				index cesium.ChannelKey
+				data  cesium.ChannelKey
+			)
+			openDB := func(ctx SpecContext) *cesium.DB {
+				return MustSucceed(cesium.Open(ctx, "",
+					cesium.WithFS(fs),
+					cesium.WithInstrumentation(PanicLogger()),
+				))
+			}
+			BeforeEach(func(ctx SpecContext) {
+				fs = openFS()
+				index = GenerateChannelKey()
+				data = GenerateChannelKey()
+				db := openDB(ctx)
+				Expect(db.CreateChannel(
+					ctx,
+					cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+					cesium.Channel{Key: data, Name: "data", DataType: telem.Int64T, Index: index},
+				)).To(Succeed())
+				Expect(db.Write(ctx, 10*telem.SecondTS, telem.MultiFrame(
+					[]cesium.ChannelKey{index, data},
+					[]telem.Series{
+						telem.NewSeriesSecondsTSV(10, 11, 12),
+						telem.NewSeriesV[int64](1, 2, 3),
+					},
+				))).To(Succeed())
+				Expect(db.Close()).To(Succeed())
+			})
+
+			It("Should report a healthy database", func() {
+				report := MustSucceed(cesium.Check("", false, cesium.WithFS(fs)))
+				Expect(report.Healthy()).To(BeTrue())
+				Expect(report.Channels).To(HaveLen(2))
+			})
+
+			It("Should report and repair a corrupt channel", func(ctx SpecContext) {
+				f := MustSucceed(fs.Open(channelKeyToPath(data)+"/1.domain", os.O_RDWR))
+				MustSucceed(f.WriteAt([]byte{0xFF}, 3))
+				Expect(f.Close()).To(Succeed())
+
+				By("Returning an error when reading the corrupt data")
+				db := openDB(ctx)
+				Expect(db.Read(ctx, telem.TimeRangeMax, data)).Error().
+					To(MatchError(cesium.ErrChecksumMismatch))
+				Expect(db.Close()).To(Succeed())
+
+				By("Reporting the corrupt channel")
+				report := MustSucceed(cesium.Check("", false, cesium.WithFS(fs)))
+				Expect(report.Healthy()).To(BeFalse())
+				var corrupt []cesium.ChannelKey
+				for _, ch := range report.Channels {
+					if !ch.Healthy() {
+						corrupt = append(corrupt, ch.Key)
+					}
+				}
+				Expect(corrupt).To(Equal([]cesium.ChannelKey{data}))
+
+				By("Removing the corrupt data")
+				report = MustSucceed(cesium.Check("", true, cesium.WithFS(fs)))
+				Expect(report.Healthy()).To(BeFalse())
+				Expect(MustSucceed(cesium.Check("", false, cesium.WithFS(fs))).Healthy()).To(BeTrue())
+				db = openDB(ctx)
+				frame := MustSucceed(db.Read(ctx, telem.TimeRangeMax, index))
+				Expect(frame.Get(index).Len()).To(BeEquivalentTo(3))
+				Expect(db.WriteSeries(ctx, data, 10*telem.SecondTS, telem.NewSeriesV[int64](4, 5, 6))).To(Succeed())
+				Expect(MustSucceed(db.Read(ctx, telem.TimeRangeMax, data)).Get(data).Series[0]).
+					To(telem.MatchSeriesDataV[int64](4, 5, 6))
+				Expect(db.Close()).To(Succeed())
+			})
+		})
+	}
+})
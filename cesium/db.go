@@ -17,6 +17,8 @@ import (
 
 	"github.com/synnaxlabs/cesium/internal/alignment"
 	"github.com/synnaxlabs/cesium/internal/channel"
+	"github.com/synnaxlabs/cesium/internal/compression"
+	"github.com/synnaxlabs/cesium/internal/domain"
 	"github.com/synnaxlabs/cesium/internal/resource"
 	"github.com/synnaxlabs/cesium/internal/unary"
 	"github.com/synnaxlabs/cesium/internal/virtual"
@@ -29,18 +31,42 @@ type (
 	Channel    = channel.Channel
 	ChannelKey = channel.Key
 	Frame      = channel.Frame
+	// Compression is the codec used to compress the on-disk data of a channel.
+	Compression = compression.Codec
+)
+
+const (
+	// CompressionNone stores channel data uncompressed.
+	CompressionNone = compression.None
+	// CompressionXOR uses Gorilla-style XOR encoding, which is best suited for
+	// slowly changing floating point values.
+	CompressionXOR = compression.XOR
+	// CompressionDeltaOfDelta uses delta-of-delta encoding, which is best suited for
+	// regularly spaced timestamps and monotonic counters.
+	CompressionDeltaOfDelta = compression.DeltaOfDelta
+	// CompressionZstd uses general purpose zstd compression, and can be used with any
+	// data type.
+	CompressionZstd = compression.Zstd
 )
 
 var (
 	ErrDBClosed          = resource.NewClosedError("cesium.db")
 	ErrChannelNotFound   = channel.ErrNotFound
 	ZeroLeadingAlignment = alignment.ZeroLeading
+	// ErrCorrupt is returned when data read from disk is found to be corrupt.
+	ErrCorrupt = domain.ErrCorrupt
+	// ErrChecksumMismatch is returned when data read from disk does not match the
+	// checksum recorded for it.
+	ErrChecksumMismatch = domain.ErrChecksumMismatch
 )
 
 // Metrics contains statistics about the cesium database.
 type Metrics struct {
 	// DiskSize is the total disk space used by all channel data.
 	DiskSize telem.Size
+	// LogicalSize is the total size of all channel data before compression. For
+	// databases without any compressed channels, LogicalSize is equal to DiskSize.
+	LogicalSize telem.Size
 	// ChannelCount is the number of channels in the database.
 	ChannelCount int
 }
@@ -110,26 +136,28 @@ func (db *DB) Read(ctx context.Context, tr telem.TimeRange, keys ...channel.Key)
 	if err != nil {
 		return frame, err
 	}
-	defer func() { err = iter.Close() }()
+	defer func() { err = errors.Combine(err, iter.Close()) }()
 	if !iter.SeekFirst() {
-		return frame, err
+		return frame, iter.Error()
 	}
 	for iter.Next(telem.TimeSpanMax) {
 		frame = frame.Extend(iter.Value())
 	}
-	return frame, err
+	return frame, iter.Error()
 }
 
 // Metrics returns current metrics for the database.
 func (db *DB) Metrics() Metrics {
 	db.mu.RLock()
 	defer db.mu.RUnlock()
-	var size telem.Size
+	var size, logicalSize telem.Size
 	for _, u := range db.mu.dbs.unary {
 		size += u.Size()
+		logicalSize += u.LogicalSize()
 	}
 	return Metrics{
 		DiskSize:     size,
+		LogicalSize:  logicalSize,
 		ChannelCount: len(db.mu.dbs.unary) + len(db.mu.dbs.virtual),
 	}
 }
@@ -12,6 +12,7 @@ package channel
 import (
 	"fmt"
 
+	"github.com/synnaxlabs/cesium/internal/compression"
 	"github.com/synnaxlabs/cesium/internal/version"
 	"github.com/synnaxlabs/x/control"
 	"github.com/synnaxlabs/x/errors"
@@ -65,6 +66,40 @@ type Channel struct {
 	//
 	// [OPTIONAL]
 	Version version.Version `json:"version" msgpack:"version"`
+	// Compression is the codec used to compress the channel's data on disk. The codec
+	// must be compatible with the channel's data type. Compression is transparent to
+	// readers, and has no effect on virtual channels.
+	//
+	// [OPTIONAL] Default: compression.None
+	Compression compression.Codec `json:"compression" msgpack:"compression"`
+	// Retention is the policy used to automatically delete old data from the channel.
+	// Retention has no effect on virtual channels.
+	//
+	// [OPTIONAL] Default: data is retained forever.
+	Retention Retention `json:"retention" msgpack:"retention"`
+}
+
+// Retention is a policy for automatically aging out the data in a channel. When both
+// MaxAge and MaxSize are set, the stricter of the two applies.
+type Retention struct {
+	// MaxAge is the maximum age of data in the channel, measured from the current time.
+	// Data older than MaxAge is deleted. A value of zero disables age based retention.
+	MaxAge telem.TimeSpan `json:"max_age" msgpack:"max_age"`
+	// MaxSize is the maximum number of bytes the channel's data can occupy on disk.
+	// When exceeded, the oldest data is deleted until the channel fits. A value of zero
+	// disables size based retention.
+	MaxSize telem.Size `json:"max_size" msgpack:"max_size"`
+}
+
+// Enabled returns true if the retention policy requires data to be deleted.
+func (r Retention) Enabled() bool { return r.MaxAge > 0 || r.MaxSize > 0 }
+
+// Validate checks that the retention policy is valid.
+func (r Retention) Validate() error {
+	v := validate.New("retention")
+	validate.GreaterThanEq(v, "max_age", r.MaxAge, 0)
+	validate.GreaterThanEq(v, "max_size", r.MaxSize, 0)
+	return v.Error()
 }
 
 // String implements fmt.Stringer to return nicely formatted channel info.
@@ -109,6 +144,10 @@ func (c Channel) Validate() error {
 		} else {
 			v.Ternaryf("index", c.Index == 0, "non-indexed channel must have an index")
 		}
+		if err := c.Compression.Validate(c.DataType); err != nil {
+			v.Exec(func() error { return validate.PathedError(err, "compression") })
+		}
+		v.Exec(c.Retention.Validate)
 	}
 	return v.Error()
 }
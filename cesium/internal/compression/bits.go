@@ -0,0 +1,77 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package compression
+
+// bitWriter appends individual bits to a byte slice, most significant bit first.
+type bitWriter struct {
+	buf []byte
+	// free is the number of unused bits remaining in the last byte of buf.
+	free uint8
+}
+
+func (w *bitWriter) writeBit(bit bool) {
+	if w.free == 0 {
+		w.buf = append(w.buf, 0)
+		w.free = 8
+	}
+	w.free--
+	if bit {
+		w.buf[len(w.buf)-1] |= 1 << w.free
+	}
+}
+
+// writeBits writes the nBits least significant bits of v.
+func (w *bitWriter) writeBits(v uint64, nBits uint8) {
+	for nBits > 0 {
+		if w.free == 0 {
+			w.buf = append(w.buf, 0)
+			w.free = 8
+		}
+		n := min(nBits, w.free)
+		chunk := (v >> (nBits - n)) & (1<<n - 1)
+		w.free -= n
+		w.buf[len(w.buf)-1] |= byte(chunk << w.free)
+		nBits -= n
+	}
+}
+
+// bitReader reads individual bits from a byte slice written by a bitWriter.
+type bitReader struct {
+	buf []byte
+	// pos is the index of the next bit to read.
+	pos int
+}
+
+func (r *bitReader) readBit() (bool, error) {
+	if r.pos >= len(r.buf)*8 {
+		return false, ErrCorrupt
+	}
+	bit := r.buf[r.pos/8]&(1<<(7-r.pos%8)) != 0
+	r.pos++
+	return bit, nil
+}
+
+// readBits reads nBits bits and returns them as the least significant bits of the
+// result.
+func (r *bitReader) readBits(nBits uint8) (uint64, error) {
+	if r.pos+int(nBits) > len(r.buf)*8 {
+		return 0, ErrCorrupt
+	}
+	var v uint64
+	for nBits > 0 {
+		avail := uint8(8 - r.pos%8)
+		n := min(nBits, avail)
+		b := uint64(r.buf[r.pos/8]>>(avail-n)) & (1<<n - 1)
+		v = v<<n | b
+		r.pos += int(n)
+		nBits -= n
+	}
+	return v, nil
+}
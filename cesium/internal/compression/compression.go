@@ -0,0 +1,151 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package compression implements the codecs that cesium can use to compress the
+// samples of a channel before they are written to a domain file. Every codec is
+// lossless, and operates on a contiguous block of samples that is compressed and
+// decompressed as a single unit.
+package compression
+
+import (
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// Codec identifies the algorithm used to compress a block of channel data.
+type Codec uint8
+
+const (
+	// None stores samples uncompressed. This is the default codec for all channels.
+	None Codec = iota
+	// XOR is a Gorilla-style codec that XORs each sample with its predecessor and
+	// stores only the meaningful bits of the result. It is best suited for slowly
+	// changing floating point sensor data, and can be used on any 32 or 64-bit data
+	// type.
+	XOR
+	// DeltaOfDelta stores the difference between consecutive deltas of each sample. It
+	// is best suited for regularly sampled timestamps, and can be used on any 64-bit
+	// integer data type.
+	DeltaOfDelta
+	// Zstd is a general purpose, byte-oriented codec. It is best suited for variable
+	// density data such as strings and JSON, and can be used on any data type.
+	Zstd
+)
+
+var codecNames = map[Codec]string{
+	None:         "none",
+	XOR:          "xor",
+	DeltaOfDelta: "delta_of_delta",
+	Zstd:         "zstd",
+}
+
+// String implements fmt.Stringer.
+func (c Codec) String() string {
+	if name, ok := codecNames[c]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ErrCorrupt is returned when a compressed block cannot be decoded.
+var ErrCorrupt = errors.New("compressed block is corrupt")
+
+// Validate returns an error if the codec cannot be used to compress samples of the
+// given data type.
+func (c Codec) Validate(dt telem.DataType) error {
+	switch c {
+	case None, Zstd:
+		return nil
+	case XOR:
+		if den := dt.Density(); den != telem.Bit32 && den != telem.Bit64 {
+			return errors.Wrapf(
+				validate.ErrValidation,
+				"%s compression is only supported on 32 and 64-bit data types, received %s",
+				c,
+				dt,
+			)
+		}
+		return nil
+	case DeltaOfDelta:
+		if dt != telem.TimeStampT && dt != telem.Int64T && dt != telem.Uint64T {
+			return errors.Wrapf(
+				validate.ErrValidation,
+				"%s compression is only supported on 64-bit integer data types, received %s",
+				c,
+				dt,
+			)
+		}
+		return nil
+	default:
+		return errors.Wrapf(validate.ErrValidation, "unknown compression codec %d", c)
+	}
+}
+
+// Compress compresses src, a block of samples of the given density, and appends the
+// result to dst. Fixed width codecs require the length of src to be a multiple of the
+// density.
+func Compress(c Codec, den telem.Density, dst, src []byte) ([]byte, error) {
+	switch c {
+	case None:
+		return append(dst, src...), nil
+	case XOR:
+		if err := validateWidth(c, den, src); err != nil {
+			return nil, err
+		}
+		return compressXOR(den, dst, src), nil
+	case DeltaOfDelta:
+		if den != telem.Bit64 {
+			return nil, errors.Newf("%s compression does not support a density of %d", c, den)
+		}
+		if err := validateWidth(c, den, src); err != nil {
+			return nil, err
+		}
+		return compressDeltaOfDelta(dst, src), nil
+	case Zstd:
+		return compressZstd(dst, src), nil
+	default:
+		return nil, errors.Newf("unknown compression codec %d", c)
+	}
+}
+
+// Decompress decompresses src, a block previously produced by Compress with the same
+// codec and density, and appends the rawLen decompressed bytes to dst.
+func Decompress(c Codec, den telem.Density, dst, src []byte, rawLen int) ([]byte, error) {
+	switch c {
+	case None:
+		if len(src) != rawLen {
+			return nil, ErrCorrupt
+		}
+		return append(dst, src...), nil
+	case XOR:
+		return decompressXOR(den, dst, src, rawLen)
+	case DeltaOfDelta:
+		return decompressDeltaOfDelta(dst, src, rawLen)
+	case Zstd:
+		return decompressZstd(dst, src, rawLen)
+	default:
+		return nil, errors.Newf("unknown compression codec %d", c)
+	}
+}
+
+func validateWidth(c Codec, den telem.Density, src []byte) error {
+	if den != telem.Bit32 && den != telem.Bit64 {
+		return errors.Newf("%s compression does not support a density of %d", c, den)
+	}
+	if len(src)%int(den) != 0 {
+		return errors.Newf(
+			"%s compression requires a block size that is a multiple of %d, received %d",
+			c,
+			den,
+			len(src),
+		)
+	}
+	return nil
+}
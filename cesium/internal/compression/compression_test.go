@@ -0,0 +1,151 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package compression_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/cesium/internal/compression"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+	"github.com/synnaxlabs/x/validate"
+)
+
+func sine(n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = math.Round(100*math.Sin(float64(i)/2000)) / 10
+	}
+	return values
+}
+
+var _ = Describe("Compression", func() {
+	DescribeTable("Round Trip",
+		func(c compression.Codec, s telem.Series) {
+			den := s.DataType.Density()
+			compressed := MustSucceed(compression.Compress(c, den, nil, s.Data))
+			decompressed := MustSucceed(compression.Decompress(
+				c,
+				den,
+				nil,
+				compressed,
+				len(s.Data),
+			))
+			Expect(decompressed).To(Equal(s.Data))
+		},
+		Entry("None", compression.None, telem.NewSeriesV[int64](1, 2, 3)),
+		Entry("XOR - float64", compression.XOR, telem.NewSeriesV(sine(1000)...)),
+		Entry("XOR - float64 constant", compression.XOR, telem.NewSeriesV(1.5, 1.5, 1.5, 1.5)),
+		Entry("XOR - float64 single", compression.XOR, telem.NewSeriesV(3.14)),
+		Entry(
+			"XOR - float64 extremes",
+			compression.XOR,
+			telem.NewSeriesV(0, math.MaxFloat64, -math.MaxFloat64, math.SmallestNonzeroFloat64, math.Inf(1), 0),
+		),
+		Entry("XOR - float32", compression.XOR, telem.NewSeriesV[float32](1.1, 1.2, 1.2, 100.5, -3, 0)),
+		Entry("XOR - int32", compression.XOR, telem.NewSeriesV[int32](math.MaxInt32, math.MinInt32, 0, 1)),
+		Entry("XOR - empty", compression.XOR, telem.NewSeriesV[float64]()),
+		Entry(
+			"DeltaOfDelta - regular timestamps",
+			compression.DeltaOfDelta,
+			telem.NewSeriesSecondsTSV(1, 2, 3, 4, 5, 6, 7, 8, 9, 10),
+		),
+		Entry(
+			"DeltaOfDelta - irregular timestamps",
+			compression.DeltaOfDelta,
+			telem.NewSeriesV[telem.TimeStamp](
+				telem.Now(),
+				telem.Now()+40*telem.TimeStamp(telem.Microsecond)+3,
+				telem.Now()+80*telem.TimeStamp(telem.Microsecond)-1,
+				telem.Now()+2*telem.TimeStamp(telem.Second),
+				telem.TimeStampMax,
+				telem.TimeStampMin,
+			),
+		),
+		Entry("DeltaOfDelta - two values", compression.DeltaOfDelta, telem.NewSeriesV[int64](5, -5)),
+		Entry("DeltaOfDelta - single value", compression.DeltaOfDelta, telem.NewSeriesV[int64](42)),
+		Entry("DeltaOfDelta - uint64", compression.DeltaOfDelta, telem.NewSeriesV[uint64](0, math.MaxUint64, 1, 2)),
+		Entry("Zstd - strings", compression.Zstd, telem.NewSeriesV("cat", "dog", "cat", "dog", "cat")),
+		Entry("Zstd - json", compression.Zstd, telem.NewSeriesV(`{"a": 1}`, `{"a": 2}`, `{"a": 3}`)),
+		Entry("Zstd - empty", compression.Zstd, telem.NewSeriesV[string]()),
+	)
+
+	Describe("Compression Ratio", func() {
+		It("Should significantly compress slowly changing floats", func() {
+			s := telem.NewSeriesV(sine(10000)...)
+			compressed := MustSucceed(compression.Compress(compression.XOR, telem.Bit64, nil, s.Data))
+			Expect(len(compressed)).To(BeNumerically("<", len(s.Data)/2))
+		})
+
+		It("Should compress regularly spaced timestamps to roughly a bit per sample", func() {
+			s := telem.NewSeriesV(make([]telem.TimeStamp, 10000)...)
+			for i := range 10000 {
+				telem.SetValueAt(s, i, telem.TimeStamp(i)*telem.TimeStamp(40*telem.Microsecond))
+			}
+			compressed := MustSucceed(compression.Compress(compression.DeltaOfDelta, telem.Bit64, nil, s.Data))
+			Expect(len(compressed)).To(BeNumerically("<", 10000/8+32))
+		})
+	})
+
+	Describe("Validation", func() {
+		DescribeTable("Supported data types",
+			func(c compression.Codec, dt telem.DataType, ok bool) {
+				err := c.Validate(dt)
+				if ok {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(validate.ErrValidation))
+				}
+			},
+			Entry("None on string", compression.None, telem.StringT, true),
+			Entry("XOR on float64", compression.XOR, telem.Float64T, true),
+			Entry("XOR on float32", compression.XOR, telem.Float32T, true),
+			Entry("XOR on uint8", compression.XOR, telem.Uint8T, false),
+			Entry("XOR on string", compression.XOR, telem.StringT, false),
+			Entry("DeltaOfDelta on timestamp", compression.DeltaOfDelta, telem.TimeStampT, true),
+			Entry("DeltaOfDelta on float64", compression.DeltaOfDelta, telem.Float64T, false),
+			Entry("Zstd on json", compression.Zstd, telem.JSONT, true),
+			Entry("Unknown codec", compression.Codec(200), telem.Float64T, false),
+		)
+
+		It("Should return an error when the block is not a multiple of the density", func() {
+			Expect(compression.Compress(compression.XOR, telem.Bit64, nil, []byte{1, 2, 3})).
+				Error().To(HaveOccurred())
+		})
+	})
+
+	Describe("Corruption", func() {
+		It("Should return an error when a block is truncated", func() {
+			s := telem.NewSeriesV(sine(100)...)
+			compressed := MustSucceed(compression.Compress(compression.XOR, telem.Bit64, nil, s.Data))
+			Expect(compression.Decompress(
+				compression.XOR,
+				telem.Bit64,
+				nil,
+				compressed[:len(compressed)/2],
+				len(s.Data),
+			)).Error().To(MatchError(compression.ErrCorrupt))
+		})
+
+		It("Should return an error when a zstd block has the wrong length", func() {
+			s := telem.NewSeriesV("a", "b", "c")
+			compressed := MustSucceed(compression.Compress(compression.Zstd, 0, nil, s.Data))
+			Expect(compression.Decompress(
+				compression.Zstd,
+				0,
+				nil,
+				compressed,
+				len(s.Data)+1,
+			)).Error().To(MatchError(compression.ErrCorrupt))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package compression
+
+// deltaBucket is a range of zig-zag encoded delta-of-delta values that share a control
+// prefix and a fixed payload width.
+type deltaBucket struct {
+	// prefix is the control bit sequence identifying the bucket.
+	prefix uint64
+	// prefixBits is the number of bits in prefix.
+	prefixBits uint8
+	// valueBits is the number of bits used to store values in the bucket.
+	valueBits uint8
+}
+
+// deltaBuckets are ordered from smallest to largest. A delta-of-delta of zero is
+// encoded as a single zero bit and is not included in the table.
+var deltaBuckets = []deltaBucket{
+	{prefix: 0b10, prefixBits: 2, valueBits: 7},
+	{prefix: 0b110, prefixBits: 3, valueBits: 9},
+	{prefix: 0b1110, prefixBits: 4, valueBits: 12},
+	{prefix: 0b1111, prefixBits: 4, valueBits: 64},
+}
+
+func zigZag(v int64) uint64 { return uint64((v << 1) ^ (v >> 63)) }
+
+func unZigZag(v uint64) int64 { return int64(v>>1) ^ -int64(v&1) }
+
+// compressDeltaOfDelta implements the timestamp compression scheme described in the
+// Facebook Gorilla paper. The first sample and the first delta are stored verbatim,
+// and every subsequent sample is stored as the difference between its delta and the
+// previous delta. Regularly sampled timestamps therefore compress to a single bit per
+// sample.
+func compressDeltaOfDelta(dst, src []byte) []byte {
+	if len(src) == 0 {
+		return dst
+	}
+	var (
+		w         = bitWriter{buf: dst}
+		prev      = int64(byteOrder.Uint64(src))
+		prevDelta int64
+	)
+	w.writeBits(uint64(prev), 64)
+	for i := 8; i < len(src); i += 8 {
+		v := int64(byteOrder.Uint64(src[i:]))
+		delta := v - prev
+		prev = v
+		if i == 8 {
+			w.writeBits(zigZag(delta), 64)
+			prevDelta = delta
+			continue
+		}
+		dod := zigZag(delta - prevDelta)
+		prevDelta = delta
+		if dod == 0 {
+			w.writeBit(false)
+			continue
+		}
+		for _, b := range deltaBuckets {
+			if b.valueBits == 64 || dod < 1<<b.valueBits {
+				w.writeBits(b.prefix, b.prefixBits)
+				w.writeBits(dod, b.valueBits)
+				break
+			}
+		}
+	}
+	return w.buf
+}
+
+func decompressDeltaOfDelta(dst, src []byte, rawLen int) ([]byte, error) {
+	if rawLen == 0 {
+		return dst, nil
+	}
+	if rawLen%8 != 0 {
+		return nil, ErrCorrupt
+	}
+	var (
+		r     = bitReader{buf: src}
+		count = rawLen / 8
+	)
+	first, err := r.readBits(64)
+	if err != nil {
+		return nil, err
+	}
+	prev := int64(first)
+	dst = byteOrder.AppendUint64(dst, first)
+	if count == 1 {
+		return dst, nil
+	}
+	d, err := r.readBits(64)
+	if err != nil {
+		return nil, err
+	}
+	delta := unZigZag(d)
+	prev += delta
+	dst = byteOrder.AppendUint64(dst, uint64(prev))
+	for range count - 2 {
+		dod, err := readDeltaOfDelta(&r)
+		if err != nil {
+			return nil, err
+		}
+		delta += unZigZag(dod)
+		prev += delta
+		dst = byteOrder.AppendUint64(dst, uint64(prev))
+	}
+	return dst, nil
+}
+
+func readDeltaOfDelta(r *bitReader) (uint64, error) {
+	set, err := r.readBit()
+	if err != nil || !set {
+		return 0, err
+	}
+	for i, b := range deltaBuckets {
+		// The last bucket is identified by a full prefix of ones, so there is no
+		// terminating zero bit to read.
+		if i < len(deltaBuckets)-1 {
+			set, err = r.readBit()
+			if err != nil {
+				return 0, err
+			}
+			if set {
+				continue
+			}
+		}
+		return r.readBits(b.valueBits)
+	}
+	return 0, ErrCorrupt
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package compression
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/synnaxlabs/x/telem"
+)
+
+var byteOrder = binary.LittleEndian
+
+// maxLeadingZeros is the largest leading zero count that can be represented in the
+// 5-bit leading zero field of an XOR encoded value.
+const maxLeadingZeros = 31
+
+func readWord(den telem.Density, b []byte) uint64 {
+	if den == telem.Bit32 {
+		return uint64(byteOrder.Uint32(b))
+	}
+	return byteOrder.Uint64(b)
+}
+
+func putWord(den telem.Density, dst []byte, v uint64) []byte {
+	if den == telem.Bit32 {
+		return byteOrder.AppendUint32(dst, uint32(v))
+	}
+	return byteOrder.AppendUint64(dst, v)
+}
+
+// lengthBits returns the number of bits used to store the length of the meaningful
+// section of an XOR encoded value.
+func lengthBits(den telem.Density) uint8 {
+	if den == telem.Bit32 {
+		return 5
+	}
+	return 6
+}
+
+// compressXOR implements the value compression scheme described in the Facebook
+// Gorilla paper. The first sample is stored verbatim. Every subsequent sample is XORed
+// with its predecessor, and only the bits between the leading and trailing zeros of the
+// result are stored. When the meaningful bits fit inside the window used by the
+// previous sample, the window is reused to avoid re-encoding its bounds.
+func compressXOR(den telem.Density, dst, src []byte) []byte {
+	if len(src) == 0 {
+		return dst
+	}
+	var (
+		width    = uint8(den) * 8
+		lenBits  = lengthBits(den)
+		w        = bitWriter{buf: dst}
+		prev     = readWord(den, src)
+		leading  = uint8(0xFF)
+		trailing = uint8(0)
+	)
+	w.writeBits(prev, width)
+	for i := int(den); i < len(src); i += int(den) {
+		v := readWord(den, src[i:])
+		xor := v ^ prev
+		prev = v
+		if xor == 0 {
+			w.writeBit(false)
+			continue
+		}
+		w.writeBit(true)
+		l := uint8(bits.LeadingZeros64(xor)) - (64 - width)
+		t := uint8(bits.TrailingZeros64(xor))
+		l = min(l, maxLeadingZeros)
+		if leading != 0xFF && l >= leading && t >= trailing {
+			w.writeBit(false)
+			w.writeBits(xor>>trailing, width-leading-trailing)
+			continue
+		}
+		leading, trailing = l, t
+		meaningful := width - leading - trailing
+		w.writeBit(true)
+		w.writeBits(uint64(leading), 5)
+		w.writeBits(uint64(meaningful-1), lenBits)
+		w.writeBits(xor>>trailing, meaningful)
+	}
+	return w.buf
+}
+
+func decompressXOR(den telem.Density, dst, src []byte, rawLen int) ([]byte, error) {
+	if rawLen == 0 {
+		return dst, nil
+	}
+	if rawLen%int(den) != 0 {
+		return nil, ErrCorrupt
+	}
+	var (
+		width    = uint8(den) * 8
+		lenBits  = lengthBits(den)
+		r        = bitReader{buf: src}
+		count    = rawLen / int(den)
+		leading  uint8
+		trailing uint8
+	)
+	prev, err := r.readBits(width)
+	if err != nil {
+		return nil, err
+	}
+	dst = putWord(den, dst, prev)
+	for range count - 1 {
+		changed, err := r.readBit()
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			dst = putWord(den, dst, prev)
+			continue
+		}
+		newWindow, err := r.readBit()
+		if err != nil {
+			return nil, err
+		}
+		if newWindow {
+			l, err := r.readBits(5)
+			if err != nil {
+				return nil, err
+			}
+			m, err := r.readBits(lenBits)
+			if err != nil {
+				return nil, err
+			}
+			meaningful := uint8(m) + 1
+			if uint8(l)+meaningful > width {
+				return nil, ErrCorrupt
+			}
+			leading, trailing = uint8(l), width-uint8(l)-meaningful
+		}
+		v, err := r.readBits(width - leading - trailing)
+		if err != nil {
+			return nil, err
+		}
+		prev ^= v << trailing
+		dst = putWord(den, dst, prev)
+	}
+	return dst, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package compression
+
+import (
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/synnaxlabs/x/errors"
+)
+
+// The zstd encoder and decoder are safe for concurrent use when calling EncodeAll and
+// DecodeAll, so a single instance of each is shared across the process. Neither holds
+// any goroutines when used in this way.
+var (
+	zstdEncoder = sync.OnceValue(func() *zstd.Encoder {
+		e, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			panic(err)
+		}
+		return e
+	})
+	zstdDecoder = sync.OnceValue(func() *zstd.Decoder {
+		d, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			panic(err)
+		}
+		return d
+	})
+)
+
+func compressZstd(dst, src []byte) []byte {
+	if len(src) == 0 {
+		return dst
+	}
+	return zstdEncoder().EncodeAll(src, dst)
+}
+
+func decompressZstd(dst, src []byte, rawLen int) ([]byte, error) {
+	if rawLen == 0 {
+		return dst, nil
+	}
+	start := len(dst)
+	dst, err := zstdDecoder().DecodeAll(src, dst)
+	if err != nil {
+		return nil, errors.Wrap(ErrCorrupt, err.Error())
+	}
+	if len(dst)-start != rawLen {
+		return nil, ErrCorrupt
+	}
+	return dst, nil
+}
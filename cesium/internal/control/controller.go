@@ -174,6 +174,20 @@ func (c *Controller[R]) ResourceAt(tr telem.TimeRange) (res R, ok bool) {
 	return res, false
 }
 
+// ResourcesIn returns the resources held by every region overlapping tr. The same
+// concurrency caveats as ResourceAt apply to the returned resources.
+func (c *Controller[R]) ResourcesIn(tr telem.TimeRange) []R {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var res []R
+	for _, reg := range c.regions {
+		if reg.timeRange.OverlapsWith(tr) {
+			res = append(res, reg.resource)
+		}
+	}
+	return res
+}
+
 // OpenGate opens a new gate for the region occupying the specified time range. If the
 // region does not exist, it will be created and cfg.OpenResource will be called.
 // If the region does exist, the new gate will be added to the authority chain for the
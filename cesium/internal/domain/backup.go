@@ -0,0 +1,130 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package domain
+
+import (
+	"context"
+	"io"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/synnaxlabs/x/errors"
+	xio "github.com/synnaxlabs/x/io"
+	"github.com/synnaxlabs/x/io/fs"
+)
+
+// Snapshot is a point-in-time view of the domains in a DB. A snapshot can be written
+// to another file system while writers continue to commit data to the DB, and the
+// written copy can be opened as a DB containing exactly the domains that existed when
+// the snapshot was taken.
+//
+// Garbage collection is suspended while a snapshot is open, so a snapshot must be
+// closed after use.
+type Snapshot struct {
+	db       *DB
+	pointers []pointer
+	counter  int32
+	release  func()
+}
+
+// Snapshot takes a snapshot of the domains in the DB.
+func (db *DB) Snapshot() (*Snapshot, error) {
+	if db.closed.Load() {
+		return nil, ErrDBClosed
+	}
+	// Files cannot be rewritten by garbage collection while the snapshot is open, as
+	// the snapshot's pointers reference data at its current offsets.
+	db.gcMu.RLock()
+	db.resourceCount.Add(1)
+	db.idx.mu.RLock()
+	pointers := slices.Clone(db.idx.mu.pointers)
+	db.idx.mu.RUnlock()
+	// The counter is read after the pointers so that it covers every file they
+	// reference.
+	return &Snapshot{
+		db:       db,
+		pointers: pointers,
+		counter:  db.fc.counter.Value(),
+		release: sync.OnceFunc(func() {
+			db.resourceCount.Add(-1)
+			db.gcMu.RUnlock()
+		}),
+	}, nil
+}
+
+// WriteTo writes the snapshot to the given file system, which should be empty.
+func (s *Snapshot) WriteTo(ctx context.Context, dst fs.FS) error {
+	// Writers only ever append to files, so the data referenced by the snapshot's
+	// pointers is found in the prefix of each file that ends with the last section
+	// referenced in it.
+	ends := make(map[uint16]int64)
+	for _, ptr := range s.pointers {
+		ends[ptr.fileKey] = max(
+			ends[ptr.fileKey],
+			int64(ptr.offset)+int64(ptr.size),
+			int64(ptr.checksumOffset)+int64(ptr.checksumSize),
+		)
+	}
+	for key, end := range ends {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := copyFilePrefix(s.db.cfg.FS, dst, fileKeyToName(key), end); err != nil {
+			return err
+		}
+	}
+	if err := writeTempIndex(dst, pointerVersionLatest, s.pointers); err != nil {
+		return err
+	}
+	if err := dst.Rename(indexTempFile, indexFile); err != nil {
+		return err
+	}
+	// Copying the counter prevents writers on the restored DB from creating files
+	// with keys that are referenced by the snapshot.
+	f, err := dst.Open(counterFile, os.O_CREATE|os.O_RDWR)
+	if err != nil {
+		return err
+	}
+	counter, err := xio.NewInt32Counter(f)
+	if err != nil {
+		return errors.Combine(err, f.Close())
+	}
+	if _, err = counter.Add(s.counter); err != nil {
+		return errors.Combine(err, f.Close())
+	}
+	return f.Close()
+}
+
+// Close releases the snapshot, allowing garbage collection to resume. Close is
+// idempotent.
+func (s *Snapshot) Close() error {
+	s.release()
+	return nil
+}
+
+// copyFilePrefix copies the first size bytes of the named file in src to a file with
+// the same name in dst.
+func copyFilePrefix(src, dst fs.FS, name string, size int64) (err error) {
+	r, err := src.Open(name, os.O_RDONLY)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Combine(err, r.Close()) }()
+	w, err := dst.Open(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Combine(err, w.Close()) }()
+	if _, err = io.Copy(w, io.NewSectionReader(r, 0, size)); err != nil {
+		return err
+	}
+	return w.Sync()
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package domain_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/cesium/internal/domain"
+	"github.com/synnaxlabs/cesium/internal/resource"
+	. "github.com/synnaxlabs/cesium/internal/testutil"
+	xfs "github.com/synnaxlabs/x/io/fs"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Snapshot", func() {
+	for fsName, openFS := range FileSystems {
+		Context("FS: "+fsName, func() {
+			var (
+				db    *domain.DB
+				fs    xfs.FS
+				dstFS xfs.FS
+			)
+			BeforeEach(func() {
+				fs = openFS()
+				dstFS = openFS()
+				db = MustSucceed(domain.Open(domain.Config{
+					FS:              fs,
+					FileSize:        5 * telem.Byte,
+					GCThreshold:     math.SmallestNonzeroFloat32,
+					Instrumentation: PanicLogger(),
+				}))
+			})
+			AfterEach(func() { Expect(db.Close()).To(Succeed()) })
+
+			It("Should write the domains that existed when the snapshot was taken", func(ctx SpecContext) {
+				Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(15*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+				w := MustSucceed(db.OpenWriter(ctx, domain.WriterConfig{Start: 20 * telem.SecondTS}))
+				MustSucceed(w.Write([]byte{6, 7, 8}))
+				Expect(w.Commit(ctx, 23*telem.SecondTS)).To(Succeed())
+
+				s := MustSucceed(db.Snapshot())
+				By("Committing more data after the snapshot was taken")
+				MustSucceed(w.Write([]byte{9, 10}))
+				Expect(w.Commit(ctx, 25*telem.SecondTS)).To(Succeed())
+				Expect(w.Close()).To(Succeed())
+				Expect(domain.Write(ctx, db, (30 * telem.SecondTS).Range(31*telem.SecondTS), []byte{11})).To(Succeed())
+
+				Expect(s.WriteTo(ctx, dstFS)).To(Succeed())
+				Expect(s.Close()).To(Succeed())
+
+				restored := MustSucceed(domain.Open(domain.Config{FS: dstFS, FileSize: 5 * telem.Byte}))
+				Expect(domain.Read(ctx, restored, telem.TimeRangeMax)).
+					To(Equal([]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+				By("Writing new data to the restored DB")
+				Expect(domain.Write(ctx, restored, (40 * telem.SecondTS).Range(41*telem.SecondTS), []byte{12})).To(Succeed())
+				Expect(domain.Read(ctx, restored, telem.TimeRangeMax)).
+					To(Equal([]byte{1, 2, 3, 4, 5, 6, 7, 8, 12}))
+				Expect(restored.Close()).To(Succeed())
+				Expect(domain.Check(dstFS, false)).To(HaveField("Corrupt", BeEmpty()))
+			})
+
+			It("Should write a snapshot of an empty DB", func(ctx SpecContext) {
+				s := MustSucceed(db.Snapshot())
+				Expect(s.WriteTo(ctx, dstFS)).To(Succeed())
+				Expect(s.Close()).To(Succeed())
+				restored := MustSucceed(domain.Open(domain.Config{FS: dstFS}))
+				Expect(domain.Read(ctx, restored, telem.TimeRangeMax)).To(BeEmpty())
+				Expect(restored.Close()).To(Succeed())
+			})
+
+			It("Should not garbage collect while a snapshot is open", func(ctx SpecContext) {
+				Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(15*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+				Expect(domain.Write(ctx, db, (20 * telem.SecondTS).Range(25*telem.SecondTS), []byte{6, 7, 8, 9, 10})).To(Succeed())
+				s := MustSucceed(db.Snapshot())
+				Expect(db.Delete(ctx, (10 * telem.SecondTS).Range(15*telem.SecondTS), fixedOffset(0), fixedOffset(5))).To(Succeed())
+				Expect(db.GarbageCollect(ctx)).To(Succeed())
+				Expect(MustSucceed(fs.Stat("1.domain")).Size()).To(BeEquivalentTo(5))
+
+				Expect(s.WriteTo(ctx, dstFS)).To(Succeed())
+				Expect(s.Close()).To(Succeed())
+				restored := MustSucceed(domain.Open(domain.Config{FS: dstFS, FileSize: 5 * telem.Byte}))
+				Expect(domain.Read(ctx, restored, telem.TimeRangeMax)).
+					To(Equal([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}))
+				Expect(restored.Close()).To(Succeed())
+
+				By("Garbage collecting after the snapshot is closed")
+				Expect(db.GarbageCollect(ctx)).To(Succeed())
+				Expect(fs.Exists("1.domain")).To(BeTrue())
+				Expect(MustSucceed(fs.Stat("1.domain")).Size()).To(BeZero())
+			})
+
+			It("Should not allow the DB to close while a snapshot is open", func() {
+				s := MustSucceed(db.Snapshot())
+				Expect(db.Close()).To(MatchError(resource.ErrOpen))
+				Expect(s.Close()).To(Succeed())
+				Expect(s.Close()).To(Succeed())
+			})
+
+			It("Should return an error when taking a snapshot of a closed DB", func() {
+				Expect(db.Close()).To(Succeed())
+				Expect(db.Snapshot()).Error().To(MatchError(domain.ErrDBClosed))
+			})
+		})
+	}
+})
@@ -0,0 +1,199 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package domain
+
+import (
+	"io"
+	"sort"
+
+	"github.com/synnaxlabs/cesium/internal/compression"
+	"github.com/synnaxlabs/x/errors"
+	xio "github.com/synnaxlabs/x/io"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// The data of a compressed domain is stored as a sequence of independently compressed
+// blocks, one for each batch of data committed by a Writer. Each block is laid out as
+// follows:
+//
+//	| raw length (4 bytes) | encoded length (4 bytes) | encoded data (encoded length) |
+//
+// Storing blocks independently allows a Writer to append to a compressed domain on
+// every commit without re-encoding the data it has already written.
+const blockHeaderSize = 8
+
+// encodeBlock compresses raw and appends the resulting block to dst.
+func encodeBlock(
+	codec compression.Codec,
+	den telem.Density,
+	dst []byte,
+	raw []byte,
+) ([]byte, error) {
+	start := len(dst)
+	dst = append(dst, make([]byte, blockHeaderSize)...)
+	dst, err := compression.Compress(codec, den, dst, raw)
+	if err != nil {
+		return nil, err
+	}
+	byteOrder.PutUint32(dst[start:start+4], uint32(len(raw)))
+	byteOrder.PutUint32(dst[start+4:start+8], uint32(len(dst)-start-blockHeaderSize))
+	return dst, nil
+}
+
+// block is the location of a single compressed block within a domain.
+type block struct {
+	// offset is the offset of the block's encoded data relative to the start of the
+	// domain.
+	offset int64
+	// encodedLen is the number of bytes of encoded data in the block.
+	encodedLen int64
+	// rawOffset is the offset of the block's first decompressed byte relative to the
+	// decompressed contents of the domain.
+	rawOffset int64
+	// rawLen is the number of bytes in the decompressed block.
+	rawLen int64
+}
+
+// blockReader implements io.ReaderAt over the decompressed contents of a compressed
+// domain. Blocks are decompressed lazily as they are read, and the most recently
+// decompressed block is cached so that sequential reads within a block only decode it
+// once.
+type blockReader struct {
+	internal xio.ReaderAtCloser
+	codec    compression.Codec
+	density  telem.Density
+	blocks   []block
+	// logicalOffset and logicalSize bound the section of the decompressed contents that
+	// belong to the domain.
+	logicalOffset int64
+	logicalSize   int64
+	cache         struct {
+		data  []byte
+		block int
+	}
+}
+
+var _ xio.ReaderAtCloser = (*blockReader)(nil)
+
+// openBlockReader scans the block headers of the compressed domain referenced by ptr,
+// whose encoded bytes are read from internal.
+func openBlockReader(
+	internal xio.ReaderAtCloser,
+	ptr pointer,
+	den telem.Density,
+) (*blockReader, error) {
+	r := &blockReader{
+		internal:      internal,
+		codec:         ptr.codec,
+		density:       den,
+		logicalOffset: int64(ptr.logicalOffset),
+		logicalSize:   int64(ptr.logicalSize),
+	}
+	r.cache.block = -1
+	var (
+		header    = make([]byte, blockHeaderSize)
+		offset    int64
+		rawOffset int64
+		end       = r.logicalOffset + r.logicalSize
+	)
+	// There is no need to scan blocks that start after the end of the domain's
+	// logical section.
+	for offset < int64(ptr.size) && rawOffset < end {
+		if _, err := internal.ReadAt(header, offset); err != nil {
+			return nil, errors.Wrapf(ErrCorrupt, "failed to read block header: %v", err)
+		}
+		b := block{
+			offset:     offset + blockHeaderSize,
+			rawLen:     int64(byteOrder.Uint32(header[0:4])),
+			encodedLen: int64(byteOrder.Uint32(header[4:8])),
+			rawOffset:  rawOffset,
+		}
+		if b.offset+b.encodedLen > int64(ptr.size) {
+			return nil, errors.Wrapf(
+				ErrCorrupt,
+				"block at offset %d extends past the end of the domain",
+				offset,
+			)
+		}
+		r.blocks = append(r.blocks, b)
+		offset = b.offset + b.encodedLen
+		rawOffset += b.rawLen
+	}
+	if rawOffset < end {
+		return nil, errors.Wrapf(
+			ErrCorrupt,
+			"domain blocks contain %d bytes, expected at least %d",
+			rawOffset,
+			end,
+		)
+	}
+	return r, nil
+}
+
+// ReadAt implements io.ReaderAt.
+func (r *blockReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	if off >= r.logicalSize {
+		return 0, io.EOF
+	}
+	var (
+		n     int
+		avail = r.logicalSize - off
+		want  = p
+	)
+	if int64(len(want)) > avail {
+		want = want[:avail]
+	}
+	off += r.logicalOffset
+	i := sort.Search(len(r.blocks), func(i int) bool {
+		return r.blocks[i].rawOffset+r.blocks[i].rawLen > off
+	})
+	for ; n < len(want) && i < len(r.blocks); i++ {
+		data, err := r.decode(i)
+		if err != nil {
+			return n, err
+		}
+		n += copy(want[n:], data[off-r.blocks[i].rawOffset:])
+		off = r.blocks[i].rawOffset + r.blocks[i].rawLen
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (r *blockReader) decode(i int) ([]byte, error) {
+	if r.cache.block == i {
+		return r.cache.data, nil
+	}
+	b := r.blocks[i]
+	encoded := make([]byte, b.encodedLen)
+	if _, err := r.internal.ReadAt(encoded, b.offset); err != nil {
+		return nil, errors.Wrapf(ErrCorrupt, "failed to read block: %v", err)
+	}
+	data, err := compression.Decompress(
+		r.codec,
+		r.density,
+		r.cache.data[:0],
+		encoded,
+		int(b.rawLen),
+	)
+	if err != nil {
+		r.cache.block = -1
+		return nil, errors.Wrap(ErrCorrupt, err.Error())
+	}
+	r.cache.data, r.cache.block = data, i
+	return data, nil
+}
+
+// Close implements io.Closer.
+func (r *blockReader) Close() error { return r.internal.Close() }
@@ -0,0 +1,144 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package domain
+
+import (
+	"os"
+
+	"github.com/synnaxlabs/x/errors"
+	xio "github.com/synnaxlabs/x/io"
+	xfs "github.com/synnaxlabs/x/io/fs"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// CorruptDomain is a domain that failed verification during a Check.
+type CorruptDomain struct {
+	// TimeRange is the time range occupied by the domain.
+	TimeRange telem.TimeRange
+	// Err describes why the domain is considered corrupt.
+	Err error
+}
+
+// CheckReport summarizes the results of a Check.
+type CheckReport struct {
+	// Domains is the number of domains referenced by the index.
+	Domains int
+	// Unverified is the number of domains written without a checksum. These domains
+	// are only checked for referencing data that exists.
+	Unverified int
+	// CorruptRecords is the number of records in the index that could not be decoded.
+	CorruptRecords int
+	// Corrupt contains the domains that failed verification.
+	Corrupt []CorruptDomain
+	// Repaired is true if the corrupt domains and records were removed from the index.
+	Repaired bool
+}
+
+// Healthy returns true if no corruption was found.
+func (r CheckReport) Healthy() bool {
+	return r.CorruptRecords == 0 && len(r.Corrupt) == 0
+}
+
+// Check verifies the data of every domain in the DB stored in the given file system
+// against the checksums recorded in its index. If repair is true, corrupt domains and
+// index records are removed from the index. Check must not be called while the DB is
+// open.
+func Check(fs xfs.FS, repair bool) (report CheckReport, err error) {
+	exists, err := fs.Exists(indexFile)
+	if err != nil || !exists {
+		return report, err
+	}
+	p, err := openPointerPersist(fs)
+	if err != nil {
+		return report, err
+	}
+	ptrs, corrupt, err := p.read()
+	if err = errors.Combine(err, p.Close()); err != nil {
+		return report, err
+	}
+	report.Domains = len(ptrs)
+	report.CorruptRecords = len(corrupt)
+	var (
+		valid    = make([]pointer, 0, len(ptrs))
+		files    = make(map[uint16]xfs.File)
+		verifier = newChecksumVerifier()
+	)
+	defer func() {
+		for _, f := range files {
+			err = errors.Combine(err, f.Close())
+		}
+	}()
+	for _, ptr := range ptrs {
+		if !ptr.checksummed() {
+			report.Unverified++
+		}
+		f, ok := files[ptr.fileKey]
+		if !ok {
+			if f, err = fs.Open(fileKeyToName(ptr.fileKey), os.O_RDONLY); err != nil {
+				if !errors.Is(err, os.ErrNotExist) {
+					return report, err
+				}
+				err = errors.Wrapf(ErrCorrupt, "file %s does not exist", fileKeyToName(ptr.fileKey))
+				report.Corrupt = append(report.Corrupt, CorruptDomain{TimeRange: ptr.TimeRange, Err: err})
+				err = nil
+				continue
+			}
+			files[ptr.fileKey] = f
+		}
+		if cErr := checkPointer(f, verifier, ptr); cErr != nil {
+			if !errors.Is(cErr, ErrCorrupt) {
+				return report, cErr
+			}
+			report.Corrupt = append(report.Corrupt, CorruptDomain{TimeRange: ptr.TimeRange, Err: cErr})
+			continue
+		}
+		valid = append(valid, ptr)
+	}
+	if !repair || report.Healthy() {
+		return report, nil
+	}
+	if err = writeTempIndex(fs, pointerVersionLatest, valid); err != nil {
+		return report, err
+	}
+	if err = fs.Rename(indexTempFile, indexFile); err != nil {
+		return report, err
+	}
+	report.Repaired = true
+	return report, nil
+}
+
+// checkPointer verifies that the data referenced by ptr exists in f and matches its
+// checksum.
+func checkPointer(f xfs.File, verifier *checksumVerifier, ptr pointer) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if end := int64(ptr.offset) + int64(ptr.size); end > info.Size() {
+		return newCorruptDomainError(ptr, errors.Wrapf(
+			ErrCorrupt,
+			"domain ends at offset %d, but file is only %d bytes",
+			end,
+			info.Size(),
+		))
+	}
+	if err = verifier.verify(f, ptr); err != nil || !ptr.compressed() {
+		return err
+	}
+	// Opening a block reader validates the layout of the domain's blocks, which is the
+	// only check available for compressed domains written without a checksum. The
+	// reader is not closed, as that would close f.
+	_, err = openBlockReader(
+		xio.NewSectionReaderAtCloser(f, int64(ptr.offset), int64(ptr.size)),
+		ptr,
+		telem.UnknownDensity,
+	)
+	return err
+}
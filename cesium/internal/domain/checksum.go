@@ -0,0 +1,133 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package domain
+
+import (
+	"hash/crc32"
+	"io"
+	"sync"
+
+	"github.com/synnaxlabs/x/errors"
+)
+
+// checksumReadSize is the maximum number of bytes read from a file at once when
+// computing a checksum.
+const checksumReadSize = 1 << 20
+
+// computeChecksum computes the checksum of size bytes of r starting at offset,
+// continuing from the checksum crc of the bytes that precede them.
+func computeChecksum(r io.ReaderAt, crc uint32, offset, size int64) (uint32, error) {
+	buf := make([]byte, min(size, checksumReadSize))
+	for size > 0 {
+		chunk := buf[:min(size, int64(len(buf)))]
+		if _, err := r.ReadAt(chunk, offset); err != nil {
+			return crc, err
+		}
+		crc = crc32.Update(crc, checksumTable, chunk)
+		offset += int64(len(chunk))
+		size -= int64(len(chunk))
+	}
+	return crc, nil
+}
+
+// verifiedPrefix is the leading part of a checksummed section of a file that has
+// already been verified.
+type verifiedPrefix struct {
+	size     uint32
+	checksum uint32
+}
+
+// sectionStart identifies a checksummed section of a file by where it starts.
+type sectionStart struct {
+	fileKey uint16
+	offset  uint32
+}
+
+// checksumVerifier verifies the data of domains against their checksums before they
+// are read. Verifying a domain requires reading all of its data, so the verifier
+// remembers which sections have already been verified. A section grows as a writer
+// commits more data to it, in which case verification resumes from the end of the
+// previously verified prefix.
+type checksumVerifier struct {
+	mu       sync.Mutex
+	verified map[sectionStart]verifiedPrefix
+}
+
+func newChecksumVerifier() *checksumVerifier {
+	return &checksumVerifier{verified: make(map[sectionStart]verifiedPrefix)}
+}
+
+// verify verifies the data of ptr, read from r, against the pointer's checksum.
+// Pointers without a checksum are assumed to be valid.
+func (v *checksumVerifier) verify(r io.ReaderAt, ptr pointer) error {
+	if !ptr.checksummed() {
+		return nil
+	}
+	start := sectionStart{fileKey: ptr.fileKey, offset: ptr.checksumOffset}
+	v.mu.Lock()
+	prefix := v.verified[start]
+	v.mu.Unlock()
+	if prefix.size == ptr.checksumSize && prefix.checksum == ptr.checksum {
+		return nil
+	}
+	if prefix.size >= ptr.checksumSize {
+		prefix = verifiedPrefix{}
+	}
+	checksum, err := computeChecksum(
+		r,
+		prefix.checksum,
+		int64(ptr.checksumOffset+prefix.size),
+		int64(ptr.checksumSize-prefix.size),
+	)
+	if err != nil {
+		return newCorruptDomainError(ptr, errors.Wrapf(
+			ErrCorrupt,
+			"failed to read domain data: %v",
+			err,
+		))
+	}
+	if checksum != ptr.checksum {
+		return newCorruptDomainError(ptr, errors.Wrapf(
+			ErrChecksumMismatch,
+			"expected checksum %#08x, computed %#08x",
+			ptr.checksum,
+			checksum,
+		))
+	}
+	v.mu.Lock()
+	if v.verified[start].size < ptr.checksumSize {
+		v.verified[start] = verifiedPrefix{size: ptr.checksumSize, checksum: checksum}
+	}
+	v.mu.Unlock()
+	return nil
+}
+
+// forget discards all verified sections of the file with the given key. It must be
+// called whenever the contents of the file are rewritten.
+func (v *checksumVerifier) forget(fileKey uint16) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for start := range v.verified {
+		if start.fileKey == fileKey {
+			delete(v.verified, start)
+		}
+	}
+}
+
+func newCorruptDomainError(ptr pointer, err error) error {
+	return errors.Wrapf(
+		err,
+		"domain %s in file %s (offset %d, size %d) is corrupt",
+		ptr.TimeRange,
+		fileKeyToName(ptr.fileKey),
+		ptr.checksumOffset,
+		ptr.checksumSize,
+	)
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package domain_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/cesium/internal/compression"
+	"github.com/synnaxlabs/cesium/internal/domain"
+	. "github.com/synnaxlabs/cesium/internal/testutil"
+	xfs "github.com/synnaxlabs/x/io/fs"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Checksum", func() {
+	for fsName, openFS := range FileSystems {
+		Context("FS: "+fsName, func() {
+			var (
+				db *domain.DB
+				fs xfs.FS
+			)
+			openDB := func(cfg domain.Config) *domain.DB {
+				cfg.FS = fs
+				cfg.Instrumentation = PanicLogger()
+				return MustSucceed(domain.Open(cfg))
+			}
+			// flipByte inverts the byte at the given offset of the named file.
+			flipByte := func(name string, offset int64) {
+				f := MustSucceed(fs.Open(name, os.O_RDWR))
+				b := make([]byte, 1)
+				MustSucceed(f.ReadAt(b, offset))
+				b[0] = ^b[0]
+				MustSucceed(f.WriteAt(b, offset))
+				Expect(f.Close()).To(Succeed())
+			}
+			truncate := func(name string, size int64) {
+				f := MustSucceed(fs.Open(name, os.O_RDWR))
+				Expect(f.Truncate(size)).To(Succeed())
+				Expect(f.Close()).To(Succeed())
+			}
+			BeforeEach(func() { fs = openFS() })
+
+			Describe("Read", func() {
+				AfterEach(func() { Expect(db.Close()).To(Succeed()) })
+
+				It("Should return an error when reading corrupt data", func(ctx SpecContext) {
+					db = openDB(domain.Config{})
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(20*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+					flipByte("1.domain", 2)
+					db = openDB(domain.Config{})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).Error().
+						To(MatchError(domain.ErrChecksumMismatch))
+				})
+
+				It("Should return an error when reading corrupt compressed data", func(ctx SpecContext) {
+					db = openDB(domain.Config{Compression: compression.Zstd})
+					s := linearSeries(0, 100)
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(110*telem.SecondTS), s.Data)).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+					flipByte("1.domain", 12)
+					db = openDB(domain.Config{Compression: compression.Zstd})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).Error().
+						To(MatchError(domain.ErrCorrupt))
+				})
+
+				It("Should return an error when a domain extends past the end of its file", func(ctx SpecContext) {
+					db = openDB(domain.Config{})
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(20*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+					truncate("1.domain", 3)
+					db = openDB(domain.Config{})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).Error().
+						To(MatchError(domain.ErrCorrupt))
+				})
+
+				It("Should verify a domain as a writer commits to it", func(ctx SpecContext) {
+					db = openDB(domain.Config{})
+					w := MustSucceed(db.OpenWriter(ctx, domain.WriterConfig{Start: 10 * telem.SecondTS}))
+					MustSucceed(w.Write([]byte{1, 2, 3}))
+					Expect(w.Commit(ctx, 13*telem.SecondTS)).To(Succeed())
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal([]byte{1, 2, 3}))
+					MustSucceed(w.Write([]byte{4, 5, 6}))
+					Expect(w.Commit(ctx, 16*telem.SecondTS)).To(Succeed())
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal([]byte{1, 2, 3, 4, 5, 6}))
+					Expect(w.Close()).To(Succeed())
+				})
+
+				It("Should verify the remaining data after a partial delete", func(ctx SpecContext) {
+					db = openDB(domain.Config{})
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(15*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+					Expect(db.Delete(ctx, (10 * telem.SecondTS).Range(12*telem.SecondTS), fixedOffset(0), fixedOffset(2))).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+					db = openDB(domain.Config{})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal([]byte{3, 4, 5}))
+					Expect(db.Close()).To(Succeed())
+					By("Corrupting data that was deleted, but is still covered by the checksum")
+					flipByte("1.domain", 0)
+					db = openDB(domain.Config{})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).Error().
+						To(MatchError(domain.ErrChecksumMismatch))
+				})
+
+				It("Should recompute checksums when garbage collecting", func(ctx SpecContext) {
+					db = openDB(domain.Config{FileSize: 5 * telem.Byte, GCThreshold: 0})
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(15*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+					Expect(domain.Write(ctx, db, (20 * telem.SecondTS).Range(25*telem.SecondTS), []byte{6, 7, 8, 9, 10})).To(Succeed())
+					Expect(db.Delete(ctx, (10 * telem.SecondTS).Range(12*telem.SecondTS), fixedOffset(0), fixedOffset(2))).To(Succeed())
+					Expect(db.GarbageCollect(ctx)).To(Succeed())
+					Expect(MustSucceed(fs.Stat("1.domain")).Size()).To(BeEquivalentTo(3))
+					Expect(db.Close()).To(Succeed())
+					db = openDB(domain.Config{FileSize: 5 * telem.Byte, GCThreshold: 0})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal([]byte{3, 4, 5, 6, 7, 8, 9, 10}))
+				})
+			})
+
+			Describe("Index", func() {
+				It("Should return an error when opening a DB with a corrupt index", func(ctx SpecContext) {
+					db = openDB(domain.Config{})
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(20*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+					// Skip past the index file header.
+					flipByte("index.domain", 10)
+					Expect(domain.Open(domain.Config{FS: fs})).Error().
+						To(MatchError(domain.ErrChecksumMismatch))
+				})
+			})
+
+			Describe("Check", func() {
+				It("Should report a healthy DB", func(ctx SpecContext) {
+					db = openDB(domain.Config{FileSize: 5 * telem.Byte})
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(20*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+					Expect(domain.Write(ctx, db, (20 * telem.SecondTS).Range(30*telem.SecondTS), []byte{6, 7, 8})).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+					report := MustSucceed(domain.Check(fs, false))
+					Expect(report.Healthy()).To(BeTrue())
+					Expect(report.Domains).To(Equal(2))
+					Expect(report.Unverified).To(BeZero())
+				})
+
+				It("Should report an empty DB as healthy", func() {
+					report := MustSucceed(domain.Check(fs, false))
+					Expect(report.Healthy()).To(BeTrue())
+					Expect(report.Domains).To(BeZero())
+				})
+
+				It("Should report and remove corrupt domains", func(ctx SpecContext) {
+					db = openDB(domain.Config{FileSize: 2 * telem.Byte})
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(20*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+					Expect(domain.Write(ctx, db, (20 * telem.SecondTS).Range(30*telem.SecondTS), []byte{6, 7, 8})).To(Succeed())
+					Expect(domain.Write(ctx, db, (30 * telem.SecondTS).Range(40*telem.SecondTS), []byte{9})).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+					flipByte("1.domain", 4)
+					truncate("2.domain", 1)
+
+					By("Reporting the corruption")
+					report := MustSucceed(domain.Check(fs, false))
+					Expect(report.Healthy()).To(BeFalse())
+					Expect(report.Repaired).To(BeFalse())
+					Expect(report.Corrupt).To(HaveLen(2))
+					Expect(report.Corrupt[0].TimeRange).To(Equal((10 * telem.SecondTS).Range(20 * telem.SecondTS)))
+					Expect(report.Corrupt[0].Err).To(MatchError(domain.ErrChecksumMismatch))
+					Expect(report.Corrupt[1].TimeRange).To(Equal((20 * telem.SecondTS).Range(30 * telem.SecondTS)))
+					Expect(report.Corrupt[1].Err).To(MatchError(ContainSubstring("file is only 1 bytes")))
+
+					By("Removing the corrupt domains")
+					report = MustSucceed(domain.Check(fs, true))
+					Expect(report.Repaired).To(BeTrue())
+					Expect(MustSucceed(domain.Check(fs, false)).Healthy()).To(BeTrue())
+					db = openDB(domain.Config{FileSize: 2 * telem.Byte})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal([]byte{9}))
+					Expect(db.Close()).To(Succeed())
+				})
+
+				It("Should report and remove corrupt index records", func(ctx SpecContext) {
+					db = openDB(domain.Config{FileSize: 5 * telem.Byte})
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(20*telem.SecondTS), []byte{1, 2, 3, 4, 5})).To(Succeed())
+					Expect(domain.Write(ctx, db, (20 * telem.SecondTS).Range(30*telem.SecondTS), []byte{6, 7, 8})).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+					flipByte("index.domain", 10)
+					report := MustSucceed(domain.Check(fs, true))
+					Expect(report.CorruptRecords).To(Equal(1))
+					Expect(report.Domains).To(Equal(1))
+					Expect(report.Repaired).To(BeTrue())
+					db = openDB(domain.Config{FileSize: 5 * telem.Byte})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal([]byte{6, 7, 8}))
+					Expect(db.Close()).To(Succeed())
+				})
+			})
+		})
+	}
+})
@@ -0,0 +1,173 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package domain_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/cesium/internal/compression"
+	"github.com/synnaxlabs/cesium/internal/domain"
+	. "github.com/synnaxlabs/cesium/internal/testutil"
+	xfs "github.com/synnaxlabs/x/io/fs"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+func linearSeries(start, count int64) telem.Series {
+	values := make([]int64, count)
+	for i := range values {
+		values[i] = start + int64(i)
+	}
+	return telem.NewSeriesV(values...)
+}
+
+var _ = Describe("Compression", Ordered, func() {
+	for fsName, openFS := range FileSystems {
+		Context("FS: "+fsName, func() {
+			var (
+				db *domain.DB
+				fs xfs.FS
+			)
+			openDB := func(cfg domain.Config) *domain.DB {
+				cfg.FS = fs
+				cfg.Instrumentation = PanicLogger()
+				return MustSucceed(domain.Open(cfg))
+			}
+			BeforeEach(func() { fs = openFS() })
+			AfterEach(func() { Expect(db.Close()).To(Succeed()) })
+
+			Describe("Config", func() {
+				It("Should require a density for density dependent codecs", func() {
+					db = openDB(domain.Config{})
+					Expect(domain.Open(domain.Config{
+						FS:              fs,
+						Instrumentation: PanicLogger(),
+						Compression:     compression.DeltaOfDelta,
+					})).Error().To(MatchError(ContainSubstring("density must be set")))
+				})
+			})
+
+			Describe("Write and Read", func() {
+				It("Should transparently compress and decompress data", func(ctx SpecContext) {
+					db = openDB(domain.Config{
+						Compression: compression.DeltaOfDelta,
+						Density:     telem.Bit64,
+					})
+					s := linearSeries(0, 1000)
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(1010*telem.SecondTS), s.Data)).To(Succeed())
+					Expect(db.Size()).To(BeNumerically("<", telem.Size(len(s.Data))/10))
+					Expect(db.LogicalSize()).To(Equal(telem.Size(len(s.Data))))
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal(s.Data))
+				})
+
+				It("Should read data from a domain committed across multiple blocks", func(ctx SpecContext) {
+					db = openDB(domain.Config{Compression: compression.Zstd})
+					w := MustSucceed(db.OpenWriter(ctx, domain.WriterConfig{Start: 10 * telem.SecondTS}))
+					first := linearSeries(0, 100)
+					second := linearSeries(100, 100)
+					Expect(w.Write(first.Data)).To(Equal(len(first.Data)))
+					Expect(w.Commit(ctx, 110*telem.SecondTS)).To(Succeed())
+					Expect(w.Write(second.Data)).To(Equal(len(second.Data)))
+					Expect(w.Commit(ctx, 210*telem.SecondTS)).To(Succeed())
+					Expect(w.Close()).To(Succeed())
+
+					i := db.OpenIterator(domain.IterRange(telem.TimeRangeMax))
+					Expect(i.SeekFirst(ctx)).To(BeTrue())
+					Expect(i.Size()).To(Equal(telem.Size(len(first.Data) + len(second.Data))))
+					r := MustSucceed(i.OpenReader(ctx))
+					buf := make([]byte, 16)
+					Expect(r.ReadAt(buf, 96*8)).To(Equal(16))
+					Expect(telem.UnmarshalSeries[int64](telem.Series{DataType: telem.Int64T, Data: buf})).To(Equal([]int64{96, 97}))
+					buf = make([]byte, 8*8)
+					Expect(r.ReadAt(buf, 98*8)).To(Equal(len(buf)))
+					Expect(telem.UnmarshalSeries[int64](telem.Series{DataType: telem.Int64T, Data: buf})).To(Equal([]int64{98, 99, 100, 101, 102, 103, 104, 105}))
+					Expect(r.Close()).To(Succeed())
+					Expect(i.Close()).To(Succeed())
+				})
+
+				It("Should persist compressed domains across restarts", func(ctx SpecContext) {
+					db = openDB(domain.Config{
+						Compression: compression.XOR,
+						Density:     telem.Bit64,
+					})
+					s := telem.NewSeriesV(1.5, 1.5, 1.5, 2.25, 2.25, math.Pi)
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(16*telem.SecondTS), s.Data)).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+					db = openDB(domain.Config{
+						Compression: compression.XOR,
+						Density:     telem.Bit64,
+					})
+					Expect(db.LogicalSize()).To(Equal(telem.Size(len(s.Data))))
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal(s.Data))
+				})
+			})
+
+			Describe("Legacy Files", func() {
+				It("Should read uncompressed domains after compression is enabled", func(ctx SpecContext) {
+					db = openDB(domain.Config{})
+					old := linearSeries(0, 10)
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(20*telem.SecondTS), old.Data)).To(Succeed())
+					Expect(db.Close()).To(Succeed())
+
+					db = openDB(domain.Config{
+						Compression: compression.DeltaOfDelta,
+						Density:     telem.Bit64,
+					})
+					next := linearSeries(10, 10)
+					Expect(domain.Write(ctx, db, (20 * telem.SecondTS).Range(30*telem.SecondTS), next.Data)).To(Succeed())
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal(append(old.Data, next.Data...)))
+					Expect(db.Close()).To(Succeed())
+
+					By("Reopening the database")
+					db = openDB(domain.Config{
+						Compression: compression.DeltaOfDelta,
+						Density:     telem.Bit64,
+					})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal(append(old.Data, next.Data...)))
+				})
+			})
+
+			Describe("Delete", func() {
+				It("Should delete a range from the middle of a compressed domain", func(ctx SpecContext) {
+					db = openDB(domain.Config{
+						Compression: compression.DeltaOfDelta,
+						Density:     telem.Bit64,
+						GCThreshold: math.SmallestNonzeroFloat32,
+					})
+					s := linearSeries(10, 10)
+					Expect(domain.Write(ctx, db, (10 * telem.SecondTS).Range(19*telem.SecondTS+1), s.Data)).To(Succeed())
+					Expect(db.Delete(
+						ctx,
+						telem.TimeRange{Start: 12*telem.SecondTS + 1, End: 16*telem.SecondTS + 1},
+						fixedOffset(3*8),
+						fixedOffset(7*8),
+					)).To(Succeed())
+					expected := telem.NewSeriesV[int64](10, 11, 12, 17, 18, 19)
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal(expected.Data))
+					Expect(db.LogicalSize()).To(Equal(telem.Size(len(expected.Data))))
+
+					By("Garbage collecting the shared block")
+					Expect(db.GarbageCollect(ctx)).To(Succeed())
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal(expected.Data))
+
+					By("Reopening the database")
+					Expect(db.Close()).To(Succeed())
+					db = openDB(domain.Config{
+						Compression: compression.DeltaOfDelta,
+						Density:     telem.Bit64,
+					})
+					Expect(domain.Read(ctx, db, telem.TimeRangeMax)).To(Equal(expected.Data))
+				})
+			})
+		})
+	}
+})
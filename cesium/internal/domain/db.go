@@ -15,9 +15,11 @@ package domain
 import (
 	"context"
 	"math"
+	"sync"
 	"sync/atomic"
 
 	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/cesium/internal/compression"
 	"github.com/synnaxlabs/cesium/internal/resource"
 	"github.com/synnaxlabs/x/config"
 	"github.com/synnaxlabs/x/errors"
@@ -47,8 +49,12 @@ import (
 //
 // A DB must be closed after use to avoid leaking any underlying resources/locks.
 type DB struct {
-	idx           *index
-	fc            *fileController
+	idx       *index
+	fc        *fileController
+	checksums *checksumVerifier
+	// gcMu is held for reading by open snapshots, and for writing during garbage
+	// collection.
+	gcMu          sync.RWMutex
 	closed        *atomic.Bool
 	resourceCount *atomic.Int64
 	cfg           Config
@@ -79,6 +85,15 @@ type Config struct {
 	// instead, set it to a very small number greater than 0.
 	// [OPTIONAL] Default: 0.2
 	GCThreshold float32
+	// Compression is the codec used to compress data written to new domains. Domains
+	// are always read using the codec they were written with, so changing this value
+	// does not affect existing data.
+	// [OPTIONAL] Default: compression.None
+	Compression compression.Codec
+	// Density is the density of the samples stored in the DB. Fixed width codecs use
+	// it to split blocks into individual samples.
+	// [REQUIRED if Compression is compression.XOR or compression.DeltaOfDelta]
+	Density telem.Density
 }
 
 var (
@@ -99,6 +114,12 @@ func (c Config) Validate() error {
 	validate.NotNil(v, "fs", c.FS)
 	validate.GreaterThanEq(v, "gc_threshold", c.GCThreshold, 0)
 	validate.LessThanEq(v, "gc_threshold", c.GCThreshold, 1)
+	v.Ternary(
+		"density",
+		(c.Compression == compression.XOR || c.Compression == compression.DeltaOfDelta) &&
+			c.Density == telem.UnknownDensity,
+		"density must be set when using a fixed width compression codec",
+	)
 	return v.Error()
 }
 
@@ -109,6 +130,8 @@ func (c Config) Override(other Config) Config {
 	c.FS = override.Nil(c.FS, other.FS)
 	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
 	c.GCThreshold = override.Numeric(c.GCThreshold, other.GCThreshold)
+	c.Compression = override.Numeric(c.Compression, other.Compression)
+	c.Density = override.Numeric(c.Density, other.Density)
 	// Store 80% of the desired maximum file size as file size since we must leave some
 	// buffer for when we stop acquiring a new writer on a file.
 	c.FileSize = telem.Size(math.Round(0.8 * float64(c.FileSize)))
@@ -132,12 +155,19 @@ func Open(configs ...Config) (*DB, error) {
 	if err != nil {
 		return nil, errors.Combine(err, idxPst.Close())
 	}
-	var initialSize int64
+	// Index files written in older formats cannot represent compressed domains or
+	// checksums, so they need to be upgraded before any new data is written.
+	if err = idxPst.upgrade(pointerVersionLatest, idx.mu.pointers); err != nil {
+		return nil, errors.Combine(err, idxPst.Close())
+	}
+	var logicalSize int64
 	for _, p := range idx.mu.pointers {
-		initialSize += int64(p.size)
+		logicalSize += int64(p.logicalSize)
 	}
 	idx.totalSize = &atomic.Int64{}
-	idx.totalSize.Store(initialSize)
+	idx.totalSize.Store(diskSize(idx.mu.pointers))
+	idx.logicalSize = &atomic.Int64{}
+	idx.logicalSize.Store(logicalSize)
 	controller, err := openFileController(cfg)
 	if err != nil {
 		return nil, errors.Combine(err, idx.close())
@@ -146,6 +176,7 @@ func Open(configs ...Config) (*DB, error) {
 		cfg:           cfg,
 		idx:           idx,
 		fc:            controller,
+		checksums:     newChecksumVerifier(),
 		closed:        &atomic.Bool{},
 		resourceCount: &atomic.Int64{},
 	}, nil
@@ -164,11 +195,18 @@ func (db *DB) HasDataFor(ctx context.Context, tr telem.TimeRange) (bool, error)
 	return i.SeekLE(ctx, tr.End) && i.TimeRange().OverlapsWith(tr), i.Close()
 }
 
-// Size returns the total size of all data stored in the database.
+// Size returns the total number of bytes that the data stored in the database
+// occupies on disk.
 func (db *DB) Size() telem.Size {
 	return telem.Size(db.idx.totalSize.Load())
 }
 
+// LogicalSize returns the total number of bytes of data stored in the database before
+// compression. For databases without compressed domains, LogicalSize is equal to Size.
+func (db *DB) LogicalSize() telem.Size {
+	return telem.Size(db.idx.logicalSize.Load())
+}
+
 // Close closes the DB. Close should not be called concurrently with any other DB
 // methods. If close fails for a reason other than unclosed writers/readers, the
 // database will still be marked closed and no read/write operations are allowed on it
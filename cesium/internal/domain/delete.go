@@ -11,6 +11,7 @@ package domain
 
 import (
 	"context"
+	"hash/crc32"
 	"os"
 
 	"github.com/synnaxlabs/x/errors"
@@ -117,7 +118,7 @@ func (db *DB) Delete(
 		if endOffset, tr.End, err = calculateEndOffset(ctx, end.Start, tr.End); err != nil {
 			return err
 		}
-		endOffset = telem.Size(end.size) - endOffset
+		endOffset = telem.Size(end.logicalSize) - endOffset
 	} else {
 		// Non-exact: tr.End is not contained within any domain.
 		if endDomain == -1 {
@@ -154,39 +155,48 @@ func (db *DB) Delete(
 		return span.Error(err)
 	}
 
-	// Calculate size of removed pointers.
+	// Calculate the logical size of removed pointers.
 	var removedSize int64
 	for i := startDomain; i <= endDomain; i++ {
-		removedSize += int64(db.idx.mu.pointers[i].size)
+		removedSize += int64(db.idx.mu.pointers[i].logicalSize)
 	}
 
 	// Remove old pointers.
 	db.idx.mu.pointers = append(db.idx.mu.pointers[:startDomain], db.idx.mu.pointers[endDomain+1:]...)
 
 	if startOffset != 0 {
-		newPointers = append(newPointers, pointer{
-			TimeRange: telem.TimeRange{Start: start.Start, End: tr.Start},
-			fileKey:   start.fileKey,
-			offset:    start.offset,
-			size:      uint32(startOffset), // size from start.Start to tr.Start
-		})
+		// Keep the data from start.Start to tr.Start.
+		ptr := start
+		ptr.TimeRange = telem.TimeRange{Start: start.Start, End: tr.Start}
+		ptr.logicalSize = uint32(startOffset)
+		if !start.compressed() {
+			ptr.size = uint32(startOffset)
+		}
+		newPointers = append(newPointers, ptr)
 	}
 
 	if endOffset != 0 {
-		newPointers = append(newPointers, pointer{
-			TimeRange: telem.TimeRange{Start: tr.End, End: end.End},
-			fileKey:   end.fileKey,
-			offset:    end.offset + end.size - uint32(endOffset),
-			size:      uint32(endOffset), // size from tr.End to end.End
-		})
+		// Keep the data from tr.End to end.End. Compressed blocks cannot be split, so
+		// compressed pointers keep referencing the same blocks and skip past the
+		// deleted data instead.
+		ptr := end
+		ptr.TimeRange = telem.TimeRange{Start: tr.End, End: end.End}
+		ptr.logicalSize = uint32(endOffset)
+		if end.compressed() {
+			ptr.logicalOffset = end.logicalOffset + end.logicalSize - uint32(endOffset)
+		} else {
+			ptr.offset = end.offset + end.size - uint32(endOffset)
+			ptr.size = uint32(endOffset)
+		}
+		newPointers = append(newPointers, ptr)
 	}
 
-	// Calculate size of new partial pointers and update totalSize.
+	// Calculate size of new partial pointers and update sizes.
 	var addedSize int64
 	for _, p := range newPointers {
-		addedSize += int64(p.size)
+		addedSize += int64(p.logicalSize)
 	}
-	db.idx.totalSize.Add(addedSize - removedSize)
+	db.idx.logicalSize.Add(addedSize - removedSize)
 
 	if len(newPointers) != 0 {
 		db.idx.mu.pointers = append(
@@ -194,6 +204,9 @@ func (db *DB) Delete(
 			append(newPointers, db.idx.mu.pointers[startDomain:]...)...,
 		)
 	}
+	// Compressed pointers may share blocks with other pointers after a partial delete,
+	// so we recompute the disk size instead of deriving it from the removed pointers.
+	db.idx.totalSize.Store(diskSize(db.idx.mu.pointers))
 
 	persist := db.idx.indexPersist.prepare(startDomain)
 	// We choose to keep the mutex locked while persisting to index.
@@ -212,6 +225,13 @@ func (db *DB) GarbageCollect(ctx context.Context) error {
 	db.resourceCount.Add(1)
 	defer db.resourceCount.Add(-1)
 
+	// Garbage collection rewrites files, which would invalidate any open snapshots.
+	// Instead of waiting for them to close, we skip this run.
+	if !db.gcMu.TryLock() {
+		return nil
+	}
+	defer db.gcMu.Unlock()
+
 	if _, err := db.fc.gcWriters(); err != nil {
 		return span.Error(err)
 	}
@@ -282,6 +302,14 @@ func (db *DB) garbageCollectFile(key uint16, size int64) error {
 		// between its new offset and its old offset. Note that time ranges are
 		// necessarily unique within a domain.
 		offsetDeltaMap = make(map[telem.TimeRange]uint32)
+		// regions maps each region of the file referenced by a pointer to its offset in
+		// the new file. Compressed pointers split by a delete share the same region,
+		// which must only be copied once.
+		regions = make(map[region]uint32)
+		// checksums maps each region of the file referenced by a pointer to the
+		// checksum of its data, which becomes the checksum of the region in the new
+		// file.
+		checksums = make(map[region]uint32)
 	)
 
 	restore := func() {
@@ -312,8 +340,12 @@ func (db *DB) garbageCollectFile(key uint16, size int64) error {
 	// data accounts for due to deletion (these gaps are tombstones).
 	db.idx.mu.RLock()
 	for _, ptr := range db.idx.mu.pointers {
-		if ptr.fileKey == key {
-			ptrs = append(ptrs, ptr)
+		if ptr.fileKey != key {
+			continue
+		}
+		ptrs = append(ptrs, ptr)
+		if _, ok := regions[ptr.region()]; !ok {
+			regions[ptr.region()] = 0
 			tombstoneSize -= int64(ptr.size)
 		}
 	}
@@ -337,24 +369,38 @@ func (db *DB) garbageCollectFile(key uint16, size int64) error {
 		return err
 	}
 
-	// Find all pointers stored in the old file, and write them to the new file.
+	// Find all regions stored in the old file, and write them to the new file.
+	copied := make(map[region]bool, len(regions))
 	for _, ptr := range ptrs {
+		if copied[ptr.region()] {
+			continue
+		}
+		// Verify the data before copying it, as the copied region is checksummed anew
+		// and would otherwise hide any existing corruption.
+		if err = db.checksums.verify(r, ptr); err != nil {
+			return err
+		}
 		buf := make([]byte, ptr.size)
 		_, err = r.ReadAt(buf, int64(ptr.offset))
 		if err != nil {
 			return err
 		}
+		checksums[ptr.region()] = crc32.Checksum(buf, checksumTable)
 
 		n, err := w.Write(buf)
 		if err != nil {
 			return err
 		}
 
-		if newOffset != ptr.offset {
-			offsetDeltaMap[ptr.TimeRange] = ptr.offset - newOffset
-		}
+		regions[ptr.region()] = newOffset
+		copied[ptr.region()] = true
 		newOffset += uint32(n)
 	}
+	for _, ptr := range ptrs {
+		if o := regions[ptr.region()]; o != ptr.offset {
+			offsetDeltaMap[ptr.TimeRange] = ptr.offset - o
+		}
+	}
 
 	if err = r.Close(); err != nil {
 		return err
@@ -381,11 +427,13 @@ func (db *DB) garbageCollectFile(key uint16, size int64) error {
 		defer db.idx.mu.Unlock()
 		for i, ptr := range db.idx.mu.pointers {
 			if ptr.fileKey == key {
+				db.idx.mu.pointers[i] = rechecksum(ptr, regions, checksums)
 				if deltaOffset, ok := resolvePointerOffset(ptr.TimeRange, offsetDeltaMap); ok {
 					db.idx.mu.pointers[i].offset = ptr.offset - deltaOffset
 				}
 			}
 		}
+		db.checksums.forget(key)
 
 		if err = db.cfg.FS.Rename(name, name+"_temp"); err != nil {
 			return err
@@ -402,6 +450,27 @@ func (db *DB) garbageCollectFile(key uint16, size int64) error {
 	return db.cfg.FS.Remove(name + "_temp")
 }
 
+// rechecksum points the checksum of ptr at the region it was copied to during garbage
+// collection. Pointers split by a delete while the file was being copied are matched
+// by the section their checksum covers instead. If neither was copied, the pointer's
+// checksum no longer describes any section of the new file and is discarded.
+func rechecksum(
+	ptr pointer,
+	regions map[region]uint32,
+	checksums map[region]uint32,
+) pointer {
+	for _, r := range []region{ptr.region(), ptr.checksumSection()} {
+		if checksum, ok := checksums[r]; ok {
+			ptr.checksum = checksum
+			ptr.checksumOffset = regions[r]
+			ptr.checksumSize = r.size
+			return ptr
+		}
+	}
+	ptr.checksum, ptr.checksumOffset, ptr.checksumSize = 0, 0, 0
+	return ptr
+}
+
 func resolvePointerOffset(
 	ptrRange telem.TimeRange,
 	offsetDeltaMap map[telem.TimeRange]uint32,
@@ -439,7 +508,8 @@ func validateDelete(
 		*endOffset = 0
 	}
 
-	startPtrLen, endPtrLen := telem.Size(idx.mu.pointers[startPosition].size), telem.Size(idx.mu.pointers[endPosition].size)
+	startPtrLen := telem.Size(idx.mu.pointers[startPosition].logicalSize)
+	endPtrLen := telem.Size(idx.mu.pointers[endPosition].logicalSize)
 	if *startOffset > startPtrLen {
 		*startOffset = startPtrLen
 	}
@@ -464,7 +534,7 @@ func validateDelete(
 			"deletion start offset %d is after end offset %d for size %d",
 			*startOffset,
 			*endOffset,
-			idx.mu.pointers[startPosition].size,
+			idx.mu.pointers[startPosition].logicalSize,
 		)
 	}
 
@@ -25,6 +25,12 @@ var (
 	ErrRangeNotFound = errors.Wrap(query.ErrNotFound, "time range not found")
 	// ErrDBClosed is returned when an operation is attempted on a closed DB.
 	ErrDBClosed = resource.NewClosedError("domain.db")
+	// ErrCorrupt is returned when the data of a domain or the index referencing it is
+	// found to be corrupt.
+	ErrCorrupt = errors.New("domain data is corrupt")
+	// ErrChecksumMismatch is returned when data read from disk does not match the
+	// checksum recorded for it.
+	ErrChecksumMismatch = errors.Wrap(ErrCorrupt, "checksum mismatch")
 )
 
 // NewRangeWriteConflictError creates a new error returned when existing data in the
@@ -29,7 +29,31 @@ type index struct {
 	}
 	persistHead int
 	deleteLock  sync.RWMutex
-	totalSize   *atomic.Int64
+	// totalSize is the number of bytes occupied on disk by the data referenced by the
+	// index.
+	totalSize *atomic.Int64
+	// logicalSize is the number of decompressed bytes referenced by the index.
+	logicalSize *atomic.Int64
+}
+
+// diskSize returns the number of bytes occupied on disk by the given pointers.
+// Compressed pointers that share a region as a result of a partial delete are only
+// counted once.
+func diskSize(ptrs []pointer) int64 {
+	var (
+		size   int64
+		shared = make(map[region]struct{})
+	)
+	for _, p := range ptrs {
+		if p.compressed() {
+			if _, ok := shared[p.region()]; ok {
+				continue
+			}
+			shared[p.region()] = struct{}{}
+		}
+		size += int64(p.size)
+	}
+	return size
 }
 
 // insert adds a new pointer to the index.
@@ -68,6 +92,7 @@ func (idx *index) insert(ctx context.Context, p pointer, persist bool) error {
 	}
 
 	idx.totalSize.Add(int64(p.size))
+	idx.logicalSize.Add(int64(p.logicalSize))
 	idx.persistHead = min(idx.persistHead, insertAt)
 
 	idx.mu.Unlock()
@@ -134,9 +159,9 @@ func (idx *index) update(ctx context.Context, p pointer, persist bool) error {
 		idx.mu.Unlock()
 		return span.Error(NewRangeWriteConflictError(p.TimeRange, ptrs[updateAt+1].TimeRange))
 	} else {
-		sizeDelta := int64(p.size) - int64(oldP.size)
 		idx.mu.pointers[updateAt] = p
-		idx.totalSize.Add(sizeDelta)
+		idx.totalSize.Add(int64(p.size) - int64(oldP.size))
+		idx.logicalSize.Add(int64(p.logicalSize) - int64(oldP.logicalSize))
 	}
 
 	idx.persistHead = min(idx.persistHead, updateAt)
@@ -10,26 +10,32 @@
 package domain
 
 import (
+	"bytes"
 	"encoding/binary"
+	"hash/crc32"
 	"os"
 	"sync"
 
+	"github.com/synnaxlabs/cesium/internal/compression"
+	"github.com/synnaxlabs/x/errors"
 	"github.com/synnaxlabs/x/io/fs"
 	"github.com/synnaxlabs/x/telem"
 )
 
-const indexFile = "index" + extension
+const (
+	indexFile     = "index" + extension
+	indexTempFile = indexFile + ".tmp"
+)
 
 type indexPersist struct {
 	p   *pointerPersist
 	idx *index
-	Config
+	fs  fs.FS
 }
 
 func openIndexPersist(idx *index, fs fs.FS) (*indexPersist, error) {
 	p, err := openPointerPersist(fs)
-
-	ip := &indexPersist{p: p, idx: idx}
+	ip := &indexPersist{p: p, idx: idx, fs: fs}
 	return ip, err
 }
 
@@ -44,14 +50,54 @@ func (ip *indexPersist) prepare(start int) func() error {
 	return func() error {
 		ip.p.Lock()
 		defer ip.p.Unlock()
-
-		err := ip.p.Truncate(int64(lenOfPointers) * pointerByteSize)
+		header := ip.p.headerSize()
+		err := ip.p.Truncate(header + int64(lenOfPointers)*ip.p.size())
 		if err != nil {
 			return err
 		}
-		_, err = ip.p.WriteAt(pointerEncoded, int64(start*pointerByteSize))
+		_, err = ip.p.WriteAt(pointerEncoded, header+int64(start)*ip.p.size())
+		return err
+	}
+}
+
+// upgrade rewrites the index file using the given pointer format. The new file is
+// written alongside the existing one and then renamed over it, so a crash during the
+// upgrade leaves the original index intact.
+func (ip *indexPersist) upgrade(version pointerVersion, ptrs []pointer) error {
+	ip.p.Lock()
+	defer ip.p.Unlock()
+	if ip.p.version == version {
+		return nil
+	}
+	if err := writeTempIndex(ip.fs, version, ptrs); err != nil {
+		return err
+	}
+	if err := ip.p.File.Close(); err != nil {
+		return err
+	}
+	if err := ip.fs.Rename(indexTempFile, indexFile); err != nil {
+		return err
+	}
+	var err error
+	if ip.p.File, err = ip.fs.Open(indexFile, os.O_RDWR); err != nil {
 		return err
 	}
+	ip.p.version = version
+	return nil
+}
+
+// writeTempIndex writes the given pointers in the given format to a temporary index
+// file, which callers can then rename over the index file.
+func writeTempIndex(fs fs.FS, version pointerVersion, ptrs []pointer) error {
+	codec := pointerCodec{version: version}
+	f, err := fs.Open(indexTempFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(codec.header(), codec.encode(0, ptrs)...)); err != nil {
+		return errors.Combine(err, f.Close())
+	}
+	return f.Close()
 }
 
 func (ip *indexPersist) Close() error {
@@ -70,49 +116,167 @@ func openPointerPersist(fs fs.FS) (*pointerPersist, error) {
 }
 
 func (p *pointerPersist) load() ([]pointer, error) {
-	info, err := p.Stat()
+	ptrs, corrupt, err := p.read()
 	if err != nil {
 		return nil, err
 	}
+	if len(corrupt) > 0 {
+		return nil, errors.Wrapf(
+			ErrChecksumMismatch,
+			"records %v of the domain index are corrupt",
+			corrupt,
+		)
+	}
+	return ptrs, nil
+}
+
+// read reads and decodes all pointers in the index file. Unlike load, read does not
+// fail on corrupt records, and instead returns their positions in corrupt.
+func (p *pointerPersist) read() (ptrs []pointer, corrupt []int, err error) {
+	info, err := p.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
 	size := info.Size()
 
 	b := make([]byte, size)
 	if len(b) != 0 {
 		if _, err = p.ReadAt(b, 0); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return p.decode(b), nil
+	if b, err = p.parseHeader(b); err != nil {
+		return nil, nil, err
+	}
+	ptrs, corrupt = p.decode(b)
+	return ptrs, corrupt, nil
 }
 
 var byteOrder = binary.LittleEndian
 
-type pointerCodec struct{}
+// pointerVersion is the version of the format used to encode pointers in the index
+// file.
+type pointerVersion uint8
+
+const (
+	// pointerVersionLegacy is the original, headerless index format. It stores only the
+	// time range and physical location of each pointer, and cannot represent
+	// compressed domains.
+	pointerVersionLegacy pointerVersion = iota
+	// pointerVersionCompression adds the compression codec and the logical bounds of
+	// each domain.
+	pointerVersionCompression
+	// pointerVersionChecksum adds a checksum of each domain's data, along with a
+	// checksum of each pointer record that detects torn writes to the index file.
+	pointerVersionChecksum
+	// pointerVersionLatest is the version used when writing index files.
+	pointerVersionLatest = pointerVersionChecksum
+)
+
+// indexMagic marks the start of a versioned index file. Legacy index files have no
+// header and begin directly with the start timestamp of their first pointer.
+var indexMagic = []byte{'c', 's', 'm', 'i', 'd', 'x', 0}
+
+// indexHeaderSize is the size of the header of a versioned index file: the magic
+// sequence followed by a single version byte.
+const indexHeaderSize = 8
+
+var pointerByteSizes = map[pointerVersion]int64{
+	pointerVersionLegacy:      26,
+	pointerVersionCompression: 35,
+	pointerVersionChecksum:    51,
+}
+
+// checksumTable is the table used to compute the checksums of domain data and pointer
+// records.
+var checksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+type pointerCodec struct {
+	version pointerVersion
+}
+
+func (f *pointerCodec) size() int64 { return pointerByteSizes[f.version] }
+
+func (f *pointerCodec) headerSize() int64 {
+	if f.version == pointerVersionLegacy {
+		return 0
+	}
+	return indexHeaderSize
+}
+
+func (f *pointerCodec) header() []byte {
+	if f.version == pointerVersionLegacy {
+		return nil
+	}
+	return append(bytes.Clone(indexMagic), byte(f.version))
+}
+
+// parseHeader sets the version of the codec from the header of the given index file
+// contents, returning the remaining encoded pointers. Files without a header are
+// assumed to be in the legacy format. An empty file keeps the codec's current version.
+func (f *pointerCodec) parseHeader(b []byte) ([]byte, error) {
+	if len(b) < indexHeaderSize || !bytes.Equal(b[:len(indexMagic)], indexMagic) {
+		if len(b) != 0 {
+			f.version = pointerVersionLegacy
+		}
+		return b, nil
+	}
+	version := pointerVersion(b[len(indexMagic)])
+	if _, ok := pointerByteSizes[version]; !ok {
+		return nil, errors.Newf("unrecognized index file version %d", version)
+	}
+	f.version = version
+	return b[indexHeaderSize:], nil
+}
 
 func (f *pointerCodec) encode(start int, ptrs []pointer) []byte {
-	b := make([]byte, (len(ptrs)-start)*pointerByteSize)
+	size := int(f.size())
+	b := make([]byte, (len(ptrs)-start)*size)
 	for i := start; i < len(ptrs); i++ {
 		ptr := ptrs[i]
-		base := (i - start) * pointerByteSize
+		base := (i - start) * size
 		byteOrder.PutUint64(b[base:base+8], uint64(ptr.Start))
 		byteOrder.PutUint64(b[base+8:base+16], uint64(ptr.End))
 		byteOrder.PutUint16(b[base+16:base+18], ptr.fileKey)
 		byteOrder.PutUint32(b[base+18:base+22], ptr.offset)
 		byteOrder.PutUint32(b[base+22:base+26], ptr.size)
+		if f.version >= pointerVersionCompression {
+			b[base+26] = byte(ptr.codec)
+			byteOrder.PutUint32(b[base+27:base+31], ptr.logicalOffset)
+			byteOrder.PutUint32(b[base+31:base+35], ptr.logicalSize)
+		}
+		if f.version >= pointerVersionChecksum {
+			byteOrder.PutUint32(b[base+35:base+39], ptr.checksum)
+			byteOrder.PutUint32(b[base+39:base+43], ptr.checksumOffset)
+			byteOrder.PutUint32(b[base+43:base+47], ptr.checksumSize)
+			byteOrder.PutUint32(
+				b[base+47:base+51],
+				crc32.Checksum(b[base:base+47], checksumTable),
+			)
+		}
 	}
 
 	return b
 }
 
-func (f *pointerCodec) decode(b []byte) []pointer {
+// decode decodes the pointers encoded in b. Records that fail checksum verification
+// are omitted from the returned pointers, and their positions are returned in corrupt.
+func (f *pointerCodec) decode(b []byte) (pointers []pointer, corrupt []int) {
 	if len(b) == 0 {
-		return []pointer{}
+		return []pointer{}, nil
 	}
 
-	pointers := make([]pointer, len(b)/pointerByteSize)
-	for i := range len(b) / pointerByteSize {
-		base := i * pointerByteSize
-		pointers[i] = pointer{
+	size := int(f.size())
+	pointers = make([]pointer, 0, len(b)/size)
+	for i := range len(b) / size {
+		base := i * size
+		if f.version >= pointerVersionChecksum &&
+			crc32.Checksum(b[base:base+47], checksumTable) !=
+				byteOrder.Uint32(b[base+47:base+51]) {
+			corrupt = append(corrupt, i)
+			continue
+		}
+		ptr := pointer{
 			TimeRange: telem.TimeRange{
 				Start: telem.TimeStamp(byteOrder.Uint64(b[base : base+8])),
 				End:   telem.TimeStamp(byteOrder.Uint64(b[base+8 : base+16])),
@@ -121,6 +285,19 @@ func (f *pointerCodec) decode(b []byte) []pointer {
 			offset:  byteOrder.Uint32(b[base+18 : base+22]),
 			size:    byteOrder.Uint32(b[base+22 : base+26]),
 		}
+		if f.version >= pointerVersionCompression {
+			ptr.codec = compression.Codec(b[base+26])
+			ptr.logicalOffset = byteOrder.Uint32(b[base+27 : base+31])
+			ptr.logicalSize = byteOrder.Uint32(b[base+31 : base+35])
+		} else {
+			ptr.logicalSize = ptr.size
+		}
+		if f.version >= pointerVersionChecksum {
+			ptr.checksum = byteOrder.Uint32(b[base+35 : base+39])
+			ptr.checksumOffset = byteOrder.Uint32(b[base+39 : base+43])
+			ptr.checksumSize = byteOrder.Uint32(b[base+43 : base+47])
+		}
+		pointers = append(pointers, ptr)
 	}
-	return pointers
+	return pointers, corrupt
 }
@@ -204,8 +204,9 @@ func (i *Iterator) OpenReader(ctx context.Context) (*Reader, error) {
 	return i.readerFactory(ctx, i.currPtr)
 }
 
-// Size returns the number of bytes occupied by the telemetry in the current domain.
-func (i *Iterator) Size() telem.Size { return telem.Size(i.currPtr.size) }
+// Size returns the number of decompressed bytes occupied by the telemetry in the
+// current domain.
+func (i *Iterator) Size() telem.Size { return telem.Size(i.currPtr.logicalSize) }
 
 // Close closes the iterator.
 func (i *Iterator) Close() error {
@@ -9,9 +9,10 @@
 
 package domain
 
-import "github.com/synnaxlabs/x/telem"
-
-const pointerByteSize = 26
+import (
+	"github.com/synnaxlabs/cesium/internal/compression"
+	"github.com/synnaxlabs/x/telem"
+)
 
 // pointer is a reference to a telemetry blob occupying a particular time domain.
 type pointer struct {
@@ -28,7 +29,57 @@ type pointer struct {
 	// offset is the offset of the domain within the file.
 	// 4 bytes
 	offset uint32
-	// size is the size of the domain within the file.
+	// size is the number of bytes the domain occupies within the file.
 	// 4 bytes
 	size uint32
+	// codec is the compression codec used to encode the domain's data. Compressed
+	// domains are stored as a sequence of blocks (see block.go).
+	// 1 byte
+	codec compression.Codec
+	// logicalOffset is the offset of the domain's first byte within the decompressed
+	// contents of its blocks. Partial deletes of a compressed domain keep the blocks in
+	// place and move this offset instead. Always zero for uncompressed domains.
+	// 4 bytes
+	logicalOffset uint32
+	// logicalSize is the number of decompressed bytes in the domain. Equal to size for
+	// uncompressed domains.
+	// 4 bytes
+	logicalSize uint32
+	// checksum is the CRC-32C of the section of the file bounded by checksumOffset and
+	// checksumSize.
+	// 4 bytes
+	checksum uint32
+	// checksumOffset and checksumSize bound the section of the file covered by
+	// checksum. The section always contains the pointer's region: a partial delete
+	// shrinks the region of an uncompressed pointer, but the remaining pointers keep
+	// verifying against the section that was originally checksummed. A checksumSize of
+	// zero means that the pointer has no checksum, which is the case for pointers
+	// written before checksums were introduced.
+	// 8 bytes
+	checksumOffset uint32
+	checksumSize   uint32
+}
+
+// compressed returns true if the domain's data is stored in compressed blocks.
+func (p pointer) compressed() bool { return p.codec != compression.None }
+
+// checksummed returns true if a checksum was recorded for the pointer's data.
+func (p pointer) checksummed() bool { return p.checksumSize != 0 }
+
+// checksumSection returns the section of the pointer's file covered by its checksum.
+func (p pointer) checksumSection() region {
+	return region{fileKey: p.fileKey, offset: p.checksumOffset, size: p.checksumSize}
+}
+
+// region returns the physical location of the pointer's data within its file.
+// Compressed pointers that were split by a delete share the same region.
+func (p pointer) region() region {
+	return region{fileKey: p.fileKey, offset: p.offset, size: p.size}
+}
+
+// region is a contiguous section of bytes in a domain file.
+type region struct {
+	fileKey uint16
+	offset  uint32
+	size    uint32
 }
@@ -12,12 +12,14 @@ package domain
 import (
 	"context"
 
+	"github.com/synnaxlabs/x/errors"
 	"github.com/synnaxlabs/x/io"
 	"github.com/synnaxlabs/x/telem"
 )
 
 // Reader is a readable domain of telemetry within the DB implementing the io.ReaderAt
-// and io.Closer interfaces.
+// and io.Closer interfaces. Offsets passed to ReadAt are always relative to the
+// decompressed contents of the domain, regardless of the codec it was written with.
 type Reader struct {
 	io.ReaderAtCloser
 	ptr pointer
@@ -28,9 +30,19 @@ func (db *DB) newReader(ctx context.Context, ptr pointer) (*Reader, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = db.checksums.verify(internal, ptr); err != nil {
+		return nil, errors.Combine(err, internal.Close())
+	}
 	reader := io.NewSectionReaderAtCloser(internal, int64(ptr.offset), int64(ptr.size))
-	return &Reader{ptr: ptr, ReaderAtCloser: reader}, nil
+	if !ptr.compressed() {
+		return &Reader{ptr: ptr, ReaderAtCloser: reader}, nil
+	}
+	br, err := openBlockReader(reader, ptr, db.cfg.Density)
+	if err != nil {
+		return nil, errors.Combine(err, reader.Close())
+	}
+	return &Reader{ptr: ptr, ReaderAtCloser: br}, nil
 }
 
-// Size returns the number of bytes in the entire domain.
-func (r *Reader) Size() telem.Size { return telem.Size(r.ptr.size) }
+// Size returns the number of decompressed bytes in the entire domain.
+func (r *Reader) Size() telem.Size { return telem.Size(r.ptr.logicalSize) }
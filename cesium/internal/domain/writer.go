@@ -11,9 +11,11 @@ package domain
 
 import (
 	"context"
+	"hash/crc32"
 
 	"github.com/samber/lo"
 	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/cesium/internal/compression"
 	"github.com/synnaxlabs/cesium/internal/resource"
 	"github.com/synnaxlabs/x/config"
 	"github.com/synnaxlabs/x/errors"
@@ -141,7 +143,22 @@ type Writer struct {
 	// prevCommit is the timestamp for the previous Commit call made to the database.
 	prevCommit telem.TimeStamp
 	// len is the number of bytes written by all internal writers of the domain writer.
+	// For compressed domains, this is the number of bytes before compression.
 	len int64
+	// codec is the compression codec used to encode the domain.
+	codec compression.Codec
+	// density is the density of the samples written to the domain.
+	density telem.Density
+	// pending holds data that has been written to a compressed domain but has not yet
+	// been encoded into a block. Pending data is flushed as a single block on the next
+	// commit.
+	pending []byte
+	// logicalLen is the number of decompressed bytes flushed to the domain in the
+	// current file.
+	logicalLen int64
+	// checksum is the running checksum of the bytes written to the domain in the
+	// current file.
+	checksum uint32
 	// lastIndexPersist stores the timestamp of the last time changes to index were
 	// flushed to disk.
 	lastIndexPersist telem.TimeStamp
@@ -189,6 +206,8 @@ func (db *DB) OpenWriter(ctx context.Context, cfg WriterConfig) (*Writer, error)
 		idx:              db.idx,
 		presetEnd:        !cfg.End.IsZero(),
 		lastIndexPersist: telem.Now(),
+		codec:            db.cfg.Compression,
+		density:          db.cfg.Density,
 		onClose: func() {
 			db.resourceCount.Add(-1)
 		},
@@ -217,12 +236,39 @@ func (w *Writer) Write(p []byte) (int, error) {
 	if w.closed {
 		return 0, ErrWriterClosed
 	}
+	if w.codec != compression.None {
+		w.pending = append(w.pending, p...)
+		w.len += int64(len(p))
+		return len(p), nil
+	}
 	n, err := w.internal.Write(p)
+	w.checksum = crc32.Update(w.checksum, checksumTable, p[:n])
 	w.fileSize += telem.Size(n)
 	w.len += int64(n)
 	return n, err
 }
 
+// flush encodes any pending data into a compressed block and writes it to the
+// underlying file.
+func (w *Writer) flush() error {
+	if len(w.pending) == 0 {
+		return nil
+	}
+	b, err := encodeBlock(w.codec, w.density, nil, w.pending)
+	if err != nil {
+		return err
+	}
+	n, err := w.internal.Write(b)
+	w.checksum = crc32.Update(w.checksum, checksumTable, b[:n])
+	w.fileSize += telem.Size(n)
+	if err != nil {
+		return err
+	}
+	w.logicalLen += int64(len(w.pending))
+	w.pending = w.pending[:0]
+	return nil
+}
+
 // Commit commits the domain to the DB, making it available for reading by other
 // processes. If the WriterConfig.End parameter was set, Commit will ignore the provided
 // timestamp and use the WriterConfig.End parameter instead. If the WriterConfig.End
@@ -264,6 +310,9 @@ func (w *Writer) commit(ctx context.Context, end telem.TimeStamp, shouldPersist
 		))
 	}
 
+	if err := w.flush(); err != nil {
+		return span.Error(err)
+	}
 	length := w.internal.Len()
 	if length == 0 {
 		return nil
@@ -275,10 +324,18 @@ func (w *Writer) commit(ctx context.Context, end telem.TimeStamp, shouldPersist
 	}
 
 	ptr := pointer{
-		TimeRange: telem.TimeRange{Start: w.Start, End: commitEnd},
-		offset:    uint32(w.internal.Offset()),
-		size:      uint32(length),
-		fileKey:   w.fileKey,
+		TimeRange:      telem.TimeRange{Start: w.Start, End: commitEnd},
+		offset:         uint32(w.internal.Offset()),
+		size:           uint32(length),
+		fileKey:        w.fileKey,
+		codec:          w.codec,
+		logicalSize:    uint32(length),
+		checksum:       w.checksum,
+		checksumOffset: uint32(w.internal.Offset()),
+		checksumSize:   uint32(length),
+	}
+	if w.codec != compression.None {
+		ptr.logicalSize = uint32(w.logicalLen)
 	}
 	f := lo.Ternary(w.prevCommit.IsZero(), w.idx.insert, w.idx.update)
 
@@ -303,6 +360,8 @@ func (w *Writer) commit(ctx context.Context, end telem.TimeStamp, shouldPersist
 		w.fileSize = telem.Size(newFileSize)
 		w.Start = commitEnd
 		w.prevCommit = 0
+		w.logicalLen = 0
+		w.checksum = 0
 		if w.OnRollover != nil {
 			w.OnRollover(commitEnd)
 		}
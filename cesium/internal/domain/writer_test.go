@@ -32,6 +32,8 @@ func extractPointer(f xfs.File) (p struct {
 	offset  uint32
 	length  uint32
 }) {
+	// Skip the header of the index file, which holds its magic sequence and version.
+	MustSucceed(f.Read(make([]byte, 8)))
 	b := make([]byte, 26)
 	MustSucceed(f.Read(b))
 	p.Start = telem.TimeStamp(binary.LittleEndian.Uint64(b[0:8]))
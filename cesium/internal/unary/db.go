@@ -120,9 +120,24 @@ func (db *DB) Read(ctx context.Context, tr telem.TimeRange) (frame channel.Frame
 	return frame, err
 }
 
-// Size returns the total size of all data stored in the database.
+// Size returns the total number of bytes that the data stored in the database occupies
+// on disk.
 func (db *DB) Size() telem.Size { return db.domain.Size() }
 
+// Snapshot takes a point-in-time snapshot of the data in the database. The snapshot
+// must be closed after use.
+func (db *DB) Snapshot() (*domain.Snapshot, error) {
+	if db.closed.Load() {
+		return nil, db.wrapError(ErrDBClosed)
+	}
+	s, err := db.domain.Snapshot()
+	return s, db.wrapError(err)
+}
+
+// LogicalSize returns the total number of bytes of data stored in the database before
+// compression.
+func (db *DB) LogicalSize() telem.Size { return db.domain.LogicalSize() }
+
 // Close closes the unary database, releasing all resources associated with it. Close
 // will return an error if there are any unclosed writers, iterators, or delete
 // operations being executed on the database. Close is idempotent, and will return nil
@@ -219,7 +219,10 @@ func (i *Iterator) autoNext(ctx context.Context) bool {
 		i.err = err
 		return false
 	}
-	if endApprox.Lower.After(i.bounds.End) {
+	// If the chunk extends past the bounds of the iterator or the end of the data in
+	// the channel, read everything that remains so that the next call lands at the end
+	// of the iterator's bounds instead of on a stamp that does not exist in the index.
+	if endApprox.Lower.After(i.bounds.End) || endApprox.Upper == telem.TimeStampMax {
 		return i.Next(ctx, i.view.Start.Span(i.bounds.End))
 	}
 	i.view.End = endApprox.Lower
@@ -106,6 +106,8 @@ func Open(ctx context.Context, configs ...Config) (*DB, error) {
 		Instrumentation: cfg.Instrumentation,
 		FileSize:        cfg.FileSize,
 		GCThreshold:     cfg.GCThreshold,
+		Compression:     cfg.Channel.Compression,
+		Density:         cfg.Channel.DataType.Density(),
 	})
 	if err != nil {
 		return nil, err
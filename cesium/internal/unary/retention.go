@@ -0,0 +1,133 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package unary
+
+import (
+	"context"
+
+	"github.com/synnaxlabs/cesium/internal/channel"
+	"github.com/synnaxlabs/cesium/internal/domain"
+	"github.com/synnaxlabs/cesium/internal/index"
+	"github.com/synnaxlabs/cesium/internal/meta"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// RetentionCutoff returns the timestamp before which all data in the database must be
+// deleted in order to satisfy the channel's retention policy at the given time. When
+// both a maximum age and a maximum size are set, the later (stricter) cutoff is
+// returned. Returns telem.TimeStampMin if no data needs to be deleted.
+func (db *DB) RetentionCutoff(ctx context.Context, now telem.TimeStamp) (telem.TimeStamp, error) {
+	if db.closed.Load() {
+		return 0, ErrDBClosed
+	}
+	var (
+		r      = db.cfg.Channel.Retention
+		cutoff = telem.TimeStampMin
+	)
+	if r.MaxAge > 0 {
+		cutoff = now.Sub(r.MaxAge)
+	}
+	if r.MaxSize > 0 && db.Size() > r.MaxSize {
+		sizeCutoff, err := db.sizeCutoff(ctx, r.MaxSize)
+		if err != nil {
+			return 0, db.wrapError(err)
+		}
+		cutoff = max(cutoff, sizeCutoff)
+	}
+	return cutoff, nil
+}
+
+// sizeCutoff returns the timestamp before which data must be deleted for the on-disk
+// size of the database to fit within maxSize. Domains are walked from newest to oldest
+// until the budget is exhausted. If the domain that crosses the budget holds fixed
+// density data, it is split at the sample that brings the database back within budget,
+// otherwise the whole domain is dropped.
+func (db *DB) sizeCutoff(ctx context.Context, maxSize telem.Size) (cutoff telem.TimeStamp, err error) {
+	// Domain sizes are tracked before compression, so scale the on-disk budget by the
+	// database's compression ratio.
+	budget := telem.Size(float64(maxSize) * float64(db.LogicalSize()) / float64(db.Size()))
+	iter := db.domain.OpenIterator(domain.IterRange(telem.TimeRangeMax))
+	defer func() { err = errors.Combine(err, iter.Close()) }()
+	var retained telem.Size
+	for ok := iter.SeekLast(ctx); ok; ok = iter.Prev() {
+		size := iter.Size()
+		if retained+size <= budget {
+			retained += size
+			continue
+		}
+		tr := iter.TimeRange()
+		density := db.cfg.Channel.DataType.Density()
+		if density == telem.UnknownDensity {
+			return tr.End, nil
+		}
+		// Round up so that the partial domain left behind fits within the budget.
+		excess := int64(size - (budget - retained))
+		drop := (excess + int64(density) - 1) / int64(density)
+		approx, err := db.index().Stamp(ctx, tr.Start, drop, index.MustBeContinuous)
+		if err != nil {
+			if errors.Is(err, index.ErrDiscontinuous) {
+				return tr.End, nil
+			}
+			return 0, err
+		}
+		return min(approx.Lower, tr.End), nil
+	}
+	return telem.TimeStampMin, nil
+}
+
+// Trim deletes all data in the database before the given cutoff. Unlike Delete, Trim
+// does not fail when a writer controls part of the range, and instead only deletes
+// data that precedes the domain every open writer is currently writing to. This allows
+// retention to be enforced on channels with long-running writers.
+func (db *DB) Trim(ctx context.Context, cutoff telem.TimeStamp) error {
+	if db.closed.Load() {
+		return ErrDBClosed
+	}
+	tr := telem.TimeStampMin.Range(cutoff)
+	for _, cw := range db.controller.ResourcesIn(tr) {
+		tr.End = min(tr.End, telem.TimeStamp(cw.domainStart.Load()))
+	}
+	if tr.Span() <= 0 {
+		return nil
+	}
+	if err := db.domain.Delete(ctx, tr, db.calculateStartOffset, db.calculateEndOffset); err != nil {
+		return db.wrapError(err)
+	}
+	db.resolver.invalidate()
+	return nil
+}
+
+// LeadingTimeStamp returns the start timestamp of the oldest data in the database. The
+// second return value is false if the database holds no data.
+func (db *DB) LeadingTimeStamp(ctx context.Context) (ts telem.TimeStamp, ok bool, err error) {
+	if db.closed.Load() {
+		return 0, false, ErrDBClosed
+	}
+	iter := db.domain.OpenIterator(domain.IterRange(telem.TimeRangeMax))
+	defer func() { err = errors.Combine(err, iter.Close()) }()
+	if !iter.SeekFirst(ctx) {
+		return 0, false, nil
+	}
+	return iter.TimeRange().Start, true, nil
+}
+
+// SetRetentionInMeta changes the channel's retention policy, and persists the change to
+// the underlying file system.
+func (db *DB) SetRetentionInMeta(ctx context.Context, r channel.Retention) error {
+	if db.closed.Load() {
+		return ErrDBClosed
+	}
+	if db.cfg.Channel.Retention == r {
+		return nil
+	}
+	db.cfg.Channel.Retention = r
+	return meta.Create(ctx, db.cfg.FS, db.cfg.MetaCodec, db.cfg.Channel)
+}
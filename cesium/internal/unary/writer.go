@@ -138,6 +138,11 @@ type controlledWriter struct {
 	// and the current domain start remain consistent across owners. Reset on rollover
 	// via the domain.Writer's OnRollover hook.
 	tracker *offsetTracker
+	// domainStart is the start timestamp of the domain the writer is currently writing
+	// to. Retention enforcement reads it to avoid deleting data out from under an open
+	// domain, so it is accessed atomically. Zero for resources that do not hold a
+	// domain writer.
+	domainStart atomic.Int64
 }
 
 var _ control.Resource = &controlledWriter{}
@@ -203,8 +208,12 @@ func (db *DB) OpenWriter(ctx context.Context, cfgs ...WriterConfig) (
 				channelKey: db.cfg.Channel.Key,
 				tracker:    db.resolver.newTracker(cfg.Start),
 			}
+			cw.domainStart.Store(int64(cfg.Start))
 			domainCfg := cfg.domain()
-			domainCfg.OnRollover = cw.tracker.rollover
+			domainCfg.OnRollover = func(commitEnd telem.TimeStamp) {
+				cw.tracker.rollover(commitEnd)
+				cw.domainStart.Store(int64(commitEnd))
+			}
 			dw, err := db.domain.OpenWriter(ctx, domainCfg)
 			cw.Writer = dw
 			cw.storeAlignment(telem.NewAlignment(db.leadingAlignment.Add(1), 0))
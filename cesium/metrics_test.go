@@ -138,6 +138,69 @@ var _ = Describe("Metrics", Ordered, func() {
 					// 5 timestamps (8 bytes each) + 5 int64s (8 bytes each) = 80 bytes
 					Expect(m.DiskSize).To(Equal(telem.Size(80)))
 				})
+
+				It("Should report the logical size of compressed channels", func(ctx SpecContext) {
+					sub := MustSucceed(fs.Sub("compression-metrics"))
+					subDB := openDBOnFS(ctx, sub)
+					defer func() { Expect(subDB.Close()).To(Succeed()) }()
+
+					indexKey := GenerateChannelKey()
+					dataKey := GenerateChannelKey()
+
+					Expect(subDB.CreateChannel(ctx, cesium.Channel{
+						Key:         indexKey,
+						Name:        "index",
+						IsIndex:     true,
+						DataType:    telem.TimeStampT,
+						Compression: cesium.CompressionDeltaOfDelta,
+					})).To(Succeed())
+
+					Expect(subDB.CreateChannel(ctx, cesium.Channel{
+						Key:         dataKey,
+						Name:        "data",
+						Index:       indexKey,
+						DataType:    telem.Float64T,
+						Compression: cesium.CompressionXOR,
+					})).To(Succeed())
+
+					stamps := make([]telem.TimeStamp, 1000)
+					values := make([]float64, 1000)
+					for i := range stamps {
+						stamps[i] = telem.TimeStamp(i+1) * telem.SecondTS
+						values[i] = 12.5
+					}
+					Expect(subDB.Write(ctx, 1*telem.SecondTS, telem.MultiFrame(
+						[]cesium.ChannelKey{indexKey, dataKey},
+						[]telem.Series{telem.NewSeriesV(stamps...), telem.NewSeriesV(values...)},
+					))).To(Succeed())
+
+					m := subDB.Metrics()
+					Expect(m.LogicalSize).To(Equal(telem.Size(16000)))
+					Expect(m.DiskSize).To(BeNumerically("<", m.LogicalSize/10))
+
+					frame := MustSucceed(subDB.Read(ctx, telem.TimeRangeMax, dataKey))
+					Expect(frame.Get(dataKey).Series[0]).To(telem.MatchSeriesDataV(values...))
+				})
+
+				It("Should reject a compression codec that is incompatible with the data type", func(ctx SpecContext) {
+					sub := MustSucceed(fs.Sub("invalid-compression"))
+					subDB := openDBOnFS(ctx, sub)
+					defer func() { Expect(subDB.Close()).To(Succeed()) }()
+					indexKey := GenerateChannelKey()
+					Expect(subDB.CreateChannel(ctx, cesium.Channel{
+						Key:      indexKey,
+						Name:     "index",
+						IsIndex:  true,
+						DataType: telem.TimeStampT,
+					})).To(Succeed())
+					Expect(subDB.CreateChannel(ctx, cesium.Channel{
+						Key:         GenerateChannelKey(),
+						Name:        "data",
+						Index:       indexKey,
+						DataType:    telem.Float64T,
+						Compression: cesium.CompressionDeltaOfDelta,
+					})).To(MatchError(ContainSubstring("compression")))
+				})
 			})
 		})
 	}
@@ -74,6 +74,7 @@ func Open(ctx context.Context, dirname string, opts ...Option) (*DB, error) {
 	sCtx, cancel := signal.Isolated(signal.WithInstrumentation(o.Instrumentation))
 	db.relay = openRelay(sCtx, o.Instrumentation, db.streamingConfig)
 	db.startGC(sCtx, o)
+	db.startRetention(sCtx, o)
 	db.shutdown = signal.NewHardShutdown(sCtx, cancel)
 	return db, nil
 }
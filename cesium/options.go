@@ -26,6 +26,7 @@ type options struct {
 	metaCodec       encoding.Codec
 	dirname         string
 	gcCfg           GCConfig
+	retentionCfg    RetentionConfig
 	streamingConfig DBStreamingConfig
 	fileSize        telem.Size
 }
@@ -46,11 +47,15 @@ func mergeAndValidateOptions(o *options) error {
 	o.metaCodec = override.Nil[encoding.Codec](json.Codec, o.metaCodec)
 	o.fs = override.Nil(xfs.Default, o.fs)
 	o.gcCfg = DefaultGCConfig.Override(o.gcCfg)
+	o.retentionCfg = DefaultRetentionConfig.Override(o.retentionCfg)
 	o.fileSize = override.Numeric(1*telem.Gigabyte, o.fileSize)
 	o.streamingConfig = DefaultDBStreamingConfig.Override(o.streamingConfig)
 	if err := o.gcCfg.Validate(); err != nil {
 		return err
 	}
+	if err := o.retentionCfg.Validate(); err != nil {
+		return err
+	}
 	return o.streamingConfig.Validate()
 }
 
@@ -62,6 +67,12 @@ func WithFS(fs xfs.FS) Option { return func(o *options) { o.fs = fs } }
 // struct for more details.
 func WithGCConfig(config GCConfig) Option { return func(o *options) { o.gcCfg = config } }
 
+// WithRetentionConfig sets the configuration for enforcing channel retention policies.
+// See the RetentionConfig struct for more details.
+func WithRetentionConfig(config RetentionConfig) Option {
+	return func(o *options) { o.retentionCfg = config }
+}
+
 // WithInstrumentation sets the instrumentation the DB will use for logging, tracing,
 // etc. Defaults to noop instrumentation.
 func WithInstrumentation(i alamos.Instrumentation) Option {
@@ -0,0 +1,199 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package cesium
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/synnaxlabs/cesium/internal/channel"
+	"github.com/synnaxlabs/cesium/internal/unary"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/override"
+	"github.com/synnaxlabs/x/signal"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// Retention is a policy for automatically deleting old data from a channel.
+type Retention = channel.Retention
+
+// RetentionConfig configures the background enforcement of channel retention policies.
+type RetentionConfig struct {
+	// TryInterval is the interval of time between two passes of retention
+	// enforcement.
+	// [OPTIONAL] Default: 1 minute
+	TryInterval time.Duration
+}
+
+var (
+	_                      config.Config[RetentionConfig] = RetentionConfig{}
+	DefaultRetentionConfig                                = RetentionConfig{
+		TryInterval: time.Minute,
+	}
+)
+
+// Override implements config.Config.
+func (cfg RetentionConfig) Override(other RetentionConfig) RetentionConfig {
+	cfg.TryInterval = override.Numeric(cfg.TryInterval, other.TryInterval)
+	return cfg
+}
+
+// Validate implements config.Config.
+func (cfg RetentionConfig) Validate() error {
+	v := validate.New("cesium.retention_config")
+	validate.Positive(v, "try_interval", cfg.TryInterval)
+	return v.Error()
+}
+
+// SetChannelRetention sets the retention policy of the channel with the given key and
+// persists it to disk. The policy is enforced in the background on the next pass of
+// retention enforcement. Returns a validation error if the channel is virtual, and
+// ErrChannelNotFound if the channel does not exist.
+func (db *DB) SetChannelRetention(ctx context.Context, key ChannelKey, r Retention) error {
+	if db.closed.Load() {
+		return ErrDBClosed
+	}
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if u, ok := db.mu.dbs.unary[key]; ok {
+		if err := u.SetRetentionInMeta(ctx, r); err != nil {
+			return err
+		}
+		db.mu.dbs.unary[key] = u
+		return nil
+	}
+	if v, ok := db.mu.dbs.virtual[key]; ok {
+		return errors.Wrapf(
+			validate.ErrValidation,
+			"cannot set retention policy on virtual channel %v",
+			v.Channel(),
+		)
+	}
+	return channel.NewNotFoundError(key)
+}
+
+// enforceRetention deletes all data that falls outside the retention policies of the
+// database's channels. Data channels are trimmed first, and inherit the policy of
+// their index channel when it is stricter than their own. Index channels are then
+// trimmed, but never past the oldest data remaining in a channel that depends on them,
+// so that every persisted sample keeps its timestamp.
+//
+// Cutoffs are computed from a snapshot of the database's channels taken under a read
+// lock, and trimming happens without holding the lock so that it doesn't block
+// channels from being opened, created, or deleted. A channel that is deleted during
+// the pass is skipped.
+func (db *DB) enforceRetention(ctx context.Context) (err error) {
+	ctx, span := db.T.Debug(ctx, "enforce_retention")
+	defer func() { _ = span.EndWith(err) }()
+	dbs, cutoffs, err := db.retentionCutoffs(ctx, telem.Now())
+	if err != nil {
+		return err
+	}
+	for key, u := range dbs {
+		if u.Channel().IsIndex {
+			continue
+		}
+		cutoffs[key] = max(cutoffs[key], cutoffs[u.Channel().Index])
+		if cutoffs[key] == telem.TimeStampMin {
+			continue
+		}
+		err = errors.Combine(err, skipClosed(u.Trim(ctx, cutoffs[key])))
+	}
+	if err != nil {
+		return err
+	}
+	for key, idx := range dbs {
+		if !idx.Channel().IsIndex {
+			continue
+		}
+		var (
+			cutoff        = cutoffs[key]
+			depCutoff     = telem.TimeStampMax
+			hasDependents bool
+		)
+		for depKey, dep := range dbs {
+			if depKey == key || dep.Channel().Index != key {
+				continue
+			}
+			hasDependents = true
+			depCutoff = min(depCutoff, cutoffs[depKey])
+			leading, ok, err := dep.LeadingTimeStamp(ctx)
+			if err != nil {
+				return skipClosed(err)
+			}
+			if ok {
+				depCutoff = min(depCutoff, leading)
+			}
+		}
+		// An index channel without a policy of its own is trimmed along with its
+		// dependents once all of them have aged out.
+		if cutoff == telem.TimeStampMin && hasDependents {
+			cutoff = depCutoff
+		}
+		cutoff = min(cutoff, depCutoff)
+		if cutoff == telem.TimeStampMin {
+			continue
+		}
+		if err := skipClosed(idx.Trim(ctx, cutoff)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retentionCutoffs returns a snapshot of the database's unary channels along with the
+// retention cutoff of each of them at the given time.
+func (db *DB) retentionCutoffs(
+	ctx context.Context,
+	now telem.TimeStamp,
+) (map[ChannelKey]unary.DB, map[ChannelKey]telem.TimeStamp, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	var (
+		dbs     = maps.Clone(db.mu.dbs.unary)
+		cutoffs = make(map[ChannelKey]telem.TimeStamp, len(dbs))
+		err     error
+	)
+	for key, u := range dbs {
+		if cutoffs[key], err = u.RetentionCutoff(ctx, now); err != nil {
+			return nil, nil, err
+		}
+	}
+	return dbs, cutoffs, nil
+}
+
+// skipClosed discards the error returned by a unary database that was closed because
+// its channel was deleted while retention was being enforced.
+func skipClosed(err error) error {
+	if errors.Is(err, unary.ErrDBClosed) {
+		return nil
+	}
+	return err
+}
+
+func (db *DB) startRetention(sCtx signal.Context, opts *options) {
+	signal.GoTick(sCtx, opts.retentionCfg.TryInterval, func(ctx context.Context, _ time.Time) error {
+		if err := db.enforceRetention(ctx); err != nil {
+			db.L.Error("retention enforcement error", zap.Error(err))
+		}
+		return nil
+	},
+		signal.WithRetryOnPanic(10),
+		signal.RecoverWithoutErrOnPanic(),
+		signal.WithKey("retention-ticker"),
+	)
+}
@@ -0,0 +1,247 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package cesium_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/cesium"
+	. "github.com/synnaxlabs/cesium/internal/testutil"
+	xfs "github.com/synnaxlabs/x/io/fs"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// retentionTimeout bounds how long a spec waits for background retention
+// enforcement to delete data.
+const retentionTimeout = 5 * time.Second
+
+var _ = Describe("Retention", func() {
+	for fsName, openFS := range FileSystems {
+		Context("FS: "+fsName, func() {
+			ShouldNotLeakGoroutinesPerSpec()
+			var (
+				db    *cesium.DB
+				fs    xfs.FS
+				index cesium.ChannelKey
+				data  cesium.ChannelKey
+			)
+			openDB := func(ctx SpecContext) *cesium.DB {
+				return MustSucceed(cesium.Open(ctx, "",
+					cesium.WithFS(fs),
+					cesium.WithRetentionConfig(cesium.RetentionConfig{
+						TryInterval: 10 * telem.Millisecond.Duration(),
+					}),
+					cesium.WithInstrumentation(PanicLogger()),
+				))
+			}
+			// write writes count samples to the index and data channels, with
+			// timestamps spaced one second apart starting at start.
+			write := func(ctx SpecContext, start telem.TimeStamp, count int) {
+				timestamps := make([]telem.TimeStamp, count)
+				values := make([]int64, count)
+				for i := range count {
+					timestamps[i] = start.Add(telem.TimeSpan(i) * telem.Second)
+					values[i] = int64(i)
+				}
+				Expect(db.Write(ctx, start, telem.MultiFrame(
+					[]cesium.ChannelKey{index, data},
+					[]telem.Series{telem.NewSeriesV(timestamps...), telem.NewSeriesV(values...)},
+				))).To(Succeed())
+			}
+			read := func(ctx SpecContext, key cesium.ChannelKey) telem.MultiSeries {
+				return MustSucceed(db.Read(ctx, telem.TimeRangeMax, key)).Get(key)
+			}
+			BeforeEach(func(ctx SpecContext) {
+				fs = openFS()
+				db = openDB(ctx)
+				index = GenerateChannelKey()
+				data = GenerateChannelKey()
+			})
+			AfterEach(func() { Expect(db.Close()).To(Succeed()) })
+
+			Describe("Max Age", func() {
+				It("Should delete data older than the maximum age", func(ctx SpecContext) {
+					Expect(db.CreateChannel(
+						ctx,
+						cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+						cesium.Channel{
+							Key:       data,
+							Name:      "data",
+							DataType:  telem.Int64T,
+							Index:     index,
+							Retention: cesium.Retention{MaxAge: telem.Hour},
+						},
+					)).To(Succeed())
+					now := telem.Now()
+					write(ctx, 10*telem.SecondTS, 10)
+					write(ctx, now, 5)
+					Eventually(func(ctx SpecContext) int64 {
+						return read(ctx, data).Len()
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(BeEquivalentTo(5))
+					By("Trimming the index channel to the remaining data")
+					Eventually(func(ctx SpecContext) telem.TimeStamp {
+						return read(ctx, index).TimeRange().Start
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(Equal(now))
+				})
+
+				It("Should apply the policy of an index channel to its data channels", func(ctx SpecContext) {
+					Expect(db.CreateChannel(
+						ctx,
+						cesium.Channel{
+							Key:       index,
+							Name:      "time",
+							DataType:  telem.TimeStampT,
+							IsIndex:   true,
+							Retention: cesium.Retention{MaxAge: telem.Hour},
+						},
+						cesium.Channel{Key: data, Name: "data", DataType: telem.Int64T, Index: index},
+					)).To(Succeed())
+					now := telem.Now()
+					write(ctx, 10*telem.SecondTS, 10)
+					write(ctx, now, 5)
+					Eventually(func(ctx SpecContext) int64 {
+						return read(ctx, data).Len()
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(BeEquivalentTo(5))
+					Eventually(func(ctx SpecContext) int64 {
+						return read(ctx, index).Len()
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(BeEquivalentTo(5))
+				})
+
+				It("Should not delete data from the domain a writer is writing to", func(ctx SpecContext) {
+					Expect(db.CreateChannel(
+						ctx,
+						cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+						cesium.Channel{
+							Key:       data,
+							Name:      "data",
+							DataType:  telem.Int64T,
+							Index:     index,
+							Retention: cesium.Retention{MaxAge: telem.Hour},
+						},
+					)).To(Succeed())
+					write(ctx, 10*telem.SecondTS, 10)
+					w := MustSucceed(db.OpenWriter(ctx, cesium.WriterConfig{
+						Channels: []cesium.ChannelKey{index, data},
+						Start:    100 * telem.SecondTS,
+					}))
+					MustSucceed(w.Write(telem.MultiFrame(
+						[]cesium.ChannelKey{index, data},
+						[]telem.Series{
+							telem.NewSeriesSecondsTSV(100, 101, 102),
+							telem.NewSeriesV[int64](1, 2, 3),
+						},
+					)))
+					MustSucceed(w.Commit())
+					Eventually(func(ctx SpecContext) int64 {
+						return read(ctx, data).Len()
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(BeEquivalentTo(3))
+					Consistently(func(ctx SpecContext) int64 {
+						return read(ctx, data).Len()
+					}).WithContext(ctx).WithTimeout(100 * time.Millisecond).Should(BeEquivalentTo(3))
+					Expect(w.Close()).To(Succeed())
+					Eventually(func(ctx SpecContext) int64 {
+						return read(ctx, data).Len()
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(BeEquivalentTo(0))
+				})
+			})
+
+			Describe("Max Size", func() {
+				It("Should delete whole domains and then partial domains", func(ctx SpecContext) {
+					Expect(db.CreateChannel(
+						ctx,
+						cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+						cesium.Channel{
+							Key:       data,
+							Name:      "data",
+							DataType:  telem.Int64T,
+							Index:     index,
+							Retention: cesium.Retention{MaxSize: telem.Bit64.Size(15)},
+						},
+					)).To(Succeed())
+					write(ctx, 10*telem.SecondTS, 10)
+					write(ctx, 100*telem.SecondTS, 10)
+					write(ctx, 200*telem.SecondTS, 10)
+					Eventually(func(ctx SpecContext) int64 {
+						return read(ctx, data).Len()
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(BeEquivalentTo(15))
+					series := read(ctx, data)
+					Expect(series.Series).To(HaveLen(2))
+					Expect(series.Series[0]).To(telem.MatchSeriesDataV[int64](5, 6, 7, 8, 9))
+					Expect(series.TimeRange().Start).To(Equal(105 * telem.SecondTS))
+					By("Trimming the index channel to the remaining data")
+					Eventually(func(ctx SpecContext) telem.TimeStamp {
+						return read(ctx, index).TimeRange().Start
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(Equal(105 * telem.SecondTS))
+				})
+			})
+
+			Describe("SetChannelRetention", func() {
+				It("Should set and persist the retention policy of a channel", func(ctx SpecContext) {
+					Expect(db.CreateChannel(
+						ctx,
+						cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+					)).To(Succeed())
+					r := cesium.Retention{MaxAge: telem.Hour, MaxSize: telem.Megabyte}
+					Expect(db.SetChannelRetention(ctx, index, r)).To(Succeed())
+					Expect(MustSucceed(db.RetrieveChannel(ctx, index)).Retention).To(Equal(r))
+					Expect(db.Close()).To(Succeed())
+					db = openDB(ctx)
+					Expect(MustSucceed(db.RetrieveChannel(ctx, index)).Retention).To(Equal(r))
+				})
+
+				It("Should start enforcing the new policy", func(ctx SpecContext) {
+					Expect(db.CreateChannel(
+						ctx,
+						cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+						cesium.Channel{Key: data, Name: "data", DataType: telem.Int64T, Index: index},
+					)).To(Succeed())
+					write(ctx, 10*telem.SecondTS, 10)
+					Consistently(func(ctx SpecContext) int64 {
+						return read(ctx, data).Len()
+					}).WithContext(ctx).WithTimeout(50 * time.Millisecond).Should(BeEquivalentTo(10))
+					Expect(db.SetChannelRetention(ctx, data, cesium.Retention{MaxAge: telem.Hour})).To(Succeed())
+					Eventually(func(ctx SpecContext) int64 {
+						return read(ctx, data).Len()
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(BeEquivalentTo(0))
+					Eventually(func(ctx SpecContext) int64 {
+						return read(ctx, index).Len()
+					}).WithContext(ctx).WithTimeout(retentionTimeout).Should(BeEquivalentTo(0))
+				})
+
+				It("Should return an error for a virtual channel", func(ctx SpecContext) {
+					Expect(db.CreateChannel(
+						ctx,
+						cesium.Channel{Key: data, Name: "virtual", DataType: telem.Int64T, Virtual: true},
+					)).To(Succeed())
+					Expect(db.SetChannelRetention(ctx, data, cesium.Retention{MaxAge: telem.Hour})).
+						To(MatchError(validate.ErrValidation))
+				})
+
+				It("Should return an error for a negative policy", func(ctx SpecContext) {
+					Expect(db.CreateChannel(
+						ctx,
+						cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+					)).To(Succeed())
+					Expect(db.SetChannelRetention(ctx, index, cesium.Retention{MaxAge: -telem.Hour})).
+						To(MatchError(ContainSubstring("max_age")))
+				})
+
+				It("Should return an error if the channel does not exist", func(ctx SpecContext) {
+					Expect(db.SetChannelRetention(ctx, index, cesium.Retention{MaxAge: telem.Hour})).
+						To(MatchError(cesium.ErrChannelNotFound))
+				})
+			})
+		})
+	}
+})
@@ -1401,7 +1401,7 @@ var _ = Describe("Writer Behavior", func() {
 								By("Asserting that the telemetry has been persisted")
 								f := MustSucceed(fs.Open(channelKeyToPath(index1)+"/index.domain", os.O_RDONLY))
 								buf := make([]byte, 26)
-								MustSucceed(f.ReadAt(buf, 0))
+								MustSucceed(f.ReadAt(buf, indexHeaderSize))
 								Expect(f.Close()).To(Succeed())
 								Expect(binary.LittleEndian.Uint64(buf[0:8])).To(Equal(uint64(10 * telem.SecondTS)))
 								Expect(binary.LittleEndian.Uint64(buf[8:16])).To(Equal(uint64(12*telem.SecondTS + 1)))
@@ -1409,7 +1409,7 @@ var _ = Describe("Writer Behavior", func() {
 
 								f = MustSucceed(fs.Open(channelKeyToPath(basic1)+"/index.domain", os.O_RDONLY))
 								buf = make([]byte, 26)
-								MustSucceed(f.ReadAt(buf, 0))
+								MustSucceed(f.ReadAt(buf, indexHeaderSize))
 								Expect(f.Close()).To(Succeed())
 								Expect(binary.LittleEndian.Uint64(buf[0:8])).To(Equal(uint64(10 * telem.SecondTS)))
 								Expect(binary.LittleEndian.Uint64(buf[8:16])).To(Equal(uint64(12*telem.SecondTS + 1)))
@@ -1436,9 +1436,9 @@ var _ = Describe("Writer Behavior", func() {
 
 								By("Checking that this telemetry is not persisted")
 								s := MustSucceed(fs.Stat(channelKeyToPath(index1) + "/index.domain"))
-								Expect(s.Size()).To(Equal(int64(0)))
+								Expect(s.Size()).To(Equal(int64(indexHeaderSize)))
 								s = MustSucceed(fs.Stat(channelKeyToPath(basic1) + "/index.domain"))
-								Expect(s.Size()).To(Equal(int64(0)))
+								Expect(s.Size()).To(Equal(int64(indexHeaderSize)))
 
 								By("Sleeping to wait for the threshold to be met")
 								time.Sleep(time.Duration(1000 * telem.Millisecond))
@@ -1456,7 +1456,7 @@ var _ = Describe("Writer Behavior", func() {
 								Eventually(func(g Gomega) {
 									f := MustSucceed(fs.Open(channelKeyToPath(index1)+"/index.domain", os.O_RDONLY))
 									buf := make([]byte, 26)
-									_, err := f.Read(buf)
+									_, err := f.ReadAt(buf, indexHeaderSize)
 									g.Expect(err).ToNot(HaveOccurred())
 									g.Expect(f.Close()).To(Succeed())
 									g.Expect(binary.LittleEndian.Uint64(buf[0:8])).To(Equal(uint64(10 * telem.SecondTS)))
@@ -1465,7 +1465,7 @@ var _ = Describe("Writer Behavior", func() {
 
 									f = MustSucceed(fs.Open(channelKeyToPath(basic1)+"/index.domain", os.O_RDONLY))
 									buf = make([]byte, 26)
-									_, err = f.Read(buf)
+									_, err = f.ReadAt(buf, indexHeaderSize)
 									g.Expect(err).ToNot(HaveOccurred())
 									g.Expect(f.Close()).To(Succeed())
 									g.Expect(binary.LittleEndian.Uint64(buf[0:8])).To(Equal(uint64(10 * telem.SecondTS)))
@@ -1487,14 +1487,14 @@ var _ = Describe("Writer Behavior", func() {
 
 								f := MustSucceed(fs.Open(channelKeyToPath(index1)+"/index.domain", os.O_RDONLY))
 								buf := make([]byte, 26)
-								MustSucceed(f.Read(buf))
+								MustSucceed(f.ReadAt(buf, indexHeaderSize))
 								Expect(f.Close()).To(Succeed())
 								Expect(binary.LittleEndian.Uint64(buf[8:16])).To(Equal(uint64(33*telem.SecondTS + 1)))
 								Expect(binary.LittleEndian.Uint32(buf[22:26])).To(Equal(uint32(80)))
 
 								f = MustSucceed(fs.Open(channelKeyToPath(basic1)+"/index.domain", os.O_RDONLY))
 								buf = make([]byte, 26)
-								MustSucceed(f.Read(buf))
+								MustSucceed(f.ReadAt(buf, indexHeaderSize))
 								Expect(f.Close()).To(Succeed())
 								Expect(binary.LittleEndian.Uint64(buf[8:16])).To(Equal(uint64(33*telem.SecondTS + 1)))
 								Expect(binary.LittleEndian.Uint32(buf[22:26])).To(Equal(uint32(80)))
@@ -1520,9 +1520,9 @@ var _ = Describe("Writer Behavior", func() {
 
 								By("Checking that this telemetry is not persisted")
 								s := MustSucceed(fs.Stat(channelKeyToPath(index1) + "/index.domain"))
-								Expect(s.Size()).To(Equal(int64(0)))
+								Expect(s.Size()).To(Equal(int64(indexHeaderSize)))
 								s = MustSucceed(fs.Stat(channelKeyToPath(basic1) + "/index.domain"))
-								Expect(s.Size()).To(Equal(int64(0)))
+								Expect(s.Size()).To(Equal(int64(indexHeaderSize)))
 
 								By("Sleeping to wait for the threshold to be met")
 								time.Sleep(time.Duration(200 * telem.Millisecond))
@@ -1540,7 +1540,7 @@ var _ = Describe("Writer Behavior", func() {
 								Eventually(func(g Gomega) {
 									f := MustSucceed(fs.Open(channelKeyToPath(index1)+"/index.domain", os.O_RDONLY))
 									buf := make([]byte, 26)
-									_, err := f.ReadAt(buf, 0)
+									_, err := f.ReadAt(buf, indexHeaderSize)
 									g.Expect(err).ToNot(HaveOccurred())
 									g.Expect(f.Close()).To(Succeed())
 									g.Expect(binary.LittleEndian.Uint64(buf[0:8])).To(Equal(uint64(10 * telem.SecondTS)))
@@ -1549,7 +1549,7 @@ var _ = Describe("Writer Behavior", func() {
 
 									f = MustSucceed(fs.Open(channelKeyToPath(basic1)+"/index.domain", os.O_RDONLY))
 									buf = make([]byte, 26)
-									_, err = f.ReadAt(buf, 0)
+									_, err = f.ReadAt(buf, indexHeaderSize)
 									g.Expect(err).ToNot(HaveOccurred())
 									g.Expect(f.Close()).To(Succeed())
 									g.Expect(binary.LittleEndian.Uint64(buf[0:8])).To(Equal(uint64(10 * telem.SecondTS)))
@@ -1588,7 +1588,7 @@ var _ = Describe("Writer Behavior", func() {
 								Eventually(func(g Gomega) {
 									f := MustSucceed(fs.Open(channelKeyToPath(index1)+"/index.domain", os.O_RDONLY))
 									buf := make([]byte, 26)
-									_, err := f.Read(buf)
+									_, err := f.ReadAt(buf, indexHeaderSize)
 									g.Expect(err).ToNot(HaveOccurred())
 									g.Expect(f.Close()).To(Succeed())
 									g.Expect(binary.LittleEndian.Uint64(buf[0:8])).To(Equal(uint64(10 * telem.SecondTS)))
@@ -1597,7 +1597,7 @@ var _ = Describe("Writer Behavior", func() {
 
 									f = MustSucceed(fs.Open(channelKeyToPath(basic1)+"/index.domain", os.O_RDONLY))
 									buf = make([]byte, 26)
-									_, err = f.ReadAt(buf, 0)
+									_, err = f.ReadAt(buf, indexHeaderSize)
 									g.Expect(err).ToNot(HaveOccurred())
 									g.Expect(f.Close()).To(Succeed())
 									g.Expect(binary.LittleEndian.Uint64(buf[0:8])).To(Equal(uint64(10 * telem.SecondTS)))
@@ -58,6 +58,10 @@ resource_type_to_pb(const std::string &cpp) {
             {RESOURCE_TYPE_VIEW, ::distribution::ontology::pb::RESOURCE_TYPE_VIEW},
             {RESOURCE_TYPE_WORKSPACE,
              ::distribution::ontology::pb::RESOURCE_TYPE_WORKSPACE},
+            {RESOURCE_TYPE_ARC_LIBRARY,
+             ::distribution::ontology::pb::RESOURCE_TYPE_ARC_LIBRARY},
+            {RESOURCE_TYPE_AUDIT, ::distribution::ontology::pb::RESOURCE_TYPE_AUDIT},
+            {RESOURCE_TYPE_BACKUP, ::distribution::ontology::pb::RESOURCE_TYPE_BACKUP},
         };
     auto it = kMap.find(cpp);
     if (it == kMap.end())
@@ -114,6 +118,12 @@ resource_type_from_pb(::distribution::ontology::pb::ResourceType pb) {
             return {RESOURCE_TYPE_VIEW, x::errors::NIL};
         case ::distribution::ontology::pb::RESOURCE_TYPE_WORKSPACE:
             return {RESOURCE_TYPE_WORKSPACE, x::errors::NIL};
+        case ::distribution::ontology::pb::RESOURCE_TYPE_ARC_LIBRARY:
+            return {RESOURCE_TYPE_ARC_LIBRARY, x::errors::NIL};
+        case ::distribution::ontology::pb::RESOURCE_TYPE_AUDIT:
+            return {RESOURCE_TYPE_AUDIT, x::errors::NIL};
+        case ::distribution::ontology::pb::RESOURCE_TYPE_BACKUP:
+            return {RESOURCE_TYPE_BACKUP, x::errors::NIL};
         default:
             return {"", x::errors::Error("unrecognized ResourceType protobuf value")};
     }
@@ -36,4 +36,7 @@ constexpr const char *RESOURCE_TYPE_TASK = "task";
 constexpr const char *RESOURCE_TYPE_USER = "user";
 constexpr const char *RESOURCE_TYPE_VIEW = "view";
 constexpr const char *RESOURCE_TYPE_WORKSPACE = "workspace";
+constexpr const char *RESOURCE_TYPE_ARC_LIBRARY = "arc_library";
+constexpr const char *RESOURCE_TYPE_AUDIT = "audit";
+constexpr const char *RESOURCE_TYPE_BACKUP = "backup";
 }
@@ -58,6 +58,9 @@ RESOURCE_TYPE_USER: Literal["user"] = "user"
 RESOURCE_TYPE_VIEW: Literal["view"] = "view"
 
 RESOURCE_TYPE_WORKSPACE: Literal["workspace"] = "workspace"
+RESOURCE_TYPE_ARC_LIBRARY: Literal["arc_library"] = "arc_library"
+RESOURCE_TYPE_AUDIT: Literal["audit"] = "audit"
+RESOURCE_TYPE_BACKUP: Literal["backup"] = "backup"
 
 
 ResourceType = Literal[
@@ -84,4 +87,7 @@ ResourceType = Literal[
     "user",
     "view",
     "workspace",
+    "arc_library",
+    "audit",
+    "backup",
 ]
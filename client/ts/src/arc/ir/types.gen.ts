@@ -80,6 +80,23 @@ export const authoritiesZ = z.object({
 });
 export interface Authorities extends z.infer<typeof authoritiesZ> {}
 
+/**
+ * Interlock holds a guarded channel at its safe value while another channel's
+ * value satisfies a comparison.
+ */
+export const interlockZ = z.object({
+  /** channel is the key of the channel whose value the interlock conditions on. */
+  channel: z.uint32(),
+  /**
+   * comparison is the operator comparing the channel's value to value: one of <,
+   * <=, >, >=, == or !=.
+   */
+  comparison: z.string(),
+  /** value is the threshold the channel's value is compared to. */
+  value: z.number(),
+});
+export interface Interlock extends z.infer<typeof interlockZ> {}
+
 /** Edge is a dataflow connection between node parameters in the Arc graph. */
 export const edgeZ = z.object({
   /** source is the source node parameter producing data. */
@@ -126,12 +143,51 @@ export interface Function extends z.infer<typeof functionZ> {}
 export const nodesZ = array.nullishToEmpty(nodeZ);
 export type Nodes = z.infer<typeof nodesZ>;
 
+/**
+ * Envelope is the safety envelope enforced on writes to an output channel of an
+ * Arc program.
+ */
+export const envelopeZ = z.object({
+  /** channel is the key of the guarded channel. */
+  channel: z.uint32(),
+  /** min is the smallest value that may be written to the channel. */
+  min: z.number().optional(),
+  /** max is the largest value that may be written to the channel. */
+  max: z.number().optional(),
+  /**
+   * maxRate is the largest change in the channel's value per second between
+   * consecutive writes.
+   */
+  maxRate: z.number().optional(),
+  /**
+   * interlocks hold the channel at safe_value while any of their conditions are
+   * satisfied.
+   */
+  interlocks: array.nullishToEmpty(interlockZ),
+  /**
+   * safeValue is the value the channel is held at while interlocked. Zero when
+   * unset.
+   */
+  safeValue: z.number().optional(),
+  /**
+   * safeSequence is the sequence containing safe_stage. Empty when a violation does
+   * not trigger a stage.
+   */
+  safeSequence: z.string(),
+  /** safeStage is the stage entered when a write violates the envelope. */
+  safeStage: z.string(),
+});
+export interface Envelope extends z.infer<typeof envelopeZ> {}
+
 export const edgesZ = array.nullishToEmpty(edgeZ);
 export type Edges = z.infer<typeof edgesZ>;
 
 export const functionsZ = array.nullishToEmpty(functionZ);
 export type Functions = z.infer<typeof functionsZ>;
 
+export const safetyZ = array.nullishToEmpty(envelopeZ);
+export type Safety = z.infer<typeof safetyZ>;
+
 /**
  * Member is a tagged union representing a single child of a Scope. Exactly one
  * of nodeKey or scope is set. The member's lookup key (used as the
@@ -213,6 +269,8 @@ export const irZ = z.object({
    * reactive flow with top-level gated scopes.
    */
   root: scopeZ,
+  /** safety contains the safety envelopes declared by this program. */
+  safety: safetyZ,
 });
 export interface IR extends z.infer<typeof irZ> {}
 
@@ -35,6 +35,7 @@ export enum Kind {
   function = 21,
   sequence = 22,
   stage = 23,
+  structure = 24,
 }
 export const kindZ = z.enum(Kind);
 
@@ -114,11 +115,19 @@ export const typeZ = functionPropertiesZ.extend({
     return typeZ.optional();
   },
   chanDirection: chanDirectionZ.optional(),
+  get fields(): z.ZodOptional<typeof paramsZ> {
+    return paramsZ.optional();
+  },
 });
 export interface Type extends z.infer<typeof typeZ> {}
 
 /** Param is a named, typed parameter with optional default value. */
-export const paramZ = z.object({
+export interface Param {
+  name: string;
+  type: Type;
+  value?: unknown;
+}
+export const paramZ: z.ZodType<Param> = z.object({
   /** name is the parameter name. */
   name: z.string(),
   /** type is the parameter type. */
@@ -126,7 +135,6 @@ export const paramZ = z.object({
   /** value is an optional default value. */
   value: z.unknown().optional(),
 });
-export interface Param extends z.infer<typeof paramZ> {}
 
 export const paramsZ = array.nullishToEmpty(paramZ);
 export type Params = z.infer<typeof paramsZ>;
@@ -35,6 +35,9 @@ export const RESOURCE_TYPES = [
   "user",
   "view",
   "workspace",
+  "arc_library",
+  "audit",
+  "backup",
 ] as const;
 export const resourceTypeZ = z.enum(RESOURCE_TYPES);
 export type ResourceType = z.infer<typeof resourceTypeZ>;
@@ -0,0 +1,165 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package backup
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/freighter"
+	fhttp "github.com/synnaxlabs/freighter/http"
+	"github.com/synnaxlabs/synnax/cmd/cert"
+	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/api/backup"
+	seccert "github.com/synnaxlabs/synnax/pkg/security/cert"
+	"github.com/synnaxlabs/x/address"
+	"github.com/synnaxlabs/x/encoding/msgpack"
+	"github.com/synnaxlabs/x/errors"
+	"go.uber.org/zap"
+)
+
+// Config is the configuration for backing up a running Core.
+type Config struct {
+	alamos.Instrumentation
+	// Host is the address of the Core to back up.
+	Host address.Address
+	// Credentials are used to authenticate with the Core.
+	Credentials auth.Credentials
+	// Insecure sets whether to connect to the Core without TLS.
+	Insecure bool
+}
+
+// Create backs up the Core at cfg.Host and writes the archive to the file at path.
+// The archive is written to a temporary file that is only moved to path once the
+// backup completes, so a failed backup never leaves a partial archive behind.
+func Create(ctx context.Context, path string, cfg Config) (err error) {
+	var tlsCfg *tls.Config
+	if !cfg.Insecure {
+		if tlsCfg, err = buildTLS(cfg.Instrumentation); err != nil {
+			return err
+		}
+	}
+	token, err := login(ctx, cfg, tlsCfg)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Combine(err, os.Remove(f.Name()))
+		}
+	}()
+	cfg.L.Info("backing up core", zap.Stringer("host", cfg.Host))
+	if err = stream(ctx, cfg, tlsCfg, token, f); err != nil {
+		return errors.Combine(err, f.Close())
+	}
+	if err = errors.Combine(f.Sync(), f.Close()); err != nil {
+		return err
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		return err
+	}
+	cfg.L.Info("backup complete", zap.String("path", path))
+	return nil
+}
+
+func login(ctx context.Context, cfg Config, tlsCfg *tls.Config) (string, error) {
+	client, err := fhttp.NewUnaryClient[auth.LoginRequest, auth.LoginResponse](
+		fhttp.UnaryClientConfig{TLS: tlsCfg},
+	)
+	if err != nil {
+		return "", err
+	}
+	res, err := client.Send(
+		ctx,
+		cfg.Host+"/api/v1/auth/login",
+		auth.LoginRequest{Credentials: cfg.Credentials},
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to authenticate with the core")
+	}
+	return res.Token, nil
+}
+
+func stream(
+	ctx context.Context,
+	cfg Config,
+	tlsCfg *tls.Config,
+	token string,
+	w io.Writer,
+) error {
+	client, err := fhttp.NewStreamClient[backup.Request, backup.Response](
+		fhttp.StreamClientConfig{Codec: msgpack.Codec, TLS: tlsCfg},
+	)
+	if err != nil {
+		return err
+	}
+	client.Use(freighter.MiddlewareFunc(func(
+		ctx freighter.Context,
+		next freighter.Next,
+	) (freighter.Context, error) {
+		ctx.Set(fiber.HeaderAuthorization, "Bearer "+token)
+		return next(ctx)
+	}))
+	s, err := client.Stream(ctx, cfg.Host+"/api/v1/backup/create")
+	if err != nil {
+		return err
+	}
+	if err = s.Send(backup.Request{}); err != nil {
+		return err
+	}
+	if err = s.CloseSend(); err != nil {
+		return err
+	}
+	for {
+		res, err := s.Receive()
+		if errors.Is(err, freighter.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(res.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// buildTLS builds a TLS configuration that trusts the CA certificates in the certs
+// directory. If no CA certificate is found, the system's root CAs are used instead.
+func buildTLS(ins alamos.Instrumentation) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS13}
+	loader, err := seccert.NewLoader(cert.BuildLoaderConfig(ins))
+	if err != nil {
+		return nil, err
+	}
+	cas, err := loader.LoadCAs()
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cfg.RootCAs = x509.NewCertPool()
+	for _, ca := range cas {
+		cfg.RootCAs.AddCert(ca)
+	}
+	return cfg, nil
+}
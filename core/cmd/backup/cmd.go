@@ -0,0 +1,50 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+package backup
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/synnaxlabs/synnax/cmd/instrumentation"
+	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/x/address"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "backup <archive>",
+	Short: "Back up a running Synnax Core to an archive",
+	Long: `Back up a running Synnax Core to an archive.
+
+Takes a consistent snapshot of the Core's key-value store and time-series data
+while it continues to accept writes, and writes it to the given archive file.
+Run 'synnax restore' to extract the archive into a data directory that a new
+Core can be started from.`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ins := instrumentation.Configure()
+		defer instrumentation.Cleanup(cmd.Context(), ins)
+		return Create(cmd.Context(), args[0], Config{
+			Instrumentation: ins,
+			Host:            address.Address(viper.GetString(FlagHost)),
+			Credentials: auth.Credentials{
+				Username: viper.GetString(FlagUsername),
+				Password: viper.GetString(FlagPassword),
+			},
+			Insecure: viper.GetBool(FlagInsecure),
+		})
+	},
+}
+
+func init() {
+	AddFlags(Cmd)
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+package backup
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/synnaxlabs/synnax/cmd/cert"
+)
+
+// Flag names used for backing up a Synnax Core. FlagUsername, FlagPassword, and
+// FlagInsecure share their names with the start command's flags so that both read the
+// same configuration values.
+const (
+	FlagHost     = "host"
+	FlagUsername = "username"
+	FlagPassword = "password"
+	FlagInsecure = "insecure"
+)
+
+// AddFlags adds the backup flags to the given command.
+func AddFlags(cmd *cobra.Command) {
+	cert.AddFlags(cmd)
+	cmd.Flags().String(
+		FlagHost,
+		"localhost:9090",
+		"The address of the Core to back up",
+	)
+	cmd.Flags().String(FlagUsername, "synnax", "Username to authenticate with")
+	cmd.Flags().String(FlagPassword, "seldon", "Password to authenticate with")
+	cmd.Flags().BoolP(
+		FlagInsecure,
+		"i",
+		false,
+		"Connect to the Core without TLS",
+	)
+}
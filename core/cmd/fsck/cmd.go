@@ -0,0 +1,104 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package fsck
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/synnaxlabs/synnax/cmd/instrumentation"
+	"github.com/synnaxlabs/synnax/pkg/storage"
+	"github.com/synnaxlabs/x/errors"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "fsck",
+	Short: "Check a Synnax Core data directory for corruption",
+	Long: `Check a Synnax Core data directory for corruption.
+
+Verifies the key-value store and every domain of time-series data against the
+checksums recorded when they were written. The Core must not be running. When
+--repair is set, corrupt time-series data is removed so that the Core can start
+again. The key-value store cannot be repaired.`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cmd.SilenceUsage = true
+		ins := instrumentation.Configure()
+		defer instrumentation.Cleanup(cmd.Context(), ins)
+		repair := viper.GetBool(FlagRepair)
+		report, err := storage.Check(storage.CheckConfig{
+			Instrumentation: ins,
+			Dirname:         viper.GetString(FlagData),
+			Repair:          &repair,
+		})
+		if err != nil {
+			return err
+		}
+		if err = printReport(cmd.OutOrStdout(), report); err != nil {
+			return err
+		}
+		if !report.KV.Healthy() {
+			return errors.New("key-value store is corrupt")
+		}
+		if !report.TS.Healthy() && !repair {
+			return errors.New("time-series data is corrupt. run with --repair to remove it")
+		}
+		return nil
+	},
+}
+
+func init() {
+	AddFlags(Cmd)
+}
+
+func printReport(w io.Writer, report storage.CheckReport) (err error) {
+	p := func(format string, args ...any) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format+"\n", args...)
+		}
+	}
+	if report.KV.Healthy() {
+		p("key-value store: ok (%d keys)", report.KV.Points)
+	} else {
+		p("key-value store: corrupt: %v", report.KV.Err)
+	}
+	var domains, unverified, corrupt int
+	for _, ch := range report.TS.Channels {
+		domains += ch.Domains
+		unverified += ch.Unverified
+		if ch.Healthy() {
+			continue
+		}
+		corrupt++
+		var suffix string
+		if ch.Repaired {
+			suffix = " (removed)"
+		}
+		if ch.CorruptRecords > 0 {
+			p("channel %d: %d corrupt index records%s", ch.Key, ch.CorruptRecords, suffix)
+		}
+		for _, d := range ch.Corrupt {
+			p("channel %d: %v%s", ch.Key, d.Err, suffix)
+		}
+	}
+	p(
+		"time-series data: %d of %d channels corrupt (%d domains checked, %d without checksums)",
+		corrupt,
+		len(report.TS.Channels),
+		domains,
+		unverified,
+	)
+	return err
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package fsck
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/synnaxlabs/synnax/cmd/instrumentation"
+)
+
+// Flag names used for checking a storage directory. FlagData shares its name with the
+// start command's flag so that both read the same configuration value.
+const (
+	FlagData   = "data"
+	FlagRepair = "repair"
+)
+
+// AddFlags adds the fsck flags to the given command.
+func AddFlags(cmd *cobra.Command) {
+	instrumentation.AddFlags(cmd)
+	cmd.Flags().StringP(
+		FlagData,
+		"d",
+		"synnax-data",
+		"Directory where the Core stores its data",
+	)
+	cmd.Flags().Bool(
+		FlagRepair,
+		false,
+		"Remove corrupt time-series data so that the Core can start",
+	)
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+package restore
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/synnaxlabs/synnax/cmd/instrumentation"
+	"github.com/synnaxlabs/synnax/pkg/storage"
+	"github.com/synnaxlabs/x/errors"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "restore <archive>",
+	Short: "Restore a Synnax Core data directory from a backup archive",
+	Long: `Restore a Synnax Core data directory from a backup archive.
+
+Extracts an archive created by 'synnax backup' into the data directory, which
+must be empty or not exist. A Core can then be started from the directory with
+'synnax start --data <dir>'.`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		cmd.SilenceUsage = true
+		ins := instrumentation.Configure()
+		defer instrumentation.Cleanup(cmd.Context(), ins)
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer func() { err = errors.Combine(err, f.Close()) }()
+		return storage.Restore(f, storage.RestoreConfig{
+			Instrumentation: ins,
+			Dirname:         viper.GetString(FlagData),
+		})
+	},
+}
+
+func init() {
+	AddFlags(Cmd)
+}
@@ -0,0 +1,29 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+package restore
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/synnaxlabs/synnax/cmd/instrumentation"
+)
+
+// Flag names used for restoring a backup. FlagData shares its name with the start
+// command's flag so that both read the same configuration value.
+const FlagData = "data"
+
+// AddFlags adds the restore flags to the given command.
+func AddFlags(cmd *cobra.Command) {
+	instrumentation.AddFlags(cmd)
+	cmd.Flags().StringP(
+		FlagData,
+		"d",
+		"synnax-data",
+		"Directory to restore the Core's data into",
+	)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/synnaxlabs/synnax/cmd/cert"
 	"github.com/synnaxlabs/synnax/cmd/instrumentation"
 	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/oidc"
 	"github.com/synnaxlabs/x/address"
 	"github.com/synnaxlabs/x/errors"
 	xsignal "github.com/synnaxlabs/x/signal"
@@ -126,5 +127,17 @@ func GetCoreConfigFromViper(ins alamos.Instrumentation) CoreConfig {
 		enabledIntegrations:  viper.GetStringSlice(FlagEnableIntegrations),
 		disabledIntegrations: viper.GetStringSlice(FlagDisableIntegrations),
 		validateChannelNames: new(!viper.GetBool(FlagDisableChannelNameValidation)),
+		metricsChannels:      viper.GetStringSlice(FlagMetricsChannels),
+		oidc: oidc.ServiceConfig{
+			Issuer:            viper.GetString(FlagOIDCIssuer),
+			ClientID:          viper.GetString(FlagOIDCClientID),
+			ClientSecret:      viper.GetString(FlagOIDCClientSecret),
+			RedirectURL:       viper.GetString(FlagOIDCRedirectURL),
+			Scopes:            viper.GetStringSlice(FlagOIDCScopes),
+			UsernameClaim:     viper.GetString(FlagOIDCUsernameClaim),
+			LinkExistingUsers: new(viper.GetBool(FlagOIDCLinkExistingUsers)),
+			GroupsClaim:       viper.GetString(FlagOIDCGroupsClaim),
+			RoleMappings:      viper.GetStringMapString(FlagOIDCRoleMappings),
+		},
 	}
 }
@@ -36,6 +36,16 @@ const (
 	FlagTaskShutdownTimeout          = "task-shutdown-timeout"
 	FlagTaskWorkerCount              = "task-worker-count"
 	FlagDisableChannelNameValidation = "disable-channel-name-validation"
+	FlagMetricsChannels              = "metrics-channels"
+	FlagOIDCIssuer                   = "oidc-issuer"
+	FlagOIDCClientID                 = "oidc-client-id"
+	FlagOIDCClientSecret             = "oidc-client-secret"
+	FlagOIDCRedirectURL              = "oidc-redirect-url"
+	FlagOIDCScopes                   = "oidc-scopes"
+	FlagOIDCUsernameClaim            = "oidc-username-claim"
+	FlagOIDCLinkExistingUsers        = "oidc-link-existing-users"
+	FlagOIDCGroupsClaim              = "oidc-groups-claim"
+	FlagOIDCRoleMappings             = "oidc-role-mappings"
 )
 
 // AddFlags adds the start flags to the given command.
@@ -114,6 +124,54 @@ func AddFlags(cmd *cobra.Command) {
 		false,
 		"Disable channel name validation (allows special characters, spaces, etc.)",
 	)
+	cmd.Flags().StringSlice(
+		FlagMetricsChannels,
+		nil,
+		"Names of channels whose latest values are exported on the /metrics endpoint. "+
+			"When set, scrapes of /metrics must authenticate with an API key or session "+
+			"token, as the endpoint exposes telemetry",
+	)
+	cmd.Flags().String(
+		FlagOIDCIssuer,
+		"",
+		"URL of the OpenID Connect provider to enable single sign-on with",
+	)
+	cmd.Flags().String(FlagOIDCClientID, "", "Client ID registered with the OpenID Connect provider")
+	cmd.Flags().String(
+		FlagOIDCClientSecret,
+		"",
+		"Client secret registered with the OpenID Connect provider",
+	)
+	cmd.Flags().String(
+		FlagOIDCRedirectURL,
+		"",
+		"URL the OpenID Connect provider redirects users to after they sign in",
+	)
+	cmd.Flags().StringSlice(
+		FlagOIDCScopes,
+		nil,
+		"Scopes to request from the OpenID Connect provider (default openid,profile,email)",
+	)
+	cmd.Flags().String(
+		FlagOIDCUsernameClaim,
+		"",
+		"ID token claim used as the username of single sign-on users (default preferred_username)",
+	)
+	cmd.Flags().Bool(
+		FlagOIDCLinkExistingUsers,
+		false,
+		"Link new single sign-on users to existing users with the same username (unsafe unless usernames at the provider are unique and immutable)",
+	)
+	cmd.Flags().String(
+		FlagOIDCGroupsClaim,
+		"",
+		"ID token claim listing the groups of single sign-on users (default groups)",
+	)
+	cmd.Flags().StringToString(
+		FlagOIDCRoleMappings,
+		nil,
+		"Roles assigned to members of OpenID Connect provider groups, as group=role pairs",
+	)
 	cmd.Flags().String(FlagDecoded, "", usage)
 }
 
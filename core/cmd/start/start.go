@@ -25,6 +25,7 @@ import (
 	"github.com/synnaxlabs/freighter/http"
 	cmdcert "github.com/synnaxlabs/synnax/cmd/cert"
 	"github.com/synnaxlabs/synnax/pkg/api"
+	apiauth "github.com/synnaxlabs/synnax/pkg/api/auth"
 	"github.com/synnaxlabs/synnax/pkg/console"
 	"github.com/synnaxlabs/synnax/pkg/distribution"
 	channeltransport "github.com/synnaxlabs/synnax/pkg/distribution/transport/grpc/channel"
@@ -35,6 +36,8 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/server"
 	"github.com/synnaxlabs/synnax/pkg/service"
 	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/oidc"
+	"github.com/synnaxlabs/synnax/pkg/service/metrics/openmetrics"
 	"github.com/synnaxlabs/synnax/pkg/storage"
 	"github.com/synnaxlabs/synnax/pkg/transport"
 	"github.com/synnaxlabs/synnax/pkg/version"
@@ -65,6 +68,8 @@ type CoreConfig struct {
 	peers                []address.Address
 	disabledIntegrations []string
 	enabledIntegrations  []string
+	metricsChannels      []string
+	oidc                 oidc.ServiceConfig
 	certFactoryConfig    cert.FactoryConfig
 	taskShutdownTimeout  time.Duration
 	taskPollInterval     time.Duration
@@ -121,6 +126,8 @@ func (c CoreConfig) Override(other CoreConfig) CoreConfig {
 		enabledIntegrations:  override.Slice(c.enabledIntegrations, other.enabledIntegrations),
 		disabledIntegrations: override.Slice(c.disabledIntegrations, other.disabledIntegrations),
 		validateChannelNames: override.Nil(c.validateChannelNames, other.validateChannelNames),
+		metricsChannels:      override.Slice(c.metricsChannels, other.metricsChannels),
+		oidc:                 c.oidc.Override(other.oidc),
 	}
 }
 
@@ -163,6 +170,7 @@ func BootupCore(ctx context.Context, onServerStarted chan struct{}, cfgs ...Core
 		transportLayer    transport.Layer
 		rootServer        *server.Server
 		embeddedDriver    *driver.Driver
+		metricsExporter   *openmetrics.Exporter
 	)
 	cleanup, ok := xservice.NewOpener(ctx, &closer)
 	defer func() {
@@ -229,6 +237,7 @@ func BootupCore(ctx context.Context, onServerStarted chan struct{}, cfgs ...Core
 		Security:        securityProvider,
 		Storage:         storageLayer,
 		RootCredentials: cfg.rootCredentials,
+		OIDC:            cfg.oidc,
 	}); !ok(err, serviceLayer) {
 		return err
 	}
@@ -237,6 +246,7 @@ func BootupCore(ctx context.Context, onServerStarted chan struct{}, cfgs ...Core
 		Instrumentation: cfg.Child("api"),
 		Service:         serviceLayer,
 		Distribution:    distributionLayer,
+		Storage:         storageLayer,
 	}
 	if apiLayer, err = api.NewLayer(apiCfg); !ok(err, nil) {
 		return err
@@ -259,6 +269,25 @@ func BootupCore(ctx context.Context, onServerStarted chan struct{}, cfgs ...Core
 		return err
 	}
 
+	if metricsExporter, err = openmetrics.Open(ctx, openmetrics.Config{
+		Instrumentation: cfg.Child("openmetrics"),
+		Storage:         storageLayer,
+		Cluster:         distributionLayer.Cluster,
+		Framer:          serviceLayer.Framer,
+		Channel:         serviceLayer.Channel,
+		Task:            serviceLayer.Task,
+		Status:          serviceLayer.Status,
+		Channels:        cfg.metricsChannels,
+		Collectors:      []openmetrics.Collector{apiLayer.Framer},
+		Auth: apiauth.TokenMiddleware(
+			serviceLayer.Token,
+			serviceLayer.Session,
+			serviceLayer.APIKey,
+		),
+	}); !ok(err, metricsExporter) {
+		return err
+	}
+
 	var embeddedConsole *console.Console
 	if embeddedConsole, err = console.New(); !ok(err, nil) {
 		return err
@@ -268,7 +297,11 @@ func BootupCore(ctx context.Context, onServerStarted chan struct{}, cfgs ...Core
 		server.Config{
 			Branches: []server.Branch{
 				&server.SecureHTTPBranch{
-					Transports: []http.BindableTransport{r, embeddedConsole},
+					Transports: []http.BindableTransport{
+						r,
+						metricsExporter,
+						embeddedConsole,
+					},
 				},
 				&server.GRPCBranch{Transports: slices.Concat(
 					transportLayer.GRPC,
@@ -0,0 +1,285 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package apikey
+
+import (
+	"context"
+	"go/types"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/api/config"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/access"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/apikey"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	xconfig "github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/query"
+)
+
+// Service is the API for managing service accounts and their API keys.
+type Service struct {
+	db       *gorp.DB
+	access   *rbac.Service
+	internal *apikey.Service
+}
+
+// NewService creates a new Service for managing service accounts and their API keys.
+func NewService(cfgs ...config.LayerConfig) (*Service, error) {
+	cfg, err := xconfig.New(config.DefaultLayerConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		db:       cfg.Distribution.DB,
+		access:   cfg.Service.RBAC,
+		internal: cfg.Service.APIKey,
+	}, nil
+}
+
+// NewServiceAccount is the create-request payload for a single service account.
+type NewServiceAccount struct {
+	Username    string   `json:"username" msgpack:"username"`
+	Description string   `json:"description" msgpack:"description"`
+	Key         user.Key `json:"key" msgpack:"key"`
+}
+
+type (
+	ServiceAccountCreateRequest struct {
+		ServiceAccounts []NewServiceAccount `json:"service_accounts" msgpack:"service_accounts"`
+	}
+	ServiceAccountCreateResponse struct {
+		Users           []user.User             `json:"users" msgpack:"users"`
+		ServiceAccounts []apikey.ServiceAccount `json:"service_accounts" msgpack:"service_accounts"`
+	}
+)
+
+// CreateServiceAccount creates the service accounts in the request, along with the
+// users backing them.
+func (s *Service) CreateServiceAccount(
+	ctx context.Context,
+	req ServiceAccountCreateRequest,
+) (ServiceAccountCreateResponse, error) {
+	if err := s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionCreate,
+		Objects: []ontology.ID{{Type: ontology.ResourceTypeUser}},
+	}); err != nil {
+		return ServiceAccountCreateResponse{}, err
+	}
+	var res ServiceAccountCreateResponse
+	if err := s.db.WithTx(ctx, func(tx gorp.Tx) error {
+		w := s.internal.NewWriter(tx)
+		for _, na := range req.ServiceAccounts {
+			u, a, err := w.CreateServiceAccount(
+				ctx,
+				user.User{Key: na.Key, Username: na.Username},
+				na.Description,
+			)
+			if err != nil {
+				return err
+			}
+			res.Users = append(res.Users, u)
+			res.ServiceAccounts = append(res.ServiceAccounts, a)
+		}
+		return nil
+	}); err != nil {
+		return ServiceAccountCreateResponse{}, err
+	}
+	return res, nil
+}
+
+type (
+	ServiceAccountRetrieveRequest struct {
+		Keys []user.Key `json:"keys" msgpack:"keys"`
+	}
+	ServiceAccountRetrieveResponse struct {
+		ServiceAccounts []apikey.ServiceAccount `json:"service_accounts" msgpack:"service_accounts"`
+	}
+)
+
+// RetrieveServiceAccount returns the service accounts with the provided keys, or all
+// service accounts if no keys are provided.
+func (s *Service) RetrieveServiceAccount(
+	ctx context.Context,
+	req ServiceAccountRetrieveRequest,
+) (ServiceAccountRetrieveResponse, error) {
+	accounts, err := s.internal.RetrieveServiceAccounts(ctx, nil, req.Keys...)
+	if err != nil {
+		return ServiceAccountRetrieveResponse{}, err
+	}
+	if err = s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionRetrieve,
+		Objects: user.OntologyIDsFromKeys(lo.Map(
+			accounts,
+			func(a apikey.ServiceAccount, _ int) user.Key { return a.Key },
+		)),
+	}); err != nil {
+		return ServiceAccountRetrieveResponse{}, err
+	}
+	return ServiceAccountRetrieveResponse{ServiceAccounts: accounts}, nil
+}
+
+type ServiceAccountDeleteRequest struct {
+	Keys []user.Key `json:"keys" msgpack:"keys"`
+}
+
+// DeleteServiceAccount deletes the service accounts with the provided keys, along with
+// their users and API keys.
+func (s *Service) DeleteServiceAccount(
+	ctx context.Context,
+	req ServiceAccountDeleteRequest,
+) (types.Nil, error) {
+	if err := s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionDelete,
+		Objects: user.OntologyIDsFromKeys(req.Keys),
+	}); err != nil {
+		return types.Nil{}, err
+	}
+	return types.Nil{}, s.db.WithTx(ctx, func(tx gorp.Tx) error {
+		return s.internal.NewWriter(tx).DeleteServiceAccounts(ctx, req.Keys...)
+	})
+}
+
+// CreatedKey is a newly created API key along with the token used to authenticate
+// with it. The token is only ever returned once.
+type CreatedKey struct {
+	apikey.Key
+	Token string `json:"token" msgpack:"token"`
+}
+
+type (
+	CreateRequest struct {
+		Keys []apikey.Key `json:"keys" msgpack:"keys"`
+	}
+	CreateResponse struct {
+		Keys []CreatedKey `json:"keys" msgpack:"keys"`
+	}
+)
+
+// Create creates the API keys in the request. Creating a key requires permission to
+// update its service account.
+func (s *Service) Create(ctx context.Context, req CreateRequest) (CreateResponse, error) {
+	if err := s.enforceUpdate(ctx, req.Keys); err != nil {
+		return CreateResponse{}, err
+	}
+	res := CreateResponse{Keys: make([]CreatedKey, 0, len(req.Keys))}
+	if err := s.db.WithTx(ctx, func(tx gorp.Tx) error {
+		w := s.internal.NewWriter(tx)
+		for _, k := range req.Keys {
+			tk, err := w.Create(ctx, &k)
+			if err != nil {
+				return err
+			}
+			res.Keys = append(res.Keys, CreatedKey{Key: k, Token: tk})
+		}
+		return nil
+	}); err != nil {
+		return CreateResponse{}, err
+	}
+	return res, nil
+}
+
+type (
+	RetrieveRequest struct {
+		ServiceAccounts []user.Key `json:"service_accounts" msgpack:"service_accounts"`
+	}
+	RetrieveResponse struct {
+		Keys []apikey.Key `json:"keys" msgpack:"keys"`
+	}
+)
+
+// Retrieve returns the API keys of the provided service accounts, or all API keys if
+// no service accounts are provided. Tokens are never returned.
+func (s *Service) Retrieve(ctx context.Context, req RetrieveRequest) (RetrieveResponse, error) {
+	keys, err := s.internal.RetrieveKeys(ctx, nil, req.ServiceAccounts...)
+	if err != nil {
+		return RetrieveResponse{}, err
+	}
+	if err = s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionRetrieve,
+		Objects: serviceAccountIDs(keys),
+	}); err != nil {
+		return RetrieveResponse{}, err
+	}
+	return RetrieveResponse{Keys: keys}, nil
+}
+
+type (
+	RotateRequest struct {
+		Key uuid.UUID `json:"key" msgpack:"key"`
+	}
+	RotateResponse struct {
+		Token string `json:"token" msgpack:"token"`
+	}
+)
+
+// Rotate replaces the secret of the API key with the provided key, returning its new
+// token. The previous token is rejected as soon as the rotation succeeds.
+func (s *Service) Rotate(ctx context.Context, req RotateRequest) (RotateResponse, error) {
+	keys, err := s.internal.RetrieveKeysByKey(ctx, nil, req.Key)
+	if err != nil {
+		return RotateResponse{}, err
+	}
+	if err = s.enforceUpdate(ctx, keys); err != nil {
+		return RotateResponse{}, err
+	}
+	var res RotateResponse
+	if err = s.db.WithTx(ctx, func(tx gorp.Tx) (err error) {
+		res.Token, err = s.internal.NewWriter(tx).Rotate(ctx, req.Key)
+		return err
+	}); err != nil {
+		return RotateResponse{}, err
+	}
+	return res, nil
+}
+
+type DeleteRequest struct {
+	Keys []uuid.UUID `json:"keys" msgpack:"keys"`
+}
+
+// Delete revokes the API keys with the provided keys. Deleting keys that do not exist
+// is a no-op.
+func (s *Service) Delete(ctx context.Context, req DeleteRequest) (types.Nil, error) {
+	keys, err := s.internal.RetrieveKeysByKey(ctx, nil, req.Keys...)
+	if err != nil && !errors.Is(err, query.ErrNotFound) {
+		return types.Nil{}, err
+	}
+	if err = s.enforceUpdate(ctx, keys); err != nil {
+		return types.Nil{}, err
+	}
+	return types.Nil{}, s.db.WithTx(ctx, func(tx gorp.Tx) error {
+		return s.internal.NewWriter(tx).Delete(ctx, req.Keys...)
+	})
+}
+
+// enforceUpdate checks that the subject of the request is allowed to update the service
+// accounts of the given keys, which is required to manage their keys.
+func (s *Service) enforceUpdate(ctx context.Context, keys []apikey.Key) error {
+	return s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionUpdate,
+		Objects: serviceAccountIDs(keys),
+	})
+}
+
+func serviceAccountIDs(keys []apikey.Key) []ontology.ID {
+	return user.OntologyIDsFromKeys(lo.Uniq(lo.Map(
+		keys,
+		func(k apikey.Key, _ int) user.Key { return k.ServiceAccount },
+	)))
+}
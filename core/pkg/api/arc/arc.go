@@ -13,9 +13,11 @@ import (
 	"context"
 	"go/types"
 
+	"github.com/google/uuid"
 	"github.com/synnaxlabs/alamos"
 	arctransport "github.com/synnaxlabs/arc/lsp/transport"
 	"github.com/synnaxlabs/arc/parser"
+	arctest "github.com/synnaxlabs/arc/test"
 	arctext "github.com/synnaxlabs/arc/text"
 	"github.com/synnaxlabs/freighter"
 	"github.com/synnaxlabs/synnax/pkg/api/auth"
@@ -23,6 +25,9 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/service/access"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
 	"github.com/synnaxlabs/synnax/pkg/service/arc"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/library"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/runtime"
+	"github.com/synnaxlabs/synnax/pkg/service/channel"
 	"github.com/synnaxlabs/synnax/pkg/service/status"
 	xconfig "github.com/synnaxlabs/x/config"
 	"github.com/synnaxlabs/x/gorp"
@@ -37,7 +42,9 @@ type Service struct {
 	db       *gorp.DB
 	access   *rbac.Service
 	internal *arc.Service
+	library  *library.Service
 	status   *status.Service
+	channel  *channel.Service
 	alamos.Instrumentation
 }
 
@@ -51,7 +58,9 @@ func NewService(cfgs ...config.LayerConfig) (*Service, error) {
 		access:          cfg.Service.RBAC,
 		Instrumentation: cfg.Instrumentation,
 		internal:        cfg.Service.Arc,
+		library:         cfg.Service.ArcLibrary,
 		status:          cfg.Service.Status,
+		channel:         cfg.Service.Channel,
 	}, nil
 }
 
@@ -170,6 +179,87 @@ func (s *Service) Retrieve(ctx context.Context, req RetrieveRequest) (res Retrie
 	return res, nil
 }
 
+type (
+	TestRequest struct {
+		// Key is the key of a stored Arc whose tests to run. Takes precedence over
+		// Text.
+		Key arc.Key `json:"key" msgpack:"key"`
+		// Text is the source of an unsaved Arc whose tests to run.
+		Text arctext.Text `json:"text" msgpack:"text"`
+	}
+	TestFailure struct {
+		Line    int    `json:"line" msgpack:"line"`
+		Column  int    `json:"column" msgpack:"column"`
+		Message string `json:"message" msgpack:"message"`
+	}
+	TestResult struct {
+		Name     string        `json:"name" msgpack:"name"`
+		Line     int           `json:"line" msgpack:"line"`
+		Passed   bool          `json:"passed" msgpack:"passed"`
+		Failures []TestFailure `json:"failures" msgpack:"failures"`
+	}
+	TestResponse struct {
+		Results []TestResult `json:"results" msgpack:"results"`
+	}
+)
+
+// Test compiles an Arc and runs the test blocks declared in its text. Failed
+// assertions are reported in the response; an error is returned only if the Arc
+// or its tests fail to compile.
+func (s *Service) Test(ctx context.Context, req TestRequest) (res TestResponse, err error) {
+	a := Arc{Text: req.Text}
+	if req.Key != uuid.Nil {
+		if err = s.access.Enforce(ctx, access.Request{
+			Subject: auth.GetSubject(ctx),
+			Action:  access.ActionRetrieve,
+			Objects: arc.OntologyIDs([]arc.Key{req.Key}),
+		}); err != nil {
+			return res, err
+		}
+		if err = s.internal.NewRetrieve().
+			Where(arc.MatchKeys(req.Key)).
+			Entry(&a).
+			Exec(ctx, nil); err != nil {
+			return res, err
+		}
+	}
+	if err = s.compile(ctx, &a); err != nil {
+		return res, err
+	}
+	cfg := parser.Config{AllowDashedNames: s.internal.AllowDashedNames()}
+	tests, diag := arctest.Parse(a.Text.Raw, cfg)
+	if diag != nil && !diag.Ok() {
+		return res, CompileError{Diagnostics: diag.Error()}
+	}
+	results, err := runtime.Test(ctx, runtime.TestConfig{
+		Instrumentation: s.Instrumentation,
+		Channel:         s.channel,
+		Program:         a,
+		Tests:           tests,
+		Parser:          cfg,
+	})
+	if err != nil {
+		return res, err
+	}
+	res.Results = make([]TestResult, len(results))
+	for i, r := range results {
+		res.Results[i] = TestResult{
+			Name:     r.Name,
+			Line:     r.Position.Line,
+			Passed:   r.Passed(),
+			Failures: make([]TestFailure, len(r.Failures)),
+		}
+		for j, f := range r.Failures {
+			res.Results[i].Failures[j] = TestFailure{
+				Line:    f.Position.Line,
+				Column:  f.Position.Col,
+				Message: f.Message,
+			}
+		}
+	}
+	return res, nil
+}
+
 // LSPMessage represents a single JSON-RPC message for the LSP
 type LSPMessage = arctransport.JSONRPCMessage
 
@@ -193,7 +283,7 @@ func (s *Service) compile(ctx context.Context, arc *Arc) error {
 	if diag != nil && !diag.Ok() {
 		return CompileError{Diagnostics: diag.Error()}
 	}
-	ir, diag := arctext.Analyze(ctx, parsed, s.internal.NewRoot(nil), cfg)
+	ir, diag := arctext.Analyze(ctx, parsed, s.internal.NewRoot(ctx, nil), cfg)
 	if diag != nil && !diag.Ok() {
 		return CompileError{Diagnostics: diag.Error()}
 	}
@@ -0,0 +1,122 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package arc
+
+import (
+	"context"
+	"go/types"
+
+	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/access"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/library"
+	"github.com/synnaxlabs/x/gorp"
+)
+
+type Library = library.Library
+
+type (
+	CreateLibraryRequest struct {
+		Libraries []Library `json:"libraries" msgpack:"libraries"`
+	}
+	CreateLibraryResponse = CreateLibraryRequest
+)
+
+// CreateLibrary creates or updates the given libraries. The version that each library
+// is stored with is returned in the response. Programs that import a library always
+// resolve its latest version.
+func (s *Service) CreateLibrary(
+	ctx context.Context,
+	req CreateLibraryRequest,
+) (res CreateLibraryResponse, err error) {
+	if err = s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionCreate,
+		Objects: library.OntologyIDsFromLibraries(req.Libraries),
+	}); err != nil {
+		return res, err
+	}
+	return res, s.db.WithTx(ctx, func(tx gorp.Tx) error {
+		w := s.library.NewWriter(tx)
+		for i, l := range req.Libraries {
+			if err = w.Create(ctx, &l); err != nil {
+				return err
+			}
+			req.Libraries[i] = l
+		}
+		res.Libraries = req.Libraries
+		return nil
+	})
+}
+
+type DeleteLibraryRequest struct {
+	Keys []library.Key `json:"keys" msgpack:"keys"`
+}
+
+func (s *Service) DeleteLibrary(
+	ctx context.Context,
+	req DeleteLibraryRequest,
+) (res types.Nil, err error) {
+	if err = s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionDelete,
+		Objects: library.OntologyIDs(req.Keys),
+	}); err != nil {
+		return res, err
+	}
+	return res, s.db.WithTx(ctx, func(tx gorp.Tx) error {
+		return s.library.NewWriter(tx).Delete(ctx, req.Keys...)
+	})
+}
+
+type (
+	RetrieveLibraryRequest struct {
+		SearchTerm string        `json:"search_term" msgpack:"search_term"`
+		Keys       []library.Key `json:"keys" msgpack:"keys"`
+		Names      []string      `json:"names" msgpack:"names"`
+		Limit      int           `json:"limit" msgpack:"limit"`
+		Offset     int           `json:"offset" msgpack:"offset"`
+	}
+	RetrieveLibraryResponse struct {
+		Libraries []Library `json:"libraries" msgpack:"libraries"`
+	}
+)
+
+func (s *Service) RetrieveLibrary(
+	ctx context.Context,
+	req RetrieveLibraryRequest,
+) (res RetrieveLibraryResponse, err error) {
+	q := s.library.NewRetrieve().Entries(&res.Libraries)
+	if len(req.Keys) > 0 {
+		q = q.Where(library.MatchKeys(req.Keys...))
+	}
+	if len(req.Names) > 0 {
+		q = q.Where(library.MatchNames(req.Names...))
+	}
+	if req.SearchTerm != "" {
+		q = q.Search(req.SearchTerm)
+	}
+	if req.Limit > 0 {
+		q = q.Limit(req.Limit)
+	}
+	if req.Offset > 0 {
+		q = q.Offset(req.Offset)
+	}
+	if err = q.Exec(ctx, nil); err != nil {
+		return RetrieveLibraryResponse{}, err
+	}
+	if err = s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionRetrieve,
+		Objects: library.OntologyIDsFromLibraries(res.Libraries),
+	}); err != nil {
+		return RetrieveLibraryResponse{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package audit implements the API for querying, exporting, and verifying the audit
+// log, along with the helpers used by other API services to record their actions in
+// it.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/api/config"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/access"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
+	"github.com/synnaxlabs/synnax/pkg/service/audit"
+	xconfig "github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// Service is the API for the audit log. Reading the log requires permission to
+// retrieve the audit resource type.
+type Service struct {
+	access   *rbac.Service
+	internal *audit.Service
+}
+
+// NewService creates a new Service for the audit log.
+func NewService(cfgs ...config.LayerConfig) (*Service, error) {
+	cfg, err := xconfig.New(config.DefaultLayerConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{access: cfg.Service.RBAC, internal: cfg.Service.Audit}, nil
+}
+
+// Query selects records from the log. Records must match every non-empty field.
+type Query struct {
+	Kinds     []audit.Kind    `json:"kinds" msgpack:"kinds"`
+	Subjects  []ontology.ID   `json:"subjects" msgpack:"subjects"`
+	Objects   []ontology.ID   `json:"objects" msgpack:"objects"`
+	TimeRange telem.TimeRange `json:"time_range" msgpack:"time_range"`
+	// Failed restricts the query to actions that were denied or failed.
+	Failed bool `json:"failed" msgpack:"failed"`
+}
+
+type (
+	RetrieveRequest struct {
+		Query
+		Limit  int `json:"limit" msgpack:"limit"`
+		Offset int `json:"offset" msgpack:"offset"`
+	}
+	RetrieveResponse struct {
+		Records []audit.Record `json:"records" msgpack:"records"`
+	}
+)
+
+// Retrieve returns the records matching the request, ordered by the node that wrote
+// them and then by their position in the chain of that node.
+func (s *Service) Retrieve(ctx context.Context, req RetrieveRequest) (RetrieveResponse, error) {
+	if err := s.enforce(ctx); err != nil {
+		return RetrieveResponse{}, err
+	}
+	records, err := s.retrieve(ctx, req.Query, req.Limit, req.Offset)
+	if err != nil {
+		return RetrieveResponse{}, err
+	}
+	return RetrieveResponse{Records: records}, nil
+}
+
+type (
+	ExportRequest  struct{ Query }
+	ExportResponse struct {
+		// CSV holds the matching records as comma-separated values with a header row,
+		// ordered by time.
+		CSV string `json:"csv" msgpack:"csv"`
+	}
+)
+
+var exportHeader = []string{
+	"key", "node", "sequence", "time", "kind", "subject", "action", "objects",
+	"target", "error", "diff", "prev_hash", "hash",
+}
+
+// Export returns the records matching the request in a form suitable for archival.
+// Each row includes the hashes of its record, so the export can be checked against
+// the log.
+func (s *Service) Export(ctx context.Context, req ExportRequest) (ExportResponse, error) {
+	if err := s.enforce(ctx); err != nil {
+		return ExportResponse{}, err
+	}
+	records, err := s.retrieve(ctx, req.Query, 0, 0)
+	if err != nil {
+		return ExportResponse{}, err
+	}
+	slices.SortStableFunc(records, func(a, b audit.Record) int {
+		return int(a.Time - b.Time)
+	})
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err = w.Write(exportHeader); err != nil {
+		return ExportResponse{}, err
+	}
+	for _, r := range records {
+		if err = w.Write([]string{
+			r.Key.String(),
+			strconv.Itoa(int(r.Key.Node())),
+			strconv.FormatUint(r.Key.Sequence(), 10),
+			r.Time.Time().UTC().Format(time.RFC3339Nano),
+			string(r.Kind),
+			r.Subject.String(),
+			r.Action,
+			strings.Join(lo.Map(r.Objects, func(o ontology.ID, _ int) string {
+				return o.String()
+			}), " "),
+			r.Target,
+			r.Error,
+			r.Diff,
+			r.PrevHash,
+			r.Hash,
+		}); err != nil {
+			return ExportResponse{}, err
+		}
+	}
+	w.Flush()
+	if err = w.Error(); err != nil {
+		return ExportResponse{}, err
+	}
+	return ExportResponse{CSV: buf.String()}, nil
+}
+
+type (
+	VerifyRequest  struct{}
+	VerifyResponse struct {
+		// Valid is true if no record in the log has been modified, removed, or
+		// inserted since it was written.
+		Valid bool `json:"valid" msgpack:"valid"`
+		// Reason describes where the log was found to be tampered with if Valid is
+		// false.
+		Reason string `json:"reason" msgpack:"reason"`
+	}
+)
+
+// Verify checks the hash chains of the log for tampering.
+func (s *Service) Verify(ctx context.Context, _ VerifyRequest) (VerifyResponse, error) {
+	if err := s.enforce(ctx); err != nil {
+		return VerifyResponse{}, err
+	}
+	err := s.internal.Verify(ctx)
+	if errors.Is(err, audit.ErrTampered) {
+		return VerifyResponse{Reason: err.Error()}, nil
+	}
+	if err != nil {
+		return VerifyResponse{}, err
+	}
+	return VerifyResponse{Valid: true}, nil
+}
+
+func (s *Service) enforce(ctx context.Context) error {
+	return s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionRetrieve,
+		Objects: []ontology.ID{{Type: ontology.ResourceTypeAudit}},
+	})
+}
+
+func (s *Service) retrieve(
+	ctx context.Context,
+	q Query,
+	limit, offset int,
+) ([]audit.Record, error) {
+	records := make([]audit.Record, 0)
+	r := s.internal.NewRetrieve().Entries(&records).Limit(limit).Offset(offset)
+	if len(q.Kinds) > 0 {
+		r = r.Where(audit.MatchKinds(q.Kinds...))
+	}
+	if len(q.Subjects) > 0 {
+		r = r.Where(audit.MatchSubjects(q.Subjects...))
+	}
+	if len(q.Objects) > 0 {
+		r = r.Where(audit.MatchObjects(q.Objects...))
+	}
+	if !q.TimeRange.IsZero() {
+		r = r.Where(audit.MatchTimeRange(q.TimeRange))
+	}
+	if q.Failed {
+		r = r.Where(audit.MatchFailed())
+	}
+	return records, r.Exec(ctx, nil)
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/freighter"
+	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/access"
+	"github.com/synnaxlabs/synnax/pkg/service/audit"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/errors"
+)
+
+// Handler is a unary API handler.
+type Handler[RQ, RS any] = func(context.Context, RQ) (RS, error)
+
+// NewRecord returns a record of an action of the given kind performed by the subject
+// of the request that ctx was created for.
+func NewRecord(ctx context.Context, kind audit.Kind, action string) audit.Record {
+	r := newRecord(ctx, kind, action)
+	r.Subject = auth.GetSubject(ctx)
+	return r
+}
+
+func newRecord(ctx context.Context, kind audit.Kind, action string) audit.Record {
+	r := audit.Record{Kind: kind, Action: action}
+	if fCtx, ok := ctx.(freighter.Context); ok {
+		r.Target = fCtx.Target.String()
+	}
+	return r
+}
+
+// Create wraps h so that every call to it is recorded in log as the creation of
+// resources.
+func Create[RQ, RS any](log *audit.Service, h Handler[RQ, RS]) Handler[RQ, RS] {
+	return resource(log, access.ActionCreate, h)
+}
+
+// Update wraps h so that every call to it is recorded in log as the modification of
+// resources.
+func Update[RQ, RS any](log *audit.Service, h Handler[RQ, RS]) Handler[RQ, RS] {
+	return resource(log, access.ActionUpdate, h)
+}
+
+// Delete wraps h so that every call to it is recorded in log as the deletion of
+// resources.
+func Delete[RQ, RS any](log *audit.Service, h Handler[RQ, RS]) Handler[RQ, RS] {
+	return resource(log, access.ActionDelete, h)
+}
+
+// resource records calls to h as the given action on resources. The diff of the
+// record holds the request and, if the call succeeded, the response, so that the keys
+// of created resources are recorded.
+func resource[RQ, RS any](
+	log *audit.Service,
+	action access.Action,
+	h Handler[RQ, RS],
+) Handler[RQ, RS] {
+	return func(ctx context.Context, req RQ) (RS, error) {
+		res, err := h(ctx, req)
+		r := NewRecord(ctx, audit.KindResource, string(action))
+		diff := map[string]any{"request": req}
+		if err != nil {
+			r.Error = err.Error()
+		} else {
+			diff["response"] = res
+		}
+		r.Diff = Diff(diff)
+		return res, errors.Combine(err, log.Log(ctx, r))
+	}
+}
+
+// Login wraps h so that every login attempt made through it is recorded in log,
+// including failed attempts. Passwords and tokens are never recorded.
+func Login[RQ any](
+	log *audit.Service,
+	h Handler[RQ, auth.LoginResponse],
+) Handler[RQ, auth.LoginResponse] {
+	return func(ctx context.Context, req RQ) (auth.LoginResponse, error) {
+		res, err := h(ctx, req)
+		r := newRecord(ctx, audit.KindLogin, "login")
+		r.Diff = Diff(req)
+		if err != nil {
+			r.Error = err.Error()
+		} else {
+			r.Subject = user.OntologyID(res.User.Key)
+		}
+		return res, errors.Combine(err, log.Log(ctx, r))
+	}
+}
+
+// Logout wraps h so that every logout made through it is recorded in log.
+func Logout[RQ, RS any](log *audit.Service, h Handler[RQ, RS]) Handler[RQ, RS] {
+	return func(ctx context.Context, req RQ) (RS, error) {
+		res, err := h(ctx, req)
+		r := NewRecord(ctx, audit.KindLogin, "logout")
+		if err != nil {
+			r.Error = err.Error()
+		}
+		return res, errors.Combine(err, log.Log(ctx, r))
+	}
+}
+
+// secretFields are the fields of JSON objects that are removed by Diff.
+var secretFields = []string{"password", "new_password", "token", "secret", "code"}
+
+// Diff returns v encoded as JSON with the values of any fields that hold secrets
+// replaced, for use as the diff of a record.
+func Diff(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	// Numbers are decoded as json.Number so that large keys keep their precision.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var decoded any
+	if err = dec.Decode(&decoded); err != nil {
+		return ""
+	}
+	if b, err = json.Marshal(redact(decoded)); err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if lo.Contains(secretFields, k) {
+				v[k] = "[REDACTED]"
+				continue
+			}
+			v[k] = redact(field)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = redact(e)
+		}
+	}
+	return v
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package audit_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/freighter"
+	apiaudit "github.com/synnaxlabs/synnax/pkg/api/audit"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/audit"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/kv/memkv"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var alice = ontology.ID{Type: ontology.ResourceTypeUser, Key: "alice"}
+
+func requestCtx(ctx context.Context) freighter.Context {
+	fCtx := freighter.Context{
+		Context: ctx,
+		Params:  freighter.Params{},
+		Target:  "/api/v1/cat/create",
+	}
+	fCtx.Set("Subject", alice)
+	return fCtx
+}
+
+type request struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+	Key      uint64 `json:"key"`
+}
+
+var _ = Describe("Record", func() {
+	Describe("Diff", func() {
+		It("Should redact secret fields at any depth", func() {
+			Expect(apiaudit.Diff(map[string]any{
+				"users": []request{{Name: "alice", Password: "hunter2"}},
+				"token": "abc",
+			})).To(MatchJSON(`{
+				"users": [{"name": "alice", "password": "[REDACTED]", "key": 0}],
+				"token": "[REDACTED]"
+			}`))
+		})
+
+		It("Should preserve the precision of large numbers", func() {
+			Expect(apiaudit.Diff(request{Key: 1<<63 + 1})).
+				To(ContainSubstring("9223372036854775809"))
+		})
+	})
+
+	Describe("Handlers", func() {
+		var log *audit.Service
+		BeforeEach(func(ctx SpecContext) {
+			log = MustOpen(audit.OpenService(ctx, audit.ServiceConfig{
+				DB:           DeferClose(gorp.Wrap(memkv.New())),
+				HostProvider: mock.StaticHostKeyProvider(1),
+			}))
+		})
+		committed := func(ctx SpecContext) []audit.Record {
+			var records []audit.Record
+			Eventually(func(g Gomega) {
+				records = nil
+				g.Expect(log.NewRetrieve().Entries(&records).Exec(ctx, nil)).To(Succeed())
+				g.Expect(records).To(HaveLen(1))
+			}).Should(Succeed())
+			return records
+		}
+
+		It("Should record the request and response of a successful call", func(ctx SpecContext) {
+			h := apiaudit.Create(log, func(_ context.Context, req request) (request, error) {
+				req.Key = 42
+				return req, nil
+			})
+			Expect(h(requestCtx(ctx), request{Name: "alice", Password: "hunter2"})).
+				To(Equal(request{Name: "alice", Password: "hunter2", Key: 42}))
+			r := committed(ctx)[0]
+			Expect(r.Kind).To(Equal(audit.KindResource))
+			Expect(r.Action).To(Equal("create"))
+			Expect(r.Subject).To(Equal(alice))
+			Expect(r.Target).To(Equal("/api/v1/cat/create"))
+			Expect(r.Failed()).To(BeFalse())
+			Expect(r.Diff).ToNot(ContainSubstring("hunter2"))
+			Expect(r.Diff).To(ContainSubstring(`"key":42`))
+		})
+
+		It("Should record the error of a failed call", func(ctx SpecContext) {
+			h := apiaudit.Delete(log, func(context.Context, request) (request, error) {
+				return request{}, errors.New("cat not found")
+			})
+			_, err := h(requestCtx(ctx), request{Name: "alice"})
+			Expect(err).To(MatchError("cat not found"))
+			r := committed(ctx)[0]
+			Expect(r.Action).To(Equal("delete"))
+			Expect(r.Error).To(Equal("cat not found"))
+			Expect(r.Diff).ToNot(ContainSubstring("response"))
+		})
+	})
+})
@@ -12,14 +12,21 @@ package auth
 import (
 	"context"
 	"go/types"
+	"strings"
 
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"github.com/synnaxlabs/freighter"
 	"github.com/synnaxlabs/synnax/pkg/api/config"
 	"github.com/synnaxlabs/synnax/pkg/distribution/node"
 	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/oidc"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/session"
 	"github.com/synnaxlabs/synnax/pkg/service/auth/token"
 	"github.com/synnaxlabs/synnax/pkg/service/user"
 	"github.com/synnaxlabs/synnax/pkg/version"
 	xconfig "github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
 	"github.com/synnaxlabs/x/gorp"
 	"github.com/synnaxlabs/x/telem"
 )
@@ -45,6 +52,8 @@ type Service struct {
 	token   *token.Service
 	auth    *auth.Service
 	user    *user.Service
+	oidc    *oidc.Service
+	session *session.Service
 	cluster node.Cluster
 }
 
@@ -58,6 +67,8 @@ func NewService(cfgs ...config.LayerConfig) (*Service, error) {
 		token:   cfg.Service.Token,
 		auth:    cfg.Service.Auth,
 		user:    cfg.Service.User,
+		oidc:    cfg.Service.OIDC,
+		session: cfg.Service.Session,
 		cluster: cfg.Distribution.Cluster,
 	}, nil
 }
@@ -87,7 +98,69 @@ func (s *Service) Login(ctx context.Context, req LoginRequest) (LoginResponse, e
 		Exec(ctx, nil); err != nil {
 		return LoginResponse{}, err
 	}
-	tk, err := s.token.New(u.Key)
+	return s.newLoginResponse(ctx, u, startTime)
+}
+
+// ErrSSONotConfigured is returned when a single sign-on request is made to a Core
+// that has no identity provider configured.
+var ErrSSONotConfigured = errors.Wrap(auth.ErrAuth, "single sign-on is not configured")
+
+type (
+	OIDCAuthorizeRequest  struct{}
+	OIDCAuthorizeResponse = oidc.Authorization
+)
+
+// OIDCAuthorize starts a single sign-on, returning the URL of the identity provider to
+// redirect the user to. The sign-in is completed by calling OIDCLogin with the code
+// and state the provider returns.
+func (s *Service) OIDCAuthorize(
+	ctx context.Context,
+	_ OIDCAuthorizeRequest,
+) (OIDCAuthorizeResponse, error) {
+	if s.oidc == nil {
+		return OIDCAuthorizeResponse{}, ErrSSONotConfigured
+	}
+	return s.oidc.Authorize(ctx)
+}
+
+type OIDCLoginRequest struct {
+	// Code is the authorization code returned by the identity provider.
+	Code string `json:"code" msgpack:"code"`
+	// State is the state returned by OIDCAuthorize and echoed by the identity
+	// provider.
+	State string `json:"state" msgpack:"state"`
+}
+
+// OIDCLogin completes a single sign-on started with OIDCAuthorize. If successful,
+// returns a response containing a valid JWT along with the details of the user the
+// identity signs in as, provisioning the user on its first sign-in.
+func (s *Service) OIDCLogin(ctx context.Context, req OIDCLoginRequest) (LoginResponse, error) {
+	startTime := telem.Now()
+	if s.oidc == nil {
+		return LoginResponse{}, ErrSSONotConfigured
+	}
+	u, err := s.oidc.Login(ctx, req.Code, req.State)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+	return s.newLoginResponse(ctx, u, startTime)
+}
+
+// newLoginResponse opens a new session for u and issues a token for it.
+func (s *Service) newLoginResponse(
+	ctx context.Context,
+	u user.User,
+	startTime telem.TimeStamp,
+) (LoginResponse, error) {
+	ses := session.Session{Key: uuid.New(), User: u.Key, Client: parseClient(ctx)}
+	tk, claims, err := s.token.NewSession(u.Key, ses.Key)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+	ses.ExpiresAt = telem.NewTimeStamp(claims.ExpiresAt)
+	if err = s.session.NewWriter(nil).Create(ctx, &ses); err != nil {
+		return LoginResponse{}, err
+	}
 	endTime := telem.Now()
 	midPoint := startTime + (endTime-startTime)/2
 	return LoginResponse{
@@ -99,7 +172,42 @@ func (s *Service) Login(ctx context.Context, req LoginRequest) (LoginResponse, e
 			NodeVersion: version.Get(),
 			NodeTime:    midPoint,
 		},
-	}, err
+	}, nil
+}
+
+// parseClient returns the client information of the request ctx was created for.
+func parseClient(ctx context.Context) session.Client {
+	md, ok := ctx.(freighter.Context)
+	if !ok {
+		return session.Client{}
+	}
+	header := func(k string) string {
+		v, ok := md.Get(k)
+		if !ok {
+			// GRPC sends lowercase headers
+			v, _ = md.Get(strings.ToLower(k))
+		}
+		s, _ := v.(string)
+		return s
+	}
+	address, _, _ := strings.Cut(header(fiber.HeaderXForwardedFor), ",")
+	return session.Client{
+		UserAgent: header(fiber.HeaderUserAgent),
+		Address:   strings.TrimSpace(address),
+		Protocol:  md.Protocol,
+	}
+}
+
+type LogoutRequest struct{}
+
+// Logout revokes the session of the token the request was made with, after which the
+// token and any tokens it was refreshed into are rejected.
+func (s *Service) Logout(ctx context.Context, _ LogoutRequest) (types.Nil, error) {
+	key, ok := GetSession(ctx)
+	if !ok {
+		return types.Nil{}, errors.Wrap(auth.ErrAuth, "request was not made with a session token")
+	}
+	return types.Nil{}, s.session.NewWriter(nil).Revoke(ctx, key)
 }
 
 type ChangePasswordRequest struct {
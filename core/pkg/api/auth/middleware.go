@@ -17,14 +17,28 @@ import (
 	"github.com/google/uuid"
 	"github.com/synnaxlabs/freighter"
 	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/access"
 	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/apikey"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/session"
 	"github.com/synnaxlabs/synnax/pkg/service/auth/token"
 	"github.com/synnaxlabs/synnax/pkg/service/user"
 	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
 	"go.uber.org/zap"
 )
 
-func TokenMiddleware(svc *token.Service) freighter.Middleware {
+// TokenMiddleware authenticates requests made with either a session token or an API
+// key. Requests made with an API key that is scoped to a subset of policies are only
+// allowed by the policies within the scope. Session tokens are rejected once their
+// session is revoked, and API keys once they are deleted or rotated or their service
+// account is deleted. Streams opened with either are closed on revocation. Tokens
+// issued before sessions existed are accepted until they expire.
+func TokenMiddleware(
+	svc *token.Service,
+	sessions *session.Service,
+	keys *apikey.Service,
+) freighter.Middleware {
 	return freighter.MiddlewareFunc(func(
 		ctx freighter.Context,
 		next freighter.Next,
@@ -33,11 +47,53 @@ func TokenMiddleware(svc *token.Service) freighter.Middleware {
 		if err != nil {
 			return ctx, err
 		}
-		userKey, newTK, err := svc.ValidateMaybeRefresh(tk)
+		if apikey.IsToken(tk) {
+			k, err := keys.Authenticate(ctx, tk)
+			if err != nil {
+				return ctx, err
+			}
+			ctx.Set(subjectKey, user.OntologyID(k.ServiceAccount))
+			if len(k.Policies) > 0 {
+				ctx.Context = access.WithScope(ctx.Context, k.Policies)
+			}
+			if ctx.Variant == freighter.VariantStream {
+				var cancel context.CancelFunc
+				ctx.Context, cancel = keys.Watch(ctx.Context, k.Key)
+				defer cancel()
+			}
+			return next(ctx)
+		}
+		claims, newTK, err := svc.ValidateSessionMaybeRefresh(tk)
 		if err != nil {
 			return ctx, err
 		}
-		ctx.Set(subjectKey, user.OntologyID(userKey))
+		if claims.Session == uuid.Nil {
+			// Tokens issued before sessions were introduced carry no session. They are
+			// accepted until they expire so that upgrading doesn't sign every user
+			// out, but are never refreshed, so clients move to a session the next time
+			// they log in.
+			ctx.Set(subjectKey, user.OntologyID(claims.User))
+			return next(ctx)
+		}
+		if _, err = sessions.Validate(ctx, claims.Session); err != nil {
+			return ctx, err
+		}
+		if newTK != "" {
+			if err = sessions.NewWriter(nil).Extend(
+				ctx,
+				claims.Session,
+				telem.NewTimeStamp(claims.ExpiresAt),
+			); err != nil {
+				return ctx, err
+			}
+		}
+		ctx.Set(subjectKey, user.OntologyID(claims.User))
+		ctx.Set(sessionKey, claims.Session)
+		if ctx.Variant == freighter.VariantStream {
+			var cancel context.CancelFunc
+			ctx.Context, cancel = sessions.Watch(ctx.Context, claims.Session)
+			defer cancel()
+		}
 		oCtx, err := next(ctx)
 		if newTK != "" {
 			oCtx.Set("Refresh-Token", newTK)
@@ -80,7 +136,10 @@ func tryParseToken(params freighter.Params) (string, error) {
 	return strings.TrimPrefix(tkStr, tokenParamPrefix), nil
 }
 
-const subjectKey = "Subject"
+const (
+	subjectKey = "Subject"
+	sessionKey = "Session"
+)
 
 func GetSubject(ctx context.Context) ontology.ID {
 	s, ok := freighter.MDFromContext(ctx).Get(subjectKey)
@@ -90,3 +149,13 @@ func GetSubject(ctx context.Context) ontology.ID {
 	}
 	return s.(ontology.ID)
 }
+
+// GetSession returns the key of the session the request was made with. Returns false
+// if the request was made with an API key.
+func GetSession(ctx context.Context) (uuid.UUID, bool) {
+	s, ok := freighter.MDFromContext(ctx).Get(sessionKey)
+	if !ok {
+		return uuid.Nil, false
+	}
+	return s.(uuid.UUID), true
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package backup
+
+import (
+	"bufio"
+	"context"
+
+	"github.com/synnaxlabs/freighter"
+	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/api/config"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/access"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
+	"github.com/synnaxlabs/synnax/pkg/storage"
+	xconfig "github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// chunkSize is the maximum number of bytes of the archive sent in each response.
+const chunkSize = int(telem.Megabyte)
+
+type Service struct {
+	access  *rbac.Service
+	storage *storage.Layer
+}
+
+func NewService(cfgs ...config.LayerConfig) (*Service, error) {
+	cfg, err := xconfig.New(config.DefaultLayerConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	v := validate.New("api.backup")
+	validate.NotNil(v, "storage", cfg.Storage)
+	if err = v.Error(); err != nil {
+		return nil, err
+	}
+	return &Service{
+		access:  cfg.Service.RBAC,
+		storage: cfg.Storage,
+	}, nil
+}
+
+type (
+	// Request starts a backup of the node that receives it.
+	Request struct{}
+	// Response is a chunk of the backup archive. Concatenating the data of every
+	// response in the stream produces an archive that can be passed to storage.Restore.
+	Response struct {
+		Data []byte `json:"data" msgpack:"data"`
+	}
+)
+
+// Create streams an archive of the node's storage layer to the client. The stream is
+// closed after the last chunk of the archive is sent. The archive contains the entire
+// key-value store of the node, including credential hashes, so the caller must be
+// allowed to create backups, which only owners are by default.
+func (s *Service) Create(
+	ctx context.Context,
+	stream freighter.ServerStream[Request, Response],
+) error {
+	if _, err := stream.Receive(); err != nil {
+		return err
+	}
+	if err := s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionCreate,
+		Objects: []ontology.ID{{Type: ontology.ResourceTypeBackup}},
+	}); err != nil {
+		return err
+	}
+	w := bufio.NewWriterSize(streamWriter{stream}, chunkSize)
+	if err := s.storage.Backup(ctx, w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// streamWriter adapts a backup stream to an io.Writer.
+type streamWriter struct {
+	stream freighter.ServerStream[Request, Response]
+}
+
+func (w streamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(Response{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
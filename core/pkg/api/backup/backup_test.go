@@ -0,0 +1,94 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package backup_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/freighter"
+	"github.com/synnaxlabs/synnax/pkg/api/backup"
+	apicfg "github.com/synnaxlabs/synnax/pkg/api/config"
+	"github.com/synnaxlabs/synnax/pkg/distribution"
+	"github.com/synnaxlabs/synnax/pkg/distribution/group"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/distribution/search"
+	svc "github.com/synnaxlabs/synnax/pkg/service"
+	"github.com/synnaxlabs/synnax/pkg/service/access"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/role"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/synnax/pkg/storage"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/kv/memkv"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+// stream is a backup stream that receives a single request and records every
+// response sent to it.
+type stream struct {
+	freighter.ServerStream[backup.Request, backup.Response]
+	sent []backup.Response
+}
+
+func (s *stream) Receive() (backup.Request, error) { return backup.Request{}, nil }
+
+func (s *stream) Send(res backup.Response) error {
+	s.sent = append(s.sent, res)
+	return nil
+}
+
+var _ = Describe("Backup", func() {
+	var (
+		rbacSvc *rbac.Service
+		userSvc *user.Service
+		apiSvc  *backup.Service
+	)
+	BeforeEach(func(ctx SpecContext) {
+		db := DeferClose(gorp.Wrap(memkv.New()))
+		otg := MustOpen(ontology.Open(ctx, ontology.Config{DB: db}))
+		searchIdx := MustOpen(search.Open())
+		g := MustOpen(group.OpenService(ctx, group.ServiceConfig{
+			DB: db, Ontology: otg, Search: searchIdx,
+		}))
+		userSvc = MustOpen(user.OpenService(ctx, user.ServiceConfig{
+			DB: db, Ontology: otg, Group: g, Search: searchIdx,
+		}))
+		rbacSvc = MustOpen(rbac.OpenService(ctx, rbac.ServiceConfig{
+			DB: db, Ontology: otg, Group: g, Search: searchIdx, User: userSvc,
+		}))
+		// The storage layer is never touched by a denied request.
+		apiSvc = MustSucceed(backup.NewService(apicfg.LayerConfig{
+			Distribution: &distribution.Layer{DB: db},
+			Storage:      &storage.Layer{},
+			Service:      &svc.Layer{RBAC: rbacSvc},
+		}))
+	})
+
+	DescribeTable("Should deny backups to roles other than Owner",
+		func(ctx SpecContext, roleName string) {
+			u := MustSucceed(userSvc.NewWriter(nil).Create(ctx, user.User{Username: roleName}))
+			var r role.Role
+			Expect(rbacSvc.Role.NewRetrieve().
+				Where(role.MatchNames(roleName)).
+				Entry(&r).
+				Exec(ctx, nil)).To(Succeed())
+			Expect(rbacSvc.Role.NewWriter(nil, true).
+				AssignRole(ctx, user.OntologyID(u.Key), r.Key)).To(Succeed())
+			fCtx := freighter.Context{Context: ctx, Params: freighter.Params{}}
+			fCtx.Set("Subject", user.OntologyID(u.Key))
+			s := &stream{}
+			Expect(apiSvc.Create(fCtx, s)).To(MatchError(access.ErrDenied))
+			Expect(s.sent).To(BeEmpty())
+		},
+		Entry("Viewer", "Viewer"),
+		Entry("Operator", "Operator"),
+		Entry("Engineer", "Engineer"),
+	)
+})
@@ -100,7 +100,7 @@ func (s *Service) Create(
 		if err := w.CreateMany(ctx, &translated, opts...); err != nil {
 			return err
 		}
-		res.Channels = translateChannelsForward(translated)
+		res.Channels = translateChannelsForward(translated, nil)
 		return nil
 	}); err != nil {
 		return CreateResponse{}, err
@@ -198,7 +198,7 @@ func (s *Service) Retrieve(
 		q = q.Search(req.SearchTerm)
 	}
 	if req.NodeKey != 0 {
-		q = q.Where(channel.MatchLeaseholders(req.NodeKey))
+		q = q.Where(s.internal.MatchCurrentLeaseholders(req.NodeKey))
 	}
 	if hasDataTypes {
 		q = q.Where(channel.MatchDataTypes(req.DataTypes...))
@@ -233,7 +233,10 @@ func (s *Service) Retrieve(
 			})...,
 		)
 	}
-	oChannels := translateChannelsForward(resChannels)
+	oChannels := translateChannelsForward(
+		resChannels,
+		s.internal.Leases(channel.KeysFromChannels(resChannels)...),
+	)
 	if resRng.Key != uuid.Nil {
 		aliasReader := s.alias.NewReader(nil)
 		for i, ch := range resChannels {
@@ -253,13 +256,15 @@ func (s *Service) Retrieve(
 	return RetrieveResponse{Channels: oChannels}, nil
 }
 
-func translateChannelsForward(channels []channel.Channel) []Channel {
+// translateChannelsForward translates a slice of internal channel structs to a slice of
+// api channel structs, reporting the current leaseholder of each channel from leases.
+func translateChannelsForward(channels []channel.Channel, leases channel.Leases) []Channel {
 	translated := make([]Channel, len(channels))
 	for i, ch := range channels {
 		translated[i] = Channel{
 			Key:         ch.Key(),
 			Name:        ch.Name,
-			Leaseholder: ch.Leaseholder,
+			Leaseholder: leases.Leaseholder(ch.Key()),
 			DataType:    ch.DataType,
 			IsIndex:     ch.IsIndex,
 			Index:       ch.Index(),
@@ -369,6 +374,31 @@ func (s *Service) Rename(
 	})
 }
 
+type TransferLeaseRequest struct {
+	// Keys are the keys of the channels to transfer. The transfer also includes the
+	// indexes of the channels and all other channels sharing those indexes.
+	Keys channel.Keys `json:"keys" msgpack:"keys" validate:"required"`
+	// Target is the node to transfer the leases to.
+	Target node.Key `json:"target" msgpack:"target" validate:"required"`
+}
+
+// TransferLease transfers the leases on a set of channels, along with their data, to
+// another node in the cluster. Writes to the channels are rejected until the transfer
+// completes.
+func (s *Service) TransferLease(
+	ctx context.Context,
+	req TransferLeaseRequest,
+) (types.Nil, error) {
+	if err := s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionUpdate,
+		Objects: req.Keys.OntologyIDs(),
+	}); err != nil {
+		return types.Nil{}, err
+	}
+	return types.Nil{}, s.internal.TransferLease(ctx, req.Keys, req.Target)
+}
+
 type RetrieveGroupRequest struct{}
 
 type RetrieveGroupResponse struct {
@@ -13,6 +13,7 @@ import (
 	"github.com/synnaxlabs/alamos"
 	"github.com/synnaxlabs/synnax/pkg/distribution"
 	"github.com/synnaxlabs/synnax/pkg/service"
+	"github.com/synnaxlabs/synnax/pkg/storage"
 	"github.com/synnaxlabs/x/config"
 	"github.com/synnaxlabs/x/override"
 	"github.com/synnaxlabs/x/validate"
@@ -23,6 +24,7 @@ import (
 type LayerConfig struct {
 	Service      *service.Layer
 	Distribution *distribution.Layer
+	Storage      *storage.Layer
 	alamos.Instrumentation
 }
 
@@ -44,5 +46,6 @@ func (c LayerConfig) Override(other LayerConfig) LayerConfig {
 	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
 	c.Service = override.Nil(c.Service, other.Service)
 	c.Distribution = override.Nil(c.Distribution, other.Distribution)
+	c.Storage = override.Nil(c.Storage, other.Storage)
 	return c
 }
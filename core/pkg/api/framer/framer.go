@@ -11,11 +11,14 @@ package framer
 
 import (
 	"context"
+	"encoding/json"
 	"go/types"
+	"sync/atomic"
 
 	"github.com/synnaxlabs/alamos"
 	"github.com/synnaxlabs/freighter"
 	"github.com/synnaxlabs/freighter/freightfluence"
+	apiaudit "github.com/synnaxlabs/synnax/pkg/api/audit"
 	"github.com/synnaxlabs/synnax/pkg/api/auth"
 	"github.com/synnaxlabs/synnax/pkg/api/config"
 	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
@@ -23,8 +26,11 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
 	"github.com/synnaxlabs/synnax/pkg/service/access"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
+	"github.com/synnaxlabs/synnax/pkg/service/audit"
 	"github.com/synnaxlabs/synnax/pkg/service/framer"
 	"github.com/synnaxlabs/synnax/pkg/service/framer/iterator"
+	"github.com/synnaxlabs/synnax/pkg/service/metrics/openmetrics"
+	"github.com/synnaxlabs/synnax/pkg/service/task"
 	"github.com/synnaxlabs/x/address"
 	xconfig "github.com/synnaxlabs/x/config"
 	"github.com/synnaxlabs/x/confluence"
@@ -49,7 +55,44 @@ type Service struct {
 	access   *rbac.Service
 	channel  *channel.Service
 	internal *framer.Service
+	// audit records writer opens, authority transfers, and task commands written
+	// through the service.
+	audit *audit.Service
+	// taskCommands is the key of the channel that task commands are written to, or
+	// zero if task commands should not be audited.
+	taskCommands channel.Key
 	alamos.Instrumentation
+	// open tracks the number of writers, iterators, and streamers currently open by
+	// clients.
+	open struct {
+		writers   atomic.Int64
+		iterators atomic.Int64
+		streamers atomic.Int64
+	}
+}
+
+var _ openmetrics.Collector = (*Service)(nil)
+
+// Collect implements openmetrics.Collector, reporting the number of writers,
+// iterators, and streamers currently open by clients.
+func (s *Service) Collect(context.Context) ([]openmetrics.Family, error) {
+	return []openmetrics.Family{
+		openmetrics.Gauge(
+			"synnax_framer_open_writers",
+			"Number of writers currently open by clients.",
+			float64(s.open.writers.Load()),
+		),
+		openmetrics.Gauge(
+			"synnax_framer_open_iterators",
+			"Number of iterators currently open by clients.",
+			float64(s.open.iterators.Load()),
+		),
+		openmetrics.Gauge(
+			"synnax_framer_open_streamers",
+			"Number of streamers currently open by clients.",
+			float64(s.open.streamers.Load()),
+		),
+	}, nil
 }
 
 func NewService(cfgs ...config.LayerConfig) (*Service, error) {
@@ -57,12 +100,17 @@ func NewService(cfgs ...config.LayerConfig) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Service{
+	s := &Service{
 		Instrumentation: cfg.Instrumentation,
 		internal:        cfg.Service.Framer,
 		channel:         cfg.Distribution.Channel,
 		access:          cfg.Service.RBAC,
-	}, nil
+		audit:           cfg.Service.Audit,
+	}
+	if cfg.Service.Task != nil {
+		s.taskCommands = cfg.Service.Task.CommandChannelKey()
+	}
+	return s, nil
 }
 
 type DeleteRequest struct {
@@ -106,6 +154,41 @@ func (s *Service) Delete(
 	return types.Nil{}, s.internal.DeleteTimeRange(ctx, keys, req.Bounds)
 }
 
+type AggregateRequest struct {
+	// Keys are the keys of the channels to aggregate.
+	Keys channel.Keys `json:"keys" msgpack:"keys" validate:"required"`
+	// Bounds is the time range to aggregate over.
+	Bounds telem.TimeRange `json:"bounds" msgpack:"bounds" validate:"bounds"`
+	// BucketWidth is the width of each time bucket.
+	BucketWidth telem.TimeSpan `json:"bucket_width" msgpack:"bucket_width" validate:"required"`
+}
+
+type AggregateResponse struct {
+	Aggregates []framer.Aggregate `json:"aggregates" msgpack:"aggregates"`
+}
+
+// Aggregate computes the min, max, mean, count, first, last, and standard deviation of
+// each channel over fixed-width time buckets. Statistics are computed on the nodes
+// that store the data, so only the buckets are sent over the network.
+func (s *Service) Aggregate(
+	ctx context.Context,
+	req AggregateRequest,
+) (AggregateResponse, error) {
+	if err := s.access.Enforce(ctx, access.Request{
+		Subject: auth.GetSubject(ctx),
+		Action:  access.ActionRetrieve,
+		Objects: framer.OntologyIDs(req.Keys),
+	}); err != nil {
+		return AggregateResponse{}, err
+	}
+	aggs, err := s.internal.Aggregate(ctx, framer.AggregateConfig{
+		Keys:        req.Keys,
+		Bounds:      req.Bounds,
+		BucketWidth: req.BucketWidth,
+	})
+	return AggregateResponse{Aggregates: aggs}, err
+}
+
 type (
 	IteratorRequest  = framer.IteratorRequest
 	IteratorResponse = framer.IteratorResponse
@@ -122,6 +205,8 @@ func (s *Service) Iterate(ctx context.Context, stream IteratorStream) error {
 	if err != nil {
 		return err
 	}
+	s.open.iterators.Add(1)
+	defer s.open.iterators.Add(-1)
 
 	sCtx, cancel := signal.WithCancel(ctx, signal.WithInstrumentation(s.Child("frame_iterator")))
 	// Cancellation here would occur for one of two reasons. Either we encounter
@@ -162,10 +247,12 @@ func (s *Service) openIterator(ctx context.Context, srv IteratorStream) (framer.
 		return nil, err
 	}
 	iter, err := s.internal.NewStreamIterator(ctx, framer.IteratorConfig{
-		Bounds:           req.Bounds,
-		Keys:             req.Keys,
-		ChunkSize:        req.ChunkSize,
-		DownsampleFactor: req.DownsampleFactor,
+		Bounds:                 req.Bounds,
+		Keys:                   req.Keys,
+		ChunkSize:              req.ChunkSize,
+		DownsampleFactor:       req.DownsampleFactor,
+		DownsampleMode:         req.DownsampleMode,
+		DownsampleTargetPoints: req.DownsampleTargetPoints,
 	})
 	if err != nil {
 		return nil, err
@@ -192,6 +279,8 @@ func (s *Service) Stream(ctx context.Context, stream StreamerStream) error {
 	if err != nil {
 		return err
 	}
+	s.open.streamers.Add(1)
+	defer s.open.streamers.Add(-1)
 	var (
 		receiver = &freightfluence.Receiver[StreamerRequest]{Receiver: stream}
 		sender   = &freightfluence.Sender[StreamerResponse]{
@@ -339,14 +428,16 @@ func (s *Service) Write(_ctx context.Context, stream WriterStream) error {
 	// which case resources have already been freed and cancel does nothing).
 	defer cancel()
 
-	w, err := s.openWriter(ctx, auth.GetSubject(_ctx), stream)
+	w, err := s.openWriter(ctx, _ctx, stream)
 	if err != nil {
 		return err
 	}
+	s.open.writers.Add(1)
+	defer s.open.writers.Add(-1)
 
 	receiver := &freightfluence.TransformReceiver[framer.WriterRequest, WriterRequest]{
 		Receiver: stream,
-		Transform: func(_ context.Context, req WriterRequest) (framer.WriterRequest, bool, error) {
+		Transform: func(ctx context.Context, req WriterRequest) (framer.WriterRequest, bool, error) {
 			r := framer.WriterRequest{Command: req.Command, Frame: req.Frame}
 			if r.Command == writer.CommandSetAuthority {
 				// We decode like this because msgpack has a tough time decoding slices of uint8.
@@ -355,6 +446,20 @@ func (s *Service) Write(_ctx context.Context, stream WriterStream) error {
 					r.Config.Authorities[i] = control.Authority(a)
 				}
 				r.Config.Keys = req.Config.Keys
+				rec := apiaudit.NewRecord(_ctx, audit.KindAuthority, "set_authority")
+				rec.Objects = framer.OntologyIDs(r.Config.Keys)
+				rec.Diff = apiaudit.Diff(map[string]any{
+					"authorities": r.Config.Authorities,
+					"keys":        r.Config.Keys,
+				})
+				if err := s.log(ctx, rec); err != nil {
+					return r, false, err
+				}
+			}
+			if r.Command == writer.CommandWrite {
+				if err := s.logTaskCommands(_ctx, ctx, r.Frame); err != nil {
+					return r, false, err
+				}
 			}
 			return r, true, nil
 		},
@@ -383,11 +488,14 @@ func (s *Service) Write(_ctx context.Context, stream WriterStream) error {
 	return err
 }
 
+// openWriter opens a writer using the configuration in the first request received
+// from srv. reqCtx is the context of the request that opened the stream.
 func (s *Service) openWriter(
 	ctx context.Context,
-	subject ontology.ID,
+	reqCtx context.Context,
 	srv WriterStream,
 ) (framer.StreamWriter, error) {
+	subject := auth.GetSubject(reqCtx)
 	req, err := srv.Receive()
 	if err != nil {
 		return nil, err
@@ -417,7 +525,17 @@ func (s *Service) openWriter(
 		AutoIndexPersistInterval: req.Config.AutoIndexPersistInterval,
 		AutoIndex:                new(req.Config.AutoIndex),
 	})
+	rec := apiaudit.NewRecord(reqCtx, audit.KindWriter, "open")
+	rec.Objects = framer.OntologyIDs(req.Config.Keys)
+	rec.Diff = apiaudit.Diff(map[string]any{
+		"control_subject": req.Config.ControlSubject,
+		"authorities":     authorities,
+		"keys":            req.Config.Keys,
+	})
 	if err != nil {
+		rec.Error = err.Error()
+	}
+	if err = errors.Combine(err, s.log(ctx, rec)); err != nil {
 		return w, err
 	}
 
@@ -431,3 +549,42 @@ func (s *Service) openWriter(
 		Err:     errors.Encode(ctx, nil, false),
 	})
 }
+
+// logTaskCommands records every task command in the frame. Commands are recorded
+// before they are written, so a command that fails to be written is still recorded.
+// reqCtx is the context of the request that opened the writer.
+func (s *Service) logTaskCommands(reqCtx, ctx context.Context, frame Frame) error {
+	if s.taskCommands == 0 {
+		return nil
+	}
+	for k := range frame.Keys() {
+		if k != s.taskCommands {
+			continue
+		}
+		for _, series := range frame.Get(k).Series {
+			for sample := range series.Samples() {
+				var cmd task.Command
+				rec := apiaudit.NewRecord(reqCtx, audit.KindTaskCommand, "")
+				rec.Diff = string(sample)
+				if err := json.Unmarshal(sample, &cmd); err != nil {
+					rec.Error = err.Error()
+				} else {
+					rec.Action = cmd.Type
+					rec.Objects = []ontology.ID{task.OntologyID(cmd.Task)}
+				}
+				if err := s.log(ctx, rec); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func (s *Service) log(ctx context.Context, r audit.Record) error {
+	if s.audit == nil {
+		return nil
+	}
+	return s.audit.Log(ctx, r)
+}
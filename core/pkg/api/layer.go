@@ -21,8 +21,11 @@ import (
 	"github.com/synnaxlabs/freighter/alamos"
 	"github.com/synnaxlabs/freighter/recovery"
 	"github.com/synnaxlabs/synnax/pkg/api/access"
+	"github.com/synnaxlabs/synnax/pkg/api/apikey"
 	"github.com/synnaxlabs/synnax/pkg/api/arc"
+	"github.com/synnaxlabs/synnax/pkg/api/audit"
 	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/api/backup"
 	"github.com/synnaxlabs/synnax/pkg/api/channel"
 	"github.com/synnaxlabs/synnax/pkg/api/config"
 	"github.com/synnaxlabs/synnax/pkg/api/connectivity"
@@ -39,6 +42,7 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/api/ranger/alias"
 	"github.com/synnaxlabs/synnax/pkg/api/ranger/kv"
 	"github.com/synnaxlabs/synnax/pkg/api/schematic"
+	"github.com/synnaxlabs/synnax/pkg/api/session"
 	"github.com/synnaxlabs/synnax/pkg/api/status"
 	"github.com/synnaxlabs/synnax/pkg/api/table"
 	"github.com/synnaxlabs/synnax/pkg/api/task"
@@ -57,25 +61,42 @@ type Transport struct {
 	// AUTH
 	AuthLogin          freighter.UnaryServer[auth.LoginRequest, auth.LoginResponse]
 	AuthChangePassword freighter.UnaryServer[auth.ChangePasswordRequest, types.Nil]
+	AuthOIDCAuthorize  freighter.UnaryServer[auth.OIDCAuthorizeRequest, auth.OIDCAuthorizeResponse]
+	AuthOIDCLogin      freighter.UnaryServer[auth.OIDCLoginRequest, auth.LoginResponse]
+	AuthLogout         freighter.UnaryServer[auth.LogoutRequest, types.Nil]
+	// SESSION
+	SessionRetrieve freighter.UnaryServer[session.RetrieveRequest, session.RetrieveResponse]
+	SessionRevoke   freighter.UnaryServer[session.RevokeRequest, types.Nil]
 	// USER
 	UserRename         freighter.UnaryServer[user.RenameRequest, types.Nil]
 	UserChangeUsername freighter.UnaryServer[user.ChangeUsernameRequest, types.Nil]
 	UserCreate         freighter.UnaryServer[user.CreateRequest, user.CreateResponse]
 	UserDelete         freighter.UnaryServer[user.DeleteRequest, types.Nil]
 	UserRetrieve       freighter.UnaryServer[user.RetrieveRequest, user.RetrieveResponse]
+	// SERVICE ACCOUNT
+	ServiceAccountCreate   freighter.UnaryServer[apikey.ServiceAccountCreateRequest, apikey.ServiceAccountCreateResponse]
+	ServiceAccountRetrieve freighter.UnaryServer[apikey.ServiceAccountRetrieveRequest, apikey.ServiceAccountRetrieveResponse]
+	ServiceAccountDelete   freighter.UnaryServer[apikey.ServiceAccountDeleteRequest, types.Nil]
+	// API KEY
+	APIKeyCreate   freighter.UnaryServer[apikey.CreateRequest, apikey.CreateResponse]
+	APIKeyRetrieve freighter.UnaryServer[apikey.RetrieveRequest, apikey.RetrieveResponse]
+	APIKeyRotate   freighter.UnaryServer[apikey.RotateRequest, apikey.RotateResponse]
+	APIKeyDelete   freighter.UnaryServer[apikey.DeleteRequest, types.Nil]
 	// CHANNEL
 	ChannelCreate        freighter.UnaryServer[channel.CreateRequest, channel.CreateResponse]
 	ChannelRetrieve      freighter.UnaryServer[channel.RetrieveRequest, channel.RetrieveResponse]
 	ChannelDelete        freighter.UnaryServer[channel.DeleteRequest, types.Nil]
 	ChannelRename        freighter.UnaryServer[channel.RenameRequest, types.Nil]
+	ChannelTransferLease freighter.UnaryServer[channel.TransferLeaseRequest, types.Nil]
 	ChannelRetrieveGroup freighter.UnaryServer[channel.RetrieveGroupRequest, channel.RetrieveGroupResponse]
 	// CONNECTIVITY
 	ConnectivityCheck freighter.UnaryServer[types.Nil, connectivity.CheckResponse]
 	// FRAME
-	FrameWriter   freighter.StreamServer[framer.WriterRequest, framer.WriterResponse]
-	FrameIterator freighter.StreamServer[framer.IteratorRequest, framer.IteratorResponse]
-	FrameStreamer freighter.StreamServer[framer.StreamerRequest, framer.StreamerResponse]
-	FrameDelete   freighter.UnaryServer[framer.DeleteRequest, types.Nil]
+	FrameWriter    freighter.StreamServer[framer.WriterRequest, framer.WriterResponse]
+	FrameIterator  freighter.StreamServer[framer.IteratorRequest, framer.IteratorResponse]
+	FrameStreamer  freighter.StreamServer[framer.StreamerRequest, framer.StreamerResponse]
+	FrameDelete    freighter.UnaryServer[framer.DeleteRequest, types.Nil]
+	FrameAggregate freighter.UnaryServer[framer.AggregateRequest, framer.AggregateResponse]
 	// RANGE
 	RangeCreate   freighter.UnaryServer[ranger.CreateRequest, ranger.CreateResponse]
 	RangeRetrieve freighter.UnaryServer[ranger.RetrieveRequest, ranger.RetrieveResponse]
@@ -172,10 +193,14 @@ type Transport struct {
 	StatusDelete         freighter.UnaryServer[status.DeleteRequest, types.Nil]
 	StatusSetByKeyOrName freighter.UnaryServer[status.SetByKeyOrNameRequest, status.SetByKeyOrNameResponse]
 	// ARC
-	ArcCreate   freighter.UnaryServer[arc.CreateRequest, arc.CreateResponse]
-	ArcDelete   freighter.UnaryServer[arc.DeleteRequest, types.Nil]
-	ArcRetrieve freighter.UnaryServer[arc.RetrieveRequest, arc.RetrieveResponse]
-	ArcLSP      freighter.StreamServer[arc.LSPMessage, arc.LSPMessage]
+	ArcCreate          freighter.UnaryServer[arc.CreateRequest, arc.CreateResponse]
+	ArcDelete          freighter.UnaryServer[arc.DeleteRequest, types.Nil]
+	ArcRetrieve        freighter.UnaryServer[arc.RetrieveRequest, arc.RetrieveResponse]
+	ArcLSP             freighter.StreamServer[arc.LSPMessage, arc.LSPMessage]
+	ArcTest            freighter.UnaryServer[arc.TestRequest, arc.TestResponse]
+	ArcLibraryCreate   freighter.UnaryServer[arc.CreateLibraryRequest, arc.CreateLibraryResponse]
+	ArcLibraryDelete   freighter.UnaryServer[arc.DeleteLibraryRequest, types.Nil]
+	ArcLibraryRetrieve freighter.UnaryServer[arc.RetrieveLibraryRequest, arc.RetrieveLibraryResponse]
 	// VIEW
 	ViewCreate   freighter.UnaryServer[view.CreateRequest, view.CreateResponse]
 	ViewRetrieve freighter.UnaryServer[view.RetrieveRequest, view.RetrieveResponse]
@@ -183,6 +208,12 @@ type Transport struct {
 	// IMPORT/EXPORT
 	ImExImport freighter.UnaryServer[imex.ImportRequest, imex.ImportResponse]
 	ImExExport freighter.UnaryServer[imex.ExportRequest, imex.ExportResponse]
+	// BACKUP
+	BackupCreate freighter.StreamServer[backup.Request, backup.Response]
+	// AUDIT
+	AuditRetrieve freighter.UnaryServer[audit.RetrieveRequest, audit.RetrieveResponse]
+	AuditExport   freighter.UnaryServer[audit.ExportRequest, audit.ExportResponse]
+	AuditVerify   freighter.UnaryServer[audit.VerifyRequest, audit.VerifyResponse]
 }
 
 // Layer wraps all implemented API services into a single container. Protocol-specific Layer
@@ -191,6 +222,7 @@ type Layer struct {
 	Workspace    *workspace.Service
 	LinePlot     *lineplot.Service
 	User         *user.Service
+	APIKey       *apikey.Service
 	Framer       *framer.Service
 	Channel      *channel.Service
 	Connectivity *connectivity.Service
@@ -201,6 +233,7 @@ type Layer struct {
 	Group        *group.Service
 	Log          *log.Service
 	Auth         *auth.Service
+	Session      *session.Service
 	Schematic    *schematic.Service
 	View         *view.Service
 	Table        *table.Service
@@ -212,13 +245,15 @@ type Layer struct {
 	Arc          *arc.Service
 	Status       *status.Service
 	ImEx         *imex.Service
+	Backup       *backup.Service
+	Audit        *audit.Service
 	config       config.LayerConfig
 }
 
 // BindTo binds the API layer to the provided Transport implementation.
 func (l *Layer) BindTo(t Transport) {
 	var (
-		tk                 = auth.TokenMiddleware(l.config.Service.Token)
+		tk                 = auth.TokenMiddleware(l.config.Service.Token, l.config.Service.Session, l.config.Service.APIKey)
 		instrumentation    = lo.Must(alamos.Middleware(alamos.Config{Instrumentation: l.config.Instrumentation}))
 		rec                = recovery.Middleware(l.config.Instrumentation)
 		insecureMiddleware = []freighter.Middleware{rec, instrumentation}
@@ -226,10 +261,14 @@ func (l *Layer) BindTo(t Transport) {
 	)
 	copy(secureMiddleware, insecureMiddleware)
 	secureMiddleware = append(secureMiddleware, tk)
+	// al records logins and changes made to resources through the API.
+	al := l.config.Service.Audit
 
 	freighter.UseOnAll(
 		insecureMiddleware,
 		t.AuthLogin,
+		t.AuthOIDCAuthorize,
+		t.AuthOIDCLogin,
 		t.ConnectivityCheck,
 	)
 
@@ -238,6 +277,11 @@ func (l *Layer) BindTo(t Transport) {
 
 		// AUTH
 		t.AuthChangePassword,
+		t.AuthLogout,
+
+		// SESSION
+		t.SessionRetrieve,
+		t.SessionRevoke,
 
 		// USER
 		t.UserRename,
@@ -246,11 +290,23 @@ func (l *Layer) BindTo(t Transport) {
 		t.UserDelete,
 		t.UserRetrieve,
 
+		// SERVICE ACCOUNT
+		t.ServiceAccountCreate,
+		t.ServiceAccountRetrieve,
+		t.ServiceAccountDelete,
+
+		// API KEY
+		t.APIKeyCreate,
+		t.APIKeyRetrieve,
+		t.APIKeyRotate,
+		t.APIKeyDelete,
+
 		// CHANNEL
 		t.ChannelCreate,
 		t.ChannelRetrieve,
 		t.ChannelDelete,
 		t.ChannelRename,
+		t.ChannelTransferLease,
 		t.ChannelRetrieveGroup,
 
 		// FRAME
@@ -258,6 +314,7 @@ func (l *Layer) BindTo(t Transport) {
 		t.FrameIterator,
 		t.FrameStreamer,
 		t.FrameDelete,
+		t.FrameAggregate,
 
 		// ONTOLOGY
 		t.OntologyRetrieve,
@@ -380,163 +437,207 @@ func (l *Layer) BindTo(t Transport) {
 		t.ArcCreate,
 		t.ArcDelete,
 		t.ArcRetrieve,
+		t.ArcTest,
+		t.ArcLibraryCreate,
+		t.ArcLibraryDelete,
+		t.ArcLibraryRetrieve,
 
 		// IMPORT/EXPORT
 		t.ImExImport,
 		t.ImExExport,
+
+		// BACKUP
+		t.BackupCreate,
+
+		// AUDIT
+		t.AuditRetrieve,
+		t.AuditExport,
+		t.AuditVerify,
 	)
 
 	// AUTH
-	t.AuthLogin.BindHandler(l.Auth.Login)
-	t.AuthChangePassword.BindHandler(l.Auth.ChangePassword)
+	t.AuthLogin.BindHandler(audit.Login(al, l.Auth.Login))
+	t.AuthChangePassword.BindHandler(audit.Update(al, l.Auth.ChangePassword))
+	t.AuthOIDCAuthorize.BindHandler(l.Auth.OIDCAuthorize)
+	t.AuthOIDCLogin.BindHandler(audit.Login(al, l.Auth.OIDCLogin))
+	t.AuthLogout.BindHandler(audit.Logout(al, l.Auth.Logout))
+
+	// SESSION
+	t.SessionRetrieve.BindHandler(l.Session.Retrieve)
+	t.SessionRevoke.BindHandler(audit.Update(al, l.Session.Revoke))
 
 	// USER
-	t.UserRename.BindHandler(l.User.Rename)
-	t.UserChangeUsername.BindHandler(l.User.ChangeUsername)
-	t.UserCreate.BindHandler(l.User.Create)
-	t.UserDelete.BindHandler(l.User.Delete)
+	t.UserRename.BindHandler(audit.Update(al, l.User.Rename))
+	t.UserChangeUsername.BindHandler(audit.Update(al, l.User.ChangeUsername))
+	t.UserCreate.BindHandler(audit.Create(al, l.User.Create))
+	t.UserDelete.BindHandler(audit.Delete(al, l.User.Delete))
 	t.UserRetrieve.BindHandler(l.User.Retrieve)
 
+	// SERVICE ACCOUNT
+	t.ServiceAccountCreate.BindHandler(audit.Create(al, l.APIKey.CreateServiceAccount))
+	t.ServiceAccountRetrieve.BindHandler(l.APIKey.RetrieveServiceAccount)
+	t.ServiceAccountDelete.BindHandler(audit.Delete(al, l.APIKey.DeleteServiceAccount))
+
+	// API KEY
+	t.APIKeyCreate.BindHandler(audit.Create(al, l.APIKey.Create))
+	t.APIKeyRetrieve.BindHandler(l.APIKey.Retrieve)
+	t.APIKeyRotate.BindHandler(audit.Update(al, l.APIKey.Rotate))
+	t.APIKeyDelete.BindHandler(audit.Delete(al, l.APIKey.Delete))
+
 	// CHANNEL
-	t.ChannelCreate.BindHandler(l.Channel.Create)
+	t.ChannelCreate.BindHandler(audit.Create(al, l.Channel.Create))
 	t.ChannelRetrieve.BindHandler(l.Channel.Retrieve)
 	t.ConnectivityCheck.BindHandler(l.Connectivity.Check)
-	t.ChannelDelete.BindHandler(l.Channel.Delete)
-	t.ChannelRename.BindHandler(l.Channel.Rename)
+	t.ChannelDelete.BindHandler(audit.Delete(al, l.Channel.Delete))
+	t.ChannelRename.BindHandler(audit.Update(al, l.Channel.Rename))
+	t.ChannelTransferLease.BindHandler(audit.Update(al, l.Channel.TransferLease))
 	t.ChannelRetrieveGroup.BindHandler(l.Channel.RetrieveGroup)
 
 	// FRAME
 	t.FrameWriter.BindHandler(l.Framer.Write)
 	t.FrameIterator.BindHandler(l.Framer.Iterate)
 	t.FrameStreamer.BindHandler(l.Framer.Stream)
-	t.FrameDelete.BindHandler(l.Framer.Delete)
+	t.FrameDelete.BindHandler(audit.Delete(al, l.Framer.Delete))
+	t.FrameAggregate.BindHandler(l.Framer.Aggregate)
 
 	// ONTOLOGY
 	t.OntologyRetrieve.BindHandler(l.Ontology.Retrieve)
-	t.OntologyAddChildren.BindHandler(l.Ontology.AddChildren)
-	t.OntologyRemoveChildren.BindHandler(l.Ontology.RemoveChildren)
-	t.OntologyMoveChildren.BindHandler(l.Ontology.MoveChildren)
+	t.OntologyAddChildren.BindHandler(audit.Update(al, l.Ontology.AddChildren))
+	t.OntologyRemoveChildren.BindHandler(audit.Update(al, l.Ontology.RemoveChildren))
+	t.OntologyMoveChildren.BindHandler(audit.Update(al, l.Ontology.MoveChildren))
 
 	// GROUP
-	t.GroupCreate.BindHandler(l.Group.Create)
-	t.GroupDelete.BindHandler(l.Group.Delete)
-	t.GroupRename.BindHandler(l.Group.Rename)
+	t.GroupCreate.BindHandler(audit.Create(al, l.Group.Create))
+	t.GroupDelete.BindHandler(audit.Delete(al, l.Group.Delete))
+	t.GroupRename.BindHandler(audit.Update(al, l.Group.Rename))
 
 	// RANGE
 	t.RangeRetrieve.BindHandler(l.Range.Retrieve)
-	t.RangeCreate.BindHandler(l.Range.Create)
-	t.RangeDelete.BindHandler(l.Range.Delete)
-	t.RangeRename.BindHandler(l.Range.Rename)
+	t.RangeCreate.BindHandler(audit.Create(al, l.Range.Create))
+	t.RangeDelete.BindHandler(audit.Delete(al, l.Range.Delete))
+	t.RangeRename.BindHandler(audit.Update(al, l.Range.Rename))
 
 	// KV
 	t.KVGet.BindHandler(l.KV.Get)
-	t.KVSet.BindHandler(l.KV.Set)
-	t.KVDelete.BindHandler(l.KV.Delete)
+	t.KVSet.BindHandler(audit.Update(al, l.KV.Set))
+	t.KVDelete.BindHandler(audit.Delete(al, l.KV.Delete))
 
 	// ALIAS
-	t.AliasSet.BindHandler(l.Alias.Set)
+	t.AliasSet.BindHandler(audit.Update(al, l.Alias.Set))
 	t.AliasResolve.BindHandler(l.Alias.Resolve)
 	t.AliasRetrieve.BindHandler(l.Alias.Retrieve)
 	t.AliasList.BindHandler(l.Alias.List)
-	t.AliasDelete.BindHandler(l.Alias.Delete)
+	t.AliasDelete.BindHandler(audit.Delete(al, l.Alias.Delete))
 
 	// WORKSPACE
-	t.WorkspaceCreate.BindHandler(l.Workspace.Create)
-	t.WorkspaceDelete.BindHandler(l.Workspace.Delete)
+	t.WorkspaceCreate.BindHandler(audit.Create(al, l.Workspace.Create))
+	t.WorkspaceDelete.BindHandler(audit.Delete(al, l.Workspace.Delete))
 	t.WorkspaceRetrieve.BindHandler(l.Workspace.Retrieve)
-	t.WorkspaceRename.BindHandler(l.Workspace.Rename)
-	t.WorkspaceSetLayout.BindHandler(l.Workspace.SetLayout)
+	t.WorkspaceRename.BindHandler(audit.Update(al, l.Workspace.Rename))
+	t.WorkspaceSetLayout.BindHandler(audit.Update(al, l.Workspace.SetLayout))
 
 	// SCHEMATIC
-	t.SchematicCreate.BindHandler(l.Schematic.Create)
+	t.SchematicCreate.BindHandler(audit.Create(al, l.Schematic.Create))
 	t.SchematicRetrieve.BindHandler(l.Schematic.Retrieve)
-	t.SchematicDelete.BindHandler(l.Schematic.Delete)
-	t.SchematicSetData.BindHandler(l.Schematic.SetData)
-	t.SchematicDispatch.BindHandler(l.Schematic.Dispatch)
-	t.SchematicCopy.BindHandler(l.Schematic.Copy)
+	t.SchematicDelete.BindHandler(audit.Delete(al, l.Schematic.Delete))
+	t.SchematicSetData.BindHandler(audit.Update(al, l.Schematic.SetData))
+	t.SchematicDispatch.BindHandler(audit.Update(al, l.Schematic.Dispatch))
+	t.SchematicCopy.BindHandler(audit.Create(al, l.Schematic.Copy))
 
 	// SCHEMATIC SYMBOL
-	t.SchematicCreateSymbol.BindHandler(l.Schematic.CreateSymbol)
+	t.SchematicCreateSymbol.BindHandler(audit.Create(al, l.Schematic.CreateSymbol))
 	t.SchematicRetrieveSymbol.BindHandler(l.Schematic.RetrieveSymbol)
-	t.SchematicDeleteSymbol.BindHandler(l.Schematic.DeleteSymbol)
-	t.SchematicRenameSymbol.BindHandler(l.Schematic.RenameSymbol)
+	t.SchematicDeleteSymbol.BindHandler(audit.Delete(al, l.Schematic.DeleteSymbol))
+	t.SchematicRenameSymbol.BindHandler(audit.Update(al, l.Schematic.RenameSymbol))
 	t.SchematicRetrieveSymbolGroup.BindHandler(l.Schematic.RetrieveSymbolGroup)
 
 	// LINE PLOT
-	t.LinePlotCreate.BindHandler(l.LinePlot.Create)
-	t.LinePlotRename.BindHandler(l.LinePlot.Rename)
-	t.LinePlotSetData.BindHandler(l.LinePlot.SetData)
+	t.LinePlotCreate.BindHandler(audit.Create(al, l.LinePlot.Create))
+	t.LinePlotRename.BindHandler(audit.Update(al, l.LinePlot.Rename))
+	t.LinePlotSetData.BindHandler(audit.Update(al, l.LinePlot.SetData))
 	t.LinePlotRetrieve.BindHandler(l.LinePlot.Retrieve)
-	t.LinePlotDelete.BindHandler(l.LinePlot.Delete)
+	t.LinePlotDelete.BindHandler(audit.Delete(al, l.LinePlot.Delete))
 
 	// LOG
-	t.LogCreate.BindHandler(l.Log.Create)
+	t.LogCreate.BindHandler(audit.Create(al, l.Log.Create))
 	t.LogRetrieve.BindHandler(l.Log.Retrieve)
-	t.LogDelete.BindHandler(l.Log.Delete)
-	t.LogRename.BindHandler(l.Log.Rename)
-	t.LogSetData.BindHandler(l.Log.SetData)
+	t.LogDelete.BindHandler(audit.Delete(al, l.Log.Delete))
+	t.LogRename.BindHandler(audit.Update(al, l.Log.Rename))
+	t.LogSetData.BindHandler(audit.Update(al, l.Log.SetData))
 
 	// TABLE
-	t.TableCreate.BindHandler(l.Table.Create)
+	t.TableCreate.BindHandler(audit.Create(al, l.Table.Create))
 	t.TableRetrieve.BindHandler(l.Table.Retrieve)
-	t.TableDelete.BindHandler(l.Table.Delete)
-	t.TableRename.BindHandler(l.Table.Rename)
-	t.TableSetData.BindHandler(l.Table.SetData)
-	t.TableDispatch.BindHandler(l.Table.Dispatch)
+	t.TableDelete.BindHandler(audit.Delete(al, l.Table.Delete))
+	t.TableRename.BindHandler(audit.Update(al, l.Table.Rename))
+	t.TableSetData.BindHandler(audit.Update(al, l.Table.SetData))
+	t.TableDispatch.BindHandler(audit.Update(al, l.Table.Dispatch))
 
 	// LABEL
-	t.LabelCreate.BindHandler(l.Label.Create)
+	t.LabelCreate.BindHandler(audit.Create(al, l.Label.Create))
 	t.LabelRetrieve.BindHandler(l.Label.Retrieve)
-	t.LabelDelete.BindHandler(l.Label.Delete)
-	t.LabelAdd.BindHandler(l.Label.Add)
-	t.LabelRemove.BindHandler(l.Label.Remove)
+	t.LabelDelete.BindHandler(audit.Delete(al, l.Label.Delete))
+	t.LabelAdd.BindHandler(audit.Update(al, l.Label.Add))
+	t.LabelRemove.BindHandler(audit.Update(al, l.Label.Remove))
 
 	// RACK
-	t.RackCreate.BindHandler(l.Rack.Create)
+	t.RackCreate.BindHandler(audit.Create(al, l.Rack.Create))
 	t.RackRetrieve.BindHandler(l.Rack.Retrieve)
-	t.RackDelete.BindHandler(l.Rack.Delete)
+	t.RackDelete.BindHandler(audit.Delete(al, l.Rack.Delete))
 
 	// TASK
-	t.TaskCreate.BindHandler(l.Task.Create)
+	t.TaskCreate.BindHandler(audit.Create(al, l.Task.Create))
 	t.TaskRetrieve.BindHandler(l.Task.Retrieve)
-	t.TaskDelete.BindHandler(l.Task.Delete)
-	t.TaskCopy.BindHandler(l.Task.Copy)
+	t.TaskDelete.BindHandler(audit.Delete(al, l.Task.Delete))
+	t.TaskCopy.BindHandler(audit.Create(al, l.Task.Copy))
 
 	// DEVICE
-	t.DeviceCreate.BindHandler(l.Device.Create)
+	t.DeviceCreate.BindHandler(audit.Create(al, l.Device.Create))
 	t.DeviceRetrieve.BindHandler(l.Device.Retrieve)
-	t.DeviceDelete.BindHandler(l.Device.Delete)
+	t.DeviceDelete.BindHandler(audit.Delete(al, l.Device.Delete))
 
 	// ACCESS
-	t.AccessCreatePolicy.BindHandler(l.Access.CreatePolicy)
-	t.AccessDeletePolicy.BindHandler(l.Access.DeletePolicy)
+	t.AccessCreatePolicy.BindHandler(audit.Create(al, l.Access.CreatePolicy))
+	t.AccessDeletePolicy.BindHandler(audit.Delete(al, l.Access.DeletePolicy))
 	t.AccessRetrievePolicy.BindHandler(l.Access.RetrievePolicy)
-	t.AccessCreateRole.BindHandler(l.Access.CreateRole)
-	t.AccessDeleteRole.BindHandler(l.Access.DeleteRole)
+	t.AccessCreateRole.BindHandler(audit.Create(al, l.Access.CreateRole))
+	t.AccessDeleteRole.BindHandler(audit.Delete(al, l.Access.DeleteRole))
 	t.AccessRetrieveRole.BindHandler(l.Access.RetrieveRole)
-	t.AccessAssignRole.BindHandler(l.Access.AssignRole)
-	t.AccessUnassignRole.BindHandler(l.Access.UnassignRole)
+	t.AccessAssignRole.BindHandler(audit.Update(al, l.Access.AssignRole))
+	t.AccessUnassignRole.BindHandler(audit.Update(al, l.Access.UnassignRole))
 
 	// STATUS
-	t.StatusSet.BindHandler(l.Status.Set)
+	t.StatusSet.BindHandler(audit.Update(al, l.Status.Set))
 	t.StatusRetrieve.BindHandler(l.Status.Retrieve)
-	t.StatusDelete.BindHandler(l.Status.Delete)
-	t.StatusSetByKeyOrName.BindHandler(l.Status.SetByKeyOrName)
+	t.StatusDelete.BindHandler(audit.Delete(al, l.Status.Delete))
+	t.StatusSetByKeyOrName.BindHandler(audit.Update(al, l.Status.SetByKeyOrName))
 
 	// VIEW
-	t.ViewCreate.BindHandler(l.View.Create)
+	t.ViewCreate.BindHandler(audit.Create(al, l.View.Create))
 	t.ViewRetrieve.BindHandler(l.View.Retrieve)
-	t.ViewDelete.BindHandler(l.View.Delete)
+	t.ViewDelete.BindHandler(audit.Delete(al, l.View.Delete))
 
 	// ARC
-	t.ArcCreate.BindHandler(l.Arc.Create)
-	t.ArcDelete.BindHandler(l.Arc.Delete)
+	t.ArcCreate.BindHandler(audit.Create(al, l.Arc.Create))
+	t.ArcDelete.BindHandler(audit.Delete(al, l.Arc.Delete))
 	t.ArcRetrieve.BindHandler(l.Arc.Retrieve)
 	t.ArcLSP.BindHandler(l.Arc.LSP)
+	t.ArcTest.BindHandler(l.Arc.Test)
+	t.ArcLibraryCreate.BindHandler(audit.Create(al, l.Arc.CreateLibrary))
+	t.ArcLibraryDelete.BindHandler(audit.Delete(al, l.Arc.DeleteLibrary))
+	t.ArcLibraryRetrieve.BindHandler(l.Arc.RetrieveLibrary)
 
 	// IMPORT/EXPORT
-	t.ImExImport.BindHandler(l.ImEx.Import)
+	t.ImExImport.BindHandler(audit.Create(al, l.ImEx.Import))
 	t.ImExExport.BindHandler(l.ImEx.Export)
+
+	// BACKUP
+	t.BackupCreate.BindHandler(l.Backup.Create)
+
+	// AUDIT
+	t.AuditRetrieve.BindHandler(l.Audit.Retrieve)
+	t.AuditExport.BindHandler(l.Audit.Export)
+	t.AuditVerify.BindHandler(l.Audit.Verify)
 }
 
 // NewLayer instantiates the server API layer using the provided Configs. This should
@@ -553,6 +654,12 @@ func NewLayer(cfgs ...LayerConfig) (*Layer, error) {
 	if l.User, err = user.NewService(cfg); err != nil {
 		return nil, err
 	}
+	if l.Session, err = session.NewService(cfg); err != nil {
+		return nil, err
+	}
+	if l.APIKey, err = apikey.NewService(cfg); err != nil {
+		return nil, err
+	}
 	if l.Access, err = access.NewService(cfg); err != nil {
 		return nil, err
 	}
@@ -619,5 +726,11 @@ func NewLayer(cfgs ...LayerConfig) (*Layer, error) {
 	if l.ImEx, err = imex.NewService(cfg); err != nil {
 		return nil, err
 	}
+	if l.Backup, err = backup.NewService(cfg); err != nil {
+		return nil, err
+	}
+	if l.Audit, err = audit.NewService(cfg); err != nil {
+		return nil, err
+	}
 	return l, nil
 }
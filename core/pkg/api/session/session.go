@@ -0,0 +1,137 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package session
+
+import (
+	"context"
+	"go/types"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/api/config"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/access"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/session"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	xconfig "github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/query"
+)
+
+// Service is the API for listing and revoking the sessions of users. Users can always
+// list and revoke their own sessions, while doing so for other users requires
+// permission to retrieve or update them respectively.
+type Service struct {
+	db       *gorp.DB
+	access   *rbac.Service
+	internal *session.Service
+}
+
+// NewService creates a new Service for listing and revoking sessions.
+func NewService(cfgs ...config.LayerConfig) (*Service, error) {
+	cfg, err := xconfig.New(config.DefaultLayerConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		db:       cfg.Distribution.DB,
+		access:   cfg.Service.RBAC,
+		internal: cfg.Service.Session,
+	}, nil
+}
+
+type (
+	RetrieveRequest struct {
+		Users []user.Key `json:"users" msgpack:"users"`
+	}
+	RetrieveResponse struct {
+		Sessions []session.Session `json:"sessions" msgpack:"sessions"`
+	}
+)
+
+// Retrieve returns the active sessions of the provided users, or of the user making
+// the request if no users are provided.
+func (s *Service) Retrieve(ctx context.Context, req RetrieveRequest) (RetrieveResponse, error) {
+	subject := auth.GetSubject(ctx)
+	users := req.Users
+	if len(users) == 0 {
+		key, err := user.KeyFromOntologyID(subject)
+		if err != nil {
+			return RetrieveResponse{}, err
+		}
+		users = []user.Key{key}
+	}
+	if err := s.enforce(ctx, access.ActionRetrieve, users); err != nil {
+		return RetrieveResponse{}, err
+	}
+	sessions, err := s.internal.Retrieve(ctx, nil, users...)
+	if err != nil {
+		return RetrieveResponse{}, err
+	}
+	return RetrieveResponse{Sessions: sessions}, nil
+}
+
+type RevokeRequest struct {
+	// Keys are the keys of the sessions to revoke.
+	Keys []uuid.UUID `json:"keys" msgpack:"keys"`
+	// Users are the keys of the users to revoke all sessions of, logging them out of
+	// every client.
+	Users []user.Key `json:"users" msgpack:"users"`
+}
+
+// Revoke revokes the sessions with the provided keys and all sessions of the provided
+// users. Tokens issued for revoked sessions are rejected, and streams opened with them
+// closed, immediately.
+func (s *Service) Revoke(ctx context.Context, req RevokeRequest) (types.Nil, error) {
+	sessions, err := s.internal.RetrieveByKey(ctx, nil, req.Keys...)
+	if err != nil && !errors.Is(err, query.ErrNotFound) {
+		return types.Nil{}, err
+	}
+	users := append(
+		lo.Map(sessions, func(ses session.Session, _ int) user.Key { return ses.User }),
+		req.Users...,
+	)
+	if err = s.enforce(ctx, access.ActionUpdate, users); err != nil {
+		return types.Nil{}, err
+	}
+	keys := lo.Map(sessions, func(ses session.Session, _ int) uuid.UUID { return ses.Key })
+	return types.Nil{}, s.db.WithTx(ctx, func(tx gorp.Tx) error {
+		w := s.internal.NewWriter(tx)
+		if err := w.Revoke(ctx, keys...); err != nil {
+			return err
+		}
+		return w.RevokeUsers(ctx, req.Users...)
+	})
+}
+
+// enforce checks that the subject of the request is allowed to perform action on the
+// given users, excluding the subject itself.
+func (s *Service) enforce(
+	ctx context.Context,
+	action access.Action,
+	users []user.Key,
+) error {
+	subject := auth.GetSubject(ctx)
+	objects := lo.Filter(
+		user.OntologyIDsFromKeys(lo.Uniq(users)),
+		func(id ontology.ID, _ int) bool { return id != subject },
+	)
+	if len(objects) == 0 {
+		return nil
+	}
+	return s.access.Enforce(ctx, access.Request{
+		Subject: subject,
+		Action:  action,
+		Objects: objects,
+	})
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/service/access"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
 	svcauth "github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/apikey"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/session"
 	"github.com/synnaxlabs/synnax/pkg/service/user"
 	xconfig "github.com/synnaxlabs/x/config"
 	"github.com/synnaxlabs/x/errors"
@@ -33,6 +35,8 @@ type Service struct {
 	access   *rbac.Service
 	internal *user.Service
 	auth     *svcauth.Service
+	session  *session.Service
+	apiKey   *apikey.Service
 }
 
 // NewService creates a new Service that allows for registering, updating, and
@@ -47,6 +51,8 @@ func NewService(cfgs ...config.LayerConfig) (*Service, error) {
 		access:   cfg.Service.RBAC,
 		internal: cfg.Service.User,
 		auth:     cfg.Service.Auth,
+		session:  cfg.Service.Session,
+		apiKey:   cfg.Service.APIKey,
 	}, nil
 }
 
@@ -208,7 +214,8 @@ type DeleteRequest struct {
 	Keys []user.Key `json:"keys" msgpack:"keys"`
 }
 
-// Delete removes the users with the provided keys from the Synnax cluster.
+// Delete removes the users with the provided keys from the Synnax cluster. The
+// sessions of the users are revoked, immediately closing any streams they have open.
 func (s *Service) Delete(ctx context.Context, req DeleteRequest) (types.Nil, error) {
 	if err := s.access.Enforce(ctx, access.Request{
 		Subject: auth.GetSubject(ctx),
@@ -234,6 +241,14 @@ func (s *Service) Delete(ctx context.Context, req DeleteRequest) (types.Nil, err
 		if err := s.internal.NewWriter(tx).Delete(ctx, req.Keys...); err != nil {
 			return err
 		}
+		if err := s.session.NewWriter(tx).RevokeUsers(ctx, req.Keys...); err != nil {
+			return err
+		}
+		// Revoke the API keys of any deleted service accounts so that their open
+		// streams are closed.
+		if err := s.apiKey.NewWriter(tx).DeleteServiceAccounts(ctx, req.Keys...); err != nil {
+			return err
+		}
 		if len(toDelete) == 0 {
 			return nil
 		}
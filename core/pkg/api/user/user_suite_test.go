@@ -24,6 +24,8 @@ import (
 	svc "github.com/synnaxlabs/synnax/pkg/service"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
 	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/apikey"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/session"
 	"github.com/synnaxlabs/synnax/pkg/service/user"
 	"github.com/synnaxlabs/x/gorp"
 	"github.com/synnaxlabs/x/kv/memkv"
@@ -38,11 +40,13 @@ func TestAPIUser(t *testing.T) {
 var _ = ShouldNotLeakGoroutinesPerSpec()
 
 var (
-	db      *gorp.DB
-	authSvc *auth.Service
-	userSvc *user.Service
-	apiSvc  *apiuser.Service
-	root    user.User
+	db         *gorp.DB
+	authSvc    *auth.Service
+	userSvc    *user.Service
+	sessionSvc *session.Service
+	apiKeySvc  *apikey.Service
+	apiSvc     *apiuser.Service
+	root       user.User
 )
 
 var _ = BeforeSuite(func(ctx SpecContext) {
@@ -68,12 +72,20 @@ var _ = BeforeSuite(func(ctx SpecContext) {
 		Search:   searchIdx,
 		User:     userSvc,
 	}))
+	sessionSvc = MustOpen(session.OpenService(ctx, session.ServiceConfig{DB: db}))
+	apiKeySvc = MustOpen(apikey.OpenService(ctx, apikey.ServiceConfig{
+		DB:     db,
+		User:   userSvc,
+		Policy: rbacSvc.Policy,
+	}))
 	apiSvc = MustSucceed(apiuser.NewService(apicfg.LayerConfig{
 		Distribution: &distribution.Layer{DB: db},
 		Service: &svc.Layer{
-			User: userSvc,
-			RBAC: rbacSvc,
-			Auth: authSvc,
+			User:    userSvc,
+			RBAC:    rbacSvc,
+			Auth:    authSvc,
+			Session: sessionSvc,
+			APIKey:  apiKeySvc,
 		},
 	}))
 	root = findRoot(ctx, userSvc, "api-user-suite-root")
@@ -10,6 +10,8 @@
 package user_test
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -17,8 +19,11 @@ import (
 	apiuser "github.com/synnaxlabs/synnax/pkg/api/user"
 	"github.com/synnaxlabs/synnax/pkg/service/access"
 	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/apikey"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/session"
 	"github.com/synnaxlabs/synnax/pkg/service/user"
 	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/telem"
 	. "github.com/synnaxlabs/x/testutil"
 )
 
@@ -247,6 +252,38 @@ var _ = Describe("Service", func() {
 				Password: "password",
 			})).To(MatchError(auth.ErrInvalidCredentials))
 		})
+		It("Should revoke the sessions of the deleted users", func(ctx SpecContext) {
+			created := MustSucceed(userSvc.NewWriter(nil).Create(ctx, user.User{
+				Username: uuid.NewString(),
+			}))
+			ses := session.Session{User: created.Key, ExpiresAt: telem.Now().Add(telem.Hour)}
+			Expect(sessionSvc.NewWriter(nil).Create(ctx, &ses)).To(Succeed())
+			Expect(apiSvc.Delete(
+				rootCtx(ctx),
+				apiuser.DeleteRequest{Keys: []user.Key{created.Key}},
+			)).Error().To(Not(HaveOccurred()))
+			Expect(sessionSvc.Validate(ctx, ses.Key)).Error().
+				To(MatchError(session.ErrRevoked))
+		})
+		It("Should revoke the API keys of deleted service accounts", func(ctx SpecContext) {
+			account, _ := MustSucceed2(apiKeySvc.NewWriter(nil).CreateServiceAccount(
+				ctx,
+				user.User{Username: "ci-" + uuid.NewString()},
+				"Continuous integration",
+			))
+			k := apikey.Key{Name: "key", ServiceAccount: account.Key}
+			tk := MustSucceed(apiKeySvc.NewWriter(nil).Create(ctx, &k))
+			wCtx, cancel := apiKeySvc.Watch(ctx, k.Key)
+			defer cancel()
+			Expect(apiSvc.Delete(
+				rootCtx(ctx),
+				apiuser.DeleteRequest{Keys: []user.Key{account.Key}},
+			)).Error().To(Not(HaveOccurred()))
+			Eventually(wCtx.Done()).Should(BeClosed())
+			Expect(context.Cause(wCtx)).To(MatchError(apikey.ErrRevoked))
+			Expect(apiKeySvc.Authenticate(ctx, tk)).Error().
+				To(MatchError(auth.ErrInvalidToken))
+		})
 		It("Should deny access when the subject lacks delete permission", func(ctx SpecContext) {
 			u := MustSucceed(userSvc.NewWriter(nil).Create(ctx, user.User{
 				Username: "delete-denied-" + uuid.NewString(),
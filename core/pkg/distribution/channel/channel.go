@@ -197,6 +197,10 @@ func (c Channel) Lease() node.Key { return c.Leaseholder }
 // non-leased virtual channel.
 func (c Channel) Free() bool { return c.Leaseholder == node.KeyFree }
 
+// Replicated returns true if the channel's data is stored on nodes other than its
+// leaseholder.
+func (c Channel) Replicated() bool { return c.ReplicationFactor > 1 }
+
 // Storage returns the storage layer representation of the channel for creation in the
 // storage ts.DB.
 func (c Channel) Storage() ts.Channel {
@@ -208,9 +212,15 @@ func (c Channel) Storage() ts.Channel {
 		Index:       ts.ChannelKey(c.Index()),
 		Virtual:     c.Virtual,
 		Concurrency: c.Concurrency,
+		Retention:   c.Retention.Storage(),
 	}
 }
 
+// Storage returns the storage layer representation of the retention policy.
+func (r Retention) Storage() ts.Retention {
+	return ts.Retention{MaxAge: r.MaxAge, MaxSize: r.MaxSize}
+}
+
 // toStorage converts a slice of channels to their storage layer equivalent.
 func toStorage(channels []Channel) []ts.Channel {
 	return lo.Map(channels, func(c Channel, _ int) ts.Channel { return c.Storage() })
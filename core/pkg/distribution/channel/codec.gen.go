@@ -38,6 +38,20 @@ func (c Channel) EncodeOrc(w *orc.Writer) error {
 		}
 	}
 	w.String(c.Expression)
+	if err := c.Retention.EncodeOrc(w); err != nil {
+		return err
+	}
+	w.Uint8(uint8(c.ReplicationFactor))
+	w.Bool(c.Replicas != nil)
+	if c.Replicas != nil {
+		w.Uint32(uint32(len(c.Replicas)))
+		for i := range c.Replicas {
+			w.Uint16(uint16(c.Replicas[i]))
+		}
+	}
+	if err := c.Safety.EncodeOrc(w); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -111,6 +125,62 @@ func (c *Channel) DecodeOrc(r *orc.Reader) error {
 	if c.Expression, err = r.String(); err != nil {
 		return err
 	}
+	if err = c.Retention.DecodeOrc(r); err != nil {
+		return err
+	}
+	if c.ReplicationFactor, err = r.Uint8(); err != nil {
+		return err
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			n, err := r.CollectionLen()
+			if err != nil {
+				return err
+			}
+			c.Replicas = make([]node.Key, n)
+			for i := range c.Replicas {
+				{
+					v, err := r.Uint16()
+					if err != nil {
+						return err
+					}
+					c.Replicas[i] = node.Key(v)
+				}
+			}
+		}
+	}
+	if err = c.Safety.DecodeOrc(r); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (iv Interlock) EncodeOrc(w *orc.Writer) error {
+	w.Uint32(uint32(iv.Channel))
+	w.String(iv.Comparison)
+	w.Float64(float64(iv.Value))
+	return nil
+}
+
+func (iv *Interlock) DecodeOrc(r *orc.Reader) error {
+	var err error
+	{
+		v, err := r.Uint32()
+		if err != nil {
+			return err
+		}
+		iv.Channel = Key(v)
+	}
+	if iv.Comparison, err = r.String(); err != nil {
+		return err
+	}
+	if iv.Value, err = r.Float64(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -145,3 +215,138 @@ func (o *Operation) DecodeOrc(r *orc.Reader) error {
 	}
 	return nil
 }
+
+func (rv Retention) EncodeOrc(w *orc.Writer) error {
+	w.Int64(int64(rv.MaxAge))
+	w.Int64(int64(rv.MaxSize))
+	return nil
+}
+
+func (rv *Retention) DecodeOrc(r *orc.Reader) error {
+	{
+		v, err := r.Int64()
+		if err != nil {
+			return err
+		}
+		rv.MaxAge = telem.TimeSpan(v)
+	}
+	{
+		v, err := r.Int64()
+		if err != nil {
+			return err
+		}
+		rv.MaxSize = telem.Size(v)
+	}
+	return nil
+}
+
+func (s Safety) EncodeOrc(w *orc.Writer) error {
+	if s.Min != nil {
+		w.Bool(true)
+		w.Float64(float64((*s.Min)))
+	} else {
+		w.Bool(false)
+	}
+	if s.Max != nil {
+		w.Bool(true)
+		w.Float64(float64((*s.Max)))
+	} else {
+		w.Bool(false)
+	}
+	if s.MaxRate != nil {
+		w.Bool(true)
+		w.Float64(float64((*s.MaxRate)))
+	} else {
+		w.Bool(false)
+	}
+	w.Bool(s.Interlocks != nil)
+	if s.Interlocks != nil {
+		w.Uint32(uint32(len(s.Interlocks)))
+		for i := range s.Interlocks {
+			if err := s.Interlocks[i].EncodeOrc(w); err != nil {
+				return err
+			}
+		}
+	}
+	if s.SafeValue != nil {
+		w.Bool(true)
+		w.Float64(float64((*s.SafeValue)))
+	} else {
+		w.Bool(false)
+	}
+	return nil
+}
+
+func (s *Safety) DecodeOrc(r *orc.Reader) error {
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			var hv float64
+			if hv, err = r.Float64(); err != nil {
+				return err
+			}
+			s.Min = &hv
+		}
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			var hv float64
+			if hv, err = r.Float64(); err != nil {
+				return err
+			}
+			s.Max = &hv
+		}
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			var hv float64
+			if hv, err = r.Float64(); err != nil {
+				return err
+			}
+			s.MaxRate = &hv
+		}
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			n, err := r.CollectionLen()
+			if err != nil {
+				return err
+			}
+			s.Interlocks = make([]Interlock, n)
+			for i := range s.Interlocks {
+				if err = s.Interlocks[i].DecodeOrc(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			var hv float64
+			if hv, err = r.Float64(); err != nil {
+				return err
+			}
+			s.SafeValue = &hv
+		}
+	}
+	return nil
+}
@@ -54,33 +54,92 @@ var _ = Describe("Codec", func() {
 						Duration:     telem.TimeSpan(14),
 					},
 				},
-				Expression: "test_14",
+				Expression:        "test_14",
+				Retention:         channel.Retention{MaxAge: telem.TimeSpan(17), MaxSize: telem.Size(18)},
+				ReplicationFactor: 19,
+				Replicas:          []node.Key{node.Key(20)},
+				Safety: channel.Safety{
+					Min:     func() *float64 { v := float64(21.5); return &v }(),
+					Max:     func() *float64 { v := float64(22.5); return &v }(),
+					MaxRate: func() *float64 { v := float64(23.5); return &v }(),
+					Interlocks: []channel.Interlock{
+						{
+							Channel:    channel.Key(26),
+							Comparison: "test_26",
+							Value:      27.5,
+						},
+					},
+					SafeValue: func() *float64 { v := float64(28.5); return &v }(),
+				},
 			}),
 			Entry("zero values", channel.Channel{
-				Name:        "",
-				Leaseholder: node.Key(0),
-				DataType:    telem.DataType(""),
-				IsIndex:     false,
-				LocalKey:    channel.LocalKey(0),
-				LocalIndex:  channel.LocalKey(0),
-				Virtual:     false,
-				Concurrency: control.Concurrency(0),
-				Internal:    false,
-				Operations:  nil,
-				Expression:  "",
+				Name:              "",
+				Leaseholder:       node.Key(0),
+				DataType:          telem.DataType(""),
+				IsIndex:           false,
+				LocalKey:          channel.LocalKey(0),
+				LocalIndex:        channel.LocalKey(0),
+				Virtual:           false,
+				Concurrency:       control.Concurrency(0),
+				Internal:          false,
+				Operations:        nil,
+				Expression:        "",
+				Retention:         channel.Retention{MaxAge: telem.TimeSpan(0), MaxSize: telem.Size(0)},
+				ReplicationFactor: 0,
+				Replicas:          nil,
+				Safety: channel.Safety{
+					Min:        nil,
+					Max:        nil,
+					MaxRate:    nil,
+					Interlocks: nil,
+					SafeValue:  nil,
+				},
 			}),
 			Entry("empty collections", channel.Channel{
-				Name:        "test_1",
-				Leaseholder: node.Key(3),
-				DataType:    telem.DataType("test_3"),
-				IsIndex:     false,
-				LocalKey:    channel.LocalKey(6),
-				LocalIndex:  channel.LocalKey(7),
-				Virtual:     true,
-				Concurrency: control.Concurrency(0),
-				Internal:    true,
-				Operations:  []channel.Operation{},
-				Expression:  "test_11",
+				Name:              "test_1",
+				Leaseholder:       node.Key(3),
+				DataType:          telem.DataType("test_3"),
+				IsIndex:           false,
+				LocalKey:          channel.LocalKey(6),
+				LocalIndex:        channel.LocalKey(7),
+				Virtual:           true,
+				Concurrency:       control.Concurrency(0),
+				Internal:          true,
+				Operations:        []channel.Operation{},
+				Expression:        "test_11",
+				Retention:         channel.Retention{MaxAge: telem.TimeSpan(14), MaxSize: telem.Size(15)},
+				ReplicationFactor: 16,
+				Replicas:          []node.Key{},
+				Safety: channel.Safety{
+					Min:        func() *float64 { v := float64(18.5); return &v }(),
+					Max:        func() *float64 { v := float64(19.5); return &v }(),
+					MaxRate:    func() *float64 { v := float64(20.5); return &v }(),
+					Interlocks: []channel.Interlock{},
+					SafeValue:  func() *float64 { v := float64(22.5); return &v }(),
+				},
+			}),
+		)
+	})
+	Describe("Interlock", func() {
+		DescribeTable("should round-trip encode and decode",
+			func(original channel.Interlock) {
+				w := orc.NewWriter(0)
+				Expect(original.EncodeOrc(w)).To(Succeed())
+				var decoded channel.Interlock
+				r := orc.NewReader(nil)
+				r.ResetBytes(w.Bytes())
+				Expect(decoded.DecodeOrc(r)).To(Succeed())
+				Expect(decoded).To(Equal(original))
+			},
+			Entry("fully populated", channel.Interlock{
+				Channel:    channel.Key(2),
+				Comparison: "test_2",
+				Value:      3.5,
+			}),
+			Entry("zero values", channel.Interlock{
+				Channel:    channel.Key(0),
+				Comparison: "",
+				Value:      0,
 			}),
 		)
 	})
@@ -107,6 +166,61 @@ var _ = Describe("Codec", func() {
 			}),
 		)
 	})
+	Describe("Retention", func() {
+		DescribeTable("should round-trip encode and decode",
+			func(original channel.Retention) {
+				w := orc.NewWriter(0)
+				Expect(original.EncodeOrc(w)).To(Succeed())
+				var decoded channel.Retention
+				r := orc.NewReader(nil)
+				r.ResetBytes(w.Bytes())
+				Expect(decoded.DecodeOrc(r)).To(Succeed())
+				Expect(decoded).To(Equal(original))
+			},
+			Entry("fully populated", channel.Retention{MaxAge: telem.TimeSpan(2), MaxSize: telem.Size(3)}),
+			Entry("zero values", channel.Retention{MaxAge: telem.TimeSpan(0), MaxSize: telem.Size(0)}),
+		)
+	})
+	Describe("Safety", func() {
+		DescribeTable("should round-trip encode and decode",
+			func(original channel.Safety) {
+				w := orc.NewWriter(0)
+				Expect(original.EncodeOrc(w)).To(Succeed())
+				var decoded channel.Safety
+				r := orc.NewReader(nil)
+				r.ResetBytes(w.Bytes())
+				Expect(decoded.DecodeOrc(r)).To(Succeed())
+				Expect(decoded).To(Equal(original))
+			},
+			Entry("fully populated", channel.Safety{
+				Min:     func() *float64 { v := float64(1.5); return &v }(),
+				Max:     func() *float64 { v := float64(2.5); return &v }(),
+				MaxRate: func() *float64 { v := float64(3.5); return &v }(),
+				Interlocks: []channel.Interlock{
+					{
+						Channel:    channel.Key(6),
+						Comparison: "test_6",
+						Value:      7.5,
+					},
+				},
+				SafeValue: func() *float64 { v := float64(8.5); return &v }(),
+			}),
+			Entry("zero values", channel.Safety{
+				Min:        nil,
+				Max:        nil,
+				MaxRate:    nil,
+				Interlocks: nil,
+				SafeValue:  nil,
+			}),
+			Entry("empty collections", channel.Safety{
+				Min:        func() *float64 { v := float64(1.5); return &v }(),
+				Max:        func() *float64 { v := float64(2.5); return &v }(),
+				MaxRate:    func() *float64 { v := float64(3.5); return &v }(),
+				Interlocks: []channel.Interlock{},
+				SafeValue:  func() *float64 { v := float64(5.5); return &v }(),
+			}),
+		)
+	})
 })
 
 func BenchmarkEncodeDecodeChannel(b *testing.B) {
@@ -127,7 +241,23 @@ func BenchmarkEncodeDecodeChannel(b *testing.B) {
 				Duration:     telem.TimeSpan(14),
 			},
 		},
-		Expression: "test_14",
+		Expression:        "test_14",
+		Retention:         channel.Retention{MaxAge: telem.TimeSpan(17), MaxSize: telem.Size(18)},
+		ReplicationFactor: 19,
+		Replicas:          []node.Key{node.Key(20)},
+		Safety: channel.Safety{
+			Min:     func() *float64 { v := float64(21.5); return &v }(),
+			Max:     func() *float64 { v := float64(22.5); return &v }(),
+			MaxRate: func() *float64 { v := float64(23.5); return &v }(),
+			Interlocks: []channel.Interlock{
+				{
+					Channel:    channel.Key(26),
+					Comparison: "test_26",
+					Value:      27.5,
+				},
+			},
+			SafeValue: func() *float64 { v := float64(28.5); return &v }(),
+		},
 	}
 	w := orc.NewWriter(0)
 	r := orc.NewReader(nil)
@@ -144,6 +274,27 @@ func BenchmarkEncodeDecodeChannel(b *testing.B) {
 	}
 }
 
+func BenchmarkEncodeDecodeInterlock(b *testing.B) {
+	iv := channel.Interlock{
+		Channel:    channel.Key(2),
+		Comparison: "test_2",
+		Value:      3.5,
+	}
+	w := orc.NewWriter(0)
+	r := orc.NewReader(nil)
+	for i := 0; i < b.N; i++ {
+		w.Reset()
+		if err := iv.EncodeOrc(w); err != nil {
+			b.Fatal(err)
+		}
+		var decoded channel.Interlock
+		r.ResetBytes(w.Bytes())
+		if err := decoded.DecodeOrc(r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkEncodeDecodeOperation(b *testing.B) {
 	o := channel.Operation{
 		Type:         channel.OperationType("min"),
@@ -165,6 +316,52 @@ func BenchmarkEncodeDecodeOperation(b *testing.B) {
 	}
 }
 
+func BenchmarkEncodeDecodeRetention(b *testing.B) {
+	rv := channel.Retention{MaxAge: telem.TimeSpan(2), MaxSize: telem.Size(3)}
+	w := orc.NewWriter(0)
+	r := orc.NewReader(nil)
+	for i := 0; i < b.N; i++ {
+		w.Reset()
+		if err := rv.EncodeOrc(w); err != nil {
+			b.Fatal(err)
+		}
+		var decoded channel.Retention
+		r.ResetBytes(w.Bytes())
+		if err := decoded.DecodeOrc(r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodeDecodeSafety(b *testing.B) {
+	s := channel.Safety{
+		Min:     func() *float64 { v := float64(1.5); return &v }(),
+		Max:     func() *float64 { v := float64(2.5); return &v }(),
+		MaxRate: func() *float64 { v := float64(3.5); return &v }(),
+		Interlocks: []channel.Interlock{
+			{
+				Channel:    channel.Key(6),
+				Comparison: "test_6",
+				Value:      7.5,
+			},
+		},
+		SafeValue: func() *float64 { v := float64(8.5); return &v }(),
+	}
+	w := orc.NewWriter(0)
+	r := orc.NewReader(nil)
+	for i := 0; i < b.N; i++ {
+		w.Reset()
+		if err := s.EncodeOrc(w); err != nil {
+			b.Fatal(err)
+		}
+		var decoded channel.Safety
+		r.ResetBytes(w.Bytes())
+		if err := decoded.DecodeOrc(r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func FuzzDecodeChannel(f *testing.F) {
 	{
 		seed := channel.Channel{
@@ -184,7 +381,23 @@ func FuzzDecodeChannel(f *testing.F) {
 					Duration:     telem.TimeSpan(14),
 				},
 			},
-			Expression: "test_14",
+			Expression:        "test_14",
+			Retention:         channel.Retention{MaxAge: telem.TimeSpan(17), MaxSize: telem.Size(18)},
+			ReplicationFactor: 19,
+			Replicas:          []node.Key{node.Key(20)},
+			Safety: channel.Safety{
+				Min:     func() *float64 { v := float64(21.5); return &v }(),
+				Max:     func() *float64 { v := float64(22.5); return &v }(),
+				MaxRate: func() *float64 { v := float64(23.5); return &v }(),
+				Interlocks: []channel.Interlock{
+					{
+						Channel:    channel.Key(26),
+						Comparison: "test_26",
+						Value:      27.5,
+					},
+				},
+				SafeValue: func() *float64 { v := float64(28.5); return &v }(),
+			},
 		}
 		w := orc.NewWriter(0)
 		if err := seed.EncodeOrc(w); err != nil {
@@ -194,17 +407,27 @@ func FuzzDecodeChannel(f *testing.F) {
 	}
 	{
 		seed := channel.Channel{
-			Name:        "",
-			Leaseholder: node.Key(0),
-			DataType:    telem.DataType(""),
-			IsIndex:     false,
-			LocalKey:    channel.LocalKey(0),
-			LocalIndex:  channel.LocalKey(0),
-			Virtual:     false,
-			Concurrency: control.Concurrency(0),
-			Internal:    false,
-			Operations:  nil,
-			Expression:  "",
+			Name:              "",
+			Leaseholder:       node.Key(0),
+			DataType:          telem.DataType(""),
+			IsIndex:           false,
+			LocalKey:          channel.LocalKey(0),
+			LocalIndex:        channel.LocalKey(0),
+			Virtual:           false,
+			Concurrency:       control.Concurrency(0),
+			Internal:          false,
+			Operations:        nil,
+			Expression:        "",
+			Retention:         channel.Retention{MaxAge: telem.TimeSpan(0), MaxSize: telem.Size(0)},
+			ReplicationFactor: 0,
+			Replicas:          nil,
+			Safety: channel.Safety{
+				Min:        nil,
+				Max:        nil,
+				MaxRate:    nil,
+				Interlocks: nil,
+				SafeValue:  nil,
+			},
 		}
 		w := orc.NewWriter(0)
 		if err := seed.EncodeOrc(w); err != nil {
@@ -214,17 +437,27 @@ func FuzzDecodeChannel(f *testing.F) {
 	}
 	{
 		seed := channel.Channel{
-			Name:        "test_1",
-			Leaseholder: node.Key(3),
-			DataType:    telem.DataType("test_3"),
-			IsIndex:     false,
-			LocalKey:    channel.LocalKey(6),
-			LocalIndex:  channel.LocalKey(7),
-			Virtual:     true,
-			Concurrency: control.Concurrency(0),
-			Internal:    true,
-			Operations:  []channel.Operation{},
-			Expression:  "test_11",
+			Name:              "test_1",
+			Leaseholder:       node.Key(3),
+			DataType:          telem.DataType("test_3"),
+			IsIndex:           false,
+			LocalKey:          channel.LocalKey(6),
+			LocalIndex:        channel.LocalKey(7),
+			Virtual:           true,
+			Concurrency:       control.Concurrency(0),
+			Internal:          true,
+			Operations:        []channel.Operation{},
+			Expression:        "test_11",
+			Retention:         channel.Retention{MaxAge: telem.TimeSpan(14), MaxSize: telem.Size(15)},
+			ReplicationFactor: 16,
+			Replicas:          []node.Key{},
+			Safety: channel.Safety{
+				Min:        func() *float64 { v := float64(18.5); return &v }(),
+				Max:        func() *float64 { v := float64(19.5); return &v }(),
+				MaxRate:    func() *float64 { v := float64(20.5); return &v }(),
+				Interlocks: []channel.Interlock{},
+				SafeValue:  func() *float64 { v := float64(22.5); return &v }(),
+			},
 		}
 		w := orc.NewWriter(0)
 		if err := seed.EncodeOrc(w); err != nil {
@@ -261,6 +494,60 @@ func FuzzDecodeChannel(f *testing.F) {
 	})
 }
 
+func FuzzDecodeInterlock(f *testing.F) {
+	{
+		seed := channel.Interlock{
+			Channel:    channel.Key(2),
+			Comparison: "test_2",
+			Value:      3.5,
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	{
+		seed := channel.Interlock{
+			Channel:    channel.Key(0),
+			Comparison: "",
+			Value:      0,
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded channel.Interlock
+		r := orc.NewReader(nil)
+		r.ResetBytes(data)
+		if err := decoded.DecodeOrc(r); err != nil {
+			return
+		}
+		w1 := orc.NewWriter(len(data))
+		if err := decoded.EncodeOrc(w1); err != nil {
+			t.Fatalf("encode after successful decode failed: %v", err)
+		}
+		var redecoded channel.Interlock
+		r.ResetBytes(w1.Bytes())
+		if err := redecoded.DecodeOrc(r); err != nil {
+			t.Fatalf("re-decode failed: %v", err)
+		}
+		w2 := orc.NewWriter(w1.Len())
+		if err := redecoded.EncodeOrc(w2); err != nil {
+			t.Fatalf("re-encode failed: %v", err)
+		}
+		if w1.Len() != w2.Len() {
+			t.Fatalf("encoded length differs between cycles: w1=%d w2=%d", w1.Len(), w2.Len())
+		}
+		if !reflect.DeepEqual(decoded, redecoded) {
+			t.Fatal("round-trip mismatch: decoded values differ after re-encode/re-decode cycle")
+		}
+	})
+}
+
 func FuzzDecodeOperation(f *testing.F) {
 	{
 		seed := channel.Operation{
@@ -314,3 +601,127 @@ func FuzzDecodeOperation(f *testing.F) {
 		}
 	})
 }
+
+func FuzzDecodeRetention(f *testing.F) {
+	{
+		seed := channel.Retention{MaxAge: telem.TimeSpan(2), MaxSize: telem.Size(3)}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	{
+		seed := channel.Retention{MaxAge: telem.TimeSpan(0), MaxSize: telem.Size(0)}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded channel.Retention
+		r := orc.NewReader(nil)
+		r.ResetBytes(data)
+		if err := decoded.DecodeOrc(r); err != nil {
+			return
+		}
+		w1 := orc.NewWriter(len(data))
+		if err := decoded.EncodeOrc(w1); err != nil {
+			t.Fatalf("encode after successful decode failed: %v", err)
+		}
+		var redecoded channel.Retention
+		r.ResetBytes(w1.Bytes())
+		if err := redecoded.DecodeOrc(r); err != nil {
+			t.Fatalf("re-decode failed: %v", err)
+		}
+		w2 := orc.NewWriter(w1.Len())
+		if err := redecoded.EncodeOrc(w2); err != nil {
+			t.Fatalf("re-encode failed: %v", err)
+		}
+		if w1.Len() != w2.Len() {
+			t.Fatalf("encoded length differs between cycles: w1=%d w2=%d", w1.Len(), w2.Len())
+		}
+		if !reflect.DeepEqual(decoded, redecoded) {
+			t.Fatal("round-trip mismatch: decoded values differ after re-encode/re-decode cycle")
+		}
+	})
+}
+
+func FuzzDecodeSafety(f *testing.F) {
+	{
+		seed := channel.Safety{
+			Min:     func() *float64 { v := float64(1.5); return &v }(),
+			Max:     func() *float64 { v := float64(2.5); return &v }(),
+			MaxRate: func() *float64 { v := float64(3.5); return &v }(),
+			Interlocks: []channel.Interlock{
+				{
+					Channel:    channel.Key(6),
+					Comparison: "test_6",
+					Value:      7.5,
+				},
+			},
+			SafeValue: func() *float64 { v := float64(8.5); return &v }(),
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	{
+		seed := channel.Safety{
+			Min:        nil,
+			Max:        nil,
+			MaxRate:    nil,
+			Interlocks: nil,
+			SafeValue:  nil,
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	{
+		seed := channel.Safety{
+			Min:        func() *float64 { v := float64(1.5); return &v }(),
+			Max:        func() *float64 { v := float64(2.5); return &v }(),
+			MaxRate:    func() *float64 { v := float64(3.5); return &v }(),
+			Interlocks: []channel.Interlock{},
+			SafeValue:  func() *float64 { v := float64(5.5); return &v }(),
+		}
+		w := orc.NewWriter(0)
+		if err := seed.EncodeOrc(w); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(w.Bytes())
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded channel.Safety
+		r := orc.NewReader(nil)
+		r.ResetBytes(data)
+		if err := decoded.DecodeOrc(r); err != nil {
+			return
+		}
+		w1 := orc.NewWriter(len(data))
+		if err := decoded.EncodeOrc(w1); err != nil {
+			t.Fatalf("encode after successful decode failed: %v", err)
+		}
+		var redecoded channel.Safety
+		r.ResetBytes(w1.Bytes())
+		if err := redecoded.DecodeOrc(r); err != nil {
+			t.Fatalf("re-decode failed: %v", err)
+		}
+		w2 := orc.NewWriter(w1.Len())
+		if err := redecoded.EncodeOrc(w2); err != nil {
+			t.Fatalf("re-encode failed: %v", err)
+		}
+		if w1.Len() != w2.Len() {
+			t.Fatalf("encoded length differs between cycles: w1=%d w2=%d", w1.Len(), w2.Len())
+		}
+		if !reflect.DeepEqual(decoded, redecoded) {
+			t.Fatal("round-trip mismatch: decoded values differ after re-encode/re-decode cycle")
+		}
+	})
+}
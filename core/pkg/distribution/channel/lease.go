@@ -0,0 +1,540 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package channel
+
+import (
+	"context"
+	"sync"
+
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	xchange "github.com/synnaxlabs/x/change"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/observe"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/set"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// ErrLeaseFenced is returned when attempting to write to a channel whose lease is in
+// the process of being transferred to another node.
+var ErrLeaseFenced = errors.New("channel lease is being transferred")
+
+// Lease records the transfer of a channel's lease away from the node embedded in its
+// key. Channels without a Lease are leased to the node embedded in their key.
+//
+// A transfer moves through three phases, each of which is recorded on the Lease so
+// that the nodes involved can coordinate through the cluster key-value store:
+//
+//  1. The requesting node sets Target to the node receiving the lease.
+//  2. The current leaseholder stops accepting writes to the channel, closes the writers
+//     that were open on it when the transfer started, and sets Fenced.
+//  3. The target copies the channel's data into its own storage layer and takes the
+//     lease by setting Leaseholder and clearing Target and Fenced. If the copy fails,
+//     the target clears Target and Fenced and records the failure in Error instead.
+type Lease struct {
+	// Channel is the key of the channel the lease applies to.
+	Channel Key `json:"channel" msgpack:"channel"`
+	// Leaseholder is the node that holds the lease on the channel and stores its data.
+	Leaseholder node.Key `json:"leaseholder" msgpack:"leaseholder"`
+	// Target is the node the lease is being transferred to. Zero when no transfer is in
+	// progress.
+	Target node.Key `json:"target" msgpack:"target"`
+	// Fenced is true when the leaseholder has stopped accepting writes to the channel
+	// so that its data can be copied to Target.
+	Fenced bool `json:"fenced" msgpack:"fenced"`
+	// Error is the reason the most recent transfer failed, if any.
+	Error string `json:"error" msgpack:"error"`
+}
+
+var _ gorp.Entry[Key] = Lease{}
+
+// GorpKey implements gorp.Entry.
+func (l Lease) GorpKey() Key { return l.Channel }
+
+// SetOptions implements gorp.Entry.
+func (l Lease) SetOptions() []any { return nil }
+
+// Transferring returns true if a transfer of the lease is in progress.
+func (l Lease) Transferring() bool { return l.Target != 0 }
+
+// Leases maps channel keys to leaseholders that differ from the ones embedded in the
+// keys. A nil Leases is valid, and routes every channel to the node embedded in its
+// key.
+type Leases map[Key]node.Key
+
+// Leaseholder returns the node that holds the lease on the channel with the given key.
+func (l Leases) Leaseholder(key Key) node.Key {
+	if lh, ok := l[key]; ok {
+		return lh
+	}
+	return key.Leaseholder()
+}
+
+// UniqueLeaseholders returns a slice of all UNIQUE leaseholders for the given Keys.
+func (l Leases) UniqueLeaseholders(keys Keys) []node.Key {
+	return lo.Uniq(lo.Map(keys, func(key Key, _ int) node.Key { return l.Leaseholder(key) }))
+}
+
+// leaseTable is the node-local copy of all Lease entries in the cluster, kept in sync
+// with the cluster key-value store.
+type leaseTable struct {
+	sync.RWMutex
+	entries map[Key]Lease
+}
+
+// Leases returns the leaseholders of the channels with the given keys that have been
+// transferred away from the node embedded in their key. The returned Leases is a
+// snapshot, and will not reflect transfers that complete after the call returns.
+func (s *Service) Leases(keys ...Key) Leases {
+	s.leases.RLock()
+	defer s.leases.RUnlock()
+	var leases Leases
+	for _, k := range keys {
+		if l, ok := s.leases.entries[k]; ok && l.Leaseholder != k.Leaseholder() {
+			if leases == nil {
+				leases = make(Leases, len(keys))
+			}
+			leases[k] = l.Leaseholder
+		}
+	}
+	return leases
+}
+
+// Leaseholder returns the node that currently holds the lease on the channel with the
+// given key.
+func (s *Service) Leaseholder(key Key) node.Key {
+	s.leases.RLock()
+	defer s.leases.RUnlock()
+	if l, ok := s.leases.entries[key]; ok {
+		return l.Leaseholder
+	}
+	return key.Leaseholder()
+}
+
+// MatchCurrentLeaseholders returns a filter for channels whose lease is currently held
+// by any of the given nodes. Unlike MatchLeaseholders, it accounts for leases that have
+// been transferred away from the node embedded in a channel's key.
+func (s *Service) MatchCurrentLeaseholders(nodes ...node.Key) Filter {
+	return func(Retrieve) gorp.Filter[Key, Channel] {
+		return gorp.Match(func(_ gorp.Context, ch *Channel) (bool, error) {
+			return lo.Contains(nodes, s.Leaseholder(ch.Key())), nil
+		})
+	}
+}
+
+// HoldsLeases returns true if the node with the given key holds the leases on all of
+// the channels with the given keys.
+func (s *Service) HoldsLeases(n node.Key, keys ...Key) bool {
+	s.leases.RLock()
+	defer s.leases.RUnlock()
+	for _, k := range keys {
+		l, ok := s.leases.entries[k]
+		if (ok && l.Leaseholder != n) || (!ok && k.Leaseholder() != n) {
+			return false
+		}
+	}
+	return true
+}
+
+// LeaseFenced returns true if writes to any of the channels with the given keys should
+// be rejected because their leases are being transferred.
+func (s *Service) LeaseFenced(keys ...Key) bool {
+	s.leases.RLock()
+	defer s.leases.RUnlock()
+	for _, k := range keys {
+		if l, ok := s.leases.entries[k]; ok && l.Transferring() {
+			return true
+		}
+	}
+	return false
+}
+
+// FencedLeases returns the keys of the channels whose leases are being transferred to
+// the target node and are ready to have their data copied. Since a transfer fences its
+// channels one at a time, channels are only returned once every channel being
+// transferred from the same leaseholder has been fenced.
+func (s *Service) FencedLeases(ctx context.Context, target node.Key) (Keys, error) {
+	var leases []Lease
+	if err := s.leaseTable.NewRetrieve().
+		Where(gorp.Match(func(_ gorp.Context, l *Lease) (bool, error) {
+			return l.Target == target, nil
+		})).
+		Entries(&leases).
+		Exec(ctx, s.db); err != nil {
+		return nil, err
+	}
+	unfenced := make(set.Set[node.Key])
+	for _, l := range leases {
+		if !l.Fenced {
+			unfenced.Add(l.Leaseholder)
+		}
+	}
+	var keys Keys
+	for _, l := range leases {
+		if !unfenced.Contains(l.Leaseholder) {
+			keys = append(keys, l.Channel)
+		}
+	}
+	return keys, nil
+}
+
+// UnfencedLeases returns the keys of the channels whose leases the given leaseholder is
+// transferring to another node and has not yet fenced.
+func (s *Service) UnfencedLeases(ctx context.Context, leaseholder node.Key) (Keys, error) {
+	var leases []Lease
+	if err := s.leaseTable.NewRetrieve().
+		Where(gorp.Match(func(_ gorp.Context, l *Lease) (bool, error) {
+			return l.Leaseholder == leaseholder && l.Transferring() && !l.Fenced, nil
+		})).
+		Entries(&leases).
+		Exec(ctx, s.db); err != nil {
+		return nil, err
+	}
+	return lo.Map(leases, func(l Lease, _ int) Key { return l.Channel }), nil
+}
+
+// ObserveLeases returns an observable that notifies callers of changes to channel
+// leases.
+func (s *Service) ObserveLeases() observe.Observable[gorp.TxReader[Key, Lease]] {
+	return s.leaseTable.Observe()
+}
+
+// NewLeaseWriter opens a writer for updating the state of channel lease transfers. It
+// is used by the framer to fence channels once their writers have been closed, and to
+// complete transfers once the data for a channel has been copied to the host.
+func (s *Service) NewLeaseWriter(tx gorp.Tx) LeaseWriter {
+	return LeaseWriter{svc: s, tx: s.db.OverrideTx(tx)}
+}
+
+// LeaseWriter updates the state of channel lease transfers.
+type LeaseWriter struct {
+	svc *Service
+	tx  gorp.Tx
+}
+
+// Fence marks the channels with the given keys as ready to have their data copied to
+// the target of their transfer. The caller must ensure that no more data can be written
+// to the channels before calling Fence. Channels that are not being transferred are
+// left unchanged.
+func (w LeaseWriter) Fence(ctx context.Context, keys Keys) error {
+	return w.svc.leaseTable.NewUpdate().
+		Where(gorp.MatchKeys[Key, Lease](keys...)).
+		Change(func(_ gorp.Context, l Lease) Lease {
+			l.Fenced = l.Transferring()
+			return l
+		}).
+		Exec(ctx, w.tx)
+}
+
+// Complete hands the leases on the channels with the given keys to the target of
+// their transfer. Channels whose transfer targets a different node are left unchanged.
+func (w LeaseWriter) Complete(ctx context.Context, keys Keys, target node.Key) error {
+	return w.svc.leaseTable.NewUpdate().
+		Where(gorp.MatchKeys[Key, Lease](keys...)).
+		Change(func(_ gorp.Context, l Lease) Lease {
+			if l.Target != target {
+				return l
+			}
+			return Lease{Channel: l.Channel, Leaseholder: target}
+		}).
+		Exec(ctx, w.tx)
+}
+
+// Fail aborts the transfers of the channels with the given keys, recording cause as
+// the reason for the failure.
+func (w LeaseWriter) Fail(ctx context.Context, keys Keys, cause error) error {
+	return w.svc.leaseTable.NewUpdate().
+		Where(gorp.MatchKeys[Key, Lease](keys...)).
+		Change(func(_ gorp.Context, l Lease) Lease {
+			if !l.Transferring() {
+				return l
+			}
+			return Lease{Channel: l.Channel, Leaseholder: l.Leaseholder, Error: cause.Error()}
+		}).
+		Exec(ctx, w.tx)
+}
+
+// TransferLease transfers the leases on the channels with the given keys, along with
+// all of their historical data, to the target node. Since a channel's data must be
+// stored on the same node as its index, the transfer is expanded to include the index
+// of every channel and every channel sharing one of those indexes.
+//
+// Writes to the channels are rejected for the duration of the transfer, and writers
+// open when the transfer starts must be re-opened after it completes. TransferLease
+// blocks until the transfer completes, fails, or the context is cancelled. If the
+// context is cancelled before the target takes the lease, the transfer is aborted.
+func (s *Service) TransferLease(ctx context.Context, keys Keys, target node.Key) error {
+	if target == 0 || target.IsFree() {
+		return errors.Wrap(validate.ErrValidation, "target node must be specified")
+	}
+	if _, err := s.cfg.HostResolver.Resolve(target); err != nil {
+		return err
+	}
+	keys, err := s.expandLeaseGroup(ctx, keys)
+	if err != nil {
+		return err
+	}
+	leases := make([]Lease, len(keys))
+	s.leases.RLock()
+	for i, k := range keys {
+		l, ok := s.leases.entries[k]
+		if !ok {
+			l = Lease{Channel: k, Leaseholder: k.Leaseholder()}
+		}
+		if l.Transferring() {
+			s.leases.RUnlock()
+			return errors.Wrapf(ErrLeaseFenced, "channel %v", k)
+		}
+		if l.Leaseholder == target {
+			s.leases.RUnlock()
+			return errors.Wrapf(validate.ErrValidation, "channel %v is already leased to node %v", k, target)
+		}
+		if i > 0 && l.Leaseholder != leases[0].Leaseholder {
+			s.leases.RUnlock()
+			return errors.Wrapf(
+				validate.ErrValidation,
+				"cannot transfer leases on channels held by different nodes (%v, %v)",
+				leases[0].Leaseholder,
+				l.Leaseholder,
+			)
+		}
+		leases[i] = Lease{Channel: k, Leaseholder: l.Leaseholder, Target: target}
+	}
+	s.leases.RUnlock()
+
+	var (
+		mu      sync.Mutex
+		pending = set.New(keys...)
+		failure error
+		done    = make(chan struct{})
+	)
+	disconnect := s.leaseTable.Observe().OnChange(func(_ context.Context, r gorp.TxReader[Key, Lease]) {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(pending) == 0 {
+			return
+		}
+		for c := range r {
+			if c.Variant != xchange.VariantSet || c.Value.Transferring() || !pending.Contains(c.Key) {
+				continue
+			}
+			pending.Remove(c.Key)
+			if c.Value.Error != "" && failure == nil {
+				failure = errors.Newf("failed to transfer lease on channel %v: %s", c.Key, c.Value.Error)
+			}
+		}
+		if len(pending) == 0 {
+			close(done)
+		}
+	})
+	defer disconnect()
+	if err = s.leaseTable.NewCreate().Entries(&leases).Exec(ctx, s.db); err != nil {
+		return err
+	}
+	select {
+	case <-done:
+		mu.Lock()
+		defer mu.Unlock()
+		return failure
+	case <-ctx.Done():
+		// Use a fresh context, as the caller's context has already been cancelled.
+		if err = s.NewLeaseWriter(nil).Fail(
+			context.WithoutCancel(ctx),
+			keys,
+			errors.New("transfer aborted"),
+		); err != nil {
+			s.cfg.L.Error("failed to abort lease transfer", zap.Error(err))
+		}
+		return ctx.Err()
+	}
+}
+
+// expandLeaseGroup returns the keys of the given channels, their indexes, and all
+// channels sharing those indexes. Returns an error if any of the channels are virtual,
+// as virtual channels have no data to transfer, or replicated, as their data is already
+// stored on multiple nodes.
+func (s *Service) expandLeaseGroup(ctx context.Context, keys Keys) (Keys, error) {
+	var channels []Channel
+	if err := s.newRetrieve().
+		Where(MatchKeys(keys...)).
+		Entries(&channels).
+		Exec(ctx, nil); err != nil {
+		return nil, err
+	}
+	if len(channels) != len(keys.Unique()) {
+		missing, _ := lo.Difference(keys, KeysFromChannels(channels))
+		return nil, errors.Wrapf(query.ErrNotFound, "channels %v not found", missing)
+	}
+	indexes := make(set.Set[Key], len(channels))
+	for _, ch := range channels {
+		if ch.Virtual {
+			return nil, errors.Wrapf(
+				validate.ErrValidation,
+				"cannot transfer lease on virtual channel %v",
+				ch,
+			)
+		}
+		if ch.IsIndex {
+			indexes.Add(ch.Key())
+		} else {
+			indexes.Add(ch.Index())
+		}
+	}
+	if err := s.newRetrieve().
+		Where(Match(func(_ gorp.Context, _ Retrieve, ch *Channel) (bool, error) {
+			if ch.Virtual {
+				return false, nil
+			}
+			return indexes.Contains(ch.Key()) || indexes.Contains(ch.Index()), nil
+		})).
+		Entries(&channels).
+		Exec(ctx, nil); err != nil {
+		return nil, err
+	}
+	for _, ch := range channels {
+		if ch.Replicated() {
+			return nil, errors.Wrapf(
+				validate.ErrValidation,
+				"cannot transfer lease on replicated channel %v",
+				ch,
+			)
+		}
+	}
+	return KeysFromChannels(channels), nil
+}
+
+// updateLeases keeps the node-local lease table in sync with the cluster, and queues
+// any work this node needs to do to move transfers forward.
+func (s *Service) updateLeases(ctx context.Context, r gorp.TxReader[Key, Lease]) {
+	var changed []Lease
+	s.leases.Lock()
+	for c := range r {
+		if c.Variant == xchange.VariantDelete {
+			delete(s.leases.entries, c.Key)
+			continue
+		}
+		s.leases.entries[c.Key] = c.Value
+		changed = append(changed, c.Value)
+	}
+	s.leases.Unlock()
+	if len(changed) == 0 {
+		return
+	}
+	select {
+	case s.leaseUpdates <- changed:
+	case <-ctx.Done():
+	}
+}
+
+// processLeases removes the data for channels whose leases were transferred away from
+// the host.
+func (s *Service) processLeases(ctx context.Context, leases []Lease) error {
+	host := s.cfg.HostResolver.HostKey()
+	var released Keys
+	for _, l := range leases {
+		if l.Leaseholder != host && !l.Transferring() {
+			released = append(released, l.Channel)
+		}
+	}
+	if len(released) > 0 {
+		s.releaseStorage(ctx, released)
+	}
+	return nil
+}
+
+// releaseStorage removes the storage layer channels for the given keys if they exist on
+// the host.
+func (s *Service) releaseStorage(ctx context.Context, keys Keys) {
+	local := make(Keys, 0, len(keys))
+	for _, k := range keys {
+		if _, err := s.cfg.TSChannel.RetrieveChannel(ctx, k.StorageKey()); err == nil {
+			local = append(local, k)
+		}
+	}
+	if len(local) == 0 {
+		return
+	}
+	if err := s.cfg.TSChannel.DeleteChannels(local.Storage()); err != nil {
+		s.cfg.L.Warn(
+			"failed to remove data for channels whose leases were transferred to another node",
+			zap.Stringers("channels", local),
+			zap.Error(err),
+		)
+	}
+}
+
+// filterLocal returns the keys of the channels whose data is stored on the host.
+func (s *Service) filterLocal(keys Keys) Keys {
+	host := s.cfg.HostResolver.HostKey()
+	return lo.Filter(keys, func(k Key, _ int) bool { return s.Leaseholder(k) == host })
+}
+
+// applyTransferredChanges applies renames and deletions of channels whose leases have
+// been transferred to the host to the storage layer. Changes to all other channels are
+// applied by the node embedded in the channel's key, which handles all routed
+// operations on the channel's metadata.
+func (s *Service) applyTransferredChanges(ctx context.Context, r gorp.TxReader[Key, Channel]) {
+	host := s.cfg.HostResolver.HostKey()
+	changes := make(map[Key]xchange.Change[Key, Channel])
+	for c := range r {
+		if c.Key.Leaseholder() != host {
+			changes[c.Key] = c
+		}
+	}
+	if len(changes) == 0 {
+		return
+	}
+	// Read the leases from the database instead of the node-local lease table, which
+	// may not have caught up with a transfer that the host just completed.
+	var leases []Lease
+	if err := s.leaseTable.NewRetrieve().
+		Where(gorp.Match(func(_ gorp.Context, l *Lease) (bool, error) {
+			_, ok := changes[l.Channel]
+			return ok && l.Leaseholder == host, nil
+		})).
+		Entries(&leases).
+		Exec(ctx, s.db); err != nil {
+		s.cfg.L.Error("failed to retrieve channel leases", zap.Error(err))
+		return
+	}
+	var deleted Keys
+	for _, l := range leases {
+		c := changes[l.Channel]
+		if c.Variant == xchange.VariantDelete {
+			deleted = append(deleted, c.Key)
+			continue
+		}
+		s.syncStorageName(ctx, c.Value)
+	}
+	if len(deleted) == 0 {
+		return
+	}
+	s.releaseStorage(ctx, deleted)
+	if err := s.leaseTable.NewDelete().
+		Where(gorp.MatchKeys[Key, Lease](deleted...)).
+		Exec(ctx, s.db); err != nil {
+		s.cfg.L.Warn("failed to remove leases on deleted channels", zap.Error(err))
+	}
+}
+
+// syncStorageName renames the storage layer channel for the given channel if its name
+// has changed.
+func (s *Service) syncStorageName(ctx context.Context, ch Channel) {
+	storageCh, err := s.cfg.TSChannel.RetrieveChannel(ctx, ch.Key().StorageKey())
+	if err != nil || storageCh.Name == ch.Name {
+		return
+	}
+	if err = s.cfg.TSChannel.RenameChannel(ctx, ch.Key().StorageKey(), ch.Name); err != nil {
+		s.cfg.L.Error("failed to rename channel", zap.Stringer("channel", ch), zap.Error(err))
+	}
+}
@@ -68,6 +68,9 @@ func (s *Service) create(ctx context.Context, tx gorp.Tx, _channels *[]Channel,
 		}
 	}
 	for i, ch := range channels {
+		if err := ch.Safety.Validate(); err != nil {
+			return validate.PathedError(err, fmt.Sprintf("[%d]", i))
+		}
 		if ch.Leaseholder == 0 {
 			channels[i].Leaseholder = s.cfg.HostResolver.HostKey()
 		}
@@ -463,6 +466,10 @@ func (s *Service) createGateway(
 		return err
 	}
 
+	if err = s.assignReplicas(ctx, tx, channels, toCreate); err != nil {
+		return err
+	}
+
 	externalCreatedKeys := make(Keys, 0, len(toCreate))
 	for _, ch := range toCreate {
 		if !ch.Internal && !ch.Virtual {
@@ -599,8 +606,9 @@ func (s *Service) deleteGateway(ctx context.Context, tx gorp.Tx, keys Keys) erro
 		return err
 	}
 	// It's very important that this goes last, as it's the only operation that can fail
-	// without an atomic guarantee.
-	if err := s.cfg.TSChannel.DeleteChannels(keys.Storage()); err != nil {
+	// without an atomic guarantee. The data for channels whose leases have been
+	// transferred is removed by their leaseholder when it observes the deletion.
+	if err := s.cfg.TSChannel.DeleteChannels(s.filterLocal(keys).Storage()); err != nil {
 		return err
 	}
 	s.mu.Lock()
@@ -721,5 +729,13 @@ func (s *Service) renameGateway(ctx context.Context, tx gorp.Tx, keys Keys, name
 		Exec(ctx, tx); err != nil {
 		return err
 	}
-	return s.cfg.TSChannel.RenameChannels(ctx, keys.Storage(), names)
+	localKeys := make(Keys, 0, len(keys))
+	localNames := make([]string, 0, len(names))
+	for i, k := range keys {
+		if s.Leaseholder(k) == s.cfg.HostResolver.HostKey() {
+			localKeys = append(localKeys, k)
+			localNames = append(localNames, names[i])
+		}
+	}
+	return s.cfg.TSChannel.RenameChannels(ctx, localKeys.Storage(), localNames)
 }
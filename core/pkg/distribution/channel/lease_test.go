@@ -0,0 +1,285 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package channel_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/iterator"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/x/confluence"
+	"github.com/synnaxlabs/x/control"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/signal"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+	"github.com/synnaxlabs/x/validate"
+)
+
+var _ = Describe("Lease", Ordered, func() {
+	var mockCluster *mock.Cluster
+	BeforeAll(func(ctx SpecContext) { mockCluster = mock.ProvisionCluster(context.Background(), 2) })
+	AfterAll(func() { Expect(mockCluster.Close()).To(Succeed()) })
+
+	createChannels := func(ctx SpecContext) (idx, data channel.Channel) {
+		idx = channel.Channel{
+			Name:        channel.NewRandomName(),
+			IsIndex:     true,
+			DataType:    telem.TimeStampT,
+			Leaseholder: 1,
+		}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &idx)).To(Succeed())
+		data = channel.Channel{
+			Name:        channel.NewRandomName(),
+			DataType:    telem.Float64T,
+			LocalIndex:  idx.LocalKey,
+			Leaseholder: 1,
+		}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &data)).To(Succeed())
+		return idx, data
+	}
+
+	write := func(
+		ctx SpecContext,
+		n node.Key,
+		keys channel.Keys,
+		start telem.TimeStamp,
+		values ...float64,
+	) {
+		stamps := make([]telem.TimeStamp, len(values))
+		for i := range values {
+			stamps[i] = start + telem.TimeStamp(i)*telem.SecondTS
+		}
+		w := MustSucceed(mockCluster.Nodes[n].Framer.OpenWriter(ctx, framer.WriterConfig{
+			Keys:           keys,
+			Start:          start,
+			ControlSubject: control.Subject{Key: "lease_test"},
+			Sync:           new(true),
+		}))
+		Expect(MustSucceed(w.Write(frame.NewMulti(keys, []telem.Series{
+			telem.NewSeries(stamps),
+			telem.NewSeries(values),
+		})))).To(BeTrue())
+		MustSucceed(w.Commit())
+		Expect(w.Close()).To(Succeed())
+	}
+
+	read := func(ctx SpecContext, n node.Key, key channel.Key) []float64 {
+		iter := MustSucceed(mockCluster.Nodes[n].Framer.OpenIterator(ctx, framer.IteratorConfig{
+			Keys:   channel.Keys{key},
+			Bounds: telem.TimeRangeMax,
+		}))
+		var values []float64
+		for iter.SeekFirst(); iter.Next(iterator.AutoSpan); {
+			for _, s := range iter.Value().Get(key).Series {
+				values = append(values, telem.UnmarshalSeries[float64](s)...)
+			}
+		}
+		Expect(iter.Close()).To(Succeed())
+		return values
+	}
+
+	It("Should transfer the lease and data for a channel to another node", func(ctx SpecContext) {
+		idx, data := createChannels(ctx)
+		keys := channel.Keys{idx.Key(), data.Key()}
+		write(ctx, 1, keys, 10*telem.SecondTS, 1, 2, 3)
+		write(ctx, 1, keys, 100*telem.SecondTS, 4, 5)
+		Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, channel.Keys{data.Key()}, 2)).To(Succeed())
+		for _, n := range []node.Key{1, 2} {
+			Eventually(func() node.Key {
+				return mockCluster.Nodes[n].Channel.Leaseholder(data.Key())
+			}).Should(Equal(node.Key(2)))
+			Expect(mockCluster.Nodes[n].Channel.Leaseholder(idx.Key())).To(Equal(node.Key(2)))
+			Expect(read(ctx, n, data.Key())).To(Equal([]float64{1, 2, 3, 4, 5}))
+		}
+		Eventually(func(ctx SpecContext) error {
+			_, err := mockCluster.Nodes[1].Storage.TS.RetrieveChannel(ctx, data.Key().StorageKey())
+			return err
+		}).WithContext(ctx).Should(HaveOccurred())
+		write(ctx, 1, keys, 200*telem.SecondTS, 6)
+		Expect(read(ctx, 2, data.Key())).To(Equal([]float64{1, 2, 3, 4, 5, 6}))
+		Expect(MustSucceed(mockCluster.Nodes[2].Storage.TS.RetrieveChannel(ctx, data.Key().StorageKey())).Key).
+			To(Equal(data.Key().StorageKey()))
+	})
+
+	It("Should transfer a lease back to the node embedded in the channel key", func(ctx SpecContext) {
+		idx, data := createChannels(ctx)
+		keys := channel.Keys{idx.Key(), data.Key()}
+		write(ctx, 1, keys, 10*telem.SecondTS, 1, 2)
+		Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, keys, 2)).To(Succeed())
+		Eventually(func() bool {
+			return mockCluster.Nodes[2].Channel.HoldsLeases(2, keys...)
+		}).Should(BeTrue())
+		Expect(mockCluster.Nodes[2].Channel.TransferLease(ctx, keys, 1)).To(Succeed())
+		Eventually(func() channel.Leases {
+			return mockCluster.Nodes[2].Channel.Leases(keys...)
+		}).Should(BeEmpty())
+		Expect(read(ctx, 2, data.Key())).To(Equal([]float64{1, 2}))
+	})
+
+	It("Should rename the storage channel on the new leaseholder", func(ctx SpecContext) {
+		idx, _ := createChannels(ctx)
+		Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, channel.Keys{idx.Key()}, 2)).To(Succeed())
+		name := channel.NewRandomName()
+		Expect(mockCluster.Nodes[1].Channel.Rename(ctx, idx.Key(), name, false)).To(Succeed())
+		Eventually(func(ctx SpecContext) string {
+			return MustSucceed(mockCluster.Nodes[2].Storage.TS.RetrieveChannel(ctx, idx.Key().StorageKey())).Name
+		}).WithContext(ctx).Should(Equal(name))
+	})
+
+	Describe("Fencing", func() {
+		It("Should reject writes from writers opened before the transfer", func(ctx SpecContext) {
+			idx, data := createChannels(ctx)
+			keys := channel.Keys{idx.Key(), data.Key()}
+			w := MustSucceed(mockCluster.Nodes[1].Framer.OpenWriter(ctx, framer.WriterConfig{
+				Keys:           keys,
+				Start:          10 * telem.SecondTS,
+				ControlSubject: control.Subject{Key: "lease_test"},
+				Sync:           new(true),
+			}))
+			Expect(mockCluster.Nodes[1].Channel.LeaseFenced(keys...)).To(BeFalse())
+			Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, keys, 2)).To(Succeed())
+			_, err := w.Write(frame.NewMulti(keys, []telem.Series{
+				telem.NewSeriesSecondsTSV(10),
+				telem.NewSeriesV[float64](1),
+			}))
+			Expect(err).To(MatchError(channel.ErrLeaseFenced))
+			Expect(w.Close()).To(MatchError(channel.ErrLeaseFenced))
+			Eventually(func() node.Key {
+				return mockCluster.Nodes[2].Channel.Leaseholder(data.Key())
+			}).Should(Equal(node.Key(2)))
+			Expect(read(ctx, 2, data.Key())).To(BeEmpty())
+		})
+
+		It("Should not lose data committed by writers during the transfer", func(ctx SpecContext) {
+			idx, data := createChannels(ctx)
+			keys := channel.Keys{idx.Key(), data.Key()}
+			w := MustSucceed(mockCluster.Nodes[1].Framer.OpenWriter(ctx, framer.WriterConfig{
+				Keys:           keys,
+				Start:          10 * telem.SecondTS,
+				ControlSubject: control.Subject{Key: "lease_test"},
+				Sync:           new(true),
+			}))
+			var (
+				committed []float64
+				done      = make(chan error, 1)
+			)
+			go func() {
+				defer GinkgoRecover()
+				for i := range 10000 {
+					v := float64(i)
+					if _, err := w.Write(frame.NewMulti(keys, []telem.Series{
+						telem.NewSeriesV(10*telem.SecondTS + telem.TimeStamp(i)*telem.MillisecondTS),
+						telem.NewSeriesV(v),
+					})); err != nil {
+						break
+					}
+					if _, err := w.Commit(); err != nil {
+						break
+					}
+					committed = append(committed, v)
+				}
+				done <- w.Close()
+			}()
+			Eventually(func() []float64 { return read(ctx, 1, data.Key()) }).
+				ShouldNot(BeEmpty())
+			Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, keys, 2)).To(Succeed())
+			Eventually(done).Should(Receive(MatchError(channel.ErrLeaseFenced)))
+			Expect(committed).ToNot(BeEmpty())
+			Eventually(func() node.Key {
+				return mockCluster.Nodes[2].Channel.Leaseholder(data.Key())
+			}).Should(Equal(node.Key(2)))
+			Expect(read(ctx, 2, data.Key())).To(ContainElements(committed))
+		})
+
+		It("Should abort the transfer when open writers fail to close in time", func(ctx SpecContext) {
+			c := mock.ProvisionCluster(ctx, 2, distribution.LayerConfig{
+				LeaseFenceTimeout: 50 * time.Millisecond,
+			})
+			defer func() { Expect(c.Close()).To(Succeed()) }()
+			idx := channel.Channel{
+				Name:        channel.NewRandomName(),
+				IsIndex:     true,
+				DataType:    telem.TimeStampT,
+				Leaseholder: 1,
+			}
+			Expect(c.Nodes[1].Channel.Create(ctx, &idx)).To(Succeed())
+			keys := channel.Keys{idx.Key()}
+			// A stream writer that has not started flowing cannot be stopped, so the
+			// fence waits on it until the timeout expires.
+			seg := MustSucceed(c.Nodes[1].Framer.NewStreamWriter(ctx, framer.WriterConfig{
+				Keys:           keys,
+				Start:          10 * telem.SecondTS,
+				ControlSubject: control.Subject{Key: "lease_test"},
+			}))
+			Expect(c.Nodes[1].Channel.TransferLease(ctx, keys, 2)).
+				To(MatchError(ContainSubstring("deadline exceeded")))
+			Expect(c.Nodes[1].Channel.Leaseholder(idx.Key())).To(Equal(node.Key(1)))
+			Eventually(func() bool {
+				return c.Nodes[1].Channel.LeaseFenced(keys...)
+			}).Should(BeFalse())
+
+			req, res := confluence.NewStream[framer.WriterRequest](), confluence.NewStream[framer.WriterResponse]()
+			seg.InFrom(req)
+			seg.OutTo(res)
+			sCtx, cancel := signal.Isolated()
+			defer cancel()
+			seg.Flow(sCtx, confluence.CloseOutputInletsOnExit())
+			req.Close()
+			Expect(sCtx.Wait()).To(MatchError(channel.ErrLeaseFenced))
+
+			w := MustSucceed(c.Nodes[1].Framer.OpenWriter(ctx, framer.WriterConfig{
+				Keys:           keys,
+				Start:          10 * telem.SecondTS,
+				ControlSubject: control.Subject{Key: "lease_test"},
+			}))
+			Expect(w.Close()).To(Succeed())
+		})
+	})
+
+	Describe("Validation", func() {
+		It("Should return an error when the target node is not specified", func(ctx SpecContext) {
+			idx, _ := createChannels(ctx)
+			Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, channel.Keys{idx.Key()}, 0)).
+				To(MatchError(validate.ErrValidation))
+		})
+
+		It("Should return an error when the channel does not exist", func(ctx SpecContext) {
+			Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, channel.Keys{channel.NewKey(1, 99999)}, 2)).
+				To(MatchError(query.ErrNotFound))
+		})
+
+		It("Should return an error when the target already holds the lease", func(ctx SpecContext) {
+			idx, _ := createChannels(ctx)
+			Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, channel.Keys{idx.Key()}, 1)).
+				To(MatchError(validate.ErrValidation))
+		})
+
+		It("Should return an error when transferring a virtual channel", func(ctx SpecContext) {
+			ch := channel.Channel{
+				Name:        channel.NewRandomName(),
+				DataType:    telem.Float64T,
+				Virtual:     true,
+				Leaseholder: 1,
+			}
+			Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+			Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, channel.Keys{ch.Key()}, 2)).
+				To(MatchError(validate.ErrValidation))
+		})
+	})
+})
@@ -0,0 +1,24 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Generated by oracle as a template. Edit this file.
+//
+// AutoMigrate handles field copying. Customize non-zero defaults below.
+
+package channel
+
+import (
+	"context"
+
+	v56 "github.com/synnaxlabs/synnax/pkg/distribution/channel/migrations/v56"
+)
+
+func MigrateChannel(ctx context.Context, old v56.Channel) (Channel, error) {
+	return AutoMigrateChannel(ctx, old)
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Code generated by oracle. DO NOT EDIT.
+
+package channel
+
+import (
+	"context"
+	channelv56 "github.com/synnaxlabs/synnax/pkg/distribution/channel/migrations/v56"
+)
+
+func AutoMigrateChannel(ctx context.Context, old channelv56.Channel) (Channel, error) {
+	operations := make([]Operation, len(old.Operations))
+	for i, v := range old.Operations {
+		var err error
+		if operations[i], err = AutoMigrateOperation(ctx, v); err != nil {
+			return Channel{}, err
+		}
+	}
+	return Channel{
+		Name:        old.Name,
+		Leaseholder: old.Leaseholder,
+		DataType:    old.DataType,
+		IsIndex:     old.IsIndex,
+		LocalKey:    LocalKey(old.LocalKey),
+		LocalIndex:  LocalKey(old.LocalIndex),
+		Virtual:     old.Virtual,
+		Concurrency: old.Concurrency,
+		Internal:    old.Internal,
+		Operations:  operations,
+		Expression:  old.Expression,
+	}, nil
+}
+
+func AutoMigrateOperation(_ context.Context, old channelv56.Operation) (Operation, error) {
+	return Operation{
+		Type:         OperationType(old.Type),
+		ResetChannel: Key(old.ResetChannel),
+		Duration:     old.Duration,
+	}, nil
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package v56
+
+import "github.com/synnaxlabs/synnax/pkg/distribution/node"
+
+// Key returns the key for the Channel. The first 12 bits are the leaseholder node key,
+// and the last 20 bits are the local key.
+func (c Channel) Key() Key { return Key(uint32(c.Leaseholder)<<20 | uint32(c.LocalKey)) }
+
+// GorpKey implements the gorp.Entry interface.
+func (c Channel) GorpKey() Key { return c.Key() }
+
+// SetOptions implements the gorp.Entry interface. Returns the same lease options as the
+// current Channel so that migrated entries stay leased to their original node.
+func (c Channel) SetOptions() []any {
+	if c.Leaseholder == node.KeyFree {
+		return []any{node.KeyBootstrapper}
+	}
+	return []any{c.Leaseholder}
+}
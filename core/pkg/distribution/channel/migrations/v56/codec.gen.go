@@ -0,0 +1,147 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Code generated by oracle. DO NOT EDIT.
+
+package v56
+
+import (
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/x/control"
+	"github.com/synnaxlabs/x/encoding/orc"
+	"github.com/synnaxlabs/x/telem"
+)
+
+func (c Channel) EncodeOrc(w *orc.Writer) error {
+	w.String(c.Name)
+	w.Uint16(uint16(c.Leaseholder))
+	w.String(string(c.DataType))
+	w.Bool(c.IsIndex)
+	w.Uint32(uint32(c.LocalKey))
+	w.Uint32(uint32(c.LocalIndex))
+	w.Bool(c.Virtual)
+	w.Int64(int64(c.Concurrency))
+	w.Bool(c.Internal)
+	w.Bool(c.Operations != nil)
+	if c.Operations != nil {
+		w.Uint32(uint32(len(c.Operations)))
+		for i := range c.Operations {
+			if err := c.Operations[i].EncodeOrc(w); err != nil {
+				return err
+			}
+		}
+	}
+	w.String(c.Expression)
+	return nil
+}
+
+func (c *Channel) DecodeOrc(r *orc.Reader) error {
+	var err error
+	if c.Name, err = r.String(); err != nil {
+		return err
+	}
+	{
+		v, err := r.Uint16()
+		if err != nil {
+			return err
+		}
+		c.Leaseholder = node.Key(v)
+	}
+	{
+		v, err := r.String()
+		if err != nil {
+			return err
+		}
+		c.DataType = telem.DataType(v)
+	}
+	if c.IsIndex, err = r.Bool(); err != nil {
+		return err
+	}
+	{
+		v, err := r.Uint32()
+		if err != nil {
+			return err
+		}
+		c.LocalKey = LocalKey(v)
+	}
+	{
+		v, err := r.Uint32()
+		if err != nil {
+			return err
+		}
+		c.LocalIndex = LocalKey(v)
+	}
+	if c.Virtual, err = r.Bool(); err != nil {
+		return err
+	}
+	{
+		v, err := r.Int64()
+		if err != nil {
+			return err
+		}
+		c.Concurrency = control.Concurrency(v)
+	}
+	if c.Internal, err = r.Bool(); err != nil {
+		return err
+	}
+	{
+		present, err := r.Bool()
+		if err != nil {
+			return err
+		}
+		if present {
+			n, err := r.CollectionLen()
+			if err != nil {
+				return err
+			}
+			c.Operations = make([]Operation, n)
+			for i := range c.Operations {
+				if err = c.Operations[i].DecodeOrc(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if c.Expression, err = r.String(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o Operation) EncodeOrc(w *orc.Writer) error {
+	w.String(string(o.Type))
+	w.Uint32(uint32(o.ResetChannel))
+	w.Int64(int64(o.Duration))
+	return nil
+}
+
+func (o *Operation) DecodeOrc(r *orc.Reader) error {
+	{
+		v, err := r.String()
+		if err != nil {
+			return err
+		}
+		o.Type = OperationType(v)
+	}
+	{
+		v, err := r.Uint32()
+		if err != nil {
+			return err
+		}
+		o.ResetChannel = Key(v)
+	}
+	{
+		v, err := r.Int64()
+		if err != nil {
+			return err
+		}
+		o.Duration = telem.TimeSpan(v)
+	}
+	return nil
+}
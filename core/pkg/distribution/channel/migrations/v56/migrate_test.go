@@ -0,0 +1,80 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package v56_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	v56 "github.com/synnaxlabs/synnax/pkg/distribution/channel/migrations/v56"
+	"github.com/synnaxlabs/x/control"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/kv/memkv"
+	"github.com/synnaxlabs/x/migrate"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("v56 -> current Channel migration", func() {
+	It("rewrites v56-encoded entries through the new codec", func(ctx SpecContext) {
+		db := DeferClose(gorp.Wrap(memkv.New()))
+
+		v56Table := MustOpen(gorp.OpenTable[v56.Key, v56.Channel](
+			ctx, gorp.TableConfig[v56.Key, v56.Channel]{DB: db},
+		))
+		seed := v56.Channel{
+			Name:        "sensor",
+			Leaseholder: 1,
+			DataType:    telem.Float64T,
+			LocalKey:    42,
+			LocalIndex:  41,
+			Concurrency: control.ConcurrencyShared,
+			Operations: []v56.Operation{{
+				Type:         v56.OperationTypeAvg,
+				ResetChannel: 12,
+				Duration:     telem.Second,
+			}},
+			Expression: "return sensor * 2",
+		}
+		Expect(v56Table.NewCreate().Entry(&seed).Exec(ctx, db)).To(Succeed())
+
+		currentTable := MustOpen(gorp.OpenTable[channel.Key, channel.Channel](
+			ctx, gorp.TableConfig[channel.Key, channel.Channel]{
+				DB: db,
+				Migrations: []migrate.Migration{
+					gorp.NewEntryMigration[v56.Key, channel.Key, v56.Channel, channel.Channel](
+						"v56_add_retention",
+						channel.MigrateChannel,
+					),
+				},
+			},
+		))
+
+		var got channel.Channel
+		Expect(currentTable.NewRetrieve().
+			Where(gorp.MatchKeys[channel.Key, channel.Channel](channel.Key(seed.Key()))).
+			Entry(&got).
+			Exec(ctx, db)).To(Succeed())
+		Expect(got.Key()).To(Equal(channel.NewKey(1, 42)))
+		Expect(got.Name).To(Equal(seed.Name))
+		Expect(got.DataType).To(Equal(seed.DataType))
+		Expect(got.LocalIndex).To(Equal(channel.LocalKey(41)))
+		Expect(got.Concurrency).To(Equal(seed.Concurrency))
+		Expect(got.Operations).To(Equal([]channel.Operation{{
+			Type:         channel.OperationTypeAvg,
+			ResetChannel: 12,
+			Duration:     telem.Second,
+		}}))
+		Expect(got.Expression).To(Equal(seed.Expression))
+		Expect(got.Retention).To(Equal(channel.Retention{}))
+		Expect(got.ReplicationFactor).To(BeZero())
+		Expect(got.Replicas).To(BeEmpty())
+	})
+})
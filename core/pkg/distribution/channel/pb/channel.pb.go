@@ -157,6 +157,216 @@ func (x *Operation) GetDuration() int64 {
 	return 0
 }
 
+// Retention defines how long the data in a channel is retained before it is
+// automatically deleted. Retention policies only apply to channels that persist data.
+type Retention struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_age is the maximum age of data retained in the channel. Data older than max_age
+	// is deleted in the background. If 0, data is never deleted based on age.
+	MaxAge int64 `protobuf:"varint,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// max_size is the maximum on-disk size of the channel's data. When exceeded, the oldest
+	// data is deleted in the background until the channel fits within max_size. If 0, data
+	// is never deleted based on size.
+	MaxSize       int64 `protobuf:"varint,2,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Retention) Reset() {
+	*x = Retention{}
+	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Retention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Retention) ProtoMessage() {}
+
+func (x *Retention) ProtoReflect() protoreflect.Message {
+	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Retention.ProtoReflect.Descriptor instead.
+func (*Retention) Descriptor() ([]byte, []int) {
+	return file_core_pkg_distribution_channel_pb_channel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Retention) GetMaxAge() int64 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *Retention) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+// Interlock holds a channel at its safe value while another channel's value satisfies a
+// comparison.
+type Interlock struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// channel is the channel whose value the interlock conditions on.
+	Channel uint32 `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// comparison is the operator comparing the channel's value to value: one of <, <=, >,
+	// >=, == or !=.
+	Comparison string `protobuf:"bytes,2,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// value is the threshold the channel's value is compared to.
+	Value         float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interlock) Reset() {
+	*x = Interlock{}
+	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interlock) ProtoMessage() {}
+
+func (x *Interlock) ProtoReflect() protoreflect.Message {
+	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interlock.ProtoReflect.Descriptor instead.
+func (*Interlock) Descriptor() ([]byte, []int) {
+	return file_core_pkg_distribution_channel_pb_channel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Interlock) GetChannel() uint32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *Interlock) GetComparison() string {
+	if x != nil {
+		return x.Comparison
+	}
+	return ""
+}
+
+func (x *Interlock) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Safety is the safety envelope of a channel. Arc programs enforce the envelope on
+// their writes to the channel, alongside any envelope they declare themselves.
+type Safety struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// min is the smallest value that may be written to the channel.
+	Min *float64 `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	// max is the largest value that may be written to the channel.
+	Max *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	// max_rate is the largest change in the channel's value per second between consecutive
+	// writes.
+	MaxRate *float64 `protobuf:"fixed64,3,opt,name=max_rate,json=maxRate,proto3,oneof" json:"max_rate,omitempty"`
+	// interlocks hold the channel at safe_value while any of their conditions are
+	// satisfied.
+	Interlocks []*Interlock `protobuf:"bytes,4,rep,name=interlocks,proto3" json:"interlocks,omitempty"`
+	// safe_value is the value the channel is held at while interlocked. Zero when unset.
+	SafeValue     *float64 `protobuf:"fixed64,5,opt,name=safe_value,json=safeValue,proto3,oneof" json:"safe_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Safety) Reset() {
+	*x = Safety{}
+	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Safety) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Safety) ProtoMessage() {}
+
+func (x *Safety) ProtoReflect() protoreflect.Message {
+	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Safety.ProtoReflect.Descriptor instead.
+func (*Safety) Descriptor() ([]byte, []int) {
+	return file_core_pkg_distribution_channel_pb_channel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Safety) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *Safety) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *Safety) GetMaxRate() float64 {
+	if x != nil && x.MaxRate != nil {
+		return *x.MaxRate
+	}
+	return 0
+}
+
+func (x *Safety) GetInterlocks() []*Interlock {
+	if x != nil {
+		return x.Interlocks
+	}
+	return nil
+}
+
+func (x *Safety) GetSafeValue() float64 {
+	if x != nil && x.SafeValue != nil {
+		return *x.SafeValue
+	}
+	return 0
+}
+
 // Channel is an internal representation of a channel containing all storage and
 // distribution metadata. This type is used internally by the server; clients should use
 // APIChannel instead.
@@ -189,14 +399,26 @@ type Channel struct {
 	Operations []*Operation `protobuf:"bytes,10,rep,name=operations,proto3" json:"operations,omitempty"`
 	// expression is an Arc expression for calculated channels. If set, the channel is
 	// automatically configured as virtual.
-	Expression    string `protobuf:"bytes,11,opt,name=expression,proto3" json:"expression,omitempty"`
+	Expression string `protobuf:"bytes,11,opt,name=expression,proto3" json:"expression,omitempty"`
+	// retention is the retention policy for the channel's data. The policy is enforced by
+	// the storage layer on the channel's leaseholder.
+	Retention *Retention `protobuf:"bytes,12,opt,name=retention,proto3" json:"retention,omitempty"`
+	// replication_factor is the number of nodes that store a copy of the channel's data,
+	// including the leaseholder. If 0 or 1, the channel is not replicated. Data channels
+	// always share the replication of their index.
+	ReplicationFactor uint32 `protobuf:"varint,13,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	// replicas are the nodes other than the leaseholder that store a copy of the channel's
+	// data. Replicas are assigned by the leaseholder when the channel is created.
+	Replicas []uint32 `protobuf:"varint,14,rep,packed,name=replicas,proto3" json:"replicas,omitempty"`
+	// safety is the safety envelope enforced on writes to the channel by Arc programs.
+	Safety        *Safety `protobuf:"bytes,15,opt,name=safety,proto3" json:"safety,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Channel) Reset() {
 	*x = Channel{}
-	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[1]
+	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -208,7 +430,7 @@ func (x *Channel) String() string {
 func (*Channel) ProtoMessage() {}
 
 func (x *Channel) ProtoReflect() protoreflect.Message {
-	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[1]
+	mi := &file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +443,7 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Channel.ProtoReflect.Descriptor instead.
 func (*Channel) Descriptor() ([]byte, []int) {
-	return file_core_pkg_distribution_channel_pb_channel_proto_rawDescGZIP(), []int{1}
+	return file_core_pkg_distribution_channel_pb_channel_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Channel) GetName() string {
@@ -301,6 +523,34 @@ func (x *Channel) GetExpression() string {
 	return ""
 }
 
+func (x *Channel) GetRetention() *Retention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Channel) GetReplicationFactor() uint32 {
+	if x != nil {
+		return x.ReplicationFactor
+	}
+	return 0
+}
+
+func (x *Channel) GetReplicas() []uint32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *Channel) GetSafety() *Safety {
+	if x != nil {
+		return x.Safety
+	}
+	return nil
+}
+
 var File_core_pkg_distribution_channel_pb_channel_proto protoreflect.FileDescriptor
 
 const file_core_pkg_distribution_channel_pb_channel_proto_rawDesc = "" +
@@ -309,7 +559,29 @@ const file_core_pkg_distribution_channel_pb_channel_proto_rawDesc = "" +
 	"\tOperation\x12:\n" +
 	"\x04type\x18\x01 \x01(\x0e2&.distribution.channel.pb.OperationTypeR\x04type\x12#\n" +
 	"\rreset_channel\x18\x02 \x01(\rR\fresetChannel\x12\x1a\n" +
-	"\bduration\x18\x03 \x01(\x03R\bduration\"\x8c\x03\n" +
+	"\bduration\x18\x03 \x01(\x03R\bduration\"?\n" +
+	"\tRetention\x12\x17\n" +
+	"\amax_age\x18\x01 \x01(\x03R\x06maxAge\x12\x19\n" +
+	"\bmax_size\x18\x02 \x01(\x03R\amaxSize\"[\n" +
+	"\tInterlock\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\rR\achannel\x12\x1e\n" +
+	"\n" +
+	"comparison\x18\x02 \x01(\tR\n" +
+	"comparison\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\"\xea\x01\n" +
+	"\x06Safety\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x1e\n" +
+	"\bmax_rate\x18\x03 \x01(\x01H\x02R\amaxRate\x88\x01\x01\x12B\n" +
+	"\n" +
+	"interlocks\x18\x04 \x03(\v2\".distribution.channel.pb.InterlockR\n" +
+	"interlocks\x12\"\n" +
+	"\n" +
+	"safe_value\x18\x05 \x01(\x01H\x03R\tsafeValue\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_maxB\v\n" +
+	"\t_max_rateB\r\n" +
+	"\v_safe_value\"\xd2\x04\n" +
 	"\aChannel\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vleaseholder\x18\x02 \x01(\rR\vleaseholder\x12\x1b\n" +
@@ -327,7 +599,11 @@ const file_core_pkg_distribution_channel_pb_channel_proto_rawDesc = "" +
 	"operations\x12\x1e\n" +
 	"\n" +
 	"expression\x18\v \x01(\tR\n" +
-	"expression*\x8f\x01\n" +
+	"expression\x12@\n" +
+	"\tretention\x18\f \x01(\v2\".distribution.channel.pb.RetentionR\tretention\x12-\n" +
+	"\x12replication_factor\x18\r \x01(\rR\x11replicationFactor\x12\x1a\n" +
+	"\breplicas\x18\x0e \x03(\rR\breplicas\x127\n" +
+	"\x06safety\x18\x0f \x01(\v2\x1f.distribution.channel.pb.SafetyR\x06safety*\x8f\x01\n" +
 	"\rOperationType\x12\x16\n" +
 	"\x12OPERATION_TYPE_MIN\x10\x00\x12\x16\n" +
 	"\x12OPERATION_TYPE_MAX\x10\x01\x12\x16\n" +
@@ -349,22 +625,28 @@ func file_core_pkg_distribution_channel_pb_channel_proto_rawDescGZIP() []byte {
 }
 
 var file_core_pkg_distribution_channel_pb_channel_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_core_pkg_distribution_channel_pb_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_core_pkg_distribution_channel_pb_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_core_pkg_distribution_channel_pb_channel_proto_goTypes = []any{
 	(OperationType)(0),  // 0: distribution.channel.pb.OperationType
 	(*Operation)(nil),   // 1: distribution.channel.pb.Operation
-	(*Channel)(nil),     // 2: distribution.channel.pb.Channel
-	(pb.Concurrency)(0), // 3: x.control.pb.Concurrency
+	(*Retention)(nil),   // 2: distribution.channel.pb.Retention
+	(*Interlock)(nil),   // 3: distribution.channel.pb.Interlock
+	(*Safety)(nil),      // 4: distribution.channel.pb.Safety
+	(*Channel)(nil),     // 5: distribution.channel.pb.Channel
+	(pb.Concurrency)(0), // 6: x.control.pb.Concurrency
 }
 var file_core_pkg_distribution_channel_pb_channel_proto_depIdxs = []int32{
 	0, // 0: distribution.channel.pb.Operation.type:type_name -> distribution.channel.pb.OperationType
-	3, // 1: distribution.channel.pb.Channel.concurrency:type_name -> x.control.pb.Concurrency
-	1, // 2: distribution.channel.pb.Channel.operations:type_name -> distribution.channel.pb.Operation
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 1: distribution.channel.pb.Safety.interlocks:type_name -> distribution.channel.pb.Interlock
+	6, // 2: distribution.channel.pb.Channel.concurrency:type_name -> x.control.pb.Concurrency
+	1, // 3: distribution.channel.pb.Channel.operations:type_name -> distribution.channel.pb.Operation
+	2, // 4: distribution.channel.pb.Channel.retention:type_name -> distribution.channel.pb.Retention
+	4, // 5: distribution.channel.pb.Channel.safety:type_name -> distribution.channel.pb.Safety
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_core_pkg_distribution_channel_pb_channel_proto_init() }
@@ -372,13 +654,14 @@ func file_core_pkg_distribution_channel_pb_channel_proto_init() {
 	if File_core_pkg_distribution_channel_pb_channel_proto != nil {
 		return
 	}
+	file_core_pkg_distribution_channel_pb_channel_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_core_pkg_distribution_channel_pb_channel_proto_rawDesc), len(file_core_pkg_distribution_channel_pb_channel_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,6 +12,7 @@
 package pb
 
 import (
+	"github.com/samber/lo"
 	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
 	"github.com/synnaxlabs/synnax/pkg/distribution/node"
 	controlpb "github.com/synnaxlabs/x/control/pb"
@@ -75,6 +76,176 @@ func OperationsFromPB(pbs []*Operation) ([]channel.Operation, error) {
 	return result, nil
 }
 
+// RetentionToPB converts Retention to Retention.
+func RetentionToPB(r channel.Retention) (*Retention, error) {
+	pb := &Retention{
+		MaxAge:  int64(r.MaxAge),
+		MaxSize: int64(r.MaxSize),
+	}
+	return pb, nil
+}
+
+// RetentionFromPB converts Retention to Retention.
+func RetentionFromPB(pb *Retention) (channel.Retention, error) {
+	var r channel.Retention
+	if pb == nil {
+		return r, nil
+	}
+	r.MaxAge = telem.TimeSpan(pb.MaxAge)
+	r.MaxSize = telem.Size(pb.MaxSize)
+	return r, nil
+}
+
+// RetentionsToPB converts a slice of Retention to Retention.
+func RetentionsToPB(rs []channel.Retention) ([]*Retention, error) {
+	result := make([]*Retention, len(rs))
+	for i := range rs {
+		var err error
+		result[i], err = RetentionToPB(rs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// RetentionsFromPB converts a slice of Retention to Retention.
+func RetentionsFromPB(pbs []*Retention) ([]channel.Retention, error) {
+	result := make([]channel.Retention, len(pbs))
+	for i, pb := range pbs {
+		var err error
+		result[i], err = RetentionFromPB(pb)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// InterlockToPB converts Interlock to Interlock.
+func InterlockToPB(r channel.Interlock) (*Interlock, error) {
+	pb := &Interlock{
+		Channel:    uint32(r.Channel),
+		Comparison: r.Comparison,
+		Value:      r.Value,
+	}
+	return pb, nil
+}
+
+// InterlockFromPB converts Interlock to Interlock.
+func InterlockFromPB(pb *Interlock) (channel.Interlock, error) {
+	var r channel.Interlock
+	if pb == nil {
+		return r, nil
+	}
+	r.Channel = channel.Key(pb.Channel)
+	r.Comparison = pb.Comparison
+	r.Value = pb.Value
+	return r, nil
+}
+
+// InterlocksToPB converts a slice of Interlock to Interlock.
+func InterlocksToPB(rs []channel.Interlock) ([]*Interlock, error) {
+	result := make([]*Interlock, len(rs))
+	for i := range rs {
+		var err error
+		result[i], err = InterlockToPB(rs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// InterlocksFromPB converts a slice of Interlock to Interlock.
+func InterlocksFromPB(pbs []*Interlock) ([]channel.Interlock, error) {
+	result := make([]channel.Interlock, len(pbs))
+	for i, pb := range pbs {
+		var err error
+		result[i], err = InterlockFromPB(pb)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// SafetyToPB converts Safety to Safety.
+func SafetyToPB(r channel.Safety) (*Safety, error) {
+	interlocksVal, err := InterlocksToPB(r.Interlocks)
+	if err != nil {
+		return nil, err
+	}
+	pb := &Safety{
+		Interlocks: interlocksVal,
+	}
+	if r.Min != nil {
+		pb.Min = r.Min
+	}
+	if r.Max != nil {
+		pb.Max = r.Max
+	}
+	if r.MaxRate != nil {
+		pb.MaxRate = r.MaxRate
+	}
+	if r.SafeValue != nil {
+		pb.SafeValue = r.SafeValue
+	}
+	return pb, nil
+}
+
+// SafetyFromPB converts Safety to Safety.
+func SafetyFromPB(pb *Safety) (channel.Safety, error) {
+	var r channel.Safety
+	if pb == nil {
+		return r, nil
+	}
+	var err error
+	r.Interlocks, err = InterlocksFromPB(pb.Interlocks)
+	if err != nil {
+		return channel.Safety{}, err
+	}
+	if pb.Min != nil {
+		r.Min = pb.Min
+	}
+	if pb.Max != nil {
+		r.Max = pb.Max
+	}
+	if pb.MaxRate != nil {
+		r.MaxRate = pb.MaxRate
+	}
+	if pb.SafeValue != nil {
+		r.SafeValue = pb.SafeValue
+	}
+	return r, nil
+}
+
+// SafetiesToPB converts a slice of Safety to Safety.
+func SafetiesToPB(rs []channel.Safety) ([]*Safety, error) {
+	result := make([]*Safety, len(rs))
+	for i := range rs {
+		var err error
+		result[i], err = SafetyToPB(rs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// SafetiesFromPB converts a slice of Safety to Safety.
+func SafetiesFromPB(pbs []*Safety) ([]channel.Safety, error) {
+	result := make([]channel.Safety, len(pbs))
+	for i, pb := range pbs {
+		var err error
+		result[i], err = SafetyFromPB(pb)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 // ChannelToPB converts Channel to Channel.
 func ChannelToPB(r channel.Channel) (*Channel, error) {
 	concurrencyVal, err := controlpb.ConcurrencyToPB(r.Concurrency)
@@ -85,18 +256,30 @@ func ChannelToPB(r channel.Channel) (*Channel, error) {
 	if err != nil {
 		return nil, err
 	}
+	retentionVal, err := RetentionToPB(r.Retention)
+	if err != nil {
+		return nil, err
+	}
+	safetyVal, err := SafetyToPB(r.Safety)
+	if err != nil {
+		return nil, err
+	}
 	pb := &Channel{
-		Name:        string(r.Name),
-		Leaseholder: uint32(r.Leaseholder),
-		DataType:    string(r.DataType),
-		IsIndex:     r.IsIndex,
-		LocalKey:    uint32(r.LocalKey),
-		LocalIndex:  uint32(r.LocalIndex),
-		Virtual:     r.Virtual,
-		Internal:    r.Internal,
-		Expression:  r.Expression,
-		Concurrency: concurrencyVal,
-		Operations:  operationsVal,
+		Name:              string(r.Name),
+		Leaseholder:       uint32(r.Leaseholder),
+		DataType:          string(r.DataType),
+		IsIndex:           r.IsIndex,
+		LocalKey:          uint32(r.LocalKey),
+		LocalIndex:        uint32(r.LocalIndex),
+		Virtual:           r.Virtual,
+		Internal:          r.Internal,
+		Expression:        r.Expression,
+		ReplicationFactor: uint32(r.ReplicationFactor),
+		Replicas:          lo.Map(r.Replicas, func(v node.Key, _ int) uint32 { return uint32(v) }),
+		Concurrency:       concurrencyVal,
+		Operations:        operationsVal,
+		Retention:         retentionVal,
+		Safety:            safetyVal,
 	}
 	return pb, nil
 }
@@ -116,6 +299,14 @@ func ChannelFromPB(pb *Channel) (channel.Channel, error) {
 	if err != nil {
 		return channel.Channel{}, err
 	}
+	r.Retention, err = RetentionFromPB(pb.Retention)
+	if err != nil {
+		return channel.Channel{}, err
+	}
+	r.Safety, err = SafetyFromPB(pb.Safety)
+	if err != nil {
+		return channel.Channel{}, err
+	}
 	r.Name = channel.Name(pb.Name)
 	r.Leaseholder = node.Key(pb.Leaseholder)
 	r.DataType = telem.DataType(pb.DataType)
@@ -125,6 +316,8 @@ func ChannelFromPB(pb *Channel) (channel.Channel, error) {
 	r.Virtual = pb.Virtual
 	r.Internal = pb.Internal
 	r.Expression = pb.Expression
+	r.ReplicationFactor = uint8(pb.ReplicationFactor)
+	r.Replicas = lo.Map(pb.Replicas, func(v uint32, _ int) node.Key { return node.Key(v) })
 	return r, nil
 }
 
@@ -0,0 +1,192 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package channel
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	xchange "github.com/synnaxlabs/x/change"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// assignReplicas selects the nodes that store the replicas of the replicated channels
+// being created. Index channels are replicated to the healthy nodes in the cluster
+// other than the host, and data channels are replicated to the same nodes as their
+// index so that their data can be read from any replica.
+func (s *Service) assignReplicas(
+	ctx context.Context,
+	tx gorp.Tx,
+	channels *[]Channel,
+	toCreate []Channel,
+) error {
+	indexes := make(map[Key]Channel, len(toCreate))
+	var missing Keys
+	for i, ch := range toCreate {
+		if ch.Virtual && ch.Replicated() {
+			return errors.Wrapf(
+				validate.ErrValidation,
+				"cannot replicate virtual channel %v",
+				ch,
+			)
+		}
+		toCreate[i].Replicas = nil
+		if !ch.IsIndex {
+			if ch.LocalIndex != 0 {
+				missing = append(missing, ch.Index())
+			}
+			continue
+		}
+		if ch.Replicated() {
+			replicas, err := s.selectReplicas(ch)
+			if err != nil {
+				return err
+			}
+			toCreate[i].Replicas = replicas
+		}
+		indexes[ch.Key()] = toCreate[i]
+	}
+	missing = lo.Filter(missing.Unique(), func(k Key, _ int) bool {
+		_, ok := indexes[k]
+		return !ok
+	})
+	if len(missing) > 0 {
+		var existing []Channel
+		if err := s.newRetrieve().
+			Where(MatchKeys(missing...)).
+			Entries(&existing).
+			Exec(ctx, tx); err != nil && !errors.Is(err, query.ErrNotFound) {
+			return err
+		}
+		for _, idx := range existing {
+			indexes[idx.Key()] = idx
+		}
+	}
+	assigned := make(map[Key]Channel, len(toCreate))
+	for i, ch := range toCreate {
+		if !ch.IsIndex && ch.LocalIndex != 0 {
+			idx := indexes[ch.Index()]
+			if ch.ReplicationFactor != 0 && ch.ReplicationFactor != idx.ReplicationFactor {
+				return errors.Wrapf(
+					validate.ErrValidation,
+					"replication factor of channel %v must match the replication factor of its index",
+					ch,
+				)
+			}
+			toCreate[i].ReplicationFactor = idx.ReplicationFactor
+			toCreate[i].Replicas = idx.Replicas
+		} else if !ch.IsIndex && ch.Replicated() {
+			return errors.Wrapf(
+				validate.ErrValidation,
+				"cannot replicate channel %v without an index",
+				ch,
+			)
+		}
+		assigned[ch.Key()] = toCreate[i]
+	}
+	for i, ch := range *channels {
+		if c, ok := assigned[ch.Key()]; ok {
+			(*channels)[i] = c
+		}
+	}
+	return nil
+}
+
+// selectReplicas returns the keys of the nodes that should store the replicas of the
+// given index channel. Replicas are spread across the healthy nodes in the cluster by
+// rotating the selection by the channel's local key.
+func (s *Service) selectReplicas(ch Channel) ([]node.Key, error) {
+	host := s.cfg.HostResolver.HostKey()
+	candidates := slices.Sorted(maps.Keys(s.cfg.Cluster.Nodes().Where(
+		func(key node.Key, n node.Node) bool {
+			return key != host && n.State == node.StateHealthy
+		},
+	)))
+	needed := int(ch.ReplicationFactor) - 1
+	if len(candidates) < needed {
+		return nil, errors.Wrapf(
+			validate.ErrValidation,
+			"cannot replicate channel %v to %d nodes, only %d healthy nodes are available",
+			ch,
+			ch.ReplicationFactor,
+			len(candidates)+1,
+		)
+	}
+	offset := int(ch.LocalKey) % len(candidates)
+	replicas := make([]node.Key, needed)
+	for i := range replicas {
+		replicas[i] = candidates[(offset+i)%len(candidates)]
+	}
+	return replicas, nil
+}
+
+// storesData returns true if the data for the given channel is stored on the host,
+// either because the host holds the channel's lease or stores one of its replicas.
+func (s *Service) storesData(ch Channel) bool {
+	host := s.cfg.HostResolver.HostKey()
+	return s.Leaseholder(ch.Key()) == host || slices.Contains(ch.Replicas, host)
+}
+
+// applyReplicas creates the storage layer channels for the replicas stored on the host
+// when their channels are created, keeps their names in sync, and removes them when
+// their channels are deleted.
+func (s *Service) applyReplicas(ctx context.Context, r gorp.TxReader[Key, Channel]) {
+	var (
+		host     = s.cfg.HostResolver.HostKey()
+		toCreate []Channel
+		deleted  Keys
+	)
+	for c := range r {
+		if c.Variant == xchange.VariantDelete {
+			if s.Leaseholder(c.Key) != host {
+				deleted = append(deleted, c.Key)
+			}
+			continue
+		}
+		if s.Leaseholder(c.Key) == host || !slices.Contains(c.Value.Replicas, host) {
+			continue
+		}
+		if _, err := s.cfg.TSChannel.RetrieveChannel(ctx, c.Key.StorageKey()); err == nil {
+			s.syncStorageName(ctx, c.Value)
+			continue
+		}
+		toCreate = append(toCreate, c.Value)
+	}
+	if len(deleted) > 0 {
+		s.releaseStorage(ctx, deleted)
+	}
+	if len(toCreate) == 0 {
+		return
+	}
+	// Indexes must exist in the storage layer before the channels that use them.
+	slices.SortStableFunc(toCreate, func(a, b Channel) int {
+		if a.IsIndex == b.IsIndex {
+			return 0
+		}
+		if a.IsIndex {
+			return -1
+		}
+		return 1
+	})
+	if err := s.cfg.TSChannel.CreateChannel(ctx, toStorage(toCreate)...); err != nil {
+		s.cfg.L.Error(
+			"failed to create replicas",
+			zap.Stringers("channels", toCreate),
+			zap.Error(err),
+		)
+	}
+}
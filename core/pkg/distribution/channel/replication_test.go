@@ -0,0 +1,131 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package channel_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/synnax/pkg/storage/ts"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+)
+
+var _ = Describe("Replication", Ordered, func() {
+	var mockCluster *mock.Cluster
+	BeforeAll(func(ctx SpecContext) { mockCluster = mock.ProvisionCluster(context.Background(), 2) })
+	AfterAll(func() { Expect(mockCluster.Close()).To(Succeed()) })
+	storageExists := func(ctx SpecContext, n node.Key, key channel.Key) error {
+		_, err := mockCluster.Nodes[n].Storage.TS.RetrieveChannel(ctx, key.StorageKey())
+		return err
+	}
+	createIndex := func(ctx SpecContext) channel.Channel {
+		idx := channel.Channel{
+			Name:              channel.NewRandomName(),
+			IsIndex:           true,
+			DataType:          telem.TimeStampT,
+			Leaseholder:       1,
+			ReplicationFactor: 2,
+		}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &idx)).To(Succeed())
+		return idx
+	}
+
+	It("Should store a replica of an index channel on another node", func(ctx SpecContext) {
+		idx := createIndex(ctx)
+		Expect(idx.Replicated()).To(BeTrue())
+		Expect(idx.Replicas).To(Equal([]node.Key{2}))
+		Eventually(func(ctx SpecContext) error {
+			return storageExists(ctx, 2, idx.Key())
+		}).WithContext(ctx).Should(Succeed())
+	})
+
+	It("Should replicate a data channel to the same nodes as its index", func(ctx SpecContext) {
+		idx := createIndex(ctx)
+		data := channel.Channel{
+			Name:        channel.NewRandomName(),
+			DataType:    telem.Float64T,
+			LocalIndex:  idx.LocalKey,
+			Leaseholder: 1,
+		}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &data)).To(Succeed())
+		Expect(data.ReplicationFactor).To(Equal(idx.ReplicationFactor))
+		Expect(data.Replicas).To(Equal(idx.Replicas))
+		Eventually(func(ctx SpecContext) error {
+			return storageExists(ctx, 2, data.Key())
+		}).WithContext(ctx).Should(Succeed())
+	})
+
+	It("Should remove the replica when the channel is deleted", func(ctx SpecContext) {
+		idx := createIndex(ctx)
+		Eventually(func(ctx SpecContext) error {
+			return storageExists(ctx, 2, idx.Key())
+		}).WithContext(ctx).Should(Succeed())
+		Expect(mockCluster.Nodes[1].Channel.Delete(ctx, idx.Key(), false)).To(Succeed())
+		Eventually(func(ctx SpecContext) error {
+			return storageExists(ctx, 2, idx.Key())
+		}).WithContext(ctx).Should(MatchError(ts.ErrChannelNotFound))
+	})
+
+	Describe("Validation", func() {
+		It("Should return an error if there aren't enough healthy nodes", func(ctx SpecContext) {
+			ch := channel.Channel{
+				Name:              channel.NewRandomName(),
+				IsIndex:           true,
+				DataType:          telem.TimeStampT,
+				Leaseholder:       1,
+				ReplicationFactor: 3,
+			}
+			Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).
+				To(MatchError(ContainSubstring("only 2 healthy nodes")))
+		})
+
+		It("Should return an error when replicating a virtual channel", func(ctx SpecContext) {
+			ch := channel.Channel{
+				Name:              channel.NewRandomName(),
+				DataType:          telem.Float64T,
+				Virtual:           true,
+				Leaseholder:       1,
+				ReplicationFactor: 2,
+			}
+			Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).
+				To(MatchError(validate.ErrValidation))
+		})
+
+		It("Should return an error when a data channel doesn't match its index", func(ctx SpecContext) {
+			idx := channel.Channel{
+				Name:        channel.NewRandomName(),
+				IsIndex:     true,
+				DataType:    telem.TimeStampT,
+				Leaseholder: 1,
+			}
+			Expect(mockCluster.Nodes[1].Channel.Create(ctx, &idx)).To(Succeed())
+			data := channel.Channel{
+				Name:              channel.NewRandomName(),
+				DataType:          telem.Float64T,
+				LocalIndex:        idx.LocalKey,
+				Leaseholder:       1,
+				ReplicationFactor: 2,
+			}
+			Expect(mockCluster.Nodes[1].Channel.Create(ctx, &data)).
+				To(MatchError(validate.ErrValidation))
+		})
+
+		It("Should return an error when transferring the lease on a replicated channel", func(ctx SpecContext) {
+			idx := createIndex(ctx)
+			Expect(mockCluster.Nodes[1].Channel.TransferLease(ctx, channel.Keys{idx.Key()}, 2)).
+				To(MatchError(ContainSubstring("replicated")))
+		})
+	})
+})
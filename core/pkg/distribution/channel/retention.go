@@ -0,0 +1,72 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package channel
+
+import (
+	"context"
+
+	"github.com/synnaxlabs/synnax/pkg/storage/ts"
+	xchange "github.com/synnaxlabs/x/change"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// SetRetention sets the retention policy of the channel with the given key. The policy
+// is stored with the channel, and is enforced by the storage layer of the channel's
+// leaseholder and replicas once the change propagates to them.
+func (w Writer) SetRetention(ctx context.Context, key Key, r Retention) error {
+	return w.svc.setRetention(ctx, w.tx, key, r)
+}
+
+func (s *Service) setRetention(ctx context.Context, tx gorp.Tx, key Key, r Retention) error {
+	if err := r.Storage().Validate(); err != nil {
+		return err
+	}
+	return s.table.NewUpdate().
+		Where(gorp.MatchKeys[Key, Channel](key)).
+		ChangeErr(func(_ gorp.Context, c Channel) (Channel, error) {
+			if c.Virtual {
+				return c, errors.Wrapf(
+					validate.ErrValidation,
+					"cannot set retention policy on virtual channel %v",
+					c,
+				)
+			}
+			c.Retention = r
+			return c, nil
+		}).
+		Exec(ctx, tx)
+}
+
+// applyRetention propagates the retention policies of channels stored on this node to
+// the storage layer whenever they change.
+func (s *Service) applyRetention(ctx context.Context, reader gorp.TxReader[Key, Channel]) {
+	for ch := range reader {
+		if ch.Variant != xchange.VariantSet || ch.Value.Virtual || !s.storesData(ch.Value) {
+			continue
+		}
+		err := s.cfg.TSChannel.SetChannelRetention(
+			ctx,
+			ch.Key.StorageKey(),
+			ch.Value.Retention.Storage(),
+		)
+		// The storage channel may not exist yet if the change is part of the channel's
+		// creation, in which case the policy is applied when the channel is created.
+		if err != nil && !errors.Is(err, ts.ErrChannelNotFound) {
+			s.cfg.L.Error(
+				"failed to apply retention policy",
+				zap.Stringer("channel", ch.Value),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package channel_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/synnax/pkg/storage/ts"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+	"github.com/synnaxlabs/x/validate"
+)
+
+var _ = Describe("Retention", Ordered, func() {
+	var mockCluster *mock.Cluster
+	BeforeAll(func(ctx SpecContext) { mockCluster = mock.ProvisionCluster(context.Background(), 2) })
+	AfterAll(func() { Expect(mockCluster.Close()).To(Succeed()) })
+	retention := channel.Retention{MaxAge: telem.Hour, MaxSize: telem.Gigabyte}
+	storageRetention := func(ctx SpecContext, n node.Key, key channel.Key) ts.Retention {
+		return MustSucceed(mockCluster.Nodes[n].Storage.TS.RetrieveChannel(ctx, key.StorageKey())).Retention
+	}
+
+	It("Should create a channel with a retention policy", func(ctx SpecContext) {
+		ch := channel.Channel{
+			Name:      channel.NewRandomName(),
+			IsIndex:   true,
+			DataType:  telem.TimeStampT,
+			Retention: retention,
+		}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		Expect(storageRetention(ctx, 1, ch.Key())).To(Equal(retention.Storage()))
+	})
+
+	It("Should apply a retention policy to a local channel", func(ctx SpecContext) {
+		ch := channel.Channel{Name: channel.NewRandomName(), IsIndex: true, DataType: telem.TimeStampT}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		Expect(mockCluster.Nodes[1].Channel.SetRetention(ctx, ch.Key(), retention)).To(Succeed())
+		var res channel.Channel
+		Expect(mockCluster.Nodes[1].Channel.NewRetrieve().
+			Where(channel.MatchKeys(ch.Key())).
+			Entry(&res).
+			Exec(ctx, nil)).To(Succeed())
+		Expect(res.Retention).To(Equal(retention))
+		Eventually(func(ctx SpecContext) ts.Retention {
+			return storageRetention(ctx, 1, ch.Key())
+		}).WithContext(ctx).Should(Equal(retention.Storage()))
+	})
+
+	It("Should apply a retention policy to a channel leased to another node", func(ctx SpecContext) {
+		ch := channel.Channel{
+			Name:        channel.NewRandomName(),
+			Leaseholder: 2,
+			IsIndex:     true,
+			DataType:    telem.TimeStampT,
+		}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		Eventually(func(g Gomega, ctx SpecContext) {
+			g.Expect(mockCluster.Nodes[1].Channel.NewRetrieve().
+				Where(channel.MatchKeys(ch.Key())).
+				Exists(ctx, nil)).To(BeTrue())
+		}).WithContext(ctx).Should(Succeed())
+		Expect(mockCluster.Nodes[1].Channel.SetRetention(ctx, ch.Key(), retention)).To(Succeed())
+		Eventually(func(ctx SpecContext) ts.Retention {
+			return storageRetention(ctx, 2, ch.Key())
+		}).WithContext(ctx).Should(Equal(retention.Storage()))
+	})
+
+	It("Should return an error when setting a policy on a virtual channel", func(ctx SpecContext) {
+		ch := channel.Channel{Name: channel.NewRandomName(), Virtual: true, DataType: telem.Float32T}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		Expect(mockCluster.Nodes[1].Channel.SetRetention(ctx, ch.Key(), retention)).
+			To(MatchError(validate.ErrValidation))
+	})
+
+	It("Should return an error for a negative policy", func(ctx SpecContext) {
+		ch := channel.Channel{Name: channel.NewRandomName(), IsIndex: true, DataType: telem.TimeStampT}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		Expect(mockCluster.Nodes[1].Channel.SetRetention(
+			ctx,
+			ch.Key(),
+			channel.Retention{MaxAge: -telem.Hour},
+		)).To(MatchError(ContainSubstring("max_age")))
+	})
+})
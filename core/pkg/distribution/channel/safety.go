@@ -0,0 +1,94 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package channel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+)
+
+var (
+	comparisons  = []string{"<", "<=", ">", ">=", "==", "!="}
+	numericTypes = []telem.DataType{
+		telem.Float64T, telem.Float32T,
+		telem.Int64T, telem.Int32T, telem.Int16T, telem.Int8T,
+		telem.Uint64T, telem.Uint32T, telem.Uint16T, telem.Uint8T,
+	}
+)
+
+// Validate checks that the bounds of the safety envelope are consistent and that its
+// interlocks use a known comparison.
+func (s Safety) Validate() error {
+	v := validate.New("safety")
+	if s.Min != nil && s.Max != nil {
+		validate.LessThanEq(v, "min", *s.Min, *s.Max)
+	}
+	if s.MaxRate != nil {
+		validate.Positive(v, "max_rate", *s.MaxRate)
+	}
+	if len(s.Interlocks) > 0 {
+		safe := lo.FromPtr(s.SafeValue)
+		if s.Min != nil {
+			validate.GreaterThanEq(v, "safe_value", safe, *s.Min)
+		}
+		if s.Max != nil {
+			validate.LessThanEq(v, "safe_value", safe, *s.Max)
+		}
+	}
+	for i, il := range s.Interlocks {
+		v.Ternaryf(
+			fmt.Sprintf("interlocks.%d.comparison", i),
+			!slices.Contains(comparisons, il.Comparison),
+			"must be one of %v",
+			comparisons,
+		)
+		v.Ternary(fmt.Sprintf("interlocks.%d.channel", i), il.Channel == 0, "must be set")
+	}
+	return v.Error()
+}
+
+// IsZero returns true if the envelope places no bounds on the channel.
+func (s Safety) IsZero() bool {
+	return s.Min == nil && s.Max == nil && s.MaxRate == nil && len(s.Interlocks) == 0
+}
+
+// SetSafety sets the safety envelope of the channel with the given key. The envelope
+// is stored with the channel and enforced on the writes of Arc programs that write to
+// it.
+func (w Writer) SetSafety(ctx context.Context, key Key, s Safety) error {
+	return w.svc.setSafety(ctx, w.tx, key, s)
+}
+
+func (s *Service) setSafety(ctx context.Context, tx gorp.Tx, key Key, safety Safety) error {
+	if err := safety.Validate(); err != nil {
+		return err
+	}
+	return s.table.NewUpdate().
+		Where(gorp.MatchKeys[Key, Channel](key)).
+		ChangeErr(func(_ gorp.Context, c Channel) (Channel, error) {
+			if !safety.IsZero() && !slices.Contains(numericTypes, c.DataType) {
+				return c, errors.Wrapf(
+					validate.ErrValidation,
+					"cannot set safety envelope on non-numeric channel %v",
+					c,
+				)
+			}
+			c.Safety = safety
+			return c, nil
+		}).
+		Exec(ctx, tx)
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package channel_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+)
+
+var _ = Describe("Safety", Ordered, func() {
+	var mockCluster *mock.Cluster
+	BeforeAll(func(ctx SpecContext) { mockCluster = mock.ProvisionCluster(context.Background(), 1) })
+	AfterAll(func() { Expect(mockCluster.Close()).To(Succeed()) })
+	ptr := func(v float64) *float64 { return &v }
+	retrieve := func(ctx SpecContext, key channel.Key) channel.Channel {
+		var res channel.Channel
+		Expect(mockCluster.Nodes[1].Channel.NewRetrieve().
+			Where(channel.MatchKeys(key)).
+			Entry(&res).
+			Exec(ctx, nil)).To(Succeed())
+		return res
+	}
+
+	It("Should create a channel with a safety envelope", func(ctx SpecContext) {
+		safety := channel.Safety{Min: ptr(0), Max: ptr(100), MaxRate: ptr(5)}
+		ch := channel.Channel{
+			Name:     channel.NewRandomName(),
+			Virtual:  true,
+			DataType: telem.Float32T,
+			Safety:   safety,
+		}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		Expect(retrieve(ctx, ch.Key()).Safety).To(Equal(safety))
+	})
+
+	It("Should set the safety envelope of an existing channel", func(ctx SpecContext) {
+		pressure := channel.Channel{Name: channel.NewRandomName(), Virtual: true, DataType: telem.Float32T}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &pressure)).To(Succeed())
+		ch := channel.Channel{Name: channel.NewRandomName(), Virtual: true, DataType: telem.Uint8T}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		safety := channel.Safety{
+			Interlocks: []channel.Interlock{
+				{Channel: pressure.Key(), Comparison: ">", Value: 500},
+			},
+			SafeValue: new(float64),
+		}
+		Expect(mockCluster.Nodes[1].Channel.SetSafety(ctx, ch.Key(), safety)).To(Succeed())
+		Expect(retrieve(ctx, ch.Key()).Safety).To(Equal(safety))
+		Expect(mockCluster.Nodes[1].Channel.SetSafety(ctx, ch.Key(), channel.Safety{})).To(Succeed())
+		Expect(retrieve(ctx, ch.Key()).Safety.IsZero()).To(BeTrue())
+	})
+
+	It("Should return an error when the bounds are inverted", func(ctx SpecContext) {
+		ch := channel.Channel{
+			Name:     channel.NewRandomName(),
+			Virtual:  true,
+			DataType: telem.Float32T,
+			Safety:   channel.Safety{Min: ptr(10), Max: ptr(1)},
+		}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).
+			To(MatchError(ContainSubstring("min")))
+	})
+
+	It("Should return an error for a non-positive max rate", func(ctx SpecContext) {
+		ch := channel.Channel{Name: channel.NewRandomName(), Virtual: true, DataType: telem.Float32T}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		Expect(mockCluster.Nodes[1].Channel.SetSafety(
+			ctx,
+			ch.Key(),
+			channel.Safety{MaxRate: ptr(0)},
+		)).To(MatchError(ContainSubstring("max_rate")))
+	})
+
+	It("Should return an error for an unknown interlock comparison", func(ctx SpecContext) {
+		ch := channel.Channel{Name: channel.NewRandomName(), Virtual: true, DataType: telem.Float32T}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		Expect(mockCluster.Nodes[1].Channel.SetSafety(ctx, ch.Key(), channel.Safety{
+			Interlocks: []channel.Interlock{{Channel: ch.Key(), Comparison: "=>", Value: 1}},
+		})).To(MatchError(ContainSubstring("comparison")))
+	})
+
+	It("Should return an error when setting an envelope on a non-numeric channel", func(ctx SpecContext) {
+		ch := channel.Channel{Name: channel.NewRandomName(), Virtual: true, DataType: telem.StringT}
+		Expect(mockCluster.Nodes[1].Channel.Create(ctx, &ch)).To(Succeed())
+		Expect(mockCluster.Nodes[1].Channel.SetSafety(
+			ctx,
+			ch.Key(),
+			channel.Safety{Max: ptr(1)},
+		)).To(MatchError(validate.ErrValidation))
+	})
+})
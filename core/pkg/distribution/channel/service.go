@@ -14,6 +14,7 @@ import (
 	"sync"
 
 	"github.com/synnaxlabs/alamos"
+	v56 "github.com/synnaxlabs/synnax/pkg/distribution/channel/migrations/v56"
 	"github.com/synnaxlabs/synnax/pkg/distribution/group"
 	"github.com/synnaxlabs/synnax/pkg/distribution/node"
 	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
@@ -28,6 +29,7 @@ import (
 	"github.com/synnaxlabs/x/override"
 	"github.com/synnaxlabs/x/service"
 	"github.com/synnaxlabs/x/set"
+	"github.com/synnaxlabs/x/signal"
 	"github.com/synnaxlabs/x/types"
 	"github.com/synnaxlabs/x/validate"
 )
@@ -64,6 +66,13 @@ type Service struct {
 	createRouter proxy.BatchFactory[Channel]
 	renameRouter proxy.BatchFactory[renameBatchEntry]
 	keyRouter    proxy.BatchFactory[Key]
+	// leaseTable stores the leases of channels that have been transferred away from
+	// the node embedded in their key, and leases is its node-local copy used for
+	// routing. leaseUpdates carries changed leases to the goroutine responsible for
+	// moving transfers forward on this node.
+	leaseTable   *gorp.Table[Key, Lease]
+	leases       leaseTable
+	leaseUpdates chan []Lease
 }
 
 // ShouldValidateNames reports whether channel-name validation is on (default true).
@@ -75,7 +84,12 @@ type IntOverflowChecker = func(types.Uint20) error
 
 type ServiceConfig struct {
 	alamos.Instrumentation
-	HostResolver     node.HostResolver
+	HostResolver node.HostResolver
+	// Cluster is used to select the nodes that store the replicas of replicated
+	// channels.
+	//
+	// [REQUIRED]
+	Cluster          node.Cluster
 	ClusterDB        *gorp.DB
 	TSChannel        *ts.DB
 	Transport        Transport
@@ -96,6 +110,7 @@ var _ config.Config[ServiceConfig] = ServiceConfig{}
 func (c ServiceConfig) Validate() error {
 	v := validate.New("distribution.channel")
 	validate.NotNil(v, "host_resolver", c.HostResolver)
+	validate.NotNil(v, "cluster", c.Cluster)
 	validate.NotNil(v, "cluster_db", c.ClusterDB)
 	validate.NotNil(v, "ts_channel", c.TSChannel)
 	validate.NotNil(v, "transport", c.Transport)
@@ -109,6 +124,7 @@ func (c ServiceConfig) Validate() error {
 func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
 	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
 	c.HostResolver = override.Nil(c.HostResolver, other.HostResolver)
+	c.Cluster = override.Nil(c.Cluster, other.Cluster)
 	c.ClusterDB = override.Nil(c.ClusterDB, other.ClusterDB)
 	c.TSChannel = override.Nil(c.TSChannel, other.TSChannel)
 	c.Transport = override.Nil(c.Transport, other.Transport)
@@ -121,6 +137,8 @@ func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
 	return c
 }
 
+const leaseUpdateBuffer = 10
+
 var DefaultServiceConfig = ServiceConfig{ValidateNames: new(true), ForceMigration: new(false)}
 
 func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err error) {
@@ -136,12 +154,23 @@ func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err er
 		createRouter: proxy.BatchFactory[Channel]{Host: cfg.HostResolver.HostKey()},
 		keyRouter:    proxy.BatchFactory[Key]{Host: cfg.HostResolver.HostKey()},
 		renameRouter: proxy.BatchFactory[renameBatchEntry]{Host: cfg.HostResolver.HostKey()},
+		leaseUpdates: make(chan []Lease, leaseUpdateBuffer),
 	}
+	s.leases.entries = make(map[Key]Lease)
 	cleanup, ok := service.NewOpener(ctx, &s.closer)
 	defer func() { err = cleanup(err) }()
 	if s.table, err = gorp.OpenTable(ctx, gorp.TableConfig[Key, Channel]{
-		DB:              cfg.ClusterDB,
-		Migrations:      []migrate.Migration{gorp.CodecMigration[Key, Channel]("msgpack_to_orc")},
+		DB: cfg.ClusterDB,
+		Migrations: []migrate.Migration{
+			gorp.CodecMigration[v56.Key, v56.Channel]("msgpack_to_orc"),
+			migrate.WithAddedDeps(
+				gorp.NewEntryMigration[v56.Key, Key, v56.Channel, Channel](
+					"v56_add_retention",
+					MigrateChannel,
+				),
+				"msgpack_to_orc",
+			),
+		},
 		Indexes:         s.indexes.all(),
 		Instrumentation: cfg.Instrumentation,
 	}); !ok(err, s.table) {
@@ -176,9 +205,33 @@ func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err er
 			return nil, err
 		}
 	}
+	if s.leaseTable, err = gorp.OpenTable(ctx, gorp.TableConfig[Key, Lease]{
+		DB:              cfg.ClusterDB,
+		Instrumentation: cfg.Instrumentation,
+	}); !ok(err, s.leaseTable) {
+		return nil, err
+	}
+	var leases []Lease
+	if err = s.leaseTable.NewRetrieve().Entries(&leases).Exec(ctx, cfg.ClusterDB); !ok(err, nil) {
+		return nil, err
+	}
+	for _, l := range leases {
+		s.leases.entries[l.Channel] = l
+	}
+	sCtx, cancel := signal.Isolated(signal.WithInstrumentation(cfg.Instrumentation))
+	signal.GoRange(sCtx, s.leaseUpdates, s.processLeases, signal.WithKey("leases"))
+	ok(nil, signal.NewHardShutdown(sCtx, cancel))
+	ok(nil, io.NoFailCloserFunc(s.leaseTable.Observe().OnChange(s.updateLeases)))
+	// Pick up any transfers that progressed while the node was offline.
+	if len(leases) > 0 {
+		s.leaseUpdates <- leases
+	}
 	cfg.Transport.CreateServer().BindHandler(s.createHandler)
 	cfg.Transport.DeleteServer().BindHandler(s.deleteHandler)
 	cfg.Transport.RenameServer().BindHandler(s.renameHandler)
+	ok(nil, io.NoFailCloserFunc(s.table.Observe().OnChange(s.applyRetention)))
+	ok(nil, io.NoFailCloserFunc(s.table.Observe().OnChange(s.applyReplicas)))
+	ok(nil, io.NoFailCloserFunc(s.table.Observe().OnChange(s.applyTransferredChanges)))
 	s.Writer = s.NewWriter(nil)
 	if cfg.Ontology != nil {
 		cfg.Ontology.RegisterService(s)
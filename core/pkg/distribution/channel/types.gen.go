@@ -67,6 +67,47 @@ type Operation struct {
 	Duration telem.TimeSpan `json:"duration" msgpack:"duration"`
 }
 
+// Retention defines how long the data in a channel is retained before it is
+// automatically deleted. Retention policies only apply to channels that persist data.
+type Retention struct {
+	// MaxAge is the maximum age of data retained in the channel. Data older than max_age is
+	// deleted in the background. If 0, data is never deleted based on age.
+	MaxAge telem.TimeSpan `json:"max_age" msgpack:"max_age"`
+	// MaxSize is the maximum on-disk size of the channel's data. When exceeded, the oldest
+	// data is deleted in the background until the channel fits within max_size. If 0, data
+	// is never deleted based on size.
+	MaxSize telem.Size `json:"max_size" msgpack:"max_size"`
+}
+
+// Interlock holds a channel at its safe value while another channel's value satisfies a
+// comparison.
+type Interlock struct {
+	// Channel is the channel whose value the interlock conditions on.
+	Channel Key `json:"channel" msgpack:"channel"`
+	// Comparison is the operator comparing the channel's value to value: one of <, <=, >,
+	// >=, == or !=.
+	Comparison string `json:"comparison" msgpack:"comparison"`
+	// Value is the threshold the channel's value is compared to.
+	Value float64 `json:"value" msgpack:"value"`
+}
+
+// Safety is the safety envelope of a channel. Arc programs enforce the envelope on
+// their writes to the channel, alongside any envelope they declare themselves.
+type Safety struct {
+	// Min is the smallest value that may be written to the channel.
+	Min *float64 `json:"min,omitempty" msgpack:"min,omitempty"`
+	// Max is the largest value that may be written to the channel.
+	Max *float64 `json:"max,omitempty" msgpack:"max,omitempty"`
+	// MaxRate is the largest change in the channel's value per second between consecutive
+	// writes.
+	MaxRate *float64 `json:"max_rate,omitempty" msgpack:"max_rate,omitempty"`
+	// Interlocks hold the channel at safe_value while any of their conditions are
+	// satisfied.
+	Interlocks []Interlock `json:"interlocks" msgpack:"interlocks"`
+	// SafeValue is the value the channel is held at while interlocked. Zero when unset.
+	SafeValue *float64 `json:"safe_value,omitempty" msgpack:"safe_value,omitempty"`
+}
+
 // Channel is an internal representation of a channel containing all storage and
 // distribution metadata. This type is used internally by the server; clients should use
 // APIChannel instead.
@@ -99,4 +140,16 @@ type Channel struct {
 	// Expression is an Arc expression for calculated channels. If set, the channel is
 	// automatically configured as virtual.
 	Expression string `json:"expression" msgpack:"expression"`
+	// Retention is the retention policy for the channel's data. The policy is enforced by
+	// the storage layer on the channel's leaseholder.
+	Retention Retention `json:"retention" msgpack:"retention"`
+	// ReplicationFactor is the number of nodes that store a copy of the channel's data,
+	// including the leaseholder. If 0 or 1, the channel is not replicated. Data channels
+	// always share the replication of their index.
+	ReplicationFactor uint8 `json:"replication_factor" msgpack:"replication_factor"`
+	// Replicas are the nodes other than the leaseholder that store a copy of the channel's
+	// data. Replicas are assigned by the leaseholder when the channel is created.
+	Replicas []node.Key `json:"replicas" msgpack:"replicas"`
+	// Safety is the safety envelope enforced on writes to the channel by Arc programs.
+	Safety Safety `json:"safety" msgpack:"safety"`
 }
@@ -42,7 +42,7 @@ func (lp *leaseProxy) deleteTimeRange(
 	keys channel.Keys,
 	tr telem.TimeRange,
 ) error {
-	batch := lp.keyRouter.Batch(keys)
+	batch := lp.keyRouter.BatchFunc(keys, lp.Channel.Leases(keys...).Leaseholder)
 	sCtx, cancel := signal.WithCancel(ctx)
 	defer cancel()
 	for nodeKey, entries := range batch.Peers {
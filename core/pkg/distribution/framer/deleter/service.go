@@ -33,6 +33,9 @@ type ServiceConfig struct {
 	HostResolver node.HostResolver
 	TSChannel    *ts.DB
 	Transport    Transport
+	// Channel is used to resolve the leaseholders of channels whose leases have been
+	// transferred between nodes.
+	Channel *channel.Service
 }
 
 var _ config.Config[ServiceConfig] = ServiceConfig{}
@@ -43,6 +46,7 @@ func (c ServiceConfig) Validate() error {
 	validate.NotNil(v, "host_resolver", c.HostResolver)
 	validate.NotNil(v, "ts_channel", c.TSChannel)
 	validate.NotNil(v, "transport", c.Transport)
+	validate.NotNil(v, "channel", c.Channel)
 	return v.Error()
 }
 
@@ -51,6 +55,7 @@ func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
 	c.HostResolver = override.Nil(c.HostResolver, other.HostResolver)
 	c.TSChannel = override.Nil(c.TSChannel, other.TSChannel)
 	c.Transport = override.Nil(c.Transport, other.Transport)
+	c.Channel = override.Nil(c.Channel, other.Channel)
 	return c
 }
 
@@ -46,27 +46,30 @@ func NewMulti(keys []channel.Key, series []telem.Series) Frame {
 func Alloc(cap int) Frame { return Frame{telem.AllocFrame[channel.Key](cap)} }
 
 // SplitByLeaseholder splits the frame into multiple frames based on the leaseholder
-// node of each channel. Returns a map where each key is a node key and the value is a
-// frame containing all series for channels leased by that node.
-func (f Frame) SplitByLeaseholder() map[node.Key]Frame {
+// node of each channel, as resolved by leases. Returns a map where each key is a node
+// key and the value is a frame containing all series for channels leased by that node.
+func (f Frame) SplitByLeaseholder(leases channel.Leases) map[node.Key]Frame {
 	frames := make(map[node.Key]Frame)
 	for key, ser := range f.Entries() {
-		nodeKey := key.Leaseholder()
+		nodeKey := leases.Leaseholder(key)
 		frames[nodeKey] = frames[nodeKey].Append(key, ser)
 	}
 	return frames
 }
 
 // SplitByHost splits the frame into three frames based on the leaseholder of each
-// channel:
+// channel, as resolved by leases:
 //   - local: contains series for channels leased by the specified host
 //   - remote: contains series for channels leased by other hosts
 //   - free: contains series for channels that are not leased by any host
-func (f Frame) SplitByHost(host node.Key) (local Frame, remote Frame, free Frame) {
+func (f Frame) SplitByHost(
+	host node.Key,
+	leases channel.Leases,
+) (local Frame, remote Frame, free Frame) {
 	for key, series := range f.Entries() {
-		if key.Leaseholder() == host {
+		if lh := leases.Leaseholder(key); lh == host {
 			local = local.Append(key, series)
-		} else if key.Leaseholder().IsFree() {
+		} else if lh.IsFree() {
 			free = free.Append(key, series)
 		} else {
 			remote = remote.Append(key, series)
@@ -116,3 +119,53 @@ func Merge(frames []Frame) Frame {
 func NewFromStorage(frame ts.Frame) Frame {
 	return Frame{telem.UnsafeReinterpretFrameKeysAs[cesium.ChannelKey, channel.Key](frame)}
 }
+
+// Downsample returns a copy of the frame with every series downsampled by the given
+// factor using the given mode. indexes maps the key of each channel in the frame to the
+// key of its index channel. Series for channels that share an index and cover the same
+// samples are downsampled together so that they remain aligned with their index after
+// downsampling. Channels missing from indexes are downsampled on their own.
+func (f Frame) Downsample(
+	mode telem.DownsampleMode,
+	factor int,
+	indexes map[channel.Key]channel.Key,
+) Frame {
+	if factor <= 1 {
+		return f
+	}
+	type group struct {
+		index     channel.Key
+		alignment telem.Alignment
+		length    int64
+	}
+	var (
+		out    = f.ShallowCopy()
+		groups = make(map[group][]int)
+		order  []group
+	)
+	for i, key := range out.RawKeys() {
+		if out.ShouldExcludeRaw(i) {
+			continue
+		}
+		s := out.RawSeriesAt(i)
+		g := group{index: key, alignment: s.Alignment, length: s.Len()}
+		if idx := indexes[key]; idx != 0 {
+			g.index = idx
+		}
+		if _, ok := groups[g]; !ok {
+			order = append(order, g)
+		}
+		groups[g] = append(groups[g], i)
+	}
+	for _, g := range order {
+		positions := groups[g]
+		series := make([]telem.Series, len(positions))
+		for i, p := range positions {
+			series[i] = out.RawSeriesAt(p)
+		}
+		for i, s := range telem.Downsample(mode, factor, series...) {
+			out.SetRawSeriesAt(positions[i], s)
+		}
+	}
+	return out
+}
@@ -36,7 +36,7 @@ var _ = Describe("Frame", func() {
 					telem.NewSeriesV[int64](10, 11, 12),
 				},
 			)
-			frames := f.SplitByLeaseholder()
+			frames := f.SplitByLeaseholder(nil)
 			Expect(frames).To(HaveLen(2))
 			Expect(frames[1]).To(Equal(frame.NewMulti(
 				[]channel.Key{node1ch1, node1ch2},
@@ -47,6 +47,19 @@ var _ = Describe("Frame", func() {
 				[]telem.Series{telem.NewSeriesV[int64](7, 8, 9), telem.NewSeriesV[int64](10, 11, 12)},
 			)))
 		})
+
+		It("Should route channels with transferred leases to their new leaseholder", func() {
+			node1ch1 := channel.NewKey(1, 1)
+			node1ch2 := channel.NewKey(1, 2)
+			f := frame.NewMulti(
+				[]channel.Key{node1ch1, node1ch2},
+				[]telem.Series{telem.NewSeriesV[int64](1, 2, 3), telem.NewSeriesV[int64](4, 5, 6)},
+			)
+			frames := f.SplitByLeaseholder(channel.Leases{node1ch2: 2})
+			Expect(frames).To(HaveLen(2))
+			Expect(frames[1]).To(Equal(frame.NewUnary(node1ch1, telem.NewSeriesV[int64](1, 2, 3))))
+			Expect(frames[2]).To(Equal(frame.NewUnary(node1ch2, telem.NewSeriesV[int64](4, 5, 6))))
+		})
 	})
 
 	Describe("SplitByHost", func() {
@@ -62,7 +75,7 @@ var _ = Describe("Frame", func() {
 					telem.NewSeriesV[int64](7, 8, 9),
 				},
 			)
-			local, remote, free := f.SplitByHost(1)
+			local, remote, free := f.SplitByHost(1, nil)
 			Expect(local).To(Equal(frame.NewUnary(
 				localNodeCh,
 				telem.NewSeriesV[int64](1, 2, 3),
@@ -229,6 +242,44 @@ var _ = Describe("Frame", func() {
 		})
 	})
 
+	Describe("Downsample", func() {
+		It("Should keep data channels aligned with their index", func() {
+			idx := channel.NewKey(1, 1)
+			data := channel.NewKey(1, 2)
+			f := frame.NewMulti(
+				[]channel.Key{data, idx},
+				[]telem.Series{
+					telem.NewSeriesV[float64](0, 0, 100, 0, -100, 0),
+					telem.NewSeriesSecondsTSV(1, 2, 3, 4, 5, 6),
+				},
+			)
+			indexes := map[channel.Key]channel.Key{data: idx, idx: idx}
+			out := f.Downsample(telem.DownsampleModeMinMax, 3, indexes)
+			Expect(out.Get(data).Series[0]).To(telem.MatchSeries(
+				telem.NewSeriesV[float64](0, 100, 0, -100),
+			))
+			Expect(out.Get(idx).Series[0]).To(telem.MatchSeries(
+				telem.NewSeriesSecondsTSV(1, 3, 4, 5),
+			))
+			Expect(f.Get(data).Series[0].Len()).To(Equal(int64(6)))
+		})
+
+		It("Should downsample channels without an index on their own", func() {
+			a := channel.NewKey(1, 1)
+			b := channel.NewKey(1, 2)
+			f := frame.NewMulti(
+				[]channel.Key{a, b},
+				[]telem.Series{
+					telem.NewSeriesV[int64](1, 9, 1, 1),
+					telem.NewSeriesV[int64](1, 1, 1, 9),
+				},
+			)
+			out := f.Downsample(telem.DownsampleModeMinMax, 4, nil)
+			Expect(out.Get(a).Series[0]).To(telem.MatchSeries(telem.NewSeriesV[int64](1, 9)))
+			Expect(out.Get(b).Series[0]).To(telem.MatchSeries(telem.NewSeriesV[int64](1, 9)))
+		})
+	})
+
 	Describe("Alloc", func() {
 		It("Should allocate a frame with the specified capacity", func() {
 			fr := frame.Alloc(12)
@@ -28,6 +28,9 @@ type (
 	StreamWriter     = writer.StreamWriter
 	WriterConfig     = writer.Config
 	IteratorConfig   = iterator.Config
+	AggregateConfig  = iterator.AggregateConfig
+	Aggregate        = iterator.Aggregate
+	Bucket           = iterator.Bucket
 	StreamerResponse = relay.Response
 	StreamerRequest  = relay.Request
 	StreamerConfig   = relay.StreamerConfig
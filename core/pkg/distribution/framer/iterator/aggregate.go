@@ -0,0 +1,344 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package iterator
+
+import (
+	"context"
+	"math"
+
+	"github.com/synnaxlabs/freighter"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
+	"github.com/synnaxlabs/synnax/pkg/distribution/proxy"
+	"github.com/synnaxlabs/synnax/pkg/storage/ts"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// AggregateConfig is the configuration for computing summary statistics of channel
+// data over fixed-width time buckets.
+type AggregateConfig struct {
+	// Keys are the keys of the channels to aggregate. Only numeric channels that store
+	// data can be aggregated.
+	// [REQUIRED] - must have at least one key.
+	Keys channel.Keys `json:"keys" msgpack:"keys"`
+	// Bounds is the time range to aggregate over. This time range must be valid i.e.,
+	// the start value must be before or equal to the end value.
+	// [REQUIRED]
+	Bounds telem.TimeRange `json:"bounds" msgpack:"bounds"`
+	// BucketWidth is the width of each bucket. The first bucket starts at the start of
+	// Bounds, and the last bucket is truncated to the end of Bounds.
+	// [REQUIRED]
+	BucketWidth telem.TimeSpan `json:"bucket_width" msgpack:"bucket_width"`
+}
+
+// Validate validates the configuration.
+func (c AggregateConfig) Validate() error {
+	v := validate.New("distribution.framer.iterator.aggregate")
+	validate.NotEmptySlice(v, "keys", c.Keys)
+	validate.Positive(v, "bucket_width", c.BucketWidth)
+	v.Ternary("bounds", !c.Bounds.Valid(), "start must be before or equal to end")
+	return v.Error()
+}
+
+// Bucket contains summary statistics for the samples of a channel within a time
+// bucket.
+type Bucket struct {
+	// TimeRange is the time range covered by the bucket.
+	TimeRange telem.TimeRange `json:"time_range" msgpack:"time_range"`
+	// Count is the number of samples in the bucket.
+	Count int64 `json:"count" msgpack:"count"`
+	// Min is the smallest sample in the bucket.
+	Min float64 `json:"min" msgpack:"min"`
+	// Max is the largest sample in the bucket.
+	Max float64 `json:"max" msgpack:"max"`
+	// Mean is the arithmetic mean of the samples in the bucket.
+	Mean float64 `json:"mean" msgpack:"mean"`
+	// StdDev is the population standard deviation of the samples in the bucket.
+	StdDev float64 `json:"std_dev" msgpack:"std_dev"`
+	// First is the earliest sample in the bucket.
+	First float64 `json:"first" msgpack:"first"`
+	// Last is the latest sample in the bucket.
+	Last float64 `json:"last" msgpack:"last"`
+}
+
+// Aggregate contains the buckets computed for a single channel.
+type Aggregate struct {
+	// Key is the key of the channel.
+	Key channel.Key `json:"key" msgpack:"key"`
+	// Buckets are the buckets containing at least one sample, in time order.
+	Buckets []Bucket `json:"buckets" msgpack:"buckets"`
+}
+
+// Aggregate computes summary statistics for each of the channels in the given config
+// over time buckets. Statistics are computed by the node that stores each channel's
+// data, and only the buckets are sent back to the host. The returned aggregates are
+// in the same order as the keys in the config.
+func (s *Service) Aggregate(ctx context.Context, cfg AggregateConfig) ([]Aggregate, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.validateChannelKeys(ctx, cfg.Keys); err != nil {
+		return nil, err
+	}
+	cfg.Keys = cfg.Keys.Unique()
+	var (
+		hostKey = s.cfg.HostResolver.HostKey()
+		leases  = s.cfg.Channel.Leases(cfg.Keys...)
+		batch   = proxy.BatchFactory[channel.Key]{Host: hostKey}.BatchFunc(
+			cfg.Keys,
+			leases.Leaseholder,
+		)
+		clients []ClientStream
+		results []Aggregate
+	)
+	// Send the requests to all peers before aggregating locally so that the peers
+	// compute their aggregates concurrently with the host.
+	for nodeKey, keys := range batch.Peers {
+		req := Request{Keys: keys, Bounds: cfg.Bounds, BucketWidth: cfg.BucketWidth}
+		client, isLocal, err := s.openPeerOrReplica(ctx, nodeKey, req)
+		if err != nil {
+			return nil, s.closePeerClients(clientSenders(clients), err)
+		}
+		if isLocal {
+			batch.Gateway = append(batch.Gateway, keys...)
+			continue
+		}
+		clients = append(clients, client)
+	}
+	if len(batch.Gateway) > 0 {
+		local, err := aggregate(ctx, s.cfg, Request{
+			Keys:        batch.Gateway,
+			Bounds:      cfg.Bounds,
+			BucketWidth: cfg.BucketWidth,
+		})
+		if err != nil {
+			return nil, s.closePeerClients(clientSenders(clients), err)
+		}
+		results = append(results, local...)
+	}
+	for i, client := range clients {
+		res, err := receiveAggregates(client)
+		if err != nil {
+			return nil, s.closePeerClients(clientSenders(clients[i:]), err)
+		}
+		results = append(results, res...)
+	}
+	return mergeAggregates(cfg.Keys, results), nil
+}
+
+func clientSenders(clients []ClientStream) []freighter.StreamSenderCloser[Request] {
+	senders := make([]freighter.StreamSenderCloser[Request], len(clients))
+	for i, c := range clients {
+		senders[i] = c
+	}
+	return senders
+}
+
+// receiveAggregates receives the aggregates computed by a peer and closes the stream.
+func receiveAggregates(client ClientStream) ([]Aggregate, error) {
+	res, err := client.Receive()
+	if err != nil {
+		return nil, errors.Combine(err, client.CloseSend())
+	}
+	if err = client.CloseSend(); err != nil {
+		return nil, err
+	}
+	return res.Aggregates, res.Error
+}
+
+// mergeAggregates merges the aggregates computed by each node into a single
+// aggregate per key, ordered by keys.
+func mergeAggregates(keys channel.Keys, results []Aggregate) []Aggregate {
+	byKey := make(map[channel.Key]Aggregate, len(results))
+	for _, agg := range results {
+		existing := byKey[agg.Key]
+		existing.Buckets = append(existing.Buckets, agg.Buckets...)
+		byKey[agg.Key] = existing
+	}
+	merged := make([]Aggregate, len(keys))
+	for i, key := range keys {
+		merged[i] = Aggregate{Key: key, Buckets: byKey[key].Buckets}
+	}
+	return merged
+}
+
+const aggregateChunkSize = 100000
+
+// aggregate computes the aggregates requested by the given request from the data in
+// the host's storage layer.
+func aggregate(ctx context.Context, cfg ServiceConfig, req Request) (aggs []Aggregate, err error) {
+	var channels []channel.Channel
+	if err = cfg.Channel.NewRetrieve().
+		Where(channel.MatchKeys(req.Keys...)).
+		Entries(&channels).
+		Exec(ctx, nil); err != nil {
+		return nil, err
+	}
+	var (
+		accumulators = make([]*accumulator, len(channels))
+		toRead       = make(channel.Keys, 0, len(channels))
+	)
+	for i, ch := range channels {
+		if ch.Virtual {
+			return nil, errors.Wrapf(
+				validate.ErrValidation,
+				"cannot aggregate virtual channel %v",
+				ch,
+			)
+		}
+		if !isNumeric(ch.DataType) {
+			return nil, errors.Wrapf(
+				validate.ErrValidation,
+				"cannot aggregate channel %v with non-numeric data type %s",
+				ch,
+				ch.DataType,
+			)
+		}
+		accumulators[i] = &accumulator{
+			ch:     ch,
+			bounds: req.Bounds,
+			width:  req.BucketWidth,
+		}
+		toRead = append(toRead, ch.Key())
+		if !ch.IsIndex {
+			toRead = append(toRead, ch.Index())
+		}
+	}
+	iter, err := cfg.TS.OpenIterator(ts.IteratorConfig{
+		Channels:      toRead.Unique().Storage(),
+		Bounds:        req.Bounds,
+		AutoChunkSize: aggregateChunkSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errors.Combine(err, iter.Close()) }()
+	for ok := iter.SeekFirst() && iter.Next(ts.AutoSpan); ok; ok = iter.Next(ts.AutoSpan) {
+		fr := frame.NewFromStorage(iter.Value())
+		for _, acc := range accumulators {
+			acc.accumulate(fr)
+		}
+	}
+	if err = iter.Error(); err != nil {
+		return nil, err
+	}
+	aggs = make([]Aggregate, len(accumulators))
+	for i, acc := range accumulators {
+		aggs[i] = acc.aggregate()
+	}
+	return aggs, nil
+}
+
+// accumulator computes the buckets for a single channel from the frames read in time
+// order.
+type accumulator struct {
+	ch      channel.Channel
+	bounds  telem.TimeRange
+	width   telem.TimeSpan
+	buckets []Bucket
+	// current is the index of the bucket currently being accumulated. It is only
+	// meaningful once at least one bucket has been started.
+	current int64
+	// m2 is the sum of the squared differences from the mean of the samples in the
+	// current bucket.
+	m2 float64
+}
+
+func (a *accumulator) accumulate(fr frame.Frame) {
+	var stamps telem.MultiSeries
+	if !a.ch.IsIndex {
+		stamps = fr.Get(a.ch.Index())
+	}
+	for _, s := range fr.Get(a.ch.Key()).Series {
+		values := telem.Float64s(s)
+		if a.ch.IsIndex {
+			for i, ts := range telem.UnmarshalSeries[telem.TimeStamp](s) {
+				a.add(ts, values[i])
+			}
+			continue
+		}
+		cursor := 0
+		for i, v := range values {
+			alignment := s.Alignment.AddSamples(uint32(i))
+			ts, ok := stampAt(stamps, alignment, &cursor)
+			if ok {
+				a.add(ts, v)
+			}
+		}
+	}
+}
+
+// stampAt returns the timestamp at the given alignment in the given index series.
+// cursor is the position of the series to start searching from, and is updated to
+// the position of the series containing the alignment.
+func stampAt(
+	index telem.MultiSeries,
+	alignment telem.Alignment,
+	cursor *int,
+) (telem.TimeStamp, bool) {
+	for i := range index.Series {
+		pos := (*cursor + i) % len(index.Series)
+		s := index.Series[pos]
+		if s.AlignmentBounds().Contains(alignment) {
+			*cursor = pos
+			offset := alignment.SampleIndex() - s.Alignment.SampleIndex()
+			return telem.ValueAt[telem.TimeStamp](s, int(offset)), true
+		}
+	}
+	return 0, false
+}
+
+func (a *accumulator) add(ts telem.TimeStamp, v float64) {
+	idx := int64(a.bounds.Start.Span(ts) / a.width)
+	if len(a.buckets) == 0 || idx != a.current {
+		a.flush()
+		start := a.bounds.Start.Add(telem.TimeSpan(idx) * a.width)
+		end := a.bounds.End
+		if a.width < start.Span(end) {
+			end = start.Add(a.width)
+		}
+		a.buckets = append(a.buckets, Bucket{
+			TimeRange: telem.TimeRange{Start: start, End: end},
+			Min:       v,
+			Max:       v,
+			First:     v,
+		})
+		a.current = idx
+		a.m2 = 0
+	}
+	b := &a.buckets[len(a.buckets)-1]
+	b.Count++
+	b.Min = min(b.Min, v)
+	b.Max = max(b.Max, v)
+	b.Last = v
+	delta := v - b.Mean
+	b.Mean += delta / float64(b.Count)
+	a.m2 += delta * (v - b.Mean)
+}
+
+// flush finalizes the statistics of the current bucket.
+func (a *accumulator) flush() {
+	if len(a.buckets) == 0 {
+		return
+	}
+	b := &a.buckets[len(a.buckets)-1]
+	b.StdDev = math.Sqrt(a.m2 / float64(b.Count))
+}
+
+func (a *accumulator) aggregate() Aggregate {
+	a.flush()
+	return Aggregate{Key: a.ch.Key(), Buckets: a.buckets}
+}
+
+func isNumeric(dt telem.DataType) bool {
+	return dt != telem.UUIDT && dt.Density() != telem.UnknownDensity
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package iterator_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/iterator"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/writer"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+	"github.com/synnaxlabs/x/validate"
+)
+
+var _ = Describe("Aggregate", Ordered, func() {
+	var (
+		mockCluster *mock.Cluster
+		dist        mock.Node
+		gatewayIdx  channel.Channel
+		gatewayData channel.Channel
+		peerIdx     channel.Channel
+		peerData    channel.Channel
+		str         channel.Channel
+	)
+	BeforeAll(func(ctx SpecContext) {
+		mockCluster = mock.ProvisionCluster(ctx, 2)
+		dist = mockCluster.Nodes[1]
+		gatewayIdx = channel.Channel{
+			Name:        "gateway_idx",
+			IsIndex:     true,
+			DataType:    telem.TimeStampT,
+			Leaseholder: 1,
+		}
+		peerIdx = channel.Channel{
+			Name:        "peer_idx",
+			IsIndex:     true,
+			DataType:    telem.TimeStampT,
+			Leaseholder: 2,
+		}
+		Expect(dist.Channel.Create(ctx, &gatewayIdx)).To(Succeed())
+		Expect(dist.Channel.Create(ctx, &peerIdx)).To(Succeed())
+		gatewayData = channel.Channel{
+			Name:        "gateway_data",
+			DataType:    telem.Float64T,
+			LocalIndex:  gatewayIdx.LocalKey,
+			Leaseholder: 1,
+		}
+		peerData = channel.Channel{
+			Name:        "peer_data",
+			DataType:    telem.Int64T,
+			LocalIndex:  peerIdx.LocalKey,
+			Leaseholder: 2,
+		}
+		str = channel.Channel{
+			Name:        "string",
+			DataType:    telem.StringT,
+			LocalIndex:  gatewayIdx.LocalKey,
+			Leaseholder: 1,
+		}
+		Expect(dist.Channel.Create(ctx, &gatewayData)).To(Succeed())
+		Expect(dist.Channel.Create(ctx, &peerData)).To(Succeed())
+		Expect(dist.Channel.Create(ctx, &str)).To(Succeed())
+		keys := channel.Keys{gatewayIdx.Key(), gatewayData.Key(), peerIdx.Key(), peerData.Key()}
+		Eventually(func(g Gomega) {
+			var chs []channel.Channel
+			g.Expect(dist.Channel.NewRetrieve().
+				Entries(&chs).
+				Where(channel.MatchKeys(keys...)).
+				Exec(ctx, nil)).To(Succeed())
+			g.Expect(chs).To(HaveLen(len(keys)))
+		}).Should(Succeed())
+		w := MustSucceed(dist.Framer.OpenWriter(ctx, writer.Config{
+			Keys:  keys,
+			Start: 10 * telem.SecondTS,
+			Sync:  new(true),
+		}))
+		fr := frame.NewMulti(keys, []telem.Series{
+			telem.NewSeriesSecondsTSV(10, 11, 12, 13, 14, 15, 16, 17, 18, 19),
+			telem.NewSeriesV[float64](1, 2, 3, 4, 5, 6, 7, 8, 9, 10),
+			telem.NewSeriesSecondsTSV(10, 11, 12, 13, 14, 15, 16, 17, 18, 19),
+			telem.NewSeriesV[int64](5, 5, 5, 5, -5, -5, -5, -5, 0, 0),
+		})
+		Expect(w.Write(fr)).To(BeTrue())
+		Expect(w.Commit()).To(BeNumerically("==", 19*telem.SecondTS+1))
+		Expect(w.Close()).To(Succeed())
+	})
+	AfterAll(func() { Expect(mockCluster.Close()).To(Succeed()) })
+
+	It("Should compute statistics for each bucket across gateway and peer channels", func(ctx SpecContext) {
+		aggs := MustSucceed(dist.Framer.Aggregate(ctx, iterator.AggregateConfig{
+			Keys: channel.Keys{peerData.Key(), gatewayData.Key()},
+			Bounds: telem.TimeRange{
+				Start: 10 * telem.SecondTS,
+				End:   20 * telem.SecondTS,
+			},
+			BucketWidth: 4 * telem.Second,
+		}))
+		Expect(aggs).To(HaveLen(2))
+
+		Expect(aggs[0].Key).To(Equal(peerData.Key()))
+		Expect(aggs[0].Buckets).To(HaveLen(3))
+		Expect(aggs[0].Buckets[0].Mean).To(Equal(5.0))
+		Expect(aggs[0].Buckets[0].StdDev).To(Equal(0.0))
+		Expect(aggs[0].Buckets[1].Min).To(Equal(-5.0))
+		Expect(aggs[0].Buckets[2].Count).To(BeEquivalentTo(2))
+
+		Expect(aggs[1].Key).To(Equal(gatewayData.Key()))
+		Expect(aggs[1].Buckets).To(HaveLen(3))
+		first := aggs[1].Buckets[0]
+		Expect(first.TimeRange).To(Equal(telem.TimeRange{
+			Start: 10 * telem.SecondTS,
+			End:   14 * telem.SecondTS,
+		}))
+		Expect(first.Count).To(BeEquivalentTo(4))
+		Expect(first.Min).To(Equal(1.0))
+		Expect(first.Max).To(Equal(4.0))
+		Expect(first.Mean).To(Equal(2.5))
+		Expect(first.StdDev).To(BeNumerically("~", math.Sqrt(1.25), 1e-9))
+		Expect(first.First).To(Equal(1.0))
+		Expect(first.Last).To(Equal(4.0))
+		last := aggs[1].Buckets[2]
+		Expect(last.TimeRange).To(Equal(telem.TimeRange{
+			Start: 18 * telem.SecondTS,
+			End:   20 * telem.SecondTS,
+		}))
+		Expect(last.Count).To(BeEquivalentTo(2))
+		Expect(last.Mean).To(Equal(9.5))
+	})
+
+	It("Should truncate the last bucket to the end of the bounds", func(ctx SpecContext) {
+		aggs := MustSucceed(dist.Framer.Aggregate(ctx, iterator.AggregateConfig{
+			Keys: channel.Keys{gatewayData.Key()},
+			Bounds: telem.TimeRange{
+				Start: 12 * telem.SecondTS,
+				End:   17 * telem.SecondTS,
+			},
+			BucketWidth: 2 * telem.Second,
+		}))
+		Expect(aggs).To(HaveLen(1))
+		buckets := aggs[0].Buckets
+		Expect(buckets).To(HaveLen(3))
+		Expect(buckets[0].Mean).To(Equal(3.5))
+		Expect(buckets[1].Mean).To(Equal(5.5))
+		Expect(buckets[2].TimeRange).To(Equal(telem.TimeRange{
+			Start: 16 * telem.SecondTS,
+			End:   17 * telem.SecondTS,
+		}))
+		Expect(buckets[2].Count).To(BeEquivalentTo(1))
+		Expect(buckets[2].First).To(Equal(7.0))
+	})
+
+	It("Should skip buckets without any samples", func(ctx SpecContext) {
+		aggs := MustSucceed(dist.Framer.Aggregate(ctx, iterator.AggregateConfig{
+			Keys: channel.Keys{peerData.Key()},
+			Bounds: telem.TimeRange{
+				Start: 0,
+				End:   20 * telem.SecondTS,
+			},
+			BucketWidth: 5 * telem.Second,
+		}))
+		Expect(aggs[0].Buckets).To(HaveLen(2))
+		Expect(aggs[0].Buckets[0].TimeRange.Start).To(Equal(10 * telem.SecondTS))
+	})
+
+	It("Should return an error when the bucket width is not positive", func(ctx SpecContext) {
+		Expect(dist.Framer.Aggregate(ctx, iterator.AggregateConfig{
+			Keys:   channel.Keys{gatewayData.Key()},
+			Bounds: telem.TimeRangeMax,
+		})).Error().To(MatchError(ContainSubstring("bucket_width")))
+	})
+
+	It("Should return an error when aggregating a non-numeric channel", func(ctx SpecContext) {
+		Expect(dist.Framer.Aggregate(ctx, iterator.AggregateConfig{
+			Keys:        channel.Keys{str.Key()},
+			Bounds:      telem.TimeRangeMax,
+			BucketWidth: telem.Second,
+		})).Error().To(MatchError(validate.ErrValidation))
+	})
+})
@@ -16,6 +16,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
 	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
 	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
 	"github.com/synnaxlabs/synnax/pkg/distribution/framer/iterator"
@@ -127,6 +128,63 @@ var _ = Describe("Iterator", func() {
 			})
 		}
 	})
+
+	Describe("Replication", func() {
+		It("Should read from a replica when the leaseholder is unreachable", func(ctx SpecContext) {
+			mockCluster := mock.ProvisionCluster(ctx, 3)
+			defer func() { Expect(mockCluster.Close()).To(Succeed()) }()
+			ch := channel.Channel{
+				Name:              "replicated",
+				IsIndex:           true,
+				DataType:          telem.TimeStampT,
+				Leaseholder:       2,
+				ReplicationFactor: 2,
+			}
+			Expect(mockCluster.Nodes[2].Channel.Create(ctx, &ch)).To(Succeed())
+			Expect(ch.Replicas).To(HaveLen(1))
+			replica := ch.Replicas[0]
+			Expect(replica).ToNot(Equal(node.Key(2)))
+			reader := lo.Ternary(replica == 1, node.Key(3), node.Key(1))
+			Eventually(func(g Gomega) {
+				g.Expect(mockCluster.Nodes[replica].Storage.TS.RetrieveChannel(
+					ctx,
+					ch.Key().StorageKey(),
+				)).Error().ToNot(HaveOccurred())
+				var res channel.Channel
+				g.Expect(mockCluster.Nodes[reader].Channel.NewRetrieve().
+					Where(channel.MatchKeys(ch.Key())).
+					Entry(&res).
+					Exec(ctx, nil)).To(Succeed())
+			}).Should(Succeed())
+
+			w := MustSucceed(mockCluster.Nodes[reader].Framer.OpenWriter(ctx, writer.Config{
+				Keys:  channel.Keys{ch.Key()},
+				Start: 10 * telem.SecondTS,
+				Sync:  new(true),
+			}))
+			Expect(w.Write(frame.NewUnary(ch.Key(), telem.NewSeriesSecondsTSV(10, 11, 12)))).To(BeTrue())
+			Expect(w.Commit()).To(BeNumerically("==", 12*telem.SecondTS+1))
+			Expect(w.Close()).To(Succeed())
+			Eventually(func(g Gomega) {
+				frame := MustSucceed(mockCluster.Nodes[replica].Storage.TS.Read(
+					ctx,
+					telem.TimeRangeMax,
+					ch.Key().StorageKey(),
+				))
+				g.Expect(frame.SeriesAt(0).Len()).To(BeEquivalentTo(3))
+			}).Should(Succeed())
+
+			Expect(mockCluster.Kill(2)).To(Succeed())
+			iter := MustSucceed(mockCluster.Nodes[reader].Framer.OpenIterator(ctx, iterator.Config{
+				Keys:   channel.Keys{ch.Key()},
+				Bounds: telem.TimeRangeMax,
+			}))
+			Expect(iter.SeekFirst()).To(BeTrue())
+			Expect(iter.Next(iterator.AutoSpan)).To(BeTrue())
+			Expect(iter.Value().SeriesAt(0)).To(telem.MatchWrittenSeries(telem.NewSeriesSecondsTSV(10, 11, 12)))
+			Expect(iter.Close()).To(Succeed())
+		})
+	})
 })
 
 type scenario struct {
@@ -11,7 +11,9 @@ package iterator
 
 import (
 	"context"
+	"slices"
 
+	"github.com/samber/lo"
 	"github.com/synnaxlabs/freighter"
 	"github.com/synnaxlabs/freighter/freightfluence"
 	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
@@ -19,6 +21,7 @@ import (
 	"github.com/synnaxlabs/x/address"
 	"github.com/synnaxlabs/x/errors"
 	"github.com/synnaxlabs/x/telem"
+	"go.uber.org/zap"
 )
 
 type peerSender struct {
@@ -50,25 +53,99 @@ func (s *Service) openManyPeers(
 	bounds telem.TimeRange,
 	chunkSize int64,
 	targets map[node.Key][]channel.Key,
-	generateSeqNums bool,
-) (*peerSender, []*freightfluence.Receiver[Response], error) {
+) (*peerSender, []*freightfluence.Receiver[Response], channel.Keys, error) {
 	var (
-		sender    = newPeerSender(generateSeqNums)
+		sender    = newPeerSender(false)
 		receivers = make([]*freightfluence.Receiver[Response], 0, len(targets))
+		local     channel.Keys
 	)
 	for nodeKey, keys := range targets {
-		target, err := s.cfg.HostResolver.Resolve(nodeKey)
+		req := Request{Keys: keys, Bounds: bounds, ChunkSize: chunkSize}
+		client, isLocal, err := s.openPeerOrReplica(ctx, nodeKey, req)
 		if err != nil {
-			return sender, receivers, s.closePeerClients(sender.Senders, err)
+			return sender, receivers, local, s.closePeerClients(sender.Senders, err)
 		}
-		client, err := s.openPeerClient(ctx, target, Config{Keys: keys, Bounds: bounds, ChunkSize: chunkSize})
-		if err != nil {
-			return sender, receivers, s.closePeerClients(sender.Senders, err)
+		if isLocal {
+			local = append(local, keys...)
+			continue
 		}
 		sender.Senders = append(sender.Senders, client)
 		receivers = append(receivers, &freightfluence.Receiver[Response]{Receiver: client})
 	}
-	return sender, receivers, nil
+	return sender, receivers, local, nil
+}
+
+// openPeerOrReplica opens an iterator on the leaseholder of the channels in the given
+// request, sending the request as the first message on the stream. If the leaseholder can't be reached, the iterator is opened on a node that
+// stores a replica of the channels instead, preferring the host. isLocal is true if the
+// host stores a replica, in which case the caller is responsible for reading the
+// channels from local storage.
+func (s *Service) openPeerOrReplica(
+	ctx context.Context,
+	leaseholder node.Key,
+	req Request,
+) (client ClientStream, isLocal bool, err error) {
+	if client, err = s.openPeer(ctx, leaseholder, req); err == nil {
+		return client, false, nil
+	}
+	replicas, rErr := s.replicas(ctx, req.Keys)
+	if rErr != nil {
+		return nil, false, errors.Combine(err, rErr)
+	}
+	host := s.cfg.HostResolver.HostKey()
+	if slices.Contains(replicas, host) {
+		s.cfg.L.Warn(
+			"leaseholder unreachable, reading from local replica",
+			zap.Uint16("leaseholder", uint16(leaseholder)),
+			zap.Stringers("channels", req.Keys),
+			zap.Error(err),
+		)
+		return nil, true, nil
+	}
+	for _, replica := range replicas {
+		var replicaClient ClientStream
+		if replicaClient, rErr = s.openPeer(ctx, replica, req); rErr == nil {
+			s.cfg.L.Warn(
+				"leaseholder unreachable, reading from replica",
+				zap.Uint16("leaseholder", uint16(leaseholder)),
+				zap.Uint16("replica", uint16(replica)),
+				zap.Stringers("channels", req.Keys),
+				zap.Error(err),
+			)
+			return replicaClient, false, nil
+		}
+		err = errors.Combine(err, rErr)
+	}
+	return nil, false, err
+}
+
+// replicas returns the nodes that store a replica of every channel with the given
+// keys. Returns an empty slice if any of the channels are not replicated.
+func (s *Service) replicas(ctx context.Context, keys channel.Keys) ([]node.Key, error) {
+	var channels []channel.Channel
+	if err := s.cfg.Channel.NewRetrieve().
+		Where(channel.MatchKeys(keys...)).
+		Entries(&channels).
+		Exec(ctx, nil); err != nil {
+		return nil, err
+	}
+	var replicas []node.Key
+	for i, ch := range channels {
+		if i == 0 {
+			replicas = ch.Replicas
+			continue
+		}
+		replicas = lo.Intersect(replicas, ch.Replicas)
+	}
+	return replicas, nil
+}
+
+func (s *Service) openPeer(ctx context.Context, nodeKey node.Key, req Request) (ClientStream, error) {
+	target, err := s.cfg.HostResolver.Resolve(nodeKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.openPeerClient(ctx, target, req)
 }
 
 func (s *Service) closePeerClients(
@@ -81,10 +158,10 @@ func (s *Service) closePeerClients(
 	return originalErr
 }
 
-func (s *Service) openPeerClient(ctx context.Context, target address.Address, cfg Config) (ClientStream, error) {
+func (s *Service) openPeerClient(ctx context.Context, target address.Address, req Request) (ClientStream, error) {
 	client, err := s.cfg.Transport.Client().Stream(ctx, target)
 	if err != nil {
 		return nil, err
 	}
-	return client, client.Send(Request{Keys: cfg.Keys, ChunkSize: cfg.ChunkSize, Bounds: cfg.Bounds})
+	return client, client.Send(req)
 }
@@ -37,6 +37,9 @@ func (sf *server) handle(ctx context.Context, server ServerStream) error {
 	if err != nil {
 		return err
 	}
+	if req.BucketWidth > 0 {
+		return sf.aggregate(ctx, server, req)
+	}
 
 	receiver := &freightfluence.TransformReceiver[ts.IteratorRequest, Request]{Receiver: server}
 	receiver.Transform = newStorageRequestTranslator(false)
@@ -63,3 +66,17 @@ func (sf *server) handle(ctx context.Context, server ServerStream) error {
 	pipe.Flow(sCtx, confluence.CloseOutputInletsOnExit(), confluence.RecoverWithErrOnPanic())
 	return sCtx.Wait()
 }
+
+// aggregate computes the aggregates requested by the client and sends them back as a
+// single response.
+func (sf *server) aggregate(ctx context.Context, server ServerStream, req Request) error {
+	aggs, err := aggregate(ctx, sf.ServiceConfig, req)
+	if err != nil {
+		return err
+	}
+	return server.Send(Response{
+		Variant:    ResponseVariantData,
+		NodeKey:    sf.HostResolver.HostKey(),
+		Aggregates: aggs,
+	})
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/samber/lo"
 	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/freighter/freightfluence"
 	"github.com/synnaxlabs/aspen"
 	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
 	"github.com/synnaxlabs/synnax/pkg/distribution/proxy"
@@ -156,21 +157,36 @@ func (s *Service) NewStream(ctx context.Context, cfg Config) (StreamIterator, er
 	}
 	cfg.Keys = cfg.Keys.Unique()
 	var (
-		hostID             = s.cfg.HostResolver.HostKey()
-		batch              = proxy.BatchFactory[channel.Key]{Host: hostID}.Batch(cfg.Keys)
-		pipe               = plumber.New()
-		needPeerRouting    = len(batch.Peers) > 0
-		needGatewayRouting = len(batch.Gateway) > 0
-		receiverAddresses  []address.Address
-		routeInletTo       address.Address
+		hostID            = s.cfg.HostResolver.HostKey()
+		leases            = s.cfg.Channel.Leases(cfg.Keys...)
+		batch             = proxy.BatchFactory[channel.Key]{Host: hostID}.BatchFunc(cfg.Keys, leases.Leaseholder)
+		pipe              = plumber.New()
+		receiverAddresses []address.Address
+		routeInletTo      address.Address
+		sender            *peerSender
+		receivers         []*freightfluence.Receiver[Response]
 	)
 
-	if needPeerRouting {
-		routeInletTo = peerSenderAddr
-		sender, receivers, err := s.openManyPeers(ctx, cfg.Bounds, cfg.ChunkSize, batch.Peers, !needGatewayRouting)
+	if len(batch.Peers) > 0 {
+		var (
+			local channel.Keys
+			err   error
+		)
+		sender, receivers, local, err = s.openManyPeers(ctx, cfg.Bounds, cfg.ChunkSize, batch.Peers)
 		if err != nil {
 			return nil, err
 		}
+		batch.Gateway = append(batch.Gateway, local...)
+	}
+
+	var (
+		needPeerRouting    = len(receivers) > 0
+		needGatewayRouting = len(batch.Gateway) > 0
+	)
+
+	if needPeerRouting {
+		routeInletTo = peerSenderAddr
+		sender.generateSeqNums = !needGatewayRouting
 		plumber.SetSink[Request](pipe, peerSenderAddr, sender)
 		receiverAddresses = make([]address.Address, len(receivers))
 		for i, c := range receivers {
@@ -187,6 +203,9 @@ func (s *Service) NewStream(ctx context.Context, cfg Config) (StreamIterator, er
 			!needPeerRouting,
 		)
 		if err != nil {
+			if needPeerRouting {
+				err = s.closePeerClients(sender.Senders, err)
+			}
 			return nil, err
 		}
 		plumber.SetSegment[Request, Response](pipe, gatewayIterAddr, gatewayIter)
@@ -207,7 +226,7 @@ func (s *Service) NewStream(ctx context.Context, cfg Config) (StreamIterator, er
 	plumber.SetSegment[Response, Response](
 		pipe,
 		synchronizerAddr,
-		newSynchronizer(len(cfg.Keys.UniqueLeaseholders()), s.cfg.Instrumentation),
+		newSynchronizer(len(receiverAddresses), s.cfg.Instrumentation),
 	)
 
 	plumber.MultiRouter[Response]{
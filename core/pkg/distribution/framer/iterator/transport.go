@@ -49,6 +49,14 @@ type Request struct {
 	ChunkSize int64 `json:"chunk_size" msgpack:"chunk_size"`
 	// DownsampleFactor should only be set when opening the Iterator.
 	DownsampleFactor int `json:"downsample_factor" msgpack:"downsample_factor"`
+	// DownsampleMode should only be set when opening the Iterator.
+	DownsampleMode telem.DownsampleMode `json:"downsample_mode" msgpack:"downsample_mode"`
+	// DownsampleTargetPoints should only be set when opening the Iterator.
+	DownsampleTargetPoints int `json:"downsample_target_points" msgpack:"downsample_target_points"`
+	// BucketWidth should only be set when opening an aggregation. When set, the peer
+	// computes the aggregates for Keys over Bounds and closes the stream instead of
+	// opening an Iterator.
+	BucketWidth telem.TimeSpan `json:"bucket_width" msgpack:"bucket_width"`
 	// SeqNum is the sequence number of the request (starting at 0). This is used to
 	// match responses to requests. Each request should increment the sequence number
 	// by 1.
@@ -87,6 +95,9 @@ type Response struct {
 	// Ack is only relevant for variant AckResponse. Is true if the Iterator successfully
 	// executed the request.
 	Ack bool `json:"ack" msgpack:"ack"`
+	// Aggregates is only relevant for responses to an aggregation request. It contains
+	// the aggregates computed by the peer.
+	Aggregates []Aggregate `json:"aggregates" msgpack:"aggregates"`
 }
 
 type (
@@ -0,0 +1,245 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package framer
+
+import (
+	"context"
+	"slices"
+
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/iterator"
+	"github.com/synnaxlabs/synnax/pkg/storage/ts"
+	"github.com/synnaxlabs/x/change"
+	"github.com/synnaxlabs/x/control"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/telem"
+	"go.uber.org/zap"
+)
+
+// leaseTransferSubject is the control subject used when writing the data copied from
+// the previous leaseholder of a channel.
+var leaseTransferSubject = control.Subject{Key: "lease_transfer", Name: "Lease Transfer"}
+
+// observeLeases notifies the lease fencer when a transfer of a channel leased to the
+// host starts, and the lease receiver when the current leaseholder of a channel being
+// transferred to the host has fenced the channel. Channels are unfenced on the host once
+// their transfer ends.
+func (s *Service) observeLeases(_ context.Context, r gorp.TxReader[channel.Key, channel.Lease]) {
+	var (
+		host                = s.cfg.HostResolver.HostKey()
+		unfence             channel.Keys
+		fence, transferring bool
+	)
+	for c := range r {
+		if c.Variant != change.VariantSet || !c.Value.Transferring() {
+			unfence = append(unfence, c.Key)
+			continue
+		}
+		if c.Value.Leaseholder == host && !c.Value.Fenced {
+			fence = true
+		}
+		if c.Value.Target == host && c.Value.Fenced {
+			transferring = true
+		}
+	}
+	if len(unfence) > 0 {
+		s.writer.Unfence(unfence...)
+	}
+	if fence {
+		s.notify(s.leaseFences)
+	}
+	if transferring {
+		s.notify(s.leaseTransfers)
+	}
+}
+
+// notify wakes the lease routine that receives from ch. Notifications are coalesced, as
+// the routines always process every transfer that is ready.
+func (s *Service) notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// fenceLeases closes all writers open on the host for channels whose leases the host is
+// transferring to another node, and then marks the channels as fenced so that the
+// target can start copying their data. Closing the writers first ensures that no data
+// is written to the channels after the copy starts, where it would be lost when the
+// host releases the channels. If the writers do not close within the configured
+// timeout, or the channels cannot be fenced, the transfer is aborted.
+func (s *Service) fenceLeases(ctx context.Context, _ struct{}) error {
+	keys, err := s.cfg.Channel.UnfencedLeases(ctx, s.cfg.HostResolver.HostKey())
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+	fCtx, cancel := context.WithTimeout(ctx, s.cfg.LeaseFenceTimeout)
+	defer cancel()
+	w := s.cfg.Channel.NewLeaseWriter(nil)
+	if err = s.writer.Fence(fCtx, keys...); err == nil {
+		err = w.Fence(ctx, keys)
+	}
+	if err == nil {
+		return nil
+	}
+	s.cfg.L.Error(
+		"failed to fence channels for lease transfer",
+		zap.Stringers("channels", keys),
+		zap.Error(err),
+	)
+	if fErr := w.Fail(ctx, keys, err); fErr != nil {
+		s.cfg.L.Error("failed to abort lease transfer", zap.Error(fErr))
+	}
+	return nil
+}
+
+// receiveLeases copies the data for all channels whose leases are ready to be
+// transferred to the host from their current leaseholder, and then takes the leases on
+// the channels. If the copy fails, the transfer is aborted and any copied data is
+// removed.
+func (s *Service) receiveLeases(ctx context.Context, _ struct{}) error {
+	host := s.cfg.HostResolver.HostKey()
+	keys, err := s.cfg.Channel.FencedLeases(ctx, host)
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+	err = s.copyLeaseData(ctx, keys)
+	w := s.cfg.Channel.NewLeaseWriter(nil)
+	if err == nil {
+		err = w.Complete(ctx, keys, host)
+	}
+	if err == nil {
+		return nil
+	}
+	s.cfg.L.Error(
+		"failed to receive channel leases",
+		zap.Stringers("channels", keys),
+		zap.Error(err),
+	)
+	if dErr := s.deleteLocalChannels(ctx, keys); dErr != nil {
+		s.cfg.L.Warn("failed to remove partially copied channel data", zap.Error(dErr))
+	}
+	if fErr := w.Fail(ctx, keys, err); fErr != nil {
+		s.cfg.L.Error("failed to abort lease transfer", zap.Error(fErr))
+	}
+	return nil
+}
+
+// copyLeaseData creates the storage layer channels for the given keys on the host and
+// copies all of their historical data from their current leaseholder.
+func (s *Service) copyLeaseData(ctx context.Context, keys channel.Keys) error {
+	var channels []channel.Channel
+	if err := s.cfg.Channel.NewRetrieve().
+		Where(channel.MatchKeys(keys...)).
+		Entries(&channels).
+		Exec(ctx, nil); err != nil {
+		return err
+	}
+	// Index channels must be created and populated before the channels that depend on
+	// them.
+	slices.SortStableFunc(channels, func(a, b channel.Channel) int {
+		if a.IsIndex == b.IsIndex {
+			return 0
+		}
+		if a.IsIndex {
+			return -1
+		}
+		return 1
+	})
+	// Clear out any data left behind by a previous lease on the channels.
+	if err := s.deleteLocalChannels(ctx, keys); err != nil {
+		return err
+	}
+	if err := s.cfg.TS.CreateChannel(
+		ctx,
+		lo.Map(channels, func(ch channel.Channel, _ int) ts.Channel { return ch.Storage() })...,
+	); err != nil {
+		return err
+	}
+	for _, ch := range channels {
+		if err := s.copyChannel(ctx, ch.Key()); err != nil {
+			return errors.Wrapf(err, "failed to copy data for channel %v", ch)
+		}
+	}
+	return nil
+}
+
+// copyChannel reads all data for the channel with the given key from its current
+// leaseholder and writes it to the host's storage layer, preserving the domain
+// boundaries of the source.
+func (s *Service) copyChannel(ctx context.Context, key channel.Key) (err error) {
+	iter, err := s.OpenIterator(ctx, IteratorConfig{
+		Keys:   channel.Keys{key},
+		Bounds: telem.TimeRangeMax,
+	})
+	if err != nil {
+		return err
+	}
+	var (
+		w      *ts.Writer
+		domain uint32
+	)
+	closeWriter := func() error {
+		if w == nil {
+			return nil
+		}
+		_, cErr := w.Commit()
+		cErr = errors.Combine(cErr, w.Close())
+		w = nil
+		return cErr
+	}
+	defer func() {
+		err = errors.Combine(err, closeWriter())
+		err = errors.Combine(err, iter.Close())
+	}()
+	if !iter.SeekFirst() {
+		return iter.Error()
+	}
+	for iter.Next(iterator.AutoSpan) {
+		for _, ser := range iter.Value().Get(key).Series {
+			if w != nil && ser.Alignment.DomainIndex() != domain {
+				if err = closeWriter(); err != nil {
+					return err
+				}
+			}
+			if w == nil {
+				if w, err = s.cfg.TS.OpenWriter(ctx, ts.WriterConfig{
+					ControlSubject:   leaseTransferSubject,
+					Channels:         []ts.ChannelKey{key.StorageKey()},
+					Start:            ser.TimeRange.Start,
+					Mode:             ts.WriterModePersistOnly,
+					EnableAutoCommit: new(false),
+				}); err != nil {
+					return err
+				}
+				domain = ser.Alignment.DomainIndex()
+			}
+			if _, err = w.Write(telem.UnaryFrame(key.StorageKey(), ser)); err != nil {
+				return err
+			}
+		}
+	}
+	return iter.Error()
+}
+
+// deleteLocalChannels removes the storage layer channels for the given keys if they
+// exist on the host.
+func (s *Service) deleteLocalChannels(ctx context.Context, keys channel.Keys) error {
+	local := lo.Filter(keys, func(k channel.Key, _ int) bool {
+		_, err := s.cfg.TS.RetrieveChannel(ctx, k.StorageKey())
+		return err == nil
+	})
+	if len(local) == 0 {
+		return nil
+	}
+	return s.cfg.TS.DeleteChannels(local.Storage())
+}
@@ -43,6 +43,7 @@ type IteratorRequest struct {
 	Keys          []uint32               `protobuf:"varint,6,rep,packed,name=keys,proto3" json:"keys,omitempty"`
 	ChunkSize     int64                  `protobuf:"varint,7,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
 	SeqNum        int32                  `protobuf:"varint,8,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	BucketWidth   int64                  `protobuf:"varint,9,opt,name=bucket_width,json=bucketWidth,proto3" json:"bucket_width,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +127,13 @@ func (x *IteratorRequest) GetSeqNum() int32 {
 	return 0
 }
 
+func (x *IteratorRequest) GetBucketWidth() int64 {
+	if x != nil {
+		return x.BucketWidth
+	}
+	return 0
+}
+
 type IteratorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Variant       int32                  `protobuf:"varint,1,opt,name=variant,proto3" json:"variant,omitempty"`
@@ -135,6 +143,7 @@ type IteratorResponse struct {
 	Ack           bool                   `protobuf:"varint,5,opt,name=ack,proto3" json:"ack,omitempty"`
 	SeqNum        int32                  `protobuf:"varint,6,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
 	Error         *errors.PBPayload      `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Aggregates    []*Aggregate           `protobuf:"bytes,8,rep,name=aggregates,proto3" json:"aggregates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -218,6 +227,165 @@ func (x *IteratorResponse) GetError() *errors.PBPayload {
 	return nil
 }
 
+func (x *IteratorResponse) GetAggregates() []*Aggregate {
+	if x != nil {
+		return x.Aggregates
+	}
+	return nil
+}
+
+type Aggregate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           uint32                 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Buckets       []*Bucket              `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Aggregate) Reset() {
+	*x = Aggregate{}
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Aggregate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregate) ProtoMessage() {}
+
+func (x *Aggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregate.ProtoReflect.Descriptor instead.
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Aggregate) GetKey() uint32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *Aggregate) GetBuckets() []*Bucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type Bucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeRange     *pb.TimeRange          `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Min           float64                `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Mean          float64                `protobuf:"fixed64,5,opt,name=mean,proto3" json:"mean,omitempty"`
+	StdDev        float64                `protobuf:"fixed64,6,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	First         float64                `protobuf:"fixed64,7,opt,name=first,proto3" json:"first,omitempty"`
+	Last          float64                `protobuf:"fixed64,8,opt,name=last,proto3" json:"last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bucket) Reset() {
+	*x = Bucket{}
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bucket) ProtoMessage() {}
+
+func (x *Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
+func (*Bucket) Descriptor() ([]byte, []int) {
+	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Bucket) GetTimeRange() *pb.TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *Bucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Bucket) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *Bucket) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *Bucket) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *Bucket) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+func (x *Bucket) GetFirst() float64 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *Bucket) GetLast() float64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
 type RelayRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Keys          []uint32               `protobuf:"varint,1,rep,packed,name=keys,proto3" json:"keys,omitempty"`
@@ -227,7 +395,7 @@ type RelayRequest struct {
 
 func (x *RelayRequest) Reset() {
 	*x = RelayRequest{}
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[2]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -239,7 +407,7 @@ func (x *RelayRequest) String() string {
 func (*RelayRequest) ProtoMessage() {}
 
 func (x *RelayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[2]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +420,7 @@ func (x *RelayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelayRequest.ProtoReflect.Descriptor instead.
 func (*RelayRequest) Descriptor() ([]byte, []int) {
-	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{2}
+	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RelayRequest) GetKeys() []uint32 {
@@ -273,7 +441,7 @@ type RelayResponse struct {
 
 func (x *RelayResponse) Reset() {
 	*x = RelayResponse{}
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[3]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -285,7 +453,7 @@ func (x *RelayResponse) String() string {
 func (*RelayResponse) ProtoMessage() {}
 
 func (x *RelayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[3]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +466,7 @@ func (x *RelayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelayResponse.ProtoReflect.Descriptor instead.
 func (*RelayResponse) Descriptor() ([]byte, []int) {
-	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{3}
+	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RelayResponse) GetFrame() *pb.Frame {
@@ -327,13 +495,14 @@ type WriterRequest struct {
 	Command       int32                  `protobuf:"varint,1,opt,name=command,proto3" json:"command,omitempty"`
 	Config        *WriterConfig          `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	Frame         *pb.Frame              `protobuf:"bytes,3,opt,name=frame,proto3" json:"frame,omitempty"`
+	Replica       bool                   `protobuf:"varint,4,opt,name=replica,proto3" json:"replica,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WriterRequest) Reset() {
 	*x = WriterRequest{}
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[4]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -345,7 +514,7 @@ func (x *WriterRequest) String() string {
 func (*WriterRequest) ProtoMessage() {}
 
 func (x *WriterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[4]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +527,7 @@ func (x *WriterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriterRequest.ProtoReflect.Descriptor instead.
 func (*WriterRequest) Descriptor() ([]byte, []int) {
-	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{4}
+	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *WriterRequest) GetCommand() int32 {
@@ -382,6 +551,13 @@ func (x *WriterRequest) GetFrame() *pb.Frame {
 	return nil
 }
 
+func (x *WriterRequest) GetReplica() bool {
+	if x != nil {
+		return x.Replica
+	}
+	return false
+}
+
 type WriterConfig struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	Keys                     []uint32               `protobuf:"varint,1,rep,packed,name=keys,proto3" json:"keys,omitempty"`
@@ -399,7 +575,7 @@ type WriterConfig struct {
 
 func (x *WriterConfig) Reset() {
 	*x = WriterConfig{}
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[5]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -411,7 +587,7 @@ func (x *WriterConfig) String() string {
 func (*WriterConfig) ProtoMessage() {}
 
 func (x *WriterConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[5]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +600,7 @@ func (x *WriterConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriterConfig.ProtoReflect.Descriptor instead.
 func (*WriterConfig) Descriptor() ([]byte, []int) {
-	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{5}
+	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WriterConfig) GetKeys() []uint32 {
@@ -503,7 +679,7 @@ type WriterResponse struct {
 
 func (x *WriterResponse) Reset() {
 	*x = WriterResponse{}
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[6]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +691,7 @@ func (x *WriterResponse) String() string {
 func (*WriterResponse) ProtoMessage() {}
 
 func (x *WriterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[6]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +704,7 @@ func (x *WriterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriterResponse.ProtoReflect.Descriptor instead.
 func (*WriterResponse) Descriptor() ([]byte, []int) {
-	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{6}
+	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WriterResponse) GetCommand() int32 {
@@ -576,7 +752,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[7]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -588,7 +764,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[7]
+	mi := &file_core_pkg_distribution_framer_pb_framer_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +777,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{7}
+	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteRequest) GetKeys() []uint32 {
@@ -622,7 +798,7 @@ var File_core_pkg_distribution_framer_pb_framer_proto protoreflect.FileDescripto
 
 const file_core_pkg_distribution_framer_pb_framer_proto_rawDesc = "" +
 	"\n" +
-	",core/pkg/distribution/framer/pb/framer.proto\x12\x1asynnax.distribution.framer\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dx/go/control/pb/control.proto\x1a\x18x/go/errors/errors.proto\x1a\x19x/go/telem/pb/frame.proto\x1a\x19x/go/telem/pb/telem.proto\"\xd0\x01\n" +
+	",core/pkg/distribution/framer/pb/framer.proto\x12\x1asynnax.distribution.framer\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dx/go/control/pb/control.proto\x1a\x18x/go/errors/errors.proto\x1a\x19x/go/telem/pb/frame.proto\x1a\x19x/go/telem/pb/telem.proto\"\xf3\x01\n" +
 	"\x0fIteratorRequest\x12\x18\n" +
 	"\acommand\x18\x01 \x01(\x05R\acommand\x12\x14\n" +
 	"\x05stamp\x18\x02 \x01(\x03R\x05stamp\x12\x12\n" +
@@ -631,7 +807,8 @@ const file_core_pkg_distribution_framer_pb_framer_proto_rawDesc = "" +
 	"\x04keys\x18\x06 \x03(\rR\x04keys\x12\x1d\n" +
 	"\n" +
 	"chunk_size\x18\a \x01(\x03R\tchunkSize\x12\x17\n" +
-	"\aseq_num\x18\b \x01(\x05R\x06seqNum\"\xde\x01\n" +
+	"\aseq_num\x18\b \x01(\x05R\x06seqNum\x12!\n" +
+	"\fbucket_width\x18\t \x01(\x03R\vbucketWidth\"\xa5\x02\n" +
 	"\x10IteratorResponse\x12\x18\n" +
 	"\avariant\x18\x01 \x01(\x05R\avariant\x12\x18\n" +
 	"\acommand\x18\x02 \x01(\x05R\acommand\x12'\n" +
@@ -639,17 +816,34 @@ const file_core_pkg_distribution_framer_pb_framer_proto_rawDesc = "" +
 	"\bnode_key\x18\x04 \x01(\x05R\anodeKey\x12\x10\n" +
 	"\x03ack\x18\x05 \x01(\bR\x03ack\x12\x17\n" +
 	"\aseq_num\x18\x06 \x01(\x05R\x06seqNum\x12'\n" +
-	"\x05error\x18\a \x01(\v2\x11.errors.PBPayloadR\x05error\"\"\n" +
+	"\x05error\x18\a \x01(\v2\x11.errors.PBPayloadR\x05error\x12E\n" +
+	"\n" +
+	"aggregates\x18\b \x03(\v2%.synnax.distribution.framer.AggregateR\n" +
+	"aggregates\"[\n" +
+	"\tAggregate\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x12<\n" +
+	"\abuckets\x18\x02 \x03(\v2\".synnax.distribution.framer.BucketR\abuckets\"\xcf\x01\n" +
+	"\x06Bucket\x124\n" +
+	"\n" +
+	"time_range\x18\x01 \x01(\v2\x15.x.telem.pb.TimeRangeR\ttimeRange\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x10\n" +
+	"\x03min\x18\x03 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x04 \x01(\x01R\x03max\x12\x12\n" +
+	"\x04mean\x18\x05 \x01(\x01R\x04mean\x12\x17\n" +
+	"\astd_dev\x18\x06 \x01(\x01R\x06stdDev\x12\x14\n" +
+	"\x05first\x18\a \x01(\x01R\x05first\x12\x12\n" +
+	"\x04last\x18\b \x01(\x01R\x04last\"\"\n" +
 	"\fRelayRequest\x12\x12\n" +
 	"\x04keys\x18\x01 \x03(\rR\x04keys\"w\n" +
 	"\rRelayResponse\x12'\n" +
 	"\x05frame\x18\x01 \x01(\v2\x11.x.telem.pb.FrameR\x05frame\x12'\n" +
 	"\x05error\x18\x02 \x01(\v2\x11.errors.PBPayloadR\x05error\x12\x14\n" +
-	"\x05group\x18\x03 \x01(\rR\x05group\"\x94\x01\n" +
+	"\x05group\x18\x03 \x01(\rR\x05group\"\xae\x01\n" +
 	"\rWriterRequest\x12\x18\n" +
 	"\acommand\x18\x01 \x01(\x05R\acommand\x12@\n" +
 	"\x06config\x18\x02 \x01(\v2(.synnax.distribution.framer.WriterConfigR\x06config\x12'\n" +
-	"\x05frame\x18\x03 \x01(\v2\x11.x.telem.pb.FrameR\x05frame\"\xea\x02\n" +
+	"\x05frame\x18\x03 \x01(\v2\x11.x.telem.pb.FrameR\x05frame\x12\x18\n" +
+	"\areplica\x18\x04 \x01(\bR\areplica\"\xea\x02\n" +
 	"\fWriterConfig\x12\x12\n" +
 	"\x04keys\x18\x01 \x03(\rR\x04keys\x12\x14\n" +
 	"\x05start\x18\x02 \x01(\x03R\x05start\x12 \n" +
@@ -694,45 +888,50 @@ func file_core_pkg_distribution_framer_pb_framer_proto_rawDescGZIP() []byte {
 	return file_core_pkg_distribution_framer_pb_framer_proto_rawDescData
 }
 
-var file_core_pkg_distribution_framer_pb_framer_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_core_pkg_distribution_framer_pb_framer_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_core_pkg_distribution_framer_pb_framer_proto_goTypes = []any{
 	(*IteratorRequest)(nil),  // 0: synnax.distribution.framer.IteratorRequest
 	(*IteratorResponse)(nil), // 1: synnax.distribution.framer.IteratorResponse
-	(*RelayRequest)(nil),     // 2: synnax.distribution.framer.RelayRequest
-	(*RelayResponse)(nil),    // 3: synnax.distribution.framer.RelayResponse
-	(*WriterRequest)(nil),    // 4: synnax.distribution.framer.WriterRequest
-	(*WriterConfig)(nil),     // 5: synnax.distribution.framer.WriterConfig
-	(*WriterResponse)(nil),   // 6: synnax.distribution.framer.WriterResponse
-	(*DeleteRequest)(nil),    // 7: synnax.distribution.framer.DeleteRequest
-	(*pb.TimeRange)(nil),     // 8: x.telem.pb.TimeRange
-	(*pb.Frame)(nil),         // 9: x.telem.pb.Frame
-	(*errors.PBPayload)(nil), // 10: errors.PBPayload
-	(*pb1.Subject)(nil),      // 11: x.control.pb.Subject
-	(*emptypb.Empty)(nil),    // 12: google.protobuf.Empty
+	(*Aggregate)(nil),        // 2: synnax.distribution.framer.Aggregate
+	(*Bucket)(nil),           // 3: synnax.distribution.framer.Bucket
+	(*RelayRequest)(nil),     // 4: synnax.distribution.framer.RelayRequest
+	(*RelayResponse)(nil),    // 5: synnax.distribution.framer.RelayResponse
+	(*WriterRequest)(nil),    // 6: synnax.distribution.framer.WriterRequest
+	(*WriterConfig)(nil),     // 7: synnax.distribution.framer.WriterConfig
+	(*WriterResponse)(nil),   // 8: synnax.distribution.framer.WriterResponse
+	(*DeleteRequest)(nil),    // 9: synnax.distribution.framer.DeleteRequest
+	(*pb.TimeRange)(nil),     // 10: x.telem.pb.TimeRange
+	(*pb.Frame)(nil),         // 11: x.telem.pb.Frame
+	(*errors.PBPayload)(nil), // 12: errors.PBPayload
+	(*pb1.Subject)(nil),      // 13: x.control.pb.Subject
+	(*emptypb.Empty)(nil),    // 14: google.protobuf.Empty
 }
 var file_core_pkg_distribution_framer_pb_framer_proto_depIdxs = []int32{
-	8,  // 0: synnax.distribution.framer.IteratorRequest.bounds:type_name -> x.telem.pb.TimeRange
-	9,  // 1: synnax.distribution.framer.IteratorResponse.frame:type_name -> x.telem.pb.Frame
-	10, // 2: synnax.distribution.framer.IteratorResponse.error:type_name -> errors.PBPayload
-	9,  // 3: synnax.distribution.framer.RelayResponse.frame:type_name -> x.telem.pb.Frame
-	10, // 4: synnax.distribution.framer.RelayResponse.error:type_name -> errors.PBPayload
-	5,  // 5: synnax.distribution.framer.WriterRequest.config:type_name -> synnax.distribution.framer.WriterConfig
-	9,  // 6: synnax.distribution.framer.WriterRequest.frame:type_name -> x.telem.pb.Frame
-	11, // 7: synnax.distribution.framer.WriterConfig.control_subject:type_name -> x.control.pb.Subject
-	8,  // 8: synnax.distribution.framer.DeleteRequest.bounds:type_name -> x.telem.pb.TimeRange
-	0,  // 9: synnax.distribution.framer.IteratorService.Iterate:input_type -> synnax.distribution.framer.IteratorRequest
-	2,  // 10: synnax.distribution.framer.RelayService.Relay:input_type -> synnax.distribution.framer.RelayRequest
-	4,  // 11: synnax.distribution.framer.WriterService.Write:input_type -> synnax.distribution.framer.WriterRequest
-	7,  // 12: synnax.distribution.framer.DeleteService.Exec:input_type -> synnax.distribution.framer.DeleteRequest
-	1,  // 13: synnax.distribution.framer.IteratorService.Iterate:output_type -> synnax.distribution.framer.IteratorResponse
-	3,  // 14: synnax.distribution.framer.RelayService.Relay:output_type -> synnax.distribution.framer.RelayResponse
-	6,  // 15: synnax.distribution.framer.WriterService.Write:output_type -> synnax.distribution.framer.WriterResponse
-	12, // 16: synnax.distribution.framer.DeleteService.Exec:output_type -> google.protobuf.Empty
-	13, // [13:17] is the sub-list for method output_type
-	9,  // [9:13] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	10, // 0: synnax.distribution.framer.IteratorRequest.bounds:type_name -> x.telem.pb.TimeRange
+	11, // 1: synnax.distribution.framer.IteratorResponse.frame:type_name -> x.telem.pb.Frame
+	12, // 2: synnax.distribution.framer.IteratorResponse.error:type_name -> errors.PBPayload
+	2,  // 3: synnax.distribution.framer.IteratorResponse.aggregates:type_name -> synnax.distribution.framer.Aggregate
+	3,  // 4: synnax.distribution.framer.Aggregate.buckets:type_name -> synnax.distribution.framer.Bucket
+	10, // 5: synnax.distribution.framer.Bucket.time_range:type_name -> x.telem.pb.TimeRange
+	11, // 6: synnax.distribution.framer.RelayResponse.frame:type_name -> x.telem.pb.Frame
+	12, // 7: synnax.distribution.framer.RelayResponse.error:type_name -> errors.PBPayload
+	7,  // 8: synnax.distribution.framer.WriterRequest.config:type_name -> synnax.distribution.framer.WriterConfig
+	11, // 9: synnax.distribution.framer.WriterRequest.frame:type_name -> x.telem.pb.Frame
+	13, // 10: synnax.distribution.framer.WriterConfig.control_subject:type_name -> x.control.pb.Subject
+	10, // 11: synnax.distribution.framer.DeleteRequest.bounds:type_name -> x.telem.pb.TimeRange
+	0,  // 12: synnax.distribution.framer.IteratorService.Iterate:input_type -> synnax.distribution.framer.IteratorRequest
+	4,  // 13: synnax.distribution.framer.RelayService.Relay:input_type -> synnax.distribution.framer.RelayRequest
+	6,  // 14: synnax.distribution.framer.WriterService.Write:input_type -> synnax.distribution.framer.WriterRequest
+	9,  // 15: synnax.distribution.framer.DeleteService.Exec:input_type -> synnax.distribution.framer.DeleteRequest
+	1,  // 16: synnax.distribution.framer.IteratorService.Iterate:output_type -> synnax.distribution.framer.IteratorResponse
+	5,  // 17: synnax.distribution.framer.RelayService.Relay:output_type -> synnax.distribution.framer.RelayResponse
+	8,  // 18: synnax.distribution.framer.WriterService.Write:output_type -> synnax.distribution.framer.WriterResponse
+	14, // 19: synnax.distribution.framer.DeleteService.Exec:output_type -> google.protobuf.Empty
+	16, // [16:20] is the sub-list for method output_type
+	12, // [12:16] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_core_pkg_distribution_framer_pb_framer_proto_init() }
@@ -746,7 +945,7 @@ func file_core_pkg_distribution_framer_pb_framer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_core_pkg_distribution_framer_pb_framer_proto_rawDesc), len(file_core_pkg_distribution_framer_pb_framer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
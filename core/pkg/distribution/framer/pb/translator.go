@@ -69,7 +69,8 @@ func (WriterRequestTranslator) Backward(
 			AutoIndexPersistInterval: telem.TimeSpan(req.Config.AutoIndexPersistInterval),
 			AutoIndex:                new(req.Config.AutoIndex),
 		},
-		Frame: fr,
+		Frame:   fr,
+		Replica: req.Replica,
 	}, nil
 }
 
@@ -109,6 +110,7 @@ func (WriterRequestTranslator) Forward(
 		Command: int32(req.Command),
 		Config:  cfg,
 		Frame:   fr,
+		Replica: req.Replica,
 	}, nil
 }
 
@@ -154,13 +156,14 @@ func (IteratorRequestTranslator) Backward(
 		return iterator.Request{}, err
 	}
 	return iterator.Request{
-		Command:   iterator.Command(req.Command),
-		Span:      telem.TimeSpan(req.Span),
-		Bounds:    bounds,
-		Stamp:     telem.TimeStamp(req.Stamp),
-		Keys:      channel.KeysFromUint32(req.Keys),
-		ChunkSize: req.ChunkSize,
-		SeqNum:    int(req.SeqNum),
+		Command:     iterator.Command(req.Command),
+		Span:        telem.TimeSpan(req.Span),
+		Bounds:      bounds,
+		Stamp:       telem.TimeStamp(req.Stamp),
+		Keys:        channel.KeysFromUint32(req.Keys),
+		ChunkSize:   req.ChunkSize,
+		SeqNum:      int(req.SeqNum),
+		BucketWidth: telem.TimeSpan(req.BucketWidth),
 	}, nil
 }
 
@@ -174,13 +177,14 @@ func (IteratorRequestTranslator) Forward(
 		return nil, err
 	}
 	return &IteratorRequest{
-		Command:   int32(req.Command),
-		Span:      int64(req.Span),
-		Bounds:    bounds,
-		Stamp:     int64(req.Stamp),
-		Keys:      req.Keys.Uint32(),
-		ChunkSize: req.ChunkSize,
-		SeqNum:    int32(req.SeqNum),
+		Command:     int32(req.Command),
+		Span:        int64(req.Span),
+		Bounds:      bounds,
+		Stamp:       int64(req.Stamp),
+		Keys:        req.Keys.Uint32(),
+		ChunkSize:   req.ChunkSize,
+		SeqNum:      int32(req.SeqNum),
+		BucketWidth: int64(req.BucketWidth),
 	}, nil
 }
 
@@ -195,14 +199,19 @@ func (IteratorResponseTranslator) Backward(
 	if err != nil {
 		return iterator.Response{}, err
 	}
+	aggs, err := translateAggregatesForward(res.Aggregates)
+	if err != nil {
+		return iterator.Response{}, err
+	}
 	return iterator.Response{
-		Variant: iterator.ResponseVariant(res.Variant),
-		NodeKey: node.Key(res.NodeKey),
-		Ack:     res.Ack,
-		SeqNum:  int(res.SeqNum),
-		Command: iterator.Command(res.Command),
-		Error:   grpc.DecodeError(ctx, res.Error),
-		Frame:   fr,
+		Variant:    iterator.ResponseVariant(res.Variant),
+		NodeKey:    node.Key(res.NodeKey),
+		Ack:        res.Ack,
+		SeqNum:     int(res.SeqNum),
+		Command:    iterator.Command(res.Command),
+		Error:      grpc.DecodeError(ctx, res.Error),
+		Frame:      fr,
+		Aggregates: aggs,
 	}, nil
 }
 
@@ -215,17 +224,78 @@ func (IteratorResponseTranslator) Forward(
 	if err != nil {
 		return nil, err
 	}
+	aggs, err := translateAggregatesBackward(res.Aggregates)
+	if err != nil {
+		return nil, err
+	}
 	return &IteratorResponse{
-		Variant: int32(res.Variant),
-		NodeKey: int32(res.NodeKey),
-		Ack:     res.Ack,
-		SeqNum:  int32(res.SeqNum),
-		Command: int32(res.Command),
-		Error:   grpc.EncodeError(ctx, res.Error, true),
-		Frame:   fr,
+		Variant:    int32(res.Variant),
+		NodeKey:    int32(res.NodeKey),
+		Ack:        res.Ack,
+		SeqNum:     int32(res.SeqNum),
+		Command:    int32(res.Command),
+		Error:      grpc.EncodeError(ctx, res.Error, true),
+		Frame:      fr,
+		Aggregates: aggs,
 	}, nil
 }
 
+func translateAggregatesForward(aggs []*Aggregate) ([]iterator.Aggregate, error) {
+	if len(aggs) == 0 {
+		return nil, nil
+	}
+	out := make([]iterator.Aggregate, len(aggs))
+	for i, agg := range aggs {
+		buckets := make([]iterator.Bucket, len(agg.Buckets))
+		for j, b := range agg.Buckets {
+			tr, err := telempb.TimeRangeFromPB(b.TimeRange)
+			if err != nil {
+				return nil, err
+			}
+			buckets[j] = iterator.Bucket{
+				TimeRange: tr,
+				Count:     b.Count,
+				Min:       b.Min,
+				Max:       b.Max,
+				Mean:      b.Mean,
+				StdDev:    b.StdDev,
+				First:     b.First,
+				Last:      b.Last,
+			}
+		}
+		out[i] = iterator.Aggregate{Key: channel.Key(agg.Key), Buckets: buckets}
+	}
+	return out, nil
+}
+
+func translateAggregatesBackward(aggs []iterator.Aggregate) ([]*Aggregate, error) {
+	if len(aggs) == 0 {
+		return nil, nil
+	}
+	out := make([]*Aggregate, len(aggs))
+	for i, agg := range aggs {
+		buckets := make([]*Bucket, len(agg.Buckets))
+		for j, b := range agg.Buckets {
+			tr, err := telempb.TimeRangeToPB(b.TimeRange)
+			if err != nil {
+				return nil, err
+			}
+			buckets[j] = &Bucket{
+				TimeRange: tr,
+				Count:     b.Count,
+				Min:       b.Min,
+				Max:       b.Max,
+				Mean:      b.Mean,
+				StdDev:    b.StdDev,
+				First:     b.First,
+				Last:      b.Last,
+			}
+		}
+		out[i] = &Aggregate{Key: uint32(agg.Key), Buckets: buckets}
+	}
+	return out, nil
+}
+
 type RelayRequestTranslator struct{}
 
 func (w RelayRequestTranslator) Backward(
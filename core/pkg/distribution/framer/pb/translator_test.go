@@ -91,6 +91,20 @@ var _ = Describe("Translator", func() {
 			Expect(result.Config.EnableAutoCommit).ToNot(BeNil())
 			Expect(result.Config.AutoIndex).ToNot(BeNil())
 			Expect(*result.Config.AutoIndex).To(BeFalse())
+			Expect(result.Replica).To(BeFalse())
+		})
+
+		It("Should preserve the replica flag", func(ctx SpecContext) {
+			original := writer.Request{
+				Command: writer.CommandOpen,
+				Config: writer.Config{
+					ControlSubject: control.Subject{Key: "k"},
+					Keys:           channel.Keys{1},
+				},
+				Replica: true,
+			}
+			pb := MustSucceed(t.Forward(ctx, original))
+			Expect(MustSucceed(t.Backward(ctx, pb)).Replica).To(BeTrue())
 		})
 	})
 
@@ -129,13 +143,14 @@ var _ = Describe("Translator", func() {
 
 		It("Should round-trip an iterator request", func(ctx SpecContext) {
 			original := iterator.Request{
-				Command:   iterator.CommandNext,
-				Span:      telem.TimeSpan(5000),
-				Bounds:    telem.TimeRange{Start: 100, End: 200},
-				Stamp:     telem.TimeStamp(150),
-				Keys:      channel.Keys{10, 20},
-				ChunkSize: 1024,
-				SeqNum:    7,
+				Command:     iterator.CommandNext,
+				Span:        telem.TimeSpan(5000),
+				Bounds:      telem.TimeRange{Start: 100, End: 200},
+				Stamp:       telem.TimeStamp(150),
+				Keys:        channel.Keys{10, 20},
+				ChunkSize:   1024,
+				SeqNum:      7,
+				BucketWidth: telem.Second,
 			}
 			pb := MustSucceed(t.Forward(ctx, original))
 			result := MustSucceed(t.Backward(ctx, pb))
@@ -146,6 +161,7 @@ var _ = Describe("Translator", func() {
 			Expect(result.Keys).To(Equal(original.Keys))
 			Expect(result.ChunkSize).To(Equal(original.ChunkSize))
 			Expect(result.SeqNum).To(Equal(original.SeqNum))
+			Expect(result.BucketWidth).To(Equal(original.BucketWidth))
 		})
 
 		It("Should handle zero-value request", func(ctx SpecContext) {
@@ -179,6 +195,28 @@ var _ = Describe("Translator", func() {
 			Expect(result.Frame.Count()).To(Equal(2))
 		})
 
+		It("Should round-trip aggregates", func(ctx SpecContext) {
+			original := iterator.Response{
+				Variant: iterator.ResponseVariantData,
+				Aggregates: []iterator.Aggregate{{
+					Key: 5,
+					Buckets: []iterator.Bucket{{
+						TimeRange: telem.TimeRange{Start: 0, End: 10},
+						Count:     3,
+						Min:       -1,
+						Max:       4,
+						Mean:      1.5,
+						StdDev:    2,
+						First:     -1,
+						Last:      4,
+					}},
+				}},
+			}
+			pb := MustSucceed(t.Forward(ctx, original))
+			result := MustSucceed(t.Backward(ctx, pb))
+			Expect(result.Aggregates).To(Equal(original.Aggregates))
+		})
+
 		It("Should handle zero-value response", func(ctx SpecContext) {
 			original := iterator.Response{}
 			pb := MustSucceed(t.Forward(ctx, original))
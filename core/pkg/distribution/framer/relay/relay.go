@@ -13,7 +13,9 @@
 package relay
 
 import (
+	"context"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/synnaxlabs/alamos"
@@ -21,8 +23,10 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/distribution/node"
 	"github.com/synnaxlabs/synnax/pkg/storage/ts"
 	"github.com/synnaxlabs/x/address"
+	"github.com/synnaxlabs/x/change"
 	"github.com/synnaxlabs/x/config"
 	"github.com/synnaxlabs/x/confluence"
+	"github.com/synnaxlabs/x/gorp"
 	"github.com/synnaxlabs/x/observe"
 	"github.com/synnaxlabs/x/override"
 	"github.com/synnaxlabs/x/signal"
@@ -128,7 +132,23 @@ type Relay struct {
 	demands  confluence.Inlet[demand]
 	shutdown io.Closer
 	delta    *confluence.DynamicDeltaMultiplier[Response]
-	cfg      Config
+	// writes is the stream of frames waiting to be delivered to streamers.
+	writes *confluence.Stream[Response]
+	cfg    Config
+	// disconnectLeases stops the relay from observing channel lease transfers.
+	disconnectLeases observe.Disconnect
+	// mu guards closed, preventing lease transfers from sending demands after the
+	// relay has been closed.
+	mu struct {
+		sync.RWMutex
+		closed bool
+	}
+	// refreshes tracks the lease refreshes that are sending demands, so that Close can
+	// wait for them to finish before closing the demand stream.
+	refreshes sync.WaitGroup
+	// closing is closed when the relay starts closing, unblocking any lease refreshes
+	// waiting on a full demand stream.
+	closing chan struct{}
 }
 
 func Open(configs ...Config) (*Relay, error) {
@@ -137,7 +157,7 @@ func Open(configs ...Config) (*Relay, error) {
 		return nil, err
 	}
 
-	r := &Relay{cfg: cfg, ins: cfg.Instrumentation}
+	r := &Relay{cfg: cfg, ins: cfg.Instrumentation, closing: make(chan struct{})}
 
 	tpr := newTapper(cfg)
 	demands := confluence.NewStream[demand](cfg.DemandBufferSize)
@@ -150,11 +170,11 @@ func Open(configs ...Config) (*Relay, error) {
 		cfg.SlowConsumerTimeout,
 		cfg.Instrumentation,
 	)
-	writes := confluence.NewStream[Response](cfg.ResponseBufferSize)
-	writes.SetInletAddress("delta")
-	writes.SetOutletAddress("taps")
-	r.delta.InFrom(writes)
-	tpr.OutTo(writes)
+	r.writes = confluence.NewStream[Response](cfg.ResponseBufferSize)
+	r.writes.SetInletAddress("delta")
+	r.writes.SetOutletAddress("taps")
+	r.delta.InFrom(r.writes)
+	tpr.OutTo(r.writes)
 
 	sCtx, cancel := signal.Isolated(signal.WithInstrumentation(cfg.Instrumentation))
 	r.shutdown = signal.NewGracefulShutdown(sCtx, cancel)
@@ -174,11 +194,52 @@ func Open(configs ...Config) (*Relay, error) {
 	)
 
 	startServer(cfg, r.NewStreamer)
+	r.disconnectLeases = cfg.Channel.ObserveLeases().OnChange(r.refreshLeases)
 
 	return r, nil
 }
 
+// refreshLeases tells the tapper to re-resolve the leaseholders of the channels it is
+// streaming whenever a channel lease transfer completes.
+func (r *Relay) refreshLeases(
+	_ context.Context,
+	reader gorp.TxReader[channel.Key, channel.Lease],
+) {
+	completed := false
+	for c := range reader {
+		if c.Variant == change.VariantSet && !c.Value.Transferring() {
+			completed = true
+			break
+		}
+	}
+	if !completed {
+		return
+	}
+	r.mu.RLock()
+	if r.mu.closed {
+		r.mu.RUnlock()
+		return
+	}
+	r.refreshes.Add(1)
+	r.mu.RUnlock()
+	defer r.refreshes.Done()
+	select {
+	case r.demands.Inlet() <- demand{refresh: true}:
+	case <-r.closing:
+	}
+}
+
+// Backlog returns the number of frames that have been received by the relay but not
+// yet delivered to streamers.
+func (r *Relay) Backlog() int { return len(r.writes.Outlet()) }
+
 func (r *Relay) Close() error {
+	r.disconnectLeases()
+	close(r.closing)
+	r.mu.Lock()
+	r.mu.closed = true
+	r.mu.Unlock()
+	r.refreshes.Wait()
 	r.demands.Close()
 	err := r.shutdown.Close()
 	return err
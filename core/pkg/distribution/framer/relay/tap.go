@@ -43,6 +43,10 @@ type demand struct {
 	// propagating. nil for demands that need no acknowledgment (deletes and
 	// mid-stream key reconfigurations).
 	ack chan struct{}
+	// refresh, when true, re-resolves the leaseholders of all current demands without
+	// modifying them. It's sent after the lease on a channel is transferred between
+	// nodes, so that taps follow the channel to its new leaseholder.
+	refresh bool
 }
 
 // tap is a tap into a relay, whether another node's distribution relay or the hosts
@@ -104,15 +108,18 @@ func (t *tapper) sink(ctx context.Context, d demand) error {
 // updateDemands modifies the current set of locations that the relay needs to stream
 // channel data from.
 func (t *tapper) updateDemands(d demand) map[node.Key]channel.Keys {
-	if d.Variant == change.VariantDelete {
-		delete(t.demands, d.Key)
-	} else {
-		t.demands[d.Key] = d.Value.Keys
+	if !d.refresh {
+		if d.Variant == change.VariantDelete {
+			delete(t.demands, d.Key)
+		} else {
+			t.demands[d.Key] = d.Value.Keys
+		}
 	}
 	nodeDemands := make(map[node.Key]channel.Keys, len(t.taps))
 	for _, d := range t.demands {
 		for _, k := range d {
-			nodeDemands[k.Lease()] = append(nodeDemands[k.Lease()], k)
+			lh := t.Channel.Leaseholder(k)
+			nodeDemands[lh] = append(nodeDemands[lh], k)
 		}
 	}
 	return nodeDemands
@@ -11,6 +11,7 @@ package framer
 
 import (
 	"context"
+	"time"
 
 	"github.com/synnaxlabs/alamos"
 	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
@@ -25,6 +26,7 @@ import (
 	"github.com/synnaxlabs/x/io"
 	"github.com/synnaxlabs/x/override"
 	"github.com/synnaxlabs/x/service"
+	"github.com/synnaxlabs/x/signal"
 	"github.com/synnaxlabs/x/telem"
 	"github.com/synnaxlabs/x/validate"
 )
@@ -44,6 +46,8 @@ type Service struct {
 	deleter         *deleter.Service
 	cfg             ServiceConfig
 	controlStateKey channel.Key
+	leaseTransfers  chan struct{}
+	leaseFences     chan struct{}
 }
 
 // ServiceConfig is the configuration for the Service.
@@ -65,13 +69,24 @@ type ServiceConfig struct {
 	//
 	// [REQUIRED]
 	TS *ts.DB
+	// LeaseFenceTimeout sets the maximum amount of time that the host will wait for the
+	// writers open on a channel to close before failing the transfer of its lease.
+	//
+	// [OPTIONAL] - Defaults to 30 seconds
+	LeaseFenceTimeout time.Duration
 	// Instrumentation is used for logging, tracing, etc.
 	//
 	// [OPTIONAL]
 	alamos.Instrumentation
 }
 
-var _ config.Config[ServiceConfig] = ServiceConfig{}
+var (
+	_ config.Config[ServiceConfig] = ServiceConfig{}
+	// DefaultServiceConfig is the default configuration for opening the framer Service.
+	// This configuration is not valid on its own and must be overridden with the
+	// required fields. See ServiceConfig for more information.
+	DefaultServiceConfig = ServiceConfig{LeaseFenceTimeout: 30 * time.Second}
+)
 
 // Validate implements config.Config.
 func (c ServiceConfig) Validate() error {
@@ -80,6 +95,7 @@ func (c ServiceConfig) Validate() error {
 	validate.NotNil(v, "ts", c.TS)
 	validate.NotNil(v, "transport", c.Transport)
 	validate.NotNil(v, "host_resolver", c.HostResolver)
+	validate.Positive(v, "lease_fence_timeout", c.LeaseFenceTimeout)
 	return v.Error()
 }
 
@@ -90,6 +106,7 @@ func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
 	c.TS = override.Nil(c.TS, other.TS)
 	c.Transport = override.Nil(c.Transport, other.Transport)
 	c.HostResolver = override.Nil(c.HostResolver, other.HostResolver)
+	c.LeaseFenceTimeout = override.Numeric(c.LeaseFenceTimeout, other.LeaseFenceTimeout)
 	return c
 }
 
@@ -104,7 +121,7 @@ const freeWritePipelineBuffer = 4000
 //
 // The Service must be closed after use.
 func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err error) {
-	cfg, err := config.New(ServiceConfig{}, cfgs...)
+	cfg, err := config.New(DefaultServiceConfig, cfgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -145,9 +162,20 @@ func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err er
 		HostResolver: cfg.HostResolver,
 		TSChannel:    cfg.TS,
 		Transport:    cfg.Transport.Deleter(),
+		Channel:      cfg.Channel,
 	}); !ok(err, nil) {
 		return nil, err
 	}
+	s.leaseTransfers = make(chan struct{}, 1)
+	s.leaseFences = make(chan struct{}, 1)
+	sCtx, cancel := signal.Isolated(signal.WithInstrumentation(cfg.Child("lease")))
+	signal.GoRange(sCtx, s.leaseFences, s.fenceLeases, signal.WithKey("fence"))
+	signal.GoRange(sCtx, s.leaseTransfers, s.receiveLeases, signal.WithKey("receive"))
+	ok(nil, signal.NewHardShutdown(sCtx, cancel))
+	ok(nil, io.NoFailCloserFunc(cfg.Channel.ObserveLeases().OnChange(s.observeLeases)))
+	// Pick up any transfers that were started or fenced while the node was offline.
+	s.notify(s.leaseFences)
+	s.notify(s.leaseTransfers)
 	return s, nil
 }
 
@@ -175,6 +203,14 @@ func (s *Service) NewStreamIterator(
 	return s.iterator.NewStream(ctx, cfg)
 }
 
+// Aggregate computes summary statistics over fixed-width time buckets for the channels
+// in the given config. The statistics for each channel are computed on the node that
+// stores its data. For information on configuration parameters, see the
+// AggregateConfig struct.
+func (s *Service) Aggregate(ctx context.Context, cfg AggregateConfig) ([]Aggregate, error) {
+	return s.iterator.Aggregate(ctx, cfg)
+}
+
 // OpenWriter opens a new writer for writing data to a Synnax cluster. If the returned
 // error is nil, the writer must be closed after use. For information on configuration
 // parameters, see the WriterConfig struct.
@@ -205,6 +241,10 @@ func (s *Service) DeleteTimeRange(
 	return s.deleter.DeleteTimeRange(ctx, keys, tr)
 }
 
+// RelayBacklog returns the number of frames waiting to be delivered to streamers on
+// this node.
+func (s *Service) RelayBacklog() int { return s.relay.Backlog() }
+
 // ConfigureControlUpdateChannel sets the name and key of the channel used to propagate
 // control transfers between opened writers.
 func (s *Service) ConfigureControlUpdateChannel(
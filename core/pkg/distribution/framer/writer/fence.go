@@ -0,0 +1,173 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package writer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/x/confluence"
+	"github.com/synnaxlabs/x/confluence/plumber"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/set"
+	"github.com/synnaxlabs/x/signal"
+)
+
+// fences tracks the storage layer writers open on the host so that they can be closed
+// before the leases on their channels are transferred to another node. Without this,
+// writes that are in flight when a transfer starts could land after the channel's data
+// has been copied to the new leaseholder, and would then be lost when the data is
+// removed from the host.
+type fences struct {
+	mu      sync.Mutex
+	fenced  set.Set[channel.Key]
+	writers map[*fencedWriter]struct{}
+}
+
+// fencedWriter is a storage layer writer that can be closed by a fence.
+type fencedWriter struct {
+	fences *fences
+	keys   channel.Keys
+	// stopped is closed once the writer has released its storage layer resources.
+	stopped chan struct{}
+	mu      struct {
+		sync.Mutex
+		// cancel stops the writer, discarding any data that has not been committed.
+		// Nil until the writer has started.
+		cancel context.CancelFunc
+		// fenced is true if the writer was stopped by a fence.
+		fenced bool
+	}
+}
+
+func newFences() *fences {
+	return &fences{
+		fenced:  make(set.Set[channel.Key]),
+		writers: make(map[*fencedWriter]struct{}),
+	}
+}
+
+// open registers a storage layer writer on the given channels. It must be called
+// before the storage layer writer is opened, and release must be called once the writer
+// has released its storage layer resources. Returns ErrLeaseFenced if any of the
+// channels are fenced.
+func (f *fences) open(keys channel.Keys) (*fencedWriter, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, k := range keys {
+		if f.fenced.Contains(k) {
+			return nil, errors.Wrapf(channel.ErrLeaseFenced, "cannot write to channels %v", keys)
+		}
+	}
+	w := &fencedWriter{fences: f, keys: keys, stopped: make(chan struct{})}
+	f.writers[w] = struct{}{}
+	return w, nil
+}
+
+// start binds the function that stops the writer. It must be called after the writer
+// has started flowing, as a writer whose context is cancelled before it starts never
+// releases its storage layer resources. If the writer was fenced before it started, it
+// is stopped immediately.
+func (w *fencedWriter) start(cancel context.CancelFunc) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.mu.cancel = cancel
+	if w.mu.fenced {
+		cancel()
+	}
+}
+
+func (w *fencedWriter) fence() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.mu.fenced = true
+	if w.mu.cancel != nil {
+		w.mu.cancel()
+	}
+}
+
+// release removes the writer from the registry, unblocking any fence waiting on it.
+func (w *fencedWriter) release() {
+	w.fences.mu.Lock()
+	delete(w.fences.writers, w)
+	w.fences.mu.Unlock()
+	close(w.stopped)
+}
+
+// err returns the error that a writer should exit with, replacing the error caused by
+// stopping the writer if it was stopped by a fence.
+func (w *fencedWriter) err(err error) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.mu.fenced {
+		return errors.Wrapf(channel.ErrLeaseFenced, "cannot write to channels %v", w.keys)
+	}
+	return err
+}
+
+// Fence stops all storage layer writers open on the host for any of the channels with
+// the given keys, and prevents new ones from being opened until Unfence is called.
+// Data that the stopped writers have not committed is discarded, and their clients
+// receive ErrLeaseFenced. Fence blocks until every stopped writer has released its
+// storage layer resources, so all data committed to the channels is durable and no
+// more can be written once it returns.
+func (s *Service) Fence(ctx context.Context, keys ...channel.Key) error {
+	f := s.fences
+	f.mu.Lock()
+	f.fenced.Add(keys...)
+	var stop []*fencedWriter
+	for w := range f.writers {
+		if lo.Some(w.keys, keys) {
+			stop = append(stop, w)
+		}
+	}
+	f.mu.Unlock()
+	for _, w := range stop {
+		w.fence()
+	}
+	for _, w := range stop {
+		select {
+		case <-w.stopped:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Unfence allows writers to be opened on the channels with the given keys after a
+// call to Fence.
+func (s *Service) Unfence(keys ...channel.Key) {
+	s.fences.mu.Lock()
+	defer s.fences.mu.Unlock()
+	s.fences.fenced.Remove(keys...)
+}
+
+// fencedSegment is a storage layer writer segment that is stopped when the leases on
+// any of its channels are fenced for transfer.
+type fencedSegment struct {
+	*plumber.Segment[Request, Response]
+	writer *fencedWriter
+}
+
+// Flow implements confluence.Flow, running the segment in a context that is cancelled
+// if any of its channels are fenced.
+func (s *fencedSegment) Flow(ctx signal.Context, opts ...confluence.Option) {
+	sCtx, cancel := signal.WithCancel(ctx)
+	s.Segment.Flow(sCtx, opts...)
+	s.writer.start(cancel)
+	ctx.Go(func(context.Context) error {
+		err := sCtx.Wait()
+		s.writer.release()
+		return s.writer.err(err)
+	}, confluence.NewOptions(opts).Signal...)
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package writer_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/writer"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	tmock "github.com/synnaxlabs/synnax/pkg/distribution/transport/mock"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Fence", Ordered, func() {
+	var (
+		mockCluster *mock.Cluster
+		svc         *writer.Service
+		keys        channel.Keys
+	)
+	BeforeAll(func(ctx SpecContext) {
+		mockCluster = mock.ProvisionCluster(context.Background(), 1)
+		dist := mockCluster.Nodes[1]
+		idx := channel.Channel{
+			Name:     channel.NewRandomName(),
+			IsIndex:  true,
+			DataType: telem.TimeStampT,
+		}
+		Expect(dist.Channel.Create(ctx, &idx)).To(Succeed())
+		data := channel.Channel{
+			Name:       channel.NewRandomName(),
+			DataType:   telem.Float64T,
+			LocalIndex: idx.LocalKey,
+		}
+		Expect(dist.Channel.Create(ctx, &data)).To(Succeed())
+		keys = channel.Keys{idx.Key(), data.Key()}
+		svc = MustSucceed(writer.NewService(writer.ServiceConfig{
+			TS:           dist.Storage.TS,
+			Channel:      dist.Channel,
+			HostResolver: dist.Cluster,
+			Transport:    tmock.NewWriterNetwork().New("fence"),
+		}))
+	})
+	AfterAll(func() { Expect(mockCluster.Close()).To(Succeed()) })
+
+	write := func(w *writer.Writer, start telem.TimeStamp) error {
+		_, err := w.Write(frame.NewMulti(keys, []telem.Series{
+			telem.NewSeriesV(start),
+			telem.NewSeriesV[float64](1),
+		}))
+		return err
+	}
+
+	It("Should close writers open on fenced channels", func(ctx SpecContext) {
+		w := MustSucceed(svc.Open(ctx, writer.Config{
+			Keys:  keys,
+			Start: 10 * telem.SecondTS,
+			Sync:  new(true),
+		}))
+		Expect(write(w, 10*telem.SecondTS)).To(Succeed())
+		MustSucceed(w.Commit())
+		Expect(svc.Fence(ctx, keys[1])).To(Succeed())
+		Expect(write(w, 11*telem.SecondTS)).To(MatchError(channel.ErrLeaseFenced))
+		Expect(w.Close()).To(MatchError(channel.ErrLeaseFenced))
+		svc.Unfence(keys[1])
+	})
+
+	It("Should prevent writers from opening on fenced channels until they are unfenced", func(ctx SpecContext) {
+		Expect(svc.Fence(ctx, keys...)).To(Succeed())
+		_, err := svc.Open(ctx, writer.Config{
+			Keys:  keys,
+			Start: 20 * telem.SecondTS,
+			Sync:  new(true),
+		})
+		Expect(err).To(MatchError(channel.ErrLeaseFenced))
+		svc.Unfence(keys...)
+		w := MustSucceed(svc.Open(ctx, writer.Config{
+			Keys:  keys,
+			Start: 20 * telem.SecondTS,
+			Sync:  new(true),
+		}))
+		Expect(write(w, 20*telem.SecondTS)).To(Succeed())
+		MustSucceed(w.Commit())
+		Expect(w.Close()).To(Succeed())
+	})
+
+	It("Should not close writers on other channels", func(ctx SpecContext) {
+		w := MustSucceed(svc.Open(ctx, writer.Config{
+			Keys:  keys,
+			Start: 30 * telem.SecondTS,
+			Sync:  new(true),
+		}))
+		Expect(svc.Fence(ctx, keys[1]+1)).To(Succeed())
+		Expect(write(w, 30*telem.SecondTS)).To(Succeed())
+		MustSucceed(w.Commit())
+		Expect(w.Close()).To(Succeed())
+		svc.Unfence(keys[1] + 1)
+	})
+})
@@ -27,14 +27,24 @@ var (
 
 // newGateway opens a new StreamWriter that writes to the store on the gateway node.
 func (s *Service) newGateway(ctx context.Context, cfg Config) (StreamWriter, error) {
+	fw, err := s.fences.open(cfg.Keys)
+	if err != nil {
+		return nil, err
+	}
 	w, err := s.cfg.TS.NewStreamWriter(ctx, cfg.toStorage())
 	if err != nil {
+		fw.release()
 		return nil, err
 	}
 	pipe := plumber.New()
 	plumber.SetSegment(pipe, gatewayTSWriterAddr, w)
 	reqT := &confluence.LinearTransform[Request, ts.WriterRequest]{}
-	reqT.Transform = newRequestTranslator()
+	reqT.Transform = newRequestTranslator(
+		s.cfg.HostResolver.HostKey(),
+		cfg.Keys,
+		s.cfg.Channel,
+		false,
+	)
 	resT := &confluence.LinearTransform[ts.WriterResponse, Response]{}
 	resT.Transform = newResponseTranslator(s.cfg.HostResolver.HostKey())
 	plumber.SetSegment(pipe, gatewayRequestsAddr, reqT)
@@ -44,5 +54,5 @@ func (s *Service) newGateway(ctx context.Context, cfg Config) (StreamWriter, err
 	seg := &plumber.Segment[Request, Response]{Pipeline: pipe}
 	lo.Must0(seg.RouteInletTo(gatewayRequestsAddr))
 	lo.Must0(seg.RouteOutletFrom(gatewayResponsesAddr))
-	return seg, nil
+	return &fencedSegment{Segment: seg, writer: fw}, nil
 }
@@ -15,6 +15,7 @@ import (
 
 	"github.com/synnaxlabs/freighter"
 	"github.com/synnaxlabs/freighter/freightfluence"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
 	"github.com/synnaxlabs/synnax/pkg/distribution/node"
 	"github.com/synnaxlabs/synnax/pkg/distribution/proxy"
 	"github.com/synnaxlabs/x/address"
@@ -25,13 +26,14 @@ import (
 func (s *Service) openManyPeers(
 	ctx context.Context,
 	cfg Config,
+	leases channel.Leases,
 	targets map[node.Key][]keyAuthority,
 ) (confluence.Sink[Request], []*freightfluence.Receiver[Response], []address.Address, error) {
 	var (
 		receivers         = make([]*freightfluence.Receiver[Response], 0, len(targets))
 		addrMap           = make(proxy.AddressMap)
 		senders           = make(map[address.Address]freighter.StreamSenderCloser[Request])
-		sender            = newRequestSwitchSender(addrMap, senders)
+		sender            = newRequestSwitchSender(addrMap, leases, senders)
 		receiverAddresses = make([]address.Address, 0, len(targets))
 	)
 
@@ -0,0 +1,214 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package writer
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/freighter"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/synnax/pkg/storage/ts"
+	"github.com/synnaxlabs/x/confluence"
+	"github.com/synnaxlabs/x/control"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/signal"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// replica is a stream to a node that stores a replica of some of the channels being
+// written to.
+type replica struct {
+	stream ClientStream
+	keys   channel.Keys
+	node   node.Key
+	failed atomic.Bool
+}
+
+// replicator copies the writes and commits made to replicated channels to the nodes
+// storing their replicas. Replication is best-effort: a replica that fails to accept
+// a request is dropped from the writer and logged, and never causes the write to fail.
+type replicator struct {
+	alamos.Instrumentation
+	confluence.UnarySink[Request]
+	replicas []*replica
+}
+
+// Flow implements confluence.Flow.
+func (r *replicator) Flow(ctx signal.Context, opts ...confluence.Option) {
+	o := confluence.NewOptions(opts)
+	for _, rep := range r.replicas {
+		ctx.Go(func(context.Context) error {
+			r.drain(rep)
+			return nil
+		}, o.Signal...)
+	}
+	ctx.Go(r.send, o.Signal...)
+}
+
+func (r *replicator) send(ctx context.Context) error {
+	defer func() {
+		for _, rep := range r.replicas {
+			if err := rep.stream.CloseSend(); err != nil {
+				r.L.Warn("failed to close replica stream", zap.Error(err))
+			}
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case req, ok := <-r.In.Outlet():
+			if !ok {
+				return nil
+			}
+			r.forward(req)
+		}
+	}
+}
+
+func (r *replicator) forward(req Request) {
+	if req.Command != CommandWrite && req.Command != CommandCommit {
+		return
+	}
+	for _, rep := range r.replicas {
+		if rep.failed.Load() {
+			continue
+		}
+		out := req
+		if req.Command == CommandWrite {
+			if out.Frame = req.Frame.KeepKeys(rep.keys); out.Frame.Empty() {
+				continue
+			}
+		}
+		if err := rep.stream.Send(out); err != nil {
+			r.fail(rep, err)
+		}
+	}
+}
+
+// drain receives the responses from the given replica until its stream closes,
+// dropping the replica if it reports an error.
+func (r *replicator) drain(rep *replica) {
+	for {
+		res, err := rep.stream.Receive()
+		if err != nil {
+			if !errors.Is(err, freighter.EOF) && !errors.Is(err, context.Canceled) {
+				r.fail(rep, err)
+			}
+			return
+		}
+		if res.Err != nil {
+			r.fail(rep, res.Err)
+		}
+	}
+}
+
+func (r *replicator) fail(rep *replica, err error) {
+	if rep.failed.Swap(true) {
+		return
+	}
+	r.L.Error(
+		"failed to replicate writes, channels will be missing data on replica",
+		zap.Uint16("node", uint16(rep.node)),
+		zap.Stringers("channels", rep.keys),
+		zap.Error(err),
+	)
+}
+
+// openReplicas opens streams to the nodes storing replicas of the given channels.
+// Returns nil if none of the channels are replicated. Replicas that can't be reached
+// are logged and skipped.
+func (s *Service) openReplicas(
+	ctx context.Context,
+	cfg Config,
+	channels []channel.Channel,
+) ([]*replica, error) {
+	if !cfg.Mode.Persist() {
+		return nil, nil
+	}
+	var (
+		targets = make(map[node.Key]channel.Keys)
+		order   []node.Key
+	)
+	for _, ch := range channels {
+		if !ch.Replicated() {
+			continue
+		}
+		if *cfg.AutoIndex {
+			return nil, errors.Wrapf(
+				validate.ErrValidation,
+				"cannot auto-index replicated channel %v",
+				ch,
+			)
+		}
+		for _, n := range ch.Replicas {
+			if _, ok := targets[n]; !ok {
+				order = append(order, n)
+			}
+			targets[n] = append(targets[n], ch.Key())
+		}
+	}
+	replicas := make([]*replica, 0, len(order))
+	for _, n := range order {
+		keys := targets[n]
+		rCfg := cfg
+		rCfg.Keys = keys
+		rCfg.Authorities = []control.Authority{control.AuthorityAbsolute}
+		rCfg.Mode = ts.WriterModePersistOnly
+		rCfg.ErrOnUnauthorized = new(false)
+		rCfg.Sync = new(false)
+		stream, err := s.openReplicaClient(ctx, n, rCfg)
+		if err != nil {
+			s.cfg.L.Error(
+				"failed to open replica, channels will be missing data on replica",
+				zap.Uint16("node", uint16(n)),
+				zap.Stringers("channels", keys),
+				zap.Error(err),
+			)
+			continue
+		}
+		replicas = append(replicas, &replica{stream: stream, keys: keys, node: n})
+	}
+	return replicas, nil
+}
+
+// openReplicaClient opens a writer on the replicas stored by the given node. Writes to
+// replicas stored on the host are routed through the transport like any other so that
+// they go through the same server side checks.
+func (s *Service) openReplicaClient(
+	ctx context.Context,
+	target node.Key,
+	cfg Config,
+) (ClientStream, error) {
+	addr, err := s.cfg.HostResolver.Resolve(target)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.cfg.Transport.Client().Stream(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Send(Request{Config: cfg, Replica: true}); err != nil {
+		return nil, errors.Combine(err, client.CloseSend())
+	}
+	return client, nil
+}
+
+func closeReplicas(replicas []*replica) error {
+	var err error
+	for _, rep := range replicas {
+		err = errors.Combine(err, rep.stream.CloseSend())
+	}
+	return err
+}
@@ -20,10 +20,13 @@ import (
 	"github.com/synnaxlabs/x/signal"
 )
 
-type server struct{ ServiceConfig }
+type server struct {
+	ServiceConfig
+	fences *fences
+}
 
-func startServer(cfg ServiceConfig) *server {
-	s := &server{ServiceConfig: cfg}
+func startServer(cfg ServiceConfig, fences *fences) *server {
+	s := &server{ServiceConfig: cfg, fences: fences}
 	cfg.Transport.Server().BindHandler(s.handle)
 	return s
 }
@@ -41,10 +44,21 @@ func (sf *server) handle(ctx context.Context, server ServerStream) error {
 	// Senders and receivers must be set up to distribution requests and responses
 	// to their storage counterparts.
 	receiver := &freightfluence.TransformReceiver[ts.WriterRequest, Request]{Receiver: server}
-	receiver.Transform = newRequestTranslator()
+	receiver.Transform = newRequestTranslator(
+		sf.HostResolver.HostKey(),
+		req.Config.Keys,
+		sf.Channel,
+		req.Replica,
+	)
 	sender := &freightfluence.TransformSender[ts.WriterResponse, Response]{Sender: freighter.SenderNopCloser[Response]{StreamSender: server}}
 	sender.Transform = newResponseTranslator(sf.HostResolver.HostKey())
 
+	fw, err := sf.fences.open(req.Config.Keys)
+	if err != nil {
+		return err
+	}
+	defer fw.release()
+
 	w, err := sf.TS.NewStreamWriter(ctx, req.Config.toStorage())
 	if err != nil {
 		return err
@@ -57,7 +71,7 @@ func (sf *server) handle(ctx context.Context, server ServerStream) error {
 	plumber.MustConnect[ts.WriterRequest](pipe, "receiver", "toStorage", 1)
 	plumber.MustConnect[ts.WriterResponse](pipe, "toStorage", "sender", 1)
 	pipe.Flow(sCtx, confluence.CloseOutputInletsOnExit(), confluence.RecoverWithErrOnPanic())
+	fw.start(cancel)
 
-	err = sCtx.Wait()
-	return err
+	return fw.err(sCtx.Wait())
 }
@@ -275,6 +275,7 @@ func (cfg ServiceConfig) Override(other ServiceConfig) ServiceConfig {
 type Service struct {
 	server              *server
 	freeWriteAlignments *freeWriteAlignments
+	fences              *fences
 	cfg                 ServiceConfig
 }
 
@@ -285,9 +286,11 @@ func NewService(cfgs ...ServiceConfig) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	f := newFences()
 	return &Service{
 		cfg:    cfg,
-		server: startServer(cfg),
+		server: startServer(cfg, f),
+		fences: f,
 		freeWriteAlignments: &freeWriteAlignments{
 			alignments: make(map[channel.Key]*atomic.Uint32),
 		},
@@ -302,6 +305,7 @@ const (
 	peerGatewaySwitchAddr  = address.Address("peer_gateway_free_switch")
 	validatorAddr          = address.Address("validator")
 	validatorResponsesAddr = address.Address("validator_responses")
+	replicatorAddr         = address.Address("replicator")
 )
 
 // Open a new writer using the given configuration. The provided context is used to
@@ -349,13 +353,27 @@ func (s *Service) NewStream(ctx context.Context, cfgs ...Config) (StreamWriter,
 		return nil, err
 	}
 
+	if s.cfg.Channel.LeaseFenced(cfg.Keys...) {
+		return nil, errors.Wrapf(channel.ErrLeaseFenced, "cannot open writer on channels %v", cfg.Keys)
+	}
+
+	replicas, err := s.openReplicas(ctx, cfg, channels)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
-		hostKey           = s.cfg.HostResolver.HostKey()
-		batch             = proxy.BatchFactory[keyAuthority]{Host: hostKey}.Batch(cfg.keyAuthorities())
+		hostKey = s.cfg.HostResolver.HostKey()
+		leases  = s.cfg.Channel.Leases(cfg.Keys...)
+		batch   = proxy.BatchFactory[keyAuthority]{Host: hostKey}.BatchFunc(
+			cfg.keyAuthorities(),
+			func(k keyAuthority) node.Key { return leases.Leaseholder(k.key) },
+		)
 		pipe              = plumber.New()
 		hasPeer           = len(batch.Peers) > 0
 		hasGateway        = len(batch.Gateway) > 0
 		hasFree           = len(batch.Free) > 0
+		hasReplicas       = len(replicas) > 0
 		receiverAddresses []address.Address
 		routeValidatorTo  address.Address
 	)
@@ -370,20 +388,29 @@ func (s *Service) NewStream(ctx context.Context, cfgs ...Config) (StreamWriter,
 	plumber.SetSegment(
 		pipe,
 		synchronizerAddr,
-		newSynchronizer(len(cfg.Keys.UniqueLeaseholders()), s.cfg.Instrumentation),
+		newSynchronizer(len(leases.UniqueLeaseholders(cfg.Keys)), s.cfg.Instrumentation),
 	)
 
-	switchTargets := make([]address.Address, 0, 3)
+	switchTargets := make([]address.Address, 0, 4)
+	if hasReplicas {
+		switchTargets = append(switchTargets, replicatorAddr)
+		plumber.SetSink[Request](pipe, replicatorAddr, &replicator{
+			Instrumentation: s.cfg.Instrumentation,
+			replicas:        replicas,
+		})
+	}
+
 	if hasPeer {
 		routeValidatorTo = peerSenderAddr
 		switchTargets = append(switchTargets, peerSenderAddr)
 		sender, receivers, _receiverAddresses, err := s.openManyPeers(
 			ctx,
 			cfg,
+			leases,
 			batch.Peers,
 		)
 		if err != nil {
-			return nil, err
+			return nil, errors.Combine(err, closeReplicas(replicas))
 		}
 		plumber.SetSink(pipe, peerSenderAddr, sender)
 		receiverAddresses = _receiverAddresses
@@ -397,7 +424,7 @@ func (s *Service) NewStream(ctx context.Context, cfgs ...Config) (StreamWriter,
 		switchTargets = append(switchTargets, gatewayWriterAddr)
 		w, err := s.newGateway(ctx, cfg.setKeyAuthorities(batch.Gateway))
 		if err != nil {
-			return nil, err
+			return nil, errors.Combine(err, closeReplicas(replicas))
 		}
 		plumber.SetSegment(pipe, gatewayWriterAddr, w)
 		receiverAddresses = append(receiverAddresses, gatewayWriterAddr)
@@ -416,7 +443,14 @@ func (s *Service) NewStream(ctx context.Context, cfgs ...Config) (StreamWriter,
 		plumber.SetSegment(
 			pipe,
 			peerGatewaySwitchAddr,
-			newPeerGatewayFreeSwitch(hostKey, hasPeer, hasGateway, hasFree),
+			newPeerGatewayFreeSwitch(
+				hostKey,
+				leases,
+				hasPeer,
+				hasGateway,
+				hasFree,
+				hasReplicas,
+			),
 		)
 		plumber.MultiRouter[Request]{
 			SourceTargets: []address.Address{peerGatewaySwitchAddr},
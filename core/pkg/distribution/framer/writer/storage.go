@@ -12,12 +12,32 @@ package writer
 import (
 	"context"
 
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
 	"github.com/synnaxlabs/synnax/pkg/distribution/node"
 	"github.com/synnaxlabs/synnax/pkg/storage/ts"
+	"github.com/synnaxlabs/x/errors"
 )
 
-func newRequestTranslator() func(ctx context.Context, in Request) (ts.WriterRequest, bool, error) {
+// newRequestTranslator returns a function that translates distribution layer writer
+// requests for the channels with the given keys into storage layer requests. Writes and
+// commits are rejected while the lease on any of the channels is being transferred to
+// another node, and once the lease has been taken by that node. Writes to replicas are
+// never rejected, as replicated channels can't have their leases transferred.
+func newRequestTranslator(
+	host node.Key,
+	keys channel.Keys,
+	channels *channel.Service,
+	replica bool,
+) func(ctx context.Context, in Request) (ts.WriterRequest, bool, error) {
 	return func(ctx context.Context, in Request) (ts.WriterRequest, bool, error) {
+		if !replica && (in.Command == CommandWrite || in.Command == CommandCommit) &&
+			(channels.LeaseFenced(keys...) || !channels.HoldsLeases(host, keys...)) {
+			return ts.WriterRequest{}, false, errors.Wrapf(
+				channel.ErrLeaseFenced,
+				"cannot write to channels %v",
+				keys,
+			)
+		}
 		return ts.WriterRequest{
 			Command: ts.WriterCommand(in.Command),
 			Frame:   in.Frame.ToStorage(),
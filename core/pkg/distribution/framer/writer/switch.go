@@ -14,6 +14,7 @@ import (
 
 	"github.com/synnaxlabs/freighter"
 	"github.com/synnaxlabs/freighter/freightfluence"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
 	"github.com/synnaxlabs/synnax/pkg/distribution/node"
 	"github.com/synnaxlabs/synnax/pkg/distribution/proxy"
 	"github.com/synnaxlabs/x/address"
@@ -24,14 +25,16 @@ import (
 type peerSwitchSender struct {
 	freightfluence.BatchSwitchSender[Request, Request]
 	addresses proxy.AddressMap
+	leases    channel.Leases
 	logger    *zap.Logger
 }
 
 func newRequestSwitchSender(
 	addresses proxy.AddressMap,
+	leases channel.Leases,
 	senders map[address.Address]freighter.StreamSenderCloser[Request],
 ) confluence.Sink[Request] {
-	rs := &peerSwitchSender{addresses: addresses}
+	rs := &peerSwitchSender{addresses: addresses, leases: leases}
 	rs.Senders = freightfluence.MapTargetedSender[Request](senders)
 	rs.Switch = rs._switch
 	return rs
@@ -43,7 +46,7 @@ func (rs *peerSwitchSender) _switch(
 	oReqs map[address.Address]Request,
 ) error {
 	if r.Command == CommandWrite {
-		for nodeKey, frame := range r.Frame.SplitByLeaseholder() {
+		for nodeKey, frame := range r.Frame.SplitByLeaseholder(rs.leases) {
 			addr, ok := rs.addresses[nodeKey]
 			if !ok {
 				rs.logger.DPanic("missing address for node", zap.Uint32("node", uint32(nodeKey)))
@@ -62,30 +65,35 @@ func (rs *peerSwitchSender) _switch(
 
 type peerGatewayFreeSwitch struct {
 	confluence.BatchSwitch[Request, Request]
-	host node.Key
-	has  struct {
-		peer    bool
-		gateway bool
-		free    bool
+	host   node.Key
+	leases channel.Leases
+	has    struct {
+		peer     bool
+		gateway  bool
+		free     bool
+		replicas bool
 	}
 }
 
 func newPeerGatewayFreeSwitch(
 	host node.Key,
+	leases channel.Leases,
 	hasPeer bool,
 	hasGateway bool,
 	hasFree bool,
+	hasReplicas bool,
 ) *peerGatewayFreeSwitch {
-	rl := &peerGatewayFreeSwitch{host: host}
+	rl := &peerGatewayFreeSwitch{host: host, leases: leases}
 	rl.Switch = rl._switch
 	rl.has.peer = hasPeer
 	rl.has.gateway = hasGateway
 	rl.has.free = hasFree
+	rl.has.replicas = hasReplicas
 	return rl
 }
 
 func (rl *peerGatewayFreeSwitch) _switch(ctx context.Context, r Request, oReqs map[address.Address]Request) error {
-	local, remote, free := r.Frame.SplitByHost(rl.host)
+	local, remote, free := r.Frame.SplitByHost(rl.host, rl.leases)
 	if rl.has.peer {
 		pr := r
 		pr.Frame = remote
@@ -101,5 +109,8 @@ func (rl *peerGatewayFreeSwitch) _switch(ctx context.Context, r Request, oReqs m
 		fr.Frame = free
 		oReqs[freeWriterAddr] = fr
 	}
+	if rl.has.replicas {
+		oReqs[replicatorAddr] = r
+	}
 	return nil
 }
@@ -46,6 +46,10 @@ type Request struct {
 	SeqNum int `json:"seq_num" msgpack:"seq_num"`
 	// Command is the command to execute on the writer.
 	Command Command `json:"command" msgpack:"command"`
+	// Replica is set on the open request of a stream that copies writes to a node
+	// storing a replica of the channels instead of their leaseholder. Only used
+	// internally.
+	Replica bool `json:"replica" msgpack:"replica"`
 }
 
 // Response represents a response to a streaming call to a Writer.
@@ -13,6 +13,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/synnaxlabs/alamos"
@@ -107,6 +108,11 @@ type LayerConfig struct {
 	//
 	// [OPTIONAL] - Defaults to []
 	PeerAddresses []address.Address
+	// LeaseFenceTimeout sets the maximum amount of time that a node will wait for the
+	// writers open on a channel to close before failing the transfer of its lease.
+	//
+	// [OPTIONAL] - Defaults to 30 seconds
+	LeaseFenceTimeout time.Duration
 }
 
 var (
@@ -136,6 +142,7 @@ func (c LayerConfig) Override(other LayerConfig) LayerConfig {
 	c.GorpCodec = override.Nil(c.GorpCodec, other.GorpCodec)
 	c.EnableServiceSignals = override.Nil(c.EnableServiceSignals, other.EnableServiceSignals)
 	c.ValidateChannelNames = override.Nil(c.ValidateChannelNames, other.ValidateChannelNames)
+	c.LeaseFenceTimeout = override.Numeric(c.LeaseFenceTimeout, other.LeaseFenceTimeout)
 	return c
 }
 
@@ -281,6 +288,7 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 	if l.Channel, err = channel.OpenService(ctx, channel.ServiceConfig{
 		Instrumentation: cfg.Child("channel"),
 		HostResolver:    l.Cluster,
+		Cluster:         l.Cluster,
 		ClusterDB:       l.DB,
 		TSChannel:       cfg.Storage.TS,
 		Transport:       cfg.ChannelTransport,
@@ -293,16 +301,17 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 			l.Verification.IsOverflowed,
 		),
 		ValidateNames: cfg.ValidateChannelNames,
-	}); !ok(err, nil) {
+	}); !ok(err, l.Channel) {
 		return nil, err
 	}
 
 	if l.Framer, err = framer.OpenService(ctx, framer.ServiceConfig{
-		Instrumentation: cfg.Child("framer"),
-		Channel:         l.Channel,
-		TS:              cfg.Storage.TS,
-		Transport:       cfg.FrameTransport,
-		HostResolver:    l.Cluster,
+		Instrumentation:   cfg.Child("framer"),
+		Channel:           l.Channel,
+		TS:                cfg.Storage.TS,
+		Transport:         cfg.FrameTransport,
+		HostResolver:      l.Cluster,
+		LeaseFenceTimeout: cfg.LeaseFenceTimeout,
 	}); !ok(err, l.Framer) {
 		return nil, err
 	}
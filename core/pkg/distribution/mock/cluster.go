@@ -14,6 +14,7 @@ import (
 
 	"github.com/onsi/gomega"
 	"github.com/synnaxlabs/aspen"
+	"github.com/synnaxlabs/freighter"
 	aspentransmock "github.com/synnaxlabs/aspen/transport/mock"
 	"github.com/synnaxlabs/synnax/pkg/distribution"
 	"github.com/synnaxlabs/synnax/pkg/distribution/framer"
@@ -33,6 +34,7 @@ import (
 type Node struct {
 	*distribution.Layer
 	Storage *storage.Layer
+	aspen   aspen.Transport
 }
 
 type Cluster struct {
@@ -87,6 +89,7 @@ func (c *Cluster) Provision(
 		peers             = c.addrFactory.Generated()
 		addr              = c.addrFactory.Next()
 		storageLayer      = c.storage.Provision(ctx)
+		aspenTransport    = c.aspenNet.NewTransport()
 		distributionLayer = testutil.MustSucceed(distribution.OpenLayer(ctx, append([]distribution.LayerConfig{{
 			Storage: storageLayer,
 			FrameTransport: mockFramerTransport{
@@ -96,7 +99,7 @@ func (c *Cluster) Provision(
 				deleter: c.deleteNet.New(addr),
 			},
 			ChannelTransport: c.channelNet.New(addr),
-			AspenTransport:   c.aspenNet.NewTransport(),
+			AspenTransport:   aspenTransport,
 			AdvertiseAddress: addr,
 			PeerAddresses:    peers,
 			AspenOptions: []aspen.Option{
@@ -105,7 +108,7 @@ func (c *Cluster) Provision(
 			EnableServiceSignals: new(false),
 		}, c.cfg}, cfgs...)...))
 	)
-	node := Node{Layer: distributionLayer, Storage: storageLayer}
+	node := Node{Layer: distributionLayer, Storage: storageLayer, aspen: aspenTransport}
 	c.Nodes[distributionLayer.Cluster.HostKey()] = node
 	c.WaitForTopologyToStabilize()
 	return node
@@ -121,6 +124,30 @@ func (c *Cluster) WaitForTopologyToStabilize() {
 	}
 }
 
+// Kill simulates the abrupt loss of the node with the given key by cutting it off from
+// the rest of the cluster and then shutting it down. Since the node can't notify its
+// peers that it is leaving, they must detect its failure on their own. The node's
+// storage is left open until the cluster is closed.
+func (c *Cluster) Kill(key node.Key) error {
+	n, ok := c.Nodes[key]
+	if !ok {
+		return errors.Newf("node %v not found in cluster", key)
+	}
+	addr := n.Cluster.Host().Address
+	c.writerNet.Disconnect(addr)
+	c.iterNet.Disconnect(addr)
+	c.channelNet.Disconnect(addr)
+	c.relayNet.Disconnect(addr)
+	c.deleteNet.Disconnect(addr)
+	n.aspen.Use(freighter.MiddlewareFunc(
+		func(ctx freighter.Context, _ freighter.Next) (freighter.Context, error) {
+			return ctx, address.NewTargetNotFoundError(ctx.Target)
+		},
+	))
+	delete(c.Nodes, key)
+	return n.Close()
+}
+
 func (b *Cluster) Close() error {
 	var err error
 	for _, node := range b.Nodes {
@@ -56,4 +56,22 @@ var _ = Describe("Cluster", func() {
 		})
 	})
 
+	Describe("Kill", func() {
+		It("Should cut a node off from the rest of the cluster", func(ctx SpecContext) {
+			mockCluster := mock.ProvisionCluster(ctx, 3)
+			Expect(mockCluster.Kill(3)).To(Succeed())
+			Expect(mockCluster.Nodes).To(HaveLen(2))
+			Eventually(func(g Gomega) {
+				n := MustSucceed(mockCluster.Nodes[1].Cluster.Node(3))
+				g.Expect(n.State).To(Or(Equal(node.StateSuspect), Equal(node.StateDead)))
+			}).Should(Succeed())
+			Expect(mockCluster.Close()).To(Succeed())
+		})
+
+		It("Should return an error if the node is not in the cluster", func(ctx SpecContext) {
+			mockCluster := mock.ProvisionCluster(ctx, 1)
+			Expect(mockCluster.Kill(2)).To(MatchError(ContainSubstring("not found")))
+			Expect(mockCluster.Close()).To(Succeed())
+		})
+	})
 })
@@ -24,9 +24,9 @@ type (
 	// distributed counter through aspen's pledge protocol). Two reserved values are
 	// defined: KeyFree and KeyBootstrapper.
 	Key = aspen.NodeKey
-	// State describes the reachability of a Node from the host's perspective. The
-	// concrete values (Healthy, Suspect, Dead, Left) live on aspen's node package and
-	// propagate through the cluster via SI gossip.
+	// State describes the reachability of a Node from the host's perspective. States
+	// are assigned by aspen's failure detector and propagate through the cluster via SI
+	// gossip.
 	State = aspen.NodeState
 	// Change describes a single mutation to a Node's record (a node joining, leaving,
 	// or transitioning state). Changes are emitted by Cluster.OnChange as part of a
@@ -61,3 +61,17 @@ const (
 	// node that bootstraps a new cluster rather than joining an existing one).
 	KeyBootstrapper = aspen.NodeKeyBootstrapper
 )
+
+const (
+	// StateHealthy is the State of a Node whose heartbeat is advancing.
+	StateHealthy = aspen.NodeStateHealthy
+	// StateSuspect is the State of a Node that could not be reached, or whose
+	// heartbeat has not advanced recently.
+	StateSuspect = aspen.NodeStateSuspect
+	// StateDead is the State of a Node whose heartbeat has not advanced for long enough
+	// that it is considered to have failed.
+	StateDead = aspen.NodeStateDead
+	// StateLeft is the State of a Node that gracefully left the cluster when it was
+	// shut down.
+	StateLeft = aspen.NodeStateLeft
+)
@@ -152,6 +152,32 @@ var _ = Describe("Ontology", func() {
 				Eventually(received).Should(Receive(&change))
 				Expect(change.Key).To(Equal(node.OntologyID(newKey).String()))
 			})
+
+			It("Should translate cluster changes into ontology changes when a node leaves", func(ctx SpecContext) {
+				ephemeral := mock.ProvisionCluster(ctx, 2)
+				DeferCleanup(func() { Expect(ephemeral.Close()).To(Succeed()) })
+				ephemeralSvc, _ := openTestService(ctx, ephemeral.Nodes[1].Cluster)
+
+				received := make(chan ontology.Change, 8)
+				disconnect := ephemeralSvc.OnChange(func(_ context.Context, changes iter.Seq[ontology.Change]) {
+					for ch := range changes {
+						received <- ch
+					}
+				})
+				DeferCleanup(disconnect)
+
+				Expect(ephemeral.Nodes[2].Close()).To(Succeed())
+				delete(ephemeral.Nodes, 2)
+
+				Eventually(func(g Gomega) {
+					var change ontology.Change
+					g.Expect(received).To(Receive(&change))
+					g.Expect(change.Key).To(Equal(node.OntologyID(2).String()))
+					var n node.Node
+					g.Expect(change.Value.Parse(&n)).To(Succeed())
+					g.Expect(n.State).To(Equal(node.StateLeft))
+				}).Should(Succeed())
+			})
 		})
 	})
 })
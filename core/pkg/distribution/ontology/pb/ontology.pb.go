@@ -59,6 +59,9 @@ const (
 	ResourceType_RESOURCE_TYPE_USER             ResourceType = 20
 	ResourceType_RESOURCE_TYPE_VIEW             ResourceType = 21
 	ResourceType_RESOURCE_TYPE_WORKSPACE        ResourceType = 22
+	ResourceType_RESOURCE_TYPE_ARC_LIBRARY      ResourceType = 23
+	ResourceType_RESOURCE_TYPE_AUDIT            ResourceType = 24
+	ResourceType_RESOURCE_TYPE_BACKUP           ResourceType = 25
 )
 
 // Enum value maps for ResourceType.
@@ -87,6 +90,9 @@ var (
 		20: "RESOURCE_TYPE_USER",
 		21: "RESOURCE_TYPE_VIEW",
 		22: "RESOURCE_TYPE_WORKSPACE",
+		23: "RESOURCE_TYPE_ARC_LIBRARY",
+		24: "RESOURCE_TYPE_AUDIT",
+		25: "RESOURCE_TYPE_BACKUP",
 	}
 	ResourceType_value = map[string]int32{
 		"RESOURCE_TYPE_ARC":              0,
@@ -112,6 +118,9 @@ var (
 		"RESOURCE_TYPE_USER":             20,
 		"RESOURCE_TYPE_VIEW":             21,
 		"RESOURCE_TYPE_WORKSPACE":        22,
+		"RESOURCE_TYPE_ARC_LIBRARY":      23,
+		"RESOURCE_TYPE_AUDIT":            24,
+		"RESOURCE_TYPE_BACKUP":           25,
 	}
 )
 
@@ -212,7 +221,7 @@ const file_core_pkg_distribution_ontology_pb_ontology_proto_rawDesc = "" +
 	"0core/pkg/distribution/ontology/pb/ontology.proto\x12\x18distribution.ontology.pb\"R\n" +
 	"\x02ID\x12:\n" +
 	"\x04type\x18\x01 \x01(\x0e2&.distribution.ontology.pb.ResourceTypeR\x04type\x12\x10\n" +
-	"\x03key\x18\x02 \x01(\tR\x03key*\xe7\x04\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key*\xb9\x05\n" +
 	"\fResourceType\x12\x15\n" +
 	"\x11RESOURCE_TYPE_ARC\x10\x00\x12\x19\n" +
 	"\x15RESOURCE_TYPE_BUILTIN\x10\x01\x12\x19\n" +
@@ -237,7 +246,10 @@ const file_core_pkg_distribution_ontology_pb_ontology_proto_rawDesc = "" +
 	"\x12RESOURCE_TYPE_TASK\x10\x13\x12\x16\n" +
 	"\x12RESOURCE_TYPE_USER\x10\x14\x12\x16\n" +
 	"\x12RESOURCE_TYPE_VIEW\x10\x15\x12\x1b\n" +
-	"\x17RESOURCE_TYPE_WORKSPACE\x10\x16B\xea\x01\n" +
+	"\x17RESOURCE_TYPE_WORKSPACE\x10\x16\x12\x1d\n" +
+	"\x19RESOURCE_TYPE_ARC_LIBRARY\x10\x17\x12\x17\n" +
+	"\x13RESOURCE_TYPE_AUDIT\x10\x18\x12\x18\n" +
+	"\x14RESOURCE_TYPE_BACKUP\x10\x19B\xea\x01\n" +
 	"\x1ccom.distribution.ontology.pbB\rOntologyProtoP\x01Z9github.com/synnaxlabs/synnax/pkg/distribution/ontology/pb\xa2\x02\x03DOP\xaa\x02\x18Distribution.Ontology.Pb\xca\x02\x18Distribution\\Ontology\\Pb\xe2\x02$Distribution\\Ontology\\Pb\\GPBMetadata\xea\x02\x1aDistribution::Ontology::Pbb\x06proto3"
 
 var (
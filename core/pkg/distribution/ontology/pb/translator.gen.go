@@ -119,6 +119,12 @@ func ResourceTypeToPB(v ontology.ResourceType) (ResourceType, error) {
 		return ResourceType_RESOURCE_TYPE_VIEW, nil
 	case ontology.ResourceTypeWorkspace:
 		return ResourceType_RESOURCE_TYPE_WORKSPACE, nil
+	case ontology.ResourceTypeArcLibrary:
+		return ResourceType_RESOURCE_TYPE_ARC_LIBRARY, nil
+	case ontology.ResourceTypeAudit:
+		return ResourceType_RESOURCE_TYPE_AUDIT, nil
+	case ontology.ResourceTypeBackup:
+		return ResourceType_RESOURCE_TYPE_BACKUP, nil
 	default:
 		return 0, errors.Newf("unrecognized ontology.ResourceType value: %v", v)
 	}
@@ -173,6 +179,12 @@ func ResourceTypeFromPB(v ResourceType) (ontology.ResourceType, error) {
 		return ontology.ResourceTypeView, nil
 	case ResourceType_RESOURCE_TYPE_WORKSPACE:
 		return ontology.ResourceTypeWorkspace, nil
+	case ResourceType_RESOURCE_TYPE_ARC_LIBRARY:
+		return ontology.ResourceTypeArcLibrary, nil
+	case ResourceType_RESOURCE_TYPE_AUDIT:
+		return ontology.ResourceTypeAudit, nil
+	case ResourceType_RESOURCE_TYPE_BACKUP:
+		return ontology.ResourceTypeBackup, nil
 	default:
 		return ontology.ResourceType(""), errors.Newf("unrecognized ResourceType value: %v", v)
 	}
@@ -38,12 +38,15 @@ const (
 	ResourceTypeUser            ResourceType = "user"
 	ResourceTypeView            ResourceType = "view"
 	ResourceTypeWorkspace       ResourceType = "workspace"
+	ResourceTypeArcLibrary      ResourceType = "arc_library"
+	ResourceTypeAudit           ResourceType = "audit"
+	ResourceTypeBackup          ResourceType = "backup"
 )
 
 // IsValid reports whether r is one of the defined ResourceType values.
 func (r ResourceType) IsValid() bool {
 	switch r {
-	case ResourceTypeArc, ResourceTypeBuiltin, ResourceTypeChannel, ResourceTypeDevice, ResourceTypeFramer, ResourceTypeGroup, ResourceTypeLabel, ResourceTypeLineplot, ResourceTypeLog, ResourceTypeNode, ResourceTypePolicy, ResourceTypeRack, ResourceTypeRange, ResourceTypeRangeAlias, ResourceTypeRole, ResourceTypeSchematic, ResourceTypeSchematicSymbol, ResourceTypeStatus, ResourceTypeTable, ResourceTypeTask, ResourceTypeUser, ResourceTypeView, ResourceTypeWorkspace:
+	case ResourceTypeArc, ResourceTypeBuiltin, ResourceTypeChannel, ResourceTypeDevice, ResourceTypeFramer, ResourceTypeGroup, ResourceTypeLabel, ResourceTypeLineplot, ResourceTypeLog, ResourceTypeNode, ResourceTypePolicy, ResourceTypeRack, ResourceTypeRange, ResourceTypeRangeAlias, ResourceTypeRole, ResourceTypeSchematic, ResourceTypeSchematicSymbol, ResourceTypeStatus, ResourceTypeTable, ResourceTypeTask, ResourceTypeUser, ResourceTypeView, ResourceTypeWorkspace, ResourceTypeArcLibrary, ResourceTypeAudit, ResourceTypeBackup:
 		return true
 	default:
 		return false
@@ -30,9 +30,15 @@ type Batch[E Entry] struct {
 }
 
 func (f BatchFactory[E]) Batch(entries []E) Batch[E] {
+	return f.BatchFunc(entries, E.Lease)
+}
+
+// BatchFunc is identical to Batch, but uses the provided function to resolve the lease
+// of each entry instead of Entry.Lease.
+func (f BatchFactory[E]) BatchFunc(entries []E, leaseOf func(E) node.Key) Batch[E] {
 	b := Batch[E]{Peers: make(map[node.Key][]E)}
 	for _, entry := range entries {
-		lease := entry.Lease()
+		lease := leaseOf(entry)
 		if lease.IsFree() {
 			b.Free = append(b.Free, entry)
 		} else if lease == f.Host {
@@ -41,6 +41,13 @@ func NewChannelNetwork() *ChannelNetwork {
 	}
 }
 
+// Disconnect makes the transport hosted at the given address unreachable.
+func (c *ChannelNetwork) Disconnect(addr address.Address) {
+	c.CreateNet.Disconnect(addr)
+	c.DeleteNet.Disconnect(addr)
+	c.RenameNet.Disconnect(addr)
+}
+
 type ChannelTransport struct {
 	createClient channel.CreateTransportClient
 	createServer channel.CreateTransportServer
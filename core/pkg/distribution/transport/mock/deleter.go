@@ -31,6 +31,9 @@ func NewDeleterNetwork() *FramerDeleterNetwork {
 	return &FramerDeleterNetwork{Internal: mock.NewNetwork[deleter.Request, types.Nil]()}
 }
 
+// Disconnect makes the transport hosted at the given address unreachable.
+func (c *FramerDeleterNetwork) Disconnect(addr address.Address) { c.Internal.Disconnect(addr) }
+
 type FramerDeleterTransport struct {
 	client deleter.TransportClient
 	server deleter.TransportServer
@@ -30,6 +30,9 @@ func NewIteratorNetwork() *FramerIteratorNetwork {
 	return &FramerIteratorNetwork{Internal: mock.NewNetwork[iterator.Request, iterator.Response]()}
 }
 
+// Disconnect makes the transport hosted at the given address unreachable.
+func (c *FramerIteratorNetwork) Disconnect(addr address.Address) { c.Internal.Disconnect(addr) }
+
 type FramerIteratorTransport struct {
 	client iterator.TransportClient
 	server iterator.TransportServer
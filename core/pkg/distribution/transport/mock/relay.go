@@ -25,6 +25,9 @@ func NewRelayNetwork() *FramerRelayNetwork {
 	}
 }
 
+// Disconnect makes the transport hosted at the given address unreachable.
+func (r *FramerRelayNetwork) Disconnect(addr address.Address) { r.internal.Disconnect(addr) }
+
 func (r *FramerRelayNetwork) New(addr address.Address, buffers ...int) relay.Transport {
 	return &FramerRelayTransport{
 		client: r.internal.StreamClient(buffers...),
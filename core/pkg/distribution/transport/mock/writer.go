@@ -30,6 +30,9 @@ func NewWriterNetwork() *FramerWriterNetwork {
 	return &FramerWriterNetwork{Internal: mock.NewNetwork[writer.Request, writer.Response]()}
 }
 
+// Disconnect makes the transport hosted at the given address unreachable.
+func (c *FramerWriterNetwork) Disconnect(addr address.Address) { c.Internal.Disconnect(addr) }
+
 type FramerWriterTransport struct {
 	client writer.TransportClient
 	server writer.TransportServer
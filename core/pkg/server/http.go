@@ -69,7 +69,7 @@ func (b *SecureHTTPBranch) maybeRouteDebugUtil(ctx BranchContext) {
 	if !ctx.Debug {
 		return
 	}
-	b.internal.Get("/metrics", monitor.New(monitor.Config{Title: "Synnax Metrics"}))
+	b.internal.Get("/debug/monitor", monitor.New(monitor.Config{Title: "Synnax Metrics"}))
 	b.internal.Use(pprof.New())
 }
 
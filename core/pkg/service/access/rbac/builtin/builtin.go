@@ -10,6 +10,8 @@
 package builtin
 
 import (
+	"slices"
+
 	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
 	"github.com/synnaxlabs/synnax/pkg/service/access"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/policy"
@@ -34,6 +36,7 @@ var allObjects = []ontology.ID{
 	{Type: ontology.ResourceTypeTask},
 	{Type: ontology.ResourceTypeTable},
 	{Type: ontology.ResourceTypeArc},
+	{Type: ontology.ResourceTypeArcLibrary},
 	{Type: ontology.ResourceTypeSchematicSymbol},
 	{Type: ontology.ResourceTypeStatus},
 	{Type: ontology.ResourceTypeRole},
@@ -42,6 +45,14 @@ var allObjects = []ontology.ID{
 	{Type: ontology.ResourceTypeView},
 }
 
+// ownerOnlyObjects are resource types that expose the actions or secrets of every
+// user, such as the audit log and full node backups, so only owners are granted access
+// to them by default.
+var ownerOnlyObjects = []ontology.ID{
+	{Type: ontology.ResourceTypeAudit},
+	{Type: ontology.ResourceTypeBackup},
+}
+
 var (
 	ownerRoleName = "Owner"
 	ownerRole     = role.Role{
@@ -51,7 +62,7 @@ var (
 	}
 	ownerPolicy = policy.Policy{
 		Name:     ownerRoleName,
-		Objects:  allObjects,
+		Objects:  slices.Concat(allObjects, ownerOnlyObjects),
 		Actions:  access.AllActions,
 		Internal: true,
 	}
@@ -84,6 +95,7 @@ var (
 				{Type: ontology.ResourceTypeTask},
 				{Type: ontology.ResourceTypeTable},
 				{Type: ontology.ResourceTypeArc},
+				{Type: ontology.ResourceTypeArcLibrary},
 				{Type: ontology.ResourceTypeSchematicSymbol},
 				{Type: ontology.ResourceTypeStatus},
 				{Type: ontology.ResourceTypeView},
@@ -153,6 +153,29 @@ var _ = Describe("Provision", func() {
 		})
 	})
 
+	DescribeTable("Should only grant owners access to owner-only resource types",
+		func(ctx SpecContext, t ontology.ResourceType) {
+			for _, name := range []string{"Owner", "Engineer", "Host", "Operator", "Viewer"} {
+				var r role.Role
+				Expect(rbacSvc.Role.NewRetrieve().Where(role.MatchNames(name)).Entry(&r).Exec(ctx, tx)).To(Succeed())
+				subject := ontology.ID{Type: "user", Key: uuid.New().String()}
+				Expect(otg.NewWriter(tx).DefineResource(ctx, subject)).To(Succeed())
+				Expect(rbacSvc.Role.NewWriter(tx, true).AssignRole(ctx, subject, r.Key)).To(Succeed())
+				matcher := MatchError(access.ErrDenied)
+				if name == "Owner" {
+					matcher = Succeed()
+				}
+				Expect(rbacSvc.NewEnforcer(tx).Enforce(ctx, access.Request{
+					Subject: subject,
+					Action:  access.ActionRetrieve,
+					Objects: []ontology.ID{{Type: t}},
+				})).To(matcher, name)
+			}
+		},
+		Entry("audit", ontology.ResourceTypeAudit),
+		Entry("backup", ontology.ResourceTypeBackup),
+	)
+
 	Describe("Idempotency", func() {
 		It("Should produce the same role keys when opened again", func(ctx SpecContext) {
 			var ownerBefore role.Role
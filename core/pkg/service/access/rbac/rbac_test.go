@@ -13,11 +13,14 @@ import (
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
 	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
 	"github.com/synnaxlabs/synnax/pkg/service/access"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/policy"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/role"
+	"github.com/synnaxlabs/synnax/pkg/service/audit"
 	"github.com/synnaxlabs/x/gorp"
 	. "github.com/synnaxlabs/x/testutil"
 )
@@ -135,6 +138,38 @@ var _ = Describe("Service", func() {
 				Expect(rbacSvc.NewEnforcer(tx).Enforce(ctx, req)).To(Succeed())
 			})
 
+			It("Should only consider the policies within the scope of the context", func(ctx SpecContext) {
+				r := &role.Role{Name: "test-role", Description: "Test role"}
+				Expect(roleWriter.Create(ctx, r)).To(Succeed())
+				read1 := &policy.Policy{
+					Name:    "allow-read-1",
+					Objects: []ontology.ID{obj1},
+					Actions: []access.Action{access.ActionRetrieve},
+				}
+				read2 := &policy.Policy{
+					Name:    "allow-read-2",
+					Objects: []ontology.ID{obj2},
+					Actions: []access.Action{access.ActionRetrieve},
+				}
+				Expect(policyWriter.Create(ctx, read1)).To(Succeed())
+				Expect(policyWriter.Create(ctx, read2)).To(Succeed())
+				Expect(policyWriter.SetOnRole(ctx, r.Key, read1.Key, read2.Key)).To(Succeed())
+				Expect(roleWriter.AssignRole(ctx, subject, r.Key)).To(Succeed())
+
+				scoped := access.WithScope(ctx, []uuid.UUID{read1.Key, uuid.New()})
+				req := access.Request{
+					Subject: subject,
+					Objects: []ontology.ID{obj1},
+					Action:  access.ActionRetrieve,
+				}
+				Expect(rbacSvc.NewEnforcer(tx).Enforce(scoped, req)).To(Succeed())
+				req.Objects = []ontology.ID{obj2}
+				Expect(rbacSvc.NewEnforcer(tx).Enforce(scoped, req)).To(MatchError(access.ErrDenied))
+				Expect(rbacSvc.NewEnforcer(tx).Enforce(ctx, req)).To(Succeed())
+				empty := access.WithScope(ctx, []uuid.UUID{})
+				Expect(rbacSvc.NewEnforcer(tx).Enforce(empty, req)).To(MatchError(access.ErrDenied))
+			})
+
 			It("Should deny access when no policy exists", func(ctx SpecContext) {
 				req := access.Request{
 					Subject: subject,
@@ -216,6 +251,68 @@ var _ = Describe("Service", func() {
 			})
 		})
 
+		Describe("Enforce with an audit log", func() {
+			var (
+				auditSvc *audit.Service
+				enforcer *rbac.Enforcer
+				req      access.Request
+			)
+			BeforeEach(func(ctx SpecContext) {
+				auditSvc = MustSucceed(audit.OpenService(ctx, audit.ServiceConfig{
+					DB:           db,
+					HostProvider: mock.StaticHostKeyProvider(1),
+				}))
+				svc := MustOpen(rbac.OpenService(ctx, rbac.ServiceConfig{
+					DB:       db,
+					Ontology: otg,
+					Group:    groupSvc,
+					Search:   searchIdx,
+					User:     userSvc,
+					Audit:    auditSvc,
+				}))
+				p := &policy.Policy{
+					Name:    "allow-read",
+					Objects: []ontology.ID{obj1},
+					Actions: []access.Action{access.ActionRetrieve},
+				}
+				r := &role.Role{Name: "audited-role", Description: "Audited role"}
+				Expect(roleWriter.Create(ctx, r)).To(Succeed())
+				Expect(policyWriter.Create(ctx, p)).To(Succeed())
+				Expect(policyWriter.SetOnRole(ctx, r.Key, p.Key)).To(Succeed())
+				Expect(roleWriter.AssignRole(ctx, subject, r.Key)).To(Succeed())
+				enforcer = svc.NewEnforcer(tx)
+				req = access.Request{
+					Subject: subject,
+					Objects: []ontology.ID{obj1},
+					Action:  access.ActionRetrieve,
+				}
+			})
+
+			It("Should record allowed and denied decisions", func(ctx SpecContext) {
+				Expect(enforcer.Enforce(ctx, req)).To(Succeed())
+				denied := req
+				denied.Action = access.ActionDelete
+				Expect(enforcer.Enforce(ctx, denied)).To(MatchError(access.ErrDenied))
+				var records []audit.Record
+				Expect(auditSvc.NewRetrieve().
+					Where(audit.MatchKinds(audit.KindAccess)).
+					Entries(&records).
+					Exec(ctx, nil)).To(Succeed())
+				records = lo.Filter(records, func(r audit.Record, _ int) bool {
+					return r.Subject == subject
+				})
+				Expect(records).To(HaveLen(2))
+				Expect(records[0].Error).To(BeEmpty())
+				Expect(records[1].Error).To(ContainSubstring(access.ErrDenied.Error()))
+				Expect(auditSvc.Close()).To(Succeed())
+			})
+
+			It("Should deny requests whose decision cannot be recorded", func(ctx SpecContext) {
+				Expect(auditSvc.Close()).To(Succeed())
+				Expect(enforcer.Enforce(ctx, req)).To(MatchError(ContainSubstring("audit log closed")))
+			})
+		})
+
 		Describe("Enforce with multiple roles", func() {
 			It("Should allow access via role assignment", func(ctx SpecContext) {
 				r := &role.Role{
@@ -23,6 +23,7 @@ import (
 	v0 "github.com/synnaxlabs/synnax/pkg/service/access/rbac/migrations/v0"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/policy"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/role"
+	"github.com/synnaxlabs/synnax/pkg/service/audit"
 	"github.com/synnaxlabs/synnax/pkg/service/user"
 	"github.com/synnaxlabs/x/config"
 	"github.com/synnaxlabs/x/errors"
@@ -71,6 +72,13 @@ type ServiceConfig struct {
 	//
 	// [REQUIRED]
 	User *user.Service
+	// Audit is the audit log that every enforcement decision, including denials, is
+	// recorded in. Enforcement fails closed: each decision waits for its record to be
+	// committed, and a request whose decision cannot be recorded is denied, so an
+	// unavailable audit log denies all access until it recovers.
+	//
+	// [OPTIONAL] - Defaults to nil, in which case decisions are not recorded.
+	Audit *audit.Service
 }
 
 var _ config.Config[ServiceConfig] = ServiceConfig{}
@@ -84,6 +92,7 @@ func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
 	c.Group = override.Nil(c.Group, other.Group)
 	c.Search = override.Nil(c.Search, other.Search)
 	c.User = override.Nil(c.User, other.User)
+	c.Audit = override.Nil(c.Audit, other.Audit)
 	return c
 }
 
@@ -249,16 +258,42 @@ func (s *Service) NewEnforcer(tx gorp.Tx) *Enforcer {
 }
 
 // Enforce implements the access.Enforcer interface. It checks both direct user policies
-// and policies from all roles assigned to the user.
+// and policies from all roles assigned to the user. If ctx is scoped with
+// [access.WithScope], only the subject's policies within the scope are considered.
+// If an audit log is configured, the decision is recorded in it.
 func (e *Enforcer) Enforce(ctx context.Context, req access.Request) error {
 	v, err := e.retrievePolicies(ctx, req.Subject)
 	if err != nil {
 		return err
 	}
-	if allowRequest(req, v) {
+	if scope, ok := access.ScopeFromContext(ctx); ok {
+		v = lo.Filter(v, func(p policy.Policy, _ int) bool {
+			return lo.Contains(scope, p.Key)
+		})
+	}
+	if !allowRequest(req, v) {
+		err = access.ErrDenied
+	}
+	return errors.Combine(err, e.record(ctx, req, err))
+}
+
+// record adds the decision on req to the audit log, returning once it is committed. A
+// decision that cannot be committed returns an error so that unaudited requests are
+// never allowed.
+func (e *Enforcer) record(ctx context.Context, req access.Request, decision error) error {
+	if e.cfg.Audit == nil {
 		return nil
 	}
-	return access.ErrDenied
+	r := audit.Record{
+		Kind:    audit.KindAccess,
+		Subject: req.Subject,
+		Action:  string(req.Action),
+		Objects: req.Objects,
+	}
+	if decision != nil {
+		r.Error = decision.Error()
+	}
+	return e.cfg.Audit.Log(ctx, r)
 }
 
 func allowRequest(req access.Request, policies []policy.Policy) bool {
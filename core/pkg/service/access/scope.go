@@ -0,0 +1,36 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package access
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type scopeKey struct{}
+
+// WithScope returns a context in which requests are only allowed by the policies with
+// the given keys. Policies outside the scope are ignored even if the subject holds
+// them, so a scope can only narrow a subject's access. A nil scope leaves the context
+// unscoped.
+func WithScope(ctx context.Context, policies []uuid.UUID) context.Context {
+	if policies == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, scopeKey{}, policies)
+}
+
+// ScopeFromContext returns the policies a context was scoped to by [WithScope], and
+// false if it is unscoped.
+func ScopeFromContext(ctx context.Context) ([]uuid.UUID, bool) {
+	policies, ok := ctx.Value(scopeKey{}).([]uuid.UUID)
+	return policies, ok
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
 	"github.com/synnaxlabs/synnax/pkg/distribution/search"
 	"github.com/synnaxlabs/synnax/pkg/service/arc"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/library"
 	"github.com/synnaxlabs/synnax/pkg/service/label"
 	"github.com/synnaxlabs/synnax/pkg/service/rack"
 	"github.com/synnaxlabs/synnax/pkg/service/status"
@@ -40,6 +41,7 @@ var (
 	db       *gorp.DB
 	otg      *ontology.Ontology
 	svc      *arc.Service
+	libSvc   *library.Service
 	tx       gorp.Tx
 	dist     mock.Node
 	groupSvc *group.Service
@@ -93,12 +95,18 @@ var (
 		}))
 		testRack = &rack.Rack{Name: "Test Rack"}
 		Expect(rackSvc.NewWriter(db).Create(ctx, testRack)).To(Succeed())
+		libSvc = MustOpen(library.OpenService(ctx, library.ServiceConfig{
+			DB:       db,
+			Ontology: otg,
+			Search:   searchIdx,
+		}))
 		svc = MustOpen(arc.OpenService(ctx, arc.ServiceConfig{
 			DB:       db,
 			Ontology: otg,
 			Channel:  dist.Channel,
 			Task:     taskSvc,
 			Search:   searchIdx,
+			Library:  libSvc,
 		}))
 	})
 	_ = BeforeEach(func() { tx = db.OpenTx() })
@@ -0,0 +1,25 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package library stores reusable Arc library modules. Each library is a versioned
+// Arc source file containing functions and constants that other Arc programs can
+// import by name. Imports always resolve to the latest version of a library, so a
+// change to its source takes effect in every program that imports it the next time
+// that program is compiled.
+package library
+
+import "github.com/synnaxlabs/x/gorp"
+
+var _ gorp.Entry[Key] = Library{}
+
+// GorpKey implements gorp.Entry.
+func (l Library) GorpKey() Key { return l.Key }
+
+// SetOptions implements gorp.Entry.
+func (l Library) SetOptions() []any { return nil }
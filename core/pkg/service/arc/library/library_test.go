@@ -0,0 +1,150 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package library_test
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/text"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/library"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/query"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+const source = `
+func scale(v f64) f64 {
+    return v * 2.0
+}
+`
+
+var _ = Describe("Library", func() {
+	var (
+		tx gorp.Tx
+		w  library.Writer
+	)
+	BeforeEach(func() {
+		tx = db.OpenTx()
+		w = svc.NewWriter(tx)
+	})
+	AfterEach(func() { Expect(tx.Close()).To(Succeed()) })
+
+	Describe("Create", func() {
+		It("Should create a library with a new key at version 1", func(ctx SpecContext) {
+			l := library.Library{Name: "conv", Text: text.Text{Raw: source}}
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			Expect(l.Key).ToNot(Equal(uuid.Nil))
+			Expect(l.Version).To(Equal(uint32(1)))
+			var res library.Library
+			Expect(svc.NewRetrieve().
+				Where(library.MatchKeys(l.Key)).
+				Entry(&res).
+				Exec(ctx, tx)).To(Succeed())
+			Expect(res.Name).To(Equal("conv"))
+			Expect(res.Text.Raw).To(Equal(source))
+		})
+
+		It("Should increment the version when the source changes", func(ctx SpecContext) {
+			l := library.Library{Name: "conv", Text: text.Text{Raw: source}}
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			l.Text.Raw = source + "\nOFFSET f64 := 1.0\n"
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			Expect(l.Version).To(Equal(uint32(2)))
+		})
+
+		It("Should not increment the version when only the name changes", func(ctx SpecContext) {
+			l := library.Library{Name: "conv", Text: text.Text{Raw: source}}
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			l.Name = "conversions"
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			Expect(l.Version).To(Equal(uint32(1)))
+		})
+
+		It("Should define the library in the ontology", func(ctx SpecContext) {
+			l := library.Library{Name: "conv", Text: text.Text{Raw: source}}
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			var res ontology.Resource
+			Expect(otg.NewRetrieve().
+				WhereIDs(library.OntologyID(l.Key)).
+				Entry(&res).
+				Exec(ctx, tx)).To(Succeed())
+			Expect(res.Name).To(Equal("conv"))
+		})
+
+		DescribeTable("Should reject invalid names", func(ctx SpecContext, name, msg string) {
+			l := library.Library{Name: name, Text: text.Text{Raw: source}}
+			Expect(w.Create(ctx, &l)).To(MatchError(ContainSubstring(msg)))
+		},
+			Entry("empty", "", "required"),
+			Entry("not an identifier", "my-lib", "not a valid Arc identifier"),
+			Entry("a keyword", "func", "not a valid Arc identifier"),
+			Entry("a built-in module", "math", "built-in Arc module"),
+		)
+
+		It("Should reject a name that is already in use", func(ctx SpecContext) {
+			Expect(w.Create(ctx, &library.Library{
+				Name: "conv",
+				Text: text.Text{Raw: source},
+			})).To(Succeed())
+			Expect(w.Create(ctx, &library.Library{
+				Name: "conv",
+				Text: text.Text{Raw: source},
+			})).To(MatchError(ContainSubstring(`a library named "conv" already exists`)))
+		})
+
+		It("Should reject source that does not parse", func(ctx SpecContext) {
+			l := library.Library{Name: "conv", Text: text.Text{Raw: "func scale( {"}}
+			Expect(w.Create(ctx, &l)).To(MatchError(ContainSubstring("text")))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("Should delete a library", func(ctx SpecContext) {
+			l := library.Library{Name: "conv", Text: text.Text{Raw: source}}
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			Expect(w.Delete(ctx, l.Key)).To(Succeed())
+			Expect(svc.NewRetrieve().
+				Where(library.MatchKeys(l.Key)).
+				Entry(&library.Library{}).
+				Exec(ctx, tx)).To(MatchError(query.ErrNotFound))
+		})
+
+		It("Should be idempotent", func(ctx SpecContext) {
+			Expect(w.Delete(ctx, uuid.New())).To(Succeed())
+		})
+	})
+
+	Describe("NewSymbols", func() {
+		It("Should return a module symbol for each library", func(ctx SpecContext) {
+			l := library.Library{Name: "conv", Text: text.Text{Raw: source}}
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			syms := MustSucceed(svc.NewSymbols(ctx, tx))
+			Expect(syms).To(HaveLen(1))
+			Expect(syms[0].Name).To(Equal("conv"))
+			Expect(syms[0].Kind).To(Equal(symbol.KindModule))
+			Expect(syms[0].IsLibrary()).To(BeTrue())
+		})
+
+		It("Should re-parse a library when its version changes", func(ctx SpecContext) {
+			l := library.Library{Name: "conv", Text: text.Text{Raw: source}}
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			first := MustSucceed(svc.NewSymbols(ctx, tx))
+			Expect(MustSucceed(svc.NewSymbols(ctx, tx))[0].AST).
+				To(BeIdenticalTo(first[0].AST))
+			l.Text.Raw = source + "\nOFFSET f64 := 1.0\n"
+			Expect(w.Create(ctx, &l)).To(Succeed())
+			Expect(MustSucceed(svc.NewSymbols(ctx, tx))[0].AST).
+				ToNot(BeIdenticalTo(first[0].AST))
+		})
+	})
+})
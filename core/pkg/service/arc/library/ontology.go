@@ -0,0 +1,122 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package library
+
+import (
+	"context"
+	"io"
+	"iter"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/distribution/search"
+	xchange "github.com/synnaxlabs/x/change"
+	"github.com/synnaxlabs/x/gorp"
+	xiter "github.com/synnaxlabs/x/iter"
+	"github.com/synnaxlabs/x/observe"
+	"github.com/synnaxlabs/x/zyn"
+)
+
+// OntologyID returns the unique identifier for the library within the ontology.
+func OntologyID(k Key) ontology.ID {
+	return ontology.ID{Type: ontology.ResourceTypeArcLibrary, Key: k.String()}
+}
+
+// OntologyIDs returns the unique identifiers for the libraries within the ontology.
+func OntologyIDs(keys []Key) []ontology.ID {
+	return lo.Map(keys, func(k Key, _ int) ontology.ID { return OntologyID(k) })
+}
+
+// OntologyIDsFromLibraries returns the ontology IDs of the libraries.
+func OntologyIDsFromLibraries(libs []Library) []ontology.ID {
+	return lo.Map(libs, func(l Library, _ int) ontology.ID { return OntologyID(l.Key) })
+}
+
+// KeysFromOntologyIDs extracts the keys of the libraries from the ontology IDs.
+func KeysFromOntologyIDs(ids []ontology.ID) ([]Key, error) {
+	keys := make([]Key, len(ids))
+	var err error
+	for i, id := range ids {
+		if keys[i], err = uuid.Parse(id.Key); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+var schema = zyn.Object(map[string]zyn.Schema{
+	"key":     zyn.UUID(),
+	"name":    zyn.String(),
+	"version": zyn.Uint32(),
+})
+
+func newResource(l Library) ontology.Resource {
+	return ontology.NewResource(schema, OntologyID(l.Key), l.Name, l)
+}
+
+var (
+	_ ontology.Service = (*Service)(nil)
+	_ search.Service   = (*Service)(nil)
+)
+
+type change = xchange.Change[Key, Library]
+
+// Type implements ontology.Service.
+func (s *Service) Type() ontology.ResourceType { return ontology.ResourceTypeArcLibrary }
+
+// Schema implements ontology.Service.
+func (s *Service) Schema() zyn.Schema { return schema }
+
+// RetrieveResource implements ontology.Service.
+func (s *Service) RetrieveResource(
+	ctx context.Context,
+	key string,
+	tx gorp.Tx,
+) (ontology.Resource, error) {
+	k, err := uuid.Parse(key)
+	if err != nil {
+		return ontology.Resource{}, err
+	}
+	var l Library
+	if err = s.NewRetrieve().Where(MatchKeys(k)).Entry(&l).Exec(ctx, tx); err != nil {
+		return ontology.Resource{}, err
+	}
+	return newResource(l), nil
+}
+
+func translateChange(c change) ontology.Change {
+	return ontology.Change{
+		Variant: c.Variant,
+		Key:     OntologyID(c.Key).String(),
+		Value:   newResource(c.Value),
+	}
+}
+
+// OnChange implements ontology.Service.
+func (s *Service) OnChange(
+	f func(context.Context, iter.Seq[ontology.Change]),
+) observe.Disconnect {
+	handleChange := func(ctx context.Context, reader gorp.TxReader[Key, Library]) {
+		f(ctx, xiter.Map(reader, translateChange))
+	}
+	return s.table.Observe().OnChange(handleChange)
+}
+
+// OpenNexter implements ontology.Service.
+func (s *Service) OpenNexter(
+	ctx context.Context,
+) (iter.Seq[ontology.Resource], io.Closer, error) {
+	n, closer, err := s.table.OpenNexter(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return xiter.Map(n, newResource), closer, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Code generated by oracle. DO NOT EDIT.
+
+package library
+
+import (
+	"context"
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/distribution/search"
+	"github.com/synnaxlabs/x/gorp"
+)
+
+// Retrieve is used to retrieve Library records from the database using a
+// builder pattern for constructing queries.
+type Retrieve struct {
+	baseTX     gorp.Tx
+	gorp       gorp.Retrieve[Key, Library]
+	search     *search.Index
+	searchTerm string
+}
+
+// Filter is a per-service filter that is bound to the Retrieve when passed to
+// Where. Pure filters ignore the Retrieve argument; service-bound filters read
+// from it (e.g. r.indexes, r.label, r.hostProvider) to evaluate. Use Match to
+// construct one from a closure.
+//
+// Filter is a type alias for gorp.BoundFilter[Retrieve, K, E] so the
+// composition helpers (Match / And / Or / Not) can be one-line wrappers
+// around their gorp.*Bound counterparts instead of re-emitting closure
+// plumbing per service.
+type Filter = gorp.BoundFilter[Retrieve, Key, Library]
+
+// Match wraps a closure that needs the Retrieve into a Filter. The Retrieve
+// value is supplied by Retrieve.Where at evaluation time.
+func Match(f func(ctx gorp.Context, r Retrieve, e *Library) (bool, error)) Filter {
+	return gorp.MatchBound[Retrieve, Key, Library](f)
+}
+
+// And returns a filter that matches when all provided filters match.
+func And(fs ...Filter) Filter {
+	return gorp.AndBound[Retrieve, Key, Library](fs...)
+}
+
+// Or returns a filter that matches when any provided filter matches.
+func Or(fs ...Filter) Filter {
+	return gorp.OrBound[Retrieve, Key, Library](fs...)
+}
+
+// Not returns a filter that inverts the provided filter.
+func Not(f Filter) Filter {
+	return gorp.NotBound[Retrieve, Key, Library](f)
+}
+
+// Search sets a fuzzy search term that Retrieve will use to filter results.
+func (r Retrieve) Search(term string) Retrieve { r.searchTerm = term; return r }
+
+// MatchKeys returns a filter that restricts results to libraries whose key
+// matches any of the provided values. Composing MatchKeys at the top level
+// of a Where clause (i.e. r.Where(MatchKeys(...))) dispatches Exec to the
+// multi-get fast path; composing inside Or / Not falls back to a full scan.
+func MatchKeys(keys ...Key) Filter {
+	return func(_ Retrieve) gorp.Filter[Key, Library] {
+		return gorp.MatchKeys[Key, Library](keys...)
+	}
+}
+
+// MatchNames returns a filter for libraries whose Name matches any of the provided values.
+func MatchNames(vals ...string) Filter {
+	return func(r Retrieve) gorp.Filter[Key, Library] {
+		return gorp.Match(func(_ gorp.Context, e *Library) (bool, error) {
+			return lo.Contains(vals, e.Name), nil
+		})
+	}
+}
+
+// Where applies the provided filter to the query, binding it to the Retrieve
+// so service-bound filters can read from r.indexes, r.label, r.hostProvider,
+// etc. To compose multiple filters, chain Where calls or pass a combined
+// filter via And / Or.
+func (r Retrieve) Where(filter Filter) Retrieve {
+	r.gorp = r.gorp.Where(filter(r))
+	return r
+}
+
+// Entry binds the provided library as the result container for the query. If
+// multiple libraries match, the first one is used.
+func (r Retrieve) Entry(e *Library) Retrieve {
+	r.gorp = r.gorp.Entry(e)
+	return r
+}
+
+// Entries binds the provided slice of libraries as the result container for the query.
+func (r Retrieve) Entries(es *[]Library) Retrieve {
+	r.gorp = r.gorp.Entries(es)
+	return r
+}
+
+// Limit sets the maximum number of libraries to return.
+func (r Retrieve) Limit(limit int) Retrieve { r.gorp = r.gorp.Limit(limit); return r }
+
+// Offset sets the starting index of the libraries to return.
+func (r Retrieve) Offset(offset int) Retrieve {
+	r.gorp = r.gorp.Offset(offset)
+	return r
+}
+
+func (r Retrieve) execSearch(ctx context.Context) (Retrieve, error) {
+	if r.searchTerm == "" {
+		return r, nil
+	}
+	ids, err := r.search.Search(ctx, search.Request{
+		Type: ontology.ResourceTypeArcLibrary,
+		Term: r.searchTerm,
+	})
+	if err != nil {
+		return Retrieve{}, err
+	}
+	keys, err := KeysFromOntologyIDs(ids)
+	if err != nil {
+		return Retrieve{}, err
+	}
+	return r.Where(MatchKeys(keys...)), nil
+}
+
+// Exec executes the query against the provided transaction.
+func (r Retrieve) Exec(ctx context.Context, tx gorp.Tx) error {
+	var err error
+	if r, err = r.execSearch(ctx); err != nil {
+		return err
+	}
+	return r.gorp.Exec(ctx, gorp.OverrideTx(r.baseTX, tx))
+}
+
+// Count returns the number of libraries matching the query.
+func (r Retrieve) Count(ctx context.Context, tx gorp.Tx) (int, error) {
+	var err error
+	if r, err = r.execSearch(ctx); err != nil {
+		return 0, err
+	}
+	return r.gorp.Count(ctx, gorp.OverrideTx(r.baseTX, tx))
+}
+
+// Exists checks whether any libraries match the query.
+func (r Retrieve) Exists(ctx context.Context, tx gorp.Tx) (bool, error) {
+	var err error
+	if r, err = r.execSearch(ctx); err != nil {
+		return false, err
+	}
+	return r.gorp.Exists(ctx, gorp.OverrideTx(r.baseTX, tx))
+}
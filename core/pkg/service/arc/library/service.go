@@ -0,0 +1,191 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package library
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/arc/parser"
+	arcsymbol "github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/text"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/distribution/search"
+	"github.com/synnaxlabs/synnax/pkg/distribution/signals"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/gorp"
+	xio "github.com/synnaxlabs/x/io"
+	"github.com/synnaxlabs/x/override"
+	"github.com/synnaxlabs/x/service"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// ServiceConfig is the configuration for opening the library service.
+type ServiceConfig struct {
+	// DB is the database that the service will store libraries in.
+	//
+	// [REQUIRED]
+	DB *gorp.DB
+	// Ontology is used to define libraries as resources in the Synnax resource graph.
+	//
+	// [REQUIRED]
+	Ontology *ontology.Ontology
+	// Search is the search index for fuzzy searching libraries.
+	//
+	// [REQUIRED]
+	Search *search.Index
+	// Signals is used for propagating changes to libraries through the cluster.
+	//
+	// [OPTIONAL] - Defaults to nil. Signals will not be propagated if this service is
+	// nil.
+	Signals *signals.Provider
+	// Instrumentation is used for logging, tracing, and metrics.
+	alamos.Instrumentation
+}
+
+var (
+	_                    config.Config[ServiceConfig] = ServiceConfig{}
+	DefaultServiceConfig                              = ServiceConfig{}
+)
+
+// Override implements config.Config.
+func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
+	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
+	c.DB = override.Nil(c.DB, other.DB)
+	c.Ontology = override.Nil(c.Ontology, other.Ontology)
+	c.Search = override.Nil(c.Search, other.Search)
+	c.Signals = override.Nil(c.Signals, other.Signals)
+	return c
+}
+
+// Validate implements config.Config.
+func (c ServiceConfig) Validate() error {
+	v := validate.New("arc.library")
+	validate.NotNil(v, "db", c.DB)
+	validate.NotNil(v, "ontology", c.Ontology)
+	validate.NotNil(v, "search", c.Search)
+	return v.Error()
+}
+
+// Service is the primary service for retrieving and modifying Arc libraries.
+type Service struct {
+	cfg    ServiceConfig
+	table  *gorp.Table[Key, Library]
+	closer xio.MultiCloser
+	// parsed caches the parsed source of each library by key so that building a
+	// program root only re-parses the libraries whose version has changed.
+	parsed struct {
+		sync.Mutex
+		entries map[Key]parsedLibrary
+	}
+}
+
+type parsedLibrary struct {
+	version uint32
+	ast     parser.IProgramContext
+}
+
+// OpenService opens a new Service with the provided configuration. If error is nil, the
+// service is ready for use and must be closed by calling Close to prevent resource
+// leaks.
+func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err error) {
+	s = &Service{}
+	if s.cfg, err = config.New(DefaultServiceConfig, cfgs...); err != nil {
+		return nil, err
+	}
+	s.parsed.entries = make(map[Key]parsedLibrary)
+	cleanup, ok := service.NewOpener(ctx, &s.closer)
+	defer func() { err = cleanup(err) }()
+	if s.table, err = gorp.OpenTable(ctx, gorp.TableConfig[Key, Library]{
+		DB:              s.cfg.DB,
+		Instrumentation: s.cfg.Instrumentation,
+	}); !ok(err, s.table) {
+		return nil, err
+	}
+	s.cfg.Ontology.RegisterService(s)
+	s.cfg.Search.RegisterService(s)
+	if s.cfg.Signals == nil {
+		return s, nil
+	}
+	var sig io.Closer
+	if sig, err = signals.PublishFromGorp(
+		ctx,
+		s.cfg.Signals,
+		signals.GorpPublisherConfigUUID[Library](s.table.Observe()),
+	); !ok(err, sig) {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the service and releases any resources that it may have acquired.
+func (s *Service) Close() error { return s.closer.Close() }
+
+// NewWriter opens a new Writer to create and delete libraries. If tx is not nil, the
+// writer will use it to execute all operations. If tx is nil, the writer will execute
+// all operations directly against the underlying gorp.DB.
+func (s *Service) NewWriter(tx gorp.Tx) Writer {
+	return Writer{
+		tx:    gorp.OverrideTx(s.cfg.DB, tx),
+		otg:   s.cfg.Ontology.NewWriter(tx),
+		table: s.table,
+	}
+}
+
+// NewRetrieve opens a new Retrieve query to fetch libraries from the database.
+func (s *Service) NewRetrieve() Retrieve {
+	return Retrieve{
+		gorp:   s.table.NewRetrieve(),
+		baseTX: s.cfg.DB,
+		search: s.cfg.Search,
+	}
+}
+
+// NewSymbols returns an Arc module symbol for every stored library. The symbols are
+// passed as extras when building a program root, after which programs can import the
+// libraries by name.
+func (s *Service) NewSymbols(ctx context.Context, tx gorp.Tx) ([]*arcsymbol.Symbol, error) {
+	var libs []Library
+	if err := s.NewRetrieve().Entries(&libs).Exec(ctx, tx); err != nil {
+		return nil, err
+	}
+	s.parsed.Lock()
+	defer s.parsed.Unlock()
+	stale := make(map[Key]parsedLibrary, len(s.parsed.entries))
+	for k, p := range s.parsed.entries {
+		stale[k] = p
+	}
+	symbols := make([]*arcsymbol.Symbol, 0, len(libs))
+	for _, lib := range libs {
+		delete(stale, lib.Key)
+		p, ok := s.parsed.entries[lib.Key]
+		if !ok || p.version != lib.Version {
+			t, diag := text.Parse(lib.Text)
+			if diag != nil {
+				s.cfg.L.Warn(
+					"skipping arc library that failed to parse",
+					zap.String("name", lib.Name),
+					zap.Error(diag),
+				)
+				continue
+			}
+			p = parsedLibrary{version: lib.Version, ast: t.AST}
+			s.parsed.entries[lib.Key] = p
+		}
+		symbols = append(symbols, arcsymbol.NewLibrary(lib.Name, p.ast))
+	}
+	for k := range stale {
+		delete(s.parsed.entries, k)
+	}
+	return symbols, nil
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Code generated by oracle. DO NOT EDIT.
+
+package library
+
+import (
+	"github.com/google/uuid"
+	"github.com/synnaxlabs/arc/text"
+)
+
+// Key is a unique identifier for an Arc library.
+type Key = uuid.UUID
+
+// Library is a reusable Arc module containing functions and constants that other Arc
+// programs can import by name.
+type Library struct {
+	// Key is the unique identifier for this library.
+	Key Key `json:"key" msgpack:"key"`
+	// Name is the module name that Arc programs use to import the library. Must be a valid
+	// Arc identifier.
+	Name string `json:"name" msgpack:"name"`
+	// Version is incremented each time the source of the library changes, starting at 1
+	// when the library is created.
+	Version uint32 `json:"version" msgpack:"version"`
+	// Text is the Arc source code of the library.
+	Text text.Text `json:"text" msgpack:"text"`
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package library
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/synnaxlabs/arc/stl"
+	"github.com/synnaxlabs/arc/text"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	arcstatus "github.com/synnaxlabs/synnax/pkg/service/arc/status"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// Writer is used to create, update, and delete libraries within Synnax. The writer
+// executes all operations within the transaction provided to the Service.NewWriter
+// method. If no transaction is provided, the writer will execute operations directly on
+// the database.
+type Writer struct {
+	tx    gorp.Tx
+	otg   ontology.Writer
+	table *gorp.Table[Key, Library]
+}
+
+// Create creates or updates the given library. If the library does not have a key, a
+// new key will be generated. The version of a new library is 1, and the version of an
+// existing library is incremented whenever its source changes. The version that the
+// library is stored with is written back to l.
+func (w Writer) Create(ctx context.Context, l *Library) error {
+	if err := w.validate(ctx, *l); err != nil {
+		return err
+	}
+	l.Text.AST = nil
+	if l.Key == uuid.Nil {
+		l.Key = uuid.New()
+	}
+	var existing Library
+	err := w.table.NewRetrieve().
+		Where(gorp.MatchKeys[Key, Library](l.Key)).
+		Entry(&existing).
+		Exec(ctx, w.tx)
+	if err != nil && !errors.Is(err, query.ErrNotFound) {
+		return err
+	}
+	exists := err == nil
+	switch {
+	case !exists:
+		l.Version = 1
+	case existing.Text.Raw != l.Text.Raw:
+		l.Version = existing.Version + 1
+	default:
+		l.Version = existing.Version
+	}
+	if err = w.table.NewCreate().Entry(l).Exec(ctx, w.tx); err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	return w.otg.DefineResource(ctx, OntologyID(l.Key))
+}
+
+// Delete deletes the libraries with the given keys. Delete is idempotent.
+func (w Writer) Delete(ctx context.Context, keys ...Key) error {
+	if err := w.table.NewDelete().
+		Where(gorp.MatchKeys[Key, Library](keys...)).
+		Exec(ctx, w.tx); err != nil && !errors.Is(err, query.ErrNotFound) {
+		return err
+	}
+	for _, key := range keys {
+		if err := w.otg.DeleteResource(ctx, OntologyID(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reservedNames are the names of the modules that are always available to Arc
+// programs. A library cannot use one of these names, as it would shadow the module.
+var reservedNames = func() []string {
+	var names []string
+	for _, sym := range slices.Concat(stl.NewSymbols(), arcstatus.NewSymbols()) {
+		names = append(names, sym.Name)
+	}
+	return names
+}()
+
+func (w Writer) validate(ctx context.Context, l Library) error {
+	v := validate.New("arc.library")
+	if validate.NotEmptyString(v, "name", l.Name) {
+		return v.Error()
+	}
+	_, diag := text.Parse(text.Text{Raw: "import " + l.Name})
+	if v.Ternaryf("name", diag != nil, "%q is not a valid Arc identifier", l.Name) {
+		return v.Error()
+	}
+	if v.Ternaryf(
+		"name",
+		slices.Contains(reservedNames, l.Name),
+		"%q is the name of a built-in Arc module",
+		l.Name,
+	) {
+		return v.Error()
+	}
+	v.Exec(func() error {
+		exists, err := w.table.NewRetrieve().
+			Where(gorp.Match(func(_ gorp.Context, e *Library) (bool, error) {
+				return e.Name == l.Name && e.Key != l.Key, nil
+			})).
+			Exists(ctx, w.tx)
+		if err != nil || !exists {
+			return err
+		}
+		return validate.PathedError(
+			errors.Newf("a library named %q already exists", l.Name),
+			"name",
+		)
+	})
+	if _, diag = text.Parse(l.Text); diag != nil {
+		v.Exec(func() error { return validate.PathedError(diag, "text") })
+	}
+	return v.Error()
+}
@@ -0,0 +1,405 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package runtime
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/arc/runtime/node"
+	"github.com/synnaxlabs/arc/runtime/scheduler"
+	distchannel "github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
+	"github.com/synnaxlabs/synnax/pkg/service/arc"
+	"github.com/synnaxlabs/synnax/pkg/service/channel"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/override"
+	xstatus "github.com/synnaxlabs/x/status"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// BacktestConfig is the configuration for replaying an Arc program against
+// recorded data.
+type BacktestConfig struct {
+	alamos.Instrumentation
+	// Channel is used for retrieving channel information.
+	//
+	// [REQUIRED]
+	Channel *channel.Service
+	// Framer is used for reading the recorded telemetry.
+	//
+	// [REQUIRED]
+	Framer *framer.Service
+	// Program is the Arc to replay. Its Program must be compiled.
+	//
+	// [REQUIRED]
+	Program arc.Arc
+	// TimeRange is the recorded range to replay the program against. The
+	// simulated clock starts at TimeRange.Start, and timers keep firing until
+	// TimeRange.End even after the last recorded sample.
+	//
+	// [REQUIRED]
+	TimeRange telem.TimeRange
+	// ReadSpan is the span of recorded data read from the cluster at a time.
+	//
+	// [OPTIONAL] - Defaults to 10 seconds.
+	ReadSpan telem.TimeSpan
+}
+
+var (
+	_ config.Config[BacktestConfig] = BacktestConfig{}
+	// DefaultBacktestConfig is the default configuration for a backtest.
+	DefaultBacktestConfig = BacktestConfig{ReadSpan: 10 * telem.Second}
+)
+
+// Override implements config.Config.
+func (c BacktestConfig) Override(other BacktestConfig) BacktestConfig {
+	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
+	c.Channel = override.Nil(c.Channel, other.Channel)
+	c.Framer = override.Nil(c.Framer, other.Framer)
+	c.Program = override.If(c.Program, other.Program, other.Program.Program != nil)
+	c.TimeRange = override.Zero(c.TimeRange, other.TimeRange)
+	c.ReadSpan = override.Numeric(c.ReadSpan, other.ReadSpan)
+	return c
+}
+
+// Validate implements config.Config.
+func (c BacktestConfig) Validate() error {
+	v := validate.New("arc.runtime.backtest")
+	validate.NotNil(v, "channel", c.Channel)
+	validate.NotNil(v, "framer", c.Framer)
+	validate.NotNil(v, "program", c.Program.Program)
+	v.Ternary("time_range", c.TimeRange.Span() <= 0, "must have a positive span")
+	validate.Positive(v, "read_span", c.ReadSpan)
+	return v.Error()
+}
+
+// StageEntry records a stage of a sequence being entered during a backtest.
+type StageEntry struct {
+	// Sequence is the key of the sequence that owns the stage.
+	Sequence string
+	// Stage is the key of the stage that was entered.
+	Stage string
+	// Time is the simulated time at which the stage was entered.
+	Time telem.TimeStamp
+}
+
+// StatusSet records a call to status.set during a backtest. Statuses are
+// captured instead of being written to the cluster.
+type StatusSet struct {
+	KeyOrName string
+	Message   string
+	Variant   xstatus.Variant
+	Time      telem.TimeStamp
+}
+
+// BacktestError records an error raised while replaying a program.
+type BacktestError struct {
+	// Node is the key of the node that raised the error.
+	Node  string
+	Error error
+	Time  telem.TimeStamp
+}
+
+// BacktestResult is the outcome of replaying a program against a recorded
+// range.
+type BacktestResult struct {
+	// Stages holds every stage entered during the replay, in order. Stages
+	// that are active when the program starts are recorded at the start of
+	// the range.
+	Stages []StageEntry
+	// Writes holds the channel writes made by the program, including the
+	// simulated timestamps written to index channels. Nothing is written to
+	// the cluster.
+	Writes frame.Frame
+	// Statuses holds the status.set calls made by the program.
+	Statuses []StatusSet
+	// Errors holds the runtime errors raised by the program's nodes.
+	Errors []BacktestError
+}
+
+// Backtest replays an Arc program against the data recorded over a time range
+// instead of a live stream. The program reads a simulated clock that follows
+// the timestamps of the recorded samples, so intervals, waits, and reads of
+// the current time behave as they would have during the recording. Control
+// writes and status updates are captured in the returned result rather than
+// sent to the cluster.
+func Backtest(ctx context.Context, cfgs ...BacktestConfig) (res BacktestResult, err error) {
+	cfg, err := config.New(DefaultBacktestConfig, cfgs...)
+	if err != nil {
+		return res, err
+	}
+	stateCfg, err := NewStateConfig(ctx, cfg.Channel.Service, *cfg.Program.Program)
+	if err != nil {
+		return res, err
+	}
+	res.Writes = frame.Alloc(0)
+	var (
+		elapsed telem.TimeSpan
+		now     = func() telem.TimeStamp { return cfg.TimeRange.Start.Add(elapsed) }
+	)
+	drt, baseInterval, closers, err := openProgram(ctx, programConfig{
+		prog:     cfg.Program,
+		stateCfg: stateCfg,
+		status: statusRecorder(func(keyOrName, message string, variant xstatus.Variant) {
+			res.Statuses = append(res.Statuses, StatusSet{
+				KeyOrName: keyOrName,
+				Message:   message,
+				Variant:   variant,
+				Time:      now(),
+			})
+		}),
+		reporter: func(_ context.Context, _ xstatus.Variant, message string) {
+			res.Errors = append(res.Errors, BacktestError{
+				Error: errors.New(message),
+				Time:  now(),
+			})
+		},
+		now: now,
+	})
+	if err != nil {
+		return BacktestResult{}, err
+	}
+	defer func() {
+		if closeErr := closers.Close(); closeErr != nil {
+			res, err = BacktestResult{}, errors.Join(err, closeErr)
+		}
+	}()
+
+	for _, stage := range drt.scheduler.ActiveStages() {
+		res.Stages = append(res.Stages, StageEntry{
+			Sequence: stage.Scope,
+			Stage:    stage.Step,
+			Time:     now(),
+		})
+	}
+	drt.scheduler.SetStageHandler(scheduler.StageHandlerFunc(
+		func(_ context.Context, stage scheduler.Stage) {
+			res.Stages = append(res.Stages, StageEntry{
+				Sequence: stage.Scope,
+				Stage:    stage.Step,
+				Time:     now(),
+			})
+		},
+	))
+	drt.scheduler.SetErrorHandler(scheduler.ErrorHandlerFunc(
+		func(_ context.Context, nodeKey string, err error) {
+			res.Errors = append(res.Errors, BacktestError{
+				Node:  nodeKey,
+				Error: err,
+				Time:  now(),
+			})
+		},
+	))
+
+	var (
+		hasIntervals = baseInterval != telem.TimeSpanMax
+		lastTick     = telem.TimeSpan(-1)
+		run          = func(fr telem.Frame[uint32], reason node.RunReason) {
+			writes, changed := drt.cycle(ctx, fr, elapsed, reason)
+			// Authority changes only matter to a live writer.
+			drt.state.authority.Flush()
+			if changed {
+				res.Writes = res.Writes.Extend(frame.NewFromStorage(writes))
+			}
+		}
+		// fireTimers ticks the program at every timer deadline up to and
+		// including target.
+		fireTimers = func(target telem.TimeSpan) {
+			if !hasIntervals {
+				return
+			}
+			for {
+				deadline := max(drt.scheduler.NextDeadline(), elapsed)
+				if deadline > target || deadline == lastTick {
+					return
+				}
+				elapsed, lastTick = deadline, deadline
+				run(telem.Frame[uint32]{}, node.ReasonTimerTick)
+			}
+		}
+	)
+	if hasIntervals {
+		// Mirror a live task, which ticks once on start so that timer nodes
+		// seed their first deadline.
+		lastTick = 0
+		run(telem.Frame[uint32]{}, node.ReasonTimerTick)
+	}
+
+	if err = replay(ctx, cfg, stateCfg, func(ts telem.TimeStamp, fr telem.Frame[uint32]) {
+		target := cfg.TimeRange.Start.Span(ts)
+		fireTimers(target)
+		elapsed = target
+		run(fr, node.ReasonChannelInput)
+	}); err != nil {
+		return BacktestResult{}, err
+	}
+	fireTimers(cfg.TimeRange.Span() - 1)
+	return res, nil
+}
+
+// recordedSample is a single recorded sample of a channel, paired with the
+// timestamp read from the channel's index.
+type recordedSample struct {
+	key       distchannel.Key
+	index     distchannel.Key
+	time      telem.TimeStamp
+	alignment telem.Alignment
+	series    telem.Series
+}
+
+// indexSample identifies a sample of an index channel within a replayed frame.
+type indexSample struct {
+	key       distchannel.Key
+	alignment telem.Alignment
+}
+
+// replay reads the data recorded for the program's indexed input channels and
+// calls onFrame once per distinct timestamp, in time order. Each frame holds
+// the samples recorded at that timestamp along with the matching index
+// samples, aligned the same way a live stream would deliver them.
+func replay(
+	ctx context.Context,
+	cfg BacktestConfig,
+	stateCfg ExtendedStateConfig,
+	onFrame func(telem.TimeStamp, telem.Frame[uint32]),
+) error {
+	indexes := make(map[distchannel.Key]distchannel.Key, len(stateCfg.ChannelDigests))
+	for _, d := range stateCfg.ChannelDigests {
+		indexes[distchannel.Key(d.Key)] = distchannel.Key(d.Index)
+	}
+	var keys distchannel.Keys
+	for key := range stateCfg.Reads {
+		// Virtual channels have no recorded data to replay.
+		if indexes[key] != 0 {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	iter, err := cfg.Framer.OpenIterator(ctx, framer.IteratorConfig{
+		Keys:   keys,
+		Bounds: cfg.TimeRange,
+	})
+	if err != nil {
+		return err
+	}
+	for start := cfg.TimeRange.Start; start < cfg.TimeRange.End; start = start.Add(cfg.ReadSpan) {
+		window := telem.TimeRange{
+			Start: start,
+			End:   min(start.Add(cfg.ReadSpan), cfg.TimeRange.End),
+		}
+		iter.SetBounds(window)
+		if !iter.SeekFirst() || !iter.Next(window.Span()) {
+			continue
+		}
+		samples := splitSamples(iter.Value(), indexes)
+		for i := 0; i < len(samples); {
+			var (
+				ts      = samples[i].time
+				fr      telem.Frame[uint32]
+				written = make(map[indexSample]struct{})
+			)
+			for ; i < len(samples) && samples[i].time == ts; i++ {
+				var (
+					s          = samples[i]
+					idx        = indexSample{key: s.index, alignment: s.alignment}
+					_, indexed = written[idx]
+				)
+				if s.key == s.index {
+					// The program reads the index channel directly.
+					if !indexed {
+						written[idx] = struct{}{}
+						fr = fr.Append(uint32(s.key), s.series)
+					}
+					continue
+				}
+				fr = fr.Append(uint32(s.key), s.series)
+				if indexed {
+					continue
+				}
+				written[idx] = struct{}{}
+				indexSeries := telem.NewSeriesV(ts)
+				indexSeries.Alignment = s.alignment
+				fr = fr.Append(uint32(s.index), indexSeries)
+			}
+			onFrame(ts, fr)
+		}
+	}
+	return errors.Join(iter.Error(), iter.Close())
+}
+
+// splitSamples breaks the series in fr into single-sample series, each paired
+// with its timestamp from the index series that shares its alignment. The
+// returned samples are sorted by time.
+func splitSamples(
+	fr frame.Frame,
+	indexes map[distchannel.Key]distchannel.Key,
+) []recordedSample {
+	var samples []recordedSample
+	for key, data := range fr.Entries() {
+		index := indexes[key]
+		indexData := fr.Get(index)
+		dataBounds := data.AlignmentBounds()
+		i := slices.IndexFunc(indexData.Series, func(s telem.Series) bool {
+			b := s.AlignmentBounds()
+			return b.Lower <= dataBounds.Lower && dataBounds.Upper <= b.Upper
+		})
+		if i == -1 {
+			continue
+		}
+		indexSeries := indexData.Series[i]
+		offset := int(data.Alignment - indexSeries.Alignment)
+		j := 0
+		for sample := range data.Samples() {
+			if data.DataType.IsVariable() {
+				sample = telem.MarshalVariableSample(sample)
+			}
+			alignment := data.Alignment.AddSamples(uint32(j))
+			samples = append(samples, recordedSample{
+				key:       key,
+				index:     index,
+				time:      telem.ValueAt[telem.TimeStamp](indexSeries, offset+j),
+				alignment: alignment,
+				series: telem.Series{
+					DataType:  data.DataType,
+					Data:      slices.Clone(sample),
+					Alignment: alignment,
+				},
+			})
+			j++
+		}
+	}
+	slices.SortStableFunc(samples, func(a, b recordedSample) int {
+		return cmp.Or(cmp.Compare(a.time, b.time), cmp.Compare(a.alignment, b.alignment))
+	})
+	return samples
+}
+
+// statusRecorder captures status.set calls made while a program runs on a
+// simulated clock, in place of writing them to the cluster.
+type statusRecorder func(keyOrName, message string, variant xstatus.Variant)
+
+func (r statusRecorder) SetByKeyOrName(
+	_ context.Context,
+	keyOrName, message, variant string,
+) (string, bool, error) {
+	if !xstatus.Variant(variant).IsValid() {
+		return "", false, errors.Wrap(validate.ErrValidation, "invalid status variant")
+	}
+	r(keyOrName, message, xstatus.Variant(variant))
+	return keyOrName, false, nil
+}
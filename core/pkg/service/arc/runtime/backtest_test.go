@@ -0,0 +1,403 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	svcarc "github.com/synnaxlabs/synnax/pkg/service/arc"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/runtime"
+	arcstatus "github.com/synnaxlabs/synnax/pkg/service/arc/status"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/symbol"
+	svcchannel "github.com/synnaxlabs/synnax/pkg/service/channel"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Backtest", Ordered, func() {
+	var dist mock.Node
+
+	BeforeAll(func(ctx SpecContext) {
+		distB := DeferClose(mock.NewCluster())
+		dist = DeferClose(distB.Provision(ctx))
+	})
+
+	compile := func(ctx context.Context, raw string) svcarc.Arc {
+		resolver := symbol.NewChannelResolver(dist.Channel, nil)
+		root := arc.NewRoot(resolver, arcstatus.NewSymbols()...)
+		prog := MustSucceed(arc.CompileText(ctx, arc.Text{Raw: raw}, root))
+		return svcarc.Arc{Key: uuid.New(), Name: "backtest", Program: &prog}
+	}
+
+	createIndexed := func(ctx context.Context, prefix string, dataType telem.DataType) (idx, data *channel.Channel) {
+		idx = &channel.Channel{
+			Name:     prefix + "_idx_" + uuid.NewString()[:8],
+			IsIndex:  true,
+			DataType: telem.TimeStampT,
+		}
+		Expect(dist.Channel.Create(ctx, idx)).To(Succeed())
+		data = &channel.Channel{
+			Name:       prefix + "_" + uuid.NewString()[:8],
+			LocalIndex: idx.LocalKey,
+			DataType:   dataType,
+		}
+		Expect(dist.Channel.Create(ctx, data)).To(Succeed())
+		return idx, data
+	}
+
+	record := func(
+		ctx context.Context,
+		idx, data *channel.Channel,
+		stamps []telem.TimeStamp,
+		values telem.Series,
+	) {
+		w := MustSucceed(dist.Framer.OpenWriter(ctx, framer.WriterConfig{
+			Keys:  channel.Keys{idx.Key(), data.Key()},
+			Start: stamps[0],
+		}))
+		Expect(w.Write(frame.NewMulti(
+			channel.Keys{idx.Key(), data.Key()},
+			[]telem.Series{telem.NewSeriesV(stamps...), values},
+		))).To(BeTrue())
+		MustSucceed(w.Commit())
+		Expect(w.Close()).To(Succeed())
+	}
+
+	backtest := func(ctx context.Context, prog svcarc.Arc, tr telem.TimeRange, readSpan telem.TimeSpan) runtime.BacktestResult {
+		return MustSucceed(runtime.Backtest(ctx, runtime.BacktestConfig{
+			Channel:   svcchannel.Wrap(dist.Channel),
+			Framer:    dist.Framer,
+			Program:   prog,
+			TimeRange: tr,
+			ReadSpan:  readSpan,
+		}))
+	}
+
+	It("Should report the stages an abort sequence enters and capture its writes", func(ctx SpecContext) {
+		pressureIdx, pressure := createIndexed(ctx, "pressure", telem.Float32T)
+		_, vent := createIndexed(ctx, "vent_cmd", telem.Uint8T)
+		start := 100 * telem.SecondTS
+		stamps := make([]telem.TimeStamp, 10)
+		for i := range stamps {
+			stamps[i] = start.Add(telem.TimeSpan(i+1) * telem.Second)
+		}
+		record(ctx, pressureIdx, pressure, stamps, telem.NewSeriesV[float32](
+			30, 60, 90, 120, 90, 60, 30, 30, 30, 30,
+		))
+		prog := compile(ctx, fmt.Sprintf(`
+			sequence abort {
+				stage armed {
+					%[1]s > 100 => vent
+				}
+				stage vent {
+					1 -> %[2]s,
+					wait{duration=2s} => safe
+				}
+				stage safe {
+					0 -> %[2]s
+				}
+			}
+			%[1]s => abort
+		`, pressure.Name, vent.Name))
+
+		res := backtest(ctx, prog, start.SpanRange(20*telem.Second), 3*telem.Second)
+
+		Expect(res.Errors).To(BeEmpty())
+		Expect(res.Stages).To(Equal([]runtime.StageEntry{
+			{Sequence: "abort", Stage: "armed", Time: start.Add(1 * telem.Second)},
+			{Sequence: "abort", Stage: "vent", Time: start.Add(4 * telem.Second)},
+			{Sequence: "abort", Stage: "safe", Time: start.Add(6 * telem.Second)},
+		}))
+		written := res.Writes.Get(vent.Key())
+		Expect(written.Series).To(HaveLen(2))
+		Expect(telem.UnmarshalSeries[uint8](written.Series[0])).To(Equal([]uint8{1}))
+		Expect(telem.UnmarshalSeries[uint8](written.Series[1])).To(Equal([]uint8{0}))
+		ventIdx := res.Writes.Get(vent.Index())
+		Expect(ventIdx.Series).To(HaveLen(2))
+		Expect(telem.UnmarshalSeries[telem.TimeStamp](ventIdx.Series[0])).
+			To(Equal([]telem.TimeStamp{start.Add(4 * telem.Second)}))
+		Expect(telem.UnmarshalSeries[telem.TimeStamp](ventIdx.Series[1])).
+			To(Equal([]telem.TimeStamp{start.Add(6 * telem.Second)}))
+	})
+
+	It("Should fire intervals on the simulated clock when there is no recorded data", func(ctx SpecContext) {
+		_, out := createIndexed(ctx, "tick", telem.Uint8T)
+		prog := compile(ctx, fmt.Sprintf(`
+			func tick() {
+				%s = 1
+			}
+			interval{period=1s} -> tick{}
+		`, out.Name))
+		start := 500 * telem.SecondTS
+
+		res := backtest(ctx, prog, start.SpanRange(5*telem.Second), 0)
+
+		Expect(res.Errors).To(BeEmpty())
+		idx := res.Writes.Get(out.Index())
+		var stamps []telem.TimeStamp
+		for _, s := range idx.Series {
+			stamps = append(stamps, telem.UnmarshalSeries[telem.TimeStamp](s)...)
+		}
+		Expect(stamps).To(Equal([]telem.TimeStamp{
+			start,
+			start.Add(1 * telem.Second),
+			start.Add(2 * telem.Second),
+			start.Add(3 * telem.Second),
+			start.Add(4 * telem.Second),
+		}))
+	})
+
+	It("Should capture status updates instead of writing them to the cluster", func(ctx SpecContext) {
+		idx, pressure := createIndexed(ctx, "status_pressure", telem.Float32T)
+		start := 900 * telem.SecondTS
+		record(ctx, idx, pressure, []telem.TimeStamp{
+			start.Add(1 * telem.Second),
+			start.Add(2 * telem.Second),
+		}, telem.NewSeriesV[float32](10, 200))
+		prog := compile(ctx, fmt.Sprintf(`
+			import status
+			%s > 100 -> status.set{key_or_name="ox_alarm", message="Overpressure", variant="error"}
+		`, pressure.Name))
+
+		res := backtest(ctx, prog, start.SpanRange(5*telem.Second), 0)
+
+		Expect(res.Errors).To(BeEmpty())
+		Expect(res.Statuses).ToNot(BeEmpty())
+		last := res.Statuses[len(res.Statuses)-1]
+		Expect(last.KeyOrName).To(Equal("ox_alarm"))
+		Expect(last.Message).To(Equal("Overpressure"))
+		Expect(last.Variant).To(BeEquivalentTo("error"))
+		Expect(last.Time).To(Equal(start.Add(2 * telem.Second)))
+	})
+
+	Describe("Safety Envelopes", func() {
+		written := func(res runtime.BacktestResult, key channel.Key) []float32 {
+			var values []float32
+			for _, s := range res.Writes.Get(key).Series {
+				values = append(values, telem.UnmarshalSeries[float32](s)...)
+			}
+			return values
+		}
+
+		It("Should clamp writes to the bounds of an envelope and report the violation", func(ctx SpecContext) {
+			idx, pressure := createIndexed(ctx, "clamp_pressure", telem.Float32T)
+			_, cmd := createIndexed(ctx, "clamp_cmd", telem.Float32T)
+			start := 1200 * telem.SecondTS
+			record(ctx, idx, pressure, []telem.TimeStamp{
+				start.Add(1 * telem.Second),
+				start.Add(2 * telem.Second),
+				start.Add(3 * telem.Second),
+			}, telem.NewSeriesV[float32](30, 60, 90))
+			prog := compile(ctx, fmt.Sprintf(`
+				safety %[2]s{min=0, max=50}
+				%[1]s -> %[2]s
+			`, pressure.Name, cmd.Name))
+
+			res := backtest(ctx, prog, start.SpanRange(5*telem.Second), 0)
+
+			Expect(res.Errors).To(BeEmpty())
+			Expect(written(res, cmd.Key())).To(Equal([]float32{30, 50, 50}))
+			Expect(res.Statuses).To(HaveLen(1))
+			Expect(res.Statuses[0].KeyOrName).To(Equal(cmd.Name + " safety"))
+			Expect(res.Statuses[0].Message).To(Equal(
+				fmt.Sprintf("Write to %s 60 clamped to 50", cmd.Name),
+			))
+			Expect(res.Statuses[0].Variant).To(BeEquivalentTo("warning"))
+		})
+
+		It("Should limit the rate of change of writes", func(ctx SpecContext) {
+			idx, pressure := createIndexed(ctx, "rate_pressure", telem.Float32T)
+			_, cmd := createIndexed(ctx, "rate_cmd", telem.Float32T)
+			start := 1300 * telem.SecondTS
+			record(ctx, idx, pressure, []telem.TimeStamp{
+				start.Add(1 * telem.Second),
+				start.Add(2 * telem.Second),
+				start.Add(3 * telem.Second),
+				start.Add(4 * telem.Second),
+			}, telem.NewSeriesV[float32](0, 50, 55, 25))
+			prog := compile(ctx, fmt.Sprintf(`
+				safety %[2]s{max_rate=10}
+				%[1]s -> %[2]s
+			`, pressure.Name, cmd.Name))
+
+			res := backtest(ctx, prog, start.SpanRange(5*telem.Second), 0)
+
+			Expect(res.Errors).To(BeEmpty())
+			Expect(written(res, cmd.Key())).To(Equal([]float32{0, 10, 20, 25}))
+			Expect(res.Statuses).To(HaveLen(1))
+			Expect(res.Statuses[0].Message).To(ContainSubstring("rate limited to 10"))
+		})
+
+		It("Should hold a channel at its safe value while an interlock is engaged", func(ctx SpecContext) {
+			idx, pressure := createIndexed(ctx, "interlock_pressure", telem.Float32T)
+			_, cmd := createIndexed(ctx, "interlock_cmd", telem.Float32T)
+			start := 1400 * telem.SecondTS
+			record(ctx, idx, pressure, []telem.TimeStamp{
+				start.Add(1 * telem.Second),
+				start.Add(2 * telem.Second),
+				start.Add(3 * telem.Second),
+			}, telem.NewSeriesV[float32](50, 150, 60))
+			prog := compile(ctx, fmt.Sprintf(`
+				safety %[2]s{interlock=%[1]s > 100, safe_value=5}
+				%[1]s -> %[2]s
+			`, pressure.Name, cmd.Name))
+
+			res := backtest(ctx, prog, start.SpanRange(5*telem.Second), 0)
+
+			Expect(res.Errors).To(BeEmpty())
+			Expect(written(res, cmd.Key())).To(Equal([]float32{50, 5, 60}))
+			Expect(res.Statuses).To(HaveLen(1))
+			Expect(res.Statuses[0].Message).To(Equal(fmt.Sprintf(
+				"Write to %s held at safe value 5 while %s > 100",
+				cmd.Name, pressure.Name,
+			)))
+		})
+
+		It("Should hold a channel at its safe value while an interlock channel has no value", func(ctx SpecContext) {
+			idx, pressure := createIndexed(ctx, "unobserved_pressure", telem.Float32T)
+			_, other := createIndexed(ctx, "unobserved_other", telem.Float32T)
+			_, cmd := createIndexed(ctx, "unobserved_cmd", telem.Float32T)
+			start := 1450 * telem.SecondTS
+			record(ctx, idx, pressure, []telem.TimeStamp{
+				start.Add(1 * telem.Second),
+				start.Add(2 * telem.Second),
+			}, telem.NewSeriesV[float32](50, 60))
+			prog := compile(ctx, fmt.Sprintf(`
+				safety %[2]s{interlock=%[3]s > 100, safe_value=5}
+				%[1]s -> %[2]s
+			`, pressure.Name, cmd.Name, other.Name))
+
+			res := backtest(ctx, prog, start.SpanRange(5*telem.Second), 0)
+
+			Expect(res.Errors).To(BeEmpty())
+			Expect(written(res, cmd.Key())).To(Equal([]float32{5, 5}))
+			Expect(res.Statuses).To(HaveLen(1))
+			Expect(res.Statuses[0].Message).To(Equal(fmt.Sprintf(
+				"Write to %s held at safe value 5 while %s has no value",
+				cmd.Name, other.Name,
+			)))
+		})
+
+		It("Should replace non-finite writes with the safe value", func(ctx SpecContext) {
+			idx, pressure := createIndexed(ctx, "nan_pressure", telem.Float32T)
+			_, cmd := createIndexed(ctx, "nan_cmd", telem.Float32T)
+			start := 1475 * telem.SecondTS
+			nan := float32(math.NaN())
+			record(ctx, idx, pressure, []telem.TimeStamp{
+				start.Add(1 * telem.Second),
+				start.Add(2 * telem.Second),
+				start.Add(3 * telem.Second),
+				start.Add(4 * telem.Second),
+			}, telem.NewSeriesV(0, nan, 8, float32(math.Inf(1))))
+			prog := compile(ctx, fmt.Sprintf(`
+				safety %[2]s{max=50, max_rate=10, safe_value=2}
+				%[1]s -> %[2]s
+			`, pressure.Name, cmd.Name))
+
+			res := backtest(ctx, prog, start.SpanRange(5*telem.Second), 0)
+
+			Expect(res.Errors).To(BeEmpty())
+			Expect(written(res, cmd.Key())).To(Equal([]float32{0, 2, 8, 2}))
+			Expect(res.Statuses).ToNot(BeEmpty())
+			Expect(res.Statuses[0].Message).To(Equal(
+				fmt.Sprintf("Write to %s NaN replaced with safe value 2", cmd.Name),
+			))
+		})
+
+		It("Should enter the safe stage of a violated envelope", func(ctx SpecContext) {
+			idx, pressure := createIndexed(ctx, "stage_pressure", telem.Float32T)
+			_, cmd := createIndexed(ctx, "stage_cmd", telem.Float32T)
+			_, vent := createIndexed(ctx, "stage_vent", telem.Uint8T)
+			start := 1500 * telem.SecondTS
+			record(ctx, idx, pressure, []telem.TimeStamp{
+				start.Add(1 * telem.Second),
+				start.Add(2 * telem.Second),
+				start.Add(3 * telem.Second),
+			}, telem.NewSeriesV[float32](50, 150, 50))
+			prog := compile(ctx, fmt.Sprintf(`
+				safety %[2]s{max=100, safe_stage=main.abort}
+				sequence main {
+					stage run {
+						%[1]s -> %[2]s
+					}
+					stage abort {
+						1 -> %[3]s
+					}
+				}
+				%[1]s => main
+			`, pressure.Name, cmd.Name, vent.Name))
+
+			res := backtest(ctx, prog, start.SpanRange(5*telem.Second), 0)
+
+			Expect(res.Errors).To(BeEmpty())
+			Expect(res.Stages).To(ContainElement(runtime.StageEntry{
+				Sequence: "main",
+				Stage:    "abort",
+				Time:     start.Add(2 * telem.Second),
+			}))
+			Expect(written(res, cmd.Key())).To(Equal([]float32{100}))
+			ventWrites := res.Writes.Get(vent.Key())
+			Expect(ventWrites.Series).ToNot(BeEmpty())
+			Expect(telem.UnmarshalSeries[uint8](ventWrites.Series[0])).To(Equal([]uint8{1}))
+		})
+
+		It("Should enforce an envelope configured on the channel", func(ctx SpecContext) {
+			idx, pressure := createIndexed(ctx, "channel_pressure", telem.Float32T)
+			cmdIdx := &channel.Channel{
+				Name:     "channel_cmd_idx_" + uuid.NewString()[:8],
+				IsIndex:  true,
+				DataType: telem.TimeStampT,
+			}
+			Expect(dist.Channel.Create(ctx, cmdIdx)).To(Succeed())
+			maxValue := 40.0
+			cmd := &channel.Channel{
+				Name:       "channel_cmd_" + uuid.NewString()[:8],
+				LocalIndex: cmdIdx.LocalKey,
+				DataType:   telem.Float32T,
+				Safety:     channel.Safety{Max: &maxValue},
+			}
+			Expect(dist.Channel.Create(ctx, cmd)).To(Succeed())
+			start := 1600 * telem.SecondTS
+			record(ctx, idx, pressure, []telem.TimeStamp{
+				start.Add(1 * telem.Second),
+				start.Add(2 * telem.Second),
+			}, telem.NewSeriesV[float32](30, 60))
+			prog := compile(ctx, fmt.Sprintf(`%s -> %s`, pressure.Name, cmd.Name))
+
+			res := backtest(ctx, prog, start.SpanRange(5*telem.Second), 0)
+
+			Expect(res.Errors).To(BeEmpty())
+			Expect(written(res, cmd.Key())).To(Equal([]float32{30, 40}))
+		})
+	})
+
+	It("Should return a validation error for an invalid config", func(ctx SpecContext) {
+		Expect(runtime.Backtest(ctx, runtime.BacktestConfig{
+			Channel:   svcchannel.Wrap(dist.Channel),
+			Framer:    dist.Framer,
+			TimeRange: telem.TimeRangeMax,
+		})).Error().To(MatchError(ContainSubstring("program: must be non-nil")))
+		Expect(runtime.Backtest(ctx, runtime.BacktestConfig{
+			Channel: svcchannel.Wrap(dist.Channel),
+			Framer:  dist.Framer,
+			Program: svcarc.Arc{Program: &arc.Program{}},
+		})).Error().To(MatchError(ContainSubstring("time_range: must have a positive span")))
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/service/status"
 	"github.com/synnaxlabs/synnax/pkg/service/task"
 	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/gorp"
 	"github.com/synnaxlabs/x/override"
 	xstatus "github.com/synnaxlabs/x/status"
 	"github.com/synnaxlabs/x/telem"
@@ -37,6 +38,15 @@ type TaskConfig struct {
 	ArcKey arc.Key `json:"arc_key"`
 	// AutoStart sets whether the taskImpl should start automatically when configured.
 	AutoStart bool `json:"auto_start"`
+	// PersistState sets whether the values of the program's stateful variables and
+	// the active stages of its sequences are snapshotted while the task runs and
+	// restored when it starts again, including after the Core restarts.
+	PersistState bool `json:"persist_state"`
+	// SnapshotInterval is the minimum time between snapshots of a task that
+	// persists its state. Defaults to DefaultSnapshotInterval.
+	SnapshotInterval telem.TimeSpan `json:"snapshot_interval"`
+	// Trace configures live execution tracing of the task.
+	Trace TraceConfig `json:"trace"`
 }
 
 // GetProgramFunc retrieves an Arc with its compiled Program by key.
@@ -60,6 +70,10 @@ type FactoryConfig struct {
 	//
 	// [REQUIRED]
 	GetProgram GetProgramFunc
+	// DB is where tasks that persist their state store their snapshots.
+	//
+	// [OPTIONAL] - Tasks that persist their state fail to start without it.
+	DB *gorp.DB
 	alamos.Instrumentation
 }
 
@@ -74,6 +88,7 @@ func (c FactoryConfig) Override(other FactoryConfig) FactoryConfig {
 	c.Framer = override.Nil(c.Framer, other.Framer)
 	c.Status = override.Nil(c.Status, other.Status)
 	c.GetProgram = override.Nil(c.GetProgram, other.GetProgram)
+	c.DB = override.Nil(c.DB, other.DB)
 	return c
 }
 
@@ -88,7 +103,10 @@ func (c FactoryConfig) Validate() error {
 
 type factory struct{ cfg FactoryConfig }
 
-var _ driver.Factory = (*factory)(nil)
+var (
+	_ driver.Factory = (*factory)(nil)
+	_ driver.Deleter = (*factory)(nil)
+)
 
 // NewFactory creates a new Arc factory.
 func NewFactory(cfgs ...FactoryConfig) (driver.Factory, error) {
@@ -163,4 +181,12 @@ func (f *factory) setConfigStatus(
 	}
 }
 
+// DeleteTask implements driver.Deleter, deleting the persisted state of the task.
+func (f *factory) DeleteTask(ctx context.Context, key task.Key) error {
+	if f.cfg.DB == nil {
+		return nil
+	}
+	return deleteSnapshot(ctx, f.cfg.DB, key)
+}
+
 func (f *factory) Name() string { return "arc" }
@@ -0,0 +1,129 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/arc/runtime/scheduler"
+	"github.com/synnaxlabs/arc/stl/stateful"
+	"github.com/synnaxlabs/synnax/pkg/service/task"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/telem"
+	"go.uber.org/zap"
+)
+
+// DefaultSnapshotInterval is the minimum time between snapshots of a task that
+// persists its state and does not configure its own interval.
+const DefaultSnapshotInterval = 5 * telem.Second
+
+// Snapshot is the persisted state of an Arc task: the values of its stateful
+// variables and the activation state of its sequences. Snapshot is exported so that
+// gorp's type-name-derived key prefix remains stable across releases, and should
+// otherwise be treated as an internal type.
+type Snapshot struct {
+	// Task is the key of the task the snapshot was captured from.
+	Task task.Key `json:"task" msgpack:"task"`
+	// ProgramHash is the hash of the program the snapshot was captured from.
+	// Snapshots captured from a different program are discarded instead of being
+	// restored.
+	ProgramHash string `json:"program_hash" msgpack:"program_hash"`
+	// Time is when the snapshot was captured.
+	Time telem.TimeStamp `json:"time" msgpack:"time"`
+	// Scheduler is the activation state of the program's scopes.
+	Scheduler scheduler.Snapshot `json:"scheduler" msgpack:"scheduler"`
+	// Stateful holds the values of the program's stateful variables.
+	Stateful stateful.Snapshot `json:"stateful" msgpack:"stateful"`
+}
+
+var _ gorp.Entry[task.Key] = Snapshot{}
+
+// GorpKey implements gorp.Entry.
+func (s Snapshot) GorpKey() task.Key { return s.Task }
+
+// SetOptions implements gorp.Entry.
+func (Snapshot) SetOptions() []any { return nil }
+
+// persister snapshots the state of a running program to the cluster's gorp DB
+// and restores it when the program's task starts again.
+type persister struct {
+	alamos.Instrumentation
+	db        *gorp.DB
+	task      task.Key
+	hash      string
+	interval  telem.TimeSpan
+	scheduler *scheduler.Scheduler
+	stateful  *stateful.Host
+	// last is when the most recent snapshot was saved.
+	last telem.TimeStamp
+}
+
+// restore applies the task's most recent snapshot to the program, returning the
+// time the snapshot was captured and whether one was applied. A snapshot captured
+// from a different program is deleted instead.
+func (p *persister) restore(ctx context.Context) (telem.TimeStamp, bool, error) {
+	var snap Snapshot
+	if err := gorp.NewRetrieve[task.Key, Snapshot]().
+		Where(gorp.MatchKeys[task.Key, Snapshot](p.task)).
+		Entry(&snap).
+		Exec(ctx, p.db); err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	if snap.ProgramHash != p.hash {
+		return 0, false, deleteSnapshot(ctx, p.db, p.task)
+	}
+	// Restoring the scheduler resets the nodes of the scopes it activates, which
+	// clears their stateful variables, so it must happen first.
+	p.scheduler.Restore(snap.Scheduler)
+	p.stateful.Restore(snap.Stateful)
+	return snap.Time, true, nil
+}
+
+// maybeSave saves a snapshot if at least the snapshot interval has passed since the
+// previous one. A failed save is logged rather than stopping the program, and is
+// retried after the next interval.
+func (p *persister) maybeSave(ctx context.Context, now telem.TimeStamp) {
+	if p.last.Span(now) < p.interval {
+		return
+	}
+	if err := p.save(ctx, now); err != nil {
+		p.L.Warn("failed to snapshot arc task state",
+			zap.Uint64("task", uint64(p.task)),
+			zap.Error(err),
+		)
+	}
+}
+
+// save captures the program's state and saves it, replacing any previous snapshot
+// of the task.
+func (p *persister) save(ctx context.Context, now telem.TimeStamp) error {
+	p.last = now
+	snap := Snapshot{
+		Task:        p.task,
+		ProgramHash: p.hash,
+		Time:        now,
+		Scheduler:   p.scheduler.Snapshot(),
+		Stateful:    p.stateful.Snapshot(),
+	}
+	return gorp.NewCreate[task.Key, Snapshot]().Entry(&snap).Exec(ctx, p.db)
+}
+
+// deleteSnapshot deletes the snapshot of the task with the given key, if any.
+func deleteSnapshot(ctx context.Context, db *gorp.DB, key task.Key) error {
+	return gorp.NewDelete[task.Key, Snapshot]().
+		Where(gorp.MatchKeys[task.Key, Snapshot](key)).
+		Exec(ctx, db)
+}
@@ -0,0 +1,322 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/program"
+	"github.com/synnaxlabs/arc/runtime/scheduler"
+	"github.com/synnaxlabs/arc/symbol"
+	"github.com/synnaxlabs/arc/types"
+	distchannel "github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/writer"
+	"github.com/synnaxlabs/x/confluence"
+	"github.com/synnaxlabs/x/control"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/set"
+	"github.com/synnaxlabs/x/signal"
+	"github.com/synnaxlabs/x/status"
+	"github.com/synnaxlabs/x/telem"
+	"go.uber.org/zap"
+)
+
+// openPipeline describes the pipeline of a running task. A reloaded program runs
+// in the same pipeline, so its streamer and writer, along with the control
+// authorities the writer holds, stay open across the reload.
+type openPipeline struct {
+	// reloads hands reloaded programs to the runtime.
+	reloads chan *reload
+	// program releases the WASM runtime of the running program.
+	program io.Closer
+	// ticking is whether the runtime wakes on the deadlines of timers.
+	ticking bool
+	// reads are the channels streamed to the program.
+	reads set.Set[distchannel.Key]
+	// streamerRequests updates the channels streamed to the program. Nil when the
+	// task has no streamer.
+	streamerRequests confluence.Inlet[framer.StreamerRequest]
+	// writer is whether the task has a writer.
+	writer bool
+	// writeKeys are the program channels the writer was opened with.
+	writeKeys distchannel.Keys
+	// authorities are the static authorities the program holds over writeKeys,
+	// aligned with writeKeys.
+	authorities []control.Authority
+}
+
+// reload is a program prepared to replace the running program of a task.
+type reload struct {
+	scheduler *scheduler.Scheduler
+	state     state
+	guard     *guard
+	hash      string
+	// remap maps the IDs of the stateful variables of the running program to the
+	// IDs of their counterparts in the new program.
+	remap func(nodeKey string, id uint32) (uint32, bool)
+	// authority sets the static authorities of the new program on the writer.
+	// Empty when they are the same as those of the running program.
+	authority writer.Config
+	// swapped is closed once the runtime is running the new program.
+	swapped chan struct{}
+}
+
+// reload recompiles the task's program and swaps it into the running pipeline on
+// the next cycle boundary, carrying over the active stages of its sequences and
+// the values of stateful variables that are compatible between the two programs.
+// The streamer and writer stay open, so the task never releases control of the
+// channels it writes to. A program that cannot run in the open pipeline is
+// rejected and the running program is left untouched. Reloading a task that isn't
+// running replaces the program it starts with. A task paused at a breakpoint can't
+// swap programs until it is resumed, so reloading it is rejected.
+func (t *taskImpl) reload(ctx context.Context) (err error) {
+	running := t.isRunning()
+	prog, err := t.factoryCfg.GetProgram(ctx, t.cfg.ArcKey)
+	if err != nil {
+		t.setStatus(ctx, status.VariantError, running, err.Error())
+		return err
+	}
+	if !running {
+		t.prog = prog
+		t.setStatus(ctx, status.VariantSuccess, false, "Task reloaded successfully")
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			t.setStatus(ctx, status.VariantError, true, err.Error())
+		}
+	}()
+	stateCfg, err := NewStateConfig(ctx, t.factoryCfg.Channel.Service, *prog.Program)
+	if err != nil {
+		return err
+	}
+	authorities, err := t.pipeline.validateReload(stateCfg, prog.Program.Authorities)
+	if err != nil {
+		return err
+	}
+	drt, baseInterval, closers, err := openProgram(ctx, programConfig{
+		prog:     prog,
+		stateCfg: stateCfg,
+		status:   t.factoryCfg.Status,
+		reporter: t.reporter(),
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, closers.Close())
+		}
+	}()
+	if hasIntervals(baseInterval) && !t.pipeline.ticking {
+		return errors.New(
+			"arc.runtime: cannot reload a program that adds timers to a running " +
+				"program without them; restart the task instead",
+		)
+	}
+	drt.scheduler.SetErrorHandler(t.errorHandler())
+	rl := &reload{
+		scheduler: drt.scheduler,
+		state:     drt.state,
+		guard:     drt.guard,
+		hash:      prog.Program.Hash(),
+		remap:     statefulRemap(*t.prog.Program, *prog.Program),
+		swapped:   make(chan struct{}),
+	}
+	if !slices.Equal(authorities, t.pipeline.authorities) {
+		rl.authority = writer.Config{
+			Keys:        t.pipeline.writeKeys,
+			Authorities: authorities,
+		}
+	}
+	// The runtime doesn't receive reloads while paused at a breakpoint, and the resume
+	// command that would release it can't run until this one returns.
+	var paused <-chan struct{}
+	if t.tracer != nil {
+		paused = t.tracer.paused()
+	}
+	select {
+	case t.pipeline.reloads <- rl:
+	case <-paused:
+		return errReloadPaused
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	// The runtime swaps the program as soon as it receives it, so this never
+	// waits on a cycle.
+	<-rl.swapped
+	old := t.pipeline.program
+	t.pipeline.program = closers
+	t.pipeline.authorities = authorities
+	t.prog = prog
+	closers = nil
+	if closeErr := old.Close(); closeErr != nil {
+		t.factoryCfg.L.Warn("failed to close reloaded arc program",
+			zap.Uint64("task", uint64(t.task.Key)),
+			zap.Error(closeErr),
+		)
+	}
+	if !stateCfg.Reads.Equal(t.pipeline.reads) && t.pipeline.streamerRequests != nil {
+		req := framer.StreamerRequest{Keys: stateCfg.Reads.Slice()}
+		if err = signal.SendUnderContext(ctx, t.pipeline.streamerRequests.Inlet(), req); err != nil {
+			return err
+		}
+		t.pipeline.reads = stateCfg.Reads
+	}
+	t.setStatus(ctx, status.VariantSuccess, true, "Task reloaded successfully")
+	return nil
+}
+
+var errReloadPaused = errors.New(
+	"arc.runtime: cannot reload a task that is paused at a breakpoint; resume it first",
+)
+
+// validateReload checks that a program with the given state config and static
+// authorities can run in the pipeline, and returns the authorities it holds over
+// the pipeline's write channels.
+func (p openPipeline) validateReload(
+	stateCfg ExtendedStateConfig,
+	auth ir.Authorities,
+) ([]control.Authority, error) {
+	writeKeys := set.New(p.writeKeys...)
+	for key := range stateCfg.Writes {
+		if !writeKeys.Contains(key) {
+			return nil, errors.Newf(
+				"arc.runtime: cannot reload a program that writes to channel %s, "+
+					"which the running program does not write to; restart the task instead",
+				key,
+			)
+		}
+	}
+	if p.streamerRequests == nil && len(stateCfg.Reads) > 0 {
+		return nil, errors.New(
+			"arc.runtime: cannot reload a program that reads channels into a running " +
+				"program that reads none; restart the task instead",
+		)
+	}
+	if !p.writer {
+		return nil, nil
+	}
+	return writerAuthorities(auth, p.writeKeys), nil
+}
+
+// swap replaces the running program with the one prepared by rl, carrying over
+// the activation state of its sequences, the values of its compatible stateful
+// variables, and the last values written to its guarded channels.
+func (d *dataRuntime) swap(ctx context.Context, rl *reload) error {
+	schedSnap := d.scheduler.Snapshot()
+	statefulSnap := d.state.stateful.Snapshot().Remap(rl.remap)
+	// Restoring the scheduler resets the nodes of the scopes it activates, which
+	// clears their stateful variables, so it must happen first.
+	rl.scheduler.Restore(schedSnap)
+	rl.state.stateful.Restore(statefulSnap)
+	if d.tracer != nil {
+		rl.scheduler.SetTracer(d.tracer)
+	}
+	rl.guard.carry(d.guard)
+	d.scheduler, d.state, d.guard = rl.scheduler, rl.state, rl.guard
+	if d.persister != nil {
+		d.persister.hash = rl.hash
+		d.persister.scheduler = rl.scheduler
+		d.persister.stateful = rl.state.stateful
+		// Replace the snapshot of the previous program right away, as it would be
+		// discarded if the task restarted before the next one.
+		d.persister.last = 0
+		d.persister.maybeSave(ctx, telem.Now())
+	}
+	close(rl.swapped)
+	if len(rl.authority.Keys) == 0 || d.Out == nil {
+		return nil
+	}
+	req := framer.WriterRequest{Command: writer.CommandSetAuthority, Config: rl.authority}
+	return signal.SendUnderContext(ctx, d.Out.Inlet(), req)
+}
+
+// writerAuthorities returns the static authorities a program holds over the given
+// write channels, aligned with writeKeys.
+func writerAuthorities(auth ir.Authorities, writeKeys distchannel.Keys) []control.Authority {
+	authorities := buildAuthorities(auth, writeKeys)
+	if len(authorities) == len(writeKeys) {
+		return authorities
+	}
+	aligned := make([]control.Authority, len(writeKeys))
+	for i := range aligned {
+		aligned[i] = authorities[0]
+	}
+	return aligned
+}
+
+// statefulRemap maps the stateful variables of the nodes of one program to those
+// of another. A variable is carried over when a node with the same key
+// instantiates the same function in both programs, and that function declares a
+// stateful variable with the same name and type in both.
+func statefulRemap(from, to program.Program) func(string, uint32) (uint32, bool) {
+	type variable struct {
+		node string
+		id   uint32
+	}
+	ids := make(map[variable]uint32)
+	if from.Symbols != nil && to.Symbols != nil {
+		for _, n := range to.Nodes {
+			prev, ok := from.Nodes.Find(n.Key)
+			if !ok || prev.Type != n.Type {
+				continue
+			}
+			prevVars := statefulVariables(from.Symbols, n.Type)
+			for name, sym := range statefulVariables(to.Symbols, n.Type) {
+				if prevSym, ok := prevVars[name]; ok && types.Equal(prevSym.Type, sym.Type) {
+					ids[variable{node: n.Key, id: uint32(prevSym.ID)}] = uint32(sym.ID)
+				}
+			}
+		}
+	}
+	return func(node string, id uint32) (uint32, bool) {
+		newID, ok := ids[variable{node: node, id: id}]
+		return newID, ok
+	}
+}
+
+// statefulVariables returns the stateful variables declared in the body of the
+// function with the given name, keyed by name. Names declared more than once in
+// the function are left out, as their declarations can't be told apart between
+// programs.
+func statefulVariables(root *symbol.Symbol, fn string) map[string]*symbol.Symbol {
+	idx := slices.IndexFunc(root.Children(), func(s *symbol.Symbol) bool {
+		return s.Kind == symbol.KindFunction && s.Name == fn
+	})
+	if idx < 0 {
+		return nil
+	}
+	var (
+		vars       = make(map[string]*symbol.Symbol)
+		duplicates = make(set.Set[string])
+		collect    func(*symbol.Symbol)
+	)
+	collect = func(scope *symbol.Symbol) {
+		for _, child := range scope.Children() {
+			if child.Kind == symbol.KindStatefulVariable {
+				if _, ok := vars[child.Name]; ok {
+					duplicates.Add(child.Name)
+				}
+				vars[child.Name] = child
+			}
+			collect(child)
+		}
+	}
+	collect(root.Children()[idx])
+	for name := range duplicates {
+		delete(vars, name)
+	}
+	return vars
+}
@@ -0,0 +1,398 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/runtime/scheduler"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/internal/taskreporter"
+	arcstatus "github.com/synnaxlabs/synnax/pkg/service/arc/status"
+	"github.com/synnaxlabs/x/set"
+	"github.com/synnaxlabs/x/status"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// lastWrite is the most recent value written to a guarded channel.
+type lastWrite struct {
+	value float64
+	time  telem.TimeStamp
+}
+
+// guard enforces the safety envelopes of a program on the frames it writes before
+// they reach the cluster. Violations are reported as warning statuses named after
+// the guarded channel, and can move the program into a safe stage.
+type guard struct {
+	envelopes map[uint32]ir.Envelope
+	// indexes maps each guarded channel to its index channel, or zero if the
+	// channel is virtual.
+	indexes map[uint32]uint32
+	names   map[uint32]string
+	// watched holds the channels that interlocks condition on.
+	watched set.Set[uint32]
+	// values holds the latest value of each watched channel. Interlocks on
+	// channels that have not received a finite value are engaged, as their condition
+	// can't be shown not to hold.
+	values map[uint32]float64
+	last   map[uint32]lastWrite
+	// reported holds the kind of the last violation reported for each guarded
+	// channel, so a persistent violation is only reported once.
+	reported map[uint32]string
+	status   arcstatus.Setter
+	report   taskreporter.Reporter
+	now      func() telem.TimeStamp
+}
+
+// newGuard returns a guard enforcing the envelopes in cfg, or nil if the program has
+// none.
+func newGuard(
+	cfg ExtendedStateConfig,
+	stat arcstatus.Setter,
+	report taskreporter.Reporter,
+	now func() telem.TimeStamp,
+) *guard {
+	if len(cfg.Safety) == 0 {
+		return nil
+	}
+	if now == nil {
+		now = telem.Now
+	}
+	g := &guard{
+		envelopes: make(map[uint32]ir.Envelope, len(cfg.Safety)),
+		indexes:   make(map[uint32]uint32, len(cfg.Safety)),
+		names:     make(map[uint32]string),
+		watched:   make(set.Set[uint32]),
+		values:    make(map[uint32]float64),
+		last:      make(map[uint32]lastWrite),
+		reported:  make(map[uint32]string),
+		status:    stat,
+		report:    report,
+		now:       now,
+	}
+	for _, env := range cfg.Safety {
+		g.envelopes[env.Channel] = env
+		for _, il := range env.Interlocks {
+			g.watched.Add(il.Channel)
+		}
+	}
+	for _, d := range cfg.ChannelDigests {
+		if _, ok := g.envelopes[d.Key]; ok {
+			g.indexes[d.Key] = d.Index
+		}
+	}
+	for _, n := range cfg.IR.Nodes {
+		for key, name := range n.Channels.Read {
+			g.names[key] = name
+		}
+		for key, name := range n.Channels.Write {
+			g.names[key] = name
+		}
+	}
+	return g
+}
+
+// observe records the latest value of each watched channel in fr.
+func (g *guard) observe(fr telem.Frame[uint32]) {
+	for key, s := range fr.Entries() {
+		if s.Len() == 0 || !g.watched.Contains(key) {
+			continue
+		}
+		if v, ok := sampleAt(s, int(s.Len())-1); ok {
+			g.values[key] = v
+		}
+	}
+}
+
+// carry copies the interlock values and last writes of prev that are still relevant
+// to g, so a reloaded program can't step past a rate limit or miss an interlock
+// engaged before the reload. carry is a no-op if either guard is nil.
+func (g *guard) carry(prev *guard) {
+	if g == nil || prev == nil {
+		return
+	}
+	for key, v := range prev.values {
+		if g.watched.Contains(key) {
+			g.values[key] = v
+		}
+	}
+	for key, w := range prev.last {
+		if _, ok := g.envelopes[key]; ok {
+			g.last[key] = w
+		}
+	}
+}
+
+// enforce clamps, rate limits, and interlocks the guarded series in fr in place,
+// reporting any violations. It returns the safe stages requested by the envelopes
+// that were violated.
+func (g *guard) enforce(ctx context.Context, fr telem.Frame[uint32]) []scheduler.Stage {
+	var stages []scheduler.Stage
+	for i, key := range fr.RawKeys() {
+		if fr.ShouldExcludeRaw(i) {
+			continue
+		}
+		env, ok := g.envelopes[key]
+		if !ok {
+			continue
+		}
+		s := fr.RawSeriesAt(i)
+		if s.Len() == 0 {
+			continue
+		}
+		if _, numeric := sampleAt(s, 0); !numeric {
+			continue
+		}
+		times := g.times(fr, key, s)
+		s = s.DeepCopy()
+		var first violation
+		for j := range int(s.Len()) {
+			v, _ := sampleAt(s, j)
+			safe, vi := g.apply(env, v, times[j])
+			if vi.kind != "" && first.kind == "" {
+				first = vi
+			}
+			setSampleAt(s, j, safe)
+		}
+		fr.SetRawSeriesAt(i, s)
+		if first.kind == "" {
+			delete(g.reported, key)
+			continue
+		}
+		g.notify(ctx, key, first)
+		if env.SafeStage != "" {
+			stages = append(stages, scheduler.Stage{Scope: env.SafeSequence, Step: env.SafeStage})
+		}
+	}
+	return stages
+}
+
+// violation describes a write that was altered to stay within an envelope.
+type violation struct {
+	// kind identifies the bound that was violated, and is empty if the write was
+	// left untouched.
+	kind    string
+	message string
+}
+
+// apply returns the value that may be written to the channel of env in place of v at
+// ts, along with the violation if the two differ.
+func (g *guard) apply(env ir.Envelope, v float64, ts telem.TimeStamp) (float64, violation) {
+	if il, observed, engaged := g.interlocked(env); engaged {
+		safe := clamp(env, lo.FromPtr(env.SafeValue))
+		g.last[env.Channel] = lastWrite{value: safe, time: ts}
+		if v == safe {
+			return safe, violation{}
+		}
+		vi := violation{kind: "interlock " + strconv.FormatUint(uint64(il.Channel), 10)}
+		if observed {
+			vi.message = fmt.Sprintf(
+				"held at safe value %s while %s %s %s",
+				formatValue(safe), g.name(il.Channel), il.Comparison, formatValue(il.Value),
+			)
+		} else {
+			vi.message = fmt.Sprintf(
+				"held at safe value %s while %s has no value",
+				formatValue(safe), g.name(il.Channel),
+			)
+		}
+		return safe, vi
+	}
+	// NaN fails every comparison, so it would pass through the bounds and rate limit
+	// untouched, and poison the rate limit of every later write if stored as the last
+	// value.
+	if !isFinite(v) {
+		safe := clamp(env, lo.FromPtr(env.SafeValue))
+		g.last[env.Channel] = lastWrite{value: safe, time: ts}
+		return safe, violation{
+			kind:    "non-finite",
+			message: fmt.Sprintf("%s replaced with safe value %s", formatValue(v), formatValue(safe)),
+		}
+	}
+	var vi violation
+	out := clamp(env, v)
+	if out != v {
+		vi = violation{
+			kind:    "bounds",
+			message: fmt.Sprintf("%s clamped to %s", formatValue(v), formatValue(out)),
+		}
+	}
+	if last, ok := g.last[env.Channel]; ok && env.MaxRate != nil {
+		step := *env.MaxRate * math.Max(telem.TimeSpan(ts-last.time).Seconds(), 0)
+		limited := math.Min(math.Max(out, last.value-step), last.value+step)
+		if limited != out {
+			vi = violation{
+				kind: "rate",
+				message: fmt.Sprintf(
+					"%s rate limited to %s (max rate %s/s)",
+					formatValue(v), formatValue(limited), formatValue(*env.MaxRate),
+				),
+			}
+			out = limited
+		}
+	}
+	g.last[env.Channel] = lastWrite{value: out, time: ts}
+	return out, vi
+}
+
+// interlocked returns the first interlock of env that is engaged, along with whether
+// its channel has a finite value. An interlock is engaged if its condition holds, or
+// if its channel has no finite value to evaluate the condition against.
+func (g *guard) interlocked(env ir.Envelope) (ir.Interlock, bool, bool) {
+	for _, il := range env.Interlocks {
+		v, ok := g.values[il.Channel]
+		if !ok || !isFinite(v) {
+			return il, false, true
+		}
+		if compare(v, il.Comparison, il.Value) {
+			return il, true, true
+		}
+	}
+	return ir.Interlock{}, false, false
+}
+
+// times returns the timestamp of each sample in s, read from the series written to
+// the channel's index in fr. Samples of virtual channels are stamped with the
+// current time.
+func (g *guard) times(fr telem.Frame[uint32], key uint32, s telem.Series) []telem.TimeStamp {
+	times := make([]telem.TimeStamp, s.Len())
+	if idx := g.indexes[key]; idx != 0 {
+		for _, ts := range fr.Get(idx).Series {
+			if ts.DataType == telem.TimeStampT && ts.Len() == s.Len() {
+				copy(times, telem.UnmarshalSeries[telem.TimeStamp](ts))
+				return times
+			}
+		}
+	}
+	now := g.now()
+	for i := range times {
+		times[i] = now
+	}
+	return times
+}
+
+// notify sets a warning status describing vi, unless the channel's previous
+// violation was of the same kind.
+func (g *guard) notify(ctx context.Context, key uint32, vi violation) {
+	if g.reported[key] == vi.kind {
+		return
+	}
+	g.reported[key] = vi.kind
+	name := g.name(key)
+	msg := fmt.Sprintf("Write to %s %s", name, vi.message)
+	if _, _, err := g.status.SetByKeyOrName(
+		ctx,
+		name+" safety",
+		msg,
+		string(status.VariantWarning),
+	); err != nil && g.report != nil {
+		g.report(ctx, status.VariantWarning, fmt.Sprintf("safety: %v", err))
+	}
+}
+
+func (g *guard) name(key uint32) string {
+	if name, ok := g.names[key]; ok {
+		return name
+	}
+	return strconv.FormatUint(uint64(key), 10)
+}
+
+func clamp(env ir.Envelope, v float64) float64 {
+	if env.Min != nil && v < *env.Min {
+		v = *env.Min
+	}
+	if env.Max != nil && v > *env.Max {
+		v = *env.Max
+	}
+	return v
+}
+
+func isFinite(v float64) bool { return !math.IsNaN(v) && !math.IsInf(v, 0) }
+
+func compare(a float64, op string, b float64) bool {
+	switch op {
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	case "==":
+		return a == b
+	case "!=":
+		return a != b
+	default:
+		return false
+	}
+}
+
+func formatValue(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+
+// sampleAt returns the sample at i in a numeric series as a float64. It returns
+// false if the series is not numeric.
+func sampleAt(s telem.Series, i int) (float64, bool) {
+	switch s.DataType {
+	case telem.Float64T:
+		return telem.ValueAt[float64](s, i), true
+	case telem.Float32T:
+		return float64(telem.ValueAt[float32](s, i)), true
+	case telem.Int64T:
+		return float64(telem.ValueAt[int64](s, i)), true
+	case telem.Int32T:
+		return float64(telem.ValueAt[int32](s, i)), true
+	case telem.Int16T:
+		return float64(telem.ValueAt[int16](s, i)), true
+	case telem.Int8T:
+		return float64(telem.ValueAt[int8](s, i)), true
+	case telem.Uint64T:
+		return float64(telem.ValueAt[uint64](s, i)), true
+	case telem.Uint32T:
+		return float64(telem.ValueAt[uint32](s, i)), true
+	case telem.Uint16T:
+		return float64(telem.ValueAt[uint16](s, i)), true
+	case telem.Uint8T:
+		return float64(telem.ValueAt[uint8](s, i)), true
+	default:
+		return 0, false
+	}
+}
+
+// setSampleAt sets the sample at i in a numeric series, converting v to the
+// series' data type.
+func setSampleAt(s telem.Series, i int, v float64) {
+	switch s.DataType {
+	case telem.Float64T:
+		telem.SetValueAt(s, i, v)
+	case telem.Float32T:
+		telem.SetValueAt(s, i, float32(v))
+	case telem.Int64T:
+		telem.SetValueAt(s, i, int64(v))
+	case telem.Int32T:
+		telem.SetValueAt(s, i, int32(v))
+	case telem.Int16T:
+		telem.SetValueAt(s, i, int16(v))
+	case telem.Int8T:
+		telem.SetValueAt(s, i, int8(v))
+	case telem.Uint64T:
+		telem.SetValueAt(s, i, uint64(v))
+	case telem.Uint32T:
+		telem.SetValueAt(s, i, uint32(v))
+	case telem.Uint16T:
+		telem.SetValueAt(s, i, uint16(v))
+	case telem.Uint8T:
+		telem.SetValueAt(s, i, uint8(v))
+	}
+}
@@ -26,6 +26,10 @@ type ExtendedStateConfig struct {
 	Writes         set.Set[channel.Key]
 	ChannelDigests []stlchannels.Digest
 	IR             ir.IR
+	// Safety holds the envelopes enforced on the channels written by the program,
+	// merging those declared in the program with those configured on the channels
+	// themselves.
+	Safety ir.Safety
 }
 
 func retrieveChannels(
@@ -72,10 +76,30 @@ func NewStateConfig(
 	for key := range prog.Authorities.Channels {
 		writes.Add(channel.Key(key))
 	}
+	for _, env := range prog.Safety {
+		for _, il := range env.Interlocks {
+			reads.Add(channel.Key(il.Channel))
+		}
+	}
 	channels, err := retrieveChannels(ctx, channelSvc, slices.Concat(reads.Slice(), writes.Slice()))
 	if err != nil {
 		return ExtendedStateConfig{}, err
 	}
+	safety, interlocks := mergeSafety(prog.Safety, channels, writes)
+	var missing []channel.Key
+	for key := range interlocks {
+		if !reads.Contains(key) && !writes.Contains(key) {
+			missing = append(missing, key)
+		}
+		reads.Add(key)
+	}
+	if len(missing) > 0 {
+		interlockChannels, err := retrieveChannels(ctx, channelSvc, missing)
+		if err != nil {
+			return ExtendedStateConfig{}, err
+		}
+		channels = append(channels, interlockChannels...)
+	}
 	channelDigests := make([]stlchannels.Digest, 0, len(channels))
 	for _, ch := range channels {
 		channelDigests = append(channelDigests, stlchannels.Digest{
@@ -95,5 +119,63 @@ func NewStateConfig(
 		Writes:         writes,
 		ChannelDigests: lo.Uniq(channelDigests),
 		IR:             prog.IR,
+		Safety:         safety,
 	}, nil
 }
+
+// mergeSafety combines the safety envelopes declared in a program with those
+// configured on the channels it writes to. When both define a bound, the stricter
+// one wins, and interlocks from both sources apply. A safe value declared by the
+// program takes precedence over the channel's. mergeSafety also returns the
+// interlock channels introduced by channel-level envelopes.
+func mergeSafety(
+	declared ir.Safety,
+	channels []channel.Channel,
+	writes set.Set[channel.Key],
+) (ir.Safety, set.Set[channel.Key]) {
+	var (
+		merged     = slices.Clone(declared)
+		interlocks = make(set.Set[channel.Key])
+	)
+	for _, ch := range channels {
+		if !writes.Contains(ch.Key()) || ch.Safety.IsZero() {
+			continue
+		}
+		idx := slices.IndexFunc(merged, func(env ir.Envelope) bool {
+			return env.Channel == uint32(ch.Key())
+		})
+		if idx == -1 {
+			merged = append(merged, ir.Envelope{Channel: uint32(ch.Key())})
+			idx = len(merged) - 1
+		}
+		env := &merged[idx]
+		env.Min = stricter(env.Min, ch.Safety.Min, func(a, b float64) bool { return a > b })
+		env.Max = stricter(env.Max, ch.Safety.Max, func(a, b float64) bool { return a < b })
+		env.MaxRate = stricter(env.MaxRate, ch.Safety.MaxRate, func(a, b float64) bool { return a < b })
+		if env.SafeValue == nil {
+			env.SafeValue = ch.Safety.SafeValue
+		}
+		env.Interlocks = slices.Clone(env.Interlocks)
+		for _, il := range ch.Safety.Interlocks {
+			env.Interlocks = append(env.Interlocks, ir.Interlock{
+				Channel:    uint32(il.Channel),
+				Comparison: il.Comparison,
+				Value:      il.Value,
+			})
+			interlocks.Add(il.Channel)
+		}
+	}
+	return merged, interlocks
+}
+
+// stricter returns whichever of the two optional bounds is preferred by the
+// provided comparison, or the one that is set if only one is.
+func stricter(a, b *float64, prefer func(a, b float64) bool) *float64 {
+	if a == nil {
+		return b
+	}
+	if b == nil || prefer(*a, *b) {
+		return a
+	}
+	return b
+}
@@ -496,5 +496,170 @@ var _ = Describe("StateConfig", Ordered, func() {
 			Expect(cfg.Writes.Contains(indexCh.Key())).To(BeTrue())
 			Expect(cfg.ChannelDigests).To(HaveLen(4))
 		})
+
+		It("Should merge program and channel safety envelopes, keeping the stricter bounds", func(ctx SpecContext) {
+			pressure := &channel.Channel{
+				Name:     "safety_pressure",
+				Virtual:  true,
+				DataType: telem.Float32T,
+			}
+			Expect(dist.Channel.Create(ctx, pressure)).To(Succeed())
+			temp := &channel.Channel{
+				Name:     "safety_temp",
+				Virtual:  true,
+				DataType: telem.Float32T,
+			}
+			Expect(dist.Channel.Create(ctx, temp)).To(Succeed())
+			channelMax, channelMin, channelRate, channelSafe := 50.0, -10.0, 5.0, 1.0
+			valve := &channel.Channel{
+				Name:     "safety_valve",
+				Virtual:  true,
+				DataType: telem.Float32T,
+				Safety: channel.Safety{
+					Min:     &channelMin,
+					Max:     &channelMax,
+					MaxRate: &channelRate,
+					Interlocks: []channel.Interlock{
+						{Channel: temp.Key(), Comparison: ">", Value: 80},
+					},
+					SafeValue: &channelSafe,
+				},
+			}
+			Expect(dist.Channel.Create(ctx, valve)).To(Succeed())
+			programMax, programMin, programSafe := 80.0, 0.0, 0.0
+			prog := arc.Program{
+				IR: ir.IR{
+					Nodes: []ir.Node{
+						{
+							Key:  "write_node",
+							Type: "write",
+							Channels: types.Channels{
+								Write: map[uint32]string{uint32(valve.Key()): "safety_valve"},
+							},
+						},
+					},
+					Safety: ir.Safety{
+						{
+							Channel: uint32(valve.Key()),
+							Min:     &programMin,
+							Max:     &programMax,
+							Interlocks: []ir.Interlock{
+								{Channel: uint32(pressure.Key()), Comparison: ">=", Value: 500},
+							},
+							SafeValue: &programSafe,
+						},
+					},
+				},
+			}
+
+			cfg := MustSucceed(runtime.NewStateConfig(ctx, dist.Channel, prog))
+			Expect(cfg.Reads.Contains(pressure.Key())).To(BeTrue())
+			Expect(cfg.Reads.Contains(temp.Key())).To(BeTrue())
+			Expect(cfg.Safety).To(HaveLen(1))
+			env := cfg.Safety[0]
+			Expect(*env.Min).To(Equal(0.0))
+			Expect(*env.Max).To(Equal(50.0))
+			Expect(*env.MaxRate).To(Equal(5.0))
+			Expect(*env.SafeValue).To(Equal(0.0))
+			Expect(env.Interlocks).To(ConsistOf(
+				ir.Interlock{Channel: uint32(pressure.Key()), Comparison: ">=", Value: 500},
+				ir.Interlock{Channel: uint32(temp.Key()), Comparison: ">", Value: 80},
+			))
+			Expect(prog.Safety[0].Interlocks).To(HaveLen(1))
+		})
+
+		It("Should keep the safe value declared by the program", func(ctx SpecContext) {
+			channelMax := 40.0
+			valve := &channel.Channel{
+				Name:     "safety_declared_valve",
+				Virtual:  true,
+				DataType: telem.Float32T,
+				Safety:   channel.Safety{Max: &channelMax},
+			}
+			Expect(dist.Channel.Create(ctx, valve)).To(Succeed())
+			programMax, programSafe := 10.0, 5.0
+			prog := arc.Program{
+				IR: ir.IR{
+					Nodes: []ir.Node{
+						{
+							Key:  "write_node",
+							Type: "write",
+							Channels: types.Channels{
+								Write: map[uint32]string{uint32(valve.Key()): "safety_declared_valve"},
+							},
+						},
+					},
+					Safety: ir.Safety{
+						{
+							Channel:   uint32(valve.Key()),
+							Max:       &programMax,
+							SafeValue: &programSafe,
+						},
+					},
+				},
+			}
+
+			cfg := MustSucceed(runtime.NewStateConfig(ctx, dist.Channel, prog))
+			Expect(cfg.Safety).To(HaveLen(1))
+			Expect(*cfg.Safety[0].Max).To(Equal(10.0))
+			Expect(*cfg.Safety[0].SafeValue).To(Equal(5.0))
+		})
+
+		It("Should take the channel's safe value when the program declares none", func(ctx SpecContext) {
+			channelMax, channelSafe := 40.0, 2.0
+			valve := &channel.Channel{
+				Name:     "safety_undeclared_valve",
+				Virtual:  true,
+				DataType: telem.Float32T,
+				Safety:   channel.Safety{Max: &channelMax, SafeValue: &channelSafe},
+			}
+			Expect(dist.Channel.Create(ctx, valve)).To(Succeed())
+			programMax := 10.0
+			prog := arc.Program{
+				IR: ir.IR{
+					Nodes: []ir.Node{
+						{
+							Key:  "write_node",
+							Type: "write",
+							Channels: types.Channels{
+								Write: map[uint32]string{uint32(valve.Key()): "safety_undeclared_valve"},
+							},
+						},
+					},
+					Safety: ir.Safety{{Channel: uint32(valve.Key()), Max: &programMax}},
+				},
+			}
+
+			cfg := MustSucceed(runtime.NewStateConfig(ctx, dist.Channel, prog))
+			Expect(cfg.Safety).To(HaveLen(1))
+			Expect(*cfg.Safety[0].SafeValue).To(Equal(2.0))
+		})
+
+		It("Should ignore channel safety envelopes on channels the program doesn't write", func(ctx SpecContext) {
+			limit := 10.0
+			ch := &channel.Channel{
+				Name:     "safety_read_only",
+				Virtual:  true,
+				DataType: telem.Float32T,
+				Safety:   channel.Safety{Max: &limit},
+			}
+			Expect(dist.Channel.Create(ctx, ch)).To(Succeed())
+			prog := arc.Program{
+				IR: ir.IR{
+					Nodes: []ir.Node{
+						{
+							Key:  "read_node",
+							Type: "on",
+							Channels: types.Channels{
+								Read: map[uint32]string{uint32(ch.Key()): "safety_read_only"},
+							},
+						},
+					},
+				},
+			}
+
+			cfg := MustSucceed(runtime.NewStateConfig(ctx, dist.Channel, prog))
+			Expect(cfg.Safety).To(BeEmpty())
+		})
 	})
 })
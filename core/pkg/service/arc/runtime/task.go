@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"slices"
 	stdtime "time"
 
 	"github.com/synnaxlabs/arc/ir"
@@ -22,6 +23,7 @@ import (
 	stlchannels "github.com/synnaxlabs/arc/stl/channels"
 	"github.com/synnaxlabs/arc/stl/constant"
 	stlcontrol "github.com/synnaxlabs/arc/stl/control"
+	"github.com/synnaxlabs/arc/stl/dsp"
 	stlerrors "github.com/synnaxlabs/arc/stl/errors"
 	stlmath "github.com/synnaxlabs/arc/stl/math"
 	stlop "github.com/synnaxlabs/arc/stl/op"
@@ -70,6 +72,14 @@ type taskImpl struct {
 	prog       arc.Arc
 
 	closer io.Closer
+	// persister snapshots the state of the running program when the task persists
+	// its state.
+	persister *persister
+	// tracer traces the running program when the task has tracing enabled.
+	tracer *tracer
+	// pipeline describes the pipeline of the running program, which bounds the
+	// programs that can be reloaded into it.
+	pipeline openPipeline
 }
 
 var _ driver.Task = (*taskImpl)(nil)
@@ -80,6 +90,13 @@ func (t *taskImpl) Exec(ctx context.Context, cmd task.Command) error {
 		return t.start(ctx)
 	case "stop":
 		return t.Stop()
+	case "reset_state":
+		return t.resetState(ctx)
+	case "resume":
+		t.resume()
+		return nil
+	case "reload":
+		return t.reload(ctx)
 	default:
 		return driver.ErrUnsupportedCommand
 	}
@@ -91,144 +108,85 @@ func (t *taskImpl) start(ctx context.Context) (err error) {
 	if t.isRunning() {
 		return nil
 	}
-	drt := dataRuntime{}
 	stateCfg, err := NewStateConfig(ctx, t.factoryCfg.Channel.Service, *t.prog.Program)
 	if err != nil {
 		t.setStatus(ctx, status.VariantError, false, err.Error())
 		return err
 	}
-
-	drt.state.nodes = node.New(stateCfg.IR)
-	drt.state.channel = stlchannels.NewProgramState(stateCfg.ChannelDigests)
-	drt.state.series = series.NewProgramState()
-	drt.state.strings = stlstrings.NewProgramState()
-	drt.state.authority = &stlcontrol.ProgramState{}
-
-	var closers xio.MultiCloser
-	defer func() {
-		if err != nil {
-			err = errors.Join(err, closers.Close())
-		}
-	}()
-
-	var wasmRT wazero.Runtime
-	if len(t.prog.Program.WASM) > 0 {
-		wasmRT = wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfigCompiler())
-		closers = append(closers, xio.CloserFunc(func() error {
-			return wasmRT.Close(ctx)
-		}))
-	}
-
-	timeMod, err := time.NewHost(ctx, wasmRT)
-	if err != nil {
-		t.setStatus(ctx, status.VariantError, false, err.Error())
-		return err
-	}
-	channelMod, err := stlchannels.NewHost(ctx, wasmRT, drt.state.channel, drt.state.strings)
-	if err != nil {
-		t.setStatus(ctx, status.VariantError, false, err.Error())
-		return err
-	}
-	statefulMod, err := stateful.NewHost(ctx, wasmRT, drt.state.series, drt.state.strings)
-	if err != nil {
-		t.setStatus(ctx, status.VariantError, false, err.Error())
-		return err
-	}
-	if _, err = series.NewHost(ctx, wasmRT, drt.state.series); err != nil {
-		t.setStatus(ctx, status.VariantError, false, err.Error())
-		return err
-	}
-	stringsMod, err := stlstrings.NewHost(ctx, wasmRT, drt.state.strings, nil)
-	if err != nil {
-		t.setStatus(ctx, status.VariantError, false, err.Error())
-		return err
-	}
-	mathMod, err := stlmath.NewHost(ctx, wasmRT)
-	if err != nil {
-		t.setStatus(ctx, status.VariantError, false, err.Error())
-		return err
-	}
-	errorsMod, err := stlerrors.NewHost(ctx, wasmRT, nil)
-	if err != nil {
-		t.setStatus(ctx, status.VariantError, false, err.Error())
-		return err
-	}
-	statusMod, err := arcstatus.NewModule(ctx, arcstatus.ModuleConfig{
-		Status:   t.factoryCfg.Status,
-		Strings:  drt.state.strings,
-		Runtime:  wasmRT,
-		Reporter: t.reporter(),
+	drt, baseInterval, closers, err := openProgram(ctx, programConfig{
+		prog:     t.prog,
+		stateCfg: stateCfg,
+		status:   t.factoryCfg.Status,
+		reporter: t.reporter(),
 	})
 	if err != nil {
 		t.setStatus(ctx, status.VariantError, false, err.Error())
 		return err
 	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, closers.Close())
+		}
+	}()
 
-	f := node.CompoundFactory{
-		channelMod,
-		statefulMod,
-		timeMod,
-		selector.NewHost(),
-		constant.NewHost(),
-		stlop.NewHost(),
-		stable.NewHost(),
-		statusMod,
-		stlcontrol.NewHost(drt.state.authority),
-		mathMod,
-	}
+	drt.scheduler.SetErrorHandler(t.errorHandler())
 
-	if len(t.prog.Program.WASM) > 0 {
-		guest, guestErr := wasmRT.Instantiate(ctx, t.prog.Program.WASM)
-		if guestErr != nil {
-			t.setStatus(ctx, status.VariantError, false, guestErr.Error())
-			return guestErr
+	startMessage := "Task started successfully"
+	if t.cfg.PersistState {
+		if t.factoryCfg.DB == nil {
+			err = errors.New("arc.runtime: a db is required to persist task state")
+			t.setStatus(ctx, status.VariantError, false, err.Error())
+			return err
+		}
+		drt.persister = &persister{
+			Instrumentation: t.factoryCfg.Instrumentation,
+			db:              t.factoryCfg.DB,
+			task:            t.task.Key,
+			hash:            t.prog.Program.Hash(),
+			interval:        t.cfg.SnapshotInterval,
+			scheduler:       drt.scheduler,
+			stateful:        drt.state.stateful,
+		}
+		if drt.persister.interval <= 0 {
+			drt.persister.interval = DefaultSnapshotInterval
+		}
+		restoredAt, restored, restoreErr := drt.persister.restore(ctx)
+		if restoreErr != nil {
+			err = restoreErr
+			t.setStatus(ctx, status.VariantError, false, err.Error())
+			return err
+		}
+		if restored {
+			startMessage = fmt.Sprintf(
+				"Task started successfully with state restored from %s",
+				restoredAt,
+			)
 		}
-		stringsMod.SetMemory(guest.Memory())
-		errorsMod.SetMemory(guest.Memory())
-		closers = append(closers, xio.CloserFunc(func() error {
-			return guest.Close(ctx)
-		}))
-		f = append(f, &wasm.Module{
-			Module:        guest,
-			Memory:        guest.Memory(),
-			Strings:       drt.state.strings,
-			NodeKeySetter: statefulMod,
-		})
 	}
 
-	nodes := make(map[string]node.Node)
-	for _, irNode := range t.prog.Program.Nodes {
-		n, nodeErr := f.Create(ctx, node.Config{
-			Node:    irNode,
-			Program: *t.prog.Program,
-			State:   drt.state.nodes.Node(irNode.Key),
-		})
-		if nodeErr != nil {
-			t.setStatus(ctx, status.VariantError, false, nodeErr.Error())
-			return nodeErr
+	if t.cfg.Trace.Enabled {
+		var traceKey distchannel.Key
+		if traceKey, err = openTraceChannel(
+			ctx,
+			t.factoryCfg.Channel,
+			t.task.Key,
+		); err != nil {
+			t.setStatus(ctx, status.VariantError, false, err.Error())
+			return err
 		}
-		nodes[irNode.Key] = n
+		drt.tracer = newTracer(traceKey, t.cfg.Trace, t.onPause, t.onResume)
+		drt.scheduler.SetTracer(drt.tracer)
 	}
 
-	tolerance := time.CalculateTolerance(timeMod.BaseInterval)
-	drt.scheduler = scheduler.New(t.prog.Program.IR, nodes, tolerance)
-
-	drt.scheduler.SetErrorHandler(scheduler.ErrorHandlerFunc(func(ctx context.Context, nodeKey string, err error) {
-		t.factoryCfg.L.Warn("runtime error in arc node",
-			zap.String("node", nodeKey),
-			zap.Uint64("task", uint64(t.task.Key)),
-			zap.Error(err),
-		)
-		t.setRuntimeError(ctx, nodeKey, err)
-	}))
-
 	drt.startTime = telem.Now()
 	drt.writeKeys = stateCfg.Writes.Slice()
+	drt.reloads = make(chan *reload)
+	open := openPipeline{reloads: drt.reloads, reads: stateCfg.Reads}
 
 	pipeline := plumber.New()
 
 	var runtime confluence.Segment[framer.StreamerResponse, framer.WriterRequest] = &drt
-	if hasIntervals := timeMod.BaseInterval != telem.TimeSpan(math.MaxInt64); hasIntervals {
+	if open.ticking = hasIntervals(baseInterval); open.ticking {
 		runtime = &tickerRuntime{dataRuntime: drt}
 	}
 	plumber.SetSegment(pipeline, runtimeAddr, runtime)
@@ -251,16 +209,29 @@ func (t *taskImpl) start(ctx context.Context) (err error) {
 		plumber.MustConnect[framer.StreamerResponse](pipeline, streamerAddr, runtimeAddr, 10)
 		streamer.InFrom(streamerRequests)
 		streamerCloseSignal = xio.NoFailCloserFunc(streamerRequests.Close)
+		open.streamerRequests = streamerRequests
 	} else {
 		streamerResponses := confluence.NewStream[framer.StreamerResponse]()
 		runtime.InFrom(streamerResponses)
 		streamerCloseSignal = xio.NoFailCloserFunc(streamerResponses.Close)
 	}
 
-	if len(stateCfg.Writes) > 0 {
+	if len(stateCfg.Writes) > 0 || drt.tracer != nil {
 		// Critical: ToSlice is extracted from a map, so we need to convert it to a
 		// slice ONCE in order go guarantee stable order.
 		writeKeys := stateCfg.Writes.Slice()
+		authorities := buildAuthorities(t.prog.Program.Authorities, writeKeys)
+		open.writer = true
+		open.writeKeys = writeKeys
+		open.authorities = writerAuthorities(t.prog.Program.Authorities, writeKeys)
+		if drt.tracer != nil {
+			// The task is the only writer to its trace channel, so it always holds
+			// absolute authority over it.
+			if len(authorities) == len(writeKeys) {
+				authorities = append(authorities, control.AuthorityAbsolute)
+			}
+			writeKeys = append(writeKeys, drt.tracer.key)
+		}
 		writerCfg := framer.WriterConfig{
 			ControlSubject: control.Subject{
 				Name: t.prog.Name,
@@ -269,10 +240,7 @@ func (t *taskImpl) start(ctx context.Context) (err error) {
 			Start: drt.startTime,
 			Keys:  writeKeys,
 		}
-		if authorities := buildAuthorities(
-			t.prog.Program.Authorities,
-			writeKeys,
-		); len(authorities) > 0 {
+		if len(authorities) > 0 {
 			writerCfg.Authorities = authorities
 		}
 		var wrt framer.StreamWriter
@@ -308,11 +276,20 @@ func (t *taskImpl) start(ctx context.Context) (err error) {
 		plumber.MustConnect[framer.WriterResponse](pipeline, writerAddr, writerResponsesAddr, 10)
 	}
 	sCtx, cancel := signal.Isolated(signal.WithInstrumentation(t.factoryCfg.Instrumentation))
-	t.closer = append(
-		closers,
+	// The program is replaced when the task reloads, so its closers are held apart
+	// from those of the pipeline, and closed once the pipeline has shut down.
+	open.program = closers
+	taskClosers := xio.MultiCloser{
+		xio.CloserFunc(func() error { return t.pipeline.program.Close() }),
 		signal.NewGracefulShutdown(sCtx, cancel),
 		streamerCloseSignal,
-	)
+	}
+	if drt.tracer != nil {
+		// Closed first so that a task paused at a breakpoint can shut down.
+		taskClosers = append(taskClosers, drt.tracer)
+	}
+	t.closer = taskClosers
+	t.pipeline = open
 	closers = nil
 	pipeline.Flow(
 		sCtx,
@@ -320,7 +297,9 @@ func (t *taskImpl) start(ctx context.Context) (err error) {
 		confluence.RecoverWithErrOnPanic(),
 		confluence.CancelOnFail(),
 	)
-	t.setStatus(ctx, status.VariantSuccess, true, "Task started successfully")
+	t.persister = drt.persister
+	t.tracer = drt.tracer
+	t.setStatus(ctx, status.VariantSuccess, true, startMessage)
 	return nil
 }
 
@@ -330,9 +309,21 @@ func (t *taskImpl) Stop() error {
 	}
 	err := t.closer.Close()
 	t.closer = nil
+	t.pipeline = openPipeline{}
 	/// TODO until we fix our usage of contexts in general:
 	// https://linear.app/synnax/issue/SY-4002/refactor-usages-of-contextcontext
 	ctx := context.TODO()
+	if t.persister != nil {
+		// The program has stopped, so this captures its final state.
+		if saveErr := t.persister.save(ctx, telem.Now()); saveErr != nil {
+			t.factoryCfg.L.Warn("failed to snapshot arc task state",
+				zap.Uint64("task", uint64(t.task.Key)),
+				zap.Error(saveErr),
+			)
+		}
+		t.persister = nil
+	}
+	t.tracer = nil
 	if err != nil {
 		t.setStatus(ctx, status.VariantError, false, err.Error())
 		return err
@@ -341,6 +332,49 @@ func (t *taskImpl) Stop() error {
 	return nil
 }
 
+// resetState discards the persisted state of the task, so that its next start
+// begins from the program's initial state.
+func (t *taskImpl) resetState(ctx context.Context) error {
+	if t.isRunning() {
+		return errors.New("arc.runtime: cannot reset the state of a running task")
+	}
+	if t.factoryCfg.DB == nil {
+		return nil
+	}
+	if err := deleteSnapshot(ctx, t.factoryCfg.DB, t.task.Key); err != nil {
+		t.setStatus(ctx, status.VariantError, false, err.Error())
+		return err
+	}
+	t.setStatus(ctx, status.VariantSuccess, false, "Task state reset successfully")
+	return nil
+}
+
+// resume resumes the task if it is paused at a breakpoint.
+func (t *taskImpl) resume() {
+	if t.tracer != nil {
+		t.tracer.resumeTask()
+	}
+}
+
+func (t *taskImpl) onPause(ctx context.Context, breakpoint string) {
+	t.setStatus(ctx, status.VariantInfo, true, fmt.Sprintf("Paused at breakpoint %s", breakpoint))
+}
+
+func (t *taskImpl) onResume(ctx context.Context, breakpoint string) {
+	t.setStatus(ctx, status.VariantSuccess, true, fmt.Sprintf("Resumed from breakpoint %s", breakpoint))
+}
+
+func (t *taskImpl) errorHandler() scheduler.ErrorHandler {
+	return scheduler.ErrorHandlerFunc(func(ctx context.Context, nodeKey string, err error) {
+		t.factoryCfg.L.Warn("runtime error in arc node",
+			zap.String("node", nodeKey),
+			zap.Uint64("task", uint64(t.task.Key)),
+			zap.Error(err),
+		)
+		t.setRuntimeError(ctx, nodeKey, err)
+	})
+}
+
 func (t *taskImpl) reporter() taskreporter.Reporter {
 	return func(ctx context.Context, variant status.Variant, message string) {
 		t.setStatus(ctx, variant, t.isRunning(), fmt.Sprintf("[%s] %s", t.task.Name, message))
@@ -383,12 +417,155 @@ func (t *taskImpl) setRuntimeError(ctx context.Context, nodeKey string, err erro
 	}
 }
 
+// programConfig configures the construction of an Arc program's nodes and
+// scheduler.
+type programConfig struct {
+	prog     arc.Arc
+	stateCfg ExtendedStateConfig
+	status   arcstatus.Setter
+	reporter taskreporter.Reporter
+	// now overrides the clock read by the program's nodes. Nil uses telem.Now.
+	now func() telem.TimeStamp
+}
+
+// openProgram instantiates the standard library modules and nodes of a program
+// and builds its scheduler. It returns the runtime, the base interval of the
+// program's timers, and a closer that releases the program's WASM runtime.
+func openProgram(
+	ctx context.Context,
+	cfg programConfig,
+) (drt dataRuntime, baseInterval telem.TimeSpan, closers xio.MultiCloser, err error) {
+	prog := cfg.prog.Program
+	drt.state.nodes = node.New(cfg.stateCfg.IR)
+	drt.state.channel = stlchannels.NewProgramState(
+		cfg.stateCfg.ChannelDigests,
+		stlchannels.WithNow(cfg.now),
+	)
+	drt.state.series = series.NewProgramState()
+	drt.state.strings = stlstrings.NewProgramState()
+	drt.state.authority = &stlcontrol.ProgramState{}
+
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, closers.Close())
+			closers = nil
+		}
+	}()
+
+	var wasmRT wazero.Runtime
+	if len(prog.WASM) > 0 {
+		wasmRT = wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfigCompiler())
+		closers = append(closers, xio.CloserFunc(func() error {
+			return wasmRT.Close(ctx)
+		}))
+	}
+
+	timeMod, err := time.NewHost(ctx, wasmRT, time.WithNow(cfg.now))
+	if err != nil {
+		return drt, 0, closers, err
+	}
+	channelMod, err := stlchannels.NewHost(ctx, wasmRT, drt.state.channel, drt.state.strings)
+	if err != nil {
+		return drt, 0, closers, err
+	}
+	statefulMod, err := stateful.NewHost(ctx, wasmRT, drt.state.series, drt.state.strings)
+	if err != nil {
+		return drt, 0, closers, err
+	}
+	drt.state.stateful = statefulMod
+	if _, err = series.NewHost(ctx, wasmRT, drt.state.series); err != nil {
+		return drt, 0, closers, err
+	}
+	stringsMod, err := stlstrings.NewHost(ctx, wasmRT, drt.state.strings, nil)
+	if err != nil {
+		return drt, 0, closers, err
+	}
+	mathMod, err := stlmath.NewHost(ctx, wasmRT)
+	if err != nil {
+		return drt, 0, closers, err
+	}
+	errorsMod, err := stlerrors.NewHost(ctx, wasmRT, nil)
+	if err != nil {
+		return drt, 0, closers, err
+	}
+	dspMod, err := dsp.NewHost(ctx, wasmRT)
+	if err != nil {
+		return drt, 0, closers, err
+	}
+	statusMod, err := arcstatus.NewModule(ctx, arcstatus.ModuleConfig{
+		Status:   cfg.status,
+		Strings:  drt.state.strings,
+		Runtime:  wasmRT,
+		Reporter: cfg.reporter,
+		Now:      cfg.now,
+	})
+	if err != nil {
+		return drt, 0, closers, err
+	}
+
+	var stableOpts []func(*stable.Host)
+	if cfg.now != nil {
+		stableOpts = append(stableOpts, stable.WithNow(cfg.now))
+	}
+	f := node.CompoundFactory{
+		channelMod,
+		statefulMod,
+		timeMod,
+		selector.NewHost(),
+		constant.NewHost(constant.WithNow(cfg.now)),
+		stlop.NewHost(),
+		stable.NewHost(stableOpts...),
+		statusMod,
+		stlcontrol.NewHost(drt.state.authority),
+		mathMod,
+		dspMod,
+	}
+
+	if len(prog.WASM) > 0 {
+		guest, guestErr := wasmRT.Instantiate(ctx, prog.WASM)
+		if guestErr != nil {
+			return drt, 0, closers, guestErr
+		}
+		stringsMod.SetMemory(guest.Memory())
+		errorsMod.SetMemory(guest.Memory())
+		closers = append(closers, xio.CloserFunc(func() error {
+			return guest.Close(ctx)
+		}))
+		f = append(f, &wasm.Module{
+			Module:        guest,
+			Memory:        guest.Memory(),
+			Strings:       drt.state.strings,
+			NodeKeySetter: wasm.NodeKeySetters{statefulMod, dspMod},
+			Now:           cfg.now,
+		})
+	}
+
+	nodes := make(map[string]node.Node)
+	for _, irNode := range prog.Nodes {
+		n, nodeErr := f.Create(ctx, node.Config{
+			Node:    irNode,
+			Program: *prog,
+			State:   drt.state.nodes.Node(irNode.Key),
+		})
+		if nodeErr != nil {
+			return drt, 0, closers, nodeErr
+		}
+		nodes[irNode.Key] = n
+	}
+
+	drt.guard = newGuard(cfg.stateCfg, cfg.status, cfg.reporter, cfg.now)
+	tolerance := time.CalculateTolerance(timeMod.BaseInterval)
+	drt.scheduler = scheduler.New(prog.IR, nodes, tolerance)
+	return drt, timeMod.BaseInterval, closers, nil
+}
+
 type state struct {
 	nodes     *node.ProgramState
 	channel   *stlchannels.ProgramState
 	series    *series.ProgramState
 	strings   *stlstrings.ProgramState
 	authority *stlcontrol.ProgramState
+	stateful  *stateful.Host
 }
 
 type dataRuntime struct {
@@ -397,6 +574,22 @@ type dataRuntime struct {
 	scheduler *scheduler.Scheduler
 	writeKeys distchannel.Keys
 	state     state
+	// persister snapshots the program's state after each cycle once the snapshot
+	// interval has passed. Nil when the task doesn't persist its state.
+	persister *persister
+	// tracer writes the program's scheduler events to the trace channel after each
+	// cycle and pauses the program at breakpoints. Nil when tracing is disabled.
+	tracer *tracer
+	// reloads receives programs to swap in between cycles. Nil when the runtime
+	// doesn't support reloading.
+	reloads chan *reload
+	// guard enforces the program's safety envelopes on its writes. Nil when the
+	// program has none.
+	guard *guard
+	// enteredSafeStage is set when a safety violation moved the program into a safe
+	// stage during the last cycle, so the stage can run before waiting for more
+	// input.
+	enteredSafeStage bool
 }
 
 func (d *dataRuntime) next(
@@ -404,33 +597,90 @@ func (d *dataRuntime) next(
 	res framer.StreamerResponse,
 	reason node.RunReason,
 ) error {
-	d.state.channel.Ingest(res.Frame.ToStorage())
-	d.scheduler.Next(ctx, telem.Since(d.startTime), reason)
-	d.state.channel.ClearReads()
-	if d.Out != nil {
-		if err := d.flushAuthorityChanges(ctx); err != nil {
+	elapsed := telem.Since(d.startTime)
+	fr, changed := d.cycle(ctx, res.Frame.ToStorage(), elapsed, reason)
+	if d.persister != nil {
+		d.persister.maybeSave(ctx, telem.Now())
+	}
+	if d.Out == nil {
+		return nil
+	}
+	if err := d.flushAuthorityChanges(ctx, elapsed); err != nil {
+		return err
+	}
+	if d.tracer != nil {
+		var (
+			traced bool
+			err    error
+		)
+		if fr, traced, err = d.tracer.flush(fr); err != nil {
 			return err
 		}
+		changed = changed || traced
 	}
-	d.state.series.Clear()
-	d.state.strings.Clear()
-	if fr, changed := d.state.channel.Flush(telem.Frame[uint32]{}); changed && d.Out != nil {
+	if changed {
 		req := framer.WriterRequest{
 			Frame:   frame.NewFromStorage(fr),
 			Command: writer.CommandWrite,
 		}
-		return signal.SendUnderContext(ctx, d.Out.Inlet(), req)
+		if err := signal.SendUnderContext(ctx, d.Out.Inlet(), req); err != nil {
+			return err
+		}
+	}
+	if d.tracer != nil {
+		if err := d.tracer.pause(ctx); err != nil {
+			return err
+		}
+	}
+	if d.enteredSafeStage {
+		d.enteredSafeStage = false
+		return d.next(ctx, framer.StreamerResponse{}, reason)
 	}
 	return nil
 }
 
-func (d *dataRuntime) flushAuthorityChanges(ctx context.Context) error {
+// cycle ingests fr, runs the scheduler once at elapsed, and returns the channel
+// writes made by the program during the cycle after enforcing its safety envelopes.
+func (d *dataRuntime) cycle(
+	ctx context.Context,
+	fr telem.Frame[uint32],
+	elapsed telem.TimeSpan,
+	reason node.RunReason,
+) (telem.Frame[uint32], bool) {
+	d.state.channel.Ingest(fr)
+	if d.guard != nil {
+		d.guard.observe(fr)
+	}
+	d.scheduler.Next(ctx, elapsed, reason)
+	d.state.channel.ClearReads()
+	d.state.series.Clear()
+	d.state.strings.Clear()
+	out, changed := d.state.channel.Flush(telem.Frame[uint32]{})
+	if d.guard == nil || !changed {
+		return out, changed
+	}
+	for _, stage := range d.guard.enforce(ctx, out) {
+		if slices.Contains(d.scheduler.ActiveStages(), stage) {
+			continue
+		}
+		if d.scheduler.EnterStage(ctx, stage.Scope, stage.Step) {
+			d.enteredSafeStage = true
+		}
+	}
+	d.guard.observe(out)
+	return out, changed
+}
+
+func (d *dataRuntime) flushAuthorityChanges(ctx context.Context, elapsed telem.TimeSpan) error {
 	changes := d.state.authority.Flush()
 	if len(changes) == 0 {
 		return nil
 	}
 	cfg := writer.Config{}
 	for _, change := range changes {
+		if d.tracer != nil {
+			d.tracer.traceAuthority(elapsed, change)
+		}
 		if change.Channel != nil {
 			cfg.Keys = append(cfg.Keys, distchannel.Key(*change.Channel))
 			cfg.Authorities = append(cfg.Authorities, control.Authority(change.Authority))
@@ -450,8 +700,24 @@ func (d *dataRuntime) Flow(sCtx signal.Context, opts ...confluence.Option) {
 	if d.Out != nil {
 		o.AttachClosables(d.Out)
 	}
-	signal.GoRange(sCtx, d.In.Outlet(), func(ctx context.Context, res framer.StreamerResponse) error {
-		return d.next(ctx, res, node.ReasonChannelInput)
+	sCtx.Go(func(ctx context.Context) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case rl := <-d.reloads:
+				if err := d.swap(ctx, rl); err != nil {
+					return err
+				}
+			case res, ok := <-d.In.Outlet():
+				if !ok {
+					return nil
+				}
+				if err := d.next(ctx, res, node.ReasonChannelInput); err != nil {
+					return err
+				}
+			}
+		}
 	}, o.Signal...)
 }
 
@@ -478,6 +744,15 @@ func (r *tickerRuntime) Flow(sCtx signal.Context, opts ...confluence.Option) {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
+			case rl := <-r.reloads:
+				if err := r.swap(ctx, rl); err != nil {
+					return err
+				}
+				// Fire immediately so the timer nodes of the new program seed
+				// their first deadline.
+				drainTimer(timer)
+				timer.Reset(0)
+				continue
 			case <-timer.C:
 				runReason = node.ReasonTimerTick
 			case res, ok = <-r.In.Outlet():
@@ -489,14 +764,7 @@ func (r *tickerRuntime) Flow(sCtx signal.Context, opts ...confluence.Option) {
 			if err := r.next(ctx, res, runReason); err != nil {
 				return err
 			}
-			// Drain the timer channel before resetting to avoid stale
-			// values from a simultaneous fire during the select.
-			if !timer.Stop() {
-				select {
-				case <-timer.C:
-				default:
-				}
-			}
+			drainTimer(timer)
 			deadline := r.scheduler.NextDeadline()
 			elapsed := telem.Since(r.startTime)
 			if deadline == telem.TimeSpanMax {
@@ -511,6 +779,23 @@ func (r *tickerRuntime) Flow(sCtx signal.Context, opts ...confluence.Option) {
 	}, o.Signal...)
 }
 
+// drainTimer stops the timer and drains its channel before a reset, avoiding stale
+// values from a simultaneous fire during a select.
+func drainTimer(timer *stdtime.Timer) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+}
+
+// hasIntervals reports whether a program with the given base timer interval has
+// timers, and so needs a runtime that wakes on their deadlines.
+func hasIntervals(baseInterval telem.TimeSpan) bool {
+	return baseInterval != telem.TimeSpan(math.MaxInt64)
+}
+
 const DefaultAuthority = control.AuthorityAbsolute
 
 // buildAuthorities constructs a per-channel authority slice from the static
@@ -21,6 +21,7 @@ import (
 	"github.com/synnaxlabs/arc"
 	"github.com/synnaxlabs/arc/graph"
 	"github.com/synnaxlabs/arc/ir"
+	"github.com/synnaxlabs/arc/runtime/scheduler"
 	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
 	"github.com/synnaxlabs/synnax/pkg/distribution/framer"
 	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
@@ -160,6 +161,29 @@ var _ = Describe("Task", Ordered, func() {
 		}
 	}
 
+	writeInput := func(ctx context.Context, ch *channel.Channel, value uint8) {
+		w := MustSucceed(dist.Framer.OpenWriter(ctx, framer.WriterConfig{
+			Keys:  []channel.Key{ch.Key()},
+			Start: telem.Now(),
+		}))
+		Expect(w.Write(frame.NewUnary(ch.Key(), telem.NewSeriesV(value)))).To(BeTrue())
+		Expect(w.Close()).To(Succeed())
+	}
+
+	expectCount := func(responses <-chan framer.StreamerResponse, ch *channel.Channel, count int64) {
+		var fr framer.StreamerResponse
+		Eventually(responses).Should(Receive(&fr))
+		Expect(telem.ValueAt[int64](fr.Frame.Get(ch.Key()).Series[0], -1)).To(Equal(count))
+	}
+
+	expectStatusMessage := func(ctx context.Context, key task.Key) Assertion {
+		var stat task.Status
+		Expect(status.NewRetrieve[task.StatusDetails](statusSvc).
+			Where(status.MatchKeys[task.StatusDetails](task.OntologyID(key).String())).
+			Entry(&stat).Exec(ctx, nil)).To(Succeed())
+		return Expect(stat.Message)
+	}
+
 	bangBangProg := func(ch1, ch2, stopSignal, startSignal *channel.Channel) arc.Text {
 		return arc.Text{
 			Raw: fmt.Sprintf(`
@@ -1452,4 +1476,582 @@ var _ = Describe("Task", Ordered, func() {
 			Entry("with index-less sessions injected while watching", 6, true),
 		)
 	})
+
+	Describe("Persisted State", func() {
+		newPersistentFactory := func(ctx context.Context, prog arc.Text) driver.Factory {
+			return MustSucceed(runtime.NewFactory(runtime.FactoryConfig{
+				Channel: svcchannel.Wrap(dist.Channel),
+				Framer:  dist.Framer,
+				Status:  statusSvc,
+				DB:      dist.DB,
+				GetProgram: func(_ context.Context, key uuid.UUID) (svcarc.Arc, error) {
+					resolver := symbol.NewChannelResolver(dist.Channel, nil)
+					root := arc.NewRoot(resolver, arcstatus.NewSymbols()...)
+					module, err := arc.CompileText(ctx, prog, root)
+					if err != nil {
+						return svcarc.Arc{}, err
+					}
+					return svcarc.Arc{Key: key, Name: "test-arc", Text: prog, Program: &module}, nil
+				},
+			}))
+		}
+
+		counterProgram := func(inputCh, countCh *channel.Channel, increment int) arc.Text {
+			return arc.Text{Raw: fmt.Sprintf(`
+				func count{out chan i64}(input u8) {
+					n i64 $= 0
+					n = n + %d
+					out = n
+				}
+				%s -> count{out=%s}
+
+				sequence main {
+					stage first {
+						%s > 1 => second
+					}
+					stage second {
+						%s > 5 => first
+					}
+				}
+				%s => main
+			`, increment, inputCh.Name, countCh.Name, inputCh.Name, inputCh.Name, inputCh.Name)}
+		}
+
+		configure := func(ctx context.Context, factory driver.Factory, key task.Key) driver.Task {
+			return MustSucceed(factory.ConfigureTask(ctx, task.Task{
+				Key:  key,
+				Name: "test-persisted-state",
+				Type: runtime.TaskType,
+				Config: configToMap(runtime.TaskConfig{
+					ArcKey:       uuid.New(),
+					PersistState: true,
+				}),
+			}))
+		}
+
+		It("Should restore stateful variables and the active stage after a restart", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "persist_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "persist_count", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 200)
+			factory := newPersistentFactory(ctx, counterProgram(inputCh, countCh, 1))
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{countCh.Key()}, 10)
+			defer closeStreamer()
+
+			t := configure(ctx, factory, key)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 1)
+			expectCount(responses, countCh, 1)
+			writeInput(ctx, inputCh, 2)
+			expectCount(responses, countCh, 2)
+			Expect(t.Stop()).To(Succeed())
+
+			var snap runtime.Snapshot
+			Expect(gorp.NewRetrieve[task.Key, runtime.Snapshot]().
+				Where(gorp.MatchKeys[task.Key, runtime.Snapshot](key)).
+				Entry(&snap).
+				Exec(ctx, dist.DB)).To(Succeed())
+			Expect(snap.Scheduler.Scopes).To(ContainElement(
+				scheduler.ScopeSnapshot{Path: "main", Active: true, Step: "second"},
+			))
+
+			t = configure(ctx, factory, key)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+			expectStatusMessage(ctx, key).To(ContainSubstring("state restored from"))
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 0)
+			expectCount(responses, countCh, 3)
+		})
+
+		It("Should discard state captured from a different program", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "persist_changed_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "persist_changed_count", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 201)
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{countCh.Key()}, 10)
+			defer closeStreamer()
+
+			t := configure(ctx, newPersistentFactory(ctx, counterProgram(inputCh, countCh, 1)), key)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 2)
+			expectCount(responses, countCh, 1)
+			Expect(t.Stop()).To(Succeed())
+
+			t = configure(ctx, newPersistentFactory(ctx, counterProgram(inputCh, countCh, 10)), key)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+			expectStatusMessage(ctx, key).To(Equal("Task started successfully"))
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 0)
+			expectCount(responses, countCh, 10)
+		})
+
+		It("Should start from the initial state after the state is reset", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "persist_reset_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "persist_reset_count", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 202)
+			factory := newPersistentFactory(ctx, counterProgram(inputCh, countCh, 1))
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{countCh.Key()}, 10)
+			defer closeStreamer()
+
+			t := configure(ctx, factory, key)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 2)
+			expectCount(responses, countCh, 1)
+			Expect(t.Exec(ctx, task.Command{Type: "reset_state"})).
+				To(MatchError(ContainSubstring("cannot reset the state of a running task")))
+			Expect(t.Stop()).To(Succeed())
+			Expect(t.Exec(ctx, task.Command{Type: "reset_state"})).To(Succeed())
+
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 0)
+			expectCount(responses, countCh, 1)
+		})
+
+		It("Should delete the persisted state when the task is deleted", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "persist_delete_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "persist_delete_count", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 204)
+			factory := newPersistentFactory(ctx, counterProgram(inputCh, countCh, 1))
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{countCh.Key()}, 10)
+			defer closeStreamer()
+
+			t := configure(ctx, factory, key)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 2)
+			expectCount(responses, countCh, 1)
+			Expect(t.Stop()).To(Succeed())
+			snapshotExists := func() (bool, error) {
+				return gorp.NewRetrieve[task.Key, runtime.Snapshot]().
+					Where(gorp.MatchKeys[task.Key, runtime.Snapshot](key)).
+					Exists(ctx, dist.DB)
+			}
+			Expect(snapshotExists()).To(BeTrue())
+
+			deleter := factory.(driver.Deleter)
+			Expect(deleter.DeleteTask(ctx, key)).To(Succeed())
+			Expect(snapshotExists()).To(BeFalse())
+			Expect(deleter.DeleteTask(ctx, key)).To(Succeed())
+		})
+
+		It("Should fail to start without a DB", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "persist_no_db_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "persist_no_db_count", telem.Int64T)
+			t := configure(ctx, newTextFactory(ctx, counterProgram(inputCh, countCh, 1)), task.NewKey(rack.NewKey(1, 1), 203))
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).
+				To(MatchError(ContainSubstring("a db is required to persist task state")))
+		})
+	})
+
+	Describe("Hot Reload", func() {
+		// newReloadableFactory returns a factory whose GetProgram compiles the
+		// current value of prog, so that tests can edit the program of a running task.
+		newReloadableFactory := func(ctx context.Context, prog *arc.Text, db *gorp.DB) driver.Factory {
+			return MustSucceed(runtime.NewFactory(runtime.FactoryConfig{
+				Channel: svcchannel.Wrap(dist.Channel),
+				Framer:  dist.Framer,
+				Status:  statusSvc,
+				DB:      db,
+				GetProgram: func(_ context.Context, key uuid.UUID) (svcarc.Arc, error) {
+					resolver := symbol.NewChannelResolver(dist.Channel, nil)
+					root := arc.NewRoot(resolver, arcstatus.NewSymbols()...)
+					module, err := arc.CompileText(ctx, *prog, root)
+					if err != nil {
+						return svcarc.Arc{}, err
+					}
+					return svcarc.Arc{Key: key, Name: "test-arc", Text: *prog, Program: &module}, nil
+				},
+			}))
+		}
+
+		// counterProgram counts input samples by increment. preamble is inserted
+		// ahead of the counter's stateful variable, shifting the IDs of the
+		// variables in the compiled program.
+		counterProgram := func(inputCh, countCh *channel.Channel, preamble string, increment int) arc.Text {
+			return arc.Text{Raw: fmt.Sprintf(`
+				func count{out chan i64}(input u8) {
+					%s
+					n i64 $= 0
+					n = n + %d
+					out = n
+				}
+				%s -> count{out=%s}
+
+				sequence main {
+					stage first {
+						%s > 1 => second
+					}
+					stage second {
+						%s > 5 => first
+					}
+				}
+				%s => main
+			`, preamble, increment, inputCh.Name, countCh.Name, inputCh.Name, inputCh.Name, inputCh.Name)}
+		}
+
+		configure := func(ctx context.Context, factory driver.Factory, key task.Key, persist bool) driver.Task {
+			return MustSucceed(factory.ConfigureTask(ctx, task.Task{
+				Key:  key,
+				Name: "test-hot-reload",
+				Type: runtime.TaskType,
+				Config: configToMap(runtime.TaskConfig{
+					ArcKey:       uuid.New(),
+					PersistState: persist,
+				}),
+			}))
+		}
+
+		It("Should carry stateful variables and the active stage over to the new program", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "reload_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "reload_count", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 300)
+			prog := counterProgram(inputCh, countCh, "", 1)
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{countCh.Key()}, 10)
+			defer closeStreamer()
+
+			t := configure(ctx, newReloadableFactory(ctx, &prog, dist.DB), key, true)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 1)
+			expectCount(responses, countCh, 1)
+			writeInput(ctx, inputCh, 2)
+			expectCount(responses, countCh, 2)
+
+			prog = counterProgram(inputCh, countCh, "scratch i64 := 0\nlast f64 $= 0.0", 10)
+			Expect(t.Exec(ctx, task.Command{Type: "reload"})).To(Succeed())
+			expectStatusMessage(ctx, key).To(Equal("Task reloaded successfully"))
+
+			var snap runtime.Snapshot
+			Expect(gorp.NewRetrieve[task.Key, runtime.Snapshot]().
+				Where(gorp.MatchKeys[task.Key, runtime.Snapshot](key)).
+				Entry(&snap).
+				Exec(ctx, dist.DB)).To(Succeed())
+			Expect(snap.Scheduler.Scopes).To(ContainElement(
+				scheduler.ScopeSnapshot{Path: "main", Active: true, Step: "second"},
+			))
+
+			writeInput(ctx, inputCh, 0)
+			expectCount(responses, countCh, 12)
+		})
+
+		It("Should keep control of its channels across a reload", func(ctx SpecContext) {
+			ch := createVirtualCh(ctx, "reload_auth", telem.Uint8T)
+			output := func(authority, value int) arc.Text {
+				return arc.Text{Raw: fmt.Sprintf(`
+					authority %d
+					func output() {
+						%s = %d
+					}
+					interval{period=20ms} -> output{}
+				`, authority, ch.Name, value)}
+			}
+			prog := output(200, 42)
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{ch.Key()}, 10)
+			defer closeStreamer()
+
+			t := configure(ctx, newReloadableFactory(ctx, &prog, nil), task.NewKey(rack.NewKey(1, 1), 301), false)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+			Eventually(responses).Should(Receive())
+
+			w := MustSucceed(dist.Framer.OpenWriter(ctx, framer.WriterConfig{
+				Keys:        channel.Keys{ch.Key()},
+				Start:       telem.Now(),
+				Authorities: []control.Authority{control.Authority(100)},
+				Sync:        new(true),
+			}))
+			defer func() { Expect(w.Close()).To(Succeed()) }()
+			Expect(w.Write(frame.NewUnary(ch.Key(), telem.NewSeriesV[uint8](99)))).To(BeFalse())
+
+			prog = output(200, 43)
+			Expect(t.Exec(ctx, task.Command{Type: "reload"})).To(Succeed())
+			Eventually(func(g Gomega) {
+				var fr framer.StreamerResponse
+				g.Eventually(responses).Should(Receive(&fr))
+				g.Expect(telem.ValueAt[uint8](fr.Frame.Get(ch.Key()).Series[0], -1)).To(Equal(uint8(43)))
+			}).Should(Succeed())
+			Expect(w.Write(frame.NewUnary(ch.Key(), telem.NewSeriesV[uint8](99)))).To(BeFalse())
+
+			prog = output(50, 43)
+			Expect(t.Exec(ctx, task.Command{Type: "reload"})).To(Succeed())
+			Eventually(func() (bool, error) {
+				return w.Write(frame.NewUnary(ch.Key(), telem.NewSeriesV[uint8](99)))
+			}).Should(BeTrue())
+		})
+
+		It("Should reject a program that writes to channels the writer doesn't hold", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "reload_reject_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "reload_reject_count", telem.Int64T)
+			otherCh := createVirtualCh(ctx, "reload_reject_other", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 302)
+			prog := counterProgram(inputCh, countCh, "", 1)
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{countCh.Key()}, 10)
+			defer closeStreamer()
+
+			t := configure(ctx, newReloadableFactory(ctx, &prog, nil), key, false)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 0)
+			expectCount(responses, countCh, 1)
+
+			prog = counterProgram(inputCh, otherCh, "", 10)
+			Expect(t.Exec(ctx, task.Command{Type: "reload"})).
+				To(MatchError(ContainSubstring("which the running program does not write to")))
+			expectStatusMessage(ctx, key).To(ContainSubstring("restart the task instead"))
+
+			writeInput(ctx, inputCh, 0)
+			expectCount(responses, countCh, 2)
+		})
+
+		It("Should start a stopped task with the reloaded program", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "reload_stopped_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "reload_stopped_count", telem.Int64T)
+			prog := counterProgram(inputCh, countCh, "", 1)
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{countCh.Key()}, 10)
+			defer closeStreamer()
+
+			t := configure(ctx, newReloadableFactory(ctx, &prog, nil), task.NewKey(rack.NewKey(1, 1), 303), false)
+			prog = counterProgram(inputCh, countCh, "", 5)
+			Expect(t.Exec(ctx, task.Command{Type: "reload"})).To(Succeed())
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 0)
+			expectCount(responses, countCh, 5)
+		})
+	})
+
+	Describe("Tracing", func() {
+		// sequence main advances from first to second once the input exceeds 1,
+		// counting every input it receives.
+		sequenceProgram := func(inputCh, countCh *channel.Channel) arc.Text {
+			return arc.Text{Raw: fmt.Sprintf(`
+				func count{out chan i64}(input u8) {
+					n i64 $= 0
+					n = n + 1
+					out = n
+				}
+				%s -> count{out=%s}
+
+				sequence main {
+					stage first {
+						%s > 1 => second
+					}
+					stage second {
+						%s > 5 => first
+					}
+				}
+				%s => main
+			`, inputCh.Name, countCh.Name, inputCh.Name, inputCh.Name, inputCh.Name)}
+		}
+
+		configure := func(ctx context.Context, prog arc.Text, key task.Key, breakpoints ...string) driver.Task {
+			return MustSucceed(newTextFactory(ctx, prog).ConfigureTask(ctx, task.Task{
+				Key:  key,
+				Name: "test-tracing",
+				Type: runtime.TaskType,
+				Config: configToMap(runtime.TaskConfig{
+					ArcKey: uuid.New(),
+					Trace:  runtime.TraceConfig{Enabled: true, Breakpoints: breakpoints},
+				}),
+			}))
+		}
+
+		statusMessage := func(ctx context.Context, key task.Key) string {
+			var stat task.Status
+			Expect(status.NewRetrieve[task.StatusDetails](statusSvc).
+				Where(status.MatchKeys[task.StatusDetails](task.OntologyID(key).String())).
+				Entry(&stat).Exec(ctx, nil)).To(Succeed())
+			return stat.Message
+		}
+
+		It("Should stream stage activations and transitions to the trace channel", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "trace_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "trace_count", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 300)
+			t := configure(ctx, sequenceProgram(inputCh, countCh), key)
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+
+			var traceCh channel.Channel
+			Expect(dist.Channel.NewRetrieve().
+				Where(channel.MatchNames(runtime.TraceChannelName(key))).
+				Entry(&traceCh).
+				Exec(ctx, nil)).To(Succeed())
+			Expect(traceCh.Internal).To(BeTrue())
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{traceCh.Key()}, 10)
+			defer closeStreamer()
+
+			var events []scheduler.Event
+			collect := func() []scheduler.Event {
+				for {
+					select {
+					case res := <-responses:
+						for _, s := range res.Frame.Get(traceCh.Key()).Series {
+							for _, e := range MustSucceed(telem.UnmarshalJSONSeries[runtime.TraceEvent](s)) {
+								e.Elapsed = 0
+								events = append(events, e.Event)
+							}
+						}
+					default:
+						return events
+					}
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 1)
+			Eventually(collect).Should(ContainElements(
+				scheduler.Event{Kind: scheduler.EventNodeExecuted, Node: "count_0"},
+				scheduler.Event{Kind: scheduler.EventStageEntered, Scope: "main", Step: "first"},
+			))
+			writeInput(ctx, inputCh, 2)
+			Eventually(collect).Should(ContainElements(
+				scheduler.Event{Kind: scheduler.EventStageExited, Scope: "main", Step: "first"},
+				scheduler.Event{Kind: scheduler.EventStageEntered, Scope: "main", Step: "second"},
+			))
+			Expect(events).To(ContainElement(And(
+				HaveField("Kind", scheduler.EventTransitionEvaluated),
+				HaveField("Scope", "main"),
+				HaveField("Step", "first"),
+				HaveField("Target", "second"),
+				HaveField("Fired", true),
+			)))
+		})
+
+		It("Should pause at a breakpoint until resumed", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "trace_breakpoint_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "trace_breakpoint_count", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 301)
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{countCh.Key()}, 10)
+			defer closeStreamer()
+			t := configure(ctx, sequenceProgram(inputCh, countCh), key, "main.second")
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 1)
+			expectCount(responses, countCh, 1)
+			writeInput(ctx, inputCh, 2)
+			expectCount(responses, countCh, 2)
+			Eventually(func() string { return statusMessage(ctx, key) }).
+				Should(Equal("Paused at breakpoint main.second"))
+
+			writeInput(ctx, inputCh, 0)
+			Consistently(responses, 100*time.Millisecond).ShouldNot(Receive())
+			Expect(t.Exec(ctx, task.Command{Type: "resume"})).To(Succeed())
+			expectCount(responses, countCh, 3)
+			Eventually(func() string { return statusMessage(ctx, key) }).
+				Should(Equal("Resumed from breakpoint main.second"))
+		})
+
+		It("Should reject reloads while paused at a breakpoint", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "trace_reload_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "trace_reload_count", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 304)
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{countCh.Key()}, 10)
+			defer closeStreamer()
+			t := configure(ctx, sequenceProgram(inputCh, countCh), key, "main.second")
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 1)
+			expectCount(responses, countCh, 1)
+			writeInput(ctx, inputCh, 2)
+			expectCount(responses, countCh, 2)
+			Eventually(func() string { return statusMessage(ctx, key) }).
+				Should(Equal("Paused at breakpoint main.second"))
+
+			Expect(t.Exec(ctx, task.Command{Type: "reload"})).
+				Error().To(MatchError(ContainSubstring("paused at a breakpoint")))
+			Expect(t.Exec(ctx, task.Command{Type: "resume"})).To(Succeed())
+			writeInput(ctx, inputCh, 0)
+			expectCount(responses, countCh, 3)
+			Expect(t.Exec(ctx, task.Command{Type: "reload"})).To(Succeed())
+		})
+
+		It("Should stop a task paused at a breakpoint", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "trace_stop_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "trace_stop_count", telem.Int64T)
+			key := task.NewKey(rack.NewKey(1, 1), 302)
+			t := configure(ctx, sequenceProgram(inputCh, countCh), key, "main.first")
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			time.Sleep(20 * time.Millisecond)
+			writeInput(ctx, inputCh, 1)
+			Eventually(func() string { return statusMessage(ctx, key) }).
+				Should(Equal("Paused at breakpoint main.first"))
+			Expect(t.Stop()).To(Succeed())
+			Expect(statusMessage(ctx, key)).To(Equal("Task stopped successfully"))
+		})
+
+		It("Should ignore resume commands when the task is not paused", func(ctx SpecContext) {
+			inputCh := createVirtualCh(ctx, "trace_resume_input", telem.Uint8T)
+			countCh := createVirtualCh(ctx, "trace_resume_count", telem.Int64T)
+			t := configure(ctx, sequenceProgram(inputCh, countCh), task.NewKey(rack.NewKey(1, 1), 303))
+			Expect(t.Exec(ctx, task.Command{Type: "resume"})).To(Succeed())
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			Expect(t.Exec(ctx, task.Command{Type: "resume"})).To(Succeed())
+			Expect(t.Stop()).To(Succeed())
+		})
+	})
+
+	Describe("Safety Envelopes", func() {
+		It("Should clamp writes, report the violation, and run the safe stage", func(ctx SpecContext) {
+			input := createVirtualCh(ctx, "safety_input", telem.Uint8T)
+			out := createVirtualCh(ctx, "safety_out", telem.Uint8T)
+			vent := createVirtualCh(ctx, "safety_vent", telem.Uint8T)
+			prog := arc.Text{
+				Raw: fmt.Sprintf(`
+					safety %[2]s{max=10, safe_stage=main.abort}
+					sequence main {
+						stage run {
+							%[1]s -> %[2]s
+						}
+						stage abort {
+							1 -> %[3]s
+						}
+					}
+					%[1]s => main
+				`, input.Name, out.Name, vent.Name),
+			}
+			responses, closeStreamer := openTestStreamer(ctx, channel.Keys{out.Key(), vent.Key()}, 10)
+			defer closeStreamer()
+
+			t := newTask(ctx, newTextFactory(ctx, prog))
+			Expect(t.Exec(ctx, task.Command{Type: "start"})).To(Succeed())
+			defer func() { Expect(t.Stop()).To(Succeed()) }()
+
+			Eventually(func(g Gomega) {
+				writeInput(ctx, input, 50)
+				var stat status.Status[any]
+				g.Expect(status.NewRetrieve[any](statusSvc).
+					Where(status.MatchNames[any](out.Name+" safety")).
+					Entry(&stat).Exec(ctx, nil)).To(Succeed())
+				g.Expect(stat.Variant).To(BeEquivalentTo("warning"))
+				g.Expect(stat.Message).To(Equal(
+					fmt.Sprintf("Write to %s 50 clamped to 10", out.Name),
+				))
+			}).Should(Succeed())
+			var outValues, ventValues []uint8
+			Eventually(func(g Gomega) {
+				var fr framer.StreamerResponse
+				g.Expect(responses).To(Receive(&fr))
+				for _, s := range fr.Frame.Get(out.Key()).Series {
+					outValues = append(outValues, telem.UnmarshalSeries[uint8](s)...)
+				}
+				for _, s := range fr.Frame.Get(vent.Key()).Series {
+					ventValues = append(ventValues, telem.UnmarshalSeries[uint8](s)...)
+				}
+				g.Expect(ventValues).To(ContainElement(uint8(1)))
+			}).Should(Succeed())
+			Expect(outValues).To(HaveEach(uint8(10)))
+		})
+	})
 })
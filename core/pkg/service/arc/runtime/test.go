@@ -0,0 +1,155 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/arc/parser"
+	"github.com/synnaxlabs/arc/runtime/node"
+	"github.com/synnaxlabs/arc/runtime/scheduler"
+	arctest "github.com/synnaxlabs/arc/test"
+	"github.com/synnaxlabs/synnax/pkg/service/arc"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/symbol"
+	"github.com/synnaxlabs/synnax/pkg/service/channel"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	xio "github.com/synnaxlabs/x/io"
+	"github.com/synnaxlabs/x/override"
+	xstatus "github.com/synnaxlabs/x/status"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// TestConfig is the configuration for running the test blocks of an Arc program.
+type TestConfig struct {
+	alamos.Instrumentation
+	// Channel is used for resolving the channels the tests feed and assert on.
+	//
+	// [REQUIRED]
+	Channel *channel.Service
+	// Program is the Arc under test. Its Program must be compiled.
+	//
+	// [REQUIRED]
+	Program arc.Arc
+	// Tests are the tests to run, parsed from the Arc's text.
+	//
+	// [OPTIONAL]
+	Tests []arctest.Test
+	// Parser is the language configuration the program was compiled with.
+	//
+	// [OPTIONAL]
+	Parser parser.Config
+}
+
+var (
+	_ config.Config[TestConfig] = TestConfig{}
+	// DefaultTestConfig is the default configuration for running Arc tests.
+	DefaultTestConfig = TestConfig{}
+)
+
+// Override implements config.Config.
+func (c TestConfig) Override(other TestConfig) TestConfig {
+	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
+	c.Channel = override.Nil(c.Channel, other.Channel)
+	c.Program = override.If(c.Program, other.Program, other.Program.Program != nil)
+	c.Tests = override.Slice(c.Tests, other.Tests)
+	c.Parser = override.If(c.Parser, other.Parser, other.Parser.AllowDashedNames)
+	return c
+}
+
+// Validate implements config.Config.
+func (c TestConfig) Validate() error {
+	v := validate.New("arc.runtime.test")
+	validate.NotNil(v, "channel", c.Channel)
+	validate.NotNil(v, "program", c.Program.Program)
+	return v.Error()
+}
+
+// Test runs the test blocks of an Arc program. Each test runs against a fresh
+// instance of the program on a simulated clock that starts at the zero timestamp.
+// Channel writes and status updates made by the program are captured for the
+// test's assertions instead of being sent to the cluster.
+func Test(ctx context.Context, cfgs ...TestConfig) ([]arctest.Result, error) {
+	cfg, err := config.New(DefaultTestConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Tests) == 0 {
+		return nil, nil
+	}
+	stateCfg, err := NewStateConfig(ctx, cfg.Channel.Service, *cfg.Program.Program)
+	if err != nil {
+		return nil, err
+	}
+	return arctest.Run(ctx, arctest.Config{
+		Channels: symbol.NewChannelResolver(cfg.Channel.Service, nil),
+		Digests:  stateCfg.ChannelDigests,
+		Parser:   cfg.Parser,
+		Open: func(ctx context.Context, env arctest.Env) (arctest.Instance, error) {
+			drt, baseInterval, closers, err := openProgram(ctx, programConfig{
+				prog:     cfg.Program,
+				stateCfg: stateCfg,
+				status: statusRecorder(func(keyOrName, message string, variant xstatus.Variant) {
+					env.SetStatus(arctest.StatusSet{
+						KeyOrName: keyOrName,
+						Message:   message,
+						Variant:   string(variant),
+						Time:      env.Now(),
+					})
+				}),
+				reporter: func(_ context.Context, _ xstatus.Variant, message string) {
+					env.ReportError(errors.New(message))
+				},
+				now: env.Now,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &testInstance{
+				drt:          drt,
+				baseInterval: baseInterval,
+				closers:      closers,
+			}, nil
+		},
+	}, cfg.Tests)
+}
+
+// testInstance adapts an instantiated program to the Arc test runner.
+type testInstance struct {
+	drt          dataRuntime
+	baseInterval telem.TimeSpan
+	closers      xio.MultiCloser
+}
+
+var _ arctest.Instance = (*testInstance)(nil)
+
+// Cycle implements arctest.Instance.
+func (t *testInstance) Cycle(
+	ctx context.Context,
+	fr telem.Frame[uint32],
+	elapsed telem.TimeSpan,
+	reason node.RunReason,
+) (telem.Frame[uint32], bool) {
+	writes, changed := t.drt.cycle(ctx, fr, elapsed, reason)
+	// Authority changes only matter to a live writer.
+	t.drt.state.authority.Flush()
+	return writes, changed
+}
+
+// Scheduler implements arctest.Instance.
+func (t *testInstance) Scheduler() *scheduler.Scheduler { return t.drt.scheduler }
+
+// BaseInterval implements arctest.Instance.
+func (t *testInstance) BaseInterval() telem.TimeSpan { return t.baseInterval }
+
+// Close implements arctest.Instance.
+func (t *testInstance) Close() error { return t.closers.Close() }
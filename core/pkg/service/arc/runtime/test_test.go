@@ -0,0 +1,134 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/arc"
+	arctest "github.com/synnaxlabs/arc/test"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	svcarc "github.com/synnaxlabs/synnax/pkg/service/arc"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/runtime"
+	arcstatus "github.com/synnaxlabs/synnax/pkg/service/arc/status"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/symbol"
+	svcchannel "github.com/synnaxlabs/synnax/pkg/service/channel"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Test", Ordered, func() {
+	var dist mock.Node
+
+	BeforeAll(func(ctx SpecContext) {
+		distB := DeferClose(mock.NewCluster())
+		dist = DeferClose(distB.Provision(ctx))
+	})
+
+	createIndexed := func(ctx context.Context, prefix string, dataType telem.DataType) *channel.Channel {
+		idx := &channel.Channel{
+			Name:     prefix + "_idx_" + uuid.NewString()[:8],
+			IsIndex:  true,
+			DataType: telem.TimeStampT,
+		}
+		Expect(dist.Channel.Create(ctx, idx)).To(Succeed())
+		data := &channel.Channel{
+			Name:       prefix + "_" + uuid.NewString()[:8],
+			LocalIndex: idx.LocalKey,
+			DataType:   dataType,
+		}
+		Expect(dist.Channel.Create(ctx, data)).To(Succeed())
+		return data
+	}
+
+	runTests := func(ctx context.Context, raw string) []arctest.Result {
+		resolver := symbol.NewChannelResolver(dist.Channel, nil)
+		root := arc.NewRoot(resolver, arcstatus.NewSymbols()...)
+		prog := MustSucceed(arc.CompileText(ctx, arc.Text{Raw: raw}, root))
+		tests := MustSucceed(arctest.Parse(raw))
+		return MustSucceed(runtime.Test(ctx, runtime.TestConfig{
+			Channel: svcchannel.Wrap(dist.Channel),
+			Program: svcarc.Arc{Key: uuid.New(), Name: "test", Program: &prog},
+			Tests:   tests,
+		}))
+	}
+
+	It("Should run the test blocks of a program against the simulated clock", func(ctx SpecContext) {
+		pressure := createIndexed(ctx, "pressure", telem.Float32T)
+		vent := createIndexed(ctx, "vent_cmd", telem.Uint8T)
+		results := runTests(ctx, fmt.Sprintf(`
+			sequence abort {
+				stage armed {
+					%[1]s > 100 => vent
+				}
+				stage vent {
+					1 -> %[2]s,
+					wait{duration=2s} => safe
+				}
+				stage safe {
+					0 -> %[2]s
+				}
+			}
+			%[1]s => abort
+
+			test "vents then safes" {
+				feed %[1]s [30, 120] every 1s
+				expect stage abort.vent
+				expect %[2]s == 1
+				advance 2s
+				expect stage abort.safe
+				expect %[2]s == 0
+			}
+
+			test "vents below the threshold" {
+				feed %[1]s 90
+				expect stage abort.vent
+			}
+		`, pressure.Name, vent.Name))
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Name).To(Equal("vents then safes"))
+		Expect(results[0].Failures).To(BeEmpty())
+		Expect(results[1].Passed()).To(BeFalse())
+		Expect(results[1].Failures[0].Message).
+			To(Equal("expected stage abort.vent to be active, got abort.armed"))
+	})
+
+	It("Should capture status updates for assertions", func(ctx SpecContext) {
+		pressure := createIndexed(ctx, "status_pressure", telem.Float32T)
+		results := runTests(ctx, fmt.Sprintf(`
+			import status
+			%[1]s > 100 -> status.set{key_or_name="ox_alarm", message="Overpressure", variant="error"}
+
+			test "alarms" {
+				feed %[1]s 200
+				expect status "ox_alarm" error "Overpressure"
+				expect status "ox_alarm" warning
+			}
+		`, pressure.Name))
+		Expect(results[0].Failures).To(HaveLen(1))
+		Expect(results[0].Failures[0].Message).
+			To(Equal(`expected status "ox_alarm" to have variant warning, got error`))
+	})
+
+	It("Should return no results for a program without tests", func(ctx SpecContext) {
+		Expect(runTests(ctx, `func f() {}`)).To(BeEmpty())
+	})
+
+	It("Should return a validation error for an invalid config", func(ctx SpecContext) {
+		Expect(runtime.Test(ctx, runtime.TestConfig{
+			Channel: svcchannel.Wrap(dist.Channel),
+		})).Error().To(MatchError(ContainSubstring("program: must be non-nil")))
+	})
+})
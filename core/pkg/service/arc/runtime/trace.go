@@ -0,0 +1,215 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/synnaxlabs/arc/runtime/scheduler"
+	stlcontrol "github.com/synnaxlabs/arc/stl/control"
+	"github.com/synnaxlabs/synnax/pkg/service/channel"
+	"github.com/synnaxlabs/synnax/pkg/service/task"
+	"github.com/synnaxlabs/x/control"
+	"github.com/synnaxlabs/x/set"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// TraceConfig configures live execution tracing of an Arc task.
+type TraceConfig struct {
+	// Enabled sets whether the task streams its scheduler events to its trace
+	// channel while it runs.
+	Enabled bool `json:"enabled"`
+	// Breakpoints are the stages that pause the task when entered, each in the
+	// form "<sequence>.<stage>". A paused task resumes on the "resume" command.
+	// Breakpoints only apply when tracing is enabled.
+	Breakpoints []string `json:"breakpoints"`
+}
+
+// EventAuthorityChanged is the kind of trace event emitted when the task changes
+// its control authority over one or all of the channels it writes to.
+const EventAuthorityChanged scheduler.EventKind = "authority_changed"
+
+// TraceEvent is a single event written to the trace channel of a task.
+type TraceEvent struct {
+	scheduler.Event
+	// Channel is the channel whose authority changed, or zero if the change
+	// applies to every channel the task writes to.
+	Channel channel.Key `json:"channel,omitempty"`
+	// Authority is the new authority of an authority change.
+	Authority *control.Authority `json:"authority,omitempty"`
+}
+
+// TraceChannelName returns the name of the internal channel that the task with
+// the given key writes its trace events to.
+func TraceChannelName(key task.Key) string {
+	return fmt.Sprintf("sy_task_%s_trace", key)
+}
+
+// openTraceChannel creates the trace channel of the task with the given key, or
+// retrieves it if it already exists.
+func openTraceChannel(
+	ctx context.Context,
+	channels *channel.Service,
+	key task.Key,
+) (channel.Key, error) {
+	ch := channel.Channel{
+		Name:     TraceChannelName(key),
+		DataType: telem.JSONT,
+		Virtual:  true,
+		Internal: true,
+	}
+	if err := channels.Create(ctx, &ch, channel.RetrieveIfNameExists()); err != nil {
+		return 0, err
+	}
+	return ch.Key(), nil
+}
+
+// tracer buffers the scheduler events of a cycle so they can be written to the
+// trace channel alongside the cycle's channel writes, and detects when a cycle
+// enters a stage with a breakpoint.
+type tracer struct {
+	// key is the key of the trace channel.
+	key channel.Key
+	// breakpoints are the stages that pause the task, as "<sequence>.<stage>".
+	breakpoints set.Set[string]
+	// events are the events traced since the last flush.
+	events []TraceEvent
+	// breakpoint is the breakpoint hit during the current cycle, or empty if none
+	// was hit.
+	breakpoint string
+	// resume receives a value when the task is told to resume from a breakpoint.
+	resume chan struct{}
+	// done is closed when the task stops, releasing a paused task.
+	done chan struct{}
+	// pausedMu guards pausedC, which is read by the goroutine that executes task
+	// commands.
+	pausedMu sync.Mutex
+	// pausedC is closed while the task is paused at a breakpoint, and replaced when
+	// it resumes.
+	pausedC chan struct{}
+	// onPause is called when the task pauses at a breakpoint, once it is ready to
+	// be resumed.
+	onPause func(ctx context.Context, breakpoint string)
+	// onResume is called when the task resumes from a breakpoint.
+	onResume func(ctx context.Context, breakpoint string)
+}
+
+var _ scheduler.Tracer = (*tracer)(nil)
+
+func newTracer(
+	key channel.Key,
+	cfg TraceConfig,
+	onPause, onResume func(ctx context.Context, breakpoint string),
+) *tracer {
+	return &tracer{
+		key:         key,
+		breakpoints: set.New(cfg.Breakpoints...),
+		resume:      make(chan struct{}, 1),
+		done:        make(chan struct{}),
+		pausedC:     make(chan struct{}),
+		onPause:     onPause,
+		onResume:    onResume,
+	}
+}
+
+// Trace implements scheduler.Tracer.
+func (t *tracer) Trace(_ context.Context, event scheduler.Event) {
+	t.events = append(t.events, TraceEvent{Event: event})
+	if event.Kind != scheduler.EventStageEntered || t.breakpoint != "" {
+		return
+	}
+	if stage := event.Scope + "." + event.Step; t.breakpoints.Contains(stage) {
+		t.breakpoint = stage
+	}
+}
+
+// traceAuthority traces an authority change made by the program at elapsed.
+func (t *tracer) traceAuthority(elapsed telem.TimeSpan, change stlcontrol.AuthorityChange) {
+	authority := control.Authority(change.Authority)
+	event := TraceEvent{
+		Event:     scheduler.Event{Kind: EventAuthorityChanged, Elapsed: elapsed},
+		Authority: &authority,
+	}
+	if change.Channel != nil {
+		event.Channel = channel.Key(*change.Channel)
+	}
+	t.events = append(t.events, event)
+}
+
+// flush appends the buffered events to fr as a single JSON series on the trace
+// channel, returning whether any events were appended.
+func (t *tracer) flush(fr telem.Frame[uint32]) (telem.Frame[uint32], bool, error) {
+	if len(t.events) == 0 {
+		return fr, false, nil
+	}
+	series, err := telem.NewJSONSeries(t.events)
+	t.events = t.events[:0]
+	if err != nil {
+		return fr, false, err
+	}
+	return fr.Append(uint32(t.key), series), true, nil
+}
+
+// pause blocks a task that hit a breakpoint during its last cycle until it is
+// resumed, stopped, or ctx is cancelled. It returns immediately if no breakpoint
+// was hit.
+func (t *tracer) pause(ctx context.Context) error {
+	if t.breakpoint == "" {
+		return nil
+	}
+	breakpoint := t.breakpoint
+	t.breakpoint = ""
+	// Discard resumes sent while the task wasn't paused.
+	select {
+	case <-t.resume:
+	default:
+	}
+	t.pausedMu.Lock()
+	close(t.pausedC)
+	t.pausedMu.Unlock()
+	defer func() {
+		t.pausedMu.Lock()
+		t.pausedC = make(chan struct{})
+		t.pausedMu.Unlock()
+	}()
+	t.onPause(ctx, breakpoint)
+	select {
+	case <-t.resume:
+		t.onResume(ctx, breakpoint)
+		return nil
+	case <-t.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// paused returns a channel that is closed once the task is paused at a breakpoint.
+func (t *tracer) paused() <-chan struct{} {
+	t.pausedMu.Lock()
+	defer t.pausedMu.Unlock()
+	return t.pausedC
+}
+
+// resumeTask releases the task if it is paused at a breakpoint.
+func (t *tracer) resumeTask() {
+	select {
+	case t.resume <- struct{}{}:
+	default:
+	}
+}
+
+// Close releases a paused task so that it can shut down.
+func (t *tracer) Close() error {
+	close(t.done)
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
 	"github.com/synnaxlabs/synnax/pkg/distribution/search"
 	"github.com/synnaxlabs/synnax/pkg/distribution/signals"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/library"
 	arcv54 "github.com/synnaxlabs/synnax/pkg/service/arc/migrations/v54"
 	arcstatus "github.com/synnaxlabs/synnax/pkg/service/arc/status"
 	"github.com/synnaxlabs/synnax/pkg/service/arc/symbol"
@@ -34,6 +35,7 @@ import (
 	"github.com/synnaxlabs/x/override"
 	"github.com/synnaxlabs/x/service"
 	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
 )
 
 // ServiceConfig is the configuration for opening a Arc service.
@@ -64,6 +66,11 @@ type ServiceConfig struct {
 	//
 	// [REQUIRED]
 	Search *search.Index
+	// Library is used for resolving the Arc library modules that programs import.
+	//
+	// [OPTIONAL] - Defaults to nil. Programs can only import built-in modules if this
+	// service is nil.
+	Library *library.Service
 	// Instrumentation is used for logging, tracing, and metrics.
 	alamos.Instrumentation
 }
@@ -79,6 +86,7 @@ func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
 	c.Search = override.Nil(c.Search, other.Search)
 	c.Channel = override.Nil(c.Channel, other.Channel)
 	c.Task = override.Nil(c.Task, other.Task)
+	c.Library = override.Nil(c.Library, other.Library)
 	return c
 }
 
@@ -114,22 +122,32 @@ func (s *Service) NewSymbolResolver(tx gorp.Tx) arc.SymbolResolver {
 	return s.NewChannelResolver(tx)
 }
 
-// NewRoot builds a program root populated with STL + status module + the cluster
-// channel resolver attached as the dynamic resolver. This is the production analysis
-// root: tx is consulted for channel lookups, nil means "use the service DB directly."
-func (s *Service) NewRoot(tx gorp.Tx) *arcsymbol.Symbol {
+// NewRoot builds a program root populated with STL + status module + stored libraries
+// + the cluster channel resolver attached as the dynamic resolver. This is the
+// production analysis root: tx is consulted for channel and library lookups, nil means
+// "use the service DB directly." Libraries that cannot be retrieved are logged and
+// omitted, so programs that import them fail analysis rather than the root failing to
+// build.
+func (s *Service) NewRoot(ctx context.Context, tx gorp.Tx) *arcsymbol.Symbol {
 	stlSyms := stl.NewSymbols()
 	statusSyms := arcstatus.NewSymbols()
 	syms := make([]*arcsymbol.Symbol, 0, len(stlSyms)+len(statusSyms))
 	syms = append(syms, stlSyms...)
 	syms = append(syms, statusSyms...)
+	if s.cfg.Library != nil {
+		libs, err := s.cfg.Library.NewSymbols(ctx, tx)
+		if err != nil {
+			s.cfg.L.Warn("failed to retrieve arc libraries", zap.Error(err))
+		}
+		syms = append(syms, libs...)
+	}
 	return arcsymbol.NewRoot(s.NewChannelResolver(tx), syms)
 }
 
 func (s *Service) NewLSP() (*lsp.Server, error) {
 	return lsp.New(lsp.Config{
 		Instrumentation:  s.cfg.Child("lsp"),
-		NewRoot:          func() *arcsymbol.Symbol { return s.NewRoot(nil) },
+		NewRoot:          func() *arcsymbol.Symbol { return s.NewRoot(context.Background(), nil) },
 		AllowDashedNames: s.AllowDashedNames(),
 		OnRename:         channelRename(s.cfg.Channel),
 		OnExternalChange: observe.Translator[gorp.TxReader[channel.Key, channel.Channel], struct{}]{
@@ -160,10 +178,10 @@ func (s *Service) CompileProgram(ctx context.Context, key Key) (Arc, error) {
 	}
 	var prog arc.Program
 	if entry.Mode == ModeText {
-		prog, err = arc.CompileText(ctx, entry.Text, s.NewRoot(nil),
+		prog, err = arc.CompileText(ctx, entry.Text, s.NewRoot(ctx, nil),
 			arc.WithAllowDashedNames(s.AllowDashedNames()))
 	} else {
-		prog, err = arc.CompileGraph(ctx, entry.Graph, s.NewRoot(nil),
+		prog, err = arc.CompileGraph(ctx, entry.Graph, s.NewRoot(ctx, nil),
 			arc.WithAllowDashedNames(s.AllowDashedNames()))
 	}
 	if err != nil {
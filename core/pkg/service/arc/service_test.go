@@ -13,12 +13,15 @@ import (
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
 	"github.com/synnaxlabs/arc/graph"
 	"github.com/synnaxlabs/arc/ir"
 	. "github.com/synnaxlabs/arc/lsp/testutil"
+	"github.com/synnaxlabs/arc/text"
 	"github.com/synnaxlabs/arc/types"
 	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
 	"github.com/synnaxlabs/synnax/pkg/service/arc"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/library"
 	"github.com/synnaxlabs/x/lsp/protocol"
 	. "github.com/synnaxlabs/x/lsp/testutil"
 	"github.com/synnaxlabs/x/query"
@@ -89,6 +92,47 @@ var _ = Describe("CompileProgram", func() {
 		Expect(svc.CompileProgram(ctx, a.Key)).Error().
 			To(MatchError(ContainSubstring("edge target node 'nonexistent' not found")))
 	})
+
+	It("Should link stored libraries imported by a text program", func(ctx SpecContext) {
+		lib := library.Library{
+			Name: "service_conv",
+			Text: text.Text{Raw: "func double(v f64) f64 {\n    return v * 2.0\n}"},
+		}
+		Expect(libSvc.NewWriter(nil).Create(ctx, &lib)).To(Succeed())
+		DeferCleanup(func(ctx SpecContext) {
+			Expect(libSvc.NewWriter(nil).Delete(ctx, lib.Key)).To(Succeed())
+		})
+		a := arc.Arc{
+			Name: "library-arc",
+			Mode: arc.ModeText,
+			Text: text.Text{Raw: `import service_conv
+
+func quadruple(v f64) f64 {
+    return service_conv.double(service_conv.double(v))
+}`},
+		}
+		Expect(svc.NewWriter(tx).Create(ctx, &a)).To(Succeed())
+		Expect(tx.Commit(ctx)).To(Succeed())
+
+		result := MustSucceed(svc.CompileProgram(ctx, a.Key))
+		keys := lo.Map(result.Program.IR.Functions, func(f ir.Function, _ int) string {
+			return f.Key
+		})
+		Expect(keys).To(ContainElements("service_conv.double", "quadruple"))
+	})
+
+	It("Should fail to compile a program that imports a missing library", func(ctx SpecContext) {
+		a := arc.Arc{
+			Name: "missing-library-arc",
+			Mode: arc.ModeText,
+			Text: text.Text{Raw: "import service_missing"},
+		}
+		Expect(svc.NewWriter(tx).Create(ctx, &a)).To(Succeed())
+		Expect(tx.Commit(ctx)).To(Succeed())
+
+		Expect(svc.CompileProgram(ctx, a.Key)).Error().
+			To(MatchError(ContainSubstring("service_missing")))
+	})
 })
 
 var _ = Describe("NewLSP", func() {
@@ -90,21 +90,39 @@ func NewSymbols() []*symbol.Symbol {
 	return []*symbol.Symbol{mod}
 }
 
+// Setter upserts a status by key or name on behalf of status.set. It is
+// implemented by *status.Service.
+type Setter interface {
+	SetByKeyOrName(
+		ctx context.Context,
+		keyOrName, message, variant string,
+	) (key string, multipleMatches bool, err error)
+}
+
+var _ Setter = (*status.Service)(nil)
+
 type Module struct {
-	stat   *status.Service
+	stat   Setter
 	report taskreporter.Reporter
+	now    func() telem.TimeStamp
 }
 
 // ModuleConfig wires the Arc `status` module into a wazero runtime.
 type ModuleConfig struct {
-	Status   *status.Service
+	Status   Setter
 	Strings  *stlstrings.ProgramState
 	Runtime  wazero.Runtime
 	Reporter taskreporter.Reporter
+	// Now overrides the clock used to timestamp status.set outputs. Nil uses
+	// telem.Now.
+	Now func() telem.TimeStamp
 }
 
 func NewModule(ctx context.Context, cfg ModuleConfig) (*Module, error) {
-	m := &Module{stat: cfg.Status, report: cfg.Reporter}
+	m := &Module{stat: cfg.Status, report: cfg.Reporter, now: cfg.Now}
+	if m.now == nil {
+		m.now = telem.Now
+	}
 	if cfg.Runtime == nil {
 		return m, nil
 	}
@@ -141,6 +159,7 @@ func (m *Module) Create(ctx context.Context, cfg node.Config) (node.Node, error)
 			State:     cfg.State,
 			stat:      m.stat,
 			report:    m.report,
+			now:       m.now,
 			keyOrName: sc.KeyOrName,
 			message:   sc.Message,
 			variant:   sc.Variant,
@@ -164,8 +183,9 @@ var setConfigSchema = zyn.Object(map[string]zyn.Schema{
 
 type setNode struct {
 	*node.State
-	stat      *status.Service
+	stat      Setter
 	report    taskreporter.Reporter
+	now       func() telem.TimeStamp
 	keyOrName string
 	message   string
 	variant   string
@@ -174,7 +194,7 @@ type setNode struct {
 func (s *setNode) Next(ctx node.Context) {
 	key := dispatchSet(ctx, s.stat, s.report, s.keyOrName, s.message, s.variant)
 	*s.Output(0) = telem.NewSeriesV[string](key)
-	*s.OutputTime(0) = telem.NewSeriesV[telem.TimeStamp](telem.Now())
+	*s.OutputTime(0) = telem.NewSeriesV[telem.TimeStamp](s.now())
 	ctx.MarkChanged(0)
 }
 
@@ -182,7 +202,7 @@ func (s *setNode) Next(ctx node.Context) {
 // neither matches. Failures use VariantWarning so the task continues running.
 func dispatchSet(
 	ctx context.Context,
-	stat *status.Service,
+	stat Setter,
 	report taskreporter.Reporter,
 	keyOrName, message, variantStr string,
 ) string {
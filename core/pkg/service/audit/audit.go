@@ -0,0 +1,161 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package audit implements an append-only log of security- and control-relevant
+// actions, recording who did what, when, and with what change. Every node in the
+// cluster appends to its own chain of records, where each record embeds the hash of
+// the record before it. Records are never updated or deleted, and any modification to
+// a stored record breaks the chain, which is detected by [Service.Verify].
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"strconv"
+
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// ErrTampered is returned by [Service.Verify] when a chain of records has been
+// modified since it was written.
+var ErrTampered = errors.New("audit log tampered")
+
+// Kind is the category of action a record describes.
+type Kind string
+
+const (
+	// KindAccess is an access control decision, including those that were denied.
+	KindAccess Kind = "access"
+	// KindResource is the creation, modification, or deletion of a resource through
+	// the API.
+	KindResource Kind = "resource"
+	// KindWriter is a writer being opened with a set of control authorities.
+	KindWriter Kind = "writer"
+	// KindAuthority is a writer changing its control authorities over channels.
+	KindAuthority Kind = "authority"
+	// KindTaskCommand is a command being sent to a task.
+	KindTaskCommand Kind = "task_command"
+	// KindLogin is a user logging in, including failed attempts.
+	KindLogin Kind = "login"
+)
+
+// Key uniquely identifies a record. The first 16 bits are the key of the node that
+// wrote the record, and the remaining 48 bits are the position of the record in the
+// chain of that node, starting at 1.
+type Key uint64
+
+// NewKey instantiates a new record key from its node and sequence components.
+func NewKey(node node.Key, seq uint64) Key {
+	return Key(uint64(node)<<48 | seq&(1<<48-1))
+}
+
+// Node returns the node that wrote the record.
+func (k Key) Node() node.Key { return node.Key(k >> 48) }
+
+// Sequence returns the position of the record in the chain of its node.
+func (k Key) Sequence() uint64 { return uint64(k) & (1<<48 - 1) }
+
+// String implements fmt.Stringer.
+func (k Key) String() string { return strconv.FormatUint(uint64(k), 10) }
+
+// Record is an entry in the audit log.
+type Record struct {
+	// Key is the unique key of the record, assigned when it is appended to the log.
+	Key Key `json:"key" msgpack:"key"`
+	// Time is the time the action was performed.
+	Time telem.TimeStamp `json:"time" msgpack:"time"`
+	// Kind is the category of the action.
+	Kind Kind `json:"kind" msgpack:"kind"`
+	// Subject is the user or service account that performed the action.
+	Subject ontology.ID `json:"subject" msgpack:"subject"`
+	// Action is what the subject did, such as the access action that was checked,
+	// or the type of a task command.
+	Action string `json:"action" msgpack:"action"`
+	// Objects are the resources the action was performed on.
+	Objects []ontology.ID `json:"objects" msgpack:"objects"`
+	// Target is the API endpoint the action was performed through, if any.
+	Target string `json:"target" msgpack:"target"`
+	// Error is the reason the action failed or was denied. If empty, the action
+	// succeeded.
+	Error string `json:"error" msgpack:"error"`
+	// Diff is the JSON-encoded change requested by the action, such as an API request
+	// payload with any secrets removed.
+	Diff string `json:"diff" msgpack:"diff"`
+	// PrevHash is the hash of the previous record in the chain of the node, and is
+	// empty for the first record.
+	PrevHash string `json:"prev_hash" msgpack:"prev_hash"`
+	// Hash is the hex-encoded SHA-256 hash of the record's contents and PrevHash.
+	Hash string `json:"hash" msgpack:"hash"`
+}
+
+var _ gorp.Entry[Key] = Record{}
+
+// GorpKey implements gorp.Entry.
+func (r Record) GorpKey() Key { return r.Key }
+
+// SetOptions implements gorp.Entry.
+func (r Record) SetOptions() []any { return []any{r.Key.Node()} }
+
+func (Record) CustomTypeName() string { return "AuditRecord" }
+
+// Failed returns true if the action was denied or failed.
+func (r Record) Failed() bool { return r.Error != "" }
+
+// computeHash returns the hash of the record. Every field except Hash is written with
+// a length prefix so that distinct records can never produce the same input.
+func (r Record) computeHash() string {
+	h := sha256.New()
+	writeUint(h, uint64(r.Key))
+	writeUint(h, uint64(r.Time))
+	writeString(h, string(r.Kind))
+	writeString(h, r.Subject.String())
+	writeString(h, r.Action)
+	writeUint(h, uint64(len(r.Objects)))
+	for _, o := range r.Objects {
+		writeString(h, o.String())
+	}
+	writeString(h, r.Target)
+	writeString(h, r.Error)
+	writeString(h, r.Diff)
+	writeString(h, r.PrevHash)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeUint(h hash.Hash, v uint64) {
+	h.Write(binary.BigEndian.AppendUint64(nil, v))
+}
+
+func writeString(h hash.Hash, s string) {
+	writeUint(h, uint64(len(s)))
+	h.Write([]byte(s))
+}
+
+// chainHead is the most recent record in the chain of a node, which allows a node to
+// continue its chain after restarting without scanning it.
+type chainHead struct {
+	Node     node.Key `json:"node" msgpack:"node"`
+	Sequence uint64   `json:"sequence" msgpack:"sequence"`
+	Hash     string   `json:"hash" msgpack:"hash"`
+}
+
+var _ gorp.Entry[node.Key] = chainHead{}
+
+// GorpKey implements gorp.Entry.
+func (h chainHead) GorpKey() node.Key { return h.Node }
+
+// SetOptions implements gorp.Entry.
+func (h chainHead) SetOptions() []any { return []any{h.Node} }
+
+func (chainHead) CustomTypeName() string { return "AuditChainHead" }
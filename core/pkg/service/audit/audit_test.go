@@ -0,0 +1,207 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package audit_test
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/audit"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/kv/memkv"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var (
+	alice = ontology.ID{Type: ontology.ResourceTypeUser, Key: "alice"}
+	bob   = ontology.ID{Type: ontology.ResourceTypeUser, Key: "bob"}
+)
+
+var _ = Describe("Audit", func() {
+	var (
+		db  *gorp.DB
+		cfg audit.ServiceConfig
+	)
+	BeforeEach(func() {
+		db = DeferClose(gorp.Wrap(memkv.New()))
+		cfg = audit.ServiceConfig{
+			DB:           db,
+			HostProvider: mock.StaticHostKeyProvider(1),
+		}
+	})
+	retrieve := func(ctx SpecContext, svc *audit.Service, filters ...audit.Filter) []audit.Record {
+		var records []audit.Record
+		q := svc.NewRetrieve().Entries(&records)
+		for _, f := range filters {
+			q = q.Where(f)
+		}
+		Expect(q.Exec(ctx, nil)).To(Succeed())
+		return records
+	}
+	committed := func(ctx SpecContext, svc *audit.Service, n int) []audit.Record {
+		Eventually(func(g Gomega) {
+			g.Expect(svc.NewRetrieve().Count(ctx, nil)).To(Equal(n))
+		}).Should(Succeed())
+		return retrieve(ctx, svc)
+	}
+
+	Describe("Log", func() {
+		var svc *audit.Service
+		BeforeEach(func(ctx SpecContext) {
+			svc = MustOpen(audit.OpenService(ctx, cfg))
+		})
+
+		It("Should append records to the chain of the node", func(ctx SpecContext) {
+			for _, action := range []string{"create", "update", "delete"} {
+				Expect(svc.Log(ctx, audit.Record{
+					Kind:    audit.KindResource,
+					Subject: alice,
+					Action:  action,
+				})).To(Succeed())
+			}
+			records := committed(ctx, svc, 3)
+			prev := ""
+			for i, r := range records {
+				Expect(r.Key.Node()).To(Equal(node.Key(1)))
+				Expect(r.Key.Sequence()).To(Equal(uint64(i + 1)))
+				Expect(r.Time).ToNot(BeZero())
+				Expect(r.PrevHash).To(Equal(prev))
+				Expect(r.Hash).To(HaveLen(64))
+				prev = r.Hash
+			}
+			Expect(records[1].Action).To(Equal("update"))
+			Expect(svc.Verify(ctx)).To(Succeed())
+		})
+
+		It("Should commit the record before returning", func(ctx SpecContext) {
+			Expect(svc.Log(ctx, audit.Record{Kind: audit.KindLogin, Subject: alice})).
+				To(Succeed())
+			Expect(svc.NewRetrieve().Count(ctx, nil)).To(Equal(1))
+		})
+
+		It("Should commit records logged concurrently onto a single chain", func(ctx SpecContext) {
+			var wg sync.WaitGroup
+			for range 50 {
+				wg.Go(func() {
+					defer GinkgoRecover()
+					Expect(svc.Log(ctx, audit.Record{
+						Kind:    audit.KindWriter,
+						Subject: alice,
+						Action:  "open",
+					})).To(Succeed())
+				})
+			}
+			wg.Wait()
+			Expect(svc.NewRetrieve().Count(ctx, nil)).To(Equal(50))
+			Expect(svc.Verify(ctx)).To(Succeed())
+		})
+
+		It("Should filter retrieved records", func(ctx SpecContext) {
+			start := telem.Now()
+			Expect(svc.Log(ctx, audit.Record{
+				Kind:    audit.KindAccess,
+				Subject: alice,
+				Action:  "retrieve",
+			})).To(Succeed())
+			Expect(svc.Log(ctx, audit.Record{
+				Kind:    audit.KindAccess,
+				Subject: bob,
+				Action:  "delete",
+				Error:   "access denied",
+			})).To(Succeed())
+			Expect(svc.Log(ctx, audit.Record{
+				Kind:    audit.KindLogin,
+				Subject: bob,
+				Time:    start.Sub(telem.Hour),
+			})).To(Succeed())
+			committed(ctx, svc, 3)
+			Expect(retrieve(ctx, svc, audit.MatchKinds(audit.KindAccess))).To(HaveLen(2))
+			Expect(retrieve(ctx, svc, audit.MatchSubjects(bob))).To(HaveLen(2))
+			denied := retrieve(ctx, svc, audit.MatchFailed())
+			Expect(denied).To(HaveLen(1))
+			Expect(denied[0].Subject).To(Equal(bob))
+			Expect(retrieve(
+				ctx,
+				svc,
+				audit.MatchSubjects(bob),
+				audit.MatchTimeRange(start.Range(telem.Now())),
+			)).To(HaveLen(1))
+		})
+	})
+
+	Describe("Close", func() {
+		It("Should continue the chain on reopen", func(ctx SpecContext) {
+			first := MustSucceed(audit.OpenService(ctx, cfg))
+			Expect(first.Log(ctx, audit.Record{Kind: audit.KindLogin, Subject: alice})).
+				To(Succeed())
+			Expect(first.Close()).To(Succeed())
+			second := MustOpen(audit.OpenService(ctx, cfg))
+			Expect(second.Log(ctx, audit.Record{Kind: audit.KindLogin, Subject: bob})).
+				To(Succeed())
+			records := committed(ctx, second, 2)
+			Expect(records[1].Key.Sequence()).To(Equal(uint64(2)))
+			Expect(records[1].PrevHash).To(Equal(records[0].Hash))
+			Expect(second.Verify(ctx)).To(Succeed())
+		})
+
+		It("Should reject records after the service is closed", func(ctx SpecContext) {
+			closed := MustSucceed(audit.OpenService(ctx, cfg))
+			Expect(closed.Close()).To(Succeed())
+			Expect(closed.Log(ctx, audit.Record{Kind: audit.KindLogin})).
+				To(MatchError(ContainSubstring("closed")))
+		})
+	})
+
+	Describe("Verify", func() {
+		var (
+			svc     *audit.Service
+			records []audit.Record
+		)
+		BeforeEach(func(ctx SpecContext) {
+			svc = MustOpen(audit.OpenService(ctx, cfg))
+			for range 3 {
+				Expect(svc.Log(ctx, audit.Record{
+					Kind:    audit.KindTaskCommand,
+					Subject: alice,
+					Action:  "start",
+					Diff:    `{"task":"1"}`,
+				})).To(Succeed())
+			}
+			records = committed(ctx, svc, 3)
+		})
+
+		It("Should detect a modified record", func(ctx SpecContext) {
+			r := records[1]
+			r.Subject = bob
+			Expect(gorp.NewCreate[audit.Key, audit.Record]().Entry(&r).Exec(ctx, db)).
+				To(Succeed())
+			Expect(svc.Verify(ctx)).To(MatchError(audit.ErrTampered))
+		})
+
+		It("Should detect a removed record", func(ctx SpecContext) {
+			Expect(gorp.NewDelete[audit.Key, audit.Record]().
+				Where(gorp.MatchKeys[audit.Key, audit.Record](records[1].Key)).
+				Exec(ctx, db)).To(Succeed())
+			Expect(svc.Verify(ctx)).To(MatchError(audit.ErrTampered))
+		})
+
+		It("Should detect removed records at the end of the chain", func(ctx SpecContext) {
+			Expect(gorp.NewDelete[audit.Key, audit.Record]().
+				Where(gorp.MatchKeys[audit.Key, audit.Record](records[2].Key)).
+				Exec(ctx, db)).To(Succeed())
+			Expect(svc.Verify(ctx)).To(MatchError(audit.ErrTampered))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package audit
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// Retrieve is used to retrieve records from the log. Records are returned ordered by
+// the node that wrote them, and then by their position in the chain of that node.
+type Retrieve struct {
+	baseTX gorp.Tx
+	gorp   gorp.Retrieve[Key, Record]
+}
+
+// Filter restricts the records returned by a Retrieve.
+type Filter = gorp.Filter[Key, Record]
+
+// MatchKinds returns a filter for records of any of the given kinds.
+func MatchKinds(kinds ...Kind) Filter {
+	return gorp.Match(func(_ gorp.Context, r *Record) (bool, error) {
+		return lo.Contains(kinds, r.Kind), nil
+	})
+}
+
+// MatchSubjects returns a filter for records of actions performed by any of the given
+// subjects.
+func MatchSubjects(subjects ...ontology.ID) Filter {
+	return gorp.Match(func(_ gorp.Context, r *Record) (bool, error) {
+		return lo.Contains(subjects, r.Subject), nil
+	})
+}
+
+// MatchObjects returns a filter for records of actions performed on any of the given
+// objects.
+func MatchObjects(objects ...ontology.ID) Filter {
+	return gorp.Match(func(_ gorp.Context, r *Record) (bool, error) {
+		return lo.Some(r.Objects, objects), nil
+	})
+}
+
+// MatchTimeRange returns a filter for records of actions performed within the given
+// time range.
+func MatchTimeRange(tr telem.TimeRange) Filter {
+	return gorp.Match(func(_ gorp.Context, r *Record) (bool, error) {
+		return tr.ContainsStamp(r.Time), nil
+	})
+}
+
+// MatchFailed returns a filter for records of actions that were denied or failed.
+func MatchFailed() Filter {
+	return gorp.Match(func(_ gorp.Context, r *Record) (bool, error) {
+		return r.Failed(), nil
+	})
+}
+
+// Where adds the filter to the query, ANDing it with any existing filters.
+func (r Retrieve) Where(filter Filter) Retrieve {
+	r.gorp = r.gorp.Where(filter)
+	return r
+}
+
+// Entries binds the provided slice as the result container for the query.
+func (r Retrieve) Entries(records *[]Record) Retrieve {
+	r.gorp = r.gorp.Entries(records)
+	return r
+}
+
+// Limit sets the maximum number of records to return.
+func (r Retrieve) Limit(limit int) Retrieve {
+	r.gorp = r.gorp.Limit(limit)
+	return r
+}
+
+// Offset sets the number of matching records to skip.
+func (r Retrieve) Offset(offset int) Retrieve {
+	r.gorp = r.gorp.Offset(offset)
+	return r
+}
+
+// Exec executes the query against the provided transaction.
+func (r Retrieve) Exec(ctx context.Context, tx gorp.Tx) error {
+	return r.gorp.Exec(ctx, gorp.OverrideTx(r.baseTX, tx))
+}
+
+// Count returns the number of records matching the query.
+func (r Retrieve) Count(ctx context.Context, tx gorp.Tx) (int, error) {
+	return r.gorp.Count(ctx, gorp.OverrideTx(r.baseTX, tx))
+}
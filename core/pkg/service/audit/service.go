@@ -0,0 +1,298 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package audit
+
+import (
+	"context"
+	"sync"
+
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	xio "github.com/synnaxlabs/x/io"
+	"github.com/synnaxlabs/x/override"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/service"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// ServiceConfig is the configuration for opening a [Service].
+type ServiceConfig struct {
+	// DB is the database used to store the log.
+	//
+	// [REQUIRED]
+	DB *gorp.DB
+	// HostProvider is used to identify the chain of records written by this node.
+	//
+	// [REQUIRED]
+	HostProvider node.HostProvider
+	// BufferSize is the maximum number of records that are committed in a single
+	// transaction. Once this many records are waiting to be committed, calls to
+	// [Service.Log] block until they are.
+	//
+	// [OPTIONAL] - Defaults to 1000.
+	BufferSize int
+	// Instrumentation is for logging, tracing, metrics, etc.
+	//
+	// [OPTIONAL] - Defaults to noop instrumentation.
+	alamos.Instrumentation
+}
+
+var (
+	_ config.Config[ServiceConfig] = ServiceConfig{}
+	// DefaultServiceConfig is the default configuration for opening a [Service].
+	DefaultServiceConfig = ServiceConfig{BufferSize: 1000}
+)
+
+// Override implements config.Config.
+func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
+	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
+	c.DB = override.Nil(c.DB, other.DB)
+	c.HostProvider = override.Nil(c.HostProvider, other.HostProvider)
+	c.BufferSize = override.Numeric(c.BufferSize, other.BufferSize)
+	return c
+}
+
+// Validate implements config.Config.
+func (c ServiceConfig) Validate() error {
+	v := validate.New("audit")
+	validate.NotNil(v, "db", c.DB)
+	validate.NotNil(v, "host_provider", c.HostProvider)
+	validate.Positive(v, "buffer_size", c.BufferSize)
+	return v.Error()
+}
+
+var errClosed = errors.New("audit log closed")
+
+// Service is the audit log. Records are appended with [Service.Log], and are
+// committed to the database by a single writer so that the chain of the node is never
+// forked. Records logged concurrently are committed together in a single transaction.
+type Service struct {
+	cfg     ServiceConfig
+	closer  xio.MultiCloser
+	records *gorp.Table[Key, Record]
+	heads   *gorp.Table[node.Key, chainHead]
+	pending chan pending
+	stop    chan struct{}
+	done    chan struct{}
+	mu      struct {
+		sync.RWMutex
+		closed bool
+	}
+}
+
+// OpenService opens a new [Service] using the provided configurations. The returned
+// Service must be closed after use, which commits any records that are still pending.
+func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err error) {
+	cfg, err := config.New(DefaultServiceConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	s = &Service{
+		cfg:     cfg,
+		pending: make(chan pending, cfg.BufferSize),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	cleanup, ok := service.NewOpener(ctx, &s.closer)
+	defer func() { err = cleanup(err) }()
+	if s.records, err = gorp.OpenTable(ctx, gorp.TableConfig[Key, Record]{
+		DB:              cfg.DB,
+		Instrumentation: cfg.Instrumentation,
+	}); !ok(err, s.records) {
+		return nil, err
+	}
+	if s.heads, err = gorp.OpenTable(ctx, gorp.TableConfig[node.Key, chainHead]{
+		DB:              cfg.DB,
+		Instrumentation: cfg.Instrumentation,
+	}); !ok(err, s.heads) {
+		return nil, err
+	}
+	head := chainHead{Node: cfg.HostProvider.HostKey()}
+	if err := s.heads.NewRetrieve().
+		Where(gorp.MatchKeys[node.Key, chainHead](head.Node)).
+		Entry(&head).
+		Exec(ctx, cfg.DB); errors.Skip(err, query.ErrNotFound) != nil {
+		return nil, err
+	}
+	go s.run(head)
+	s.closer = append(s.closer, xio.CloserFunc(s.shutdown))
+	return s, nil
+}
+
+// Close commits any pending records and closes the service. Records logged after
+// Close is called are rejected.
+func (s *Service) Close() error { return s.closer.Close() }
+
+// pending is a record waiting to be committed, along with the channel that the result
+// of its commit is sent on.
+type pending struct {
+	Record
+	committed chan error
+}
+
+// Log appends the record to the log, assigning its key and hash. If the record's time
+// is zero, it is set to the current time. Log returns once the record is committed to
+// the database, so a record that Log succeeds for is never lost, and returns an error
+// if the record could not be committed.
+func (s *Service) Log(ctx context.Context, r Record) error {
+	if r.Time.IsZero() {
+		r.Time = telem.Now()
+	}
+	p := pending{Record: r, committed: make(chan error, 1)}
+	if err := s.enqueue(ctx, p); err != nil {
+		return err
+	}
+	select {
+	case err := <-p.committed:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Service) enqueue(ctx context.Context, p pending) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.mu.closed {
+		return errClosed
+	}
+	select {
+	case s.pending <- p:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// NewRetrieve opens a new query for retrieving records from the log.
+func (s *Service) NewRetrieve() Retrieve {
+	return Retrieve{gorp: s.records.NewRetrieve(), baseTX: s.cfg.DB}
+}
+
+// Verify recomputes the chain of every node in the cluster, returning [ErrTampered]
+// if a record has been modified, removed, or inserted since it was written. Records
+// that are still pending are not verified.
+func (s *Service) Verify(ctx context.Context) error {
+	var heads []chainHead
+	if err := s.heads.NewRetrieve().Entries(&heads).Exec(ctx, s.cfg.DB); err != nil {
+		return err
+	}
+	records, closer, err := s.records.OpenNexter(ctx)
+	if err != nil {
+		return err
+	}
+	last := make(map[node.Key]chainHead, len(heads))
+	for r := range records {
+		prev := last[r.Key.Node()]
+		if r.Key.Sequence() != prev.Sequence+1 {
+			err = errors.Wrapf(ErrTampered, "record %v is out of sequence", r.Key)
+			break
+		}
+		if r.PrevHash != prev.Hash || r.Hash != r.computeHash() {
+			err = errors.Wrapf(ErrTampered, "hash mismatch at record %v", r.Key)
+			break
+		}
+		last[r.Key.Node()] = chainHead{Node: r.Key.Node(), Sequence: r.Key.Sequence(), Hash: r.Hash}
+	}
+	if err = errors.Combine(err, closer.Close()); err != nil {
+		return err
+	}
+	// Records may have been committed after the heads were read, so the chain must
+	// reach at least as far as each head.
+	for _, h := range heads {
+		l := last[h.Node]
+		if l.Sequence < h.Sequence {
+			return errors.Wrapf(ErrTampered, "records of node %v end at %d, expected %d", h.Node, l.Sequence, h.Sequence)
+		}
+	}
+	return nil
+}
+
+func (s *Service) shutdown() error {
+	s.mu.Lock()
+	s.mu.closed = true
+	s.mu.Unlock()
+	close(s.stop)
+	<-s.done
+	return nil
+}
+
+// run is the single writer of the chain of the node. It commits each pending record
+// along with any others that are waiting by the time it is received.
+func (s *Service) run(head chainHead) {
+	defer close(s.done)
+	batch := make([]pending, 0, s.cfg.BufferSize)
+	for {
+		select {
+		case p := <-s.pending:
+			batch = s.commit(&head, s.fill(append(batch, p)))
+		case <-s.stop:
+			// No records can be logged once the service is closed, so the pending
+			// records are all that remain.
+			for len(s.pending) > 0 {
+				batch = s.commit(&head, s.fill(batch))
+			}
+			return
+		}
+	}
+}
+
+// fill adds records that are waiting to be committed to the batch until it is full or
+// no records are left.
+func (s *Service) fill(batch []pending) []pending {
+	for len(batch) < s.cfg.BufferSize {
+		select {
+		case p := <-s.pending:
+			batch = append(batch, p)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// commit links the batch onto the chain of the node, writes it to the database, and
+// notifies the loggers of the records in the batch of the result. If the write fails,
+// the head of the chain is left unchanged so that later records don't break it. The
+// emptied batch is returned for reuse.
+func (s *Service) commit(head *chainHead, batch []pending) []pending {
+	next := *head
+	records := make([]Record, len(batch))
+	for i, p := range batch {
+		r := p.Record
+		next.Sequence++
+		r.Key = NewKey(next.Node, next.Sequence)
+		r.PrevHash = next.Hash
+		r.Hash = r.computeHash()
+		next.Hash = r.Hash
+		records[i] = r
+	}
+	ctx := context.Background()
+	err := s.cfg.DB.WithTx(ctx, func(tx gorp.Tx) error {
+		if err := s.records.NewCreate().Entries(&records).Exec(ctx, tx); err != nil {
+			return err
+		}
+		return s.heads.NewCreate().Entry(&next).Exec(ctx, tx)
+	})
+	if err != nil {
+		s.cfg.L.Error("failed to commit audit records", zap.Int("count", len(batch)), zap.Error(err))
+	} else {
+		*head = next
+	}
+	for _, p := range batch {
+		p.committed <- err
+	}
+	return batch[:0]
+}
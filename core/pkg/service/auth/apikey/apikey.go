@@ -0,0 +1,128 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package apikey implements service accounts and the long-lived API keys they
+// authenticate with. A service account is a [user.User] without credentials, so it
+// can't log in with a password but can be assigned roles like any other user. Each of
+// its keys can carry an expiration and be scoped to a subset of the policies the
+// account holds. Only a hash of a key's secret is stored; the secret itself is
+// returned once, when the key is created or rotated.
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// ServiceAccount marks a user as a service account.
+type ServiceAccount struct {
+	// Key is the key of the service account's user.
+	Key user.Key `json:"key" msgpack:"key"`
+	// Description describes what the service account is used for.
+	Description string `json:"description" msgpack:"description"`
+	// CreatedAt is the time the service account was created.
+	CreatedAt telem.TimeStamp `json:"created_at" msgpack:"created_at"`
+}
+
+// GorpKey implements gorp.Entry.
+func (a ServiceAccount) GorpKey() user.Key { return a.Key }
+
+// SetOptions implements gorp.Entry.
+func (ServiceAccount) SetOptions() []any { return nil }
+
+// Key is an API key that authenticates as a service account.
+type Key struct {
+	// Key is the unique identifier of the key.
+	Key uuid.UUID `json:"key" msgpack:"key"`
+	// Name is a human-readable name for the key.
+	Name string `json:"name" msgpack:"name"`
+	// ServiceAccount is the key of the service account the key authenticates as.
+	ServiceAccount user.Key `json:"service_account" msgpack:"service_account"`
+	// Policies are the keys of the policies the key is scoped to. Requests made with
+	// the key are only allowed by the service account's policies within the scope. If
+	// empty, the key carries all of the service account's access.
+	Policies []uuid.UUID `json:"policies" msgpack:"policies"`
+	// CreatedAt is the time the key was created.
+	CreatedAt telem.TimeStamp `json:"created_at" msgpack:"created_at"`
+	// ExpiresAt is the time after which the key is no longer accepted. If zero, the
+	// key never expires.
+	ExpiresAt telem.TimeStamp `json:"expires_at" msgpack:"expires_at"`
+	// LastUsedAt is the approximate time the key was last used to authenticate a
+	// request. If zero, the key has never been used.
+	LastUsedAt telem.TimeStamp `json:"last_used_at" msgpack:"last_used_at"`
+	// Hash is the SHA-256 hash of the key's secret.
+	Hash []byte `json:"-" msgpack:"hash"`
+}
+
+// GorpKey implements gorp.Entry.
+func (k Key) GorpKey() uuid.UUID { return k.Key }
+
+// SetOptions implements gorp.Entry.
+func (Key) SetOptions() []any { return nil }
+
+// Expired returns true if the key has an expiration at or before now.
+func (k Key) Expired(now telem.TimeStamp) bool {
+	return !k.ExpiresAt.IsZero() && now >= k.ExpiresAt
+}
+
+// Prefix is the prefix of every API key token, which distinguishes them from session
+// tokens.
+const Prefix = "sy_"
+
+// IsToken returns true if tk has the format of an API key token.
+func IsToken(tk string) bool { return strings.HasPrefix(tk, Prefix) }
+
+// newSecret returns a random secret and its hash.
+func newSecret() (string, []byte) {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	secret := hex.EncodeToString(b)
+	return secret, hashSecret(secret)
+}
+
+func hashSecret(secret string) []byte {
+	h := sha256.Sum256([]byte(secret))
+	return h[:]
+}
+
+// formatToken returns the token presented by clients to authenticate with the key.
+func formatToken(key uuid.UUID, secret string) string {
+	return Prefix + key.String() + "_" + secret
+}
+
+// ErrRevoked is the cause of the cancellation of contexts returned by [Service.Watch]
+// when their key is revoked.
+var ErrRevoked = errors.Wrap(auth.ErrInvalidToken, "API key revoked")
+
+var errMalformedToken = errors.Wrap(auth.ErrInvalidToken, "malformed API key")
+
+// parseToken returns the key and secret of a token produced by formatToken.
+func parseToken(tk string) (uuid.UUID, string, error) {
+	rest, ok := strings.CutPrefix(tk, Prefix)
+	if !ok {
+		return uuid.Nil, "", errMalformedToken
+	}
+	rawKey, secret, ok := strings.Cut(rest, "_")
+	if !ok || secret == "" {
+		return uuid.Nil, "", errMalformedToken
+	}
+	key, err := uuid.Parse(rawKey)
+	if err != nil {
+		return uuid.Nil, "", errMalformedToken
+	}
+	return key, secret, nil
+}
@@ -0,0 +1,278 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package apikey_test
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/access"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/policy"
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/apikey"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+	"github.com/synnaxlabs/x/validate"
+)
+
+var _ = Describe("API Key", func() {
+	var (
+		svc     *apikey.Service
+		now     telem.TimeStamp
+		account user.User
+	)
+	BeforeEach(func(ctx SpecContext) {
+		now = telem.Now()
+		svc = MustOpen(apikey.OpenService(ctx, apikey.ServiceConfig{
+			DB:     db,
+			User:   userSvc,
+			Policy: policySvc,
+			Now:    func() telem.TimeStamp { return now },
+		}))
+		account, _ = MustSucceed2(svc.NewWriter(nil).CreateServiceAccount(
+			ctx,
+			user.User{Username: "ci-" + uuid.NewString()},
+			"Continuous integration",
+		))
+	})
+	create := func(ctx SpecContext, k apikey.Key) (apikey.Key, string) {
+		k.ServiceAccount = account.Key
+		if k.Name == "" {
+			k.Name = "key"
+		}
+		tk := MustSucceed(svc.NewWriter(nil).Create(ctx, &k))
+		return k, tk
+	}
+
+	Describe("CreateServiceAccount", func() {
+		It("Should create a user marked as a service account", func(ctx SpecContext) {
+			Expect(userSvc.NewRetrieve().
+				Where(user.MatchKeys(account.Key)).
+				Exists(ctx, nil)).To(BeTrue())
+			accounts := MustSucceed(svc.RetrieveServiceAccounts(ctx, nil, account.Key))
+			Expect(accounts).To(HaveLen(1))
+			Expect(accounts[0].Description).To(Equal("Continuous integration"))
+			Expect(accounts[0].CreatedAt).To(Equal(now))
+		})
+	})
+
+	Describe("Create", func() {
+		It("Should return a token that authenticates as the service account", func(ctx SpecContext) {
+			k, tk := create(ctx, apikey.Key{Name: "pipeline"})
+			Expect(apikey.IsToken(tk)).To(BeTrue())
+			Expect(k.Hash).To(BeNil())
+			Expect(k.CreatedAt).To(Equal(now))
+			authed := MustSucceed(svc.Authenticate(ctx, tk))
+			Expect(authed.Key).To(Equal(k.Key))
+			Expect(authed.ServiceAccount).To(Equal(account.Key))
+			Expect(authed.Hash).To(BeNil())
+		})
+
+		It("Should scope the key to existing policies", func(ctx SpecContext) {
+			p := policy.Policy{
+				Name:    "write-channel",
+				Objects: []ontology.ID{{Type: ontology.ResourceTypeChannel, Key: "1"}},
+				Actions: []access.Action{access.ActionUpdate},
+			}
+			Expect(policySvc.NewWriter(nil, false).Create(ctx, &p)).To(Succeed())
+			k, tk := create(ctx, apikey.Key{Policies: []uuid.UUID{p.Key}})
+			Expect(MustSucceed(svc.Authenticate(ctx, tk)).Policies).To(Equal(k.Policies))
+		})
+
+		It("Should return an error when a scoped policy does not exist", func(ctx SpecContext) {
+			k := apikey.Key{Name: "key", ServiceAccount: account.Key, Policies: []uuid.UUID{uuid.New()}}
+			Expect(svc.NewWriter(nil).Create(ctx, &k)).Error().
+				To(MatchError(validate.ErrValidation))
+		})
+
+		It("Should return an error when the service account does not exist", func(ctx SpecContext) {
+			k := apikey.Key{Name: "key", ServiceAccount: uuid.New()}
+			Expect(svc.NewWriter(nil).Create(ctx, &k)).Error().
+				To(MatchError(validate.ErrValidation))
+		})
+
+		It("Should return an error when the key is not named", func(ctx SpecContext) {
+			k := apikey.Key{ServiceAccount: account.Key}
+			Expect(svc.NewWriter(nil).Create(ctx, &k)).Error().
+				To(MatchError(ContainSubstring("name")))
+		})
+
+		It("Should return an error when the expiration is in the past", func(ctx SpecContext) {
+			k := apikey.Key{Name: "key", ServiceAccount: account.Key, ExpiresAt: now - 1}
+			Expect(svc.NewWriter(nil).Create(ctx, &k)).Error().
+				To(MatchError(ContainSubstring("expires_at")))
+		})
+	})
+
+	Describe("Authenticate", func() {
+		It("Should reject a token with the wrong secret", func(ctx SpecContext) {
+			_, tk := create(ctx, apikey.Key{})
+			last := "0"
+			if strings.HasSuffix(tk, last) {
+				last = "1"
+			}
+			tampered := tk[:len(tk)-1] + last
+			Expect(svc.Authenticate(ctx, tampered)).Error().To(MatchError(auth.ErrInvalidToken))
+		})
+
+		It("Should reject a malformed token", func(ctx SpecContext) {
+			Expect(svc.Authenticate(ctx, "sy_not-a-key")).Error().
+				To(MatchError(auth.ErrInvalidToken))
+		})
+
+		It("Should reject a token for an unknown key", func(ctx SpecContext) {
+			Expect(svc.Authenticate(ctx, "sy_"+uuid.NewString()+"_abc")).Error().
+				To(MatchError(auth.ErrInvalidToken))
+		})
+
+		It("Should reject an expired key", func(ctx SpecContext) {
+			_, tk := create(ctx, apikey.Key{ExpiresAt: now.Add(telem.Hour)})
+			MustSucceed(svc.Authenticate(ctx, tk))
+			now = now.Add(2 * telem.Hour)
+			Expect(svc.Authenticate(ctx, tk)).Error().To(MatchError(auth.ErrExpiredToken))
+		})
+
+		It("Should record the last time the key was used", func(ctx SpecContext) {
+			k, tk := create(ctx, apikey.Key{})
+			lastUsed := func() telem.TimeStamp {
+				keys := MustSucceed(svc.RetrieveKeys(ctx, nil, account.Key))
+				Expect(keys).To(HaveLen(1))
+				Expect(keys[0].Key).To(Equal(k.Key))
+				return keys[0].LastUsedAt
+			}
+			Expect(lastUsed()).To(Equal(telem.TimeStamp(0)))
+			first := now
+			MustSucceed(svc.Authenticate(ctx, tk))
+			Expect(lastUsed()).To(Equal(first))
+			now = now.Add(telem.Second)
+			MustSucceed(svc.Authenticate(ctx, tk))
+			Expect(lastUsed()).To(Equal(first))
+			now = now.Add(telem.TimeSpan(time.Minute))
+			MustSucceed(svc.Authenticate(ctx, tk))
+			Expect(lastUsed()).To(Equal(now))
+		})
+
+		It("Should reject a key whose service account user was deleted", func(ctx SpecContext) {
+			_, tk := create(ctx, apikey.Key{})
+			Expect(userSvc.NewWriter(nil).Delete(ctx, account.Key)).To(Succeed())
+			Expect(svc.Authenticate(ctx, tk)).Error().To(MatchError(auth.ErrInvalidToken))
+		})
+	})
+
+	Describe("Rotate", func() {
+		It("Should replace the secret of the key", func(ctx SpecContext) {
+			k, old := create(ctx, apikey.Key{})
+			tk := MustSucceed(svc.NewWriter(nil).Rotate(ctx, k.Key))
+			Expect(tk).ToNot(Equal(old))
+			Expect(svc.Authenticate(ctx, old)).Error().To(MatchError(auth.ErrInvalidToken))
+			Expect(MustSucceed(svc.Authenticate(ctx, tk)).Key).To(Equal(k.Key))
+		})
+
+		It("Should return an error for an unknown key", func(ctx SpecContext) {
+			Expect(svc.NewWriter(nil).Rotate(ctx, uuid.New())).Error().
+				To(MatchError(query.ErrNotFound))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("Should revoke the key", func(ctx SpecContext) {
+			k, tk := create(ctx, apikey.Key{})
+			Expect(svc.NewWriter(nil).Delete(ctx, k.Key)).To(Succeed())
+			Expect(svc.Authenticate(ctx, tk)).Error().To(MatchError(auth.ErrInvalidToken))
+			Expect(svc.RetrieveKeys(ctx, nil, account.Key)).To(BeEmpty())
+		})
+	})
+
+	Describe("DeleteServiceAccounts", func() {
+		It("Should delete the service account, its user, and its keys", func(ctx SpecContext) {
+			_, tk := create(ctx, apikey.Key{})
+			Expect(svc.NewWriter(nil).DeleteServiceAccounts(ctx, account.Key)).To(Succeed())
+			Expect(svc.Authenticate(ctx, tk)).Error().To(MatchError(auth.ErrInvalidToken))
+			Expect(svc.RetrieveKeys(ctx, nil, account.Key)).To(BeEmpty())
+			Expect(userSvc.NewRetrieve().
+				Where(user.MatchKeys(account.Key)).
+				Exists(ctx, nil)).To(BeFalse())
+		})
+
+		It("Should ignore users that are not service accounts", func(ctx SpecContext) {
+			u := MustSucceed(userSvc.NewWriter(nil).Create(ctx, user.User{
+				Username: uuid.NewString(),
+			}))
+			Expect(svc.NewWriter(nil).DeleteServiceAccounts(ctx, u.Key)).To(Succeed())
+			Expect(userSvc.NewRetrieve().
+				Where(user.MatchKeys(u.Key)).
+				Exists(ctx, nil)).To(BeTrue())
+		})
+	})
+
+	Describe("Watch", func() {
+		It("Should cancel the context when the key is deleted", func(ctx SpecContext) {
+			k, _ := create(ctx, apikey.Key{})
+			other, _ := create(ctx, apikey.Key{})
+			wCtx, cancel := svc.Watch(ctx, k.Key)
+			defer cancel()
+			otherCtx, otherCancel := svc.Watch(ctx, other.Key)
+			defer otherCancel()
+			Expect(wCtx.Err()).ToNot(HaveOccurred())
+			Expect(svc.NewWriter(nil).Delete(ctx, k.Key)).To(Succeed())
+			Eventually(wCtx.Done()).Should(BeClosed())
+			Expect(context.Cause(wCtx)).To(MatchError(apikey.ErrRevoked))
+			Consistently(otherCtx.Done()).ShouldNot(BeClosed())
+		})
+
+		It("Should cancel the context when the key is rotated", func(ctx SpecContext) {
+			k, _ := create(ctx, apikey.Key{})
+			wCtx, cancel := svc.Watch(ctx, k.Key)
+			defer cancel()
+			MustSucceed(svc.NewWriter(nil).Rotate(ctx, k.Key))
+			Eventually(wCtx.Done()).Should(BeClosed())
+			Expect(context.Cause(wCtx)).To(MatchError(apikey.ErrRevoked))
+		})
+
+		It("Should cancel the context when the service account is deleted", func(ctx SpecContext) {
+			k, _ := create(ctx, apikey.Key{})
+			wCtx, cancel := svc.Watch(ctx, k.Key)
+			defer cancel()
+			Expect(svc.NewWriter(nil).DeleteServiceAccounts(ctx, account.Key)).To(Succeed())
+			Eventually(wCtx.Done()).Should(BeClosed())
+			Expect(context.Cause(wCtx)).To(MatchError(apikey.ErrRevoked))
+		})
+
+		It("Should not cancel the context when the key is used", func(ctx SpecContext) {
+			k, tk := create(ctx, apikey.Key{})
+			wCtx, cancel := svc.Watch(ctx, k.Key)
+			defer cancel()
+			MustSucceed(svc.Authenticate(ctx, tk))
+			Consistently(wCtx.Done()).ShouldNot(BeClosed())
+		})
+
+		It("Should cancel the context immediately if the key no longer exists", func(ctx SpecContext) {
+			wCtx, cancel := svc.Watch(ctx, uuid.New())
+			defer cancel()
+			Expect(wCtx.Done()).To(BeClosed())
+			Expect(context.Cause(wCtx)).To(MatchError(apikey.ErrRevoked))
+		})
+
+		It("Should cancel the context when its cancel function is called", func(ctx SpecContext) {
+			k, _ := create(ctx, apikey.Key{})
+			wCtx, cancel := svc.Watch(ctx, k.Key)
+			cancel()
+			Expect(wCtx.Done()).To(BeClosed())
+			Expect(context.Cause(wCtx)).To(MatchError(context.Canceled))
+		})
+	})
+})
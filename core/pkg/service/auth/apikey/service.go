@@ -0,0 +1,346 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package apikey
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/policy"
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/change"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	xio "github.com/synnaxlabs/x/io"
+	"github.com/synnaxlabs/x/override"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/service"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// ServiceConfig is the configuration for opening a [Service].
+type ServiceConfig struct {
+	// DB is the database used to store service accounts and keys.
+	//
+	// [REQUIRED]
+	DB *gorp.DB
+	// User is used to create and delete the users backing service accounts.
+	//
+	// [REQUIRED]
+	User *user.Service
+	// Policy is used to check that the policies keys are scoped to exist.
+	//
+	// [REQUIRED]
+	Policy *policy.Service
+	// LastUsedResolution is the minimum interval between updates to the last used
+	// time of a key, which keeps keys used on every request from causing a write on
+	// every request.
+	//
+	// [OPTIONAL] - Defaults to 1 minute.
+	LastUsedResolution time.Duration
+	// Now returns the current time.
+	//
+	// [OPTIONAL] - Defaults to telem.Now.
+	Now func() telem.TimeStamp
+	// Instrumentation is for logging, tracing, metrics, etc.
+	//
+	// [OPTIONAL] - Defaults to noop instrumentation.
+	alamos.Instrumentation
+}
+
+var (
+	_ config.Config[ServiceConfig] = ServiceConfig{}
+	// DefaultServiceConfig is the default configuration for opening a [Service].
+	DefaultServiceConfig = ServiceConfig{
+		LastUsedResolution: time.Minute,
+		Now:                telem.Now,
+	}
+)
+
+// Override implements config.Config.
+func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
+	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
+	c.DB = override.Nil(c.DB, other.DB)
+	c.User = override.Nil(c.User, other.User)
+	c.Policy = override.Nil(c.Policy, other.Policy)
+	c.LastUsedResolution = override.Numeric(c.LastUsedResolution, other.LastUsedResolution)
+	c.Now = override.Nil(c.Now, other.Now)
+	return c
+}
+
+// Validate implements config.Config.
+func (c ServiceConfig) Validate() error {
+	v := validate.New("apikey")
+	validate.NotNil(v, "db", c.DB)
+	validate.NotNil(v, "user", c.User)
+	validate.NotNil(v, "policy", c.Policy)
+	validate.NotNil(v, "now", c.Now)
+	return v.Error()
+}
+
+// Service manages service accounts and authenticates requests made with their API
+// keys.
+type Service struct {
+	cfg      ServiceConfig
+	closer   xio.MultiCloser
+	accounts *gorp.Table[user.Key, ServiceAccount]
+	keys     *gorp.Table[uuid.UUID, Key]
+	watchers struct {
+		sync.Mutex
+		counter int
+		watches map[uuid.UUID]map[int]watch
+	}
+}
+
+// watch is a context watching for the revocation of a key.
+type watch struct {
+	// hash is the hash of the key's secret when the watch started, which is used to
+	// detect rotations.
+	hash   []byte
+	cancel context.CancelCauseFunc
+}
+
+// OpenService opens a new [Service] using the provided configurations. The returned
+// Service must be closed after use.
+func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err error) {
+	cfg, err := config.New(DefaultServiceConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	s = &Service{cfg: cfg}
+	s.watchers.watches = make(map[uuid.UUID]map[int]watch)
+	cleanup, ok := service.NewOpener(ctx, &s.closer)
+	defer func() { err = cleanup(err) }()
+	if s.accounts, err = gorp.OpenTable(ctx, gorp.TableConfig[user.Key, ServiceAccount]{
+		DB:              cfg.DB,
+		Instrumentation: cfg.Instrumentation,
+	}); !ok(err, s.accounts) {
+		return nil, err
+	}
+	if s.keys, err = gorp.OpenTable(ctx, gorp.TableConfig[uuid.UUID, Key]{
+		DB:              cfg.DB,
+		Instrumentation: cfg.Instrumentation,
+	}); !ok(err, s.keys) {
+		return nil, err
+	}
+	disconnect := s.keys.Observe().OnChange(s.handleChanges)
+	s.closer = append(s.closer, xio.CloserFunc(func() error {
+		disconnect()
+		return nil
+	}))
+	return s, nil
+}
+
+// Close closes the service and releases any resources.
+func (s *Service) Close() error { return s.closer.Close() }
+
+// NewWriter opens a new [Writer] using the provided transaction.
+func (s *Service) NewWriter(tx gorp.Tx) Writer {
+	return Writer{svc: s, tx: gorp.OverrideTx(s.cfg.DB, tx)}
+}
+
+// RetrieveServiceAccounts returns the service accounts with the given keys, or all
+// service accounts if no keys are given.
+func (s *Service) RetrieveServiceAccounts(
+	ctx context.Context,
+	tx gorp.Tx,
+	keys ...user.Key,
+) ([]ServiceAccount, error) {
+	q := s.accounts.NewRetrieve()
+	if len(keys) > 0 {
+		q = q.Where(gorp.MatchKeys[user.Key, ServiceAccount](keys...))
+	}
+	var accounts []ServiceAccount
+	err := q.Entries(&accounts).Exec(ctx, gorp.OverrideTx(s.cfg.DB, tx))
+	return accounts, err
+}
+
+// RetrieveKeys returns the keys of the given service accounts, or all keys if no
+// service accounts are given. The hashes of the returned keys are cleared.
+func (s *Service) RetrieveKeys(
+	ctx context.Context,
+	tx gorp.Tx,
+	accounts ...user.Key,
+) ([]Key, error) {
+	q := s.keys.NewRetrieve()
+	if len(accounts) > 0 {
+		q = q.Where(matchServiceAccounts(accounts...))
+	}
+	var keys []Key
+	if err := q.Entries(&keys).Exec(ctx, gorp.OverrideTx(s.cfg.DB, tx)); err != nil {
+		return nil, err
+	}
+	for i := range keys {
+		keys[i].Hash = nil
+	}
+	return keys, nil
+}
+
+// RetrieveKeysByKey returns the keys with the given IDs. The hashes of the returned
+// keys are cleared. Returns [query.ErrNotFound] along with any keys that were found if
+// any of the IDs do not exist.
+func (s *Service) RetrieveKeysByKey(
+	ctx context.Context,
+	tx gorp.Tx,
+	keys ...uuid.UUID,
+) ([]Key, error) {
+	var res []Key
+	err := s.keys.NewRetrieve().
+		Where(gorp.MatchKeys[uuid.UUID, Key](keys...)).
+		Entries(&res).
+		Exec(ctx, gorp.OverrideTx(s.cfg.DB, tx))
+	for i := range res {
+		res[i].Hash = nil
+	}
+	return res, err
+}
+
+// Authenticate validates the API key token tk, returning the key it identifies. The
+// hash of the returned key is cleared. Returns [auth.ErrInvalidToken] if the token is
+// malformed, does not match a key, or its service account or the service account's
+// user has been deleted, and [auth.ErrExpiredToken] if the key has expired.
+func (s *Service) Authenticate(ctx context.Context, tk string) (Key, error) {
+	keyID, secret, err := parseToken(tk)
+	if err != nil {
+		return Key{}, err
+	}
+	var k Key
+	if err = s.keys.NewRetrieve().
+		Where(gorp.MatchKeys[uuid.UUID, Key](keyID)).
+		Entry(&k).
+		Exec(ctx, s.cfg.DB); err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return Key{}, errors.Wrap(auth.ErrInvalidToken, "unknown API key")
+		}
+		return Key{}, err
+	}
+	if subtle.ConstantTimeCompare(k.Hash, hashSecret(secret)) != 1 {
+		return Key{}, errors.Wrap(auth.ErrInvalidToken, "unknown API key")
+	}
+	now := s.cfg.Now()
+	if k.Expired(now) {
+		return Key{}, errors.Wrapf(auth.ErrExpiredToken, "API key %s expired", k.Name)
+	}
+	if err = s.validateServiceAccount(ctx, k.ServiceAccount); err != nil {
+		return Key{}, err
+	}
+	if telem.TimeSpan(now-k.LastUsedAt) >= telem.TimeSpan(s.cfg.LastUsedResolution) {
+		// Failing to record the last used time should not fail the request.
+		if err = s.touch(ctx, k.Key, now); err != nil {
+			s.cfg.L.Warn("failed to record API key use", zap.Stringer("key", k.Key), zap.Error(err))
+		}
+		k.LastUsedAt = now
+	}
+	k.Hash = nil
+	return k, nil
+}
+
+// validateServiceAccount returns [auth.ErrInvalidToken] if the service account with
+// the given key, or the user backing it, no longer exists.
+func (s *Service) validateServiceAccount(ctx context.Context, key user.Key) error {
+	exists, err := s.accounts.NewRetrieve().
+		Where(gorp.MatchKeys[user.Key, ServiceAccount](key)).
+		Exists(ctx, s.cfg.DB)
+	if err != nil {
+		return err
+	}
+	if exists {
+		exists, err = s.cfg.User.NewRetrieve().
+			Where(user.MatchKeys(key)).
+			Exists(ctx, s.cfg.DB)
+		if err != nil {
+			return err
+		}
+	}
+	if !exists {
+		return errors.Wrap(auth.ErrInvalidToken, "service account no longer exists")
+	}
+	return nil
+}
+
+// Watch returns a copy of ctx that is canceled with [ErrRevoked] as its cause when the
+// key with the given ID is deleted or rotated on any node in the cluster, including
+// when its service account is deleted. The returned cancel function must be called to
+// release resources once the context is no longer needed.
+func (s *Service) Watch(ctx context.Context, key uuid.UUID) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	var k Key
+	if err := s.keys.NewRetrieve().
+		Where(gorp.MatchKeys[uuid.UUID, Key](key)).
+		Entry(&k).
+		Exec(ctx, s.cfg.DB); err != nil {
+		// The key was revoked before the watch started.
+		cancel(ErrRevoked)
+		return ctx, func() { cancel(context.Canceled) }
+	}
+	s.watchers.Lock()
+	id := s.watchers.counter
+	s.watchers.counter++
+	if s.watchers.watches[key] == nil {
+		s.watchers.watches[key] = make(map[int]watch)
+	}
+	s.watchers.watches[key][id] = watch{hash: k.Hash, cancel: cancel}
+	s.watchers.Unlock()
+	return ctx, func() {
+		s.watchers.Lock()
+		delete(s.watchers.watches[key], id)
+		if len(s.watchers.watches[key]) == 0 {
+			delete(s.watchers.watches, key)
+		}
+		s.watchers.Unlock()
+		cancel(context.Canceled)
+	}
+}
+
+func (s *Service) handleChanges(
+	_ context.Context,
+	changes iter.Seq[change.Change[uuid.UUID, Key]],
+) {
+	s.watchers.Lock()
+	defer s.watchers.Unlock()
+	for ch := range changes {
+		for _, w := range s.watchers.watches[ch.Key] {
+			if ch.Variant == change.VariantDelete || !bytes.Equal(ch.Value.Hash, w.hash) {
+				w.cancel(ErrRevoked)
+			}
+		}
+	}
+}
+
+func (s *Service) touch(ctx context.Context, key uuid.UUID, now telem.TimeStamp) error {
+	return s.keys.NewUpdate().
+		Where(gorp.MatchKeys[uuid.UUID, Key](key)).
+		Change(func(_ gorp.Context, k Key) Key {
+			k.LastUsedAt = now
+			return k
+		}).
+		Exec(ctx, s.cfg.DB)
+}
+
+func matchServiceAccounts(accounts ...user.Key) gorp.Filter[uuid.UUID, Key] {
+	return gorp.Match(func(_ gorp.Context, k *Key) (bool, error) {
+		for _, a := range accounts {
+			if k.ServiceAccount == a {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package apikey
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/policy"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// A Writer is used to create and delete service accounts and their keys. No identity
+// checks are performed; callers must already have authorized the operation.
+type Writer struct {
+	svc *Service
+	tx  gorp.Tx
+}
+
+// CreateServiceAccount creates a service account backed by a new user with the
+// username of u. Returns [auth.ErrRepeatedUsername] if the username is taken.
+func (w Writer) CreateServiceAccount(
+	ctx context.Context,
+	u user.User,
+	description string,
+) (user.User, ServiceAccount, error) {
+	u, err := w.svc.cfg.User.NewWriter(w.tx).Create(ctx, u)
+	if err != nil {
+		return user.User{}, ServiceAccount{}, err
+	}
+	a := ServiceAccount{Key: u.Key, Description: description, CreatedAt: w.svc.cfg.Now()}
+	if err = w.svc.accounts.NewCreate().Entry(&a).Exec(ctx, w.tx); err != nil {
+		return user.User{}, ServiceAccount{}, err
+	}
+	return u, a, nil
+}
+
+// DeleteServiceAccounts deletes the service accounts with the given keys, along with
+// their users and keys. Keys that are not service accounts are ignored.
+func (w Writer) DeleteServiceAccounts(ctx context.Context, keys ...user.Key) error {
+	accounts, err := w.svc.RetrieveServiceAccounts(ctx, w.tx, keys...)
+	if err != nil && !errors.Is(err, query.ErrNotFound) {
+		return err
+	}
+	if len(accounts) == 0 {
+		return nil
+	}
+	keys = lo.Map(accounts, func(a ServiceAccount, _ int) user.Key { return a.Key })
+	if err = w.svc.keys.NewDelete().
+		Where(matchServiceAccounts(keys...)).
+		Exec(ctx, w.tx); err != nil {
+		return err
+	}
+	if err = w.svc.accounts.NewDelete().
+		Where(gorp.MatchKeys[user.Key, ServiceAccount](keys...)).
+		Exec(ctx, w.tx); err != nil {
+		return err
+	}
+	return w.svc.cfg.User.NewWriter(w.tx).Delete(ctx, keys...)
+}
+
+// Create creates k, assigning it a new key and secret, and returns the token clients
+// authenticate with. The token can't be retrieved again. Returns a validation error if
+// the service account or any of the policies k is scoped to do not exist.
+func (w Writer) Create(ctx context.Context, k *Key) (string, error) {
+	v := validate.New("api_key")
+	validate.NotEmptyString(v, "name", k.Name)
+	if !k.ExpiresAt.IsZero() {
+		v.Ternary("expires_at", k.ExpiresAt <= w.svc.cfg.Now(), "must be in the future")
+	}
+	if err := v.Error(); err != nil {
+		return "", err
+	}
+	if err := w.validateServiceAccount(ctx, k.ServiceAccount); err != nil {
+		return "", err
+	}
+	if err := w.validatePolicies(ctx, k.Policies); err != nil {
+		return "", err
+	}
+	k.Key = uuid.New()
+	k.CreatedAt = w.svc.cfg.Now()
+	k.LastUsedAt = 0
+	secret, hash := newSecret()
+	k.Hash = hash
+	if err := w.svc.keys.NewCreate().Entry(k).Exec(ctx, w.tx); err != nil {
+		return "", err
+	}
+	k.Hash = nil
+	return formatToken(k.Key, secret), nil
+}
+
+// Rotate replaces the secret of the key with the given ID, returning the new token.
+// The previous token is rejected as soon as the rotation is committed.
+func (w Writer) Rotate(ctx context.Context, key uuid.UUID) (string, error) {
+	secret, hash := newSecret()
+	if err := w.svc.keys.NewUpdate().
+		Where(gorp.MatchKeys[uuid.UUID, Key](key)).
+		Change(func(_ gorp.Context, k Key) Key {
+			k.Hash = hash
+			return k
+		}).
+		Exec(ctx, w.tx); err != nil {
+		return "", err
+	}
+	return formatToken(key, secret), nil
+}
+
+// Delete revokes the keys with the given IDs. Revoked keys are rejected as soon as
+// the deletion is committed.
+func (w Writer) Delete(ctx context.Context, keys ...uuid.UUID) error {
+	return w.svc.keys.NewDelete().
+		Where(gorp.MatchKeys[uuid.UUID, Key](keys...)).
+		Exec(ctx, w.tx)
+}
+
+func (w Writer) validateServiceAccount(ctx context.Context, key user.Key) error {
+	exists, err := w.svc.accounts.NewRetrieve().
+		Where(gorp.MatchKeys[user.Key, ServiceAccount](key)).
+		Exists(ctx, w.tx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Wrapf(validate.ErrValidation, "service account %s does not exist", key)
+	}
+	return nil
+}
+
+func (w Writer) validatePolicies(ctx context.Context, keys []uuid.UUID) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	var policies []policy.Policy
+	if err := w.svc.cfg.Policy.NewRetrieve().
+		Where(policy.MatchKeys(keys...)).
+		Entries(&policies).
+		Exec(ctx, w.tx); err != nil && !errors.Is(err, query.ErrNotFound) {
+		return err
+	}
+	found := lo.Map(policies, func(p policy.Policy, _ int) uuid.UUID { return p.Key })
+	if missing, _ := lo.Difference(keys, found); len(missing) > 0 {
+		return errors.Wrapf(validate.ErrValidation, "policies %v do not exist", missing)
+	}
+	return nil
+}
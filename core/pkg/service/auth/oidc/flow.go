@@ -0,0 +1,133 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/errors"
+)
+
+// ErrInvalidState is returned when a sign-in is completed with a state that was not
+// issued by [Service.Authorize], has already been used, or has expired.
+var ErrInvalidState = errors.Wrap(auth.ErrAuth, "invalid or expired sign-in state")
+
+// pending is a sign-in that has been started but not completed.
+type pending struct {
+	verifier string
+	nonce    string
+	expires  time.Time
+}
+
+// Authorization is the start of a sign-in.
+type Authorization struct {
+	// URL is the provider URL the user should be redirected to in order to sign in.
+	URL string `json:"url" msgpack:"url"`
+	// State identifies the sign-in, and is returned by the provider alongside the
+	// authorization code.
+	State string `json:"state" msgpack:"state"`
+}
+
+// Authorize starts a sign-in, returning the URL of the provider's authorization
+// endpoint to redirect the user to. The sign-in must be completed with [Service.Login]
+// within the configured state TTL. Pending sign-ins are held in memory, so Login must
+// be called on the same node as Authorize. At most the configured maximum number of
+// sign-ins are pending at once, and the oldest is discarded to start a new one.
+func (s *Service) Authorize(ctx context.Context) (Authorization, error) {
+	meta, err := s.provider.metadata(ctx)
+	if err != nil {
+		return Authorization{}, err
+	}
+	p := pending{
+		verifier: randomString(),
+		nonce:    randomString(),
+		expires:  s.cfg.Now().Add(s.cfg.StateTTL),
+	}
+	state := randomString()
+	challenge := sha256.Sum256([]byte(p.verifier))
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {s.cfg.ClientID},
+		"redirect_uri":          {s.cfg.RedirectURL},
+		"scope":                 {strings.Join(s.cfg.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {p.nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	u, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return Authorization{}, errors.Wrap(err, "invalid authorization endpoint")
+	}
+	if u.RawQuery != "" {
+		u.RawQuery += "&"
+	}
+	u.RawQuery += q.Encode()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.cfg.Now()
+	var (
+		oldest        string
+		oldestExpires time.Time
+	)
+	for k, v := range s.mu.pending {
+		if now.After(v.expires) {
+			delete(s.mu.pending, k)
+		} else if oldest == "" || v.expires.Before(oldestExpires) {
+			oldest, oldestExpires = k, v.expires
+		}
+	}
+	if len(s.mu.pending) >= s.cfg.MaxPending {
+		delete(s.mu.pending, oldest)
+	}
+	s.mu.pending[state] = p
+	return Authorization{URL: u.String(), State: state}, nil
+}
+
+// Login completes the sign-in identified by state, exchanging the authorization code
+// returned by the provider for an ID token and validating it. The identity in the
+// token is linked to a user, provisioning one on first sign-in, and the user's mapped
+// roles are synchronized with the groups in the token. Returns [ErrInvalidState] if
+// state is unknown or expired, and [auth.ErrInvalidToken] if the ID token fails
+// validation.
+func (s *Service) Login(ctx context.Context, code, state string) (user.User, error) {
+	s.mu.Lock()
+	p, ok := s.mu.pending[state]
+	delete(s.mu.pending, state)
+	s.mu.Unlock()
+	if !ok || s.cfg.Now().After(p.expires) {
+		return user.User{}, ErrInvalidState
+	}
+	raw, err := s.provider.exchange(ctx, code, p.verifier)
+	if err != nil {
+		return user.User{}, err
+	}
+	claims, err := s.provider.verify(ctx, raw, p.nonce)
+	if err != nil {
+		return user.User{}, err
+	}
+	return s.link(ctx, s.parseClaims(claims))
+}
+
+// randomString returns 32 random bytes encoded as unpadded base64url, which is
+// suitable for use as a state, nonce, or PKCE code verifier.
+func randomString() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/role"
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/query"
+	"go.uber.org/zap"
+)
+
+// Identity links an identity at a provider to the user it signs in as.
+type Identity struct {
+	// Issuer is the issuer of the identity.
+	Issuer string `json:"issuer" msgpack:"issuer"`
+	// Subject is the identifier of the identity at its issuer.
+	Subject string `json:"subject" msgpack:"subject"`
+	// User is the key of the user the identity signs in as.
+	User user.Key `json:"user" msgpack:"user"`
+}
+
+// GorpKey implements gorp.Entry. Issuers are URLs without a fragment, so the key
+// can't be ambiguous.
+func (i Identity) GorpKey() string { return i.Issuer + "#" + i.Subject }
+
+// SetOptions implements gorp.Entry.
+func (Identity) SetOptions() []any { return nil }
+
+// Claims are the claims of a validated ID token that are used to sign a user in.
+type Claims struct {
+	// Subject is the identifier of the user at the provider.
+	Subject string
+	// Username is the value of the configured username claim, falling back to the
+	// email if the provider has verified it, and then the subject.
+	Username  string
+	FirstName string
+	LastName  string
+	// Groups are the groups the user belongs to at the provider.
+	Groups []string
+}
+
+func (s *Service) parseClaims(c jwt.MapClaims) Claims {
+	str := func(name string) string { v, _ := c[name].(string); return v }
+	out := Claims{
+		Subject:   str("sub"),
+		FirstName: str("given_name"),
+		LastName:  str("family_name"),
+	}
+	for _, name := range []string{s.cfg.UsernameClaim, "email", "sub"} {
+		// Anyone can claim an unverified email, so it can't identify a user.
+		if name == "email" && !emailVerified(c) {
+			continue
+		}
+		if out.Username = str(name); out.Username != "" {
+			break
+		}
+	}
+	switch groups := c[s.cfg.GroupsClaim].(type) {
+	case string:
+		out.Groups = []string{groups}
+	case []any:
+		for _, g := range groups {
+			if g, ok := g.(string); ok {
+				out.Groups = append(out.Groups, g)
+			}
+		}
+	}
+	return out
+}
+
+// emailVerified returns true if the provider has verified the email claim in c. Some
+// providers encode the email_verified claim as a string.
+func emailVerified(c jwt.MapClaims) bool {
+	switch v := c["email_verified"].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
+
+// RetrieveIdentity returns the identity with the given subject at the configured
+// issuer. Returns [query.ErrNotFound] if the identity has not signed in.
+func (s *Service) RetrieveIdentity(ctx context.Context, tx gorp.Tx, subject string) (Identity, error) {
+	var id Identity
+	err := s.identities.NewRetrieve().
+		Where(gorp.MatchKeys[string, Identity](Identity{Issuer: s.cfg.Issuer, Subject: subject}.GorpKey())).
+		Entry(&id).
+		Exec(ctx, gorp.OverrideTx(s.cfg.DB, tx))
+	return id, err
+}
+
+// link returns the user the identity in c signs in as and synchronizes its mapped
+// roles. On the identity's first sign-in a new user is provisioned for it, or, if
+// the service is configured to link existing users, it is linked to the user with the
+// same username. Identities are never linked to root users, and identities whose user
+// has been deleted can no longer sign in.
+func (s *Service) link(ctx context.Context, c Claims) (u user.User, err error) {
+	err = s.cfg.DB.WithTx(ctx, func(tx gorp.Tx) error {
+		id, err := s.RetrieveIdentity(ctx, tx, c.Subject)
+		if err == nil {
+			err = s.cfg.User.NewRetrieve().
+				Where(user.MatchKeys(id.User)).
+				Entry(&u).
+				Exec(ctx, tx)
+			if errors.Is(err, query.ErrNotFound) {
+				// The identity is kept after its user is deleted, so that deleting
+				// a user deactivates them instead of provisioning a new user the
+				// next time they sign in at the provider.
+				return errors.Wrap(auth.ErrInvalidCredentials, "user has been deactivated")
+			}
+			if err != nil {
+				return err
+			}
+			return s.syncRoles(ctx, tx, u, c.Groups)
+		}
+		if !errors.Is(err, query.ErrNotFound) {
+			return err
+		}
+		if u, err = s.provision(ctx, tx, c); err != nil {
+			return err
+		}
+		if err = s.identities.NewCreate().Entry(&Identity{
+			Issuer:  s.cfg.Issuer,
+			Subject: c.Subject,
+			User:    u.Key,
+		}).Exec(ctx, tx); err != nil {
+			return err
+		}
+		return s.syncRoles(ctx, tx, u, c.Groups)
+	})
+	return u, err
+}
+
+// provision returns the user a new identity signs in as. If the service is configured
+// to link existing users, this is the user with the username in c. Otherwise, or if
+// there is no such user, a new user is created. If the username in c is taken, the
+// new user's username is suffixed with a hash of the identity, as usernames at the
+// provider are neither unique nor stable and must not capture another user's account.
+func (s *Service) provision(ctx context.Context, tx gorp.Tx, c Claims) (user.User, error) {
+	var u user.User
+	err := s.cfg.User.NewRetrieve().
+		Where(user.MatchUsernames(c.Username)).
+		Entry(&u).
+		Exec(ctx, tx)
+	if err != nil && !errors.Is(err, query.ErrNotFound) {
+		return user.User{}, err
+	}
+	username := c.Username
+	if err == nil && *s.cfg.LinkExistingUsers {
+		if u.RootUser {
+			return user.User{}, errors.Wrapf(
+				auth.ErrInvalidCredentials,
+				"cannot sign in as root user %s through single sign-on",
+				u.Username,
+			)
+		}
+		s.cfg.L.Info(
+			"linked identity to existing user",
+			zap.String("subject", c.Subject),
+			zap.String("username", u.Username),
+		)
+		return u, nil
+	}
+	if err == nil {
+		username = s.disambiguate(c)
+	}
+	if u, err = s.cfg.User.NewWriter(tx).Create(ctx, user.User{
+		Username:  username,
+		FirstName: c.FirstName,
+		LastName:  c.LastName,
+	}); err != nil {
+		return user.User{}, err
+	}
+	s.cfg.L.Info(
+		"provisioned user",
+		zap.String("subject", c.Subject),
+		zap.String("username", u.Username),
+	)
+	return u, nil
+}
+
+// disambiguate returns the username in c suffixed with a short hash of the identity,
+// which is stable across sign-ins and distinct for every identity.
+func (s *Service) disambiguate(c Claims) string {
+	sum := sha256.Sum256([]byte(Identity{Issuer: s.cfg.Issuer, Subject: c.Subject}.GorpKey()))
+	return c.Username + "-" + hex.EncodeToString(sum[:4])
+}
+
+// syncRoles assigns u the roles mapped from groups and unassigns the mapped roles of
+// the groups it does not belong to. Mapped roles that do not exist are skipped.
+func (s *Service) syncRoles(ctx context.Context, tx gorp.Tx, u user.User, groups []string) error {
+	if len(s.cfg.RoleMappings) == 0 {
+		return nil
+	}
+	names := slices.Compact(slices.Sorted(maps.Values(s.cfg.RoleMappings)))
+	var roles []role.Role
+	if err := s.cfg.Role.NewRetrieve().
+		Where(role.MatchNames(names...)).
+		Entries(&roles).
+		Exec(ctx, tx); err != nil {
+		return err
+	}
+	if len(roles) < len(names) {
+		s.cfg.L.Warn("some mapped roles do not exist", zap.Strings("roles", names))
+	}
+	assigned := make(map[string]bool, len(groups))
+	for _, g := range groups {
+		if name, ok := s.cfg.RoleMappings[g]; ok {
+			assigned[name] = true
+		}
+	}
+	w := s.cfg.Role.NewWriter(tx, false)
+	for _, r := range roles {
+		var err error
+		if assigned[r.Name] {
+			err = w.AssignRole(ctx, user.OntologyID(u.Key), r.Key)
+		} else {
+			err = w.UnassignRole(ctx, user.OntologyID(u.Key), r.Key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package oidc implements single sign-on through an OpenID Connect identity provider.
+// Users are signed in with the authorization code flow using PKCE: the Core builds the
+// authorization URL, the client redirects the user to the provider, and the code the
+// provider returns is exchanged for an ID token that the Core validates against the
+// provider's published keys. The first sign-in of a provider identity provisions a
+// [user.User] for it, or links it to an existing user if configured to, and the
+// provider's group claims are mapped onto RBAC roles on every sign-in.
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/role"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/override"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// ServiceConfig is the configuration for opening a [Service].
+type ServiceConfig struct {
+	// DB is the database used to store the links between provider identities and
+	// users.
+	//
+	// [REQUIRED]
+	DB *gorp.DB
+	// User is used to look up and provision the users that identities are linked to.
+	//
+	// [REQUIRED]
+	User *user.Service
+	// Role is used to assign the roles mapped from the provider's group claims.
+	//
+	// [REQUIRED]
+	Role *role.Service
+	// Issuer is the URL of the identity provider. The provider's configuration is
+	// discovered at Issuer/.well-known/openid-configuration.
+	//
+	// [REQUIRED]
+	Issuer string
+	// ClientID is the ID of the client registered with the provider. ID tokens must
+	// list it in their audience.
+	//
+	// [REQUIRED]
+	ClientID string
+	// ClientSecret is the secret of the client registered with the provider. Public
+	// clients that only rely on PKCE can leave it empty.
+	//
+	// [OPTIONAL]
+	ClientSecret string
+	// RedirectURL is the URL the provider redirects the user to after they sign in.
+	// It must match one of the redirect URLs registered for the client.
+	//
+	// [REQUIRED]
+	RedirectURL string
+	// Scopes are the scopes requested from the provider. The openid scope is always
+	// requested.
+	//
+	// [OPTIONAL] - Defaults to openid, profile, and email.
+	Scopes []string
+	// UsernameClaim is the claim used as the username of provisioned users. If a
+	// token does not carry it, the email claim is used if the provider has verified
+	// it, and then the subject.
+	//
+	// [OPTIONAL] - Defaults to preferred_username.
+	UsernameClaim string
+	// LinkExistingUsers sets whether the first sign-in of an identity links it to the
+	// existing user with the same username. Only enable this if the provider
+	// guarantees that the username claim is unique and can't be changed by its users,
+	// as anyone who can set their username at the provider can otherwise sign in as
+	// any user. If false, a new user is always provisioned for a new identity.
+	//
+	// [OPTIONAL] - Defaults to false.
+	LinkExistingUsers *bool
+	// GroupsClaim is the claim that lists the groups the user belongs to.
+	//
+	// [OPTIONAL] - Defaults to groups.
+	GroupsClaim string
+	// RoleMappings maps the name of a provider group to the name of the role its
+	// members are assigned. Mapped roles are unassigned from users that leave the
+	// group; roles assigned outside the mapping are left untouched.
+	//
+	// [OPTIONAL]
+	RoleMappings map[string]string
+	// StateTTL is how long a sign-in started with [Service.Authorize] can be
+	// completed with [Service.Login].
+	//
+	// [OPTIONAL] - Defaults to 10 minutes.
+	StateTTL time.Duration
+	// MaxPending is the maximum number of sign-ins started with [Service.Authorize]
+	// that are held in memory awaiting completion. Once reached, the oldest pending
+	// sign-in is discarded to make room for a new one.
+	//
+	// [OPTIONAL] - Defaults to 1000.
+	MaxPending int
+	// HTTPClient is used for all requests to the provider.
+	//
+	// [OPTIONAL] - Defaults to a client with a 10 second timeout.
+	HTTPClient *http.Client
+	// Now returns the current time, and is used to validate token lifetimes.
+	//
+	// [OPTIONAL] - Defaults to time.Now.
+	Now func() time.Time
+	// Instrumentation is for logging, tracing, metrics, etc.
+	//
+	// [OPTIONAL] - Defaults to noop instrumentation.
+	alamos.Instrumentation
+}
+
+var (
+	_ config.Config[ServiceConfig] = ServiceConfig{}
+	// DefaultServiceConfig is the default configuration for opening a [Service].
+	DefaultServiceConfig = ServiceConfig{
+		Scopes:            []string{"openid", "profile", "email"},
+		UsernameClaim:     "preferred_username",
+		LinkExistingUsers: new(false),
+		GroupsClaim:       "groups",
+		StateTTL:          10 * time.Minute,
+		MaxPending:        1000,
+		HTTPClient:        &http.Client{Timeout: 10 * time.Second},
+		Now:               time.Now,
+	}
+)
+
+// Enabled returns true if the configuration names an identity provider.
+func (c ServiceConfig) Enabled() bool { return c.Issuer != "" }
+
+// Override implements config.Config.
+func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
+	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
+	c.DB = override.Nil(c.DB, other.DB)
+	c.User = override.Nil(c.User, other.User)
+	c.Role = override.Nil(c.Role, other.Role)
+	c.Issuer = override.String(c.Issuer, other.Issuer)
+	c.ClientID = override.String(c.ClientID, other.ClientID)
+	c.ClientSecret = override.String(c.ClientSecret, other.ClientSecret)
+	c.RedirectURL = override.String(c.RedirectURL, other.RedirectURL)
+	c.Scopes = override.Slice(c.Scopes, other.Scopes)
+	c.UsernameClaim = override.String(c.UsernameClaim, other.UsernameClaim)
+	c.LinkExistingUsers = override.Nil(c.LinkExistingUsers, other.LinkExistingUsers)
+	c.GroupsClaim = override.String(c.GroupsClaim, other.GroupsClaim)
+	c.RoleMappings = override.Nil(c.RoleMappings, other.RoleMappings)
+	c.StateTTL = override.Numeric(c.StateTTL, other.StateTTL)
+	c.MaxPending = override.Numeric(c.MaxPending, other.MaxPending)
+	c.HTTPClient = override.Nil(c.HTTPClient, other.HTTPClient)
+	c.Now = override.Nil(c.Now, other.Now)
+	return c
+}
+
+// Validate implements config.Config.
+func (c ServiceConfig) Validate() error {
+	v := validate.New("oidc")
+	validate.NotNil(v, "db", c.DB)
+	validate.NotNil(v, "user", c.User)
+	validate.NotNil(v, "role", c.Role)
+	validate.NotEmptyString(v, "issuer", c.Issuer)
+	validate.NotEmptyString(v, "client_id", c.ClientID)
+	validate.NotEmptyString(v, "redirect_url", c.RedirectURL)
+	validate.NotEmptyString(v, "username_claim", c.UsernameClaim)
+	validate.NotNil(v, "link_existing_users", c.LinkExistingUsers)
+	validate.NotEmptyString(v, "groups_claim", c.GroupsClaim)
+	validate.Positive(v, "state_ttl", c.StateTTL)
+	validate.Positive(v, "max_pending", c.MaxPending)
+	validate.NotNil(v, "http_client", c.HTTPClient)
+	validate.NotNil(v, "now", c.Now)
+	return v.Error()
+}
+
+// Service signs users in through an OpenID Connect identity provider.
+type Service struct {
+	cfg        ServiceConfig
+	identities *gorp.Table[string, Identity]
+	provider   provider
+	mu         struct {
+		sync.Mutex
+		// pending holds the sign-ins started by Authorize that have not yet been
+		// completed, keyed by their state.
+		pending map[string]pending
+	}
+}
+
+// OpenService opens a new [Service] using the provided configurations. The provider's
+// configuration is discovered on the first sign-in, so the Core can start while the
+// provider is unreachable. The returned Service must be closed after use.
+func OpenService(ctx context.Context, cfgs ...ServiceConfig) (*Service, error) {
+	cfg, err := config.New(DefaultServiceConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(cfg.Scopes, "openid") {
+		cfg.Scopes = append([]string{"openid"}, cfg.Scopes...)
+	}
+	s := &Service{cfg: cfg, provider: provider{cfg: cfg}}
+	s.mu.pending = make(map[string]pending)
+	if s.identities, err = gorp.OpenTable(ctx, gorp.TableConfig[string, Identity]{
+		DB:              cfg.DB,
+		Instrumentation: cfg.Instrumentation,
+	}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the service and releases any resources.
+func (s *Service) Close() error { return s.identities.Close() }
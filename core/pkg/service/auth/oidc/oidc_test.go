@@ -0,0 +1,420 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package oidc_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/access/rbac/role"
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/oidc"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/gorp"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+const clientID = "synnax"
+
+// grant is an authorization code issued by the stand-in issuer.
+type grant struct {
+	challenge string
+	claims    jwt.MapClaims
+}
+
+// issuer is a stand-in OpenID Connect provider that serves discovery and a key set,
+// and signs ID tokens for the codes it grants.
+type issuer struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	mu     sync.Mutex
+	grants map[string]grant
+}
+
+func newIssuer() *issuer {
+	iss := &issuer{
+		key:    MustSucceed(rsa.GenerateKey(rand.Reader, 2048)),
+		grants: make(map[string]grant),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 iss.URL,
+			"authorization_endpoint": iss.URL + "/authorize",
+			"token_endpoint":         iss.URL + "/token",
+			"jwks_uri":               iss.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kid": "key",
+			"kty": "RSA",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(iss.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(iss.key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		Expect(r.ParseForm()).To(Succeed())
+		iss.mu.Lock()
+		g, ok := iss.grants[r.PostForm.Get("code")]
+		delete(iss.grants, r.PostForm.Get("code"))
+		iss.mu.Unlock()
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != g.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": iss.sign(g.claims)})
+	})
+	iss.Server = httptest.NewServer(mux)
+	return iss
+}
+
+func (iss *issuer) sign(claims jwt.MapClaims) string {
+	tk := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tk.Header["kid"] = "key"
+	return MustSucceed(tk.SignedString(iss.key))
+}
+
+// authorize plays the part of the user's browser, signing in at the URL returned by
+// Authorize. The returned code carries the standard claims for subject, overridden by
+// claims.
+func (iss *issuer) authorize(a oidc.Authorization, subject string, claims jwt.MapClaims) string {
+	u := MustSucceed(url.Parse(a.URL))
+	q := u.Query()
+	Expect(q.Get("state")).To(Equal(a.State))
+	Expect(q.Get("code_challenge_method")).To(Equal("S256"))
+	now := time.Now()
+	full := jwt.MapClaims{
+		"iss":   iss.URL,
+		"aud":   clientID,
+		"sub":   subject,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Minute).Unix(),
+		"nonce": q.Get("nonce"),
+	}
+	for k, v := range claims {
+		full[k] = v
+	}
+	code := uuid.NewString()
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	iss.grants[code] = grant{challenge: q.Get("code_challenge"), claims: full}
+	return code
+}
+
+var _ = Describe("OIDC", func() {
+	var (
+		iss *issuer
+		svc *oidc.Service
+		cfg oidc.ServiceConfig
+	)
+	BeforeEach(func(ctx SpecContext) {
+		iss = newIssuer()
+		DeferCleanup(iss.Close)
+		cfg = oidc.ServiceConfig{
+			DB:          db,
+			User:        userSvc,
+			Role:        roleSvc,
+			Issuer:      iss.URL,
+			ClientID:    clientID,
+			RedirectURL: "http://localhost:5173/callback",
+			HTTPClient:  iss.Client(),
+		}
+	})
+	JustBeforeEach(func(ctx SpecContext) {
+		svc = MustOpen(oidc.OpenService(ctx, cfg))
+	})
+	login := func(ctx SpecContext, subject string, claims jwt.MapClaims) (user.User, error) {
+		a := MustSucceed(svc.Authorize(ctx))
+		return svc.Login(ctx, iss.authorize(a, subject, claims), a.State)
+	}
+	roles := func(ctx SpecContext, u user.User) []string {
+		var parents []ontology.Resource
+		Expect(otg.NewRetrieve().
+			WhereIDs(user.OntologyID(u.Key)).
+			TraverseTo(ontology.ParentsTraverser).
+			WhereTypes(ontology.ResourceTypeRole).
+			Entries(&parents).
+			Exec(ctx, nil)).To(Succeed())
+		names := make([]string, len(parents))
+		for i, p := range parents {
+			names[i] = p.Name
+		}
+		return names
+	}
+
+	Describe("Authorize", func() {
+		It("Should build an authorization URL with a PKCE challenge", func(ctx SpecContext) {
+			a := MustSucceed(svc.Authorize(ctx))
+			u := MustSucceed(url.Parse(a.URL))
+			Expect(u.Path).To(Equal("/authorize"))
+			q := u.Query()
+			Expect(q.Get("response_type")).To(Equal("code"))
+			Expect(q.Get("client_id")).To(Equal(clientID))
+			Expect(q.Get("redirect_uri")).To(Equal(cfg.RedirectURL))
+			Expect(q.Get("scope")).To(Equal("openid profile email"))
+			Expect(q.Get("state")).To(Equal(a.State))
+			Expect(q.Get("nonce")).ToNot(BeEmpty())
+			Expect(q.Get("code_challenge")).To(HaveLen(43))
+		})
+
+		It("Should return an error when the provider can't be discovered", func(ctx SpecContext) {
+			iss.Close()
+			Expect(svc.Authorize(ctx)).Error().To(MatchError(ContainSubstring("discover")))
+		})
+
+		Context("Max Pending", func() {
+			BeforeEach(func() { cfg.MaxPending = 2 })
+			It("Should discard the oldest pending sign-in once the limit is reached", func(ctx SpecContext) {
+				authorizations := make([]oidc.Authorization, 3)
+				for i := range authorizations {
+					authorizations[i] = MustSucceed(svc.Authorize(ctx))
+				}
+				first := authorizations[0]
+				Expect(svc.Login(ctx, iss.authorize(first, uuid.NewString(), nil), first.State)).
+					Error().To(MatchError(oidc.ErrInvalidState))
+				for _, a := range authorizations[1:] {
+					MustSucceed(svc.Login(ctx, iss.authorize(a, uuid.NewString(), nil), a.State))
+				}
+			})
+		})
+	})
+
+	Describe("Login", func() {
+		It("Should provision a user on the first sign-in", func(ctx SpecContext) {
+			username := "sso-" + uuid.NewString()
+			u := MustSucceed(login(ctx, uuid.NewString(), jwt.MapClaims{
+				"preferred_username": username,
+				"given_name":         "Ada",
+				"family_name":        "Lovelace",
+			}))
+			Expect(u.Username).To(Equal(username))
+			Expect(u.FirstName).To(Equal("Ada"))
+			Expect(u.LastName).To(Equal("Lovelace"))
+			var stored user.User
+			Expect(userSvc.NewRetrieve().
+				Where(user.MatchKeys(u.Key)).
+				Entry(&stored).
+				Exec(ctx, nil)).To(Succeed())
+			Expect(stored).To(Equal(u))
+		})
+
+		It("Should sign in as the same user on later sign-ins", func(ctx SpecContext) {
+			subject := uuid.NewString()
+			first := MustSucceed(login(ctx, subject, jwt.MapClaims{
+				"preferred_username": "sso-" + uuid.NewString(),
+			}))
+			second := MustSucceed(login(ctx, subject, jwt.MapClaims{
+				"preferred_username": "sso-" + uuid.NewString(),
+			}))
+			Expect(second).To(Equal(first))
+			id := MustSucceed(svc.RetrieveIdentity(ctx, nil, subject))
+			Expect(id.User).To(Equal(first.Key))
+		})
+
+		It("Should not link the identity to an existing user with the same username", func(ctx SpecContext) {
+			existing := MustSucceed(userSvc.NewWriter(nil).Create(ctx, user.User{
+				Username: "operator-" + uuid.NewString(),
+			}))
+			u := MustSucceed(login(ctx, uuid.NewString(), jwt.MapClaims{
+				"preferred_username": existing.Username,
+			}))
+			Expect(u.Key).ToNot(Equal(existing.Key))
+			Expect(u.Username).To(HavePrefix(existing.Username + "-"))
+			Expect(u.RootUser).To(BeFalse())
+		})
+
+		It("Should not sign in as the root user with its username", func(ctx SpecContext) {
+			u := MustSucceed(login(ctx, uuid.NewString(), jwt.MapClaims{
+				"preferred_username": root.Username,
+			}))
+			Expect(u.Username).ToNot(Equal(root.Username))
+			Expect(u.RootUser).To(BeFalse())
+		})
+
+		It("Should fall back to a verified email when the username claim is missing", func(ctx SpecContext) {
+			email := uuid.NewString() + "@example.com"
+			u := MustSucceed(login(ctx, uuid.NewString(), jwt.MapClaims{
+				"email":          email,
+				"email_verified": true,
+			}))
+			Expect(u.Username).To(Equal(email))
+		})
+
+		It("Should not use an unverified email as the username", func(ctx SpecContext) {
+			subject := uuid.NewString()
+			u := MustSucceed(login(ctx, subject, jwt.MapClaims{
+				"email": uuid.NewString() + "@example.com",
+			}))
+			Expect(u.Username).To(Equal(subject))
+		})
+
+		It("Should not sign in as a user that has been deleted", func(ctx SpecContext) {
+			subject := uuid.NewString()
+			u := MustSucceed(login(ctx, subject, nil))
+			Expect(userSvc.NewWriter(nil).Delete(ctx, u.Key)).To(Succeed())
+			Expect(login(ctx, subject, nil)).Error().
+				To(MatchError(auth.ErrInvalidCredentials))
+		})
+
+		Context("Linking Existing Users", func() {
+			BeforeEach(func() { cfg.LinkExistingUsers = new(true) })
+
+			It("Should link the identity to an existing user with the same username", func(ctx SpecContext) {
+				existing := MustSucceed(userSvc.NewWriter(nil).Create(ctx, user.User{
+					Username: "sso-" + uuid.NewString(),
+				}))
+				u := MustSucceed(login(ctx, uuid.NewString(), jwt.MapClaims{
+					"preferred_username": existing.Username,
+				}))
+				Expect(u.Key).To(Equal(existing.Key))
+			})
+
+			It("Should not link an identity to the root user", func(ctx SpecContext) {
+				Expect(login(ctx, uuid.NewString(), jwt.MapClaims{
+					"preferred_username": root.Username,
+				})).Error().To(MatchError(auth.ErrInvalidCredentials))
+			})
+		})
+
+		It("Should not complete a sign-in twice", func(ctx SpecContext) {
+			a := MustSucceed(svc.Authorize(ctx))
+			code := iss.authorize(a, uuid.NewString(), nil)
+			MustSucceed(svc.Login(ctx, code, a.State))
+			Expect(svc.Login(ctx, code, a.State)).Error().To(MatchError(oidc.ErrInvalidState))
+		})
+
+		It("Should return an error for an unknown state", func(ctx SpecContext) {
+			Expect(svc.Login(ctx, "code", "state")).Error().To(MatchError(oidc.ErrInvalidState))
+		})
+
+		It("Should return an error when the code is rejected", func(ctx SpecContext) {
+			a := MustSucceed(svc.Authorize(ctx))
+			Expect(svc.Login(ctx, "bogus", a.State)).Error().
+				To(MatchError(auth.ErrInvalidCredentials))
+		})
+
+		It("Should reject an ID token for another audience", func(ctx SpecContext) {
+			Expect(login(ctx, uuid.NewString(), jwt.MapClaims{"aud": "other"})).
+				Error().To(MatchError(auth.ErrInvalidToken))
+		})
+
+		It("Should reject an ID token with a mismatched nonce", func(ctx SpecContext) {
+			Expect(login(ctx, uuid.NewString(), jwt.MapClaims{"nonce": "replayed"})).
+				Error().To(MatchError(auth.ErrInvalidToken))
+		})
+
+		It("Should reject an expired ID token", func(ctx SpecContext) {
+			Expect(login(ctx, uuid.NewString(), jwt.MapClaims{
+				"exp": time.Now().Add(-time.Minute).Unix(),
+			})).Error().To(MatchError(auth.ErrExpiredToken))
+		})
+
+		Context("Expired State", func() {
+			var now time.Time
+			BeforeEach(func() {
+				now = time.Now()
+				cfg.Now = func() time.Time { return now }
+			})
+			It("Should reject a sign-in completed after the state expires", func(ctx SpecContext) {
+				a := MustSucceed(svc.Authorize(ctx))
+				code := iss.authorize(a, uuid.NewString(), nil)
+				now = now.Add(time.Hour)
+				Expect(svc.Login(ctx, code, a.State)).Error().
+					To(MatchError(oidc.ErrInvalidState))
+			})
+		})
+	})
+
+	Describe("Role Mapping", func() {
+		var operator, viewer role.Role
+		BeforeEach(func(ctx SpecContext) {
+			operator = role.Role{Name: "operator-" + uuid.NewString()}
+			viewer = role.Role{Name: "viewer-" + uuid.NewString()}
+			Expect(db.WithTx(ctx, func(tx gorp.Tx) error {
+				w := roleSvc.NewWriter(tx, false)
+				if err := w.Create(ctx, &operator); err != nil {
+					return err
+				}
+				return w.Create(ctx, &viewer)
+			})).To(Succeed())
+			cfg.RoleMappings = map[string]string{
+				"operators": operator.Name,
+				"viewers":   viewer.Name,
+				"missing":   "nonexistent-role",
+			}
+		})
+
+		It("Should assign the roles mapped from the user's groups", func(ctx SpecContext) {
+			u := MustSucceed(login(ctx, uuid.NewString(), jwt.MapClaims{
+				"groups": []string{"operators", "unmapped"},
+			}))
+			Expect(roles(ctx, u)).To(ConsistOf(operator.Name))
+		})
+
+		It("Should accept a single group as a string", func(ctx SpecContext) {
+			u := MustSucceed(login(ctx, uuid.NewString(), jwt.MapClaims{"groups": "viewers"}))
+			Expect(roles(ctx, u)).To(ConsistOf(viewer.Name))
+		})
+
+		It("Should unassign mapped roles when the user leaves a group", func(ctx SpecContext) {
+			subject := uuid.NewString()
+			u := MustSucceed(login(ctx, subject, jwt.MapClaims{
+				"groups": []string{"operators", "viewers"},
+			}))
+			Expect(roles(ctx, u)).To(ConsistOf(operator.Name, viewer.Name))
+			MustSucceed(login(ctx, subject, jwt.MapClaims{"groups": []string{"viewers"}}))
+			Expect(roles(ctx, u)).To(ConsistOf(viewer.Name))
+		})
+
+		It("Should leave roles assigned outside the mapping untouched", func(ctx SpecContext) {
+			subject := uuid.NewString()
+			u := MustSucceed(login(ctx, subject, nil))
+			manual := role.Role{Name: "manual-" + uuid.NewString()}
+			Expect(db.WithTx(ctx, func(tx gorp.Tx) error {
+				w := roleSvc.NewWriter(tx, false)
+				if err := w.Create(ctx, &manual); err != nil {
+					return err
+				}
+				return w.AssignRole(ctx, user.OntologyID(u.Key), manual.Key)
+			})).To(Succeed())
+			MustSucceed(login(ctx, subject, jwt.MapClaims{"groups": []string{"operators"}}))
+			Expect(roles(ctx, u)).To(ConsistOf(manual.Name, operator.Name))
+		})
+
+		Context("Custom Groups Claim", func() {
+			BeforeEach(func() { cfg.GroupsClaim = "roles" })
+			It("Should read groups from the configured claim", func(ctx SpecContext) {
+				u := MustSucceed(login(ctx, uuid.NewString(), jwt.MapClaims{
+					"roles":  []string{"operators"},
+					"groups": []string{"viewers"},
+				}))
+				Expect(roles(ctx, u)).To(ConsistOf(operator.Name))
+			})
+		})
+	})
+})
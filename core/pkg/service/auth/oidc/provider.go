@@ -0,0 +1,283 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/x/errors"
+)
+
+// metadata is the subset of the provider's discovery document used by the Core.
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// jwk is a single key of a JSON web key set.
+type jwk struct {
+	KID string `json:"kid"`
+	KTY string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	CRV string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// signingMethods are the algorithms accepted for ID token signatures. Symmetric
+// algorithms are rejected, as their keys can't be published.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// provider discovers and caches the configuration and signing keys of the identity
+// provider.
+type provider struct {
+	cfg ServiceConfig
+	mu  struct {
+		sync.Mutex
+		meta *metadata
+		keys map[string]crypto.PublicKey
+	}
+}
+
+// metadata returns the provider's discovery document, fetching it on first use.
+func (p *provider) metadata(ctx context.Context) (metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.mu.meta != nil {
+		return *p.mu.meta, nil
+	}
+	var m metadata
+	wellKnown := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJSON(ctx, wellKnown, &m); err != nil {
+		return metadata{}, errors.Wrap(err, "failed to discover identity provider")
+	}
+	if strings.TrimSuffix(m.Issuer, "/") != strings.TrimSuffix(p.cfg.Issuer, "/") {
+		return metadata{}, errors.Newf(
+			"identity provider reported issuer %s, expected %s",
+			m.Issuer,
+			p.cfg.Issuer,
+		)
+	}
+	if m.AuthorizationEndpoint == "" || m.TokenEndpoint == "" || m.JWKSURI == "" {
+		return metadata{}, errors.New("identity provider discovery document is incomplete")
+	}
+	p.mu.meta = &m
+	return m, nil
+}
+
+// key returns the public key with the given ID. The key set is refetched when the ID
+// is unknown, so keys the provider rotates in are picked up without a restart.
+func (p *provider) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if k, ok := p.mu.keys[kid]; ok {
+		return k, nil
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = p.getJSON(ctx, meta.JWKSURI, &set); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch identity provider keys")
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		// Keys of unsupported types are skipped rather than failing the whole set.
+		if pub, err := k.publicKey(); err == nil {
+			keys[k.KID] = pub
+		}
+	}
+	p.mu.keys = keys
+	if k, ok := keys[kid]; ok {
+		return k, nil
+	}
+	return nil, errors.Wrapf(auth.ErrInvalidToken, "unknown signing key %q", kid)
+}
+
+// exchange redeems an authorization code at the provider's token endpoint and returns
+// the raw ID token.
+func (p *provider) exchange(ctx context.Context, code, verifier string) (string, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"client_id":     {p.cfg.ClientID},
+		"code_verifier": {verifier},
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		meta.TokenEndpoint,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+	res, err := p.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to exchange authorization code")
+	}
+	defer func() { _ = res.Body.Close() }()
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err = json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(&body); err != nil {
+		return "", errors.Wrapf(err, "failed to decode token response (status %d)", res.StatusCode)
+	}
+	if body.Error != "" {
+		return "", errors.Wrapf(
+			auth.ErrInvalidCredentials,
+			"identity provider rejected authorization code: %s %s",
+			body.Error,
+			body.ErrorDescription,
+		)
+	}
+	if res.StatusCode != http.StatusOK || body.IDToken == "" {
+		return "", errors.Newf("identity provider returned no ID token (status %d)", res.StatusCode)
+	}
+	return body.IDToken, nil
+}
+
+// verify validates the signature, issuer, audience, lifetime, and nonce of an ID
+// token, returning its claims.
+func (p *provider) verify(ctx context.Context, raw, nonce string) (jwt.MapClaims, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims := jwt.MapClaims{}
+	if _, err = jwt.ParseWithClaims(
+		raw,
+		claims,
+		func(t *jwt.Token) (any, error) {
+			kid, _ := t.Header["kid"].(string)
+			return p.key(ctx, kid)
+		},
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithTimeFunc(p.cfg.Now),
+	); err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, errors.Combine(auth.ErrExpiredToken, err)
+		}
+		return nil, errors.Combine(auth.ErrInvalidToken, err)
+	}
+	if got, _ := claims["nonce"].(string); got != nonce {
+		return nil, errors.Wrap(auth.ErrInvalidToken, "ID token nonce does not match")
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, errors.Wrap(auth.ErrInvalidToken, "ID token has no subject")
+	}
+	return claims, nil
+}
+
+// maxResponseSize bounds the size of the responses read from the provider.
+const maxResponseSize = 1 << 20
+
+func (p *provider) getJSON(ctx context.Context, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := p.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return errors.Newf("GET %s returned status %d", u, res.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(v)
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.KTY {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.CRV {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Newf("unsupported curve %q", k.CRV)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Newf("unsupported key type %q", k.KTY)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package session
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/change"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/gorp"
+	xio "github.com/synnaxlabs/x/io"
+	"github.com/synnaxlabs/x/override"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/service"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// ServiceConfig is the configuration for opening a [Service].
+type ServiceConfig struct {
+	// DB is the database used to store sessions. Revocations are propagated to other
+	// nodes through the database, so it should be replicated across the cluster.
+	//
+	// [REQUIRED]
+	DB *gorp.DB
+	// LastSeenResolution is the minimum interval between updates to the last seen
+	// time of a session, which keeps sessions used on every request from causing a
+	// write on every request.
+	//
+	// [OPTIONAL] - Defaults to 1 minute.
+	LastSeenResolution time.Duration
+	// Now returns the current time.
+	//
+	// [OPTIONAL] - Defaults to telem.Now.
+	Now func() telem.TimeStamp
+	// Instrumentation is for logging, tracing, metrics, etc.
+	//
+	// [OPTIONAL] - Defaults to noop instrumentation.
+	alamos.Instrumentation
+}
+
+var (
+	_ config.Config[ServiceConfig] = ServiceConfig{}
+	// DefaultServiceConfig is the default configuration for opening a [Service].
+	DefaultServiceConfig = ServiceConfig{
+		LastSeenResolution: time.Minute,
+		Now:                telem.Now,
+	}
+)
+
+// Override implements config.Config.
+func (c ServiceConfig) Override(other ServiceConfig) ServiceConfig {
+	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
+	c.DB = override.Nil(c.DB, other.DB)
+	c.LastSeenResolution = override.Numeric(c.LastSeenResolution, other.LastSeenResolution)
+	c.Now = override.Nil(c.Now, other.Now)
+	return c
+}
+
+// Validate implements config.Config.
+func (c ServiceConfig) Validate() error {
+	v := validate.New("session")
+	validate.NotNil(v, "db", c.DB)
+	validate.NotNil(v, "now", c.Now)
+	return v.Error()
+}
+
+// Service opens, validates, and revokes sessions.
+type Service struct {
+	cfg      ServiceConfig
+	closer   xio.MultiCloser
+	table    *gorp.Table[uuid.UUID, Session]
+	watchers struct {
+		sync.Mutex
+		counter int
+		cancels map[uuid.UUID]map[int]context.CancelCauseFunc
+	}
+}
+
+// OpenService opens a new [Service] using the provided configurations. The returned
+// Service must be closed after use.
+func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err error) {
+	cfg, err := config.New(DefaultServiceConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	s = &Service{cfg: cfg}
+	s.watchers.cancels = make(map[uuid.UUID]map[int]context.CancelCauseFunc)
+	cleanup, ok := service.NewOpener(ctx, &s.closer)
+	defer func() { err = cleanup(err) }()
+	if s.table, err = gorp.OpenTable(ctx, gorp.TableConfig[uuid.UUID, Session]{
+		DB:              cfg.DB,
+		Instrumentation: cfg.Instrumentation,
+	}); !ok(err, s.table) {
+		return nil, err
+	}
+	disconnect := s.table.Observe().OnChange(s.handleChanges)
+	s.closer = append(s.closer, xio.CloserFunc(func() error {
+		disconnect()
+		return nil
+	}))
+	return s, nil
+}
+
+// Close closes the service and releases any resources.
+func (s *Service) Close() error { return s.closer.Close() }
+
+// NewWriter opens a new [Writer] using the provided transaction.
+func (s *Service) NewWriter(tx gorp.Tx) Writer {
+	return Writer{svc: s, tx: gorp.OverrideTx(s.cfg.DB, tx)}
+}
+
+// Retrieve returns the active sessions of the given users, or of all users if no
+// users are given.
+func (s *Service) Retrieve(
+	ctx context.Context,
+	tx gorp.Tx,
+	users ...user.Key,
+) ([]Session, error) {
+	now := s.cfg.Now()
+	var sessions []Session
+	err := s.table.NewRetrieve().
+		Where(gorp.Match(func(_ gorp.Context, ses *Session) (bool, error) {
+			return ses.Active(now) && (len(users) == 0 || lo.Contains(users, ses.User)), nil
+		})).
+		Entries(&sessions).
+		Exec(ctx, gorp.OverrideTx(s.cfg.DB, tx))
+	return sessions, err
+}
+
+// RetrieveByKey returns the sessions with the given keys, including those that have
+// been revoked or have expired. Returns [query.ErrNotFound] along with any sessions
+// that were found if any of the keys do not exist.
+func (s *Service) RetrieveByKey(
+	ctx context.Context,
+	tx gorp.Tx,
+	keys ...uuid.UUID,
+) ([]Session, error) {
+	var sessions []Session
+	err := s.table.NewRetrieve().
+		Where(gorp.MatchKeys[uuid.UUID, Session](keys...)).
+		Entries(&sessions).
+		Exec(ctx, gorp.OverrideTx(s.cfg.DB, tx))
+	return sessions, err
+}
+
+// Validate returns the session with the given key, returning [auth.ErrInvalidToken]
+// if the session does not exist and [ErrRevoked] if it has been revoked.
+func (s *Service) Validate(ctx context.Context, key uuid.UUID) (Session, error) {
+	var ses Session
+	if err := s.table.NewRetrieve().
+		Where(gorp.MatchKeys[uuid.UUID, Session](key)).
+		Entry(&ses).
+		Exec(ctx, s.cfg.DB); err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return Session{}, errors.Wrap(auth.ErrInvalidToken, "unknown session")
+		}
+		return Session{}, err
+	}
+	if ses.Revoked() {
+		return Session{}, ErrRevoked
+	}
+	now := s.cfg.Now()
+	if telem.TimeSpan(now-ses.LastSeenAt) >= telem.TimeSpan(s.cfg.LastSeenResolution) {
+		// Failing to record the last seen time should not fail the request.
+		if err := s.touch(ctx, key, now); err != nil {
+			s.cfg.L.Warn("failed to record session use", zap.Stringer("session", key), zap.Error(err))
+		}
+		ses.LastSeenAt = now
+	}
+	return ses, nil
+}
+
+// Watch returns a copy of ctx that is canceled with [ErrRevoked] as its cause when the
+// session with the given key is revoked on any node in the cluster. The returned
+// cancel function must be called to release resources once the context is no longer
+// needed.
+func (s *Service) Watch(ctx context.Context, key uuid.UUID) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	s.watchers.Lock()
+	id := s.watchers.counter
+	s.watchers.counter++
+	if s.watchers.cancels[key] == nil {
+		s.watchers.cancels[key] = make(map[int]context.CancelCauseFunc)
+	}
+	s.watchers.cancels[key][id] = cancel
+	s.watchers.Unlock()
+	return ctx, func() {
+		s.watchers.Lock()
+		delete(s.watchers.cancels[key], id)
+		if len(s.watchers.cancels[key]) == 0 {
+			delete(s.watchers.cancels, key)
+		}
+		s.watchers.Unlock()
+		cancel(context.Canceled)
+	}
+}
+
+func (s *Service) handleChanges(
+	_ context.Context,
+	changes iter.Seq[change.Change[uuid.UUID, Session]],
+) {
+	for ch := range changes {
+		if ch.Variant == change.VariantSet && !ch.Value.Revoked() {
+			continue
+		}
+		s.watchers.Lock()
+		for _, cancel := range s.watchers.cancels[ch.Key] {
+			cancel(ErrRevoked)
+		}
+		s.watchers.Unlock()
+	}
+}
+
+func (s *Service) touch(ctx context.Context, key uuid.UUID, now telem.TimeStamp) error {
+	return s.table.NewUpdate().
+		Where(gorp.MatchKeys[uuid.UUID, Session](key)).
+		Change(func(_ gorp.Context, ses Session) Session {
+			ses.LastSeenAt = now
+			return ses
+		}).
+		Exec(ctx, s.cfg.DB)
+}
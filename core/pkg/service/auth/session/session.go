@@ -0,0 +1,73 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package session implements server-side sessions for the tokens users log in with.
+// Every token embeds the key of the session it was issued for, and a token is only
+// accepted while its session has not been revoked. Revoked sessions are kept until
+// they expire, forming a revocation list that is replicated to every node in the
+// cluster through the key-value store.
+package session
+
+import (
+	"github.com/google/uuid"
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// ErrRevoked is returned when a token is used with a session that has been revoked.
+var ErrRevoked = errors.Wrap(auth.ErrInvalidToken, "session revoked")
+
+// Client describes the client a session was opened from.
+type Client struct {
+	// UserAgent is the user agent the client logged in with.
+	UserAgent string `json:"user_agent" msgpack:"user_agent"`
+	// Address is the address the client logged in from, if known.
+	Address string `json:"address" msgpack:"address"`
+	// Protocol is the protocol the client logged in over.
+	Protocol string `json:"protocol" msgpack:"protocol"`
+}
+
+// Session is a login of a user.
+type Session struct {
+	// Key is the unique identifier of the session, embedded in its tokens.
+	Key uuid.UUID `json:"key" msgpack:"key"`
+	// User is the key of the user the session belongs to.
+	User user.Key `json:"user" msgpack:"user"`
+	// Client describes the client the session was opened from.
+	Client Client `json:"client" msgpack:"client"`
+	// CreatedAt is the time the session was opened.
+	CreatedAt telem.TimeStamp `json:"created_at" msgpack:"created_at"`
+	// ExpiresAt is the time the most recent token issued for the session expires.
+	ExpiresAt telem.TimeStamp `json:"expires_at" msgpack:"expires_at"`
+	// LastSeenAt is the approximate time the session was last used to authenticate a
+	// request.
+	LastSeenAt telem.TimeStamp `json:"last_seen_at" msgpack:"last_seen_at"`
+	// RevokedAt is the time the session was revoked. If zero, the session has not been
+	// revoked.
+	RevokedAt telem.TimeStamp `json:"revoked_at" msgpack:"revoked_at"`
+}
+
+// GorpKey implements gorp.Entry.
+func (s Session) GorpKey() uuid.UUID { return s.Key }
+
+// SetOptions implements gorp.Entry.
+func (Session) SetOptions() []any { return nil }
+
+// Revoked returns true if the session has been revoked.
+func (s Session) Revoked() bool { return !s.RevokedAt.IsZero() }
+
+// Expired returns true if the session expires at or before now.
+func (s Session) Expired(now telem.TimeStamp) bool { return now >= s.ExpiresAt }
+
+// Active returns true if the session has neither been revoked nor expired.
+func (s Session) Active(now telem.TimeStamp) bool {
+	return !s.Revoked() && !s.Expired(now)
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package session_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/session"
+	"github.com/synnaxlabs/x/query"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Session", func() {
+	var (
+		svc  *session.Service
+		now  telem.TimeStamp
+		user uuid.UUID
+	)
+	BeforeEach(func(ctx SpecContext) {
+		now = telem.Now()
+		user = uuid.New()
+		svc = MustOpen(session.OpenService(ctx, session.ServiceConfig{
+			DB:  db,
+			Now: func() telem.TimeStamp { return now },
+		}))
+	})
+	create := func(ctx SpecContext, user uuid.UUID) session.Session {
+		ses := session.Session{
+			User:      user,
+			ExpiresAt: now.Add(telem.Hour),
+			Client:    session.Client{UserAgent: "test", Protocol: "http"},
+		}
+		Expect(svc.NewWriter(nil).Create(ctx, &ses)).To(Succeed())
+		return ses
+	}
+
+	Describe("Create", func() {
+		It("Should open a session for the user", func(ctx SpecContext) {
+			ses := create(ctx, user)
+			Expect(ses.Key).ToNot(Equal(uuid.Nil))
+			Expect(ses.CreatedAt).To(Equal(now))
+			Expect(ses.Active(now)).To(BeTrue())
+			Expect(MustSucceed(svc.Validate(ctx, ses.Key)).Client.UserAgent).
+				To(Equal("test"))
+		})
+
+		It("Should remove expired sessions of the user", func(ctx SpecContext) {
+			expired := create(ctx, user)
+			now = now.Add(2 * telem.Hour)
+			create(ctx, user)
+			_, err := svc.RetrieveByKey(ctx, nil, expired.Key)
+			Expect(err).To(MatchError(query.ErrNotFound))
+		})
+	})
+
+	Describe("Retrieve", func() {
+		It("Should only return the active sessions of the given users", func(ctx SpecContext) {
+			a := create(ctx, user)
+			b := create(ctx, user)
+			other := create(ctx, uuid.New())
+			Expect(svc.NewWriter(nil).Revoke(ctx, b.Key)).To(Succeed())
+			sessions := MustSucceed(svc.Retrieve(ctx, nil, user))
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].Key).To(Equal(a.Key))
+			Expect(MustSucceed(svc.Retrieve(ctx, nil))).To(ContainElement(
+				HaveField("Key", other.Key),
+			))
+		})
+
+		It("Should not return expired sessions", func(ctx SpecContext) {
+			create(ctx, user)
+			now = now.Add(2 * telem.Hour)
+			Expect(svc.Retrieve(ctx, nil, user)).To(BeEmpty())
+		})
+	})
+
+	Describe("Validate", func() {
+		It("Should reject an unknown session", func(ctx SpecContext) {
+			Expect(svc.Validate(ctx, uuid.New())).Error().
+				To(MatchError(auth.ErrInvalidToken))
+		})
+
+		It("Should reject a revoked session", func(ctx SpecContext) {
+			ses := create(ctx, user)
+			Expect(svc.NewWriter(nil).Revoke(ctx, ses.Key)).To(Succeed())
+			Expect(svc.Validate(ctx, ses.Key)).Error().To(MatchError(session.ErrRevoked))
+			Expect(svc.Validate(ctx, ses.Key)).Error().
+				To(MatchError(auth.ErrInvalidToken))
+		})
+
+		It("Should record the last time the session was seen", func(ctx SpecContext) {
+			ses := create(ctx, user)
+			lastSeen := func() telem.TimeStamp {
+				return MustSucceed(svc.RetrieveByKey(ctx, nil, ses.Key))[0].LastSeenAt
+			}
+			created := now
+			now = now.Add(telem.Second)
+			MustSucceed(svc.Validate(ctx, ses.Key))
+			Expect(lastSeen()).To(Equal(created))
+			now = now.Add(telem.TimeSpan(time.Minute))
+			MustSucceed(svc.Validate(ctx, ses.Key))
+			Expect(lastSeen()).To(Equal(now))
+		})
+	})
+
+	Describe("Extend", func() {
+		It("Should update the expiration of the session", func(ctx SpecContext) {
+			ses := create(ctx, user)
+			Expect(svc.NewWriter(nil).Extend(ctx, ses.Key, now.Add(3*telem.Hour))).
+				To(Succeed())
+			now = now.Add(2 * telem.Hour)
+			Expect(svc.Retrieve(ctx, nil, user)).To(HaveLen(1))
+		})
+	})
+
+	Describe("RevokeUsers", func() {
+		It("Should revoke all sessions of the given users", func(ctx SpecContext) {
+			a := create(ctx, user)
+			b := create(ctx, user)
+			other := create(ctx, uuid.New())
+			Expect(svc.NewWriter(nil).RevokeUsers(ctx, user)).To(Succeed())
+			Expect(svc.Validate(ctx, a.Key)).Error().To(MatchError(session.ErrRevoked))
+			Expect(svc.Validate(ctx, b.Key)).Error().To(MatchError(session.ErrRevoked))
+			MustSucceed(svc.Validate(ctx, other.Key))
+		})
+	})
+
+	Describe("Watch", func() {
+		It("Should cancel the context when the session is revoked", func(ctx SpecContext) {
+			ses := create(ctx, user)
+			other := create(ctx, user)
+			wCtx, cancel := svc.Watch(ctx, ses.Key)
+			defer cancel()
+			otherCtx, otherCancel := svc.Watch(ctx, other.Key)
+			defer otherCancel()
+			Expect(wCtx.Err()).ToNot(HaveOccurred())
+			Expect(svc.NewWriter(nil).Revoke(ctx, ses.Key)).To(Succeed())
+			Eventually(wCtx.Done()).Should(BeClosed())
+			Expect(context.Cause(wCtx)).To(MatchError(session.ErrRevoked))
+			Consistently(otherCtx.Done()).ShouldNot(BeClosed())
+		})
+
+		It("Should cancel the context when its cancel function is called", func(ctx SpecContext) {
+			ses := create(ctx, user)
+			wCtx, cancel := svc.Watch(ctx, ses.Key)
+			cancel()
+			Expect(wCtx.Done()).To(BeClosed())
+			Expect(context.Cause(wCtx)).To(MatchError(context.Canceled))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package session
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/synnaxlabs/synnax/pkg/service/user"
+	"github.com/synnaxlabs/x/gorp"
+	"github.com/synnaxlabs/x/telem"
+)
+
+// A Writer is used to open and revoke sessions. No identity checks are performed;
+// callers must already have authorized the operation.
+type Writer struct {
+	svc *Service
+	tx  gorp.Tx
+}
+
+// Create opens ses, assigning it a new key if ses.Key is uuid.Nil. ses.User and
+// ses.ExpiresAt must be set by the caller. Sessions of the same user that have expired
+// are removed.
+func (w Writer) Create(ctx context.Context, ses *Session) error {
+	now := w.svc.cfg.Now()
+	if err := w.svc.table.NewDelete().
+		Where(gorp.Match(func(_ gorp.Context, e *Session) (bool, error) {
+			return e.User == ses.User && e.Expired(now), nil
+		})).
+		Exec(ctx, w.tx); err != nil {
+		return err
+	}
+	if ses.Key == uuid.Nil {
+		ses.Key = uuid.New()
+	}
+	ses.CreatedAt = now
+	ses.LastSeenAt = now
+	ses.RevokedAt = 0
+	return w.svc.table.NewCreate().Entry(ses).Exec(ctx, w.tx)
+}
+
+// Extend sets the expiration of the session with the given key, which should be
+// called whenever a new token is issued for the session.
+func (w Writer) Extend(ctx context.Context, key uuid.UUID, expiresAt telem.TimeStamp) error {
+	return w.svc.table.NewUpdate().
+		Where(gorp.MatchKeys[uuid.UUID, Session](key)).
+		Change(func(_ gorp.Context, ses Session) Session {
+			ses.ExpiresAt = expiresAt
+			return ses
+		}).
+		Exec(ctx, w.tx)
+}
+
+// Revoke revokes the sessions with the given keys. Tokens issued for the sessions are
+// rejected, and any streams opened with them closed, as soon as the revocation is
+// committed.
+func (w Writer) Revoke(ctx context.Context, keys ...uuid.UUID) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return w.revoke(ctx, gorp.MatchKeys[uuid.UUID, Session](keys...))
+}
+
+// RevokeUsers revokes all sessions of the given users.
+func (w Writer) RevokeUsers(ctx context.Context, users ...user.Key) error {
+	if len(users) == 0 {
+		return nil
+	}
+	return w.revoke(ctx, gorp.Match(func(_ gorp.Context, ses *Session) (bool, error) {
+		return !ses.Revoked() && lo.Contains(users, ses.User), nil
+	}))
+}
+
+func (w Writer) revoke(ctx context.Context, filter gorp.Filter[uuid.UUID, Session]) error {
+	now := w.svc.cfg.Now()
+	return w.svc.table.NewUpdate().
+		Where(filter).
+		Change(func(_ gorp.Context, ses Session) Session {
+			if !ses.Revoked() {
+				ses.RevokedAt = now
+			}
+			return ses
+		}).
+		Exec(ctx, w.tx)
+}
@@ -100,63 +100,100 @@ func NewService(cfgs ...ServiceConfig) (*Service, error) {
 	return &Service{cfg: cfg}, nil
 }
 
+// Claims are the claims carried by a token.
+type Claims struct {
+	// User is the key of the user the token was issued to.
+	User user.Key
+	// Session is the key of the session the token was issued for, or uuid.Nil if the
+	// token was issued without a session.
+	Session uuid.UUID
+	// ExpiresAt is the time the token expires.
+	ExpiresAt time.Time
+}
+
 // New issues a new token for the given issuer. Returns the token as a string, and
 // any errors encountered during signing.
 func (s *Service) New(issuer user.Key) (string, error) {
+	tk, _, err := s.NewSession(issuer, uuid.Nil)
+	return tk, err
+}
+
+// NewSession issues a new token for the given issuer that embeds the key of the given
+// session. Returns the token as a string along with its claims, and any errors
+// encountered during signing.
+func (s *Service) NewSession(issuer user.Key, session uuid.UUID) (string, Claims, error) {
 	method, key := s.signingMethodAndKey()
 	now := s.cfg.Now().UTC()
-	claims := jwt.NewWithClaims(method, jwt.RegisteredClaims{
+	c := Claims{User: issuer, Session: session, ExpiresAt: now.Add(s.cfg.Expiration)}
+	registered := jwt.RegisteredClaims{
 		IssuedAt:  jwt.NewNumericDate(now),
 		Issuer:    issuer.String(),
-		ExpiresAt: jwt.NewNumericDate(now.Add(s.cfg.Expiration)),
-	})
-	v, err := claims.SignedString(key)
+		ExpiresAt: jwt.NewNumericDate(c.ExpiresAt),
+	}
+	if session != uuid.Nil {
+		registered.ID = session.String()
+	}
+	v, err := jwt.NewWithClaims(method, registered).SignedString(key)
 	if err != nil {
-		return v, auth.ErrInvalidToken
+		return v, c, auth.ErrInvalidToken
 	}
-	return v, nil
+	return v, c, nil
 }
 
 // Validate validates the given token. Returns the UUID of the issuer along with any
 // errors encountered.
 func (s *Service) Validate(token string) (user.Key, error) {
-	id, _, err := s.validate(token)
-	return id, err
+	c, _, err := s.validate(token)
+	return c.User, err
 }
 
 // ValidateMaybeRefresh validates the given token. If the token is close to expiration
 // (as defined by the RefreshThreshold), a new token will be issued and returned as well.
 func (s *Service) ValidateMaybeRefresh(token string) (user.Key, string, error) {
-	id, claims, err := s.validate(token)
+	c, tk, err := s.ValidateSessionMaybeRefresh(token)
+	return c.User, tk, err
+}
+
+// ValidateSessionMaybeRefresh validates the given token, returning its claims. If the
+// token is close to expiration (as defined by the RefreshThreshold), a new token for
+// the same session will be issued and returned as well, in which case the returned
+// claims are those of the new token.
+func (s *Service) ValidateSessionMaybeRefresh(token string) (Claims, string, error) {
+	c, registered, err := s.validate(token)
 	if err != nil {
-		return id, "", err
+		return c, "", err
 	}
-	if s.isCloseToExpired(claims) {
-		tk, err := s.New(id)
-		return id, tk, err
+	if s.isCloseToExpired(registered) {
+		tk, refreshed, err := s.NewSession(c.User, c.Session)
+		return refreshed, tk, err
 	}
-	return id, "", nil
+	return c, "", nil
 }
 
-func (s *Service) validate(token string) (user.Key, *jwt.RegisteredClaims, error) {
+func (s *Service) validate(token string) (Claims, *jwt.RegisteredClaims, error) {
 	claims := &jwt.RegisteredClaims{}
 	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
 		return s.publicKey(), nil
 	})
 	if err != nil {
 		if isVerificationError(err) {
-			return uuid.Nil, claims, auth.ErrInvalidToken
+			return Claims{}, claims, auth.ErrInvalidToken
 		}
 		if isExpiredError(err) {
-			return uuid.Nil, claims, auth.ErrExpiredToken
+			return Claims{}, claims, auth.ErrExpiredToken
 		}
-		return uuid.Nil, claims, errors.Wrap(auth.ErrAuth, err.Error())
+		return Claims{}, claims, errors.Wrap(auth.ErrAuth, err.Error())
 	}
-	id, err := uuid.Parse(claims.Issuer)
-	if err != nil {
-		return uuid.Nil, claims, errors.Wrap(auth.ErrAuth, err.Error())
+	c := Claims{ExpiresAt: claims.ExpiresAt.Time}
+	if c.User, err = uuid.Parse(claims.Issuer); err != nil {
+		return Claims{}, claims, errors.Wrap(auth.ErrAuth, err.Error())
+	}
+	if claims.ID != "" {
+		if c.Session, err = uuid.Parse(claims.ID); err != nil {
+			return Claims{}, claims, errors.Wrap(auth.ErrAuth, err.Error())
+		}
 	}
-	return id, claims, nil
+	return c, claims, nil
 }
 
 func (s *Service) isCloseToExpired(claims *jwt.RegisteredClaims) bool {
@@ -84,6 +84,32 @@ var _ = Describe("token", func() {
 			Expect(id).To(Equal(issuer))
 			Expect(newToken).ToNot(BeEmpty())
 		})
+		It("Should keep the session of the token when refreshing it", func() {
+			now = time.Now()
+			issuer, session := uuid.New(), uuid.New()
+			tk, claims := MustSucceed2(svc.NewSession(issuer, session))
+			Expect(claims.ExpiresAt).To(BeTemporally("~", now.Add(cfg.Expiration), time.Second))
+
+			c, newToken := MustSucceed2(svc.ValidateSessionMaybeRefresh(tk))
+			Expect(c.User).To(Equal(issuer))
+			Expect(c.Session).To(Equal(session))
+			Expect(newToken).To(BeEmpty())
+
+			now = now.Add(time.Second * 6)
+
+			c, newToken = MustSucceed2(svc.ValidateSessionMaybeRefresh(tk))
+			Expect(newToken).ToNot(BeEmpty())
+			Expect(c.ExpiresAt).To(BeTemporally("~", now.Add(cfg.Expiration), time.Second))
+			c, _ = MustSucceed2(svc.ValidateSessionMaybeRefresh(newToken))
+			Expect(c.Session).To(Equal(session))
+		})
+
+		It("Should not embed a session in tokens issued without one", func() {
+			now = time.Now()
+			tk := MustSucceed(svc.New(uuid.New()))
+			c, _ := MustSucceed2(svc.ValidateSessionMaybeRefresh(tk))
+			Expect(c.Session).To(Equal(uuid.Nil))
+		})
 	})
 	Describe("Token Expiration Outside Refresh Interval", func() {
 		// Unfortunately there's not much we can do get rid of the long sleep here,
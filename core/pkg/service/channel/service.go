@@ -40,6 +40,7 @@ var (
 	ParseKey                                    = distchannel.ParseKey
 	OntologyID                                  = distchannel.OntologyID
 	MatchKeys                                   = distchannel.MatchKeys
+	MatchNames                                  = distchannel.MatchNames
 )
 
 // ServiceConfig configures a channel Service.
@@ -220,7 +220,7 @@ func (d *Driver) handleTaskChange(
 			if ch.Variant == change.VariantSet {
 				d.configure(ctx, ch.Value)
 			} else {
-				d.delete(ch.Key)
+				d.delete(ctx, ch.Key)
 			}
 		}
 	}
@@ -298,22 +298,37 @@ func (d *Driver) configure(ctx context.Context, t task.Task) {
 	}
 }
 
-func (d *Driver) delete(key task.Key) {
+func (d *Driver) delete(ctx context.Context, key task.Key) {
 	d.mu.Lock()
 	t, ok := d.mu.tasks[key]
 	delete(d.mu.tasks, key)
 	d.mu.Unlock()
-	if !ok {
-		return
+	if ok {
+		if err := t.Stop(); err != nil {
+			d.cfg.L.Error(
+				"failed to stop task during deletion",
+				zap.Stringer("task", key),
+				zap.Error(err),
+			)
+		}
 	}
-	if err := t.Stop(); err != nil {
-		d.cfg.L.Error(
-			"failed to stop task during deletion",
-			zap.Stringer("task", key),
-			zap.Error(err),
-		)
+	for _, f := range d.cfg.Factories {
+		deleter, isDeleter := f.(Deleter)
+		if !isDeleter {
+			continue
+		}
+		if err := deleter.DeleteTask(ctx, key); err != nil {
+			d.cfg.L.Error(
+				"failed to clean up deleted task",
+				zap.String("factory", f.Name()),
+				zap.Stringer("task", key),
+				zap.Error(err),
+			)
+		}
+	}
+	if ok {
+		d.cfg.L.Info("deleted task", zap.Stringer("task", key))
 	}
-	d.cfg.L.Info("deleted task", zap.Stringer("task", key))
 }
 
 func (d *Driver) Close() error {
@@ -88,6 +88,7 @@ var _ = BeforeSuite(func(ctx SpecContext) {
 // mockFactory is a test implementation of driver.Factory.
 type mockFactory struct {
 	configureFunc func(context.Context, task.Task) (driver.Task, error)
+	deleteFunc    func(context.Context, task.Key) error
 	name          string
 }
 
@@ -101,6 +102,13 @@ func (f *mockFactory) ConfigureTask(
 	return nil, driver.ErrTaskNotHandled
 }
 
+func (f *mockFactory) DeleteTask(ctx context.Context, key task.Key) error {
+	if f.deleteFunc != nil {
+		return f.deleteFunc(ctx, key)
+	}
+	return nil
+}
+
 func (f *mockFactory) Name() string { return f.name }
 
 // mockTask is a test implementation of driver.Task.
@@ -277,6 +277,44 @@ var _ = Describe("Driver", func() {
 			Eventually(func() bool { return stopped.Load() }).Should(BeTrue())
 		})
 
+		It("should let factories clean up after a task is deleted", func(ctx SpecContext) {
+			var (
+				stopped             atomic.Bool
+				stoppedBeforeDelete atomic.Bool
+				deleted             = make(chan task.Key, 1)
+				initialReady        = make(chan struct{})
+				readyOnce           sync.Once
+			)
+			factory := &mockFactory{
+				name: "test",
+				configureFunc: func(
+					_ context.Context,
+					t task.Task,
+				) (driver.Task, error) {
+					readyOnce.Do(func() { close(initialReady) })
+					return &mockTask{
+						key:      t.Key,
+						stopFunc: func() error { stopped.Store(true); return nil },
+					}, nil
+				},
+				deleteFunc: func(_ context.Context, key task.Key) error {
+					stoppedBeforeDelete.Store(stopped.Load())
+					deleted <- key
+					return nil
+				},
+			}
+			openDriver(ctx, factory)
+
+			t := newTask(embeddedRackKey(ctx))
+			w := taskService.NewWriter(nil)
+			Expect(w.Create(ctx, &t)).To(Succeed())
+			Eventually(initialReady).Should(BeClosed())
+
+			Expect(w.Delete(ctx, t.Key, false)).To(Succeed())
+			Eventually(deleted).Should(Receive(Equal(t.Key)))
+			Expect(stoppedBeforeDelete.Load()).To(BeTrue())
+		})
+
 		It("should handle stop error gracefully during deletion", func(ctx SpecContext) {
 			var (
 				stopCalled  atomic.Bool
@@ -26,5 +26,14 @@ type Factory interface {
 	Name() string
 }
 
+// Deleter is an optional interface that a Factory can implement to clean up the
+// state it keeps for a task once the task has been deleted.
+type Deleter interface {
+	// DeleteTask is called after the task with the given key is deleted and, if it
+	// was configured, stopped. DeleteTask is called on every Deleter regardless of
+	// which factory configured the task, so it must ignore keys it does not know.
+	DeleteTask(context.Context, task.Key) error
+}
+
 // ErrTaskNotHandled is returned when a task is not handled by a factory.
 var ErrTaskNotHandled = errors.New("task not handled by factory")
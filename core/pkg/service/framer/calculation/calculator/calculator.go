@@ -17,6 +17,7 @@ import (
 	"github.com/synnaxlabs/arc/runtime/scheduler"
 	stlchannels "github.com/synnaxlabs/arc/stl/channels"
 	"github.com/synnaxlabs/arc/stl/constant"
+	"github.com/synnaxlabs/arc/stl/dsp"
 	stlerrors "github.com/synnaxlabs/arc/stl/errors"
 	stlmath "github.com/synnaxlabs/arc/stl/math"
 	stlop "github.com/synnaxlabs/arc/stl/op"
@@ -109,6 +110,11 @@ func Open(
 		return nil, err
 	}
 
+	dspMod, err := dsp.NewHost(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	f := node.CompoundFactory{
 		channelMod,
 		selector.NewHost(),
@@ -116,6 +122,7 @@ func Open(
 		stlop.NewHost(),
 		stable.NewHost(),
 		mathMod,
+		dspMod,
 	}
 
 	var closers io.MultiCloser
@@ -129,6 +136,7 @@ func Open(
 		var statefulMod *stateful.Host
 		var stringsMod *stlstrings.Host
 		var errorsMod *stlerrors.Host
+		var wasmDSPMod *dsp.Host
 		wasmRT := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfigCompiler())
 		closers = append(closers, io.CloserFunc(func() error {
 			return wasmRT.Close(ctx)
@@ -148,6 +156,9 @@ func Open(
 		if errorsMod, err = stlerrors.NewHost(ctx, wasmRT, nil); err != nil {
 			return nil, err
 		}
+		if wasmDSPMod, err = dsp.NewHost(ctx, wasmRT); err != nil {
+			return nil, err
+		}
 
 		guest, guestErr := wasmRT.Instantiate(ctx, cfg.Module.WASM)
 		if guestErr != nil {
@@ -167,7 +178,7 @@ func Open(
 			Module:        guest,
 			Memory:        guest.Memory(),
 			Strings:       cs.strings,
-			NodeKeySetter: statefulMod,
+			NodeKeySetter: wasm.NodeKeySetters{statefulMod, wasmDSPMod},
 		})
 	}
 
@@ -12,27 +12,77 @@ package iterator
 import (
 	"context"
 
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/iterator"
+	"github.com/synnaxlabs/synnax/pkg/service/channel"
 	"github.com/synnaxlabs/x/confluence"
+	"github.com/synnaxlabs/x/signal"
 )
 
+// downsampler downsamples the data returned by each iterator command. The data for
+// different channels arrives in separate responses, so the downsampler buffers data
+// responses until the command is acknowledged, and then sends all of the data as a
+// single downsampled response so that data channels stay aligned with their indexes.
 type downsampler struct {
-	confluence.LinearTransform[Response, Response]
-	factor int
+	confluence.AbstractLinear[Response, Response]
+	cfg Config
+	// indexes maps the key of each channel being iterated over to the key of its
+	// index.
+	indexes map[channel.Key]channel.Key
+	// buffered is the data received since the last acknowledgement.
+	buffered frame.Frame
 }
 
-func newDownsampler(cfg Config) responseSegment {
-	d := &downsampler{factor: cfg.DownsampleFactor}
-	d.Transform = d.transform
-	return d
+func (s *Service) newDownsampler(ctx context.Context, cfg Config) (responseSegment, error) {
+	var channels []channel.Channel
+	if err := s.cfg.Channel.NewRetrieve().
+		Where(channel.MatchKeys(cfg.Keys...)).
+		Entries(&channels).
+		Exec(ctx, nil); err != nil {
+		return nil, err
+	}
+	d := &downsampler{cfg: cfg, indexes: make(map[channel.Key]channel.Key, len(channels))}
+	for _, ch := range channels {
+		d.indexes[ch.Key()] = ch.Index()
+	}
+	return d, nil
+}
+
+// Flow implements confluence.Flow.
+func (d *downsampler) Flow(ctx signal.Context, opts ...confluence.Option) {
+	o := confluence.NewOptions(opts)
+	o.AttachClosables(d.Out)
+	d.GoRange(ctx, d.downsample, o.Signal...)
+}
+
+func (d *downsampler) downsample(ctx context.Context, res Response) error {
+	if res.Variant == iterator.ResponseVariantData {
+		d.buffered = d.buffered.Extend(res.Frame)
+		return nil
+	}
+	if !d.buffered.Empty() {
+		data := Response{
+			Variant: iterator.ResponseVariantData,
+			Command: res.Command,
+			SeqNum:  res.SeqNum,
+			NodeKey: res.NodeKey,
+			Frame:   d.buffered.Downsample(d.cfg.DownsampleMode, d.factor(), d.indexes),
+		}
+		d.buffered = frame.Frame{}
+		if err := signal.SendUnderContext(ctx, d.Out.Inlet(), data); err != nil {
+			return err
+		}
+	}
+	return signal.SendUnderContext(ctx, d.Out.Inlet(), res)
 }
 
-func (d *downsampler) transform(
-	_ context.Context,
-	in Response,
-) (out Response, ok bool, err error) {
-	in.Frame = in.Frame.ShallowCopy()
-	for i, s := range in.Frame.SeriesI() {
-		in.Frame.SetSeriesAt(i, s.Downsample(d.factor))
+// factor returns the factor to downsample the buffered data by.
+func (d *downsampler) factor() int {
+	if d.cfg.DownsampleTargetPoints <= 0 {
+		return d.cfg.DownsampleFactor
 	}
-	return in, true, nil
+	return d.cfg.DownsampleMode.FactorForTarget(
+		d.buffered.Len(),
+		d.cfg.DownsampleTargetPoints,
+	)
 }
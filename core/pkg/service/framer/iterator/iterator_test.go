@@ -29,6 +29,7 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/service/task"
 	"github.com/synnaxlabs/x/telem"
 	. "github.com/synnaxlabs/x/testutil"
+	"github.com/synnaxlabs/x/validate"
 )
 
 var _ = Describe("StreamIterator", Ordered, func() {
@@ -1242,5 +1243,96 @@ var _ = Describe("StreamIterator", Ordered, func() {
 			Expect(iter.Next(iterator.AutoSpan)).To(BeFalse())
 			Expect(iter.Close()).To(Succeed())
 		})
+		Describe("Modes", func() {
+			var indexCh, dataCh *channel.Channel
+			BeforeEach(func(ctx SpecContext) {
+				indexCh = &channel.Channel{
+					Name:     channel.NewRandomName(),
+					DataType: telem.TimeStampT,
+					IsIndex:  true,
+				}
+				Expect(dist.Channel.Create(ctx, indexCh)).To(Succeed())
+				dataCh = &channel.Channel{
+					Name:       channel.NewRandomName(),
+					DataType:   telem.Float32T,
+					LocalIndex: indexCh.LocalKey,
+				}
+				Expect(dist.Channel.Create(ctx, dataCh)).To(Succeed())
+				keys := []channel.Key{indexCh.Key(), dataCh.Key()}
+				w := MustSucceed(dist.Framer.OpenWriter(ctx, framer.WriterConfig{
+					Start:            telem.SecondTS,
+					Keys:             keys,
+					EnableAutoCommit: new(true),
+				}))
+				Expect(w.Write(frame.NewMulti(
+					keys,
+					[]telem.Series{
+						telem.NewSeriesSecondsTSV(1, 2, 3, 4, 5, 6, 7, 8, 9),
+						telem.NewSeriesV[float32](1, 1, 52, 1, 1, 1, -50, 1, 1),
+					},
+				))).To(BeTrue())
+				Expect(w.Close()).To(Succeed())
+			})
+
+			read := func(ctx SpecContext, cfg iterator.Config) (telem.Series, telem.Series) {
+				cfg.Keys = []channel.Key{indexCh.Key(), dataCh.Key()}
+				cfg.Bounds = telem.TimeRangeMax
+				iter := MustSucceed(iteratorSvc.Open(ctx, cfg))
+				Expect(iter.SeekFirst()).To(BeTrue())
+				Expect(iter.Next(iterator.AutoSpan)).To(BeTrue())
+				idx := iter.Value().Get(indexCh.Key())
+				data := iter.Value().Get(dataCh.Key())
+				Expect(idx.Series).To(HaveLen(1))
+				Expect(data.Series).To(HaveLen(1))
+				Expect(iter.Close()).To(Succeed())
+				return idx.Series[0], data.Series[0]
+			}
+
+			It("Should preserve spikes when downsampling with min/max", func(ctx SpecContext) {
+				idx, data := read(ctx, iterator.Config{
+					DownsampleFactor: 3,
+					DownsampleMode:   telem.DownsampleModeMinMax,
+				})
+				Expect(data).To(telem.MatchSeriesDataV[float32](1, 52, 1, -50, 1))
+				Expect(idx).To(telem.MatchSeriesData(telem.NewSeriesSecondsTSV(1, 3, 4, 7, 8)))
+			})
+
+			It("Should average each bucket", func(ctx SpecContext) {
+				idx, data := read(ctx, iterator.Config{
+					DownsampleFactor: 3,
+					DownsampleMode:   telem.DownsampleModeAverage,
+				})
+				Expect(data).To(telem.MatchSeriesDataV[float32](18, 1, -16))
+				Expect(idx).To(telem.MatchSeriesData(telem.NewSeriesSecondsTSV(2, 5, 8)))
+			})
+
+			It("Should keep the samples selected by LTTB aligned with the index", func(ctx SpecContext) {
+				idx, data := read(ctx, iterator.Config{
+					DownsampleFactor: 2,
+					DownsampleMode:   telem.DownsampleModeLTTB,
+				})
+				Expect(data.Len()).To(Equal(int64(5)))
+				Expect(idx.Len()).To(Equal(data.Len()))
+				Expect(telem.UnmarshalSeries[float32](data)).To(ContainElements(float32(52), float32(-50)))
+			})
+
+			It("Should downsample to a target number of points", func(ctx SpecContext) {
+				idx, data := read(ctx, iterator.Config{
+					DownsampleTargetPoints: 3,
+					DownsampleMode:         telem.DownsampleModeDecimate,
+				})
+				Expect(data).To(telem.MatchSeriesDataV[float32](1, 1, -50))
+				Expect(idx).To(telem.MatchSeriesData(telem.NewSeriesSecondsTSV(1, 4, 7)))
+			})
+
+			It("Should return an error for an unknown mode", func(ctx SpecContext) {
+				Expect(iteratorSvc.Open(ctx, iterator.Config{
+					Keys:             []channel.Key{dataCh.Key()},
+					Bounds:           telem.TimeRangeMax,
+					DownsampleFactor: 2,
+					DownsampleMode:   "median",
+				})).Error().To(MatchError(validate.ErrValidation))
+			})
+		})
 	})
 })
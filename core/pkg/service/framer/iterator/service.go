@@ -55,6 +55,23 @@ type Config struct {
 	//
 	// [OPTIONAL]
 	DownsampleFactor int `json:"downsample_factor" msgpack:"downsample_factor"`
+	// DownsampleMode is the method used to downsample the data. Defaults to
+	// telem.DownsampleModeDecimate.
+	//
+	// [OPTIONAL]
+	DownsampleMode telem.DownsampleMode `json:"downsample_mode" msgpack:"downsample_mode"`
+	// DownsampleTargetPoints is the approximate number of samples to return for each
+	// channel on each call to Next or Prev. When set, it takes precedence over
+	// DownsampleFactor, and the factor is computed from the number of samples read by
+	// each call. If DownsampleTargetPoints is less than or equal to 0,
+	// DownsampleFactor is used.
+	//
+	// [OPTIONAL]
+	DownsampleTargetPoints int `json:"downsample_target_points" msgpack:"downsample_target_points"`
+}
+
+func (c Config) downsample() bool {
+	return c.DownsampleFactor > 1 || c.DownsampleTargetPoints > 0
 }
 
 func (c Config) distribution() framer.IteratorConfig {
@@ -118,6 +135,16 @@ func NewService(cfgs ...ServiceConfig) (*Service, error) {
 }
 
 func (s *Service) NewStream(ctx context.Context, cfg Config) (StreamIterator, error) {
+	if err := cfg.DownsampleMode.Validate(); err != nil {
+		return nil, err
+	}
+	var ds responseSegment
+	if cfg.downsample() {
+		var err error
+		if ds, err = s.newDownsampler(ctx, cfg); err != nil {
+			return nil, err
+		}
+	}
 	p := plumber.New()
 	calcTransform, err := s.newCalculationTransform(ctx, &cfg)
 	if err != nil {
@@ -139,12 +166,8 @@ func (s *Service) NewStream(ctx context.Context, cfg Config) (StreamIterator, er
 		plumber.MustConnect[Response](p, routeOutletFrom, "calculation", 25)
 		routeOutletFrom = "calculation"
 	}
-	if cfg.DownsampleFactor > 1 {
-		plumber.SetSegment(
-			p,
-			"downsampler",
-			newDownsampler(cfg),
-		)
+	if ds != nil {
+		plumber.SetSegment(p, "downsampler", ds)
 		plumber.MustConnect[Response](p, routeOutletFrom, "downsampler", 25)
 		routeOutletFrom = "downsampler"
 	}
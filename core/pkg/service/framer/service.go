@@ -44,6 +44,9 @@ type (
 	StreamWriter     = writer.StreamWriter
 	WriterConfig     = writer.Config
 	IteratorConfig   = iterator.Config
+	AggregateConfig  = framer.AggregateConfig
+	Aggregate        = framer.Aggregate
+	Bucket           = framer.Bucket
 	StreamerConfig   = streamer.Config
 	StreamerRequest  = streamer.Request
 	StreamerResponse = streamer.Response
@@ -104,6 +107,13 @@ func (s *Service) NewStreamIterator(
 	return s.Iterator.NewStream(ctx, cfg)
 }
 
+func (s *Service) Aggregate(
+	ctx context.Context,
+	cfg AggregateConfig,
+) ([]Aggregate, error) {
+	return s.cfg.Framer.Aggregate(ctx, cfg)
+}
+
 func (s *Service) NewStreamWriter(
 	ctx context.Context, cfg WriterConfig,
 ) (StreamWriter, error) {
@@ -129,6 +139,8 @@ func (s *Service) NewStreamer(
 	return s.Streamer.New(ctx, cfg)
 }
 
+func (s *Service) RelayBacklog() int { return s.cfg.Framer.RelayBacklog() }
+
 func (s *Service) Close() error { return s.closer.Close() }
 
 func OpenService(ctx context.Context, cfgs ...ServiceConfig) (s *Service, err error) {
@@ -12,27 +12,63 @@ package streamer
 import (
 	"context"
 
+	"github.com/synnaxlabs/synnax/pkg/service/channel"
 	"github.com/synnaxlabs/x/confluence"
+	"go.uber.org/zap"
 )
 
 type downsampler struct {
 	confluence.LinearTransform[Response, Response]
 	cfg Config
+	svc *Service
+	// indexes maps the key of each channel streamed so far to the key of its index, so
+	// that data channels are downsampled together with their index. Since the keys
+	// being streamed can change, indexes are resolved as new channels arrive.
+	indexes map[channel.Key]channel.Key
 }
 
-func newDownsampler(cfg Config) responseSegment {
-	d := &downsampler{cfg: cfg}
+func (s *Service) newDownsampler(cfg Config) responseSegment {
+	d := &downsampler{
+		cfg:     cfg,
+		svc:     s,
+		indexes: make(map[channel.Key]channel.Key, len(cfg.Keys)),
+	}
 	d.Transform = d.transform
 	return d
 }
 
 func (d *downsampler) transform(
-	_ context.Context,
+	ctx context.Context,
 	in Response,
 ) (out Response, ok bool, err error) {
-	in.Frame = in.Frame.ShallowCopy()
-	for i, s := range in.Frame.SeriesI() {
-		in.Frame.SetSeriesAt(i, s.Downsample(d.cfg.DownsampleFactor))
-	}
+	d.resolveIndexes(ctx, in)
+	in.Frame = in.Frame.Downsample(d.cfg.DownsampleMode, d.cfg.DownsampleFactor, d.indexes)
 	return in, true, nil
 }
+
+// resolveIndexes retrieves the indexes of any channels in the response that haven't
+// been seen before. Channels whose indexes can't be resolved are downsampled on their
+// own.
+func (d *downsampler) resolveIndexes(ctx context.Context, res Response) {
+	var unknown channel.Keys
+	for key := range res.Frame.Entries() {
+		if _, ok := d.indexes[key]; !ok {
+			d.indexes[key] = 0
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return
+	}
+	var channels []channel.Channel
+	if err := d.svc.cfg.Channel.NewRetrieve().
+		Where(channel.MatchKeys(unknown...)).
+		Entries(&channels).
+		Exec(ctx, nil); err != nil {
+		d.svc.cfg.L.Warn("failed to resolve channel indexes for downsampling", zap.Error(err))
+		return
+	}
+	for _, ch := range channels {
+		d.indexes[ch.Key()] = ch.Index()
+	}
+}
@@ -33,11 +33,12 @@ type (
 )
 
 type Config struct {
-	Keys             channel.Keys `json:"keys" msgpack:"keys"`
-	SendOpenAck      bool         `json:"send_open_ack" msgpack:"send_open_ack"`
-	DownsampleFactor int          `json:"downsample_factor" msgpack:"downsample_factor"`
-	ThrottleRate     telem.Rate   `json:"throttle_rate" msgpack:"throttle_rate"`
-	ExcludeGroups    []uint32     `json:"exclude_groups" msgpack:"exclude_groups"`
+	Keys             channel.Keys         `json:"keys" msgpack:"keys"`
+	SendOpenAck      bool                 `json:"send_open_ack" msgpack:"send_open_ack"`
+	DownsampleFactor int                  `json:"downsample_factor" msgpack:"downsample_factor"`
+	DownsampleMode   telem.DownsampleMode `json:"downsample_mode" msgpack:"downsample_mode"`
+	ThrottleRate     telem.Rate           `json:"throttle_rate" msgpack:"throttle_rate"`
+	ExcludeGroups    []uint32             `json:"exclude_groups" msgpack:"exclude_groups"`
 }
 
 var (
@@ -50,6 +51,7 @@ func (cfg Config) Validate() error {
 	v := validate.New("streamer.config")
 	validate.GreaterThanEq(v, "downsample_factor", cfg.DownsampleFactor, 0)
 	validate.GreaterThanEq(v, "throttle_rate", cfg.ThrottleRate, 0)
+	v.Exec(cfg.DownsampleMode.Validate)
 	return v.Error()
 }
 
@@ -58,6 +60,7 @@ func (cfg Config) Override(other Config) Config {
 	cfg.Keys = override.Slice(cfg.Keys, other.Keys)
 	cfg.SendOpenAck = other.SendOpenAck
 	cfg.DownsampleFactor = override.Numeric(cfg.DownsampleFactor, other.DownsampleFactor)
+	cfg.DownsampleMode = override.String(cfg.DownsampleMode, other.DownsampleMode)
 	cfg.ThrottleRate = override.Numeric(cfg.ThrottleRate, other.ThrottleRate)
 	cfg.ExcludeGroups = override.Slice(cfg.ExcludeGroups, other.ExcludeGroups)
 	return cfg
@@ -143,7 +146,7 @@ func (s *Service) New(ctx context.Context, cfgs ...Config) (Streamer, error) {
 	plumber.MustConnect[framer.StreamerRequest](p, utAddr, distAddr, requestBufferSize)
 	var routeOutletFrom = distAddr
 	if cfg.DownsampleFactor > 1 {
-		plumber.SetSegment(p, downsampleAddr, newDownsampler(cfg))
+		plumber.SetSegment(p, downsampleAddr, s.newDownsampler(cfg))
 		plumber.MustConnect[Response](p, routeOutletFrom, downsampleAddr, responseBufferSize)
 		routeOutletFrom = downsampleAddr
 	}
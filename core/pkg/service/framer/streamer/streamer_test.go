@@ -372,6 +372,59 @@ var _ = Describe("Streamer", Ordered, func() {
 			Expect(err).To(MatchError(ContainSubstring("downsample_factor: must be greater than or equal to 0")))
 		})
 
+		It("Should keep data aligned with its index when downsampling with min/max", func(ctx SpecContext) {
+			indexCh := &channel.Channel{
+				Name:     channel.NewRandomName(),
+				DataType: telem.TimeStampT,
+				IsIndex:  true,
+			}
+			Expect(dist.Channel.Create(ctx, indexCh)).To(Succeed())
+			dataCh := &channel.Channel{
+				Name:       channel.NewRandomName(),
+				DataType:   telem.Float32T,
+				LocalIndex: indexCh.LocalKey,
+			}
+			Expect(dist.Channel.Create(ctx, dataCh)).To(Succeed())
+			keys := []channel.Key{indexCh.Key(), dataCh.Key()}
+			w := MustSucceed(dist.Framer.OpenWriter(ctx, framer.WriterConfig{
+				Start: telem.SecondTS,
+				Keys:  keys,
+			}))
+			s := MustSucceed(streamerSvc.New(ctx, streamer.Config{
+				Keys:             keys,
+				SendOpenAck:      true,
+				DownsampleFactor: 3,
+				DownsampleMode:   telem.DownsampleModeMinMax,
+			}))
+			sCtx, cancel := signal.Isolated()
+			inlet, outlet := confluence.Attach(s)
+			defer cancel()
+			s.Flow(sCtx, confluence.CloseOutputInletsOnExit())
+			Eventually(outlet.Outlet()).Should(Receive())
+			MustSucceed(w.Write(frame.NewMulti(keys, []telem.Series{
+				telem.NewSeriesSecondsTSV(1, 2, 3, 4, 5, 6),
+				telem.NewSeriesV[float32](1, 1, 50, 1, -50, 1),
+			})))
+			var res streamer.Response
+			Eventually(outlet.Outlet()).Should(Receive(&res))
+			Expect(res.Frame.Get(dataCh.Key()).Series[0]).
+				To(telem.MatchSeriesDataV[float32](1, 50, 1, -50))
+			Expect(res.Frame.Get(indexCh.Key()).Series[0]).
+				To(telem.MatchSeriesData(telem.NewSeriesSecondsTSV(1, 3, 4, 5)))
+			inlet.Close()
+			Eventually(outlet.Outlet()).Should(BeClosed())
+			Expect(w.Close()).To(Succeed())
+		})
+
+		It("Should return an error for an unknown downsampling mode", func(ctx SpecContext) {
+			_, err := streamerSvc.New(ctx, streamer.Config{
+				Keys:             []channel.Key{1},
+				DownsampleFactor: 2,
+				DownsampleMode:   "median",
+			})
+			Expect(err).To(MatchError(ContainSubstring("unknown downsample mode")))
+		})
+
 		It("Should correctly combine downsampling with calculations", func(ctx SpecContext) {
 			indexCh := &channel.Channel{
 				Name:     channel.NewRandomName(),
@@ -18,8 +18,13 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/security"
 	"github.com/synnaxlabs/synnax/pkg/service/access/rbac"
 	"github.com/synnaxlabs/synnax/pkg/service/arc"
+	"github.com/synnaxlabs/synnax/pkg/service/arc/library"
 	arcruntime "github.com/synnaxlabs/synnax/pkg/service/arc/runtime"
+	"github.com/synnaxlabs/synnax/pkg/service/audit"
 	"github.com/synnaxlabs/synnax/pkg/service/auth"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/apikey"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/oidc"
+	"github.com/synnaxlabs/synnax/pkg/service/auth/session"
 	"github.com/synnaxlabs/synnax/pkg/service/auth/token"
 	"github.com/synnaxlabs/synnax/pkg/service/channel"
 	"github.com/synnaxlabs/synnax/pkg/service/device"
@@ -70,6 +75,12 @@ type LayerConfig struct {
 	//
 	// [OPTIONAL]
 	RootCredentials auth.Credentials
+	// OIDC configures single sign-on through an OpenID Connect identity provider.
+	// Single sign-on is enabled when an issuer is set. The database, user, and role
+	// services are provided by the layer.
+	//
+	// [OPTIONAL]
+	OIDC oidc.ServiceConfig
 	// Instrumentation is for logging, tracing, metrics, etc.
 	//
 	// [OPTIONAL] - Defaults to noop instrumentation.
@@ -91,6 +102,7 @@ func (c LayerConfig) Override(other LayerConfig) LayerConfig {
 	c.Security = override.Nil(c.Security, other.Security)
 	c.Storage = override.Nil(c.Storage, other.Storage)
 	c.RootCredentials = override.Zero(c.RootCredentials, other.RootCredentials)
+	c.OIDC = c.OIDC.Override(other.OIDC)
 	return c
 }
 
@@ -111,10 +123,20 @@ type Layer struct {
 	User *user.Service
 	// RBAC implements role-based access control for users.
 	RBAC *rbac.Service
+	// Audit is the append-only log of security- and control-relevant actions.
+	Audit *audit.Service
 	// Auth validates credentials and manages credential storage.
 	Auth *auth.Service
 	// Token is for creating and validating authentication tokens.
 	Token *token.Service
+	// Session tracks the sessions tokens are issued for, allowing them to be revoked.
+	Session *session.Service
+	// APIKey manages service accounts and authenticates requests made with their API
+	// keys.
+	APIKey *apikey.Service
+	// OIDC signs users in through an OpenID Connect identity provider. It is nil
+	// when single sign-on is not configured.
+	OIDC *oidc.Service
 	// Ranger is for working with ranges.
 	Ranger *ranger.Service
 	// Alias is for working with channel aliases on ranges.
@@ -144,6 +166,8 @@ type Layer struct {
 	Channel *channel.Service
 	// Arc is used for validating, saving, and executing arc automations.
 	Arc *arc.Service
+	// ArcLibrary is used for storing the Arc library modules that programs import.
+	ArcLibrary *library.Service
 	// Metrics is used for collecting host machine metrics and publishing them over channels
 	Metrics *metrics.Service
 	// Status is used for tracking the statuses
@@ -194,6 +218,13 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 	}); !ok(err, l.User) {
 		return nil, err
 	}
+	if l.Audit, err = audit.OpenService(ctx, audit.ServiceConfig{
+		Instrumentation: cfg.Child("audit"),
+		DB:              cfg.Distribution.DB,
+		HostProvider:    cfg.Distribution.Cluster,
+	}); !ok(err, l.Audit) {
+		return nil, err
+	}
 	if l.RBAC, err = rbac.OpenService(ctx, rbac.ServiceConfig{
 		Instrumentation: cfg.Child("rbac"),
 		DB:              cfg.Distribution.DB,
@@ -202,6 +233,7 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 		Group:           cfg.Distribution.Group,
 		Search:          cfg.Distribution.Search,
 		User:            l.User,
+		Audit:           l.Audit,
 	}); !ok(err, l.RBAC) {
 		return nil, err
 	}
@@ -212,6 +244,30 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 	}); !ok(err, nil) {
 		return nil, err
 	}
+	if l.Session, err = session.OpenService(ctx, session.ServiceConfig{
+		Instrumentation: cfg.Child("session"),
+		DB:              cfg.Distribution.DB,
+	}); !ok(err, l.Session) {
+		return nil, err
+	}
+	if l.APIKey, err = apikey.OpenService(ctx, apikey.ServiceConfig{
+		Instrumentation: cfg.Child("apikey"),
+		DB:              cfg.Distribution.DB,
+		User:            l.User,
+		Policy:          l.RBAC.Policy,
+	}); !ok(err, l.APIKey) {
+		return nil, err
+	}
+	if cfg.OIDC.Enabled() {
+		if l.OIDC, err = oidc.OpenService(ctx, cfg.OIDC, oidc.ServiceConfig{
+			Instrumentation: cfg.Child("oidc"),
+			DB:              cfg.Distribution.DB,
+			User:            l.User,
+			Role:            l.RBAC.Role,
+		}); !ok(err, l.OIDC) {
+			return nil, err
+		}
+	}
 	if l.Label, err = label.OpenService(ctx, label.ServiceConfig{
 		Instrumentation: cfg.Child("label"),
 		DB:              cfg.Distribution.DB,
@@ -342,6 +398,18 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 	}); !ok(err, l.Task) {
 		return nil, err
 	}
+	if l.ArcLibrary, err = library.OpenService(
+		ctx,
+		library.ServiceConfig{
+			Instrumentation: cfg.Child("arc_library"),
+			DB:              cfg.Distribution.DB,
+			Ontology:        cfg.Distribution.Ontology,
+			Search:          cfg.Distribution.Search,
+			Signals:         cfg.Distribution.Signals,
+		},
+	); !ok(err, l.ArcLibrary) {
+		return nil, err
+	}
 	if l.Arc, err = arc.OpenService(
 		ctx,
 		arc.ServiceConfig{
@@ -352,6 +420,7 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 			Channel:         cfg.Distribution.Channel,
 			Signals:         cfg.Distribution.Signals,
 			Task:            l.Task,
+			Library:         l.ArcLibrary,
 		},
 	); !ok(err, l.Arc) {
 		return nil, err
@@ -422,6 +491,7 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 		Framer:          cfg.Distribution.Framer,
 		Status:          l.Status,
 		GetProgram:      l.Arc.CompileProgram,
+		DB:              cfg.Distribution.DB,
 	})
 	if !ok(err, nil) {
 		return nil, err
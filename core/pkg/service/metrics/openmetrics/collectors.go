@@ -0,0 +1,335 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package openmetrics
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/samber/lo"
+	distchannel "github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/synnax/pkg/distribution/ontology"
+	"github.com/synnaxlabs/synnax/pkg/service/channel"
+	"github.com/synnaxlabs/synnax/pkg/service/framer"
+	"github.com/synnaxlabs/synnax/pkg/service/status"
+	"github.com/synnaxlabs/synnax/pkg/service/task"
+	"github.com/synnaxlabs/synnax/pkg/storage"
+	"github.com/synnaxlabs/x/confluence"
+	"github.com/synnaxlabs/x/confluence/plumber"
+	xio "github.com/synnaxlabs/x/io"
+	"github.com/synnaxlabs/x/signal"
+	xstatus "github.com/synnaxlabs/x/status"
+	"github.com/synnaxlabs/x/telem"
+	"go.uber.org/zap"
+)
+
+// storageCollector collects metrics on the storage engines of the node.
+type storageCollector struct{ storage *storage.Layer }
+
+func (c storageCollector) Collect(context.Context) ([]Family, error) {
+	m := c.storage.TS.Metrics()
+	return []Family{
+		Gauge(
+			"synnax_storage_ts_disk_size_bytes",
+			"Disk space used by all channel data.",
+			float64(m.DiskSize),
+		),
+		Gauge(
+			"synnax_storage_ts_logical_size_bytes",
+			"Size of all channel data before compression.",
+			float64(m.LogicalSize),
+		),
+		Gauge(
+			"synnax_storage_ts_channels",
+			"Number of channels in the time-series engine.",
+			float64(m.ChannelCount),
+		),
+		Gauge(
+			"synnax_storage_kv_size_bytes",
+			"Disk space used by the key-value store.",
+			float64(c.storage.KVSize()),
+		),
+	}, nil
+}
+
+// nodeStates are the gossip states that a node can be in, in the order they are
+// exported.
+var nodeStates = []node.State{
+	node.StateHealthy,
+	node.StateSuspect,
+	node.StateDead,
+	node.StateLeft,
+}
+
+// clusterCollector collects the gossip health of every node in the cluster as observed
+// by the host.
+type clusterCollector struct{ cluster node.Cluster }
+
+func (c clusterCollector) Collect(context.Context) ([]Family, error) {
+	var (
+		nodes = lo.Values(c.cluster.Nodes())
+		state = Family{
+			Name: "synnax_cluster_node_state",
+			Help: "Gossip state of each node in the cluster as observed by this node.",
+			Type: TypeGauge,
+		}
+		generation = Family{
+			Name: "synnax_cluster_node_heartbeat_generation",
+			Help: "Number of times each node in the cluster has restarted.",
+			Type: TypeGauge,
+		}
+		version = Family{
+			Name: "synnax_cluster_node_heartbeat_version",
+			Help: "Gossip heartbeat version of each node in the cluster.",
+			Type: TypeGauge,
+		}
+	)
+	slices.SortFunc(nodes, func(a, b node.Node) int { return int(a.Key) - int(b.Key) })
+	for _, n := range nodes {
+		key := Label{Name: "node", Value: n.Key.String()}
+		for _, s := range nodeStates {
+			state.Samples = append(state.Samples, Sample{
+				Labels: []Label{key, {Name: "state", Value: s.String()}},
+				Value:  lo.Ternary(n.State == s, 1.0, 0.0),
+			})
+		}
+		generation.Samples = append(generation.Samples, Sample{
+			Labels: []Label{key},
+			Value:  float64(n.Heartbeat.Generation),
+		})
+		version.Samples = append(version.Samples, Sample{
+			Labels: []Label{key},
+			Value:  float64(n.Heartbeat.Version),
+		})
+	}
+	return []Family{state, generation, version}, nil
+}
+
+// relayCollector collects metrics on the relay that distributes frames to streamers.
+type relayCollector struct{ framer *framer.Service }
+
+func (c relayCollector) Collect(context.Context) ([]Family, error) {
+	return []Family{Gauge(
+		"synnax_framer_relay_backlog",
+		"Number of frames waiting to be delivered to streamers.",
+		float64(c.framer.RelayBacklog()),
+	)}, nil
+}
+
+// taskVariants are the status variants that a task can be in, in the order they are
+// exported.
+var taskVariants = []xstatus.Variant{
+	xstatus.VariantSuccess,
+	xstatus.VariantInfo,
+	xstatus.VariantWarning,
+	xstatus.VariantError,
+	xstatus.VariantLoading,
+	xstatus.VariantDisabled,
+}
+
+// taskCollector collects the states of all non-internal tasks.
+type taskCollector struct {
+	task   *task.Service
+	status *status.Service
+}
+
+func (c taskCollector) Collect(ctx context.Context) ([]Family, error) {
+	var tasks []task.Task
+	if err := c.task.NewRetrieve().Entries(&tasks).Exec(ctx, nil); err != nil {
+		return nil, err
+	}
+	tasks = lo.Filter(tasks, func(t task.Task, _ int) bool { return !t.Internal })
+	statuses := make(map[task.Key]task.Status, len(tasks))
+	if len(tasks) > 0 {
+		var entries []task.Status
+		if err := status.NewRetrieve[task.StatusDetails](c.status).
+			Where(status.MatchKeys[task.StatusDetails](ontology.IDsToKeys(task.OntologyIDsFromTasks(tasks))...)).
+			Entries(&entries).
+			Exec(ctx, nil); err != nil {
+			return nil, err
+		}
+		for _, s := range entries {
+			statuses[s.Details.Task] = s
+		}
+	}
+	running := Family{
+		Name: "synnax_task_running",
+		Help: "Whether each task is running.",
+		Type: TypeGauge,
+	}
+	variant := Family{
+		Name: "synnax_task_status",
+		Help: "Variant of the most recent status of each task.",
+		Type: TypeGauge,
+	}
+	for _, t := range tasks {
+		labels := []Label{
+			{Name: "task", Value: t.Key.String()},
+			{Name: "name", Value: t.Name},
+			{Name: "type", Value: t.Type},
+		}
+		s, ok := statuses[t.Key]
+		running.Samples = append(running.Samples, Sample{
+			Labels: labels,
+			Value:  lo.Ternary(ok && s.Details.Running, 1.0, 0.0),
+		})
+		for _, v := range taskVariants {
+			variant.Samples = append(variant.Samples, Sample{
+				Labels: append(slices.Clip(labels), Label{Name: "variant", Value: string(v)}),
+				Value:  lo.Ternary(ok && s.Variant == v, 1.0, 0.0),
+			})
+		}
+	}
+	return []Family{running, variant}, nil
+}
+
+// valueCollector exports the latest value of each channel in an allow-list. Values are
+// kept up to date by a streamer, and channels that have not received a value since the
+// collector was opened are omitted.
+type valueCollector struct {
+	confluence.UnarySink[framer.StreamerResponse]
+	channels map[channel.Key]channel.Channel
+	requests confluence.Inlet[framer.StreamerRequest]
+	closer   xio.MultiCloser
+	mu       struct {
+		sync.RWMutex
+		values map[channel.Key]float64
+	}
+}
+
+func openValueCollector(ctx context.Context, cfg Config) (*valueCollector, error) {
+	var channels []channel.Channel
+	if err := cfg.Channel.NewRetrieve().
+		Where(channel.MatchNames(cfg.Channels...)).
+		Entries(&channels).
+		Exec(ctx, nil); err != nil {
+		return nil, err
+	}
+	found := lo.Map(channels, func(ch channel.Channel, _ int) string { return ch.Name })
+	if missing, _ := lo.Difference(cfg.Channels, found); len(missing) > 0 {
+		cfg.L.Warn("channels to export not found", zap.Strings("channels", missing))
+	}
+	channels = lo.Filter(channels, func(ch channel.Channel, _ int) bool {
+		if ch.DataType.IsVariable() {
+			cfg.L.Warn(
+				"cannot export values of a variable density channel",
+				zap.Stringer("channel", ch),
+			)
+			return false
+		}
+		return true
+	})
+	c := &valueCollector{channels: lo.KeyBy(channels, channel.Channel.Key)}
+	c.mu.values = make(map[channel.Key]float64, len(channels))
+	if len(channels) == 0 {
+		return c, nil
+	}
+	streamer, err := cfg.Framer.NewStreamer(ctx, framer.StreamerConfig{
+		Keys: distchannel.KeysFromChannels(channels),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sCtx, cancel := signal.Isolated(signal.WithInstrumentation(cfg.Instrumentation))
+	c.closer = append(c.closer, signal.NewGracefulShutdown(sCtx, cancel))
+	p := plumber.New()
+	plumber.SetSegment[framer.StreamerRequest, framer.StreamerResponse](
+		p, "streamer", streamer,
+	)
+	c.Sink = c.update
+	plumber.SetSink[framer.StreamerResponse](p, "values", c)
+	plumber.MustConnect[framer.StreamerResponse](p, "streamer", "values", 10)
+	requests := confluence.NewStream[framer.StreamerRequest]()
+	streamer.InFrom(requests)
+	c.requests = requests
+	p.Flow(
+		sCtx,
+		confluence.CloseOutputInletsOnExit(),
+		confluence.RecoverWithErrOnPanic(),
+	)
+	return c, nil
+}
+
+func (c *valueCollector) update(_ context.Context, res framer.StreamerResponse) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, series := range res.Frame.Entries() {
+		if v, ok := lastValue(series); ok {
+			c.mu.values[key] = v
+		}
+	}
+	return nil
+}
+
+func (c *valueCollector) Collect(context.Context) ([]Family, error) {
+	f := Family{
+		Name: "synnax_channel_value",
+		Help: "Latest value of each exported channel.",
+		Type: TypeGauge,
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	keys := lo.Keys(c.mu.values)
+	slices.Sort(keys)
+	for _, key := range keys {
+		f.Samples = append(f.Samples, Sample{
+			Labels: []Label{
+				{Name: "channel", Value: c.channels[key].Name},
+				{Name: "key", Value: strconv.Itoa(int(key))},
+			},
+			Value: c.mu.values[key],
+		})
+	}
+	return []Family{f}, nil
+}
+
+func (c *valueCollector) Close() error {
+	if c.requests != nil {
+		c.requests.Close()
+	}
+	return c.closer.Close()
+}
+
+// lastValue returns the last sample in the series as a float64. If the series is empty
+// or is not numeric, lastValue returns false.
+func lastValue(s telem.Series) (float64, bool) {
+	if s.Len() == 0 {
+		return 0, false
+	}
+	switch s.DataType {
+	case telem.Float64T:
+		return telem.ValueAt[float64](s, -1), true
+	case telem.Float32T:
+		return float64(telem.ValueAt[float32](s, -1)), true
+	case telem.Int64T:
+		return float64(telem.ValueAt[int64](s, -1)), true
+	case telem.Int32T:
+		return float64(telem.ValueAt[int32](s, -1)), true
+	case telem.Int16T:
+		return float64(telem.ValueAt[int16](s, -1)), true
+	case telem.Int8T:
+		return float64(telem.ValueAt[int8](s, -1)), true
+	case telem.Uint64T:
+		return float64(telem.ValueAt[uint64](s, -1)), true
+	case telem.Uint32T:
+		return float64(telem.ValueAt[uint32](s, -1)), true
+	case telem.Uint16T:
+		return float64(telem.ValueAt[uint16](s, -1)), true
+	case telem.Uint8T:
+		return float64(telem.ValueAt[uint8](s, -1)), true
+	case telem.TimeStampT:
+		return float64(telem.ValueAt[telem.TimeStamp](s, -1)), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package openmetrics
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/freighter"
+	fhttp "github.com/synnaxlabs/freighter/http"
+	"github.com/synnaxlabs/synnax/pkg/distribution/node"
+	"github.com/synnaxlabs/synnax/pkg/service/channel"
+	"github.com/synnaxlabs/synnax/pkg/service/framer"
+	"github.com/synnaxlabs/synnax/pkg/service/status"
+	"github.com/synnaxlabs/synnax/pkg/service/task"
+	"github.com/synnaxlabs/synnax/pkg/storage"
+	"github.com/synnaxlabs/x/address"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/io"
+	"github.com/synnaxlabs/x/override"
+	"github.com/synnaxlabs/x/service"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// Path is the HTTP path that the exporter serves metrics on.
+const Path = "/metrics"
+
+// Config is the configuration for opening an Exporter.
+type Config struct {
+	// Instrumentation is used for logging, tracing, and metrics.
+	alamos.Instrumentation
+	// Storage is the storage layer used for storage engine metrics.
+	//
+	// [REQUIRED]
+	Storage *storage.Layer
+	// Cluster is used for gossip health metrics on each node in the cluster.
+	//
+	// [REQUIRED]
+	Cluster node.Cluster
+	// Framer is used for relay metrics and to stream the latest values of the
+	// channels in Channels.
+	//
+	// [REQUIRED]
+	Framer *framer.Service
+	// Channel is used to resolve the channels in Channels.
+	//
+	// [REQUIRED]
+	Channel *channel.Service
+	// Task is used to retrieve the tasks whose states are exported.
+	//
+	// [REQUIRED]
+	Task *task.Service
+	// Status is used to retrieve the statuses of tasks.
+	//
+	// [REQUIRED]
+	Status *status.Service
+	// Channels is an allow-list of the names of channels whose latest values are
+	// exported as gauges. Names that do not resolve to a channel when the exporter is
+	// opened are logged and ignored.
+	//
+	// [OPTIONAL] - Defaults to no channels.
+	Channels []string
+	// Collectors are additional collectors whose families are included in every
+	// scrape.
+	//
+	// [OPTIONAL]
+	Collectors []Collector
+	// Auth authenticates scrapes when the latest values of channels are exported, so
+	// that telemetry is not readable without credentials. Scrapes that fail
+	// authentication are rejected with a 401 status.
+	//
+	// [OPTIONAL] - Required when Channels is not empty.
+	Auth freighter.Middleware
+}
+
+var (
+	_ config.Config[Config] = Config{}
+	// DefaultConfig is the default configuration for an Exporter.
+	DefaultConfig = Config{}
+)
+
+// Override implements config.Config.
+func (c Config) Override(other Config) Config {
+	c.Instrumentation = override.Zero(c.Instrumentation, other.Instrumentation)
+	c.Storage = override.Nil(c.Storage, other.Storage)
+	c.Cluster = override.Nil(c.Cluster, other.Cluster)
+	c.Framer = override.Nil(c.Framer, other.Framer)
+	c.Channel = override.Nil(c.Channel, other.Channel)
+	c.Task = override.Nil(c.Task, other.Task)
+	c.Status = override.Nil(c.Status, other.Status)
+	c.Channels = override.Slice(c.Channels, other.Channels)
+	c.Collectors = override.Slice(c.Collectors, other.Collectors)
+	c.Auth = override.Nil(c.Auth, other.Auth)
+	return c
+}
+
+// Validate implements config.Config.
+func (c Config) Validate() error {
+	v := validate.New("metrics.openmetrics")
+	validate.NotNil(v, "storage", c.Storage)
+	validate.NotNil(v, "cluster", c.Cluster)
+	validate.NotNil(v, "framer", c.Framer)
+	validate.NotNil(v, "channel", c.Channel)
+	validate.NotNil(v, "task", c.Task)
+	validate.NotNil(v, "status", c.Status)
+	if len(c.Channels) > 0 {
+		validate.NotNil(v, "auth", c.Auth)
+	}
+	return v.Error()
+}
+
+// Exporter serves the internal state of the Core, along with the latest values of an
+// allow-list of channels, on an HTTP endpoint that can be scraped by Prometheus or any
+// other OpenMetrics compatible collector.
+type Exporter struct {
+	cfg        Config
+	collectors []Collector
+	closer     io.MultiCloser
+}
+
+var _ fhttp.BindableTransport = (*Exporter)(nil)
+
+// Open opens a new Exporter using the provided configuration. If Open returns an
+// error, the exporter is not safe to use. If Open succeeds, the exporter must be
+// closed by calling Close after use.
+func Open(ctx context.Context, cfgs ...Config) (e *Exporter, err error) {
+	cfg, err := config.New(DefaultConfig, cfgs...)
+	if err != nil {
+		return nil, err
+	}
+	e = &Exporter{cfg: cfg}
+	cleanup, ok := service.NewOpener(ctx, &e.closer)
+	defer func() { err = cleanup(err) }()
+	e.collectors = []Collector{
+		storageCollector{storage: cfg.Storage},
+		clusterCollector{cluster: cfg.Cluster},
+		relayCollector{framer: cfg.Framer},
+		taskCollector{task: cfg.Task, status: cfg.Status},
+	}
+	if len(cfg.Channels) > 0 {
+		var values *valueCollector
+		if values, err = openValueCollector(ctx, cfg); !ok(err, values) {
+			return nil, err
+		}
+		e.collectors = append(e.collectors, values)
+	}
+	e.collectors = append(e.collectors, cfg.Collectors...)
+	return e, nil
+}
+
+// Collect implements Collector, returning the families of every collector in the
+// exporter. Collectors that fail are logged and skipped so that a single failure does
+// not prevent the rest of the families from being scraped.
+func (e *Exporter) Collect(ctx context.Context) ([]Family, error) {
+	var families []Family
+	for _, c := range e.collectors {
+		f, err := c.Collect(ctx)
+		if err != nil {
+			e.cfg.L.Warn("failed to collect metrics", zap.Error(err))
+			continue
+		}
+		families = append(families, f...)
+	}
+	return families, nil
+}
+
+// BindTo implements fhttp.BindableTransport.
+func (e *Exporter) BindTo(app *fiber.App) { app.Get(Path, e.serve) }
+
+func (e *Exporter) serve(c fiber.Ctx) error {
+	if len(e.cfg.Channels) > 0 {
+		if _, err := e.cfg.Auth.Exec(
+			requestContext(c),
+			freighter.FinalizerFunc(func(ctx freighter.Context) (freighter.Context, error) {
+				return ctx, nil
+			}),
+		); err != nil {
+			return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+		}
+	}
+	families, err := e.Collect(c)
+	if err != nil {
+		return err
+	}
+	openMetrics := strings.Contains(c.Get(fiber.HeaderAccept), "application/openmetrics-text")
+	contentType := ContentTypeText
+	if openMetrics {
+		contentType = ContentTypeOpenMetrics
+	}
+	var buf bytes.Buffer
+	if err = Encode(&buf, families, openMetrics); err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.Send(buf.Bytes())
+}
+
+// requestContext returns the freighter context of a scrape, holding its headers as
+// parameters so that Config.Auth can read the credentials of the request.
+func requestContext(c fiber.Ctx) freighter.Context {
+	headers := c.GetReqHeaders()
+	params := make(freighter.Params, len(headers))
+	for k, v := range headers {
+		if len(v) > 0 {
+			params[k] = v[0]
+		}
+	}
+	return freighter.Context{
+		Context:  c,
+		Params:   params,
+		Protocol: "http",
+		Target:   address.Address(Path),
+		Role:     freighter.RoleServer,
+		Variant:  freighter.VariantUnary,
+	}
+}
+
+// Use implements fhttp.BindableTransport. The exporter does not support middleware,
+// and authenticates scrapes with Config.Auth instead.
+func (*Exporter) Use(...freighter.Middleware) {}
+
+// Report implements alamos.ReportProvider.
+func (e *Exporter) Report() alamos.Report {
+	return alamos.Report{"path": Path, "channels": len(e.cfg.Channels)}
+}
+
+// Close stops streaming channel values to the exporter.
+func (e *Exporter) Close() error { return e.closer.Close() }
@@ -0,0 +1,224 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package openmetrics_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gofiber/fiber/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/freighter"
+	"github.com/synnaxlabs/synnax/pkg/distribution/channel"
+	"github.com/synnaxlabs/synnax/pkg/distribution/framer/frame"
+	"github.com/synnaxlabs/synnax/pkg/service/framer"
+	"github.com/synnaxlabs/synnax/pkg/service/metrics/openmetrics"
+	"github.com/synnaxlabs/synnax/pkg/service/task"
+	"github.com/synnaxlabs/x/errors"
+	xstatus "github.com/synnaxlabs/x/status"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+func scrape(app *fiber.App, accept string) (*http.Response, string) {
+	req := httptest.NewRequest(http.MethodGet, openmetrics.Path, nil)
+	if accept != "" {
+		req.Header.Set(fiber.HeaderAccept, accept)
+	}
+	res := MustSucceed(app.Test(req))
+	body := MustSucceed(io.ReadAll(res.Body))
+	return res, string(body)
+}
+
+func scrapeWithToken(app *fiber.App, token string) (*http.Response, string) {
+	req := httptest.NewRequest(http.MethodGet, openmetrics.Path, nil)
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	res := MustSucceed(app.Test(req))
+	body := MustSucceed(io.ReadAll(res.Body))
+	return res, string(body)
+}
+
+// tokenAuth is an authentication middleware that only accepts the token "secret".
+var tokenAuth = freighter.MiddlewareFunc(func(
+	ctx freighter.Context,
+	next freighter.Next,
+) (freighter.Context, error) {
+	if tk, _ := ctx.Get(fiber.HeaderAuthorization); tk != "Bearer secret" {
+		return ctx, errors.New("invalid token")
+	}
+	return next(ctx)
+})
+
+var _ = Describe("Exporter", func() {
+	baseConfig := func() openmetrics.Config {
+		return openmetrics.Config{
+			Storage: dist.Storage,
+			Cluster: dist.Cluster,
+			Framer:  framerSvc,
+			Channel: channelSvc,
+			Task:    taskSvc,
+			Status:  statusSvc,
+		}
+	}
+
+	Describe("Config", func() {
+		It("Should return an error when the storage layer is missing", func(ctx SpecContext) {
+			cfg := baseConfig()
+			cfg.Storage = nil
+			Expect(openmetrics.Open(ctx, cfg)).Error().
+				To(MatchError(ContainSubstring("storage: must be non-nil")))
+		})
+		It("Should return an error when the task service is missing", func(ctx SpecContext) {
+			cfg := baseConfig()
+			cfg.Task = nil
+			Expect(openmetrics.Open(ctx, cfg)).Error().
+				To(MatchError(ContainSubstring("task: must be non-nil")))
+		})
+		It("Should return an error when channels are exported without auth", func(ctx SpecContext) {
+			cfg := baseConfig()
+			cfg.Channels = []string{"openmetrics_unauthenticated"}
+			Expect(openmetrics.Open(ctx, cfg)).Error().
+				To(MatchError(ContainSubstring("auth: must be non-nil")))
+		})
+	})
+
+	Describe("Scrape", func() {
+		var (
+			exporter *openmetrics.Exporter
+			app      *fiber.App
+		)
+		BeforeEach(func(ctx SpecContext) {
+			cfg := baseConfig()
+			cfg.Collectors = []openmetrics.Collector{
+				openmetrics.CollectorFunc(func(context.Context) ([]openmetrics.Family, error) {
+					return []openmetrics.Family{
+						openmetrics.Gauge("synnax_custom", "A custom metric.", 42),
+					}, nil
+				}),
+				openmetrics.CollectorFunc(func(context.Context) ([]openmetrics.Family, error) {
+					return nil, errors.New("collector failed")
+				}),
+			}
+			exporter = MustSucceed(openmetrics.Open(ctx, cfg))
+			app = fiber.New()
+			exporter.BindTo(app)
+		})
+		AfterEach(func() {
+			Expect(app.Shutdown()).To(Succeed())
+			Expect(exporter.Close()).To(Succeed())
+		})
+
+		It("Should serve metrics in the Prometheus text format by default", func() {
+			res, body := scrape(app, "")
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get(fiber.HeaderContentType)).
+				To(Equal(openmetrics.ContentTypeText))
+			Expect(body).ToNot(ContainSubstring("# EOF"))
+		})
+
+		It("Should serve the OpenMetrics text format when accepted", func() {
+			res, body := scrape(app, "application/openmetrics-text;version=1.0.0,*/*;q=0.1")
+			Expect(res.Header.Get(fiber.HeaderContentType)).
+				To(Equal(openmetrics.ContentTypeOpenMetrics))
+			Expect(body).To(HaveSuffix("# EOF\n"))
+		})
+
+		It("Should export storage, relay, and cluster metrics", func() {
+			_, body := scrape(app, "")
+			Expect(body).To(ContainSubstring("# TYPE synnax_storage_ts_disk_size_bytes gauge"))
+			Expect(body).To(ContainSubstring("synnax_storage_ts_channels "))
+			Expect(body).To(ContainSubstring("synnax_storage_kv_size_bytes "))
+			Expect(body).To(ContainSubstring("synnax_framer_relay_backlog "))
+			Expect(body).To(ContainSubstring(`synnax_cluster_node_state{node="1",state="healthy"} 1`))
+			Expect(body).To(ContainSubstring(`synnax_cluster_node_state{node="1",state="dead"} 0`))
+		})
+
+		It("Should include additional collectors and skip failed ones", func() {
+			_, body := scrape(app, "")
+			Expect(body).To(ContainSubstring("synnax_custom 42"))
+		})
+
+		It("Should export the state of each task", func(ctx SpecContext) {
+			t := &task.Task{
+				Key:  task.NewKey(testRack.Key, 0),
+				Name: "Exported Task",
+				Type: "modbus_read",
+				Status: &task.Status{
+					Variant: xstatus.VariantSuccess,
+					Message: "Task running",
+					Time:    telem.Now(),
+					Details: task.StatusDetails{Running: true},
+				},
+			}
+			Expect(taskSvc.NewWriter(nil).Create(ctx, t)).To(Succeed())
+			DeferCleanup(func(ctx SpecContext) {
+				Expect(taskSvc.NewWriter(nil).Delete(ctx, t.Key, false)).To(Succeed())
+			})
+			_, body := scrape(app, "")
+			labels := `task="` + t.Key.String() + `",name="Exported Task",type="modbus_read"`
+			Expect(body).To(ContainSubstring(`synnax_task_running{` + labels + `} 1`))
+			Expect(body).To(ContainSubstring(`synnax_task_status{` + labels + `,variant="success"} 1`))
+			Expect(body).To(ContainSubstring(`synnax_task_status{` + labels + `,variant="error"} 0`))
+		})
+	})
+
+	Describe("Channel Values", func() {
+		It("Should export the latest values of allow-listed channels", func(ctx SpecContext) {
+			ch := &channel.Channel{
+				Name:     "openmetrics_exported",
+				DataType: telem.Float64T,
+				Virtual:  true,
+			}
+			other := &channel.Channel{
+				Name:     "openmetrics_not_exported",
+				DataType: telem.Float64T,
+				Virtual:  true,
+			}
+			Expect(dist.Channel.Create(ctx, ch)).To(Succeed())
+			Expect(dist.Channel.Create(ctx, other)).To(Succeed())
+			cfg := baseConfig()
+			cfg.Channels = []string{ch.Name, "openmetrics_missing"}
+			cfg.Auth = tokenAuth
+			exporter := MustSucceed(openmetrics.Open(ctx, cfg))
+			app := fiber.New()
+			exporter.BindTo(app)
+			w := MustSucceed(framerSvc.OpenWriter(ctx, framer.WriterConfig{
+				Start: telem.Now(),
+				Keys:  channel.Keys{ch.Key(), other.Key()},
+			}))
+			Eventually(func(g Gomega) {
+				g.Expect(w.Write(frame.NewMulti(
+					channel.Keys{ch.Key(), other.Key()},
+					[]telem.Series{
+						telem.NewSeriesV(1.0, 2.5),
+						telem.NewSeriesV(3.0),
+					},
+				))).To(BeTrue())
+				_, body := scrapeWithToken(app, "secret")
+				g.Expect(body).To(ContainSubstring(
+					`synnax_channel_value{channel="openmetrics_exported",key="` +
+						ch.Key().String() + `"} 2.5`,
+				))
+				g.Expect(body).ToNot(ContainSubstring("openmetrics_not_exported"))
+			}).Should(Succeed())
+			res, body := scrape(app, "")
+			Expect(res.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			Expect(body).ToNot(ContainSubstring("openmetrics_exported"))
+			res, _ = scrapeWithToken(app, "wrong")
+			Expect(res.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			Expect(w.Close()).To(Succeed())
+			Expect(app.Shutdown()).To(Succeed())
+			Expect(exporter.Close()).To(Succeed())
+		})
+	})
+})
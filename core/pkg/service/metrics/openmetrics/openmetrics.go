@@ -0,0 +1,156 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+// Package openmetrics exposes the internal state of a Synnax Core, along with the
+// latest values of selected channels, in the Prometheus and OpenMetrics text formats so
+// that the Core can be scraped by existing monitoring infrastructure.
+package openmetrics
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Type is the type of a metric family.
+type Type string
+
+const (
+	// TypeGauge is a metric whose value can go up and down.
+	TypeGauge Type = "gauge"
+)
+
+// Label is a name-value pair that identifies a sample within a metric family.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a single value of a metric family.
+type Sample struct {
+	// Labels identify the sample within its family.
+	Labels []Label
+	// Value is the value of the sample.
+	Value float64
+}
+
+// Family is a group of samples that share a name, type, and help text.
+type Family struct {
+	// Name is the name of the metric family. Names should be prefixed with synnax_ and
+	// must only contain characters that are valid in a metric name.
+	Name string
+	// Help is a human-readable description of the metric family.
+	Help string
+	// Type is the type of the metric family.
+	Type Type
+	// Samples are the samples in the family.
+	Samples []Sample
+}
+
+// Gauge returns a gauge family with a single unlabeled sample.
+func Gauge(name, help string, value float64) Family {
+	return Family{
+		Name:    name,
+		Help:    help,
+		Type:    TypeGauge,
+		Samples: []Sample{{Value: value}},
+	}
+}
+
+// Collector collects metric families on every scrape.
+type Collector interface {
+	// Collect returns the current values of the collector's metric families.
+	Collect(ctx context.Context) ([]Family, error)
+}
+
+// CollectorFunc is a function that implements Collector.
+type CollectorFunc func(ctx context.Context) ([]Family, error)
+
+var _ Collector = CollectorFunc(nil)
+
+// Collect implements Collector.
+func (f CollectorFunc) Collect(ctx context.Context) ([]Family, error) { return f(ctx) }
+
+const (
+	// ContentTypeOpenMetrics is the content type of the OpenMetrics text format.
+	ContentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+	// ContentTypeText is the content type of the Prometheus text format.
+	ContentTypeText = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// Encode writes the given families to w in the Prometheus text format. If openMetrics
+// is true, the families are written in the OpenMetrics text format instead, which
+// differs only in its terminating # EOF line.
+func Encode(w io.Writer, families []Family, openMetrics bool) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		bw.WriteString("# HELP ")
+		bw.WriteString(f.Name)
+		bw.WriteByte(' ')
+		bw.WriteString(escapeHelp(f.Help))
+		bw.WriteString("\n# TYPE ")
+		bw.WriteString(f.Name)
+		bw.WriteByte(' ')
+		bw.WriteString(string(f.Type))
+		bw.WriteByte('\n')
+		for _, s := range f.Samples {
+			bw.WriteString(f.Name)
+			writeLabels(bw, s.Labels)
+			bw.WriteByte(' ')
+			bw.WriteString(formatValue(s.Value))
+			bw.WriteByte('\n')
+		}
+	}
+	if openMetrics {
+		bw.WriteString("# EOF\n")
+	}
+	return bw.Flush()
+}
+
+func writeLabels(w *bufio.Writer, labels []Label) {
+	if len(labels) == 0 {
+		return
+	}
+	w.WriteByte('{')
+	for i, l := range labels {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		w.WriteString(l.Name)
+		w.WriteString(`="`)
+		w.WriteString(escapeLabelValue(l.Value))
+		w.WriteByte('"')
+	}
+	w.WriteByte('}')
+}
+
+var (
+	helpReplacer       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(help string) string { return helpReplacer.Replace(help) }
+
+func escapeLabelValue(v string) string { return labelValueReplacer.Replace(v) }
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
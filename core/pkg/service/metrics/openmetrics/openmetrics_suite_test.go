@@ -0,0 +1,109 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package openmetrics_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gleak"
+	"github.com/synnaxlabs/synnax/pkg/distribution/mock"
+	"github.com/synnaxlabs/synnax/pkg/distribution/search"
+	"github.com/synnaxlabs/synnax/pkg/service/arc"
+	servicechannel "github.com/synnaxlabs/synnax/pkg/service/channel"
+	"github.com/synnaxlabs/synnax/pkg/service/framer"
+	"github.com/synnaxlabs/synnax/pkg/service/label"
+	"github.com/synnaxlabs/synnax/pkg/service/rack"
+	"github.com/synnaxlabs/synnax/pkg/service/status"
+	"github.com/synnaxlabs/synnax/pkg/service/task"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var (
+	builder    *mock.Cluster
+	dist       mock.Node
+	framerSvc  *framer.Service
+	channelSvc *servicechannel.Service
+	taskSvc    *task.Service
+	statusSvc  *status.Service
+	testRack   *rack.Rack
+)
+
+func TestOpenMetrics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OpenMetrics Suite")
+}
+
+// fasthttp starts a process-wide goroutine that refreshes the server date header the
+// first time a request is served, and never stops it.
+var _ = ShouldNotLeakGoroutinesPerSpec(LeakIgnoring(
+	gleak.IgnoringTopFunction("github.com/valyala/fasthttp.updateServerDate.func1"),
+))
+
+var _ = BeforeSuite(func(ctx SpecContext) {
+	builder = DeferClose(mock.NewCluster())
+	dist = builder.Provision(ctx)
+	searchIdx := MustOpen(search.Open())
+	labelSvc := MustOpen(label.OpenService(ctx, label.ServiceConfig{
+		DB:       dist.DB,
+		Ontology: dist.Ontology,
+		Group:    dist.Group,
+		Signals:  dist.Signals,
+		Search:   searchIdx,
+	}))
+	statusSvc = MustOpen(status.OpenService(ctx, status.ServiceConfig{
+		DB:       dist.DB,
+		Label:    labelSvc,
+		Ontology: dist.Ontology,
+		Group:    dist.Group,
+		Signals:  dist.Signals,
+		Search:   searchIdx,
+	}))
+	rackSvc := MustOpen(rack.OpenService(ctx, rack.ServiceConfig{
+		DB:           dist.DB,
+		Ontology:     dist.Ontology,
+		Group:        dist.Group,
+		HostProvider: mock.StaticHostKeyProvider(1),
+		Status:       statusSvc,
+		Search:       searchIdx,
+	}))
+	testRack = &rack.Rack{Name: "Test Rack"}
+	Expect(rackSvc.NewWriter(dist.DB).Create(ctx, testRack)).To(Succeed())
+	taskSvc = MustOpen(task.OpenService(ctx, task.ServiceConfig{
+		DB:       dist.DB,
+		Ontology: dist.Ontology,
+		Group:    dist.Group,
+		Rack:     rackSvc,
+		Status:   statusSvc,
+		Search:   searchIdx,
+	}))
+	arcSvc := MustOpen(arc.OpenService(ctx, arc.ServiceConfig{
+		Channel:  dist.Channel,
+		Ontology: dist.Ontology,
+		DB:       dist.DB,
+		Signals:  dist.Signals,
+		Task:     taskSvc,
+		Search:   searchIdx,
+	}))
+	channelSvc = MustOpen(servicechannel.OpenService(ctx, servicechannel.ServiceConfig{
+		DB:           dist.DB,
+		Distribution: dist.Channel,
+		Status:       statusSvc,
+		Arc:          arcSvc,
+	}))
+	framerSvc = MustOpen(framer.OpenService(ctx, framer.ServiceConfig{
+		Framer:  dist.Framer,
+		Channel: channelSvc,
+		Arc:     arcSvc,
+		Status:  statusSvc,
+		DB:      dist.DB,
+	}))
+})
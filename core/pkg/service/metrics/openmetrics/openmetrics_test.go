@@ -0,0 +1,94 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package openmetrics_test
+
+import (
+	"bytes"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/synnax/pkg/service/metrics/openmetrics"
+)
+
+var _ = Describe("Encode", func() {
+	families := []openmetrics.Family{
+		openmetrics.Gauge("synnax_up", "Whether the Core is up.", 1),
+		{
+			Name: "synnax_node_state",
+			Help: "State of each node.",
+			Type: openmetrics.TypeGauge,
+			Samples: []openmetrics.Sample{
+				{
+					Labels: []openmetrics.Label{
+						{Name: "node", Value: "1"},
+						{Name: "state", Value: "healthy"},
+					},
+					Value: 1,
+				},
+				{
+					Labels: []openmetrics.Label{
+						{Name: "node", Value: "2"},
+						{Name: "state", Value: "healthy"},
+					},
+					Value: 0.5,
+				},
+			},
+		},
+	}
+
+	It("Should encode families in the Prometheus text format", func() {
+		var buf bytes.Buffer
+		Expect(openmetrics.Encode(&buf, families, false)).To(Succeed())
+		Expect(buf.String()).To(Equal(`# HELP synnax_up Whether the Core is up.
+# TYPE synnax_up gauge
+synnax_up 1
+# HELP synnax_node_state State of each node.
+# TYPE synnax_node_state gauge
+synnax_node_state{node="1",state="healthy"} 1
+synnax_node_state{node="2",state="healthy"} 0.5
+`))
+	})
+
+	It("Should terminate the OpenMetrics text format with an EOF marker", func() {
+		var buf bytes.Buffer
+		Expect(openmetrics.Encode(&buf, families[:1], true)).To(Succeed())
+		Expect(buf.String()).To(HaveSuffix("synnax_up 1\n# EOF\n"))
+	})
+
+	It("Should escape help text and label values", func() {
+		var buf bytes.Buffer
+		Expect(openmetrics.Encode(&buf, []openmetrics.Family{{
+			Name: "synnax_channel_value",
+			Help: "Line one\nline two \\",
+			Type: openmetrics.TypeGauge,
+			Samples: []openmetrics.Sample{{
+				Labels: []openmetrics.Label{{Name: "channel", Value: "a \"quoted\"\nname"}},
+				Value:  2,
+			}},
+		}}, false)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`# HELP synnax_channel_value Line one\nline two \\`))
+		Expect(buf.String()).To(ContainSubstring(`{channel="a \"quoted\"\nname"} 2`))
+	})
+
+	DescribeTable("Should encode special float values", func(v float64, expected string) {
+		var buf bytes.Buffer
+		Expect(openmetrics.Encode(&buf, []openmetrics.Family{
+			openmetrics.Gauge("synnax_value", "Value.", v),
+		}, false)).To(Succeed())
+		Expect(buf.String()).To(HaveSuffix("synnax_value " + expected + "\n"))
+	},
+		Entry("NaN", math.NaN(), "NaN"),
+		Entry("positive infinity", math.Inf(1), "+Inf"),
+		Entry("negative infinity", math.Inf(-1), "-Inf"),
+		Entry("large integers", 1e21, "1e+21"),
+		Entry("negative values", -2.25, "-2.25"),
+	)
+})
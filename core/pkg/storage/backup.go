@@ -0,0 +1,258 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package storage
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/pebble/v2"
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	xfs "github.com/synnaxlabs/x/io/fs"
+	"github.com/synnaxlabs/x/override"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+const (
+	kvDirname = "kv"
+	tsDirname = "cesium"
+	// backupStagingPattern is the pattern for the directories that backups are staged
+	// in before being archived.
+	backupStagingPattern = ".backup-*"
+)
+
+// ErrInvalidArchive is returned by Restore when the provided archive was not written by
+// Layer.Backup.
+var ErrInvalidArchive = errors.New("[storage] - invalid backup archive")
+
+// Backup writes a gzip-compressed tar archive containing a consistent copy of the
+// storage layer to w. Backup can be called while the layer is in use, and writers may
+// continue to commit data while it runs. The archive can be extracted with Restore to
+// create a storage directory that a new node can be started from.
+//
+// The key-value store is captured before the time-series engine, so the archive may
+// contain time-series data committed after the key-value store was captured, but never
+// the reverse.
+//
+// Backup is not supported for in-memory storage layers.
+func (s *Layer) Backup(ctx context.Context, w io.Writer) (err error) {
+	if *s.cfg.InMemory {
+		return errors.New("[storage] - cannot back up an in-memory storage layer")
+	}
+	ctx, span := s.cfg.T.Bench(ctx, "backup")
+	defer func() { err = span.EndWith(err) }()
+	// The backup is staged in the storage directory so that pebble can hard-link its
+	// immutable files instead of copying them.
+	staging, err := os.MkdirTemp(s.cfg.Dirname, backupStagingPattern)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Combine(err, os.RemoveAll(staging)) }()
+	s.cfg.L.Info("backing up key-value store")
+	if err = s.kvDB.Checkpoint(
+		filepath.Join(staging, kvDirname),
+		pebble.WithFlushedWAL(),
+	); err != nil {
+		return errors.Wrap(err, "[storage] - failed to checkpoint key-value store")
+	}
+	s.cfg.L.Info("backing up time-series data")
+	tsFS, err := xfs.Default.Sub(filepath.Join(staging, tsDirname))
+	if err != nil {
+		return err
+	}
+	if err = s.TS.Backup(ctx, tsFS); err != nil {
+		return errors.Wrap(err, "[storage] - failed to back up time-series data")
+	}
+	s.cfg.L.Info("writing backup archive")
+	return writeArchive(ctx, staging, w)
+}
+
+func writeArchive(ctx context.Context, dirname string, w io.Writer) (err error) {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	defer func() {
+		err = errors.Combine(err, tw.Close())
+		err = errors.Combine(err, gw.Close())
+	}()
+	return filepath.WalkDir(dirname, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dirname, name)
+		if err != nil || rel == "." {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if d.IsDir() {
+			hdr.Name += "/"
+		}
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(tw, f)
+		return errors.Combine(err, f.Close())
+	})
+}
+
+// removeBackupStaging removes directories left behind by backups that were
+// interrupted.
+func removeBackupStaging(dirname string) error {
+	matches, err := filepath.Glob(filepath.Join(dirname, backupStagingPattern))
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		if err = os.RemoveAll(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreConfig is the configuration for restoring a storage directory from a backup.
+type RestoreConfig struct {
+	alamos.Instrumentation
+	// Dirname is the storage directory to restore the backup into. The directory must
+	// be empty or not exist.
+	// [REQUIRED]
+	Dirname string
+	// Perm is the file permissions to use for the storage directory.
+	// [OPTIONAL] - Defaults to OS_USER_RWX
+	Perm fs.FileMode
+}
+
+var (
+	_ config.Config[RestoreConfig] = RestoreConfig{}
+	// DefaultRestoreConfig is the default configuration for restoring a backup.
+	DefaultRestoreConfig = RestoreConfig{Perm: xfs.UserRWX}
+)
+
+// Override implements config.Config.
+func (cfg RestoreConfig) Override(other RestoreConfig) RestoreConfig {
+	cfg.Instrumentation = override.Zero(cfg.Instrumentation, other.Instrumentation)
+	cfg.Dirname = override.String(cfg.Dirname, other.Dirname)
+	cfg.Perm = override.Numeric(cfg.Perm, other.Perm)
+	return cfg
+}
+
+// Validate implements config.Config.
+func (cfg RestoreConfig) Validate() error {
+	v := validate.New("storage.restore")
+	validate.NotEmptyString(v, "dirname", cfg.Dirname)
+	v.Ternary("permissions", cfg.Perm == 0, "insufficient permission bits on directory")
+	return v.Error()
+}
+
+// Restore extracts an archive written by Layer.Backup into a storage directory. If
+// the archive is invalid, Restore returns an error wrapping ErrInvalidArchive, and the
+// contents of the directory are undefined.
+func Restore(r io.Reader, cfgs ...RestoreConfig) error {
+	cfg, err := config.New(DefaultRestoreConfig, cfgs...)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(cfg.Dirname, cfg.Perm); err != nil {
+		return errors.Wrapf(err, "failed to create storage directory %s", cfg.Dirname)
+	}
+	entries, err := os.ReadDir(cfg.Dirname)
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return errors.Newf("[storage] - cannot restore into non-empty directory %s", cfg.Dirname)
+	}
+	cfg.L.Info("restoring backup", zap.String("dirname", cfg.Dirname))
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Wrap(ErrInvalidArchive, err.Error())
+	}
+	if err = extractArchive(tar.NewReader(gr), cfg); err != nil {
+		return err
+	}
+	for _, name := range []string{kvDirname, tsDirname} {
+		if _, err = os.Stat(filepath.Join(cfg.Dirname, name)); err != nil {
+			return errors.Wrapf(ErrInvalidArchive, "archive does not contain %s", name)
+		}
+	}
+	return gr.Close()
+}
+
+func extractArchive(tr *tar.Reader, cfg RestoreConfig) error {
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(ErrInvalidArchive, err.Error())
+		}
+		name := path.Clean(hdr.Name)
+		root, _, _ := strings.Cut(name, "/")
+		if !fs.ValidPath(name) || (root != kvDirname && root != tsDirname) {
+			return errors.Wrapf(ErrInvalidArchive, "unexpected entry %s", hdr.Name)
+		}
+		target := filepath.Join(cfg.Dirname, filepath.FromSlash(name))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(target, cfg.Perm); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err = extractFile(tr, target, cfg.Perm); err != nil {
+				return err
+			}
+		default:
+			return errors.Wrapf(ErrInvalidArchive, "unexpected entry type for %s", hdr.Name)
+		}
+	}
+}
+
+func extractFile(r io.Reader, target string, perm fs.FileMode) (err error) {
+	if err = os.MkdirAll(filepath.Dir(target), perm); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, xfs.UserRW)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Combine(err, f.Close()) }()
+	if _, err = io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package storage_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/cesium"
+	"github.com/synnaxlabs/synnax/pkg/storage"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Backup", func() {
+	ShouldNotLeakGoroutinesPerSpec()
+	var (
+		tempDir string
+		l       *storage.Layer
+		index   cesium.ChannelKey = 1
+		data    cesium.ChannelKey = 2
+	)
+	BeforeEach(func(ctx SpecContext) {
+		tempDir = MustSucceed(os.MkdirTemp("", "synnax-test"))
+		DeferCleanup(func() { Expect(os.RemoveAll(tempDir)).To(Succeed()) })
+		l = MustSucceed(storage.OpenLayer(ctx, storage.LayerConfig{
+			Dirname: filepath.Join(tempDir, "storage"),
+		}))
+		DeferCleanup(func() { Expect(l.Close()).To(Succeed()) })
+		Expect(l.KV.Set(ctx, []byte("key"), []byte("value"))).To(Succeed())
+		Expect(l.TS.CreateChannel(
+			ctx,
+			cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+			cesium.Channel{Key: data, Name: "data", DataType: telem.Int64T, Index: index},
+		)).To(Succeed())
+	})
+
+	It("Should back up and restore a storage layer while it is being written to", func(ctx SpecContext) {
+		w := MustSucceed(l.TS.OpenWriter(ctx, cesium.WriterConfig{
+			Channels: []cesium.ChannelKey{index, data},
+			Start:    10 * telem.SecondTS,
+		}))
+		MustSucceed(w.Write(telem.MultiFrame(
+			[]cesium.ChannelKey{index, data},
+			[]telem.Series{
+				telem.NewSeriesSecondsTSV(10, 11, 12),
+				telem.NewSeriesV[int64](1, 2, 3),
+			},
+		)))
+		MustSucceed(w.Commit())
+
+		var buf bytes.Buffer
+		Expect(l.Backup(ctx, &buf)).To(Succeed())
+		MustSucceed(w.Write(telem.MultiFrame(
+			[]cesium.ChannelKey{index, data},
+			[]telem.Series{
+				telem.NewSeriesSecondsTSV(13, 14),
+				telem.NewSeriesV[int64](4, 5),
+			},
+		)))
+		MustSucceed(w.Commit())
+		Expect(w.Close()).To(Succeed())
+		Expect(l.KV.Set(ctx, []byte("key"), []byte("changed"))).To(Succeed())
+
+		By("Removing the staging directory")
+		Expect(filepath.Glob(filepath.Join(tempDir, "storage", ".backup-*"))).To(BeEmpty())
+
+		By("Restoring the backup into a new directory")
+		cfg := storage.LayerConfig{Dirname: filepath.Join(tempDir, "restored")}
+		Expect(storage.Restore(&buf, storage.RestoreConfig{Dirname: cfg.Dirname})).To(Succeed())
+		restored := MustSucceed(storage.OpenLayer(ctx, cfg))
+		v, closer := MustSucceed2(restored.KV.Get(ctx, []byte("key")))
+		Expect(v).To(Equal([]byte("value")))
+		Expect(closer.Close()).To(Succeed())
+		frame := MustSucceed(restored.TS.Read(ctx, telem.TimeRangeMax, data))
+		Expect(frame.Get(data).Series).To(HaveLen(1))
+		Expect(frame.Get(data).Series[0]).To(telem.MatchSeriesDataV[int64](1, 2, 3))
+		Expect(restored.Close()).To(Succeed())
+		Expect(MustSucceed(storage.Check(storage.CheckConfig{Dirname: cfg.Dirname})).Healthy()).
+			To(BeTrue())
+	})
+
+	Describe("Restore", func() {
+		It("Should return an error if the directory is not empty", func(ctx SpecContext) {
+			var buf bytes.Buffer
+			Expect(l.Backup(ctx, &buf)).To(Succeed())
+			dirname := filepath.Join(tempDir, "restored")
+			Expect(os.MkdirAll(filepath.Join(dirname, "existing"), 0o700)).To(Succeed())
+			Expect(storage.Restore(&buf, storage.RestoreConfig{Dirname: dirname})).
+				To(MatchError(ContainSubstring("non-empty directory")))
+		})
+
+		It("Should return an error if the archive is not a backup", func() {
+			Expect(storage.Restore(
+				bytes.NewReader([]byte("not an archive")),
+				storage.RestoreConfig{Dirname: filepath.Join(tempDir, "restored")},
+			)).To(MatchError(storage.ErrInvalidArchive))
+		})
+
+		It("Should not extract entries outside of the storage directory", func() {
+			var buf bytes.Buffer
+			gw := gzip.NewWriter(&buf)
+			tw := tar.NewWriter(gw)
+			contents := []byte("escaped")
+			Expect(tw.WriteHeader(&tar.Header{
+				Name:     "kv/../../escaped",
+				Typeflag: tar.TypeReg,
+				Mode:     0o600,
+				Size:     int64(len(contents)),
+			})).To(Succeed())
+			MustSucceed(tw.Write(contents))
+			Expect(tw.Close()).To(Succeed())
+			Expect(gw.Close()).To(Succeed())
+			Expect(storage.Restore(
+				&buf,
+				storage.RestoreConfig{Dirname: filepath.Join(tempDir, "restored")},
+			)).To(MatchError(storage.ErrInvalidArchive))
+			Expect(filepath.Join(tempDir, "escaped")).ToNot(BeAnExistingFile())
+		})
+	})
+})
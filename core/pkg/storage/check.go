@@ -0,0 +1,155 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble/v2"
+	"github.com/cockroachdb/pebble/v2/vfs"
+	"github.com/synnaxlabs/alamos"
+	"github.com/synnaxlabs/cesium"
+	"github.com/synnaxlabs/x/config"
+	"github.com/synnaxlabs/x/errors"
+	xfs "github.com/synnaxlabs/x/io/fs"
+	"github.com/synnaxlabs/x/kv/pebblekv"
+	"github.com/synnaxlabs/x/override"
+	"github.com/synnaxlabs/x/validate"
+	"go.uber.org/zap"
+)
+
+// CheckConfig is the configuration for checking the integrity of a storage directory.
+type CheckConfig struct {
+	alamos.Instrumentation
+	// Dirname is the storage directory to check. It must have been written to by a
+	// storage Layer, and no Layer may have it open while it is being checked.
+	// [REQUIRED]
+	Dirname string
+	// Repair sets whether corrupt time-series data should be removed so that the
+	// storage Layer can be opened again. The key-value store cannot be repaired.
+	// [OPTIONAL] - Defaults to false.
+	Repair *bool
+}
+
+var (
+	_ config.Config[CheckConfig] = CheckConfig{}
+	// DefaultCheckConfig is the default configuration for checking a storage directory.
+	DefaultCheckConfig = CheckConfig{Repair: new(false)}
+)
+
+// Override implements config.Config.
+func (cfg CheckConfig) Override(other CheckConfig) CheckConfig {
+	cfg.Instrumentation = override.Zero(cfg.Instrumentation, other.Instrumentation)
+	cfg.Dirname = override.String(cfg.Dirname, other.Dirname)
+	cfg.Repair = override.Nil(cfg.Repair, other.Repair)
+	return cfg
+}
+
+// Validate implements config.Config.
+func (cfg CheckConfig) Validate() error {
+	v := validate.New("storage.check")
+	validate.NotEmptyString(v, "dirname", cfg.Dirname)
+	validate.NotNil(v, "repair", cfg.Repair)
+	return v.Error()
+}
+
+// KVCheckReport summarizes the results of checking the key-value store.
+type KVCheckReport struct {
+	// Points is the number of point keys that were verified.
+	Points int64
+	// Err is the corruption found in the key-value store, if any.
+	Err error
+}
+
+// Healthy returns true if no corruption was found in the key-value store.
+func (r KVCheckReport) Healthy() bool { return r.Err == nil }
+
+// CheckReport summarizes the results of checking a storage directory.
+type CheckReport struct {
+	// KV is the report for the key-value store.
+	KV KVCheckReport
+	// TS is the report for the time-series engine.
+	TS cesium.CheckReport
+}
+
+// Healthy returns true if no corruption was found in either storage engine.
+func (r CheckReport) Healthy() bool { return r.KV.Healthy() && r.TS.Healthy() }
+
+// Check verifies the integrity of the data stored in a storage directory. Every
+// sstable in the key-value store is read and checked against its block checksums, and
+// every domain of time-series data is checked against the checksum recorded when it
+// was written.
+//
+// Check acquires the same exclusive lock on the directory as OpenLayer, and returns an
+// error if the lock cannot be acquired. Corruption does not cause Check to return an
+// error, and is instead recorded in the returned CheckReport.
+func Check(cfgs ...CheckConfig) (report CheckReport, err error) {
+	cfg, err := config.New(DefaultCheckConfig, cfgs...)
+	if err != nil {
+		return report, err
+	}
+	if _, err = os.Stat(cfg.Dirname); err != nil {
+		return report, errors.Wrapf(err, "failed to access storage directory %s", cfg.Dirname)
+	}
+	lock, err := acquireLock(LayerConfig{Dirname: cfg.Dirname}, vfs.Default)
+	if err != nil {
+		return report, err
+	}
+	defer func() { err = errors.Combine(err, lock.Close()) }()
+	if report.KV, err = checkKV(cfg); err != nil {
+		return report, err
+	}
+	cfg.L.Info("checking time-series data", zap.Bool("repair", *cfg.Repair))
+	report.TS, err = cesium.Check(
+		filepath.Join(cfg.Dirname, tsDirname),
+		*cfg.Repair,
+		cesium.WithFS(xfs.Default),
+		cesium.WithInstrumentation(cfg.Child("ts")),
+	)
+	return report, err
+}
+
+func checkKV(cfg CheckConfig) (report KVCheckReport, err error) {
+	dirname := filepath.Join(cfg.Dirname, kvDirname)
+	cfg.L.Info("checking key-value store", zap.String("dirname", dirname))
+	requiresMigration, err := pebblekv.RequiresMigration(dirname, vfs.Default)
+	if err != nil {
+		return report, err
+	}
+	if requiresMigration {
+		return report, errors.Newf(
+			"[storage] - key-value store in %s must be migrated by starting a node before it can be checked",
+			dirname,
+		)
+	}
+	logger := pebblekv.NewLogger(cfg.Child("kv"))
+	db, err := pebble.Open(dirname, &pebble.Options{
+		FS:       vfs.Default,
+		Logger:   logger,
+		ReadOnly: true,
+	})
+	if err != nil {
+		if pebble.IsCorruptionError(err) {
+			report.Err = err
+			return report, nil
+		}
+		return report, errors.Wrapf(
+			err,
+			"[storage] - failed to open key-value store in %s",
+			dirname,
+		)
+	}
+	defer func() { err = errors.Combine(err, db.Close()) }()
+	var stats pebble.CheckLevelsStats
+	report.Err = db.CheckLevels(&stats)
+	report.Points = stats.NumPoints
+	return report, nil
+}
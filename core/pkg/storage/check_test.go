@@ -0,0 +1,98 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package storage_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/cesium"
+	"github.com/synnaxlabs/synnax/pkg/storage"
+	"github.com/synnaxlabs/x/telem"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Check", func() {
+	ShouldNotLeakGoroutinesPerSpec()
+	var (
+		tempDir string
+		cfg     storage.LayerConfig
+		index   cesium.ChannelKey = 1
+		data    cesium.ChannelKey = 2
+	)
+	BeforeEach(func(ctx SpecContext) {
+		tempDir = MustSucceed(os.MkdirTemp("", "synnax-test"))
+		DeferCleanup(func() { Expect(os.RemoveAll(tempDir)).To(Succeed()) })
+		cfg = storage.LayerConfig{Dirname: filepath.Join(tempDir, "storage")}
+		l := MustSucceed(storage.OpenLayer(ctx, cfg))
+		Expect(l.KV.Set(ctx, []byte("key"), []byte("value"))).To(Succeed())
+		Expect(l.TS.CreateChannel(
+			ctx,
+			cesium.Channel{Key: index, Name: "time", DataType: telem.TimeStampT, IsIndex: true},
+			cesium.Channel{Key: data, Name: "data", DataType: telem.Int64T, Index: index},
+		)).To(Succeed())
+		Expect(l.TS.Write(ctx, 10*telem.SecondTS, telem.MultiFrame(
+			[]cesium.ChannelKey{index, data},
+			[]telem.Series{
+				telem.NewSeriesSecondsTSV(10, 11, 12),
+				telem.NewSeriesV[int64](1, 2, 3),
+			},
+		))).To(Succeed())
+		Expect(l.Close()).To(Succeed())
+	})
+
+	It("Should report a healthy storage directory", func() {
+		report := MustSucceed(storage.Check(storage.CheckConfig{Dirname: cfg.Dirname}))
+		Expect(report.Healthy()).To(BeTrue())
+		Expect(report.KV.Points).To(BeNumerically(">", 0))
+		Expect(report.TS.Channels).To(HaveLen(2))
+	})
+
+	It("Should report and repair corrupt time-series data", func(ctx SpecContext) {
+		name := filepath.Join(cfg.Dirname, "cesium", strconv.Itoa(int(data)), "1.domain")
+		f := MustSucceed(os.OpenFile(name, os.O_RDWR, 0))
+		MustSucceed(f.WriteAt([]byte{0xFF}, 0))
+		Expect(f.Close()).To(Succeed())
+
+		report := MustSucceed(storage.Check(storage.CheckConfig{Dirname: cfg.Dirname}))
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.KV.Healthy()).To(BeTrue())
+		Expect(report.TS.Channels[1].Key).To(Equal(data))
+		Expect(report.TS.Channels[1].Corrupt).To(HaveLen(1))
+		Expect(report.TS.Channels[1].Corrupt[0].Err).To(MatchError(cesium.ErrChecksumMismatch))
+
+		report = MustSucceed(storage.Check(storage.CheckConfig{
+			Dirname: cfg.Dirname,
+			Repair:  new(true),
+		}))
+		Expect(report.TS.Channels[1].Repaired).To(BeTrue())
+		Expect(MustSucceed(storage.Check(storage.CheckConfig{Dirname: cfg.Dirname})).Healthy()).
+			To(BeTrue())
+		l := MustSucceed(storage.OpenLayer(ctx, cfg))
+		v, closer := MustSucceed2(l.KV.Get(ctx, []byte("key")))
+		Expect(v).To(Equal([]byte("value")))
+		Expect(closer.Close()).To(Succeed())
+		Expect(l.Close()).To(Succeed())
+	})
+
+	It("Should return an error if the storage directory is in use", func(ctx SpecContext) {
+		MustOpen(storage.OpenLayer(ctx, cfg))
+		Expect(storage.Check(storage.CheckConfig{Dirname: cfg.Dirname})).Error().
+			To(MatchError(ContainSubstring("Failed to acquire lock")))
+	})
+
+	It("Should return an error if the storage directory does not exist", func() {
+		Expect(storage.Check(storage.CheckConfig{Dirname: filepath.Join(tempDir, "missing")})).
+			Error().To(HaveOccurred())
+	})
+})
@@ -162,6 +162,10 @@ type Layer struct {
 	KV kv.DB
 	// TS is the time-series engine for the node.
 	TS *cesium.DB
+	// cfg is the configuration the layer was opened with.
+	cfg LayerConfig
+	// kvDB is the pebble database underlying KV, used for taking checkpoints.
+	kvDB *pebble.DB
 	// closer is used for shutting down the storage layer.
 	closer xio.MultiCloser
 }
@@ -183,7 +187,7 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 	if err != nil {
 		return nil, err
 	}
-	l = &Layer{}
+	l = &Layer{cfg: cfg}
 	cleanup, ok := service.NewOpener(ctx, &l.closer)
 	defer func() {
 		err = cleanup(err)
@@ -214,13 +218,24 @@ func OpenLayer(ctx context.Context, cfgs ...LayerConfig) (l *Layer, err error) {
 		return nil, err
 	}
 
+	// Clean up after any backups that were interrupted by the node shutting down.
+	if !*cfg.InMemory {
+		if err = removeBackupStaging(cfg.Dirname); err != nil {
+			return nil, err
+		}
+	}
+
 	cache, cacheCloser, err := openPebbleCache(cfg)
 	if !ok(err, cacheCloser) {
 		return nil, err
 	}
 
 	// Open the key-value storage engine.
-	if l.KV, err = openKV(cfg, kvFS, cache); !ok(err, l.KV) {
+	if l.kvDB, err = openKV(cfg, kvFS, cache); !ok(err, nil) {
+		return nil, err
+	}
+	l.KV = pebblekv.Wrap(l.kvDB, pebblekv.DisableObservation())
+	if !ok(nil, l.KV) {
 		return nil, err
 	}
 
@@ -335,12 +350,12 @@ func openPebbleCache(cfg LayerConfig) (*pebble.Cache, io.Closer, error) {
 	}), nil
 }
 
-func openKV(cfg LayerConfig, fs vfs.FS, cache *pebble.Cache) (kv.DB, error) {
+func openKV(cfg LayerConfig, fs vfs.FS, cache *pebble.Cache) (*pebble.DB, error) {
 	if cfg.KVEngine != KVEnginePebble {
 		return nil, errors.Newf("[storage] - unsupported key-value engine: %s", cfg.KVEngine)
 	}
 	ins := cfg.Child("kv")
-	dirname := filepath.Join(cfg.Dirname, "kv")
+	dirname := filepath.Join(cfg.Dirname, kvDirname)
 	requiresMigration, err := pebblekv.RequiresMigration(dirname, fs)
 	if err != nil {
 		return nil, err
@@ -399,7 +414,7 @@ func openKV(cfg LayerConfig, fs vfs.FS, cache *pebble.Cache) (kv.DB, error) {
 			dirname,
 		)
 	}
-	return pebblekv.Wrap(db, pebblekv.DisableObservation()), err
+	return db, nil
 }
 
 func openTS(ctx context.Context, cfg LayerConfig, fs xfs.FS) (*ts.DB, error) {
@@ -408,7 +423,7 @@ func openTS(ctx context.Context, cfg LayerConfig, fs xfs.FS) (*ts.DB, error) {
 	}
 	return ts.Open(ctx, ts.Config{
 		Instrumentation: cfg.Child("ts"),
-		Dirname:         filepath.Join(cfg.Dirname, "cesium"),
+		Dirname:         filepath.Join(cfg.Dirname, tsDirname),
 		FS:              fs,
 	})
 }
@@ -25,6 +25,7 @@ type (
 	Frame            = cesium.Frame
 	Channel          = cesium.Channel
 	ChannelKey       = cesium.ChannelKey
+	Retention        = cesium.Retention
 	WriterConfig     = cesium.WriterConfig
 	Writer           = cesium.Writer
 	WriterMode       = cesium.WriterMode
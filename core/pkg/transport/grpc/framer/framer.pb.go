@@ -476,6 +476,7 @@ type StreamerRequest struct {
 	DownsampleFactor int32                  `protobuf:"varint,2,opt,name=downsample_factor,json=downsampleFactor,proto3" json:"downsample_factor,omitempty"`
 	ThrottleRateHz   float64                `protobuf:"fixed64,4,opt,name=throttle_rate_hz,json=throttleRateHz,proto3" json:"throttle_rate_hz,omitempty"`
 	ExcludeGroups    []uint32               `protobuf:"varint,5,rep,packed,name=exclude_groups,json=excludeGroups,proto3" json:"exclude_groups,omitempty"`
+	DownsampleMode   string                 `protobuf:"bytes,6,opt,name=downsample_mode,json=downsampleMode,proto3" json:"downsample_mode,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -538,6 +539,13 @@ func (x *StreamerRequest) GetExcludeGroups() []uint32 {
 	return nil
 }
 
+func (x *StreamerRequest) GetDownsampleMode() string {
+	if x != nil {
+		return x.DownsampleMode
+	}
+	return ""
+}
+
 type StreamerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Frame         *pb.Frame              `protobuf:"bytes,1,opt,name=frame,proto3" json:"frame,omitempty"`
@@ -693,12 +701,13 @@ const file_core_pkg_transport_grpc_framer_framer_proto_rawDesc = "" +
 	"\bnode_key\x18\x02 \x01(\x05R\anodeKey\x12\x18\n" +
 	"\acounter\x18\x03 \x01(\x05R\acounter\x12'\n" +
 	"\x05error\x18\x04 \x01(\v2\x11.errors.PBPayloadR\x05error\x12\x10\n" +
-	"\x03end\x18\x05 \x01(\x03R\x03end\"\xa3\x01\n" +
+	"\x03end\x18\x05 \x01(\x03R\x03end\"\xcc\x01\n" +
 	"\x0fStreamerRequest\x12\x12\n" +
 	"\x04keys\x18\x01 \x03(\rR\x04keys\x12+\n" +
 	"\x11downsample_factor\x18\x02 \x01(\x05R\x10downsampleFactor\x12(\n" +
 	"\x10throttle_rate_hz\x18\x04 \x01(\x01R\x0ethrottleRateHz\x12%\n" +
-	"\x0eexclude_groups\x18\x05 \x03(\rR\rexcludeGroups\"S\n" +
+	"\x0eexclude_groups\x18\x05 \x03(\rR\rexcludeGroups\x12'\n" +
+	"\x0fdownsample_mode\x18\x06 \x01(\tR\x0edownsampleMode\"S\n" +
 	"\x10StreamerResponse\x12'\n" +
 	"\x05frame\x18\x01 \x01(\v2\x11.x.telem.pb.FrameR\x05frame\x12\x16\n" +
 	"\x06buffer\x18\x02 \x01(\fR\x06buffer\"h\n" +
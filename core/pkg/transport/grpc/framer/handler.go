@@ -286,6 +286,7 @@ func (frameStreamerRequestTranslator) Forward(
 	return &StreamerRequest{
 		Keys:             msg.Keys.Uint32(),
 		DownsampleFactor: int32(msg.DownsampleFactor),
+		DownsampleMode:   string(msg.DownsampleMode),
 		ThrottleRateHz:   float64(msg.ThrottleRate),
 		ExcludeGroups:    msg.ExcludeGroups,
 	}, nil
@@ -298,6 +299,7 @@ func (t frameStreamerRequestTranslator) Backward(
 	rq := framer.StreamerRequest{
 		Keys:             channel.KeysFromUint32(msg.Keys),
 		DownsampleFactor: int(msg.DownsampleFactor),
+		DownsampleMode:   telem.DownsampleMode(msg.DownsampleMode),
 		ThrottleRate:     telem.Rate(msg.ThrottleRateHz),
 		ExcludeGroups:    msg.ExcludeGroups,
 	}
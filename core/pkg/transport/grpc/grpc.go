@@ -16,9 +16,13 @@ import (
 	"github.com/synnaxlabs/freighter/noop"
 	"github.com/synnaxlabs/synnax/pkg/api"
 	"github.com/synnaxlabs/synnax/pkg/api/access"
+	"github.com/synnaxlabs/synnax/pkg/api/apikey"
 	apiarc "github.com/synnaxlabs/synnax/pkg/api/arc"
+	"github.com/synnaxlabs/synnax/pkg/api/audit"
 	apiauth "github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/api/backup"
 	apichannel "github.com/synnaxlabs/synnax/pkg/api/channel"
+	apiframer "github.com/synnaxlabs/synnax/pkg/api/framer"
 	"github.com/synnaxlabs/synnax/pkg/api/group"
 	"github.com/synnaxlabs/synnax/pkg/api/imex"
 	"github.com/synnaxlabs/synnax/pkg/api/label"
@@ -26,6 +30,7 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/api/log"
 	"github.com/synnaxlabs/synnax/pkg/api/ontology"
 	"github.com/synnaxlabs/synnax/pkg/api/schematic"
+	"github.com/synnaxlabs/synnax/pkg/api/session"
 	"github.com/synnaxlabs/synnax/pkg/api/table"
 	"github.com/synnaxlabs/synnax/pkg/api/user"
 	"github.com/synnaxlabs/synnax/pkg/api/workspace"
@@ -69,11 +74,22 @@ func Bind(layer *api.Layer, channelSvc *distchannel.Service) []grpc.BindableTran
 
 	// AUTH
 	t.AuthChangePassword = noop.UnaryServer[apiauth.ChangePasswordRequest, types.Nil]{}
+	t.AuthOIDCAuthorize = noop.UnaryServer[apiauth.OIDCAuthorizeRequest, apiauth.OIDCAuthorizeResponse]{}
+	t.AuthOIDCLogin = noop.UnaryServer[apiauth.OIDCLoginRequest, apiauth.LoginResponse]{}
+	t.AuthLogout = noop.UnaryServer[apiauth.LogoutRequest, types.Nil]{}
+
+	// SESSION
+	t.SessionRetrieve = noop.UnaryServer[session.RetrieveRequest, session.RetrieveResponse]{}
+	t.SessionRevoke = noop.UnaryServer[session.RevokeRequest, types.Nil]{}
 
 	// CHANNEL
 	t.ChannelRename = noop.UnaryServer[apichannel.RenameRequest, types.Nil]{}
+	t.ChannelTransferLease = noop.UnaryServer[apichannel.TransferLeaseRequest, types.Nil]{}
 	t.ChannelRetrieveGroup = noop.UnaryServer[apichannel.RetrieveGroupRequest, apichannel.RetrieveGroupResponse]{}
 
+	// FRAME
+	t.FrameAggregate = noop.UnaryServer[apiframer.AggregateRequest, apiframer.AggregateResponse]{}
+
 	// USER
 	t.UserRename = noop.UnaryServer[user.RenameRequest, types.Nil]{}
 	t.UserChangeUsername = noop.UnaryServer[user.ChangeUsernameRequest, types.Nil]{}
@@ -81,6 +97,17 @@ func Bind(layer *api.Layer, channelSvc *distchannel.Service) []grpc.BindableTran
 	t.UserDelete = noop.UnaryServer[user.DeleteRequest, types.Nil]{}
 	t.UserRetrieve = noop.UnaryServer[user.RetrieveRequest, user.RetrieveResponse]{}
 
+	// SERVICE ACCOUNT
+	t.ServiceAccountCreate = noop.UnaryServer[apikey.ServiceAccountCreateRequest, apikey.ServiceAccountCreateResponse]{}
+	t.ServiceAccountRetrieve = noop.UnaryServer[apikey.ServiceAccountRetrieveRequest, apikey.ServiceAccountRetrieveResponse]{}
+	t.ServiceAccountDelete = noop.UnaryServer[apikey.ServiceAccountDeleteRequest, types.Nil]{}
+
+	// API KEY
+	t.APIKeyCreate = noop.UnaryServer[apikey.CreateRequest, apikey.CreateResponse]{}
+	t.APIKeyRetrieve = noop.UnaryServer[apikey.RetrieveRequest, apikey.RetrieveResponse]{}
+	t.APIKeyRotate = noop.UnaryServer[apikey.RotateRequest, apikey.RotateResponse]{}
+	t.APIKeyDelete = noop.UnaryServer[apikey.DeleteRequest, types.Nil]{}
+
 	// ONTOLOGY
 	t.OntologyRetrieve = noop.UnaryServer[ontology.RetrieveRequest, ontology.RetrieveResponse]{}
 	t.OntologyAddChildren = noop.UnaryServer[ontology.AddChildrenRequest, types.Nil]{}
@@ -160,6 +187,22 @@ func Bind(layer *api.Layer, channelSvc *distchannel.Service) []grpc.BindableTran
 	// ARC LSP
 	t.ArcLSP = noop.StreamServer[apiarc.LSPMessage, apiarc.LSPMessage]{}
 
+	// ARC TEST
+	t.ArcTest = noop.UnaryServer[apiarc.TestRequest, apiarc.TestResponse]{}
+
+	// ARC LIBRARY
+	t.ArcLibraryCreate = noop.UnaryServer[apiarc.CreateLibraryRequest, apiarc.CreateLibraryResponse]{}
+	t.ArcLibraryDelete = noop.UnaryServer[apiarc.DeleteLibraryRequest, types.Nil]{}
+	t.ArcLibraryRetrieve = noop.UnaryServer[apiarc.RetrieveLibraryRequest, apiarc.RetrieveLibraryResponse]{}
+
+	// BACKUP
+	t.BackupCreate = noop.StreamServer[backup.Request, backup.Response]{}
+
+	// AUDIT
+	t.AuditRetrieve = noop.UnaryServer[audit.RetrieveRequest, audit.RetrieveResponse]{}
+	t.AuditExport = noop.UnaryServer[audit.ExportRequest, audit.ExportResponse]{}
+	t.AuditVerify = noop.UnaryServer[audit.VerifyRequest, audit.VerifyResponse]{}
+
 	layer.BindTo(t)
 	return transports
 }
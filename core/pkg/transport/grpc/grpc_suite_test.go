@@ -48,5 +48,6 @@ var _ = BeforeSuite(func(ctx SpecContext) {
 	apiLayer = MustSucceed(api.NewLayer(api.LayerConfig{
 		Service:      svc,
 		Distribution: dist,
+		Storage:      cluster.Nodes[1].Storage,
 	}))
 })
@@ -32,11 +32,13 @@ type (
 	WriterRequest  = framer.WriterRequest
 	WriterResponse = framer.WriterResponse
 
-	IteratorRequest  = framer.IteratorRequest
-	IteratorResponse = framer.IteratorResponse
-	StreamerRequest  = framer.StreamerRequest
-	StreamerResponse = framer.StreamerResponse
-	DeleteRequest    = framer.DeleteRequest
+	IteratorRequest   = framer.IteratorRequest
+	IteratorResponse  = framer.IteratorResponse
+	StreamerRequest   = framer.StreamerRequest
+	StreamerResponse  = framer.StreamerResponse
+	DeleteRequest     = framer.DeleteRequest
+	AggregateRequest  = framer.AggregateRequest
+	AggregateResponse = framer.AggregateResponse
 )
 
 type Codec struct {
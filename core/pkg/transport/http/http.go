@@ -15,8 +15,11 @@ import (
 	"github.com/synnaxlabs/freighter/http"
 	"github.com/synnaxlabs/synnax/pkg/api"
 	"github.com/synnaxlabs/synnax/pkg/api/access"
+	"github.com/synnaxlabs/synnax/pkg/api/apikey"
 	"github.com/synnaxlabs/synnax/pkg/api/arc"
+	"github.com/synnaxlabs/synnax/pkg/api/audit"
 	"github.com/synnaxlabs/synnax/pkg/api/auth"
+	"github.com/synnaxlabs/synnax/pkg/api/backup"
 	"github.com/synnaxlabs/synnax/pkg/api/channel"
 	"github.com/synnaxlabs/synnax/pkg/api/connectivity"
 	"github.com/synnaxlabs/synnax/pkg/api/device"
@@ -31,6 +34,7 @@ import (
 	"github.com/synnaxlabs/synnax/pkg/api/ranger/alias"
 	"github.com/synnaxlabs/synnax/pkg/api/ranger/kv"
 	"github.com/synnaxlabs/synnax/pkg/api/schematic"
+	"github.com/synnaxlabs/synnax/pkg/api/session"
 	"github.com/synnaxlabs/synnax/pkg/api/status"
 	"github.com/synnaxlabs/synnax/pkg/api/table"
 	"github.com/synnaxlabs/synnax/pkg/api/task"
@@ -51,6 +55,13 @@ func Bind(layer *api.Layer, router *http.Router, ch *distchannel.Service) {
 		// AUTH
 		AuthLogin:          http.NewUnaryServer[auth.LoginRequest, auth.LoginResponse](router, "/api/v1/auth/login"),
 		AuthChangePassword: http.NewUnaryServer[auth.ChangePasswordRequest, types.Nil](router, "/api/v1/auth/change-password"),
+		AuthOIDCAuthorize:  http.NewUnaryServer[auth.OIDCAuthorizeRequest, auth.OIDCAuthorizeResponse](router, "/api/v1/auth/oidc/authorize"),
+		AuthOIDCLogin:      http.NewUnaryServer[auth.OIDCLoginRequest, auth.LoginResponse](router, "/api/v1/auth/oidc/login"),
+		AuthLogout:         http.NewUnaryServer[auth.LogoutRequest, types.Nil](router, "/api/v1/auth/logout"),
+
+		// SESSION
+		SessionRetrieve: http.NewUnaryServer[session.RetrieveRequest, session.RetrieveResponse](router, "/api/v1/session/retrieve"),
+		SessionRevoke:   http.NewUnaryServer[session.RevokeRequest, types.Nil](router, "/api/v1/session/revoke"),
 
 		// USER
 		UserRename:         http.NewUnaryServer[user.RenameRequest, types.Nil](router, "/api/v1/user/rename"),
@@ -59,21 +70,34 @@ func Bind(layer *api.Layer, router *http.Router, ch *distchannel.Service) {
 		UserDelete:         http.NewUnaryServer[user.DeleteRequest, types.Nil](router, "/api/v1/user/delete"),
 		UserRetrieve:       http.NewUnaryServer[user.RetrieveRequest, user.RetrieveResponse](router, "/api/v1/user/retrieve"),
 
+		// SERVICE ACCOUNT
+		ServiceAccountCreate:   http.NewUnaryServer[apikey.ServiceAccountCreateRequest, apikey.ServiceAccountCreateResponse](router, "/api/v1/service-account/create"),
+		ServiceAccountRetrieve: http.NewUnaryServer[apikey.ServiceAccountRetrieveRequest, apikey.ServiceAccountRetrieveResponse](router, "/api/v1/service-account/retrieve"),
+		ServiceAccountDelete:   http.NewUnaryServer[apikey.ServiceAccountDeleteRequest, types.Nil](router, "/api/v1/service-account/delete"),
+
+		// API KEY
+		APIKeyCreate:   http.NewUnaryServer[apikey.CreateRequest, apikey.CreateResponse](router, "/api/v1/api-key/create"),
+		APIKeyRetrieve: http.NewUnaryServer[apikey.RetrieveRequest, apikey.RetrieveResponse](router, "/api/v1/api-key/retrieve"),
+		APIKeyRotate:   http.NewUnaryServer[apikey.RotateRequest, apikey.RotateResponse](router, "/api/v1/api-key/rotate"),
+		APIKeyDelete:   http.NewUnaryServer[apikey.DeleteRequest, types.Nil](router, "/api/v1/api-key/delete"),
+
 		// CHANNEL
 		ChannelCreate:        http.NewUnaryServer[channel.CreateRequest, channel.CreateResponse](router, "/api/v1/channel/create"),
 		ChannelRetrieve:      http.NewUnaryServer[channel.RetrieveRequest, channel.RetrieveResponse](router, "/api/v1/channel/retrieve"),
 		ChannelDelete:        http.NewUnaryServer[channel.DeleteRequest, types.Nil](router, "/api/v1/channel/delete"),
 		ChannelRename:        http.NewUnaryServer[channel.RenameRequest, types.Nil](router, "/api/v1/channel/rename"),
+		ChannelTransferLease: http.NewUnaryServer[channel.TransferLeaseRequest, types.Nil](router, "/api/v1/channel/transfer-lease"),
 		ChannelRetrieveGroup: http.NewUnaryServer[channel.RetrieveGroupRequest, channel.RetrieveGroupResponse](router, "/api/v1/channel/retrieve-group"),
 
 		// CONNECTIVITY
 		ConnectivityCheck: http.NewUnaryServer[types.Nil, connectivity.CheckResponse](router, "/api/v1/connectivity/check"),
 
 		// FRAME
-		FrameWriter:   http.NewStreamServer[framer.WriterRequest, framer.WriterResponse](router, "/api/v1/frame/write", framerServerOption),
-		FrameIterator: http.NewStreamServer[framer.IteratorRequest, framer.IteratorResponse](router, "/api/v1/frame/iterate", framerServerOption),
-		FrameStreamer: http.NewStreamServer[framer.StreamerRequest, framer.StreamerResponse](router, "/api/v1/frame/stream", framerServerOption),
-		FrameDelete:   http.NewUnaryServer[framer.DeleteRequest, types.Nil](router, "/api/v1/frame/delete"),
+		FrameWriter:    http.NewStreamServer[framer.WriterRequest, framer.WriterResponse](router, "/api/v1/frame/write", framerServerOption),
+		FrameIterator:  http.NewStreamServer[framer.IteratorRequest, framer.IteratorResponse](router, "/api/v1/frame/iterate", framerServerOption),
+		FrameStreamer:  http.NewStreamServer[framer.StreamerRequest, framer.StreamerResponse](router, "/api/v1/frame/stream", framerServerOption),
+		FrameDelete:    http.NewUnaryServer[framer.DeleteRequest, types.Nil](router, "/api/v1/frame/delete"),
+		FrameAggregate: http.NewUnaryServer[framer.AggregateRequest, framer.AggregateResponse](router, "/api/v1/frame/aggregate"),
 
 		// ONTOLOGY
 		OntologyRetrieve:       http.NewUnaryServer[ontology.RetrieveRequest, ontology.RetrieveResponse](router, "/api/v1/ontology/retrieve"),
@@ -182,10 +206,14 @@ func Bind(layer *api.Layer, router *http.Router, ch *distchannel.Service) {
 		AccessUnassignRole:   http.NewUnaryServer[access.UnassignRoleRequest, types.Nil](router, "/api/v1/access/role/unassign"),
 
 		// ARC
-		ArcCreate:   http.NewUnaryServer[arc.CreateRequest, arc.CreateResponse](router, "/api/v1/arc/create"),
-		ArcDelete:   http.NewUnaryServer[arc.DeleteRequest, types.Nil](router, "/api/v1/arc/delete"),
-		ArcRetrieve: http.NewUnaryServer[arc.RetrieveRequest, arc.RetrieveResponse](router, "/api/v1/arc/retrieve"),
-		ArcLSP:      http.NewStreamServer[arc.LSPMessage, arc.LSPMessage](router, "/api/v1/arc/lsp"),
+		ArcCreate:          http.NewUnaryServer[arc.CreateRequest, arc.CreateResponse](router, "/api/v1/arc/create"),
+		ArcDelete:          http.NewUnaryServer[arc.DeleteRequest, types.Nil](router, "/api/v1/arc/delete"),
+		ArcRetrieve:        http.NewUnaryServer[arc.RetrieveRequest, arc.RetrieveResponse](router, "/api/v1/arc/retrieve"),
+		ArcLSP:             http.NewStreamServer[arc.LSPMessage, arc.LSPMessage](router, "/api/v1/arc/lsp"),
+		ArcTest:            http.NewUnaryServer[arc.TestRequest, arc.TestResponse](router, "/api/v1/arc/test"),
+		ArcLibraryCreate:   http.NewUnaryServer[arc.CreateLibraryRequest, arc.CreateLibraryResponse](router, "/api/v1/arc/library/create"),
+		ArcLibraryDelete:   http.NewUnaryServer[arc.DeleteLibraryRequest, types.Nil](router, "/api/v1/arc/library/delete"),
+		ArcLibraryRetrieve: http.NewUnaryServer[arc.RetrieveLibraryRequest, arc.RetrieveLibraryResponse](router, "/api/v1/arc/library/retrieve"),
 
 		// STATUS
 		StatusSet:            http.NewUnaryServer[status.SetRequest, status.SetResponse](router, "/api/v1/status/set"),
@@ -201,5 +229,13 @@ func Bind(layer *api.Layer, router *http.Router, ch *distchannel.Service) {
 		// IMPORT/EXPORT
 		ImExImport: http.NewUnaryServer[imex.ImportRequest, imex.ImportResponse](router, "/api/v1/import", http.WithRequestDecoders(json.Codec)),
 		ImExExport: http.NewUnaryServer[imex.ExportRequest, imex.ExportResponse](router, "/api/v1/export", http.WithResponseEncoders(json.Codec)),
+
+		// BACKUP
+		BackupCreate: http.NewStreamServer[backup.Request, backup.Response](router, "/api/v1/backup/create"),
+
+		// AUDIT
+		AuditRetrieve: http.NewUnaryServer[audit.RetrieveRequest, audit.RetrieveResponse](router, "/api/v1/audit/retrieve"),
+		AuditExport:   http.NewUnaryServer[audit.ExportRequest, audit.ExportResponse](router, "/api/v1/audit/export"),
+		AuditVerify:   http.NewUnaryServer[audit.VerifyRequest, audit.VerifyResponse](router, "/api/v1/audit/verify"),
 	})
 }
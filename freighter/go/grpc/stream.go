@@ -66,7 +66,7 @@ func (s *StreamServerCore[RQ, RQT, RS, RST]) Handler(
 			attachedInitialMetaData = true
 			if err := stream.SendHeader(metadata.Pairs()); err != nil {
 				if md.Err() != nil {
-					return md, s.handler(md, s.adaptStream(stream))
+					return md, s.handler(md, s.adaptStream(md, stream))
 				}
 				return md, err
 			}
@@ -74,7 +74,7 @@ func (s *StreamServerCore[RQ, RQT, RS, RST]) Handler(
 				Context:  md.Context,
 				Protocol: md.Protocol,
 				Params:   make(freighter.Params),
-			}, s.handler(md, s.adaptStream(stream))
+			}, s.handler(md, s.adaptStream(md, stream))
 		}),
 	)
 	if !attachedInitialMetaData {
@@ -146,6 +146,7 @@ func (s *StreamClient[RQ, RQT, RS, RST]) Stream(
 }
 
 func (s *StreamServerCore[RQ, RQT, RS, RST]) adaptStream(
+	ctx context.Context,
 	stream grpcServerStream[RQT, RST],
 ) freighter.ServerStream[RQ, RS] {
 	var (
@@ -156,6 +157,7 @@ func (s *StreamServerCore[RQ, RQT, RS, RST]) adaptStream(
 		requestTranslator, responseTranslator = s.CreateTranslators()
 	}
 	return &ServerStream[RQ, RQT, RS, RST]{
+		ctx:                ctx,
 		requestTranslator:  requestTranslator,
 		responseTranslator: responseTranslator,
 		internal:           stream,
@@ -174,20 +176,67 @@ func (s *StreamClient[RQ, RQT, RS, RST]) adaptStream(
 
 // ServerStream wraps a grpc stream to implement the freighter.ServerStream interface.
 type ServerStream[RQ, RQT, RS, RST freighter.Payload] struct {
+	// ctx is the context passed on by the middleware chain.
+	ctx                context.Context
 	internal           grpcServerStream[RQT, RST]
 	requestTranslator  Translator[RQ, RQT]
 	responseTranslator Translator[RS, RST]
+	// received is lazily opened by recv when ctx can be canceled independently of the
+	// underlying stream.
+	received chan received[RQT]
+}
+
+type received[RQT freighter.Payload] struct {
+	req RQT
+	err error
 }
 
 // Receive implements the freighter.ClientStream interface.
 func (s *ServerStream[RQ, RQT, RS, RST]) Receive() (req RQ, err error) {
-	tReq, err := s.internal.Recv()
+	tReq, err := s.recv()
 	if err != nil {
-		return req, translateGRPCError(err)
+		return req, err
 	}
 	return s.requestTranslator.Backward(s.internal.Context(), tReq)
 }
 
+// recv receives the next request from the underlying stream. Middleware may derive a
+// context that is canceled before the stream itself is (e.g. when the credentials the
+// stream was opened with are revoked). gRPC only closes a stream once its handler
+// returns, so in that case requests are received in a separate goroutine, and recv
+// returns the cause of the cancellation as soon as the context is canceled so the
+// handler can exit.
+func (s *ServerStream[RQ, RQT, RS, RST]) recv() (req RQT, err error) {
+	sCtx := s.internal.Context()
+	if s.ctx.Done() == sCtx.Done() {
+		req, err = s.internal.Recv()
+		return req, translateGRPCError(err)
+	}
+	if s.received == nil {
+		s.received = make(chan received[RQT])
+		go func() {
+			for {
+				var r received[RQT]
+				r.req, r.err = s.internal.Recv()
+				select {
+				case s.received <- r:
+				case <-sCtx.Done():
+					return
+				}
+				if r.err != nil {
+					return
+				}
+			}
+		}()
+	}
+	select {
+	case r := <-s.received:
+		return r.req, translateGRPCError(r.err)
+	case <-s.ctx.Done():
+		return req, context.Cause(s.ctx)
+	}
+}
+
 // Send implements the freighter.ClientStream interface.
 func (s *ServerStream[RQ, RQT, RS, RST]) Send(res RS) error {
 	tRes, err := s.responseTranslator.Forward(s.internal.Context(), res)
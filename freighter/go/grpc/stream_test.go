@@ -12,6 +12,7 @@ package grpc_test
 import (
 	"context"
 	"net"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -20,6 +21,7 @@ import (
 	v1 "github.com/synnaxlabs/freighter/grpc/v1"
 	"github.com/synnaxlabs/freighter/test"
 	"github.com/synnaxlabs/x/address"
+	"github.com/synnaxlabs/x/errors"
 	. "github.com/synnaxlabs/x/testutil"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -44,10 +46,14 @@ func (s *streamServer) BindTo(reg grpc.ServiceRegistrar) {
 
 var _ = Describe("Stream", Ordered, Serial, func() {
 	var (
-		server     freighter.StreamServer[test.Request, test.Response]
-		client     freighter.StreamClient[test.Request, test.Response]
-		addr       address.Address
-		grpcServer *grpc.Server
+		server freighter.StreamServer[test.Request, test.Response]
+		// cancelServer is kept separate from server, as the shared stream suite adds
+		// middleware to server that fails every request.
+		cancelServer     freighter.StreamServer[test.Request, test.Response]
+		client           freighter.StreamClient[test.Request, test.Response]
+		addr, cancelAddr address.Address
+		grpcServer       *grpc.Server
+		cancelGRPCServer *grpc.Server
 	)
 
 	BeforeAll(func() {
@@ -70,6 +76,23 @@ var _ = Describe("Stream", Ordered, Serial, func() {
 		sServer.BindTo(grpcServer)
 		server = &sServer.StreamServerCore
 
+		cancelLis := MustSucceed(net.Listen("tcp", "localhost:0"))
+		cancelAddr = address.Address(cancelLis.Addr().String())
+		cancelGRPCServer = grpc.NewServer()
+		cServer := &streamServer{
+			StreamServerCore: fgrpc.StreamServerCore[
+				test.Request, *v1.Request,
+				test.Response, *v1.Response,
+			]{
+				RequestTranslator:  requestTranslator{},
+				ResponseTranslator: responseTranslator{},
+				ServiceDesc:        &v1.TestStreamService_ServiceDesc,
+				Internal:           true,
+			},
+		}
+		cServer.BindTo(cancelGRPCServer)
+		cancelServer = &cServer.StreamServerCore
+
 		pool := fgrpc.NewPool(
 			"",
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -94,9 +117,16 @@ var _ = Describe("Stream", Ordered, Serial, func() {
 			defer GinkgoRecover()
 			Expect(grpcServer.Serve(lis)).To(Succeed())
 		}()
+		go func() {
+			defer GinkgoRecover()
+			Expect(cancelGRPCServer.Serve(cancelLis)).To(Succeed())
+		}()
 	})
 
-	AfterAll(func() { grpcServer.GracefulStop() })
+	AfterAll(func() {
+		grpcServer.GracefulStop()
+		cancelGRPCServer.GracefulStop()
+	})
 
 	test.StreamSuite(func() (
 		freighter.StreamServer[test.Request, test.Response],
@@ -105,4 +135,34 @@ var _ = Describe("Stream", Ordered, Serial, func() {
 	) {
 		return server, client, addr
 	})
+	Describe("Middleware Cancellation", func() {
+		It("Should close the stream when a middleware cancels the context it passes on", func(ctx SpecContext) {
+			errRevoked := errors.New("session revoked")
+			serverClosed := make(chan struct{})
+			cancelServer.BindHandler(func(
+				ctx context.Context,
+				server freighter.ServerStream[test.Request, test.Response],
+			) error {
+				defer close(serverClosed)
+				defer GinkgoRecover()
+				_, err := server.Receive()
+				Expect(err).To(MatchError(errRevoked))
+				return err
+			})
+			cancelServer.Use(freighter.MiddlewareFunc(func(
+				ctx freighter.Context,
+				next freighter.Next,
+			) (freighter.Context, error) {
+				var cancel context.CancelCauseFunc
+				ctx.Context, cancel = context.WithCancelCause(ctx.Context)
+				defer cancel(nil)
+				time.AfterFunc(10*time.Millisecond, func() { cancel(errRevoked) })
+				return next(ctx)
+			}))
+			stream := MustSucceed(client.Stream(ctx, cancelAddr))
+			Expect(stream.Receive()).Error().
+				To(MatchError(ContainSubstring(errRevoked.Error())))
+			Eventually(serverClosed).Should(BeClosed())
+		})
+	})
 })
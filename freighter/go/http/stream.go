@@ -67,6 +67,7 @@ func newStreamCore[RQ, RS freighter.Payload](
 	c := streamCore[RQ, RS]{
 		serverShutdownSig:  serverShutdownSig,
 		normalShutdownSig:  make(chan struct{}),
+		cancelSig:          make(chan struct{}),
 		successfulShutdown: make(chan struct{}),
 		streamCoreConfig:   cfg,
 	}
@@ -87,6 +88,7 @@ type streamCore[I, O freighter.Payload] struct {
 	peerCloseErr       error
 	serverShutdownSig  <-chan struct{}
 	normalShutdownSig  chan struct{}
+	cancelSig          chan struct{}
 	successfulShutdown chan struct{}
 	streamCoreConfig
 }
@@ -153,20 +155,22 @@ func (c *streamCore[I, O]) close() error {
 }
 
 // listenForContextCancellation is a goroutine that listens for the context to be
-// canceled and shuts down the stream forcefully if it is. We need this as the websocket
-// implementation itself doesn't support context cancellation.
+// canceled, or for cancelSig to be closed, and shuts down the stream forcefully if it
+// is. We need this as the websocket implementation itself doesn't support context
+// cancellation.
 func (c *streamCore[I, O]) listenForContextCancellation() {
 	defer close(c.successfulShutdown)
 	select {
 	case <-c.normalShutdownSig:
 		return
 	case <-c.serverShutdownSig:
-		if err := c.conn.WriteControl(
-			websocket.CloseMessage,
-			websocket.FormatCloseMessage(contextCancelledCloseCode, ""),
-			time.Now().Add(time.Second),
-		); err != nil && !errors.Is(err, websocket.ErrCloseSent) {
-			c.L.Error("error sending close message: %v \n", zap.Error(err))
-		}
+	case <-c.cancelSig:
+	}
+	if err := c.conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(contextCancelledCloseCode, ""),
+		time.Now().Add(time.Second),
+	); err != nil && !errors.Is(err, websocket.ErrCloseSent) {
+		c.L.Error("error sending close message: %v \n", zap.Error(err))
 	}
 }
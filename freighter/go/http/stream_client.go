@@ -11,6 +11,7 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"go/types"
 	"net/http"
 
@@ -40,6 +41,12 @@ type StreamClientConfig struct {
 	//
 	// [REQUIRED]
 	Codec xhttp.Codec
+	// TLS is the TLS configuration used to connect to the server. When set, streams
+	// are opened over secure websockets.
+	//
+	// [OPTIONAL] - Defaults to nil, in which case streams are opened over plain
+	// websockets.
+	TLS *tls.Config
 }
 
 // Validate implements config.Config.
@@ -52,6 +59,7 @@ func (c StreamClientConfig) Validate() error {
 // Override implements config.Config.
 func (c StreamClientConfig) Override(other StreamClientConfig) StreamClientConfig {
 	c.Codec = override.Nil(c.Codec, other.Codec)
+	c.TLS = override.Nil(c.TLS, other.TLS)
 	return c
 }
 
@@ -66,13 +74,19 @@ func NewStreamClient[RQ, RS freighter.Payload](
 	if err != nil {
 		return nil, err
 	}
-	return &streamClient[RQ, RS]{codec: cfg.Codec}, nil
+	c := &streamClient[RQ, RS]{codec: cfg.Codec, scheme: "ws://"}
+	if cfg.TLS != nil {
+		c.dialer.TLSClientConfig = cfg.TLS
+		c.scheme = "wss://"
+	}
+	return c, nil
 }
 
 type streamClient[RQ, RS freighter.Payload] struct {
 	alamos.Instrumentation
 	codec  xhttp.Codec
 	dialer ws.Dialer
+	scheme string
 	freighter.MiddlewareCollector
 }
 
@@ -100,7 +114,7 @@ func (s *streamClient[RQ, RS]) Stream(
 		freighter.FinalizerFunc(func(ctx freighter.Context) (freighter.Context, error) {
 			ctx.Params[fiber.HeaderContentType] = s.codec.ContentType()
 			conn, res, err := s.dialer.DialContext(
-				ctx, "ws://"+target.String(), ctxToHeaders(ctx),
+				ctx, s.scheme+target.String(), ctxToHeaders(ctx),
 			)
 			if err != nil {
 				return freighter.Context{Target: target}, err
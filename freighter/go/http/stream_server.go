@@ -170,6 +170,11 @@ func (s *streamServer[RQ, RS]) handleSocket(
 		freighter.FinalizerFunc(func(ctx freighter.Context) (freighter.Context, error) {
 			oCtx := ctx
 			oCtx.Params = make(freighter.Params)
+			// Middleware may derive a context that is canceled before the server shuts
+			// down (e.g. when the credentials the stream was opened with are revoked),
+			// so we need to forcefully shut the stream down when it is.
+			stop := context.AfterFunc(ctx, func() { close(stream.cancelSig) })
+			defer stop()
 			// Send a confirmation message to the client that the stream is open.
 			if err := stream.send(WSMessage[RS]{Type: WSMessageTypeOpen}); err != nil {
 				return oCtx, err
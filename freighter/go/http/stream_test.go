@@ -23,6 +23,7 @@ import (
 	"github.com/synnaxlabs/freighter/test"
 	"github.com/synnaxlabs/x/address"
 	"github.com/synnaxlabs/x/encoding/json"
+	"github.com/synnaxlabs/x/errors"
 	"github.com/synnaxlabs/x/net"
 	. "github.com/synnaxlabs/x/testutil"
 )
@@ -30,9 +31,12 @@ import (
 var _ = Describe("Stream", Ordered, Serial, func() {
 	var (
 		server freighter.StreamServer[test.Request, test.Response]
-		client freighter.StreamClient[test.Request, test.Response]
-		addr   address.Address
-		app    *fiber.App
+		// cancelServer is kept separate from server, as the shared stream suite adds
+		// middleware to server that fails every request.
+		cancelServer freighter.StreamServer[test.Request, test.Response]
+		client       freighter.StreamClient[test.Request, test.Response]
+		addr         address.Address
+		app          *fiber.App
 	)
 
 	BeforeAll(func() {
@@ -45,6 +49,7 @@ var _ = Describe("Stream", Ordered, Serial, func() {
 			return c.SendStatus(fiber.StatusOK)
 		})
 		server = fhttp.NewStreamServer[test.Request, test.Response](router, "/")
+		cancelServer = fhttp.NewStreamServer[test.Request, test.Response](router, "/cancel")
 		client = MustSucceed(fhttp.NewStreamClient[test.Request, test.Response](
 			fhttp.StreamClientConfig{Codec: json.Codec},
 		))
@@ -71,6 +76,36 @@ var _ = Describe("Stream", Ordered, Serial, func() {
 		return server, client, addr
 	})
 
+	Describe("Middleware Cancellation", func() {
+		It("Should close the stream when a middleware cancels the context it passes on", func(ctx SpecContext) {
+			serverClosed := make(chan struct{})
+			cancelServer.BindHandler(func(
+				ctx context.Context,
+				server freighter.ServerStream[test.Request, test.Response],
+			) error {
+				defer close(serverClosed)
+				defer GinkgoRecover()
+				Expect(server.Receive()).Error().To(MatchError(context.Canceled))
+				return ctx.Err()
+			})
+			cancelServer.Use(freighter.MiddlewareFunc(func(
+				ctx freighter.Context,
+				next freighter.Next,
+			) (freighter.Context, error) {
+				var cancel context.CancelCauseFunc
+				ctx.Context, cancel = context.WithCancelCause(ctx.Context)
+				defer cancel(nil)
+				time.AfterFunc(10*time.Millisecond, func() {
+					cancel(errors.New("session revoked"))
+				})
+				return next(ctx)
+			}))
+			stream := MustSucceed(client.Stream(ctx, address.Newf("%s/cancel", addr)))
+			Expect(stream.Receive()).Error().To(MatchError(context.Canceled))
+			Eventually(serverClosed).Should(BeClosed())
+		})
+	})
+
 	Describe("Report", func() {
 		It("should report the stream server's protocol and the content types it can negotiate at upgrade time", func() {
 			report := server.Report()
@@ -12,6 +12,7 @@ package http
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"net/http"
 	"strings"
 
@@ -41,6 +42,11 @@ type UnaryClientConfig struct {
 	//
 	// [REQUIRED] - At least one decoder must be supplied.
 	Decoders []xhttp.Decoder
+	// TLS is the TLS configuration used to connect to the server. When set, requests
+	// are sent over HTTPS.
+	//
+	// [OPTIONAL] - Defaults to nil, in which case requests are sent over plain HTTP.
+	TLS *tls.Config
 }
 
 // Validate implements config.Config.
@@ -55,6 +61,7 @@ func (c UnaryClientConfig) Validate() error {
 func (c UnaryClientConfig) Override(other UnaryClientConfig) UnaryClientConfig {
 	c.Encoder = override.Nil(c.Encoder, other.Encoder)
 	c.Decoders = override.Slice(c.Decoders, other.Decoders)
+	c.TLS = override.Nil(c.TLS, other.TLS)
 	return c
 }
 
@@ -72,17 +79,26 @@ func NewUnaryClient[RQ, RS freighter.Payload](
 	if err != nil {
 		return nil, err
 	}
-	return &unaryClient[RQ, RS]{
+	c := &unaryClient[RQ, RS]{
 		encoder:      cfg.Encoder,
 		decoders:     cfg.Decoders,
 		acceptHeader: buildAcceptHeader(cfg.Decoders),
-	}, nil
+		client:       &http.Client{},
+		scheme:       "http://",
+	}
+	if cfg.TLS != nil {
+		c.client.Transport = &http.Transport{TLSClientConfig: cfg.TLS}
+		c.scheme = "https://"
+	}
+	return c, nil
 }
 
 type unaryClient[RQ, RS freighter.Payload] struct {
 	encoder      xhttp.Encoder
 	decoders     []xhttp.Decoder
 	acceptHeader string
+	client       *http.Client
+	scheme       string
 	freighter.MiddlewareCollector
 }
 
@@ -138,7 +154,7 @@ func (u *unaryClient[RQ, RS]) Send(
 			httpReq, err := http.NewRequestWithContext(
 				ctx,
 				http.MethodPost,
-				"http://"+target.String(),
+				u.scheme+target.String(),
 				bytes.NewReader(b),
 			)
 			if err != nil {
@@ -148,7 +164,7 @@ func (u *unaryClient[RQ, RS]) Send(
 			httpReq.Header.Set(fiber.HeaderContentType, u.encoder.ContentType())
 			httpReq.Header.Set(fiber.HeaderAccept, u.acceptHeader)
 
-			httpRes, err := (&http.Client{}).Do(httpReq)
+			httpRes, err := u.client.Do(httpReq)
 			if err != nil {
 				return freighter.Context{Target: target}, err
 			}
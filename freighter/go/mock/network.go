@@ -103,6 +103,16 @@ func (n *Network[RQ, RS]) resolveStreamTarget(target address.Address) (*StreamSe
 	return t, ok
 }
 
+// Disconnect removes the unary and stream servers hosted at the given address from
+// the network, simulating the loss of the host. Requests to the host fail as if it
+// were unreachable, while streams that are already open are left untouched.
+func (n *Network[RQ, RS]) Disconnect(host address.Address) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.mu.unaryRoutes, host)
+	delete(n.mu.streamRoutes, host)
+}
+
 func (n *Network[RQ, RS]) parseTarget(target address.Address) address.Address {
 	if target == "" {
 		return address.Address(fmt.Sprintf("localhost:%v", len(n.mu.unaryRoutes)+len(n.mu.streamRoutes)))
@@ -0,0 +1,46 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package mock_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/freighter"
+	"github.com/synnaxlabs/freighter/mock"
+	"github.com/synnaxlabs/freighter/test"
+	"github.com/synnaxlabs/x/address"
+	. "github.com/synnaxlabs/x/testutil"
+)
+
+var _ = Describe("Network", func() {
+	Describe("Disconnect", func() {
+		It("Should make the servers at the host unreachable", func(ctx SpecContext) {
+			net := mock.NewNetwork[test.Request, test.Response]()
+			server := net.UnaryServer("localhost:0")
+			server.BindHandler(func(_ context.Context, req test.Request) (test.Response, error) {
+				return test.Response{ID: req.ID, Message: req.Message}, nil
+			})
+			stream := net.StreamServer("localhost:0")
+			stream.BindHandler(func(context.Context, freighter.ServerStream[test.Request, test.Response]) error {
+				return nil
+			})
+			client := net.UnaryClient()
+			res := MustSucceed(client.Send(ctx, "localhost:0", test.Request{ID: 1}))
+			Expect(res.ID).To(Equal(1))
+			net.Disconnect("localhost:0")
+			Expect(client.Send(ctx, "localhost:0", test.Request{ID: 2})).
+				Error().To(MatchError(address.ErrNotFound))
+			Expect(net.StreamClient().Stream(ctx, "localhost:0")).
+				Error().To(MatchError(address.ErrNotFound))
+		})
+	})
+})
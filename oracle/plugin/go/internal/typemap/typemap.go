@@ -192,6 +192,11 @@ func ResolveGoSliceElemType(
 		case resolution.AliasForm:
 			baseRef = form.Target
 		case resolution.DistinctForm:
+			// Distinct types over primitives keep their own Go name so that slices
+			// of them (e.g., []node.Key) match the declared field type.
+			if resolution.IsPrimitive(form.Base.Name) {
+				return goTypeNameFn(actual)
+			}
 			baseRef = form.Base
 		default:
 			return goTypeNameFn(actual)
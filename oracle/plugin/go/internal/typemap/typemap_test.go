@@ -459,6 +459,20 @@ var _ = Describe("ResolveGoSliceElemType", func() {
 		Expect(result).To(Equal("Base"))
 	})
 
+	It("should keep the name of a distinct type over a primitive", func() {
+		table := resolution.NewTable()
+		distinct := resolution.Type{
+			Name:          "NodeKey",
+			QualifiedName: "test.NodeKey",
+			Form: resolution.DistinctForm{
+				Base: resolution.TypeRef{Name: "uint16"},
+			},
+		}
+		Expect(table.Add(distinct)).To(Succeed())
+		result := MustSucceed(typemap.ResolveGoSliceElemType(distinct, table, goTypeName))
+		Expect(result).To(Equal("NodeKey"))
+	})
+
 	It("should error when alias target is unresolvable", func() {
 		table := resolution.NewTable()
 		alias := resolution.Type{
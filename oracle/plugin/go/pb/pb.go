@@ -1250,6 +1250,26 @@ func (p *Plugin) generateTypeDefConversion(
 		return goField, pbField, "", false
 	}
 
+	goType := p.qualifiedTypeDefName(resolved, data)
+
+	if baseType.Name == "uuid" {
+		data.imports.AddExternal("github.com/google/uuid")
+		forward = fmt.Sprintf("%s.String()", goField)
+		backward = fmt.Sprintf("uuid.Parse(%s)", pbField)
+		return forward, backward, goType, true
+	}
+
+	protoType := primitiveToProtoType(baseType.Name)
+
+	forward = fmt.Sprintf("%s(%s)", protoType, goField)
+	backward = fmt.Sprintf("%s(%s)", goType, pbField)
+
+	return forward, backward, "", false
+}
+
+// qualifiedTypeDefName returns the package-qualified Go name of a distinct type,
+// registering the import of its package if necessary.
+func (p *Plugin) qualifiedTypeDefName(resolved resolution.Type, data *templateData) string {
 	typedefPrefix := ""
 	goOutput := output.GetPath(resolved, "go")
 	if resolved.Namespace != data.Namespace || (goOutput != "" && goOutput != data.ParentGoPath) {
@@ -1264,23 +1284,7 @@ func (p *Plugin) generateTypeDefConversion(
 	} else {
 		typedefPrefix = data.parentAlias + "."
 	}
-
-	resolvedGoName := naming.GetGoName(resolved)
-
-	if baseType.Name == "uuid" {
-		data.imports.AddExternal("github.com/google/uuid")
-		forward = fmt.Sprintf("%s.String()", goField)
-		backward = fmt.Sprintf("uuid.Parse(%s)", pbField)
-		backwardCast = fmt.Sprintf("%s%s", typedefPrefix, resolvedGoName)
-		return forward, backward, backwardCast, true
-	}
-
-	protoType := primitiveToProtoType(baseType.Name)
-
-	forward = fmt.Sprintf("%s(%s)", protoType, goField)
-	backward = fmt.Sprintf("%s%s(%s)", typedefPrefix, resolvedGoName, pbField)
-
-	return forward, backward, "", false
+	return typedefPrefix + naming.GetGoName(resolved)
 }
 
 func (p *Plugin) generateAliasConversion(
@@ -1370,6 +1374,19 @@ func (p *Plugin) generateArrayConversion(
 		}
 	}
 
+	if ok {
+		if form, isDistinct := elemResolved.Form.(resolution.DistinctForm); isDistinct &&
+			resolution.IsPrimitive(form.Base.Name) &&
+			(isNumericPrimitive(form.Base.Name) || primitiveToProtoType(form.Base.Name) != form.Base.Name) {
+			data.imports.AddExternal("github.com/samber/lo")
+			protoType := primitiveToProtoType(form.Base.Name)
+			goType := p.qualifiedTypeDefName(elemResolved, data)
+			return fmt.Sprintf("lo.Map(%s, func(v %s, _ int) %s { return %s(v) })", goField, goType, protoType, protoType),
+				fmt.Sprintf("lo.Map(%s, func(v %s, _ int) %s { return %s(v) })", pbField, protoType, goType, goType),
+				false, false
+		}
+	}
+
 	if resolution.IsPrimitive(elemType.Name) {
 		switch elemType.Name {
 		case "uuid":
@@ -909,6 +909,24 @@ var _ = Describe("Go PB Plugin", func() {
 					ToContain("test.Key(pb.Rack)")
 			})
 
+			It("Should convert arrays of typedefs with a numeric base", func(ctx SpecContext) {
+				source := `
+					@go output "core/test"
+					@pb
+
+					NodeKey uint16
+
+					Test struct {
+						replicas NodeKey[]
+					}
+				`
+				resp := MustGenerate(ctx, source, "test", loader, pbPlugin)
+
+				ExpectContent(resp, "translator.gen.go").
+					ToContain("lo.Map(r.Replicas, func(v test.NodeKey, _ int) uint32 { return uint32(v) })").
+					ToContain("lo.Map(pb.Replicas, func(v uint32, _ int) test.NodeKey { return test.NodeKey(v) })")
+			})
+
 			It("Should convert typedef with uuid base", func(ctx SpecContext) {
 				source := `
 					@go output "core/test"
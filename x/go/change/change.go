@@ -31,6 +31,10 @@ type Change[K, V any] struct {
 	Variant Variant
 }
 
+// Map returns the changes required to transform prev into next. Entries that are in
+// next but not prev, or whose values are not equal, are returned as VariantSet changes
+// containing the value in next. Entries that are in prev but not next are returned as
+// VariantDelete changes containing the value in prev.
 func Map[K comparable, V comparable](
 	prev,
 	next map[K]V,
@@ -41,14 +45,13 @@ func Map[K comparable, V comparable](
 	}
 	changes := make([]Change[K, V], 0, len(prev)+len(next))
 	for k, v := range prev {
-		next, ok := next[k]
-		if !ok || !equal(v, next) {
+		if _, ok := next[k]; !ok {
 			changes = append(changes, Change[K, V]{Key: k, Value: v, Variant: VariantDelete})
 		}
 	}
-	for k := range next {
-		if _, ok := prev[k]; !ok {
-			changes = append(changes, Change[K, V]{Key: k, Value: next[k], Variant: VariantSet})
+	for k, v := range next {
+		if p, ok := prev[k]; !ok || !equal(p, v) {
+			changes = append(changes, Change[K, V]{Key: k, Value: v, Variant: VariantSet})
 		}
 	}
 	return changes
@@ -0,0 +1,43 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package change_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/x/change"
+)
+
+type Change = change.Change[string, int]
+
+var _ = Describe("Map", func() {
+	It("Should return a set for every entry added to the map", func() {
+		Expect(change.Map(map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, nil)).
+			To(ConsistOf(Change{Key: "b", Value: 2, Variant: change.VariantSet}))
+	})
+
+	It("Should return a delete for every entry removed from the map", func() {
+		Expect(change.Map(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1}, nil)).
+			To(ConsistOf(Change{Key: "b", Value: 2, Variant: change.VariantDelete}))
+	})
+
+	It("Should return a set with the new value for every changed entry", func() {
+		Expect(change.Map(map[string]int{"a": 1}, map[string]int{"a": 2}, nil)).
+			To(ConsistOf(Change{Key: "a", Value: 2, Variant: change.VariantSet}))
+	})
+
+	It("Should use the provided equality function", func() {
+		sameParity := func(prev, next int) bool { return prev%2 == next%2 }
+		Expect(change.Map(map[string]int{"a": 1}, map[string]int{"a": 3}, sameParity)).
+			To(BeEmpty())
+		Expect(change.Map(map[string]int{"a": 1}, map[string]int{"a": 2}, sameParity)).
+			To(ConsistOf(Change{Key: "a", Value: 2, Variant: change.VariantSet}))
+	})
+})
@@ -135,6 +135,10 @@ func (NoopServer) References(context.Context, *protocol.ReferenceParams) ([]prot
 	return nil, nil
 }
 
+func (NoopServer) InlayHint(context.Context, *protocol.InlayHintParams) ([]protocol.InlayHint, error) {
+	return nil, nil
+}
+
 func (NoopServer) ResolveCodeAction(context.Context, *protocol.CodeAction) (*protocol.CodeAction, error) {
 	return nil, nil
 }
@@ -128,6 +128,11 @@ type ServerCapabilities struct {
 	// @since 3.16.0.
 	MonikerProvider interface{} `json:"monikerProvider,omitempty"` // TODO(zchee): bool | *MonikerOptions | *MonikerRegistrationOptions
 
+	// InlayHintProvider is the server provides inlay hint support.
+	//
+	// @since 3.17.0.
+	InlayHintProvider interface{} `json:"inlayHintProvider,omitempty"` // bool | *InlayHintOptions
+
 	// Experimental server capabilities.
 	Experimental interface{} `json:"experimental,omitempty"`
 }
@@ -520,6 +525,17 @@ type MonikerOptions struct {
 	WorkDoneProgressOptions
 }
 
+// InlayHintOptions option of inlay hint provider server capabilities.
+//
+// @since 3.17.0.
+type InlayHintOptions struct {
+	WorkDoneProgressOptions
+
+	// ResolveProvider is the server provides support to resolve additional
+	// information for an inlay hint item.
+	ResolveProvider bool `json:"resolveProvider,omitempty"`
+}
+
 // MonikerRegistrationOptions registration option of moniker provider server capabilities.
 //
 // @since 3.16.0.
@@ -1322,3 +1322,53 @@ type FoldingRange struct {
 	// See FoldingRangeKind for an enumeration of standardized kinds.
 	Kind FoldingRangeKind `json:"kind,omitempty"`
 }
+
+// InlayHintParams params of InlayHint request.
+//
+// @since 3.17.0.
+type InlayHintParams struct {
+	WorkDoneProgressParams
+
+	// TextDocument is the text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// Range is the visible document range for which inlay hints should be computed.
+	Range Range `json:"range"`
+}
+
+// InlayHintKind is the kind of an inlay hint.
+//
+// @since 3.17.0.
+type InlayHintKind float64
+
+const (
+	// InlayHintKindType is an inlay hint that is for a type annotation.
+	InlayHintKindType InlayHintKind = 1
+
+	// InlayHintKindParameter is an inlay hint that is for a parameter.
+	InlayHintKindParameter InlayHintKind = 2
+)
+
+// InlayHint is an inlay hint displayed inline in the editor.
+//
+// @since 3.17.0.
+type InlayHint struct {
+	// Position is the position of this hint.
+	Position Position `json:"position"`
+
+	// Label is the label of this hint.
+	Label string `json:"label"`
+
+	// Kind is the kind of this hint. Can be omitted in which case the client
+	// should fall back to a reasonable default.
+	Kind InlayHintKind `json:"kind,omitempty"`
+
+	// Tooltip is the tooltip text when you hover over this item.
+	Tooltip string `json:"tooltip,omitempty"`
+
+	// PaddingLeft renders padding before the hint.
+	PaddingLeft bool `json:"paddingLeft,omitempty"`
+
+	// PaddingRight renders padding after the hint.
+	PaddingRight bool `json:"paddingRight,omitempty"`
+}
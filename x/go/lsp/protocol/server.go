@@ -777,6 +777,18 @@ func serverDispatch(ctx context.Context, server Server, reply jsonrpc2.Replier,
 
 		return true, reply(ctx, resp, err)
 
+	case MethodTextDocumentInlayHint: // request
+		defer logger.Debug(MethodTextDocumentInlayHint, zap.Error(err))
+
+		var params InlayHintParams
+		if err := dec.Decode(&params); err != nil {
+			return true, replyParseError(ctx, reply, err)
+		}
+
+		resp, err := server.InlayHint(ctx, &params)
+
+		return true, reply(ctx, resp, err)
+
 	default:
 		return false, nil
 	}
@@ -843,6 +855,7 @@ type Server interface {
 	SemanticTokensRefresh(ctx context.Context) (err error)
 	LinkedEditingRange(ctx context.Context, params *LinkedEditingRangeParams) (result *LinkedEditingRanges, err error)
 	Moniker(ctx context.Context, params *MonikerParams) (result []Moniker, err error)
+	InlayHint(ctx context.Context, params *InlayHintParams) (result []InlayHint, err error)
 	Request(ctx context.Context, method string, params interface{}) (result interface{}, err error)
 }
 
@@ -1027,6 +1040,9 @@ const (
 
 	// MethodMoniker method name of "textDocument/moniker".
 	MethodMoniker = "textDocument/moniker"
+
+	// MethodTextDocumentInlayHint method name of "textDocument/inlayHint".
+	MethodTextDocumentInlayHint = "textDocument/inlayHint"
 )
 
 // server implements a Language Server Protocol server.
@@ -1886,6 +1902,22 @@ func (s *server) Moniker(ctx context.Context, params *MonikerParams) (result []M
 	return result, nil
 }
 
+// InlayHint is the request is sent from the client to the server to compute inlay hints for a given text document range.
+//
+// If no hints can be calculated, an empty array or null should be returned.
+//
+// @since 3.17.0.
+func (s *server) InlayHint(ctx context.Context, params *InlayHintParams) (result []InlayHint, err error) {
+	s.logger.Debug("call " + MethodTextDocumentInlayHint)
+	defer s.logger.Debug("end "+MethodTextDocumentInlayHint, zap.Error(err))
+
+	if err := Call(ctx, s.Conn, MethodTextDocumentInlayHint, params, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // Request sends a request from the client to the server that non-compliant with the Language Server Protocol specifications.
 func (s *server) Request(ctx context.Context, method string, params interface{}) (interface{}, error) {
 	s.logger.Debug("call " + method)
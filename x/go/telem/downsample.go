@@ -0,0 +1,331 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package telem
+
+import (
+	"math"
+	"slices"
+
+	"github.com/synnaxlabs/x/errors"
+	"github.com/synnaxlabs/x/validate"
+)
+
+// DownsampleMode selects how samples are reduced when downsampling a Series.
+type DownsampleMode string
+
+const (
+	// DownsampleModeDecimate keeps the first sample out of every factor samples. This
+	// is the default mode.
+	DownsampleModeDecimate DownsampleMode = "decimate"
+	// DownsampleModeMinMax splits the series into buckets of factor samples and keeps
+	// the minimum and maximum samples of each bucket, preserving spikes.
+	DownsampleModeMinMax DownsampleMode = "min_max"
+	// DownsampleModeAverage splits the series into buckets of factor samples and
+	// replaces each bucket with the average of its samples.
+	DownsampleModeAverage DownsampleMode = "average"
+	// DownsampleModeLTTB keeps one sample out of every factor samples using the
+	// Largest-Triangle-Three-Buckets algorithm, which selects the samples that best
+	// preserve the visual shape of the series.
+	DownsampleModeLTTB DownsampleMode = "lttb"
+)
+
+// Validate returns an error if the mode is not a known DownsampleMode. The zero value
+// is valid, and is equivalent to DownsampleModeDecimate.
+func (m DownsampleMode) Validate() error {
+	switch m {
+	case "", DownsampleModeDecimate, DownsampleModeMinMax, DownsampleModeAverage, DownsampleModeLTTB:
+		return nil
+	}
+	return errors.Wrapf(validate.ErrValidation, "unknown downsample mode %q", m)
+}
+
+// FactorForTarget returns the factor to downsample samples by in order to keep
+// approximately target samples. Returns 1 if no downsampling is necessary.
+func (m DownsampleMode) FactorForTarget(samples int64, target int) int {
+	if target <= 0 || samples <= int64(target) {
+		return 1
+	}
+	// Min/max keeps up to two samples per bucket, so buckets need to be twice as
+	// large to hit the same target.
+	if m == DownsampleModeMinMax {
+		samples *= 2
+	}
+	return int((samples + int64(target) - 1) / int64(target))
+}
+
+// Downsample downsamples the given series by the given factor using the given mode.
+// The series must all be the same length, and samples at the same position in each
+// series must correspond to each other, as is the case for an index channel and the
+// data channels it indexes. The series remain aligned with each other after
+// downsampling.
+//
+// For DownsampleModeMinMax and DownsampleModeLTTB, the samples to keep are selected
+// using the values of the numeric series that are not timestamps, and the same samples
+// are kept from every series. For DownsampleModeAverage, series that can't be averaged
+// keep the first sample of each bucket. If the series are not all the same length, each
+// one is downsampled separately.
+func Downsample(mode DownsampleMode, factor int, series ...Series) []Series {
+	if factor <= 1 || len(series) == 0 || series[0].Len() == 0 {
+		return series
+	}
+	n := series[0].Len()
+	if len(series) > 1 && slices.ContainsFunc(series, func(s Series) bool { return s.Len() != n }) {
+		out := make([]Series, len(series))
+		for i, s := range series {
+			out[i] = Downsample(mode, factor, s)[0]
+		}
+		return out
+	}
+	out := make([]Series, len(series))
+	switch mode {
+	case DownsampleModeAverage:
+		for i, s := range series {
+			out[i] = averageBuckets(s, factor)
+		}
+	case DownsampleModeMinMax, DownsampleModeLTTB:
+		values, x := selectionValues(series)
+		var positions []int
+		if len(values) == 0 {
+			positions = decimatePositions(int(n), factor)
+		} else if mode == DownsampleModeMinMax {
+			positions = minMaxPositions(values, int(n), factor)
+		} else {
+			positions = lttbPositions(values, x, int(n), factor)
+		}
+		for i, s := range series {
+			out[i] = s.selectSamples(positions)
+		}
+	default:
+		for i, s := range series {
+			out[i] = s.Downsample(factor)
+		}
+	}
+	return out
+}
+
+// selectionValues returns the values of the series used to select samples, along with
+// the x coordinates of the samples. The x coordinates are taken from the first
+// timestamp series, falling back to the position of each sample.
+func selectionValues(series []Series) (values [][]float64, x []float64) {
+	for _, s := range series {
+		if s.DataType == TimeStampT {
+			if x == nil {
+				x = float64s(s)
+			}
+			continue
+		}
+		if v := float64s(s); v != nil {
+			values = append(values, v)
+		}
+	}
+	if x == nil && len(series) > 0 {
+		x = make([]float64, series[0].Len())
+		for i := range x {
+			x[i] = float64(i)
+		}
+	}
+	return values, x
+}
+
+// float64s returns the values of a numeric series as float64s. Timestamps are made
+// relative to the first sample to preserve precision. Returns nil if the series is not
+// numeric.
+func float64s(s Series) []float64 {
+	if s.DataType != TimeStampT {
+		return Float64s(s)
+	}
+	stamps := UnmarshalSeries[TimeStamp](s)
+	out := make([]float64, len(stamps))
+	for i, ts := range stamps {
+		out[i] = float64(ts - stamps[0])
+	}
+	return out
+}
+
+func decimatePositions(n, factor int) []int {
+	positions := make([]int, 0, n/factor+1)
+	for i := 0; i < n; i += factor {
+		positions = append(positions, i)
+	}
+	return positions
+}
+
+// minMaxPositions returns the positions of the minimum and maximum samples of each
+// bucket of factor samples, across all the given values, in ascending order.
+func minMaxPositions(values [][]float64, n, factor int) []int {
+	positions := make([]int, 0, 2*(n/factor+1))
+	bucket := make([]int, 0, 2*len(values))
+	for start := 0; start < n; start += factor {
+		end := min(start+factor, n)
+		bucket = bucket[:0]
+		for _, v := range values {
+			lo, hi := start, start
+			for i := start + 1; i < end; i++ {
+				if v[i] < v[lo] {
+					lo = i
+				}
+				if v[i] > v[hi] {
+					hi = i
+				}
+			}
+			bucket = append(bucket, lo, hi)
+		}
+		slices.Sort(bucket)
+		positions = append(positions, slices.Compact(bucket)...)
+	}
+	return positions
+}
+
+// lttbPositions returns the positions selected by running the
+// Largest-Triangle-Three-Buckets algorithm over each of the given values, merged in
+// ascending order.
+func lttbPositions(values [][]float64, x []float64, n, factor int) []int {
+	threshold := (n + factor - 1) / factor
+	if threshold >= n {
+		return decimatePositions(n, 1)
+	}
+	if threshold < 3 {
+		return []int{0, n - 1}
+	}
+	var positions []int
+	for _, y := range values {
+		positions = append(positions, lttb(x, y, threshold)...)
+	}
+	slices.Sort(positions)
+	return slices.Compact(positions)
+}
+
+func lttb(x, y []float64, threshold int) []int {
+	var (
+		n          = len(y)
+		bucketSize = float64(n-2) / float64(threshold-2)
+		sampled    = make([]int, 0, threshold)
+		a          = 0
+	)
+	sampled = append(sampled, 0)
+	for i := range threshold - 2 {
+		// Average the next bucket to use as the third vertex of the triangle.
+		avgStart := int(float64(i+1)*bucketSize) + 1
+		avgEnd := min(int(float64(i+2)*bucketSize)+1, n)
+		var avgX, avgY float64
+		for j := avgStart; j < avgEnd; j++ {
+			avgX += x[j]
+			avgY += y[j]
+		}
+		count := float64(avgEnd - avgStart)
+		avgX /= count
+		avgY /= count
+		var (
+			start   = int(float64(i)*bucketSize) + 1
+			end     = int(float64(i+1)*bucketSize) + 1
+			maxArea = -1.0
+			next    = start
+		)
+		for j := start; j < end; j++ {
+			area := math.Abs((x[a]-avgX)*(y[j]-y[a]) - (x[a]-x[j])*(avgY-y[a]))
+			if area > maxArea {
+				maxArea = area
+				next = j
+			}
+		}
+		sampled = append(sampled, next)
+		a = next
+	}
+	return append(sampled, n-1)
+}
+
+// averageBuckets replaces each bucket of factor samples in the series with the average
+// of its samples. Series that can't be averaged keep the first sample of each bucket.
+func averageBuckets(s Series, factor int) Series {
+	switch s.DataType {
+	case Float64T:
+		return averageBucketsOf[float64](s, factor)
+	case Float32T:
+		return averageBucketsOf[float32](s, factor)
+	case Int64T:
+		return averageBucketsOf[int64](s, factor)
+	case Int32T:
+		return averageBucketsOf[int32](s, factor)
+	case Int16T:
+		return averageBucketsOf[int16](s, factor)
+	case Int8T:
+		return averageBucketsOf[int8](s, factor)
+	case Uint64T:
+		return averageBucketsOf[uint64](s, factor)
+	case Uint32T:
+		return averageBucketsOf[uint32](s, factor)
+	case Uint16T:
+		return averageBucketsOf[uint16](s, factor)
+	case Uint8T:
+		return averageBucketsOf[uint8](s, factor)
+	case TimeStampT:
+		return averageBucketsOf[TimeStamp](s, factor)
+	default:
+		return s.Downsample(factor)
+	}
+}
+
+func averageBucketsOf[T NumericSample](s Series, factor int) Series {
+	data := UnmarshalSeries[T](s)
+	out := make([]T, 0, len(data)/factor+1)
+	for start := 0; start < len(data); start += factor {
+		out = append(out, average(data[start:min(start+factor, len(data))]))
+	}
+	res := NewSeries(out)
+	res.TimeRange = s.TimeRange
+	res.Alignment = s.Alignment
+	return res
+}
+
+// average returns the average of the given samples. Offsets from the first sample are
+// averaged instead of the samples themselves so that large integers (e.g. timestamps)
+// don't lose precision or overflow.
+func average[T NumericSample](samples []T) T {
+	var (
+		first = samples[0]
+		sum   float64
+	)
+	for _, v := range samples[1:] {
+		if v >= first {
+			sum += float64(v - first)
+		} else {
+			sum -= float64(first - v)
+		}
+	}
+	avg := sum / float64(len(samples))
+	if avg >= 0 {
+		return first + T(avg)
+	}
+	return first - T(-avg)
+}
+
+// selectSamples returns a copy of the series containing only the samples at the given
+// positions, which must be in ascending order.
+func (s Series) selectSamples(positions []int) Series {
+	if int64(len(positions)) == s.Len() {
+		return s
+	}
+	out := Series{TimeRange: s.TimeRange, DataType: s.DataType, Alignment: s.Alignment}
+	if s.DataType.IsVariable() {
+		samples := unmarshalVariable[[]byte](s.Data)
+		selected := make([][]byte, len(positions))
+		for i, p := range positions {
+			selected[i] = samples[p]
+		}
+		out.Data = marshalVariable(selected)
+		return out
+	}
+	den := int(s.DataType.Density())
+	out.Data = make([]byte, 0, len(positions)*den)
+	for _, p := range positions {
+		out.Data = append(out.Data, s.Data[p*den:(p+1)*den]...)
+	}
+	return out
+}
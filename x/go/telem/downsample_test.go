@@ -0,0 +1,181 @@
+// Copyright 2026 Synnax Labs, Inc.
+//
+// Use of this software is governed by the Business Source License included in the file
+// licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with the Business Source
+// License, use of this software will be governed by the Apache License, Version 2.0,
+// included in the file licenses/APL.txt.
+
+package telem_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/synnaxlabs/x/telem"
+	"github.com/synnaxlabs/x/validate"
+)
+
+var _ = Describe("Downsample", func() {
+	Describe("DownsampleMode", func() {
+		DescribeTable("Validate",
+			func(mode telem.DownsampleMode, valid bool) {
+				if valid {
+					Expect(mode.Validate()).To(Succeed())
+				} else {
+					Expect(mode.Validate()).To(MatchError(validate.ErrValidation))
+				}
+			},
+			Entry("zero value", telem.DownsampleMode(""), true),
+			Entry("decimate", telem.DownsampleModeDecimate, true),
+			Entry("min/max", telem.DownsampleModeMinMax, true),
+			Entry("average", telem.DownsampleModeAverage, true),
+			Entry("lttb", telem.DownsampleModeLTTB, true),
+			Entry("unknown", telem.DownsampleMode("median"), false),
+		)
+
+		DescribeTable("FactorForTarget",
+			func(mode telem.DownsampleMode, samples int64, target, expected int) {
+				Expect(mode.FactorForTarget(samples, target)).To(Equal(expected))
+			},
+			Entry("no target", telem.DownsampleModeDecimate, int64(1000), 0, 1),
+			Entry("fewer samples than target", telem.DownsampleModeDecimate, int64(10), 100, 1),
+			Entry("even division", telem.DownsampleModeDecimate, int64(1000), 100, 10),
+			Entry("uneven division", telem.DownsampleModeAverage, int64(1001), 100, 11),
+			Entry("min/max", telem.DownsampleModeMinMax, int64(1000), 100, 20),
+		)
+	})
+
+	Describe("Decimate", func() {
+		It("Should keep one out of every factor samples", func() {
+			s := telem.NewSeriesV[int64](1, 2, 3, 4, 5, 6, 7)
+			res := telem.Downsample(telem.DownsampleModeDecimate, 3, s)
+			Expect(res).To(HaveLen(1))
+			Expect(telem.UnmarshalSeries[int64](res[0])).To(Equal([]int64{1, 4, 7}))
+		})
+
+		It("Should decimate when no mode is specified", func() {
+			s := telem.NewSeriesV[int64](1, 2, 3, 4)
+			res := telem.Downsample("", 2, s)
+			Expect(telem.UnmarshalSeries[int64](res[0])).To(Equal([]int64{1, 3}))
+		})
+
+		It("Should not modify the series when the factor is less than 2", func() {
+			s := telem.NewSeriesV[int64](1, 2, 3)
+			res := telem.Downsample(telem.DownsampleModeMinMax, 1, s)
+			Expect(res[0]).To(Equal(s))
+		})
+	})
+
+	Describe("MinMax", func() {
+		It("Should keep the minimum and maximum of each bucket", func() {
+			s := telem.NewSeriesV(1.0, 9.0, 2.0, 3.0, -4.0, 3.5, 5.0, 5.0, 5.0)
+			res := telem.Downsample(telem.DownsampleModeMinMax, 3, s)
+			Expect(telem.UnmarshalSeries[float64](res[0])).
+				To(Equal([]float64{1.0, 9.0, -4.0, 3.5, 5.0}))
+		})
+
+		It("Should keep the index aligned with its data", func() {
+			idx := telem.NewSeriesSecondsTSV(1, 2, 3, 4, 5, 6)
+			data := telem.NewSeriesV[float32](0, 0, 100, 0, -100, 0)
+			res := telem.Downsample(telem.DownsampleModeMinMax, 3, idx, data)
+			Expect(telem.UnmarshalSeries[telem.TimeStamp](res[0])).To(Equal([]telem.TimeStamp{
+				1 * telem.SecondTS,
+				3 * telem.SecondTS,
+				4 * telem.SecondTS,
+				5 * telem.SecondTS,
+			}))
+			Expect(telem.UnmarshalSeries[float32](res[1])).To(Equal([]float32{0, 100, 0, -100}))
+		})
+
+		It("Should keep the extrema of every data series", func() {
+			a := telem.NewSeriesV[int32](5, 0, 5, 5)
+			b := telem.NewSeriesV[int32](1, 1, 1, 9)
+			res := telem.Downsample(telem.DownsampleModeMinMax, 4, a, b)
+			Expect(telem.UnmarshalSeries[int32](res[0])).To(Equal([]int32{5, 0, 5}))
+			Expect(telem.UnmarshalSeries[int32](res[1])).To(Equal([]int32{1, 1, 9}))
+		})
+
+		It("Should decimate series that aren't numeric", func() {
+			s := telem.NewSeriesV("a", "b", "c", "d")
+			res := telem.Downsample(telem.DownsampleModeMinMax, 2, s)
+			Expect(telem.UnmarshalSeries[string](res[0])).To(Equal([]string{"a", "c"}))
+		})
+	})
+
+	Describe("Average", func() {
+		It("Should average each bucket", func() {
+			s := telem.NewSeriesV(1.0, 2.0, 3.0, 10.0, 20.0)
+			res := telem.Downsample(telem.DownsampleModeAverage, 3, s)
+			Expect(telem.UnmarshalSeries[float64](res[0])).To(Equal([]float64{2.0, 15.0}))
+		})
+
+		It("Should average timestamps without losing precision", func() {
+			start := telem.TimeStamp(math.MaxInt64 - 10)
+			s := telem.NewSeriesV(start, start+2, start+4, start+6)
+			res := telem.Downsample(telem.DownsampleModeAverage, 2, s)
+			Expect(telem.UnmarshalSeries[telem.TimeStamp](res[0])).
+				To(Equal([]telem.TimeStamp{start + 1, start + 5}))
+		})
+
+		It("Should average unsigned integers that decrease", func() {
+			s := telem.NewSeriesV[uint8](10, 4, 200, 100)
+			res := telem.Downsample(telem.DownsampleModeAverage, 2, s)
+			Expect(telem.UnmarshalSeries[uint8](res[0])).To(Equal([]uint8{7, 150}))
+		})
+
+		It("Should preserve the time range and alignment of the series", func() {
+			s := telem.NewSeriesV[int64](1, 2, 3, 4)
+			s.Alignment = telem.NewAlignment(2, 10)
+			s.TimeRange = telem.TimeRange{Start: 1, End: 5}
+			res := telem.Downsample(telem.DownsampleModeAverage, 2, s)
+			Expect(res[0].Alignment).To(Equal(s.Alignment))
+			Expect(res[0].TimeRange).To(Equal(s.TimeRange))
+		})
+	})
+
+	Describe("LTTB", func() {
+		It("Should keep the first and last samples and preserve spikes", func() {
+			values := make([]float64, 100)
+			values[42] = 1000
+			s := telem.NewSeriesV(values...)
+			res := telem.Downsample(telem.DownsampleModeLTTB, 10, s)
+			out := telem.UnmarshalSeries[float64](res[0])
+			Expect(out).To(HaveLen(10))
+			Expect(out).To(ContainElement(1000.0))
+		})
+
+		It("Should keep the index aligned with its data", func() {
+			stamps := make([]telem.TimeStamp, 50)
+			values := make([]int64, 50)
+			for i := range stamps {
+				stamps[i] = telem.TimeStamp(i) * telem.SecondTS
+				values[i] = int64(i % 7)
+			}
+			values[31] = -50
+			res := telem.Downsample(
+				telem.DownsampleModeLTTB,
+				5,
+				telem.NewSeriesV(stamps...),
+				telem.NewSeriesV(values...),
+			)
+			outStamps := telem.UnmarshalSeries[telem.TimeStamp](res[0])
+			outValues := telem.UnmarshalSeries[int64](res[1])
+			Expect(outStamps).To(HaveLen(len(outValues)))
+			Expect(outStamps).To(ContainElement(31 * telem.SecondTS))
+			for i, ts := range outStamps {
+				Expect(outValues[i]).To(Equal(values[ts/telem.SecondTS]))
+			}
+		})
+	})
+
+	It("Should downsample series of different lengths separately", func() {
+		a := telem.NewSeriesV[int64](1, 2, 3, 4)
+		b := telem.NewSeriesV[int64](1, 2, 3, 4, 5, 6)
+		res := telem.Downsample(telem.DownsampleModeAverage, 2, a, b)
+		Expect(telem.UnmarshalSeries[int64](res[0])).To(Equal([]int64{1, 3}))
+		Expect(telem.UnmarshalSeries[int64](res[1])).To(Equal([]int64{1, 3, 5}))
+	})
+})
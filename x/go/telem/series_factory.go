@@ -194,6 +194,46 @@ func UnmarshalSeries[T Sample](series Series) []T {
 	panic(fmt.Sprintf("unsupported sample type %T", t))
 }
 
+// Float64s converts the samples of a numeric series to float64s. Timestamps are
+// converted to their value in nanoseconds since the epoch. Returns nil if the series
+// is not numeric.
+func Float64s(s Series) []float64 {
+	switch s.DataType {
+	case Float64T:
+		return toFloat64s(UnmarshalSeries[float64](s))
+	case Float32T:
+		return toFloat64s(UnmarshalSeries[float32](s))
+	case Int64T:
+		return toFloat64s(UnmarshalSeries[int64](s))
+	case Int32T:
+		return toFloat64s(UnmarshalSeries[int32](s))
+	case Int16T:
+		return toFloat64s(UnmarshalSeries[int16](s))
+	case Int8T:
+		return toFloat64s(UnmarshalSeries[int8](s))
+	case Uint64T:
+		return toFloat64s(UnmarshalSeries[uint64](s))
+	case Uint32T:
+		return toFloat64s(UnmarshalSeries[uint32](s))
+	case Uint16T:
+		return toFloat64s(UnmarshalSeries[uint16](s))
+	case Uint8T:
+		return toFloat64s(UnmarshalSeries[uint8](s))
+	case TimeStampT:
+		return toFloat64s(UnmarshalSeries[TimeStamp](s))
+	default:
+		return nil
+	}
+}
+
+func toFloat64s[T NumericSample](data []T) []float64 {
+	out := make([]float64, len(data))
+	for i, v := range data {
+		out[i] = float64(v)
+	}
+	return out
+}
+
 func unmarshalFixed[T FixedSample](b []byte) []T { return unsafe.CastSlice[byte, T](b) }
 
 func unmarshalVariable[T VariableSample](b []byte) []T {
@@ -232,6 +232,23 @@ var _ = Describe("SeriesFactory", func() {
 		})
 	})
 
+	Describe("Float64s", func() {
+		DescribeTable("Should convert the samples of numeric series",
+			func(s telem.Series, expected []float64) {
+				Expect(telem.Float64s(s)).To(Equal(expected))
+			},
+			Entry("float64", telem.NewSeriesV(1.5, -2.5), []float64{1.5, -2.5}),
+			Entry("float32", telem.NewSeriesV[float32](1.5, -2.5), []float64{1.5, -2.5}),
+			Entry("int8", telem.NewSeriesV[int8](-1, 2), []float64{-1, 2}),
+			Entry("uint64", telem.NewSeriesV[uint64](3, 4), []float64{3, 4}),
+			Entry("timestamp", telem.NewSeriesV[telem.TimeStamp](10, 20), []float64{10, 20}),
+		)
+		It("Should return nil for non-numeric series", func() {
+			Expect(telem.Float64s(telem.NewSeriesV("a", "b"))).To(BeNil())
+			Expect(telem.Float64s(telem.NewSeriesV(uuid.New()))).To(BeNil())
+		})
+	})
+
 	Describe("MarshalVariableSample", func() {
 		It("Should marshal a typical sample with a length prefix", func() {
 			sample := []byte("hello")